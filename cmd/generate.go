@@ -6,15 +6,31 @@ import (
 
 	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
 	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	"github.com/kylebeee/algokit-client-generator-go/internal/validate"
 	"github.com/spf13/cobra"
 )
 
 var (
-	applicationPath string
-	outputDir       string
-	packageName     string
-	mode            string
-	preserveNames   bool
+	applicationPath  string
+	outputDir        string
+	packageName      string
+	mode             string
+	preserveNames    bool
+	allowUntyped     bool
+	templatesDir     string
+	typeConfigPath   string
+	childConfigPath  string
+	emitTests        bool
+	emitCLI          bool
+	emitFake         bool
+	emitRecorder     bool
+	emitJSON         bool
+	emitInterceptors bool
+	emitParamsCache  bool
+	emitAsync        bool
+	emitOffline      bool
+	cliImportPath    string
+	networks         []string
 )
 
 // generateCmd represents the generate command.
@@ -33,6 +49,33 @@ through a Client struct with methods for each ABI method call.`,
 			return fmt.Errorf("--output flag is required")
 		}
 
+		// Validate the app spec before loading so problems are reported with their JSON paths
+		data, err := os.ReadFile(applicationPath)
+		if err != nil {
+			return fmt.Errorf("failed to read app spec file: %w", err)
+		}
+		var overrides *generate.TypeOverrides
+		if typeConfigPath != "" {
+			if overrides, err = generate.LoadTypeOverrides(typeConfigPath); err != nil {
+				return err
+			}
+		}
+		var diags []validate.Diagnostic
+		for _, d := range validate.ValidateWith(data, validate.Options{TypeOverrides: overrides}) {
+			// Unknown ABI types are emitted as any when explicitly allowed
+			if allowUntyped && d.Kind == validate.KindType {
+				continue
+			}
+			if d.IsWarning() {
+				fmt.Fprintf(os.Stderr, "warning: %s\n", d)
+				continue
+			}
+			diags = append(diags, d)
+		}
+		if len(diags) > 0 {
+			return &validate.Error{Diagnostics: diags}
+		}
+
 		// Load the app spec
 		contract, err := schema.LoadAppSpec(applicationPath)
 		if err != nil {
@@ -43,11 +86,55 @@ through a Client struct with methods for each ABI method call.`,
 
 		// Generate code
 		opts := generate.Options{
-			AppSpecPath:   applicationPath,
-			OutputDir:     outputDir,
-			PackageName:   packageName,
-			Mode:          mode,
-			PreserveNames: preserveNames,
+			AppSpecPath:      applicationPath,
+			OutputDir:        outputDir,
+			PackageName:      packageName,
+			Mode:             mode,
+			PreserveNames:    preserveNames,
+			AllowUntyped:     allowUntyped,
+			EmitTests:        emitTests,
+			EmitCLI:          emitCLI,
+			CLIImportPath:    cliImportPath,
+			EmitFake:         emitFake,
+			EmitRecorder:     emitRecorder,
+			EmitJSON:         emitJSON,
+			EmitInterceptors: emitInterceptors,
+			EmitParamsCache:  emitParamsCache,
+			EmitAsync:        emitAsync,
+			EmitOffline:      emitOffline,
+		}
+		opts.TypeOverrides = overrides
+		extras, err := schema.ParseExtras(data)
+		if err != nil {
+			return fmt.Errorf("failed to load app spec: %w", err)
+		}
+		opts.Events = extras.Events
+		if childConfigPath != "" {
+			children, err := generate.LoadChildApps(childConfigPath)
+			if err != nil {
+				return err
+			}
+			opts.ChildApps = children
+		}
+		for _, entry := range networks {
+			hash, appID, err := generate.ParseNetwork(entry)
+			if err != nil {
+				return err
+			}
+			if opts.Networks == nil {
+				opts.Networks = make(map[string]uint64)
+			}
+			opts.Networks[hash] = appID
+		}
+		if templatesDir != "" {
+			info, err := os.Stat(templatesDir)
+			if err != nil {
+				return fmt.Errorf("failed to read templates directory: %w", err)
+			}
+			if !info.IsDir() {
+				return fmt.Errorf("--templates must be a directory: %s", templatesDir)
+			}
+			opts.Templates = os.DirFS(templatesDir)
 		}
 
 		if err := generate.Generate(contract, opts); err != nil {
@@ -65,6 +152,21 @@ func init() {
 	generateCmd.Flags().StringVarP(&packageName, "package", "p", "", "Go package name (default: derived from contract name)")
 	generateCmd.Flags().StringVarP(&mode, "mode", "m", "full", "Generation mode: full or minimal")
 	generateCmd.Flags().BoolVar(&preserveNames, "preserve-names", false, "Preserve original method names (don't sanitize)")
+	generateCmd.Flags().BoolVar(&emitTests, "emit-tests", false, "Also generate round-trip fuzz tests for the ABI structs")
+	generateCmd.Flags().BoolVar(&emitCLI, "emit-cli", false, "Also generate a cobra program in cmd/<package> for calling the deployed app")
+	generateCmd.Flags().BoolVar(&emitFake, "emit-fake", false, "Also generate fake.go with FakeClient, an in-memory ClientAPI for unit tests")
+	generateCmd.Flags().BoolVar(&emitRecorder, "emit-recorder", false, "Also generate recorder.go with Client.Recorder and Client.Replay (implied by --emit-cli)")
+	generateCmd.Flags().BoolVar(&emitJSON, "emit-json", false, "Also generate json.go with AlgoKit-compatible JSON encoding of the generated types (implied by --emit-recorder)")
+	generateCmd.Flags().BoolVar(&emitInterceptors, "emit-interceptors", false, "Also generate interceptor.go with Client.Interceptors and the logging, retry and tracing interceptors")
+	generateCmd.Flags().BoolVar(&emitParamsCache, "emit-params-cache", false, "Also generate paramscache.go with SuggestedParamsCache for ClientOptions.ParamsCache")
+	generateCmd.Flags().BoolVar(&emitAsync, "emit-async", false, "Also generate async.go with SendAsync and Send{Method}Async (implied by --emit-offline)")
+	generateCmd.Flags().BoolVar(&emitOffline, "emit-offline", false, "Also generate offline.go with Build{Method}, Composer.Build and SubmitSigned for external signing")
+	generateCmd.Flags().StringVar(&cliImportPath, "cli-import", "", "Import path of the generated package for --emit-cli (default: derived from go.mod)")
+	generateCmd.Flags().StringVar(&templatesDir, "templates", "", "Directory of *.go.tmpl files overriding or extending the built-in templates")
+	generateCmd.Flags().StringVar(&typeConfigPath, "type-config", "", "JSON file declaring Go type overrides for ABI types, structs and fields")
+	generateCmd.Flags().StringVar(&childConfigPath, "child-config", "", "JSON file declaring methods that return the app IDs of child contracts")
+	generateCmd.Flags().StringArrayVar(&networks, "network", nil, "App ID on a network as <network>=<appID>, where network is mainnet, testnet or a base64 genesis hash (repeatable)")
+	generateCmd.Flags().BoolVar(&allowUntyped, "allow-untyped", false, "Generate ABI types without a Go mapping as any instead of failing")
 }
 
 // GetGenerateCmd returns the generate command for registration.
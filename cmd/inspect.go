@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/inspect"
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inspectApplicationPath string
+	inspectFormat          string
+)
+
+// inspectCmd represents the inspect command.
+var inspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Print a human-readable summary of an ARC-56/ARC-32 app spec",
+	Long: `Print a summary of an ARC-56 or ARC-32 application specification as the
+generator sees it: methods with their Go names and types, structs, state
+schema and keys, box maps, events, template variables and error messages.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if inspectApplicationPath == "" {
+			return fmt.Errorf("--application flag is required")
+		}
+
+		contract, err := schema.LoadAppSpec(inspectApplicationPath)
+		if err != nil {
+			return fmt.Errorf("failed to load app spec: %w", err)
+		}
+
+		extras, err := schema.LoadExtras(inspectApplicationPath)
+		if err != nil {
+			return fmt.Errorf("failed to load app spec: %w", err)
+		}
+
+		return inspect.Render(os.Stdout, inspect.Build(contract, extras), inspectFormat)
+	},
+}
+
+func init() {
+	inspectCmd.Flags().StringVarP(&inspectApplicationPath, "application", "a", "", "Path to ARC-56/ARC-32 app spec JSON file")
+	inspectCmd.Flags().StringVarP(&inspectFormat, "format", "f", "table", "Output format: "+strings.Join(inspect.Formats, ", "))
+}
+
+// GetInspectCmd returns the inspect command for registration.
+func GetInspectCmd() *cobra.Command {
+	return inspectCmd
+}
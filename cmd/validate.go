@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
+	"github.com/kylebeee/algokit-client-generator-go/internal/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	validateApplicationPath string
+	validateTypeConfigPath  string
+	validateJSON            bool
+)
+
+// validateCmd represents the validate command.
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Strictly validate an ARC-56/ARC-32 app spec",
+	Long: `Validate an ARC-56 or ARC-32 application specification and report every
+problem found with its JSON path: unknown or malformed ABI types, unresolved
+struct references, duplicate method signatures, invalid base64 keys and
+prefixes, schema counts that don't fit the declared keys, and Go identifier
+collisions after name sanitization.
+
+Schema count problems are reported as warnings, since the check can't tell how
+a contract stores ABI-typed values. The command fails only if there are other
+problems. Types mapped by --type-config are not reported as unknown.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if validateApplicationPath == "" {
+			return fmt.Errorf("--application flag is required")
+		}
+
+		data, err := os.ReadFile(validateApplicationPath)
+		if err != nil {
+			return fmt.Errorf("failed to read app spec file: %w", err)
+		}
+
+		var opts validate.Options
+		if validateTypeConfigPath != "" {
+			overrides, err := generate.LoadTypeOverrides(validateTypeConfigPath)
+			if err != nil {
+				return err
+			}
+			opts.TypeOverrides = overrides
+		}
+		diags := validate.ValidateWith(data, opts)
+
+		if validateJSON {
+			if diags == nil {
+				diags = []validate.Diagnostic{}
+			}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(diags); err != nil {
+				return err
+			}
+		} else {
+			for _, d := range diags {
+				if d.IsWarning() {
+					fmt.Fprintf(os.Stdout, "warning: %s\n", d)
+				} else {
+					fmt.Fprintf(os.Stdout, "error: %s\n", d)
+				}
+			}
+		}
+
+		problems, warnings := 0, 0
+		for _, d := range diags {
+			if d.IsWarning() {
+				warnings++
+			} else {
+				problems++
+			}
+		}
+		if problems > 0 {
+			return fmt.Errorf("%s: %d problem(s) and %d warning(s) found", validateApplicationPath, problems, warnings)
+		}
+
+		if warnings > 0 {
+			fmt.Fprintf(os.Stderr, "%s is valid, with %d warning(s)\n", validateApplicationPath, warnings)
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "%s is valid\n", validateApplicationPath)
+		return nil
+	},
+}
+
+func init() {
+	validateCmd.Flags().StringVarP(&validateApplicationPath, "application", "a", "", "Path to ARC-56/ARC-32 app spec JSON file")
+	validateCmd.Flags().StringVar(&validateTypeConfigPath, "type-config", "", "JSON file declaring Go type overrides; the types it maps are accepted")
+	validateCmd.Flags().BoolVar(&validateJSON, "json", false, "Print diagnostics as JSON")
+}
+
+// GetValidateCmd returns the validate command for registration.
+func GetValidateCmd() *cobra.Command {
+	return validateCmd
+}
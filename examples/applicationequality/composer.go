@@ -2,77 +2,513 @@
 package applicationequality
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/algorand/go-algorand-sdk/v2/abi"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // Composer builds atomic transaction groups for the ApplicationEquality contract.
+// A Composer is not safe for concurrent use.
 type Composer struct {
-	client   *Client
-	composer *algokit.TransactionComposer
+	client  *Client
+	pending []groupCall
 }
 
-// DoNothing adds a doNothing method call to the transaction group.
-func (comp *Composer) DoNothing(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
+// groupCall is a method call added to a Composer, built into a group with
+// the go-algorand-sdk AtomicTransactionComposer when the group is sent.
+type groupCall struct {
+	method    abi.Method
+	args      []interface{}
+	sender    types.Address
+	signer    transaction.TransactionSigner
+	note      []byte
+	boxes     []types.AppBoxReference
+	accounts  []types.Address
+	apps      []uint64
+	assets    []uint64
+	extraFee  uint64
+	staticFee uint64
+	readonly  bool
+	populate  bool // SendParams.PopulateAppCallResources
+}
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "doNothing" {
-			method, err = m.ToABIMethod()
-			break
-		}
+func newGroupCall[T any](method abi.Method, args []interface{}, params algokit.CallParams[T], readonly bool) groupCall {
+	return groupCall{
+		method:    method,
+		args:      args,
+		sender:    params.Sender,
+		signer:    params.Signer,
+		note:      params.Note,
+		boxes:     params.BoxReferences,
+		accounts:  params.AccountReferences,
+		apps:      params.AppReferences,
+		assets:    params.AssetReferences,
+		extraFee:  params.ExtraFee,
+		staticFee: params.StaticFee,
+		readonly:  readonly,
+		populate:  params.SendParams.PopulateAppCallResources,
 	}
+}
+
+// DoNothing adds a doNothing method call to the transaction group.
+func (comp *Composer) DoNothing(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("doNothing()void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // AppEquals adds a appEquals method call to the transaction group.
 func (comp *Composer) AppEquals(ctx context.Context, params algokit.CallParams[AppEqualsArgs]) (*Composer, error) {
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
+	}
 	methodArgs := argsToInterfaceAppEquals(params.Args)
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "appEquals" {
-			method, err = m.ToABIMethod()
-			break
+	method, err := abi.MethodFromSignature("appEquals(uint64)void")
+	if err != nil {
+		return nil, err
+	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
+	return comp, nil
+}
+
+// GroupResult is a confirmed group, or the simulated result of a group of
+// readonly calls.
+type GroupResult struct {
+	ConfirmedRound uint64 // 0 for a simulated group
+	TxIDs          []string
+	// Results holds the result of each method call of the group, in order,
+	// with ABIReturn as decoded by the SDK.
+	Results []algokit.SendAppTransactionResult
+	// Returns holds the typed result of each method call: its
+	// *{Method}MethodResult, or *algokit.SendAppTransactionResult for a void
+	// method.
+	Returns []interface{}
+}
+
+// Send sends the composed transaction group and waits for confirmation,
+// until the ctx deadline or 5 rounds if ctx has none. A group of only
+// readonly calls is simulated instead of sent. Send returns a
+// ConfirmationError if the group is not known to be confirmed. The client
+// must have an algod client: see ClientOptions.Algod.
+func (comp *Composer) Send(ctx context.Context) (*GroupResult, error) {
+	return comp.send(ctx)
+}
+
+func (comp *Composer) send(ctx context.Context) (*GroupResult, error) {
+	client, err := comp.client.algodClient("Composer.Send")
+	if err != nil {
+		return nil, err
+	}
+	if comp.readonly() {
+		return comp.simulate(ctx, client)
+	}
+	atc, _, lastValid, err := comp.prepare(ctx, client, signed)
+	if err != nil {
+		return nil, err
+	}
+	executed, err := atc.Execute(client, ctx, waitRounds(ctx))
+	if err != nil {
+		return nil, comp.client.sendFailed(sendError(ctx, atc, lastValid, err))
+	}
+	return comp.client.groupResult(executed.ConfirmedRound, executed.TxIDs, executed.MethodResults)
+}
+
+// readonly reports whether every call of the group is readonly.
+func (comp *Composer) readonly() bool {
+	for _, call := range comp.pending {
+		if !call.readonly {
+			return false
 		}
 	}
+	return len(comp.pending) > 0
+}
+
+// simulate runs a group of readonly calls without sending it.
+func (comp *Composer) simulate(ctx context.Context, client *algod.Client) (*GroupResult, error) {
+	atc, _, _, err := comp.prepare(ctx, client, simulated)
 	if err != nil {
 		return nil, err
 	}
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate readonly calls: %w", err)
+	}
+	if failure := response.SimulateResponse.TxnGroups[0].FailureMessage; failure != "" {
+		return nil, fmt.Errorf("readonly calls failed: %s", failure)
+	}
+	txIDs := make([]string, len(response.MethodResults))
+	for i, r := range response.MethodResults {
+		txIDs[i] = r.TxID
+	}
+	return comp.client.groupResult(0, txIDs, response.MethodResults)
+}
+
+// groupResult returns the results of the method calls of a group.
+func (c *Client) groupResult(round uint64, txIDs []string, methodResults []transaction.ABIMethodResult) (*GroupResult, error) {
+	result := &GroupResult{ConfirmedRound: round, TxIDs: txIDs}
+	for _, r := range methodResults {
+		if r.DecodeError != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, r.DecodeError)
+		}
+		sent := algokit.SendAppTransactionResult{TxID: r.TxID, ABIReturn: r.ReturnValue, Confirmation: r.TransactionInfo}
+		typed, err := c.decodeResult(r.Method, sent)
+		if err != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, err)
+		}
+		result.Results = append(result.Results, sent)
+		result.Returns = append(result.Returns, typed)
+	}
+	return result, nil
+}
+
+// decodeResult returns the typed result of a confirmed method call.
+func (c *Client) decodeResult(method abi.Method, result algokit.SendAppTransactionResult) (interface{}, error) {
+	switch method.GetSignature() {
+	}
+	return &result, nil
+}
+
+// suggestedParams returns the params to build transactions with, with the
+// client's ValidityWindow applied.
+func (c *Client) suggestedParams(ctx context.Context, client *algod.Client) (types.SuggestedParams, error) {
+	sp, err := client.SuggestedParams().Do(ctx)
+	if err != nil {
+		return sp, fmt.Errorf("failed to get suggested params: %w", err)
+	}
+	if window := c.opts.ValidityWindow; window > 0 {
+		sp.LastRoundValid = sp.FirstRoundValid + types.Round(window)
+	}
+	return sp, nil
+}
+
+// sendFailed returns the error of a failed send.
+func (c *Client) sendFailed(err error) error {
+	return err
+}
+
+// signing is how buildATC signs the calls of a group.
+type signing int
+
+const (
+	signed    signing = iota // each call needs a signer
+	unsigned                 // no signer is needed
+	simulated                // every signer is replaced by an empty one
+)
+
+// sentCall is a method call of a built group.
+type sentCall struct {
+	index  int // position in the group
+	method abi.Method
+}
+
+// prepare builds the group with the SDK composer, first simulating it to
+// find the resources of calls with PopulateAppCallResources. It returns the
+// last valid round of the group.
+func (comp *Composer) prepare(ctx context.Context, client *algod.Client, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, uint64, error) {
+	if len(comp.pending) == 0 {
+		return nil, nil, 0, errors.New("the group needs at least one call")
+	}
+	sp, err := comp.client.suggestedParams(ctx, client)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	calls := comp.pending
+	if mode != simulated && comp.populates() {
+		if calls, err = comp.populateResources(ctx, client, sp); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	atc, sent, err := comp.buildATC(calls, sp, mode)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return atc, sent, uint64(sp.LastRoundValid), nil
+}
+
+// buildATC adds calls to an SDK composer, using the client's default sender
+// and signer for calls without them.
+func (comp *Composer) buildATC(calls []groupCall, sp types.SuggestedParams, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, error) {
+	var atc transaction.AtomicTransactionComposer
+	sent := make([]sentCall, 0, len(calls))
+	for _, call := range calls {
+		if call.sender.IsZero() {
+			call.sender = comp.client.params.DefaultSender
+		}
+		if call.signer == nil && call.sender == comp.client.params.DefaultSender {
+			call.signer = comp.client.params.DefaultSigner
+		}
+		if call.sender.IsZero() {
+			return nil, nil, fmt.Errorf("no sender for %s", call.method.Name)
+		}
+		switch {
+		case mode == simulated:
+			call.signer = transaction.EmptyTransactionSigner{}
+		case call.signer == nil && mode == signed:
+			return nil, nil, fmt.Errorf("no signer for %s", call.sender)
+		}
+		callSP := sp
+		if call.staticFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(call.staticFee)
+		} else if call.extraFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(sp.MinFee+call.extraFee)
+		}
+		accounts := make([]string, len(call.accounts))
+		for i, a := range call.accounts {
+			accounts[i] = a.String()
+		}
+		args, err := sdkArgs(call.method, call.args, mode == simulated)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = atc.AddMethodCall(transaction.AddMethodCallParams{
+			AppID:           comp.client.AppID(),
+			Method:          call.method,
+			MethodArgs:      args,
+			Sender:          call.sender,
+			SuggestedParams: callSP,
+			OnComplete:      types.NoOpOC,
+			Note:            call.note,
+			Signer:          call.signer,
+			ForeignApps:     call.apps,
+			ForeignAssets:   call.assets,
+			ForeignAccounts: accounts,
+			BoxReferences:   call.boxes,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		sent = append(sent, sentCall{index: atc.Count() - 1, method: call.method})
+	}
+	return &atc, sent, nil
+}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+// groupTxIDs returns the transaction IDs of a built group.
+func groupTxIDs(atc *transaction.AtomicTransactionComposer) []string {
+	group, err := atc.BuildGroup()
+	if err != nil {
+		return nil
+	}
+	txIDs := make([]string, len(group))
+	for i, txn := range group {
+		txIDs[i] = crypto.GetTxID(txn.Txn)
+	}
+	return txIDs
+}
+
+// sdkArgs converts method args to the values the SDK composer encodes. The
+// generated Args structs hold account reference args as address strings,
+// which the SDK only accepts as a types.Address. With emptySigners, the
+// signers of transaction args are replaced for simulation.
+func sdkArgs(method abi.Method, args []interface{}, emptySigners bool) ([]interface{}, error) {
+	converted := make([]interface{}, len(args))
+	copy(converted, args)
+	for i, arg := range converted {
+		switch a := arg.(type) {
+		case transaction.TransactionWithSigner:
+			if emptySigners {
+				a.Signer = transaction.EmptyTransactionSigner{}
+				converted[i] = a
+			}
+		case string:
+			if i >= len(method.Args) || method.Args[i].Type != abi.AccountReferenceType {
+				continue
+			}
+			address, err := types.DecodeAddress(a)
+			if err != nil {
+				return nil, fmt.Errorf("%s arg %s: %w", method.Name, method.Args[i].Name, err)
+			}
+			converted[i] = address
+		}
+	}
+	return converted, nil
+}
+
+// populates reports whether a call of the group has PopulateAppCallResources.
+func (comp *Composer) populates() bool {
+	for _, call := range comp.pending {
+		if call.populate {
+			return true
+		}
+	}
+	return false
+}
+
+// maxReferences and maxAccountReferences are the most resources and
+// accounts an app call can reference.
+const (
+	maxReferences        = 8
+	maxAccountReferences = 4
+)
+
+// populateResources simulates the group and returns its calls with the
+// resources they access without referencing them added to their references.
+// Resources the group shares go to the first call with room for them.
+func (comp *Composer) populateResources(ctx context.Context, client *algod.Client, sp types.SuggestedParams) ([]groupCall, error) {
+	atc, sent, err := comp.buildATC(comp.pending, sp, simulated)
 	if err != nil {
 		return nil, err
 	}
-	return comp, nil
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate to populate resources: %w", err)
+	}
+	group := response.SimulateResponse.TxnGroups[0]
+	if group.FailureMessage != "" {
+		return nil, fmt.Errorf("simulating to populate resources failed: %s", group.FailureMessage)
+	}
+
+	appID := comp.client.AppID()
+	calls := make([]groupCall, len(comp.pending))
+	copy(calls, comp.pending)
+	for i, s := range sent {
+		accessed := group.TxnResults[s.index].UnnamedResourcesAccessed
+		if err := calls[i].addResources(appID, accessed, false); err != nil {
+			return nil, err
+		}
+	}
+	accessed := group.UnnamedResourcesAccessed
+	for _, resources := range splitResources(accessed) {
+		added := false
+		for i := range calls {
+			if calls[i].addResources(appID, resources, true) == nil {
+				added = true
+				break
+			}
+		}
+		if !added {
+			return nil, fmt.Errorf("no call of the group has room to reference %+v", resources)
+		}
+	}
+	return calls, nil
 }
 
-// Send executes the composed transaction group and waits for confirmation.
-func (comp *Composer) Send(ctx context.Context) (*algokit.ComposerExecuteResult, error) {
-	return comp.composer.Execute(ctx, 5)
+// splitResources splits the resources a group accesses into the sets that
+// must be referenced by the same call: an account or app with its local
+// state, an account with an asset holding and an app with its box.
+func splitResources(accessed models.SimulateUnnamedResourcesAccessed) []models.SimulateUnnamedResourcesAccessed {
+	var sets []models.SimulateUnnamedResourcesAccessed
+	for _, local := range accessed.AppLocals {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{local.Account}, Apps: []uint64{local.App}})
+	}
+	for _, holding := range accessed.AssetHoldings {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{holding.Account}, Assets: []uint64{holding.Asset}})
+	}
+	for _, account := range accessed.Accounts {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{account}})
+	}
+	for _, app := range accessed.Apps {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Apps: []uint64{app}})
+	}
+	for _, asset := range accessed.Assets {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Assets: []uint64{asset}})
+	}
+	for _, box := range accessed.Boxes {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Boxes: []models.BoxReference{box}})
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{ExtraBoxRefs: 1})
+	}
+	return sets
+}
+
+// addResources adds resources to the call's references. With limited set,
+// it adds none and fails if they would take the call over the reference
+// limits.
+func (call *groupCall) addResources(appID uint64, accessed models.SimulateUnnamedResourcesAccessed, limited bool) error {
+	updated := *call
+	updated.accounts = append([]types.Address(nil), call.accounts...)
+	updated.apps = append([]uint64(nil), call.apps...)
+	updated.assets = append([]uint64(nil), call.assets...)
+	updated.boxes = append([]types.AppBoxReference(nil), call.boxes...)
+
+	accounts := append([]string(nil), accessed.Accounts...)
+	apps := append([]uint64(nil), accessed.Apps...)
+	assets := append([]uint64(nil), accessed.Assets...)
+	for _, local := range accessed.AppLocals {
+		accounts, apps = append(accounts, local.Account), append(apps, local.App)
+	}
+	for _, holding := range accessed.AssetHoldings {
+		accounts, assets = append(accounts, holding.Account), append(assets, holding.Asset)
+	}
+
+	addApp := func(app uint64) {
+		if app != appID && !containsUint(updated.apps, app) {
+			updated.apps = append(updated.apps, app)
+		}
+	}
+	for _, s := range accounts {
+		account, err := types.DecodeAddress(s)
+		if err != nil {
+			return err
+		}
+		if account != updated.sender && !containsAddress(updated.accounts, account) {
+			updated.accounts = append(updated.accounts, account)
+		}
+	}
+	for _, app := range apps {
+		addApp(app)
+	}
+	for _, asset := range assets {
+		if !containsUint(updated.assets, asset) {
+			updated.assets = append(updated.assets, asset)
+		}
+	}
+	for _, box := range accessed.Boxes {
+		ref := types.AppBoxReference{AppID: box.App, Name: box.Name}
+		if box.App == appID {
+			ref.AppID = 0
+		} else {
+			addApp(box.App)
+		}
+		updated.boxes = append(updated.boxes, ref)
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		updated.boxes = append(updated.boxes, types.AppBoxReference{})
+	}
+
+	references := len(updated.accounts) + len(updated.apps) + len(updated.assets) + len(updated.boxes)
+	if limited && (len(updated.accounts) > maxAccountReferences || references > maxReferences) {
+		return errors.New("too many references")
+	}
+	*call = updated
+	return nil
+}
+
+func containsUint(values []uint64, v uint64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(values []types.Address, v types.Address) bool {
+	for _, x := range values {
+		if bytes.Equal(x[:], v[:]) {
+			return true
+		}
+	}
+	return false
 }
@@ -3,5 +3,5 @@ package applicationequality
 
 // AppEqualsArgs holds the arguments for the appEquals method.
 type AppEqualsArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
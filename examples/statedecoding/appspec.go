@@ -2,4 +2,11 @@
 package statedecoding
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"StateDecoding\",\"arcs\":[22,28],\"methods\":[{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"getBox\",\"args\":[{\"type\":\"uint64\",\"name\":\"offset\"}],\"returns\":{\"type\":\"byte[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"doNothing\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"rawState\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"byte[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"decodeAppList\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AppList\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"decodeUint64\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"decodeStaticArray\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AppList\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"checkObjectAssignment\",\"args\":[{\"type\":\"uint64\",\"name\":\"a\"},{\"type\":\"uint64\",\"name\":\"b\"}],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"RandoStruct\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"retObject\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"RandoObject\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"retDecode\",\"args\":[],\"returns\":{\"type\":\"(uint64,address,uint64[])\",\"struct\":\"RandoComplexObject\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"retList\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64)[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"percentileCheck\",\"args\":[],\"returns\":{\"type\":\"uint64[5]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"bigLoop\",\"args\":[],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"bigCLoop\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64,uint64)\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"nullun\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"dynamicArrayOfDynamicArrays\",\"args\":[{\"type\":\"uint64\",\"name\":\"a\"},{\"type\":\"(uint64,address,uint64[])[]\",\"name\":\"b\"},{\"type\":\"address\",\"name\":\"c\"}],\"returns\":{\"type\":\"uint64[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"subTest\",\"args\":[],\"returns\":{\"type\":\"uint64[5]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"shadowTest\",\"args\":[],\"returns\":{\"type\":\"(bool,bool,bool,bool)\",\"struct\":\"shadowTestResult\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"boxSetTest\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"paddedBytes\",\"args\":[],\"returns\":{\"type\":\"byte[32]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"AppList\":[{\"name\":\"one\",\"type\":\"uint64\"},{\"name\":\"two\",\"type\":\"uint64\"},{\"name\":\"three\",\"type\":\"uint64\"},{\"name\":\"four\",\"type\":\"uint64\"},{\"name\":\"five\",\"type\":\"uint64\"},{\"name\":\"six\",\"type\":\"uint64\"},{\"name\":\"seven\",\"type\":\"uint64\"},{\"name\":\"eight\",\"type\":\"uint64\"},{\"name\":\"nine\",\"type\":\"uint64\"},{\"name\":\"ten\",\"type\":\"uint64\"},{\"name\":\"eleven\",\"type\":\"uint64\"},{\"name\":\"twelve\",\"type\":\"uint64\"},{\"name\":\"thirteen\",\"type\":\"uint64\"},{\"name\":\"fourteen\",\"type\":\"uint64\"},{\"name\":\"fifteen\",\"type\":\"uint64\"}],\"RandoComplexObject\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"address\"},{\"name\":\"c\",\"type\":\"uint64[]\"}],\"RandoObject\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"uint64\"}],\"RandoStruct\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"uint64\"}],\"shadowTestResult\":[{\"name\":\"a\",\"type\":\"bool\"},{\"name\":\"b\",\"type\":\"bool\"},{\"name\":\"c\",\"type\":\"bool\"},{\"name\":\"valid\",\"type\":\"bool\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":0,\"bytes\":1},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"check\":{\"keyType\":\"AVMString\",\"valueType\":\"RandoStruct\",\"key\":\"Y2hlY2s=\"}},\"local\":{},\"box\":{\"box\":{\"keyType\":\"AVMString\",\"valueType\":\"uint64[4096]\",\"key\":\"Yw==\"},\"boxarc4\":{\"keyType\":\"AVMString\",\"valueType\":\"RandoStruct\",\"key\":\"YQ==\"}}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMSAwIDggMTAwMDAgNDA5NiAxMDAwMDAKICAgIGJ5dGVjYmxvY2sgMHgxNTFmN2M3NSAweDYzMDAgMHgwNjgxMDEgImFwcF9saXN0IiAiY2hlY2siCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzUKICAgIC8vIGV4cG9ydCBjbGFzcyBTdGF0ZURlY29kaW5nIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE51bUFwcEFyZ3MKICAgIGJ6IG1haW5fYmFyZV9yb3V0aW5nQDI3CiAgICBwdXNoYnl0ZXNzIDB4ODNmMTQ3NDggMHg2MWY0MGM5MyAweGU5ZTEwMzQ0IDB4ZGJhZTY1M2EgMHgyNzRjZjg3NSAweDk5YmUxMzEyIDB4MmU5NzQyZGQgMHgxMGUwNzZlZiAweDhjZjA0OTI2IDB4ZjA1NGEyMTEgMHhkZjNiYTkxMCAweDQ4YTc2M2ZlIDB4ZDAyNmY5YTAgMHgxYjZjOWU0MyAweDczODhhYmUxIDB4MjIxNjVmZjAgMHhiNGVmOGVmMSAweGQ4NzA2MzY2IDB4MzI1M2Y5MmMgMHg2M2E4ZDA5MSAvLyBtZXRob2QgImluaXQoKXZvaWQiLCBtZXRob2QgImdldEJveCh1aW50NjQpYnl0ZVtdIiwgbWV0aG9kICJkb05vdGhpbmcoKXZvaWQiLCBtZXRob2QgInJhd1N0YXRlKGFwcGxpY2F0aW9uKWJ5dGVbXSIsIG1ldGhvZCAiZGVjb2RlQXBwTGlzdChhcHBsaWNhdGlvbikodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJkZWNvZGVVaW50NjQoYXBwbGljYXRpb24pdWludDY0IiwgbWV0aG9kICJkZWNvZGVTdGF0aWNBcnJheShhcHBsaWNhdGlvbikodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJjaGVja09iamVjdEFzc2lnbm1lbnQodWludDY0LHVpbnQ2NCkodWludDY0LHVpbnQ2NCkiLCBtZXRob2QgInJldE9iamVjdCgpKHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJyZXREZWNvZGUoKSh1aW50NjQsYWRkcmVzcyx1aW50NjRbXSkiLCBtZXRob2QgInJldExpc3QoKSh1aW50NjQsdWludDY0KVtdIiwgbWV0aG9kICJwZXJjZW50aWxlQ2hlY2soKXVpbnQ2NFs1XSIsIG1ldGhvZCAiYmlnTG9vcCgpdWludDY0IiwgbWV0aG9kICJiaWdDTG9vcCgpKHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAibnVsbHVuKCl2b2lkIiwgbWV0aG9kICJkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXModWludDY0LCh1aW50NjQsYWRkcmVzcyx1aW50NjRbXSlbXSxhZGRyZXNzKXVpbnQ2NFtdIiwgbWV0aG9kICJzdWJUZXN0KCl1aW50NjRbNV0iLCBtZXRob2QgInNoYWRvd1Rlc3QoKShib29sLGJvb2wsYm9vbCxib29sKSIsIG1ldGhvZCAiYm94U2V0VGVzdCgpdm9pZCIsIG1ldGhvZCAicGFkZGVkQnl0ZXMoKWJ5dGVbMzJdIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggbWFpbl9pbml0X3JvdXRlQDUgbWFpbl9nZXRCb3hfcm91dGVANiBtYWluX2RvTm90aGluZ19yb3V0ZUA3IG1haW5fcmF3U3RhdGVfcm91dGVAOCBtYWluX2RlY29kZUFwcExpc3Rfcm91dGVAOSBtYWluX2RlY29kZVVpbnQ2NF9yb3V0ZUAxMCBtYWluX2RlY29kZVN0YXRpY0FycmF5X3JvdXRlQDExIG1haW5fY2hlY2tPYmplY3RBc3NpZ25tZW50X3JvdXRlQDEyIG1haW5fcmV0T2JqZWN0X3JvdXRlQDEzIG1haW5fcmV0RGVjb2RlX3JvdXRlQDE0IG1haW5fcmV0TGlzdF9yb3V0ZUAxNSBtYWluX3BlcmNlbnRpbGVDaGVja19yb3V0ZUAxNiBtYWluX2JpZ0xvb3Bfcm91dGVAMTcgbWFpbl9iaWdDTG9vcF9yb3V0ZUAxOCBtYWluX251bGx1bl9yb3V0ZUAxOSBtYWluX2R5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c19yb3V0ZUAyMCBtYWluX3N1YlRlc3Rfcm91dGVAMjEgbWFpbl9zaGFkb3dUZXN0X3JvdXRlQDIyIG1haW5fYm94U2V0VGVzdF9yb3V0ZUAyMyBtYWluX3BhZGRlZEJ5dGVzX3JvdXRlQDI0CgptYWluX2FmdGVyX2lmX2Vsc2VAMzE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzUKICAgIC8vIGV4cG9ydCBjbGFzcyBTdGF0ZURlY29kaW5nIGV4dGVuZHMgQ29udHJhY3QgewogICAgaW50Y18xIC8vIDAKICAgIHJldHVybgoKbWFpbl9wYWRkZWRCeXRlc19yb3V0ZUAyNDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoyMTQKICAgIC8vIHBhZGRlZEJ5dGVzKCk6IFN0YXRpY0J5dGVzPDMyPiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1MDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JveFNldFRlc3Rfcm91dGVAMjM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTk0CiAgICAvLyBib3hTZXRUZXN0KCk6IHZvaWQgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBpcyBub3QgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3NoYWRvd1Rlc3Rfcm91dGVAMjI6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTgxCiAgICAvLyBzaGFkb3dUZXN0KCk6IHsgYTogYm9vbGVhbiwgYjogYm9vbGVhbiwgYzogYm9vbGVhbiwgdmFsaWQ6IGJvb2xlYW4gfSB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1YjAKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9zdWJUZXN0X3JvdXRlQDIxOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE3MgogICAgLy8gc3ViVGVzdCgpOiBTdGF0aWNBcnJheTxVaW50TjY0LCA1PiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgc3ViVGVzdAogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9keW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfcm91dGVAMjA6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICBjYWxsc3ViIGR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5cwogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9udWxsdW5fcm91dGVAMTk6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU0CiAgICAvLyBudWxsdW4oKTogdm9pZCB7IH0KICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9iaWdDTG9vcF9yb3V0ZUAxODoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzMKICAgIC8vIGJpZ0NMb29wKCk6IFt1aW50NjQsIHVpbnQ2NCwgdWludDY0XSB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgYmlnQ0xvb3AKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JpZ0xvb3Bfcm91dGVAMTc6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTIzCiAgICAvLyBiaWdMb29wKCk6IHVpbnQ2NCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgYmlnTG9vcAogICAgaXRvYgogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9wZXJjZW50aWxlQ2hlY2tfcm91dGVAMTY6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEzCiAgICAvLyBwZXJjZW50aWxlQ2hlY2soKTogU3RhdGljQXJyYXk8VWludE42NCwgNT4gewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBpcyBub3QgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBjYWxsc3ViIHBlcmNlbnRpbGVDaGVjawogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9yZXRMaXN0X3JvdXRlQDE1OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEwMgogICAgLy8gcmV0TGlzdCgpOiBEeW5hbWljQXJyYXk8UmFuZG9TdHJ1Y3Q+IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgcHVzaGJ5dGVzIDB4MTUxZjdjNzUwMDAyMDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDIwMDAwMDAwMDAwMDAwMDAzMDAwMDAwMDAwMDAwMDAwNAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3JldERlY29kZV9yb3V0ZUAxNDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo5MQogICAgLy8gcmV0RGVjb2RlKCk6IFJhbmRvQ29tcGxleE9iamVjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyBiYXNlMzIoQ1VQWFk1SUFBQUFBQUFBQUFBQVFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBRklBQUVBQUFBQUFBQUFBQUFFQUFBQUFBQUFBQUFBUSkKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9yZXRPYmplY3Rfcm91dGVAMTM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODcKICAgIC8vIHJldE9iamVjdCgpOiBSYW5kb09iamVjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1MDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDIKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9jaGVja09iamVjdEFzc2lnbm1lbnRfcm91dGVAMTI6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODIKICAgIC8vIGNoZWNrT2JqZWN0QXNzaWdubWVudChhOiBhcmM0LlVpbnRONjQsIGI6IGFyYzQuVWludE42NCk6IFJhbmRvU3RydWN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MgogICAgLy8gY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGFyYzQuVWludE42NCwgYjogYXJjNC5VaW50TjY0KTogUmFuZG9TdHJ1Y3QgewogICAgY2FsbHN1YiBjaGVja09iamVjdEFzc2lnbm1lbnQKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlU3RhdGljQXJyYXlfcm91dGVAMTE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzYKICAgIC8vIGRlY29kZVN0YXRpY0FycmF5KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIHR4bmFzIEFwcGxpY2F0aW9ucwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjc2CiAgICAvLyBkZWNvZGVTdGF0aWNBcnJheShhcHA6IEFwcGxpY2F0aW9uKTogQXBwTGlzdCB7CiAgICBjYWxsc3ViIGRlY29kZVN0YXRpY0FycmF5CiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICB1bmNvdmVyIDE0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDExCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA5CiAgICBjb25jYXQKICAgIHVuY292ZXIgOAogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBjb25jYXQKICAgIHVuY292ZXIgNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlVWludDY0X3JvdXRlQDEwOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjcxCiAgICAvLyBkZWNvZGVVaW50NjQoYXBwOiBBcHBsaWNhdGlvbik6IHVpbnQ2NCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hcyBBcHBsaWNhdGlvbnMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3MQogICAgLy8gZGVjb2RlVWludDY0KGFwcDogQXBwbGljYXRpb24pOiB1aW50NjQgewogICAgY2FsbHN1YiBkZWNvZGVVaW50NjQKICAgIGl0b2IKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlQXBwTGlzdF9yb3V0ZUA5OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIHR4bmFzIEFwcGxpY2F0aW9ucwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIGNhbGxzdWIgZGVjb2RlQXBwTGlzdAogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgdW5jb3ZlciAxNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDEzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTIKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDEwCiAgICBjb25jYXQKICAgIHVuY292ZXIgOQogICAgY29uY2F0CiAgICB1bmNvdmVyIDgKICAgIGNvbmNhdAogICAgdW5jb3ZlciA3CiAgICBjb25jYXQKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3Jhd1N0YXRlX3JvdXRlQDg6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjAKICAgIC8vIHJhd1N0YXRlKGFwcDogQXBwbGljYXRpb24pOiBieXRlcyB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hcyBBcHBsaWNhdGlvbnMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2MAogICAgLy8gcmF3U3RhdGUoYXBwOiBBcHBsaWNhdGlvbik6IGJ5dGVzIHsKICAgIGNhbGxzdWIgcmF3U3RhdGUKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2RvTm90aGluZ19yb3V0ZUA3OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjU2CiAgICAvLyBkb05vdGhpbmcoKTogdm9pZCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZ2V0Qm94X3JvdXRlQDY6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NTIKICAgIC8vIGdldEJveChvZmZzZXQ6IHVpbnQ2NCk6IGJ5dGVzIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo1MgogICAgLy8gZ2V0Qm94KG9mZnNldDogdWludDY0KTogYnl0ZXMgewogICAgY2FsbHN1YiBnZXRCb3gKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2luaXRfcm91dGVANToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo0NwogICAgLy8gaW5pdCgpOiB2b2lkIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgY2FsbHN1YiBpbml0CiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JhcmVfcm91dGluZ0AyNzoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDMxCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgIQogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBjcmVhdGluZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIF9wdXlhX2xpYi51dGlsLmVuc3VyZV9idWRnZXQocmVxdWlyZWRfYnVkZ2V0OiB1aW50NjQsIGZlZV9zb3VyY2U6IHVpbnQ2NCkgLT4gdm9pZDoKZW5zdXJlX2J1ZGdldDoKICAgIHByb3RvIDIgMAogICAgZnJhbWVfZGlnIC0yCiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCgplbnN1cmVfYnVkZ2V0X3doaWxlX3RvcEAxOgogICAgZnJhbWVfZGlnIDAKICAgIGdsb2JhbCBPcGNvZGVCdWRnZXQKICAgID4KICAgIGJ6IGVuc3VyZV9idWRnZXRfYWZ0ZXJfd2hpbGVANwogICAgaXR4bl9iZWdpbgogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIHB1c2hpbnQgNSAvLyBEZWxldGVBcHBsaWNhdGlvbgogICAgaXR4bl9maWVsZCBPbkNvbXBsZXRpb24KICAgIGJ5dGVjXzIgLy8gMHgwNjgxMDEKICAgIGl0eG5fZmllbGQgQXBwcm92YWxQcm9ncmFtCiAgICBieXRlY18yIC8vIDB4MDY4MTAxCiAgICBpdHhuX2ZpZWxkIENsZWFyU3RhdGVQcm9ncmFtCiAgICBmcmFtZV9kaWcgLTEKICAgIHN3aXRjaCBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzBAMyBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzFANAoKZW5zdXJlX2J1ZGdldF9zd2l0Y2hfY2FzZV9uZXh0QDY6CiAgICBpdHhuX3N1Ym1pdAogICAgYiBlbnN1cmVfYnVkZ2V0X3doaWxlX3RvcEAxCgplbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzFANDoKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIGl0eG5fZmllbGQgRmVlCiAgICBiIGVuc3VyZV9idWRnZXRfc3dpdGNoX2Nhc2VfbmV4dEA2CgplbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzBAMzoKICAgIGludGNfMSAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgYiBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlX25leHRANgoKZW5zdXJlX2J1ZGdldF9hZnRlcl93aGlsZUA3OgogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmluaXQoKSAtPiB2b2lkOgppbml0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQ4CiAgICAvLyB0aGlzLmJveCh1aW50OCgwKSkudmFsdWUgPSBpbnRlcnByZXRBc0FyYzQ8U3RhdGljQXJyYXk8VWludE42NCwgNDA5Nj4+KG9wLmJ6ZXJvKDQwOTYpKQogICAgaW50YyA0IC8vIDQwOTYKICAgIGJ6ZXJvCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzkKICAgIC8vIGJveCA9IEJveE1hcDxVaW50TjgsIFN0YXRpY0FycmF5PFVpbnRONjQsIDQwOTY+Pih7IGtleVByZWZpeDogJ2MnIH0pCiAgICBieXRlY18xIC8vIDB4NjMwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQ4CiAgICAvLyB0aGlzLmJveCh1aW50OCgwKSkudmFsdWUgPSBpbnRlcnByZXRBc0FyYzQ8U3RhdGljQXJyYXk8VWludE42NCwgNDA5Nj4+KG9wLmJ6ZXJvKDQwOTYpKQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQxCiAgICAvLyBib3hhcmM0ID0gQm94TWFwPFVpbnROOCwgUmFuZG9TdHJ1Y3Q+KHsga2V5UHJlZml4OiAnYScgfSkKICAgIHB1c2hieXRlc3MgMHg2MTAwIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAgLy8gMHg2MTAwLCAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NDkKICAgIC8vIHRoaXMuYm94YXJjNCh1aW50OCgwKSkudmFsdWUgPSBuZXcgUmFuZG9TdHJ1Y3QoeyBhOiBuZXcgVWludE42NCgwKSwgYjogbmV3IFVpbnRONjQoMCkgfSkKICAgIGJveF9wdXQKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5nZXRCb3gob2Zmc2V0OiB1aW50NjQpIC0+IGJ5dGVzOgpnZXRCb3g6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NTIKICAgIC8vIGdldEJveChvZmZzZXQ6IHVpbnQ2NCk6IGJ5dGVzIHsKICAgIHByb3RvIDEgMQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM5CiAgICAvLyBib3ggPSBCb3hNYXA8VWludE44LCBTdGF0aWNBcnJheTxVaW50TjY0LCA0MDk2Pj4oeyBrZXlQcmVmaXg6ICdjJyB9KQogICAgYnl0ZWNfMSAvLyAweDYzMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo1MwogICAgLy8gcmV0dXJuIHRoaXMuYm94KHVpbnQ4KDApKS52YWx1ZS5ieXRlcy5zbGljZShvZmZzZXQsIG9mZnNldCArIDQwOTYpCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBsZW4KICAgIGZyYW1lX2RpZyAtMQogICAgZGlnIDEKICAgID49CiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjIDQgLy8gNDA5NgogICAgKwogICAgZHVwCiAgICBkaWcgMwogICAgPj0KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGRpZyAyCiAgICBzd2FwCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5yYXdTdGF0ZShhcHA6IHVpbnQ2NCkgLT4gYnl0ZXM6CnJhd1N0YXRlOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjYwCiAgICAvLyByYXdTdGF0ZShhcHA6IEFwcGxpY2F0aW9uKTogYnl0ZXMgewogICAgcHJvdG8gMSAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjEKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYXBwLCBCeXRlcygnYXBwX2xpc3QnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWNfMyAvLyAiYXBwX2xpc3QiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjIKICAgIC8vIHJldHVybiBhcHBMaXN0Qnl0ZXMKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5kZWNvZGVBcHBMaXN0KGFwcDogdWludDY0KSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpkZWNvZGVBcHBMaXN0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHByb3RvIDEgMTUKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2NgogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhhcHAsIEJ5dGVzKCdhcHBfbGlzdCcpKQogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlY18zIC8vICJhcHBfbGlzdCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2NwogICAgLy8gY29uc3QgYXBwTGlzdCA9IGRlY29kZUFyYzQ8QXBwTGlzdD4oYXBwTGlzdEJ5dGVzKQogICAgZHVwCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAyCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNAogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA1CiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDYKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNwogICAgcHVzaGludCA1NiAvLyA1NgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA4CiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDkKICAgIHB1c2hpbnQgNzIgLy8gNzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTAKICAgIHB1c2hpbnQgODAgLy8gODAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTEKICAgIHB1c2hpbnQgODggLy8gODgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTIKICAgIHB1c2hpbnQgOTYgLy8gOTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTMKICAgIHB1c2hpbnQgMTA0IC8vIDEwNAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMTQKICAgIHB1c2hpbnQgMTEyIC8vIDExMgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2OAogICAgLy8gcmV0dXJuIGFwcExpc3QKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5kZWNvZGVVaW50NjQoYXBwOiB1aW50NjQpIC0+IHVpbnQ2NDoKZGVjb2RlVWludDY0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjcxCiAgICAvLyBkZWNvZGVVaW50NjQoYXBwOiBBcHBsaWNhdGlvbik6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3MgogICAgLy8gY29uc3QgW2FwcEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NChhcHAsIEJ5dGVzKCdhcHAnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGJ5dGVzICJhcHAiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzMKICAgIC8vIHJldHVybiBhcHBCeXRlcwogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmRlY29kZVN0YXRpY0FycmF5KGFwcDogdWludDY0KSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpkZWNvZGVTdGF0aWNBcnJheToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3NgogICAgLy8gZGVjb2RlU3RhdGljQXJyYXkoYXBwOiBBcHBsaWNhdGlvbik6IEFwcExpc3QgewogICAgcHJvdG8gMSAxNQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjc3CiAgICAvLyBjb25zdCBbYXBwQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYXBwLCBCeXRlcygnc2tpcF9saXMnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGJ5dGVzICJza2lwX2xpcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3OAogICAgLy8gY29uc3QgYXBwTGlzdCA9IGRlY29kZUFyYzQ8QXBwTGlzdD4oYXBwQnl0ZXMsICdub25lJykKICAgIGR1cAogICAgaW50Y18xIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMgogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDQKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNQogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA2CiAgICBwdXNoaW50IDQ4IC8vIDQ4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDcKICAgIHB1c2hpbnQgNTYgLy8gNTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgOAogICAgcHVzaGludCA2NCAvLyA2NAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICBwdXNoaW50IDcyIC8vIDcyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEwCiAgICBwdXNoaW50IDgwIC8vIDgwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDExCiAgICBwdXNoaW50IDg4IC8vIDg4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEyCiAgICBwdXNoaW50IDk2IC8vIDk2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEzCiAgICBwdXNoaW50IDEwNCAvLyAxMDQKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDE0CiAgICBwdXNoaW50IDExMiAvLyAxMTIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzkKICAgIC8vIHJldHVybiBhcHBMaXN0CiAgICByZXRzdWIKCgovLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6OlN0YXRlRGVjb2RpbmcuY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGJ5dGVzLCBiOiBieXRlcykgLT4gYnl0ZXM6CmNoZWNrT2JqZWN0QXNzaWdubWVudDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MgogICAgLy8gY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGFyYzQuVWludE42NCwgYjogYXJjNC5VaW50TjY0KTogUmFuZG9TdHJ1Y3QgewogICAgcHJvdG8gMiAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODMKICAgIC8vIHRoaXMuY2hlY2sudmFsdWUgPSBuZXcgUmFuZG9TdHJ1Y3QoeyBhLCBiIH0pCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzcKICAgIC8vIGNoZWNrID0gR2xvYmFsU3RhdGU8UmFuZG9TdHJ1Y3Q+KHsga2V5OiAnY2hlY2snIH0pCiAgICBieXRlYyA0IC8vICJjaGVjayIKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MwogICAgLy8gdGhpcy5jaGVjay52YWx1ZSA9IG5ldyBSYW5kb1N0cnVjdCh7IGEsIGIgfSkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzcKICAgIC8vIGNoZWNrID0gR2xvYmFsU3RhdGU8UmFuZG9TdHJ1Y3Q+KHsga2V5OiAnY2hlY2snIH0pCiAgICBpbnRjXzEgLy8gMAogICAgYnl0ZWMgNCAvLyAiY2hlY2siCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjg0CiAgICAvLyByZXR1cm4gdGhpcy5jaGVjay52YWx1ZQogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLnBlcmNlbnRpbGVDaGVjaygpIC0+IGJ5dGVzOgpwZXJjZW50aWxlQ2hlY2s6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE1CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgaW50YyA1IC8vIDEwMDAwMAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE1CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNgogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDApKSwgLy8gMTAlIHNob3VsZCBiZSAxMF8wMDAKICAgIGludGMgNSAvLyAxMDAwMDAKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE2CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMCkpLCAvLyAxMCUgc2hvdWxkIGJlIDEwXzAwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNwogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDAwKSksIC8vIDEwMCUgc2hvdWxkIGJlIDEwMF8wMDAKICAgIGludGMgNSAvLyAxMDAwMDAKICAgIGludGNfMyAvLyAxMDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExMAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBiKSwgRElWSVNPUikKICAgIG11bHcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoyOAogICAgLy8gZXhwb3J0IGNvbnN0IERJVklTT1I6IHVpbnQ2NCA9IDEwXzAwMAogICAgaW50Y18zIC8vIDEwMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgZGl2dwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNwogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDAwKSksIC8vIDEwMCUgc2hvdWxkIGJlIDEwMF8wMDAKICAgIGl0b2IKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTgKICAgIC8vIG5ldyBVaW50TjY0KHRoaXMucGVyY2VudGFnZSg1MDBfMDAwLCAxMCkpLCAvLyAuMSUgc2hvdWxkIGJlIDUwMAogICAgcHVzaGludHMgNTAwMDAwIDEwIC8vIDUwMDAwMCwgMTAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBtdWx3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MjgKICAgIC8vIGV4cG9ydCBjb25zdCBESVZJU09SOiB1aW50NjQgPSAxMF8wMDAKICAgIGludGNfMyAvLyAxMDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExMAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBiKSwgRElWSVNPUikKICAgIGRpdncKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTgKICAgIC8vIG5ldyBVaW50TjY0KHRoaXMucGVyY2VudGFnZSg1MDBfMDAwLCAxMCkpLCAvLyAuMSUgc2hvdWxkIGJlIDUwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExOQogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDMyMl8zMjBfOTIyXzMyMiwgNTQ0KSkgLy8gNS40NCUgc2hvdWxkIGJlIDE3XzUzNF8yNThfMTc0CiAgICBwdXNoaW50cyAzMjIzMjA5MjIzMjIgNTQ0IC8vIDMyMjMyMDkyMjMyMiwgNTQ0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE5CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMzIyXzMyMF85MjJfMzIyLCA1NDQpKSAvLyA1LjQ0JSBzaG91bGQgYmUgMTdfNTM0XzI1OF8xNzQKICAgIGl0b2IKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTQtMTIwCiAgICAvLyByZXR1cm4gbmV3IFN0YXRpY0FycmF5PFVpbnRONjQsIDU+KAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMCkpLCAvLyAxMCUgc2hvdWxkIGJlIDEwXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMDApKSwgLy8gMTAwJSBzaG91bGQgYmUgMTAwXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoNTAwXzAwMCwgMTApKSwgLy8gLjElIHNob3VsZCBiZSA1MDAKICAgIC8vICAgbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDMyMl8zMjBfOTIyXzMyMiwgNTQ0KSkgLy8gNS40NCUgc2hvdWxkIGJlIDE3XzUzNF8yNThfMTc0CiAgICAvLyApCiAgICB1bmNvdmVyIDQKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmJpZ0xvb3AoKSAtPiB1aW50NjQ6CmJpZ0xvb3A6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTIzCiAgICAvLyBiaWdMb29wKCk6IHVpbnQ2NCB7CiAgICBwcm90byAwIDEKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMjQKICAgIC8vIGVuc3VyZUJ1ZGdldCg4MF8wMDApCiAgICBwdXNoaW50IDgwMDAwIC8vIDgwMDAwCiAgICBpbnRjXzEgLy8gMAogICAgY2FsbHN1YiBlbnN1cmVfYnVkZ2V0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTI2CiAgICAvLyBsZXQgc3VtOiB1aW50NjQgPSAwCiAgICBpbnRjXzEgLy8gMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEyNwogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IDQwOTY7IGkrKykgewogICAgZHVwCgpiaWdMb29wX3doaWxlX3RvcEAxOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEyNwogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IDQwOTY7IGkrKykgewogICAgZnJhbWVfZGlnIDEKICAgIGludGMgNCAvLyA0MDk2CiAgICA8CiAgICBieiBiaWdMb29wX2FmdGVyX3doaWxlQDMKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgYiBiaWdMb29wX3doaWxlX3RvcEAxCgpiaWdMb29wX2FmdGVyX3doaWxlQDM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTMwCiAgICAvLyByZXR1cm4gc3VtCiAgICByZXRzdWIKCgovLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6OlN0YXRlRGVjb2RpbmcuYmlnQ0xvb3AoKSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpiaWdDTG9vcDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzMKICAgIC8vIGJpZ0NMb29wKCk6IFt1aW50NjQsIHVpbnQ2NCwgdWludDY0XSB7CiAgICBwcm90byAwIDMKICAgIHB1c2hieXRlcyAiIgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM5CiAgICAvLyBib3ggPSBCb3hNYXA8VWludE44LCBTdGF0aWNBcnJheTxVaW50TjY0LCA0MDk2Pj4oeyBrZXlQcmVmaXg6ICdjJyB9KQogICAgYnl0ZWNfMSAvLyAweDYzMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzgKICAgIC8vIGNvbnN0IGJveCA9IHRoaXMuYm94KHVpbnQ4KDApKS52YWx1ZS5jb3B5KCkKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQwCiAgICAvLyBsZXQgY3VyU3RhcnRSYW5nZTogdWludDY0ID0gMAogICAgaW50Y18xIC8vIDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDIKICAgIC8vIGVuc3VyZUJ1ZGdldCgxNzBfMDAwKQogICAgcHVzaGludCAxNzAwMDAgLy8gMTcwMDAwCiAgICBpbnRjXzEgLy8gMAogICAgY2FsbHN1YiBlbnN1cmVfYnVkZ2V0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ0CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaXRlcmF0aW9uQW1vdW50OyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAwCgpiaWdDTG9vcF93aGlsZV90b3BAMToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpdGVyYXRpb25BbW91bnQ7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIDMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzQKICAgIC8vIGNvbnN0IGl0ZXJhdGlvbkFtb3VudDogdWludDY0ID0gNDA5NgogICAgaW50YyA0IC8vIDQwOTYKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpdGVyYXRpb25BbW91bnQ7IGkgKz0gMSkgewogICAgPAogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfd2hpbGVANgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NQogICAgLy8gY3VyRW5kUmFuZ2UgPSBjdXJTdGFydFJhbmdlICsgYm94W2ldLm5hdGl2ZQogICAgZnJhbWVfZGlnIDMKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBmcmFtZV9kaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgKwogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTM1CiAgICAvLyBjb25zdCBybmRTdGFrZVdpbkVsZW1lbnRFbGVtZW50OiB1aW50NjQgPSAxCiAgICBpbnRjXzAgLy8gMQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NgogICAgLy8gaWYgKHJuZFN0YWtlV2luRWxlbWVudEVsZW1lbnQgPj0gY3VyU3RhcnRSYW5nZSAmJiBybmRTdGFrZVdpbkVsZW1lbnRFbGVtZW50IDw9IGN1ckVuZFJhbmdlKSB7CiAgICA8PQogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfaWZfZWxzZUA1CiAgICBmcmFtZV9kaWcgMAogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ3CiAgICAvLyByZXR1cm4gW3N0YXJ0aW5nSW5kZXggKyBpICsgMSwgY3VyU3RhcnRSYW5nZSwgY3VyRW5kUmFuZ2VdCiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgMAogICAgZnJhbWVfYnVyeSAyCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpiaWdDTG9vcF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ5CiAgICAvLyBjdXJTdGFydFJhbmdlID0gY3VyRW5kUmFuZ2UgKyAxCiAgICBmcmFtZV9kaWcgMAogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgMgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGl0ZXJhdGlvbkFtb3VudDsgaSArPSAxKSB7CiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgMwogICAgYiBiaWdDTG9vcF93aGlsZV90b3BAMQoKYmlnQ0xvb3BfYWZ0ZXJfd2hpbGVANjoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNTEKICAgIC8vIHJldHVybiBbMCwgMCwgMF0KICAgIGludGNfMSAvLyAwCiAgICBkdXBuIDIKICAgIGZyYW1lX2J1cnkgMgogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5keW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBieXRlcywgYzogYnl0ZXMpIC0+IGJ5dGVzOgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICBwcm90byAzIDEKICAgIGludGNfMSAvLyAwCiAgICBkdXBuIDMKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAyCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU4CiAgICAvLyBsZXQgZCA9IG5ldyBEeW5hbWljQXJyYXk8VWludE42ND4oKQogICAgcHVzaGJ5dGVzIDB4MDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE1OQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGIubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAwCgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfd2hpbGVfdG9wQDE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU5CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYi5sZW5ndGg7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIC0yCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgOAogICAgPgogICAgYnogZHluYW1pY0FycmF5T2ZEeW5hbWljQXJyYXlzX2FmdGVyX3doaWxlQDgKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjAKICAgIC8vIGNvbnN0IHN0cnVjdCA9IGJbaV0uY29weSgpCiAgICBmcmFtZV9kaWcgLTIKICAgIGV4dHJhY3QgMiAwCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDIgLy8gMgogICAgKgogICAgZGlnIDEKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAxCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOAogICAgZnJhbWVfZGlnIDUKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBJbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDIgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTYxCiAgICAvLyBmb3IgKGxldCBqOiB1aW50NjQgPSAwOyBqIDwgc3RydWN0LmMubGVuZ3RoOyBqICs9IDEpIHsKICAgIGludGNfMSAvLyAwCiAgICBmcmFtZV9idXJ5IDQKCmR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c193aGlsZV90b3BAMzoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjEKICAgIC8vIGZvciAobGV0IGo6IHVpbnQ2NCA9IDA7IGogPCBzdHJ1Y3QuYy5sZW5ndGg7IGogKz0gMSkgewogICAgZnJhbWVfZGlnIDEKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSA2CiAgICBmcmFtZV9kaWcgNAogICAgPgogICAgYnogZHluYW1pY0FycmF5T2ZEeW5hbWljQXJyYXlzX3doaWxlX3RvcEAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTYyCiAgICAvLyBjb25zdCB2YWx1ZSA9IHN0cnVjdC5jW2pdCiAgICBmcmFtZV9kaWcgMgogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IEluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjMKICAgIC8vIGlmIChqID09PSBzdHJ1Y3QuYy5sZW5ndGggLSAxKSB7CiAgICBmcmFtZV9kaWcgNgogICAgaW50Y18wIC8vIDEKICAgIC0KICAgID09CiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfYnVyeSAwCiAgICBieiBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTY0CiAgICAvLyBkLnB1c2godmFsdWUpCiAgICBmcmFtZV9kaWcgNwogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyAzCiAgICBjb25jYXQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfYnVyeSAwCgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgZnJhbWVfZGlnIDAKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE2MQogICAgLy8gZm9yIChsZXQgajogdWludDY0ID0gMDsgaiA8IHN0cnVjdC5jLmxlbmd0aDsgaiArPSAxKSB7CiAgICBmcmFtZV9kaWcgNAogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgNAogICAgYiBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfd2hpbGVfdG9wQDMKCmR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c19hZnRlcl93aGlsZUA4OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE2OQogICAgLy8gcmV0dXJuIGQKICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5zdWJUZXN0KCkgLT4gYnl0ZXM6CnN1YlRlc3Q6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTczCiAgICAvLyByZXR1cm4gdGhpcy5wZXJjZW50aWxlQ2hlY2soKQogICAgY2FsbHN1YiBwZXJjZW50aWxlQ2hlY2sKICAgIHJldHN1Ygo=\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CiAGAQAIkE6AIKCNBiYFBBUffHUCYwADBoEBCGFwcF9saXN0BWNoZWNrMRtBA0eCFASD8UdIBGH0DJME6eEDRATbrmU6BCdM+HUEmb4TEgQul0LdBBDgdu8EjPBJJgTwVKIRBN87qRAESKdj/gTQJvmgBBtsnkMEc4ir4QQiFl/wBLTvjvEE2HBjZgQyU/ksBGOo0JE2GgCOFAKoAowCgwJlAfcB4AFyAVwBPADwAL4ArgCdAH8AdgBcAEwAOwAyAAIjQzEZFEQxGESAJBUffHUAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAALAiQzEZFEQxGEQiQzEZFEQxGESABRUffHWwsCJDMRkURDEYRIgFJChMULAiQzEZFEQxGEQ2GgEXNhoCNhoDiARiKExQsCJDMRkURDEYRCJDMRkURDEYRIgD7U8CFk8CFk8CFk4CUExQKExQsCJDMRkURDEYRIgDrRYoTFCwIkMxGRREMRhEiANeKExQsCJDMRkURDEYRIAmFR98dQACAAAAAAAAAAEAAAAAAAAAAgAAAAAAAAADAAAAAAAAAASwIkMxGRREMRhEgEAVH3x1AAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAqAAIAAAAAAAAAAQAAAAAAAAACsCJDMRkURDEYRIAUFR98dQAAAAAAAAABAAAAAAAAAAKwIkMxGRREMRhENhoBNhoCiAKYKExQsCJDMRkURDEYRDYaARfAMogCKE8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OTw5QTw1QTwxQTwtQTwpQTwlQTwhQTwdQTwZQTwVQTwRQTwNQTwJQTFAoTFCwIkMxGRREMRhENhoBF8AyiAGtFihMULAiQzEZFEQxGEQ2GgEXwDKIAUVPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDk8OUE8NUE8MUE8LUE8KUE8JUE8IUE8HUE8GUE8FUE8EUE8DUE8CUExQKExQsCJDMRkURDEYRDYaARfAMogAzkkVFlcGAkxQKExQsCJDMRkURDEYRCJDMRkURDEYRDYaAReIAHxJFRZXBgJMUChMULAiQzEZFEQxGESIAEYiQzEZQP1HMRgURCJDigIAi/6BCgiLADIMDUEAKLGBBrIQgQWyGSqyHiqyH4v/jQIACwAEs0L/3TIAsgFC//UjsgFC/++JIQSvKUy/ggICYQAQAAAAAAAAAAAAAAAAAAAAAL+JigEBKb5ESRWL/0sBD4v/SwJPAk2L/yEECElLAw9MTwNPAk1JSwIMSwJMTVKJigEBi/8rZUiJigEPi/8rZUhJI1tLASRbSwKBEFtLA4EYW0sEgSBbSwWBKFtLBoEwW0sHgThbSwiBQFtLCYFIW0sKgVBbSwuBWFtLDIFgW0sNgWhbTw6BcFuJigEBi/+AA2FwcGVIiYoBD4v/gAhza2lwX2xpc2VISSNbSwEkW0sCgRBbSwOBGFtLBIEgW0sFgShbSwaBMFtLB4E4W0sIgUBbSwmBSFtLCoFQW0sLgVhbSwyBYFtLDYFoW08OgXBbiYoCAYv+i/9QJwRMZyMnBGVEiSEFgWQdJZcWIQWB6AcdJZcWIQUlHSWXFoMCoMIeCh0llxaDAtLty96wCaAEHSWXFk8ETwRQTwNQTwJQTFCJigABgYDxBCOI/mAjSYsBIQQMQQAMiwFJIgiMAYwAQv/siYoAA4AAKb5EI4GQsAojiP44I4sDIQQMQQA4iwMkC4sBTFuLAklPAgiMACIOQQAUiwBBAA+LAyIIiwKLAIwCjAGMAImLACIIjAKLAyIIjANC/8AjRwKMAowBjACJigMBI0cDgABHAoACAAAji/4jWUmMBYsIDUEAh4v+VwIAiwhJTgKBAgtLAUxZTwIiCEmMCIsFSwEJSwMVTwKBAgtLBExZTwJNUowBI4wEiwFJgShZSwEVUkmMAiNZSYwGiwQNQf+piwJXAgCLBElOAiQLJFiMA4sGIgkSiweMAEEAFIsHVwIAiwNQSRUkChZXBgJMUIwAiwCMB4sEIgiMBEL/q4sHjACJiP6ZiQ==\",\"clear\":\"CoEBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[989,1343],\"errorMessage\":\"Box must have value\"},{\"pc\":[1484,1543],\"errorMessage\":\"Index access is out of bounds\"},{\"pc\":[199,247,256,273,289,315,324,354,371,387,437,513,545,567,677,700,810,840,849,877],\"errorMessage\":\"OnCompletion is not NoOp\"},{\"pc\":[894],\"errorMessage\":\"can only call when creating\"},{\"pc\":[202,250,259,276,292,318,327,357,374,390,440,516,548,570,680,703,813,843,852,880],\"errorMessage\":\"can only call when not creating\"},{\"pc\":[1238],\"errorMessage\":\"check GlobalState exists\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":4,\"minor\":5,\"patch\":3}}}"
+var AppSpecJSON = "{\"name\":\"StateDecoding\",\"structs\":{\"AppList\":[{\"name\":\"one\",\"type\":\"uint64\"},{\"name\":\"two\",\"type\":\"uint64\"},{\"name\":\"three\",\"type\":\"uint64\"},{\"name\":\"four\",\"type\":\"uint64\"},{\"name\":\"five\",\"type\":\"uint64\"},{\"name\":\"six\",\"type\":\"uint64\"},{\"name\":\"seven\",\"type\":\"uint64\"},{\"name\":\"eight\",\"type\":\"uint64\"},{\"name\":\"nine\",\"type\":\"uint64\"},{\"name\":\"ten\",\"type\":\"uint64\"},{\"name\":\"eleven\",\"type\":\"uint64\"},{\"name\":\"twelve\",\"type\":\"uint64\"},{\"name\":\"thirteen\",\"type\":\"uint64\"},{\"name\":\"fourteen\",\"type\":\"uint64\"},{\"name\":\"fifteen\",\"type\":\"uint64\"}],\"RandoComplexObject\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"address\"},{\"name\":\"c\",\"type\":\"uint64[]\"}],\"RandoObject\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"uint64\"}],\"RandoStruct\":[{\"name\":\"a\",\"type\":\"uint64\"},{\"name\":\"b\",\"type\":\"uint64\"}],\"shadowTestResult\":[{\"name\":\"a\",\"type\":\"bool\"},{\"name\":\"b\",\"type\":\"bool\"},{\"name\":\"c\",\"type\":\"bool\"},{\"name\":\"valid\",\"type\":\"bool\"}]},\"methods\":[{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"getBox\",\"args\":[{\"type\":\"uint64\",\"name\":\"offset\"}],\"returns\":{\"type\":\"byte[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"doNothing\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"rawState\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"byte[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"decodeAppList\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AppList\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"decodeUint64\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"decodeStaticArray\",\"args\":[{\"type\":\"application\",\"name\":\"app\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AppList\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"checkObjectAssignment\",\"args\":[{\"type\":\"uint64\",\"name\":\"a\"},{\"type\":\"uint64\",\"name\":\"b\"}],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"RandoStruct\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"retObject\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"RandoObject\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"retDecode\",\"args\":[],\"returns\":{\"type\":\"(uint64,address,uint64[])\",\"struct\":\"RandoComplexObject\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"retList\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64)[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"percentileCheck\",\"args\":[],\"returns\":{\"type\":\"uint64[5]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"bigLoop\",\"args\":[],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"bigCLoop\",\"args\":[],\"returns\":{\"type\":\"(uint64,uint64,uint64)\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"nullun\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"dynamicArrayOfDynamicArrays\",\"args\":[{\"type\":\"uint64\",\"name\":\"a\"},{\"type\":\"(uint64,address,uint64[])[]\",\"name\":\"b\"},{\"type\":\"address\",\"name\":\"c\"}],\"returns\":{\"type\":\"uint64[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"subTest\",\"args\":[],\"returns\":{\"type\":\"uint64[5]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"shadowTest\",\"args\":[],\"returns\":{\"type\":\"(bool,bool,bool,bool)\",\"struct\":\"shadowTestResult\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"boxSetTest\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"paddedBytes\",\"args\":[],\"returns\":{\"type\":\"byte[32]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"check\":{\"keyType\":\"AVMString\",\"valueType\":\"RandoStruct\",\"key\":\"Y2hlY2s=\"}},\"local\":{},\"box\":{\"box\":{\"keyType\":\"AVMString\",\"valueType\":\"uint64[4096]\",\"key\":\"Yw==\"},\"boxarc4\":{\"keyType\":\"AVMString\",\"valueType\":\"RandoStruct\",\"key\":\"YQ==\"}}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMSAwIDggMTAwMDAgNDA5NiAxMDAwMDAKICAgIGJ5dGVjYmxvY2sgMHgxNTFmN2M3NSAweDYzMDAgMHgwNjgxMDEgImFwcF9saXN0IiAiY2hlY2siCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzUKICAgIC8vIGV4cG9ydCBjbGFzcyBTdGF0ZURlY29kaW5nIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE51bUFwcEFyZ3MKICAgIGJ6IG1haW5fYmFyZV9yb3V0aW5nQDI3CiAgICBwdXNoYnl0ZXNzIDB4ODNmMTQ3NDggMHg2MWY0MGM5MyAweGU5ZTEwMzQ0IDB4ZGJhZTY1M2EgMHgyNzRjZjg3NSAweDk5YmUxMzEyIDB4MmU5NzQyZGQgMHgxMGUwNzZlZiAweDhjZjA0OTI2IDB4ZjA1NGEyMTEgMHhkZjNiYTkxMCAweDQ4YTc2M2ZlIDB4ZDAyNmY5YTAgMHgxYjZjOWU0MyAweDczODhhYmUxIDB4MjIxNjVmZjAgMHhiNGVmOGVmMSAweGQ4NzA2MzY2IDB4MzI1M2Y5MmMgMHg2M2E4ZDA5MSAvLyBtZXRob2QgImluaXQoKXZvaWQiLCBtZXRob2QgImdldEJveCh1aW50NjQpYnl0ZVtdIiwgbWV0aG9kICJkb05vdGhpbmcoKXZvaWQiLCBtZXRob2QgInJhd1N0YXRlKGFwcGxpY2F0aW9uKWJ5dGVbXSIsIG1ldGhvZCAiZGVjb2RlQXBwTGlzdChhcHBsaWNhdGlvbikodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJkZWNvZGVVaW50NjQoYXBwbGljYXRpb24pdWludDY0IiwgbWV0aG9kICJkZWNvZGVTdGF0aWNBcnJheShhcHBsaWNhdGlvbikodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJjaGVja09iamVjdEFzc2lnbm1lbnQodWludDY0LHVpbnQ2NCkodWludDY0LHVpbnQ2NCkiLCBtZXRob2QgInJldE9iamVjdCgpKHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJyZXREZWNvZGUoKSh1aW50NjQsYWRkcmVzcyx1aW50NjRbXSkiLCBtZXRob2QgInJldExpc3QoKSh1aW50NjQsdWludDY0KVtdIiwgbWV0aG9kICJwZXJjZW50aWxlQ2hlY2soKXVpbnQ2NFs1XSIsIG1ldGhvZCAiYmlnTG9vcCgpdWludDY0IiwgbWV0aG9kICJiaWdDTG9vcCgpKHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAibnVsbHVuKCl2b2lkIiwgbWV0aG9kICJkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXModWludDY0LCh1aW50NjQsYWRkcmVzcyx1aW50NjRbXSlbXSxhZGRyZXNzKXVpbnQ2NFtdIiwgbWV0aG9kICJzdWJUZXN0KCl1aW50NjRbNV0iLCBtZXRob2QgInNoYWRvd1Rlc3QoKShib29sLGJvb2wsYm9vbCxib29sKSIsIG1ldGhvZCAiYm94U2V0VGVzdCgpdm9pZCIsIG1ldGhvZCAicGFkZGVkQnl0ZXMoKWJ5dGVbMzJdIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggbWFpbl9pbml0X3JvdXRlQDUgbWFpbl9nZXRCb3hfcm91dGVANiBtYWluX2RvTm90aGluZ19yb3V0ZUA3IG1haW5fcmF3U3RhdGVfcm91dGVAOCBtYWluX2RlY29kZUFwcExpc3Rfcm91dGVAOSBtYWluX2RlY29kZVVpbnQ2NF9yb3V0ZUAxMCBtYWluX2RlY29kZVN0YXRpY0FycmF5X3JvdXRlQDExIG1haW5fY2hlY2tPYmplY3RBc3NpZ25tZW50X3JvdXRlQDEyIG1haW5fcmV0T2JqZWN0X3JvdXRlQDEzIG1haW5fcmV0RGVjb2RlX3JvdXRlQDE0IG1haW5fcmV0TGlzdF9yb3V0ZUAxNSBtYWluX3BlcmNlbnRpbGVDaGVja19yb3V0ZUAxNiBtYWluX2JpZ0xvb3Bfcm91dGVAMTcgbWFpbl9iaWdDTG9vcF9yb3V0ZUAxOCBtYWluX251bGx1bl9yb3V0ZUAxOSBtYWluX2R5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c19yb3V0ZUAyMCBtYWluX3N1YlRlc3Rfcm91dGVAMjEgbWFpbl9zaGFkb3dUZXN0X3JvdXRlQDIyIG1haW5fYm94U2V0VGVzdF9yb3V0ZUAyMyBtYWluX3BhZGRlZEJ5dGVzX3JvdXRlQDI0CgptYWluX2FmdGVyX2lmX2Vsc2VAMzE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzUKICAgIC8vIGV4cG9ydCBjbGFzcyBTdGF0ZURlY29kaW5nIGV4dGVuZHMgQ29udHJhY3QgewogICAgaW50Y18xIC8vIDAKICAgIHJldHVybgoKbWFpbl9wYWRkZWRCeXRlc19yb3V0ZUAyNDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoyMTQKICAgIC8vIHBhZGRlZEJ5dGVzKCk6IFN0YXRpY0J5dGVzPDMyPiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1MDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JveFNldFRlc3Rfcm91dGVAMjM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTk0CiAgICAvLyBib3hTZXRUZXN0KCk6IHZvaWQgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBpcyBub3QgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3NoYWRvd1Rlc3Rfcm91dGVAMjI6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTgxCiAgICAvLyBzaGFkb3dUZXN0KCk6IHsgYTogYm9vbGVhbiwgYjogYm9vbGVhbiwgYzogYm9vbGVhbiwgdmFsaWQ6IGJvb2xlYW4gfSB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1YjAKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9zdWJUZXN0X3JvdXRlQDIxOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE3MgogICAgLy8gc3ViVGVzdCgpOiBTdGF0aWNBcnJheTxVaW50TjY0LCA1PiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgc3ViVGVzdAogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9keW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfcm91dGVAMjA6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICBjYWxsc3ViIGR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5cwogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9udWxsdW5fcm91dGVAMTk6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU0CiAgICAvLyBudWxsdW4oKTogdm9pZCB7IH0KICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9iaWdDTG9vcF9yb3V0ZUAxODoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzMKICAgIC8vIGJpZ0NMb29wKCk6IFt1aW50NjQsIHVpbnQ2NCwgdWludDY0XSB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgYmlnQ0xvb3AKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JpZ0xvb3Bfcm91dGVAMTc6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTIzCiAgICAvLyBiaWdMb29wKCk6IHVpbnQ2NCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGNhbGxzdWIgYmlnTG9vcAogICAgaXRvYgogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9wZXJjZW50aWxlQ2hlY2tfcm91dGVAMTY6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEzCiAgICAvLyBwZXJjZW50aWxlQ2hlY2soKTogU3RhdGljQXJyYXk8VWludE42NCwgNT4gewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBpcyBub3QgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBjYWxsc3ViIHBlcmNlbnRpbGVDaGVjawogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9yZXRMaXN0X3JvdXRlQDE1OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEwMgogICAgLy8gcmV0TGlzdCgpOiBEeW5hbWljQXJyYXk8UmFuZG9TdHJ1Y3Q+IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgcHVzaGJ5dGVzIDB4MTUxZjdjNzUwMDAyMDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDIwMDAwMDAwMDAwMDAwMDAzMDAwMDAwMDAwMDAwMDAwNAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3JldERlY29kZV9yb3V0ZUAxNDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo5MQogICAgLy8gcmV0RGVjb2RlKCk6IFJhbmRvQ29tcGxleE9iamVjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyBiYXNlMzIoQ1VQWFk1SUFBQUFBQUFBQUFBQVFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBRklBQUVBQUFBQUFBQUFBQUFFQUFBQUFBQUFBQUFBUSkKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9yZXRPYmplY3Rfcm91dGVAMTM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODcKICAgIC8vIHJldE9iamVjdCgpOiBSYW5kb09iamVjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIHB1c2hieXRlcyAweDE1MWY3Yzc1MDAwMDAwMDAwMDAwMDAwMTAwMDAwMDAwMDAwMDAwMDIKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKbWFpbl9jaGVja09iamVjdEFzc2lnbm1lbnRfcm91dGVAMTI6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODIKICAgIC8vIGNoZWNrT2JqZWN0QXNzaWdubWVudChhOiBhcmM0LlVpbnRONjQsIGI6IGFyYzQuVWludE42NCk6IFJhbmRvU3RydWN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MgogICAgLy8gY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGFyYzQuVWludE42NCwgYjogYXJjNC5VaW50TjY0KTogUmFuZG9TdHJ1Y3QgewogICAgY2FsbHN1YiBjaGVja09iamVjdEFzc2lnbm1lbnQKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlU3RhdGljQXJyYXlfcm91dGVAMTE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzYKICAgIC8vIGRlY29kZVN0YXRpY0FycmF5KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIHR4bmFzIEFwcGxpY2F0aW9ucwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjc2CiAgICAvLyBkZWNvZGVTdGF0aWNBcnJheShhcHA6IEFwcGxpY2F0aW9uKTogQXBwTGlzdCB7CiAgICBjYWxsc3ViIGRlY29kZVN0YXRpY0FycmF5CiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICB1bmNvdmVyIDE0CiAgICB1bmNvdmVyIDE0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDExCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA5CiAgICBjb25jYXQKICAgIHVuY292ZXIgOAogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBjb25jYXQKICAgIHVuY292ZXIgNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlVWludDY0X3JvdXRlQDEwOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjcxCiAgICAvLyBkZWNvZGVVaW50NjQoYXBwOiBBcHBsaWNhdGlvbik6IHVpbnQ2NCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hcyBBcHBsaWNhdGlvbnMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3MQogICAgLy8gZGVjb2RlVWludDY0KGFwcDogQXBwbGljYXRpb24pOiB1aW50NjQgewogICAgY2FsbHN1YiBkZWNvZGVVaW50NjQKICAgIGl0b2IKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZGVjb2RlQXBwTGlzdF9yb3V0ZUA5OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIHR4bmFzIEFwcGxpY2F0aW9ucwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIGNhbGxzdWIgZGVjb2RlQXBwTGlzdAogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgaXRvYgogICAgdW5jb3ZlciAxNAogICAgdW5jb3ZlciAxNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDEzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTIKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDEwCiAgICBjb25jYXQKICAgIHVuY292ZXIgOQogICAgY29uY2F0CiAgICB1bmNvdmVyIDgKICAgIGNvbmNhdAogICAgdW5jb3ZlciA3CiAgICBjb25jYXQKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX3Jhd1N0YXRlX3JvdXRlQDg6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjAKICAgIC8vIHJhd1N0YXRlKGFwcDogQXBwbGljYXRpb24pOiBieXRlcyB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBidG9pCiAgICB0eG5hcyBBcHBsaWNhdGlvbnMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2MAogICAgLy8gcmF3U3RhdGUoYXBwOiBBcHBsaWNhdGlvbik6IGJ5dGVzIHsKICAgIGNhbGxzdWIgcmF3U3RhdGUKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2RvTm90aGluZ19yb3V0ZUA3OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjU2CiAgICAvLyBkb05vdGhpbmcoKTogdm9pZCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIGlzIG5vdCBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBub3QgY3JlYXRpbmcKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCm1haW5fZ2V0Qm94X3JvdXRlQDY6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NTIKICAgIC8vIGdldEJveChvZmZzZXQ6IHVpbnQ2NCk6IGJ5dGVzIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM1CiAgICAvLyBleHBvcnQgY2xhc3MgU3RhdGVEZWNvZGluZyBleHRlbmRzIENvbnRyYWN0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGJ0b2kKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo1MgogICAgLy8gZ2V0Qm94KG9mZnNldDogdWludDY0KTogYnl0ZXMgewogICAgY2FsbHN1YiBnZXRCb3gKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2luaXRfcm91dGVANToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo0NwogICAgLy8gaW5pdCgpOiB2b2lkIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gaXMgbm90IE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBhc3NlcnQgLy8gY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgY2FsbHN1YiBpbml0CiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgptYWluX2JhcmVfcm91dGluZ0AyNzoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czozNQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YXRlRGVjb2RpbmcgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDMxCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgIQogICAgYXNzZXJ0IC8vIGNhbiBvbmx5IGNhbGwgd2hlbiBjcmVhdGluZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIF9wdXlhX2xpYi51dGlsLmVuc3VyZV9idWRnZXQocmVxdWlyZWRfYnVkZ2V0OiB1aW50NjQsIGZlZV9zb3VyY2U6IHVpbnQ2NCkgLT4gdm9pZDoKZW5zdXJlX2J1ZGdldDoKICAgIHByb3RvIDIgMAogICAgZnJhbWVfZGlnIC0yCiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCgplbnN1cmVfYnVkZ2V0X3doaWxlX3RvcEAxOgogICAgZnJhbWVfZGlnIDAKICAgIGdsb2JhbCBPcGNvZGVCdWRnZXQKICAgID4KICAgIGJ6IGVuc3VyZV9idWRnZXRfYWZ0ZXJfd2hpbGVANwogICAgaXR4bl9iZWdpbgogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIHB1c2hpbnQgNSAvLyBEZWxldGVBcHBsaWNhdGlvbgogICAgaXR4bl9maWVsZCBPbkNvbXBsZXRpb24KICAgIGJ5dGVjXzIgLy8gMHgwNjgxMDEKICAgIGl0eG5fZmllbGQgQXBwcm92YWxQcm9ncmFtCiAgICBieXRlY18yIC8vIDB4MDY4MTAxCiAgICBpdHhuX2ZpZWxkIENsZWFyU3RhdGVQcm9ncmFtCiAgICBmcmFtZV9kaWcgLTEKICAgIHN3aXRjaCBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzBAMyBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzFANAoKZW5zdXJlX2J1ZGdldF9zd2l0Y2hfY2FzZV9uZXh0QDY6CiAgICBpdHhuX3N1Ym1pdAogICAgYiBlbnN1cmVfYnVkZ2V0X3doaWxlX3RvcEAxCgplbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzFANDoKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIGl0eG5fZmllbGQgRmVlCiAgICBiIGVuc3VyZV9idWRnZXRfc3dpdGNoX2Nhc2VfbmV4dEA2CgplbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlXzBAMzoKICAgIGludGNfMSAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgYiBlbnN1cmVfYnVkZ2V0X3N3aXRjaF9jYXNlX25leHRANgoKZW5zdXJlX2J1ZGdldF9hZnRlcl93aGlsZUA3OgogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmluaXQoKSAtPiB2b2lkOgppbml0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQ4CiAgICAvLyB0aGlzLmJveCh1aW50OCgwKSkudmFsdWUgPSBpbnRlcnByZXRBc0FyYzQ8U3RhdGljQXJyYXk8VWludE42NCwgNDA5Nj4+KG9wLmJ6ZXJvKDQwOTYpKQogICAgaW50YyA0IC8vIDQwOTYKICAgIGJ6ZXJvCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzkKICAgIC8vIGJveCA9IEJveE1hcDxVaW50TjgsIFN0YXRpY0FycmF5PFVpbnRONjQsIDQwOTY+Pih7IGtleVByZWZpeDogJ2MnIH0pCiAgICBieXRlY18xIC8vIDB4NjMwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQ4CiAgICAvLyB0aGlzLmJveCh1aW50OCgwKSkudmFsdWUgPSBpbnRlcnByZXRBc0FyYzQ8U3RhdGljQXJyYXk8VWludE42NCwgNDA5Nj4+KG9wLmJ6ZXJvKDQwOTYpKQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjQxCiAgICAvLyBib3hhcmM0ID0gQm94TWFwPFVpbnROOCwgUmFuZG9TdHJ1Y3Q+KHsga2V5UHJlZml4OiAnYScgfSkKICAgIHB1c2hieXRlc3MgMHg2MTAwIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAgLy8gMHg2MTAwLCAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NDkKICAgIC8vIHRoaXMuYm94YXJjNCh1aW50OCgwKSkudmFsdWUgPSBuZXcgUmFuZG9TdHJ1Y3QoeyBhOiBuZXcgVWludE42NCgwKSwgYjogbmV3IFVpbnRONjQoMCkgfSkKICAgIGJveF9wdXQKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5nZXRCb3gob2Zmc2V0OiB1aW50NjQpIC0+IGJ5dGVzOgpnZXRCb3g6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NTIKICAgIC8vIGdldEJveChvZmZzZXQ6IHVpbnQ2NCk6IGJ5dGVzIHsKICAgIHByb3RvIDEgMQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM5CiAgICAvLyBib3ggPSBCb3hNYXA8VWludE44LCBTdGF0aWNBcnJheTxVaW50TjY0LCA0MDk2Pj4oeyBrZXlQcmVmaXg6ICdjJyB9KQogICAgYnl0ZWNfMSAvLyAweDYzMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo1MwogICAgLy8gcmV0dXJuIHRoaXMuYm94KHVpbnQ4KDApKS52YWx1ZS5ieXRlcy5zbGljZShvZmZzZXQsIG9mZnNldCArIDQwOTYpCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBsZW4KICAgIGZyYW1lX2RpZyAtMQogICAgZGlnIDEKICAgID49CiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjIDQgLy8gNDA5NgogICAgKwogICAgZHVwCiAgICBkaWcgMwogICAgPj0KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGRpZyAyCiAgICBzd2FwCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5yYXdTdGF0ZShhcHA6IHVpbnQ2NCkgLT4gYnl0ZXM6CnJhd1N0YXRlOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjYwCiAgICAvLyByYXdTdGF0ZShhcHA6IEFwcGxpY2F0aW9uKTogYnl0ZXMgewogICAgcHJvdG8gMSAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjEKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYXBwLCBCeXRlcygnYXBwX2xpc3QnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWNfMyAvLyAiYXBwX2xpc3QiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NjIKICAgIC8vIHJldHVybiBhcHBMaXN0Qnl0ZXMKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5kZWNvZGVBcHBMaXN0KGFwcDogdWludDY0KSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpkZWNvZGVBcHBMaXN0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjY1CiAgICAvLyBkZWNvZGVBcHBMaXN0KGFwcDogQXBwbGljYXRpb24pOiBBcHBMaXN0IHsKICAgIHByb3RvIDEgMTUKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2NgogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhhcHAsIEJ5dGVzKCdhcHBfbGlzdCcpKQogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlY18zIC8vICJhcHBfbGlzdCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2NwogICAgLy8gY29uc3QgYXBwTGlzdCA9IGRlY29kZUFyYzQ8QXBwTGlzdD4oYXBwTGlzdEJ5dGVzKQogICAgZHVwCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAyCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNAogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA1CiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDYKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNwogICAgcHVzaGludCA1NiAvLyA1NgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA4CiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDkKICAgIHB1c2hpbnQgNzIgLy8gNzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTAKICAgIHB1c2hpbnQgODAgLy8gODAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTEKICAgIHB1c2hpbnQgODggLy8gODgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTIKICAgIHB1c2hpbnQgOTYgLy8gOTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMTMKICAgIHB1c2hpbnQgMTA0IC8vIDEwNAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMTQKICAgIHB1c2hpbnQgMTEyIC8vIDExMgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo2OAogICAgLy8gcmV0dXJuIGFwcExpc3QKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5kZWNvZGVVaW50NjQoYXBwOiB1aW50NjQpIC0+IHVpbnQ2NDoKZGVjb2RlVWludDY0OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjcxCiAgICAvLyBkZWNvZGVVaW50NjQoYXBwOiBBcHBsaWNhdGlvbik6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3MgogICAgLy8gY29uc3QgW2FwcEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NChhcHAsIEJ5dGVzKCdhcHAnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGJ5dGVzICJhcHAiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzMKICAgIC8vIHJldHVybiBhcHBCeXRlcwogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmRlY29kZVN0YXRpY0FycmF5KGFwcDogdWludDY0KSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0LCB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpkZWNvZGVTdGF0aWNBcnJheToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3NgogICAgLy8gZGVjb2RlU3RhdGljQXJyYXkoYXBwOiBBcHBsaWNhdGlvbik6IEFwcExpc3QgewogICAgcHJvdG8gMSAxNQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjc3CiAgICAvLyBjb25zdCBbYXBwQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYXBwLCBCeXRlcygnc2tpcF9saXMnKSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGJ5dGVzICJza2lwX2xpcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo3OAogICAgLy8gY29uc3QgYXBwTGlzdCA9IGRlY29kZUFyYzQ8QXBwTGlzdD4oYXBwQnl0ZXMsICdub25lJykKICAgIGR1cAogICAgaW50Y18xIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgMgogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDQKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNQogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA2CiAgICBwdXNoaW50IDQ4IC8vIDQ4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDcKICAgIHB1c2hpbnQgNTYgLy8gNTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgOAogICAgcHVzaGludCA2NCAvLyA2NAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICBwdXNoaW50IDcyIC8vIDcyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEwCiAgICBwdXNoaW50IDgwIC8vIDgwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDExCiAgICBwdXNoaW50IDg4IC8vIDg4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEyCiAgICBwdXNoaW50IDk2IC8vIDk2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEzCiAgICBwdXNoaW50IDEwNCAvLyAxMDQKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDE0CiAgICBwdXNoaW50IDExMiAvLyAxMTIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6NzkKICAgIC8vIHJldHVybiBhcHBMaXN0CiAgICByZXRzdWIKCgovLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6OlN0YXRlRGVjb2RpbmcuY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGJ5dGVzLCBiOiBieXRlcykgLT4gYnl0ZXM6CmNoZWNrT2JqZWN0QXNzaWdubWVudDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MgogICAgLy8gY2hlY2tPYmplY3RBc3NpZ25tZW50KGE6IGFyYzQuVWludE42NCwgYjogYXJjNC5VaW50TjY0KTogUmFuZG9TdHJ1Y3QgewogICAgcHJvdG8gMiAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6ODMKICAgIC8vIHRoaXMuY2hlY2sudmFsdWUgPSBuZXcgUmFuZG9TdHJ1Y3QoeyBhLCBiIH0pCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzcKICAgIC8vIGNoZWNrID0gR2xvYmFsU3RhdGU8UmFuZG9TdHJ1Y3Q+KHsga2V5OiAnY2hlY2snIH0pCiAgICBieXRlYyA0IC8vICJjaGVjayIKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo4MwogICAgLy8gdGhpcy5jaGVjay52YWx1ZSA9IG5ldyBSYW5kb1N0cnVjdCh7IGEsIGIgfSkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MzcKICAgIC8vIGNoZWNrID0gR2xvYmFsU3RhdGU8UmFuZG9TdHJ1Y3Q+KHsga2V5OiAnY2hlY2snIH0pCiAgICBpbnRjXzEgLy8gMAogICAgYnl0ZWMgNCAvLyAiY2hlY2siCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjg0CiAgICAvLyByZXR1cm4gdGhpcy5jaGVjay52YWx1ZQogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLnBlcmNlbnRpbGVDaGVjaygpIC0+IGJ5dGVzOgpwZXJjZW50aWxlQ2hlY2s6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE1CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgaW50YyA1IC8vIDEwMDAwMAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE1CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNgogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDApKSwgLy8gMTAlIHNob3VsZCBiZSAxMF8wMDAKICAgIGludGMgNSAvLyAxMDAwMDAKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE2CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMCkpLCAvLyAxMCUgc2hvdWxkIGJlIDEwXzAwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNwogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDAwKSksIC8vIDEwMCUgc2hvdWxkIGJlIDEwMF8wMDAKICAgIGludGMgNSAvLyAxMDAwMDAKICAgIGludGNfMyAvLyAxMDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExMAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBiKSwgRElWSVNPUikKICAgIG11bHcKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoyOAogICAgLy8gZXhwb3J0IGNvbnN0IERJVklTT1I6IHVpbnQ2NCA9IDEwXzAwMAogICAgaW50Y18zIC8vIDEwMDAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgZGl2dwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExNwogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDEwMF8wMDAsIDEwMDAwKSksIC8vIDEwMCUgc2hvdWxkIGJlIDEwMF8wMDAKICAgIGl0b2IKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTgKICAgIC8vIG5ldyBVaW50TjY0KHRoaXMucGVyY2VudGFnZSg1MDBfMDAwLCAxMCkpLCAvLyAuMSUgc2hvdWxkIGJlIDUwMAogICAgcHVzaGludHMgNTAwMDAwIDEwIC8vIDUwMDAwMCwgMTAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBtdWx3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MjgKICAgIC8vIGV4cG9ydCBjb25zdCBESVZJU09SOiB1aW50NjQgPSAxMF8wMDAKICAgIGludGNfMyAvLyAxMDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExMAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBiKSwgRElWSVNPUikKICAgIGRpdncKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTgKICAgIC8vIG5ldyBVaW50TjY0KHRoaXMucGVyY2VudGFnZSg1MDBfMDAwLCAxMCkpLCAvLyAuMSUgc2hvdWxkIGJlIDUwMAogICAgaXRvYgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjExOQogICAgLy8gbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDMyMl8zMjBfOTIyXzMyMiwgNTQ0KSkgLy8gNS40NCUgc2hvdWxkIGJlIDE3XzUzNF8yNThfMTc0CiAgICBwdXNoaW50cyAzMjIzMjA5MjIzMjIgNTQ0IC8vIDMyMjMyMDkyMjMyMiwgNTQ0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIGIpLCBESVZJU09SKQogICAgbXVsdwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjI4CiAgICAvLyBleHBvcnQgY29uc3QgRElWSVNPUjogdWludDY0ID0gMTBfMDAwCiAgICBpbnRjXzMgLy8gMTAwMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgYiksIERJVklTT1IpCiAgICBkaXZ3CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTE5CiAgICAvLyBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMzIyXzMyMF85MjJfMzIyLCA1NDQpKSAvLyA1LjQ0JSBzaG91bGQgYmUgMTdfNTM0XzI1OF8xNzQKICAgIGl0b2IKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMTQtMTIwCiAgICAvLyByZXR1cm4gbmV3IFN0YXRpY0FycmF5PFVpbnRONjQsIDU+KAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwKSksIC8vIDElIHNob3VsZCBiZSAxXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMCkpLCAvLyAxMCUgc2hvdWxkIGJlIDEwXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoMTAwXzAwMCwgMTAwMDApKSwgLy8gMTAwJSBzaG91bGQgYmUgMTAwXzAwMAogICAgLy8gICBuZXcgVWludE42NCh0aGlzLnBlcmNlbnRhZ2UoNTAwXzAwMCwgMTApKSwgLy8gLjElIHNob3VsZCBiZSA1MDAKICAgIC8vICAgbmV3IFVpbnRONjQodGhpcy5wZXJjZW50YWdlKDMyMl8zMjBfOTIyXzMyMiwgNTQ0KSkgLy8gNS40NCUgc2hvdWxkIGJlIDE3XzUzNF8yNThfMTc0CiAgICAvLyApCiAgICB1bmNvdmVyIDQKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgcmV0c3ViCgoKLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjpTdGF0ZURlY29kaW5nLmJpZ0xvb3AoKSAtPiB1aW50NjQ6CmJpZ0xvb3A6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTIzCiAgICAvLyBiaWdMb29wKCk6IHVpbnQ2NCB7CiAgICBwcm90byAwIDEKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMjQKICAgIC8vIGVuc3VyZUJ1ZGdldCg4MF8wMDApCiAgICBwdXNoaW50IDgwMDAwIC8vIDgwMDAwCiAgICBpbnRjXzEgLy8gMAogICAgY2FsbHN1YiBlbnN1cmVfYnVkZ2V0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTI2CiAgICAvLyBsZXQgc3VtOiB1aW50NjQgPSAwCiAgICBpbnRjXzEgLy8gMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEyNwogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IDQwOTY7IGkrKykgewogICAgZHVwCgpiaWdMb29wX3doaWxlX3RvcEAxOgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjEyNwogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IDQwOTY7IGkrKykgewogICAgZnJhbWVfZGlnIDEKICAgIGludGMgNCAvLyA0MDk2CiAgICA8CiAgICBieiBiaWdMb29wX2FmdGVyX3doaWxlQDMKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgYiBiaWdMb29wX3doaWxlX3RvcEAxCgpiaWdMb29wX2FmdGVyX3doaWxlQDM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTMwCiAgICAvLyByZXR1cm4gc3VtCiAgICByZXRzdWIKCgovLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6OlN0YXRlRGVjb2RpbmcuYmlnQ0xvb3AoKSAtPiB1aW50NjQsIHVpbnQ2NCwgdWludDY0OgpiaWdDTG9vcDoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzMKICAgIC8vIGJpZ0NMb29wKCk6IFt1aW50NjQsIHVpbnQ2NCwgdWludDY0XSB7CiAgICBwcm90byAwIDMKICAgIHB1c2hieXRlcyAiIgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjM5CiAgICAvLyBib3ggPSBCb3hNYXA8VWludE44LCBTdGF0aWNBcnJheTxVaW50TjY0LCA0MDk2Pj4oeyBrZXlQcmVmaXg6ICdjJyB9KQogICAgYnl0ZWNfMSAvLyAweDYzMDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzgKICAgIC8vIGNvbnN0IGJveCA9IHRoaXMuYm94KHVpbnQ4KDApKS52YWx1ZS5jb3B5KCkKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQwCiAgICAvLyBsZXQgY3VyU3RhcnRSYW5nZTogdWludDY0ID0gMAogICAgaW50Y18xIC8vIDAKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDIKICAgIC8vIGVuc3VyZUJ1ZGdldCgxNzBfMDAwKQogICAgcHVzaGludCAxNzAwMDAgLy8gMTcwMDAwCiAgICBpbnRjXzEgLy8gMAogICAgY2FsbHN1YiBlbnN1cmVfYnVkZ2V0CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ0CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaXRlcmF0aW9uQW1vdW50OyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAwCgpiaWdDTG9vcF93aGlsZV90b3BAMToKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpdGVyYXRpb25BbW91bnQ7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIDMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxMzQKICAgIC8vIGNvbnN0IGl0ZXJhdGlvbkFtb3VudDogdWludDY0ID0gNDA5NgogICAgaW50YyA0IC8vIDQwOTYKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNDQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpdGVyYXRpb25BbW91bnQ7IGkgKz0gMSkgewogICAgPAogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfd2hpbGVANgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NQogICAgLy8gY3VyRW5kUmFuZ2UgPSBjdXJTdGFydFJhbmdlICsgYm94W2ldLm5hdGl2ZQogICAgZnJhbWVfZGlnIDMKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBmcmFtZV9kaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgKwogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTM1CiAgICAvLyBjb25zdCBybmRTdGFrZVdpbkVsZW1lbnRFbGVtZW50OiB1aW50NjQgPSAxCiAgICBpbnRjXzAgLy8gMQogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NgogICAgLy8gaWYgKHJuZFN0YWtlV2luRWxlbWVudEVsZW1lbnQgPj0gY3VyU3RhcnRSYW5nZSAmJiBybmRTdGFrZVdpbkVsZW1lbnRFbGVtZW50IDw9IGN1ckVuZFJhbmdlKSB7CiAgICA8PQogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfaWZfZWxzZUA1CiAgICBmcmFtZV9kaWcgMAogICAgYnogYmlnQ0xvb3BfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ3CiAgICAvLyByZXR1cm4gW3N0YXJ0aW5nSW5kZXggKyBpICsgMSwgY3VyU3RhcnRSYW5nZSwgY3VyRW5kUmFuZ2VdCiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgMAogICAgZnJhbWVfYnVyeSAyCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpiaWdDTG9vcF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTQ5CiAgICAvLyBjdXJTdGFydFJhbmdlID0gY3VyRW5kUmFuZ2UgKyAxCiAgICBmcmFtZV9kaWcgMAogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgMgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE0NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGl0ZXJhdGlvbkFtb3VudDsgaSArPSAxKSB7CiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgMwogICAgYiBiaWdDTG9vcF93aGlsZV90b3BAMQoKYmlnQ0xvb3BfYWZ0ZXJfd2hpbGVANjoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNTEKICAgIC8vIHJldHVybiBbMCwgMCwgMF0KICAgIGludGNfMSAvLyAwCiAgICBkdXBuIDIKICAgIGZyYW1lX2J1cnkgMgogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5keW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBieXRlcywgYzogYnl0ZXMpIC0+IGJ5dGVzOgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXM6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU2CiAgICAvLyBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXMoYTogdWludDY0LCBiOiBEeW5hbWljQXJyYXk8UmFuZG9Db21wbGV4U3RydWN0PiwgYzogQWRkcmVzcyk6IER5bmFtaWNBcnJheTxVaW50TjY0PiB7CiAgICBwcm90byAzIDEKICAgIGludGNfMSAvLyAwCiAgICBkdXBuIDMKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAyCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU4CiAgICAvLyBsZXQgZCA9IG5ldyBEeW5hbWljQXJyYXk8VWludE42ND4oKQogICAgcHVzaGJ5dGVzIDB4MDAwMAogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE1OQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGIubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAwCgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfd2hpbGVfdG9wQDE6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTU5CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYi5sZW5ndGg7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIC0yCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgOAogICAgPgogICAgYnogZHluYW1pY0FycmF5T2ZEeW5hbWljQXJyYXlzX2FmdGVyX3doaWxlQDgKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjAKICAgIC8vIGNvbnN0IHN0cnVjdCA9IGJbaV0uY29weSgpCiAgICBmcmFtZV9kaWcgLTIKICAgIGV4dHJhY3QgMiAwCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDIgLy8gMgogICAgKgogICAgZGlnIDEKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAxCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOAogICAgZnJhbWVfZGlnIDUKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBJbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDIgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTYxCiAgICAvLyBmb3IgKGxldCBqOiB1aW50NjQgPSAwOyBqIDwgc3RydWN0LmMubGVuZ3RoOyBqICs9IDEpIHsKICAgIGludGNfMSAvLyAwCiAgICBmcmFtZV9idXJ5IDQKCmR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c193aGlsZV90b3BAMzoKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjEKICAgIC8vIGZvciAobGV0IGo6IHVpbnQ2NCA9IDA7IGogPCBzdHJ1Y3QuYy5sZW5ndGg7IGogKz0gMSkgewogICAgZnJhbWVfZGlnIDEKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICBpbnRjXzEgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSA2CiAgICBmcmFtZV9kaWcgNAogICAgPgogICAgYnogZHluYW1pY0FycmF5T2ZEeW5hbWljQXJyYXlzX3doaWxlX3RvcEAxCiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTYyCiAgICAvLyBjb25zdCB2YWx1ZSA9IHN0cnVjdC5jW2pdCiAgICBmcmFtZV9kaWcgMgogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IEluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czoxNjMKICAgIC8vIGlmIChqID09PSBzdHJ1Y3QuYy5sZW5ndGggLSAxKSB7CiAgICBmcmFtZV9kaWcgNgogICAgaW50Y18wIC8vIDEKICAgIC0KICAgID09CiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfYnVyeSAwCiAgICBieiBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTY0CiAgICAvLyBkLnB1c2godmFsdWUpCiAgICBmcmFtZV9kaWcgNwogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyAzCiAgICBjb25jYXQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfYnVyeSAwCgpkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgZnJhbWVfZGlnIDAKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE2MQogICAgLy8gZm9yIChsZXQgajogdWludDY0ID0gMDsgaiA8IHN0cnVjdC5jLmxlbmd0aDsgaiArPSAxKSB7CiAgICBmcmFtZV9kaWcgNAogICAgaW50Y18wIC8vIDEKICAgICsKICAgIGZyYW1lX2J1cnkgNAogICAgYiBkeW5hbWljQXJyYXlPZkR5bmFtaWNBcnJheXNfd2hpbGVfdG9wQDMKCmR5bmFtaWNBcnJheU9mRHluYW1pY0FycmF5c19hZnRlcl93aGlsZUA4OgogICAgLy8gY29udHJhY3RzL2RlY29kZV9zdGF0ZS5hbGdvLnRzOjE2OQogICAgLy8gcmV0dXJuIGQKICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIGNvbnRyYWN0cy9kZWNvZGVfc3RhdGUuYWxnby50czo6U3RhdGVEZWNvZGluZy5zdWJUZXN0KCkgLT4gYnl0ZXM6CnN1YlRlc3Q6CiAgICAvLyBjb250cmFjdHMvZGVjb2RlX3N0YXRlLmFsZ28udHM6MTczCiAgICAvLyByZXR1cm4gdGhpcy5wZXJjZW50aWxlQ2hlY2soKQogICAgY2FsbHN1YiBwZXJjZW50aWxlQ2hlY2sKICAgIHJldHN1Ygo=\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CiAGAQAIkE6AIKCNBiYFBBUffHUCYwADBoEBCGFwcF9saXN0BWNoZWNrMRtBA0eCFASD8UdIBGH0DJME6eEDRATbrmU6BCdM+HUEmb4TEgQul0LdBBDgdu8EjPBJJgTwVKIRBN87qRAESKdj/gTQJvmgBBtsnkMEc4ir4QQiFl/wBLTvjvEE2HBjZgQyU/ksBGOo0JE2GgCOFAKoAowCgwJlAfcB4AFyAVwBPADwAL4ArgCdAH8AdgBcAEwAOwAyAAIjQzEZFEQxGESAJBUffHUAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAALAiQzEZFEQxGEQiQzEZFEQxGESABRUffHWwsCJDMRkURDEYRIgFJChMULAiQzEZFEQxGEQ2GgEXNhoCNhoDiARiKExQsCJDMRkURDEYRCJDMRkURDEYRIgD7U8CFk8CFk8CFk4CUExQKExQsCJDMRkURDEYRIgDrRYoTFCwIkMxGRREMRhEiANeKExQsCJDMRkURDEYRIAmFR98dQACAAAAAAAAAAEAAAAAAAAAAgAAAAAAAAADAAAAAAAAAASwIkMxGRREMRhEgEAVH3x1AAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAqAAIAAAAAAAAAAQAAAAAAAAACsCJDMRkURDEYRIAUFR98dQAAAAAAAAABAAAAAAAAAAKwIkMxGRREMRhENhoBNhoCiAKYKExQsCJDMRkURDEYRDYaARfAMogCKE8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OFk8OTw5QTw1QTwxQTwtQTwpQTwlQTwhQTwdQTwZQTwVQTwRQTwNQTwJQTFAoTFCwIkMxGRREMRhENhoBF8AyiAGtFihMULAiQzEZFEQxGEQ2GgEXwDKIAUVPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDhZPDk8OUE8NUE8MUE8LUE8KUE8JUE8IUE8HUE8GUE8FUE8EUE8DUE8CUExQKExQsCJDMRkURDEYRDYaARfAMogAzkkVFlcGAkxQKExQsCJDMRkURDEYRCJDMRkURDEYRDYaAReIAHxJFRZXBgJMUChMULAiQzEZFEQxGESIAEYiQzEZQP1HMRgURCJDigIAi/6BCgiLADIMDUEAKLGBBrIQgQWyGSqyHiqyH4v/jQIACwAEs0L/3TIAsgFC//UjsgFC/++JIQSvKUy/ggICYQAQAAAAAAAAAAAAAAAAAAAAAL+JigEBKb5ESRWL/0sBD4v/SwJPAk2L/yEECElLAw9MTwNPAk1JSwIMSwJMTVKJigEBi/8rZUiJigEPi/8rZUhJI1tLASRbSwKBEFtLA4EYW0sEgSBbSwWBKFtLBoEwW0sHgThbSwiBQFtLCYFIW0sKgVBbSwuBWFtLDIFgW0sNgWhbTw6BcFuJigEBi/+AA2FwcGVIiYoBD4v/gAhza2lwX2xpc2VISSNbSwEkW0sCgRBbSwOBGFtLBIEgW0sFgShbSwaBMFtLB4E4W0sIgUBbSwmBSFtLCoFQW0sLgVhbSwyBYFtLDYFoW08OgXBbiYoCAYv+i/9QJwRMZyMnBGVEiSEFgWQdJZcWIQWB6AcdJZcWIQUlHSWXFoMCoMIeCh0llxaDAtLty96wCaAEHSWXFk8ETwRQTwNQTwJQTFCJigABgYDxBCOI/mAjSYsBIQQMQQAMiwFJIgiMAYwAQv/siYoAA4AAKb5EI4GQsAojiP44I4sDIQQMQQA4iwMkC4sBTFuLAklPAgiMACIOQQAUiwBBAA+LAyIIiwKLAIwCjAGMAImLACIIjAKLAyIIjANC/8AjRwKMAowBjACJigMBI0cDgABHAoACAAAji/4jWUmMBYsIDUEAh4v+VwIAiwhJTgKBAgtLAUxZTwIiCEmMCIsFSwEJSwMVTwKBAgtLBExZTwJNUowBI4wEiwFJgShZSwEVUkmMAiNZSYwGiwQNQf+piwJXAgCLBElOAiQLJFiMA4sGIgkSiweMAEEAFIsHVwIAiwNQSRUkChZXBgJMUIwAiwCMB4sEIgiMBEL/q4sHjACJiP6ZiQ==\",\"clear\":\"CoEBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":4,\"minor\":5,\"patch\":3}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "3aa85de62d3c40f5fd37f08387f7144ba3f6568d4af492c955237193b05a2e98"
@@ -2,26 +2,324 @@
 package statedecoding
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendInit calls the init ABI method.
+	SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendGetBox calls the getBox ABI method.
+	SendGetBox(ctx context.Context, params algokit.CallParams[GetBoxArgs]) (*GetBoxMethodResult, error)
+	// SendDoNothing calls the doNothing ABI method.
+	SendDoNothing(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendRawState calls the rawState ABI method.
+	SendRawState(ctx context.Context, params algokit.CallParams[RawStateArgs]) (*RawStateMethodResult, error)
+	// SendDecodeAppList calls the decodeAppList ABI method.
+	SendDecodeAppList(ctx context.Context, params algokit.CallParams[DecodeAppListArgs]) (*DecodeAppListMethodResult, error)
+	// SendDecodeUint64 calls the decodeUint64 ABI method.
+	SendDecodeUint64(ctx context.Context, params algokit.CallParams[DecodeUint64Args]) (*DecodeUint64MethodResult, error)
+	// SendDecodeStaticArray calls the decodeStaticArray ABI method.
+	SendDecodeStaticArray(ctx context.Context, params algokit.CallParams[DecodeStaticArrayArgs]) (*DecodeStaticArrayMethodResult, error)
+	// SendCheckObjectAssignment calls the checkObjectAssignment ABI method.
+	SendCheckObjectAssignment(ctx context.Context, params algokit.CallParams[CheckObjectAssignmentArgs]) (*CheckObjectAssignmentMethodResult, error)
+	// SendRetObject calls the retObject ABI method.
+	SendRetObject(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetObjectMethodResult, error)
+	// SendRetDecode calls the retDecode ABI method.
+	SendRetDecode(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetDecodeMethodResult, error)
+	// SendRetList calls the retList ABI method.
+	SendRetList(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetListMethodResult, error)
+	// SendPercentileCheck calls the percentileCheck ABI method.
+	SendPercentileCheck(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*PercentileCheckMethodResult, error)
+	// SendBigLoop calls the bigLoop ABI method.
+	SendBigLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BigLoopMethodResult, error)
+	// SendBigCLoop calls the bigCLoop ABI method.
+	SendBigCLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BigCLoopMethodResult, error)
+	// SendNullun calls the nullun ABI method.
+	SendNullun(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendDynamicArrayOfDynamicArrays calls the dynamicArrayOfDynamicArrays ABI method.
+	SendDynamicArrayOfDynamicArrays(ctx context.Context, params algokit.CallParams[DynamicArrayOfDynamicArraysArgs]) (*DynamicArrayOfDynamicArraysMethodResult, error)
+	// SendSubTest calls the subTest ABI method.
+	SendSubTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*SubTestMethodResult, error)
+	// SendShadowTest calls the shadowTest ABI method.
+	SendShadowTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*ShadowTestMethodResult, error)
+	// SendBoxSetTest calls the boxSetTest ABI method.
+	SendBoxSetTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendPaddedBytes calls the paddedBytes ABI method.
+	SendPaddedBytes(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*PaddedBytesMethodResult, error)
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxBox reads the box box.
+	GetBoxBox(ctx context.Context) ([4096]uint64, error)
+	// GetBoxBoxarc4 reads the boxarc4 box.
+	GetBoxBoxarc4(ctx context.Context) (RandoStruct, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the StateDecoding smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +331,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("StateDecoding has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,60 +380,109 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
-// NewGroup creates a new Composer for building atomic transaction groups.
-func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
 	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
 }
 
-// SendInit calls the init ABI method and waits for confirmation.
-func (c *Client) SendInit(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match StateDecoding")
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "init",
-		MethodArgs: methodArgs,
-	})
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
 	if err != nil {
 		return err
 	}
-
-	_ = result
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
 	return nil
 }
 
-// SendGetBox calls the getBox ABI method and waits for confirmation.
+// NewGroup creates a new Composer for building atomic transaction groups.
+func (c *Client) NewGroup() *Composer {
+	return &Composer{client: c}
+}
+
+// SendInit calls the init ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return err
+	}
+	_, err = c.sendInit(ctx, params)
+	return err
+}
+
+func (c *Client) sendInit(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Init(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
+
+// SendGetBox calls the getBox ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetBox(ctx context.Context, params algokit.CallParams[GetBoxArgs]) (*GetBoxMethodResult, error) {
-	methodArgs := argsToInterfaceGetBox(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetBox(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getBox",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetBox(ctx context.Context, params algokit.CallParams[GetBoxArgs]) (*GetBoxMethodResult, error) {
+	comp, err := c.NewGroup().GetBox(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetBoxMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetBoxResult decodes the return value of a getBox call.
+func (c *Client) decodeGetBoxResult(result *algokit.SendAppTransactionResult) (*GetBoxMethodResult, error) {
 	typedResult := &GetBoxMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -110,44 +496,56 @@ func (c *Client) SendGetBox(ctx context.Context, params algokit.CallParams[GetBo
 	return typedResult, nil
 }
 
-// SendDoNothing calls the doNothing ABI method and waits for confirmation.
-func (c *Client) SendDoNothing(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "doNothing",
-		MethodArgs: methodArgs,
-	})
+// SendDoNothing calls the doNothing ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendDoNothing(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDoNothing(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDoNothing(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DoNothing(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRawState calls the rawState ABI method and waits for confirmation.
+// SendRawState calls the rawState ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRawState(ctx context.Context, params algokit.CallParams[RawStateArgs]) (*RawStateMethodResult, error) {
-	methodArgs := argsToInterfaceRawState(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRawState(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "rawState",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendRawState(ctx context.Context, params algokit.CallParams[RawStateArgs]) (*RawStateMethodResult, error) {
+	comp, err := c.NewGroup().RawState(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*RawStateMethodResult)
+	return typedResult, nil
+}
 
+// decodeRawStateResult decodes the return value of a rawState call.
+func (c *Client) decodeRawStateResult(result *algokit.SendAppTransactionResult) (*RawStateMethodResult, error) {
 	typedResult := &RawStateMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -161,28 +559,31 @@ func (c *Client) SendRawState(ctx context.Context, params algokit.CallParams[Raw
 	return typedResult, nil
 }
 
-// SendDecodeAppList calls the decodeAppList ABI method and waits for confirmation.
+// SendDecodeAppList calls the decodeAppList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDecodeAppList(ctx context.Context, params algokit.CallParams[DecodeAppListArgs]) (*DecodeAppListMethodResult, error) {
-	methodArgs := argsToInterfaceDecodeAppList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendDecodeAppList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "decodeAppList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendDecodeAppList(ctx context.Context, params algokit.CallParams[DecodeAppListArgs]) (*DecodeAppListMethodResult, error) {
+	comp, err := c.NewGroup().DecodeAppList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*DecodeAppListMethodResult)
+	return typedResult, nil
+}
 
+// decodeDecodeAppListResult decodes the return value of a decodeAppList call.
+func (c *Client) decodeDecodeAppListResult(result *algokit.SendAppTransactionResult) (*DecodeAppListMethodResult, error) {
 	typedResult := &DecodeAppListMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -198,28 +599,31 @@ func (c *Client) SendDecodeAppList(ctx context.Context, params algokit.CallParam
 	return typedResult, nil
 }
 
-// SendDecodeUint64 calls the decodeUint64 ABI method and waits for confirmation.
+// SendDecodeUint64 calls the decodeUint64 ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDecodeUint64(ctx context.Context, params algokit.CallParams[DecodeUint64Args]) (*DecodeUint64MethodResult, error) {
-	methodArgs := argsToInterfaceDecodeUint64(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendDecodeUint64(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "decodeUint64",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendDecodeUint64(ctx context.Context, params algokit.CallParams[DecodeUint64Args]) (*DecodeUint64MethodResult, error) {
+	comp, err := c.NewGroup().DecodeUint64(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*DecodeUint64MethodResult)
+	return typedResult, nil
+}
 
+// decodeDecodeUint64Result decodes the return value of a decodeUint64 call.
+func (c *Client) decodeDecodeUint64Result(result *algokit.SendAppTransactionResult) (*DecodeUint64MethodResult, error) {
 	typedResult := &DecodeUint64MethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -233,28 +637,31 @@ func (c *Client) SendDecodeUint64(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendDecodeStaticArray calls the decodeStaticArray ABI method and waits for confirmation.
+// SendDecodeStaticArray calls the decodeStaticArray ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDecodeStaticArray(ctx context.Context, params algokit.CallParams[DecodeStaticArrayArgs]) (*DecodeStaticArrayMethodResult, error) {
-	methodArgs := argsToInterfaceDecodeStaticArray(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendDecodeStaticArray(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "decodeStaticArray",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendDecodeStaticArray(ctx context.Context, params algokit.CallParams[DecodeStaticArrayArgs]) (*DecodeStaticArrayMethodResult, error) {
+	comp, err := c.NewGroup().DecodeStaticArray(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*DecodeStaticArrayMethodResult)
+	return typedResult, nil
+}
 
+// decodeDecodeStaticArrayResult decodes the return value of a decodeStaticArray call.
+func (c *Client) decodeDecodeStaticArrayResult(result *algokit.SendAppTransactionResult) (*DecodeStaticArrayMethodResult, error) {
 	typedResult := &DecodeStaticArrayMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -270,28 +677,31 @@ func (c *Client) SendDecodeStaticArray(ctx context.Context, params algokit.CallP
 	return typedResult, nil
 }
 
-// SendCheckObjectAssignment calls the checkObjectAssignment ABI method and waits for confirmation.
+// SendCheckObjectAssignment calls the checkObjectAssignment ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendCheckObjectAssignment(ctx context.Context, params algokit.CallParams[CheckObjectAssignmentArgs]) (*CheckObjectAssignmentMethodResult, error) {
-	methodArgs := argsToInterfaceCheckObjectAssignment(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendCheckObjectAssignment(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "checkObjectAssignment",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendCheckObjectAssignment(ctx context.Context, params algokit.CallParams[CheckObjectAssignmentArgs]) (*CheckObjectAssignmentMethodResult, error) {
+	comp, err := c.NewGroup().CheckObjectAssignment(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*CheckObjectAssignmentMethodResult)
+	return typedResult, nil
+}
 
+// decodeCheckObjectAssignmentResult decodes the return value of a checkObjectAssignment call.
+func (c *Client) decodeCheckObjectAssignmentResult(result *algokit.SendAppTransactionResult) (*CheckObjectAssignmentMethodResult, error) {
 	typedResult := &CheckObjectAssignmentMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -307,18 +717,32 @@ func (c *Client) SendCheckObjectAssignment(ctx context.Context, params algokit.C
 	return typedResult, nil
 }
 
-// SendRetObject calls the retObject ABI method and waits for confirmation.
-func (c *Client) SendRetObject(ctx context.Context) (*RetObjectMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendRetObject calls the retObject ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendRetObject(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetObjectMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRetObject(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "retObject",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendRetObject(ctx context.Context, params algokit.CallParams[struct{}]) (*RetObjectMethodResult, error) {
+	comp, err := c.NewGroup().RetObject(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*RetObjectMethodResult)
+	return typedResult, nil
+}
 
+// decodeRetObjectResult decodes the return value of a retObject call.
+func (c *Client) decodeRetObjectResult(result *algokit.SendAppTransactionResult) (*RetObjectMethodResult, error) {
 	typedResult := &RetObjectMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -334,18 +758,32 @@ func (c *Client) SendRetObject(ctx context.Context) (*RetObjectMethodResult, err
 	return typedResult, nil
 }
 
-// SendRetDecode calls the retDecode ABI method and waits for confirmation.
-func (c *Client) SendRetDecode(ctx context.Context) (*RetDecodeMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendRetDecode calls the retDecode ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendRetDecode(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetDecodeMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRetDecode(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "retDecode",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendRetDecode(ctx context.Context, params algokit.CallParams[struct{}]) (*RetDecodeMethodResult, error) {
+	comp, err := c.NewGroup().RetDecode(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*RetDecodeMethodResult)
+	return typedResult, nil
+}
 
+// decodeRetDecodeResult decodes the return value of a retDecode call.
+func (c *Client) decodeRetDecodeResult(result *algokit.SendAppTransactionResult) (*RetDecodeMethodResult, error) {
 	typedResult := &RetDecodeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -361,43 +799,71 @@ func (c *Client) SendRetDecode(ctx context.Context) (*RetDecodeMethodResult, err
 	return typedResult, nil
 }
 
-// SendRetList calls the retList ABI method and waits for confirmation.
-func (c *Client) SendRetList(ctx context.Context) (*RetListMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendRetList calls the retList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendRetList(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RetListMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRetList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "retList",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendRetList(ctx context.Context, params algokit.CallParams[struct{}]) (*RetListMethodResult, error) {
+	comp, err := c.NewGroup().RetList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*RetListMethodResult)
+	return typedResult, nil
+}
 
+// decodeRetListResult decodes the return value of a retList call.
+func (c *Client) decodeRetListResult(result *algokit.SendAppTransactionResult) (*RetListMethodResult, error) {
 	typedResult := &RetListMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendPercentileCheck calls the percentileCheck ABI method and waits for confirmation.
-func (c *Client) SendPercentileCheck(ctx context.Context) (*PercentileCheckMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendPercentileCheck calls the percentileCheck ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendPercentileCheck(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*PercentileCheckMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendPercentileCheck(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "percentileCheck",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendPercentileCheck(ctx context.Context, params algokit.CallParams[struct{}]) (*PercentileCheckMethodResult, error) {
+	comp, err := c.NewGroup().PercentileCheck(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*PercentileCheckMethodResult)
+	return typedResult, nil
+}
 
+// decodePercentileCheckResult decodes the return value of a percentileCheck call.
+func (c *Client) decodePercentileCheckResult(result *algokit.SendAppTransactionResult) (*PercentileCheckMethodResult, error) {
 	typedResult := &PercentileCheckMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -411,18 +877,32 @@ func (c *Client) SendPercentileCheck(ctx context.Context) (*PercentileCheckMetho
 	return typedResult, nil
 }
 
-// SendBigLoop calls the bigLoop ABI method and waits for confirmation.
-func (c *Client) SendBigLoop(ctx context.Context) (*BigLoopMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendBigLoop calls the bigLoop ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendBigLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BigLoopMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendBigLoop(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "bigLoop",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendBigLoop(ctx context.Context, params algokit.CallParams[struct{}]) (*BigLoopMethodResult, error) {
+	comp, err := c.NewGroup().BigLoop(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*BigLoopMethodResult)
+	return typedResult, nil
+}
 
+// decodeBigLoopResult decodes the return value of a bigLoop call.
+func (c *Client) decodeBigLoopResult(result *algokit.SendAppTransactionResult) (*BigLoopMethodResult, error) {
 	typedResult := &BigLoopMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -436,69 +916,95 @@ func (c *Client) SendBigLoop(ctx context.Context) (*BigLoopMethodResult, error)
 	return typedResult, nil
 }
 
-// SendBigCLoop calls the bigCLoop ABI method and waits for confirmation.
-func (c *Client) SendBigCLoop(ctx context.Context) (*BigCLoopMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendBigCLoop calls the bigCLoop ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendBigCLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BigCLoopMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendBigCLoop(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "bigCLoop",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendBigCLoop(ctx context.Context, params algokit.CallParams[struct{}]) (*BigCLoopMethodResult, error) {
+	comp, err := c.NewGroup().BigCLoop(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*BigCLoopMethodResult)
+	return typedResult, nil
+}
 
+// decodeBigCLoopResult decodes the return value of a bigCLoop call.
+func (c *Client) decodeBigCLoopResult(result *algokit.SendAppTransactionResult) (*BigCLoopMethodResult, error) {
 	typedResult := &BigCLoopMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendNullun calls the nullun ABI method and waits for confirmation.
-func (c *Client) SendNullun(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "nullun",
-		MethodArgs: methodArgs,
-	})
+// SendNullun calls the nullun ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendNullun(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendNullun(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendNullun(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Nullun(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDynamicArrayOfDynamicArrays calls the dynamicArrayOfDynamicArrays ABI method and waits for confirmation.
+// SendDynamicArrayOfDynamicArrays calls the dynamicArrayOfDynamicArrays ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDynamicArrayOfDynamicArrays(ctx context.Context, params algokit.CallParams[DynamicArrayOfDynamicArraysArgs]) (*DynamicArrayOfDynamicArraysMethodResult, error) {
-	methodArgs := argsToInterfaceDynamicArrayOfDynamicArrays(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendDynamicArrayOfDynamicArrays(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "dynamicArrayOfDynamicArrays",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendDynamicArrayOfDynamicArrays(ctx context.Context, params algokit.CallParams[DynamicArrayOfDynamicArraysArgs]) (*DynamicArrayOfDynamicArraysMethodResult, error) {
+	comp, err := c.NewGroup().DynamicArrayOfDynamicArrays(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*DynamicArrayOfDynamicArraysMethodResult)
+	return typedResult, nil
+}
 
+// decodeDynamicArrayOfDynamicArraysResult decodes the return value of a dynamicArrayOfDynamicArrays call.
+func (c *Client) decodeDynamicArrayOfDynamicArraysResult(result *algokit.SendAppTransactionResult) (*DynamicArrayOfDynamicArraysMethodResult, error) {
 	typedResult := &DynamicArrayOfDynamicArraysMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -512,18 +1018,32 @@ func (c *Client) SendDynamicArrayOfDynamicArrays(ctx context.Context, params alg
 	return typedResult, nil
 }
 
-// SendSubTest calls the subTest ABI method and waits for confirmation.
-func (c *Client) SendSubTest(ctx context.Context) (*SubTestMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendSubTest calls the subTest ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendSubTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*SubTestMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSubTest(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "subTest",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendSubTest(ctx context.Context, params algokit.CallParams[struct{}]) (*SubTestMethodResult, error) {
+	comp, err := c.NewGroup().SubTest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*SubTestMethodResult)
+	return typedResult, nil
+}
 
+// decodeSubTestResult decodes the return value of a subTest call.
+func (c *Client) decodeSubTestResult(result *algokit.SendAppTransactionResult) (*SubTestMethodResult, error) {
 	typedResult := &SubTestMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -537,18 +1057,32 @@ func (c *Client) SendSubTest(ctx context.Context) (*SubTestMethodResult, error)
 	return typedResult, nil
 }
 
-// SendShadowTest calls the shadowTest ABI method and waits for confirmation.
-func (c *Client) SendShadowTest(ctx context.Context) (*ShadowTestMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendShadowTest calls the shadowTest ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendShadowTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*ShadowTestMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendShadowTest(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "shadowTest",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendShadowTest(ctx context.Context, params algokit.CallParams[struct{}]) (*ShadowTestMethodResult, error) {
+	comp, err := c.NewGroup().ShadowTest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ShadowTestMethodResult)
+	return typedResult, nil
+}
 
+// decodeShadowTestResult decodes the return value of a shadowTest call.
+func (c *Client) decodeShadowTestResult(result *algokit.SendAppTransactionResult) (*ShadowTestMethodResult, error) {
 	typedResult := &ShadowTestMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -564,34 +1098,57 @@ func (c *Client) SendShadowTest(ctx context.Context) (*ShadowTestMethodResult, e
 	return typedResult, nil
 }
 
-// SendBoxSetTest calls the boxSetTest ABI method and waits for confirmation.
-func (c *Client) SendBoxSetTest(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "boxSetTest",
-		MethodArgs: methodArgs,
-	})
+// SendBoxSetTest calls the boxSetTest ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendBoxSetTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBoxSetTest(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBoxSetTest(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().BoxSetTest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendPaddedBytes calls the paddedBytes ABI method and waits for confirmation.
-func (c *Client) SendPaddedBytes(ctx context.Context) (*PaddedBytesMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendPaddedBytes calls the paddedBytes ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendPaddedBytes(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*PaddedBytesMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendPaddedBytes(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "paddedBytes",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendPaddedBytes(ctx context.Context, params algokit.CallParams[struct{}]) (*PaddedBytesMethodResult, error) {
+	comp, err := c.NewGroup().PaddedBytes(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*PaddedBytesMethodResult)
+	return typedResult, nil
+}
 
+// decodePaddedBytesResult decodes the return value of a paddedBytes call.
+func (c *Client) decodePaddedBytesResult(result *algokit.SendAppTransactionResult) (*PaddedBytesMethodResult, error) {
 	typedResult := &PaddedBytesMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -642,12 +1199,16 @@ func argsToInterfaceCheckObjectAssignment(args CheckObjectAssignmentArgs) []inte
 	}
 }
 
-func argsToInterfaceDynamicArrayOfDynamicArrays(args DynamicArrayOfDynamicArraysArgs) []interface{} {
-	return []interface{}{
-		args.A,
-		args.B,
-		args.C,
+func argsToInterfaceDynamicArrayOfDynamicArrays(args DynamicArrayOfDynamicArraysArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 3)
+	methodArgs = append(methodArgs, args.A)
+	if v, err := toABIValue(args.B); err != nil {
+		return nil, fmt.Errorf("invalid b argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	methodArgs = append(methodArgs, args.C)
+	return methodArgs, nil
 }
 
 // Unmarshal helper for JSON decoding
@@ -2,587 +2,848 @@
 package statedecoding
 
 import (
+	"bytes"
 	"context"
-
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/abi"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // Composer builds atomic transaction groups for the StateDecoding contract.
+// A Composer is not safe for concurrent use.
 type Composer struct {
-	client   *Client
-	composer *algokit.TransactionComposer
+	client  *Client
+	pending []groupCall
 }
 
-// Init adds a init method call to the transaction group.
-func (comp *Composer) Init(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
+// groupCall is a method call added to a Composer, built into a group with
+// the go-algorand-sdk AtomicTransactionComposer when the group is sent.
+type groupCall struct {
+	method    abi.Method
+	args      []interface{}
+	sender    types.Address
+	signer    transaction.TransactionSigner
+	note      []byte
+	boxes     []types.AppBoxReference
+	accounts  []types.Address
+	apps      []uint64
+	assets    []uint64
+	extraFee  uint64
+	staticFee uint64
+	readonly  bool
+	populate  bool // SendParams.PopulateAppCallResources
+}
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "init" {
-			method, err = m.ToABIMethod()
-			break
-		}
+func newGroupCall[T any](method abi.Method, args []interface{}, params algokit.CallParams[T], readonly bool) groupCall {
+	return groupCall{
+		method:    method,
+		args:      args,
+		sender:    params.Sender,
+		signer:    params.Signer,
+		note:      params.Note,
+		boxes:     params.BoxReferences,
+		accounts:  params.AccountReferences,
+		apps:      params.AppReferences,
+		assets:    params.AssetReferences,
+		extraFee:  params.ExtraFee,
+		staticFee: params.StaticFee,
+		readonly:  readonly,
+		populate:  params.SendParams.PopulateAppCallResources,
 	}
+}
+
+// Init adds a init method call to the transaction group.
+func (comp *Composer) Init(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("init()void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // GetBox adds a getBox method call to the transaction group.
 func (comp *Composer) GetBox(ctx context.Context, params algokit.CallParams[GetBoxArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceGetBox(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "getBox" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceGetBox(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("getBox(uint64)byte[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // DoNothing adds a doNothing method call to the transaction group.
-func (comp *Composer) DoNothing(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "doNothing" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) DoNothing(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("doNothing()void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // RawState adds a rawState method call to the transaction group.
 func (comp *Composer) RawState(ctx context.Context, params algokit.CallParams[RawStateArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceRawState(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "rawState" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceRawState(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("rawState(application)byte[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // DecodeAppList adds a decodeAppList method call to the transaction group.
 func (comp *Composer) DecodeAppList(ctx context.Context, params algokit.CallParams[DecodeAppListArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceDecodeAppList(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "decodeAppList" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceDecodeAppList(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("decodeAppList(application)(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // DecodeUint64 adds a decodeUint64 method call to the transaction group.
 func (comp *Composer) DecodeUint64(ctx context.Context, params algokit.CallParams[DecodeUint64Args]) (*Composer, error) {
-	methodArgs := argsToInterfaceDecodeUint64(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "decodeUint64" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceDecodeUint64(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("decodeUint64(application)uint64")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // DecodeStaticArray adds a decodeStaticArray method call to the transaction group.
 func (comp *Composer) DecodeStaticArray(ctx context.Context, params algokit.CallParams[DecodeStaticArrayArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceDecodeStaticArray(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "decodeStaticArray" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceDecodeStaticArray(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("decodeStaticArray(application)(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // CheckObjectAssignment adds a checkObjectAssignment method call to the transaction group.
 func (comp *Composer) CheckObjectAssignment(ctx context.Context, params algokit.CallParams[CheckObjectAssignmentArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceCheckObjectAssignment(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "checkObjectAssignment" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceCheckObjectAssignment(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("checkObjectAssignment(uint64,uint64)(uint64,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // RetObject adds a retObject method call to the transaction group.
-func (comp *Composer) RetObject(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "retObject" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) RetObject(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("retObject()(uint64,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // RetDecode adds a retDecode method call to the transaction group.
-func (comp *Composer) RetDecode(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "retDecode" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) RetDecode(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("retDecode()(uint64,address,uint64[])")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // RetList adds a retList method call to the transaction group.
-func (comp *Composer) RetList(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "retList" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) RetList(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("retList()(uint64,uint64)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // PercentileCheck adds a percentileCheck method call to the transaction group.
-func (comp *Composer) PercentileCheck(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "percentileCheck" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) PercentileCheck(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("percentileCheck()uint64[5]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // BigLoop adds a bigLoop method call to the transaction group.
-func (comp *Composer) BigLoop(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "bigLoop" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) BigLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("bigLoop()uint64")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // BigCLoop adds a bigCLoop method call to the transaction group.
-func (comp *Composer) BigCLoop(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "bigCLoop" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) BigCLoop(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("bigCLoop()(uint64,uint64,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Nullun adds a nullun method call to the transaction group.
-func (comp *Composer) Nullun(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "nullun" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) Nullun(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("nullun()void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // DynamicArrayOfDynamicArrays adds a dynamicArrayOfDynamicArrays method call to the transaction group.
 func (comp *Composer) DynamicArrayOfDynamicArrays(ctx context.Context, params algokit.CallParams[DynamicArrayOfDynamicArraysArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceDynamicArrayOfDynamicArrays(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "dynamicArrayOfDynamicArrays" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceDynamicArrayOfDynamicArrays(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("dynamicArrayOfDynamicArrays(uint64,(uint64,address,uint64[])[],address)uint64[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SubTest adds a subTest method call to the transaction group.
-func (comp *Composer) SubTest(ctx context.Context) (*Composer, error) {
+func (comp *Composer) SubTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
+	}
 	methodArgs := []interface{}(nil)
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "subTest" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	method, err := abi.MethodFromSignature("subTest()uint64[5]")
+	if err != nil {
+		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
+	return comp, nil
+}
+
+// ShadowTest adds a shadowTest method call to the transaction group.
+func (comp *Composer) ShadowTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("shadowTest()(bool,bool,bool,bool)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
-// ShadowTest adds a shadowTest method call to the transaction group.
-func (comp *Composer) ShadowTest(ctx context.Context) (*Composer, error) {
+// BoxSetTest adds a boxSetTest method call to the transaction group.
+func (comp *Composer) BoxSetTest(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
+	}
 	methodArgs := []interface{}(nil)
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "shadowTest" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	method, err := abi.MethodFromSignature("boxSetTest()void")
+	if err != nil {
+		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
+	return comp, nil
+}
+
+// PaddedBytes adds a paddedBytes method call to the transaction group.
+func (comp *Composer) PaddedBytes(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("paddedBytes()byte[32]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
-// BoxSetTest adds a boxSetTest method call to the transaction group.
-func (comp *Composer) BoxSetTest(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
+// GroupResult is a confirmed group, or the simulated result of a group of
+// readonly calls.
+type GroupResult struct {
+	ConfirmedRound uint64 // 0 for a simulated group
+	TxIDs          []string
+	// Results holds the result of each method call of the group, in order,
+	// with ABIReturn as decoded by the SDK.
+	Results []algokit.SendAppTransactionResult
+	// Returns holds the typed result of each method call: its
+	// *{Method}MethodResult, or *algokit.SendAppTransactionResult for a void
+	// method.
+	Returns []interface{}
+}
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "boxSetTest" {
-			method, err = m.ToABIMethod()
-			break
-		}
+// Send sends the composed transaction group and waits for confirmation,
+// until the ctx deadline or 5 rounds if ctx has none. A group of only
+// readonly calls is simulated instead of sent. Send returns a
+// ConfirmationError if the group is not known to be confirmed. The client
+// must have an algod client: see ClientOptions.Algod.
+func (comp *Composer) Send(ctx context.Context) (*GroupResult, error) {
+	return comp.send(ctx)
+}
+
+func (comp *Composer) send(ctx context.Context) (*GroupResult, error) {
+	client, err := comp.client.algodClient("Composer.Send")
+	if err != nil {
+		return nil, err
+	}
+	if comp.readonly() {
+		return comp.simulate(ctx, client)
 	}
+	atc, _, lastValid, err := comp.prepare(ctx, client, signed)
 	if err != nil {
 		return nil, err
 	}
+	executed, err := atc.Execute(client, ctx, waitRounds(ctx))
+	if err != nil {
+		return nil, comp.client.sendFailed(sendError(ctx, atc, lastValid, err))
+	}
+	return comp.client.groupResult(executed.ConfirmedRound, executed.TxIDs, executed.MethodResults)
+}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+// readonly reports whether every call of the group is readonly.
+func (comp *Composer) readonly() bool {
+	for _, call := range comp.pending {
+		if !call.readonly {
+			return false
+		}
+	}
+	return len(comp.pending) > 0
+}
+
+// simulate runs a group of readonly calls without sending it.
+func (comp *Composer) simulate(ctx context.Context, client *algod.Client) (*GroupResult, error) {
+	atc, _, _, err := comp.prepare(ctx, client, simulated)
 	if err != nil {
 		return nil, err
 	}
-	return comp, nil
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate readonly calls: %w", err)
+	}
+	if failure := response.SimulateResponse.TxnGroups[0].FailureMessage; failure != "" {
+		return nil, fmt.Errorf("readonly calls failed: %s", failure)
+	}
+	txIDs := make([]string, len(response.MethodResults))
+	for i, r := range response.MethodResults {
+		txIDs[i] = r.TxID
+	}
+	return comp.client.groupResult(0, txIDs, response.MethodResults)
 }
 
-// PaddedBytes adds a paddedBytes method call to the transaction group.
-func (comp *Composer) PaddedBytes(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
+// groupResult returns the results of the method calls of a group.
+func (c *Client) groupResult(round uint64, txIDs []string, methodResults []transaction.ABIMethodResult) (*GroupResult, error) {
+	result := &GroupResult{ConfirmedRound: round, TxIDs: txIDs}
+	for _, r := range methodResults {
+		if r.DecodeError != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, r.DecodeError)
+		}
+		sent := algokit.SendAppTransactionResult{TxID: r.TxID, ABIReturn: r.ReturnValue, Confirmation: r.TransactionInfo}
+		typed, err := c.decodeResult(r.Method, sent)
+		if err != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, err)
+		}
+		result.Results = append(result.Results, sent)
+		result.Returns = append(result.Returns, typed)
+	}
+	return result, nil
+}
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "paddedBytes" {
-			method, err = m.ToABIMethod()
-			break
+// decodeResult returns the typed result of a confirmed method call.
+func (c *Client) decodeResult(method abi.Method, result algokit.SendAppTransactionResult) (interface{}, error) {
+	switch method.GetSignature() {
+	case "getBox(uint64)byte[]":
+		return c.decodeGetBoxResult(&result)
+	case "rawState(application)byte[]":
+		return c.decodeRawStateResult(&result)
+	case "decodeAppList(application)(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)":
+		return c.decodeDecodeAppListResult(&result)
+	case "decodeUint64(application)uint64":
+		return c.decodeDecodeUint64Result(&result)
+	case "decodeStaticArray(application)(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)":
+		return c.decodeDecodeStaticArrayResult(&result)
+	case "checkObjectAssignment(uint64,uint64)(uint64,uint64)":
+		return c.decodeCheckObjectAssignmentResult(&result)
+	case "retObject()(uint64,uint64)":
+		return c.decodeRetObjectResult(&result)
+	case "retDecode()(uint64,address,uint64[])":
+		return c.decodeRetDecodeResult(&result)
+	case "retList()(uint64,uint64)[]":
+		return c.decodeRetListResult(&result)
+	case "percentileCheck()uint64[5]":
+		return c.decodePercentileCheckResult(&result)
+	case "bigLoop()uint64":
+		return c.decodeBigLoopResult(&result)
+	case "bigCLoop()(uint64,uint64,uint64)":
+		return c.decodeBigCLoopResult(&result)
+	case "dynamicArrayOfDynamicArrays(uint64,(uint64,address,uint64[])[],address)uint64[]":
+		return c.decodeDynamicArrayOfDynamicArraysResult(&result)
+	case "subTest()uint64[5]":
+		return c.decodeSubTestResult(&result)
+	case "shadowTest()(bool,bool,bool,bool)":
+		return c.decodeShadowTestResult(&result)
+	case "paddedBytes()byte[32]":
+		return c.decodePaddedBytesResult(&result)
+	}
+	return &result, nil
+}
+
+// suggestedParams returns the params to build transactions with, with the
+// client's ValidityWindow applied.
+func (c *Client) suggestedParams(ctx context.Context, client *algod.Client) (types.SuggestedParams, error) {
+	sp, err := client.SuggestedParams().Do(ctx)
+	if err != nil {
+		return sp, fmt.Errorf("failed to get suggested params: %w", err)
+	}
+	if window := c.opts.ValidityWindow; window > 0 {
+		sp.LastRoundValid = sp.FirstRoundValid + types.Round(window)
+	}
+	return sp, nil
+}
+
+// sendFailed returns the error of a failed send.
+func (c *Client) sendFailed(err error) error {
+	return err
+}
+
+// signing is how buildATC signs the calls of a group.
+type signing int
+
+const (
+	signed    signing = iota // each call needs a signer
+	unsigned                 // no signer is needed
+	simulated                // every signer is replaced by an empty one
+)
+
+// sentCall is a method call of a built group.
+type sentCall struct {
+	index  int // position in the group
+	method abi.Method
+}
+
+// prepare builds the group with the SDK composer, first simulating it to
+// find the resources of calls with PopulateAppCallResources. It returns the
+// last valid round of the group.
+func (comp *Composer) prepare(ctx context.Context, client *algod.Client, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, uint64, error) {
+	if len(comp.pending) == 0 {
+		return nil, nil, 0, errors.New("the group needs at least one call")
+	}
+	sp, err := comp.client.suggestedParams(ctx, client)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	calls := comp.pending
+	if mode != simulated && comp.populates() {
+		if calls, err = comp.populateResources(ctx, client, sp); err != nil {
+			return nil, nil, 0, err
 		}
 	}
+	atc, sent, err := comp.buildATC(calls, sp, mode)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
+	return atc, sent, uint64(sp.LastRoundValid), nil
+}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+// buildATC adds calls to an SDK composer, using the client's default sender
+// and signer for calls without them.
+func (comp *Composer) buildATC(calls []groupCall, sp types.SuggestedParams, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, error) {
+	var atc transaction.AtomicTransactionComposer
+	sent := make([]sentCall, 0, len(calls))
+	for _, call := range calls {
+		if call.sender.IsZero() {
+			call.sender = comp.client.params.DefaultSender
+		}
+		if call.signer == nil && call.sender == comp.client.params.DefaultSender {
+			call.signer = comp.client.params.DefaultSigner
+		}
+		if call.sender.IsZero() {
+			return nil, nil, fmt.Errorf("no sender for %s", call.method.Name)
+		}
+		switch {
+		case mode == simulated:
+			call.signer = transaction.EmptyTransactionSigner{}
+		case call.signer == nil && mode == signed:
+			return nil, nil, fmt.Errorf("no signer for %s", call.sender)
+		}
+		callSP := sp
+		if call.staticFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(call.staticFee)
+		} else if call.extraFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(sp.MinFee+call.extraFee)
+		}
+		accounts := make([]string, len(call.accounts))
+		for i, a := range call.accounts {
+			accounts[i] = a.String()
+		}
+		args, err := sdkArgs(call.method, call.args, mode == simulated)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = atc.AddMethodCall(transaction.AddMethodCallParams{
+			AppID:           comp.client.AppID(),
+			Method:          call.method,
+			MethodArgs:      args,
+			Sender:          call.sender,
+			SuggestedParams: callSP,
+			OnComplete:      types.NoOpOC,
+			Note:            call.note,
+			Signer:          call.signer,
+			ForeignApps:     call.apps,
+			ForeignAssets:   call.assets,
+			ForeignAccounts: accounts,
+			BoxReferences:   call.boxes,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		sent = append(sent, sentCall{index: atc.Count() - 1, method: call.method})
+	}
+	return &atc, sent, nil
+}
+
+// groupTxIDs returns the transaction IDs of a built group.
+func groupTxIDs(atc *transaction.AtomicTransactionComposer) []string {
+	group, err := atc.BuildGroup()
+	if err != nil {
+		return nil
+	}
+	txIDs := make([]string, len(group))
+	for i, txn := range group {
+		txIDs[i] = crypto.GetTxID(txn.Txn)
+	}
+	return txIDs
+}
+
+// sdkArgs converts method args to the values the SDK composer encodes. The
+// generated Args structs hold account reference args as address strings,
+// which the SDK only accepts as a types.Address. With emptySigners, the
+// signers of transaction args are replaced for simulation.
+func sdkArgs(method abi.Method, args []interface{}, emptySigners bool) ([]interface{}, error) {
+	converted := make([]interface{}, len(args))
+	copy(converted, args)
+	for i, arg := range converted {
+		switch a := arg.(type) {
+		case transaction.TransactionWithSigner:
+			if emptySigners {
+				a.Signer = transaction.EmptyTransactionSigner{}
+				converted[i] = a
+			}
+		case string:
+			if i >= len(method.Args) || method.Args[i].Type != abi.AccountReferenceType {
+				continue
+			}
+			address, err := types.DecodeAddress(a)
+			if err != nil {
+				return nil, fmt.Errorf("%s arg %s: %w", method.Name, method.Args[i].Name, err)
+			}
+			converted[i] = address
+		}
+	}
+	return converted, nil
+}
+
+// populates reports whether a call of the group has PopulateAppCallResources.
+func (comp *Composer) populates() bool {
+	for _, call := range comp.pending {
+		if call.populate {
+			return true
+		}
+	}
+	return false
+}
+
+// maxReferences and maxAccountReferences are the most resources and
+// accounts an app call can reference.
+const (
+	maxReferences        = 8
+	maxAccountReferences = 4
+)
+
+// populateResources simulates the group and returns its calls with the
+// resources they access without referencing them added to their references.
+// Resources the group shares go to the first call with room for them.
+func (comp *Composer) populateResources(ctx context.Context, client *algod.Client, sp types.SuggestedParams) ([]groupCall, error) {
+	atc, sent, err := comp.buildATC(comp.pending, sp, simulated)
 	if err != nil {
 		return nil, err
 	}
-	return comp, nil
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate to populate resources: %w", err)
+	}
+	group := response.SimulateResponse.TxnGroups[0]
+	if group.FailureMessage != "" {
+		return nil, fmt.Errorf("simulating to populate resources failed: %s", group.FailureMessage)
+	}
+
+	appID := comp.client.AppID()
+	calls := make([]groupCall, len(comp.pending))
+	copy(calls, comp.pending)
+	for i, s := range sent {
+		accessed := group.TxnResults[s.index].UnnamedResourcesAccessed
+		if err := calls[i].addResources(appID, accessed, false); err != nil {
+			return nil, err
+		}
+	}
+	accessed := group.UnnamedResourcesAccessed
+	for _, resources := range splitResources(accessed) {
+		added := false
+		for i := range calls {
+			if calls[i].addResources(appID, resources, true) == nil {
+				added = true
+				break
+			}
+		}
+		if !added {
+			return nil, fmt.Errorf("no call of the group has room to reference %+v", resources)
+		}
+	}
+	return calls, nil
+}
+
+// splitResources splits the resources a group accesses into the sets that
+// must be referenced by the same call: an account or app with its local
+// state, an account with an asset holding and an app with its box.
+func splitResources(accessed models.SimulateUnnamedResourcesAccessed) []models.SimulateUnnamedResourcesAccessed {
+	var sets []models.SimulateUnnamedResourcesAccessed
+	for _, local := range accessed.AppLocals {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{local.Account}, Apps: []uint64{local.App}})
+	}
+	for _, holding := range accessed.AssetHoldings {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{holding.Account}, Assets: []uint64{holding.Asset}})
+	}
+	for _, account := range accessed.Accounts {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{account}})
+	}
+	for _, app := range accessed.Apps {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Apps: []uint64{app}})
+	}
+	for _, asset := range accessed.Assets {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Assets: []uint64{asset}})
+	}
+	for _, box := range accessed.Boxes {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Boxes: []models.BoxReference{box}})
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{ExtraBoxRefs: 1})
+	}
+	return sets
 }
 
-// Send executes the composed transaction group and waits for confirmation.
-func (comp *Composer) Send(ctx context.Context) (*algokit.ComposerExecuteResult, error) {
-	return comp.composer.Execute(ctx, 5)
+// addResources adds resources to the call's references. With limited set,
+// it adds none and fails if they would take the call over the reference
+// limits.
+func (call *groupCall) addResources(appID uint64, accessed models.SimulateUnnamedResourcesAccessed, limited bool) error {
+	updated := *call
+	updated.accounts = append([]types.Address(nil), call.accounts...)
+	updated.apps = append([]uint64(nil), call.apps...)
+	updated.assets = append([]uint64(nil), call.assets...)
+	updated.boxes = append([]types.AppBoxReference(nil), call.boxes...)
+
+	accounts := append([]string(nil), accessed.Accounts...)
+	apps := append([]uint64(nil), accessed.Apps...)
+	assets := append([]uint64(nil), accessed.Assets...)
+	for _, local := range accessed.AppLocals {
+		accounts, apps = append(accounts, local.Account), append(apps, local.App)
+	}
+	for _, holding := range accessed.AssetHoldings {
+		accounts, assets = append(accounts, holding.Account), append(assets, holding.Asset)
+	}
+
+	addApp := func(app uint64) {
+		if app != appID && !containsUint(updated.apps, app) {
+			updated.apps = append(updated.apps, app)
+		}
+	}
+	for _, s := range accounts {
+		account, err := types.DecodeAddress(s)
+		if err != nil {
+			return err
+		}
+		if account != updated.sender && !containsAddress(updated.accounts, account) {
+			updated.accounts = append(updated.accounts, account)
+		}
+	}
+	for _, app := range apps {
+		addApp(app)
+	}
+	for _, asset := range assets {
+		if !containsUint(updated.assets, asset) {
+			updated.assets = append(updated.assets, asset)
+		}
+	}
+	for _, box := range accessed.Boxes {
+		ref := types.AppBoxReference{AppID: box.App, Name: box.Name}
+		if box.App == appID {
+			ref.AppID = 0
+		} else {
+			addApp(box.App)
+		}
+		updated.boxes = append(updated.boxes, ref)
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		updated.boxes = append(updated.boxes, types.AppBoxReference{})
+	}
+
+	references := len(updated.accounts) + len(updated.apps) + len(updated.assets) + len(updated.boxes)
+	if limited && (len(updated.accounts) > maxAccountReferences || references > maxReferences) {
+		return errors.New("too many references")
+	}
+	*call = updated
+	return nil
+}
+
+func containsUint(values []uint64, v uint64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(values []types.Address, v types.Address) bool {
+	for _, x := range values {
+		if bytes.Equal(x[:], v[:]) {
+			return true
+		}
+	}
+	return false
 }
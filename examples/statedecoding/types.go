@@ -6,20 +6,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// RandoObject is a generated struct type.
-type RandoObject struct {
-	A uint64 `json:"a"`
-	B uint64 `json:"b"`
-}
-
-// ShadowTestResult is a generated struct type.
-type ShadowTestResult struct {
-	A     bool `json:"a"`
-	B     bool `json:"b"`
-	C     bool `json:"c"`
-	Valid bool `json:"valid"`
-}
-
 // AppList is a generated struct type.
 type AppList struct {
 	One      uint64 `json:"one"`
@@ -39,149 +25,163 @@ type AppList struct {
 	Fifteen  uint64 `json:"fifteen"`
 }
 
+// RandoComplexObject is a generated struct type.
+type RandoComplexObject struct {
+	A uint64        `json:"a"`
+	B types.Address `json:"b"`
+	C []uint64      `json:"c"`
+}
+
+// RandoObject is a generated struct type.
+type RandoObject struct {
+	A uint64 `json:"a"`
+	B uint64 `json:"b"`
+}
+
 // RandoStruct is a generated struct type.
 type RandoStruct struct {
 	A uint64 `json:"a"`
 	B uint64 `json:"b"`
 }
 
-// RandoComplexObject is a generated struct type.
-type RandoComplexObject struct {
-	A uint64        `json:"a"`
-	B types.Address `json:"b"`
-	C []uint64      `json:"c"`
+// ShadowTestResult is a generated struct type.
+type ShadowTestResult struct {
+	A     bool `json:"a"`
+	B     bool `json:"b"`
+	C     bool `json:"c"`
+	Valid bool `json:"valid"`
 }
 
 // GetBoxArgs holds the arguments for the getBox method.
 type GetBoxArgs struct {
-	Offset uint64
+	Offset uint64 `json:"offset"`
 }
 
 // GetBoxMethodResult holds the result of calling getBox.
 type GetBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
 
 // RawStateArgs holds the arguments for the rawState method.
 type RawStateArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // RawStateMethodResult holds the result of calling rawState.
 type RawStateMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
 
 // DecodeAppListArgs holds the arguments for the decodeAppList method.
 type DecodeAppListArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // DecodeAppListMethodResult holds the result of calling decodeAppList.
 type DecodeAppListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AppList
+	Return AppList `json:"return"`
 }
 
 // DecodeUint64Args holds the arguments for the decodeUint64 method.
 type DecodeUint64Args struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // DecodeUint64MethodResult holds the result of calling decodeUint64.
 type DecodeUint64MethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // DecodeStaticArrayArgs holds the arguments for the decodeStaticArray method.
 type DecodeStaticArrayArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // DecodeStaticArrayMethodResult holds the result of calling decodeStaticArray.
 type DecodeStaticArrayMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AppList
+	Return AppList `json:"return"`
 }
 
 // CheckObjectAssignmentArgs holds the arguments for the checkObjectAssignment method.
 type CheckObjectAssignmentArgs struct {
-	A uint64
-	B uint64
+	A uint64 `json:"a"`
+	B uint64 `json:"b"`
 }
 
 // CheckObjectAssignmentMethodResult holds the result of calling checkObjectAssignment.
 type CheckObjectAssignmentMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RandoStruct
+	Return RandoStruct `json:"return"`
 }
 
 // RetObjectMethodResult holds the result of calling retObject.
 type RetObjectMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RandoObject
+	Return RandoObject `json:"return"`
 }
 
 // RetDecodeMethodResult holds the result of calling retDecode.
 type RetDecodeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RandoComplexObject
+	Return RandoComplexObject `json:"return"`
 }
 
 // RetListMethodResult holds the result of calling retList.
 type RetListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple2[uint64, uint64] `json:"return"`
 }
 
 // PercentileCheckMethodResult holds the result of calling percentileCheck.
 type PercentileCheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [5]uint64
+	Return [5]uint64 `json:"return"`
 }
 
 // BigLoopMethodResult holds the result of calling bigLoop.
 type BigLoopMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // BigCLoopMethodResult holds the result of calling bigCLoop.
 type BigCLoopMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []interface{}
+	Return Tuple3[uint64, uint64, uint64] `json:"return"`
 }
 
 // DynamicArrayOfDynamicArraysArgs holds the arguments for the dynamicArrayOfDynamicArrays method.
 type DynamicArrayOfDynamicArraysArgs struct {
-	A uint64
-	B [][]interface{}
-	C types.Address
+	A uint64                                    `json:"a"`
+	B []Tuple3[uint64, types.Address, []uint64] `json:"b"`
+	C types.Address                             `json:"c"`
 }
 
 // DynamicArrayOfDynamicArraysMethodResult holds the result of calling dynamicArrayOfDynamicArrays.
 type DynamicArrayOfDynamicArraysMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []uint64
+	Return []uint64 `json:"return"`
 }
 
 // SubTestMethodResult holds the result of calling subTest.
 type SubTestMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [5]uint64
+	Return [5]uint64 `json:"return"`
 }
 
 // ShadowTestMethodResult holds the result of calling shadowTest.
 type ShadowTestMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ShadowTestResult
+	Return ShadowTestResult `json:"return"`
 }
 
 // PaddedBytesMethodResult holds the result of calling paddedBytes.
 type PaddedBytesMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [32]byte
+	Return [32]byte `json:"return"`
 }
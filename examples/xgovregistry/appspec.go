@@ -2,4 +2,8 @@
 package xgovregistry
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"XGovRegistry\",\"desc\":\"xGov Registry Contract\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"desc\":\"Create the xGov Registry.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"init_proposal_contract\",\"desc\":\"Initializes the Proposal Approval Program contract.\",\"args\":[{\"type\":\"uint64\",\"name\":\"size\",\"desc\":\"The size of the Proposal Approval Program contract\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"load_proposal_contract\",\"desc\":\"Loads the Proposal Approval Program contract.\",\"args\":[{\"type\":\"uint64\",\"name\":\"offset\",\"desc\":\"The offset in the Proposal Approval Program contract\"},{\"type\":\"byte[]\",\"name\":\"data\",\"desc\":\"The data to load into the Proposal Approval Program contract\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"delete_proposal_contract_box\",\"desc\":\"Deletes the Proposal Approval Program contract box.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"pause_registry\",\"desc\":\"Pauses the xGov Registry non-administrative methods.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"pause_proposals\",\"desc\":\"Pauses the creation of new Proposals.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"resume_registry\",\"desc\":\"Resumes the xGov Registry non-administrative methods.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"resume_proposals\",\"desc\":\"Resumes the creation of new Proposals.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_xgov_manager\",\"desc\":\"Sets the xGov Manager.\",\"args\":[{\"type\":\"address\",\"name\":\"manager\",\"desc\":\"Address of the new xGov Manager\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_payor\",\"desc\":\"Sets the xGov Payor.\",\"args\":[{\"type\":\"address\",\"name\":\"payor\",\"desc\":\"Address of the new xGov Payor\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_xgov_council\",\"desc\":\"Sets the xGov Council.\",\"args\":[{\"type\":\"address\",\"name\":\"council\",\"desc\":\"Address of the new xGov Council\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_xgov_subscriber\",\"desc\":\"Sets the xGov Subscriber.\",\"args\":[{\"type\":\"address\",\"name\":\"subscriber\",\"desc\":\"Address of the new xGov Subscriber\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_kyc_provider\",\"desc\":\"Sets the KYC provider.\",\"args\":[{\"type\":\"address\",\"name\":\"provider\",\"desc\":\"Address of the new KYC Provider\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_committee_manager\",\"desc\":\"Sets the Committee Manager.\",\"args\":[{\"type\":\"address\",\"name\":\"manager\",\"desc\":\"Address of the new xGov Manager\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_xgov_daemon\",\"desc\":\"Sets the xGov Daemon.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_daemon\",\"desc\":\"Address of the new xGov Daemon\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"config_xgov_registry\",\"desc\":\"Sets the configuration of the xGov Registry.\",\"args\":[{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64[3],uint64[4],uint64[4],uint64[3],uint64[3],uint64,uint64,uint64)\",\"name\":\"config\",\"desc\":\"Configuration class containing the field data\",\"struct\":\"XGovRegistryConfig\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"update_xgov_registry\",\"desc\":\"Updates the xGov Registry contract.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"subscribe_xgov\",\"desc\":\"Subscribes the sender to being an xGov.\",\"args\":[{\"type\":\"address\",\"name\":\"voting_address\",\"desc\":\"The address of the voting account for the xGov\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"XGovSubscribed\",\"desc\":\"An xGov subscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"},{\"type\":\"address\",\"name\":\"delegate\"}]}],\"recommendations\":{}},{\"name\":\"unsubscribe_xgov\",\"desc\":\"Unsubscribes the sender from being an xGov.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"XGovUnsubscribed\",\"desc\":\"An xGov unsubscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"}]}],\"recommendations\":{}},{\"name\":\"unsubscribe_absentee\",\"desc\":\"Unsubscribes an absentee xGov. This is a temporary method used only for the\\nfirst absentees removal at the inception of the absenteeism penalty.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"(Account): The address of the absentee xGov to unsubscribe\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"XGovUnsubscribed\",\"desc\":\"An xGov unsubscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"}]}],\"recommendations\":{}},{\"name\":\"request_subscribe_xgov\",\"desc\":\"Requests to subscribe to the xGov.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"},{\"type\":\"address\",\"name\":\"owner_address\",\"desc\":\"The address of the xGov Address owner/controller (Voting Address)\"},{\"type\":\"uint64\",\"name\":\"relation_type\",\"desc\":\"The type of relationship enum\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"approve_subscribe_xgov\",\"desc\":\"Approves a subscribe request to xGov.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the request to approve\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"XGovSubscribed\",\"desc\":\"An xGov subscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"},{\"type\":\"address\",\"name\":\"delegate\"}]}],\"recommendations\":{}},{\"name\":\"reject_subscribe_xgov\",\"desc\":\"Rejects a subscribe request to xGov.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the request to reject\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"request_unsubscribe_xgov\",\"desc\":\"Requests to unsubscribe from the xGov.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"},{\"type\":\"address\",\"name\":\"owner_address\",\"desc\":\"The address of the xGov Address owner/controller\"},{\"type\":\"uint64\",\"name\":\"relation_type\",\"desc\":\"The type of relationship enum\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov (unsubscribe) fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"approve_unsubscribe_xgov\",\"desc\":\"Approves a request to unsubscribe from xGov.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request to approve\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"XGovUnsubscribed\",\"desc\":\"An xGov unsubscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"}]}],\"recommendations\":{}},{\"name\":\"reject_unsubscribe_xgov\",\"desc\":\"Rejects a request to unsubscribe from xGov.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request to reject\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"set_voting_account\",\"desc\":\"Sets the Voting Address for the xGov.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The xGov address delegating voting power\"},{\"type\":\"address\",\"name\":\"voting_address\",\"desc\":\"The voting account address to delegate voting power to\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"subscribe_proposer\",\"desc\":\"Subscribes the sender to being a Proposer.\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the Proposer fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"ProposerSubscribed\",\"desc\":\"A Proposer subscribed\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\"}]}],\"recommendations\":{}},{\"name\":\"set_proposer_kyc\",\"desc\":\"Sets a proposer's KYC status.\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\",\"desc\":\"The address of the Proposer\"},{\"type\":\"bool\",\"name\":\"kyc_status\",\"desc\":\"The new status of the Proposer\"},{\"type\":\"uint64\",\"name\":\"kyc_expiring\",\"desc\":\"The expiration date as a unix timestamp of the time the KYC expires\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"ProposerKYC\",\"desc\":\"A Proposer KYC status update\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\"},{\"type\":\"bool\",\"name\":\"valid_kyc\"}]}],\"recommendations\":{}},{\"name\":\"declare_committee\",\"desc\":\"Sets the xGov Committee in charge.\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"committee_id\",\"desc\":\"The ID of the xGov Committee\"},{\"type\":\"uint64\",\"name\":\"size\",\"desc\":\"The size of the xGov Committee\"},{\"type\":\"uint64\",\"name\":\"votes\",\"desc\":\"The voting power of the xGov Committee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"NewCommittee\",\"desc\":\"A new xGov Committee has been elected\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"committee_id\"},{\"type\":\"uint32\",\"name\":\"size\"},{\"type\":\"uint32\",\"name\":\"votes\"}]}],\"recommendations\":{}},{\"name\":\"open_proposal\",\"desc\":\"Creates a new Proposal.\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"payment for covering the proposal fee (includes child contract MBR)\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"events\":[{\"name\":\"NewProposal\",\"desc\":\"A new Proposal has been opened\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\"},{\"type\":\"address\",\"name\":\"proposer\"}]}],\"recommendations\":{}},{\"name\":\"vote_proposal\",\"desc\":\"Votes on a Proposal.\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app being voted on\"},{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"(Account): The address of the xGov being voted on behalf of\"},{\"type\":\"uint64\",\"name\":\"approval_votes\",\"desc\":\"(UInt64): The number of approvals votes allocated\"},{\"type\":\"uint64\",\"name\":\"rejection_votes\",\"desc\":\"(UInt64): The number of rejections votes allocated\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"unassign_absentee_from_proposal\",\"desc\":\"Unassign absentees from a scrutinized Proposal.\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the scrutinized Proposal\"},{\"type\":\"address[]\",\"name\":\"absentees\",\"desc\":\"List of absentees to be unassigned\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"pay_grant_proposal\",\"desc\":\"Disburses the funds for an approved Proposal.\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the approved Proposal\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalize_proposal\",\"desc\":\"Finalize a Proposal.\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app to finalize\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"drop_proposal\",\"desc\":\"Drops a Proposal.\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app to drop\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"deposit_funds\",\"desc\":\"Deposits xGov program funds into the xGov Treasury (xGov Registry Account).\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"the deposit transaction\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"withdraw_funds\",\"desc\":\"Remove xGov program funds from the xGov Treasury (xGov Registry Account).\",\"args\":[{\"type\":\"uint64\",\"name\":\"amount\",\"desc\":\"the amount to remove\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"withdraw_balance\",\"desc\":\"Withdraw outstanding Algos, excluding MBR and outstanding funds, from the xGov Registry.\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"get_state\",\"desc\":\"Returns the xGov Registry state.\",\"args\":[],\"returns\":{\"type\":\"(bool,bool,address,address,address,address,address,address,address,uint64,uint64,uint64,uint64,uint64,uint64,uint64[3],uint64[4],uint64[4],uint64[3],uint64[3],uint64,uint64,byte[32],uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"TypedGlobalState\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"get_xgov_box\",\"desc\":\"Returns the xGov box for the given address.\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"}],\"returns\":{\"type\":\"((address,uint64,uint64,uint64),bool)\",\"desc\":\"The xGov box value bool: `True` if xGov box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"get_proposer_box\",\"desc\":\"Returns the Proposer box for the given address.\",\"args\":[{\"type\":\"address\",\"name\":\"proposer_address\",\"desc\":\"The address of the Proposer\"}],\"returns\":{\"type\":\"((bool,bool,uint64),bool)\",\"desc\":\"The Proposer box value bool: `True` if Proposer box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"get_request_box\",\"desc\":\"Returns the xGov subscribe request box for the given request ID.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the subscribe request\"}],\"returns\":{\"type\":\"((address,address,uint64),bool)\",\"desc\":\"The subscribe request box value bool: `True` if xGov subscribe request box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"get_request_unsubscribe_box\",\"desc\":\"Returns the xGov unsubscribe request box for the given unsubscribe request ID.\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request\"}],\"returns\":{\"type\":\"((address,address,uint64),bool)\",\"desc\":\"The unsubscribe request box value bool: `True` if xGov unsubscribe request box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"is_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"op_up\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"ProposerBoxValue\":[{\"name\":\"active_proposal\",\"type\":\"bool\"},{\"name\":\"kyc_status\",\"type\":\"bool\"},{\"name\":\"kyc_expiring\",\"type\":\"uint64\"}],\"TypedGlobalState\":[{\"name\":\"paused_registry\",\"type\":\"bool\"},{\"name\":\"paused_proposals\",\"type\":\"bool\"},{\"name\":\"xgov_manager\",\"type\":\"address\"},{\"name\":\"xgov_payor\",\"type\":\"address\"},{\"name\":\"xgov_council\",\"type\":\"address\"},{\"name\":\"xgov_subscriber\",\"type\":\"address\"},{\"name\":\"kyc_provider\",\"type\":\"address\"},{\"name\":\"committee_manager\",\"type\":\"address\"},{\"name\":\"xgov_daemon\",\"type\":\"address\"},{\"name\":\"xgov_fee\",\"type\":\"uint64\"},{\"name\":\"proposer_fee\",\"type\":\"uint64\"},{\"name\":\"open_proposal_fee\",\"type\":\"uint64\"},{\"name\":\"daemon_ops_funding_bps\",\"type\":\"uint64\"},{\"name\":\"proposal_commitment_bps\",\"type\":\"uint64\"},{\"name\":\"min_requested_amount\",\"type\":\"uint64\"},{\"name\":\"max_requested_amount\",\"type\":\"uint64[3]\"},{\"name\":\"discussion_duration\",\"type\":\"uint64[4]\"},{\"name\":\"voting_duration\",\"type\":\"uint64[4]\"},{\"name\":\"quorum\",\"type\":\"uint64[3]\"},{\"name\":\"weighted_quorum\",\"type\":\"uint64[3]\"},{\"name\":\"outstanding_funds\",\"type\":\"uint64\"},{\"name\":\"pending_proposals\",\"type\":\"uint64\"},{\"name\":\"committee_id\",\"type\":\"byte[32]\"},{\"name\":\"committee_members\",\"type\":\"uint64\"},{\"name\":\"committee_votes\",\"type\":\"uint64\"},{\"name\":\"absence_tolerance\",\"type\":\"uint64\"},{\"name\":\"governance_period\",\"type\":\"uint64\"},{\"name\":\"committee_grace_period\",\"type\":\"uint64\"},{\"name\":\"committee_last_anchor\",\"type\":\"uint64\"}],\"XGovBoxValue\":[{\"name\":\"voting_address\",\"type\":\"address\"},{\"name\":\"tolerated_absences\",\"type\":\"uint64\"},{\"name\":\"last_vote_timestamp\",\"type\":\"uint64\"},{\"name\":\"subscription_round\",\"type\":\"uint64\"}],\"XGovRegistryConfig\":[{\"name\":\"xgov_fee\",\"type\":\"uint64\"},{\"name\":\"proposer_fee\",\"type\":\"uint64\"},{\"name\":\"open_proposal_fee\",\"type\":\"uint64\"},{\"name\":\"daemon_ops_funding_bps\",\"type\":\"uint64\"},{\"name\":\"proposal_commitment_bps\",\"type\":\"uint64\"},{\"name\":\"min_requested_amount\",\"type\":\"uint64\"},{\"name\":\"max_requested_amount\",\"type\":\"uint64[3]\"},{\"name\":\"discussion_duration\",\"type\":\"uint64[4]\"},{\"name\":\"voting_duration\",\"type\":\"uint64[4]\"},{\"name\":\"quorum\",\"type\":\"uint64[3]\"},{\"name\":\"weighted_quorum\",\"type\":\"uint64[3]\"},{\"name\":\"absence_tolerance\",\"type\":\"uint64\"},{\"name\":\"governance_period\",\"type\":\"uint64\"},{\"name\":\"committee_grace_period\",\"type\":\"uint64\"}],\"XGovSubscribeRequestBoxValue\":[{\"name\":\"xgov_addr\",\"type\":\"address\"},{\"name\":\"owner_addr\",\"type\":\"address\"},{\"name\":\"relation_type\",\"type\":\"uint64\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":36,\"bytes\":28},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"absence_tolerance\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"YWJzZW5jZV90b2xlcmFuY2U=\"},\"committee_grace_period\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX2dyYWNlX3BlcmlvZA==\"},\"committee_id\":{\"keyType\":\"AVMBytes\",\"valueType\":\"byte[32]\",\"key\":\"Y29tbWl0dGVlX2lk\"},\"committee_last_anchor\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX2xhc3RfYW5jaG9y\"},\"committee_manager\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"Y29tbWl0dGVlX21hbmFnZXI=\"},\"committee_members\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX21lbWJlcnM=\"},\"committee_votes\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX3ZvdGVz\"},\"daemon_ops_funding_bps\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGFlbW9uX29wZXJhdGlvbl9mdW5kaW5nX2Jwcw==\"},\"discussion_duration_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZQ==\"},\"discussion_duration_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0=\"},\"discussion_duration_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbA==\"},\"discussion_duration_xlarge\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2U=\"},\"governance_period\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Z292ZXJuYW5jZV9wZXJpb2Q=\"},\"kyc_provider\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"a3ljX3Byb3ZpZGVy\"},\"max_committee_size\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X2NvbW1pdHRlZV9zaXpl\"},\"max_requested_amount_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfbGFyZ2U=\"},\"max_requested_amount_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfbWVkaXVt\"},\"max_requested_amount_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfc21hbGw=\"},\"min_requested_amount\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWluX3JlcXVlc3RlZF9hbW91bnQ=\"},\"open_proposal_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"b3Blbl9wcm9wb3NhbF9mZWU=\"},\"outstanding_funds\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"b3V0c3RhbmRpbmdfZnVuZHM=\"},\"paused_proposals\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGF1c2VkX3Byb3Bvc2Fscw==\"},\"paused_registry\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGF1c2VkX3JlZ2lzdHJ5\"},\"pending_proposals\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGVuZGluZ19wcm9wb3NhbHM=\"},\"proposal_commitment_bps\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfY29tbWl0bWVudF9icHM=\"},\"proposer_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zZXJfZmVl\"},\"quorum_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX2xhcmdl\"},\"quorum_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX21lZGl1bQ==\"},\"quorum_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX3NtYWxs\"},\"request_id\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cmVxdWVzdF9pZA==\"},\"voting_duration_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX2xhcmdl\"},\"voting_duration_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX21lZGl1bQ==\"},\"voting_duration_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX3NtYWxs\"},\"voting_duration_xlarge\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX3hsYXJnZQ==\"},\"weighted_quorum_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX2xhcmdl\"},\"weighted_quorum_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX21lZGl1bQ==\"},\"weighted_quorum_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX3NtYWxs\"},\"xgov_council\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9jb3VuY2ls\"},\"xgov_daemon\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9kYWVtb24=\"},\"xgov_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"eGdvdl9mZWU=\"},\"xgov_manager\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9tYW5hZ2Vy\"},\"xgov_payor\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9wYXlvcg==\"},\"xgov_subscriber\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9zdWJzY3JpYmVy\"},\"xgovs\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"eGdvdnM=\"}},\"local\":{},\"box\":{\"proposal_approval_program\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMBytes\",\"key\":\"cGE=\"}}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"proposer_box\":{\"keyType\":\"address\",\"valueType\":\"ProposerBoxValue\",\"prefix\":\"cA==\"},\"request_box\":{\"keyType\":\"uint64\",\"valueType\":\"XGovSubscribeRequestBoxValue\",\"prefix\":\"cg==\"},\"request_unsubscribe_box\":{\"keyType\":\"uint64\",\"valueType\":\"XGovSubscribeRequestBoxValue\",\"prefix\":\"cnU=\"},\"voters\":{\"keyType\":\"address\",\"valueType\":\"uint64\",\"prefix\":\"Vg==\"},\"xgov_box\":{\"keyType\":\"address\",\"valueType\":\"XGovBoxValue\",\"prefix\":\"eA==\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBhbGdvcHkuYXJjNC5BUkM0Q29udHJhY3QuYXBwcm92YWxfcHJvZ3JhbSgpIC0+IHVpbnQ2NDoKbWFpbjoKICAgIGludGNibG9jayAwIDEgMzIgOCAyMDQ4CiAgICBieXRlY2Jsb2NrIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5IDB4Nzg2NzZmNzY3MyAweDc4IDB4MTUxZjdjNzUgMHg3MCAweDZmNzU3NDczNzQ2MTZlNjQ2OTZlNjc1ZjY2NzU2ZTY0NzMgMHg3MDYxIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyIDB4MDAgMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczIDB4NzA2MTc1NzM2NTY0NWY3MDcyNmY3MDZmNzM2MTZjNzMgMHg3MjY1NzE3NTY1NzM3NDVmNjk2NCAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIgIkVSUjoiIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyIDB4Nzg2NzZmNzY1ZjcwNjE3OTZmNzIgMHg2Yjc5NjM1ZjcwNzI2Zjc2Njk2NDY1NzIgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZkNjE2ZTYxNjc2NTcyIDB4Nzg2NzZmNzY1ZjY0NjE2NTZkNmY2ZSAweDc4Njc2Zjc2NWY2NjY1NjUgMHg3MDcyNmY3MDZmNzM2NTcyNWY2NjY1NjUgMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1IDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NSAweDcyIDB4NzI3NSAweDc4Njc2Zjc2NWY2MzZmNzU2ZTYzNjk2YyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczIDB4NzA3MjZmNzA2ZjczNjE2YzVmNjM2ZjZkNmQ2OTc0NmQ2NTZlNzQ1ZjYyNzA3MyAweDZkNjk2ZTVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQgMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY3MzZkNjE2YzZjIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmQ2NTY0Njk3NTZkIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NSAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY3MzZkNjE2YzZjIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZCAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1IDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NSAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1IDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY3ODZjNjE3MjY3NjUgMHg3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMgMHg3MTc1NmY3Mjc1NmQ1ZjZkNjU2NDY5NzU2ZCAweDcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NSAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1IDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDY1NmQ2MjY1NzI3MyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MyAweDZkNjE3ODVmNjM2ZjZkNmQ2OTc0NzQ2NTY1NWY3MzY5N2E2NSAweDY3NmY3NjY1NzI2ZTYxNmU2MzY1NWY3MDY1NzI2OTZmNjQgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NCAweDUxMDk5YWIwIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2YzYxNzM3NDVmNjE2ZTYzNjg2ZjcyIDB4YjEzMjQ4NjAgMHgwMDAwMDAwMDAwMDAwMDAwMDAgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY5NjQgIlZvdGVyIG5vdCBmb3VuZCIgYmFzZTMyKEFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBKSBUTVBMX2VudHJvcHkKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU3LTU4CiAgICAvLyAjIFByZWNvbmRpdGlvbnMKICAgIC8vIGFzc2VydCBUeG4uZ2xvYmFsX251bV9ieXRlX3NsaWNlID09IGNmZy5HTE9CQUxfQllURVMsIGVyci5XUk9OR19HTE9CQUxfQllURVMKICAgIHR4biBHbG9iYWxOdW1CeXRlU2xpY2UKICAgIHB1c2hpbnQgMjgKICAgID09CiAgICBhc3NlcnQgLy8gV3JvbmcgR2xvYmFsIEJ5dGVzIGFsbG9jYXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU5CiAgICAvLyBhc3NlcnQgVHhuLmdsb2JhbF9udW1fdWludCA9PSBjZmcuR0xPQkFMX1VJTlRTLCBlcnIuV1JPTkdfR0xPQkFMX1VJTlRTCiAgICB0eG4gR2xvYmFsTnVtVWludAogICAgcHVzaGludCAzNgogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBHbG9iYWwgVUludHMgYWxsb2NhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjAKICAgIC8vIGFzc2VydCBUeG4ubG9jYWxfbnVtX2J5dGVfc2xpY2UgPT0gY2ZnLkxPQ0FMX0JZVEVTLCBlcnIuV1JPTkdfTE9DQUxfQllURVMKICAgIHR4biBMb2NhbE51bUJ5dGVTbGljZQogICAgIQogICAgYXNzZXJ0IC8vIFdyb25nIExvY2FsIEJ5dGVzIGFsbG9jYXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxCiAgICAvLyBhc3NlcnQgVHhuLmxvY2FsX251bV91aW50ID09IGNmZy5MT0NBTF9VSU5UUywgZXJyLldST05HX0xPQ0FMX1VJTlRTCiAgICB0eG4gTG9jYWxOdW1VaW50CiAgICAhCiAgICBhc3NlcnQgLy8gV3JvbmcgTG9jYWwgVUludHMgYWxsb2NhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjMtNjQKICAgIC8vICMgUm9sZS1CYXNlZCBBY2Nlc3MgQ29udHJvbCAoUkJBQykKICAgIC8vIHNlbGYueGdvdl9tYW5hZ2VyID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX01BTkFHRVIpCiAgICBieXRlYyA3IC8vIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NQogICAgLy8gc2VsZi54Z292X3N1YnNjcmliZXIgPSBHbG9iYWxTdGF0ZShBY2NvdW50KCksIGtleT1jZmcuR1NfS0VZX1hHT1ZfU1VCU0NSSUJFUikKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NgogICAgLy8gc2VsZi54Z292X3BheW9yID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX1BBWU9SKQogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjcKICAgIC8vIHNlbGYueGdvdl9jb3VuY2lsID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX0NPVU5DSUwpCiAgICBieXRlYyAyNSAvLyAweDc4Njc2Zjc2NWY2MzZmNzU2ZTYzNjk2YwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgKICAgIC8vIHNlbGYua3ljX3Byb3ZpZGVyID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9LWUNfUFJPVklERVIpCiAgICBieXRlYyAxNiAvLyAweDZiNzk2MzVmNzA3MjZmNzY2OTY0NjU3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzAKICAgIC8vIEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01BTkFHRVIKICAgIGJ5dGVjIDE3IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDYxNmU2MTY3NjU3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2OS03MQogICAgLy8gc2VsZi5jb21taXR0ZWVfbWFuYWdlciA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01BTkFHRVIKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MgogICAgLy8gc2VsZi54Z292X2RhZW1vbiA9IEdsb2JhbFN0YXRlKEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfWEdPVl9EQUVNT04pCiAgICBieXRlYyAxOCAvLyAweDc4Njc2Zjc2NWY2NDYxNjU2ZDZmNmUKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc2CiAgICAvLyBGYWxzZSwga2V5PWNmZy5HU19LRVlfUEFVU0VEX1JFR0lTVFJZICAjIG5vcWE6IEZCVDAwMwogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc0LTc3CiAgICAvLyAjIFJlZ2lzdHJ5IENvbnRyb2wgU3RhdGVzCiAgICAvLyBzZWxmLnBhdXNlZF9yZWdpc3RyeSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIEZhbHNlLCBrZXk9Y2ZnLkdTX0tFWV9QQVVTRURfUkVHSVNUUlkgICMgbm9xYTogRkJUMDAzCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzkKICAgIC8vIEZhbHNlLCBrZXk9Y2ZnLkdTX0tFWV9QQVVTRURfUFJPUE9TQUxTICAjIG5vcWE6IEZCVDAwMwogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc4LTgwCiAgICAvLyBzZWxmLnBhdXNlZF9wcm9wb3NhbHMgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBGYWxzZSwga2V5PWNmZy5HU19LRVlfUEFVU0VEX1BST1BPU0FMUyAgIyBub3FhOiBGQlQwMDMKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4Mi04MwogICAgLy8gIyB4R292IFRyZWFzdXJ5CiAgICAvLyBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX09VVFNUQU5ESU5HX0ZVTkRTKQogICAgYnl0ZWMgNSAvLyAweDZmNzU3NDczNzQ2MTZlNjQ2OTZlNjc1ZjY2NzU2ZTY0NzMKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODUtODYKICAgIC8vICMgRmVlcwogICAgLy8gc2VsZi54Z292X2ZlZSA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX0ZFRSkKICAgIGJ5dGVjIDE5IC8vIDB4Nzg2NzZmNzY1ZjY2NjU2NQogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NwogICAgLy8gc2VsZi5wcm9wb3Nlcl9mZWUgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfUFJPUE9TRVJfRkVFKQogICAgYnl0ZWMgMjAgLy8gMHg3MDcyNmY3MDZmNzM2NTcyNWY2NjY1NjUKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODgKICAgIC8vIHNlbGYub3Blbl9wcm9wb3NhbF9mZWUgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfT1BFTl9QUk9QT1NBTF9GRUUpCiAgICBieXRlYyAyMSAvLyAweDZmNzA2NTZlNWY3MDcyNmY3MDZmNzM2MTZjNWY2NjY1NjUKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9EQUVNT05fT1BTX0ZVTkRJTkdfQlBTCiAgICBieXRlYyAyNiAvLyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODktOTEKICAgIC8vIHNlbGYuZGFlbW9uX29wc19mdW5kaW5nX2JwcyA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9EQUVNT05fT1BTX0ZVTkRJTkdfQlBTCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTMKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9QUk9QT1NBTF9DT01NSVRNRU5UX0JQUwogICAgYnl0ZWMgMjcgLy8gMHg3MDcyNmY3MDZmNzM2MTZjNWY2MzZmNmQ2ZDY5NzQ2ZDY1NmU3NDVmNjI3MDczCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTItOTQKICAgIC8vIHNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfUFJPUE9TQUxfQ09NTUlUTUVOVF9CUFMKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5OAogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01JTl9SRVFVRVNURURfQU1PVU5UCiAgICBieXRlYyAyOCAvLyAweDZkNjk2ZTVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5Ni05OQogICAgLy8gIyBSZXF1ZXN0ZWQgQW1vdW50IExpbWl0cwogICAgLy8gc2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9NSU5fUkVRVUVTVEVEX0FNT1VOVAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX1NNQUxMCiAgICBieXRlYyAyOSAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDEtMTAzCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX1NNQUxMCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfTUFYX1JFUVVFU1RFRF9BTU9VTlRfTUVESVVNCiAgICBieXRlYyAzMCAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZkNjU2NDY5NzU2ZAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNC0xMDYKICAgIC8vIHNlbGYubWF4X3JlcXVlc3RlZF9hbW91bnRfbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX01FRElVTQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwOAogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX0xBUkdFCiAgICBieXRlYyAzMSAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZjNjE3MjY3NjUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDctMTA5CiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX0xBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzCiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9TTUFMTAogICAgYnl0ZWMgMzIgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExMS0xMTQKICAgIC8vICMgVGltZSBMaW1pdHMKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1NNQUxMCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9NRURJVU0KICAgIGJ5dGVjIDMzIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNS0xMTcKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0gPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9NRURJVU0KICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTkKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX0xBUkdFCiAgICBieXRlYyAzNCAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4LTEyMAogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX0RJU0NVU1NJT05fRFVSQVRJT05fTEFSR0UKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjIKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1hMQVJHRQogICAgYnl0ZWMgMzUgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzg2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIxLTEyMwogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3hsYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1hMQVJHRQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNQogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1ZPVElOR19EVVJBVElPTl9TTUFMTAogICAgYnl0ZWMgMzYgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjQtMTI2CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fU01BTEwKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjgKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fTUVESVVNCiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3LTEyOQogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1ZPVElOR19EVVJBVElPTl9NRURJVU0KICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzEKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fTEFSR0UKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwLTEzMgogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fbGFyZ2UgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfVk9USU5HX0RVUkFUSU9OX0xBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM0CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfVk9USU5HX0RVUkFUSU9OX1hMQVJHRQogICAgYnl0ZWMgMzkgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMy0xMzUKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX3hsYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fWExBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3LTEzOAogICAgLy8gIyBRdW9ydW1zCiAgICAvLyBzZWxmLnF1b3J1bV9zbWFsbCA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9RVU9SVU1fU01BTEwpCiAgICBieXRlYyA0MCAvLyAweDcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YwogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9RVU9SVU1fTUVESVVNCiAgICBieXRlYyA0MSAvLyAweDcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM5LTE0MQogICAgLy8gc2VsZi5xdW9ydW1fbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1FVT1JVTV9NRURJVU0KICAgIC8vICkgICMgTm8gbG9uZ2VyIHVzZWQKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDIKICAgIC8vIHNlbGYucXVvcnVtX2xhcmdlID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1FVT1JVTV9MQVJHRSkKICAgIGJ5dGVjIDQyIC8vIDB4NzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9TTUFMTAogICAgYnl0ZWMgNDMgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQtMTQ3CiAgICAvLyAjIFdlaWdodGVkIFF1b3J1bXMKICAgIC8vIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX3NtYWxsID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9TTUFMTAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OQogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9NRURJVU0gICMgTm8gbG9uZ2VyIHVzZWQKICAgIGJ5dGVjIDQ0IC8vIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDgtMTUwCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0gPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfV0VJR0hURURfUVVPUlVNX01FRElVTSAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9MQVJHRQogICAgYnl0ZWMgNDUgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjZjNjE3MjY3NjUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTEtMTUzCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9XRUlHSFRFRF9RVU9SVU1fTEFSR0UKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTcKICAgIC8vIHNlbGYuY29tbWl0dGVlX21lbWJlcnMgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01FTUJFUlMpCiAgICBieXRlYyA0NiAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2NTZkNjI2NTcyNzMKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU4CiAgICAvLyBzZWxmLmNvbW1pdHRlZV92b3RlcyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9DT01NSVRURUVfVk9URVMpCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9NQVhfQ09NTUlUVEVFX1NJWkUKICAgIGJ5dGVjIDQ4IC8vIDB4NmQ2MTc4NWY2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjczNjk3YTY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5LTE2MQogICAgLy8gc2VsZi5tYXhfY29tbWl0dGVlX3NpemUgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfTUFYX0NPTU1JVFRFRV9TSVpFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYzLTE2NAogICAgLy8gIyBDb3VudGVycwogICAgLy8gc2VsZi54Z292cyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WUykKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NQogICAgLy8gc2VsZi5wZW5kaW5nX3Byb3Bvc2FscyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9QRU5ESU5HX1BST1BPU0FMUykKICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NgogICAgLy8gc2VsZi5yZXF1ZXN0X2lkID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1JFUVVFU1RfSUQpCiAgICBieXRlYyAxMSAvLyAweDcyNjU3MTc1NjU3Mzc0NWY2OTY0CiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKCm1haW5fYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDUtNTMKICAgIC8vIGNsYXNzIFhHb3ZSZWdpc3RyeSgKICAgIC8vICAgICBYR292UmVnaXN0cnlJbnRlcmZhY2UsCiAgICAvLyAgICAgc3RhdGVfdG90YWxzPVN0YXRlVG90YWxzKAogICAgLy8gICAgICAgICBnbG9iYWxfYnl0ZXM9Y2ZnLkdMT0JBTF9CWVRFUywKICAgIC8vICAgICAgICAgZ2xvYmFsX3VpbnRzPWNmZy5HTE9CQUxfVUlOVFMsCiAgICAvLyAgICAgICAgIGxvY2FsX2J5dGVzPWNmZy5MT0NBTF9CWVRFUywKICAgIC8vICAgICAgICAgbG9jYWxfdWludHM9Y2ZnLkxPQ0FMX1VJTlRTLAogICAgLy8gICAgICksCiAgICAvLyApOgogICAgcHVzaGJ5dGVzIDB4NDk1NmMxYWIgLy8gbWV0aG9kICJ1cGRhdGVfeGdvdl9yZWdpc3RyeSgpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5fdXBkYXRlX3hnb3ZfcmVnaXN0cnlfcm91dGVANAoKbWFpbl9zd2l0Y2hfY2FzZV9uZXh0QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NS01MwogICAgLy8gY2xhc3MgWEdvdlJlZ2lzdHJ5KAogICAgLy8gICAgIFhHb3ZSZWdpc3RyeUludGVyZmFjZSwKICAgIC8vICAgICBzdGF0ZV90b3RhbHM9U3RhdGVUb3RhbHMoCiAgICAvLyAgICAgICAgIGdsb2JhbF9ieXRlcz1jZmcuR0xPQkFMX0JZVEVTLAogICAgLy8gICAgICAgICBnbG9iYWxfdWludHM9Y2ZnLkdMT0JBTF9VSU5UUywKICAgIC8vICAgICAgICAgbG9jYWxfYnl0ZXM9Y2ZnLkxPQ0FMX0JZVEVTLAogICAgLy8gICAgICAgICBsb2NhbF91aW50cz1jZmcuTE9DQUxfVUlOVFMsCiAgICAvLyAgICAgKSwKICAgIC8vICk6CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDUyCiAgICBwdXNoYnl0ZXNzIDB4OTU5YzQ3NjIgMHg2YzFmNTY0ZCAweGUxMGE1MTJlIDB4ODZmN2UwZTYgMHgzOWUyNmQ4YSAweDk4MzUyZTg2IDB4ZmE0ZWQ2ZTIgMHhkNmM5Y2MxYSAweDVjNDg0MDU1IDB4MzdkNmFkZjEgMHhkNDIxNmI2ZSAweGJhODUyMGYyIDB4MTY3N2IzMGUgMHg4NGI3ZDI2OCAweGFmN2YxODYwIDB4YTA4MmNlZjggMHhkZjM5ZmRiOSAweGNhMGY2YTNhIDB4NDUwNzczOTAgMHgzYzMxYmMwMiAweDBkMmM3ODkxIDB4OTNmYWNkYmEgMHhjZThiM2ExYyAweGZhZWEwODFmIDB4MGRhMjc4ODUgMHg3YTRmZWU0MyAweDUyZGQxMGQ3IDB4ZDRkMzdhNjQgMHgzNDM0OWRjYyAweDE1OGY4ZGQ2IDB4NWZlMjU5MzUgMHhkYjI3YjlhZiAweGY1OTEwNzU2IDB4NjU2MTBhOWYgMHhmZGM2OTVjMiAweGJhOTBhYjU0IDB4YjNiNTg0ODIgMHg2YWU1ZWI0NiAweDI3NjMwZDY1IDB4ODI0Zjk4YmMgMHg0OTU0OGJhMCAweDgyNjc4NGY2IDB4MjY5ODMyMDAgMHhkYmQ4M2RkOSAvLyBtZXRob2QgImluaXRfcHJvcG9zYWxfY29udHJhY3QodWludDY0KXZvaWQiLCBtZXRob2QgImxvYWRfcHJvcG9zYWxfY29udHJhY3QodWludDY0LGJ5dGVbXSl2b2lkIiwgbWV0aG9kICJkZWxldGVfcHJvcG9zYWxfY29udHJhY3RfYm94KCl2b2lkIiwgbWV0aG9kICJwYXVzZV9yZWdpc3RyeSgpdm9pZCIsIG1ldGhvZCAicGF1c2VfcHJvcG9zYWxzKCl2b2lkIiwgbWV0aG9kICJyZXN1bWVfcmVnaXN0cnkoKXZvaWQiLCBtZXRob2QgInJlc3VtZV9wcm9wb3NhbHMoKXZvaWQiLCBtZXRob2QgInNldF94Z292X21hbmFnZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfcGF5b3IoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfeGdvdl9jb3VuY2lsKGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAic2V0X3hnb3Zfc3Vic2NyaWJlcihhZGRyZXNzKXZvaWQiLCBtZXRob2QgInNldF9reWNfcHJvdmlkZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfY29tbWl0dGVlX21hbmFnZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfeGdvdl9kYWVtb24oYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJjb25maWdfeGdvdl9yZWdpc3RyeSgodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0WzNdLHVpbnQ2NFs0XSx1aW50NjRbNF0sdWludDY0WzNdLHVpbnQ2NFszXSx1aW50NjQsdWludDY0LHVpbnQ2NCkpdm9pZCIsIG1ldGhvZCAic3Vic2NyaWJlX3hnb3YoYWRkcmVzcyxwYXkpdm9pZCIsIG1ldGhvZCAidW5zdWJzY3JpYmVfeGdvdigpdm9pZCIsIG1ldGhvZCAidW5zdWJzY3JpYmVfYWJzZW50ZWUoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJyZXF1ZXN0X3N1YnNjcmliZV94Z292KGFkZHJlc3MsYWRkcmVzcyx1aW50NjQscGF5KXZvaWQiLCBtZXRob2QgImFwcHJvdmVfc3Vic2NyaWJlX3hnb3YodWludDY0KXZvaWQiLCBtZXRob2QgInJlamVjdF9zdWJzY3JpYmVfeGdvdih1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVxdWVzdF91bnN1YnNjcmliZV94Z292KGFkZHJlc3MsYWRkcmVzcyx1aW50NjQscGF5KXZvaWQiLCBtZXRob2QgImFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdih1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3YodWludDY0KXZvaWQiLCBtZXRob2QgInNldF92b3RpbmdfYWNjb3VudChhZGRyZXNzLGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAic3Vic2NyaWJlX3Byb3Bvc2VyKHBheSl2b2lkIiwgbWV0aG9kICJzZXRfcHJvcG9zZXJfa3ljKGFkZHJlc3MsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVjbGFyZV9jb21taXR0ZWUoYnl0ZVszMl0sdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcGVuX3Byb3Bvc2FsKHBheSl1aW50NjQiLCBtZXRob2QgInZvdGVfcHJvcG9zYWwodWludDY0LGFkZHJlc3MsdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJ1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsKHVpbnQ2NCxhZGRyZXNzW10pdm9pZCIsIG1ldGhvZCAicGF5X2dyYW50X3Byb3Bvc2FsKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZV9wcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZHJvcF9wcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVwb3NpdF9mdW5kcyhwYXkpdm9pZCIsIG1ldGhvZCAid2l0aGRyYXdfZnVuZHModWludDY0KXZvaWQiLCBtZXRob2QgIndpdGhkcmF3X2JhbGFuY2UoKXZvaWQiLCBtZXRob2QgImdldF9zdGF0ZSgpKGJvb2wsYm9vbCxhZGRyZXNzLGFkZHJlc3MsYWRkcmVzcyxhZGRyZXNzLGFkZHJlc3MsYWRkcmVzcyxhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NFszXSx1aW50NjRbNF0sdWludDY0WzRdLHVpbnQ2NFszXSx1aW50NjRbM10sdWludDY0LHVpbnQ2NCxieXRlWzMyXSx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiLCBtZXRob2QgImdldF94Z292X2JveChhZGRyZXNzKSgoYWRkcmVzcyx1aW50NjQsdWludDY0LHVpbnQ2NCksYm9vbCkiLCBtZXRob2QgImdldF9wcm9wb3Nlcl9ib3goYWRkcmVzcykoKGJvb2wsYm9vbCx1aW50NjQpLGJvb2wpIiwgbWV0aG9kICJnZXRfcmVxdWVzdF9ib3godWludDY0KSgoYWRkcmVzcyxhZGRyZXNzLHVpbnQ2NCksYm9vbCkiLCBtZXRob2QgImdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCh1aW50NjQpKChhZGRyZXNzLGFkZHJlc3MsdWludDY0KSxib29sKSIsIG1ldGhvZCAiaXNfcHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgIm9wX3VwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggaW5pdF9wcm9wb3NhbF9jb250cmFjdCBsb2FkX3Byb3Bvc2FsX2NvbnRyYWN0IGRlbGV0ZV9wcm9wb3NhbF9jb250cmFjdF9ib3ggcGF1c2VfcmVnaXN0cnkgcGF1c2VfcHJvcG9zYWxzIHJlc3VtZV9yZWdpc3RyeSByZXN1bWVfcHJvcG9zYWxzIHNldF94Z292X21hbmFnZXIgc2V0X3BheW9yIHNldF94Z292X2NvdW5jaWwgc2V0X3hnb3Zfc3Vic2NyaWJlciBzZXRfa3ljX3Byb3ZpZGVyIHNldF9jb21taXR0ZWVfbWFuYWdlciBzZXRfeGdvdl9kYWVtb24gY29uZmlnX3hnb3ZfcmVnaXN0cnkgc3Vic2NyaWJlX3hnb3YgdW5zdWJzY3JpYmVfeGdvdiB1bnN1YnNjcmliZV9hYnNlbnRlZSByZXF1ZXN0X3N1YnNjcmliZV94Z292IGFwcHJvdmVfc3Vic2NyaWJlX3hnb3YgcmVqZWN0X3N1YnNjcmliZV94Z292IHJlcXVlc3RfdW5zdWJzY3JpYmVfeGdvdiBhcHByb3ZlX3Vuc3Vic2NyaWJlX3hnb3YgcmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3Ygc2V0X3ZvdGluZ19hY2NvdW50IHN1YnNjcmliZV9wcm9wb3NlciBzZXRfcHJvcG9zZXJfa3ljIGRlY2xhcmVfY29tbWl0dGVlIG9wZW5fcHJvcG9zYWwgdm90ZV9wcm9wb3NhbCB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsIHBheV9ncmFudF9wcm9wb3NhbCBmaW5hbGl6ZV9wcm9wb3NhbCBkcm9wX3Byb3Bvc2FsIGRlcG9zaXRfZnVuZHMgd2l0aGRyYXdfZnVuZHMgd2l0aGRyYXdfYmFsYW5jZSBnZXRfc3RhdGUgZ2V0X3hnb3ZfYm94IGdldF9wcm9wb3Nlcl9ib3ggZ2V0X3JlcXVlc3RfYm94IGdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBpc19wcm9wb3NhbCBtYWluX29wX3VwX3JvdXRlQDUwCiAgICBlcnIKCm1haW5fb3BfdXBfcm91dGVANTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNzA5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEA1MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1LTUzCiAgICAvLyBjbGFzcyBYR292UmVnaXN0cnkoCiAgICAvLyAgICAgWEdvdlJlZ2lzdHJ5SW50ZXJmYWNlLAogICAgLy8gICAgIHN0YXRlX3RvdGFscz1TdGF0ZVRvdGFscygKICAgIC8vICAgICAgICAgZ2xvYmFsX2J5dGVzPWNmZy5HTE9CQUxfQllURVMsCiAgICAvLyAgICAgICAgIGdsb2JhbF91aW50cz1jZmcuR0xPQkFMX1VJTlRTLAogICAgLy8gICAgICAgICBsb2NhbF9ieXRlcz1jZmcuTE9DQUxfQllURVMsCiAgICAvLyAgICAgICAgIGxvY2FsX3VpbnRzPWNmZy5MT0NBTF9VSU5UUywKICAgIC8vICAgICApLAogICAgLy8gKToKICAgIHB1c2hieXRlcyAweDRjNWM2MWJhIC8vIG1ldGhvZCAiY3JlYXRlKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggY3JlYXRlCiAgICBlcnIKCm1haW5fdXBkYXRlX3hnb3ZfcmVnaXN0cnlfcm91dGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5NQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKGFsbG93X2FjdGlvbnM9WyJVcGRhdGVBcHBsaWNhdGlvbiJdKQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQKICAgIGIgdXBkYXRlX3hnb3ZfcmVnaXN0cnkKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzc2CiAgICAvLyBzZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSA9IFR4bi5zZW5kZXIKICAgIGJ5dGVjIDcgLy8gMHg3ODY3NmY3NjVmNmQ2MTZlNjE2NzY1NzIKICAgIHR4biBTZW5kZXIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTIKICAgIC8vIHJldHVybiBUZW1wbGF0ZVZhcltCeXRlc10oImVudHJvcHkiKSAgIyB0cmljayB0byBhbGxvdyBmcmVzaCBkZXBsb3ltZW50CiAgICBieXRlYyA1OCAvLyBUTVBMX2VudHJvcHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM3NwogICAgLy8gYXNzZXJ0IHNlbGYuZW50cm9weSgpID09IFRlbXBsYXRlVmFyW0J5dGVzXSgiZW50cm9weSIpCiAgICBkdXAKICAgID09CiAgICBhc3NlcnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM3MAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKGNyZWF0ZT0icmVxdWlyZSIpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmluaXRfcHJvcG9zYWxfY29udHJhY3Rbcm91dGluZ10oKSAtPiB2b2lkOgppbml0X3Byb3Bvc2FsX2NvbnRyYWN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzc5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzkxCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM5MwogICAgLy8gaWYgc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtOgogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogaW5pdF9wcm9wb3NhbF9jb250cmFjdF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzk0CiAgICAvLyBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0ucmVzaXplKHNpemUpCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgc3dhcAogICAgYm94X3Jlc2l6ZQoKaW5pdF9wcm9wb3NhbF9jb250cmFjdF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgppbml0X3Byb3Bvc2FsX2NvbnRyYWN0X2Vsc2VfYm9keUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzk2LTM5NwogICAgLy8gIyBJbml0aWFsaXplIHRoZSBQcm9wb3NhbCBBcHByb3ZhbCBQcm9ncmFtIGNvbnRyYWN0CiAgICAvLyBfY3JlYXRlZCA9IHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5jcmVhdGUoc2l6ZT1zaXplKQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIHN3YXAKICAgIGJveF9jcmVhdGUKICAgIHBvcAogICAgYiBpbml0X3Byb3Bvc2FsX2NvbnRyYWN0X2FmdGVyX2lmX2Vsc2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5sb2FkX3Byb3Bvc2FsX2NvbnRyYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKbG9hZF9wcm9wb3NhbF9jb250cmFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM5OQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2IC8vIG9uIGVycm9yOiBpbnZhbGlkIGFycmF5IGxlbmd0aCBoZWFkZXIKICAgIHB1c2hpbnQgMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5keW5hbWljX2FycmF5PGFyYzQudWludDg+CiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDEyCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQxNC00MTUKICAgIC8vICMgTG9hZCB0aGUgUHJvcG9zYWwgQXBwcm92YWwgUHJvZ3JhbSBjb250cmFjdAogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLnJlcGxhY2Uoc3RhcnRfaW5kZXg9b2Zmc2V0LCB2YWx1ZT1kYXRhKQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozOTkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmRlbGV0ZV9wcm9wb3NhbF9jb250cmFjdF9ib3hbcm91dGluZ10oKSAtPiB2b2lkOgpkZWxldGVfcHJvcG9zYWxfY29udHJhY3RfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDI2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQyOC00MjkKICAgIC8vICMgRGVsZXRlIHRoZSBQcm9wb3NhbCBBcHByb3ZhbCBQcm9ncmFtIGNvbnRyYWN0IGJveAogICAgLy8gZGVsIHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS52YWx1ZQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDE3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5wYXVzZV9yZWdpc3RyeVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnBhdXNlX3JlZ2lzdHJ5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDM3CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQzOAogICAgLy8gc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUgPSBUcnVlCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBpbnRjXzEgLy8gMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQzMQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucGF1c2VfcHJvcG9zYWxzW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF1c2VfcHJvcG9zYWxzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDQ2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ0NwogICAgLy8gc2VsZi5wYXVzZWRfcHJvcG9zYWxzLnZhbHVlID0gVHJ1ZQogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgaW50Y18xIC8vIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NDAKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnJlc3VtZV9yZWdpc3RyeVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJlc3VtZV9yZWdpc3RyeToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1NQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NTYKICAgIC8vIHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlID0gRmFsc2UKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDQ5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5yZXN1bWVfcHJvcG9zYWxzW3JvdXRpbmddKCkgLT4gdm9pZDoKcmVzdW1lX3Byb3Bvc2FsczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ2NAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NjUKICAgIC8vIHNlbGYucGF1c2VkX3Byb3Bvc2Fscy52YWx1ZSA9IEZhbHNlCiAgICBieXRlYyAxMCAvLyAweDcwNjE3NTczNjU2NDVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfbWFuYWdlcltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF94Z292X21hbmFnZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NjcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ3OQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0ODAKICAgIC8vIHNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlID0gbWFuYWdlcgogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ2NwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3BheW9yW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3BheW9yOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDgyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDk1CiAgICAvLyBzZWxmLnhnb3ZfcGF5b3IudmFsdWUgPSBwYXlvcgogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ4MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfY291bmNpbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF94Z292X2NvdW5jaWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjUwOQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MTAKICAgIC8vIHNlbGYueGdvdl9jb3VuY2lsLnZhbHVlID0gY291bmNpbAogICAgYnl0ZWMgMjUgLy8gMHg3ODY3NmY3NjVmNjM2Zjc1NmU2MzY5NmMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF94Z292X3N1YnNjcmliZXJbcm91dGluZ10oKSAtPiB2b2lkOgpzZXRfeGdvdl9zdWJzY3JpYmVyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTEyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MjQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTI1CiAgICAvLyBzZWxmLnhnb3Zfc3Vic2NyaWJlci52YWx1ZSA9IHN1YnNjcmliZXIKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTEyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5zZXRfa3ljX3Byb3ZpZGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X2t5Y19wcm92aWRlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjUyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTM5CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MAogICAgLy8gc2VsZi5reWNfcHJvdmlkZXIudmFsdWUgPSBwcm92aWRlcgogICAgYnl0ZWMgMTYgLy8gMHg2Yjc5NjM1ZjcwNzI2Zjc2Njk2NDY1NzIKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MjcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF9jb21taXR0ZWVfbWFuYWdlcltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF9jb21taXR0ZWVfbWFuYWdlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTU0CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU1NQogICAgLy8gc2VsZi5jb21taXR0ZWVfbWFuYWdlci52YWx1ZSA9IG1hbmFnZXIKICAgIGJ5dGVjIDE3IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDYxNmU2MTY3NjU3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfZGFlbW9uW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3hnb3ZfZGFlbW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTU3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1NjkKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTcwCiAgICAvLyBzZWxmLnhnb3ZfZGFlbW9uLnZhbHVlID0geGdvdl9kYWVtb24KICAgIGJ5dGVjIDE4IC8vIDB4Nzg2NzZmNzY1ZjY0NjE2NTZkNmY2ZQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU1NwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuY29uZmlnX3hnb3ZfcmVnaXN0cnlbcm91dGluZ10oKSAtPiB2b2lkOgpjb25maWdfeGdvdl9yZWdpc3RyeToKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDQKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTcyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBsZW4KICAgIHB1c2hpbnQgMjA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBzbWFydF9jb250cmFjdHMuY29tbW9uLmFiaV90eXBlcy5YR292UmVnaXN0cnlDb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU4NAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTMKICAgIC8vIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI4My0yODUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgICAga2V5X3ByZWZpeF9sZW5ndGggKyBrZXlfdHlwZV9zaXplICsgdmFsdWVfdHlwZV9zaXplCiAgICAvLyApICogUEVSX0JZVEVfSU5fQk9YX01CUiArIFBFUl9CT1hfTUJSCiAgICBwdXNoaW50IDM4MTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTMKICAgIC8vIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgPj0KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VANAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyODMtMjg1CiAgICAvLyByZXR1cm4gKAogICAgLy8gICAgIGtleV9wcmVmaXhfbGVuZ3RoICsga2V5X3R5cGVfc2l6ZSArIHZhbHVlX3R5cGVfc2l6ZQogICAgLy8gKSAqIFBFUl9CWVRFX0lOX0JPWF9NQlIgKyBQRVJfQk9YX01CUgogICAgcHVzaGludCAzNDkwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjEzCiAgICAvLyBjb25maWcueGdvdl9mZWUgPj0geGdvdl9ib3hfbWJyIGFuZCBjb25maWcueGdvdl9mZWUgPj0geGdvdl9yZXF1ZXN0X2JveF9tYnIKICAgID49CiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTItNjE0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgLy8gKSwgZXJyLklOVkFMSURfWEdPVl9GRUUKICAgIGFzc2VydCAvLyBJbnZhbGlkIHhHb3YgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTYKICAgIC8vIGFzc2VydCBjb25maWcucHJvcG9zZXJfZmVlID49IHByb3Bvc2VyX2JveF9tYnIsIGVyci5JTlZBTElEX1BST1BPU0VSX0ZFRQogICAgZGlnIDEKICAgIGR1cAogICAgaW50Y18zIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjgzLTI4NQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBrZXlfcHJlZml4X2xlbmd0aCArIGtleV90eXBlX3NpemUgKyB2YWx1ZV90eXBlX3NpemUKICAgIC8vICkgKiBQRVJfQllURV9JTl9CT1hfTUJSICsgUEVSX0JPWF9NQlIKICAgIHB1c2hpbnQgMTkzMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxNgogICAgLy8gYXNzZXJ0IGNvbmZpZy5wcm9wb3Nlcl9mZWUgPj0gcHJvcG9zZXJfYm94X21iciwgZXJyLklOVkFMSURfUFJPUE9TRVJfRkVFCiAgICA+PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zZXIgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjAKICAgIC8vIDwgY29uZmlnLm1pbl9yZXF1ZXN0ZWRfYW1vdW50CiAgICBwdXNoaW50IDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxOS02MjEKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLm1pbl9yZXF1ZXN0ZWRfYW1vdW50CiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgZGlnIDEKICAgIGV4dHJhY3QgNDggMjQKICAgIGR1cAogICAgYnVyeSAxOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIwLTYyMQogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICBkaWcgNgogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjE5LTYyMQogICAgLy8gMAogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjIKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICBkaWcgMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYyMS02MjIKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYyMgogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxLTYyMgogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMF0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICBkaWcgNQogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIwLTYyMgogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIzCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgZGlnIDE3CiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIyLTYyMwogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzJdCiAgICBkaWcgNAogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxLTYyMwogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMF0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfbWVyZ2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTgtNjI0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIDAKICAgIC8vICAgICA8IGNvbmZpZy5taW5fcmVxdWVzdGVkX2Ftb3VudAogICAgLy8gICAgIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyAgICAgPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIC8vICAgICA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9SRVFVRVNURURfQU1PVU5UX0NPTkZJRwogICAgYXNzZXJ0IC8vIEluY29uc2lzdGVudCByZXF1ZXN0ZWQgYW1vdW50IGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjI3CiAgICAvLyBjb25maWcub3Blbl9wcm9wb3NhbF9mZWUsCiAgICBkaWcgMQogICAgZHVwCiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjgKICAgIC8vIGNvbmZpZy5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLAogICAgZHVwCiAgICBwdXNoaW50IDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjcKICAgIC8vIHJldHVybiBhbW91bnQgKiBmcmFjdGlvbl9pbl9icHMgLy8gQlBTCiAgICBkaWcgMgogICAgKgogICAgcHVzaGludCAxMDAwMAogICAgLwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzExCiAgICAvLyBVSW50NjQoTUFYX01CUl9QRVJfQVBQICsgTUFYX01CUl9QRVJfQk9YICsgQUNDT1VOVF9NQlIpICsgZGFlbW9uX29wc19mdW5kaW5nCiAgICBwdXNoaW50IDE2ODM1MzAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozMTQKICAgIC8vIGFzc2VydCBvcGVuX3Byb3Bvc2FsX2ZlZSA+IHRvX3N1YnN0cmFjdCwgZXJyLklOVkFMSURfT1BFTl9QUk9QT1NBTF9GRUUKICAgIGRpZyAyCiAgICBkaWcgMQogICAgPgogICAgYXNzZXJ0IC8vIEludmFsaWQgb3BlbiBwcm9wb3NhbCBmZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMxNgogICAgLy8gbWJyX2F2YWlsYWJsZV9mb3JfY29tbWl0dGVlID0gb3Blbl9wcm9wb3NhbF9mZWUgLSB0b19zdWJzdHJhY3QKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgLQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjgzLTI4NQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBrZXlfcHJlZml4X2xlbmd0aCArIGtleV90eXBlX3NpemUgKyB2YWx1ZV90eXBlX3NpemUKICAgIC8vICkgKiBQRVJfQllURV9JTl9CT1hfTUJSICsgUEVSX0JPWF9NQlIKICAgIHB1c2hpbnQgMTg5MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMxOAogICAgLy8gc2VsZi5tYXhfY29tbWl0dGVlX3NpemUudmFsdWUgPSBtYnJfYXZhaWxhYmxlX2Zvcl9jb21taXR0ZWUgLy8gdm90ZXJfbWJyCiAgICAvCiAgICBieXRlYyA0OCAvLyAweDZkNjE3ODVmNjM2ZjZkNmQ2OTc0NzQ2NTY1NWY3MzY5N2E2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNAogICAgLy8gPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgZXh0cmFjdCA3MiAzMgogICAgZHVwCiAgICBidXJ5IDE4CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzMtNjM1CiAgICAvLyAwCiAgICAvLyA8IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjM1CiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgZGlnIDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzQtNjM1CiAgICAvLyA8IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNC02MzUKICAgIC8vIDwgY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICBkaWcgMwogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzMy02MzUKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzYKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzJdCiAgICBkaWcgMTYKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNS02MzYKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgZGlnIDE0CiAgICA+PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjM0LTYzNgogICAgLy8gPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzJdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzcKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzNdCiAgICBkaWcgMTYKICAgIHB1c2hpbnQgMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzYtNjM3CiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bM10KICAgIGRpZyAxMwogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNS02MzcKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bM10KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMTYKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjMyLTYzOAogICAgLy8gYXNzZXJ0ICgKICAgIC8vICAgICAwCiAgICAvLyAgICAgPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgLy8gICAgIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyAgICAgPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMl0KICAgIC8vICAgICA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblszXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9ESVNDVVNTSU9OX0RVUkFUSU9OX0NPTkZJRwogICAgYXNzZXJ0IC8vIEluY29uc2lzdGVudCBkaXNjdXNzaW9uIGR1cmF0aW9uIGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQyCiAgICAvLyA8IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMF0KICAgIGRpZyAxCiAgICBleHRyYWN0IDEwNCAzMgogICAgZHVwCiAgICBidXJ5IDIyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQxLTY0MwogICAgLy8gMAogICAgLy8gPCBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDIyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDMKICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIGRpZyAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQyLTY0MwogICAgLy8gPCBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBpbnRjXzMgLy8gOAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQzCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDItNjQzCiAgICAvLyA8IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIGRpZyAxMgogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0MS02NDMKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ0CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICBkaWcgMjAKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0My02NDQKICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMl0KICAgIGRpZyAxMQogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0Mi02NDQKICAgIC8vIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsxXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsyXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBkaWcgMjAKICAgIHB1c2hpbnQgMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDQtNjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBkaWcgMTAKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDMtNjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDIyCiAgICBpbnRjXzEgLy8gMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0MC02NDYKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgMAogICAgLy8gICAgIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gICAgIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIC8vICAgICA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyAgICAgPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblszXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9WT1RJTkdfRFVSQVRJT05fQ09ORklHCiAgICBhc3NlcnQgLy8gSW5jb25zaXN0ZW50IHZvdGluZyBkdXJhdGlvbiBjb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1MAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBkaWcgMQogICAgZXh0cmFjdCAxMzYgMjQKICAgIGR1cAogICAgYnVyeSAyMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0OS02NTAKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLnF1b3J1bVswXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjUxLTY1MgogICAgLy8gIyBRdW9ydW0gTWVkaXVtIG5vIGxvbmdlciB1c2VkCiAgICAvLyA8IGNvbmZpZy5xdW9ydW1bMl0KICAgIGRpZyAxOQogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1MAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBkaWcgOQogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ5LTY1MAogICAgLy8gMAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDI2CiAgICBpbnRjXzEgLy8gMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0OC02NTMKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgMAogICAgLy8gICAgIDwgY29uZmlnLnF1b3J1bVswXQogICAgLy8gICAgICMgUXVvcnVtIE1lZGl1bSBubyBsb25nZXIgdXNlZAogICAgLy8gICAgIDwgY29uZmlnLnF1b3J1bVsyXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9RVU9SVU1fQ09ORklHCiAgICBhc3NlcnQgLy8gSW5jb25zaXN0ZW50IHF1b3J1bSBjb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1NwogICAgLy8gPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICBkaWcgMQogICAgZXh0cmFjdCAxNjAgMjQKICAgIGR1cAogICAgYnVyeSAyMAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU2LTY1NwogICAgLy8gMAogICAgLy8gPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NTgtNjU5CiAgICAvLyAjIFdlaWdodGVkIFF1b3J1bSBNZWRpdW0gbm8gbG9uZ2VyIHVzZWQKICAgIC8vIDwgY29uZmlnLndlaWdodGVkX3F1b3J1bVsyXQogICAgZGlnIDE4CiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU3CiAgICAvLyA8IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGRpZyA4CiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NTYtNjU3CiAgICAvLyAwCiAgICAvLyA8IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMzAKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDMxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU1LTY2MAogICAgLy8gYXNzZXJ0ICgKICAgIC8vICAgICAwCiAgICAvLyAgICAgPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICAvLyAgICAgIyBXZWlnaHRlZCBRdW9ydW0gTWVkaXVtIG5vIGxvbmdlciB1c2VkCiAgICAvLyAgICAgPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzJdCiAgICAvLyApLCBlcnIuSU5DT05TSVNURU5UX1dFSUdIVEVEX1FVT1JVTV9DT05GSUcKICAgIGFzc2VydCAvLyBJbmNvbnNpc3RlbnQgd2VpZ2h0ZWQgcXVvcnVtIGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjYyCiAgICAvLyBzZWxmLnhnb3ZfZmVlLnZhbHVlID0gY29uZmlnLnhnb3ZfZmVlCiAgICBieXRlYyAxOSAvLyAweDc4Njc2Zjc2NWY2NjY1NjUKICAgIGRpZyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjYzCiAgICAvLyBzZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSA9IGNvbmZpZy5wcm9wb3Nlcl9mZWUKICAgIGJ5dGVjIDIwIC8vIDB4NzA3MjZmNzA2ZjczNjU3MjVmNjY2NTY1CiAgICBkaWcgNwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2NAogICAgLy8gc2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSA9IGNvbmZpZy5vcGVuX3Byb3Bvc2FsX2ZlZQogICAgYnl0ZWMgMjEgLy8gMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1CiAgICBkaWcgMTUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjUKICAgIC8vIHNlbGYuZGFlbW9uX29wc19mdW5kaW5nX2Jwcy52YWx1ZSA9IGNvbmZpZy5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzCiAgICBieXRlYyAyNiAvLyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczCiAgICBkaWcgMTYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjYKICAgIC8vIHNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMudmFsdWUgPSBjb25maWcucHJvcG9zYWxfY29tbWl0bWVudF9icHMKICAgIGRpZyAxCiAgICBkdXAKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDI3IC8vIDB4NzA3MjZmNzA2ZjczNjE2YzVmNjM2ZjZkNmQ2OTc0NmQ2NTZlNzQ1ZjYyNzA3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2OAogICAgLy8gc2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudC52YWx1ZSA9IGNvbmZpZy5taW5fcmVxdWVzdGVkX2Ftb3VudAogICAgYnl0ZWMgMjggLy8gMHg2ZDY5NmU1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0CiAgICBkaWcgNwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2OQogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9zbWFsbC52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgZHVwCiAgICBleHRyYWN0IDQ4IDI0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgMjkgLy8gMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY3MzZkNjE2YzZjCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjcwCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjktNjcwCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlID0gY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MAogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUgPSBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyAzMCAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZkNjU2NDY5NzU2ZAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MQogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9sYXJnZS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDMxIC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MwogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIGJ5dGVjIDMyIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGRpZyA0CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njc0CiAgICAvLyBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25fbWVkaXVtLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIGRpZyAxNwogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDMzIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3NQogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMl0KICAgIGR1cAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM0IC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZjNjE3MjY3NjUKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NzYKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UudmFsdWUgPSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblszXQogICAgcHVzaGludCAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM1IC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3OAogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fc21hbGwudmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICBieXRlYyAzNiAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgZGlnIDEzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njc5CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBkaWcgMjEKICAgIGR1cAogICAgaW50Y18zIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgwCiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9sYXJnZS52YWx1ZSA9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMl0KICAgIGR1cAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgxCiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl94bGFyZ2UudmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBwdXNoaW50IDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgMzkgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4MwogICAgLy8gc2VsZi5xdW9ydW1fc21hbGwudmFsdWUgPSBjb25maWcucXVvcnVtWzBdCiAgICBieXRlYyA0MCAvLyAweDcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YwogICAgZGlnIDEwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg0CiAgICAvLyBzZWxmLnF1b3J1bV9tZWRpdW0udmFsdWUgPSBVSW50NjQoMCkgICMgTm8gbG9uZ2VyIHVzZWQKICAgIGJ5dGVjIDQxIC8vIDB4NzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg1CiAgICAvLyBzZWxmLnF1b3J1bV9sYXJnZS52YWx1ZSA9IGNvbmZpZy5xdW9ydW1bMl0KICAgIGRpZyAyMAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDQyIC8vIDB4NzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg3CiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSA9IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGJ5dGVjIDQzIC8vIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY3MzZkNjE2YzZjCiAgICBkaWcgOQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4OAogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fbWVkaXVtLnZhbHVlID0gVUludDY0KDApICAjIE5vIGxvbmdlciB1c2VkCiAgICBieXRlYyA0NCAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4OQogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fbGFyZ2UudmFsdWUgPSBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzJdCiAgICBkaWcgMTkKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyA0NSAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MQogICAgLy8gc2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZSA9IGNvbmZpZy5hYnNlbmNlX3RvbGVyYW5jZQogICAgZHVwCiAgICBwdXNoaW50IDE4NAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDIyIC8vIDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MgogICAgLy8gc2VsZi5nb3Zlcm5hbmNlX3BlcmlvZC52YWx1ZSA9IGNvbmZpZy5nb3Zlcm5hbmNlX3BlcmlvZAogICAgZHVwCiAgICBwdXNoaW50IDE5MgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MwogICAgLy8gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlID0gY29uZmlnLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QKICAgIHB1c2hpbnQgMjAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgNTAgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU3MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMzA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDMxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDI2OgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyNwoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMjoKICAgIGludGNfMCAvLyAwCiAgICBiIGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfbWVyZ2VAMjMKCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMTY6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDE3Cgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDEwOgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAxMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVwZGF0ZV94Z292X3JlZ2lzdHJ5W3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlX3hnb3ZfcmVnaXN0cnk6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QoYWxsb3dfYWN0aW9ucz1bIlVwZGF0ZUFwcGxpY2F0aW9uIl0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzIyCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjcyNAogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgbm90IGluIHNlbGYueGdvdl9ib3gsIGVyci5BTFJFQURZX1hHT1YKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBBbHJlYWR5IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjcyNS03MjYKICAgIC8vICMgY2hlY2sgcGF5bWVudAogICAgLy8gYXNzZXJ0IHNlbGYudmFsaWRfeGdvdl9wYXltZW50KHBheW1lbnQpLCBlcnIuSU5WQUxJRF9QQVlNRU5UCiAgICBjYWxsc3ViIHZhbGlkX3hnb3ZfcGF5bWVudAogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzI4LTcyOQogICAgLy8gIyBjcmVhdGUgYm94CiAgICAvLyBzZWxmLnhnb3ZfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3hnb3ZfYm94KHZvdGluZ19hZGRyZXNzKQogICAgZHVwCiAgICBjYWxsc3ViIG1ha2VfeGdvdl9ib3gKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzAKICAgIC8vIHNlbGYueGdvdnMudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdnMgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzIKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlN1YnNjcmliZWQoeGdvdj1UeG4uc2VuZGVyLCBkZWxlZ2F0ZT12b3RpbmdfYWRkcmVzcykpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDUzIC8vIG1ldGhvZCAiWEdvdlN1YnNjcmliZWQoYWRkcmVzcyxhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVuc3Vic2NyaWJlX3hnb3Zbcm91dGluZ10oKSAtPiB2b2lkOgp1bnN1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzQzCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc0NS03NDYKICAgIC8vICMgZW5zdXJlIHRoZSBzZW5kZXIgaXMgYW4geEdvdgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgaW4gc2VsZi54Z292X2JveCwgZXJyLlVOQVVUSE9SSVpFRAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzQ4LTc0OQogICAgLy8gIyBkZWxldGUgYm94CiAgICAvLyBkZWwgc2VsZi54Z292X2JveFtUeG4uc2VuZGVyXQogICAgYnl0ZWNfMiAvLyAweDc4CiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzUwCiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzUyCiAgICAvLyBhcmM0LmVtaXQodHlwLlhHb3ZVbnN1YnNjcmliZWQoeGdvdj1UeG4uc2VuZGVyKSkKICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiWEdvdlVuc3Vic2NyaWJlZChhZGRyZXNzKSIKICAgIHR4biBTZW5kZXIKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzQKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVuc3Vic2NyaWJlX2Fic2VudGVlW3JvdXRpbmddKCkgLT4gdm9pZDoKdW5zdWJzY3JpYmVfYWJzZW50ZWU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NTQKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc2OQogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzAKICAgIC8vIGFzc2VydCB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveCwgZXJyLk5PVF9YR09WCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gTm90IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3MQogICAgLy8gYXNzZXJ0IHNlbGYueGdvdl9ib3hbeGdvdl9hZGRyZXNzXS50b2xlcmF0ZWRfYWJzZW5jZXMgPT0gMCwgZXJyLlVOQVVUSE9SSVpFRAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgICEKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3MwogICAgLy8gZGVsIHNlbGYueGdvdl9ib3hbeGdvdl9hZGRyZXNzXQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzQKICAgIC8vIHNlbGYueGdvdnMudmFsdWUgLT0gMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdnMgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzYKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlVuc3Vic2NyaWJlZCh4Z292PXhnb3ZfYWRkcmVzcykpCiAgICBieXRlYyA1MSAvLyBtZXRob2QgIlhHb3ZVbnN1YnNjcmliZWQoYWRkcmVzcykiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzU0CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5yZXF1ZXN0X3N1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVxdWVzdF9zdWJzY3JpYmVfeGdvdjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgwMgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gb3duZXJfYWRkcmVzcywgZXJyLlVOQVVUSE9SSVpFRAogICAgdHhuIFNlbmRlcgogICAgZGlnIDMKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MDMKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODA1LTgwNgogICAgLy8gIyBlbnN1cmUgdGhlIHhnb3ZfYWRkcmVzcyBpcyBub3QgYWxyZWFkeSBhbiB4R292CiAgICAvLyBhc3NlcnQgeGdvdl9hZGRyZXNzIG5vdCBpbiBzZWxmLnhnb3ZfYm94LCBlcnIuQUxSRUFEWV9YR09WCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyA0CiAgICBjb25jYXQKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIEFscmVhZHkgYW4geEdvdgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODA4LTgwOQogICAgLy8gIyBjaGVjayBwYXltZW50CiAgICAvLyBhc3NlcnQgc2VsZi52YWxpZF94Z292X3BheW1lbnQocGF5bWVudCksIGVyci5JTlZBTElEX1BBWU1FTlQKICAgIGNhbGxzdWIgdmFsaWRfeGdvdl9wYXltZW50CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MTEtODEyCiAgICAvLyAjIGNyZWF0ZSByZXF1ZXN0IGJveAogICAgLy8gcmlkID0gc2VsZi5yZXF1ZXN0X2lkLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTEgLy8gMHg3MjY1NzE3NTY1NzM3NDVmNjk2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfaWQgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MTMtODE3CiAgICAvLyBzZWxmLnJlcXVlc3RfYm94W3JpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODEzCiAgICAvLyBzZWxmLnJlcXVlc3RfYm94W3JpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIGRpZyAxCiAgICBpdG9iCiAgICBieXRlYyAyMyAvLyAweDcyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgxMy04MTcKICAgIC8vIHNlbGYucmVxdWVzdF9ib3hbcmlkXSA9IHR5cC5YR292U3Vic2NyaWJlUmVxdWVzdEJveFZhbHVlKAogICAgLy8gICAgIHhnb3ZfYWRkcj14Z292X2FkZHJlc3MsCiAgICAvLyAgICAgb3duZXJfYWRkcj1vd25lcl9hZGRyZXNzLAogICAgLy8gICAgIHJlbGF0aW9uX3R5cGU9cmVsYXRpb25fdHlwZSwKICAgIC8vICkKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgxOS04MjAKICAgIC8vICMgaW5jcmVtZW50IHJlcXVlc3QgaWQKICAgIC8vIHNlbGYucmVxdWVzdF9pZC52YWx1ZSArPSAxCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnl0ZWMgMTEgLy8gMHg3MjY1NzE3NTY1NzM3NDVmNjk2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuYXBwcm92ZV9zdWJzY3JpYmVfeGdvdltyb3V0aW5nXSgpIC0+IHZvaWQ6CmFwcHJvdmVfc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MjIKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MzQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3Zfc3Vic2NyaWJlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3Zfc3Vic2NyaWJlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODM2LTgzNwogICAgLy8gIyBnZXQgdGhlIHJlcXVlc3QKICAgIC8vIHJlcXVlc3QgPSBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdLmNvcHkoKQogICAgaXRvYgogICAgYnl0ZWMgMjMgLy8gMHg3MgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfYm94IGVudHJ5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODM4LTgzOQogICAgLy8gIyBjcmVhdGUgdGhlIHhHb3YKICAgIC8vIHNlbGYueGdvdl9ib3hbcmVxdWVzdC54Z292X2FkZHJdID0gc2VsZi5tYWtlX3hnb3ZfYm94KHJlcXVlc3Qub3duZXJfYWRkcikKICAgIGR1cAogICAgZXh0cmFjdCAzMiAzMgogICAgZHVwCiAgICBjYWxsc3ViIG1ha2VfeGdvdl9ib3gKICAgIHVuY292ZXIgMgogICAgZXh0cmFjdCAwIDMyCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQwCiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQxLTg0MgogICAgLy8gIyBkZWxldGUgdGhlIHJlcXVlc3QKICAgIC8vIGRlbCBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdCiAgICB1bmNvdmVyIDIKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQ1LTg0OAogICAgLy8gdHlwLlhHb3ZTdWJzY3JpYmVkKAogICAgLy8gICAgIHhnb3Y9cmVxdWVzdC54Z292X2FkZHIsCiAgICAvLyAgICAgZGVsZWdhdGU9cmVxdWVzdC5vd25lcl9hZGRyLAogICAgLy8gKQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NDQtODQ5CiAgICAvLyBhcmM0LmVtaXQoCiAgICAvLyAgICAgdHlwLlhHb3ZTdWJzY3JpYmVkKAogICAgLy8gICAgICAgICB4Z292PXJlcXVlc3QueGdvdl9hZGRyLAogICAgLy8gICAgICAgICBkZWxlZ2F0ZT1yZXF1ZXN0Lm93bmVyX2FkZHIsCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgYnl0ZWMgNTMgLy8gbWV0aG9kICJYR292U3Vic2NyaWJlZChhZGRyZXNzLGFkZHJlc3MpIgogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgyMgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucmVqZWN0X3N1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVqZWN0X3N1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODUxCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODYzCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X3N1YnNjcmliZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X3N1YnNjcmliZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg2NS04NjYKICAgIC8vICMgZGVsZXRlIHRoZSByZXF1ZXN0CiAgICAvLyBkZWwgc2VsZi5yZXF1ZXN0X2JveFtyZXF1ZXN0X2lkXQogICAgaXRvYgogICAgYnl0ZWMgMjMgLy8gMHg3MgogICAgc3dhcAogICAgY29uY2F0CiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg1MQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucmVxdWVzdF91bnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVxdWVzdF91bnN1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODY4CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODkzCiAgICAvLyBhc3NlcnQgVHhuLnNlbmRlciA9PSBvd25lcl9hZGRyZXNzLCBlcnIuVU5BVVRIT1JJWkVECiAgICB0eG4gU2VuZGVyCiAgICBkaWcgMwogICAgPT0KICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg5NAogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4OTYtODk3CiAgICAvLyAjIGVuc3VyZSB0aGUgeGdvdl9hZGRyZXNzIGlzIGFscmVhZHkgYW4geEdvdgogICAgLy8gYXNzZXJ0IHhnb3ZfYWRkcmVzcyBpbiBzZWxmLnhnb3ZfYm94LCBlcnIuTk9UX1hHT1YKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgZGlnIDQKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gTm90IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg5OS05MDAKICAgIC8vICMgY2hlY2sgcGF5bWVudAogICAgLy8gYXNzZXJ0IHNlbGYudmFsaWRfeGdvdl9wYXltZW50KHBheW1lbnQpLCBlcnIuSU5WQUxJRF9QQVlNRU5UCiAgICBjYWxsc3ViIHZhbGlkX3hnb3ZfcGF5bWVudAogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTAyLTkwMwogICAgLy8gIyBjcmVhdGUgdW5zdWJzY3JpYmUgcmVxdWVzdCBib3gKICAgIC8vIHJ1aWQgPSBzZWxmLnJlcXVlc3RfaWQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMSAvLyAweDcyNjU3MTc1NjU3Mzc0NWY2OTY0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucmVxdWVzdF9pZCBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkwNC05MDgKICAgIC8vIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcnVpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTA0CiAgICAvLyBzZWxmLnJlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3J1aWRdID0gdHlwLlhHb3ZTdWJzY3JpYmVSZXF1ZXN0Qm94VmFsdWUoCiAgICBkaWcgMQogICAgaXRvYgogICAgYnl0ZWMgMjQgLy8gMHg3Mjc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkwNC05MDgKICAgIC8vIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcnVpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MTAtOTExCiAgICAvLyAjIGluY3JlbWVudCByZXF1ZXN0IGlkCiAgICAvLyBzZWxmLnJlcXVlc3RfaWQudmFsdWUgKz0gMQogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ5dGVjIDExIC8vIDB4NzI2NTcxNzU2NTczNzQ1ZjY5NjQKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NjgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdltyb3V0aW5nXSgpIC0+IHZvaWQ6CmFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkxMwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkyNQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9zdWJzY3JpYmVyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9zdWJzY3JpYmVyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MjctOTI4CiAgICAvLyAjIGdldCB0aGUgcmVxdWVzdAogICAgLy8gcmVxdWVzdCA9IHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcmVxdWVzdF9pZF0uY29weSgpCiAgICBpdG9iCiAgICBieXRlYyAyNCAvLyAweDcyNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBlbnRyeSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzMC05MzEKICAgIC8vICMgZGVsIHRoZSB4R292CiAgICAvLyBkZWwgc2VsZi54Z292X2JveFtyZXF1ZXN0Lnhnb3ZfYWRkcl0KICAgIGV4dHJhY3QgMCAzMgogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBkaWcgMQogICAgY29uY2F0CiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzMgogICAgLy8gc2VsZi54Z292cy52YWx1ZSAtPSAxCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292cyBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzNC05MzUKICAgIC8vICMgZGVsZXRlIHRoZSByZXF1ZXN0CiAgICAvLyBkZWwgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveFtyZXF1ZXN0X2lkXQogICAgc3dhcAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzcKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlVuc3Vic2NyaWJlZCh4Z292PXJlcXVlc3QueGdvdl9hZGRyKSkKICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiWEdvdlVuc3Vic2NyaWJlZChhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MTMKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnJlamVjdF91bnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NTEKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3Zfc3Vic2NyaWJlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3Zfc3Vic2NyaWJlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTUzLTk1NAogICAgLy8gIyBkZWxldGUgdGhlIHJlcXVlc3QKICAgIC8vIGRlbCBzZWxmLnJlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3JlcXVlc3RfaWRdCiAgICBpdG9iCiAgICBieXRlYyAyNCAvLyAweDcyNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF92b3RpbmdfYWNjb3VudFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF92b3RpbmdfYWNjb3VudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk1NgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NzIKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTc0LTk3NQogICAgLy8gIyBDaGVjayBpZiB0aGUgc2VuZGVyIGlzIGFuIHhHb3YgbWVtYmVyCiAgICAvLyBleGlzdHMgPSB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cG4gMgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NzYKICAgIC8vIGFzc2VydCBleGlzdHMsIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk3NwogICAgLy8geGdvdl9ib3ggPSBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10uY29weSgpCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk4MQogICAgLy8gVHhuLnNlbmRlciA9PSB4Z292X2JveC52b3RpbmdfYWRkcmVzcyBvciBUeG4uc2VuZGVyID09IHhnb3ZfYWRkcmVzcwogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgZXh0cmFjdCAwIDMyCiAgICA9PQogICAgYm56IHNldF92b3RpbmdfYWNjb3VudF9ib29sX3RydWVAMwogICAgdHhuIFNlbmRlcgogICAgZGlnIDMKICAgID09CiAgICBieiBzZXRfdm90aW5nX2FjY291bnRfYm9vbF9mYWxzZUA0CgpzZXRfdm90aW5nX2FjY291bnRfYm9vbF90cnVlQDM6CiAgICBpbnRjXzEgLy8gMQoKc2V0X3ZvdGluZ19hY2NvdW50X2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk3OS05ODIKICAgIC8vICMgQ2hlY2sgdGhhdCB0aGUgc2VuZGVyIGlzIGVpdGhlciB0aGUgeEdvdiBvciB0aGUgdm90aW5nIGFkZHJlc3MKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgVHhuLnNlbmRlciA9PSB4Z292X2JveC52b3RpbmdfYWRkcmVzcyBvciBUeG4uc2VuZGVyID09IHhnb3ZfYWRkcmVzcwogICAgLy8gKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg0LTk4NQogICAgLy8gIyBVcGRhdGUgdGhlIHZvdGluZyBhY2NvdW50IGluIHRoZSB4R292IGJveAogICAgLy8gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLnZvdGluZ19hZGRyZXNzID0gdm90aW5nX2FkZHJlc3MKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTU2CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKc2V0X3ZvdGluZ19hY2NvdW50X2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIHNldF92b3RpbmdfYWNjb3VudF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc3Vic2NyaWJlX3Byb3Bvc2VyW3JvdXRpbmddKCkgLT4gdm9pZDoKc3Vic2NyaWJlX3Byb3Bvc2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDAxCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDMKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyIG5vdCBpbiBzZWxmLnByb3Bvc2VyX2JveCwgZXJyLkFMUkVBRFlfUFJPUE9TRVIKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBBbHJlYWR5IGEgcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDYKICAgIC8vIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDA0LTEwMDcKICAgIC8vICMgY2hlY2sgZmVlCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgLy8gKSwgZXJyLldST05HX1JFQ0VJVkVSCiAgICBhc3NlcnQgLy8gV3JvbmcgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDgKICAgIC8vIGFzc2VydCBwYXltZW50LmFtb3VudCA9PSBzZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSwgZXJyLldST05HX1BBWU1FTlRfQU1PVU5UCiAgICBndHhucyBBbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMCAvLyAweDcwNzI2ZjcwNmY3MzY1NzI1ZjY2NjU2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnByb3Bvc2VyX2ZlZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gV3JvbmcgcGF5bWVudCBhbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTAKICAgIC8vIHNlbGYucHJvcG9zZXJfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3Byb3Bvc2VyX2JveCgKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNjQtMzY4CiAgICAvLyByZXR1cm4gdHlwLlByb3Bvc2VyQm94VmFsdWUoCiAgICAvLyAgICAgYWN0aXZlX3Byb3Bvc2FsPWFjdGl2ZV9wcm9wb3NhbCwKICAgIC8vICAgICBreWNfc3RhdHVzPWt5Y19zdGF0dXMsCiAgICAvLyAgICAga3ljX2V4cGlyaW5nPWt5Y19leHBpcmluZywKICAgIC8vICkKICAgIGJ5dGVjIDU0IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDEwLTEwMTIKICAgIC8vIHNlbGYucHJvcG9zZXJfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3Byb3Bvc2VyX2JveCgKICAgIC8vICAgICBhY3RpdmVfcHJvcG9zYWw9RmFsc2UsIGt5Y19zdGF0dXM9RmFsc2UsIGt5Y19leHBpcmluZz1VSW50NjQoMCkKICAgIC8vICkKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTQKICAgIC8vIGFyYzQuZW1pdCh0eXAuUHJvcG9zZXJTdWJzY3JpYmVkKHByb3Bvc2VyPVR4bi5zZW5kZXIpKQogICAgcHVzaGJ5dGVzIDB4YmQ3OTJmZDEgLy8gbWV0aG9kICJQcm9wb3NlclN1YnNjcmliZWQoYWRkcmVzcykiCiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5zZXRfcHJvcG9zZXJfa3ljW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3Byb3Bvc2VyX2t5YzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5ib29sCiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDM3LTEwMzgKICAgIC8vICMgY2hlY2sgaWYga3ljIHByb3ZpZGVyCiAgICAvLyBhc3NlcnQgVHhuLnNlbmRlciA9PSBzZWxmLmt5Y19wcm92aWRlci52YWx1ZSwgZXJyLlVOQVVUSE9SSVpFRAogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE2IC8vIDB4NmI3OTYzNWY3MDcyNmY3NjY5NjQ2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYua3ljX3Byb3ZpZGVyIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMzkKICAgIC8vIGFzc2VydCBwcm9wb3NlciBpbiBzZWxmLnByb3Bvc2VyX2JveCwgZXJyLlBST1BPU0VSX0RPRVNfTk9UX0VYSVNUCiAgICBieXRlYyA0IC8vIDB4NzAKICAgIGRpZyAzCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUHJvcG9zZXIgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNDEKICAgIC8vIGFjdGl2ZV9wcm9wb3NhbCA9IHNlbGYucHJvcG9zZXJfYm94W3Byb3Bvc2VyXS5jb3B5KCkuYWN0aXZlX3Byb3Bvc2FsCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzY0LTM2OAogICAgLy8gcmV0dXJuIHR5cC5Qcm9wb3NlckJveFZhbHVlKAogICAgLy8gICAgIGFjdGl2ZV9wcm9wb3NhbD1hY3RpdmVfcHJvcG9zYWwsCiAgICAvLyAgICAga3ljX3N0YXR1cz1reWNfc3RhdHVzLAogICAgLy8gICAgIGt5Y19leHBpcmluZz1reWNfZXhwaXJpbmcsCiAgICAvLyApCiAgICBieXRlYyA4IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgaW50Y18xIC8vIDEKICAgIHVuY292ZXIgNAogICAgc2V0Yml0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA0My0xMDQ3CiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFtwcm9wb3Nlcl0gPSBzZWxmLm1ha2VfcHJvcG9zZXJfYm94KAogICAgLy8gICAgIGFjdGl2ZV9wcm9wb3NhbD1hY3RpdmVfcHJvcG9zYWwsCiAgICAvLyAgICAga3ljX3N0YXR1cz1reWNfc3RhdHVzLAogICAgLy8gICAgIGt5Y19leHBpcmluZz1reWNfZXhwaXJpbmcsCiAgICAvLyApCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDUyCiAgICAvLyB2YWxpZF9reWM9Ym9vbChzZWxmLnZhbGlkX2t5Yyhwcm9wb3NlcikpLAogICAgZHVwCiAgICBjYWxsc3ViIHZhbGlkX2t5YwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1MC0xMDUzCiAgICAvLyB0eXAuUHJvcG9zZXJLWUMoCiAgICAvLyAgICAgcHJvcG9zZXI9cHJvcG9zZXIsCiAgICAvLyAgICAgdmFsaWRfa3ljPWJvb2woc2VsZi52YWxpZF9reWMocHJvcG9zZXIpKSwKICAgIC8vICkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNDktMTA1NAogICAgLy8gYXJjNC5lbWl0KAogICAgLy8gICAgIHR5cC5Qcm9wb3NlcktZQygKICAgIC8vICAgICAgICAgcHJvcG9zZXI9cHJvcG9zZXIsCiAgICAvLyAgICAgICAgIHZhbGlkX2t5Yz1ib29sKHNlbGYudmFsaWRfa3ljKHByb3Bvc2VyKSksCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgcHVzaGJ5dGVzIDB4Y2I1MGZkODQgLy8gbWV0aG9kICJQcm9wb3NlcktZQyhhZGRyZXNzLGJvb2wpIgogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmRlY2xhcmVfY29tbWl0dGVlW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVjbGFyZV9jb21taXR0ZWU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDU2CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMjEKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYuY29tbWl0dGVlX21hbmFnZXIudmFsdWUKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxNyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWFuYWdlciBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDc2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X2NvbW1pdHRlZV9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNzgKICAgIC8vIGFzc2VydCBzaXplID4gMCwgZXJyLldST05HX0NPTU1JVFRFRV9NRU1CRVJTCiAgICBkaWcgMQogICAgYXNzZXJ0IC8vIFdyb25nIENvbW1pdHRlZSBNZW1iZXJzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDc5CiAgICAvLyBhc3NlcnQgdm90ZXMgPiAwLCBlcnIuV1JPTkdfQ09NTUlUVEVFX1ZPVEVTCiAgICBkdXAKICAgIGFzc2VydCAvLyBXcm9uZyBDb21taXR0ZWUgVm90ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwODAKICAgIC8vIGFzc2VydCBzaXplIDw9IHNlbGYubWF4X2NvbW1pdHRlZV9zaXplLnZhbHVlLCBlcnIuQ09NTUlUVEVFX1NJWkVfVE9PX0xBUkdFCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDggLy8gMHg2ZDYxNzg1ZjYzNmY2ZDZkNjk3NDc0NjU2NTVmNzM2OTdhNjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5tYXhfY29tbWl0dGVlX3NpemUgZXhpc3RzCiAgICBkaWcgMgogICAgPj0KICAgIGFzc2VydCAvLyBDb21taXR0ZWUgc2l6ZSBpcyB0b28gbGFyZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwODIKICAgIC8vIHNlbGYuY29tbWl0dGVlX2lkLnZhbHVlID0gY29tbWl0dGVlX2lkLmNvcHkoKQogICAgYnl0ZWMgNTUgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY5NjQKICAgIGRpZyAzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA4MwogICAgLy8gc2VsZi5jb21taXR0ZWVfbWVtYmVycy52YWx1ZSA9IHNpemUKICAgIGJ5dGVjIDQ2IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDY1NmQ2MjY1NzI3MwogICAgZGlnIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg0CiAgICAvLyBzZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSA9IHZvdGVzCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgZGlnIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg1CiAgICAvLyBzZWxmLmNvbW1pdHRlZV9sYXN0X2FuY2hvci52YWx1ZSA9IHNlbGYuZ2V0X2NvbW1pdHRlZV9hbmNob3IoKQogICAgY2FsbHN1YiBnZXRfY29tbWl0dGVlX2FuY2hvcgogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDkwCiAgICAvLyBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgc3dhcAogICAgaXRvYgogICAgZHVwCiAgICBiaXRsZW4KICAgIGludGNfMiAvLyAzMgogICAgPD0KICAgIGFzc2VydCAvLyBvdmVyZmxvdwogICAgZXh0cmFjdCA0IDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwOTEKICAgIC8vIHZvdGVzPWFyYzQuVUludDMyKHZvdGVzKSwKICAgIHN3YXAKICAgIGl0b2IKICAgIGR1cAogICAgYml0bGVuCiAgICBpbnRjXzIgLy8gMzIKICAgIDw9CiAgICBhc3NlcnQgLy8gb3ZlcmZsb3cKICAgIGV4dHJhY3QgNCA0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg4LTEwOTIKICAgIC8vIHR5cC5OZXdDb21taXR0ZWUoCiAgICAvLyAgICAgY29tbWl0dGVlX2lkPWNvbW1pdHRlZV9pZCwKICAgIC8vICAgICBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgLy8gICAgIHZvdGVzPWFyYzQuVUludDMyKHZvdGVzKSwKICAgIC8vICkKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg3LTEwOTMKICAgIC8vIGFyYzQuZW1pdCgKICAgIC8vICAgICB0eXAuTmV3Q29tbWl0dGVlKAogICAgLy8gICAgICAgICBjb21taXR0ZWVfaWQ9Y29tbWl0dGVlX2lkLAogICAgLy8gICAgICAgICBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgLy8gICAgICAgICB2b3Rlcz1hcmM0LlVJbnQzMih2b3RlcyksCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgcHVzaGJ5dGVzIDB4ODczNjU4NjYgLy8gbWV0aG9kICJOZXdDb21taXR0ZWUoYnl0ZVszMl0sdWludDMyLHVpbnQzMikiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1NgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkub3Blbl9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wZW5fcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTExNgogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTE3CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3Byb3Bvc2Fscy52YWx1ZSwgZXJyLlBBVVNFRF9QUk9QT1NBTFMKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMCAvLyAweDcwNjE3NTczNjU2NDVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3Byb3Bvc2FscyBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBDcmVhdGlvbiBvZiBwcm9wb3NhbHMgaXMgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTE5CiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID0gc2VsZi5nZXRfY29tbWl0dGVlX2FuY2hvcigpCiAgICBjYWxsc3ViIGdldF9jb21taXR0ZWVfYW5jaG9yCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIwCiAgICAvLyBjb21taXR0ZWVfZGVsYXkgPSBHbG9iYWwucm91bmQgLSBjb21taXR0ZWVfYW5jaG9yCiAgICBnbG9iYWwgUm91bmQKICAgIGRpZyAxCiAgICAtCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIyCiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID09IHNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IgZXhpc3RzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMi0xMTIzCiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID09IHNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlCiAgICAvLyBvciBjb21taXR0ZWVfZGVsYXkgPD0gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlCiAgICBibnogb3Blbl9wcm9wb3NhbF9ib29sX3RydWVAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMwogICAgLy8gb3IgY29tbWl0dGVlX2RlbGF5IDw9IHNlbGYuY29tbWl0dGVlX2dyYWNlX3BlcmlvZC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUwIC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2NzcyNjE2MzY1NWY3MDY1NzI2OTZmNjQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kIGV4aXN0cwogICAgZGlnIDEKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIyLTExMjMKICAgIC8vIGNvbW1pdHRlZV9hbmNob3IgPT0gc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUKICAgIC8vIG9yIGNvbW1pdHRlZV9kZWxheSA8PSBzZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUKICAgIGJ6IG9wZW5fcHJvcG9zYWxfYm9vbF9mYWxzZUA0CgpvcGVuX3Byb3Bvc2FsX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCm9wZW5fcHJvcG9zYWxfYm9vbF9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMS0xMTI0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIGNvbW1pdHRlZV9hbmNob3IgPT0gc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUKICAgIC8vICAgICBvciBjb21taXR0ZWVfZGVsYXkgPD0gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlCiAgICAvLyApLCBlcnIuQ09NTUlUVEVFX1NUQUxFCiAgICBhc3NlcnQgLy8gQ29tbWl0dGVlIGlzIHN0YWxlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTI2LTExMjcKICAgIC8vICMgQ2hlY2sgaWYgdGhlIGNhbGxlciBpcyBhIHJlZ2lzdGVyZWQgcHJvcG9zZXIKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyIGluIHNlbGYucHJvcG9zZXJfYm94LCBlcnIuVU5BVVRIT1JJWkVECiAgICBieXRlYyA0IC8vIDB4NzAKICAgIHR4biBTZW5kZXIKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTI5LTExMzAKICAgIC8vICMgQ2hlY2sgaWYgdGhlIHByb3Bvc2VyIGFscmVhZHkgaGFzIGFuIGFjdGl2ZSBwcm9wb3NhbAogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnByb3Bvc2VyX2JveFsKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzMQogICAgLy8gVHhuLnNlbmRlcgogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyOS0xMTMyCiAgICAvLyAjIENoZWNrIGlmIHRoZSBwcm9wb3NlciBhbHJlYWR5IGhhcyBhbiBhY3RpdmUgcHJvcG9zYWwKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wcm9wb3Nlcl9ib3hbCiAgICAvLyAgICAgVHhuLnNlbmRlcgogICAgLy8gXS5hY3RpdmVfcHJvcG9zYWwsIGVyci5BTFJFQURZX0FDVElWRV9QUk9QT1NBTAogICAgY29uY2F0CiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3Nlcl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAhCiAgICBhc3NlcnQgLy8gUHJvcG9zZXIgYWxyZWFkeSBoYXMgYW4gYWN0aXZlIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTMzCiAgICAvLyBhc3NlcnQgc2VsZi52YWxpZF9reWMoVHhuLnNlbmRlciksIGVyci5JTlZBTElEX0tZQwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiB2YWxpZF9reWMKICAgIGFzc2VydCAvLyBJbnZhbGlkIEtZQwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzNQogICAgLy8gYXNzZXJ0IFR4bi5mZWUgPj0gKEdsb2JhbC5taW5fdHhuX2ZlZSAqIDMpLCBlcnIuSU5TVUZGSUNJRU5UX0ZFRQogICAgdHhuIEZlZQogICAgZ2xvYmFsIE1pblR4bkZlZQogICAgcHVzaGludCAzCiAgICAqCiAgICA+PQogICAgYXNzZXJ0IC8vIEluc3VmZmljaWVudCBmZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExMzkKICAgIC8vIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZGlnIDEKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzNy0xMTQwCiAgICAvLyAjIEVuc3VyZSB0aGUgdHJhbnNhY3Rpb24gaGFzIHRoZSBjb3JyZWN0IHBheW1lbnQKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyApLCBlcnIuV1JPTkdfUkVDRUlWRVIKICAgIGFzc2VydCAvLyBXcm9uZyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0MQogICAgLy8gYXNzZXJ0IHBheW1lbnQuYW1vdW50ID09IHNlbGYub3Blbl9wcm9wb3NhbF9mZWUudmFsdWUsIGVyci5XUk9OR19QQVlNRU5UX0FNT1VOVAogICAgZ3R4bnMgQW1vdW50CiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjEgLy8gMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3Blbl9wcm9wb3NhbF9mZWUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBwYXltZW50IGFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0MwogICAgLy8gbWJyX2JlZm9yZSA9IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MuYmFsYW5jZQogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGFjY3RfcGFyYW1zX2dldCBBY2N0QmFsYW5jZQogICAgYXNzZXJ0IC8vIGFjY291bnQgZnVuZGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTQ1CiAgICAvLyBhc3NlcnQgc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLCBlcnIuTUlTU0lOR19QUk9QT1NBTF9BUFBST1ZBTF9QUk9HUkFNCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgYm94X2xlbgogICAgYXNzZXJ0IC8vIE1pc3NpbmcgcHJvcG9zYWwgYXBwcm92YWwgcHJvZ3JhbQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1NQogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmxlbmd0aCArIGNvbXBpbGVkX2NsZWFyX3N0YXRlXzEubGVuZ3RoCiAgICBkdXAKICAgIHB1c2hpbnQgNAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1MwogICAgLy8gYnl0ZXNfcGVyX3BhZ2UgPSBVSW50NjQoQllURVNfUEVSX0FQUF9QQUdFKQogICAgaW50YyA0IC8vIDIwNDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNTcKICAgIC8vIHRvdGFsX3BhZ2VzID0gdG90YWxfc2l6ZSAvLyBieXRlc19wZXJfcGFnZQogICAgLwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1OS0xMTYyCiAgICAvLyAjIFRoZSBmb2xsb3dpbmcgYXNzZXJ0aW9uIG1ha2VzIHN1cmUgdGhlIGxvb3AtdW5yb2xsaW5nIGlzIGNvbnNpc3RlbnQKICAgIC8vIGFzc2VydCB0b3RhbF9wYWdlcyA9PSBVSW50NjQoCiAgICAvLyAgICAgUFJPUE9TQUxfQVBQUk9WQUxfUEFHRVMKICAgIC8vICksIGVyci5JTlZBTElEX1BST1BPU0FMX0FQUFJPVkFMX1BST0dSQU1fU0laRQogICAgZHVwCiAgICBwdXNoaW50IDIKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbCBhcHByb3ZhbCBwcm9ncmFtIHNpemUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNjQKICAgIC8vIHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5sZW5ndGggLSAodG90YWxfcGFnZXMgLSAxKSAqIGJ5dGVzX3Blcl9wYWdlCiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTUzCiAgICAvLyBieXRlc19wZXJfcGFnZSA9IFVJbnQ2NChCWVRFU19QRVJfQVBQX1BBR0UpCiAgICBpbnRjIDQgLy8gMjA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NAogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmxlbmd0aCAtICh0b3RhbF9wYWdlcyAtIDEpICogYnl0ZXNfcGVyX3BhZ2UKICAgICoKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgLQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NgogICAgLy8gcGFnZV8xID0gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmV4dHJhY3QoCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NwogICAgLy8gMCAqIGJ5dGVzX3Blcl9wYWdlLCBieXRlc19wZXJfcGFnZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNTMKICAgIC8vIGJ5dGVzX3Blcl9wYWdlID0gVUludDY0KEJZVEVTX1BFUl9BUFBfUEFHRSkKICAgIGludGMgNCAvLyAyMDQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTY2LTExNjgKICAgIC8vIHBhZ2VfMSA9IHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5leHRyYWN0KAogICAgLy8gICAgIDAgKiBieXRlc19wZXJfcGFnZSwgYnl0ZXNfcGVyX3BhZ2UKICAgIC8vICkKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTY5CiAgICAvLyBwYWdlXzIgPSBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0uZXh0cmFjdCgKICAgIGJ5dGVjIDYgLy8gMHg3MDYxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTUzCiAgICAvLyBieXRlc19wZXJfcGFnZSA9IFVJbnQ2NChCWVRFU19QRVJfQVBQX1BBR0UpCiAgICBpbnRjIDQgLy8gMjA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2OS0xMTcxCiAgICAvLyBwYWdlXzIgPSBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0uZXh0cmFjdCgKICAgIC8vICAgICAxICogYnl0ZXNfcGVyX3BhZ2UsIGJ5dGVzX2xhc3RfcGFnZQogICAgLy8gKQogICAgdW5jb3ZlciAzCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3My0xMTgxCiAgICAvLyB0eCA9IGFyYzQuYWJpX2NhbGwoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuY3JlYXRlLAogICAgLy8gICAgIFR4bi5zZW5kZXIsCiAgICAvLyAgICAgYXBwcm92YWxfcHJvZ3JhbT0ocGFnZV8xLCBwYWdlXzIpLAogICAgLy8gICAgIGNsZWFyX3N0YXRlX3Byb2dyYW09Y29tcGlsZWRfY2xlYXJfc3RhdGVfMSwKICAgIC8vICAgICBnbG9iYWxfbnVtX3VpbnQ9cGNmZy5HTE9CQUxfVUlOVFMsCiAgICAvLyAgICAgZ2xvYmFsX251bV9ieXRlcz1wY2ZnLkdMT0JBTF9CWVRFUywKICAgIC8vICAgICBleHRyYV9wcm9ncmFtX3BhZ2VzPXRvdGFsX3BhZ2VzLAogICAgLy8gKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3NQogICAgLy8gVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBFeHRyYVByb2dyYW1QYWdlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3OQogICAgLy8gZ2xvYmFsX251bV9ieXRlcz1wY2ZnLkdMT0JBTF9CWVRFUywKICAgIHB1c2hpbnQgMwogICAgaXR4bl9maWVsZCBHbG9iYWxOdW1CeXRlU2xpY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNzgKICAgIC8vIGdsb2JhbF9udW1fdWludD1wY2ZnLkdMT0JBTF9VSU5UUywKICAgIHB1c2hpbnQgMjcKICAgIGl0eG5fZmllbGQgR2xvYmFsTnVtVWludAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0Ny0xMTUxCiAgICAvLyAjIGNsZWFyX3N0YXRlX3Byb2dyYW0gaXMgYSB0dXBsZSBvZiAyIEJ5dGVzIGVsZW1lbnRzIHdoZXJlIGVhY2ggaXMgbWF4IDQwOTYgYnl0ZXMKICAgIC8vICMgd2Ugb25seSB1c2UgdGhlIGZpcnN0IGVsZW1lbnQgaGVyZSBhcyB3ZSBhc3N1bWUgdGhlIGNsZWFyIHN0YXRlIHByb2dyYW0gaXMgc21hbGwgZW5vdWdoCiAgICAvLyBjb21waWxlZF9jbGVhcl9zdGF0ZV8xLCBfY29tcGlsZWRfY2xlYXJfc3RhdGVfMiA9IGNvbXBpbGVfY29udHJhY3QoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwKICAgIC8vICkuY2xlYXJfc3RhdGVfcHJvZ3JhbQogICAgcHVzaGJ5dGVzIGJhc2U2NChDb0VCUXc9PSkKICAgIGl0eG5fZmllbGQgQ2xlYXJTdGF0ZVByb2dyYW1QYWdlcwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcHJvdmFsUHJvZ3JhbVBhZ2VzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcHJvdmFsUHJvZ3JhbVBhZ2VzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTczLTExODEKICAgIC8vIHR4ID0gYXJjNC5hYmlfY2FsbCgKICAgIC8vICAgICBwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC5jcmVhdGUsCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICBhcHByb3ZhbF9wcm9ncmFtPShwYWdlXzEsIHBhZ2VfMiksCiAgICAvLyAgICAgY2xlYXJfc3RhdGVfcHJvZ3JhbT1jb21waWxlZF9jbGVhcl9zdGF0ZV8xLAogICAgLy8gICAgIGdsb2JhbF9udW1fdWludD1wY2ZnLkdMT0JBTF9VSU5UUywKICAgIC8vICAgICBnbG9iYWxfbnVtX2J5dGVzPXBjZmcuR0xPQkFMX0JZVEVTLAogICAgLy8gICAgIGV4dHJhX3Byb2dyYW1fcGFnZXM9dG90YWxfcGFnZXMsCiAgICAvLyApCiAgICBwdXNoYnl0ZXMgMHhjYzY5NGVhYSAvLyBtZXRob2QgImNyZWF0ZShhZGRyZXNzKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIENyZWF0ZWRBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTgzCiAgICAvLyBtYnJfYWZ0ZXIgPSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NS0xMTkwCiAgICAvLyAjIFRyYW5zZmVyIGZ1bmRzIHRvIHRoZSBuZXcgUHJvcG9zYWwgQXBwLCBleGNsdWRpbmcgdGhlIE1CUiBuZWVkZWQgZm9yIHRoZSBQcm9wb3NhbCBBcHAKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIC8vICAgICByZWNlaXZlcj10eC5jcmVhdGVkX2FwcC5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudD1zZWxmLm9wZW5fcHJvcG9zYWxfZmVlLnZhbHVlIC0gKG1icl9hZnRlciAtIG1icl9iZWZvcmUpLAogICAgLy8gICAgIGZlZT0wLAogICAgLy8gKS5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NwogICAgLy8gcmVjZWl2ZXI9dHguY3JlYXRlZF9hcHAuYWRkcmVzcywKICAgIGRpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTg4CiAgICAvLyBhbW91bnQ9c2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSAtIChtYnJfYWZ0ZXIgLSBtYnJfYmVmb3JlKSwKICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgLQogICAgdW5jb3ZlciAzCiAgICBzd2FwCiAgICAtCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NS0xMTg2CiAgICAvLyAjIFRyYW5zZmVyIGZ1bmRzIHRvIHRoZSBuZXcgUHJvcG9zYWwgQXBwLCBleGNsdWRpbmcgdGhlIE1CUiBuZWVkZWQgZm9yIHRoZSBQcm9wb3NhbCBBcHAKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExODkKICAgIC8vIGZlZT0wLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTg1LTExOTAKICAgIC8vICMgVHJhbnNmZXIgZnVuZHMgdG8gdGhlIG5ldyBQcm9wb3NhbCBBcHAsIGV4Y2x1ZGluZyB0aGUgTUJSIG5lZWRlZCBmb3IgdGhlIFByb3Bvc2FsIEFwcAogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXR4LmNyZWF0ZWRfYXBwLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50PXNlbGYub3Blbl9wcm9wb3NhbF9mZWUudmFsdWUgLSAobWJyX2FmdGVyIC0gbWJyX2JlZm9yZSksCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE5MgogICAgLy8gc2VsZi5pbmNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMoVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMyMQogICAgLy8gc2VsZi5wZW5kaW5nX3Byb3Bvc2Fscy52YWx1ZSArPSAxCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgOSAvLyAweDcwNjU2ZTY0Njk2ZTY3NWY3MDcyNmY3MDZmNzM2MTZjNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wZW5kaW5nX3Byb3Bvc2FscyBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBieXRlYyA5IC8vIDB4NzA2NTZlNjQ2OTZlNjc1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMyMgogICAgLy8gc2VsZi5wcm9wb3Nlcl9ib3hbcHJvcG9zZXJdLmFjdGl2ZV9wcm9wb3NhbCA9IFRydWUKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgc2V0Yml0CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExOTcKICAgIC8vIHByb3Bvc2VyPVR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTk1LTExOTgKICAgIC8vIHR5cC5OZXdQcm9wb3NhbCgKICAgIC8vICAgICBwcm9wb3NhbF9pZD10eC5jcmVhdGVkX2FwcC5pZCwKICAgIC8vICAgICBwcm9wb3Nlcj1UeG4uc2VuZGVyLAogICAgLy8gKQogICAgc3dhcAogICAgaXRvYgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE5NC0xMTk5CiAgICAvLyBhcmM0LmVtaXQoCiAgICAvLyAgICAgdHlwLk5ld1Byb3Bvc2FsKAogICAgLy8gICAgICAgICBwcm9wb3NhbF9pZD10eC5jcmVhdGVkX2FwcC5pZCwKICAgIC8vICAgICAgICAgcHJvcG9zZXI9VHhuLnNlbmRlciwKICAgIC8vICAgICApCiAgICAvLyApCiAgICBwdXNoYnl0ZXMgMHhmYTc5ZDg0YiAvLyBtZXRob2QgIk5ld1Byb3Bvc2FsKHVpbnQ2NCxhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm9wZW5fcHJvcG9zYWxfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgb3Blbl9wcm9wb3NhbF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkudm90ZV9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnZvdGVfcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyMDMKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjM0CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyMzYtMTIzNwogICAgLy8gIyB2ZXJpZnkgcHJvcG9zYWxfaWQgaWQgaXMgZ2VudWluZSBwcm9wb3NhbAogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIHN3YXAKICAgIGNhbGxzdWIgX2lzX3Byb3Bvc2FsCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIzOS0xMjQwCiAgICAvLyAjIG1ha2Ugc3VyZSB0aGV5J3JlIHZvdGluZyBvbiBiZWhhbGYgb2YgYW4geEdvdgogICAgLy8gZXhpc3RzID0geGdvdl9hZGRyZXNzIGluIHNlbGYueGdvdl9ib3gKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDEKICAgIC8vIGFzc2VydCBleGlzdHMsIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDIKICAgIC8vIHhnb3ZfYm94ID0gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLmNvcHkoKQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDMtMTI0NAogICAgLy8gIyBVcG9uIHZvdGUgdGhlIGFic2VuY2UgdG9sZXJhbmNlIGlzIHJlc2V0CiAgICAvLyBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10udG9sZXJhdGVkX2Fic2VuY2VzID0gc2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDIyIC8vIDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmFic2VuY2VfdG9sZXJhbmNlIGV4aXN0cwogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMiAvLyAzMgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI0NQogICAgLy8gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLmxhc3Rfdm90ZV90aW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0X3RpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaXRvYgogICAgcHVzaGludCA0MAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDkKICAgIC8vIFR4bi5zZW5kZXIgPT0geGdvdl9hZGRyZXNzIG9yIFR4bi5zZW5kZXIgPT0geGdvdl9ib3gudm90aW5nX2FkZHJlc3MKICAgIHR4biBTZW5kZXIKICAgID09CiAgICBibnogdm90ZV9wcm9wb3NhbF9ib29sX3RydWVAMwogICAgdHhuIFNlbmRlcgogICAgZGlnIDEKICAgIGV4dHJhY3QgMCAzMgogICAgPT0KICAgIGJ6IHZvdGVfcHJvcG9zYWxfYm9vbF9mYWxzZUA0Cgp2b3RlX3Byb3Bvc2FsX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCnZvdGVfcHJvcG9zYWxfYm9vbF9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI0Ny0xMjUwCiAgICAvLyAjIFZlcmlmeSB0aGUgY2FsbGVyIGlzIGVpdGhlciB4Z292IGFkZHJlc3Mgb3IgaXRzIHZvdGluZyBhZGRyZXNzCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIFR4bi5zZW5kZXIgPT0geGdvdl9hZGRyZXNzIG9yIFR4bi5zZW5kZXIgPT0geGdvdl9ib3gudm90aW5nX2FkZHJlc3MKICAgIC8vICksIGVyci5NVVNUX0JFX1hHT1ZfT1JfVk9USU5HX0FERFJFU1MKICAgIGFzc2VydCAvLyBNdXN0IGJlIHhnb3Ygb3Igdm90aW5nIGFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNTItMTI1OQogICAgLy8gIyBDYWxsIHRoZSBQcm9wb3NhbCBBcHAgdG8gcmVnaXN0ZXIgdGhlIHZvdGUKICAgIC8vIGVycm9yLCBfdHggPSBhcmM0LmFiaV9jYWxsKAogICAgLy8gICAgIHByb3Bvc2FsX2NvbnRyYWN0LlByb3Bvc2FsLnZvdGUsCiAgICAvLyAgICAgeGdvdl9hZGRyZXNzLAogICAgLy8gICAgIGFwcHJvdmFsX3ZvdGVzLAogICAgLy8gICAgIHJlamVjdGlvbl92b3RlcywKICAgIC8vICAgICBhcHBfaWQ9cHJvcG9zYWxfaWQsCiAgICAvLyApCiAgICBpdHhuX2JlZ2luCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHgxODQxYTBkMiAvLyBtZXRob2QgInZvdGUoYWRkcmVzcyx1aW50NjQsdWludDY0KXN0cmluZyIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGJ1cnkgOQogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGxvZyB2YWx1ZSBpcyBub3QgdGhlIHJlc3VsdCBvZiBhbiBBQkkgcmV0dXJuCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNiAvLyBvbiBlcnJvcjogaW52YWxpZCBhcnJheSBsZW5ndGggaGVhZGVyCiAgICBwdXNoaW50IDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3Igc3RyaW5nCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjEKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIGJ1cnkgNwogICAgcHVzaGludCA0CiAgICA8CiAgICBieiB2b3RlX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAOAogICAgaW50Y18wIC8vIDAKCnZvdGVfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiB2b3RlX3Byb3Bvc2FsX2Vsc2VfYm9keUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MgogICAgLy8gZXJyb3Jfd2l0aG91dF9wcmVmaXggPSBTdHJpbmcuZnJvbV9ieXRlcyhlcnJvci5ieXRlc1s0Ol0pCiAgICBwdXNoaW50IDQKICAgIGRpZyA2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgID49CiAgICBwdXNoaW50IDQKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZGlnIDgKICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2NAogICAgLy8gY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgYnl0ZWMgMTIgLy8gIldyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjY2CiAgICAvLyBjYXNlIGVyci5WT1RFUl9OT1RfRk9VTkQ6CiAgICBieXRlYyA1NiAvLyAiVm90ZXIgbm90IGZvdW5kIgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2OAogICAgLy8gY2FzZSBlcnIuVk9URVNfSU5WQUxJRDoKICAgIHB1c2hieXRlc3MgIlZvdGVzIGludmFsaWQiICJWb3RpbmcgUGVyaW9kIEV4cGlyZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjYzLTEyNzMKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJfTk9UX0ZPVU5EOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIC8vICAgICBjYXNlIGVyci5WT1RFU19JTlZBTElEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVTX0lOVkFMSUQpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9USU5HX1BFUklPRF9FWFBJUkVEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVElOR19QRVJJT0RfRVhQSVJFRCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDQKICAgIG1hdGNoIHZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxMSB2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTIgdm90ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8yQDEzIHZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfM0AxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3MwogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgp2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzNAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjcxCiAgICAvLyBvcC5lcnIoZXJyLlZPVElOR19QRVJJT0RfRVhQSVJFRCkKICAgIGVyciAvLyBWb3RpbmcgUGVyaW9kIEV4cGlyZWQKCnZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMkAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjkKICAgIC8vIG9wLmVycihlcnIuVk9URVNfSU5WQUxJRCkKICAgIGVyciAvLyBWb3RlcyBpbnZhbGlkCgp2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjY3CiAgICAvLyBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIGVyciAvLyBWb3RlciBub3QgZm91bmQKCnZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjUKICAgIC8vIG9wLmVycihlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTKQogICAgZXJyIC8vIFdyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQKCnZvdGVfcHJvcG9zYWxfZWxzZV9ib2R5QDE2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3NQogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyA2CiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIwMwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBkaWcgNwogICAgZXh0cmFjdCA2IDQKICAgIGJ5dGVjIDEzIC8vICJFUlI6IgogICAgPT0KICAgIGIgdm90ZV9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDkKCnZvdGVfcHJvcG9zYWxfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgdm90ZV9wcm9wb3NhbF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkudW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNzcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBpbnRjXzIgLy8gMzIKICAgICoKICAgIHB1c2hpbnQgMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LmR5bmFtaWNfYXJyYXk8YWNjb3VudD4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyOTQKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI5Ni0xMjk3CiAgICAvLyAjIFZlcmlmeSBwcm9wb3NhbF9pZCBpcyBhIGdlbnVpbmUgcHJvcG9zYWwgY3JlYXRlZCBieSB0aGlzIHJlZ2lzdHJ5CiAgICAvLyBhc3NlcnQgc2VsZi5faXNfcHJvcG9zYWwocHJvcG9zYWxfaWQpLCBlcnIuSU5WQUxJRF9QUk9QT1NBTAogICAgY2FsbHN1YiBfaXNfcHJvcG9zYWwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHByb3Bvc2FsCiAgICBpbnRjXzAgLy8gMAoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9mb3JfaGVhZGVyQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjk5LTEzMDEKICAgIC8vICMgVGhlIGxhdGVyIEFCSSBjYWxsIHRvIHRoZSBQcm9wb3NhbCBwYW5pY3MgaWYgYW55IGFic2VudGVlIGlzIGR1cGxpY2F0ZWQsCiAgICAvLyAjIG11bHRpcGxlIG5vbi1pZGVtcG90ZW50IGVmZmVjdHMgYXJlIHJlamVjdGVkIGFmdGVyLgogICAgLy8gZm9yIGFic2VudGVlIGluIGFic2VudGVlczoKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfZm9yQDEwCiAgICBkaWcgMgogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gMzIKICAgICoKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzAyCiAgICAvLyBleGlzdHMgPSBhYnNlbnRlZSBpbiBzZWxmLnhnb3ZfYm94ICAjIHRoZSB4Z292IG1pZ2h0IGhhdmUgdW5zdWJzY3JpYmVkCiAgICBieXRlY18yIC8vIDB4NzgKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBidXJ5IDgKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwMwogICAgLy8gaWYgZXhpc3RzIGFuZCBzZWxmLnhnb3ZfYm94W2Fic2VudGVlXS50b2xlcmF0ZWRfYWJzZW5jZXMgPiAwOgogICAgYnogdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgKICAgIGRpZyA2CiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2JveCBlbnRyeSBleGlzdHMKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA4CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzA0CiAgICAvLyBzZWxmLnhnb3ZfYm94W2Fic2VudGVlXS50b2xlcmF0ZWRfYWJzZW5jZXMgLT0gMQogICAgZGlnIDYKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMiAvLyAzMgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwNQogICAgLy8gaWYgc2VsZi54Z292X2JveFthYnNlbnRlZV0udG9sZXJhdGVkX2Fic2VuY2VzID09IDA6CiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJueiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwNgogICAgLy8gZGVsIHNlbGYueGdvdl9ib3hbYWJzZW50ZWVdCiAgICBkaWcgNgogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzA3CiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDg6CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDEKICAgIGIgdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9mb3JfaGVhZGVyQDIKCnVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfZm9yQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwOS0xMzEzCiAgICAvLyBlcnJvciwgX3R4ID0gYXJjNC5hYmlfY2FsbCgKICAgIC8vICAgICBwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC51bmFzc2lnbl9hYnNlbnRlZXMsCiAgICAvLyAgICAgYWJzZW50ZWVzLAogICAgLy8gICAgIGFwcF9pZD1wcm9wb3NhbF9pZCwKICAgIC8vICkKICAgIGl0eG5fYmVnaW4KICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hieXRlcyAweDc2ZmY0YzcwIC8vIG1ldGhvZCAidW5hc3NpZ25fYWJzZW50ZWVzKGFkZHJlc3NbXSlzdHJpbmciCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGJ1cnkgOQogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGxvZyB2YWx1ZSBpcyBub3QgdGhlIHJlc3VsdCBvZiBhbiBBQkkgcmV0dXJuCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNiAvLyBvbiBlcnJvcjogaW52YWxpZCBhcnJheSBsZW5ndGggaGVhZGVyCiAgICBwdXNoaW50IDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3Igc3RyaW5nCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMTUKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIGJ1cnkgNgogICAgcHVzaGludCA0CiAgICA8CiAgICBieiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTMKICAgIGludGNfMCAvLyAwCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfbWVyZ2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE1CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfZWxzZV9ib2R5QDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE2CiAgICAvLyBlcnJvcl93aXRob3V0X3ByZWZpeCA9IFN0cmluZy5mcm9tX2J5dGVzKGVycm9yLmJ5dGVzWzQ6XSkKICAgIHB1c2hpbnQgNAogICAgZGlnIDUKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIHB1c2hpbnQgNAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBkaWcgNwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE4CiAgICAvLyBjYXNlIGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVM6CiAgICBieXRlYyAxMiAvLyAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjAKICAgIC8vIGNhc2UgZXJyLlZPVEVSX05PVF9GT1VORDoKICAgIGJ5dGVjIDU2IC8vICJWb3RlciBub3QgZm91bmQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE3LTEzMjMKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJfTk9UX0ZPVU5EOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDIKICAgIG1hdGNoIHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxNiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjMKICAgIC8vIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICBlcnIgLy8gVW5rbm93biBlcnJvcgoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8xQDE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyMQogICAgLy8gb3AuZXJyKGVyci5WT1RFUl9OT1RfRk9VTkQpCiAgICBlcnIgLy8gVm90ZXIgbm90IGZvdW5kCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE5CiAgICAvLyBvcC5lcnIoZXJyLldST05HX1BST1BPU0FMX1NUQVRVUykKICAgIGVyciAvLyBXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX2Vsc2VfYm9keUAxOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjUKICAgIC8vIGFzc2VydCBlcnJvciA9PSAiIiwgIlVua25vd24gZXJyb3IiCiAgICBkaWcgNQogICAgcHVzaGJ5dGVzICIiCiAgICA9PQogICAgYXNzZXJ0IC8vIFVua25vd24gZXJyb3IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNzcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE1CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyA3CiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfbWVyZ2VAMTQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucGF5X2dyYW50X3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF5X2dyYW50X3Byb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNDUtMTM0NgogICAgLy8gIyBWZXJpZnkgdGhlIGNhbGxlciBpcyB0aGUgeEdvdiBQYXlvcgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gc2VsZi54Z292X3BheW9yLnZhbHVlLCBlcnIuVU5BVVRIT1JJWkVECiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfcGF5b3IgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM0OC0xMzQ5CiAgICAvLyAjIFZlcmlmeSBwcm9wb3NhbF9pZCBpcyBhIGdlbnVpbmUgcHJvcG9zYWwgY3JlYXRlZCBieSB0aGlzIHJlZ2lzdHJ5CiAgICAvLyBhc3NlcnQgc2VsZi5faXNfcHJvcG9zYWwocHJvcG9zYWxfaWQpLCBlcnIuSU5WQUxJRF9QUk9QT1NBTAogICAgZHVwCiAgICBjYWxsc3ViIF9pc19wcm9wb3NhbAogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNTEtMTM1MgogICAgLy8gIyBSZWFkIHByb3Bvc2FsIHN0YXRlIGRpcmVjdGx5IGZyb20gdGhlIFByb3Bvc2FsIEFwcCdzIGdsb2JhbCBzdGF0ZQogICAgLy8gcHJvcG9zZXIgPSBzZWxmLmdldF9wcm9wb3NhbF9wcm9wb3Nlcihwcm9wb3NhbF9pZCkKICAgIGR1cAogICAgY2FsbHN1YiBnZXRfcHJvcG9zYWxfcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzOS0yNDEKICAgIC8vIHJlcXVlc3RlZF9hbW91bnQsIHJlcXVlc3RlZF9hbW91bnRfZXhpc3RzID0gb3AuQXBwR2xvYmFsLmdldF9leF91aW50NjQoCiAgICAvLyAgICAgcHJvcG9zYWwsIHBjZmcuR1NfS0VZX1JFUVVFU1RFRF9BTU9VTlQKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNDAKICAgIC8vIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9SRVFVRVNURURfQU1PVU5UCiAgICBwdXNoYnl0ZXMgMHg3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM5LTI0MQogICAgLy8gcmVxdWVzdGVkX2Ftb3VudCwgcmVxdWVzdGVkX2Ftb3VudF9leGlzdHMgPSBvcC5BcHBHbG9iYWwuZ2V0X2V4X3VpbnQ2NCgKICAgIC8vICAgICBwcm9wb3NhbCwgcGNmZy5HU19LRVlfUkVRVUVTVEVEX0FNT1VOVAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI0MgogICAgLy8gYXNzZXJ0IHJlcXVlc3RlZF9hbW91bnRfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM1NQogICAgLy8gYXNzZXJ0IHByb3Bvc2VyIGluIHNlbGYucHJvcG9zZXJfYm94LCBlcnIuV1JPTkdfUFJPUE9TRVIKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgZGlnIDIKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gV3JvbmcgcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNTcKICAgIC8vIGFzc2VydCBzZWxmLnZhbGlkX2t5Yyhwcm9wb3NlciksIGVyci5JTlZBTElEX0tZQwogICAgZGlnIDEKICAgIGNhbGxzdWIgdmFsaWRfa3ljCiAgICBhc3NlcnQgLy8gSW52YWxpZCBLWUMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNjEKICAgIC8vIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUgPj0gcmVxdWVzdGVkX2Ftb3VudAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICBkdXAKICAgIGRpZyAyCiAgICA+PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM1OS0xMzYyCiAgICAvLyAjIFZlcmlmeSBzdWZmaWNpZW50IGZ1bmRzIGFyZSBhdmFpbGFibGUKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZSA+PSByZXF1ZXN0ZWRfYW1vdW50CiAgICAvLyApLCBlcnIuSU5TVUZGSUNJRU5UX1RSRUFTVVJZX0ZVTkRTCiAgICBhc3NlcnQgLy8gSW5zdWZmaWNpZW50IHRyZWFzdXJ5IGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNDYtMjQ3CiAgICAvLyAjIFRyYW5zZmVyIHRoZSBmdW5kcyB0byB0aGUgcmVjZWl2ZXIKICAgIC8vIGl0eG4uUGF5bWVudChyZWNlaXZlcj1yZWNpcGllbnQsIGFtb3VudD1hbW91bnQsIGZlZT0wKS5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI0OS0yNTAKICAgIC8vICMgVXBkYXRlIHRoZSBvdXRzdGFuZGluZyBmdW5kcwogICAgLy8gc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZSAtPSBhbW91bnQKICAgIHN3YXAKICAgIC0KICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM2NgogICAgLy8gZXJyb3IsIF90eCA9IGFyYzQuYWJpX2NhbGwocHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuZnVuZCwgYXBwX2lkPXByb3Bvc2FsX2lkKQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHg4YTVlNGM4MCAvLyBtZXRob2QgImZ1bmQoKXN0cmluZyIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXBuIDIKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gbG9nIHZhbHVlIGlzIG5vdCB0aGUgcmVzdWx0IG9mIGFuIEFCSSByZXR1cm4KICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2IC8vIG9uIGVycm9yOiBpbnZhbGlkIGFycmF5IGxlbmd0aCBoZWFkZXIKICAgIHB1c2hpbnQgMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBzdHJpbmcKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNjgKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIHB1c2hpbnQgNAogICAgPAogICAgYnogcGF5X2dyYW50X3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VANAogICAgaW50Y18wIC8vIDAKCnBheV9ncmFudF9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzY4CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGJ6IHBheV9ncmFudF9wcm9wb3NhbF9lbHNlX2JvZHlAOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM2OQogICAgLy8gZXJyb3Jfd2l0aG91dF9wcmVmaXggPSBTdHJpbmcuZnJvbV9ieXRlcyhlcnJvci5ieXRlc1s0Ol0pCiAgICBwdXNoaW50IDQKICAgIGRpZyAxCiAgICBkdXAKICAgIGNvdmVyIDIKICAgID49CiAgICBwdXNoaW50IDQKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZGlnIDMKICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3MQogICAgLy8gY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgYnl0ZWMgMTIgLy8gIldyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzcwLTEzNzQKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBfOgogICAgLy8gICAgICAgICBvcC5lcnIoIlVua25vd24gZXJyb3IiKQogICAgc3dhcAogICAgbWF0Y2ggcGF5X2dyYW50X3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3NAogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpwYXlfZ3JhbnRfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3MgogICAgLy8gb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICBlcnIgLy8gV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZAoKcGF5X2dyYW50X3Byb3Bvc2FsX2Vsc2VfYm9keUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3NgogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyAxCiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnBheV9ncmFudF9wcm9wb3NhbF90ZXJuYXJ5X2ZhbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzY4CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyAyCiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiBwYXlfZ3JhbnRfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmZpbmFsaXplX3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZmluYWxpemVfcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNzgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyNwogICAgLy8gc3RhdHVzLCBzdGF0dXNfZXhpc3RzID0gb3AuQXBwR2xvYmFsLmdldF9leF91aW50NjQocHJvcG9zYWwsIHBjZmcuR1NfS0VZX1NUQVRVUykKICAgIHB1c2hieXRlcyAweDczNzQ2MTc0NzU3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHN3YXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMjgKICAgIC8vIGFzc2VydCBzdGF0dXNfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM5NC0xMzk2CiAgICAvLyBpZiBwcm9wb3NhbF9zdGF0dXMgPT0gVUludDY0KHBlbm0uU1RBVFVTX0VNUFRZKSBvciBwcm9wb3NhbF9zdGF0dXMgPT0gVUludDY0KAogICAgLy8gICAgIHBlbm0uU1RBVFVTX0RSQUZUCiAgICAvLyApOgogICAgYnogZmluYWxpemVfcHJvcG9zYWxfaWZfYm9keUAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTAKICAgID09CiAgICBieiBmaW5hbGl6ZV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQKCmZpbmFsaXplX3Byb3Bvc2FsX2lmX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzOTcKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9kYWVtb24udmFsdWUsIGVyci5VTkFVVEhPUklaRUQKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxOCAvLyAweDc4Njc2Zjc2NWY2NDYxNjU2ZDZmNmUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2RhZW1vbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCgpmaW5hbGl6ZV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzk5LTE0MDAKICAgIC8vICMgVmVyaWZ5IHByb3Bvc2FsX2lkIGlzIGEgZ2VudWluZSBwcm9wb3NhbCBjcmVhdGVkIGJ5IHRoaXMgcmVnaXN0cnkKICAgIC8vIGFzc2VydCBzZWxmLl9pc19wcm9wb3NhbChwcm9wb3NhbF9pZCksIGVyci5JTlZBTElEX1BST1BPU0FMCiAgICBkaWcgMQogICAgZHVwCiAgICBjYWxsc3ViIF9pc19wcm9wb3NhbAogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDItMTQwNAogICAgLy8gZXJyb3IsIF90eCA9IGFyYzQuYWJpX2NhbGwoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuZmluYWxpemUsIGFwcF9pZD1wcm9wb3NhbF9pZAogICAgLy8gKQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHg4MDIwNjliNCAvLyBtZXRob2QgImZpbmFsaXplKClzdHJpbmciCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBidXJ5IDYKICAgIGR1cAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBsb2cgdmFsdWUgaXMgbm90IHRoZSByZXN1bHQgb2YgYW4gQUJJIHJldHVybgogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgcHVzaGludCAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHN0cmluZwogICAgZXh0cmFjdCA2IDAKICAgIGR1cAogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA2CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGxlbgogICAgZHVwCiAgICBidXJ5IDQKICAgIHB1c2hpbnQgNAogICAgPAogICAgYnogZmluYWxpemVfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA3CiAgICBpbnRjXzAgLy8gMAoKZmluYWxpemVfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQwNgogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiBmaW5hbGl6ZV9wcm9wb3NhbF9lbHNlX2JvZHlAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDcKICAgIC8vIGVycm9yX3dpdGhvdXRfcHJlZml4ID0gU3RyaW5nLmZyb21fYnl0ZXMoZXJyb3IuYnl0ZXNbNDpdKQogICAgcHVzaGludCA0CiAgICBkaWcgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICA+PQogICAgcHVzaGludCA0CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGRpZyA1CiAgICBzd2FwCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDkKICAgIC8vIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIGJ5dGVjIDEyIC8vICJXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMQogICAgLy8gY2FzZSBlcnIuVk9URVJTX0FTU0lHTkVEOgogICAgcHVzaGJ5dGVzICJUaGVyZSBhcmUgdm90ZXJzIGFzc2lnbmVkIHRvIHRoaXMgcHJvcG9zYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA4LTE0MTQKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJTX0FTU0lHTkVEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSU19BU1NJR05FRCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDIKICAgIG1hdGNoIGZpbmFsaXplX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBAMTAgZmluYWxpemVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxNAogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpmaW5hbGl6ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8xQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMgogICAgLy8gb3AuZXJyKGVyci5WT1RFUlNfQVNTSUdORUQpCiAgICBlcnIgLy8gVGhlcmUgYXJlIHZvdGVycyBhc3NpZ25lZCB0byB0aGlzIHByb3Bvc2FsCgpmaW5hbGl6ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8wQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMAogICAgLy8gb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICBlcnIgLy8gV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZAoKZmluYWxpemVfcHJvcG9zYWxfZWxzZV9ib2R5QDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxNgogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyAzCiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxOAogICAgLy8gc2VsZi5kZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMocHJvcG9zYWxfaWQpCiAgICBkaWcgMQogICAgY2FsbHN1YiBkZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNzgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpmaW5hbGl6ZV9wcm9wb3NhbF90ZXJuYXJ5X2ZhbHNlQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA2CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyA0CiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiBmaW5hbGl6ZV9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDgKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZHJvcF9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRyb3BfcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDIwCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDM1CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MzctMTQzOAogICAgLy8gIyBWZXJpZnkgcHJvcG9zYWxfaWQgaXMgYSBnZW51aW5lIHByb3Bvc2FsIGNyZWF0ZWQgYnkgdGhpcyByZWdpc3RyeQogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIGR1cAogICAgY2FsbHN1YiBfaXNfcHJvcG9zYWwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQwCiAgICAvLyBwcm9wb3NlciA9IHNlbGYuZ2V0X3Byb3Bvc2FsX3Byb3Bvc2VyKHByb3Bvc2FsX2lkKQogICAgZHVwCiAgICBjYWxsc3ViIGdldF9wcm9wb3NhbF9wcm9wb3NlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0MQogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gcHJvcG9zZXIsIGVyci5VTkFVVEhPUklaRUQKICAgIHR4biBTZW5kZXIKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQzCiAgICAvLyBlcnJvciwgX3R4ID0gYXJjNC5hYmlfY2FsbChwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC5kcm9wLCBhcHBfaWQ9cHJvcG9zYWxfaWQpCiAgICBpdHhuX2JlZ2luCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hieXRlcyAweDczNzEzMjFhIC8vIG1ldGhvZCAiZHJvcCgpc3RyaW5nIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cG4gMgogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBsb2cgdmFsdWUgaXMgbm90IHRoZSByZXN1bHQgb2YgYW4gQUJJIHJldHVybgogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgcHVzaGludCAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHN0cmluZwogICAgZXh0cmFjdCA2IDAKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBsZW4KICAgIGR1cAogICAgcHVzaGludCA0CiAgICA8CiAgICBieiBkcm9wX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VANAogICAgaW50Y18wIC8vIDAKCmRyb3BfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiBkcm9wX3Byb3Bvc2FsX2Vsc2VfYm9keUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQ2CiAgICAvLyBlcnJvcl93aXRob3V0X3ByZWZpeCA9IFN0cmluZy5mcm9tX2J5dGVzKGVycm9yLmJ5dGVzWzQ6XSkKICAgIHB1c2hpbnQgNAogICAgZGlnIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIHB1c2hpbnQgNAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBkaWcgMwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQ4CiAgICAvLyBjYXNlIGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVM6CiAgICBieXRlYyAxMiAvLyAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NDctMTQ1MQogICAgLy8gbWF0Y2ggZXJyb3Jfd2l0aG91dF9wcmVmaXg6CiAgICAvLyAgICAgY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLldST05HX1BST1BPU0FMX1NUQVRVUykKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICBzd2FwCiAgICBtYXRjaCBkcm9wX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ1MQogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpkcm9wX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NDkKICAgIC8vIG9wLmVycihlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTKQogICAgZXJyIC8vIFdyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQKCmRyb3BfcHJvcG9zYWxfZWxzZV9ib2R5QDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDUzCiAgICAvLyBhc3NlcnQgZXJyb3IgPT0gIiIsICJVbmtub3duIGVycm9yIgogICAgZGlnIDEKICAgIHB1c2hieXRlcyAiIgogICAgPT0KICAgIGFzc2VydCAvLyBVbmtub3duIGVycm9yCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDU1CiAgICAvLyBzZWxmLmRlY3JlbWVudF9wZW5kaW5nX3Byb3Bvc2Fscyhwcm9wb3NhbF9pZCkKICAgIGRpZyAzCiAgICBjYWxsc3ViIGRlY3JlbWVudF9wZW5kaW5nX3Byb3Bvc2FscwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQyMAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRyb3BfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBkaWcgMgogICAgZXh0cmFjdCA2IDQKICAgIGJ5dGVjIDEzIC8vICJFUlI6IgogICAgPT0KICAgIGIgZHJvcF9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZGVwb3NpdF9mdW5kc1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlcG9zaXRfZnVuZHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDU3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDcwCiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ2OS0xNDcxCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgLy8gKSwgZXJyLldST05HX1JFQ0VJVkVSCiAgICBhc3NlcnQgLy8gV3JvbmcgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NzIKICAgIC8vIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUgKz0gcGF5bWVudC5hbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzIGV4aXN0cwogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICArCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LndpdGhkcmF3X2Z1bmRzW3JvdXRpbmddKCkgLT4gdm9pZDoKd2l0aGRyYXdfZnVuZHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDc0CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ4OAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDg5CiAgICAvLyBhc3NlcnQgYW1vdW50IDw9IHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUsIGVyci5JTlNVRkZJQ0lFTlRfRlVORFMKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzIGV4aXN0cwogICAgZHVwMgogICAgPD0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZnVuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTAKICAgIC8vIGFzc2VydCBUeG4uZmVlID49IChHbG9iYWwubWluX3R4bl9mZWUgKiAyKSwgZXJyLklOU1VGRklDSUVOVF9GRUUKICAgIHR4biBGZWUKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIHB1c2hpbnQgMgogICAgKgogICAgPj0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDkxCiAgICAvLyBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlIC09IGFtb3VudAogICAgZGlnIDEKICAgIC0KICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5My0xNDk3CiAgICAvLyBpdHhuLlBheW1lbnQoCiAgICAvLyAgICAgcmVjZWl2ZXI9c2VsZi54Z292X21hbmFnZXIudmFsdWUsCiAgICAvLyAgICAgYW1vdW50PWFtb3VudCwKICAgIC8vICAgICBmZWU9MCwKICAgIC8vICkuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTQKICAgIC8vIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDcgLy8gMHg3ODY3NmY3NjVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X21hbmFnZXIgZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5MwogICAgLy8gaXR4bi5QYXltZW50KAogICAgaW50Y18xIC8vIHBheQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5NgogICAgLy8gZmVlPTAsCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTMtMTQ5NwogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIGFtb3VudD1hbW91bnQsCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ3NAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkud2l0aGRyYXdfYmFsYW5jZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CndpdGhkcmF3X2JhbGFuY2U6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTExCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTIKICAgIC8vIGFzc2VydCBUeG4uZmVlID49IChHbG9iYWwubWluX3R4bl9mZWUgKiAyKSwgZXJyLklOU1VGRklDSUVOVF9GRUUKICAgIHR4biBGZWUKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIHB1c2hpbnQgMgogICAgKgogICAgPj0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTE2CiAgICAvLyBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUxNwogICAgLy8gLSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLm1pbl9iYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RNaW5CYWxhbmNlCiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTYtMTUxNwogICAgLy8gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcy5iYWxhbmNlCiAgICAvLyAtIEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MubWluX2JhbGFuY2UKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTgKICAgIC8vIC0gc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTE2LTE1MTgKICAgIC8vIEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MuYmFsYW5jZQogICAgLy8gLSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLm1pbl9iYWxhbmNlCiAgICAvLyAtIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjEKICAgIC8vIGFzc2VydCBhbW91bnQgPiAwLCBlcnIuSU5TVUZGSUNJRU5UX0ZVTkRTCiAgICBkdXAKICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZnVuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjItMTUyNgogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIGFtb3VudD1hbW91bnQsCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTIzCiAgICAvLyByZWNlaXZlcj1zZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA3IC8vIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9tYW5hZ2VyIGV4aXN0cwogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjIKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjUKICAgIC8vIGZlZT0wLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTIyLTE1MjYKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIC8vICAgICByZWNlaXZlcj1zZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSwKICAgIC8vICAgICBhbW91bnQ9YW1vdW50LAogICAgLy8gICAgIGZlZT0wLAogICAgLy8gKS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9zdGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9zdGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MzUKICAgIC8vIHBhdXNlZF9yZWdpc3RyeT1zZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUzNgogICAgLy8gcGF1c2VkX3Byb3Bvc2Fscz1zZWxmLnBhdXNlZF9wcm9wb3NhbHMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9wcm9wb3NhbHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTM3CiAgICAvLyB4Z292X21hbmFnZXI9c2VsZi54Z292X21hbmFnZXIudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfbWFuYWdlciBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MzgKICAgIC8vIHhnb3ZfcGF5b3I9c2VsZi54Z292X3BheW9yLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE1IC8vIDB4Nzg2NzZmNzY1ZjcwNjE3OTZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X3BheW9yIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUzOQogICAgLy8geGdvdl9jb3VuY2lsPXNlbGYueGdvdl9jb3VuY2lsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI1IC8vIDB4Nzg2NzZmNzY1ZjYzNmY3NTZlNjM2OTZjCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9jb3VuY2lsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MAogICAgLy8geGdvdl9zdWJzY3JpYmVyPXNlbGYueGdvdl9zdWJzY3JpYmVyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9zdWJzY3JpYmVyIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MQogICAgLy8ga3ljX3Byb3ZpZGVyPXNlbGYua3ljX3Byb3ZpZGVyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE2IC8vIDB4NmI3OTYzNWY3MDcyNmY3NjY5NjQ2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYua3ljX3Byb3ZpZGVyIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MgogICAgLy8gY29tbWl0dGVlX21hbmFnZXI9c2VsZi5jb21taXR0ZWVfbWFuYWdlci52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxNyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWFuYWdlciBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDMKICAgIC8vIHhnb3ZfZGFlbW9uPXNlbGYueGdvdl9kYWVtb24udmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTggLy8gMHg3ODY3NmY3NjVmNjQ2MTY1NmQ2ZjZlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9kYWVtb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTQ0CiAgICAvLyB4Z292X2ZlZT1zZWxmLnhnb3ZfZmVlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE5IC8vIDB4Nzg2NzZmNzY1ZjY2NjU2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfZmVlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0NQogICAgLy8gcHJvcG9zZXJfZmVlPXNlbGYucHJvcG9zZXJfZmVlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDIwIC8vIDB4NzA3MjZmNzA2ZjczNjU3MjVmNjY2NTY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfZmVlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0NgogICAgLy8gb3Blbl9wcm9wb3NhbF9mZWU9c2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMSAvLyAweDZmNzA2NTZlNWY3MDcyNmY3MDZmNzM2MTZjNWY2NjY1NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDcKICAgIC8vIGRhZW1vbl9vcHNfZnVuZGluZ19icHM9c2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI2IC8vIDB4NjQ2MTY1NmQ2ZjZlNWY2ZjcwNjU3MjYxNzQ2OTZmNmU1ZjY2NzU2ZTY0Njk2ZTY3NWY2MjcwNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0OAogICAgLy8gcHJvcG9zYWxfY29tbWl0bWVudF9icHM9c2VsZi5wcm9wb3NhbF9jb21taXRtZW50X2Jwcy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyNyAvLyAweDcwNzI2ZjcwNmY3MzYxNmM1ZjYzNmY2ZDZkNjk3NDZkNjU2ZTc0NWY2MjcwNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3NhbF9jb21taXRtZW50X2JwcyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDkKICAgIC8vIG1pbl9yZXF1ZXN0ZWRfYW1vdW50PXNlbGYubWluX3JlcXVlc3RlZF9hbW91bnQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjggLy8gMHg2ZDY5NmU1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYubWluX3JlcXVlc3RlZF9hbW91bnQgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTUyCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI5IC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNzM2ZDYxNmM2YwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1MwogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMzAgLy8gMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTU0CiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDMxIC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1MC0xNTU2CiAgICAvLyBtYXhfcmVxdWVzdGVkX2Ftb3VudD1GaXhlZEFycmF5KAogICAgLy8gICAgICgKICAgIC8vICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYubWF4X3JlcXVlc3RlZF9hbW91bnRfbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1OQogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDMyIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU2MAogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzMyAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjEKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNCAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjIKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMzUgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzg2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTU3LTE1NjQKICAgIC8vIGRpc2N1c3Npb25fZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAoCiAgICAvLyAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgLy8gICAgICkKICAgIC8vICksCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTY3CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNiAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbCBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjgKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYudm90aW5nX2R1cmF0aW9uX21lZGl1bSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjkKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3MAogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDM5IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY3ODZjNjE3MjY3NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi52b3RpbmdfZHVyYXRpb25feGxhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU2NS0xNTcyCiAgICAvLyB2b3RpbmdfZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAoCiAgICAvLyAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl94bGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NzUKICAgIC8vIHNlbGYucXVvcnVtX3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQwIC8vIDB4NzE3NTZmNzI3NTZkNWY3MzZkNjE2YzZjCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucXVvcnVtX3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3NgogICAgLy8gc2VsZi5xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQxIC8vIDB4NzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5xdW9ydW1fbWVkaXVtIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3NwogICAgLy8gc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDIgLy8gMHg3MTc1NmY3Mjc1NmQ1ZjZjNjE3MjY3NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5xdW9ydW1fbGFyZ2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTczLTE1NzkKICAgIC8vIHF1b3J1bT1GaXhlZEFycmF5KAogICAgLy8gICAgICgKICAgIC8vICAgICAgICAgc2VsZi5xdW9ydW1fc21hbGwudmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYucXVvcnVtX21lZGl1bS52YWx1ZSwgICMgTm8gbG9uZ2VyIHVzZWQKICAgIC8vICAgICAgICAgc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU4MgogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fc21hbGwudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDMgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi53ZWlnaHRlZF9xdW9ydW1fc21hbGwgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTgzCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0udmFsdWUsICAjIE5vIGxvbmdlciB1c2VkCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDQgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjZkNjU2NDY5NzU2ZAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg0CiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NSAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1ODAtMTU4NgogICAgLy8gd2VpZ2h0ZWRfcXVvcnVtPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgKAogICAgLy8gICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi53ZWlnaHRlZF9xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZS52YWx1ZSwKICAgIC8vICAgICApCiAgICAvLyApLAogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg3CiAgICAvLyBvdXRzdGFuZGluZ19mdW5kcz1zZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg4CiAgICAvLyBwZW5kaW5nX3Byb3Bvc2Fscz1zZWxmLnBlbmRpbmdfcHJvcG9zYWxzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGVuZGluZ19wcm9wb3NhbHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg5CiAgICAvLyBjb21taXR0ZWVfaWQ9c2VsZi5jb21taXR0ZWVfaWQudmFsdWUuY29weSgpLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDU1IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2OTY0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuY29tbWl0dGVlX2lkIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5MAogICAgLy8gY29tbWl0dGVlX21lbWJlcnM9c2VsZi5jb21taXR0ZWVfbWVtYmVycy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NiAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2NTZkNjI2NTcyNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWVtYmVycyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTEKICAgIC8vIGNvbW1pdHRlZV92b3Rlcz1zZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmNvbW1pdHRlZV92b3RlcyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTIKICAgIC8vIGFic2VuY2VfdG9sZXJhbmNlPXNlbGYuYWJzZW5jZV90b2xlcmFuY2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjIgLy8gMHg2MTYyNzM2NTZlNjM2NTVmNzQ2ZjZjNjU3MjYxNmU2MzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuYWJzZW5jZV90b2xlcmFuY2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTkzCiAgICAvLyBnb3Zlcm5hbmNlX3BlcmlvZD1zZWxmLmdvdmVybmFuY2VfcGVyaW9kLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmdvdmVybmFuY2VfcGVyaW9kIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5NAogICAgLy8gY29tbWl0dGVlX2dyYWNlX3BlcmlvZD1zZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTAgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTk1CiAgICAvLyBjb21taXR0ZWVfbGFzdF9hbmNob3I9c2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTM0LTE1OTYKICAgIC8vIHJldHVybiB0eXAuVHlwZWRHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBwYXVzZWRfcmVnaXN0cnk9c2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsCiAgICAvLyAgICAgcGF1c2VkX3Byb3Bvc2Fscz1zZWxmLnBhdXNlZF9wcm9wb3NhbHMudmFsdWUsCiAgICAvLyAgICAgeGdvdl9tYW5hZ2VyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIHhnb3ZfcGF5b3I9c2VsZi54Z292X3BheW9yLnZhbHVlLAogICAgLy8gICAgIHhnb3ZfY291bmNpbD1zZWxmLnhnb3ZfY291bmNpbC52YWx1ZSwKICAgIC8vICAgICB4Z292X3N1YnNjcmliZXI9c2VsZi54Z292X3N1YnNjcmliZXIudmFsdWUsCiAgICAvLyAgICAga3ljX3Byb3ZpZGVyPXNlbGYua3ljX3Byb3ZpZGVyLnZhbHVlLAogICAgLy8gICAgIGNvbW1pdHRlZV9tYW5hZ2VyPXNlbGYuY29tbWl0dGVlX21hbmFnZXIudmFsdWUsCiAgICAvLyAgICAgeGdvdl9kYWVtb249c2VsZi54Z292X2RhZW1vbi52YWx1ZSwKICAgIC8vICAgICB4Z292X2ZlZT1zZWxmLnhnb3ZfZmVlLnZhbHVlLAogICAgLy8gICAgIHByb3Bvc2VyX2ZlZT1zZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSwKICAgIC8vICAgICBvcGVuX3Byb3Bvc2FsX2ZlZT1zZWxmLm9wZW5fcHJvcG9zYWxfZmVlLnZhbHVlLAogICAgLy8gICAgIGRhZW1vbl9vcHNfZnVuZGluZ19icHM9c2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLnZhbHVlLAogICAgLy8gICAgIHByb3Bvc2FsX2NvbW1pdG1lbnRfYnBzPXNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMudmFsdWUsCiAgICAvLyAgICAgbWluX3JlcXVlc3RlZF9hbW91bnQ9c2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudC52YWx1ZSwKICAgIC8vICAgICBtYXhfcmVxdWVzdGVkX2Ftb3VudD1GaXhlZEFycmF5KAogICAgLy8gICAgICAgICAoCiAgICAvLyAgICAgICAgICAgICBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICBkaXNjdXNzaW9uX2R1cmF0aW9uPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgICAgICgKICAgIC8vICAgICAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICB2b3RpbmdfZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAgICAgKAogICAgLy8gICAgICAgICAgICAgc2VsZi52b3RpbmdfZHVyYXRpb25fc21hbGwudmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9sYXJnZS52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX3hsYXJnZS52YWx1ZSwKICAgIC8vICAgICAgICAgKQogICAgLy8gICAgICksCiAgICAvLyAgICAgcXVvcnVtPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgICAgICgKICAgIC8vICAgICAgICAgICAgIHNlbGYucXVvcnVtX3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICAgICAgc2VsZi5xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gICAgICAgICAgICAgc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgICAgICkKICAgIC8vICAgICApLAogICAgLy8gICAgIHdlaWdodGVkX3F1b3J1bT1GaXhlZEFycmF5KAogICAgLy8gICAgICAgICAoCiAgICAvLyAgICAgICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX21lZGl1bS52YWx1ZSwgICMgTm8gbG9uZ2VyIHVzZWQKICAgIC8vICAgICAgICAgICAgIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICBvdXRzdGFuZGluZ19mdW5kcz1zZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlLAogICAgLy8gICAgIHBlbmRpbmdfcHJvcG9zYWxzPXNlbGYucGVuZGluZ19wcm9wb3NhbHMudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2lkPXNlbGYuY29tbWl0dGVlX2lkLnZhbHVlLmNvcHkoKSwKICAgIC8vICAgICBjb21taXR0ZWVfbWVtYmVycz1zZWxmLmNvbW1pdHRlZV9tZW1iZXJzLnZhbHVlLAogICAgLy8gICAgIGNvbW1pdHRlZV92b3Rlcz1zZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSwKICAgIC8vICAgICBhYnNlbmNlX3RvbGVyYW5jZT1zZWxmLmFic2VuY2VfdG9sZXJhbmNlLnZhbHVlLAogICAgLy8gICAgIGdvdmVybmFuY2VfcGVyaW9kPXNlbGYuZ292ZXJuYW5jZV9wZXJpb2QudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2dyYWNlX3BlcmlvZD1zZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2xhc3RfYW5jaG9yPXNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlLAogICAgLy8gKQogICAgYnl0ZWMgOCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzMAogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciAyOQogICAgc2V0Yml0CiAgICB1bmNvdmVyIDI3CiAgICBjb25jYXQKICAgIHVuY292ZXIgMjYKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDI0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMjMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDIxCiAgICBjb25jYXQKICAgIHVuY292ZXIgMjAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxOQogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDE4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTcKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxNgogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDE1CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDEyCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgY29uY2F0CiAgICB1bmNvdmVyIDYKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA1CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNAogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUyOAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF94Z292X2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF94Z292X2JveDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjEwCiAgICAvLyBleGlzdHMgPSB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYxMQogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3hnb3ZfYm94X2Vsc2VfYm9keUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjEyCiAgICAvLyB2YWwgPSBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10uY29weSgpCiAgICBkaWcgMQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9ib3ggZW50cnkgZXhpc3RzCgpnZXRfeGdvdl9ib3hfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICBieXRlYyA4IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBkaWcgMwogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdldF94Z292X2JveF9lbHNlX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2MTQtMTYxOQogICAgLy8gdmFsID0gdHlwLlhHb3ZCb3hWYWx1ZSgKICAgIC8vICAgICB2b3RpbmdfYWRkcmVzcz1BY2NvdW50KCksCiAgICAvLyAgICAgdG9sZXJhdGVkX2Fic2VuY2VzPVVJbnQ2NCgwKSwKICAgIC8vICAgICBsYXN0X3ZvdGVfdGltZXN0YW1wPVVJbnQ2NCgwKSwKICAgIC8vICAgICBzdWJzY3JpcHRpb25fcm91bmQ9VUludDY0KDApLAogICAgLy8gKQogICAgcHVzaGJ5dGVzIGJhc2UzMihBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUEpCiAgICBiIGdldF94Z292X2JveF9hZnRlcl9pZl9lbHNlQDQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZ2V0X3Byb3Bvc2VyX2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9wcm9wb3Nlcl9ib3g6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjIzCiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYzOQogICAgLy8gZXhpc3RzID0gcHJvcG9zZXJfYWRkcmVzcyBpbiBzZWxmLnByb3Bvc2VyX2JveAogICAgYnl0ZWMgNCAvLyAweDcwCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY0MAogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3Byb3Bvc2VyX2JveF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY0MQogICAgLy8gdmFsID0gc2VsZi5wcm9wb3Nlcl9ib3hbcHJvcG9zZXJfYWRkcmVzc10uY29weSgpCiAgICBkaWcgMQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfYm94IGVudHJ5IGV4aXN0cwoKZ2V0X3Byb3Bvc2VyX2JveF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjIzCiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2V0X3Byb3Bvc2VyX2JveF9lbHNlX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NDMtMTY0NwogICAgLy8gdmFsID0gdHlwLlByb3Bvc2VyQm94VmFsdWUoCiAgICAvLyAgICAgYWN0aXZlX3Byb3Bvc2FsPUZhbHNlLAogICAgLy8gICAgIGt5Y19zdGF0dXM9RmFsc2UsCiAgICAvLyAgICAga3ljX2V4cGlyaW5nPVVJbnQ2NCgwKSwKICAgIC8vICkKICAgIGJ5dGVjIDU0IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICBiIGdldF9wcm9wb3Nlcl9ib3hfYWZ0ZXJfaWZfZWxzZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9yZXF1ZXN0X2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9yZXF1ZXN0X2JveDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NTEKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY2NwogICAgLy8gZXhpc3RzID0gcmVxdWVzdF9pZCBpbiBzZWxmLnJlcXVlc3RfYm94CiAgICBpdG9iCiAgICBieXRlYyAyMyAvLyAweDcyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY2OAogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3JlcXVlc3RfYm94X2Vsc2VfYm9keUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjY5CiAgICAvLyB2YWwgPSBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdLmNvcHkoKQogICAgZGlnIDEKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfYm94IGVudHJ5IGV4aXN0cwoKZ2V0X3JlcXVlc3RfYm94X2FmdGVyX2lmX2Vsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NTEKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgYnl0ZWMgOCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDMKICAgIHNldGJpdAogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnZXRfcmVxdWVzdF9ib3hfZWxzZV9ib2R5QDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjcxLTE2NzUKICAgIC8vIHZhbCA9IHR5cC5YR292U3Vic2NyaWJlUmVxdWVzdEJveFZhbHVlKAogICAgLy8gICAgIHhnb3ZfYWRkcj1BY2NvdW50KCksCiAgICAvLyAgICAgb3duZXJfYWRkcj1BY2NvdW50KCksCiAgICAvLyAgICAgcmVsYXRpb25fdHlwZT1VSW50NjQoMCksCiAgICAvLyApCiAgICBieXRlYyA1NyAvLyBiYXNlMzIoQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUEpCiAgICBiIGdldF9yZXF1ZXN0X2JveF9hZnRlcl9pZl9lbHNlQDQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY3OQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjkzCiAgICAvLyBleGlzdHMgPSByZXF1ZXN0X2lkIGluIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3gKICAgIGl0b2IKICAgIGJ5dGVjIDI0IC8vIDB4NzI3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJveF9sZW4KICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2OTQKICAgIC8vIGlmIGV4aXN0czoKICAgIGJ6IGdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY5NQogICAgLy8gdmFsID0gc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveFtyZXF1ZXN0X2lkXS5jb3B5KCkKICAgIGRpZyAxCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBlbnRyeSBleGlzdHMKCmdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjc5CiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94X2Vsc2VfYm9keUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY5Ny0xNzAxCiAgICAvLyB2YWwgPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9QWNjb3VudCgpLAogICAgLy8gICAgIG93bmVyX2FkZHI9QWNjb3VudCgpLAogICAgLy8gICAgIHJlbGF0aW9uX3R5cGU9VUludDY0KDApLAogICAgLy8gKQogICAgYnl0ZWMgNTcgLy8gYmFzZTMyKEFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBKQogICAgYiBnZXRfcmVxdWVzdF91bnN1YnNjcmliZV9ib3hfYWZ0ZXJfaWZfZWxzZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmlzX3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNfcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNzA1CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTcwNwogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIGNhbGxzdWIgX2lzX3Byb3Bvc2FsCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTcwNQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuaXNfeGdvdl9tYW5hZ2VyKCkgLT4gdWludDY0Ogppc194Z292X21hbmFnZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTUKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfbWFuYWdlciBleGlzdHMKICAgID09CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuaXNfeGdvdl9zdWJzY3JpYmVyKCkgLT4gdWludDY0Ogppc194Z292X3N1YnNjcmliZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTgKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9zdWJzY3JpYmVyLnZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTQgLy8gMHg3ODY3NmY3NjVmNzM3NTYyNzM2MzcyNjk2MjY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X3N1YnNjcmliZXIgZXhpc3RzCiAgICA9PQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5Ll9pc19wcm9wb3NhbChwcm9wb3NhbDogdWludDY0KSAtPiB1aW50NjQ6Cl9pc19wcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyMwogICAgLy8gZGVmIF9pc19wcm9wb3NhbChzZWxmLCBwcm9wb3NhbDogQXBwbGljYXRpb24pIC0+IGJvb2w6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyNAogICAgLy8gcmV0dXJuIHByb3Bvc2FsLmNyZWF0b3IgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZnJhbWVfZGlnIC0xCiAgICBhcHBfcGFyYW1zX2dldCBBcHBDcmVhdG9yCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgPT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5nZXRfcHJvcG9zYWxfcHJvcG9zZXIocHJvcG9zYWw6IHVpbnQ2NCkgLT4gYnl0ZXM6CmdldF9wcm9wb3NhbF9wcm9wb3NlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzMQogICAgLy8gZGVmIGdldF9wcm9wb3NhbF9wcm9wb3NlcihzZWxmLCBwcm9wb3NhbDogQXBwbGljYXRpb24pIC0+IEFjY291bnQ6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzMi0yMzQKICAgIC8vIHByb3Bvc2VyX2J5dGVzLCBwcm9wb3Nlcl9leGlzdHMgPSBvcC5BcHBHbG9iYWwuZ2V0X2V4X2J5dGVzKAogICAgLy8gICAgIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9QUk9QT1NFUgogICAgLy8gKQogICAgZnJhbWVfZGlnIC0xCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMzMKICAgIC8vIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9QUk9QT1NFUgogICAgcHVzaGJ5dGVzIDB4NzA3MjZmNzA2ZjczNjU3MgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjMyLTIzNAogICAgLy8gcHJvcG9zZXJfYnl0ZXMsIHByb3Bvc2VyX2V4aXN0cyA9IG9wLkFwcEdsb2JhbC5nZXRfZXhfYnl0ZXMoCiAgICAvLyAgICAgcHJvcG9zYWwsIHBjZmcuR1NfS0VZX1BST1BPU0VSCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM1CiAgICAvLyBhc3NlcnQgcHJvcG9zZXJfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM2CiAgICAvLyByZXR1cm4gQWNjb3VudChwcm9wb3Nlcl9ieXRlcykKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gQWRkcmVzcyBsZW5ndGggaXMgMzIgYnl0ZXMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS52YWxpZF94Z292X3BheW1lbnQocGF5bWVudDogdWludDY0KSAtPiB1aW50NjQ6CnZhbGlkX3hnb3ZfcGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI1MgogICAgLy8gZGVmIHZhbGlkX3hnb3ZfcGF5bWVudChzZWxmLCBwYXltZW50OiBndHhuLlBheW1lbnRUcmFuc2FjdGlvbikgLT4gYm9vbDoKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU0CiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIGZyYW1lX2RpZyAtMQogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU0LTI1NQogICAgLy8gcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgYnogdmFsaWRfeGdvdl9wYXltZW50X2Jvb2xfZmFsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU1CiAgICAvLyBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgZnJhbWVfZGlnIC0xCiAgICBndHhucyBBbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxOSAvLyAweDc4Njc2Zjc2NWY2NjY1NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2ZlZSBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNTQtMjU1CiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIC8vIGFuZCBwYXltZW50LmFtb3VudCA9PSBzZWxmLnhnb3ZfZmVlLnZhbHVlCiAgICBieiB2YWxpZF94Z292X3BheW1lbnRfYm9vbF9mYWxzZUAzCiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjUzLTI1NgogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIC8vICAgICBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgLy8gKQogICAgcmV0c3ViCgp2YWxpZF94Z292X3BheW1lbnRfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI1My0yNTYKICAgIC8vIHJldHVybiAoCiAgICAvLyAgICAgcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyAgICAgYW5kIHBheW1lbnQuYW1vdW50ID09IHNlbGYueGdvdl9mZWUudmFsdWUKICAgIC8vICkKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS52YWxpZF9reWMoYWRkcmVzczogYnl0ZXMpIC0+IHVpbnQ2NDoKdmFsaWRfa3ljOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU4CiAgICAvLyBkZWYgdmFsaWRfa3ljKHNlbGYsIGFkZHJlc3M6IEFjY291bnQpIC0+IGJvb2w6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI2MAogICAgLy8gc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX3N0YXR1cwogICAgYnl0ZWMgNCAvLyAweDcwCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3Nlcl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjAtMjYxCiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyBhbmQgc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX2V4cGlyaW5nID4gR2xvYmFsLmxhdGVzdF90aW1lc3RhbXAKICAgIGJ6IHZhbGlkX2t5Y19ib29sX2ZhbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI2MQogICAgLy8gYW5kIHNlbGYucHJvcG9zZXJfYm94W2FkZHJlc3NdLmt5Y19leHBpcmluZyA+IEdsb2JhbC5sYXRlc3RfdGltZXN0YW1wCiAgICBmcmFtZV9kaWcgMAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfYm94IGVudHJ5IGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjAtMjYxCiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyBhbmQgc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX2V4cGlyaW5nID4gR2xvYmFsLmxhdGVzdF90aW1lc3RhbXAKICAgIGJ6IHZhbGlkX2t5Y19ib29sX2ZhbHNlQDMKICAgIGludGNfMSAvLyAxCgp2YWxpZF9reWNfYm9vbF9tZXJnZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU5LTI2MgogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyAgICAgYW5kIHNlbGYucHJvcG9zZXJfYm94W2FkZHJlc3NdLmt5Y19leHBpcmluZyA+IEdsb2JhbC5sYXRlc3RfdGltZXN0YW1wCiAgICAvLyApCiAgICBzd2FwCiAgICByZXRzdWIKCnZhbGlkX2t5Y19ib29sX2ZhbHNlQDM6CiAgICBpbnRjXzAgLy8gMAogICAgYiB2YWxpZF9reWNfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9jb21taXR0ZWVfYW5jaG9yKCkgLT4gdWludDY0OgpnZXRfY29tbWl0dGVlX2FuY2hvcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI4OAogICAgLy8gciA9IEdsb2JhbC5yb3VuZAogICAgZ2xvYmFsIFJvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyODkKICAgIC8vIHJldHVybiByIC0gKHIgJSBzZWxmLmdvdmVybmFuY2VfcGVyaW9kLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmdvdmVybmFuY2VfcGVyaW9kIGV4aXN0cwogICAgZGlnIDEKICAgIHN3YXAKICAgICUKICAgIC0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5kZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMocHJvcG9zYWw6IHVpbnQ2NCkgLT4gdm9pZDoKZGVjcmVtZW50X3BlbmRpbmdfcHJvcG9zYWxzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI0CiAgICAvLyBkZWYgZGVjcmVtZW50X3BlbmRpbmdfcHJvcG9zYWxzKHNlbGYsIHByb3Bvc2FsOiBBcHBsaWNhdGlvbikgLT4gTm9uZToKICAgIHByb3RvIDEgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI1CiAgICAvLyBzZWxmLnBlbmRpbmdfcHJvcG9zYWxzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA5IC8vIDB4NzA2NTZlNjQ2OTZlNjc1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBlbmRpbmdfcHJvcG9zYWxzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI2CiAgICAvLyBwcm9wb3NlciA9IHNlbGYuZ2V0X3Byb3Bvc2FsX3Byb3Bvc2VyKHByb3Bvc2FsKQogICAgZnJhbWVfZGlnIC0xCiAgICBjYWxsc3ViIGdldF9wcm9wb3NhbF9wcm9wb3NlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI3CiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFtwcm9wb3Nlcl0uYWN0aXZlX3Byb3Bvc2FsID0gRmFsc2UKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBkdXAKICAgIHNldGJpdAogICAgaW50Y18wIC8vIDAKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkubWFrZV94Z292X2JveCh2b3RpbmdfYWRkcmVzczogYnl0ZXMpIC0+IGJ5dGVzOgptYWtlX3hnb3ZfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI5CiAgICAvLyBkZWYgbWFrZV94Z292X2JveChzZWxmLCB2b3RpbmdfYWRkcmVzczogQWNjb3VudCkgLT4gdHlwLlhHb3ZCb3hWYWx1ZToKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzQxCiAgICAvLyB0b2xlcmF0ZWRfYWJzZW5jZXM9c2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMiAvLyAweDYxNjI3MzY1NmU2MzY1NWY3NDZmNmM2NTcyNjE2ZTYzNjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5hYnNlbmNlX3RvbGVyYW5jZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM0MwogICAgLy8gc3Vic2NyaXB0aW9uX3JvdW5kPUdsb2JhbC5yb3VuZCwKICAgIGdsb2JhbCBSb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzM5LTM0NAogICAgLy8gcmV0dXJuIHR5cC5YR292Qm94VmFsdWUoCiAgICAvLyAgICAgdm90aW5nX2FkZHJlc3M9dm90aW5nX2FkZHJlc3MsCiAgICAvLyAgICAgdG9sZXJhdGVkX2Fic2VuY2VzPXNlbGYuYWJzZW5jZV90b2xlcmFuY2UudmFsdWUsCiAgICAvLyAgICAgbGFzdF92b3RlX3RpbWVzdGFtcD1VSW50NjQoMCksCiAgICAvLyAgICAgc3Vic2NyaXB0aW9uX3JvdW5kPUdsb2JhbC5yb3VuZCwKICAgIC8vICkKICAgIHN3YXAKICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNDIKICAgIC8vIGxhc3Rfdm90ZV90aW1lc3RhbXA9VUludDY0KDApLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMzOS0zNDQKICAgIC8vIHJldHVybiB0eXAuWEdvdkJveFZhbHVlKAogICAgLy8gICAgIHZvdGluZ19hZGRyZXNzPXZvdGluZ19hZGRyZXNzLAogICAgLy8gICAgIHRvbGVyYXRlZF9hYnNlbmNlcz1zZWxmLmFic2VuY2VfdG9sZXJhbmNlLnZhbHVlLAogICAgLy8gICAgIGxhc3Rfdm90ZV90aW1lc3RhbXA9VUludDY0KDApLAogICAgLy8gICAgIHN1YnNjcmlwdGlvbl9yb3VuZD1HbG9iYWwucm91bmQsCiAgICAvLyApCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgcmV0c3ViCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBhbGdvcHkuYXJjNC5BUkM0Q29udHJhY3QuY2xlYXJfc3RhdGVfcHJvZ3JhbSgpIC0+IHVpbnQ2NDoKbWFpbjoKICAgIHB1c2hpbnQgMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CiAFAAEgCIAQJjsPcGF1c2VkX3JlZ2lzdHJ5BXhnb3ZzAXgEFR98dQFwEW91dHN0YW5kaW5nX2Z1bmRzAnBhDHhnb3ZfbWFuYWdlcgEAEXBlbmRpbmdfcHJvcG9zYWxzEHBhdXNlZF9wcm9wb3NhbHMKcmVxdWVzdF9pZCJXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkBEVSUjoPeGdvdl9zdWJzY3JpYmVyCnhnb3ZfcGF5b3IMa3ljX3Byb3ZpZGVyEWNvbW1pdHRlZV9tYW5hZ2VyC3hnb3ZfZGFlbW9uCHhnb3ZfZmVlDHByb3Bvc2VyX2ZlZRFvcGVuX3Byb3Bvc2FsX2ZlZRFhYnNlbmNlX3RvbGVyYW5jZQFyAnJ1DHhnb3ZfY291bmNpbBxkYWVtb25fb3BlcmF0aW9uX2Z1bmRpbmdfYnBzF3Byb3Bvc2FsX2NvbW1pdG1lbnRfYnBzFG1pbl9yZXF1ZXN0ZWRfYW1vdW50Gm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsG21heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bRptYXhfcmVxdWVzdGVkX2Ftb3VudF9sYXJnZRlkaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsGmRpc2N1c3Npb25fZHVyYXRpb25fbWVkaXVtGWRpc2N1c3Npb25fZHVyYXRpb25fbGFyZ2UaZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UVdm90aW5nX2R1cmF0aW9uX3NtYWxsFnZvdGluZ19kdXJhdGlvbl9tZWRpdW0Vdm90aW5nX2R1cmF0aW9uX2xhcmdlFnZvdGluZ19kdXJhdGlvbl94bGFyZ2UMcXVvcnVtX3NtYWxsDXF1b3J1bV9tZWRpdW0McXVvcnVtX2xhcmdlFXdlaWdodGVkX3F1b3J1bV9zbWFsbBZ3ZWlnaHRlZF9xdW9ydW1fbWVkaXVtFXdlaWdodGVkX3F1b3J1bV9sYXJnZRFjb21taXR0ZWVfbWVtYmVycw9jb21taXR0ZWVfdm90ZXMSbWF4X2NvbW1pdHRlZV9zaXplEWdvdmVybmFuY2VfcGVyaW9kFmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QEUQmasBVjb21taXR0ZWVfbGFzdF9hbmNob3IEsTJIYAkAAAAAAAAAAAAMY29tbWl0dGVlX2lkD1ZvdGVyIG5vdCBmb3VuZEgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMRhAALUxNYEcEkQxNIEkEkQxNxREMTYURCcHMgNnJw4yA2cnDzIDZycZMgNnJxAyA2cnETIDZycSMgNnKCJnJwoiZycFImcnEyJnJxQiZycVImcnGiJnJxsiZyccImcnHSJnJx4iZycfImcnICJnJyEiZyciImcnIyJnJyQiZyclImcnJiJnJyciZycoImcnKSJnJyoiZycrImcnLCJnJy0iZycuImcnLyJnJzAiZykiZycJImcnCyJngARJVsGrNhoAjgEBVTEZFEQxGEEBPoIsBJWcR2IEbB9WTQThClEuBIb34OYEOeJtigSYNS6GBPpO1uIE1snMGgRcSEBVBDfWrfEE1CFrbgS6hSDyBBZ3sw4EhLfSaASvfxhgBKCCzvgE3zn9uQTKD2o6BEUHc5AEPDG8AgQNLHiRBJP6zboEzos6HAT66ggfBA2ieIUEek/uQwRS3RDXBNTTemQENDSdzAQVj43WBF/iWTUE2ye5rwT1kQdWBGVhCp8E/caVwgS6kKtUBLO1hIIEauXrRgQnYw1lBIJPmLwESVSLoASCZ4T2BCaYMgAE29g92TYaAI4sACkATABxAHsAhACOAJcAoQCzAMUA1wDpAPsBDQEfAzwDfwOkA9QELwRuBIQE3gUPBSUFaAWmBf4GaQeJCKsJqwp3C1QL4gwCDDoMbQ3hDkMObg6bDsgAAQAjQ4AETFxhujYaAI4BAA0AMRmBBBIxGBBEQgMZJwcxAGcnOkkSRCNDNhoBSRUlEkQXiA6iRCcGvUUBQQAGJwZM0yNDJwZMuUhC//Y2GgFJFSUSRBc2GgJJIlmBAghLARUSRFcCAIgObkQnBk4CuyNDiA5jRCcGvEgjQ4gOWUQoI2cjQ4gOUEQnCiNnI0OIDkZEKCJnI0OIDj1EJwoiZyNDNhoBSRUkEkSIDitEJwdMZyNDNhoBSRUkEkSIDhlEJw9MZyNDNhoBSRUkEkSIDgdEJxlMZyNDNhoBSRUkEkSIDfVEJw5MZyNDNhoBSRUkEkSIDeNEJxBMZyNDNhoBSRUkEkSIDdFEJxFMZyNDNhoBSRUkEkSIDb9EJxJMZyNDIkcEgABHDTYaAUcCFYHQARJEiA2jRCJbSYHUqQIPQQHySYHUkAIPQQHpI0RLAUklW0lFCYHklgEPRIEoW0lFB0EBzEsBVzAYSUUTIltJRQZLBg1BAblLESVbSUUFSwUNQQGsSxGBEFtLBA1BAaEjREsBSYEQW0lOAkURSYEYW0lFE0sCC4GQTgqB5MWDCAhLAksBDURPAkwJgdSTAQonMExnV0ggSUUSIltJRQRBAVtLECVbSUUPSwMPQQFOSxCBEFtJRQ5LDg9BAUBLEIEYW0sND0EBNSNESwFXaCBJRRYiW0lFDUEBH0sUJVtJRQxLDA9BARJLFIEQW0lFC0sLD0EBBEsUgRhbSwoPQQD5I0RLAVeIGElFFSJbSUUKQQDjSxOBEFtLCQ1BANgjREsBV6AYSUUUIltJRQlBAMJLEoEQW0sIDUEAtyNEJxNLAWcnFEsHZycVSw9nJxpLEGdLAUkkWycbTGcnHEsHZ0lXMBhJIlsnHUxnSSVbJx5MZ4EQWycfTGcnIEsEZ0sRSSVbJyFMZ0mBEFsnIkxngRhbJyNMZyckSw1nSxVJJVsnJUxnSYEQWycmTGeBGFsnJ0xnJyhLCmcnKSJnSxSBEFsnKkxnJytLCWcnLCJnSxOBEFsnLUxnSYG4AVsnFkxnSYHAAVsnMUxngcgBWycyTGcjQyJC/0YiQv8lIkL/BCJC/sgiQv5cIkL+FIgLnkQjQzYaAUkVJBJEMRYjCUk4ECMSRCIoZUQURCoxAFC9RQEURIgLrERJiAwXKjEAUEy/IillRCMIKUxnMQBMUCc1TFCwI0MiKGVEFEQqMQBQvUUBRCoxAFC8SCIpZUQjCSlMZyczMQBQsCNDNhoBSRUkEkQiKGVEFEQqSwFQSb1FAURJvkgkWxREvEgiKWVEIwkpTGcnM0xQsCNDNhoBSRUkEkQ2GgJJFSQSRDYaA0kVJRJEFzEWIwlJOBAjEkQxAEsDEkQiKGVEFEQqSwRQvUUBFESICv1EIicLZURPA08DUE8CFlBLARYnF0xQTL8jCCcLTGcjQzYaAUkVJRJEF4gKpUQWJxdMUEm+RElXICBJiAswTwJXACAqSwFQTwK/IillRCMIKUxnTwK8SExQJzVMULAjQzYaAUkVJRJEF4gKZkQWJxdMULxII0M2GgFJFSQSRDYaAkkVJBJENhoDSRUlEkQXMRYjCUk4ECMSRDEASwMSRCIoZUQURCpLBFC9RQFEiApORCInC2VETwNPA1BPAhZQSwEWJxhMUEy/IwgnC0xnI0M2GgFJFSUSRBeICfZEFicYTFBJvkRXACAqSwFQvEgiKWVEIwkpTGdMvEgnM0xQsCNDNhoBSRUlEkQXiAnFRBYnGExQvEgjQzYaAUcCFSQSRDYaAklOAhUkEkQiKGVEFEQqTFBHAr1FAUS+SDEATFcAIBJAAAgxAEsDEkEACSNESSJLA7sjQyJC//QxFiMJSTgQIxJEIihlRBREJwQxAFC9RQEUREk4BzIKEkQ4CCInFGVEEkQnBDEAUCc2v4AEvXkv0TEAULAjQzYaAUkVJBJENhoCSRUjEkQiUzYaA0kVJRJEFzEAIicQZUQSRCcESwNQSb1FAURJvkgiUycIIk8CVCNPBFRPAhZQv0mICT4nCCJPAlRQgATLUP2ETFCwI0M2GgFJFSQSRDYaAkkVJRJEFzYaA0kVJRJEFzEAIicRZUQSREsBRElEIicwZURLAg9EJzdLA2cnLksCZycvSwFniAkMJzRMZ0wWSZMkDkRXBARMFkmTJA5EVwQETgJQTFCABIc2WGZMULAjQzEWIwlJOBAjEkQiKGVEFEQiJwplRBREiAjKMgZLAQlMIic0ZUQSQAALIicyZURLAQ9BAOgjRCcEMQBQvUUBRCcEMQBQvkQiUxREMQCICHJEMQEyAIEDCw9ESwFJOAcyChJEOAgiJxVlRExLARJEMgpzAEQnBr1ESYEECCEECkmBAhJESSMJIQQLTwJMCScGIiEEuicGIQRPA7qxMQBPA7I4gQOyNYEbsjSABAqBAUOyQk8CskBMskCABMxpTqqyGrIagQayECKyAbO0PTIKcwBEsUsBcghETE8DCU8DTAmyCLIHI7IQIrIBszEAIicJZUQjCCcJTGcnBExQSSIjuiIjVCJMuzEATBZJTwJQgAT6edhLTFCwK0xQsCNDIkL/FSJJgAA2GgFJFSUSRBdJNhoCSU4CSRUkEkQ2GgNJTgMVJRJENhoESU4DFSUSRCIoZUQUREyIBylEKksBUEm9RQFESb5ITgIiJxZlRBZLASRPArsyBxaBKEy7MQASQAALMQBLAVcAIBJBALQjRLFLBLIYgAQYQaDSshpLA7IaSwKyGksBshqBBrIQIrIBs7Q+SUUJSVcABCsSRElXBABJIlmBAghMFRJEVwYASUUIFUlFB4EEDEEAXCJBAFCBBEsGSU4CD4EESwJPAk1LCExPAlInDCc4ggINVm90ZXMgaW52YWxpZBVWb3RpbmcgUGVyaW9kIEV4cGlyZWRPBI4EAAQAAwACAAEAAAAAAEsGgAASRCNDSwdXBgQnDRJC/5oiQv9JIkcCgAA2GgFJFSUSRBdJNhoCSU4CSSJZSU4DJAuBAghMFRJEIihlRBREiAYRRCJJSwIMQQBMSwJXAgBLASQLJFgqTFBJRQi9RQFBAC1LBr5EJFtBACRLBkm+RCRbIwkWSwEkTwK7vkgkW0AADUsGvEgiKWVEIwkpTGdJIwhFAUL/rbFLA7IYgAR2/0xwshpLArIagQayECKyAbO0PklFCUlXAAQrEkRJVwQASSJZgQIITBUSRFcGAElFBxVJRQaBBAxBADAiQQAkgQRLBUlOAg+BBEsCTwJNSwdMTwJSJwwnOE8CjgIAAgABAAAASwWAABJEI0NLB1cGBCcNEkL/xjYaAUkVJRJEFzEAIicPZUQSREmIBShESYgFL0sBgBByZXF1ZXN0ZWRfYW1vdW50ZUQnBEsCUL1FAURLAYgFQEQiJwVlRElLAg9EsUsBsghPArIHI7IQIrIBs0wJJwVMZ7GyGIAEil5MgLIagQayECKyAbO0PkcCVwAEKxJESVcEAEkiWYECCEwVEkRXBgBJFUmBBAxBACoiQQAegQRLAUlOAg+BBEsCTwJNSwNMTwJSJwxMjgEAAQAASwGAABJEI0NLAlcGBCcNEkL/zCJJgAA2GgFJFSUSRBdJgAZzdGF0dXNlTElPAkRBAAdJgQoSQQAJMQAiJxJlRBJESwFJiAQ9RLGyGIAEgCBptLIagQayECKyAbO0PklFBklXAAQrEkRJVwQASSJZgQIITBUSRFcGAElFBRVJRQSBBAxBAF8iQQBOgQRLA0lOAg+BBEsCTwJNSwVMTwJSJwyAKlRoZXJlIGFyZSB2b3RlcnMgYXNzaWduZWQgdG8gdGhpcyBwcm9wb3NhbE8CjgIAAgABAAAASwOAABJESwGIBBMjQ0sEVwYEJw0SQv+XNhoBSRUlEkQXSSIoZUQUREmIA4FESYgDiDEAEkSxshiABHNxMhqyGoEGshAisgGztD5HAlcABCsSRElXBABJIlmBAghMFRJEVwYASRVJgQQMQQAvIkEAHoEESwFJTgIPgQRLAk8CTUsDTE8CUicMTI4BAAEAAEsBgAASREsDiAOFI0NLAlcGBCcNEkL/xzEWIwlJOBAjEkRJOAcyChJEIicFZURMOAgIJwVMZyNDNhoBSRUlEkQXiALJRCInBWVESg5EMQEyAIECCw9ESwEJJwVMZ7EiJwdlRLIHsggjshAisgGzI0OIAppEMQEyAIECCw9EMgpzAEQyCnMBRAkiJwVlRAlJRLEiJwdlRLIHsggjshAisgGzI0MiKGVEIicKZUQiJwdlRCInD2VEIicZZUQiJw5lRCInEGVEIicRZUQiJxJlRCInE2VEIicUZUQiJxVlRCInGmVEIicbZUQiJxxlRCInHWVEIiceZUQiJx9lRE8CFk8CFlBMFlAiJyBlRCInIWVEIiciZUQiJyNlRE8DFk8DFlBPAhZQTBZQIickZUQiJyVlRCInJmVEIicnZURPAxZPAxZQTwIWUEwWUCInKGVEIicpZUQiJyplRE8CFk8CFlBMFlAiJytlRCInLGVEIictZURPAhZPAhZQTBZQIicFZUQiJwllRCInN2VEIicuZUQiJy9lRCInFmVEIicxZUQiJzJlRCInNGVEJwgiTx5UI08dVE8bUE8aUE8ZUE8YUE8XUE8WUE8VUE8UFlBPExZQTxIWUE8RFlBPEBZQTw8WUE8OUE8NUE8MUE8LUE8KUE8JFlBPCBZQTwdQTwYWUE8FFlBPBBZQTwMWUE8CFlBMFlArTFCwI0M2GgFJFSQSRCpMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDgDgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEL/tjYaAUkVJBJEJwRMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzZC/+42GgFJFSUSRBcWJxdMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzlC/+42GgFJFSUSRBcWJxhMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzlC/+42GgFJFSUSRBeIABVEI0MxACInB2VEEokxACInDmVEEomKAQGL/3IHRDIKEomKAQGL/4AIcHJvcG9zZXJlREkVJBJEiYoBAYv/OAcyChJBAA+L/zgIIicTZUQSQQACI4kiiYoBAScEi/9QSb5EI1NBAA+LAL5EI1syBw1BAAMjTIkiQv/6MgYiJzFlREsBTBgJiYoBACInCWVEIwknCUxni/+I/4gnBExQSSIjuiJJVCJMu4mKAQEiJxZlRDIGTBaL/0xQIhZQTBZQiQ==\",\"clear\":\"CoEBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[4373],\"errorMessage\":\"Address length is 32 bytes\"},{\"pc\":[1932],\"errorMessage\":\"Already a proposer\"},{\"pc\":[1383,1558],\"errorMessage\":\"Already an xGov\"},{\"pc\":[2217],\"errorMessage\":\"Committee is stale\"},{\"pc\":[2105],\"errorMessage\":\"Committee size is too large\"},{\"pc\":[2186],\"errorMessage\":\"Creation of proposals is paused\"},{\"pc\":[1025],\"errorMessage\":\"Inconsistent discussion duration config\"},{\"pc\":[1110],\"errorMessage\":\"Inconsistent quorum config\"},{\"pc\":[921],\"errorMessage\":\"Inconsistent requested amount config\"},{\"pc\":[1081],\"errorMessage\":\"Inconsistent voting duration config\"},{\"pc\":[1139],\"errorMessage\":\"Inconsistent weighted quorum config\"},{\"pc\":[2252,3626,3665],\"errorMessage\":\"Insufficient fee\"},{\"pc\":[3617,3684],\"errorMessage\":\"Insufficient funds\"},{\"pc\":[3071],\"errorMessage\":\"Insufficient treasury funds\"},{\"pc\":[2243,3061],\"errorMessage\":\"Invalid KYC\"},{\"pc\":[958],\"errorMessage\":\"Invalid open proposal fee\"},{\"pc\":[1387,1562,1737],\"errorMessage\":\"Invalid payment\"},{\"pc\":[2507,2787,3020,3255,3443,4319],\"errorMessage\":\"Invalid proposal\"},{\"pc\":[2294],\"errorMessage\":\"Invalid proposal approval program size\"},{\"pc\":[867],\"errorMessage\":\"Invalid proposer fee\"},{\"pc\":[853],\"errorMessage\":\"Invalid xGov fee\"},{\"pc\":[3046,3229,4368],\"errorMessage\":\"Missing key in state\"},{\"pc\":[2282],\"errorMessage\":\"Missing proposal approval program\"},{\"pc\":[2559],\"errorMessage\":\"Must be xgov or voting address\"},{\"pc\":[1477,1733],\"errorMessage\":\"Not an xGov\"},{\"pc\":[2237],\"errorMessage\":\"Proposer already has an active proposal\"},{\"pc\":[2014],\"errorMessage\":\"Proposer does not exist\"},{\"pc\":[1374,1423,1468,1549,1725,1864,1922,2179,2502,2783,3438],\"errorMessage\":\"Registry's non-admin methods are paused\"},{\"pc\":[3397],\"errorMessage\":\"There are voters assigned to this proposal\"},{\"pc\":[576,628,639,649,658,668,677,695,713,731,749,767,785,803,831,1348,1431,1484,1543,1606,1669,1719,1781,1830,1873,1895,2004,2091,2226,2516,3015,3248,3451,3609,3656],\"errorMessage\":\"Unauthorized\"},{\"pc\":[2714,2724,2976,2984,3181,3188,3396,3404,3539,3546],\"errorMessage\":\"Unknown error\"},{\"pc\":[2717,2977],\"errorMessage\":\"Voter not found\"},{\"pc\":[2716],\"errorMessage\":\"Votes invalid\"},{\"pc\":[2715],\"errorMessage\":\"Voting Period Expired\"},{\"pc\":[2094],\"errorMessage\":\"Wrong Committee Members\"},{\"pc\":[2096],\"errorMessage\":\"Wrong Committee Votes\"},{\"pc\":[10],\"errorMessage\":\"Wrong Global Bytes allocation\"},{\"pc\":[16],\"errorMessage\":\"Wrong Global UInts allocation\"},{\"pc\":[20],\"errorMessage\":\"Wrong Local Bytes allocation\"},{\"pc\":[24],\"errorMessage\":\"Wrong Local UInts allocation\"},{\"pc\":[2718,2978,3182,3398,3540],\"errorMessage\":\"Wrong Proposal Status or finalized\"},{\"pc\":[1939,2261,3581],\"errorMessage\":\"Wrong Receiver\"},{\"pc\":[1948,2273],\"errorMessage\":\"Wrong payment amount\"},{\"pc\":[3055],\"errorMessage\":\"Wrong proposer\"},{\"pc\":[2278,2372,3670,3675],\"errorMessage\":\"account funded\"},{\"pc\":[2378,4347],\"errorMessage\":\"application exists\"},{\"pc\":[2604,2908,3122,3286,3480],\"errorMessage\":\"application log value is not the result of an ABI return\"},{\"pc\":[2526,3950,4494],\"errorMessage\":\"check self.absence_tolerance exists\"},{\"pc\":[2209,3960],\"errorMessage\":\"check self.committee_grace_period exists\"},{\"pc\":[3935],\"errorMessage\":\"check self.committee_id exists\"},{\"pc\":[2200,3965],\"errorMessage\":\"check self.committee_last_anchor exists\"},{\"pc\":[2089,3742],\"errorMessage\":\"check self.committee_manager exists\"},{\"pc\":[3940],\"errorMessage\":\"check self.committee_members exists\"},{\"pc\":[3945],\"errorMessage\":\"check self.committee_votes exists\"},{\"pc\":[3767],\"errorMessage\":\"check self.daemon_ops_funding_bps exists\"},{\"pc\":[3817],\"errorMessage\":\"check self.discussion_duration_large exists\"},{\"pc\":[3812],\"errorMessage\":\"check self.discussion_duration_medium exists\"},{\"pc\":[3807],\"errorMessage\":\"check self.discussion_duration_small exists\"},{\"pc\":[3822],\"errorMessage\":\"check self.discussion_duration_xlarge exists\"},{\"pc\":[3955,4446],\"errorMessage\":\"check self.governance_period exists\"},{\"pc\":[2002,3737],\"errorMessage\":\"check self.kyc_provider exists\"},{\"pc\":[2101],\"errorMessage\":\"check self.max_committee_size exists\"},{\"pc\":[3792],\"errorMessage\":\"check self.max_requested_amount_large exists\"},{\"pc\":[3787],\"errorMessage\":\"check self.max_requested_amount_medium exists\"},{\"pc\":[3782],\"errorMessage\":\"check self.max_requested_amount_small exists\"},{\"pc\":[3777],\"errorMessage\":\"check self.min_requested_amount exists\"},{\"pc\":[2268,3762],\"errorMessage\":\"check self.open_proposal_fee exists\"},{\"pc\":[3066,3586,3614,3681,3925],\"errorMessage\":\"check self.outstanding_funds exists\"},{\"pc\":[2184,3712],\"errorMessage\":\"check self.paused_proposals exists\"},{\"pc\":[1372,1421,1466,1547,1723,1862,1920,2177,2500,2781,3436,3707],\"errorMessage\":\"check self.paused_registry exists\"},{\"pc\":[2404,3930,4460],\"errorMessage\":\"check self.pending_proposals exists\"},{\"pc\":[3772],\"errorMessage\":\"check self.proposal_commitment_bps exists\"},{\"pc\":[2233,4198,4415,4424],\"errorMessage\":\"check self.proposer_box entry exists\"},{\"pc\":[1946,3757],\"errorMessage\":\"check self.proposer_fee exists\"},{\"pc\":[3885],\"errorMessage\":\"check self.quorum_large exists\"},{\"pc\":[3880],\"errorMessage\":\"check self.quorum_medium exists\"},{\"pc\":[3875],\"errorMessage\":\"check self.quorum_small exists\"},{\"pc\":[1614,4243],\"errorMessage\":\"check self.request_box entry exists\"},{\"pc\":[1567,1742],\"errorMessage\":\"check self.request_id exists\"},{\"pc\":[1789,4288],\"errorMessage\":\"check self.request_unsubscribe_box entry exists\"},{\"pc\":[3851],\"errorMessage\":\"check self.voting_duration_large exists\"},{\"pc\":[3846],\"errorMessage\":\"check self.voting_duration_medium exists\"},{\"pc\":[3841],\"errorMessage\":\"check self.voting_duration_small exists\"},{\"pc\":[3856],\"errorMessage\":\"check self.voting_duration_xlarge exists\"},{\"pc\":[3910],\"errorMessage\":\"check self.weighted_quorum_large exists\"},{\"pc\":[3905],\"errorMessage\":\"check self.weighted_quorum_medium exists\"},{\"pc\":[3900],\"errorMessage\":\"check self.weighted_quorum_small exists\"},{\"pc\":[2822,2832,4099],\"errorMessage\":\"check self.xgov_box entry exists\"},{\"pc\":[3727],\"errorMessage\":\"check self.xgov_council exists\"},{\"pc\":[3246,3747],\"errorMessage\":\"check self.xgov_daemon exists\"},{\"pc\":[3752,4396],\"errorMessage\":\"check self.xgov_fee exists\"},{\"pc\":[3639,3690,3717,4328],\"errorMessage\":\"check self.xgov_manager exists\"},{\"pc\":[3013,3722],\"errorMessage\":\"check self.xgov_payor exists\"},{\"pc\":[3732,4337],\"errorMessage\":\"check self.xgov_subscriber exists\"},{\"pc\":[1401,1441,1490,1638,1802,2858],\"errorMessage\":\"check self.xgovs exists\"},{\"pc\":[2806],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[613,2611,2765,2915,3129,3293,3487],\"errorMessage\":\"invalid array length header\"},{\"pc\":[1984],\"errorMessage\":\"invalid number of bytes for arc4.bool\"},{\"pc\":[2777],\"errorMessage\":\"invalid number of bytes for arc4.dynamic_array\\u003caccount\\u003e\"},{\"pc\":[621],\"errorMessage\":\"invalid number of bytes for arc4.dynamic_array\\u003carc4.uint8\\u003e\"},{\"pc\":[691,709,727,745,763,781,799,1358,1462,1510,1518,1686,1694,1848,1858,1976,2064,2476,4083,4181],\"errorMessage\":\"invalid number of bytes for arc4.static_array\\u003carc4.uint8, 32\\u003e\"},{\"pc\":[571,606,1526,1601,1664,1702,1776,1825,1994,2072,2081,2463,2486,2496,2754,3005,3213,3430,3604,4224,4269,4314],\"errorMessage\":\"invalid number of bytes for arc4.uint64\"},{\"pc\":[827],\"errorMessage\":\"invalid number of bytes for smart_contracts.common.abi_types.XGovRegistryConfig\"},{\"pc\":[2618,2922,3136,3300,3494],\"errorMessage\":\"invalid number of bytes for string\"},{\"pc\":[2134,2144],\"errorMessage\":\"overflow\"},{\"pc\":[1368,1537,1713,1916,2173,3574],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"cblocks\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":7,\"patch\":1}},\"events\":[{\"name\":\"XGovSubscribed\",\"desc\":\"An xGov subscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"},{\"type\":\"address\",\"name\":\"delegate\"}]},{\"name\":\"XGovUnsubscribed\",\"desc\":\"An xGov unsubscribed (either through self-onboarding or managed onboarding)\",\"args\":[{\"type\":\"address\",\"name\":\"xgov\"}]},{\"name\":\"ProposerSubscribed\",\"desc\":\"A Proposer subscribed\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\"}]},{\"name\":\"ProposerKYC\",\"desc\":\"A Proposer KYC status update\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\"},{\"type\":\"bool\",\"name\":\"valid_kyc\"}]},{\"name\":\"NewCommittee\",\"desc\":\"A new xGov Committee has been elected\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"committee_id\"},{\"type\":\"uint32\",\"name\":\"size\"},{\"type\":\"uint32\",\"name\":\"votes\"}]},{\"name\":\"NewProposal\",\"desc\":\"A new Proposal has been opened\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\"},{\"type\":\"address\",\"name\":\"proposer\"}]}],\"templateVariables\":{\"entropy\":{\"type\":\"AVMBytes\"}}}"
+var AppSpecJSON = "{\"name\":\"XGovRegistry\",\"structs\":{\"ProposerBoxValue\":[{\"name\":\"active_proposal\",\"type\":\"bool\"},{\"name\":\"kyc_status\",\"type\":\"bool\"},{\"name\":\"kyc_expiring\",\"type\":\"uint64\"}],\"TypedGlobalState\":[{\"name\":\"paused_registry\",\"type\":\"bool\"},{\"name\":\"paused_proposals\",\"type\":\"bool\"},{\"name\":\"xgov_manager\",\"type\":\"address\"},{\"name\":\"xgov_payor\",\"type\":\"address\"},{\"name\":\"xgov_council\",\"type\":\"address\"},{\"name\":\"xgov_subscriber\",\"type\":\"address\"},{\"name\":\"kyc_provider\",\"type\":\"address\"},{\"name\":\"committee_manager\",\"type\":\"address\"},{\"name\":\"xgov_daemon\",\"type\":\"address\"},{\"name\":\"xgov_fee\",\"type\":\"uint64\"},{\"name\":\"proposer_fee\",\"type\":\"uint64\"},{\"name\":\"open_proposal_fee\",\"type\":\"uint64\"},{\"name\":\"daemon_ops_funding_bps\",\"type\":\"uint64\"},{\"name\":\"proposal_commitment_bps\",\"type\":\"uint64\"},{\"name\":\"min_requested_amount\",\"type\":\"uint64\"},{\"name\":\"max_requested_amount\",\"type\":\"uint64[3]\"},{\"name\":\"discussion_duration\",\"type\":\"uint64[4]\"},{\"name\":\"voting_duration\",\"type\":\"uint64[4]\"},{\"name\":\"quorum\",\"type\":\"uint64[3]\"},{\"name\":\"weighted_quorum\",\"type\":\"uint64[3]\"},{\"name\":\"outstanding_funds\",\"type\":\"uint64\"},{\"name\":\"pending_proposals\",\"type\":\"uint64\"},{\"name\":\"committee_id\",\"type\":\"byte[32]\"},{\"name\":\"committee_members\",\"type\":\"uint64\"},{\"name\":\"committee_votes\",\"type\":\"uint64\"},{\"name\":\"absence_tolerance\",\"type\":\"uint64\"},{\"name\":\"governance_period\",\"type\":\"uint64\"},{\"name\":\"committee_grace_period\",\"type\":\"uint64\"},{\"name\":\"committee_last_anchor\",\"type\":\"uint64\"}],\"XGovBoxValue\":[{\"name\":\"voting_address\",\"type\":\"address\"},{\"name\":\"tolerated_absences\",\"type\":\"uint64\"},{\"name\":\"last_vote_timestamp\",\"type\":\"uint64\"},{\"name\":\"subscription_round\",\"type\":\"uint64\"}],\"XGovRegistryConfig\":[{\"name\":\"xgov_fee\",\"type\":\"uint64\"},{\"name\":\"proposer_fee\",\"type\":\"uint64\"},{\"name\":\"open_proposal_fee\",\"type\":\"uint64\"},{\"name\":\"daemon_ops_funding_bps\",\"type\":\"uint64\"},{\"name\":\"proposal_commitment_bps\",\"type\":\"uint64\"},{\"name\":\"min_requested_amount\",\"type\":\"uint64\"},{\"name\":\"max_requested_amount\",\"type\":\"uint64[3]\"},{\"name\":\"discussion_duration\",\"type\":\"uint64[4]\"},{\"name\":\"voting_duration\",\"type\":\"uint64[4]\"},{\"name\":\"quorum\",\"type\":\"uint64[3]\"},{\"name\":\"weighted_quorum\",\"type\":\"uint64[3]\"},{\"name\":\"absence_tolerance\",\"type\":\"uint64\"},{\"name\":\"governance_period\",\"type\":\"uint64\"},{\"name\":\"committee_grace_period\",\"type\":\"uint64\"}],\"XGovSubscribeRequestBoxValue\":[{\"name\":\"xgov_addr\",\"type\":\"address\"},{\"name\":\"owner_addr\",\"type\":\"address\"},{\"name\":\"relation_type\",\"type\":\"uint64\"}]},\"methods\":[{\"name\":\"create\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"desc\":\"Create the xGov Registry.\"},{\"name\":\"init_proposal_contract\",\"args\":[{\"type\":\"uint64\",\"name\":\"size\",\"desc\":\"The size of the Proposal Approval Program contract\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Initializes the Proposal Approval Program contract.\"},{\"name\":\"load_proposal_contract\",\"args\":[{\"type\":\"uint64\",\"name\":\"offset\",\"desc\":\"The offset in the Proposal Approval Program contract\"},{\"type\":\"byte[]\",\"name\":\"data\",\"desc\":\"The data to load into the Proposal Approval Program contract\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Loads the Proposal Approval Program contract.\"},{\"name\":\"delete_proposal_contract_box\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Deletes the Proposal Approval Program contract box.\"},{\"name\":\"pause_registry\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Pauses the xGov Registry non-administrative methods.\"},{\"name\":\"pause_proposals\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Pauses the creation of new Proposals.\"},{\"name\":\"resume_registry\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Resumes the xGov Registry non-administrative methods.\"},{\"name\":\"resume_proposals\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Resumes the creation of new Proposals.\"},{\"name\":\"set_xgov_manager\",\"args\":[{\"type\":\"address\",\"name\":\"manager\",\"desc\":\"Address of the new xGov Manager\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Manager.\"},{\"name\":\"set_payor\",\"args\":[{\"type\":\"address\",\"name\":\"payor\",\"desc\":\"Address of the new xGov Payor\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Payor.\"},{\"name\":\"set_xgov_council\",\"args\":[{\"type\":\"address\",\"name\":\"council\",\"desc\":\"Address of the new xGov Council\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Council.\"},{\"name\":\"set_xgov_subscriber\",\"args\":[{\"type\":\"address\",\"name\":\"subscriber\",\"desc\":\"Address of the new xGov Subscriber\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Subscriber.\"},{\"name\":\"set_kyc_provider\",\"args\":[{\"type\":\"address\",\"name\":\"provider\",\"desc\":\"Address of the new KYC Provider\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the KYC provider.\"},{\"name\":\"set_committee_manager\",\"args\":[{\"type\":\"address\",\"name\":\"manager\",\"desc\":\"Address of the new xGov Manager\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the Committee Manager.\"},{\"name\":\"set_xgov_daemon\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_daemon\",\"desc\":\"Address of the new xGov Daemon\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Daemon.\"},{\"name\":\"config_xgov_registry\",\"args\":[{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64[3],uint64[4],uint64[4],uint64[3],uint64[3],uint64,uint64,uint64)\",\"name\":\"config\",\"desc\":\"Configuration class containing the field data\",\"struct\":\"XGovRegistryConfig\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the configuration of the xGov Registry.\"},{\"name\":\"update_xgov_registry\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"desc\":\"Updates the xGov Registry contract.\"},{\"name\":\"subscribe_xgov\",\"args\":[{\"type\":\"address\",\"name\":\"voting_address\",\"desc\":\"The address of the voting account for the xGov\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Subscribes the sender to being an xGov.\"},{\"name\":\"unsubscribe_xgov\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Unsubscribes the sender from being an xGov.\"},{\"name\":\"unsubscribe_absentee\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"(Account): The address of the absentee xGov to unsubscribe\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Unsubscribes an absentee xGov. This is a temporary method used only for the\\nfirst absentees removal at the inception of the absenteeism penalty.\"},{\"name\":\"request_subscribe_xgov\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"},{\"type\":\"address\",\"name\":\"owner_address\",\"desc\":\"The address of the xGov Address owner/controller (Voting Address)\"},{\"type\":\"uint64\",\"name\":\"relation_type\",\"desc\":\"The type of relationship enum\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Requests to subscribe to the xGov.\"},{\"name\":\"approve_subscribe_xgov\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the request to approve\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Approves a subscribe request to xGov.\"},{\"name\":\"reject_subscribe_xgov\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the request to reject\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Rejects a subscribe request to xGov.\"},{\"name\":\"request_unsubscribe_xgov\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"},{\"type\":\"address\",\"name\":\"owner_address\",\"desc\":\"The address of the xGov Address owner/controller\"},{\"type\":\"uint64\",\"name\":\"relation_type\",\"desc\":\"The type of relationship enum\"},{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the xGov (unsubscribe) fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Requests to unsubscribe from the xGov.\"},{\"name\":\"approve_unsubscribe_xgov\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request to approve\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Approves a request to unsubscribe from xGov.\"},{\"name\":\"reject_unsubscribe_xgov\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request to reject\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Rejects a request to unsubscribe from xGov.\"},{\"name\":\"set_voting_account\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The xGov address delegating voting power\"},{\"type\":\"address\",\"name\":\"voting_address\",\"desc\":\"The voting account address to delegate voting power to\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the Voting Address for the xGov.\"},{\"name\":\"subscribe_proposer\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction covering the Proposer fee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Subscribes the sender to being a Proposer.\"},{\"name\":\"set_proposer_kyc\",\"args\":[{\"type\":\"address\",\"name\":\"proposer\",\"desc\":\"The address of the Proposer\"},{\"type\":\"bool\",\"name\":\"kyc_status\",\"desc\":\"The new status of the Proposer\"},{\"type\":\"uint64\",\"name\":\"kyc_expiring\",\"desc\":\"The expiration date as a unix timestamp of the time the KYC expires\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets a proposer's KYC status.\"},{\"name\":\"declare_committee\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"committee_id\",\"desc\":\"The ID of the xGov Committee\"},{\"type\":\"uint64\",\"name\":\"size\",\"desc\":\"The size of the xGov Committee\"},{\"type\":\"uint64\",\"name\":\"votes\",\"desc\":\"The voting power of the xGov Committee\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Sets the xGov Committee in charge.\"},{\"name\":\"open_proposal\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"payment for covering the proposal fee (includes child contract MBR)\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Creates a new Proposal.\"},{\"name\":\"vote_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app being voted on\"},{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"(Account): The address of the xGov being voted on behalf of\"},{\"type\":\"uint64\",\"name\":\"approval_votes\",\"desc\":\"(UInt64): The number of approvals votes allocated\"},{\"type\":\"uint64\",\"name\":\"rejection_votes\",\"desc\":\"(UInt64): The number of rejections votes allocated\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Votes on a Proposal.\"},{\"name\":\"unassign_absentee_from_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the scrutinized Proposal\"},{\"type\":\"address[]\",\"name\":\"absentees\",\"desc\":\"List of absentees to be unassigned\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Unassign absentees from a scrutinized Proposal.\"},{\"name\":\"pay_grant_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the approved Proposal\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Disburses the funds for an approved Proposal.\"},{\"name\":\"finalize_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app to finalize\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Finalize a Proposal.\"},{\"name\":\"drop_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\",\"desc\":\"The application ID of the Proposal app to drop\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Drops a Proposal.\"},{\"name\":\"deposit_funds\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"the deposit transaction\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Deposits xGov program funds into the xGov Treasury (xGov Registry Account).\"},{\"name\":\"withdraw_funds\",\"args\":[{\"type\":\"uint64\",\"name\":\"amount\",\"desc\":\"the amount to remove\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Remove xGov program funds from the xGov Treasury (xGov Registry Account).\"},{\"name\":\"withdraw_balance\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Withdraw outstanding Algos, excluding MBR and outstanding funds, from the xGov Registry.\"},{\"name\":\"get_state\",\"args\":[],\"returns\":{\"type\":\"(bool,bool,address,address,address,address,address,address,address,uint64,uint64,uint64,uint64,uint64,uint64,uint64[3],uint64[4],uint64[4],uint64[3],uint64[3],uint64,uint64,byte[32],uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"TypedGlobalState\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Returns the xGov Registry state.\"},{\"name\":\"get_xgov_box\",\"args\":[{\"type\":\"address\",\"name\":\"xgov_address\",\"desc\":\"The address of the xGov\"}],\"returns\":{\"type\":\"((address,uint64,uint64,uint64),bool)\",\"desc\":\"The xGov box value bool: `True` if xGov box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Returns the xGov box for the given address.\"},{\"name\":\"get_proposer_box\",\"args\":[{\"type\":\"address\",\"name\":\"proposer_address\",\"desc\":\"The address of the Proposer\"}],\"returns\":{\"type\":\"((bool,bool,uint64),bool)\",\"desc\":\"The Proposer box value bool: `True` if Proposer box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Returns the Proposer box for the given address.\"},{\"name\":\"get_request_box\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the subscribe request\"}],\"returns\":{\"type\":\"((address,address,uint64),bool)\",\"desc\":\"The subscribe request box value bool: `True` if xGov subscribe request box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Returns the xGov subscribe request box for the given request ID.\"},{\"name\":\"get_request_unsubscribe_box\",\"args\":[{\"type\":\"uint64\",\"name\":\"request_id\",\"desc\":\"The ID of the unsubscribe request\"}],\"returns\":{\"type\":\"((address,address,uint64),bool)\",\"desc\":\"The unsubscribe request box value bool: `True` if xGov unsubscribe request box exists, else `False`\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Returns the xGov unsubscribe request box for the given unsubscribe request ID.\"},{\"name\":\"is_proposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposal_id\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"op_up\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"absence_tolerance\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"YWJzZW5jZV90b2xlcmFuY2U=\"},\"committee_grace_period\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX2dyYWNlX3BlcmlvZA==\"},\"committee_id\":{\"keyType\":\"AVMBytes\",\"valueType\":\"byte[32]\",\"key\":\"Y29tbWl0dGVlX2lk\"},\"committee_last_anchor\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX2xhc3RfYW5jaG9y\"},\"committee_manager\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"Y29tbWl0dGVlX21hbmFnZXI=\"},\"committee_members\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX21lbWJlcnM=\"},\"committee_votes\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Y29tbWl0dGVlX3ZvdGVz\"},\"daemon_ops_funding_bps\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGFlbW9uX29wZXJhdGlvbl9mdW5kaW5nX2Jwcw==\"},\"discussion_duration_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZQ==\"},\"discussion_duration_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0=\"},\"discussion_duration_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbA==\"},\"discussion_duration_xlarge\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"ZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2U=\"},\"governance_period\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"Z292ZXJuYW5jZV9wZXJpb2Q=\"},\"kyc_provider\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"a3ljX3Byb3ZpZGVy\"},\"max_committee_size\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X2NvbW1pdHRlZV9zaXpl\"},\"max_requested_amount_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfbGFyZ2U=\"},\"max_requested_amount_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfbWVkaXVt\"},\"max_requested_amount_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3JlcXVlc3RlZF9hbW91bnRfc21hbGw=\"},\"min_requested_amount\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"bWluX3JlcXVlc3RlZF9hbW91bnQ=\"},\"open_proposal_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"b3Blbl9wcm9wb3NhbF9mZWU=\"},\"outstanding_funds\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"b3V0c3RhbmRpbmdfZnVuZHM=\"},\"paused_proposals\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGF1c2VkX3Byb3Bvc2Fscw==\"},\"paused_registry\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGF1c2VkX3JlZ2lzdHJ5\"},\"pending_proposals\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cGVuZGluZ19wcm9wb3NhbHM=\"},\"proposal_commitment_bps\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfY29tbWl0bWVudF9icHM=\"},\"proposer_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zZXJfZmVl\"},\"quorum_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX2xhcmdl\"},\"quorum_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX21lZGl1bQ==\"},\"quorum_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cXVvcnVtX3NtYWxs\"},\"request_id\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"cmVxdWVzdF9pZA==\"},\"voting_duration_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX2xhcmdl\"},\"voting_duration_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX21lZGl1bQ==\"},\"voting_duration_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX3NtYWxs\"},\"voting_duration_xlarge\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"dm90aW5nX2R1cmF0aW9uX3hsYXJnZQ==\"},\"weighted_quorum_large\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX2xhcmdl\"},\"weighted_quorum_medium\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX21lZGl1bQ==\"},\"weighted_quorum_small\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"d2VpZ2h0ZWRfcXVvcnVtX3NtYWxs\"},\"xgov_council\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9jb3VuY2ls\"},\"xgov_daemon\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9kYWVtb24=\"},\"xgov_fee\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"eGdvdl9mZWU=\"},\"xgov_manager\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9tYW5hZ2Vy\"},\"xgov_payor\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9wYXlvcg==\"},\"xgov_subscriber\":{\"keyType\":\"AVMBytes\",\"valueType\":\"address\",\"key\":\"eGdvdl9zdWJzY3JpYmVy\"},\"xgovs\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMUint64\",\"key\":\"eGdvdnM=\"}},\"local\":{},\"box\":{\"proposal_approval_program\":{\"keyType\":\"AVMBytes\",\"valueType\":\"AVMBytes\",\"key\":\"cGE=\"}}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"proposer_box\":{\"keyType\":\"address\",\"valueType\":\"ProposerBoxValue\",\"prefix\":\"cA==\"},\"request_box\":{\"keyType\":\"uint64\",\"valueType\":\"XGovSubscribeRequestBoxValue\",\"prefix\":\"cg==\"},\"request_unsubscribe_box\":{\"keyType\":\"uint64\",\"valueType\":\"XGovSubscribeRequestBoxValue\",\"prefix\":\"cnU=\"},\"voters\":{\"keyType\":\"address\",\"valueType\":\"uint64\",\"prefix\":\"Vg==\"},\"xgov_box\":{\"keyType\":\"address\",\"valueType\":\"XGovBoxValue\",\"prefix\":\"eA==\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBhbGdvcHkuYXJjNC5BUkM0Q29udHJhY3QuYXBwcm92YWxfcHJvZ3JhbSgpIC0+IHVpbnQ2NDoKbWFpbjoKICAgIGludGNibG9jayAwIDEgMzIgOCAyMDQ4CiAgICBieXRlY2Jsb2NrIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5IDB4Nzg2NzZmNzY3MyAweDc4IDB4MTUxZjdjNzUgMHg3MCAweDZmNzU3NDczNzQ2MTZlNjQ2OTZlNjc1ZjY2NzU2ZTY0NzMgMHg3MDYxIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyIDB4MDAgMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczIDB4NzA2MTc1NzM2NTY0NWY3MDcyNmY3MDZmNzM2MTZjNzMgMHg3MjY1NzE3NTY1NzM3NDVmNjk2NCAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIgIkVSUjoiIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyIDB4Nzg2NzZmNzY1ZjcwNjE3OTZmNzIgMHg2Yjc5NjM1ZjcwNzI2Zjc2Njk2NDY1NzIgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZkNjE2ZTYxNjc2NTcyIDB4Nzg2NzZmNzY1ZjY0NjE2NTZkNmY2ZSAweDc4Njc2Zjc2NWY2NjY1NjUgMHg3MDcyNmY3MDZmNzM2NTcyNWY2NjY1NjUgMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1IDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NSAweDcyIDB4NzI3NSAweDc4Njc2Zjc2NWY2MzZmNzU2ZTYzNjk2YyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczIDB4NzA3MjZmNzA2ZjczNjE2YzVmNjM2ZjZkNmQ2OTc0NmQ2NTZlNzQ1ZjYyNzA3MyAweDZkNjk2ZTVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQgMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY3MzZkNjE2YzZjIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmQ2NTY0Njk3NTZkIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NSAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY3MzZkNjE2YzZjIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZCAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1IDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NSAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1IDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY3ODZjNjE3MjY3NjUgMHg3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMgMHg3MTc1NmY3Mjc1NmQ1ZjZkNjU2NDY5NzU2ZCAweDcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NSAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1IDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDY1NmQ2MjY1NzI3MyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MyAweDZkNjE3ODVmNjM2ZjZkNmQ2OTc0NzQ2NTY1NWY3MzY5N2E2NSAweDY3NmY3NjY1NzI2ZTYxNmU2MzY1NWY3MDY1NzI2OTZmNjQgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NCAweDUxMDk5YWIwIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2YzYxNzM3NDVmNjE2ZTYzNjg2ZjcyIDB4YjEzMjQ4NjAgMHgwMDAwMDAwMDAwMDAwMDAwMDAgMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY5NjQgIlZvdGVyIG5vdCBmb3VuZCIgYmFzZTMyKEFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBKSBUTVBMX2VudHJvcHkKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU3LTU4CiAgICAvLyAjIFByZWNvbmRpdGlvbnMKICAgIC8vIGFzc2VydCBUeG4uZ2xvYmFsX251bV9ieXRlX3NsaWNlID09IGNmZy5HTE9CQUxfQllURVMsIGVyci5XUk9OR19HTE9CQUxfQllURVMKICAgIHR4biBHbG9iYWxOdW1CeXRlU2xpY2UKICAgIHB1c2hpbnQgMjgKICAgID09CiAgICBhc3NlcnQgLy8gV3JvbmcgR2xvYmFsIEJ5dGVzIGFsbG9jYXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU5CiAgICAvLyBhc3NlcnQgVHhuLmdsb2JhbF9udW1fdWludCA9PSBjZmcuR0xPQkFMX1VJTlRTLCBlcnIuV1JPTkdfR0xPQkFMX1VJTlRTCiAgICB0eG4gR2xvYmFsTnVtVWludAogICAgcHVzaGludCAzNgogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBHbG9iYWwgVUludHMgYWxsb2NhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjAKICAgIC8vIGFzc2VydCBUeG4ubG9jYWxfbnVtX2J5dGVfc2xpY2UgPT0gY2ZnLkxPQ0FMX0JZVEVTLCBlcnIuV1JPTkdfTE9DQUxfQllURVMKICAgIHR4biBMb2NhbE51bUJ5dGVTbGljZQogICAgIQogICAgYXNzZXJ0IC8vIFdyb25nIExvY2FsIEJ5dGVzIGFsbG9jYXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxCiAgICAvLyBhc3NlcnQgVHhuLmxvY2FsX251bV91aW50ID09IGNmZy5MT0NBTF9VSU5UUywgZXJyLldST05HX0xPQ0FMX1VJTlRTCiAgICB0eG4gTG9jYWxOdW1VaW50CiAgICAhCiAgICBhc3NlcnQgLy8gV3JvbmcgTG9jYWwgVUludHMgYWxsb2NhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjMtNjQKICAgIC8vICMgUm9sZS1CYXNlZCBBY2Nlc3MgQ29udHJvbCAoUkJBQykKICAgIC8vIHNlbGYueGdvdl9tYW5hZ2VyID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX01BTkFHRVIpCiAgICBieXRlYyA3IC8vIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NQogICAgLy8gc2VsZi54Z292X3N1YnNjcmliZXIgPSBHbG9iYWxTdGF0ZShBY2NvdW50KCksIGtleT1jZmcuR1NfS0VZX1hHT1ZfU1VCU0NSSUJFUikKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NgogICAgLy8gc2VsZi54Z292X3BheW9yID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX1BBWU9SKQogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjcKICAgIC8vIHNlbGYueGdvdl9jb3VuY2lsID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX0NPVU5DSUwpCiAgICBieXRlYyAyNSAvLyAweDc4Njc2Zjc2NWY2MzZmNzU2ZTYzNjk2YwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgKICAgIC8vIHNlbGYua3ljX3Byb3ZpZGVyID0gR2xvYmFsU3RhdGUoQWNjb3VudCgpLCBrZXk9Y2ZnLkdTX0tFWV9LWUNfUFJPVklERVIpCiAgICBieXRlYyAxNiAvLyAweDZiNzk2MzVmNzA3MjZmNzY2OTY0NjU3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzAKICAgIC8vIEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01BTkFHRVIKICAgIGJ5dGVjIDE3IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDYxNmU2MTY3NjU3MgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2OS03MQogICAgLy8gc2VsZi5jb21taXR0ZWVfbWFuYWdlciA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01BTkFHRVIKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MgogICAgLy8gc2VsZi54Z292X2RhZW1vbiA9IEdsb2JhbFN0YXRlKEFjY291bnQoKSwga2V5PWNmZy5HU19LRVlfWEdPVl9EQUVNT04pCiAgICBieXRlYyAxOCAvLyAweDc4Njc2Zjc2NWY2NDYxNjU2ZDZmNmUKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc2CiAgICAvLyBGYWxzZSwga2V5PWNmZy5HU19LRVlfUEFVU0VEX1JFR0lTVFJZICAjIG5vcWE6IEZCVDAwMwogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc0LTc3CiAgICAvLyAjIFJlZ2lzdHJ5IENvbnRyb2wgU3RhdGVzCiAgICAvLyBzZWxmLnBhdXNlZF9yZWdpc3RyeSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIEZhbHNlLCBrZXk9Y2ZnLkdTX0tFWV9QQVVTRURfUkVHSVNUUlkgICMgbm9xYTogRkJUMDAzCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzkKICAgIC8vIEZhbHNlLCBrZXk9Y2ZnLkdTX0tFWV9QQVVTRURfUFJPUE9TQUxTICAjIG5vcWE6IEZCVDAwMwogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc4LTgwCiAgICAvLyBzZWxmLnBhdXNlZF9wcm9wb3NhbHMgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBGYWxzZSwga2V5PWNmZy5HU19LRVlfUEFVU0VEX1BST1BPU0FMUyAgIyBub3FhOiBGQlQwMDMKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4Mi04MwogICAgLy8gIyB4R292IFRyZWFzdXJ5CiAgICAvLyBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX09VVFNUQU5ESU5HX0ZVTkRTKQogICAgYnl0ZWMgNSAvLyAweDZmNzU3NDczNzQ2MTZlNjQ2OTZlNjc1ZjY2NzU2ZTY0NzMKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODUtODYKICAgIC8vICMgRmVlcwogICAgLy8gc2VsZi54Z292X2ZlZSA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WX0ZFRSkKICAgIGJ5dGVjIDE5IC8vIDB4Nzg2NzZmNzY1ZjY2NjU2NQogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NwogICAgLy8gc2VsZi5wcm9wb3Nlcl9mZWUgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfUFJPUE9TRVJfRkVFKQogICAgYnl0ZWMgMjAgLy8gMHg3MDcyNmY3MDZmNzM2NTcyNWY2NjY1NjUKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODgKICAgIC8vIHNlbGYub3Blbl9wcm9wb3NhbF9mZWUgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfT1BFTl9QUk9QT1NBTF9GRUUpCiAgICBieXRlYyAyMSAvLyAweDZmNzA2NTZlNWY3MDcyNmY3MDZmNzM2MTZjNWY2NjY1NjUKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9EQUVNT05fT1BTX0ZVTkRJTkdfQlBTCiAgICBieXRlYyAyNiAvLyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODktOTEKICAgIC8vIHNlbGYuZGFlbW9uX29wc19mdW5kaW5nX2JwcyA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9EQUVNT05fT1BTX0ZVTkRJTkdfQlBTCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTMKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9QUk9QT1NBTF9DT01NSVRNRU5UX0JQUwogICAgYnl0ZWMgMjcgLy8gMHg3MDcyNmY3MDZmNzM2MTZjNWY2MzZmNmQ2ZDY5NzQ2ZDY1NmU3NDVmNjI3MDczCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTItOTQKICAgIC8vIHNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfUFJPUE9TQUxfQ09NTUlUTUVOVF9CUFMKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5OAogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01JTl9SRVFVRVNURURfQU1PVU5UCiAgICBieXRlYyAyOCAvLyAweDZkNjk2ZTVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5Ni05OQogICAgLy8gIyBSZXF1ZXN0ZWQgQW1vdW50IExpbWl0cwogICAgLy8gc2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9NSU5fUkVRVUVTVEVEX0FNT1VOVAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX1NNQUxMCiAgICBieXRlYyAyOSAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDEtMTAzCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX1NNQUxMCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfTUFYX1JFUVVFU1RFRF9BTU9VTlRfTUVESVVNCiAgICBieXRlYyAzMCAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZkNjU2NDY5NzU2ZAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNC0xMDYKICAgIC8vIHNlbGYubWF4X3JlcXVlc3RlZF9hbW91bnRfbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX01FRElVTQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwOAogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX0xBUkdFCiAgICBieXRlYyAzMSAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZjNjE3MjY3NjUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDctMTA5CiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX01BWF9SRVFVRVNURURfQU1PVU5UX0xBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzCiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9TTUFMTAogICAgYnl0ZWMgMzIgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExMS0xMTQKICAgIC8vICMgVGltZSBMaW1pdHMKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1NNQUxMCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9NRURJVU0KICAgIGJ5dGVjIDMzIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNS0xMTcKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0gPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfRElTQ1VTU0lPTl9EVVJBVElPTl9NRURJVU0KICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTkKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX0xBUkdFCiAgICBieXRlYyAzNCAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4LTEyMAogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX0RJU0NVU1NJT05fRFVSQVRJT05fTEFSR0UKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjIKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1hMQVJHRQogICAgYnl0ZWMgMzUgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzg2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIxLTEyMwogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3hsYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9ESVNDVVNTSU9OX0RVUkFUSU9OX1hMQVJHRQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNQogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1ZPVElOR19EVVJBVElPTl9TTUFMTAogICAgYnl0ZWMgMzYgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjQtMTI2CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbCA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fU01BTEwKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjgKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fTUVESVVNCiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3LTEyOQogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1ZPVElOR19EVVJBVElPTl9NRURJVU0KICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzEKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fTEFSR0UKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwLTEzMgogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fbGFyZ2UgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfVk9USU5HX0RVUkFUSU9OX0xBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM0CiAgICAvLyBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfVk9USU5HX0RVUkFUSU9OX1hMQVJHRQogICAgYnl0ZWMgMzkgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMy0xMzUKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX3hsYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9WT1RJTkdfRFVSQVRJT05fWExBUkdFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3LTEzOAogICAgLy8gIyBRdW9ydW1zCiAgICAvLyBzZWxmLnF1b3J1bV9zbWFsbCA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9RVU9SVU1fU01BTEwpCiAgICBieXRlYyA0MCAvLyAweDcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YwogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9RVU9SVU1fTUVESVVNCiAgICBieXRlYyA0MSAvLyAweDcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM5LTE0MQogICAgLy8gc2VsZi5xdW9ydW1fbWVkaXVtID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1FVT1JVTV9NRURJVU0KICAgIC8vICkgICMgTm8gbG9uZ2VyIHVzZWQKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDIKICAgIC8vIHNlbGYucXVvcnVtX2xhcmdlID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1FVT1JVTV9MQVJHRSkKICAgIGJ5dGVjIDQyIC8vIDB4NzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1CiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9TTUFMTAogICAgYnl0ZWMgNDMgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQtMTQ3CiAgICAvLyAjIFdlaWdodGVkIFF1b3J1bXMKICAgIC8vIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX3NtYWxsID0gR2xvYmFsU3RhdGUoCiAgICAvLyAgICAgVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9TTUFMTAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OQogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9NRURJVU0gICMgTm8gbG9uZ2VyIHVzZWQKICAgIGJ5dGVjIDQ0IC8vIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDgtMTUwCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0gPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfV0VJR0hURURfUVVPUlVNX01FRElVTSAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MgogICAgLy8gVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1dFSUdIVEVEX1FVT1JVTV9MQVJHRQogICAgYnl0ZWMgNDUgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjZjNjE3MjY3NjUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTEtMTUzCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZSA9IEdsb2JhbFN0YXRlKAogICAgLy8gICAgIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9XRUlHSFRFRF9RVU9SVU1fTEFSR0UKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTcKICAgIC8vIHNlbGYuY29tbWl0dGVlX21lbWJlcnMgPSBHbG9iYWxTdGF0ZShVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfQ09NTUlUVEVFX01FTUJFUlMpCiAgICBieXRlYyA0NiAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2NTZkNjI2NTcyNzMKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU4CiAgICAvLyBzZWxmLmNvbW1pdHRlZV92b3RlcyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9DT01NSVRURUVfVk9URVMpCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjAKICAgIC8vIFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9NQVhfQ09NTUlUVEVFX1NJWkUKICAgIGJ5dGVjIDQ4IC8vIDB4NmQ2MTc4NWY2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjczNjk3YTY1CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5LTE2MQogICAgLy8gc2VsZi5tYXhfY29tbWl0dGVlX3NpemUgPSBHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBVSW50NjQoKSwga2V5PWNmZy5HU19LRVlfTUFYX0NPTU1JVFRFRV9TSVpFCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYzLTE2NAogICAgLy8gIyBDb3VudGVycwogICAgLy8gc2VsZi54Z292cyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9YR09WUykKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NQogICAgLy8gc2VsZi5wZW5kaW5nX3Byb3Bvc2FscyA9IEdsb2JhbFN0YXRlKFVJbnQ2NCgpLCBrZXk9Y2ZnLkdTX0tFWV9QRU5ESU5HX1BST1BPU0FMUykKICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NgogICAgLy8gc2VsZi5yZXF1ZXN0X2lkID0gR2xvYmFsU3RhdGUoVUludDY0KCksIGtleT1jZmcuR1NfS0VZX1JFUVVFU1RfSUQpCiAgICBieXRlYyAxMSAvLyAweDcyNjU3MTc1NjU3Mzc0NWY2OTY0CiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKCm1haW5fYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDUtNTMKICAgIC8vIGNsYXNzIFhHb3ZSZWdpc3RyeSgKICAgIC8vICAgICBYR292UmVnaXN0cnlJbnRlcmZhY2UsCiAgICAvLyAgICAgc3RhdGVfdG90YWxzPVN0YXRlVG90YWxzKAogICAgLy8gICAgICAgICBnbG9iYWxfYnl0ZXM9Y2ZnLkdMT0JBTF9CWVRFUywKICAgIC8vICAgICAgICAgZ2xvYmFsX3VpbnRzPWNmZy5HTE9CQUxfVUlOVFMsCiAgICAvLyAgICAgICAgIGxvY2FsX2J5dGVzPWNmZy5MT0NBTF9CWVRFUywKICAgIC8vICAgICAgICAgbG9jYWxfdWludHM9Y2ZnLkxPQ0FMX1VJTlRTLAogICAgLy8gICAgICksCiAgICAvLyApOgogICAgcHVzaGJ5dGVzIDB4NDk1NmMxYWIgLy8gbWV0aG9kICJ1cGRhdGVfeGdvdl9yZWdpc3RyeSgpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5fdXBkYXRlX3hnb3ZfcmVnaXN0cnlfcm91dGVANAoKbWFpbl9zd2l0Y2hfY2FzZV9uZXh0QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NS01MwogICAgLy8gY2xhc3MgWEdvdlJlZ2lzdHJ5KAogICAgLy8gICAgIFhHb3ZSZWdpc3RyeUludGVyZmFjZSwKICAgIC8vICAgICBzdGF0ZV90b3RhbHM9U3RhdGVUb3RhbHMoCiAgICAvLyAgICAgICAgIGdsb2JhbF9ieXRlcz1jZmcuR0xPQkFMX0JZVEVTLAogICAgLy8gICAgICAgICBnbG9iYWxfdWludHM9Y2ZnLkdMT0JBTF9VSU5UUywKICAgIC8vICAgICAgICAgbG9jYWxfYnl0ZXM9Y2ZnLkxPQ0FMX0JZVEVTLAogICAgLy8gICAgICAgICBsb2NhbF91aW50cz1jZmcuTE9DQUxfVUlOVFMsCiAgICAvLyAgICAgKSwKICAgIC8vICk6CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDUyCiAgICBwdXNoYnl0ZXNzIDB4OTU5YzQ3NjIgMHg2YzFmNTY0ZCAweGUxMGE1MTJlIDB4ODZmN2UwZTYgMHgzOWUyNmQ4YSAweDk4MzUyZTg2IDB4ZmE0ZWQ2ZTIgMHhkNmM5Y2MxYSAweDVjNDg0MDU1IDB4MzdkNmFkZjEgMHhkNDIxNmI2ZSAweGJhODUyMGYyIDB4MTY3N2IzMGUgMHg4NGI3ZDI2OCAweGFmN2YxODYwIDB4YTA4MmNlZjggMHhkZjM5ZmRiOSAweGNhMGY2YTNhIDB4NDUwNzczOTAgMHgzYzMxYmMwMiAweDBkMmM3ODkxIDB4OTNmYWNkYmEgMHhjZThiM2ExYyAweGZhZWEwODFmIDB4MGRhMjc4ODUgMHg3YTRmZWU0MyAweDUyZGQxMGQ3IDB4ZDRkMzdhNjQgMHgzNDM0OWRjYyAweDE1OGY4ZGQ2IDB4NWZlMjU5MzUgMHhkYjI3YjlhZiAweGY1OTEwNzU2IDB4NjU2MTBhOWYgMHhmZGM2OTVjMiAweGJhOTBhYjU0IDB4YjNiNTg0ODIgMHg2YWU1ZWI0NiAweDI3NjMwZDY1IDB4ODI0Zjk4YmMgMHg0OTU0OGJhMCAweDgyNjc4NGY2IDB4MjY5ODMyMDAgMHhkYmQ4M2RkOSAvLyBtZXRob2QgImluaXRfcHJvcG9zYWxfY29udHJhY3QodWludDY0KXZvaWQiLCBtZXRob2QgImxvYWRfcHJvcG9zYWxfY29udHJhY3QodWludDY0LGJ5dGVbXSl2b2lkIiwgbWV0aG9kICJkZWxldGVfcHJvcG9zYWxfY29udHJhY3RfYm94KCl2b2lkIiwgbWV0aG9kICJwYXVzZV9yZWdpc3RyeSgpdm9pZCIsIG1ldGhvZCAicGF1c2VfcHJvcG9zYWxzKCl2b2lkIiwgbWV0aG9kICJyZXN1bWVfcmVnaXN0cnkoKXZvaWQiLCBtZXRob2QgInJlc3VtZV9wcm9wb3NhbHMoKXZvaWQiLCBtZXRob2QgInNldF94Z292X21hbmFnZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfcGF5b3IoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfeGdvdl9jb3VuY2lsKGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAic2V0X3hnb3Zfc3Vic2NyaWJlcihhZGRyZXNzKXZvaWQiLCBtZXRob2QgInNldF9reWNfcHJvdmlkZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfY29tbWl0dGVlX21hbmFnZXIoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJzZXRfeGdvdl9kYWVtb24oYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJjb25maWdfeGdvdl9yZWdpc3RyeSgodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0WzNdLHVpbnQ2NFs0XSx1aW50NjRbNF0sdWludDY0WzNdLHVpbnQ2NFszXSx1aW50NjQsdWludDY0LHVpbnQ2NCkpdm9pZCIsIG1ldGhvZCAic3Vic2NyaWJlX3hnb3YoYWRkcmVzcyxwYXkpdm9pZCIsIG1ldGhvZCAidW5zdWJzY3JpYmVfeGdvdigpdm9pZCIsIG1ldGhvZCAidW5zdWJzY3JpYmVfYWJzZW50ZWUoYWRkcmVzcyl2b2lkIiwgbWV0aG9kICJyZXF1ZXN0X3N1YnNjcmliZV94Z292KGFkZHJlc3MsYWRkcmVzcyx1aW50NjQscGF5KXZvaWQiLCBtZXRob2QgImFwcHJvdmVfc3Vic2NyaWJlX3hnb3YodWludDY0KXZvaWQiLCBtZXRob2QgInJlamVjdF9zdWJzY3JpYmVfeGdvdih1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVxdWVzdF91bnN1YnNjcmliZV94Z292KGFkZHJlc3MsYWRkcmVzcyx1aW50NjQscGF5KXZvaWQiLCBtZXRob2QgImFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdih1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3YodWludDY0KXZvaWQiLCBtZXRob2QgInNldF92b3RpbmdfYWNjb3VudChhZGRyZXNzLGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAic3Vic2NyaWJlX3Byb3Bvc2VyKHBheSl2b2lkIiwgbWV0aG9kICJzZXRfcHJvcG9zZXJfa3ljKGFkZHJlc3MsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVjbGFyZV9jb21taXR0ZWUoYnl0ZVszMl0sdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcGVuX3Byb3Bvc2FsKHBheSl1aW50NjQiLCBtZXRob2QgInZvdGVfcHJvcG9zYWwodWludDY0LGFkZHJlc3MsdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJ1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsKHVpbnQ2NCxhZGRyZXNzW10pdm9pZCIsIG1ldGhvZCAicGF5X2dyYW50X3Byb3Bvc2FsKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZV9wcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZHJvcF9wcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVwb3NpdF9mdW5kcyhwYXkpdm9pZCIsIG1ldGhvZCAid2l0aGRyYXdfZnVuZHModWludDY0KXZvaWQiLCBtZXRob2QgIndpdGhkcmF3X2JhbGFuY2UoKXZvaWQiLCBtZXRob2QgImdldF9zdGF0ZSgpKGJvb2wsYm9vbCxhZGRyZXNzLGFkZHJlc3MsYWRkcmVzcyxhZGRyZXNzLGFkZHJlc3MsYWRkcmVzcyxhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NFszXSx1aW50NjRbNF0sdWludDY0WzRdLHVpbnQ2NFszXSx1aW50NjRbM10sdWludDY0LHVpbnQ2NCxieXRlWzMyXSx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiLCBtZXRob2QgImdldF94Z292X2JveChhZGRyZXNzKSgoYWRkcmVzcyx1aW50NjQsdWludDY0LHVpbnQ2NCksYm9vbCkiLCBtZXRob2QgImdldF9wcm9wb3Nlcl9ib3goYWRkcmVzcykoKGJvb2wsYm9vbCx1aW50NjQpLGJvb2wpIiwgbWV0aG9kICJnZXRfcmVxdWVzdF9ib3godWludDY0KSgoYWRkcmVzcyxhZGRyZXNzLHVpbnQ2NCksYm9vbCkiLCBtZXRob2QgImdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCh1aW50NjQpKChhZGRyZXNzLGFkZHJlc3MsdWludDY0KSxib29sKSIsIG1ldGhvZCAiaXNfcHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgIm9wX3VwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggaW5pdF9wcm9wb3NhbF9jb250cmFjdCBsb2FkX3Byb3Bvc2FsX2NvbnRyYWN0IGRlbGV0ZV9wcm9wb3NhbF9jb250cmFjdF9ib3ggcGF1c2VfcmVnaXN0cnkgcGF1c2VfcHJvcG9zYWxzIHJlc3VtZV9yZWdpc3RyeSByZXN1bWVfcHJvcG9zYWxzIHNldF94Z292X21hbmFnZXIgc2V0X3BheW9yIHNldF94Z292X2NvdW5jaWwgc2V0X3hnb3Zfc3Vic2NyaWJlciBzZXRfa3ljX3Byb3ZpZGVyIHNldF9jb21taXR0ZWVfbWFuYWdlciBzZXRfeGdvdl9kYWVtb24gY29uZmlnX3hnb3ZfcmVnaXN0cnkgc3Vic2NyaWJlX3hnb3YgdW5zdWJzY3JpYmVfeGdvdiB1bnN1YnNjcmliZV9hYnNlbnRlZSByZXF1ZXN0X3N1YnNjcmliZV94Z292IGFwcHJvdmVfc3Vic2NyaWJlX3hnb3YgcmVqZWN0X3N1YnNjcmliZV94Z292IHJlcXVlc3RfdW5zdWJzY3JpYmVfeGdvdiBhcHByb3ZlX3Vuc3Vic2NyaWJlX3hnb3YgcmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3Ygc2V0X3ZvdGluZ19hY2NvdW50IHN1YnNjcmliZV9wcm9wb3NlciBzZXRfcHJvcG9zZXJfa3ljIGRlY2xhcmVfY29tbWl0dGVlIG9wZW5fcHJvcG9zYWwgdm90ZV9wcm9wb3NhbCB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsIHBheV9ncmFudF9wcm9wb3NhbCBmaW5hbGl6ZV9wcm9wb3NhbCBkcm9wX3Byb3Bvc2FsIGRlcG9zaXRfZnVuZHMgd2l0aGRyYXdfZnVuZHMgd2l0aGRyYXdfYmFsYW5jZSBnZXRfc3RhdGUgZ2V0X3hnb3ZfYm94IGdldF9wcm9wb3Nlcl9ib3ggZ2V0X3JlcXVlc3RfYm94IGdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBpc19wcm9wb3NhbCBtYWluX29wX3VwX3JvdXRlQDUwCiAgICBlcnIKCm1haW5fb3BfdXBfcm91dGVANTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNzA5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEA1MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1LTUzCiAgICAvLyBjbGFzcyBYR292UmVnaXN0cnkoCiAgICAvLyAgICAgWEdvdlJlZ2lzdHJ5SW50ZXJmYWNlLAogICAgLy8gICAgIHN0YXRlX3RvdGFscz1TdGF0ZVRvdGFscygKICAgIC8vICAgICAgICAgZ2xvYmFsX2J5dGVzPWNmZy5HTE9CQUxfQllURVMsCiAgICAvLyAgICAgICAgIGdsb2JhbF91aW50cz1jZmcuR0xPQkFMX1VJTlRTLAogICAgLy8gICAgICAgICBsb2NhbF9ieXRlcz1jZmcuTE9DQUxfQllURVMsCiAgICAvLyAgICAgICAgIGxvY2FsX3VpbnRzPWNmZy5MT0NBTF9VSU5UUywKICAgIC8vICAgICApLAogICAgLy8gKToKICAgIHB1c2hieXRlcyAweDRjNWM2MWJhIC8vIG1ldGhvZCAiY3JlYXRlKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggY3JlYXRlCiAgICBlcnIKCm1haW5fdXBkYXRlX3hnb3ZfcmVnaXN0cnlfcm91dGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5NQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKGFsbG93X2FjdGlvbnM9WyJVcGRhdGVBcHBsaWNhdGlvbiJdKQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQKICAgIGIgdXBkYXRlX3hnb3ZfcmVnaXN0cnkKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzc2CiAgICAvLyBzZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSA9IFR4bi5zZW5kZXIKICAgIGJ5dGVjIDcgLy8gMHg3ODY3NmY3NjVmNmQ2MTZlNjE2NzY1NzIKICAgIHR4biBTZW5kZXIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTIKICAgIC8vIHJldHVybiBUZW1wbGF0ZVZhcltCeXRlc10oImVudHJvcHkiKSAgIyB0cmljayB0byBhbGxvdyBmcmVzaCBkZXBsb3ltZW50CiAgICBieXRlYyA1OCAvLyBUTVBMX2VudHJvcHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM3NwogICAgLy8gYXNzZXJ0IHNlbGYuZW50cm9weSgpID09IFRlbXBsYXRlVmFyW0J5dGVzXSgiZW50cm9weSIpCiAgICBkdXAKICAgID09CiAgICBhc3NlcnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM3MAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKGNyZWF0ZT0icmVxdWlyZSIpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmluaXRfcHJvcG9zYWxfY29udHJhY3Rbcm91dGluZ10oKSAtPiB2b2lkOgppbml0X3Byb3Bvc2FsX2NvbnRyYWN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzc5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzkxCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM5MwogICAgLy8gaWYgc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtOgogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogaW5pdF9wcm9wb3NhbF9jb250cmFjdF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzk0CiAgICAvLyBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0ucmVzaXplKHNpemUpCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgc3dhcAogICAgYm94X3Jlc2l6ZQoKaW5pdF9wcm9wb3NhbF9jb250cmFjdF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgppbml0X3Byb3Bvc2FsX2NvbnRyYWN0X2Vsc2VfYm9keUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Mzk2LTM5NwogICAgLy8gIyBJbml0aWFsaXplIHRoZSBQcm9wb3NhbCBBcHByb3ZhbCBQcm9ncmFtIGNvbnRyYWN0CiAgICAvLyBfY3JlYXRlZCA9IHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5jcmVhdGUoc2l6ZT1zaXplKQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIHN3YXAKICAgIGJveF9jcmVhdGUKICAgIHBvcAogICAgYiBpbml0X3Byb3Bvc2FsX2NvbnRyYWN0X2FmdGVyX2lmX2Vsc2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5sb2FkX3Byb3Bvc2FsX2NvbnRyYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKbG9hZF9wcm9wb3NhbF9jb250cmFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM5OQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2IC8vIG9uIGVycm9yOiBpbnZhbGlkIGFycmF5IGxlbmd0aCBoZWFkZXIKICAgIHB1c2hpbnQgMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5keW5hbWljX2FycmF5PGFyYzQudWludDg+CiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDEyCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQxNC00MTUKICAgIC8vICMgTG9hZCB0aGUgUHJvcG9zYWwgQXBwcm92YWwgUHJvZ3JhbSBjb250cmFjdAogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLnJlcGxhY2Uoc3RhcnRfaW5kZXg9b2Zmc2V0LCB2YWx1ZT1kYXRhKQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozOTkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmRlbGV0ZV9wcm9wb3NhbF9jb250cmFjdF9ib3hbcm91dGluZ10oKSAtPiB2b2lkOgpkZWxldGVfcHJvcG9zYWxfY29udHJhY3RfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDI2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQyOC00MjkKICAgIC8vICMgRGVsZXRlIHRoZSBQcm9wb3NhbCBBcHByb3ZhbCBQcm9ncmFtIGNvbnRyYWN0IGJveAogICAgLy8gZGVsIHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS52YWx1ZQogICAgYnl0ZWMgNiAvLyAweDcwNjEKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDE3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5wYXVzZV9yZWdpc3RyeVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnBhdXNlX3JlZ2lzdHJ5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDM3CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQzOAogICAgLy8gc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUgPSBUcnVlCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBpbnRjXzEgLy8gMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQzMQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucGF1c2VfcHJvcG9zYWxzW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF1c2VfcHJvcG9zYWxzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDQ2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ0NwogICAgLy8gc2VsZi5wYXVzZWRfcHJvcG9zYWxzLnZhbHVlID0gVHJ1ZQogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgaW50Y18xIC8vIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NDAKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnJlc3VtZV9yZWdpc3RyeVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJlc3VtZV9yZWdpc3RyeToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1NQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NTYKICAgIC8vIHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlID0gRmFsc2UKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDQ5CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5yZXN1bWVfcHJvcG9zYWxzW3JvdXRpbmddKCkgLT4gdm9pZDoKcmVzdW1lX3Byb3Bvc2FsczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ2NAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NjUKICAgIC8vIHNlbGYucGF1c2VkX3Byb3Bvc2Fscy52YWx1ZSA9IEZhbHNlCiAgICBieXRlYyAxMCAvLyAweDcwNjE3NTczNjU2NDVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ1OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfbWFuYWdlcltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF94Z292X21hbmFnZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0NjcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ3OQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0ODAKICAgIC8vIHNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlID0gbWFuYWdlcgogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ2NwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3BheW9yW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3BheW9yOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDgyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NDk1CiAgICAvLyBzZWxmLnhnb3ZfcGF5b3IudmFsdWUgPSBwYXlvcgogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjQ4MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfY291bmNpbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF94Z292X2NvdW5jaWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjUwOQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MTAKICAgIC8vIHNlbGYueGdvdl9jb3VuY2lsLnZhbHVlID0gY291bmNpbAogICAgYnl0ZWMgMjUgLy8gMHg3ODY3NmY3NjVmNjM2Zjc1NmU2MzY5NmMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo0OTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF94Z292X3N1YnNjcmliZXJbcm91dGluZ10oKSAtPiB2b2lkOgpzZXRfeGdvdl9zdWJzY3JpYmVyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTEyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MjQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTI1CiAgICAvLyBzZWxmLnhnb3Zfc3Vic2NyaWJlci52YWx1ZSA9IHN1YnNjcmliZXIKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTEyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5zZXRfa3ljX3Byb3ZpZGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X2t5Y19wcm92aWRlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjUyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTM5CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MAogICAgLy8gc2VsZi5reWNfcHJvdmlkZXIudmFsdWUgPSBwcm92aWRlcgogICAgYnl0ZWMgMTYgLy8gMHg2Yjc5NjM1ZjcwNzI2Zjc2Njk2NDY1NzIKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1MjcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF9jb21taXR0ZWVfbWFuYWdlcltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF9jb21taXR0ZWVfbWFuYWdlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTU0CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU1NQogICAgLy8gc2VsZi5jb21taXR0ZWVfbWFuYWdlci52YWx1ZSA9IG1hbmFnZXIKICAgIGJ5dGVjIDE3IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDYxNmU2MTY3NjU3MgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU0MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc2V0X3hnb3ZfZGFlbW9uW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3hnb3ZfZGFlbW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTU3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo1NjkKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTcwCiAgICAvLyBzZWxmLnhnb3ZfZGFlbW9uLnZhbHVlID0geGdvdl9kYWVtb24KICAgIGJ5dGVjIDE4IC8vIDB4Nzg2NzZmNzY1ZjY0NjE2NTZkNmY2ZQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU1NwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuY29uZmlnX3hnb3ZfcmVnaXN0cnlbcm91dGluZ10oKSAtPiB2b2lkOgpjb25maWdfeGdvdl9yZWdpc3RyeToKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDQKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NTcyCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBsZW4KICAgIHB1c2hpbnQgMjA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBzbWFydF9jb250cmFjdHMuY29tbW9uLmFiaV90eXBlcy5YR292UmVnaXN0cnlDb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU4NAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTMKICAgIC8vIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI4My0yODUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgICAga2V5X3ByZWZpeF9sZW5ndGggKyBrZXlfdHlwZV9zaXplICsgdmFsdWVfdHlwZV9zaXplCiAgICAvLyApICogUEVSX0JZVEVfSU5fQk9YX01CUiArIFBFUl9CT1hfTUJSCiAgICBwdXNoaW50IDM4MTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTMKICAgIC8vIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgPj0KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VANAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyODMtMjg1CiAgICAvLyByZXR1cm4gKAogICAgLy8gICAgIGtleV9wcmVmaXhfbGVuZ3RoICsga2V5X3R5cGVfc2l6ZSArIHZhbHVlX3R5cGVfc2l6ZQogICAgLy8gKSAqIFBFUl9CWVRFX0lOX0JPWF9NQlIgKyBQRVJfQk9YX01CUgogICAgcHVzaGludCAzNDkwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjEzCiAgICAvLyBjb25maWcueGdvdl9mZWUgPj0geGdvdl9ib3hfbWJyIGFuZCBjb25maWcueGdvdl9mZWUgPj0geGdvdl9yZXF1ZXN0X2JveF9tYnIKICAgID49CiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTItNjE0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X2JveF9tYnIgYW5kIGNvbmZpZy54Z292X2ZlZSA+PSB4Z292X3JlcXVlc3RfYm94X21icgogICAgLy8gKSwgZXJyLklOVkFMSURfWEdPVl9GRUUKICAgIGFzc2VydCAvLyBJbnZhbGlkIHhHb3YgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTYKICAgIC8vIGFzc2VydCBjb25maWcucHJvcG9zZXJfZmVlID49IHByb3Bvc2VyX2JveF9tYnIsIGVyci5JTlZBTElEX1BST1BPU0VSX0ZFRQogICAgZGlnIDEKICAgIGR1cAogICAgaW50Y18zIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjgzLTI4NQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBrZXlfcHJlZml4X2xlbmd0aCArIGtleV90eXBlX3NpemUgKyB2YWx1ZV90eXBlX3NpemUKICAgIC8vICkgKiBQRVJfQllURV9JTl9CT1hfTUJSICsgUEVSX0JPWF9NQlIKICAgIHB1c2hpbnQgMTkzMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxNgogICAgLy8gYXNzZXJ0IGNvbmZpZy5wcm9wb3Nlcl9mZWUgPj0gcHJvcG9zZXJfYm94X21iciwgZXJyLklOVkFMSURfUFJPUE9TRVJfRkVFCiAgICA+PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zZXIgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjAKICAgIC8vIDwgY29uZmlnLm1pbl9yZXF1ZXN0ZWRfYW1vdW50CiAgICBwdXNoaW50IDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYxOS02MjEKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLm1pbl9yZXF1ZXN0ZWRfYW1vdW50CiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgZGlnIDEKICAgIGV4dHJhY3QgNDggMjQKICAgIGR1cAogICAgYnVyeSAxOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIwLTYyMQogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICBkaWcgNgogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjE5LTYyMQogICAgLy8gMAogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjIKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICBkaWcgMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYyMS02MjIKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYyMgogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxLTYyMgogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMF0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICBkaWcgNQogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIwLTYyMgogICAgLy8gPCBjb25maWcubWluX3JlcXVlc3RlZF9hbW91bnQKICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIzCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgZGlnIDE3CiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIyLTYyMwogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzJdCiAgICBkaWcgNAogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjIxLTYyMwogICAgLy8gPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMF0KICAgIC8vIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzFdCiAgICAvLyA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfbWVyZ2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MTgtNjI0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIDAKICAgIC8vICAgICA8IGNvbmZpZy5taW5fcmVxdWVzdGVkX2Ftb3VudAogICAgLy8gICAgIDwgY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyAgICAgPCBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIC8vICAgICA8IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9SRVFVRVNURURfQU1PVU5UX0NPTkZJRwogICAgYXNzZXJ0IC8vIEluY29uc2lzdGVudCByZXF1ZXN0ZWQgYW1vdW50IGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjI3CiAgICAvLyBjb25maWcub3Blbl9wcm9wb3NhbF9mZWUsCiAgICBkaWcgMQogICAgZHVwCiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MjgKICAgIC8vIGNvbmZpZy5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLAogICAgZHVwCiAgICBwdXNoaW50IDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjcKICAgIC8vIHJldHVybiBhbW91bnQgKiBmcmFjdGlvbl9pbl9icHMgLy8gQlBTCiAgICBkaWcgMgogICAgKgogICAgcHVzaGludCAxMDAwMAogICAgLwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzExCiAgICAvLyBVSW50NjQoTUFYX01CUl9QRVJfQVBQICsgTUFYX01CUl9QRVJfQk9YICsgQUNDT1VOVF9NQlIpICsgZGFlbW9uX29wc19mdW5kaW5nCiAgICBwdXNoaW50IDE2ODM1MzAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozMTQKICAgIC8vIGFzc2VydCBvcGVuX3Byb3Bvc2FsX2ZlZSA+IHRvX3N1YnN0cmFjdCwgZXJyLklOVkFMSURfT1BFTl9QUk9QT1NBTF9GRUUKICAgIGRpZyAyCiAgICBkaWcgMQogICAgPgogICAgYXNzZXJ0IC8vIEludmFsaWQgb3BlbiBwcm9wb3NhbCBmZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMxNgogICAgLy8gbWJyX2F2YWlsYWJsZV9mb3JfY29tbWl0dGVlID0gb3Blbl9wcm9wb3NhbF9mZWUgLSB0b19zdWJzdHJhY3QKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgLQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjgzLTI4NQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBrZXlfcHJlZml4X2xlbmd0aCArIGtleV90eXBlX3NpemUgKyB2YWx1ZV90eXBlX3NpemUKICAgIC8vICkgKiBQRVJfQllURV9JTl9CT1hfTUJSICsgUEVSX0JPWF9NQlIKICAgIHB1c2hpbnQgMTg5MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMxOAogICAgLy8gc2VsZi5tYXhfY29tbWl0dGVlX3NpemUudmFsdWUgPSBtYnJfYXZhaWxhYmxlX2Zvcl9jb21taXR0ZWUgLy8gdm90ZXJfbWJyCiAgICAvCiAgICBieXRlYyA0OCAvLyAweDZkNjE3ODVmNjM2ZjZkNmQ2OTc0NzQ2NTY1NWY3MzY5N2E2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNAogICAgLy8gPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgZXh0cmFjdCA3MiAzMgogICAgZHVwCiAgICBidXJ5IDE4CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzMtNjM1CiAgICAvLyAwCiAgICAvLyA8IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjM1CiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgZGlnIDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzQtNjM1CiAgICAvLyA8IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNC02MzUKICAgIC8vIDwgY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICBkaWcgMwogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzMy02MzUKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzYKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzJdCiAgICBkaWcgMTYKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNS02MzYKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgZGlnIDE0CiAgICA+PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjM0LTYzNgogICAgLy8gPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzJdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzcKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzNdCiAgICBkaWcgMTYKICAgIHB1c2hpbnQgMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2MzYtNjM3CiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bM10KICAgIGRpZyAxMwogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjYzNS02MzcKICAgIC8vIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblsyXQogICAgLy8gPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bM10KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMTYKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjMyLTYzOAogICAgLy8gYXNzZXJ0ICgKICAgIC8vICAgICAwCiAgICAvLyAgICAgPCBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblswXQogICAgLy8gICAgIDw9IGNvbmZpZy5kaXNjdXNzaW9uX2R1cmF0aW9uWzFdCiAgICAvLyAgICAgPD0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMl0KICAgIC8vICAgICA8PSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblszXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9ESVNDVVNTSU9OX0RVUkFUSU9OX0NPTkZJRwogICAgYXNzZXJ0IC8vIEluY29uc2lzdGVudCBkaXNjdXNzaW9uIGR1cmF0aW9uIGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQyCiAgICAvLyA8IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMF0KICAgIGRpZyAxCiAgICBleHRyYWN0IDEwNCAzMgogICAgZHVwCiAgICBidXJ5IDIyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQxLTY0MwogICAgLy8gMAogICAgLy8gPCBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDIyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDMKICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIGRpZyAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQyLTY0MwogICAgLy8gPCBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBpbnRjXzMgLy8gOAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQzCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBidXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDItNjQzCiAgICAvLyA8IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMF0KICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIGRpZyAxMgogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0MS02NDMKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsxXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ0CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICBkaWcgMjAKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0My02NDQKICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIC8vIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMl0KICAgIGRpZyAxMQogICAgPj0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0Mi02NDQKICAgIC8vIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsxXQogICAgLy8gPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblsyXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBkaWcgMjAKICAgIHB1c2hpbnQgMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDQtNjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBkaWcgMTAKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NDMtNjQ1CiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDIyCiAgICBpbnRjXzEgLy8gMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0MC02NDYKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgMAogICAgLy8gICAgIDwgY29uZmlnLnZvdGluZ19kdXJhdGlvblswXQogICAgLy8gICAgIDw9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMV0KICAgIC8vICAgICA8PSBjb25maWcudm90aW5nX2R1cmF0aW9uWzJdCiAgICAvLyAgICAgPD0gY29uZmlnLnZvdGluZ19kdXJhdGlvblszXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9WT1RJTkdfRFVSQVRJT05fQ09ORklHCiAgICBhc3NlcnQgLy8gSW5jb25zaXN0ZW50IHZvdGluZyBkdXJhdGlvbiBjb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1MAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBkaWcgMQogICAgZXh0cmFjdCAxMzYgMjQKICAgIGR1cAogICAgYnVyeSAyMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0OS02NTAKICAgIC8vIDAKICAgIC8vIDwgY29uZmlnLnF1b3J1bVswXQogICAgYnogY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjUxLTY1MgogICAgLy8gIyBRdW9ydW0gTWVkaXVtIG5vIGxvbmdlciB1c2VkCiAgICAvLyA8IGNvbmZpZy5xdW9ydW1bMl0KICAgIGRpZyAxOQogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1MAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBkaWcgOQogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjQ5LTY1MAogICAgLy8gMAogICAgLy8gPCBjb25maWcucXVvcnVtWzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDI2CiAgICBpbnRjXzEgLy8gMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY0OC02NTMKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgMAogICAgLy8gICAgIDwgY29uZmlnLnF1b3J1bVswXQogICAgLy8gICAgICMgUXVvcnVtIE1lZGl1bSBubyBsb25nZXIgdXNlZAogICAgLy8gICAgIDwgY29uZmlnLnF1b3J1bVsyXQogICAgLy8gKSwgZXJyLklOQ09OU0lTVEVOVF9RVU9SVU1fQ09ORklHCiAgICBhc3NlcnQgLy8gSW5jb25zaXN0ZW50IHF1b3J1bSBjb25maWcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY1NwogICAgLy8gPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICBkaWcgMQogICAgZXh0cmFjdCAxNjAgMjQKICAgIGR1cAogICAgYnVyeSAyMAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU2LTY1NwogICAgLy8gMAogICAgLy8gPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICBieiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NTgtNjU5CiAgICAvLyAjIFdlaWdodGVkIFF1b3J1bSBNZWRpdW0gbm8gbG9uZ2VyIHVzZWQKICAgIC8vIDwgY29uZmlnLndlaWdodGVkX3F1b3J1bVsyXQogICAgZGlnIDE4CiAgICBwdXNoaW50IDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU3CiAgICAvLyA8IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGRpZyA4CiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NTYtNjU3CiAgICAvLyAwCiAgICAvLyA8IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGJ6IGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMzAKICAgIGludGNfMSAvLyAxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDMxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjU1LTY2MAogICAgLy8gYXNzZXJ0ICgKICAgIC8vICAgICAwCiAgICAvLyAgICAgPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzBdCiAgICAvLyAgICAgIyBXZWlnaHRlZCBRdW9ydW0gTWVkaXVtIG5vIGxvbmdlciB1c2VkCiAgICAvLyAgICAgPCBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzJdCiAgICAvLyApLCBlcnIuSU5DT05TSVNURU5UX1dFSUdIVEVEX1FVT1JVTV9DT05GSUcKICAgIGFzc2VydCAvLyBJbmNvbnNpc3RlbnQgd2VpZ2h0ZWQgcXVvcnVtIGNvbmZpZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjYyCiAgICAvLyBzZWxmLnhnb3ZfZmVlLnZhbHVlID0gY29uZmlnLnhnb3ZfZmVlCiAgICBieXRlYyAxOSAvLyAweDc4Njc2Zjc2NWY2NjY1NjUKICAgIGRpZyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjYzCiAgICAvLyBzZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSA9IGNvbmZpZy5wcm9wb3Nlcl9mZWUKICAgIGJ5dGVjIDIwIC8vIDB4NzA3MjZmNzA2ZjczNjU3MjVmNjY2NTY1CiAgICBkaWcgNwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2NAogICAgLy8gc2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSA9IGNvbmZpZy5vcGVuX3Byb3Bvc2FsX2ZlZQogICAgYnl0ZWMgMjEgLy8gMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1CiAgICBkaWcgMTUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjUKICAgIC8vIHNlbGYuZGFlbW9uX29wc19mdW5kaW5nX2Jwcy52YWx1ZSA9IGNvbmZpZy5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzCiAgICBieXRlYyAyNiAvLyAweDY0NjE2NTZkNmY2ZTVmNmY3MDY1NzI2MTc0Njk2ZjZlNWY2Njc1NmU2NDY5NmU2NzVmNjI3MDczCiAgICBkaWcgMTYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjYKICAgIC8vIHNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMudmFsdWUgPSBjb25maWcucHJvcG9zYWxfY29tbWl0bWVudF9icHMKICAgIGRpZyAxCiAgICBkdXAKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDI3IC8vIDB4NzA3MjZmNzA2ZjczNjE2YzVmNjM2ZjZkNmQ2OTc0NmQ2NTZlNzQ1ZjYyNzA3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2OAogICAgLy8gc2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudC52YWx1ZSA9IGNvbmZpZy5taW5fcmVxdWVzdGVkX2Ftb3VudAogICAgYnl0ZWMgMjggLy8gMHg2ZDY5NmU1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0CiAgICBkaWcgNwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY2OQogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9zbWFsbC52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFswXQogICAgZHVwCiAgICBleHRyYWN0IDQ4IDI0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgMjkgLy8gMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY3MzZkNjE2YzZjCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjcwCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NjktNjcwCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlID0gY29uZmlnLm1heF9yZXF1ZXN0ZWRfYW1vdW50WzBdCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsxXQogICAgaW50Y18zIC8vIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MAogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUgPSBjb25maWcubWF4X3JlcXVlc3RlZF9hbW91bnRbMV0KICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyAzMCAvLyAweDZkNjE3ODVmNzI2NTcxNzU2NTczNzQ2NTY0NWY2MTZkNmY3NTZlNzQ1ZjZkNjU2NDY5NzU2ZAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MQogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9sYXJnZS52YWx1ZSA9IGNvbmZpZy5tYXhfcmVxdWVzdGVkX2Ftb3VudFsyXQogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDMxIC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3MwogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMF0KICAgIGJ5dGVjIDMyIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGRpZyA0CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njc0CiAgICAvLyBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25fbWVkaXVtLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMV0KICAgIGRpZyAxNwogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDMzIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZkNjU2NDY5NzU2ZAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3NQogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlLnZhbHVlID0gY29uZmlnLmRpc2N1c3Npb25fZHVyYXRpb25bMl0KICAgIGR1cAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM0IC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjZjNjE3MjY3NjUKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo2NzYKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UudmFsdWUgPSBjb25maWcuZGlzY3Vzc2lvbl9kdXJhdGlvblszXQogICAgcHVzaGludCAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM1IC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY3OAogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25fc21hbGwudmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzBdCiAgICBieXRlYyAzNiAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgZGlnIDEzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njc5CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzFdCiAgICBkaWcgMjEKICAgIGR1cAogICAgaW50Y18zIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgwCiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9sYXJnZS52YWx1ZSA9IGNvbmZpZy52b3RpbmdfZHVyYXRpb25bMl0KICAgIGR1cAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NjgxCiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl94bGFyZ2UudmFsdWUgPSBjb25maWcudm90aW5nX2R1cmF0aW9uWzNdCiAgICBwdXNoaW50IDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgMzkgLy8gMHg3NjZmNzQ2OTZlNjc1ZjY0NzU3MjYxNzQ2OTZmNmU1Zjc4NmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4MwogICAgLy8gc2VsZi5xdW9ydW1fc21hbGwudmFsdWUgPSBjb25maWcucXVvcnVtWzBdCiAgICBieXRlYyA0MCAvLyAweDcxNzU2ZjcyNzU2ZDVmNzM2ZDYxNmM2YwogICAgZGlnIDEwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg0CiAgICAvLyBzZWxmLnF1b3J1bV9tZWRpdW0udmFsdWUgPSBVSW50NjQoMCkgICMgTm8gbG9uZ2VyIHVzZWQKICAgIGJ5dGVjIDQxIC8vIDB4NzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg1CiAgICAvLyBzZWxmLnF1b3J1bV9sYXJnZS52YWx1ZSA9IGNvbmZpZy5xdW9ydW1bMl0KICAgIGRpZyAyMAogICAgcHVzaGludCAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDQyIC8vIDB4NzE3NTZmNzI3NTZkNWY2YzYxNzI2NzY1CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njg3CiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSA9IGNvbmZpZy53ZWlnaHRlZF9xdW9ydW1bMF0KICAgIGJ5dGVjIDQzIC8vIDB4Nzc2NTY5Njc2ODc0NjU2NDVmNzE3NTZmNzI3NTZkNWY3MzZkNjE2YzZjCiAgICBkaWcgOQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4OAogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fbWVkaXVtLnZhbHVlID0gVUludDY0KDApICAjIE5vIGxvbmdlciB1c2VkCiAgICBieXRlYyA0NCAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmQ2NTY0Njk3NTZkCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY4OQogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fbGFyZ2UudmFsdWUgPSBjb25maWcud2VpZ2h0ZWRfcXVvcnVtWzJdCiAgICBkaWcgMTkKICAgIHB1c2hpbnQgMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBieXRlYyA0NSAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MQogICAgLy8gc2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZSA9IGNvbmZpZy5hYnNlbmNlX3RvbGVyYW5jZQogICAgZHVwCiAgICBwdXNoaW50IDE4NAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDIyIC8vIDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MgogICAgLy8gc2VsZi5nb3Zlcm5hbmNlX3BlcmlvZC52YWx1ZSA9IGNvbmZpZy5nb3Zlcm5hbmNlX3BlcmlvZAogICAgZHVwCiAgICBwdXNoaW50IDE5MgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjY5MwogICAgLy8gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlID0gY29uZmlnLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QKICAgIHB1c2hpbnQgMjAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnl0ZWMgNTAgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjU3MgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMzA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDMxCgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDI2OgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAyNwoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUAyMjoKICAgIGludGNfMCAvLyAwCiAgICBiIGNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfbWVyZ2VAMjMKCmNvbmZpZ194Z292X3JlZ2lzdHJ5X2Jvb2xfZmFsc2VAMTY6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX21lcmdlQDE3Cgpjb25maWdfeGdvdl9yZWdpc3RyeV9ib29sX2ZhbHNlQDEwOgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUAxMQoKY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgY29uZmlnX3hnb3ZfcmVnaXN0cnlfYm9vbF9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVwZGF0ZV94Z292X3JlZ2lzdHJ5W3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlX3hnb3ZfcmVnaXN0cnk6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3ZfbWFuYWdlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3ZfbWFuYWdlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6Njk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QoYWxsb3dfYWN0aW9ucz1bIlVwZGF0ZUFwcGxpY2F0aW9uIl0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzIyCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjcyNAogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgbm90IGluIHNlbGYueGdvdl9ib3gsIGVyci5BTFJFQURZX1hHT1YKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBBbHJlYWR5IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjcyNS03MjYKICAgIC8vICMgY2hlY2sgcGF5bWVudAogICAgLy8gYXNzZXJ0IHNlbGYudmFsaWRfeGdvdl9wYXltZW50KHBheW1lbnQpLCBlcnIuSU5WQUxJRF9QQVlNRU5UCiAgICBjYWxsc3ViIHZhbGlkX3hnb3ZfcGF5bWVudAogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzI4LTcyOQogICAgLy8gIyBjcmVhdGUgYm94CiAgICAvLyBzZWxmLnhnb3ZfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3hnb3ZfYm94KHZvdGluZ19hZGRyZXNzKQogICAgZHVwCiAgICBjYWxsc3ViIG1ha2VfeGdvdl9ib3gKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzAKICAgIC8vIHNlbGYueGdvdnMudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdnMgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzIKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlN1YnNjcmliZWQoeGdvdj1UeG4uc2VuZGVyLCBkZWxlZ2F0ZT12b3RpbmdfYWRkcmVzcykpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDUzIC8vIG1ldGhvZCAiWEdvdlN1YnNjcmliZWQoYWRkcmVzcyxhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MDYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVuc3Vic2NyaWJlX3hnb3Zbcm91dGluZ10oKSAtPiB2b2lkOgp1bnN1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzQzCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc0NS03NDYKICAgIC8vICMgZW5zdXJlIHRoZSBzZW5kZXIgaXMgYW4geEdvdgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgaW4gc2VsZi54Z292X2JveCwgZXJyLlVOQVVUSE9SSVpFRAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzQ4LTc0OQogICAgLy8gIyBkZWxldGUgYm94CiAgICAvLyBkZWwgc2VsZi54Z292X2JveFtUeG4uc2VuZGVyXQogICAgYnl0ZWNfMiAvLyAweDc4CiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzUwCiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzUyCiAgICAvLyBhcmM0LmVtaXQodHlwLlhHb3ZVbnN1YnNjcmliZWQoeGdvdj1UeG4uc2VuZGVyKSkKICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiWEdvdlVuc3Vic2NyaWJlZChhZGRyZXNzKSIKICAgIHR4biBTZW5kZXIKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3MzQKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnVuc3Vic2NyaWJlX2Fic2VudGVlW3JvdXRpbmddKCkgLT4gdm9pZDoKdW5zdWJzY3JpYmVfYWJzZW50ZWU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NTQKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc2OQogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzAKICAgIC8vIGFzc2VydCB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveCwgZXJyLk5PVF9YR09WCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gTm90IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3MQogICAgLy8gYXNzZXJ0IHNlbGYueGdvdl9ib3hbeGdvdl9hZGRyZXNzXS50b2xlcmF0ZWRfYWJzZW5jZXMgPT0gMCwgZXJyLlVOQVVUSE9SSVpFRAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgICEKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3MwogICAgLy8gZGVsIHNlbGYueGdvdl9ib3hbeGdvdl9hZGRyZXNzXQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzQKICAgIC8vIHNlbGYueGdvdnMudmFsdWUgLT0gMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdnMgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo3NzYKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlVuc3Vic2NyaWJlZCh4Z292PXhnb3ZfYWRkcmVzcykpCiAgICBieXRlYyA1MSAvLyBtZXRob2QgIlhHb3ZVbnN1YnNjcmliZWQoYWRkcmVzcykiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6NzU0CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5yZXF1ZXN0X3N1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVxdWVzdF9zdWJzY3JpYmVfeGdvdjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgwMgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gb3duZXJfYWRkcmVzcywgZXJyLlVOQVVUSE9SSVpFRAogICAgdHhuIFNlbmRlcgogICAgZGlnIDMKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MDMKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODA1LTgwNgogICAgLy8gIyBlbnN1cmUgdGhlIHhnb3ZfYWRkcmVzcyBpcyBub3QgYWxyZWFkeSBhbiB4R292CiAgICAvLyBhc3NlcnQgeGdvdl9hZGRyZXNzIG5vdCBpbiBzZWxmLnhnb3ZfYm94LCBlcnIuQUxSRUFEWV9YR09WCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyA0CiAgICBjb25jYXQKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIEFscmVhZHkgYW4geEdvdgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODA4LTgwOQogICAgLy8gIyBjaGVjayBwYXltZW50CiAgICAvLyBhc3NlcnQgc2VsZi52YWxpZF94Z292X3BheW1lbnQocGF5bWVudCksIGVyci5JTlZBTElEX1BBWU1FTlQKICAgIGNhbGxzdWIgdmFsaWRfeGdvdl9wYXltZW50CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MTEtODEyCiAgICAvLyAjIGNyZWF0ZSByZXF1ZXN0IGJveAogICAgLy8gcmlkID0gc2VsZi5yZXF1ZXN0X2lkLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTEgLy8gMHg3MjY1NzE3NTY1NzM3NDVmNjk2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfaWQgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MTMtODE3CiAgICAvLyBzZWxmLnJlcXVlc3RfYm94W3JpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODEzCiAgICAvLyBzZWxmLnJlcXVlc3RfYm94W3JpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIGRpZyAxCiAgICBpdG9iCiAgICBieXRlYyAyMyAvLyAweDcyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgxMy04MTcKICAgIC8vIHNlbGYucmVxdWVzdF9ib3hbcmlkXSA9IHR5cC5YR292U3Vic2NyaWJlUmVxdWVzdEJveFZhbHVlKAogICAgLy8gICAgIHhnb3ZfYWRkcj14Z292X2FkZHJlc3MsCiAgICAvLyAgICAgb3duZXJfYWRkcj1vd25lcl9hZGRyZXNzLAogICAgLy8gICAgIHJlbGF0aW9uX3R5cGU9cmVsYXRpb25fdHlwZSwKICAgIC8vICkKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgxOS04MjAKICAgIC8vICMgaW5jcmVtZW50IHJlcXVlc3QgaWQKICAgIC8vIHNlbGYucmVxdWVzdF9pZC52YWx1ZSArPSAxCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnl0ZWMgMTEgLy8gMHg3MjY1NzE3NTY1NzM3NDVmNjk2NAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojc3OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuYXBwcm92ZV9zdWJzY3JpYmVfeGdvdltyb3V0aW5nXSgpIC0+IHZvaWQ6CmFwcHJvdmVfc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MjIKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4MzQKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3Zfc3Vic2NyaWJlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3Zfc3Vic2NyaWJlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODM2LTgzNwogICAgLy8gIyBnZXQgdGhlIHJlcXVlc3QKICAgIC8vIHJlcXVlc3QgPSBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdLmNvcHkoKQogICAgaXRvYgogICAgYnl0ZWMgMjMgLy8gMHg3MgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfYm94IGVudHJ5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODM4LTgzOQogICAgLy8gIyBjcmVhdGUgdGhlIHhHb3YKICAgIC8vIHNlbGYueGdvdl9ib3hbcmVxdWVzdC54Z292X2FkZHJdID0gc2VsZi5tYWtlX3hnb3ZfYm94KHJlcXVlc3Qub3duZXJfYWRkcikKICAgIGR1cAogICAgZXh0cmFjdCAzMiAzMgogICAgZHVwCiAgICBjYWxsc3ViIG1ha2VfeGdvdl9ib3gKICAgIHVuY292ZXIgMgogICAgZXh0cmFjdCAwIDMyCiAgICBieXRlY18yIC8vIDB4NzgKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQwCiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQxLTg0MgogICAgLy8gIyBkZWxldGUgdGhlIHJlcXVlc3QKICAgIC8vIGRlbCBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdCiAgICB1bmNvdmVyIDIKICAgIGJveF9kZWwKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODQ1LTg0OAogICAgLy8gdHlwLlhHb3ZTdWJzY3JpYmVkKAogICAgLy8gICAgIHhnb3Y9cmVxdWVzdC54Z292X2FkZHIsCiAgICAvLyAgICAgZGVsZWdhdGU9cmVxdWVzdC5vd25lcl9hZGRyLAogICAgLy8gKQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NDQtODQ5CiAgICAvLyBhcmM0LmVtaXQoCiAgICAvLyAgICAgdHlwLlhHb3ZTdWJzY3JpYmVkKAogICAgLy8gICAgICAgICB4Z292PXJlcXVlc3QueGdvdl9hZGRyLAogICAgLy8gICAgICAgICBkZWxlZ2F0ZT1yZXF1ZXN0Lm93bmVyX2FkZHIsCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgYnl0ZWMgNTMgLy8gbWV0aG9kICJYR292U3Vic2NyaWJlZChhZGRyZXNzLGFkZHJlc3MpIgogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjgyMgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucmVqZWN0X3N1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVqZWN0X3N1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODUxCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODYzCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X3N1YnNjcmliZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X3N1YnNjcmliZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg2NS04NjYKICAgIC8vICMgZGVsZXRlIHRoZSByZXF1ZXN0CiAgICAvLyBkZWwgc2VsZi5yZXF1ZXN0X2JveFtyZXF1ZXN0X2lkXQogICAgaXRvYgogICAgYnl0ZWMgMjMgLy8gMHg3MgogICAgc3dhcAogICAgY29uY2F0CiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg1MQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucmVxdWVzdF91bnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVxdWVzdF91bnN1YnNjcmliZV94Z292OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODY4CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6ODkzCiAgICAvLyBhc3NlcnQgVHhuLnNlbmRlciA9PSBvd25lcl9hZGRyZXNzLCBlcnIuVU5BVVRIT1JJWkVECiAgICB0eG4gU2VuZGVyCiAgICBkaWcgMwogICAgPT0KICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg5NAogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4OTYtODk3CiAgICAvLyAjIGVuc3VyZSB0aGUgeGdvdl9hZGRyZXNzIGlzIGFscmVhZHkgYW4geEdvdgogICAgLy8gYXNzZXJ0IHhnb3ZfYWRkcmVzcyBpbiBzZWxmLnhnb3ZfYm94LCBlcnIuTk9UX1hHT1YKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgZGlnIDQKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gTm90IGFuIHhHb3YKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojg5OS05MDAKICAgIC8vICMgY2hlY2sgcGF5bWVudAogICAgLy8gYXNzZXJ0IHNlbGYudmFsaWRfeGdvdl9wYXltZW50KHBheW1lbnQpLCBlcnIuSU5WQUxJRF9QQVlNRU5UCiAgICBjYWxsc3ViIHZhbGlkX3hnb3ZfcGF5bWVudAogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTAyLTkwMwogICAgLy8gIyBjcmVhdGUgdW5zdWJzY3JpYmUgcmVxdWVzdCBib3gKICAgIC8vIHJ1aWQgPSBzZWxmLnJlcXVlc3RfaWQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMSAvLyAweDcyNjU3MTc1NjU3Mzc0NWY2OTY0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucmVxdWVzdF9pZCBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkwNC05MDgKICAgIC8vIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcnVpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTA0CiAgICAvLyBzZWxmLnJlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3J1aWRdID0gdHlwLlhHb3ZTdWJzY3JpYmVSZXF1ZXN0Qm94VmFsdWUoCiAgICBkaWcgMQogICAgaXRvYgogICAgYnl0ZWMgMjQgLy8gMHg3Mjc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkwNC05MDgKICAgIC8vIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcnVpZF0gPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9eGdvdl9hZGRyZXNzLAogICAgLy8gICAgIG93bmVyX2FkZHI9b3duZXJfYWRkcmVzcywKICAgIC8vICAgICByZWxhdGlvbl90eXBlPXJlbGF0aW9uX3R5cGUsCiAgICAvLyApCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MTAtOTExCiAgICAvLyAjIGluY3JlbWVudCByZXF1ZXN0IGlkCiAgICAvLyBzZWxmLnJlcXVlc3RfaWQudmFsdWUgKz0gMQogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ5dGVjIDExIC8vIDB4NzI2NTcxNzU2NTczNzQ1ZjY5NjQKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo4NjgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdltyb3V0aW5nXSgpIC0+IHZvaWQ6CmFwcHJvdmVfdW5zdWJzY3JpYmVfeGdvdjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkxMwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkyNQogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9zdWJzY3JpYmVyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9zdWJzY3JpYmVyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MjctOTI4CiAgICAvLyAjIGdldCB0aGUgcmVxdWVzdAogICAgLy8gcmVxdWVzdCA9IHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3hbcmVxdWVzdF9pZF0uY29weSgpCiAgICBpdG9iCiAgICBieXRlYyAyNCAvLyAweDcyNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBlbnRyeSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzMC05MzEKICAgIC8vICMgZGVsIHRoZSB4R292CiAgICAvLyBkZWwgc2VsZi54Z292X2JveFtyZXF1ZXN0Lnhnb3ZfYWRkcl0KICAgIGV4dHJhY3QgMCAzMgogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBkaWcgMQogICAgY29uY2F0CiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzMgogICAgLy8gc2VsZi54Z292cy52YWx1ZSAtPSAxCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMSAvLyAweDc4Njc2Zjc2NzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292cyBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjkzNC05MzUKICAgIC8vICMgZGVsZXRlIHRoZSByZXF1ZXN0CiAgICAvLyBkZWwgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveFtyZXF1ZXN0X2lkXQogICAgc3dhcAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzcKICAgIC8vIGFyYzQuZW1pdCh0eXAuWEdvdlVuc3Vic2NyaWJlZCh4Z292PXJlcXVlc3QueGdvdl9hZGRyKSkKICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiWEdvdlVuc3Vic2NyaWJlZChhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MTMKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnJlamVjdF91bnN1YnNjcmliZV94Z292W3JvdXRpbmddKCkgLT4gdm9pZDoKcmVqZWN0X3Vuc3Vic2NyaWJlX3hnb3Y6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NTEKICAgIC8vIGFzc2VydCBzZWxmLmlzX3hnb3Zfc3Vic2NyaWJlcigpLCBlcnIuVU5BVVRIT1JJWkVECiAgICBjYWxsc3ViIGlzX3hnb3Zfc3Vic2NyaWJlcgogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTUzLTk1NAogICAgLy8gIyBkZWxldGUgdGhlIHJlcXVlc3QKICAgIC8vIGRlbCBzZWxmLnJlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3JlcXVlc3RfaWRdCiAgICBpdG9iCiAgICBieXRlYyAyNCAvLyAweDcyNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5MzkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LnNldF92b3RpbmdfYWNjb3VudFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnNldF92b3RpbmdfYWNjb3VudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk1NgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NzIKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTc0LTk3NQogICAgLy8gIyBDaGVjayBpZiB0aGUgc2VuZGVyIGlzIGFuIHhHb3YgbWVtYmVyCiAgICAvLyBleGlzdHMgPSB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cG4gMgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTo5NzYKICAgIC8vIGFzc2VydCBleGlzdHMsIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk3NwogICAgLy8geGdvdl9ib3ggPSBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10uY29weSgpCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk4MQogICAgLy8gVHhuLnNlbmRlciA9PSB4Z292X2JveC52b3RpbmdfYWRkcmVzcyBvciBUeG4uc2VuZGVyID09IHhnb3ZfYWRkcmVzcwogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgZXh0cmFjdCAwIDMyCiAgICA9PQogICAgYm56IHNldF92b3RpbmdfYWNjb3VudF9ib29sX3RydWVAMwogICAgdHhuIFNlbmRlcgogICAgZGlnIDMKICAgID09CiAgICBieiBzZXRfdm90aW5nX2FjY291bnRfYm9vbF9mYWxzZUA0CgpzZXRfdm90aW5nX2FjY291bnRfYm9vbF90cnVlQDM6CiAgICBpbnRjXzEgLy8gMQoKc2V0X3ZvdGluZ19hY2NvdW50X2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5Ojk3OS05ODIKICAgIC8vICMgQ2hlY2sgdGhhdCB0aGUgc2VuZGVyIGlzIGVpdGhlciB0aGUgeEdvdiBvciB0aGUgdm90aW5nIGFkZHJlc3MKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgVHhuLnNlbmRlciA9PSB4Z292X2JveC52b3RpbmdfYWRkcmVzcyBvciBUeG4uc2VuZGVyID09IHhnb3ZfYWRkcmVzcwogICAgLy8gKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg0LTk4NQogICAgLy8gIyBVcGRhdGUgdGhlIHZvdGluZyBhY2NvdW50IGluIHRoZSB4R292IGJveAogICAgLy8gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLnZvdGluZ19hZGRyZXNzID0gdm90aW5nX2FkZHJlc3MKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTU2CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKc2V0X3ZvdGluZ19hY2NvdW50X2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIHNldF92b3RpbmdfYWNjb3VudF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuc3Vic2NyaWJlX3Byb3Bvc2VyW3JvdXRpbmddKCkgLT4gdm9pZDoKc3Vic2NyaWJlX3Byb3Bvc2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDAxCiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDMKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyIG5vdCBpbiBzZWxmLnByb3Bvc2VyX2JveCwgZXJyLkFMUkVBRFlfUFJPUE9TRVIKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBBbHJlYWR5IGEgcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDYKICAgIC8vIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDA0LTEwMDcKICAgIC8vICMgY2hlY2sgZmVlCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgLy8gKSwgZXJyLldST05HX1JFQ0VJVkVSCiAgICBhc3NlcnQgLy8gV3JvbmcgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMDgKICAgIC8vIGFzc2VydCBwYXltZW50LmFtb3VudCA9PSBzZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSwgZXJyLldST05HX1BBWU1FTlRfQU1PVU5UCiAgICBndHhucyBBbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMCAvLyAweDcwNzI2ZjcwNmY3MzY1NzI1ZjY2NjU2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnByb3Bvc2VyX2ZlZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gV3JvbmcgcGF5bWVudCBhbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTAKICAgIC8vIHNlbGYucHJvcG9zZXJfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3Byb3Bvc2VyX2JveCgKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgdHhuIFNlbmRlcgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNjQtMzY4CiAgICAvLyByZXR1cm4gdHlwLlByb3Bvc2VyQm94VmFsdWUoCiAgICAvLyAgICAgYWN0aXZlX3Byb3Bvc2FsPWFjdGl2ZV9wcm9wb3NhbCwKICAgIC8vICAgICBreWNfc3RhdHVzPWt5Y19zdGF0dXMsCiAgICAvLyAgICAga3ljX2V4cGlyaW5nPWt5Y19leHBpcmluZywKICAgIC8vICkKICAgIGJ5dGVjIDU0IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDEwLTEwMTIKICAgIC8vIHNlbGYucHJvcG9zZXJfYm94W1R4bi5zZW5kZXJdID0gc2VsZi5tYWtlX3Byb3Bvc2VyX2JveCgKICAgIC8vICAgICBhY3RpdmVfcHJvcG9zYWw9RmFsc2UsIGt5Y19zdGF0dXM9RmFsc2UsIGt5Y19leHBpcmluZz1VSW50NjQoMCkKICAgIC8vICkKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTQKICAgIC8vIGFyYzQuZW1pdCh0eXAuUHJvcG9zZXJTdWJzY3JpYmVkKHByb3Bvc2VyPVR4bi5zZW5kZXIpKQogICAgcHVzaGJ5dGVzIDB4YmQ3OTJmZDEgLy8gbWV0aG9kICJQcm9wb3NlclN1YnNjcmliZWQoYWRkcmVzcykiCiAgICB0eG4gU2VuZGVyCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6OTg3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5zZXRfcHJvcG9zZXJfa3ljW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0X3Byb3Bvc2VyX2t5YzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnN0YXRpY19hcnJheTxhcmM0LnVpbnQ4LCAzMj4KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5ib29sCiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDM3LTEwMzgKICAgIC8vICMgY2hlY2sgaWYga3ljIHByb3ZpZGVyCiAgICAvLyBhc3NlcnQgVHhuLnNlbmRlciA9PSBzZWxmLmt5Y19wcm92aWRlci52YWx1ZSwgZXJyLlVOQVVUSE9SSVpFRAogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE2IC8vIDB4NmI3OTYzNWY3MDcyNmY3NjY5NjQ2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYua3ljX3Byb3ZpZGVyIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMzkKICAgIC8vIGFzc2VydCBwcm9wb3NlciBpbiBzZWxmLnByb3Bvc2VyX2JveCwgZXJyLlBST1BPU0VSX0RPRVNfTk9UX0VYSVNUCiAgICBieXRlYyA0IC8vIDB4NzAKICAgIGRpZyAzCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUHJvcG9zZXIgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNDEKICAgIC8vIGFjdGl2ZV9wcm9wb3NhbCA9IHNlbGYucHJvcG9zZXJfYm94W3Byb3Bvc2VyXS5jb3B5KCkuYWN0aXZlX3Byb3Bvc2FsCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzY0LTM2OAogICAgLy8gcmV0dXJuIHR5cC5Qcm9wb3NlckJveFZhbHVlKAogICAgLy8gICAgIGFjdGl2ZV9wcm9wb3NhbD1hY3RpdmVfcHJvcG9zYWwsCiAgICAvLyAgICAga3ljX3N0YXR1cz1reWNfc3RhdHVzLAogICAgLy8gICAgIGt5Y19leHBpcmluZz1reWNfZXhwaXJpbmcsCiAgICAvLyApCiAgICBieXRlYyA4IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgaW50Y18xIC8vIDEKICAgIHVuY292ZXIgNAogICAgc2V0Yml0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA0My0xMDQ3CiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFtwcm9wb3Nlcl0gPSBzZWxmLm1ha2VfcHJvcG9zZXJfYm94KAogICAgLy8gICAgIGFjdGl2ZV9wcm9wb3NhbD1hY3RpdmVfcHJvcG9zYWwsCiAgICAvLyAgICAga3ljX3N0YXR1cz1reWNfc3RhdHVzLAogICAgLy8gICAgIGt5Y19leHBpcmluZz1reWNfZXhwaXJpbmcsCiAgICAvLyApCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDUyCiAgICAvLyB2YWxpZF9reWM9Ym9vbChzZWxmLnZhbGlkX2t5Yyhwcm9wb3NlcikpLAogICAgZHVwCiAgICBjYWxsc3ViIHZhbGlkX2t5YwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1MC0xMDUzCiAgICAvLyB0eXAuUHJvcG9zZXJLWUMoCiAgICAvLyAgICAgcHJvcG9zZXI9cHJvcG9zZXIsCiAgICAvLyAgICAgdmFsaWRfa3ljPWJvb2woc2VsZi52YWxpZF9reWMocHJvcG9zZXIpKSwKICAgIC8vICkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNDktMTA1NAogICAgLy8gYXJjNC5lbWl0KAogICAgLy8gICAgIHR5cC5Qcm9wb3NlcktZQygKICAgIC8vICAgICAgICAgcHJvcG9zZXI9cHJvcG9zZXIsCiAgICAvLyAgICAgICAgIHZhbGlkX2t5Yz1ib29sKHNlbGYudmFsaWRfa3ljKHByb3Bvc2VyKSksCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgcHVzaGJ5dGVzIDB4Y2I1MGZkODQgLy8gbWV0aG9kICJQcm9wb3NlcktZQyhhZGRyZXNzLGJvb2wpIgogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwMTYKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmRlY2xhcmVfY29tbWl0dGVlW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVjbGFyZV9jb21taXR0ZWU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDU2CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMjEKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYuY29tbWl0dGVlX21hbmFnZXIudmFsdWUKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxNyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWFuYWdlciBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDc2CiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X2NvbW1pdHRlZV9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwNzgKICAgIC8vIGFzc2VydCBzaXplID4gMCwgZXJyLldST05HX0NPTU1JVFRFRV9NRU1CRVJTCiAgICBkaWcgMQogICAgYXNzZXJ0IC8vIFdyb25nIENvbW1pdHRlZSBNZW1iZXJzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDc5CiAgICAvLyBhc3NlcnQgdm90ZXMgPiAwLCBlcnIuV1JPTkdfQ09NTUlUVEVFX1ZPVEVTCiAgICBkdXAKICAgIGFzc2VydCAvLyBXcm9uZyBDb21taXR0ZWUgVm90ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwODAKICAgIC8vIGFzc2VydCBzaXplIDw9IHNlbGYubWF4X2NvbW1pdHRlZV9zaXplLnZhbHVlLCBlcnIuQ09NTUlUVEVFX1NJWkVfVE9PX0xBUkdFCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDggLy8gMHg2ZDYxNzg1ZjYzNmY2ZDZkNjk3NDc0NjU2NTVmNzM2OTdhNjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5tYXhfY29tbWl0dGVlX3NpemUgZXhpc3RzCiAgICBkaWcgMgogICAgPj0KICAgIGFzc2VydCAvLyBDb21taXR0ZWUgc2l6ZSBpcyB0b28gbGFyZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwODIKICAgIC8vIHNlbGYuY29tbWl0dGVlX2lkLnZhbHVlID0gY29tbWl0dGVlX2lkLmNvcHkoKQogICAgYnl0ZWMgNTUgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY5NjQKICAgIGRpZyAzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA4MwogICAgLy8gc2VsZi5jb21taXR0ZWVfbWVtYmVycy52YWx1ZSA9IHNpemUKICAgIGJ5dGVjIDQ2IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2ZDY1NmQ2MjY1NzI3MwogICAgZGlnIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg0CiAgICAvLyBzZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSA9IHZvdGVzCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgZGlnIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg1CiAgICAvLyBzZWxmLmNvbW1pdHRlZV9sYXN0X2FuY2hvci52YWx1ZSA9IHNlbGYuZ2V0X2NvbW1pdHRlZV9hbmNob3IoKQogICAgY2FsbHN1YiBnZXRfY29tbWl0dGVlX2FuY2hvcgogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDkwCiAgICAvLyBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgc3dhcAogICAgaXRvYgogICAgZHVwCiAgICBiaXRsZW4KICAgIGludGNfMiAvLyAzMgogICAgPD0KICAgIGFzc2VydCAvLyBvdmVyZmxvdwogICAgZXh0cmFjdCA0IDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEwOTEKICAgIC8vIHZvdGVzPWFyYzQuVUludDMyKHZvdGVzKSwKICAgIHN3YXAKICAgIGl0b2IKICAgIGR1cAogICAgYml0bGVuCiAgICBpbnRjXzIgLy8gMzIKICAgIDw9CiAgICBhc3NlcnQgLy8gb3ZlcmZsb3cKICAgIGV4dHJhY3QgNCA0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg4LTEwOTIKICAgIC8vIHR5cC5OZXdDb21taXR0ZWUoCiAgICAvLyAgICAgY29tbWl0dGVlX2lkPWNvbW1pdHRlZV9pZCwKICAgIC8vICAgICBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgLy8gICAgIHZvdGVzPWFyYzQuVUludDMyKHZvdGVzKSwKICAgIC8vICkKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDg3LTEwOTMKICAgIC8vIGFyYzQuZW1pdCgKICAgIC8vICAgICB0eXAuTmV3Q29tbWl0dGVlKAogICAgLy8gICAgICAgICBjb21taXR0ZWVfaWQ9Y29tbWl0dGVlX2lkLAogICAgLy8gICAgICAgICBzaXplPWFyYzQuVUludDMyKHNpemUpLAogICAgLy8gICAgICAgICB2b3Rlcz1hcmM0LlVJbnQzMih2b3RlcyksCiAgICAvLyAgICAgKQogICAgLy8gKQogICAgcHVzaGJ5dGVzIDB4ODczNjU4NjYgLy8gbWV0aG9kICJOZXdDb21taXR0ZWUoYnl0ZVszMl0sdWludDMyLHVpbnQzMikiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTA1NgogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkub3Blbl9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wZW5fcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTExNgogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwgZXJyLlBBVVNFRF9SRUdJU1RSWQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcyNjU2NzY5NzM3NDcyNzkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wYXVzZWRfcmVnaXN0cnkgZXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gUmVnaXN0cnkncyBub24tYWRtaW4gbWV0aG9kcyBhcmUgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTE3CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3Byb3Bvc2Fscy52YWx1ZSwgZXJyLlBBVVNFRF9QUk9QT1NBTFMKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMCAvLyAweDcwNjE3NTczNjU2NDVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3Byb3Bvc2FscyBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBDcmVhdGlvbiBvZiBwcm9wb3NhbHMgaXMgcGF1c2VkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTE5CiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID0gc2VsZi5nZXRfY29tbWl0dGVlX2FuY2hvcigpCiAgICBjYWxsc3ViIGdldF9jb21taXR0ZWVfYW5jaG9yCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIwCiAgICAvLyBjb21taXR0ZWVfZGVsYXkgPSBHbG9iYWwucm91bmQgLSBjb21taXR0ZWVfYW5jaG9yCiAgICBnbG9iYWwgUm91bmQKICAgIGRpZyAxCiAgICAtCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIyCiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID09IHNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IgZXhpc3RzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMi0xMTIzCiAgICAvLyBjb21taXR0ZWVfYW5jaG9yID09IHNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlCiAgICAvLyBvciBjb21taXR0ZWVfZGVsYXkgPD0gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlCiAgICBibnogb3Blbl9wcm9wb3NhbF9ib29sX3RydWVAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMwogICAgLy8gb3IgY29tbWl0dGVlX2RlbGF5IDw9IHNlbGYuY29tbWl0dGVlX2dyYWNlX3BlcmlvZC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUwIC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2NzcyNjE2MzY1NWY3MDY1NzI2OTZmNjQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kIGV4aXN0cwogICAgZGlnIDEKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTIyLTExMjMKICAgIC8vIGNvbW1pdHRlZV9hbmNob3IgPT0gc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUKICAgIC8vIG9yIGNvbW1pdHRlZV9kZWxheSA8PSBzZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUKICAgIGJ6IG9wZW5fcHJvcG9zYWxfYm9vbF9mYWxzZUA0CgpvcGVuX3Byb3Bvc2FsX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCm9wZW5fcHJvcG9zYWxfYm9vbF9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyMS0xMTI0CiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIGNvbW1pdHRlZV9hbmNob3IgPT0gc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUKICAgIC8vICAgICBvciBjb21taXR0ZWVfZGVsYXkgPD0gc2VsZi5jb21taXR0ZWVfZ3JhY2VfcGVyaW9kLnZhbHVlCiAgICAvLyApLCBlcnIuQ09NTUlUVEVFX1NUQUxFCiAgICBhc3NlcnQgLy8gQ29tbWl0dGVlIGlzIHN0YWxlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTI2LTExMjcKICAgIC8vICMgQ2hlY2sgaWYgdGhlIGNhbGxlciBpcyBhIHJlZ2lzdGVyZWQgcHJvcG9zZXIKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyIGluIHNlbGYucHJvcG9zZXJfYm94LCBlcnIuVU5BVVRIT1JJWkVECiAgICBieXRlYyA0IC8vIDB4NzAKICAgIHR4biBTZW5kZXIKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTI5LTExMzAKICAgIC8vICMgQ2hlY2sgaWYgdGhlIHByb3Bvc2VyIGFscmVhZHkgaGFzIGFuIGFjdGl2ZSBwcm9wb3NhbAogICAgLy8gYXNzZXJ0IG5vdCBzZWxmLnByb3Bvc2VyX2JveFsKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzMQogICAgLy8gVHhuLnNlbmRlcgogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEyOS0xMTMyCiAgICAvLyAjIENoZWNrIGlmIHRoZSBwcm9wb3NlciBhbHJlYWR5IGhhcyBhbiBhY3RpdmUgcHJvcG9zYWwKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wcm9wb3Nlcl9ib3hbCiAgICAvLyAgICAgVHhuLnNlbmRlcgogICAgLy8gXS5hY3RpdmVfcHJvcG9zYWwsIGVyci5BTFJFQURZX0FDVElWRV9QUk9QT1NBTAogICAgY29uY2F0CiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3Nlcl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAhCiAgICBhc3NlcnQgLy8gUHJvcG9zZXIgYWxyZWFkeSBoYXMgYW4gYWN0aXZlIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTMzCiAgICAvLyBhc3NlcnQgc2VsZi52YWxpZF9reWMoVHhuLnNlbmRlciksIGVyci5JTlZBTElEX0tZQwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiB2YWxpZF9reWMKICAgIGFzc2VydCAvLyBJbnZhbGlkIEtZQwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzNQogICAgLy8gYXNzZXJ0IFR4bi5mZWUgPj0gKEdsb2JhbC5taW5fdHhuX2ZlZSAqIDMpLCBlcnIuSU5TVUZGSUNJRU5UX0ZFRQogICAgdHhuIEZlZQogICAgZ2xvYmFsIE1pblR4bkZlZQogICAgcHVzaGludCAzCiAgICAqCiAgICA+PQogICAgYXNzZXJ0IC8vIEluc3VmZmljaWVudCBmZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExMzkKICAgIC8vIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZGlnIDEKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTEzNy0xMTQwCiAgICAvLyAjIEVuc3VyZSB0aGUgdHJhbnNhY3Rpb24gaGFzIHRoZSBjb3JyZWN0IHBheW1lbnQKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyApLCBlcnIuV1JPTkdfUkVDRUlWRVIKICAgIGFzc2VydCAvLyBXcm9uZyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0MQogICAgLy8gYXNzZXJ0IHBheW1lbnQuYW1vdW50ID09IHNlbGYub3Blbl9wcm9wb3NhbF9mZWUudmFsdWUsIGVyci5XUk9OR19QQVlNRU5UX0FNT1VOVAogICAgZ3R4bnMgQW1vdW50CiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjEgLy8gMHg2ZjcwNjU2ZTVmNzA3MjZmNzA2ZjczNjE2YzVmNjY2NTY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3Blbl9wcm9wb3NhbF9mZWUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBwYXltZW50IGFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0MwogICAgLy8gbWJyX2JlZm9yZSA9IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MuYmFsYW5jZQogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGFjY3RfcGFyYW1zX2dldCBBY2N0QmFsYW5jZQogICAgYXNzZXJ0IC8vIGFjY291bnQgZnVuZGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTQ1CiAgICAvLyBhc3NlcnQgc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLCBlcnIuTUlTU0lOR19QUk9QT1NBTF9BUFBST1ZBTF9QUk9HUkFNCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgYm94X2xlbgogICAgYXNzZXJ0IC8vIE1pc3NpbmcgcHJvcG9zYWwgYXBwcm92YWwgcHJvZ3JhbQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1NQogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmxlbmd0aCArIGNvbXBpbGVkX2NsZWFyX3N0YXRlXzEubGVuZ3RoCiAgICBkdXAKICAgIHB1c2hpbnQgNAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1MwogICAgLy8gYnl0ZXNfcGVyX3BhZ2UgPSBVSW50NjQoQllURVNfUEVSX0FQUF9QQUdFKQogICAgaW50YyA0IC8vIDIwNDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNTcKICAgIC8vIHRvdGFsX3BhZ2VzID0gdG90YWxfc2l6ZSAvLyBieXRlc19wZXJfcGFnZQogICAgLwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE1OS0xMTYyCiAgICAvLyAjIFRoZSBmb2xsb3dpbmcgYXNzZXJ0aW9uIG1ha2VzIHN1cmUgdGhlIGxvb3AtdW5yb2xsaW5nIGlzIGNvbnNpc3RlbnQKICAgIC8vIGFzc2VydCB0b3RhbF9wYWdlcyA9PSBVSW50NjQoCiAgICAvLyAgICAgUFJPUE9TQUxfQVBQUk9WQUxfUEFHRVMKICAgIC8vICksIGVyci5JTlZBTElEX1BST1BPU0FMX0FQUFJPVkFMX1BST0dSQU1fU0laRQogICAgZHVwCiAgICBwdXNoaW50IDIKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbCBhcHByb3ZhbCBwcm9ncmFtIHNpemUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNjQKICAgIC8vIHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5sZW5ndGggLSAodG90YWxfcGFnZXMgLSAxKSAqIGJ5dGVzX3Blcl9wYWdlCiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTUzCiAgICAvLyBieXRlc19wZXJfcGFnZSA9IFVJbnQ2NChCWVRFU19QRVJfQVBQX1BBR0UpCiAgICBpbnRjIDQgLy8gMjA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NAogICAgLy8gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmxlbmd0aCAtICh0b3RhbF9wYWdlcyAtIDEpICogYnl0ZXNfcGVyX3BhZ2UKICAgICoKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgLQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NgogICAgLy8gcGFnZV8xID0gc2VsZi5wcm9wb3NhbF9hcHByb3ZhbF9wcm9ncmFtLmV4dHJhY3QoCiAgICBieXRlYyA2IC8vIDB4NzA2MQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2NwogICAgLy8gMCAqIGJ5dGVzX3Blcl9wYWdlLCBieXRlc19wZXJfcGFnZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNTMKICAgIC8vIGJ5dGVzX3Blcl9wYWdlID0gVUludDY0KEJZVEVTX1BFUl9BUFBfUEFHRSkKICAgIGludGMgNCAvLyAyMDQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTY2LTExNjgKICAgIC8vIHBhZ2VfMSA9IHNlbGYucHJvcG9zYWxfYXBwcm92YWxfcHJvZ3JhbS5leHRyYWN0KAogICAgLy8gICAgIDAgKiBieXRlc19wZXJfcGFnZSwgYnl0ZXNfcGVyX3BhZ2UKICAgIC8vICkKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTY5CiAgICAvLyBwYWdlXzIgPSBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0uZXh0cmFjdCgKICAgIGJ5dGVjIDYgLy8gMHg3MDYxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTUzCiAgICAvLyBieXRlc19wZXJfcGFnZSA9IFVJbnQ2NChCWVRFU19QRVJfQVBQX1BBR0UpCiAgICBpbnRjIDQgLy8gMjA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE2OS0xMTcxCiAgICAvLyBwYWdlXzIgPSBzZWxmLnByb3Bvc2FsX2FwcHJvdmFsX3Byb2dyYW0uZXh0cmFjdCgKICAgIC8vICAgICAxICogYnl0ZXNfcGVyX3BhZ2UsIGJ5dGVzX2xhc3RfcGFnZQogICAgLy8gKQogICAgdW5jb3ZlciAzCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3My0xMTgxCiAgICAvLyB0eCA9IGFyYzQuYWJpX2NhbGwoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuY3JlYXRlLAogICAgLy8gICAgIFR4bi5zZW5kZXIsCiAgICAvLyAgICAgYXBwcm92YWxfcHJvZ3JhbT0ocGFnZV8xLCBwYWdlXzIpLAogICAgLy8gICAgIGNsZWFyX3N0YXRlX3Byb2dyYW09Y29tcGlsZWRfY2xlYXJfc3RhdGVfMSwKICAgIC8vICAgICBnbG9iYWxfbnVtX3VpbnQ9cGNmZy5HTE9CQUxfVUlOVFMsCiAgICAvLyAgICAgZ2xvYmFsX251bV9ieXRlcz1wY2ZnLkdMT0JBTF9CWVRFUywKICAgIC8vICAgICBleHRyYV9wcm9ncmFtX3BhZ2VzPXRvdGFsX3BhZ2VzLAogICAgLy8gKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3NQogICAgLy8gVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBFeHRyYVByb2dyYW1QYWdlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE3OQogICAgLy8gZ2xvYmFsX251bV9ieXRlcz1wY2ZnLkdMT0JBTF9CWVRFUywKICAgIHB1c2hpbnQgMwogICAgaXR4bl9maWVsZCBHbG9iYWxOdW1CeXRlU2xpY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExNzgKICAgIC8vIGdsb2JhbF9udW1fdWludD1wY2ZnLkdMT0JBTF9VSU5UUywKICAgIHB1c2hpbnQgMjcKICAgIGl0eG5fZmllbGQgR2xvYmFsTnVtVWludAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE0Ny0xMTUxCiAgICAvLyAjIGNsZWFyX3N0YXRlX3Byb2dyYW0gaXMgYSB0dXBsZSBvZiAyIEJ5dGVzIGVsZW1lbnRzIHdoZXJlIGVhY2ggaXMgbWF4IDQwOTYgYnl0ZXMKICAgIC8vICMgd2Ugb25seSB1c2UgdGhlIGZpcnN0IGVsZW1lbnQgaGVyZSBhcyB3ZSBhc3N1bWUgdGhlIGNsZWFyIHN0YXRlIHByb2dyYW0gaXMgc21hbGwgZW5vdWdoCiAgICAvLyBjb21waWxlZF9jbGVhcl9zdGF0ZV8xLCBfY29tcGlsZWRfY2xlYXJfc3RhdGVfMiA9IGNvbXBpbGVfY29udHJhY3QoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwKICAgIC8vICkuY2xlYXJfc3RhdGVfcHJvZ3JhbQogICAgcHVzaGJ5dGVzIGJhc2U2NChDb0VCUXc9PSkKICAgIGl0eG5fZmllbGQgQ2xlYXJTdGF0ZVByb2dyYW1QYWdlcwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcHJvdmFsUHJvZ3JhbVBhZ2VzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcHJvdmFsUHJvZ3JhbVBhZ2VzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTczLTExODEKICAgIC8vIHR4ID0gYXJjNC5hYmlfY2FsbCgKICAgIC8vICAgICBwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC5jcmVhdGUsCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICBhcHByb3ZhbF9wcm9ncmFtPShwYWdlXzEsIHBhZ2VfMiksCiAgICAvLyAgICAgY2xlYXJfc3RhdGVfcHJvZ3JhbT1jb21waWxlZF9jbGVhcl9zdGF0ZV8xLAogICAgLy8gICAgIGdsb2JhbF9udW1fdWludD1wY2ZnLkdMT0JBTF9VSU5UUywKICAgIC8vICAgICBnbG9iYWxfbnVtX2J5dGVzPXBjZmcuR0xPQkFMX0JZVEVTLAogICAgLy8gICAgIGV4dHJhX3Byb2dyYW1fcGFnZXM9dG90YWxfcGFnZXMsCiAgICAvLyApCiAgICBwdXNoYnl0ZXMgMHhjYzY5NGVhYSAvLyBtZXRob2QgImNyZWF0ZShhZGRyZXNzKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIENyZWF0ZWRBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTgzCiAgICAvLyBtYnJfYWZ0ZXIgPSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NS0xMTkwCiAgICAvLyAjIFRyYW5zZmVyIGZ1bmRzIHRvIHRoZSBuZXcgUHJvcG9zYWwgQXBwLCBleGNsdWRpbmcgdGhlIE1CUiBuZWVkZWQgZm9yIHRoZSBQcm9wb3NhbCBBcHAKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIC8vICAgICByZWNlaXZlcj10eC5jcmVhdGVkX2FwcC5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudD1zZWxmLm9wZW5fcHJvcG9zYWxfZmVlLnZhbHVlIC0gKG1icl9hZnRlciAtIG1icl9iZWZvcmUpLAogICAgLy8gICAgIGZlZT0wLAogICAgLy8gKS5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NwogICAgLy8gcmVjZWl2ZXI9dHguY3JlYXRlZF9hcHAuYWRkcmVzcywKICAgIGRpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTg4CiAgICAvLyBhbW91bnQ9c2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSAtIChtYnJfYWZ0ZXIgLSBtYnJfYmVmb3JlKSwKICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgLQogICAgdW5jb3ZlciAzCiAgICBzd2FwCiAgICAtCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE4NS0xMTg2CiAgICAvLyAjIFRyYW5zZmVyIGZ1bmRzIHRvIHRoZSBuZXcgUHJvcG9zYWwgQXBwLCBleGNsdWRpbmcgdGhlIE1CUiBuZWVkZWQgZm9yIHRoZSBQcm9wb3NhbCBBcHAKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExODkKICAgIC8vIGZlZT0wLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTg1LTExOTAKICAgIC8vICMgVHJhbnNmZXIgZnVuZHMgdG8gdGhlIG5ldyBQcm9wb3NhbCBBcHAsIGV4Y2x1ZGluZyB0aGUgTUJSIG5lZWRlZCBmb3IgdGhlIFByb3Bvc2FsIEFwcAogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXR4LmNyZWF0ZWRfYXBwLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50PXNlbGYub3Blbl9wcm9wb3NhbF9mZWUudmFsdWUgLSAobWJyX2FmdGVyIC0gbWJyX2JlZm9yZSksCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE5MgogICAgLy8gc2VsZi5pbmNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMoVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMyMQogICAgLy8gc2VsZi5wZW5kaW5nX3Byb3Bvc2Fscy52YWx1ZSArPSAxCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgOSAvLyAweDcwNjU2ZTY0Njk2ZTY3NWY3MDcyNmY3MDZmNzM2MTZjNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wZW5kaW5nX3Byb3Bvc2FscyBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBieXRlYyA5IC8vIDB4NzA2NTZlNjQ2OTZlNjc1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMyMgogICAgLy8gc2VsZi5wcm9wb3Nlcl9ib3hbcHJvcG9zZXJdLmFjdGl2ZV9wcm9wb3NhbCA9IFRydWUKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgc2V0Yml0CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjExOTcKICAgIC8vIHByb3Bvc2VyPVR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMTk1LTExOTgKICAgIC8vIHR5cC5OZXdQcm9wb3NhbCgKICAgIC8vICAgICBwcm9wb3NhbF9pZD10eC5jcmVhdGVkX2FwcC5pZCwKICAgIC8vICAgICBwcm9wb3Nlcj1UeG4uc2VuZGVyLAogICAgLy8gKQogICAgc3dhcAogICAgaXRvYgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTE5NC0xMTk5CiAgICAvLyBhcmM0LmVtaXQoCiAgICAvLyAgICAgdHlwLk5ld1Byb3Bvc2FsKAogICAgLy8gICAgICAgICBwcm9wb3NhbF9pZD10eC5jcmVhdGVkX2FwcC5pZCwKICAgIC8vICAgICAgICAgcHJvcG9zZXI9VHhuLnNlbmRlciwKICAgIC8vICAgICApCiAgICAvLyApCiAgICBwdXNoYnl0ZXMgMHhmYTc5ZDg0YiAvLyBtZXRob2QgIk5ld1Byb3Bvc2FsKHVpbnQ2NCxhZGRyZXNzKSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMDk1CiAgICAvLyBAYXJjNC5hYmltZXRob2QKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm9wZW5fcHJvcG9zYWxfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgb3Blbl9wcm9wb3NhbF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkudm90ZV9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnZvdGVfcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyMDMKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjM0CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyMzYtMTIzNwogICAgLy8gIyB2ZXJpZnkgcHJvcG9zYWxfaWQgaWQgaXMgZ2VudWluZSBwcm9wb3NhbAogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIHN3YXAKICAgIGNhbGxzdWIgX2lzX3Byb3Bvc2FsCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIzOS0xMjQwCiAgICAvLyAjIG1ha2Ugc3VyZSB0aGV5J3JlIHZvdGluZyBvbiBiZWhhbGYgb2YgYW4geEdvdgogICAgLy8gZXhpc3RzID0geGdvdl9hZGRyZXNzIGluIHNlbGYueGdvdl9ib3gKICAgIGJ5dGVjXzIgLy8gMHg3OAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDEKICAgIC8vIGFzc2VydCBleGlzdHMsIGVyci5VTkFVVEhPUklaRUQKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDIKICAgIC8vIHhnb3ZfYm94ID0gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLmNvcHkoKQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDMtMTI0NAogICAgLy8gIyBVcG9uIHZvdGUgdGhlIGFic2VuY2UgdG9sZXJhbmNlIGlzIHJlc2V0CiAgICAvLyBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10udG9sZXJhdGVkX2Fic2VuY2VzID0gc2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDIyIC8vIDB4NjE2MjczNjU2ZTYzNjU1Zjc0NmY2YzY1NzI2MTZlNjM2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmFic2VuY2VfdG9sZXJhbmNlIGV4aXN0cwogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMiAvLyAzMgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI0NQogICAgLy8gc2VsZi54Z292X2JveFt4Z292X2FkZHJlc3NdLmxhc3Rfdm90ZV90aW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0X3RpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaXRvYgogICAgcHVzaGludCA0MAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNDkKICAgIC8vIFR4bi5zZW5kZXIgPT0geGdvdl9hZGRyZXNzIG9yIFR4bi5zZW5kZXIgPT0geGdvdl9ib3gudm90aW5nX2FkZHJlc3MKICAgIHR4biBTZW5kZXIKICAgID09CiAgICBibnogdm90ZV9wcm9wb3NhbF9ib29sX3RydWVAMwogICAgdHhuIFNlbmRlcgogICAgZGlnIDEKICAgIGV4dHJhY3QgMCAzMgogICAgPT0KICAgIGJ6IHZvdGVfcHJvcG9zYWxfYm9vbF9mYWxzZUA0Cgp2b3RlX3Byb3Bvc2FsX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCnZvdGVfcHJvcG9zYWxfYm9vbF9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI0Ny0xMjUwCiAgICAvLyAjIFZlcmlmeSB0aGUgY2FsbGVyIGlzIGVpdGhlciB4Z292IGFkZHJlc3Mgb3IgaXRzIHZvdGluZyBhZGRyZXNzCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIFR4bi5zZW5kZXIgPT0geGdvdl9hZGRyZXNzIG9yIFR4bi5zZW5kZXIgPT0geGdvdl9ib3gudm90aW5nX2FkZHJlc3MKICAgIC8vICksIGVyci5NVVNUX0JFX1hHT1ZfT1JfVk9USU5HX0FERFJFU1MKICAgIGFzc2VydCAvLyBNdXN0IGJlIHhnb3Ygb3Igdm90aW5nIGFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNTItMTI1OQogICAgLy8gIyBDYWxsIHRoZSBQcm9wb3NhbCBBcHAgdG8gcmVnaXN0ZXIgdGhlIHZvdGUKICAgIC8vIGVycm9yLCBfdHggPSBhcmM0LmFiaV9jYWxsKAogICAgLy8gICAgIHByb3Bvc2FsX2NvbnRyYWN0LlByb3Bvc2FsLnZvdGUsCiAgICAvLyAgICAgeGdvdl9hZGRyZXNzLAogICAgLy8gICAgIGFwcHJvdmFsX3ZvdGVzLAogICAgLy8gICAgIHJlamVjdGlvbl92b3RlcywKICAgIC8vICAgICBhcHBfaWQ9cHJvcG9zYWxfaWQsCiAgICAvLyApCiAgICBpdHhuX2JlZ2luCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHgxODQxYTBkMiAvLyBtZXRob2QgInZvdGUoYWRkcmVzcyx1aW50NjQsdWludDY0KXN0cmluZyIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGJ1cnkgOQogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGxvZyB2YWx1ZSBpcyBub3QgdGhlIHJlc3VsdCBvZiBhbiBBQkkgcmV0dXJuCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNiAvLyBvbiBlcnJvcjogaW52YWxpZCBhcnJheSBsZW5ndGggaGVhZGVyCiAgICBwdXNoaW50IDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3Igc3RyaW5nCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjEKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIGJ1cnkgNwogICAgcHVzaGludCA0CiAgICA8CiAgICBieiB2b3RlX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAOAogICAgaW50Y18wIC8vIDAKCnZvdGVfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiB2b3RlX3Byb3Bvc2FsX2Vsc2VfYm9keUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MgogICAgLy8gZXJyb3Jfd2l0aG91dF9wcmVmaXggPSBTdHJpbmcuZnJvbV9ieXRlcyhlcnJvci5ieXRlc1s0Ol0pCiAgICBwdXNoaW50IDQKICAgIGRpZyA2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgID49CiAgICBwdXNoaW50IDQKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZGlnIDgKICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2NAogICAgLy8gY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgYnl0ZWMgMTIgLy8gIldyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjY2CiAgICAvLyBjYXNlIGVyci5WT1RFUl9OT1RfRk9VTkQ6CiAgICBieXRlYyA1NiAvLyAiVm90ZXIgbm90IGZvdW5kIgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2OAogICAgLy8gY2FzZSBlcnIuVk9URVNfSU5WQUxJRDoKICAgIHB1c2hieXRlc3MgIlZvdGVzIGludmFsaWQiICJWb3RpbmcgUGVyaW9kIEV4cGlyZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjYzLTEyNzMKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJfTk9UX0ZPVU5EOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIC8vICAgICBjYXNlIGVyci5WT1RFU19JTlZBTElEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVTX0lOVkFMSUQpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9USU5HX1BFUklPRF9FWFBJUkVEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVElOR19QRVJJT0RfRVhQSVJFRCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDQKICAgIG1hdGNoIHZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxMSB2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTIgdm90ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8yQDEzIHZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfM0AxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3MwogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgp2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzNAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjcxCiAgICAvLyBvcC5lcnIoZXJyLlZPVElOR19QRVJJT0RfRVhQSVJFRCkKICAgIGVyciAvLyBWb3RpbmcgUGVyaW9kIEV4cGlyZWQKCnZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMkAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjkKICAgIC8vIG9wLmVycihlcnIuVk9URVNfSU5WQUxJRCkKICAgIGVyciAvLyBWb3RlcyBpbnZhbGlkCgp2b3RlX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjY3CiAgICAvLyBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIGVyciAvLyBWb3RlciBub3QgZm91bmQKCnZvdGVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNjUKICAgIC8vIG9wLmVycihlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTKQogICAgZXJyIC8vIFdyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQKCnZvdGVfcHJvcG9zYWxfZWxzZV9ib2R5QDE2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI3NQogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyA2CiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTIwMwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI2MQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBkaWcgNwogICAgZXh0cmFjdCA2IDQKICAgIGJ5dGVjIDEzIC8vICJFUlI6IgogICAgPT0KICAgIGIgdm90ZV9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDkKCnZvdGVfcHJvcG9zYWxfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgdm90ZV9wcm9wb3NhbF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkudW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNzcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBpbnRjXzIgLy8gMzIKICAgICoKICAgIHB1c2hpbnQgMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LmR5bmFtaWNfYXJyYXk8YWNjb3VudD4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyOTQKICAgIC8vIGFzc2VydCBub3Qgc2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsIGVyci5QQVVTRURfUkVHSVNUUlkKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFJlZ2lzdHJ5J3Mgbm9uLWFkbWluIG1ldGhvZHMgYXJlIHBhdXNlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTI5Ni0xMjk3CiAgICAvLyAjIFZlcmlmeSBwcm9wb3NhbF9pZCBpcyBhIGdlbnVpbmUgcHJvcG9zYWwgY3JlYXRlZCBieSB0aGlzIHJlZ2lzdHJ5CiAgICAvLyBhc3NlcnQgc2VsZi5faXNfcHJvcG9zYWwocHJvcG9zYWxfaWQpLCBlcnIuSU5WQUxJRF9QUk9QT1NBTAogICAgY2FsbHN1YiBfaXNfcHJvcG9zYWwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHByb3Bvc2FsCiAgICBpbnRjXzAgLy8gMAoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9mb3JfaGVhZGVyQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMjk5LTEzMDEKICAgIC8vICMgVGhlIGxhdGVyIEFCSSBjYWxsIHRvIHRoZSBQcm9wb3NhbCBwYW5pY3MgaWYgYW55IGFic2VudGVlIGlzIGR1cGxpY2F0ZWQsCiAgICAvLyAjIG11bHRpcGxlIG5vbi1pZGVtcG90ZW50IGVmZmVjdHMgYXJlIHJlamVjdGVkIGFmdGVyLgogICAgLy8gZm9yIGFic2VudGVlIGluIGFic2VudGVlczoKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfZm9yQDEwCiAgICBkaWcgMgogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gMzIKICAgICoKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzAyCiAgICAvLyBleGlzdHMgPSBhYnNlbnRlZSBpbiBzZWxmLnhnb3ZfYm94ICAjIHRoZSB4Z292IG1pZ2h0IGhhdmUgdW5zdWJzY3JpYmVkCiAgICBieXRlY18yIC8vIDB4NzgKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBidXJ5IDgKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwMwogICAgLy8gaWYgZXhpc3RzIGFuZCBzZWxmLnhnb3ZfYm94W2Fic2VudGVlXS50b2xlcmF0ZWRfYWJzZW5jZXMgPiAwOgogICAgYnogdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgKICAgIGRpZyA2CiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2JveCBlbnRyeSBleGlzdHMKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA4CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzA0CiAgICAvLyBzZWxmLnhnb3ZfYm94W2Fic2VudGVlXS50b2xlcmF0ZWRfYWJzZW5jZXMgLT0gMQogICAgZGlnIDYKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMiAvLyAzMgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwNQogICAgLy8gaWYgc2VsZi54Z292X2JveFthYnNlbnRlZV0udG9sZXJhdGVkX2Fic2VuY2VzID09IDA6CiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGludGNfMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGJueiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwNgogICAgLy8gZGVsIHNlbGYueGdvdl9ib3hbYWJzZW50ZWVdCiAgICBkaWcgNgogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzA3CiAgICAvLyBzZWxmLnhnb3ZzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18xIC8vIDB4Nzg2NzZmNzY3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjXzEgLy8gMHg3ODY3NmY3NjczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDg6CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDEKICAgIGIgdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9mb3JfaGVhZGVyQDIKCnVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfYWZ0ZXJfZm9yQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMwOS0xMzEzCiAgICAvLyBlcnJvciwgX3R4ID0gYXJjNC5hYmlfY2FsbCgKICAgIC8vICAgICBwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC51bmFzc2lnbl9hYnNlbnRlZXMsCiAgICAvLyAgICAgYWJzZW50ZWVzLAogICAgLy8gICAgIGFwcF9pZD1wcm9wb3NhbF9pZCwKICAgIC8vICkKICAgIGl0eG5fYmVnaW4KICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hieXRlcyAweDc2ZmY0YzcwIC8vIG1ldGhvZCAidW5hc3NpZ25fYWJzZW50ZWVzKGFkZHJlc3NbXSlzdHJpbmciCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGJ1cnkgOQogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGxvZyB2YWx1ZSBpcyBub3QgdGhlIHJlc3VsdCBvZiBhbiBBQkkgcmV0dXJuCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNiAvLyBvbiBlcnJvcjogaW52YWxpZCBhcnJheSBsZW5ndGggaGVhZGVyCiAgICBwdXNoaW50IDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3Igc3RyaW5nCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMTUKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIGJ1cnkgNgogICAgcHVzaGludCA0CiAgICA8CiAgICBieiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTMKICAgIGludGNfMCAvLyAwCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfbWVyZ2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE1CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGJ6IHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfZWxzZV9ib2R5QDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE2CiAgICAvLyBlcnJvcl93aXRob3V0X3ByZWZpeCA9IFN0cmluZy5mcm9tX2J5dGVzKGVycm9yLmJ5dGVzWzQ6XSkKICAgIHB1c2hpbnQgNAogICAgZGlnIDUKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIHB1c2hpbnQgNAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBkaWcgNwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE4CiAgICAvLyBjYXNlIGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVM6CiAgICBieXRlYyAxMiAvLyAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjAKICAgIC8vIGNhc2UgZXJyLlZPVEVSX05PVF9GT1VORDoKICAgIGJ5dGVjIDU2IC8vICJWb3RlciBub3QgZm91bmQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE3LTEzMjMKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJfTk9UX0ZPVU5EOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSX05PVF9GT1VORCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDIKICAgIG1hdGNoIHVuYXNzaWduX2Fic2VudGVlX2Zyb21fcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEAxNiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzFAMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjMKICAgIC8vIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICBlcnIgLy8gVW5rbm93biBlcnJvcgoKdW5hc3NpZ25fYWJzZW50ZWVfZnJvbV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8xQDE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyMQogICAgLy8gb3AuZXJyKGVyci5WT1RFUl9OT1RfRk9VTkQpCiAgICBlcnIgLy8gVm90ZXIgbm90IGZvdW5kCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE5CiAgICAvLyBvcC5lcnIoZXJyLldST05HX1BST1BPU0FMX1NUQVRVUykKICAgIGVyciAvLyBXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX2Vsc2VfYm9keUAxOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzMjUKICAgIC8vIGFzc2VydCBlcnJvciA9PSAiIiwgIlVua25vd24gZXJyb3IiCiAgICBkaWcgNQogICAgcHVzaGJ5dGVzICIiCiAgICA9PQogICAgYXNzZXJ0IC8vIFVua25vd24gZXJyb3IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEyNzcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgp1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzE1CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyA3CiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiB1bmFzc2lnbl9hYnNlbnRlZV9mcm9tX3Byb3Bvc2FsX3Rlcm5hcnlfbWVyZ2VAMTQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkucGF5X2dyYW50X3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF5X2dyYW50X3Byb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC51aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNDUtMTM0NgogICAgLy8gIyBWZXJpZnkgdGhlIGNhbGxlciBpcyB0aGUgeEdvdiBQYXlvcgogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gc2VsZi54Z292X3BheW9yLnZhbHVlLCBlcnIuVU5BVVRIT1JJWkVECiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTUgLy8gMHg3ODY3NmY3NjVmNzA2MTc5NmY3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfcGF5b3IgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIFVuYXV0aG9yaXplZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM0OC0xMzQ5CiAgICAvLyAjIFZlcmlmeSBwcm9wb3NhbF9pZCBpcyBhIGdlbnVpbmUgcHJvcG9zYWwgY3JlYXRlZCBieSB0aGlzIHJlZ2lzdHJ5CiAgICAvLyBhc3NlcnQgc2VsZi5faXNfcHJvcG9zYWwocHJvcG9zYWxfaWQpLCBlcnIuSU5WQUxJRF9QUk9QT1NBTAogICAgZHVwCiAgICBjYWxsc3ViIF9pc19wcm9wb3NhbAogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNTEtMTM1MgogICAgLy8gIyBSZWFkIHByb3Bvc2FsIHN0YXRlIGRpcmVjdGx5IGZyb20gdGhlIFByb3Bvc2FsIEFwcCdzIGdsb2JhbCBzdGF0ZQogICAgLy8gcHJvcG9zZXIgPSBzZWxmLmdldF9wcm9wb3NhbF9wcm9wb3Nlcihwcm9wb3NhbF9pZCkKICAgIGR1cAogICAgY2FsbHN1YiBnZXRfcHJvcG9zYWxfcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzOS0yNDEKICAgIC8vIHJlcXVlc3RlZF9hbW91bnQsIHJlcXVlc3RlZF9hbW91bnRfZXhpc3RzID0gb3AuQXBwR2xvYmFsLmdldF9leF91aW50NjQoCiAgICAvLyAgICAgcHJvcG9zYWwsIHBjZmcuR1NfS0VZX1JFUVVFU1RFRF9BTU9VTlQKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNDAKICAgIC8vIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9SRVFVRVNURURfQU1PVU5UCiAgICBwdXNoYnl0ZXMgMHg3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM5LTI0MQogICAgLy8gcmVxdWVzdGVkX2Ftb3VudCwgcmVxdWVzdGVkX2Ftb3VudF9leGlzdHMgPSBvcC5BcHBHbG9iYWwuZ2V0X2V4X3VpbnQ2NCgKICAgIC8vICAgICBwcm9wb3NhbCwgcGNmZy5HU19LRVlfUkVRVUVTVEVEX0FNT1VOVAogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI0MgogICAgLy8gYXNzZXJ0IHJlcXVlc3RlZF9hbW91bnRfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM1NQogICAgLy8gYXNzZXJ0IHByb3Bvc2VyIGluIHNlbGYucHJvcG9zZXJfYm94LCBlcnIuV1JPTkdfUFJPUE9TRVIKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgZGlnIDIKICAgIGNvbmNhdAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gV3JvbmcgcHJvcG9zZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNTcKICAgIC8vIGFzc2VydCBzZWxmLnZhbGlkX2t5Yyhwcm9wb3NlciksIGVyci5JTlZBTElEX0tZQwogICAgZGlnIDEKICAgIGNhbGxzdWIgdmFsaWRfa3ljCiAgICBhc3NlcnQgLy8gSW52YWxpZCBLWUMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNjEKICAgIC8vIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUgPj0gcmVxdWVzdGVkX2Ftb3VudAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICBkdXAKICAgIGRpZyAyCiAgICA+PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM1OS0xMzYyCiAgICAvLyAjIFZlcmlmeSBzdWZmaWNpZW50IGZ1bmRzIGFyZSBhdmFpbGFibGUKICAgIC8vIGFzc2VydCAoCiAgICAvLyAgICAgc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZSA+PSByZXF1ZXN0ZWRfYW1vdW50CiAgICAvLyApLCBlcnIuSU5TVUZGSUNJRU5UX1RSRUFTVVJZX0ZVTkRTCiAgICBhc3NlcnQgLy8gSW5zdWZmaWNpZW50IHRyZWFzdXJ5IGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNDYtMjQ3CiAgICAvLyAjIFRyYW5zZmVyIHRoZSBmdW5kcyB0byB0aGUgcmVjZWl2ZXIKICAgIC8vIGl0eG4uUGF5bWVudChyZWNlaXZlcj1yZWNpcGllbnQsIGFtb3VudD1hbW91bnQsIGZlZT0wKS5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI0OS0yNTAKICAgIC8vICMgVXBkYXRlIHRoZSBvdXRzdGFuZGluZyBmdW5kcwogICAgLy8gc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZSAtPSBhbW91bnQKICAgIHN3YXAKICAgIC0KICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM2NgogICAgLy8gZXJyb3IsIF90eCA9IGFyYzQuYWJpX2NhbGwocHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuZnVuZCwgYXBwX2lkPXByb3Bvc2FsX2lkKQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHg4YTVlNGM4MCAvLyBtZXRob2QgImZ1bmQoKXN0cmluZyIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXBuIDIKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gbG9nIHZhbHVlIGlzIG5vdCB0aGUgcmVzdWx0IG9mIGFuIEFCSSByZXR1cm4KICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2IC8vIG9uIGVycm9yOiBpbnZhbGlkIGFycmF5IGxlbmd0aCBoZWFkZXIKICAgIHB1c2hpbnQgMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBzdHJpbmcKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNjgKICAgIC8vIGlmIGVycm9yLnN0YXJ0c3dpdGgoZXJyLkFSQ182NV9QUkVGSVgpOgogICAgbGVuCiAgICBkdXAKICAgIHB1c2hpbnQgNAogICAgPAogICAgYnogcGF5X2dyYW50X3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VANAogICAgaW50Y18wIC8vIDAKCnBheV9ncmFudF9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzY4CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGJ6IHBheV9ncmFudF9wcm9wb3NhbF9lbHNlX2JvZHlAOQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM2OQogICAgLy8gZXJyb3Jfd2l0aG91dF9wcmVmaXggPSBTdHJpbmcuZnJvbV9ieXRlcyhlcnJvci5ieXRlc1s0Ol0pCiAgICBwdXNoaW50IDQKICAgIGRpZyAxCiAgICBkdXAKICAgIGNvdmVyIDIKICAgID49CiAgICBwdXNoaW50IDQKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZGlnIDMKICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3MQogICAgLy8gY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgYnl0ZWMgMTIgLy8gIldyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzcwLTEzNzQKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBfOgogICAgLy8gICAgICAgICBvcC5lcnIoIlVua25vd24gZXJyb3IiKQogICAgc3dhcAogICAgbWF0Y2ggcGF5X2dyYW50X3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3NAogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpwYXlfZ3JhbnRfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3MgogICAgLy8gb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICBlcnIgLy8gV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZAoKcGF5X2dyYW50X3Byb3Bvc2FsX2Vsc2VfYm9keUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM3NgogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyAxCiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTMyNwogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnBheV9ncmFudF9wcm9wb3NhbF90ZXJuYXJ5X2ZhbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzY4CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyAyCiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiBwYXlfZ3JhbnRfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmZpbmFsaXplX3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZmluYWxpemVfcHJvcG9zYWw6CiAgICBpbnRjXzAgLy8gMAogICAgZHVwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNzgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyNwogICAgLy8gc3RhdHVzLCBzdGF0dXNfZXhpc3RzID0gb3AuQXBwR2xvYmFsLmdldF9leF91aW50NjQocHJvcG9zYWwsIHBjZmcuR1NfS0VZX1NUQVRVUykKICAgIHB1c2hieXRlcyAweDczNzQ2MTc0NzU3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHN3YXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMjgKICAgIC8vIGFzc2VydCBzdGF0dXNfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTM5NC0xMzk2CiAgICAvLyBpZiBwcm9wb3NhbF9zdGF0dXMgPT0gVUludDY0KHBlbm0uU1RBVFVTX0VNUFRZKSBvciBwcm9wb3NhbF9zdGF0dXMgPT0gVUludDY0KAogICAgLy8gICAgIHBlbm0uU1RBVFVTX0RSQUZUCiAgICAvLyApOgogICAgYnogZmluYWxpemVfcHJvcG9zYWxfaWZfYm9keUAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTAKICAgID09CiAgICBieiBmaW5hbGl6ZV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQKCmZpbmFsaXplX3Byb3Bvc2FsX2lmX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzOTcKICAgIC8vIGFzc2VydCBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9kYWVtb24udmFsdWUsIGVyci5VTkFVVEhPUklaRUQKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxOCAvLyAweDc4Njc2Zjc2NWY2NDYxNjU2ZDZmNmUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2RhZW1vbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCgpmaW5hbGl6ZV9wcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxMzk5LTE0MDAKICAgIC8vICMgVmVyaWZ5IHByb3Bvc2FsX2lkIGlzIGEgZ2VudWluZSBwcm9wb3NhbCBjcmVhdGVkIGJ5IHRoaXMgcmVnaXN0cnkKICAgIC8vIGFzc2VydCBzZWxmLl9pc19wcm9wb3NhbChwcm9wb3NhbF9pZCksIGVyci5JTlZBTElEX1BST1BPU0FMCiAgICBkaWcgMQogICAgZHVwCiAgICBjYWxsc3ViIF9pc19wcm9wb3NhbAogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDItMTQwNAogICAgLy8gZXJyb3IsIF90eCA9IGFyYzQuYWJpX2NhbGwoCiAgICAvLyAgICAgcHJvcG9zYWxfY29udHJhY3QuUHJvcG9zYWwuZmluYWxpemUsIGFwcF9pZD1wcm9wb3NhbF9pZAogICAgLy8gKQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoYnl0ZXMgMHg4MDIwNjliNCAvLyBtZXRob2QgImZpbmFsaXplKClzdHJpbmciCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBidXJ5IDYKICAgIGR1cAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBsb2cgdmFsdWUgaXMgbm90IHRoZSByZXN1bHQgb2YgYW4gQUJJIHJldHVybgogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgcHVzaGludCAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHN0cmluZwogICAgZXh0cmFjdCA2IDAKICAgIGR1cAogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA2CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGxlbgogICAgZHVwCiAgICBidXJ5IDQKICAgIHB1c2hpbnQgNAogICAgPAogICAgYnogZmluYWxpemVfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA3CiAgICBpbnRjXzAgLy8gMAoKZmluYWxpemVfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQwNgogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiBmaW5hbGl6ZV9wcm9wb3NhbF9lbHNlX2JvZHlAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDcKICAgIC8vIGVycm9yX3dpdGhvdXRfcHJlZml4ID0gU3RyaW5nLmZyb21fYnl0ZXMoZXJyb3IuYnl0ZXNbNDpdKQogICAgcHVzaGludCA0CiAgICBkaWcgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICA+PQogICAgcHVzaGludCA0CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGRpZyA1CiAgICBzd2FwCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MDkKICAgIC8vIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIGJ5dGVjIDEyIC8vICJXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMQogICAgLy8gY2FzZSBlcnIuVk9URVJTX0FTU0lHTkVEOgogICAgcHVzaGJ5dGVzICJUaGVyZSBhcmUgdm90ZXJzIGFzc2lnbmVkIHRvIHRoaXMgcHJvcG9zYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA4LTE0MTQKICAgIC8vIG1hdGNoIGVycm9yX3dpdGhvdXRfcHJlZml4OgogICAgLy8gICAgIGNhc2UgZXJyLldST05HX1BST1BPU0FMX1NUQVRVUzoKICAgIC8vICAgICAgICAgb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICAvLyAgICAgY2FzZSBlcnIuVk9URVJTX0FTU0lHTkVEOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLlZPVEVSU19BU1NJR05FRCkKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICB1bmNvdmVyIDIKICAgIG1hdGNoIGZpbmFsaXplX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBAMTAgZmluYWxpemVfcHJvcG9zYWxfc3dpdGNoX2Nhc2VfMUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxNAogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpmaW5hbGl6ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8xQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMgogICAgLy8gb3AuZXJyKGVyci5WT1RFUlNfQVNTSUdORUQpCiAgICBlcnIgLy8gVGhlcmUgYXJlIHZvdGVycyBhc3NpZ25lZCB0byB0aGlzIHByb3Bvc2FsCgpmaW5hbGl6ZV9wcm9wb3NhbF9zd2l0Y2hfY2FzZV8wQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxMAogICAgLy8gb3AuZXJyKGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVMpCiAgICBlcnIgLy8gV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZAoKZmluYWxpemVfcHJvcG9zYWxfZWxzZV9ib2R5QDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxNgogICAgLy8gYXNzZXJ0IGVycm9yID09ICIiLCAiVW5rbm93biBlcnJvciIKICAgIGRpZyAzCiAgICBwdXNoYnl0ZXMgIiIKICAgID09CiAgICBhc3NlcnQgLy8gVW5rbm93biBlcnJvcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQxOAogICAgLy8gc2VsZi5kZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMocHJvcG9zYWxfaWQpCiAgICBkaWcgMQogICAgY2FsbHN1YiBkZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjEzNzgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpmaW5hbGl6ZV9wcm9wb3NhbF90ZXJuYXJ5X2ZhbHNlQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDA2CiAgICAvLyBpZiBlcnJvci5zdGFydHN3aXRoKGVyci5BUkNfNjVfUFJFRklYKToKICAgIGRpZyA0CiAgICBleHRyYWN0IDYgNAogICAgYnl0ZWMgMTMgLy8gIkVSUjoiCiAgICA9PQogICAgYiBmaW5hbGl6ZV9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDgKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZHJvcF9wcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRyb3BfcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDIwCiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDM1CiAgICAvLyBhc3NlcnQgbm90IHNlbGYucGF1c2VkX3JlZ2lzdHJ5LnZhbHVlLCBlcnIuUEFVU0VEX1JFR0lTVFJZCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWNfMCAvLyAweDcwNjE3NTczNjU2NDVmNzI2NTY3Njk3Mzc0NzI3OQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9yZWdpc3RyeSBleGlzdHMKICAgICEKICAgIGFzc2VydCAvLyBSZWdpc3RyeSdzIG5vbi1hZG1pbiBtZXRob2RzIGFyZSBwYXVzZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0MzctMTQzOAogICAgLy8gIyBWZXJpZnkgcHJvcG9zYWxfaWQgaXMgYSBnZW51aW5lIHByb3Bvc2FsIGNyZWF0ZWQgYnkgdGhpcyByZWdpc3RyeQogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIGR1cAogICAgY2FsbHN1YiBfaXNfcHJvcG9zYWwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQwCiAgICAvLyBwcm9wb3NlciA9IHNlbGYuZ2V0X3Byb3Bvc2FsX3Byb3Bvc2VyKHByb3Bvc2FsX2lkKQogICAgZHVwCiAgICBjYWxsc3ViIGdldF9wcm9wb3NhbF9wcm9wb3NlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0MQogICAgLy8gYXNzZXJ0IFR4bi5zZW5kZXIgPT0gcHJvcG9zZXIsIGVyci5VTkFVVEhPUklaRUQKICAgIHR4biBTZW5kZXIKICAgID09CiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQzCiAgICAvLyBlcnJvciwgX3R4ID0gYXJjNC5hYmlfY2FsbChwcm9wb3NhbF9jb250cmFjdC5Qcm9wb3NhbC5kcm9wLCBhcHBfaWQ9cHJvcG9zYWxfaWQpCiAgICBpdHhuX2JlZ2luCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hieXRlcyAweDczNzEzMjFhIC8vIG1ldGhvZCAiZHJvcCgpc3RyaW5nIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cG4gMgogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBsb2cgdmFsdWUgaXMgbm90IHRoZSByZXN1bHQgb2YgYW4gQUJJIHJldHVybgogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYgLy8gb24gZXJyb3I6IGludmFsaWQgYXJyYXkgbGVuZ3RoIGhlYWRlcgogICAgcHVzaGludCAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHN0cmluZwogICAgZXh0cmFjdCA2IDAKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBsZW4KICAgIGR1cAogICAgcHVzaGludCA0CiAgICA8CiAgICBieiBkcm9wX3Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VANAogICAgaW50Y18wIC8vIDAKCmRyb3BfcHJvcG9zYWxfdGVybmFyeV9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBieiBkcm9wX3Byb3Bvc2FsX2Vsc2VfYm9keUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQ2CiAgICAvLyBlcnJvcl93aXRob3V0X3ByZWZpeCA9IFN0cmluZy5mcm9tX2J5dGVzKGVycm9yLmJ5dGVzWzQ6XSkKICAgIHB1c2hpbnQgNAogICAgZGlnIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIHB1c2hpbnQgNAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBkaWcgMwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDQ4CiAgICAvLyBjYXNlIGVyci5XUk9OR19QUk9QT1NBTF9TVEFUVVM6CiAgICBieXRlYyAxMiAvLyAiV3JvbmcgUHJvcG9zYWwgU3RhdHVzIG9yIGZpbmFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NDctMTQ1MQogICAgLy8gbWF0Y2ggZXJyb3Jfd2l0aG91dF9wcmVmaXg6CiAgICAvLyAgICAgY2FzZSBlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTOgogICAgLy8gICAgICAgICBvcC5lcnIoZXJyLldST05HX1BST1BPU0FMX1NUQVRVUykKICAgIC8vICAgICBjYXNlIF86CiAgICAvLyAgICAgICAgIG9wLmVycigiVW5rbm93biBlcnJvciIpCiAgICBzd2FwCiAgICBtYXRjaCBkcm9wX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ1MQogICAgLy8gb3AuZXJyKCJVbmtub3duIGVycm9yIikKICAgIGVyciAvLyBVbmtub3duIGVycm9yCgpkcm9wX3Byb3Bvc2FsX3N3aXRjaF9jYXNlXzBANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NDkKICAgIC8vIG9wLmVycihlcnIuV1JPTkdfUFJPUE9TQUxfU1RBVFVTKQogICAgZXJyIC8vIFdyb25nIFByb3Bvc2FsIFN0YXR1cyBvciBmaW5hbGl6ZWQKCmRyb3BfcHJvcG9zYWxfZWxzZV9ib2R5QDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDUzCiAgICAvLyBhc3NlcnQgZXJyb3IgPT0gIiIsICJVbmtub3duIGVycm9yIgogICAgZGlnIDEKICAgIHB1c2hieXRlcyAiIgogICAgPT0KICAgIGFzc2VydCAvLyBVbmtub3duIGVycm9yCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDU1CiAgICAvLyBzZWxmLmRlY3JlbWVudF9wZW5kaW5nX3Byb3Bvc2Fscyhwcm9wb3NhbF9pZCkKICAgIGRpZyAzCiAgICBjYWxsc3ViIGRlY3JlbWVudF9wZW5kaW5nX3Byb3Bvc2FscwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQyMAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRyb3BfcHJvcG9zYWxfdGVybmFyeV9mYWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ0NQogICAgLy8gaWYgZXJyb3Iuc3RhcnRzd2l0aChlcnIuQVJDXzY1X1BSRUZJWCk6CiAgICBkaWcgMgogICAgZXh0cmFjdCA2IDQKICAgIGJ5dGVjIDEzIC8vICJFUlI6IgogICAgPT0KICAgIGIgZHJvcF9wcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZGVwb3NpdF9mdW5kc1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlcG9zaXRfZnVuZHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDU3CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDcwCiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ2OS0xNDcxCiAgICAvLyBhc3NlcnQgKAogICAgLy8gICAgIHBheW1lbnQucmVjZWl2ZXIgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgLy8gKSwgZXJyLldST05HX1JFQ0VJVkVSCiAgICBhc3NlcnQgLy8gV3JvbmcgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NzIKICAgIC8vIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUgKz0gcGF5bWVudC5hbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzIGV4aXN0cwogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICArCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0NTcKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LndpdGhkcmF3X2Z1bmRzW3JvdXRpbmddKCkgLT4gdm9pZDoKd2l0aGRyYXdfZnVuZHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDc0CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ4OAogICAgLy8gYXNzZXJ0IHNlbGYuaXNfeGdvdl9tYW5hZ2VyKCksIGVyci5VTkFVVEhPUklaRUQKICAgIGNhbGxzdWIgaXNfeGdvdl9tYW5hZ2VyCiAgICBhc3NlcnQgLy8gVW5hdXRob3JpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDg5CiAgICAvLyBhc3NlcnQgYW1vdW50IDw9IHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUsIGVyci5JTlNVRkZJQ0lFTlRfRlVORFMKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA1IC8vIDB4NmY3NTc0NzM3NDYxNmU2NDY5NmU2NzVmNjY3NTZlNjQ3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzIGV4aXN0cwogICAgZHVwMgogICAgPD0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZnVuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTAKICAgIC8vIGFzc2VydCBUeG4uZmVlID49IChHbG9iYWwubWluX3R4bl9mZWUgKiAyKSwgZXJyLklOU1VGRklDSUVOVF9GRUUKICAgIHR4biBGZWUKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIHB1c2hpbnQgMgogICAgKgogICAgPj0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNDkxCiAgICAvLyBzZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlIC09IGFtb3VudAogICAgZGlnIDEKICAgIC0KICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5My0xNDk3CiAgICAvLyBpdHhuLlBheW1lbnQoCiAgICAvLyAgICAgcmVjZWl2ZXI9c2VsZi54Z292X21hbmFnZXIudmFsdWUsCiAgICAvLyAgICAgYW1vdW50PWFtb3VudCwKICAgIC8vICAgICBmZWU9MCwKICAgIC8vICkuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTQKICAgIC8vIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDcgLy8gMHg3ODY3NmY3NjVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X21hbmFnZXIgZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5MwogICAgLy8gaXR4bi5QYXltZW50KAogICAgaW50Y18xIC8vIHBheQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ5NgogICAgLy8gZmVlPTAsCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTMtMTQ5NwogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIGFtb3VudD1hbW91bnQsCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTQ3NAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkud2l0aGRyYXdfYmFsYW5jZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CndpdGhkcmF3X2JhbGFuY2U6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTExCiAgICAvLyBhc3NlcnQgc2VsZi5pc194Z292X21hbmFnZXIoKSwgZXJyLlVOQVVUSE9SSVpFRAogICAgY2FsbHN1YiBpc194Z292X21hbmFnZXIKICAgIGFzc2VydCAvLyBVbmF1dGhvcml6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTIKICAgIC8vIGFzc2VydCBUeG4uZmVlID49IChHbG9iYWwubWluX3R4bl9mZWUgKiAyKSwgZXJyLklOU1VGRklDSUVOVF9GRUUKICAgIHR4biBGZWUKICAgIGdsb2JhbCBNaW5UeG5GZWUKICAgIHB1c2hpbnQgMgogICAgKgogICAgPj0KICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTE2CiAgICAvLyBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUxNwogICAgLy8gLSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLm1pbl9iYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RNaW5CYWxhbmNlCiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTYtMTUxNwogICAgLy8gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcy5iYWxhbmNlCiAgICAvLyAtIEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MubWluX2JhbGFuY2UKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MTgKICAgIC8vIC0gc2VsZi5vdXRzdGFuZGluZ19mdW5kcy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTE2LTE1MTgKICAgIC8vIEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MuYmFsYW5jZQogICAgLy8gLSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzLm1pbl9iYWxhbmNlCiAgICAvLyAtIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMudmFsdWUKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjEKICAgIC8vIGFzc2VydCBhbW91bnQgPiAwLCBlcnIuSU5TVUZGSUNJRU5UX0ZVTkRTCiAgICBkdXAKICAgIGFzc2VydCAvLyBJbnN1ZmZpY2llbnQgZnVuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjItMTUyNgogICAgLy8gaXR4bi5QYXltZW50KAogICAgLy8gICAgIHJlY2VpdmVyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIGFtb3VudD1hbW91bnQsCiAgICAvLyAgICAgZmVlPTAsCiAgICAvLyApLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTIzCiAgICAvLyByZWNlaXZlcj1zZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA3IC8vIDB4Nzg2NzZmNzY1ZjZkNjE2ZTYxNjc2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9tYW5hZ2VyIGV4aXN0cwogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjIKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIGludGNfMSAvLyBwYXkKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MjUKICAgIC8vIGZlZT0wLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTIyLTE1MjYKICAgIC8vIGl0eG4uUGF5bWVudCgKICAgIC8vICAgICByZWNlaXZlcj1zZWxmLnhnb3ZfbWFuYWdlci52YWx1ZSwKICAgIC8vICAgICBhbW91bnQ9YW1vdW50LAogICAgLy8gICAgIGZlZT0wLAogICAgLy8gKS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE0OTkKICAgIC8vIEBhcmM0LmFiaW1ldGhvZCgpCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9zdGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9zdGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MzUKICAgIC8vIHBhdXNlZF9yZWdpc3RyeT1zZWxmLnBhdXNlZF9yZWdpc3RyeS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18wIC8vIDB4NzA2MTc1NzM2NTY0NWY3MjY1Njc2OTczNzQ3Mjc5CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGF1c2VkX3JlZ2lzdHJ5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUzNgogICAgLy8gcGF1c2VkX3Byb3Bvc2Fscz1zZWxmLnBhdXNlZF9wcm9wb3NhbHMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTAgLy8gMHg3MDYxNzU3MzY1NjQ1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBhdXNlZF9wcm9wb3NhbHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTM3CiAgICAvLyB4Z292X21hbmFnZXI9c2VsZi54Z292X21hbmFnZXIudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfbWFuYWdlciBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1MzgKICAgIC8vIHhnb3ZfcGF5b3I9c2VsZi54Z292X3BheW9yLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE1IC8vIDB4Nzg2NzZmNzY1ZjcwNjE3OTZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X3BheW9yIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUzOQogICAgLy8geGdvdl9jb3VuY2lsPXNlbGYueGdvdl9jb3VuY2lsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI1IC8vIDB4Nzg2NzZmNzY1ZjYzNmY3NTZlNjM2OTZjCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9jb3VuY2lsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MAogICAgLy8geGdvdl9zdWJzY3JpYmVyPXNlbGYueGdvdl9zdWJzY3JpYmVyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE0IC8vIDB4Nzg2NzZmNzY1ZjczNzU2MjczNjM3MjY5NjI2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9zdWJzY3JpYmVyIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MQogICAgLy8ga3ljX3Byb3ZpZGVyPXNlbGYua3ljX3Byb3ZpZGVyLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE2IC8vIDB4NmI3OTYzNWY3MDcyNmY3NjY5NjQ2NTcyCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYua3ljX3Byb3ZpZGVyIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0MgogICAgLy8gY29tbWl0dGVlX21hbmFnZXI9c2VsZi5jb21taXR0ZWVfbWFuYWdlci52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxNyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2MTZlNjE2NzY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWFuYWdlciBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDMKICAgIC8vIHhnb3ZfZGFlbW9uPXNlbGYueGdvdl9kYWVtb24udmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTggLy8gMHg3ODY3NmY3NjVmNjQ2MTY1NmQ2ZjZlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9kYWVtb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTQ0CiAgICAvLyB4Z292X2ZlZT1zZWxmLnhnb3ZfZmVlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDE5IC8vIDB4Nzg2NzZmNzY1ZjY2NjU2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfZmVlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0NQogICAgLy8gcHJvcG9zZXJfZmVlPXNlbGYucHJvcG9zZXJfZmVlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDIwIC8vIDB4NzA3MjZmNzA2ZjczNjU3MjVmNjY2NTY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfZmVlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0NgogICAgLy8gb3Blbl9wcm9wb3NhbF9mZWU9c2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMSAvLyAweDZmNzA2NTZlNWY3MDcyNmY3MDZmNzM2MTZjNWY2NjY1NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5vcGVuX3Byb3Bvc2FsX2ZlZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDcKICAgIC8vIGRhZW1vbl9vcHNfZnVuZGluZ19icHM9c2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI2IC8vIDB4NjQ2MTY1NmQ2ZjZlNWY2ZjcwNjU3MjYxNzQ2OTZmNmU1ZjY2NzU2ZTY0Njk2ZTY3NWY2MjcwNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU0OAogICAgLy8gcHJvcG9zYWxfY29tbWl0bWVudF9icHM9c2VsZi5wcm9wb3NhbF9jb21taXRtZW50X2Jwcy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyNyAvLyAweDcwNzI2ZjcwNmY3MzYxNmM1ZjYzNmY2ZDZkNjk3NDZkNjU2ZTc0NWY2MjcwNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3NhbF9jb21taXRtZW50X2JwcyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NDkKICAgIC8vIG1pbl9yZXF1ZXN0ZWRfYW1vdW50PXNlbGYubWluX3JlcXVlc3RlZF9hbW91bnQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjggLy8gMHg2ZDY5NmU1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYubWluX3JlcXVlc3RlZF9hbW91bnQgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTUyCiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDI5IC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNzM2ZDYxNmM2YwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1MwogICAgLy8gc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMzAgLy8gMHg2ZDYxNzg1ZjcyNjU3MTc1NjU3Mzc0NjU2NDVmNjE2ZDZmNzU2ZTc0NWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTU0CiAgICAvLyBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDMxIC8vIDB4NmQ2MTc4NWY3MjY1NzE3NTY1NzM3NDY1NjQ1ZjYxNmQ2Zjc1NmU3NDVmNmM2MTcyNjc2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1MC0xNTU2CiAgICAvLyBtYXhfcmVxdWVzdGVkX2Ftb3VudD1GaXhlZEFycmF5KAogICAgLy8gICAgICgKICAgIC8vICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYubWF4X3JlcXVlc3RlZF9hbW91bnRfbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU1OQogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDMyIC8vIDB4NjQ2OTczNjM3NTczNzM2OTZmNmU1ZjY0NzU3MjYxNzQ2OTZmNmU1ZjczNmQ2MTZjNmMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU2MAogICAgLy8gc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzMyAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjEKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNCAvLyAweDY0Njk3MzYzNzU3MzczNjk2ZjZlNWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9sYXJnZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjIKICAgIC8vIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMzUgLy8gMHg2NDY5NzM2Mzc1NzM3MzY5NmY2ZTVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzg2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTU3LTE1NjQKICAgIC8vIGRpc2N1c3Npb25fZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAoCiAgICAvLyAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi5kaXNjdXNzaW9uX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgLy8gICAgICkKICAgIC8vICksCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTY3CiAgICAvLyBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNiAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNzM2ZDYxNmM2YwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnZvdGluZ19kdXJhdGlvbl9zbWFsbCBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjgKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX21lZGl1bS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAzNyAvLyAweDc2NmY3NDY5NmU2NzVmNjQ3NTcyNjE3NDY5NmY2ZTVmNmQ2NTY0Njk3NTZkCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYudm90aW5nX2R1cmF0aW9uX21lZGl1bSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NjkKICAgIC8vIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDM4IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY2YzYxNzI2NzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3MAogICAgLy8gc2VsZi52b3RpbmdfZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDM5IC8vIDB4NzY2Zjc0Njk2ZTY3NWY2NDc1NzI2MTc0Njk2ZjZlNWY3ODZjNjE3MjY3NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi52b3RpbmdfZHVyYXRpb25feGxhcmdlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU2NS0xNTcyCiAgICAvLyB2b3RpbmdfZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAoCiAgICAvLyAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl94bGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1NzUKICAgIC8vIHNlbGYucXVvcnVtX3NtYWxsLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQwIC8vIDB4NzE3NTZmNzI3NTZkNWY3MzZkNjE2YzZjCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucXVvcnVtX3NtYWxsIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3NgogICAgLy8gc2VsZi5xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQxIC8vIDB4NzE3NTZmNzI3NTZkNWY2ZDY1NjQ2OTc1NmQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5xdW9ydW1fbWVkaXVtIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU3NwogICAgLy8gc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDIgLy8gMHg3MTc1NmY3Mjc1NmQ1ZjZjNjE3MjY3NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5xdW9ydW1fbGFyZ2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTczLTE1NzkKICAgIC8vIHF1b3J1bT1GaXhlZEFycmF5KAogICAgLy8gICAgICgKICAgIC8vICAgICAgICAgc2VsZi5xdW9ydW1fc21hbGwudmFsdWUsCiAgICAvLyAgICAgICAgIHNlbGYucXVvcnVtX21lZGl1bS52YWx1ZSwgICMgTm8gbG9uZ2VyIHVzZWQKICAgIC8vICAgICAgICAgc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgKQogICAgLy8gKSwKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU4MgogICAgLy8gc2VsZi53ZWlnaHRlZF9xdW9ydW1fc21hbGwudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDMgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjczNmQ2MTZjNmMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi53ZWlnaHRlZF9xdW9ydW1fc21hbGwgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTgzCiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0udmFsdWUsICAjIE5vIGxvbmdlciB1c2VkCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNDQgLy8gMHg3NzY1Njk2NzY4NzQ2NTY0NWY3MTc1NmY3Mjc1NmQ1ZjZkNjU2NDY5NzU2ZAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLndlaWdodGVkX3F1b3J1bV9tZWRpdW0gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg0CiAgICAvLyBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NSAvLyAweDc3NjU2OTY3Njg3NDY1NjQ1ZjcxNzU2ZjcyNzU2ZDVmNmM2MTcyNjc2NQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1ODAtMTU4NgogICAgLy8gd2VpZ2h0ZWRfcXVvcnVtPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgKAogICAgLy8gICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgc2VsZi53ZWlnaHRlZF9xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9sYXJnZS52YWx1ZSwKICAgIC8vICAgICApCiAgICAvLyApLAogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg3CiAgICAvLyBvdXRzdGFuZGluZ19mdW5kcz1zZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDUgLy8gMHg2Zjc1NzQ3Mzc0NjE2ZTY0Njk2ZTY3NWY2Njc1NmU2NDczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYub3V0c3RhbmRpbmdfZnVuZHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg4CiAgICAvLyBwZW5kaW5nX3Byb3Bvc2Fscz1zZWxmLnBlbmRpbmdfcHJvcG9zYWxzLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucGVuZGluZ19wcm9wb3NhbHMgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTg5CiAgICAvLyBjb21taXR0ZWVfaWQ9c2VsZi5jb21taXR0ZWVfaWQudmFsdWUuY29weSgpLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDU1IC8vIDB4NjM2ZjZkNmQ2OTc0NzQ2NTY1NWY2OTY0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuY29tbWl0dGVlX2lkIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5MAogICAgLy8gY29tbWl0dGVlX21lbWJlcnM9c2VsZi5jb21taXR0ZWVfbWVtYmVycy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NiAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNmQ2NTZkNjI2NTcyNzMKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbWVtYmVycyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTEKICAgIC8vIGNvbW1pdHRlZV92b3Rlcz1zZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA0NyAvLyAweDYzNmY2ZDZkNjk3NDc0NjU2NTVmNzY2Zjc0NjU3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmNvbW1pdHRlZV92b3RlcyBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTIKICAgIC8vIGFic2VuY2VfdG9sZXJhbmNlPXNlbGYuYWJzZW5jZV90b2xlcmFuY2UudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMjIgLy8gMHg2MTYyNzM2NTZlNjM2NTVmNzQ2ZjZjNjU3MjYxNmU2MzY1CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYuYWJzZW5jZV90b2xlcmFuY2UgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTkzCiAgICAvLyBnb3Zlcm5hbmNlX3BlcmlvZD1zZWxmLmdvdmVybmFuY2VfcGVyaW9kLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmdvdmVybmFuY2VfcGVyaW9kIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5NAogICAgLy8gY29tbWl0dGVlX2dyYWNlX3BlcmlvZD1zZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTAgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjY3NzI2MTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTk1CiAgICAvLyBjb21taXR0ZWVfbGFzdF9hbmNob3I9c2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNTIgLy8gMHg2MzZmNmQ2ZDY5NzQ3NDY1NjU1ZjZjNjE3Mzc0NWY2MTZlNjM2ODZmNzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5jb21taXR0ZWVfbGFzdF9hbmNob3IgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNTM0LTE1OTYKICAgIC8vIHJldHVybiB0eXAuVHlwZWRHbG9iYWxTdGF0ZSgKICAgIC8vICAgICBwYXVzZWRfcmVnaXN0cnk9c2VsZi5wYXVzZWRfcmVnaXN0cnkudmFsdWUsCiAgICAvLyAgICAgcGF1c2VkX3Byb3Bvc2Fscz1zZWxmLnBhdXNlZF9wcm9wb3NhbHMudmFsdWUsCiAgICAvLyAgICAgeGdvdl9tYW5hZ2VyPXNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlLAogICAgLy8gICAgIHhnb3ZfcGF5b3I9c2VsZi54Z292X3BheW9yLnZhbHVlLAogICAgLy8gICAgIHhnb3ZfY291bmNpbD1zZWxmLnhnb3ZfY291bmNpbC52YWx1ZSwKICAgIC8vICAgICB4Z292X3N1YnNjcmliZXI9c2VsZi54Z292X3N1YnNjcmliZXIudmFsdWUsCiAgICAvLyAgICAga3ljX3Byb3ZpZGVyPXNlbGYua3ljX3Byb3ZpZGVyLnZhbHVlLAogICAgLy8gICAgIGNvbW1pdHRlZV9tYW5hZ2VyPXNlbGYuY29tbWl0dGVlX21hbmFnZXIudmFsdWUsCiAgICAvLyAgICAgeGdvdl9kYWVtb249c2VsZi54Z292X2RhZW1vbi52YWx1ZSwKICAgIC8vICAgICB4Z292X2ZlZT1zZWxmLnhnb3ZfZmVlLnZhbHVlLAogICAgLy8gICAgIHByb3Bvc2VyX2ZlZT1zZWxmLnByb3Bvc2VyX2ZlZS52YWx1ZSwKICAgIC8vICAgICBvcGVuX3Byb3Bvc2FsX2ZlZT1zZWxmLm9wZW5fcHJvcG9zYWxfZmVlLnZhbHVlLAogICAgLy8gICAgIGRhZW1vbl9vcHNfZnVuZGluZ19icHM9c2VsZi5kYWVtb25fb3BzX2Z1bmRpbmdfYnBzLnZhbHVlLAogICAgLy8gICAgIHByb3Bvc2FsX2NvbW1pdG1lbnRfYnBzPXNlbGYucHJvcG9zYWxfY29tbWl0bWVudF9icHMudmFsdWUsCiAgICAvLyAgICAgbWluX3JlcXVlc3RlZF9hbW91bnQ9c2VsZi5taW5fcmVxdWVzdGVkX2Ftb3VudC52YWx1ZSwKICAgIC8vICAgICBtYXhfcmVxdWVzdGVkX2Ftb3VudD1GaXhlZEFycmF5KAogICAgLy8gICAgICAgICAoCiAgICAvLyAgICAgICAgICAgICBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICAgICAgc2VsZi5tYXhfcmVxdWVzdGVkX2Ftb3VudF9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLm1heF9yZXF1ZXN0ZWRfYW1vdW50X2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICBkaXNjdXNzaW9uX2R1cmF0aW9uPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgICAgICgKICAgIC8vICAgICAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYuZGlzY3Vzc2lvbl9kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLmRpc2N1c3Npb25fZHVyYXRpb25feGxhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICB2b3RpbmdfZHVyYXRpb249Rml4ZWRBcnJheSgKICAgIC8vICAgICAgICAgKAogICAgLy8gICAgICAgICAgICAgc2VsZi52b3RpbmdfZHVyYXRpb25fc21hbGwudmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9tZWRpdW0udmFsdWUsCiAgICAvLyAgICAgICAgICAgICBzZWxmLnZvdGluZ19kdXJhdGlvbl9sYXJnZS52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYudm90aW5nX2R1cmF0aW9uX3hsYXJnZS52YWx1ZSwKICAgIC8vICAgICAgICAgKQogICAgLy8gICAgICksCiAgICAvLyAgICAgcXVvcnVtPUZpeGVkQXJyYXkoCiAgICAvLyAgICAgICAgICgKICAgIC8vICAgICAgICAgICAgIHNlbGYucXVvcnVtX3NtYWxsLnZhbHVlLAogICAgLy8gICAgICAgICAgICAgc2VsZi5xdW9ydW1fbWVkaXVtLnZhbHVlLCAgIyBObyBsb25nZXIgdXNlZAogICAgLy8gICAgICAgICAgICAgc2VsZi5xdW9ydW1fbGFyZ2UudmFsdWUsCiAgICAvLyAgICAgICAgICkKICAgIC8vICAgICApLAogICAgLy8gICAgIHdlaWdodGVkX3F1b3J1bT1GaXhlZEFycmF5KAogICAgLy8gICAgICAgICAoCiAgICAvLyAgICAgICAgICAgICBzZWxmLndlaWdodGVkX3F1b3J1bV9zbWFsbC52YWx1ZSwKICAgIC8vICAgICAgICAgICAgIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX21lZGl1bS52YWx1ZSwgICMgTm8gbG9uZ2VyIHVzZWQKICAgIC8vICAgICAgICAgICAgIHNlbGYud2VpZ2h0ZWRfcXVvcnVtX2xhcmdlLnZhbHVlLAogICAgLy8gICAgICAgICApCiAgICAvLyAgICAgKSwKICAgIC8vICAgICBvdXRzdGFuZGluZ19mdW5kcz1zZWxmLm91dHN0YW5kaW5nX2Z1bmRzLnZhbHVlLAogICAgLy8gICAgIHBlbmRpbmdfcHJvcG9zYWxzPXNlbGYucGVuZGluZ19wcm9wb3NhbHMudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2lkPXNlbGYuY29tbWl0dGVlX2lkLnZhbHVlLmNvcHkoKSwKICAgIC8vICAgICBjb21taXR0ZWVfbWVtYmVycz1zZWxmLmNvbW1pdHRlZV9tZW1iZXJzLnZhbHVlLAogICAgLy8gICAgIGNvbW1pdHRlZV92b3Rlcz1zZWxmLmNvbW1pdHRlZV92b3Rlcy52YWx1ZSwKICAgIC8vICAgICBhYnNlbmNlX3RvbGVyYW5jZT1zZWxmLmFic2VuY2VfdG9sZXJhbmNlLnZhbHVlLAogICAgLy8gICAgIGdvdmVybmFuY2VfcGVyaW9kPXNlbGYuZ292ZXJuYW5jZV9wZXJpb2QudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2dyYWNlX3BlcmlvZD1zZWxmLmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QudmFsdWUsCiAgICAvLyAgICAgY29tbWl0dGVlX2xhc3RfYW5jaG9yPXNlbGYuY29tbWl0dGVlX2xhc3RfYW5jaG9yLnZhbHVlLAogICAgLy8gKQogICAgYnl0ZWMgOCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzMAogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciAyOQogICAgc2V0Yml0CiAgICB1bmNvdmVyIDI3CiAgICBjb25jYXQKICAgIHVuY292ZXIgMjYKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDI0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMjMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDIxCiAgICBjb25jYXQKICAgIHVuY292ZXIgMjAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxOQogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDE4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTcKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxNgogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDE1CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDEyCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgY29uY2F0CiAgICB1bmNvdmVyIDYKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA1CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNAogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTUyOAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF94Z292X2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF94Z292X2JveDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE1OTgKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYXJjNC5zdGF0aWNfYXJyYXk8YXJjNC51aW50OCwgMzI+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjEwCiAgICAvLyBleGlzdHMgPSB4Z292X2FkZHJlc3MgaW4gc2VsZi54Z292X2JveAogICAgYnl0ZWNfMiAvLyAweDc4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYxMQogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3hnb3ZfYm94X2Vsc2VfYm9keUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjEyCiAgICAvLyB2YWwgPSBzZWxmLnhnb3ZfYm94W3hnb3ZfYWRkcmVzc10uY29weSgpCiAgICBkaWcgMQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYueGdvdl9ib3ggZW50cnkgZXhpc3RzCgpnZXRfeGdvdl9ib3hfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTU5OAogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICBieXRlYyA4IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBkaWcgMwogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdldF94Z292X2JveF9lbHNlX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2MTQtMTYxOQogICAgLy8gdmFsID0gdHlwLlhHb3ZCb3hWYWx1ZSgKICAgIC8vICAgICB2b3RpbmdfYWRkcmVzcz1BY2NvdW50KCksCiAgICAvLyAgICAgdG9sZXJhdGVkX2Fic2VuY2VzPVVJbnQ2NCgwKSwKICAgIC8vICAgICBsYXN0X3ZvdGVfdGltZXN0YW1wPVVJbnQ2NCgwKSwKICAgIC8vICAgICBzdWJzY3JpcHRpb25fcm91bmQ9VUludDY0KDApLAogICAgLy8gKQogICAgcHVzaGJ5dGVzIGJhc2UzMihBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUEpCiAgICBiIGdldF94Z292X2JveF9hZnRlcl9pZl9lbHNlQDQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZ2V0X3Byb3Bvc2VyX2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9wcm9wb3Nlcl9ib3g6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjIzCiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQuc3RhdGljX2FycmF5PGFyYzQudWludDgsIDMyPgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTYzOQogICAgLy8gZXhpc3RzID0gcHJvcG9zZXJfYWRkcmVzcyBpbiBzZWxmLnByb3Bvc2VyX2JveAogICAgYnl0ZWMgNCAvLyAweDcwCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY0MAogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3Byb3Bvc2VyX2JveF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY0MQogICAgLy8gdmFsID0gc2VsZi5wcm9wb3Nlcl9ib3hbcHJvcG9zZXJfYWRkcmVzc10uY29weSgpCiAgICBkaWcgMQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfYm94IGVudHJ5IGV4aXN0cwoKZ2V0X3Byb3Bvc2VyX2JveF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjIzCiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2V0X3Byb3Bvc2VyX2JveF9lbHNlX2JvZHlAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NDMtMTY0NwogICAgLy8gdmFsID0gdHlwLlByb3Bvc2VyQm94VmFsdWUoCiAgICAvLyAgICAgYWN0aXZlX3Byb3Bvc2FsPUZhbHNlLAogICAgLy8gICAgIGt5Y19zdGF0dXM9RmFsc2UsCiAgICAvLyAgICAga3ljX2V4cGlyaW5nPVVJbnQ2NCgwKSwKICAgIC8vICkKICAgIGJ5dGVjIDU0IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICBiIGdldF9wcm9wb3Nlcl9ib3hfYWZ0ZXJfaWZfZWxzZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9yZXF1ZXN0X2JveFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldF9yZXF1ZXN0X2JveDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NTEKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY2NwogICAgLy8gZXhpc3RzID0gcmVxdWVzdF9pZCBpbiBzZWxmLnJlcXVlc3RfYm94CiAgICBpdG9iCiAgICBieXRlYyAyMyAvLyAweDcyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYm94X2xlbgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY2OAogICAgLy8gaWYgZXhpc3RzOgogICAgYnogZ2V0X3JlcXVlc3RfYm94X2Vsc2VfYm9keUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjY5CiAgICAvLyB2YWwgPSBzZWxmLnJlcXVlc3RfYm94W3JlcXVlc3RfaWRdLmNvcHkoKQogICAgZGlnIDEKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnJlcXVlc3RfYm94IGVudHJ5IGV4aXN0cwoKZ2V0X3JlcXVlc3RfYm94X2FmdGVyX2lmX2Vsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2NTEKICAgIC8vIEBhcmM0LmFiaW1ldGhvZChyZWFkb25seT1UcnVlKQogICAgYnl0ZWMgOCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDMKICAgIHNldGJpdAogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnZXRfcmVxdWVzdF9ib3hfZWxzZV9ib2R5QDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjcxLTE2NzUKICAgIC8vIHZhbCA9IHR5cC5YR292U3Vic2NyaWJlUmVxdWVzdEJveFZhbHVlKAogICAgLy8gICAgIHhnb3ZfYWRkcj1BY2NvdW50KCksCiAgICAvLyAgICAgb3duZXJfYWRkcj1BY2NvdW50KCksCiAgICAvLyAgICAgcmVsYXRpb25fdHlwZT1VSW50NjQoMCksCiAgICAvLyApCiAgICBieXRlYyA1NyAvLyBiYXNlMzIoQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUEpCiAgICBiIGdldF9yZXF1ZXN0X2JveF9hZnRlcl9pZl9lbHNlQDQKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY3OQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKHJlYWRvbmx5PVRydWUpCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGFyYzQudWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjkzCiAgICAvLyBleGlzdHMgPSByZXF1ZXN0X2lkIGluIHNlbGYucmVxdWVzdF91bnN1YnNjcmliZV9ib3gKICAgIGl0b2IKICAgIGJ5dGVjIDI0IC8vIDB4NzI3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJveF9sZW4KICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjE2OTQKICAgIC8vIGlmIGV4aXN0czoKICAgIGJ6IGdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveF9lbHNlX2JvZHlAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY5NQogICAgLy8gdmFsID0gc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveFtyZXF1ZXN0X2lkXS5jb3B5KCkKICAgIGRpZyAxCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveCBlbnRyeSBleGlzdHMKCmdldF9yZXF1ZXN0X3Vuc3Vic2NyaWJlX2JveF9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNjc5CiAgICAvLyBAYXJjNC5hYmltZXRob2QocmVhZG9ubHk9VHJ1ZSkKICAgIGJ5dGVjIDggLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGRpZyAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2V0X3JlcXVlc3RfdW5zdWJzY3JpYmVfYm94X2Vsc2VfYm9keUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTY5Ny0xNzAxCiAgICAvLyB2YWwgPSB0eXAuWEdvdlN1YnNjcmliZVJlcXVlc3RCb3hWYWx1ZSgKICAgIC8vICAgICB4Z292X2FkZHI9QWNjb3VudCgpLAogICAgLy8gICAgIG93bmVyX2FkZHI9QWNjb3VudCgpLAogICAgLy8gICAgIHJlbGF0aW9uX3R5cGU9VUludDY0KDApLAogICAgLy8gKQogICAgYnl0ZWMgNTcgLy8gYmFzZTMyKEFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBKQogICAgYiBnZXRfcmVxdWVzdF91bnN1YnNjcmliZV9ib3hfYWZ0ZXJfaWZfZWxzZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmlzX3Byb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNfcHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToxNzA1CiAgICAvLyBAYXJjNC5hYmltZXRob2QoKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBhcmM0LnVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTcwNwogICAgLy8gYXNzZXJ0IHNlbGYuX2lzX3Byb3Bvc2FsKHByb3Bvc2FsX2lkKSwgZXJyLklOVkFMSURfUFJPUE9TQUwKICAgIGNhbGxzdWIgX2lzX3Byb3Bvc2FsCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MTcwNQogICAgLy8gQGFyYzQuYWJpbWV0aG9kKCkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuaXNfeGdvdl9tYW5hZ2VyKCkgLT4gdWludDY0Ogppc194Z292X21hbmFnZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTUKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9tYW5hZ2VyLnZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgNyAvLyAweDc4Njc2Zjc2NWY2ZDYxNmU2MTY3NjU3MgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnhnb3ZfbWFuYWdlciBleGlzdHMKICAgID09CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkuaXNfeGdvdl9zdWJzY3JpYmVyKCkgLT4gdWludDY0Ogppc194Z292X3N1YnNjcmliZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMTgKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyID09IHNlbGYueGdvdl9zdWJzY3JpYmVyLnZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTQgLy8gMHg3ODY3NmY3NjVmNzM3NTYyNzM2MzcyNjk2MjY1NzIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X3N1YnNjcmliZXIgZXhpc3RzCiAgICA9PQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5Ll9pc19wcm9wb3NhbChwcm9wb3NhbDogdWludDY0KSAtPiB1aW50NjQ6Cl9pc19wcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyMwogICAgLy8gZGVmIF9pc19wcm9wb3NhbChzZWxmLCBwcm9wb3NhbDogQXBwbGljYXRpb24pIC0+IGJvb2w6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIyNAogICAgLy8gcmV0dXJuIHByb3Bvc2FsLmNyZWF0b3IgPT0gR2xvYmFsLmN1cnJlbnRfYXBwbGljYXRpb25fYWRkcmVzcwogICAgZnJhbWVfZGlnIC0xCiAgICBhcHBfcGFyYW1zX2dldCBBcHBDcmVhdG9yCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgPT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5nZXRfcHJvcG9zYWxfcHJvcG9zZXIocHJvcG9zYWw6IHVpbnQ2NCkgLT4gYnl0ZXM6CmdldF9wcm9wb3NhbF9wcm9wb3NlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzMQogICAgLy8gZGVmIGdldF9wcm9wb3NhbF9wcm9wb3NlcihzZWxmLCBwcm9wb3NhbDogQXBwbGljYXRpb24pIC0+IEFjY291bnQ6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjIzMi0yMzQKICAgIC8vIHByb3Bvc2VyX2J5dGVzLCBwcm9wb3Nlcl9leGlzdHMgPSBvcC5BcHBHbG9iYWwuZ2V0X2V4X2J5dGVzKAogICAgLy8gICAgIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9QUk9QT1NFUgogICAgLy8gKQogICAgZnJhbWVfZGlnIC0xCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyMzMKICAgIC8vIHByb3Bvc2FsLCBwY2ZnLkdTX0tFWV9QUk9QT1NFUgogICAgcHVzaGJ5dGVzIDB4NzA3MjZmNzA2ZjczNjU3MgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjMyLTIzNAogICAgLy8gcHJvcG9zZXJfYnl0ZXMsIHByb3Bvc2VyX2V4aXN0cyA9IG9wLkFwcEdsb2JhbC5nZXRfZXhfYnl0ZXMoCiAgICAvLyAgICAgcHJvcG9zYWwsIHBjZmcuR1NfS0VZX1BST1BPU0VSCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM1CiAgICAvLyBhc3NlcnQgcHJvcG9zZXJfZXhpc3RzLCBlcnIuTUlTU0lOR19LRVkKICAgIGFzc2VydCAvLyBNaXNzaW5nIGtleSBpbiBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjM2CiAgICAvLyByZXR1cm4gQWNjb3VudChwcm9wb3Nlcl9ieXRlcykKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gQWRkcmVzcyBsZW5ndGggaXMgMzIgYnl0ZXMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS52YWxpZF94Z292X3BheW1lbnQocGF5bWVudDogdWludDY0KSAtPiB1aW50NjQ6CnZhbGlkX3hnb3ZfcGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI1MgogICAgLy8gZGVmIHZhbGlkX3hnb3ZfcGF5bWVudChzZWxmLCBwYXltZW50OiBndHhuLlBheW1lbnRUcmFuc2FjdGlvbikgLT4gYm9vbDoKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU0CiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIGZyYW1lX2RpZyAtMQogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU0LTI1NQogICAgLy8gcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgYnogdmFsaWRfeGdvdl9wYXltZW50X2Jvb2xfZmFsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU1CiAgICAvLyBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgZnJhbWVfZGlnIC0xCiAgICBndHhucyBBbW91bnQKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxOSAvLyAweDc4Njc2Zjc2NWY2NjY1NjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi54Z292X2ZlZSBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNTQtMjU1CiAgICAvLyBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIC8vIGFuZCBwYXltZW50LmFtb3VudCA9PSBzZWxmLnhnb3ZfZmVlLnZhbHVlCiAgICBieiB2YWxpZF94Z292X3BheW1lbnRfYm9vbF9mYWxzZUAzCiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjUzLTI1NgogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBwYXltZW50LnJlY2VpdmVyID09IEdsb2JhbC5jdXJyZW50X2FwcGxpY2F0aW9uX2FkZHJlc3MKICAgIC8vICAgICBhbmQgcGF5bWVudC5hbW91bnQgPT0gc2VsZi54Z292X2ZlZS52YWx1ZQogICAgLy8gKQogICAgcmV0c3ViCgp2YWxpZF94Z292X3BheW1lbnRfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI1My0yNTYKICAgIC8vIHJldHVybiAoCiAgICAvLyAgICAgcGF5bWVudC5yZWNlaXZlciA9PSBHbG9iYWwuY3VycmVudF9hcHBsaWNhdGlvbl9hZGRyZXNzCiAgICAvLyAgICAgYW5kIHBheW1lbnQuYW1vdW50ID09IHNlbGYueGdvdl9mZWUudmFsdWUKICAgIC8vICkKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS52YWxpZF9reWMoYWRkcmVzczogYnl0ZXMpIC0+IHVpbnQ2NDoKdmFsaWRfa3ljOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU4CiAgICAvLyBkZWYgdmFsaWRfa3ljKHNlbGYsIGFkZHJlc3M6IEFjY291bnQpIC0+IGJvb2w6CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI2MAogICAgLy8gc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX3N0YXR1cwogICAgYnl0ZWMgNCAvLyAweDcwCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5wcm9wb3Nlcl9ib3ggZW50cnkgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjAtMjYxCiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyBhbmQgc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX2V4cGlyaW5nID4gR2xvYmFsLmxhdGVzdF90aW1lc3RhbXAKICAgIGJ6IHZhbGlkX2t5Y19ib29sX2ZhbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI2MQogICAgLy8gYW5kIHNlbGYucHJvcG9zZXJfYm94W2FkZHJlc3NdLmt5Y19leHBpcmluZyA+IEdsb2JhbC5sYXRlc3RfdGltZXN0YW1wCiAgICBmcmFtZV9kaWcgMAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIGNoZWNrIHNlbGYucHJvcG9zZXJfYm94IGVudHJ5IGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyNjAtMjYxCiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyBhbmQgc2VsZi5wcm9wb3Nlcl9ib3hbYWRkcmVzc10ua3ljX2V4cGlyaW5nID4gR2xvYmFsLmxhdGVzdF90aW1lc3RhbXAKICAgIGJ6IHZhbGlkX2t5Y19ib29sX2ZhbHNlQDMKICAgIGludGNfMSAvLyAxCgp2YWxpZF9reWNfYm9vbF9tZXJnZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MjU5LTI2MgogICAgLy8gcmV0dXJuICgKICAgIC8vICAgICBzZWxmLnByb3Bvc2VyX2JveFthZGRyZXNzXS5reWNfc3RhdHVzCiAgICAvLyAgICAgYW5kIHNlbGYucHJvcG9zZXJfYm94W2FkZHJlc3NdLmt5Y19leHBpcmluZyA+IEdsb2JhbC5sYXRlc3RfdGltZXN0YW1wCiAgICAvLyApCiAgICBzd2FwCiAgICByZXRzdWIKCnZhbGlkX2t5Y19ib29sX2ZhbHNlQDM6CiAgICBpbnRjXzAgLy8gMAogICAgYiB2YWxpZF9reWNfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzLnhnb3ZfcmVnaXN0cnkuY29udHJhY3QuWEdvdlJlZ2lzdHJ5LmdldF9jb21taXR0ZWVfYW5jaG9yKCkgLT4gdWludDY0OgpnZXRfY29tbWl0dGVlX2FuY2hvcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjI4OAogICAgLy8gciA9IEdsb2JhbC5yb3VuZAogICAgZ2xvYmFsIFJvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weToyODkKICAgIC8vIHJldHVybiByIC0gKHIgJSBzZWxmLmdvdmVybmFuY2VfcGVyaW9kLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDQ5IC8vIDB4Njc2Zjc2NjU3MjZlNjE2ZTYzNjU1ZjcwNjU3MjY5NmY2NAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLmdvdmVybmFuY2VfcGVyaW9kIGV4aXN0cwogICAgZGlnIDEKICAgIHN3YXAKICAgICUKICAgIC0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy54Z292X3JlZ2lzdHJ5LmNvbnRyYWN0LlhHb3ZSZWdpc3RyeS5kZWNyZW1lbnRfcGVuZGluZ19wcm9wb3NhbHMocHJvcG9zYWw6IHVpbnQ2NCkgLT4gdm9pZDoKZGVjcmVtZW50X3BlbmRpbmdfcHJvcG9zYWxzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI0CiAgICAvLyBkZWYgZGVjcmVtZW50X3BlbmRpbmdfcHJvcG9zYWxzKHNlbGYsIHByb3Bvc2FsOiBBcHBsaWNhdGlvbikgLT4gTm9uZToKICAgIHByb3RvIDEgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI1CiAgICAvLyBzZWxmLnBlbmRpbmdfcHJvcG9zYWxzLnZhbHVlIC09IDEKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyA5IC8vIDB4NzA2NTZlNjQ2OTZlNjc1ZjcwNzI2ZjcwNmY3MzYxNmM3MwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBzZWxmLnBlbmRpbmdfcHJvcG9zYWxzIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGJ5dGVjIDkgLy8gMHg3MDY1NmU2NDY5NmU2NzVmNzA3MjZmNzA2ZjczNjE2YzczCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI2CiAgICAvLyBwcm9wb3NlciA9IHNlbGYuZ2V0X3Byb3Bvc2FsX3Byb3Bvc2VyKHByb3Bvc2FsKQogICAgZnJhbWVfZGlnIC0xCiAgICBjYWxsc3ViIGdldF9wcm9wb3NhbF9wcm9wb3NlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI3CiAgICAvLyBzZWxmLnByb3Bvc2VyX2JveFtwcm9wb3Nlcl0uYWN0aXZlX3Byb3Bvc2FsID0gRmFsc2UKICAgIGJ5dGVjIDQgLy8gMHg3MAogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBkdXAKICAgIHNldGJpdAogICAgaW50Y18wIC8vIDAKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMueGdvdl9yZWdpc3RyeS5jb250cmFjdC5YR292UmVnaXN0cnkubWFrZV94Z292X2JveCh2b3RpbmdfYWRkcmVzczogYnl0ZXMpIC0+IGJ5dGVzOgptYWtlX3hnb3ZfYm94OgogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzI5CiAgICAvLyBkZWYgbWFrZV94Z292X2JveChzZWxmLCB2b3RpbmdfYWRkcmVzczogQWNjb3VudCkgLT4gdHlwLlhHb3ZCb3hWYWx1ZToKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzQxCiAgICAvLyB0b2xlcmF0ZWRfYWJzZW5jZXM9c2VsZi5hYnNlbmNlX3RvbGVyYW5jZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAyMiAvLyAweDYxNjI3MzY1NmU2MzY1NWY3NDZmNmM2NTcyNjE2ZTYzNjUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgc2VsZi5hYnNlbmNlX3RvbGVyYW5jZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjM0MwogICAgLy8gc3Vic2NyaXB0aW9uX3JvdW5kPUdsb2JhbC5yb3VuZCwKICAgIGdsb2JhbCBSb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3hnb3ZfcmVnaXN0cnkvY29udHJhY3QucHk6MzM5LTM0NAogICAgLy8gcmV0dXJuIHR5cC5YR292Qm94VmFsdWUoCiAgICAvLyAgICAgdm90aW5nX2FkZHJlc3M9dm90aW5nX2FkZHJlc3MsCiAgICAvLyAgICAgdG9sZXJhdGVkX2Fic2VuY2VzPXNlbGYuYWJzZW5jZV90b2xlcmFuY2UudmFsdWUsCiAgICAvLyAgICAgbGFzdF92b3RlX3RpbWVzdGFtcD1VSW50NjQoMCksCiAgICAvLyAgICAgc3Vic2NyaXB0aW9uX3JvdW5kPUdsb2JhbC5yb3VuZCwKICAgIC8vICkKICAgIHN3YXAKICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMveGdvdl9yZWdpc3RyeS9jb250cmFjdC5weTozNDIKICAgIC8vIGxhc3Rfdm90ZV90aW1lc3RhbXA9VUludDY0KDApLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy94Z292X3JlZ2lzdHJ5L2NvbnRyYWN0LnB5OjMzOS0zNDQKICAgIC8vIHJldHVybiB0eXAuWEdvdkJveFZhbHVlKAogICAgLy8gICAgIHZvdGluZ19hZGRyZXNzPXZvdGluZ19hZGRyZXNzLAogICAgLy8gICAgIHRvbGVyYXRlZF9hYnNlbmNlcz1zZWxmLmFic2VuY2VfdG9sZXJhbmNlLnZhbHVlLAogICAgLy8gICAgIGxhc3Rfdm90ZV90aW1lc3RhbXA9VUludDY0KDApLAogICAgLy8gICAgIHN1YnNjcmlwdGlvbl9yb3VuZD1HbG9iYWwucm91bmQsCiAgICAvLyApCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgcmV0c3ViCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDEwCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBhbGdvcHkuYXJjNC5BUkM0Q29udHJhY3QuY2xlYXJfc3RhdGVfcHJvZ3JhbSgpIC0+IHVpbnQ2NDoKbWFpbjoKICAgIHB1c2hpbnQgMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CiAFAAEgCIAQJjsPcGF1c2VkX3JlZ2lzdHJ5BXhnb3ZzAXgEFR98dQFwEW91dHN0YW5kaW5nX2Z1bmRzAnBhDHhnb3ZfbWFuYWdlcgEAEXBlbmRpbmdfcHJvcG9zYWxzEHBhdXNlZF9wcm9wb3NhbHMKcmVxdWVzdF9pZCJXcm9uZyBQcm9wb3NhbCBTdGF0dXMgb3IgZmluYWxpemVkBEVSUjoPeGdvdl9zdWJzY3JpYmVyCnhnb3ZfcGF5b3IMa3ljX3Byb3ZpZGVyEWNvbW1pdHRlZV9tYW5hZ2VyC3hnb3ZfZGFlbW9uCHhnb3ZfZmVlDHByb3Bvc2VyX2ZlZRFvcGVuX3Byb3Bvc2FsX2ZlZRFhYnNlbmNlX3RvbGVyYW5jZQFyAnJ1DHhnb3ZfY291bmNpbBxkYWVtb25fb3BlcmF0aW9uX2Z1bmRpbmdfYnBzF3Byb3Bvc2FsX2NvbW1pdG1lbnRfYnBzFG1pbl9yZXF1ZXN0ZWRfYW1vdW50Gm1heF9yZXF1ZXN0ZWRfYW1vdW50X3NtYWxsG21heF9yZXF1ZXN0ZWRfYW1vdW50X21lZGl1bRptYXhfcmVxdWVzdGVkX2Ftb3VudF9sYXJnZRlkaXNjdXNzaW9uX2R1cmF0aW9uX3NtYWxsGmRpc2N1c3Npb25fZHVyYXRpb25fbWVkaXVtGWRpc2N1c3Npb25fZHVyYXRpb25fbGFyZ2UaZGlzY3Vzc2lvbl9kdXJhdGlvbl94bGFyZ2UVdm90aW5nX2R1cmF0aW9uX3NtYWxsFnZvdGluZ19kdXJhdGlvbl9tZWRpdW0Vdm90aW5nX2R1cmF0aW9uX2xhcmdlFnZvdGluZ19kdXJhdGlvbl94bGFyZ2UMcXVvcnVtX3NtYWxsDXF1b3J1bV9tZWRpdW0McXVvcnVtX2xhcmdlFXdlaWdodGVkX3F1b3J1bV9zbWFsbBZ3ZWlnaHRlZF9xdW9ydW1fbWVkaXVtFXdlaWdodGVkX3F1b3J1bV9sYXJnZRFjb21taXR0ZWVfbWVtYmVycw9jb21taXR0ZWVfdm90ZXMSbWF4X2NvbW1pdHRlZV9zaXplEWdvdmVybmFuY2VfcGVyaW9kFmNvbW1pdHRlZV9ncmFjZV9wZXJpb2QEUQmasBVjb21taXR0ZWVfbGFzdF9hbmNob3IEsTJIYAkAAAAAAAAAAAAMY29tbWl0dGVlX2lkD1ZvdGVyIG5vdCBmb3VuZEgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMRhAALUxNYEcEkQxNIEkEkQxNxREMTYURCcHMgNnJw4yA2cnDzIDZycZMgNnJxAyA2cnETIDZycSMgNnKCJnJwoiZycFImcnEyJnJxQiZycVImcnGiJnJxsiZyccImcnHSJnJx4iZycfImcnICJnJyEiZyciImcnIyJnJyQiZyclImcnJiJnJyciZycoImcnKSJnJyoiZycrImcnLCJnJy0iZycuImcnLyJnJzAiZykiZycJImcnCyJngARJVsGrNhoAjgEBVTEZFEQxGEEBPoIsBJWcR2IEbB9WTQThClEuBIb34OYEOeJtigSYNS6GBPpO1uIE1snMGgRcSEBVBDfWrfEE1CFrbgS6hSDyBBZ3sw4EhLfSaASvfxhgBKCCzvgE3zn9uQTKD2o6BEUHc5AEPDG8AgQNLHiRBJP6zboEzos6HAT66ggfBA2ieIUEek/uQwRS3RDXBNTTemQENDSdzAQVj43WBF/iWTUE2ye5rwT1kQdWBGVhCp8E/caVwgS6kKtUBLO1hIIEauXrRgQnYw1lBIJPmLwESVSLoASCZ4T2BCaYMgAE29g92TYaAI4sACkATABxAHsAhACOAJcAoQCzAMUA1wDpAPsBDQEfAzwDfwOkA9QELwRuBIQE3gUPBSUFaAWmBf4GaQeJCKsJqwp3C1QL4gwCDDoMbQ3hDkMObg6bDsgAAQAjQ4AETFxhujYaAI4BAA0AMRmBBBIxGBBEQgMZJwcxAGcnOkkSRCNDNhoBSRUlEkQXiA6iRCcGvUUBQQAGJwZM0yNDJwZMuUhC//Y2GgFJFSUSRBc2GgJJIlmBAghLARUSRFcCAIgObkQnBk4CuyNDiA5jRCcGvEgjQ4gOWUQoI2cjQ4gOUEQnCiNnI0OIDkZEKCJnI0OIDj1EJwoiZyNDNhoBSRUkEkSIDitEJwdMZyNDNhoBSRUkEkSIDhlEJw9MZyNDNhoBSRUkEkSIDgdEJxlMZyNDNhoBSRUkEkSIDfVEJw5MZyNDNhoBSRUkEkSIDeNEJxBMZyNDNhoBSRUkEkSIDdFEJxFMZyNDNhoBSRUkEkSIDb9EJxJMZyNDIkcEgABHDTYaAUcCFYHQARJEiA2jRCJbSYHUqQIPQQHySYHUkAIPQQHpI0RLAUklW0lFCYHklgEPRIEoW0lFB0EBzEsBVzAYSUUTIltJRQZLBg1BAblLESVbSUUFSwUNQQGsSxGBEFtLBA1BAaEjREsBSYEQW0lOAkURSYEYW0lFE0sCC4GQTgqB5MWDCAhLAksBDURPAkwJgdSTAQonMExnV0ggSUUSIltJRQRBAVtLECVbSUUPSwMPQQFOSxCBEFtJRQ5LDg9BAUBLEIEYW0sND0EBNSNESwFXaCBJRRYiW0lFDUEBH0sUJVtJRQxLDA9BARJLFIEQW0lFC0sLD0EBBEsUgRhbSwoPQQD5I0RLAVeIGElFFSJbSUUKQQDjSxOBEFtLCQ1BANgjREsBV6AYSUUUIltJRQlBAMJLEoEQW0sIDUEAtyNEJxNLAWcnFEsHZycVSw9nJxpLEGdLAUkkWycbTGcnHEsHZ0lXMBhJIlsnHUxnSSVbJx5MZ4EQWycfTGcnIEsEZ0sRSSVbJyFMZ0mBEFsnIkxngRhbJyNMZyckSw1nSxVJJVsnJUxnSYEQWycmTGeBGFsnJ0xnJyhLCmcnKSJnSxSBEFsnKkxnJytLCWcnLCJnSxOBEFsnLUxnSYG4AVsnFkxnSYHAAVsnMUxngcgBWycyTGcjQyJC/0YiQv8lIkL/BCJC/sgiQv5cIkL+FIgLnkQjQzYaAUkVJBJEMRYjCUk4ECMSRCIoZUQURCoxAFC9RQEURIgLrERJiAwXKjEAUEy/IillRCMIKUxnMQBMUCc1TFCwI0MiKGVEFEQqMQBQvUUBRCoxAFC8SCIpZUQjCSlMZyczMQBQsCNDNhoBSRUkEkQiKGVEFEQqSwFQSb1FAURJvkgkWxREvEgiKWVEIwkpTGcnM0xQsCNDNhoBSRUkEkQ2GgJJFSQSRDYaA0kVJRJEFzEWIwlJOBAjEkQxAEsDEkQiKGVEFEQqSwRQvUUBFESICv1EIicLZURPA08DUE8CFlBLARYnF0xQTL8jCCcLTGcjQzYaAUkVJRJEF4gKpUQWJxdMUEm+RElXICBJiAswTwJXACAqSwFQTwK/IillRCMIKUxnTwK8SExQJzVMULAjQzYaAUkVJRJEF4gKZkQWJxdMULxII0M2GgFJFSQSRDYaAkkVJBJENhoDSRUlEkQXMRYjCUk4ECMSRDEASwMSRCIoZUQURCpLBFC9RQFEiApORCInC2VETwNPA1BPAhZQSwEWJxhMUEy/IwgnC0xnI0M2GgFJFSUSRBeICfZEFicYTFBJvkRXACAqSwFQvEgiKWVEIwkpTGdMvEgnM0xQsCNDNhoBSRUlEkQXiAnFRBYnGExQvEgjQzYaAUcCFSQSRDYaAklOAhUkEkQiKGVEFEQqTFBHAr1FAUS+SDEATFcAIBJAAAgxAEsDEkEACSNESSJLA7sjQyJC//QxFiMJSTgQIxJEIihlRBREJwQxAFC9RQEUREk4BzIKEkQ4CCInFGVEEkQnBDEAUCc2v4AEvXkv0TEAULAjQzYaAUkVJBJENhoCSRUjEkQiUzYaA0kVJRJEFzEAIicQZUQSRCcESwNQSb1FAURJvkgiUycIIk8CVCNPBFRPAhZQv0mICT4nCCJPAlRQgATLUP2ETFCwI0M2GgFJFSQSRDYaAkkVJRJEFzYaA0kVJRJEFzEAIicRZUQSREsBRElEIicwZURLAg9EJzdLA2cnLksCZycvSwFniAkMJzRMZ0wWSZMkDkRXBARMFkmTJA5EVwQETgJQTFCABIc2WGZMULAjQzEWIwlJOBAjEkQiKGVEFEQiJwplRBREiAjKMgZLAQlMIic0ZUQSQAALIicyZURLAQ9BAOgjRCcEMQBQvUUBRCcEMQBQvkQiUxREMQCICHJEMQEyAIEDCw9ESwFJOAcyChJEOAgiJxVlRExLARJEMgpzAEQnBr1ESYEECCEECkmBAhJESSMJIQQLTwJMCScGIiEEuicGIQRPA7qxMQBPA7I4gQOyNYEbsjSABAqBAUOyQk8CskBMskCABMxpTqqyGrIagQayECKyAbO0PTIKcwBEsUsBcghETE8DCU8DTAmyCLIHI7IQIrIBszEAIicJZUQjCCcJTGcnBExQSSIjuiIjVCJMuzEATBZJTwJQgAT6edhLTFCwK0xQsCNDIkL/FSJJgAA2GgFJFSUSRBdJNhoCSU4CSRUkEkQ2GgNJTgMVJRJENhoESU4DFSUSRCIoZUQUREyIBylEKksBUEm9RQFESb5ITgIiJxZlRBZLASRPArsyBxaBKEy7MQASQAALMQBLAVcAIBJBALQjRLFLBLIYgAQYQaDSshpLA7IaSwKyGksBshqBBrIQIrIBs7Q+SUUJSVcABCsSRElXBABJIlmBAghMFRJEVwYASUUIFUlFB4EEDEEAXCJBAFCBBEsGSU4CD4EESwJPAk1LCExPAlInDCc4ggINVm90ZXMgaW52YWxpZBVWb3RpbmcgUGVyaW9kIEV4cGlyZWRPBI4EAAQAAwACAAEAAAAAAEsGgAASRCNDSwdXBgQnDRJC/5oiQv9JIkcCgAA2GgFJFSUSRBdJNhoCSU4CSSJZSU4DJAuBAghMFRJEIihlRBREiAYRRCJJSwIMQQBMSwJXAgBLASQLJFgqTFBJRQi9RQFBAC1LBr5EJFtBACRLBkm+RCRbIwkWSwEkTwK7vkgkW0AADUsGvEgiKWVEIwkpTGdJIwhFAUL/rbFLA7IYgAR2/0xwshpLArIagQayECKyAbO0PklFCUlXAAQrEkRJVwQASSJZgQIITBUSRFcGAElFBxVJRQaBBAxBADAiQQAkgQRLBUlOAg+BBEsCTwJNSwdMTwJSJwwnOE8CjgIAAgABAAAASwWAABJEI0NLB1cGBCcNEkL/xjYaAUkVJRJEFzEAIicPZUQSREmIBShESYgFL0sBgBByZXF1ZXN0ZWRfYW1vdW50ZUQnBEsCUL1FAURLAYgFQEQiJwVlRElLAg9EsUsBsghPArIHI7IQIrIBs0wJJwVMZ7GyGIAEil5MgLIagQayECKyAbO0PkcCVwAEKxJESVcEAEkiWYECCEwVEkRXBgBJFUmBBAxBACoiQQAegQRLAUlOAg+BBEsCTwJNSwNMTwJSJwxMjgEAAQAASwGAABJEI0NLAlcGBCcNEkL/zCJJgAA2GgFJFSUSRBdJgAZzdGF0dXNlTElPAkRBAAdJgQoSQQAJMQAiJxJlRBJESwFJiAQ9RLGyGIAEgCBptLIagQayECKyAbO0PklFBklXAAQrEkRJVwQASSJZgQIITBUSRFcGAElFBRVJRQSBBAxBAF8iQQBOgQRLA0lOAg+BBEsCTwJNSwVMTwJSJwyAKlRoZXJlIGFyZSB2b3RlcnMgYXNzaWduZWQgdG8gdGhpcyBwcm9wb3NhbE8CjgIAAgABAAAASwOAABJESwGIBBMjQ0sEVwYEJw0SQv+XNhoBSRUlEkQXSSIoZUQUREmIA4FESYgDiDEAEkSxshiABHNxMhqyGoEGshAisgGztD5HAlcABCsSRElXBABJIlmBAghMFRJEVwYASRVJgQQMQQAvIkEAHoEESwFJTgIPgQRLAk8CTUsDTE8CUicMTI4BAAEAAEsBgAASREsDiAOFI0NLAlcGBCcNEkL/xzEWIwlJOBAjEkRJOAcyChJEIicFZURMOAgIJwVMZyNDNhoBSRUlEkQXiALJRCInBWVESg5EMQEyAIECCw9ESwEJJwVMZ7EiJwdlRLIHsggjshAisgGzI0OIAppEMQEyAIECCw9EMgpzAEQyCnMBRAkiJwVlRAlJRLEiJwdlRLIHsggjshAisgGzI0MiKGVEIicKZUQiJwdlRCInD2VEIicZZUQiJw5lRCInEGVEIicRZUQiJxJlRCInE2VEIicUZUQiJxVlRCInGmVEIicbZUQiJxxlRCInHWVEIiceZUQiJx9lRE8CFk8CFlBMFlAiJyBlRCInIWVEIiciZUQiJyNlRE8DFk8DFlBPAhZQTBZQIickZUQiJyVlRCInJmVEIicnZURPAxZPAxZQTwIWUEwWUCInKGVEIicpZUQiJyplRE8CFk8CFlBMFlAiJytlRCInLGVEIictZURPAhZPAhZQTBZQIicFZUQiJwllRCInN2VEIicuZUQiJy9lRCInFmVEIicxZUQiJzJlRCInNGVEJwgiTx5UI08dVE8bUE8aUE8ZUE8YUE8XUE8WUE8VUE8UFlBPExZQTxIWUE8RFlBPEBZQTw8WUE8OUE8NUE8MUE8LUE8KUE8JFlBPCBZQTwdQTwYWUE8FFlBPBBZQTwMWUE8CFlBMFlArTFCwI0M2GgFJFSQSRCpMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDgDgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEL/tjYaAUkVJBJEJwRMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzZC/+42GgFJFSUSRBcWJxdMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzlC/+42GgFJFSUSRBcWJxhMUEm9SU8CSEEAEUsBvkQnCCJLA1RQK0xQsCNDJzlC/+42GgFJFSUSRBeIABVEI0MxACInB2VEEokxACInDmVEEomKAQGL/3IHRDIKEomKAQGL/4AIcHJvcG9zZXJlREkVJBJEiYoBAYv/OAcyChJBAA+L/zgIIicTZUQSQQACI4kiiYoBAScEi/9QSb5EI1NBAA+LAL5EI1syBw1BAAMjTIkiQv/6MgYiJzFlREsBTBgJiYoBACInCWVEIwknCUxni/+I/4gnBExQSSIjuiJJVCJMu4mKAQEiJxZlRDIGTBaL/0xQIhZQTBZQiQ==\",\"clear\":\"CoEBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":7,\"patch\":1}},\"templateVariables\":{\"entropy\":{\"type\":\"AVMBytes\"}}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
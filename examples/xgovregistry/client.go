@@ -2,26 +2,378 @@
 package xgovregistry
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendInitProposalContract calls the init_proposal_contract ABI method.
+	SendInitProposalContract(ctx context.Context, params algokit.CallParams[InitProposalContractArgs]) error
+	// SendLoadProposalContract calls the load_proposal_contract ABI method.
+	SendLoadProposalContract(ctx context.Context, params algokit.CallParams[LoadProposalContractArgs]) error
+	// SendDeleteProposalContractBox calls the delete_proposal_contract_box ABI method.
+	SendDeleteProposalContractBox(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendPauseRegistry calls the pause_registry ABI method.
+	SendPauseRegistry(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendPauseProposals calls the pause_proposals ABI method.
+	SendPauseProposals(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendResumeRegistry calls the resume_registry ABI method.
+	SendResumeRegistry(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendResumeProposals calls the resume_proposals ABI method.
+	SendResumeProposals(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendSetXgovManager calls the set_xgov_manager ABI method.
+	SendSetXgovManager(ctx context.Context, params algokit.CallParams[SetXgovManagerArgs]) error
+	// SendSetPayor calls the set_payor ABI method.
+	SendSetPayor(ctx context.Context, params algokit.CallParams[SetPayorArgs]) error
+	// SendSetXgovCouncil calls the set_xgov_council ABI method.
+	SendSetXgovCouncil(ctx context.Context, params algokit.CallParams[SetXgovCouncilArgs]) error
+	// SendSetXgovSubscriber calls the set_xgov_subscriber ABI method.
+	SendSetXgovSubscriber(ctx context.Context, params algokit.CallParams[SetXgovSubscriberArgs]) error
+	// SendSetKycProvider calls the set_kyc_provider ABI method.
+	SendSetKycProvider(ctx context.Context, params algokit.CallParams[SetKycProviderArgs]) error
+	// SendSetCommitteeManager calls the set_committee_manager ABI method.
+	SendSetCommitteeManager(ctx context.Context, params algokit.CallParams[SetCommitteeManagerArgs]) error
+	// SendSetXgovDaemon calls the set_xgov_daemon ABI method.
+	SendSetXgovDaemon(ctx context.Context, params algokit.CallParams[SetXgovDaemonArgs]) error
+	// SendConfigXgovRegistry calls the config_xgov_registry ABI method.
+	SendConfigXgovRegistry(ctx context.Context, params algokit.CallParams[ConfigXgovRegistryArgs]) error
+	// SendSubscribeXgov calls the subscribe_xgov ABI method.
+	SendSubscribeXgov(ctx context.Context, params algokit.CallParams[SubscribeXgovArgs]) error
+	// SendUnsubscribeXgov calls the unsubscribe_xgov ABI method.
+	SendUnsubscribeXgov(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendUnsubscribeAbsentee calls the unsubscribe_absentee ABI method.
+	SendUnsubscribeAbsentee(ctx context.Context, params algokit.CallParams[UnsubscribeAbsenteeArgs]) error
+	// SendRequestSubscribeXgov calls the request_subscribe_xgov ABI method.
+	SendRequestSubscribeXgov(ctx context.Context, params algokit.CallParams[RequestSubscribeXgovArgs]) error
+	// SendApproveSubscribeXgov calls the approve_subscribe_xgov ABI method.
+	SendApproveSubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveSubscribeXgovArgs]) error
+	// SendRejectSubscribeXgov calls the reject_subscribe_xgov ABI method.
+	SendRejectSubscribeXgov(ctx context.Context, params algokit.CallParams[RejectSubscribeXgovArgs]) error
+	// SendRequestUnsubscribeXgov calls the request_unsubscribe_xgov ABI method.
+	SendRequestUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RequestUnsubscribeXgovArgs]) error
+	// SendApproveUnsubscribeXgov calls the approve_unsubscribe_xgov ABI method.
+	SendApproveUnsubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveUnsubscribeXgovArgs]) error
+	// SendRejectUnsubscribeXgov calls the reject_unsubscribe_xgov ABI method.
+	SendRejectUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RejectUnsubscribeXgovArgs]) error
+	// SendSetVotingAccount calls the set_voting_account ABI method.
+	SendSetVotingAccount(ctx context.Context, params algokit.CallParams[SetVotingAccountArgs]) error
+	// SendSubscribeProposer calls the subscribe_proposer ABI method.
+	SendSubscribeProposer(ctx context.Context, params algokit.CallParams[SubscribeProposerArgs]) error
+	// SendSetProposerKyc calls the set_proposer_kyc ABI method.
+	SendSetProposerKyc(ctx context.Context, params algokit.CallParams[SetProposerKycArgs]) error
+	// SendDeclareCommittee calls the declare_committee ABI method.
+	SendDeclareCommittee(ctx context.Context, params algokit.CallParams[DeclareCommitteeArgs]) error
+	// SendOpenProposal calls the open_proposal ABI method.
+	SendOpenProposal(ctx context.Context, params algokit.CallParams[OpenProposalArgs]) (*OpenProposalMethodResult, error)
+	// SendVoteProposal calls the vote_proposal ABI method.
+	SendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) error
+	// SendUnassignAbsenteeFromProposal calls the unassign_absentee_from_proposal ABI method.
+	SendUnassignAbsenteeFromProposal(ctx context.Context, params algokit.CallParams[UnassignAbsenteeFromProposalArgs]) error
+	// SendPayGrantProposal calls the pay_grant_proposal ABI method.
+	SendPayGrantProposal(ctx context.Context, params algokit.CallParams[PayGrantProposalArgs]) error
+	// SendFinalizeProposal calls the finalize_proposal ABI method.
+	SendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) error
+	// SendDropProposal calls the drop_proposal ABI method.
+	SendDropProposal(ctx context.Context, params algokit.CallParams[DropProposalArgs]) error
+	// SendDepositFunds calls the deposit_funds ABI method.
+	SendDepositFunds(ctx context.Context, params algokit.CallParams[DepositFundsArgs]) error
+	// SendWithdrawFunds calls the withdraw_funds ABI method.
+	SendWithdrawFunds(ctx context.Context, params algokit.CallParams[WithdrawFundsArgs]) error
+	// SendWithdrawBalance calls the withdraw_balance ABI method.
+	SendWithdrawBalance(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendGetState calls the get_state ABI method (readonly).
+	SendGetState(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*GetStateMethodResult, error)
+	// SendGetXgovBox calls the get_xgov_box ABI method (readonly).
+	SendGetXgovBox(ctx context.Context, params algokit.CallParams[GetXgovBoxArgs]) (*GetXgovBoxMethodResult, error)
+	// SendGetProposerBox calls the get_proposer_box ABI method (readonly).
+	SendGetProposerBox(ctx context.Context, params algokit.CallParams[GetProposerBoxArgs]) (*GetProposerBoxMethodResult, error)
+	// SendGetRequestBox calls the get_request_box ABI method (readonly).
+	SendGetRequestBox(ctx context.Context, params algokit.CallParams[GetRequestBoxArgs]) (*GetRequestBoxMethodResult, error)
+	// SendGetRequestUnsubscribeBox calls the get_request_unsubscribe_box ABI method (readonly).
+	SendGetRequestUnsubscribeBox(ctx context.Context, params algokit.CallParams[GetRequestUnsubscribeBoxArgs]) (*GetRequestUnsubscribeBoxMethodResult, error)
+	// SendIsProposal calls the is_proposal ABI method.
+	SendIsProposal(ctx context.Context, params algokit.CallParams[IsProposalArgs]) error
+	// SendOpUp calls the op_up ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxProposalApprovalProgram reads the proposal_approval_program box.
+	GetBoxProposalApprovalProgram(ctx context.Context) ([]byte, error)
+	// GetBoxMapProposerBox reads the value for key in the proposer_box box map.
+	GetBoxMapProposerBox(ctx context.Context, key types.Address) (ProposerBoxValue, error)
+	// GetBoxMapRequestBox reads the value for key in the request_box box map.
+	GetBoxMapRequestBox(ctx context.Context, key uint64) (XGovSubscribeRequestBoxValue, error)
+	// GetBoxMapRequestUnsubscribeBox reads the value for key in the request_unsubscribe_box box map.
+	GetBoxMapRequestUnsubscribeBox(ctx context.Context, key uint64) (XGovSubscribeRequestBoxValue, error)
+	// GetBoxMapVoters reads the value for key in the voters box map.
+	GetBoxMapVoters(ctx context.Context, key types.Address) (uint64, error)
+	// GetBoxMapXgovBox reads the value for key in the xgov_box box map.
+	GetBoxMapXgovBox(ctx context.Context, key types.Address) (XGovBoxValue, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the XGovRegistry smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +385,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("XGovRegistry has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,742 +434,770 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendInitProposalContract calls the init_proposal_contract ABI method and waits for confirmation.
+// SendInitProposalContract calls the init_proposal_contract ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Initializes the Proposal Approval Program contract.
 func (c *Client) SendInitProposalContract(ctx context.Context, params algokit.CallParams[InitProposalContractArgs]) error {
-	methodArgs := argsToInterfaceInitProposalContract(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "init_proposal_contract",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendInitProposalContract(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendInitProposalContract(ctx context.Context, params algokit.CallParams[InitProposalContractArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().InitProposalContract(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendLoadProposalContract calls the load_proposal_contract ABI method and waits for confirmation.
+// SendLoadProposalContract calls the load_proposal_contract ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Loads the Proposal Approval Program contract.
 func (c *Client) SendLoadProposalContract(ctx context.Context, params algokit.CallParams[LoadProposalContractArgs]) error {
-	methodArgs := argsToInterfaceLoadProposalContract(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "load_proposal_contract",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendLoadProposalContract(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendLoadProposalContract(ctx context.Context, params algokit.CallParams[LoadProposalContractArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().LoadProposalContract(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteProposalContractBox calls the delete_proposal_contract_box ABI method and waits for confirmation.
+// SendDeleteProposalContractBox calls the delete_proposal_contract_box ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Deletes the Proposal Approval Program contract box.
-func (c *Client) SendDeleteProposalContractBox(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "delete_proposal_contract_box",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendDeleteProposalContractBox(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteProposalContractBox(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteProposalContractBox(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteProposalContractBox(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendPauseRegistry calls the pause_registry ABI method and waits for confirmation.
+// SendPauseRegistry calls the pause_registry ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Pauses the xGov Registry non-administrative methods.
-func (c *Client) SendPauseRegistry(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "pause_registry",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendPauseRegistry(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPauseRegistry(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPauseRegistry(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().PauseRegistry(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendPauseProposals calls the pause_proposals ABI method and waits for confirmation.
+// SendPauseProposals calls the pause_proposals ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Pauses the creation of new Proposals.
-func (c *Client) SendPauseProposals(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "pause_proposals",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendPauseProposals(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPauseProposals(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPauseProposals(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().PauseProposals(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendResumeRegistry calls the resume_registry ABI method and waits for confirmation.
+// SendResumeRegistry calls the resume_registry ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Resumes the xGov Registry non-administrative methods.
-func (c *Client) SendResumeRegistry(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "resume_registry",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendResumeRegistry(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendResumeRegistry(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendResumeRegistry(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ResumeRegistry(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendResumeProposals calls the resume_proposals ABI method and waits for confirmation.
+// SendResumeProposals calls the resume_proposals ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Resumes the creation of new Proposals.
-func (c *Client) SendResumeProposals(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "resume_proposals",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendResumeProposals(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendResumeProposals(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendResumeProposals(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ResumeProposals(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetXgovManager calls the set_xgov_manager ABI method and waits for confirmation.
+// SendSetXgovManager calls the set_xgov_manager ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Manager.
 func (c *Client) SendSetXgovManager(ctx context.Context, params algokit.CallParams[SetXgovManagerArgs]) error {
-	methodArgs := argsToInterfaceSetXgovManager(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_xgov_manager",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetXgovManager(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetXgovManager(ctx context.Context, params algokit.CallParams[SetXgovManagerArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetXgovManager(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetPayor calls the set_payor ABI method and waits for confirmation.
+// SendSetPayor calls the set_payor ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Payor.
 func (c *Client) SendSetPayor(ctx context.Context, params algokit.CallParams[SetPayorArgs]) error {
-	methodArgs := argsToInterfaceSetPayor(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_payor",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetPayor(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetPayor(ctx context.Context, params algokit.CallParams[SetPayorArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetPayor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetXgovCouncil calls the set_xgov_council ABI method and waits for confirmation.
+// SendSetXgovCouncil calls the set_xgov_council ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Council.
 func (c *Client) SendSetXgovCouncil(ctx context.Context, params algokit.CallParams[SetXgovCouncilArgs]) error {
-	methodArgs := argsToInterfaceSetXgovCouncil(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_xgov_council",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetXgovCouncil(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetXgovCouncil(ctx context.Context, params algokit.CallParams[SetXgovCouncilArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetXgovCouncil(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetXgovSubscriber calls the set_xgov_subscriber ABI method and waits for confirmation.
+// SendSetXgovSubscriber calls the set_xgov_subscriber ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Subscriber.
 func (c *Client) SendSetXgovSubscriber(ctx context.Context, params algokit.CallParams[SetXgovSubscriberArgs]) error {
-	methodArgs := argsToInterfaceSetXgovSubscriber(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_xgov_subscriber",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetXgovSubscriber(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetXgovSubscriber(ctx context.Context, params algokit.CallParams[SetXgovSubscriberArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetXgovSubscriber(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetKycProvider calls the set_kyc_provider ABI method and waits for confirmation.
+// SendSetKycProvider calls the set_kyc_provider ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the KYC provider.
 func (c *Client) SendSetKycProvider(ctx context.Context, params algokit.CallParams[SetKycProviderArgs]) error {
-	methodArgs := argsToInterfaceSetKycProvider(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_kyc_provider",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetKycProvider(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetKycProvider(ctx context.Context, params algokit.CallParams[SetKycProviderArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetKycProvider(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetCommitteeManager calls the set_committee_manager ABI method and waits for confirmation.
+// SendSetCommitteeManager calls the set_committee_manager ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the Committee Manager.
 func (c *Client) SendSetCommitteeManager(ctx context.Context, params algokit.CallParams[SetCommitteeManagerArgs]) error {
-	methodArgs := argsToInterfaceSetCommitteeManager(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_committee_manager",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetCommitteeManager(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetCommitteeManager(ctx context.Context, params algokit.CallParams[SetCommitteeManagerArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetCommitteeManager(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetXgovDaemon calls the set_xgov_daemon ABI method and waits for confirmation.
+// SendSetXgovDaemon calls the set_xgov_daemon ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Daemon.
 func (c *Client) SendSetXgovDaemon(ctx context.Context, params algokit.CallParams[SetXgovDaemonArgs]) error {
-	methodArgs := argsToInterfaceSetXgovDaemon(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_xgov_daemon",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetXgovDaemon(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetXgovDaemon(ctx context.Context, params algokit.CallParams[SetXgovDaemonArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetXgovDaemon(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendConfigXgovRegistry calls the config_xgov_registry ABI method and waits for confirmation.
+// SendConfigXgovRegistry calls the config_xgov_registry ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the configuration of the xGov Registry.
 func (c *Client) SendConfigXgovRegistry(ctx context.Context, params algokit.CallParams[ConfigXgovRegistryArgs]) error {
-	methodArgs := argsToInterfaceConfigXgovRegistry(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "config_xgov_registry",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendConfigXgovRegistry(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendConfigXgovRegistry(ctx context.Context, params algokit.CallParams[ConfigXgovRegistryArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ConfigXgovRegistry(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSubscribeXgov calls the subscribe_xgov ABI method and waits for confirmation.
+// SendSubscribeXgov calls the subscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Subscribes the sender to being an xGov.
 func (c *Client) SendSubscribeXgov(ctx context.Context, params algokit.CallParams[SubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceSubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "subscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSubscribeXgov(ctx context.Context, params algokit.CallParams[SubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnsubscribeXgov calls the unsubscribe_xgov ABI method and waits for confirmation.
+// SendUnsubscribeXgov calls the unsubscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Unsubscribes the sender from being an xGov.
-func (c *Client) SendUnsubscribeXgov(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "unsubscribe_xgov",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendUnsubscribeXgov(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnsubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnsubscribeXgov(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UnsubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnsubscribeAbsentee calls the unsubscribe_absentee ABI method and waits for confirmation.
+// SendUnsubscribeAbsentee calls the unsubscribe_absentee ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Unsubscribes an absentee xGov. This is a temporary method used only for the
 // first absentees removal at the inception of the absenteeism penalty.
 func (c *Client) SendUnsubscribeAbsentee(ctx context.Context, params algokit.CallParams[UnsubscribeAbsenteeArgs]) error {
-	methodArgs := argsToInterfaceUnsubscribeAbsentee(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unsubscribe_absentee",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnsubscribeAbsentee(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnsubscribeAbsentee(ctx context.Context, params algokit.CallParams[UnsubscribeAbsenteeArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UnsubscribeAbsentee(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRequestSubscribeXgov calls the request_subscribe_xgov ABI method and waits for confirmation.
+// SendRequestSubscribeXgov calls the request_subscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Requests to subscribe to the xGov.
 func (c *Client) SendRequestSubscribeXgov(ctx context.Context, params algokit.CallParams[RequestSubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceRequestSubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "request_subscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRequestSubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRequestSubscribeXgov(ctx context.Context, params algokit.CallParams[RequestSubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RequestSubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendApproveSubscribeXgov calls the approve_subscribe_xgov ABI method and waits for confirmation.
+// SendApproveSubscribeXgov calls the approve_subscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Approves a subscribe request to xGov.
 func (c *Client) SendApproveSubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveSubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceApproveSubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "approve_subscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendApproveSubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendApproveSubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveSubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ApproveSubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRejectSubscribeXgov calls the reject_subscribe_xgov ABI method and waits for confirmation.
+// SendRejectSubscribeXgov calls the reject_subscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Rejects a subscribe request to xGov.
 func (c *Client) SendRejectSubscribeXgov(ctx context.Context, params algokit.CallParams[RejectSubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceRejectSubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "reject_subscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRejectSubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRejectSubscribeXgov(ctx context.Context, params algokit.CallParams[RejectSubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RejectSubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRequestUnsubscribeXgov calls the request_unsubscribe_xgov ABI method and waits for confirmation.
+// SendRequestUnsubscribeXgov calls the request_unsubscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Requests to unsubscribe from the xGov.
 func (c *Client) SendRequestUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RequestUnsubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceRequestUnsubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "request_unsubscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRequestUnsubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRequestUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RequestUnsubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RequestUnsubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendApproveUnsubscribeXgov calls the approve_unsubscribe_xgov ABI method and waits for confirmation.
+// SendApproveUnsubscribeXgov calls the approve_unsubscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Approves a request to unsubscribe from xGov.
 func (c *Client) SendApproveUnsubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveUnsubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceApproveUnsubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "approve_unsubscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendApproveUnsubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendApproveUnsubscribeXgov(ctx context.Context, params algokit.CallParams[ApproveUnsubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ApproveUnsubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRejectUnsubscribeXgov calls the reject_unsubscribe_xgov ABI method and waits for confirmation.
+// SendRejectUnsubscribeXgov calls the reject_unsubscribe_xgov ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Rejects a request to unsubscribe from xGov.
 func (c *Client) SendRejectUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RejectUnsubscribeXgovArgs]) error {
-	methodArgs := argsToInterfaceRejectUnsubscribeXgov(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "reject_unsubscribe_xgov",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRejectUnsubscribeXgov(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRejectUnsubscribeXgov(ctx context.Context, params algokit.CallParams[RejectUnsubscribeXgovArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RejectUnsubscribeXgov(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetVotingAccount calls the set_voting_account ABI method and waits for confirmation.
+// SendSetVotingAccount calls the set_voting_account ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the Voting Address for the xGov.
 func (c *Client) SendSetVotingAccount(ctx context.Context, params algokit.CallParams[SetVotingAccountArgs]) error {
-	methodArgs := argsToInterfaceSetVotingAccount(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_voting_account",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetVotingAccount(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetVotingAccount(ctx context.Context, params algokit.CallParams[SetVotingAccountArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetVotingAccount(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSubscribeProposer calls the subscribe_proposer ABI method and waits for confirmation.
+// SendSubscribeProposer calls the subscribe_proposer ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Subscribes the sender to being a Proposer.
 func (c *Client) SendSubscribeProposer(ctx context.Context, params algokit.CallParams[SubscribeProposerArgs]) error {
-	methodArgs := argsToInterfaceSubscribeProposer(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "subscribe_proposer",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSubscribeProposer(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSubscribeProposer(ctx context.Context, params algokit.CallParams[SubscribeProposerArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SubscribeProposer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetProposerKyc calls the set_proposer_kyc ABI method and waits for confirmation.
+// SendSetProposerKyc calls the set_proposer_kyc ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets a proposer's KYC status.
 func (c *Client) SendSetProposerKyc(ctx context.Context, params algokit.CallParams[SetProposerKycArgs]) error {
-	methodArgs := argsToInterfaceSetProposerKyc(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "set_proposer_kyc",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetProposerKyc(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetProposerKyc(ctx context.Context, params algokit.CallParams[SetProposerKycArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetProposerKyc(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeclareCommittee calls the declare_committee ABI method and waits for confirmation.
+// SendDeclareCommittee calls the declare_committee ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Sets the xGov Committee in charge.
 func (c *Client) SendDeclareCommittee(ctx context.Context, params algokit.CallParams[DeclareCommitteeArgs]) error {
-	methodArgs := argsToInterfaceDeclareCommittee(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "declare_committee",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeclareCommittee(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeclareCommittee(ctx context.Context, params algokit.CallParams[DeclareCommitteeArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeclareCommittee(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendOpenProposal calls the open_proposal ABI method and waits for confirmation.
+// SendOpenProposal calls the open_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Creates a new Proposal.
 func (c *Client) SendOpenProposal(ctx context.Context, params algokit.CallParams[OpenProposalArgs]) (*OpenProposalMethodResult, error) {
-	methodArgs := argsToInterfaceOpenProposal(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendOpenProposal(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "open_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendOpenProposal(ctx context.Context, params algokit.CallParams[OpenProposalArgs]) (*OpenProposalMethodResult, error) {
+	comp, err := c.NewGroup().OpenProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*OpenProposalMethodResult)
+	return typedResult, nil
+}
 
+// decodeOpenProposalResult decodes the return value of a open_proposal call.
+func (c *Client) decodeOpenProposalResult(result *algokit.SendAppTransactionResult) (*OpenProposalMethodResult, error) {
 	typedResult := &OpenProposalMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -792,225 +1211,234 @@ func (c *Client) SendOpenProposal(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendVoteProposal calls the vote_proposal ABI method and waits for confirmation.
+// SendVoteProposal calls the vote_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Votes on a Proposal.
 func (c *Client) SendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) error {
-	methodArgs := argsToInterfaceVoteProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "vote_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendVoteProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().VoteProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnassignAbsenteeFromProposal calls the unassign_absentee_from_proposal ABI method and waits for confirmation.
+// SendUnassignAbsenteeFromProposal calls the unassign_absentee_from_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Unassign absentees from a scrutinized Proposal.
 func (c *Client) SendUnassignAbsenteeFromProposal(ctx context.Context, params algokit.CallParams[UnassignAbsenteeFromProposalArgs]) error {
-	methodArgs := argsToInterfaceUnassignAbsenteeFromProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unassign_absentee_from_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnassignAbsenteeFromProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnassignAbsenteeFromProposal(ctx context.Context, params algokit.CallParams[UnassignAbsenteeFromProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UnassignAbsenteeFromProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendPayGrantProposal calls the pay_grant_proposal ABI method and waits for confirmation.
+// SendPayGrantProposal calls the pay_grant_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Disburses the funds for an approved Proposal.
 func (c *Client) SendPayGrantProposal(ctx context.Context, params algokit.CallParams[PayGrantProposalArgs]) error {
-	methodArgs := argsToInterfacePayGrantProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "pay_grant_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPayGrantProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPayGrantProposal(ctx context.Context, params algokit.CallParams[PayGrantProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().PayGrantProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendFinalizeProposal calls the finalize_proposal ABI method and waits for confirmation.
+// SendFinalizeProposal calls the finalize_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Finalize a Proposal.
 func (c *Client) SendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) error {
-	methodArgs := argsToInterfaceFinalizeProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "finalize_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendFinalizeProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().FinalizeProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDropProposal calls the drop_proposal ABI method and waits for confirmation.
+// SendDropProposal calls the drop_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Drops a Proposal.
 func (c *Client) SendDropProposal(ctx context.Context, params algokit.CallParams[DropProposalArgs]) error {
-	methodArgs := argsToInterfaceDropProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "drop_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDropProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDropProposal(ctx context.Context, params algokit.CallParams[DropProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DropProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDepositFunds calls the deposit_funds ABI method and waits for confirmation.
+// SendDepositFunds calls the deposit_funds ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Deposits xGov program funds into the xGov Treasury (xGov Registry Account).
 func (c *Client) SendDepositFunds(ctx context.Context, params algokit.CallParams[DepositFundsArgs]) error {
-	methodArgs := argsToInterfaceDepositFunds(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deposit_funds",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDepositFunds(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDepositFunds(ctx context.Context, params algokit.CallParams[DepositFundsArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DepositFunds(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendWithdrawFunds calls the withdraw_funds ABI method and waits for confirmation.
+// SendWithdrawFunds calls the withdraw_funds ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Remove xGov program funds from the xGov Treasury (xGov Registry Account).
 func (c *Client) SendWithdrawFunds(ctx context.Context, params algokit.CallParams[WithdrawFundsArgs]) error {
-	methodArgs := argsToInterfaceWithdrawFunds(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "withdraw_funds",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendWithdrawFunds(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendWithdrawFunds(ctx context.Context, params algokit.CallParams[WithdrawFundsArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().WithdrawFunds(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendWithdrawBalance calls the withdraw_balance ABI method and waits for confirmation.
+// SendWithdrawBalance calls the withdraw_balance ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Withdraw outstanding Algos, excluding MBR and outstanding funds, from the xGov Registry.
-func (c *Client) SendWithdrawBalance(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "withdraw_balance",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendWithdrawBalance(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendWithdrawBalance(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendWithdrawBalance(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().WithdrawBalance(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGetState calls the get_state ABI method and waits for confirmation.
+// SendGetState calls the get_state ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Returns the xGov Registry state.
-func (c *Client) SendGetState(ctx context.Context) (*GetStateMethodResult, error) {
-	methodArgs := []interface{}(nil)
+func (c *Client) SendGetState(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*GetStateMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetState(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "get_state",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendGetState(ctx context.Context, params algokit.CallParams[struct{}]) (*GetStateMethodResult, error) {
+	comp, err := c.NewGroup().GetState(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetStateMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetStateResult decodes the return value of a get_state call.
+func (c *Client) decodeGetStateResult(result *algokit.SendAppTransactionResult) (*GetStateMethodResult, error) {
 	typedResult := &GetStateMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1026,190 +1454,209 @@ func (c *Client) SendGetState(ctx context.Context) (*GetStateMethodResult, error
 	return typedResult, nil
 }
 
-// SendGetXgovBox calls the get_xgov_box ABI method and waits for confirmation.
+// SendGetXgovBox calls the get_xgov_box ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Returns the xGov box for the given address.
 func (c *Client) SendGetXgovBox(ctx context.Context, params algokit.CallParams[GetXgovBoxArgs]) (*GetXgovBoxMethodResult, error) {
-	methodArgs := argsToInterfaceGetXgovBox(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetXgovBox(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "get_xgov_box",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetXgovBox(ctx context.Context, params algokit.CallParams[GetXgovBoxArgs]) (*GetXgovBoxMethodResult, error) {
+	comp, err := c.NewGroup().GetXgovBox(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetXgovBoxMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetXgovBoxResult decodes the return value of a get_xgov_box call.
+func (c *Client) decodeGetXgovBoxResult(result *algokit.SendAppTransactionResult) (*GetXgovBoxMethodResult, error) {
 	typedResult := &GetXgovBoxMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetProposerBox calls the get_proposer_box ABI method and waits for confirmation.
+// SendGetProposerBox calls the get_proposer_box ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Returns the Proposer box for the given address.
 func (c *Client) SendGetProposerBox(ctx context.Context, params algokit.CallParams[GetProposerBoxArgs]) (*GetProposerBoxMethodResult, error) {
-	methodArgs := argsToInterfaceGetProposerBox(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetProposerBox(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "get_proposer_box",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetProposerBox(ctx context.Context, params algokit.CallParams[GetProposerBoxArgs]) (*GetProposerBoxMethodResult, error) {
+	comp, err := c.NewGroup().GetProposerBox(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetProposerBoxMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetProposerBoxResult decodes the return value of a get_proposer_box call.
+func (c *Client) decodeGetProposerBoxResult(result *algokit.SendAppTransactionResult) (*GetProposerBoxMethodResult, error) {
 	typedResult := &GetProposerBoxMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetRequestBox calls the get_request_box ABI method and waits for confirmation.
+// SendGetRequestBox calls the get_request_box ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Returns the xGov subscribe request box for the given request ID.
 func (c *Client) SendGetRequestBox(ctx context.Context, params algokit.CallParams[GetRequestBoxArgs]) (*GetRequestBoxMethodResult, error) {
-	methodArgs := argsToInterfaceGetRequestBox(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetRequestBox(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "get_request_box",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetRequestBox(ctx context.Context, params algokit.CallParams[GetRequestBoxArgs]) (*GetRequestBoxMethodResult, error) {
+	comp, err := c.NewGroup().GetRequestBox(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetRequestBoxMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetRequestBoxResult decodes the return value of a get_request_box call.
+func (c *Client) decodeGetRequestBoxResult(result *algokit.SendAppTransactionResult) (*GetRequestBoxMethodResult, error) {
 	typedResult := &GetRequestBoxMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetRequestUnsubscribeBox calls the get_request_unsubscribe_box ABI method and waits for confirmation.
+// SendGetRequestUnsubscribeBox calls the get_request_unsubscribe_box ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Returns the xGov unsubscribe request box for the given unsubscribe request ID.
 func (c *Client) SendGetRequestUnsubscribeBox(ctx context.Context, params algokit.CallParams[GetRequestUnsubscribeBoxArgs]) (*GetRequestUnsubscribeBoxMethodResult, error) {
-	methodArgs := argsToInterfaceGetRequestUnsubscribeBox(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetRequestUnsubscribeBox(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "get_request_unsubscribe_box",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetRequestUnsubscribeBox(ctx context.Context, params algokit.CallParams[GetRequestUnsubscribeBoxArgs]) (*GetRequestUnsubscribeBoxMethodResult, error) {
+	comp, err := c.NewGroup().GetRequestUnsubscribeBox(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetRequestUnsubscribeBoxMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetRequestUnsubscribeBoxResult decodes the return value of a get_request_unsubscribe_box call.
+func (c *Client) decodeGetRequestUnsubscribeBoxResult(result *algokit.SendAppTransactionResult) (*GetRequestUnsubscribeBoxMethodResult, error) {
 	typedResult := &GetRequestUnsubscribeBoxMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendIsProposal calls the is_proposal ABI method and waits for confirmation.
+// SendIsProposal calls the is_proposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendIsProposal(ctx context.Context, params algokit.CallParams[IsProposalArgs]) error {
-	methodArgs := argsToInterfaceIsProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "is_proposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendIsProposal(ctx, params)
+	return err
 }
 
-// SendOpUp calls the op_up ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendIsProposal(ctx context.Context, params algokit.CallParams[IsProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().IsProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "op_up",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the op_up ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
 func argsToInterfaceInitProposalContract(args InitProposalContractArgs) []interface{} {
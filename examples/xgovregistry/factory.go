@@ -3,13 +3,21 @@ package xgovregistry
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // Factory is a typed factory for deploying XGovRegistry smart contracts.
 type Factory struct {
 	AppFactory *algokit.AppFactory
+
+	// Indexer finds existing deployments for GetByCreatorAndName.
+	Indexer AppLookupIndexer
+
+	params  algokit.AppFactoryParams // defaults for the clients and calls made by Deploy
+	lookups lookupCache
 }
 
 // NewFactory creates a new typed factory.
@@ -28,28 +36,73 @@ func NewFactory(params algokit.AppFactoryParams) (*Factory, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Factory{AppFactory: factory}, nil
+	return &Factory{AppFactory: factory, params: params}, nil
+}
+
+// newClient wraps an AppClient created by the factory, sharing the factory's
+// Algorand client, default sender and signer.
+func (f *Factory) newClient(appClient *algokit.AppClient) *Client {
+	client := NewClient(appClient)
+	client.params = algokit.AppClientParams{
+		AppID:         appClient.AppID(),
+		Algorand:      f.params.Algorand,
+		AppName:       f.params.AppName,
+		DefaultSender: f.params.DefaultSender,
+		DefaultSigner: f.params.DefaultSigner,
+	}
+	return client
 }
 
 // DeployResult holds the result of a deployment.
 type DeployResult struct {
 	Client *Client
-	Result *algokit.SendAppTransactionResult
+	Result *algokit.SendAppTransactionResult // the create or update call; nil for DeployActionNone
+	Action DeployAction
+
+	// DeleteResult is the delete call of the previous app for DeployActionReplace
+	DeleteResult *algokit.SendAppTransactionResult
 }
 
 // Create deploys a new instance of the XGovRegistry contract using the create method.
 func (f *Factory) Create(ctx context.Context, params algokit.AppFactoryCreateParams) (*Client, *algokit.SendAppTransactionResult, error) {
-	params.MethodName = "create"
-	client, result, err := f.AppFactory.Create(ctx, params)
+	return f.CreateCreate(ctx, params)
+}
+
+// CreateCreate deploys a new instance of the XGovRegistry contract using the create create method.
+func (f *Factory) CreateCreate(ctx context.Context, params algokit.AppFactoryCreateParams) (*Client, *algokit.SendAppTransactionResult, error) {
+	return f.createCreate(ctx, params, nil)
+}
+
+// createCreate is CreateCreate with the programs compiled with templateParams.
+func (f *Factory) createCreate(ctx context.Context, params algokit.AppFactoryCreateParams, templateParams map[string]interface{}) (*Client, *algokit.SendAppTransactionResult, error) {
+	onComplete, err := createOnComplete(params.OnComplete, types.NoOpOC)
 	if err != nil {
 		return nil, nil, err
 	}
-	typedClient := NewClient(client)
-	return typedClient, result, nil
+	params.MethodName = "create"
+	params.OnComplete = onComplete
+	if len(templateParams) == 0 {
+		client, result, err := f.AppFactory.Create(ctx, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		typedClient := f.newClient(client)
+		return typedClient, result, nil
+	}
+	return f.sendCreate(ctx, templateParams, "create()void", nil, params)
 }
 
-// Deploy performs an idempotent deployment of the XGovRegistry contract.
-func (f *Factory) Deploy(ctx context.Context, params algokit.DeployParams) (*algokit.DeployResult, error) {
-	deployer := algokit.NewAppDeployer(f.AppFactory.Algod(), nil)
-	return deployer.Deploy(ctx, f.AppFactory, params)
+// createOnComplete checks onComplete against the on-completes allowed for a
+// create call. The zero value, NoOp, selects the first allowed on-complete,
+// so create calls that must opt in need no OnComplete.
+func createOnComplete(onComplete types.OnCompletion, allowed ...types.OnCompletion) (types.OnCompletion, error) {
+	for _, a := range allowed {
+		if a == onComplete {
+			return onComplete, nil
+		}
+	}
+	if onComplete == types.NoOpOC && len(allowed) > 0 {
+		return allowed[0], nil
+	}
+	return onComplete, fmt.Errorf("on-complete %v is not allowed for this create call", onComplete)
 }
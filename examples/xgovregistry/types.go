@@ -7,6 +7,13 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ProposerBoxValue is a generated struct type.
+type ProposerBoxValue struct {
+	ActiveProposal bool   `json:"active_proposal"`
+	KycStatus      bool   `json:"kyc_status"`
+	KycExpiring    uint64 `json:"kyc_expiring"`
+}
+
 // TypedGlobalState is a generated struct type.
 type TypedGlobalState struct {
 	PausedRegistry        bool          `json:"paused_registry"`
@@ -73,237 +80,230 @@ type XGovSubscribeRequestBoxValue struct {
 	RelationType uint64        `json:"relation_type"`
 }
 
-// ProposerBoxValue is a generated struct type.
-type ProposerBoxValue struct {
-	ActiveProposal bool   `json:"active_proposal"`
-	KycStatus      bool   `json:"kyc_status"`
-	KycExpiring    uint64 `json:"kyc_expiring"`
-}
-
 // InitProposalContractArgs holds the arguments for the init_proposal_contract method.
 type InitProposalContractArgs struct {
-	Size uint64
+	Size uint64 `json:"size"`
 }
 
 // LoadProposalContractArgs holds the arguments for the load_proposal_contract method.
 type LoadProposalContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // SetXgovManagerArgs holds the arguments for the set_xgov_manager method.
 type SetXgovManagerArgs struct {
-	Manager types.Address
+	Manager types.Address `json:"manager"`
 }
 
 // SetPayorArgs holds the arguments for the set_payor method.
 type SetPayorArgs struct {
-	Payor types.Address
+	Payor types.Address `json:"payor"`
 }
 
 // SetXgovCouncilArgs holds the arguments for the set_xgov_council method.
 type SetXgovCouncilArgs struct {
-	Council types.Address
+	Council types.Address `json:"council"`
 }
 
 // SetXgovSubscriberArgs holds the arguments for the set_xgov_subscriber method.
 type SetXgovSubscriberArgs struct {
-	Subscriber types.Address
+	Subscriber types.Address `json:"subscriber"`
 }
 
 // SetKycProviderArgs holds the arguments for the set_kyc_provider method.
 type SetKycProviderArgs struct {
-	Provider types.Address
+	Provider types.Address `json:"provider"`
 }
 
 // SetCommitteeManagerArgs holds the arguments for the set_committee_manager method.
 type SetCommitteeManagerArgs struct {
-	Manager types.Address
+	Manager types.Address `json:"manager"`
 }
 
 // SetXgovDaemonArgs holds the arguments for the set_xgov_daemon method.
 type SetXgovDaemonArgs struct {
-	XgovDaemon types.Address
+	XgovDaemon types.Address `json:"xgov_daemon"`
 }
 
 // ConfigXgovRegistryArgs holds the arguments for the config_xgov_registry method.
 type ConfigXgovRegistryArgs struct {
-	Config XGovRegistryConfig
+	Config XGovRegistryConfig `json:"config"`
 }
 
 // SubscribeXgovArgs holds the arguments for the subscribe_xgov method.
 type SubscribeXgovArgs struct {
-	VotingAddress types.Address
-	Payment       transaction.TransactionWithSigner
+	VotingAddress types.Address                     `json:"voting_address"`
+	Payment       transaction.TransactionWithSigner `json:"-"`
 }
 
 // UnsubscribeAbsenteeArgs holds the arguments for the unsubscribe_absentee method.
 type UnsubscribeAbsenteeArgs struct {
-	XgovAddress types.Address
+	XgovAddress types.Address `json:"xgov_address"`
 }
 
 // RequestSubscribeXgovArgs holds the arguments for the request_subscribe_xgov method.
 type RequestSubscribeXgovArgs struct {
-	XgovAddress  types.Address
-	OwnerAddress types.Address
-	RelationType uint64
-	Payment      transaction.TransactionWithSigner
+	XgovAddress  types.Address                     `json:"xgov_address"`
+	OwnerAddress types.Address                     `json:"owner_address"`
+	RelationType uint64                            `json:"relation_type"`
+	Payment      transaction.TransactionWithSigner `json:"-"`
 }
 
 // ApproveSubscribeXgovArgs holds the arguments for the approve_subscribe_xgov method.
 type ApproveSubscribeXgovArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // RejectSubscribeXgovArgs holds the arguments for the reject_subscribe_xgov method.
 type RejectSubscribeXgovArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // RequestUnsubscribeXgovArgs holds the arguments for the request_unsubscribe_xgov method.
 type RequestUnsubscribeXgovArgs struct {
-	XgovAddress  types.Address
-	OwnerAddress types.Address
-	RelationType uint64
-	Payment      transaction.TransactionWithSigner
+	XgovAddress  types.Address                     `json:"xgov_address"`
+	OwnerAddress types.Address                     `json:"owner_address"`
+	RelationType uint64                            `json:"relation_type"`
+	Payment      transaction.TransactionWithSigner `json:"-"`
 }
 
 // ApproveUnsubscribeXgovArgs holds the arguments for the approve_unsubscribe_xgov method.
 type ApproveUnsubscribeXgovArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // RejectUnsubscribeXgovArgs holds the arguments for the reject_unsubscribe_xgov method.
 type RejectUnsubscribeXgovArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // SetVotingAccountArgs holds the arguments for the set_voting_account method.
 type SetVotingAccountArgs struct {
-	XgovAddress   types.Address
-	VotingAddress types.Address
+	XgovAddress   types.Address `json:"xgov_address"`
+	VotingAddress types.Address `json:"voting_address"`
 }
 
 // SubscribeProposerArgs holds the arguments for the subscribe_proposer method.
 type SubscribeProposerArgs struct {
-	Payment transaction.TransactionWithSigner
+	Payment transaction.TransactionWithSigner `json:"-"`
 }
 
 // SetProposerKycArgs holds the arguments for the set_proposer_kyc method.
 type SetProposerKycArgs struct {
-	Proposer    types.Address
-	KycStatus   bool
-	KycExpiring uint64
+	Proposer    types.Address `json:"proposer"`
+	KycStatus   bool          `json:"kyc_status"`
+	KycExpiring uint64        `json:"kyc_expiring"`
 }
 
 // DeclareCommitteeArgs holds the arguments for the declare_committee method.
 type DeclareCommitteeArgs struct {
-	CommitteeID [32]byte
-	Size        uint64
-	Votes       uint64
+	CommitteeID [32]byte `json:"committee_id"`
+	Size        uint64   `json:"size"`
+	Votes       uint64   `json:"votes"`
 }
 
 // OpenProposalArgs holds the arguments for the open_proposal method.
 type OpenProposalArgs struct {
-	Payment transaction.TransactionWithSigner
+	Payment transaction.TransactionWithSigner `json:"-"`
 }
 
 // OpenProposalMethodResult holds the result of calling open_proposal.
 type OpenProposalMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // VoteProposalArgs holds the arguments for the vote_proposal method.
 type VoteProposalArgs struct {
-	ProposalID     uint64
-	XgovAddress    types.Address
-	ApprovalVotes  uint64
-	RejectionVotes uint64
+	ProposalID     uint64        `json:"proposal_id"`
+	XgovAddress    types.Address `json:"xgov_address"`
+	ApprovalVotes  uint64        `json:"approval_votes"`
+	RejectionVotes uint64        `json:"rejection_votes"`
 }
 
 // UnassignAbsenteeFromProposalArgs holds the arguments for the unassign_absentee_from_proposal method.
 type UnassignAbsenteeFromProposalArgs struct {
-	ProposalID uint64
-	Absentees  []types.Address
+	ProposalID uint64          `json:"proposal_id"`
+	Absentees  []types.Address `json:"absentees"`
 }
 
 // PayGrantProposalArgs holds the arguments for the pay_grant_proposal method.
 type PayGrantProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposal_id"`
 }
 
 // FinalizeProposalArgs holds the arguments for the finalize_proposal method.
 type FinalizeProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposal_id"`
 }
 
 // DropProposalArgs holds the arguments for the drop_proposal method.
 type DropProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposal_id"`
 }
 
 // DepositFundsArgs holds the arguments for the deposit_funds method.
 type DepositFundsArgs struct {
-	Payment transaction.TransactionWithSigner
+	Payment transaction.TransactionWithSigner `json:"-"`
 }
 
 // WithdrawFundsArgs holds the arguments for the withdraw_funds method.
 type WithdrawFundsArgs struct {
-	Amount uint64
+	Amount uint64 `json:"amount"`
 }
 
 // GetStateMethodResult holds the result of calling get_state.
 type GetStateMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return TypedGlobalState
+	Return TypedGlobalState `json:"return"`
 }
 
 // GetXgovBoxArgs holds the arguments for the get_xgov_box method.
 type GetXgovBoxArgs struct {
-	XgovAddress types.Address
+	XgovAddress types.Address `json:"xgov_address"`
 }
 
 // GetXgovBoxMethodResult holds the result of calling get_xgov_box.
 type GetXgovBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []interface{}
+	Return Tuple2[Tuple4[types.Address, uint64, uint64, uint64], bool] `json:"return"`
 }
 
 // GetProposerBoxArgs holds the arguments for the get_proposer_box method.
 type GetProposerBoxArgs struct {
-	ProposerAddress types.Address
+	ProposerAddress types.Address `json:"proposer_address"`
 }
 
 // GetProposerBoxMethodResult holds the result of calling get_proposer_box.
 type GetProposerBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []interface{}
+	Return Tuple2[Tuple3[bool, bool, uint64], bool] `json:"return"`
 }
 
 // GetRequestBoxArgs holds the arguments for the get_request_box method.
 type GetRequestBoxArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // GetRequestBoxMethodResult holds the result of calling get_request_box.
 type GetRequestBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []interface{}
+	Return Tuple2[Tuple3[types.Address, types.Address, uint64], bool] `json:"return"`
 }
 
 // GetRequestUnsubscribeBoxArgs holds the arguments for the get_request_unsubscribe_box method.
 type GetRequestUnsubscribeBoxArgs struct {
-	RequestID uint64
+	RequestID uint64 `json:"request_id"`
 }
 
 // GetRequestUnsubscribeBoxMethodResult holds the result of calling get_request_unsubscribe_box.
 type GetRequestUnsubscribeBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []interface{}
+	Return Tuple2[Tuple3[types.Address, types.Address, uint64], bool] `json:"return"`
 }
 
 // IsProposalArgs holds the arguments for the is_proposal method.
 type IsProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposal_id"`
 }
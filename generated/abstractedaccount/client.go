@@ -2,26 +2,368 @@
 package abstractedaccount
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendRegister calls the register ABI method.
+	SendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) error
+	// SendSetDomain calls the setDomain ABI method.
+	SendSetDomain(ctx context.Context, params algokit.CallParams[SetDomainArgs]) error
+	// SendSetRevocationApp calls the setRevocationApp ABI method.
+	SendSetRevocationApp(ctx context.Context, params algokit.CallParams[SetRevocationAppArgs]) error
+	// SendSetNickname calls the setNickname ABI method.
+	SendSetNickname(ctx context.Context, params algokit.CallParams[SetNicknameArgs]) error
+	// SendSetAvatar calls the setAvatar ABI method.
+	SendSetAvatar(ctx context.Context, params algokit.CallParams[SetAvatarArgs]) error
+	// SendSetBanner calls the setBanner ABI method.
+	SendSetBanner(ctx context.Context, params algokit.CallParams[SetBannerArgs]) error
+	// SendSetBio calls the setBio ABI method.
+	SendSetBio(ctx context.Context, params algokit.CallParams[SetBioArgs]) error
+	// SendArc58ChangeAdmin calls the arc58_changeAdmin ABI method.
+	SendArc58ChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58ChangeAdminArgs]) error
+	// SendArc58PluginChangeAdmin calls the arc58_pluginChangeAdmin ABI method.
+	SendArc58PluginChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58PluginChangeAdminArgs]) error
+	// SendArc58VerifyAuthAddress calls the arc58_verifyAuthAddress ABI method.
+	SendArc58VerifyAuthAddress(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendArc58RekeyTo calls the arc58_rekeyTo ABI method.
+	SendArc58RekeyTo(ctx context.Context, params algokit.CallParams[Arc58RekeyToArgs]) error
+	// SendArc58CanCall calls the arc58_canCall ABI method (readonly).
+	SendArc58CanCall(ctx context.Context, params algokit.CallParams[Arc58CanCallArgs]) (*Arc58CanCallMethodResult, error)
+	// SendArc58RekeyToPlugin calls the arc58_rekeyToPlugin ABI method.
+	SendArc58RekeyToPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToPluginArgs]) error
+	// SendArc58RekeyToNamedPlugin calls the arc58_rekeyToNamedPlugin ABI method.
+	SendArc58RekeyToNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToNamedPluginArgs]) error
+	// SendArc58AddPlugin calls the arc58_addPlugin ABI method.
+	SendArc58AddPlugin(ctx context.Context, params algokit.CallParams[Arc58AddPluginArgs]) error
+	// SendAssignDomain calls the assignDomain ABI method.
+	SendAssignDomain(ctx context.Context, params algokit.CallParams[AssignDomainArgs]) error
+	// SendArc58RemovePlugin calls the arc58_removePlugin ABI method.
+	SendArc58RemovePlugin(ctx context.Context, params algokit.CallParams[Arc58RemovePluginArgs]) error
+	// SendArc58AddNamedPlugin calls the arc58_addNamedPlugin ABI method.
+	SendArc58AddNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58AddNamedPluginArgs]) error
+	// SendArc58RemoveNamedPlugin calls the arc58_removeNamedPlugin ABI method.
+	SendArc58RemoveNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RemoveNamedPluginArgs]) error
+	// SendArc58NewEscrow calls the arc58_newEscrow ABI method.
+	SendArc58NewEscrow(ctx context.Context, params algokit.CallParams[Arc58NewEscrowArgs]) (*Arc58NewEscrowMethodResult, error)
+	// SendArc58ToggleEscrowLock calls the arc58_toggleEscrowLock ABI method.
+	SendArc58ToggleEscrowLock(ctx context.Context, params algokit.CallParams[Arc58ToggleEscrowLockArgs]) (*Arc58ToggleEscrowLockMethodResult, error)
+	// SendArc58Reclaim calls the arc58_reclaim ABI method.
+	SendArc58Reclaim(ctx context.Context, params algokit.CallParams[Arc58ReclaimArgs]) error
+	// SendArc58PluginReclaim calls the arc58_pluginReclaim ABI method.
+	SendArc58PluginReclaim(ctx context.Context, params algokit.CallParams[Arc58PluginReclaimArgs]) error
+	// SendArc58OptInEscrow calls the arc58_optInEscrow ABI method.
+	SendArc58OptInEscrow(ctx context.Context, params algokit.CallParams[Arc58OptInEscrowArgs]) error
+	// SendArc58PluginOptInEscrow calls the arc58_pluginOptInEscrow ABI method.
+	SendArc58PluginOptInEscrow(ctx context.Context, params algokit.CallParams[Arc58PluginOptInEscrowArgs]) error
+	// SendArc58AddAllowances calls the arc58_addAllowances ABI method.
+	SendArc58AddAllowances(ctx context.Context, params algokit.CallParams[Arc58AddAllowancesArgs]) error
+	// SendArc58RemoveAllowances calls the arc58_removeAllowances ABI method.
+	SendArc58RemoveAllowances(ctx context.Context, params algokit.CallParams[Arc58RemoveAllowancesArgs]) error
+	// SendArc58AddExecutionKey calls the arc58_addExecutionKey ABI method.
+	SendArc58AddExecutionKey(ctx context.Context, params algokit.CallParams[Arc58AddExecutionKeyArgs]) error
+	// SendArc58RemoveExecutionKey calls the arc58_removeExecutionKey ABI method.
+	SendArc58RemoveExecutionKey(ctx context.Context, params algokit.CallParams[Arc58RemoveExecutionKeyArgs]) error
+	// SendArc58GetAdmin calls the arc58_getAdmin ABI method (readonly).
+	SendArc58GetAdmin(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Arc58GetAdminMethodResult, error)
+	// SendArc58GetPlugins calls the arc58_getPlugins ABI method (readonly).
+	SendArc58GetPlugins(ctx context.Context, params algokit.CallParams[Arc58GetPluginsArgs]) (*Arc58GetPluginsMethodResult, error)
+	// SendArc58GetNamedPlugins calls the arc58_getNamedPlugins ABI method (readonly).
+	SendArc58GetNamedPlugins(ctx context.Context, params algokit.CallParams[Arc58GetNamedPluginsArgs]) (*Arc58GetNamedPluginsMethodResult, error)
+	// SendArc58GetEscrows calls the arc58_getEscrows ABI method (readonly).
+	SendArc58GetEscrows(ctx context.Context, params algokit.CallParams[Arc58GetEscrowsArgs]) (*Arc58GetEscrowsMethodResult, error)
+	// SendArc58GetAllowances calls the arc58_getAllowances ABI method (readonly).
+	SendArc58GetAllowances(ctx context.Context, params algokit.CallParams[Arc58GetAllowancesArgs]) (*Arc58GetAllowancesMethodResult, error)
+	// SendArc58GetExecutions calls the arc58_getExecutions ABI method (readonly).
+	SendArc58GetExecutions(ctx context.Context, params algokit.CallParams[Arc58GetExecutionsArgs]) (*Arc58GetExecutionsMethodResult, error)
+	// SendArc58GetDomainKeys calls the arc58_getDomainKeys ABI method (readonly).
+	SendArc58GetDomainKeys(ctx context.Context, params algokit.CallParams[Arc58GetDomainKeysArgs]) (*Arc58GetDomainKeysMethodResult, error)
+	// SendMBR calls the mbr ABI method (readonly).
+	SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error)
+	// SendBalance calls the balance ABI method (readonly).
+	SendBalance(ctx context.Context, params algokit.CallParams[BalanceArgs]) (*BalanceMethodResult, error)
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapAllowances reads the value for key in the allowances box map.
+	GetBoxMapAllowances(ctx context.Context, key AllowanceKey) (AllowanceInfo, error)
+	// GetBoxMapDomainKeys reads the value for key in the domainKeys box map.
+	GetBoxMapDomainKeys(ctx context.Context, key types.Address) (string, error)
+	// GetBoxMapEscrows reads the value for key in the escrows box map.
+	GetBoxMapEscrows(ctx context.Context, key string) (EscrowInfo, error)
+	// GetBoxMapExecutions reads the value for key in the executions box map.
+	GetBoxMapExecutions(ctx context.Context, key []byte) (ExecutionInfo, error)
+	// GetBoxMapNamedPlugins reads the value for key in the namedPlugins box map.
+	GetBoxMapNamedPlugins(ctx context.Context, key string) (PluginKey, error)
+	// GetBoxMapPlugins reads the value for key in the plugins box map.
+	GetBoxMapPlugins(ctx context.Context, key PluginKey) (PluginInfo, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the AbstractedAccount smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +375,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("AbstractedAccount has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,334 +424,363 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match AbstractedAccount")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendRegister calls the register ABI method and waits for confirmation.
+// SendRegister calls the register ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Register the abstracted account with the escrow factory.
 // This allows apps to correlate the account with the app without needing
 // it to be explicitly provided.
 func (c *Client) SendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) error {
-	methodArgs := argsToInterfaceRegister(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "register",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRegister(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Register(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetDomain calls the setDomain ABI method and waits for confirmation.
+// SendSetDomain calls the setDomain ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Set the domain associated with the admin account
 func (c *Client) SendSetDomain(ctx context.Context, params algokit.CallParams[SetDomainArgs]) error {
-	methodArgs := argsToInterfaceSetDomain(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setDomain",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetDomain(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetDomain(ctx context.Context, params algokit.CallParams[SetDomainArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetDomain(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetRevocationApp calls the setRevocationApp ABI method and waits for confirmation.
+// SendSetRevocationApp calls the setRevocationApp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Changes the revocation app associated with the contract
 func (c *Client) SendSetRevocationApp(ctx context.Context, params algokit.CallParams[SetRevocationAppArgs]) error {
-	methodArgs := argsToInterfaceSetRevocationApp(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setRevocationApp",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetRevocationApp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetRevocationApp(ctx context.Context, params algokit.CallParams[SetRevocationAppArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetRevocationApp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetNickname calls the setNickname ABI method and waits for confirmation.
+// SendSetNickname calls the setNickname ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Changes the nickname of the wallet
 func (c *Client) SendSetNickname(ctx context.Context, params algokit.CallParams[SetNicknameArgs]) error {
-	methodArgs := argsToInterfaceSetNickname(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setNickname",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetNickname(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetNickname(ctx context.Context, params algokit.CallParams[SetNicknameArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetNickname(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetAvatar calls the setAvatar ABI method and waits for confirmation.
+// SendSetAvatar calls the setAvatar ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Changes the avatar of the wallet
 func (c *Client) SendSetAvatar(ctx context.Context, params algokit.CallParams[SetAvatarArgs]) error {
-	methodArgs := argsToInterfaceSetAvatar(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setAvatar",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetAvatar(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetAvatar(ctx context.Context, params algokit.CallParams[SetAvatarArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetAvatar(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetBanner calls the setBanner ABI method and waits for confirmation.
+// SendSetBanner calls the setBanner ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Changes the banner of the wallet
 func (c *Client) SendSetBanner(ctx context.Context, params algokit.CallParams[SetBannerArgs]) error {
-	methodArgs := argsToInterfaceSetBanner(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setBanner",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetBanner(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetBanner(ctx context.Context, params algokit.CallParams[SetBannerArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetBanner(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetBio calls the setBio ABI method and waits for confirmation.
+// SendSetBio calls the setBio ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Changes the bio of the wallet
 func (c *Client) SendSetBio(ctx context.Context, params algokit.CallParams[SetBioArgs]) error {
-	methodArgs := argsToInterfaceSetBio(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setBio",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetBio(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetBio(ctx context.Context, params algokit.CallParams[SetBioArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetBio(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58ChangeAdmin calls the arc58_changeAdmin ABI method and waits for confirmation.
+// SendArc58ChangeAdmin calls the arc58_changeAdmin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Attempt to change the admin for this app. Some implementations MAY not support this.
 func (c *Client) SendArc58ChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58ChangeAdminArgs]) error {
-	methodArgs := argsToInterfaceArc58ChangeAdmin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_changeAdmin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58ChangeAdmin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58ChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58ChangeAdminArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58ChangeAdmin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58PluginChangeAdmin calls the arc58_pluginChangeAdmin ABI method and waits for confirmation.
+// SendArc58PluginChangeAdmin calls the arc58_pluginChangeAdmin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Attempt to change the admin via plugin.
 func (c *Client) SendArc58PluginChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58PluginChangeAdminArgs]) error {
-	methodArgs := argsToInterfaceArc58PluginChangeAdmin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_pluginChangeAdmin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58PluginChangeAdmin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58PluginChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58PluginChangeAdminArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58PluginChangeAdmin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58VerifyAuthAddress calls the arc58_verifyAuthAddress ABI method and waits for confirmation.
+// SendArc58VerifyAuthAddress calls the arc58_verifyAuthAddress ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Verify the abstracted account is rekeyed to this app
-func (c *Client) SendArc58VerifyAuthAddress(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "arc58_verifyAuthAddress",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendArc58VerifyAuthAddress(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58VerifyAuthAddress(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58VerifyAuthAddress(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58VerifyAuthAddress(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RekeyTo calls the arc58_rekeyTo ABI method and waits for confirmation.
+// SendArc58RekeyTo calls the arc58_rekeyTo ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Rekey the abstracted account to another address. Primarily useful for rekeying to an EOA.
 func (c *Client) SendArc58RekeyTo(ctx context.Context, params algokit.CallParams[Arc58RekeyToArgs]) error {
-	methodArgs := argsToInterfaceArc58RekeyTo(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_rekeyTo",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RekeyTo(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RekeyTo(ctx context.Context, params algokit.CallParams[Arc58RekeyToArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RekeyTo(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58CanCall calls the arc58_canCall ABI method and waits for confirmation.
+// SendArc58CanCall calls the arc58_canCall ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Check whether the plugin can be used
 func (c *Client) SendArc58CanCall(ctx context.Context, params algokit.CallParams[Arc58CanCallArgs]) (*Arc58CanCallMethodResult, error) {
-	methodArgs := argsToInterfaceArc58CanCall(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58CanCall(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_canCall",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58CanCall(ctx context.Context, params algokit.CallParams[Arc58CanCallArgs]) (*Arc58CanCallMethodResult, error) {
+	comp, err := c.NewGroup().Arc58CanCall(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*Arc58CanCallMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58CanCallResult decodes the return value of a arc58_canCall call.
+func (c *Client) decodeArc58CanCallResult(result *algokit.SendAppTransactionResult) (*Arc58CanCallMethodResult, error) {
 	typedResult := &Arc58CanCallMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -384,218 +794,207 @@ func (c *Client) SendArc58CanCall(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendArc58RekeyToPlugin calls the arc58_rekeyToPlugin ABI method and waits for confirmation.
+// SendArc58RekeyToPlugin calls the arc58_rekeyToPlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Temporarily rekey to an approved plugin app address
 func (c *Client) SendArc58RekeyToPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToPluginArgs]) error {
-	methodArgs := argsToInterfaceArc58RekeyToPlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_rekeyToPlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RekeyToPlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RekeyToPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToPluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RekeyToPlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RekeyToNamedPlugin calls the arc58_rekeyToNamedPlugin ABI method and waits for confirmation.
+// SendArc58RekeyToNamedPlugin calls the arc58_rekeyToNamedPlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Temporarily rekey to a named plugin app address
 func (c *Client) SendArc58RekeyToNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToNamedPluginArgs]) error {
-	methodArgs := argsToInterfaceArc58RekeyToNamedPlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_rekeyToNamedPlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RekeyToNamedPlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RekeyToNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToNamedPluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RekeyToNamedPlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58AddPlugin calls the arc58_addPlugin ABI method and waits for confirmation.
+// SendArc58AddPlugin calls the arc58_addPlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Add an app to the list of approved plugins
 func (c *Client) SendArc58AddPlugin(ctx context.Context, params algokit.CallParams[Arc58AddPluginArgs]) error {
-	methodArgs := argsToInterfaceArc58AddPlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_addPlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58AddPlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58AddPlugin(ctx context.Context, params algokit.CallParams[Arc58AddPluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58AddPlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendAssignDomain calls the assignDomain ABI method and waits for confirmation.
+// SendAssignDomain calls the assignDomain ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Assign a domain to a passkey
 func (c *Client) SendAssignDomain(ctx context.Context, params algokit.CallParams[AssignDomainArgs]) error {
-	methodArgs := argsToInterfaceAssignDomain(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "assignDomain",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAssignDomain(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAssignDomain(ctx context.Context, params algokit.CallParams[AssignDomainArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AssignDomain(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RemovePlugin calls the arc58_removePlugin ABI method and waits for confirmation.
+// SendArc58RemovePlugin calls the arc58_removePlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Remove an app from the list of approved plugins
 func (c *Client) SendArc58RemovePlugin(ctx context.Context, params algokit.CallParams[Arc58RemovePluginArgs]) error {
-	methodArgs := argsToInterfaceArc58RemovePlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_removePlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RemovePlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RemovePlugin(ctx context.Context, params algokit.CallParams[Arc58RemovePluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RemovePlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58AddNamedPlugin calls the arc58_addNamedPlugin ABI method and waits for confirmation.
+// SendArc58AddNamedPlugin calls the arc58_addNamedPlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Add a named plugin
 func (c *Client) SendArc58AddNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58AddNamedPluginArgs]) error {
-	methodArgs := argsToInterfaceArc58AddNamedPlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_addNamedPlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58AddNamedPlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58AddNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58AddNamedPluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58AddNamedPlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RemoveNamedPlugin calls the arc58_removeNamedPlugin ABI method and waits for confirmation.
+// SendArc58RemoveNamedPlugin calls the arc58_removeNamedPlugin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Remove a named plugin
 func (c *Client) SendArc58RemoveNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RemoveNamedPluginArgs]) error {
-	methodArgs := argsToInterfaceArc58RemoveNamedPlugin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_removeNamedPlugin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RemoveNamedPlugin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RemoveNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RemoveNamedPluginArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RemoveNamedPlugin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58NewEscrow calls the arc58_newEscrow ABI method and waits for confirmation.
+// SendArc58NewEscrow calls the arc58_newEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Create a new escrow for the controlled address
 func (c *Client) SendArc58NewEscrow(ctx context.Context, params algokit.CallParams[Arc58NewEscrowArgs]) (*Arc58NewEscrowMethodResult, error) {
-	methodArgs := argsToInterfaceArc58NewEscrow(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58NewEscrow(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_newEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58NewEscrow(ctx context.Context, params algokit.CallParams[Arc58NewEscrowArgs]) (*Arc58NewEscrowMethodResult, error) {
+	comp, err := c.NewGroup().Arc58NewEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*Arc58NewEscrowMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58NewEscrowResult decodes the return value of a arc58_newEscrow call.
+func (c *Client) decodeArc58NewEscrowResult(result *algokit.SendAppTransactionResult) (*Arc58NewEscrowMethodResult, error) {
 	typedResult := &Arc58NewEscrowMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -609,29 +1008,32 @@ func (c *Client) SendArc58NewEscrow(ctx context.Context, params algokit.CallPara
 	return typedResult, nil
 }
 
-// SendArc58ToggleEscrowLock calls the arc58_toggleEscrowLock ABI method and waits for confirmation.
+// SendArc58ToggleEscrowLock calls the arc58_toggleEscrowLock ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Lock or Unlock an escrow account
 func (c *Client) SendArc58ToggleEscrowLock(ctx context.Context, params algokit.CallParams[Arc58ToggleEscrowLockArgs]) (*Arc58ToggleEscrowLockMethodResult, error) {
-	methodArgs := argsToInterfaceArc58ToggleEscrowLock(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58ToggleEscrowLock(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_toggleEscrowLock",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58ToggleEscrowLock(ctx context.Context, params algokit.CallParams[Arc58ToggleEscrowLockArgs]) (*Arc58ToggleEscrowLockMethodResult, error) {
+	comp, err := c.NewGroup().Arc58ToggleEscrowLock(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*Arc58ToggleEscrowLockMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58ToggleEscrowLockResult decodes the return value of a arc58_toggleEscrowLock call.
+func (c *Client) decodeArc58ToggleEscrowLockResult(result *algokit.SendAppTransactionResult) (*Arc58ToggleEscrowLockMethodResult, error) {
 	typedResult := &Arc58ToggleEscrowLockMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -647,237 +1049,235 @@ func (c *Client) SendArc58ToggleEscrowLock(ctx context.Context, params algokit.C
 	return typedResult, nil
 }
 
-// SendArc58Reclaim calls the arc58_reclaim ABI method and waits for confirmation.
+// SendArc58Reclaim calls the arc58_reclaim ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Transfer funds from an escrow back to the controlled address.
 func (c *Client) SendArc58Reclaim(ctx context.Context, params algokit.CallParams[Arc58ReclaimArgs]) error {
-	methodArgs := argsToInterfaceArc58Reclaim(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_reclaim",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58Reclaim(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58Reclaim(ctx context.Context, params algokit.CallParams[Arc58ReclaimArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58Reclaim(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58PluginReclaim calls the arc58_pluginReclaim ABI method and waits for confirmation.
+// SendArc58PluginReclaim calls the arc58_pluginReclaim ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Transfer funds from an escrow back to the controlled address via a plugin / allowed caller.
 // The plugin must have canReclaim set to true. CloseOut on asset transfers is blocked when the escrow is locked.
 func (c *Client) SendArc58PluginReclaim(ctx context.Context, params algokit.CallParams[Arc58PluginReclaimArgs]) error {
-	methodArgs := argsToInterfaceArc58PluginReclaim(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_pluginReclaim",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58PluginReclaim(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58PluginReclaim(ctx context.Context, params algokit.CallParams[Arc58PluginReclaimArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58PluginReclaim(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58OptInEscrow calls the arc58_optInEscrow ABI method and waits for confirmation.
+// SendArc58OptInEscrow calls the arc58_optInEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Opt-in an escrow account to assets
 func (c *Client) SendArc58OptInEscrow(ctx context.Context, params algokit.CallParams[Arc58OptInEscrowArgs]) error {
-	methodArgs := argsToInterfaceArc58OptInEscrow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_optInEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58OptInEscrow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58OptInEscrow(ctx context.Context, params algokit.CallParams[Arc58OptInEscrowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58OptInEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58PluginOptInEscrow calls the arc58_pluginOptInEscrow ABI method and waits for confirmation.
+// SendArc58PluginOptInEscrow calls the arc58_pluginOptInEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Opt-in an escrow account to assets via a plugin / allowed caller
 func (c *Client) SendArc58PluginOptInEscrow(ctx context.Context, params algokit.CallParams[Arc58PluginOptInEscrowArgs]) error {
-	methodArgs := argsToInterfaceArc58PluginOptInEscrow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_pluginOptInEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58PluginOptInEscrow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58PluginOptInEscrow(ctx context.Context, params algokit.CallParams[Arc58PluginOptInEscrowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58PluginOptInEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58AddAllowances calls the arc58_addAllowances ABI method and waits for confirmation.
+// SendArc58AddAllowances calls the arc58_addAllowances ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Add an allowance for an escrow account
 func (c *Client) SendArc58AddAllowances(ctx context.Context, params algokit.CallParams[Arc58AddAllowancesArgs]) error {
-	methodArgs := argsToInterfaceArc58AddAllowances(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_addAllowances",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58AddAllowances(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58AddAllowances(ctx context.Context, params algokit.CallParams[Arc58AddAllowancesArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58AddAllowances(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RemoveAllowances calls the arc58_removeAllowances ABI method and waits for confirmation.
+// SendArc58RemoveAllowances calls the arc58_removeAllowances ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Remove an allowances for an escrow account
 func (c *Client) SendArc58RemoveAllowances(ctx context.Context, params algokit.CallParams[Arc58RemoveAllowancesArgs]) error {
-	methodArgs := argsToInterfaceArc58RemoveAllowances(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_removeAllowances",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RemoveAllowances(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RemoveAllowances(ctx context.Context, params algokit.CallParams[Arc58RemoveAllowancesArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RemoveAllowances(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58AddExecutionKey calls the arc58_addExecutionKey ABI method and waits for confirmation.
+// SendArc58AddExecutionKey calls the arc58_addExecutionKey ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Add or extend an execution key for pre-authorized plugin usage
 func (c *Client) SendArc58AddExecutionKey(ctx context.Context, params algokit.CallParams[Arc58AddExecutionKeyArgs]) error {
-	methodArgs := argsToInterfaceArc58AddExecutionKey(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_addExecutionKey",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58AddExecutionKey(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58AddExecutionKey(ctx context.Context, params algokit.CallParams[Arc58AddExecutionKeyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58AddExecutionKey(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58RemoveExecutionKey calls the arc58_removeExecutionKey ABI method and waits for confirmation.
+// SendArc58RemoveExecutionKey calls the arc58_removeExecutionKey ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Remove an execution key. Can be called by admin at any time, or by anyone after the key has expired.
 func (c *Client) SendArc58RemoveExecutionKey(ctx context.Context, params algokit.CallParams[Arc58RemoveExecutionKeyArgs]) error {
-	methodArgs := argsToInterfaceArc58RemoveExecutionKey(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_removeExecutionKey",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendArc58RemoveExecutionKey(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendArc58RemoveExecutionKey(ctx context.Context, params algokit.CallParams[Arc58RemoveExecutionKeyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Arc58RemoveExecutionKey(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendArc58GetAdmin calls the arc58_getAdmin ABI method and waits for confirmation.
+// SendArc58GetAdmin calls the arc58_getAdmin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get the admin of this app. This method SHOULD always be used rather than reading directly from state
 // because different implementations may have different ways of determining the admin.
-func (c *Client) SendArc58GetAdmin(ctx context.Context) (*Arc58GetAdminMethodResult, error) {
-	methodArgs := []interface{}(nil)
+func (c *Client) SendArc58GetAdmin(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Arc58GetAdminMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetAdmin(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "arc58_getAdmin",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendArc58GetAdmin(ctx context.Context, params algokit.CallParams[struct{}]) (*Arc58GetAdminMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetAdmin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*Arc58GetAdminMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetAdminResult decodes the return value of a arc58_getAdmin call.
+func (c *Client) decodeArc58GetAdminResult(result *algokit.SendAppTransactionResult) (*Arc58GetAdminMethodResult, error) {
 	typedResult := &Arc58GetAdminMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -891,209 +1291,227 @@ func (c *Client) SendArc58GetAdmin(ctx context.Context) (*Arc58GetAdminMethodRes
 	return typedResult, nil
 }
 
-// SendArc58GetPlugins calls the arc58_getPlugins ABI method and waits for confirmation.
+// SendArc58GetPlugins calls the arc58_getPlugins ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get plugin info for a list of plugin keys
 func (c *Client) SendArc58GetPlugins(ctx context.Context, params algokit.CallParams[Arc58GetPluginsArgs]) (*Arc58GetPluginsMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetPlugins(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetPlugins(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getPlugins",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetPlugins(ctx context.Context, params algokit.CallParams[Arc58GetPluginsArgs]) (*Arc58GetPluginsMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetPlugins(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*Arc58GetPluginsMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetPluginsResult decodes the return value of a arc58_getPlugins call.
+func (c *Client) decodeArc58GetPluginsResult(result *algokit.SendAppTransactionResult) (*Arc58GetPluginsMethodResult, error) {
 	typedResult := &Arc58GetPluginsMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendArc58GetNamedPlugins calls the arc58_getNamedPlugins ABI method and waits for confirmation.
+// SendArc58GetNamedPlugins calls the arc58_getNamedPlugins ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get plugin info for a list of named plugins
 func (c *Client) SendArc58GetNamedPlugins(ctx context.Context, params algokit.CallParams[Arc58GetNamedPluginsArgs]) (*Arc58GetNamedPluginsMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetNamedPlugins(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetNamedPlugins(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getNamedPlugins",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetNamedPlugins(ctx context.Context, params algokit.CallParams[Arc58GetNamedPluginsArgs]) (*Arc58GetNamedPluginsMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetNamedPlugins(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*Arc58GetNamedPluginsMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetNamedPluginsResult decodes the return value of a arc58_getNamedPlugins call.
+func (c *Client) decodeArc58GetNamedPluginsResult(result *algokit.SendAppTransactionResult) (*Arc58GetNamedPluginsMethodResult, error) {
 	typedResult := &Arc58GetNamedPluginsMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendArc58GetEscrows calls the arc58_getEscrows ABI method and waits for confirmation.
+// SendArc58GetEscrows calls the arc58_getEscrows ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get escrow info for a list of escrow names
 func (c *Client) SendArc58GetEscrows(ctx context.Context, params algokit.CallParams[Arc58GetEscrowsArgs]) (*Arc58GetEscrowsMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetEscrows(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetEscrows(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getEscrows",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetEscrows(ctx context.Context, params algokit.CallParams[Arc58GetEscrowsArgs]) (*Arc58GetEscrowsMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetEscrows(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*Arc58GetEscrowsMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetEscrowsResult decodes the return value of a arc58_getEscrows call.
+func (c *Client) decodeArc58GetEscrowsResult(result *algokit.SendAppTransactionResult) (*Arc58GetEscrowsMethodResult, error) {
 	typedResult := &Arc58GetEscrowsMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendArc58GetAllowances calls the arc58_getAllowances ABI method and waits for confirmation.
+// SendArc58GetAllowances calls the arc58_getAllowances ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get allowance info for a list of assets on a given escrow
 func (c *Client) SendArc58GetAllowances(ctx context.Context, params algokit.CallParams[Arc58GetAllowancesArgs]) (*Arc58GetAllowancesMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetAllowances(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetAllowances(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getAllowances",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetAllowances(ctx context.Context, params algokit.CallParams[Arc58GetAllowancesArgs]) (*Arc58GetAllowancesMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetAllowances(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*Arc58GetAllowancesMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetAllowancesResult decodes the return value of a arc58_getAllowances call.
+func (c *Client) decodeArc58GetAllowancesResult(result *algokit.SendAppTransactionResult) (*Arc58GetAllowancesMethodResult, error) {
 	typedResult := &Arc58GetAllowancesMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendArc58GetExecutions calls the arc58_getExecutions ABI method and waits for confirmation.
+// SendArc58GetExecutions calls the arc58_getExecutions ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get execution key info for a list of leases
 func (c *Client) SendArc58GetExecutions(ctx context.Context, params algokit.CallParams[Arc58GetExecutionsArgs]) (*Arc58GetExecutionsMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetExecutions(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetExecutions(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getExecutions",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetExecutions(ctx context.Context, params algokit.CallParams[Arc58GetExecutionsArgs]) (*Arc58GetExecutionsMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetExecutions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*Arc58GetExecutionsMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetExecutionsResult decodes the return value of a arc58_getExecutions call.
+func (c *Client) decodeArc58GetExecutionsResult(result *algokit.SendAppTransactionResult) (*Arc58GetExecutionsMethodResult, error) {
 	typedResult := &Arc58GetExecutionsMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendArc58GetDomainKeys calls the arc58_getDomainKeys ABI method and waits for confirmation.
+// SendArc58GetDomainKeys calls the arc58_getDomainKeys ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get domain key assignments for a list of addresses
 func (c *Client) SendArc58GetDomainKeys(ctx context.Context, params algokit.CallParams[Arc58GetDomainKeysArgs]) (*Arc58GetDomainKeysMethodResult, error) {
-	methodArgs := argsToInterfaceArc58GetDomainKeys(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendArc58GetDomainKeys(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "arc58_getDomainKeys",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendArc58GetDomainKeys(ctx context.Context, params algokit.CallParams[Arc58GetDomainKeysArgs]) (*Arc58GetDomainKeysMethodResult, error) {
+	comp, err := c.NewGroup().Arc58GetDomainKeys(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*Arc58GetDomainKeysMethodResult)
+	return typedResult, nil
+}
 
+// decodeArc58GetDomainKeysResult decodes the return value of a arc58_getDomainKeys call.
+func (c *Client) decodeArc58GetDomainKeysResult(result *algokit.SendAppTransactionResult) (*Arc58GetDomainKeysMethodResult, error) {
 	typedResult := &Arc58GetDomainKeysMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1107,29 +1525,32 @@ func (c *Client) SendArc58GetDomainKeys(ctx context.Context, params algokit.Call
 	return typedResult, nil
 }
 
-// SendMBR calls the mbr ABI method and waits for confirmation.
+// SendMBR calls the mbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Calculate the minimum balance requirements for various box operations
 func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
-	methodArgs := argsToInterfaceMBR(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mbr",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
+	comp, err := c.NewGroup().MBR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MBRMethodResult)
+	return typedResult, nil
+}
 
+// decodeMBRResult decodes the return value of a mbr call.
+func (c *Client) decodeMBRResult(result *algokit.SendAppTransactionResult) (*MBRMethodResult, error) {
 	typedResult := &MBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1145,29 +1566,32 @@ func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]
 	return typedResult, nil
 }
 
-// SendBalance calls the balance ABI method and waits for confirmation.
+// SendBalance calls the balance ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Get the balance of a set of assets in the account, including staked amounts
 func (c *Client) SendBalance(ctx context.Context, params algokit.CallParams[BalanceArgs]) (*BalanceMethodResult, error) {
-	methodArgs := argsToInterfaceBalance(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendBalance(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "balance",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendBalance(ctx context.Context, params algokit.CallParams[BalanceArgs]) (*BalanceMethodResult, error) {
+	comp, err := c.NewGroup().Balance(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*BalanceMethodResult)
+	return typedResult, nil
+}
 
+// decodeBalanceResult decodes the return value of a balance call.
+func (c *Client) decodeBalanceResult(result *algokit.SendAppTransactionResult) (*BalanceMethodResult, error) {
 	typedResult := &BalanceMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1271,42 +1695,54 @@ func argsToInterfaceArc58CanCall(args Arc58CanCallArgs) []interface{} {
 	}
 }
 
-func argsToInterfaceArc58RekeyToPlugin(args Arc58RekeyToPluginArgs) []interface{} {
-	return []interface{}{
-		args.Plugin,
-		args.Global,
-		args.Escrow,
-		args.MethodOffsets,
-		args.FundsRequest,
-	}
-}
-
-func argsToInterfaceArc58RekeyToNamedPlugin(args Arc58RekeyToNamedPluginArgs) []interface{} {
-	return []interface{}{
-		args.Name,
-		args.Global,
-		args.Escrow,
-		args.MethodOffsets,
-		args.FundsRequest,
-	}
-}
-
-func argsToInterfaceArc58AddPlugin(args Arc58AddPluginArgs) []interface{} {
-	return []interface{}{
-		args.Plugin,
-		args.Caller,
-		args.Escrow,
-		args.Admin,
-		args.DelegationType,
-		args.LastValid,
-		args.Cooldown,
-		args.Methods,
-		args.UseRounds,
-		args.UseExecutionKey,
-		args.CoverFees,
-		args.CanReclaim,
-		args.DefaultToEscrow,
-	}
+func argsToInterfaceArc58RekeyToPlugin(args Arc58RekeyToPluginArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 5)
+	methodArgs = append(methodArgs, args.Plugin)
+	methodArgs = append(methodArgs, args.Global)
+	methodArgs = append(methodArgs, args.Escrow)
+	methodArgs = append(methodArgs, args.MethodOffsets)
+	if v, err := toABIValue(args.FundsRequest); err != nil {
+		return nil, fmt.Errorf("invalid fundsRequest argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
+	}
+	return methodArgs, nil
+}
+
+func argsToInterfaceArc58RekeyToNamedPlugin(args Arc58RekeyToNamedPluginArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 5)
+	methodArgs = append(methodArgs, args.Name)
+	methodArgs = append(methodArgs, args.Global)
+	methodArgs = append(methodArgs, args.Escrow)
+	methodArgs = append(methodArgs, args.MethodOffsets)
+	if v, err := toABIValue(args.FundsRequest); err != nil {
+		return nil, fmt.Errorf("invalid fundsRequest argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
+	}
+	return methodArgs, nil
+}
+
+func argsToInterfaceArc58AddPlugin(args Arc58AddPluginArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 13)
+	methodArgs = append(methodArgs, args.Plugin)
+	methodArgs = append(methodArgs, args.Caller)
+	methodArgs = append(methodArgs, args.Escrow)
+	methodArgs = append(methodArgs, args.Admin)
+	methodArgs = append(methodArgs, args.DelegationType)
+	methodArgs = append(methodArgs, args.LastValid)
+	methodArgs = append(methodArgs, args.Cooldown)
+	if v, err := toABIValue(args.Methods); err != nil {
+		return nil, fmt.Errorf("invalid methods argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
+	}
+	methodArgs = append(methodArgs, args.UseRounds)
+	methodArgs = append(methodArgs, args.UseExecutionKey)
+	methodArgs = append(methodArgs, args.CoverFees)
+	methodArgs = append(methodArgs, args.CanReclaim)
+	methodArgs = append(methodArgs, args.DefaultToEscrow)
+	return methodArgs, nil
 }
 
 func argsToInterfaceAssignDomain(args AssignDomainArgs) []interface{} {
@@ -1324,23 +1760,27 @@ func argsToInterfaceArc58RemovePlugin(args Arc58RemovePluginArgs) []interface{}
 	}
 }
 
-func argsToInterfaceArc58AddNamedPlugin(args Arc58AddNamedPluginArgs) []interface{} {
-	return []interface{}{
-		args.Name,
-		args.Plugin,
-		args.Caller,
-		args.Escrow,
-		args.Admin,
-		args.DelegationType,
-		args.LastValid,
-		args.Cooldown,
-		args.Methods,
-		args.UseRounds,
-		args.UseExecutionKey,
-		args.CoverFees,
-		args.CanReclaim,
-		args.DefaultToEscrow,
+func argsToInterfaceArc58AddNamedPlugin(args Arc58AddNamedPluginArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 14)
+	methodArgs = append(methodArgs, args.Name)
+	methodArgs = append(methodArgs, args.Plugin)
+	methodArgs = append(methodArgs, args.Caller)
+	methodArgs = append(methodArgs, args.Escrow)
+	methodArgs = append(methodArgs, args.Admin)
+	methodArgs = append(methodArgs, args.DelegationType)
+	methodArgs = append(methodArgs, args.LastValid)
+	methodArgs = append(methodArgs, args.Cooldown)
+	if v, err := toABIValue(args.Methods); err != nil {
+		return nil, fmt.Errorf("invalid methods argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	methodArgs = append(methodArgs, args.UseRounds)
+	methodArgs = append(methodArgs, args.UseExecutionKey)
+	methodArgs = append(methodArgs, args.CoverFees)
+	methodArgs = append(methodArgs, args.CanReclaim)
+	methodArgs = append(methodArgs, args.DefaultToEscrow)
+	return methodArgs, nil
 }
 
 func argsToInterfaceArc58RemoveNamedPlugin(args Arc58RemoveNamedPluginArgs) []interface{} {
@@ -1361,20 +1801,28 @@ func argsToInterfaceArc58ToggleEscrowLock(args Arc58ToggleEscrowLockArgs) []inte
 	}
 }
 
-func argsToInterfaceArc58Reclaim(args Arc58ReclaimArgs) []interface{} {
-	return []interface{}{
-		args.Escrow,
-		args.Reclaims,
+func argsToInterfaceArc58Reclaim(args Arc58ReclaimArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 2)
+	methodArgs = append(methodArgs, args.Escrow)
+	if v, err := toABIValue(args.Reclaims); err != nil {
+		return nil, fmt.Errorf("invalid reclaims argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
-func argsToInterfaceArc58PluginReclaim(args Arc58PluginReclaimArgs) []interface{} {
-	return []interface{}{
-		args.Plugin,
-		args.Caller,
-		args.Escrow,
-		args.Reclaims,
+func argsToInterfaceArc58PluginReclaim(args Arc58PluginReclaimArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 4)
+	methodArgs = append(methodArgs, args.Plugin)
+	methodArgs = append(methodArgs, args.Caller)
+	methodArgs = append(methodArgs, args.Escrow)
+	if v, err := toABIValue(args.Reclaims); err != nil {
+		return nil, fmt.Errorf("invalid reclaims argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceArc58OptInEscrow(args Arc58OptInEscrowArgs) []interface{} {
@@ -1394,11 +1842,15 @@ func argsToInterfaceArc58PluginOptInEscrow(args Arc58PluginOptInEscrowArgs) []in
 	}
 }
 
-func argsToInterfaceArc58AddAllowances(args Arc58AddAllowancesArgs) []interface{} {
-	return []interface{}{
-		args.Escrow,
-		args.Allowances,
+func argsToInterfaceArc58AddAllowances(args Arc58AddAllowancesArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 2)
+	methodArgs = append(methodArgs, args.Escrow)
+	if v, err := toABIValue(args.Allowances); err != nil {
+		return nil, fmt.Errorf("invalid allowances argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceArc58RemoveAllowances(args Arc58RemoveAllowancesArgs) []interface{} {
@@ -1423,10 +1875,14 @@ func argsToInterfaceArc58RemoveExecutionKey(args Arc58RemoveExecutionKeyArgs) []
 	}
 }
 
-func argsToInterfaceArc58GetPlugins(args Arc58GetPluginsArgs) []interface{} {
-	return []interface{}{
-		args.Keys,
+func argsToInterfaceArc58GetPlugins(args Arc58GetPluginsArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Keys); err != nil {
+		return nil, fmt.Errorf("invalid keys argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceArc58GetNamedPlugins(args Arc58GetNamedPluginsArgs) []interface{} {
@@ -2,1258 +2,1138 @@
 package abstractedaccount
 
 import (
+	"bytes"
 	"context"
-
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/abi"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // Composer builds atomic transaction groups for the AbstractedAccount contract.
+// A Composer is not safe for concurrent use.
 type Composer struct {
-	client   *Client
-	composer *algokit.TransactionComposer
+	client  *Client
+	pending []groupCall
 }
 
-// Register adds a register method call to the transaction group.
-func (comp *Composer) Register(ctx context.Context, params algokit.CallParams[RegisterArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceRegister(params.Args)
+// groupCall is a method call added to a Composer, built into a group with
+// the go-algorand-sdk AtomicTransactionComposer when the group is sent.
+type groupCall struct {
+	method    abi.Method
+	args      []interface{}
+	sender    types.Address
+	signer    transaction.TransactionSigner
+	note      []byte
+	boxes     []types.AppBoxReference
+	accounts  []types.Address
+	apps      []uint64
+	assets    []uint64
+	extraFee  uint64
+	staticFee uint64
+	readonly  bool
+	populate  bool // SendParams.PopulateAppCallResources
+}
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "register" {
-			method, err = m.ToABIMethod()
-			break
-		}
+func newGroupCall[T any](method abi.Method, args []interface{}, params algokit.CallParams[T], readonly bool) groupCall {
+	return groupCall{
+		method:    method,
+		args:      args,
+		sender:    params.Sender,
+		signer:    params.Signer,
+		note:      params.Note,
+		boxes:     params.BoxReferences,
+		accounts:  params.AccountReferences,
+		apps:      params.AppReferences,
+		assets:    params.AssetReferences,
+		extraFee:  params.ExtraFee,
+		staticFee: params.StaticFee,
+		readonly:  readonly,
+		populate:  params.SendParams.PopulateAppCallResources,
 	}
+}
+
+// Register adds a register method call to the transaction group.
+func (comp *Composer) Register(ctx context.Context, params algokit.CallParams[RegisterArgs]) (*Composer, error) {
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceRegister(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("register(string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetDomain adds a setDomain method call to the transaction group.
 func (comp *Composer) SetDomain(ctx context.Context, params algokit.CallParams[SetDomainArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetDomain(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setDomain" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetDomain(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setDomain(string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetRevocationApp adds a setRevocationApp method call to the transaction group.
 func (comp *Composer) SetRevocationApp(ctx context.Context, params algokit.CallParams[SetRevocationAppArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetRevocationApp(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setRevocationApp" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetRevocationApp(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setRevocationApp(uint64)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetNickname adds a setNickname method call to the transaction group.
 func (comp *Composer) SetNickname(ctx context.Context, params algokit.CallParams[SetNicknameArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetNickname(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setNickname" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetNickname(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setNickname(string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetAvatar adds a setAvatar method call to the transaction group.
 func (comp *Composer) SetAvatar(ctx context.Context, params algokit.CallParams[SetAvatarArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetAvatar(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setAvatar" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetAvatar(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setAvatar(uint64)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetBanner adds a setBanner method call to the transaction group.
 func (comp *Composer) SetBanner(ctx context.Context, params algokit.CallParams[SetBannerArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetBanner(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setBanner" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetBanner(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setBanner(uint64)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // SetBio adds a setBio method call to the transaction group.
 func (comp *Composer) SetBio(ctx context.Context, params algokit.CallParams[SetBioArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceSetBio(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "setBio" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceSetBio(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("setBio(string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58ChangeAdmin adds a arc58_changeAdmin method call to the transaction group.
 func (comp *Composer) Arc58ChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58ChangeAdminArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58ChangeAdmin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_changeAdmin" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58ChangeAdmin(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_changeAdmin(address)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58PluginChangeAdmin adds a arc58_pluginChangeAdmin method call to the transaction group.
 func (comp *Composer) Arc58PluginChangeAdmin(ctx context.Context, params algokit.CallParams[Arc58PluginChangeAdminArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58PluginChangeAdmin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_pluginChangeAdmin" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58PluginChangeAdmin(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_pluginChangeAdmin(address)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58VerifyAuthAddress adds a arc58_verifyAuthAddress method call to the transaction group.
-func (comp *Composer) Arc58VerifyAuthAddress(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_verifyAuthAddress" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) Arc58VerifyAuthAddress(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("arc58_verifyAuthAddress()void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RekeyTo adds a arc58_rekeyTo method call to the transaction group.
 func (comp *Composer) Arc58RekeyTo(ctx context.Context, params algokit.CallParams[Arc58RekeyToArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RekeyTo(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_rekeyTo" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58RekeyTo(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_rekeyTo(address,bool)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58CanCall adds a arc58_canCall method call to the transaction group.
 func (comp *Composer) Arc58CanCall(ctx context.Context, params algokit.CallParams[Arc58CanCallArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58CanCall(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_canCall" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58CanCall(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_canCall(uint64,bool,address,string,byte[4])bool")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58RekeyToPlugin adds a arc58_rekeyToPlugin method call to the transaction group.
 func (comp *Composer) Arc58RekeyToPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToPluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RekeyToPlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_rekeyToPlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58RekeyToPlugin(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_rekeyToPlugin(uint64,bool,string,uint64[],(uint64,uint64)[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RekeyToNamedPlugin adds a arc58_rekeyToNamedPlugin method call to the transaction group.
 func (comp *Composer) Arc58RekeyToNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RekeyToNamedPluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RekeyToNamedPlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_rekeyToNamedPlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58RekeyToNamedPlugin(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_rekeyToNamedPlugin(string,bool,string,uint64[],(uint64,uint64)[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58AddPlugin adds a arc58_addPlugin method call to the transaction group.
 func (comp *Composer) Arc58AddPlugin(ctx context.Context, params algokit.CallParams[Arc58AddPluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58AddPlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_addPlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58AddPlugin(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_addPlugin(uint64,address,string,bool,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,bool)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // AssignDomain adds a assignDomain method call to the transaction group.
 func (comp *Composer) AssignDomain(ctx context.Context, params algokit.CallParams[AssignDomainArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceAssignDomain(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "assignDomain" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceAssignDomain(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("assignDomain(address,string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RemovePlugin adds a arc58_removePlugin method call to the transaction group.
 func (comp *Composer) Arc58RemovePlugin(ctx context.Context, params algokit.CallParams[Arc58RemovePluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RemovePlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_removePlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58RemovePlugin(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_removePlugin(uint64,address,string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58AddNamedPlugin adds a arc58_addNamedPlugin method call to the transaction group.
 func (comp *Composer) Arc58AddNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58AddNamedPluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58AddNamedPlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_addNamedPlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58AddNamedPlugin(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_addNamedPlugin(string,uint64,address,string,bool,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,bool)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RemoveNamedPlugin adds a arc58_removeNamedPlugin method call to the transaction group.
 func (comp *Composer) Arc58RemoveNamedPlugin(ctx context.Context, params algokit.CallParams[Arc58RemoveNamedPluginArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RemoveNamedPlugin(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_removeNamedPlugin" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58RemoveNamedPlugin(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_removeNamedPlugin(string)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58NewEscrow adds a arc58_newEscrow method call to the transaction group.
 func (comp *Composer) Arc58NewEscrow(ctx context.Context, params algokit.CallParams[Arc58NewEscrowArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58NewEscrow(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_newEscrow" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58NewEscrow(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_newEscrow(string)uint64")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58ToggleEscrowLock adds a arc58_toggleEscrowLock method call to the transaction group.
 func (comp *Composer) Arc58ToggleEscrowLock(ctx context.Context, params algokit.CallParams[Arc58ToggleEscrowLockArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58ToggleEscrowLock(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_toggleEscrowLock" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58ToggleEscrowLock(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_toggleEscrowLock(string)(uint64,bool)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58Reclaim adds a arc58_reclaim method call to the transaction group.
 func (comp *Composer) Arc58Reclaim(ctx context.Context, params algokit.CallParams[Arc58ReclaimArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58Reclaim(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_reclaim" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58Reclaim(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_reclaim(string,(uint64,uint64,bool)[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58PluginReclaim adds a arc58_pluginReclaim method call to the transaction group.
 func (comp *Composer) Arc58PluginReclaim(ctx context.Context, params algokit.CallParams[Arc58PluginReclaimArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58PluginReclaim(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_pluginReclaim" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58PluginReclaim(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_pluginReclaim(uint64,address,string,(uint64,uint64,bool)[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58OptInEscrow adds a arc58_optInEscrow method call to the transaction group.
 func (comp *Composer) Arc58OptInEscrow(ctx context.Context, params algokit.CallParams[Arc58OptInEscrowArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58OptInEscrow(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_optInEscrow" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58OptInEscrow(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_optInEscrow(string,uint64[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58PluginOptInEscrow adds a arc58_pluginOptInEscrow method call to the transaction group.
 func (comp *Composer) Arc58PluginOptInEscrow(ctx context.Context, params algokit.CallParams[Arc58PluginOptInEscrowArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58PluginOptInEscrow(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_pluginOptInEscrow" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58PluginOptInEscrow(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_pluginOptInEscrow(uint64,address,string,uint64[],pay)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58AddAllowances adds a arc58_addAllowances method call to the transaction group.
 func (comp *Composer) Arc58AddAllowances(ctx context.Context, params algokit.CallParams[Arc58AddAllowancesArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58AddAllowances(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_addAllowances" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58AddAllowances(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_addAllowances(string,(uint64,uint8,uint64,uint64,uint64,bool)[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RemoveAllowances adds a arc58_removeAllowances method call to the transaction group.
 func (comp *Composer) Arc58RemoveAllowances(ctx context.Context, params algokit.CallParams[Arc58RemoveAllowancesArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RemoveAllowances(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_removeAllowances" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58RemoveAllowances(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_removeAllowances(string,uint64[])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58AddExecutionKey adds a arc58_addExecutionKey method call to the transaction group.
 func (comp *Composer) Arc58AddExecutionKey(ctx context.Context, params algokit.CallParams[Arc58AddExecutionKeyArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58AddExecutionKey(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_addExecutionKey" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58AddExecutionKey(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_addExecutionKey(byte[32],byte[32][],uint64,uint64)void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58RemoveExecutionKey adds a arc58_removeExecutionKey method call to the transaction group.
 func (comp *Composer) Arc58RemoveExecutionKey(ctx context.Context, params algokit.CallParams[Arc58RemoveExecutionKeyArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58RemoveExecutionKey(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_removeExecutionKey" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58RemoveExecutionKey(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_removeExecutionKey(byte[32])void")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, false))
 	return comp, nil
 }
 
 // Arc58GetAdmin adds a arc58_getAdmin method call to the transaction group.
-func (comp *Composer) Arc58GetAdmin(ctx context.Context) (*Composer, error) {
-	methodArgs := []interface{}(nil)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getAdmin" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+func (comp *Composer) Arc58GetAdmin(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*Composer, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := []interface{}(nil)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:      comp.client.AppID(),
-		Method:     method,
-		MethodArgs: methodArgs,
-	})
+	method, err := abi.MethodFromSignature("arc58_getAdmin()address")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetPlugins adds a arc58_getPlugins method call to the transaction group.
 func (comp *Composer) Arc58GetPlugins(ctx context.Context, params algokit.CallParams[Arc58GetPluginsArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetPlugins(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getPlugins" {
-			method, err = m.ToABIMethod()
-			break
-		}
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
 	}
+	methodArgs, err := argsToInterfaceArc58GetPlugins(params.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getPlugins((uint64,address,string)[])(uint64,uint8,uint64,uint64,(byte[4],uint64,uint64)[],bool,bool,bool,bool,bool,uint64,uint64)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetNamedPlugins adds a arc58_getNamedPlugins method call to the transaction group.
 func (comp *Composer) Arc58GetNamedPlugins(ctx context.Context, params algokit.CallParams[Arc58GetNamedPluginsArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetNamedPlugins(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getNamedPlugins" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58GetNamedPlugins(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getNamedPlugins(string[])(uint64,uint8,uint64,uint64,(byte[4],uint64,uint64)[],bool,bool,bool,bool,bool,uint64,uint64)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetEscrows adds a arc58_getEscrows method call to the transaction group.
 func (comp *Composer) Arc58GetEscrows(ctx context.Context, params algokit.CallParams[Arc58GetEscrowsArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetEscrows(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getEscrows" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58GetEscrows(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getEscrows(string[])(uint64,bool)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetAllowances adds a arc58_getAllowances method call to the transaction group.
 func (comp *Composer) Arc58GetAllowances(ctx context.Context, params algokit.CallParams[Arc58GetAllowancesArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetAllowances(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getAllowances" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58GetAllowances(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getAllowances(string,uint64[])(uint8,uint64,uint64,uint64,uint64,uint64,uint64,bool)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetExecutions adds a arc58_getExecutions method call to the transaction group.
 func (comp *Composer) Arc58GetExecutions(ctx context.Context, params algokit.CallParams[Arc58GetExecutionsArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetExecutions(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getExecutions" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58GetExecutions(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getExecutions(byte[32][])(byte[32][],uint64,uint64)[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Arc58GetDomainKeys adds a arc58_getDomainKeys method call to the transaction group.
 func (comp *Composer) Arc58GetDomainKeys(ctx context.Context, params algokit.CallParams[Arc58GetDomainKeysArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceArc58GetDomainKeys(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "arc58_getDomainKeys" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceArc58GetDomainKeys(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("arc58_getDomainKeys(address[])string[]")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // MBR adds a mbr method call to the transaction group.
 func (comp *Composer) MBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*Composer, error) {
-	methodArgs := argsToInterfaceMBR(params.Args)
-
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "mbr" {
-			method, err = m.ToABIMethod()
-			break
-		}
-	}
+	params, err := withDefaults(comp.client.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	methodArgs := argsToInterfaceMBR(params.Args)
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+	method, err := abi.MethodFromSignature("mbr(string,uint64,string,uint64)(uint64,uint64,uint64,uint64,uint64,uint64,bool,uint64)")
 	if err != nil {
 		return nil, err
 	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
 	return comp, nil
 }
 
 // Balance adds a balance method call to the transaction group.
 func (comp *Composer) Balance(ctx context.Context, params algokit.CallParams[BalanceArgs]) (*Composer, error) {
+	params, err := withDefaults(comp.client.opts, params)
+	if err != nil {
+		return nil, err
+	}
 	methodArgs := argsToInterfaceBalance(params.Args)
 
-	method, err := comp.client.AppClient.AppSpec().Methods[0].ToABIMethod()
-	for _, m := range comp.client.AppClient.AppSpec().Methods {
-		if m.Name == "balance" {
-			method, err = m.ToABIMethod()
-			break
+	method, err := abi.MethodFromSignature("balance(uint64[])uint64[]")
+	if err != nil {
+		return nil, err
+	}
+	comp.pending = append(comp.pending, newGroupCall(method, methodArgs, params, true))
+	return comp, nil
+}
+
+// GroupResult is a confirmed group, or the simulated result of a group of
+// readonly calls.
+type GroupResult struct {
+	ConfirmedRound uint64 // 0 for a simulated group
+	TxIDs          []string
+	// Results holds the result of each method call of the group, in order,
+	// with ABIReturn as decoded by the SDK.
+	Results []algokit.SendAppTransactionResult
+	// Returns holds the typed result of each method call: its
+	// *{Method}MethodResult, or *algokit.SendAppTransactionResult for a void
+	// method.
+	Returns []interface{}
+}
+
+// Send sends the composed transaction group and waits for confirmation,
+// until the ctx deadline or 5 rounds if ctx has none. A group of only
+// readonly calls is simulated instead of sent. Send returns a
+// ConfirmationError if the group is not known to be confirmed. The client
+// must have an algod client: see ClientOptions.Algod.
+func (comp *Composer) Send(ctx context.Context) (*GroupResult, error) {
+	return comp.send(ctx)
+}
+
+func (comp *Composer) send(ctx context.Context) (*GroupResult, error) {
+	client, err := comp.client.algodClient("Composer.Send")
+	if err != nil {
+		return nil, err
+	}
+	if comp.readonly() {
+		return comp.simulate(ctx, client)
+	}
+	atc, _, lastValid, err := comp.prepare(ctx, client, signed)
+	if err != nil {
+		return nil, err
+	}
+	executed, err := atc.Execute(client, ctx, waitRounds(ctx))
+	if err != nil {
+		return nil, comp.client.sendFailed(sendError(ctx, atc, lastValid, err))
+	}
+	return comp.client.groupResult(executed.ConfirmedRound, executed.TxIDs, executed.MethodResults)
+}
+
+// readonly reports whether every call of the group is readonly.
+func (comp *Composer) readonly() bool {
+	for _, call := range comp.pending {
+		if !call.readonly {
+			return false
 		}
 	}
+	return len(comp.pending) > 0
+}
+
+// simulate runs a group of readonly calls without sending it.
+func (comp *Composer) simulate(ctx context.Context, client *algod.Client) (*GroupResult, error) {
+	atc, _, _, err := comp.prepare(ctx, client, simulated)
 	if err != nil {
 		return nil, err
 	}
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate readonly calls: %w", err)
+	}
+	if failure := response.SimulateResponse.TxnGroups[0].FailureMessage; failure != "" {
+		return nil, fmt.Errorf("readonly calls failed: %s", failure)
+	}
+	txIDs := make([]string, len(response.MethodResults))
+	for i, r := range response.MethodResults {
+		txIDs[i] = r.TxID
+	}
+	return comp.client.groupResult(0, txIDs, response.MethodResults)
+}
+
+// groupResult returns the results of the method calls of a group.
+func (c *Client) groupResult(round uint64, txIDs []string, methodResults []transaction.ABIMethodResult) (*GroupResult, error) {
+	result := &GroupResult{ConfirmedRound: round, TxIDs: txIDs}
+	for _, r := range methodResults {
+		if r.DecodeError != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, r.DecodeError)
+		}
+		sent := algokit.SendAppTransactionResult{TxID: r.TxID, ABIReturn: r.ReturnValue, Confirmation: r.TransactionInfo}
+		typed, err := c.decodeResult(r.Method, sent)
+		if err != nil {
+			return nil, fmt.Errorf("group confirmed in round %d, but decoding the %s return failed: %w", round, r.Method.Name, err)
+		}
+		result.Results = append(result.Results, sent)
+		result.Returns = append(result.Returns, typed)
+	}
+	return result, nil
+}
+
+// decodeResult returns the typed result of a confirmed method call.
+func (c *Client) decodeResult(method abi.Method, result algokit.SendAppTransactionResult) (interface{}, error) {
+	switch method.GetSignature() {
+	case "arc58_canCall(uint64,bool,address,string,byte[4])bool":
+		return c.decodeArc58CanCallResult(&result)
+	case "arc58_newEscrow(string)uint64":
+		return c.decodeArc58NewEscrowResult(&result)
+	case "arc58_toggleEscrowLock(string)(uint64,bool)":
+		return c.decodeArc58ToggleEscrowLockResult(&result)
+	case "arc58_getAdmin()address":
+		return c.decodeArc58GetAdminResult(&result)
+	case "arc58_getPlugins((uint64,address,string)[])(uint64,uint8,uint64,uint64,(byte[4],uint64,uint64)[],bool,bool,bool,bool,bool,uint64,uint64)[]":
+		return c.decodeArc58GetPluginsResult(&result)
+	case "arc58_getNamedPlugins(string[])(uint64,uint8,uint64,uint64,(byte[4],uint64,uint64)[],bool,bool,bool,bool,bool,uint64,uint64)[]":
+		return c.decodeArc58GetNamedPluginsResult(&result)
+	case "arc58_getEscrows(string[])(uint64,bool)[]":
+		return c.decodeArc58GetEscrowsResult(&result)
+	case "arc58_getAllowances(string,uint64[])(uint8,uint64,uint64,uint64,uint64,uint64,uint64,bool)[]":
+		return c.decodeArc58GetAllowancesResult(&result)
+	case "arc58_getExecutions(byte[32][])(byte[32][],uint64,uint64)[]":
+		return c.decodeArc58GetExecutionsResult(&result)
+	case "arc58_getDomainKeys(address[])string[]":
+		return c.decodeArc58GetDomainKeysResult(&result)
+	case "mbr(string,uint64,string,uint64)(uint64,uint64,uint64,uint64,uint64,uint64,bool,uint64)":
+		return c.decodeMBRResult(&result)
+	case "balance(uint64[])uint64[]":
+		return c.decodeBalanceResult(&result)
+	}
+	return &result, nil
+}
+
+// suggestedParams returns the params to build transactions with, with the
+// client's ValidityWindow applied.
+func (c *Client) suggestedParams(ctx context.Context, client *algod.Client) (types.SuggestedParams, error) {
+	sp, err := client.SuggestedParams().Do(ctx)
+	if err != nil {
+		return sp, fmt.Errorf("failed to get suggested params: %w", err)
+	}
+	if window := c.opts.ValidityWindow; window > 0 {
+		sp.LastRoundValid = sp.FirstRoundValid + types.Round(window)
+	}
+	return sp, nil
+}
+
+// sendFailed returns the error of a failed send.
+func (c *Client) sendFailed(err error) error {
+	return err
+}
+
+// signing is how buildATC signs the calls of a group.
+type signing int
+
+const (
+	signed    signing = iota // each call needs a signer
+	unsigned                 // no signer is needed
+	simulated                // every signer is replaced by an empty one
+)
+
+// sentCall is a method call of a built group.
+type sentCall struct {
+	index  int // position in the group
+	method abi.Method
+}
+
+// prepare builds the group with the SDK composer, first simulating it to
+// find the resources of calls with PopulateAppCallResources. It returns the
+// last valid round of the group.
+func (comp *Composer) prepare(ctx context.Context, client *algod.Client, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, uint64, error) {
+	if len(comp.pending) == 0 {
+		return nil, nil, 0, errors.New("the group needs at least one call")
+	}
+	sp, err := comp.client.suggestedParams(ctx, client)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	calls := comp.pending
+	if mode != simulated && comp.populates() {
+		if calls, err = comp.populateResources(ctx, client, sp); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	atc, sent, err := comp.buildATC(calls, sp, mode)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return atc, sent, uint64(sp.LastRoundValid), nil
+}
 
-	err = comp.composer.AddMethodCall(ctx, algokit.MethodCallParams{
-		AppID:             comp.client.AppID(),
-		Method:            method,
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-	})
+// buildATC adds calls to an SDK composer, using the client's default sender
+// and signer for calls without them.
+func (comp *Composer) buildATC(calls []groupCall, sp types.SuggestedParams, mode signing) (*transaction.AtomicTransactionComposer, []sentCall, error) {
+	var atc transaction.AtomicTransactionComposer
+	sent := make([]sentCall, 0, len(calls))
+	for _, call := range calls {
+		if call.sender.IsZero() {
+			call.sender = comp.client.params.DefaultSender
+		}
+		if call.signer == nil && call.sender == comp.client.params.DefaultSender {
+			call.signer = comp.client.params.DefaultSigner
+		}
+		if call.sender.IsZero() {
+			return nil, nil, fmt.Errorf("no sender for %s", call.method.Name)
+		}
+		switch {
+		case mode == simulated:
+			call.signer = transaction.EmptyTransactionSigner{}
+		case call.signer == nil && mode == signed:
+			return nil, nil, fmt.Errorf("no signer for %s", call.sender)
+		}
+		callSP := sp
+		if call.staticFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(call.staticFee)
+		} else if call.extraFee > 0 {
+			callSP.FlatFee, callSP.Fee = true, types.MicroAlgos(sp.MinFee+call.extraFee)
+		}
+		accounts := make([]string, len(call.accounts))
+		for i, a := range call.accounts {
+			accounts[i] = a.String()
+		}
+		args, err := sdkArgs(call.method, call.args, mode == simulated)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = atc.AddMethodCall(transaction.AddMethodCallParams{
+			AppID:           comp.client.AppID(),
+			Method:          call.method,
+			MethodArgs:      args,
+			Sender:          call.sender,
+			SuggestedParams: callSP,
+			OnComplete:      types.NoOpOC,
+			Note:            call.note,
+			Signer:          call.signer,
+			ForeignApps:     call.apps,
+			ForeignAssets:   call.assets,
+			ForeignAccounts: accounts,
+			BoxReferences:   call.boxes,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		sent = append(sent, sentCall{index: atc.Count() - 1, method: call.method})
+	}
+	return &atc, sent, nil
+}
+
+// groupTxIDs returns the transaction IDs of a built group.
+func groupTxIDs(atc *transaction.AtomicTransactionComposer) []string {
+	group, err := atc.BuildGroup()
+	if err != nil {
+		return nil
+	}
+	txIDs := make([]string, len(group))
+	for i, txn := range group {
+		txIDs[i] = crypto.GetTxID(txn.Txn)
+	}
+	return txIDs
+}
+
+// sdkArgs converts method args to the values the SDK composer encodes. The
+// generated Args structs hold account reference args as address strings,
+// which the SDK only accepts as a types.Address. With emptySigners, the
+// signers of transaction args are replaced for simulation.
+func sdkArgs(method abi.Method, args []interface{}, emptySigners bool) ([]interface{}, error) {
+	converted := make([]interface{}, len(args))
+	copy(converted, args)
+	for i, arg := range converted {
+		switch a := arg.(type) {
+		case transaction.TransactionWithSigner:
+			if emptySigners {
+				a.Signer = transaction.EmptyTransactionSigner{}
+				converted[i] = a
+			}
+		case string:
+			if i >= len(method.Args) || method.Args[i].Type != abi.AccountReferenceType {
+				continue
+			}
+			address, err := types.DecodeAddress(a)
+			if err != nil {
+				return nil, fmt.Errorf("%s arg %s: %w", method.Name, method.Args[i].Name, err)
+			}
+			converted[i] = address
+		}
+	}
+	return converted, nil
+}
+
+// populates reports whether a call of the group has PopulateAppCallResources.
+func (comp *Composer) populates() bool {
+	for _, call := range comp.pending {
+		if call.populate {
+			return true
+		}
+	}
+	return false
+}
+
+// maxReferences and maxAccountReferences are the most resources and
+// accounts an app call can reference.
+const (
+	maxReferences        = 8
+	maxAccountReferences = 4
+)
+
+// populateResources simulates the group and returns its calls with the
+// resources they access without referencing them added to their references.
+// Resources the group shares go to the first call with room for them.
+func (comp *Composer) populateResources(ctx context.Context, client *algod.Client, sp types.SuggestedParams) ([]groupCall, error) {
+	atc, sent, err := comp.buildATC(comp.pending, sp, simulated)
 	if err != nil {
 		return nil, err
 	}
-	return comp, nil
+	response, err := atc.Simulate(ctx, client, models.SimulateRequest{AllowEmptySignatures: true, AllowUnnamedResources: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate to populate resources: %w", err)
+	}
+	group := response.SimulateResponse.TxnGroups[0]
+	if group.FailureMessage != "" {
+		return nil, fmt.Errorf("simulating to populate resources failed: %s", group.FailureMessage)
+	}
+
+	appID := comp.client.AppID()
+	calls := make([]groupCall, len(comp.pending))
+	copy(calls, comp.pending)
+	for i, s := range sent {
+		accessed := group.TxnResults[s.index].UnnamedResourcesAccessed
+		if err := calls[i].addResources(appID, accessed, false); err != nil {
+			return nil, err
+		}
+	}
+	accessed := group.UnnamedResourcesAccessed
+	for _, resources := range splitResources(accessed) {
+		added := false
+		for i := range calls {
+			if calls[i].addResources(appID, resources, true) == nil {
+				added = true
+				break
+			}
+		}
+		if !added {
+			return nil, fmt.Errorf("no call of the group has room to reference %+v", resources)
+		}
+	}
+	return calls, nil
+}
+
+// splitResources splits the resources a group accesses into the sets that
+// must be referenced by the same call: an account or app with its local
+// state, an account with an asset holding and an app with its box.
+func splitResources(accessed models.SimulateUnnamedResourcesAccessed) []models.SimulateUnnamedResourcesAccessed {
+	var sets []models.SimulateUnnamedResourcesAccessed
+	for _, local := range accessed.AppLocals {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{local.Account}, Apps: []uint64{local.App}})
+	}
+	for _, holding := range accessed.AssetHoldings {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{holding.Account}, Assets: []uint64{holding.Asset}})
+	}
+	for _, account := range accessed.Accounts {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Accounts: []string{account}})
+	}
+	for _, app := range accessed.Apps {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Apps: []uint64{app}})
+	}
+	for _, asset := range accessed.Assets {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Assets: []uint64{asset}})
+	}
+	for _, box := range accessed.Boxes {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{Boxes: []models.BoxReference{box}})
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		sets = append(sets, models.SimulateUnnamedResourcesAccessed{ExtraBoxRefs: 1})
+	}
+	return sets
+}
+
+// addResources adds resources to the call's references. With limited set,
+// it adds none and fails if they would take the call over the reference
+// limits.
+func (call *groupCall) addResources(appID uint64, accessed models.SimulateUnnamedResourcesAccessed, limited bool) error {
+	updated := *call
+	updated.accounts = append([]types.Address(nil), call.accounts...)
+	updated.apps = append([]uint64(nil), call.apps...)
+	updated.assets = append([]uint64(nil), call.assets...)
+	updated.boxes = append([]types.AppBoxReference(nil), call.boxes...)
+
+	accounts := append([]string(nil), accessed.Accounts...)
+	apps := append([]uint64(nil), accessed.Apps...)
+	assets := append([]uint64(nil), accessed.Assets...)
+	for _, local := range accessed.AppLocals {
+		accounts, apps = append(accounts, local.Account), append(apps, local.App)
+	}
+	for _, holding := range accessed.AssetHoldings {
+		accounts, assets = append(accounts, holding.Account), append(assets, holding.Asset)
+	}
+
+	addApp := func(app uint64) {
+		if app != appID && !containsUint(updated.apps, app) {
+			updated.apps = append(updated.apps, app)
+		}
+	}
+	for _, s := range accounts {
+		account, err := types.DecodeAddress(s)
+		if err != nil {
+			return err
+		}
+		if account != updated.sender && !containsAddress(updated.accounts, account) {
+			updated.accounts = append(updated.accounts, account)
+		}
+	}
+	for _, app := range apps {
+		addApp(app)
+	}
+	for _, asset := range assets {
+		if !containsUint(updated.assets, asset) {
+			updated.assets = append(updated.assets, asset)
+		}
+	}
+	for _, box := range accessed.Boxes {
+		ref := types.AppBoxReference{AppID: box.App, Name: box.Name}
+		if box.App == appID {
+			ref.AppID = 0
+		} else {
+			addApp(box.App)
+		}
+		updated.boxes = append(updated.boxes, ref)
+	}
+	for i := uint64(0); i < accessed.ExtraBoxRefs; i++ {
+		updated.boxes = append(updated.boxes, types.AppBoxReference{})
+	}
+
+	references := len(updated.accounts) + len(updated.apps) + len(updated.assets) + len(updated.boxes)
+	if limited && (len(updated.accounts) > maxAccountReferences || references > maxReferences) {
+		return errors.New("too many references")
+	}
+	*call = updated
+	return nil
 }
 
-// Send executes the composed transaction group and waits for confirmation.
-func (comp *Composer) Send(ctx context.Context) (*algokit.ComposerExecuteResult, error) {
-	return comp.composer.Execute(ctx, 5)
+func containsUint(values []uint64, v uint64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(values []types.Address, v types.Address) bool {
+	for _, x := range values {
+		if bytes.Equal(x[:], v[:]) {
+			return true
+		}
+	}
+	return false
 }
@@ -52,18 +52,18 @@ type ExecutionInfo struct {
 
 // PluginInfo is a generated struct type.
 type PluginInfo struct {
-	Escrow          uint64          `json:"escrow"`
-	DelegationType  uint8           `json:"delegationType"`
-	LastValid       uint64          `json:"lastValid"`
-	Cooldown        uint64          `json:"cooldown"`
-	Methods         [][]interface{} `json:"methods"`
-	Admin           bool            `json:"admin"`
-	UseRounds       bool            `json:"useRounds"`
-	UseExecutionKey bool            `json:"useExecutionKey"`
-	CoverFees       bool            `json:"coverFees"`
-	CanReclaim      bool            `json:"canReclaim"`
-	LastCalled      uint64          `json:"lastCalled"`
-	Start           uint64          `json:"start"`
+	Escrow          uint64                            `json:"escrow"`
+	DelegationType  uint8                             `json:"delegationType"`
+	LastValid       uint64                            `json:"lastValid"`
+	Cooldown        uint64                            `json:"cooldown"`
+	Methods         []Tuple3[[4]byte, uint64, uint64] `json:"methods"`
+	Admin           bool                              `json:"admin"`
+	UseRounds       bool                              `json:"useRounds"`
+	UseExecutionKey bool                              `json:"useExecutionKey"`
+	CoverFees       bool                              `json:"coverFees"`
+	CanReclaim      bool                              `json:"canReclaim"`
+	LastCalled      uint64                            `json:"lastCalled"`
+	Start           uint64                            `json:"start"`
 }
 
 // PluginKey is a generated struct type.
@@ -75,330 +75,330 @@ type PluginKey struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version           string
-	ControlledAddress types.Address
-	Admin             types.Address
-	Domain            string
-	EscrowFactory     uint64
-	RevocationApp     uint64
-	Nickname          string
-	Referrer          types.Address
+	Version           string        `json:"version"`
+	ControlledAddress types.Address `json:"controlledAddress"`
+	Admin             types.Address `json:"admin"`
+	Domain            string        `json:"domain"`
+	EscrowFactory     uint64        `json:"escrowFactory"`
+	RevocationApp     uint64        `json:"revocationApp"`
+	Nickname          string        `json:"nickname"`
+	Referrer          types.Address `json:"referrer"`
 }
 
 // RegisterArgs holds the arguments for the register method.
 type RegisterArgs struct {
-	Escrow string
+	Escrow string `json:"escrow"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	Version string
+	Version string `json:"version"`
 }
 
 // SetDomainArgs holds the arguments for the setDomain method.
 type SetDomainArgs struct {
-	Domain string
+	Domain string `json:"domain"`
 }
 
 // SetRevocationAppArgs holds the arguments for the setRevocationApp method.
 type SetRevocationAppArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // SetNicknameArgs holds the arguments for the setNickname method.
 type SetNicknameArgs struct {
-	Nickname string
+	Nickname string `json:"nickname"`
 }
 
 // SetAvatarArgs holds the arguments for the setAvatar method.
 type SetAvatarArgs struct {
-	Avatar uint64
+	Avatar uint64 `json:"avatar"`
 }
 
 // SetBannerArgs holds the arguments for the setBanner method.
 type SetBannerArgs struct {
-	Banner uint64
+	Banner uint64 `json:"banner"`
 }
 
 // SetBioArgs holds the arguments for the setBio method.
 type SetBioArgs struct {
-	Bio string
+	Bio string `json:"bio"`
 }
 
 // Arc58ChangeAdminArgs holds the arguments for the arc58_changeAdmin method.
 type Arc58ChangeAdminArgs struct {
-	NewAdmin types.Address
+	NewAdmin types.Address `json:"newAdmin"`
 }
 
 // Arc58PluginChangeAdminArgs holds the arguments for the arc58_pluginChangeAdmin method.
 type Arc58PluginChangeAdminArgs struct {
-	NewAdmin types.Address
+	NewAdmin types.Address `json:"newAdmin"`
 }
 
 // Arc58RekeyToArgs holds the arguments for the arc58_rekeyTo method.
 type Arc58RekeyToArgs struct {
-	Address types.Address
-	Flash   bool
+	Address types.Address `json:"address"`
+	Flash   bool          `json:"flash"`
 }
 
 // Arc58CanCallArgs holds the arguments for the arc58_canCall method.
 type Arc58CanCallArgs struct {
-	Plugin  uint64
-	Global  bool
-	Address types.Address
-	Escrow  string
-	Method  [4]byte
+	Plugin  uint64        `json:"plugin"`
+	Global  bool          `json:"global"`
+	Address types.Address `json:"address"`
+	Escrow  string        `json:"escrow"`
+	Method  [4]byte       `json:"method"`
 }
 
 // Arc58CanCallMethodResult holds the result of calling arc58_canCall.
 type Arc58CanCallMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // Arc58RekeyToPluginArgs holds the arguments for the arc58_rekeyToPlugin method.
 type Arc58RekeyToPluginArgs struct {
-	Plugin        uint64
-	Global        bool
-	Escrow        string
-	MethodOffsets []uint64
-	FundsRequest  [][]interface{}
+	Plugin        uint64                   `json:"plugin"`
+	Global        bool                     `json:"global"`
+	Escrow        string                   `json:"escrow"`
+	MethodOffsets []uint64                 `json:"methodOffsets"`
+	FundsRequest  []Tuple2[uint64, uint64] `json:"fundsRequest"`
 }
 
 // Arc58RekeyToNamedPluginArgs holds the arguments for the arc58_rekeyToNamedPlugin method.
 type Arc58RekeyToNamedPluginArgs struct {
-	Name          string
-	Global        bool
-	Escrow        string
-	MethodOffsets []uint64
-	FundsRequest  [][]interface{}
+	Name          string                   `json:"name"`
+	Global        bool                     `json:"global"`
+	Escrow        string                   `json:"escrow"`
+	MethodOffsets []uint64                 `json:"methodOffsets"`
+	FundsRequest  []Tuple2[uint64, uint64] `json:"fundsRequest"`
 }
 
 // Arc58AddPluginArgs holds the arguments for the arc58_addPlugin method.
 type Arc58AddPluginArgs struct {
-	Plugin          uint64
-	Caller          types.Address
-	Escrow          string
-	Admin           bool
-	DelegationType  uint8
-	LastValid       uint64
-	Cooldown        uint64
-	Methods         [][]interface{}
-	UseRounds       bool
-	UseExecutionKey bool
-	CoverFees       bool
-	CanReclaim      bool
-	DefaultToEscrow bool
+	Plugin          uint64                    `json:"plugin"`
+	Caller          types.Address             `json:"caller"`
+	Escrow          string                    `json:"escrow"`
+	Admin           bool                      `json:"admin"`
+	DelegationType  uint8                     `json:"delegationType"`
+	LastValid       uint64                    `json:"lastValid"`
+	Cooldown        uint64                    `json:"cooldown"`
+	Methods         []Tuple2[[4]byte, uint64] `json:"methods"`
+	UseRounds       bool                      `json:"useRounds"`
+	UseExecutionKey bool                      `json:"useExecutionKey"`
+	CoverFees       bool                      `json:"coverFees"`
+	CanReclaim      bool                      `json:"canReclaim"`
+	DefaultToEscrow bool                      `json:"defaultToEscrow"`
 }
 
 // AssignDomainArgs holds the arguments for the assignDomain method.
 type AssignDomainArgs struct {
-	Caller types.Address
-	Domain string
+	Caller types.Address `json:"caller"`
+	Domain string        `json:"domain"`
 }
 
 // Arc58RemovePluginArgs holds the arguments for the arc58_removePlugin method.
 type Arc58RemovePluginArgs struct {
-	Plugin uint64
-	Caller types.Address
-	Escrow string
+	Plugin uint64        `json:"plugin"`
+	Caller types.Address `json:"caller"`
+	Escrow string        `json:"escrow"`
 }
 
 // Arc58AddNamedPluginArgs holds the arguments for the arc58_addNamedPlugin method.
 type Arc58AddNamedPluginArgs struct {
-	Name            string
-	Plugin          uint64
-	Caller          types.Address
-	Escrow          string
-	Admin           bool
-	DelegationType  uint8
-	LastValid       uint64
-	Cooldown        uint64
-	Methods         [][]interface{}
-	UseRounds       bool
-	UseExecutionKey bool
-	CoverFees       bool
-	CanReclaim      bool
-	DefaultToEscrow bool
+	Name            string                    `json:"name"`
+	Plugin          uint64                    `json:"plugin"`
+	Caller          types.Address             `json:"caller"`
+	Escrow          string                    `json:"escrow"`
+	Admin           bool                      `json:"admin"`
+	DelegationType  uint8                     `json:"delegationType"`
+	LastValid       uint64                    `json:"lastValid"`
+	Cooldown        uint64                    `json:"cooldown"`
+	Methods         []Tuple2[[4]byte, uint64] `json:"methods"`
+	UseRounds       bool                      `json:"useRounds"`
+	UseExecutionKey bool                      `json:"useExecutionKey"`
+	CoverFees       bool                      `json:"coverFees"`
+	CanReclaim      bool                      `json:"canReclaim"`
+	DefaultToEscrow bool                      `json:"defaultToEscrow"`
 }
 
 // Arc58RemoveNamedPluginArgs holds the arguments for the arc58_removeNamedPlugin method.
 type Arc58RemoveNamedPluginArgs struct {
-	Name string
+	Name string `json:"name"`
 }
 
 // Arc58NewEscrowArgs holds the arguments for the arc58_newEscrow method.
 type Arc58NewEscrowArgs struct {
-	Escrow string
+	Escrow string `json:"escrow"`
 }
 
 // Arc58NewEscrowMethodResult holds the result of calling arc58_newEscrow.
 type Arc58NewEscrowMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // Arc58ToggleEscrowLockArgs holds the arguments for the arc58_toggleEscrowLock method.
 type Arc58ToggleEscrowLockArgs struct {
-	Escrow string
+	Escrow string `json:"escrow"`
 }
 
 // Arc58ToggleEscrowLockMethodResult holds the result of calling arc58_toggleEscrowLock.
 type Arc58ToggleEscrowLockMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return EscrowInfo
+	Return EscrowInfo `json:"return"`
 }
 
 // Arc58ReclaimArgs holds the arguments for the arc58_reclaim method.
 type Arc58ReclaimArgs struct {
-	Escrow   string
-	Reclaims [][]interface{}
+	Escrow   string                         `json:"escrow"`
+	Reclaims []Tuple3[uint64, uint64, bool] `json:"reclaims"`
 }
 
 // Arc58PluginReclaimArgs holds the arguments for the arc58_pluginReclaim method.
 type Arc58PluginReclaimArgs struct {
-	Plugin   uint64
-	Caller   types.Address
-	Escrow   string
-	Reclaims [][]interface{}
+	Plugin   uint64                         `json:"plugin"`
+	Caller   types.Address                  `json:"caller"`
+	Escrow   string                         `json:"escrow"`
+	Reclaims []Tuple3[uint64, uint64, bool] `json:"reclaims"`
 }
 
 // Arc58OptInEscrowArgs holds the arguments for the arc58_optInEscrow method.
 type Arc58OptInEscrowArgs struct {
-	Escrow string
-	Assets []uint64
+	Escrow string   `json:"escrow"`
+	Assets []uint64 `json:"assets"`
 }
 
 // Arc58PluginOptInEscrowArgs holds the arguments for the arc58_pluginOptInEscrow method.
 type Arc58PluginOptInEscrowArgs struct {
-	Plugin     uint64
-	Caller     types.Address
-	Escrow     string
-	Assets     []uint64
-	MBRPayment transaction.TransactionWithSigner
+	Plugin     uint64                            `json:"plugin"`
+	Caller     types.Address                     `json:"caller"`
+	Escrow     string                            `json:"escrow"`
+	Assets     []uint64                          `json:"assets"`
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
 }
 
 // Arc58AddAllowancesArgs holds the arguments for the arc58_addAllowances method.
 type Arc58AddAllowancesArgs struct {
-	Escrow     string
-	Allowances [][]interface{}
+	Escrow     string                                                `json:"escrow"`
+	Allowances []Tuple6[uint64, uint8, uint64, uint64, uint64, bool] `json:"allowances"`
 }
 
 // Arc58RemoveAllowancesArgs holds the arguments for the arc58_removeAllowances method.
 type Arc58RemoveAllowancesArgs struct {
-	Escrow string
-	Assets []uint64
+	Escrow string   `json:"escrow"`
+	Assets []uint64 `json:"assets"`
 }
 
 // Arc58AddExecutionKeyArgs holds the arguments for the arc58_addExecutionKey method.
 type Arc58AddExecutionKeyArgs struct {
-	Lease      [32]byte
-	Groups     [][32]byte
-	FirstValid uint64
-	LastValid  uint64
+	Lease      [32]byte   `json:"lease"`
+	Groups     [][32]byte `json:"groups"`
+	FirstValid uint64     `json:"firstValid"`
+	LastValid  uint64     `json:"lastValid"`
 }
 
 // Arc58RemoveExecutionKeyArgs holds the arguments for the arc58_removeExecutionKey method.
 type Arc58RemoveExecutionKeyArgs struct {
-	Lease [32]byte
+	Lease [32]byte `json:"lease"`
 }
 
 // Arc58GetAdminMethodResult holds the result of calling arc58_getAdmin.
 type Arc58GetAdminMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return types.Address
+	Return types.Address `json:"return"`
 }
 
 // Arc58GetPluginsArgs holds the arguments for the arc58_getPlugins method.
 type Arc58GetPluginsArgs struct {
-	Keys [][]interface{}
+	Keys []Tuple3[uint64, types.Address, string] `json:"keys"`
 }
 
 // Arc58GetPluginsMethodResult holds the result of calling arc58_getPlugins.
 type Arc58GetPluginsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple12[uint64, uint8, uint64, uint64, []Tuple3[[4]byte, uint64, uint64], bool, bool, bool, bool, bool, uint64, uint64] `json:"return"`
 }
 
 // Arc58GetNamedPluginsArgs holds the arguments for the arc58_getNamedPlugins method.
 type Arc58GetNamedPluginsArgs struct {
-	Names []string
+	Names []string `json:"names"`
 }
 
 // Arc58GetNamedPluginsMethodResult holds the result of calling arc58_getNamedPlugins.
 type Arc58GetNamedPluginsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple12[uint64, uint8, uint64, uint64, []Tuple3[[4]byte, uint64, uint64], bool, bool, bool, bool, bool, uint64, uint64] `json:"return"`
 }
 
 // Arc58GetEscrowsArgs holds the arguments for the arc58_getEscrows method.
 type Arc58GetEscrowsArgs struct {
-	Escrows []string
+	Escrows []string `json:"escrows"`
 }
 
 // Arc58GetEscrowsMethodResult holds the result of calling arc58_getEscrows.
 type Arc58GetEscrowsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple2[uint64, bool] `json:"return"`
 }
 
 // Arc58GetAllowancesArgs holds the arguments for the arc58_getAllowances method.
 type Arc58GetAllowancesArgs struct {
-	Escrow string
-	Assets []uint64
+	Escrow string   `json:"escrow"`
+	Assets []uint64 `json:"assets"`
 }
 
 // Arc58GetAllowancesMethodResult holds the result of calling arc58_getAllowances.
 type Arc58GetAllowancesMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple8[uint8, uint64, uint64, uint64, uint64, uint64, uint64, bool] `json:"return"`
 }
 
 // Arc58GetExecutionsArgs holds the arguments for the arc58_getExecutions method.
 type Arc58GetExecutionsArgs struct {
-	Leases [][32]byte
+	Leases [][32]byte `json:"leases"`
 }
 
 // Arc58GetExecutionsMethodResult holds the result of calling arc58_getExecutions.
 type Arc58GetExecutionsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple3[[][32]byte, uint64, uint64] `json:"return"`
 }
 
 // Arc58GetDomainKeysArgs holds the arguments for the arc58_getDomainKeys method.
 type Arc58GetDomainKeysArgs struct {
-	Addresses []types.Address
+	Addresses []types.Address `json:"addresses"`
 }
 
 // Arc58GetDomainKeysMethodResult holds the result of calling arc58_getDomainKeys.
 type Arc58GetDomainKeysMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []string
+	Return []string `json:"return"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Escrow      string
-	MethodCount uint64
-	Plugin      string
-	Groups      uint64
+	Escrow      string `json:"escrow"`
+	MethodCount uint64 `json:"methodCount"`
+	Plugin      string `json:"plugin"`
+	Groups      uint64 `json:"groups"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AbstractAccountBoxMBRData
+	Return AbstractAccountBoxMBRData `json:"return"`
 }
 
 // BalanceArgs holds the arguments for the balance method.
 type BalanceArgs struct {
-	Assets []uint64
+	Assets []uint64 `json:"assets"`
 }
 
 // BalanceMethodResult holds the result of calling balance.
 type BalanceMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []uint64
+	Return []uint64 `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
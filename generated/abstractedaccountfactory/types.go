@@ -4,87 +4,100 @@ package abstractedaccountfactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	abstractedaccount "github.com/kylebeee/algokit-client-generator-go/generated/abstractedaccount"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
-	Version        string
-	EscrowFactory  uint64
-	Revocation     uint64
-	Domain         string
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
+	Version        string `json:"version"`
+	EscrowFactory  uint64 `json:"escrowFactory"`
+	Revocation     uint64 `json:"revocation"`
+	Domain         string `json:"domain"`
 }
 
 // UpdateRevocationArgs holds the arguments for the updateRevocation method.
 type UpdateRevocationArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // NewAccountArgs holds the arguments for the newAccount method.
 type NewAccountArgs struct {
-	Payment           transaction.TransactionWithSigner
-	ControlledAddress types.Address
-	Admin             types.Address
-	Nickname          string
-	Referrer          types.Address
+	Payment           transaction.TransactionWithSigner `json:"-"`
+	ControlledAddress types.Address                     `json:"controlledAddress"`
+	Admin             types.Address                     `json:"admin"`
+	Nickname          string                            `json:"nickname"`
+	Referrer          types.Address                     `json:"referrer"`
 }
 
 // NewAccountMethodResult holds the result of calling newAccount.
 type NewAccountMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the abstractedaccount app created by newAccount,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewAccountMethodResult) Child() (*abstractedaccount.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return abstractedaccount.NewClientFromSpec(params)
 }
 
 // CostMethodResult holds the result of calling cost.
 type CostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,4 +2,11 @@
 package akitadao
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"AkitaDAO\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akta\"},{\"type\":\"byte[36]\",\"name\":\"contentPolicy\"},{\"type\":\"uint64\",\"name\":\"minRewardsImpact\"},{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"name\":\"apps\",\"struct\":\"AkitaDAOApps\"},{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"name\":\"fees\",\"struct\":\"AkitaDAOFees\"},{\"type\":\"((uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64))\",\"name\":\"proposalSettings\",\"struct\":\"Object752A5B25\"},{\"type\":\"((uint64,string),uint8,uint64)[]\",\"name\":\"revenueSplits\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"update\",\"desc\":\"\\nThe Akita DAO contract supports its own upgrade via a secure, auditable process. Here's a step-by-step trace of how a self-upgrade is executed:\\n\\n1. Proposal Creation:\\n   - A member submits a proposal to upgrade the DAO by including a ProposalAction of type `UpgradeApp`.\\n   - This proposal references the ARC58 contract and specifies the required upgrade parameters, such as the new application code and any associated execution keys.\\n\\n2. Proposal Approval:\\n   - The DAO community votes on the proposal. If it receives sufficient approvals as defined in the proposal’s settings, its status changes to `Approved`.\\n\\n3. Execution Initiation:\\n   - Upon approval, the DAO creates an execution entry in the ARC58 contract using the parameters from the upgrade proposal.\\n   - This sets up the conditions under which an upgrade may be performed, such as locking execution to a valid round interval and binding it to a unique execution key (via the transaction lease).\\n\\n4. Upgrade Execution:\\n   - A transaction group is submitted where the ARC58 account (or an authorized delegate) triggers the actual `update` method on the DAO contract.\\n   - The `update` method receives the `proposalID` and index of the upgrade action.\\n   - The DAO contract performs the following validation checks before actually permitting the upgrade:\\n       a. Confirms the referenced proposal exists and has status `Approved`.\\n       b. Ensures the action type matches `UpgradeApp`.\\n       c. Verifies the current app ID matches the proposal’s intended target.\\n       d. Ensures the transaction lease matches the authorized execution key from the proposal.\\n       e. Validates that the group context (via groupId and round) satisfies execution constraints—e.g., only the specified group may proceed and only within a permitted round window.\\n   - Only if all conditions are satisfied is the application update allowed to continue.\\n\\nThis architecture ensures upgrades are strictly governed, requiring DAO consensus, group-based transaction atomicity, and explicit validation checks at execution time.\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"setup\",\"args\":[{\"type\":\"string\",\"name\":\"nickname\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"partiallyInitialize\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"initialize\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"newProposal\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editProposalWithPayment\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"deleteProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"submitProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"voteProposal\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"uint8\",\"name\":\"vote\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalizeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"executeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"deleteProposalVotes\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"address[]\",\"name\":\"voters\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"setupCost\",\"args\":[],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalCost\",\"args\":[{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"ProposalCostInfo\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"(uint8,byte[36],(uint64,uint64,uint64),address,uint64,uint64,uint64,(uint8,byte[])[])\",\"struct\":\"ProposalDetails\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"mustGetExecution\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"lease\"}],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"ExecutionMetadata\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"AkitaAppList\":[{\"name\":\"staking\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"pool\",\"type\":\"uint64\"},{\"name\":\"prizeBox\",\"type\":\"uint64\"},{\"name\":\"subscriptions\",\"type\":\"uint64\"},{\"name\":\"gate\",\"type\":\"uint64\"},{\"name\":\"auction\",\"type\":\"uint64\"},{\"name\":\"hyperSwap\",\"type\":\"uint64\"},{\"name\":\"raffle\",\"type\":\"uint64\"},{\"name\":\"metaMerkles\",\"type\":\"uint64\"},{\"name\":\"marketplace\",\"type\":\"uint64\"},{\"name\":\"wallet\",\"type\":\"uint64\"}],\"AkitaAssets\":[{\"name\":\"akta\",\"type\":\"uint64\"},{\"name\":\"bones\",\"type\":\"uint64\"}],\"AkitaDAOApps\":[{\"name\":\"staking\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"pool\",\"type\":\"uint64\"},{\"name\":\"prizeBox\",\"type\":\"uint64\"},{\"name\":\"subscriptions\",\"type\":\"uint64\"},{\"name\":\"gate\",\"type\":\"uint64\"},{\"name\":\"auction\",\"type\":\"uint64\"},{\"name\":\"hyperSwap\",\"type\":\"uint64\"},{\"name\":\"raffle\",\"type\":\"uint64\"},{\"name\":\"metaMerkles\",\"type\":\"uint64\"},{\"name\":\"marketplace\",\"type\":\"uint64\"},{\"name\":\"akitaNfd\",\"type\":\"uint64\"},{\"name\":\"optin\",\"type\":\"uint64\"},{\"name\":\"revenueManager\",\"type\":\"uint64\"},{\"name\":\"update\",\"type\":\"uint64\"},{\"name\":\"social\",\"type\":\"uint64\"},{\"name\":\"graph\",\"type\":\"uint64\"},{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"moderation\",\"type\":\"uint64\"},{\"name\":\"vrfBeacon\",\"type\":\"uint64\"},{\"name\":\"nfdRegistry\",\"type\":\"uint64\"},{\"name\":\"assetInbox\",\"type\":\"uint64\"},{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"poll\",\"type\":\"uint64\"}],\"AkitaDAOFees\":[{\"name\":\"walletCreateFee\",\"type\":\"uint64\"},{\"name\":\"walletReferrerPercentage\",\"type\":\"uint64\"},{\"name\":\"postFee\",\"type\":\"uint64\"},{\"name\":\"reactFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"},{\"name\":\"poolCreationFee\",\"type\":\"uint64\"},{\"name\":\"poolImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"poolImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"subscriptionServiceCreationFee\",\"type\":\"uint64\"},{\"name\":\"subscriptionPaymentPercentage\",\"type\":\"uint64\"},{\"name\":\"subscriptionTriggerPercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMin\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMax\",\"type\":\"uint64\"},{\"name\":\"marketplaceComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceRoyaltyDefaultPercentage\",\"type\":\"uint64\"},{\"name\":\"shuffleSalePercentage\",\"type\":\"uint64\"},{\"name\":\"omnigemSaleFee\",\"type\":\"uint64\"},{\"name\":\"auctionCreationFee\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"auctionComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"auctionRafflePercentage\",\"type\":\"uint64\"},{\"name\":\"raffleCreationFee\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"raffleComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"swapFeeImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"swapFeeImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"swapComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"swapLiquidityPercentage\",\"type\":\"uint64\"},{\"name\":\"krbyPercentage\",\"type\":\"uint64\"},{\"name\":\"moderatorPercentage\",\"type\":\"uint64\"}],\"AkitaSocialAppList\":[{\"name\":\"social\",\"type\":\"uint64\"},{\"name\":\"graph\",\"type\":\"uint64\"},{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"moderation\",\"type\":\"uint64\"}],\"DAOPluginKey\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ExecutionMetadata\":[{\"name\":\"proposalID\",\"type\":\"uint64\"},{\"name\":\"index\",\"type\":\"uint64\"}],\"NFTFees\":[{\"name\":\"marketplaceSalePercentageMin\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMax\",\"type\":\"uint64\"},{\"name\":\"marketplaceComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceRoyaltyDefaultPercentage\",\"type\":\"uint64\"},{\"name\":\"shuffleSalePercentage\",\"type\":\"uint64\"},{\"name\":\"omnigemSaleFee\",\"type\":\"uint64\"},{\"name\":\"auctionCreationFee\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"auctionComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"auctionRafflePercentage\",\"type\":\"uint64\"},{\"name\":\"raffleCreationFee\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"raffleComposablePercentage\",\"type\":\"uint64\"}],\"Object752A5B25\":[{\"name\":\"upgradeApp\",\"type\":\"ProposalSettings\"},{\"name\":\"addPlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"removeExecutePlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"removePlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"addAllowance\",\"type\":\"ProposalSettings\"},{\"name\":\"removeAllowance\",\"type\":\"ProposalSettings\"},{\"name\":\"newEscrow\",\"type\":\"ProposalSettings\"},{\"name\":\"toggleEscrowLock\",\"type\":\"ProposalSettings\"},{\"name\":\"updateFields\",\"type\":\"ProposalSettings\"}],\"OtherAppList\":[{\"name\":\"vrfBeacon\",\"type\":\"uint64\"},{\"name\":\"nfdRegistry\",\"type\":\"uint64\"},{\"name\":\"assetInbox\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"poll\",\"type\":\"uint64\"},{\"name\":\"akitaNfd\",\"type\":\"uint64\"}],\"PluginAppList\":[{\"name\":\"optin\",\"type\":\"uint64\"},{\"name\":\"revenueManager\",\"type\":\"uint64\"},{\"name\":\"update\",\"type\":\"uint64\"}],\"ProposalCostInfo\":[{\"name\":\"total\",\"type\":\"uint64\"},{\"name\":\"mbr\",\"type\":\"uint64\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"}],\"ProposalDetails\":[{\"name\":\"status\",\"type\":\"uint8\"},{\"name\":\"cid\",\"type\":\"byte[36]\"},{\"name\":\"votes\",\"type\":\"ProposalVoteTotals\"},{\"name\":\"creator\",\"type\":\"address\"},{\"name\":\"votingTs\",\"type\":\"uint64\"},{\"name\":\"created\",\"type\":\"uint64\"},{\"name\":\"feesPaid\",\"type\":\"uint64\"},{\"name\":\"actions\",\"type\":\"(uint8,byte[])[]\"}],\"ProposalSettings\":[{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"}],\"ProposalVoteInfo\":[{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"power\",\"type\":\"uint64\"}],\"ProposalVoteKey\":[{\"name\":\"proposalID\",\"type\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\"}],\"ProposalVoteTotals\":[{\"name\":\"approvals\",\"type\":\"uint64\"},{\"name\":\"rejections\",\"type\":\"uint64\"},{\"name\":\"abstains\",\"type\":\"uint64\"}],\"SocialFees\":[{\"name\":\"postFee\",\"type\":\"uint64\"},{\"name\":\"reactFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"StakingFees\":[{\"name\":\"creationFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"SubscriptionFees\":[{\"name\":\"serviceCreationFee\",\"type\":\"uint64\"},{\"name\":\"paymentPercentage\",\"type\":\"uint64\"},{\"name\":\"triggerPercentage\",\"type\":\"uint64\"}],\"SwapFees\":[{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"WalletFees\":[{\"name\":\"createFee\",\"type\":\"uint64\"},{\"name\":\"referrerPercentage\",\"type\":\"uint64\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":5,\"bytes\":59},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"addAllowancesProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"YWRkX2FsbG93YW5jZV9wcw==\",\"desc\":\"proposal settings for adding an allowance\"},\"addPluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"YWRkX3BsdWdpbl9wcw==\",\"desc\":\"proposal settings for adding a plugin\"},\"akitaAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaAppList\",\"key\":\"YWFs\",\"desc\":\"the list of akita contract ids\"},\"akitaAssets\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaAssets\",\"key\":\"YWtpdGFfYXNzZXRz\",\"desc\":\"the akita assets\"},\"akitaSocialAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaSocialAppList\",\"key\":\"c2Fs\",\"desc\":\"the list of akita social contract ids\"},\"contentPolicy\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMBytes\",\"key\":\"Y29udGVudF9wb2xpY3k=\",\"desc\":\"the raw 36 byte content policy of the protocol\"},\"minRewardsImpact\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWluX3Jld2FyZHNfaW1wYWN0\",\"desc\":\"the minimum impact score to qualify for daily disbursement\"},\"newEscrowProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"bmV3X2VzY3Jvd19wcw==\",\"desc\":\"proposal settings for creating a new escrow\"},\"nftFees\":{\"keyType\":\"AVMString\",\"valueType\":\"NFTFees\",\"key\":\"bmZ0X2ZlZXM=\",\"desc\":\"fees associated with NFT sales\"},\"otherAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"OtherAppList\",\"key\":\"b2Fs\",\"desc\":\"the list of other contract ids we use\"},\"pluginAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"PluginAppList\",\"key\":\"cGFs\",\"desc\":\"the list of plugin contract ids\"},\"proposalActionLimit\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfYWN0aW9uX2xpbWl0\",\"desc\":\"the number of actions allowed in a proposal\"},\"proposalID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfaWQ=\",\"desc\":\"the next proposal id\"},\"removeAllowancesProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX2FsbG93YW5jZV9wcw==\",\"desc\":\"proposal settings for removing an allowance\"},\"removeExecutePluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3Bz\",\"desc\":\"proposal settings for removing a plugin execution\"},\"removePluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX3BsdWdpbl9wcw==\",\"desc\":\"proposal settings for removing a plugin\"},\"revenueSplits\":{\"keyType\":\"AVMString\",\"valueType\":\"((uint64,string),uint8,uint64)[]\",\"key\":\"cmV2ZW51ZV9zcGxpdHM=\",\"desc\":\"the revenue manager contract id\"},\"socialFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SocialFees\",\"key\":\"c29jaWFsX2ZlZXM=\",\"desc\":\"fees associated with akita social\"},\"stakingFees\":{\"keyType\":\"AVMString\",\"valueType\":\"StakingFees\",\"key\":\"c3Rha2luZ19mZWVz\",\"desc\":\"fees associated with staking assets\"},\"state\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"aW5pdGlhbGl6ZWQ=\",\"desc\":\"state of the DAO\"},\"subscriptionFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SubscriptionFees\",\"key\":\"c3Vic2NyaXB0aW9uX2ZlZXM=\",\"desc\":\"fees associated with subscriptions\"},\"swapFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SwapFees\",\"key\":\"c3dhcF9mZWVz\",\"desc\":\"fees associated with swaps\"},\"toggleEscrowLockProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dG9nZ2xlX2VzY3Jvd19sb2NrX3Bz\",\"desc\":\"proposal settings for toggling an escrow lock\"},\"updateFieldsProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dXBkYXRlX2ZpZWxkc19wcw==\",\"desc\":\"proposal settings for updating fields\"},\"upgradeAppProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dXBncmFkZV9hcHBfcHM=\",\"desc\":\"proposal settings for upgrading applications\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the version number of the DAO\"},\"wallet\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"d2FsbGV0\",\"desc\":\"the arc58 wallet the DAO controls\"},\"walletFees\":{\"keyType\":\"AVMString\",\"valueType\":\"WalletFees\",\"key\":\"d2FsbGV0X2ZlZXM=\",\"desc\":\"the fees for akita wallet operations\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"executions\":{\"keyType\":\"AVMBytes\",\"valueType\":\"ExecutionMetadata\",\"prefix\":\"eA==\",\"desc\":\"extra execution information for the DAO\"},\"plugins\":{\"keyType\":\"DAOPluginKey\",\"valueType\":\"ProposalSettings\",\"prefix\":\"cA==\",\"desc\":\"Plugins that add functionality to the controlledAddress and the account that has permission to use it.\"},\"proposalVotes\":{\"keyType\":\"ProposalVoteKey\",\"valueType\":\"ProposalVoteInfo\",\"prefix\":\"dg==\",\"desc\":\"votes by proposal id \\u0026 address\"},\"proposals\":{\"keyType\":\"uint64\",\"valueType\":\"ProposalDetails\",\"prefix\":\"bA==\",\"desc\":\"voting state of a proposal\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMiAxMDAwMDAgMTAwMCAxMDAwMDAwCiAgICBieXRlY2Jsb2NrIDB4MDAgMHgxNTFmN2M3NSAid2FsbGV0IiAiIiAibCIgIm9hbCIgMHgxNCAiaW5pdGlhbGl6ZWQiIDB4MjggImFhbCIgMHgxZSAiYWtpdGFfYXNzZXRzIiAweDMyICJwcm9wb3NhbF9hY3Rpb25fbGltaXQiICJwYWwiICJ1cGdyYWRlX2FwcF9wcyIgImFkZF9wbHVnaW5fcHMiICJyZW1vdmVfcGx1Z2luX3BzIiAiYWRkX2FsbG93YW5jZV9wcyIgInJlbW92ZV9hbGxvd2FuY2VfcHMiICJuZXdfZXNjcm93X3BzIiAidXBkYXRlX2ZpZWxkc19wcyIgMHgwYSAidmVyc2lvbiIgImNvbnRlbnRfcG9saWN5IiAibWluX3Jld2FyZHNfaW1wYWN0IiAic2FsIiAid2FsbGV0X2ZlZXMiICJzb2NpYWxfZmVlcyIgInN0YWtpbmdfZmVlcyIgInN1YnNjcmlwdGlvbl9mZWVzIiAibmZ0X2ZlZXMiICJzd2FwX2ZlZXMiICJyZXZlbnVlX3NwbGl0cyIgMHgwMiAweDE1IDB4MDAwMTAwMDIgInByb3Bvc2FsX2lkIiAweDAwMmEgMHgxZiAweDI5IDB4MDAwMCAweDNjIDB4NDYgMHg0NyAweDUwIDB4MDAwYSAicCIgMHgwMDAwMDAwMDAwMDAwMTZkMDAwMDAwMDAwMDBmNDI0MCAicmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3BzIiAidG9nZ2xlX2VzY3Jvd19sb2NrX3BzIiAweDg5YTc2ODI2IDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwIDB4MDA3NwogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJueiBtYWluX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMgogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBpbml0aWFsVmFsdWU6ICcnLCBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgYnl0ZWMgMjMgLy8gInZlcnNpb24iCiAgICBieXRlY18zIC8vICIiCiAgICBhcHBfZ2xvYmFsX3B1dAoKbWFpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQtMjUKICAgIC8vIEBjb250cmFjdCh7IHN0YXRlVG90YWxzOiB7IGdsb2JhbEJ5dGVzOiBBa2l0YURBT051bUdsb2JhbEJ5dGVzLCBnbG9iYWxVaW50czogQWtpdGFEQU9OdW1HbG9iYWxVaW50cyB9IH0pCiAgICAvLyBleHBvcnQgY2xhc3MgQWtpdGFEQU8gZXh0ZW5kcyBDb250cmFjdCB7CiAgICBwdXNoYnl0ZXMgMHhlYTkxODBkZCAvLyBtZXRob2QgInVwZGF0ZShzdHJpbmcpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5fdXBkYXRlX3JvdXRlQDQKCm1haW5fc3dpdGNoX2Nhc2VfbmV4dEA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0LTI1CiAgICAvLyBAY29udHJhY3QoeyBzdGF0ZVRvdGFsczogeyBnbG9iYWxCeXRlczogQWtpdGFEQU9OdW1HbG9iYWxCeXRlcywgZ2xvYmFsVWludHM6IEFraXRhREFPTnVtR2xvYmFsVWludHMgfSB9KQogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDI1CiAgICBwdXNoYnl0ZXNzIDB4ZDliYWNkOTQgMHg1M2M0NzU2NiAweGZkMmM5M2NkIDB4MDE5OGZlM2IgMHhmZTIwYzI2OSAweGNiMzdlNjY4IDB4MjhmODAxNTQgMHgxMDNhNmI4OCAweGE0YjIzNmI2IDB4NjJjZTliNWIgMHhmMWZmMjhhYSAweDQ5MDEzYjVmIDB4OTY5OWQxMDggMHg4YzU2NDc2OCAweDFmOWY4YjIwIDB4ZGJiZWM2ZDIgMHg4NTRkZWRlMCAvLyBtZXRob2QgInNldHVwKHN0cmluZyl1aW50NjQiLCBtZXRob2QgInBhcnRpYWxseUluaXRpYWxpemUoKXZvaWQiLCBtZXRob2QgImluaXRpYWxpemUoKXZvaWQiLCBtZXRob2QgIm5ld1Byb3Bvc2FsKHBheSxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXVpbnQ2NCIsIG1ldGhvZCAiZWRpdFByb3Bvc2FsKHVpbnQ2NCxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXZvaWQiLCBtZXRob2QgImVkaXRQcm9wb3NhbFdpdGhQYXltZW50KHBheSx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIiwgbWV0aG9kICJkZWxldGVQcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAic3VibWl0UHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgInZvdGVQcm9wb3NhbChwYXksdWludDY0LHVpbnQ4KXZvaWQiLCBtZXRob2QgImZpbmFsaXplUHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgImV4ZWN1dGVQcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVsZXRlUHJvcG9zYWxWb3Rlcyh1aW50NjQsYWRkcmVzc1tdKXZvaWQiLCBtZXRob2QgInNldHVwQ29zdCgpdWludDY0IiwgbWV0aG9kICJwcm9wb3NhbENvc3QoKHVpbnQ4LGJ5dGVbXSlbXSkodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAiZ2V0UHJvcG9zYWwodWludDY0KSh1aW50OCxieXRlWzM2XSwodWludDY0LHVpbnQ2NCx1aW50NjQpLGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQsKHVpbnQ4LGJ5dGVbXSlbXSkiLCBtZXRob2QgIm11c3RHZXRFeGVjdXRpb24oYnl0ZVszMl0pKHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJvcFVwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggc2V0dXAgcGFydGlhbGx5SW5pdGlhbGl6ZSBpbml0aWFsaXplIG5ld1Byb3Bvc2FsIGVkaXRQcm9wb3NhbCBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudCBkZWxldGVQcm9wb3NhbCBzdWJtaXRQcm9wb3NhbCB2b3RlUHJvcG9zYWwgZmluYWxpemVQcm9wb3NhbCBleGVjdXRlUHJvcG9zYWwgZGVsZXRlUHJvcG9zYWxWb3RlcyBzZXR1cENvc3QgcHJvcG9zYWxDb3N0IGdldFByb3Bvc2FsIG11c3RHZXRFeGVjdXRpb24gbWFpbl9vcFVwX3JvdXRlQDIzCiAgICBlcnIKCm1haW5fb3BVcF9yb3V0ZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM4CiAgICAvLyBvcFVwKCk6IHZvaWQgeyB9CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgptYWluX2NyZWF0ZV9Ob09wQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0LTI1CiAgICAvLyBAY29udHJhY3QoeyBzdGF0ZVRvdGFsczogeyBnbG9iYWxCeXRlczogQWtpdGFEQU9OdW1HbG9iYWxCeXRlcywgZ2xvYmFsVWludHM6IEFraXRhREFPTnVtR2xvYmFsVWludHMgfSB9KQogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPIGV4dGVuZHMgQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4MGViMGI5N2IgLy8gbWV0aG9kICJjcmVhdGUoc3RyaW5nLHVpbnQ2NCxieXRlWzM2XSx1aW50NjQsKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwoKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkpLCgodWludDY0LHN0cmluZyksdWludDgsdWludDY0KVtdKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKbWFpbl91cGRhdGVfcm91dGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjp3aWRlUmF0aW8obnVtZXJhdG9yczogYnl0ZXMsIGRlbm9taW5hdG9yczogYnl0ZXMpIC0+IHVpbnQ2NDoKd2lkZVJhdGlvOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTMKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiB3aWRlUmF0aW8obnVtZXJhdG9yczogW3VpbnQ2NCwgdWludDY0XSwgZGVub21pbmF0b3JzOiBbdWludDY0LCB1aW50NjRdKTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTQKICAgIC8vIGFzc2VydChkZW5vbWluYXRvcnNbMF0gPiAwICYmIGRlbm9taW5hdG9yc1sxXSA+IDAsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBieiB3aWRlUmF0aW9fYm9vbF9mYWxzZUAzCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnogd2lkZVJhdGlvX2Jvb2xfZmFsc2VAMwogICAgaW50Y18xIC8vIDEKCndpZGVSYXRpb19ib29sX21lcmdlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjExNAogICAgLy8gYXNzZXJ0KGRlbm9taW5hdG9yc1swXSA+IDAgJiYgZGVub21pbmF0b3JzWzFdID4gMCwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTE1CiAgICAvLyBjb25zdCBbb3ZlcmZsb3csIHJlc3VsdF0gPSBvcC5kaXZtb2R3KC4uLm9wLm11bHcoLi4ubnVtZXJhdG9ycyksIC4uLm9wLm11bHcoLi4uZGVub21pbmF0b3JzKSkKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgLTIKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgbXVsdwogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyAwCiAgICBtdWx3CiAgICBkaXZtb2R3CiAgICBwb3BuIDIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTE2CiAgICAvLyBhc3NlcnQob3ZlcmZsb3cgPT09IDAsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICAhCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjExNwogICAgLy8gcmV0dXJuIHJlc3VsdAogICAgc3dhcAogICAgcmV0c3ViCgp3aWRlUmF0aW9fYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgd2lkZVJhdGlvX2Jvb2xfbWVyZ2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldE9yaWdpbihlc2Nyb3dGYWN0b3J5OiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiBieXRlczoKZ2V0T3JpZ2luOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTQKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnZXRPcmlnaW4oZXNjcm93RmFjdG9yeTogdWludDY0LCBhZGRyZXNzOiBBY2NvdW50KTogQWNjb3VudCB7CiAgICBwcm90byAyIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTgyLTE4NQogICAgLy8gY29uc3QgZGF0YSA9IGFiaUNhbGw8dHlwZW9mIEVzY3Jvd0ZhY3RvcnkucHJvdG90eXBlLmdldD4oewogICAgLy8gICBhcHBJZDogZXNjcm93RmFjdG9yeSwKICAgIC8vICAgYXJnczogW2FkZHJlc3NdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4M2MxYTZmMzMgLy8gbWV0aG9kICJnZXQoYWRkcmVzcylieXRlW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4NwogICAgLy8gaWYgKEJ5dGVzKGRhdGEpLmxlbmd0aCA9PT0gMCB8fCBCeXRlcyhkYXRhKS5sZW5ndGggIT09IDgpIHsKICAgIGxlbgogICAgZHVwCiAgICBieiBnZXRPcmlnaW5faWZfYm9keUA3CiAgICBmcmFtZV9kaWcgMgogICAgaW50Y18yIC8vIDgKICAgICE9CiAgICBieiBnZXRPcmlnaW5fYWZ0ZXJfaWZfZWxzZUA4CgpnZXRPcmlnaW5faWZfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODgKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCgpnZXRPcmlnaW5fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTcKICAgIC8vIGlmICh3YWxsZXQgPT09IDApIHsKICAgIGZyYW1lX2RpZyAwCiAgICBibnogZ2V0T3JpZ2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTgKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyCiAgICB0eG4gU2VuZGVyCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0T3JpZ2luX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGZyYW1lX2RpZyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBwdXNoYnl0ZXMgImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjAxCiAgICAvLyByZXR1cm4gZ2V0T3JpZ2luQWNjb3VudChBcHBsaWNhdGlvbih3YWxsZXQpKQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldE9yaWdpbl9hZnRlcl9pZl9lbHNlQDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE5MQogICAgLy8gcmV0dXJuIGJ0b2koZGF0YSkKICAgIGZyYW1lX2RpZyAxCiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTk1CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKQogICAgYiBnZXRPcmlnaW5fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA5CgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0U3Rha2luZ1Bvd2VyKHN0YWtpbmdBcHA6IHVpbnQ2NCwgdXNlcjogYnl0ZXMsIGFzc2V0OiB1aW50NjQpIC0+IHVpbnQ2NDoKZ2V0U3Rha2luZ1Bvd2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NTUKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnZXRTdGFraW5nUG93ZXIoc3Rha2luZ0FwcDogdWludDY0LCB1c2VyOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgYnl0ZWNfMyAvLyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NTYtNDY1CiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0SW5mbz4oewogICAgLy8gICBhcHBJZDogc3Rha2luZ0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHVzZXIsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQsCiAgICAvLyAgICAgICB0eXBlOiBTVEFLSU5HX1RZUEVfTE9DSywKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDYwLTQ2MwogICAgLy8gewogICAgLy8gICBhc3NldCwKICAgIC8vICAgdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICAvLyB9LAogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ2MgogICAgLy8gdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICBieXRlYyA4IC8vIDB4MjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDYwLTQ2MwogICAgLy8gewogICAgLy8gICBhc3NldCwKICAgIC8vICAgdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICAvLyB9LAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1Ni00NjUKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBzdGFraW5nQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgdXNlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldCwKICAgIC8vICAgICAgIHR5cGU6IFNUQUtJTkdfVFlQRV9MT0NLLAogICAgLy8gICAgIH0sCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YzkwNjg4MDkgLy8gbWV0aG9kICJnZXRJbmZvKGFkZHJlc3MsKHVpbnQ2NCx1aW50OCkpKHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0zCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cG4gMgogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgcHVzaGludCAyMCAvLyAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NjcKICAgIC8vIGlmIChpbmZvLmV4cGlyYXRpb24gPD0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCkgewogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgPD0KICAgIGJ6IGdldFN0YWtpbmdQb3dlcl9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDY4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRTdGFraW5nUG93ZXJfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzEKICAgIC8vIGNvbnN0IHJlbWFpbmluZ1RpbWU6IHVpbnQ2NCA9IGluZm8uZXhwaXJhdGlvbiAtIEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAKICAgIGZyYW1lX2RpZyAyCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAtCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzMKICAgIC8vIGlmIChyZW1haW5pbmdUaW1lIDwgT05FX1dFRUspIHsKICAgIHB1c2hpbnQgNjA0ODAwIC8vIDYwNDgwMAogICAgPAogICAgYnogZ2V0U3Rha2luZ1Bvd2VyX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzQKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldFN0YWtpbmdQb3dlcl9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ3NwogICAgLy8gY29uc3QgcmVtYWluaW5nRGF5czogdWludDY0ID0gcmVtYWluaW5nVGltZSAvIE9ORV9EQVkKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoaW50IDg2NDAwIC8vIDg2NDAwCiAgICAvCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ3OAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyh3aWRlUmF0aW8oW2luZm8uYW1vdW50LCAxXzAwMF8wMDBdLCBbT05FX1lFQVJfSU5fREFZUywgMV8wMDBfMDAwXSksIHJlbWFpbmluZ0RheXMpLCAxXzAwMF8wMDApCiAgICBmcmFtZV9kaWcgMQogICAgZXh0cmFjdCA0IDgKICAgIGludGMgNiAvLyAxMDAwMDAwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGR1cAogICAgYnl0ZWMgNDggLy8gMHgwMDAwMDAwMDAwMDAwMTZkMDAwMDAwMDAwMDBmNDI0MAogICAgY2FsbHN1YiB3aWRlUmF0aW8KICAgIGRpZyAyCiAgICBtdWx3CiAgICBwb3AKICAgIGNvdmVyIDIKICAgIGJ5dGVjIDQ4IC8vIDB4MDAwMDAwMDAwMDAwMDE2ZDAwMDAwMDAwMDAwZjQyNDAKICAgIGNhbGxzdWIgd2lkZVJhdGlvCiAgICBtdWx3CiAgICBidXJ5IDEKICAgIGludGMgNiAvLyAxMDAwMDAwCiAgICBkaXZ3CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2MwogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyMDAgLy8gMjAwCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMjY0IC8vIDI2NAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYwIC8vIDM2MAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4NAogICAgLy8gYXNzZXJ0KHRoaXMudmVyc2lvbi52YWx1ZSA9PT0gJycsIEVSUl9BTFJFQURZX0lOSVRJQUxJWkVEKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMgogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBpbml0aWFsVmFsdWU6ICcnLCBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgYnl0ZWMgMjMgLy8gInZlcnNpb24iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg0CiAgICAvLyBhc3NlcnQodGhpcy52ZXJzaW9uLnZhbHVlID09PSAnJywgRVJSX0FMUkVBRFlfSU5JVElBTElaRUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAiIgogICAgPT0KICAgIGFzc2VydCAvLyBBbHJlYWR5IGluaXRpYWxpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg1CiAgICAvLyBhc3NlcnQodmVyc2lvbiAhPT0gJycsIEVSUl9WRVJTSU9OX0NBTk5PVF9CRV9FTVBUWSkKICAgIGRpZyA3CiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIFZlcnNpb24gY2Fubm90IGJlIGVtcHR5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsgaW5pdGlhbFZhbHVlOiAnJywga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDIzIC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4NwogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gdmVyc2lvbgogICAgdW5jb3ZlciA4CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwcm9wb3NhbEFjdGlvbkxpbWl0ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0IH0pCiAgICBieXRlYyAxMyAvLyAicHJvcG9zYWxfYWN0aW9uX2xpbWl0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4OAogICAgLy8gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlID0gNQogICAgcHVzaGludCA1IC8vIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg5CiAgICAvLyB0aGlzLmFraXRhQXNzZXRzLnZhbHVlID0geyBha3RhLCBib25lczogMCB9CiAgICB1bmNvdmVyIDYKICAgIGl0b2IKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MgogICAgLy8gYWtpdGFBc3NldHMgPSBHbG9iYWxTdGF0ZTxBa2l0YUFzc2V0cz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMgfSkKICAgIGJ5dGVjIDExIC8vICJha2l0YV9hc3NldHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg5CiAgICAvLyB0aGlzLmFraXRhQXNzZXRzLnZhbHVlID0geyBha3RhLCBib25lczogMCB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBjb250ZW50UG9saWN5ID0gR2xvYmFsU3RhdGU8Q0lEPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNDb250ZW50UG9saWN5IH0pCiAgICBieXRlYyAyNCAvLyAiY29udGVudF9wb2xpY3kiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkwCiAgICAvLyB0aGlzLmNvbnRlbnRQb2xpY3kudmFsdWUgPSBjb250ZW50UG9saWN5CiAgICB1bmNvdmVyIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDAKICAgIC8vIG1pblJld2FyZHNJbXBhY3QgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3QgfSkKICAgIGJ5dGVjIDI1IC8vICJtaW5fcmV3YXJkc19pbXBhY3QiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkxCiAgICAvLyB0aGlzLm1pblJld2FyZHNJbXBhY3QudmFsdWUgPSBtaW5SZXdhcmRzSW1wYWN0CiAgICB1bmNvdmVyIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk0CiAgICAvLyBzdGFraW5nOiBhcHBzLnN0YWtpbmcsCiAgICBkaWcgMwogICAgZXh0cmFjdCAwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OTUKICAgIC8vIHJld2FyZHM6IGFwcHMucmV3YXJkcywKICAgIGRpZyA0CiAgICBleHRyYWN0IDggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5NgogICAgLy8gcG9vbDogYXBwcy5wb29sLAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5NwogICAgLy8gcHJpemVCb3g6IGFwcHMucHJpemVCb3gsCiAgICBkaWcgNgogICAgZXh0cmFjdCAyNCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk4CiAgICAvLyBzdWJzY3JpcHRpb25zOiBhcHBzLnN1YnNjcmlwdGlvbnMsCiAgICBkaWcgNwogICAgZXh0cmFjdCAzMiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk5CiAgICAvLyBnYXRlOiBhcHBzLmdhdGUsCiAgICBkaWcgOAogICAgZXh0cmFjdCA0MCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAwCiAgICAvLyBhdWN0aW9uOiBhcHBzLmF1Y3Rpb24sCiAgICBkaWcgOQogICAgZXh0cmFjdCA0OCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAxCiAgICAvLyBoeXBlclN3YXA6IGFwcHMuaHlwZXJTd2FwLAogICAgZGlnIDEwCiAgICBleHRyYWN0IDU2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDIKICAgIC8vIHJhZmZsZTogYXBwcy5yYWZmbGUsCiAgICBkaWcgMTEKICAgIGV4dHJhY3QgNjQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwMwogICAgLy8gbWV0YU1lcmtsZXM6IGFwcHMubWV0YU1lcmtsZXMsCiAgICBkaWcgMTIKICAgIGV4dHJhY3QgNzIgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwNAogICAgLy8gbWFya2V0cGxhY2U6IGFwcHMubWFya2V0cGxhY2UsCiAgICBkaWcgMTMKICAgIGV4dHJhY3QgODAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwNQogICAgLy8gd2FsbGV0OiBhcHBzLndhbGxldCwKICAgIGRpZyAxNAogICAgZXh0cmFjdCAxNzYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5My04MDYKICAgIC8vIHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlID0gewogICAgLy8gICBzdGFraW5nOiBhcHBzLnN0YWtpbmcsCiAgICAvLyAgIHJld2FyZHM6IGFwcHMucmV3YXJkcywKICAgIC8vICAgcG9vbDogYXBwcy5wb29sLAogICAgLy8gICBwcml6ZUJveDogYXBwcy5wcml6ZUJveCwKICAgIC8vICAgc3Vic2NyaXB0aW9uczogYXBwcy5zdWJzY3JpcHRpb25zLAogICAgLy8gICBnYXRlOiBhcHBzLmdhdGUsCiAgICAvLyAgIGF1Y3Rpb246IGFwcHMuYXVjdGlvbiwKICAgIC8vICAgaHlwZXJTd2FwOiBhcHBzLmh5cGVyU3dhcCwKICAgIC8vICAgcmFmZmxlOiBhcHBzLnJhZmZsZSwKICAgIC8vICAgbWV0YU1lcmtsZXM6IGFwcHMubWV0YU1lcmtsZXMsCiAgICAvLyAgIG1hcmtldHBsYWNlOiBhcHBzLm1hcmtldHBsYWNlLAogICAgLy8gICB3YWxsZXQ6IGFwcHMud2FsbGV0LAogICAgLy8gfQogICAgdW5jb3ZlciAxMQogICAgdW5jb3ZlciAxMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDEwCiAgICBjb25jYXQKICAgIHVuY292ZXIgOQogICAgY29uY2F0CiAgICB1bmNvdmVyIDgKICAgIGNvbmNhdAogICAgdW5jb3ZlciA3CiAgICBjb25jYXQKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIGFraXRhQXBwTGlzdCA9IEdsb2JhbFN0YXRlPEFraXRhQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0IH0pCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkzLTgwNgogICAgLy8gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUgPSB7CiAgICAvLyAgIHN0YWtpbmc6IGFwcHMuc3Rha2luZywKICAgIC8vICAgcmV3YXJkczogYXBwcy5yZXdhcmRzLAogICAgLy8gICBwb29sOiBhcHBzLnBvb2wsCiAgICAvLyAgIHByaXplQm94OiBhcHBzLnByaXplQm94LAogICAgLy8gICBzdWJzY3JpcHRpb25zOiBhcHBzLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGdhdGU6IGFwcHMuZ2F0ZSwKICAgIC8vICAgYXVjdGlvbjogYXBwcy5hdWN0aW9uLAogICAgLy8gICBoeXBlclN3YXA6IGFwcHMuaHlwZXJTd2FwLAogICAgLy8gICByYWZmbGU6IGFwcHMucmFmZmxlLAogICAgLy8gICBtZXRhTWVya2xlczogYXBwcy5tZXRhTWVya2xlcywKICAgIC8vICAgbWFya2V0cGxhY2U6IGFwcHMubWFya2V0cGxhY2UsCiAgICAvLyAgIHdhbGxldDogYXBwcy53YWxsZXQsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwOQogICAgLy8gc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIGRpZyAzCiAgICBleHRyYWN0IDEyMCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODEwCiAgICAvLyBncmFwaDogYXBwcy5ncmFwaCwKICAgIGRpZyA0CiAgICBleHRyYWN0IDEyOCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODExCiAgICAvLyBpbXBhY3Q6IGFwcHMuaW1wYWN0LAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTM2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgZGlnIDYKICAgIGV4dHJhY3QgMTQ0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDgtODEzCiAgICAvLyB0aGlzLmFraXRhU29jaWFsQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIC8vICAgZ3JhcGg6IGFwcHMuZ3JhcGgsCiAgICAvLyAgIGltcGFjdDogYXBwcy5pbXBhY3QsCiAgICAvLyAgIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgLy8gfQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0CiAgICAvLyBha2l0YVNvY2lhbEFwcExpc3QgPSBHbG9iYWxTdGF0ZTxBa2l0YVNvY2lhbEFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCB9KQogICAgYnl0ZWMgMjYgLy8gInNhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDgtODEzCiAgICAvLyB0aGlzLmFraXRhU29jaWFsQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIC8vICAgZ3JhcGg6IGFwcHMuZ3JhcGgsCiAgICAvLyAgIGltcGFjdDogYXBwcy5pbXBhY3QsCiAgICAvLyAgIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTYKICAgIC8vIG9wdGluOiBhcHBzLm9wdGluLAogICAgZGlnIDMKICAgIGV4dHJhY3QgOTYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgxNwogICAgLy8gcmV2ZW51ZU1hbmFnZXI6IGFwcHMucmV2ZW51ZU1hbmFnZXIsCiAgICBkaWcgNAogICAgZXh0cmFjdCAxMDQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgxOAogICAgLy8gdXBkYXRlOiBhcHBzLnVwZGF0ZQogICAgZGlnIDUKICAgIGV4dHJhY3QgMTEyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTUtODE5CiAgICAvLyB0aGlzLnBsdWdpbkFwcExpc3QudmFsdWUgPSB7CiAgICAvLyAgIG9wdGluOiBhcHBzLm9wdGluLAogICAgLy8gICByZXZlbnVlTWFuYWdlcjogYXBwcy5yZXZlbnVlTWFuYWdlciwKICAgIC8vICAgdXBkYXRlOiBhcHBzLnVwZGF0ZQogICAgLy8gfQogICAgY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcGx1Z2luQXBwTGlzdCA9IEdsb2JhbFN0YXRlPFBsdWdpbkFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QgfSkKICAgIGJ5dGVjIDE0IC8vICJwYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODE1LTgxOQogICAgLy8gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gewogICAgLy8gICBvcHRpbjogYXBwcy5vcHRpbiwKICAgIC8vICAgcmV2ZW51ZU1hbmFnZXI6IGFwcHMucmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIHVwZGF0ZTogYXBwcy51cGRhdGUKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODIyCiAgICAvLyB2cmZCZWFjb246IGFwcHMudnJmQmVhY29uLAogICAgZGlnIDMKICAgIGV4dHJhY3QgMTUyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjMKICAgIC8vIG5mZFJlZ2lzdHJ5OiBhcHBzLm5mZFJlZ2lzdHJ5LAogICAgZGlnIDQKICAgIGV4dHJhY3QgMTYwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjQKICAgIC8vIGFzc2V0SW5ib3g6IGFwcHMuYXNzZXRJbmJveCwKICAgIGRpZyA1CiAgICBleHRyYWN0IDE2OCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODI1CiAgICAvLyBlc2Nyb3c6IGFwcHMuZXNjcm93LAogICAgZGlnIDYKICAgIGV4dHJhY3QgMTg0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjYKICAgIC8vIGFraXRhTmZkOiBhcHBzLmFraXRhTmZkLAogICAgZGlnIDcKICAgIGV4dHJhY3QgODggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgyNwogICAgLy8gcG9sbDogYXBwcy5wb2xsCiAgICB1bmNvdmVyIDgKICAgIGV4dHJhY3QgMTkyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjEtODI4CiAgICAvLyB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgdnJmQmVhY29uOiBhcHBzLnZyZkJlYWNvbiwKICAgIC8vICAgbmZkUmVnaXN0cnk6IGFwcHMubmZkUmVnaXN0cnksCiAgICAvLyAgIGFzc2V0SW5ib3g6IGFwcHMuYXNzZXRJbmJveCwKICAgIC8vICAgZXNjcm93OiBhcHBzLmVzY3JvdywKICAgIC8vICAgYWtpdGFOZmQ6IGFwcHMuYWtpdGFOZmQsCiAgICAvLyAgIHBvbGw6IGFwcHMucG9sbAogICAgLy8gfQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgyMS04MjgKICAgIC8vIHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlID0gewogICAgLy8gICB2cmZCZWFjb246IGFwcHMudnJmQmVhY29uLAogICAgLy8gICBuZmRSZWdpc3RyeTogYXBwcy5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXNzZXRJbmJveDogYXBwcy5hc3NldEluYm94LAogICAgLy8gICBlc2Nyb3c6IGFwcHMuZXNjcm93LAogICAgLy8gICBha2l0YU5mZDogYXBwcy5ha2l0YU5mZCwKICAgIC8vICAgcG9sbDogYXBwcy5wb2xsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzMQogICAgLy8gY3JlYXRlRmVlOiBmZWVzLndhbGxldENyZWF0ZUZlZSwKICAgIGRpZyAyCiAgICBleHRyYWN0IDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzMgogICAgLy8gcmVmZXJyZXJQZXJjZW50YWdlOiBmZWVzLndhbGxldFJlZmVycmVyUGVyY2VudGFnZQogICAgZGlnIDMKICAgIGV4dHJhY3QgOCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODMwLTgzMwogICAgLy8gdGhpcy53YWxsZXRGZWVzLnZhbHVlID0gewogICAgLy8gICBjcmVhdGVGZWU6IGZlZXMud2FsbGV0Q3JlYXRlRmVlLAogICAgLy8gICByZWZlcnJlclBlcmNlbnRhZ2U6IGZlZXMud2FsbGV0UmVmZXJyZXJQZXJjZW50YWdlCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MAogICAgLy8gd2FsbGV0RmVlcyA9IEdsb2JhbFN0YXRlPFdhbGxldEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldEZlZXMgfSkKICAgIGJ5dGVjIDI3IC8vICJ3YWxsZXRfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzAtODMzCiAgICAvLyB0aGlzLndhbGxldEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGNyZWF0ZUZlZTogZmVlcy53YWxsZXRDcmVhdGVGZWUsCiAgICAvLyAgIHJlZmVycmVyUGVyY2VudGFnZTogZmVlcy53YWxsZXRSZWZlcnJlclBlcmNlbnRhZ2UKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM2CiAgICAvLyBwb3N0RmVlOiBmZWVzLnBvc3RGZWUsCiAgICBkaWcgMgogICAgZXh0cmFjdCAxNiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM3CiAgICAvLyByZWFjdEZlZTogZmVlcy5yZWFjdEZlZSwKICAgIGRpZyAzCiAgICBleHRyYWN0IDI0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzgKICAgIC8vIGltcGFjdFRheE1pbjogZmVlcy5pbXBhY3RUYXhNaW4sCiAgICBkaWcgNAogICAgZXh0cmFjdCAzMiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBpbXBhY3RUYXhNYXg6IGZlZXMuaW1wYWN0VGF4TWF4LAogICAgZGlnIDUKICAgIGV4dHJhY3QgNDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzNS04NDAKICAgIC8vIHRoaXMuc29jaWFsRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgcG9zdEZlZTogZmVlcy5wb3N0RmVlLAogICAgLy8gICByZWFjdEZlZTogZmVlcy5yZWFjdEZlZSwKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLmltcGFjdFRheE1pbiwKICAgIC8vICAgaW1wYWN0VGF4TWF4OiBmZWVzLmltcGFjdFRheE1heCwKICAgIC8vIH0KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MgogICAgLy8gc29jaWFsRmVlcyA9IEdsb2JhbFN0YXRlPFNvY2lhbEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMgfSkKICAgIGJ5dGVjIDI4IC8vICJzb2NpYWxfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzUtODQwCiAgICAvLyB0aGlzLnNvY2lhbEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIHBvc3RGZWU6IGZlZXMucG9zdEZlZSwKICAgIC8vICAgcmVhY3RGZWU6IGZlZXMucmVhY3RGZWUsCiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5pbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5pbXBhY3RUYXhNYXgsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0MwogICAgLy8gY3JlYXRpb25GZWU6IGZlZXMucG9vbENyZWF0aW9uRmVlLAogICAgZGlnIDIKICAgIGV4dHJhY3QgNDggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0NAogICAgLy8gaW1wYWN0VGF4TWluOiBmZWVzLnBvb2xJbXBhY3RUYXhNaW4sCiAgICBkaWcgMwogICAgZXh0cmFjdCA1NiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQ1CiAgICAvLyBpbXBhY3RUYXhNYXg6IGZlZXMucG9vbEltcGFjdFRheE1heAogICAgZGlnIDQKICAgIGV4dHJhY3QgNjQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0Mi04NDYKICAgIC8vIHRoaXMuc3Rha2luZ0ZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGNyZWF0aW9uRmVlOiBmZWVzLnBvb2xDcmVhdGlvbkZlZSwKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLnBvb2xJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5wb29sSW1wYWN0VGF4TWF4CiAgICAvLyB9CiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU0CiAgICAvLyBzdGFraW5nRmVlcyA9IEdsb2JhbFN0YXRlPFN0YWtpbmdGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdGFraW5nRmVlcyB9KQogICAgYnl0ZWMgMjkgLy8gInN0YWtpbmdfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDItODQ2CiAgICAvLyB0aGlzLnN0YWtpbmdGZWVzLnZhbHVlID0gewogICAgLy8gICBjcmVhdGlvbkZlZTogZmVlcy5wb29sQ3JlYXRpb25GZWUsCiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5wb29sSW1wYWN0VGF4TWluLAogICAgLy8gICBpbXBhY3RUYXhNYXg6IGZlZXMucG9vbEltcGFjdFRheE1heAogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDkKICAgIC8vIHNlcnZpY2VDcmVhdGlvbkZlZTogZmVlcy5zdWJzY3JpcHRpb25TZXJ2aWNlQ3JlYXRpb25GZWUsCiAgICBkaWcgMgogICAgZXh0cmFjdCA3MiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODUwCiAgICAvLyBwYXltZW50UGVyY2VudGFnZTogZmVlcy5zdWJzY3JpcHRpb25QYXltZW50UGVyY2VudGFnZSwKICAgIGRpZyAzCiAgICBleHRyYWN0IDgwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTEKICAgIC8vIHRyaWdnZXJQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblRyaWdnZXJQZXJjZW50YWdlLAogICAgZGlnIDQKICAgIGV4dHJhY3QgODggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0OC04NTIKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgc2VydmljZUNyZWF0aW9uRmVlOiBmZWVzLnN1YnNjcmlwdGlvblNlcnZpY2VDcmVhdGlvbkZlZSwKICAgIC8vICAgcGF5bWVudFBlcmNlbnRhZ2U6IGZlZXMuc3Vic2NyaXB0aW9uUGF5bWVudFBlcmNlbnRhZ2UsCiAgICAvLyAgIHRyaWdnZXJQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblRyaWdnZXJQZXJjZW50YWdlLAogICAgLy8gfQogICAgY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NgogICAgLy8gc3Vic2NyaXB0aW9uRmVlcyA9IEdsb2JhbFN0YXRlPFN1YnNjcmlwdGlvbkZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N1YnNjcmlwdGlvbkZlZXMgfSkKICAgIGJ5dGVjIDMwIC8vICJzdWJzY3JpcHRpb25fZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDgtODUyCiAgICAvLyB0aGlzLnN1YnNjcmlwdGlvbkZlZXMudmFsdWUgPSB7CiAgICAvLyAgIHNlcnZpY2VDcmVhdGlvbkZlZTogZmVlcy5zdWJzY3JpcHRpb25TZXJ2aWNlQ3JlYXRpb25GZWUsCiAgICAvLyAgIHBheW1lbnRQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblBheW1lbnRQZXJjZW50YWdlLAogICAgLy8gICB0cmlnZ2VyUGVyY2VudGFnZTogZmVlcy5zdWJzY3JpcHRpb25UcmlnZ2VyUGVyY2VudGFnZSwKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODU1CiAgICAvLyBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWluOiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNaW4sCiAgICBkaWcgMgogICAgZXh0cmFjdCA5NiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODU2CiAgICAvLyBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4OiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNYXgsCiAgICBkaWcgMwogICAgZXh0cmFjdCAxMDQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg1NwogICAgLy8gbWFya2V0cGxhY2VDb21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5tYXJrZXRwbGFjZUNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgZGlnIDQKICAgIGV4dHJhY3QgMTEyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTgKICAgIC8vIG1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlLAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTIwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTkKICAgIC8vIHNodWZmbGVTYWxlUGVyY2VudGFnZTogZmVlcy5zaHVmZmxlU2FsZVBlcmNlbnRhZ2UsCiAgICBkaWcgNgogICAgZXh0cmFjdCAxMjggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2MAogICAgLy8gb21uaWdlbVNhbGVGZWU6IGZlZXMub21uaWdlbVNhbGVGZWUsCiAgICBkaWcgNwogICAgZXh0cmFjdCAxMzYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2MQogICAgLy8gYXVjdGlvbkNyZWF0aW9uRmVlOiBmZWVzLmF1Y3Rpb25DcmVhdGlvbkZlZSwKICAgIGRpZyA4CiAgICBleHRyYWN0IDE0NCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYyCiAgICAvLyBhdWN0aW9uU2FsZUltcGFjdFRheE1pbjogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1pbiwKICAgIGRpZyA5CiAgICBleHRyYWN0IDE1MiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYzCiAgICAvLyBhdWN0aW9uU2FsZUltcGFjdFRheE1heDogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1heCwKICAgIGRpZyAxMAogICAgZXh0cmFjdCAxNjAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2NAogICAgLy8gYXVjdGlvbkNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLmF1Y3Rpb25Db21wb3NhYmxlUGVyY2VudGFnZSwKICAgIGRpZyAxMQogICAgZXh0cmFjdCAxNjggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2NQogICAgLy8gYXVjdGlvblJhZmZsZVBlcmNlbnRhZ2U6IGZlZXMuYXVjdGlvblJhZmZsZVBlcmNlbnRhZ2UsCiAgICBkaWcgMTIKICAgIGV4dHJhY3QgMTc2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NjYKICAgIC8vIHJhZmZsZUNyZWF0aW9uRmVlOiBmZWVzLnJhZmZsZUNyZWF0aW9uRmVlLAogICAgZGlnIDEzCiAgICBleHRyYWN0IDE4NCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODY3CiAgICAvLyByYWZmbGVTYWxlSW1wYWN0VGF4TWluOiBmZWVzLnJhZmZsZVNhbGVJbXBhY3RUYXhNaW4sCiAgICBkaWcgMTQKICAgIGV4dHJhY3QgMTkyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NjgKICAgIC8vIHJhZmZsZVNhbGVJbXBhY3RUYXhNYXg6IGZlZXMucmFmZmxlU2FsZUltcGFjdFRheE1heCwKICAgIGRpZyAxNQogICAgZXh0cmFjdCAyMDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2OQogICAgLy8gcmFmZmxlQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMucmFmZmxlQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICBkaWcgMTYKICAgIGV4dHJhY3QgMjA4IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTQtODcwCiAgICAvLyB0aGlzLm5mdEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIG1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNaW46IGZlZXMubWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1pbiwKICAgIC8vICAgbWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1heDogZmVlcy5tYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4LAogICAgLy8gICBtYXJrZXRwbGFjZUNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIG1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlLAogICAgLy8gICBzaHVmZmxlU2FsZVBlcmNlbnRhZ2U6IGZlZXMuc2h1ZmZsZVNhbGVQZXJjZW50YWdlLAogICAgLy8gICBvbW5pZ2VtU2FsZUZlZTogZmVlcy5vbW5pZ2VtU2FsZUZlZSwKICAgIC8vICAgYXVjdGlvbkNyZWF0aW9uRmVlOiBmZWVzLmF1Y3Rpb25DcmVhdGlvbkZlZSwKICAgIC8vICAgYXVjdGlvblNhbGVJbXBhY3RUYXhNaW46IGZlZXMuYXVjdGlvblNhbGVJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGF1Y3Rpb25TYWxlSW1wYWN0VGF4TWF4OiBmZWVzLmF1Y3Rpb25TYWxlSW1wYWN0VGF4TWF4LAogICAgLy8gICBhdWN0aW9uQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMuYXVjdGlvbkNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgLy8gICBhdWN0aW9uUmFmZmxlUGVyY2VudGFnZTogZmVlcy5hdWN0aW9uUmFmZmxlUGVyY2VudGFnZSwKICAgIC8vICAgcmFmZmxlQ3JlYXRpb25GZWU6IGZlZXMucmFmZmxlQ3JlYXRpb25GZWUsCiAgICAvLyAgIHJhZmZsZVNhbGVJbXBhY3RUYXhNaW46IGZlZXMucmFmZmxlU2FsZUltcGFjdFRheE1pbiwKICAgIC8vICAgcmFmZmxlU2FsZUltcGFjdFRheE1heDogZmVlcy5yYWZmbGVTYWxlSW1wYWN0VGF4TWF4LAogICAgLy8gICByYWZmbGVDb21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5yYWZmbGVDb21wb3NhYmxlUGVyY2VudGFnZSwKICAgIC8vIH0KICAgIHVuY292ZXIgMTQKICAgIHVuY292ZXIgMTQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDEyCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgY29uY2F0CiAgICB1bmNvdmVyIDYKICAgIGNvbmNhdAogICAgdW5jb3ZlciA1CiAgICBjb25jYXQKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4CiAgICAvLyBuZnRGZWVzID0gR2xvYmFsU3RhdGU8TkZURmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTkZURmVlcyB9KQogICAgYnl0ZWMgMzEgLy8gIm5mdF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg1NC04NzAKICAgIC8vIHRoaXMubmZ0RmVlcy52YWx1ZSA9IHsKICAgIC8vICAgbWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1pbjogZmVlcy5tYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWluLAogICAgLy8gICBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4OiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNYXgsCiAgICAvLyAgIG1hcmtldHBsYWNlQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMubWFya2V0cGxhY2VDb21wb3NhYmxlUGVyY2VudGFnZSwKICAgIC8vICAgbWFya2V0cGxhY2VSb3lhbHR5RGVmYXVsdFBlcmNlbnRhZ2U6IGZlZXMubWFya2V0cGxhY2VSb3lhbHR5RGVmYXVsdFBlcmNlbnRhZ2UsCiAgICAvLyAgIHNodWZmbGVTYWxlUGVyY2VudGFnZTogZmVlcy5zaHVmZmxlU2FsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIG9tbmlnZW1TYWxlRmVlOiBmZWVzLm9tbmlnZW1TYWxlRmVlLAogICAgLy8gICBhdWN0aW9uQ3JlYXRpb25GZWU6IGZlZXMuYXVjdGlvbkNyZWF0aW9uRmVlLAogICAgLy8gICBhdWN0aW9uU2FsZUltcGFjdFRheE1pbjogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1pbiwKICAgIC8vICAgYXVjdGlvblNhbGVJbXBhY3RUYXhNYXg6IGZlZXMuYXVjdGlvblNhbGVJbXBhY3RUYXhNYXgsCiAgICAvLyAgIGF1Y3Rpb25Db21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5hdWN0aW9uQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIGF1Y3Rpb25SYWZmbGVQZXJjZW50YWdlOiBmZWVzLmF1Y3Rpb25SYWZmbGVQZXJjZW50YWdlLAogICAgLy8gICByYWZmbGVDcmVhdGlvbkZlZTogZmVlcy5yYWZmbGVDcmVhdGlvbkZlZSwKICAgIC8vICAgcmFmZmxlU2FsZUltcGFjdFRheE1pbjogZmVlcy5yYWZmbGVTYWxlSW1wYWN0VGF4TWluLAogICAgLy8gICByYWZmbGVTYWxlSW1wYWN0VGF4TWF4OiBmZWVzLnJhZmZsZVNhbGVJbXBhY3RUYXhNYXgsCiAgICAvLyAgIHJhZmZsZUNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLnJhZmZsZUNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzMKICAgIC8vIGltcGFjdFRheE1pbjogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWluLAogICAgZGlnIDIKICAgIGV4dHJhY3QgMjE2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzQKICAgIC8vIGltcGFjdFRheE1heDogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWF4LAogICAgdW5jb3ZlciAzCiAgICBleHRyYWN0IDIyNCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODcyLTg3NQogICAgLy8gdGhpcy5zd2FwRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLnN3YXBGZWVJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWF4LAogICAgLy8gfQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAKICAgIC8vIHN3YXBGZWVzID0gR2xvYmFsU3RhdGU8U3dhcEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzIH0pCiAgICBieXRlYyAzMiAvLyAic3dhcF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3Mi04NzUKICAgIC8vIHRoaXMuc3dhcEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWluLAogICAgLy8gICBpbXBhY3RUYXhNYXg6IGZlZXMuc3dhcEZlZUltcGFjdFRheE1heCwKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc3CiAgICAvLyB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGdyYWRlQXBwKQogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0MAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4CiAgICAvLyB1cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxNSAvLyAidXBncmFkZV9hcHBfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc3CiAgICAvLyB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGdyYWRlQXBwKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzgKICAgIC8vIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MuYWRkUGx1Z2luKQogICAgZGlnIDEKICAgIGV4dHJhY3QgNDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MAogICAgLy8gYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZFBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3OAogICAgLy8gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5hZGRQbHVnaW4pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3OQogICAgLy8gdGhpcy5yZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlRXhlY3V0ZVBsdWdpbikKICAgIGRpZyAxCiAgICBleHRyYWN0IDgwIDQwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQKICAgIC8vIHJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlRXhlY3V0ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDQ5IC8vICJyZW1vdmVfZXhlY3V0ZV9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc5CiAgICAvLyB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVFeGVjdXRlUGx1Z2luKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlUGx1Z2luKQogICAgZGlnIDEKICAgIGV4dHJhY3QgMTIwIDQwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIKICAgIC8vIHJlbW92ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxNyAvLyAicmVtb3ZlX3BsdWdpbl9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlUGx1Z2luKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLmFkZEFsbG93YW5jZSkKICAgIGRpZyAxCiAgICBleHRyYWN0IDE2MCA0MAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2CiAgICAvLyBhZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxOCAvLyAiYWRkX2FsbG93YW5jZV9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLmFkZEFsbG93YW5jZSkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVBbGxvd2FuY2UpCiAgICBkaWcgMQogICAgZXh0cmFjdCAyMDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVBbGxvd2FuY2UpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4MwogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5uZXdFc2Nyb3cpCiAgICBkaWcgMQogICAgZXh0cmFjdCAyNDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MAogICAgLy8gbmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4MwogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5uZXdFc2Nyb3cpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NAogICAgLy8gdGhpcy50b2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MudG9nZ2xlRXNjcm93TG9jaykKICAgIGRpZyAxCiAgICBwdXNoaW50cyAyODAgNDAgLy8gMjgwLCA0MAogICAgZXh0cmFjdDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNUb2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNTAgLy8gInRvZ2dsZV9lc2Nyb3dfbG9ja19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODQKICAgIC8vIHRoaXMudG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLnRvZ2dsZUVzY3Jvd0xvY2spCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGRhdGVGaWVsZHMpCiAgICBzd2FwCiAgICBwdXNoaW50cyAzMjAgNDAgLy8gMzIwLCA0MAogICAgZXh0cmFjdDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZGF0ZUZpZWxkc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGRhdGVGaWVsZHMpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0CiAgICAvLyByZXZlbnVlU3BsaXRzID0gR2xvYmFsU3RhdGU8U3BsaXRbXT4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0cyB9KQogICAgYnl0ZWMgMzMgLy8gInJldmVudWVfc3BsaXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NwogICAgLy8gdGhpcy5yZXZlbnVlU3BsaXRzLnZhbHVlID0gY2xvbmUocmV2ZW51ZVNwbGl0cykKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHByb3Bvc2FsSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsSUQgfSkKICAgIGJ5dGVjIDM3IC8vICJwcm9wb3NhbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODkKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSA9IDEKICAgIGludGNfMSAvLyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2MwogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIyCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy53YWxsZXQudmFsdWUuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIyCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy53YWxsZXQudmFsdWUuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkyMwogICAgLy8gY29uc3QgdXBkYXRlUGx1Z2luID0gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlLnVwZGF0ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcGx1Z2luQXBwTGlzdCA9IEdsb2JhbFN0YXRlPFBsdWdpbkFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QgfSkKICAgIGJ5dGVjIDE0IC8vICJwYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIzCiAgICAvLyBjb25zdCB1cGRhdGVQbHVnaW4gPSB0aGlzLnBsdWdpbkFwcExpc3QudmFsdWUudXBkYXRlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjQKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCB1cGdyYWRlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsgaW5pdGlhbFZhbHVlOiAnJywga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDIzIC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkyNQogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gbmV3VmVyc2lvbgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc2V0dXBbcm91dGluZ10oKSAtPiB2b2lkOgpzZXR1cDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjkKICAgIC8vIHNldHVwKG5pY2tuYW1lOiBzdHJpbmcpOiB1aW50NjQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzAKICAgIC8vIGFzc2VydCghdGhpcy53YWxsZXQuaGFzVmFsdWUsIEVSUl9XQUxMRVRfQUxSRUFEWV9TRVRVUCkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzAKICAgIC8vIGFzc2VydCghdGhpcy53YWxsZXQuaGFzVmFsdWUsIEVSUl9XQUxMRVRfQUxSRUFEWV9TRVRVUCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBXYWxsZXQgYWxyZWFkeSBzZXR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzMgogICAgLy8gY29uc3QgeyB3YWxsZXQ6IGFwcElkIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzIKICAgIC8vIGNvbnN0IHsgd2FsbGV0OiBhcHBJZCB9ID0gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDg4IC8vIDg4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzNAogICAgLy8gY29uc3QgY29zdCA9IHRoaXMuc2V0dXBDb3N0KCkKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzYtOTQ4CiAgICAvLyBjb25zdCB3YWxsZXRJRCA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50RmFjdG9yeS5wcm90b3R5cGUubmV3QWNjb3VudD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24oYXBwSWQpLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IGNvc3QsCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgIEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIG5pY2tuYW1lLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NDAKICAgIC8vIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihhcHBJZCkuYWRkcmVzcywKICAgIGRpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzOS05NDIKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihhcHBJZCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBjb3N0LAogICAgLy8gfSksCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTM2LTk0OAogICAgLy8gY29uc3Qgd2FsbGV0SUQgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudEZhY3RvcnkucHJvdG90eXBlLm5ld0FjY291bnQ+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGFwcElkKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBjb3N0LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBuaWNrbmFtZSwKICAgIC8vICAgICBHbG9iYWwuemVyb0FkZHJlc3MsCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NDMKICAgIC8vIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk0NAogICAgLy8gR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk0NgogICAgLy8gR2xvYmFsLnplcm9BZGRyZXNzLAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTM2LTk0OAogICAgLy8gY29uc3Qgd2FsbGV0SUQgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudEZhY3RvcnkucHJvdG90eXBlLm5ld0FjY291bnQ+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGFwcElkKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBjb3N0LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBuaWNrbmFtZSwKICAgIC8vICAgICBHbG9iYWwuemVyb0FkZHJlc3MsCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgzNzQ4NTU0MSAvLyBtZXRob2QgIm5ld0FjY291bnQocGF5LGFkZHJlc3MsYWRkcmVzcyxzdHJpbmcsYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBnaXR4biAxIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGR1cAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTUwCiAgICAvLyB0aGlzLndhbGxldC52YWx1ZSA9IEFwcGxpY2F0aW9uKHdhbGxldElEKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjkKICAgIC8vIHNldHVwKG5pY2tuYW1lOiBzdHJpbmcpOiB1aW50NjQgewogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucGFydGlhbGx5SW5pdGlhbGl6ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnBhcnRpYWxseUluaXRpYWxpemU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTU2CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzLCBFUlJfRk9SQklEREVOKQogICAgdHhuIFNlbmRlcgogICAgZ2xvYmFsIENyZWF0b3JBZGRyZXNzCiAgICA9PQogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3Igd2FsbGV0IGNhbiBkZWxldGUgYSBzcGVuZGluZyBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1NwogICAgLy8gdGhpcy5zdGF0ZS52YWx1ZSA9IERhb1N0YXRlUGFydGlhbGx5SW5pdGlhbGl6ZWQKICAgIHB1c2hieXRlcyAweDAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1NQogICAgLy8gcGFydGlhbGx5SW5pdGlhbGl6ZSgpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmluaXRpYWxpemVbcm91dGluZ10oKSAtPiB2b2lkOgppbml0aWFsaXplOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk2MQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcywgRVJSX0ZPUkJJRERFTikKICAgIHR4biBTZW5kZXIKICAgIGdsb2JhbCBDcmVhdG9yQWRkcmVzcwogICAgPT0KICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIHRoaXMuc3RhdGUudmFsdWUgPSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQKICAgIGJ5dGVjIDM0IC8vIDB4MDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTYwCiAgICAvLyBpbml0aWFsaXplKCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpuZXdQcm9wb3NhbDoKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDE1CiAgICBieXRlY18zIC8vICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjcKICAgIC8vIG5ld1Byb3Bvc2FsKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB1aW50NjQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTY4CiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OAogICAgLy8gb3RoZXJBcHBMaXN0ID0gR2xvYmFsU3RhdGU8T3RoZXJBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3QgfSkKICAgIGJ5dGVjIDUgLy8gIm9hbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjgKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0T3JpZ2luCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTY5CiAgICAvLyBjb25zdCB7IHRvdGFsLCBwb3dlciB9ID0gdGhpcy5wcm9wb3NhbENvc3QoYWN0aW9ucykKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3MQogICAgLy8gbGV0IGZlZTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzMKICAgIC8vIHRoaXMuc3RhdGUudmFsdWUgPT09IERhb1N0YXRlRnVsbHlJbml0aWFsaXplZCB8fAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3MwogICAgLy8gdGhpcy5zdGF0ZS52YWx1ZSA9PT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgMzQgLy8gMHgwMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzMtOTc0CiAgICAvLyB0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQgfHwKICAgIC8vICh0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZVBhcnRpYWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgIT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykKICAgIGJueiBuZXdQcm9wb3NhbF9pZl9ib2R5QDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vICh0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZVBhcnRpYWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgIT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3NAogICAgLy8gKHRoaXMuc3RhdGUudmFsdWUgPT09IERhb1N0YXRlUGFydGlhbGx5SW5pdGlhbGl6ZWQgJiYgVHhuLnNlbmRlciAhPT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDAxCiAgICA9PQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1CiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgICE9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDUKCm5ld1Byb3Bvc2FsX2lmX2JvZHlANDoKICAgIGRpZyAyCiAgICBidXJ5IDEKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzktOTg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IGZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgNgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk4MgogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzktOTg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IGZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICBkaWcgMgogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc5CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPiAwLCBFUlJfRU1QVFlfQUNUSU9OX0xJU1QpCiAgICBkaWcgMwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGJ1cnkgMTIKICAgIGFzc2VydCAvLyBBY3Rpb24gbGlzdCBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CgpuZXdQcm9wb3NhbF93aGlsZV90b3BANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgOAogICAgZGlnIDExCiAgICA8CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl93aGlsZUA2OAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE4MgogICAgLy8gc3dpdGNoIChhY3Rpb25zW2ldLnR5cGUpIHsKICAgIGRpZyAzCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDkKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBidXJ5IDEyCiAgICBkaWcgMTMKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDIxCiAgICBleHRyYWN0IDAgMQogICAgZHVwCiAgICBidXJ5IDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZ3JhZGVBcHA6IHsKICAgIGJ5dGVjIDIyIC8vIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgzLTE5MQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICAvLyAgIC8vIFVwZ3JhZGVBcHAgYWN0aW9ucyBhcmUgdmFsaWRhdGVkIGxhdGVyIGR1cmluZyBleGVjdXRpb24KICAgIC8vICAgLy8gVGhleSBuZWVkIGV4ZWN1dGlvbiBrZXkgYW5kIHdhbGxldCBidWlsZCB2YWxpZGF0aW9uCiAgICAvLyAgIGNvbnN0IHsgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWQgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGdyYWRlQXBwPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGFzc2VydChncm91cHMubGVuZ3RoID4gMCwgJ1VwZ3JhZGUgYXBwIGFjdGlvbiBtdXN0IGhhdmUgYXQgbGVhc3Qgb25lIGdyb3VwJykKICAgIC8vICAgYXNzZXJ0KGZpcnN0VmFsaWQgPiAwLCAnRmlyc3QgdmFsaWQgcm91bmQgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybycpCiAgICAvLyAgIGFzc2VydChsYXN0VmFsaWQgPiBmaXJzdFZhbGlkLCAnTGFzdCB2YWxpZCByb3VuZCBtdXN0IGJlIGdyZWF0ZXIgdGhhbiBmaXJzdCB2YWxpZCcpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg2CiAgICAvLyBjb25zdCB7IGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsVXBncmFkZUFwcD4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZGlnIDEKICAgIHB1c2hpbnQgNDQgLy8gNDQKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNTIgLy8gNTIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg3CiAgICAvLyBhc3NlcnQoZ3JvdXBzLmxlbmd0aCA+IDAsICdVcGdyYWRlIGFwcCBhY3Rpb24gbXVzdCBoYXZlIGF0IGxlYXN0IG9uZSBncm91cCcpCiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgYXNzZXJ0IC8vIFVwZ3JhZGUgYXBwIGFjdGlvbiBtdXN0IGhhdmUgYXQgbGVhc3Qgb25lIGdyb3VwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg4CiAgICAvLyBhc3NlcnQoZmlyc3RWYWxpZCA+IDAsICdGaXJzdCB2YWxpZCByb3VuZCBtdXN0IGJlIGdyZWF0ZXIgdGhhbiB6ZXJvJykKICAgIGRpZyAxCiAgICBhc3NlcnQgLy8gRmlyc3QgdmFsaWQgcm91bmQgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE4OQogICAgLy8gYXNzZXJ0KGxhc3RWYWxpZCA+IGZpcnN0VmFsaWQsICdMYXN0IHZhbGlkIHJvdW5kIG11c3QgYmUgZ3JlYXRlciB0aGFuIGZpcnN0IHZhbGlkJykKICAgIDwKICAgIGFzc2VydCAvLyBMYXN0IHZhbGlkIHJvdW5kIG11c3QgYmUgZ3JlYXRlciB0aGFuIGZpcnN0IHZhbGlkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTItMTk5CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3csIGZlZSwgcG93ZXIsIGR1cmF0aW9uLCBwYXJ0aWNpcGF0aW9uLCBhcHByb3ZhbCwgdXNlRXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGlmICh1c2VFeGVjdXRpb25LZXkpIHsKICAgIC8vICAgICB0aGlzLnZhbGlkYXRlU2V0dGluZ3MoeyBmZWUsIHBvd2VyLCBkdXJhdGlvbiwgcGFydGljaXBhdGlvbiwgYXBwcm92YWwgfSkKICAgIC8vICAgfQogICAgLy8gICBhc3NlcnQoIXRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9BTFJFQURZX0VYSVNUUykKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTkzCiAgICAvLyBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3csIGZlZSwgcG93ZXIsIGR1cmF0aW9uLCBwYXJ0aWNpcGF0aW9uLCBhcHByb3ZhbCwgdXNlRXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAyIDgKICAgIGJ1cnkgMjUKICAgIGRpZyAxCiAgICBleHRyYWN0IDEwIDMyCiAgICBidXJ5IDE4CiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgcHVzaGludCA1OSAvLyA1OQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxNwogICAgZGlnIDEKICAgIGV4dHJhY3QgNjQgOAogICAgYnVyeSAyNAogICAgZGlnIDEKICAgIGV4dHJhY3QgNzIgOAogICAgYnVyeSAyMwogICAgZGlnIDEKICAgIGV4dHJhY3QgODAgOAogICAgYnVyeSAyOQogICAgZGlnIDEKICAgIGV4dHJhY3QgODggOAogICAgYnVyeSAyOAogICAgc3dhcAogICAgZXh0cmFjdCA5NiA4CiAgICBidXJ5IDI2CiAgICBwdXNoaW50IDQ4OSAvLyA0ODkKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gaWYgKHVzZUV4ZWN1dGlvbktleSkgewogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5NQogICAgLy8gdGhpcy52YWxpZGF0ZVNldHRpbmdzKHsgZmVlLCBwb3dlciwgZHVyYXRpb24sIHBhcnRpY2lwYXRpb24sIGFwcHJvdmFsIH0pCiAgICBkaWcgMjEKICAgIGRpZyAyMQogICAgY29uY2F0CiAgICBkaWcgMjcKICAgIGNvbmNhdAogICAgZGlnIDI2CiAgICBjb25jYXQKICAgIGRpZyAyNQogICAgY29uY2F0CiAgICBjYWxsc3ViIHZhbGlkYXRlU2V0dGluZ3MKICAgIHBvcAoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTcKICAgIC8vIGFzc2VydCghdGhpcy5wbHVnaW5FeGlzdHMoeyBwbHVnaW4sIGNhbGxlciwgZXNjcm93IH0pLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgZGlnIDIyCiAgICBkaWcgMTYKICAgIGNvbmNhdAogICAgZGlnIDE1CiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWMgMzggLy8gMHgwMDJhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgY2FsbHN1YiBwbHVnaW5FeGlzdHMKICAgIHBvcAogICAgIQogICAgYXNzZXJ0IC8vIFBsdWdpbiBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5OAogICAgLy8gYnJlYWs7CiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGROYW1lZFBsdWdpbjogewogICAgZGlnIDIzCiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMC0yMDQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KCF0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMQogICAgLy8gY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gYXNzZXJ0KCF0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgY2FsbHN1YiBuYW1lZFBsdWdpbkV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFBsdWdpbiBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA1CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgMTAgLy8gMHgxZQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMDUtMjEzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBwbHVnaW4sIGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbEV4ZWN1dGVQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgY29uc3QgcGx1Z2luSW5mbyA9IHRoaXMuZ2V0UGx1Z2luKHsgcGx1Z2luLCBjYWxsZXI6IEdsb2JhbC56ZXJvQWRkcmVzcywgZXNjcm93IH0pCiAgICAvLyAgIGFzc2VydChwbHVnaW5JbmZvLnN0YXJ0ICE9PSAwLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICBhc3NlcnQocGx1Z2luSW5mby51c2VFeGVjdXRpb25LZXksIEVSUl9OT1RfRVhFQ1VUQUJMRV9QTFVHSU4pCiAgICAvLyAgIGNvbnN0IGVwb2NoUmVmID0gcGx1Z2luSW5mby51c2VSb3VuZHMgPyBHbG9iYWwucm91bmQgOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICAvLyAgIGFzc2VydChwbHVnaW5JbmZvLmxhc3RWYWxpZCA+IGVwb2NoUmVmLCBFUlJfUExVR0lOX0VYUElSRUQpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA2CiAgICAvLyBjb25zdCB7IHBsdWdpbiwgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgc3dhcAogICAgZXh0cmFjdCAyIDgKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAzCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwNwogICAgLy8gY29uc3QgcGx1Z2luSW5mbyA9IHRoaXMuZ2V0UGx1Z2luKHsgcGx1Z2luLCBjYWxsZXI6IEdsb2JhbC56ZXJvQWRkcmVzcywgZXNjcm93IH0pCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjIDM4IC8vIDB4MDAyYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjMtMTI2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldFBsdWdpbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2tleV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjUKICAgIC8vIGFyZ3M6IFtba2V5XV0KICAgIGJ5dGVjIDM2IC8vIDB4MDAwMTAwMDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIzLTEyNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRQbHVnaW5zPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW1trZXldXQogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiYXJjNThfZ2V0UGx1Z2lucygodWludDY0LGFkZHJlc3Msc3RyaW5nKVtdKSh1aW50NjQsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQsdWludDY0KVtdLGJvb2wsYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0KVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2VydChwbHVnaW5JbmZvLnN0YXJ0ICE9PSAwLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgc3dhcAogICAgZXh0cmFjdCA2IDAKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMgogICAgbGVuCiAgICBkaWcgMwogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDIwCiAgICBkdXAKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA5CiAgICAvLyBhc3NlcnQocGx1Z2luSW5mby51c2VFeGVjdXRpb25LZXksIEVSUl9OT1RfRVhFQ1VUQUJMRV9QTFVHSU4pCiAgICBkdXAKICAgIHB1c2hpbnQgMjE4IC8vIDIxOAogICAgZ2V0Yml0CiAgICBhc3NlcnQgLy8gUGx1Z2luIGlzIG5vdCBleGVjdXRhYmxlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBjb25zdCBlcG9jaFJlZiA9IHBsdWdpbkluZm8udXNlUm91bmRzID8gR2xvYmFsLnJvdW5kIDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgcHVzaGludCAyMTcgLy8gMjE3CiAgICBnZXRiaXQKICAgIGJ6IG5ld1Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTkKICAgIGdsb2JhbCBSb3VuZAoKbmV3UHJvcG9zYWxfdGVybmFyeV9tZXJnZUAyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTEKICAgIC8vIGFzc2VydChwbHVnaW5JbmZvLmxhc3RWYWxpZCA+IGVwb2NoUmVmLCBFUlJfUExVR0lOX0VYUElSRUQpCiAgICBkaWcgMTkKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBleHRyYWN0X3VpbnQ2NAogICAgPAogICAgYXNzZXJ0IC8vIFBsdWdpbiBoYXMgZXhwaXJlZAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxMgogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBjb25zdCBlcG9jaFJlZiA9IHBsdWdpbkluZm8udXNlUm91bmRzID8gR2xvYmFsLnJvdW5kIDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgYiBuZXdQcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDIwCgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxNAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE0LTIxOAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICAvLyAgIGNvbnN0IHsgZXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgLy8gICBhc3NlcnQodGhpcy5leGVjdXRpb25FeGlzdHMoZXhlY3V0aW9uS2V5KSwgRVJSX0VYRUNVVElPTl9LRVlfTk9UX0ZPVU5EKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxNQogICAgLy8gY29uc3QgeyBleGVjdXRpb25LZXkgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVFeGVjdXRlUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzAtMTczCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEV4ZWN1dGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2xlYXNlXV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE3MgogICAgLy8gYXJnczogW1tsZWFzZV1dCiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgcHVzaGJ5dGVzIDB4MDAwMQogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzAtMTczCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEV4ZWN1dGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2xlYXNlXV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBwdXNoYnl0ZXMgMHg0MWJkYzY4MCAvLyBtZXRob2QgImFyYzU4X2dldEV4ZWN1dGlvbnMoYnl0ZVszMl1bXSkoYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzUKICAgIC8vIHJldHVybiBpbmZvLmdyb3Vwcy5sZW5ndGggPiAwCiAgICBzd2FwCiAgICBleHRyYWN0IDYgMAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBzd2FwCiAgICBpbnRjXzEgLy8gMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAyCiAgICBsZW4KICAgIGRpZyAzCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBhc3NlcnQodGhpcy5leGVjdXRpb25FeGlzdHMoZXhlY3V0aW9uS2V5KSwgRVJSX0VYRUNVVElPTl9LRVlfTk9UX0ZPVU5EKQogICAgYXNzZXJ0IC8vIEV4ZWN1dGlvbiBrZXkgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDggLy8gMHgyOAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTktMjIzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZVBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9ET0VTX05PVF9FWElTVCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjAKICAgIC8vIGNvbnN0IHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMiA4CiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QgMTAgMzIKICAgIGRpZyAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyMQogICAgLy8gYXNzZXJ0KHRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9ET0VTX05PVF9FWElTVCkKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyAzOCAvLyAweDAwMmEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBjYWxsc3ViIHBsdWdpbkV4aXN0cwogICAgcG9wCiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjIyCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDAgLy8gMHgyOQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjQtMjI4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZU5hbWVkUGx1Z2luOiB7CiAgICAvLyAgIGNvbnN0IHsgbmFtZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGFzc2VydCh0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyNQogICAgLy8gY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gYXNzZXJ0KHRoaXMubmFtZWRQbHVnaW5FeGlzdHMobmFtZSksIEVSUl9QTFVHSU5fRE9FU19OT1RfRVhJU1QpCiAgICBjYWxsc3ViIG5hbWVkUGx1Z2luRXhpc3RzCiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjI3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgZGlnIDIzCiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyOS0yNDEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgLy8gICBjb25zdCB7IGVzY3JvdywgYWxsb3dhbmNlcyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbEFkZEFsbG93YW5jZXM+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KGFsbG93YW5jZXMubGVuZ3RoID4gMCwgRVJSX0FMTE9XQU5DRV9MSVNUX0VNUFRZKQogICAgLy8gCiAgICAvLyAgIGxldCBhc3NldHM6IHVpbnQ2NFtdID0gW10KICAgIC8vICAgZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFsbG93YW5jZXMubGVuZ3RoOyBpKyspIHsKICAgIC8vICAgICBhc3NldHMucHVzaChhbGxvd2FuY2VzW2ldLmFzc2V0KQogICAgLy8gICB9CiAgICAvLyAKICAgIC8vICAgY29uc3QgeyBhbnlFeGlzdCB9ID0gdGhpcy5hbGxvd2FuY2VDaGVjayhlc2Nyb3csIGFzc2V0cykKICAgIC8vICAgYXNzZXJ0KCFhbnlFeGlzdCwgRVJSX0FMTE9XQU5DRV9BTFJFQURZX0VYSVNUUykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGNvbnN0IHsgZXNjcm93LCBhbGxvd2FuY2VzIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkQWxsb3dhbmNlcz4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGJ1cnkgMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgYnVyeSAxOQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzMQogICAgLy8gYXNzZXJ0KGFsbG93YW5jZXMubGVuZ3RoID4gMCwgRVJSX0FMTE9XQU5DRV9MSVNUX0VNUFRZKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIGFzc2VydCAvLyBBbGxvd2FuY2UgbGlzdCBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzMKICAgIC8vIGxldCBhc3NldHM6IHVpbnQ2NFtdID0gW10KICAgIGJ5dGVjIDQxIC8vIDB4MDAwMAogICAgYnVyeSAxNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzNAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFsbG93YW5jZXMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDgKCm5ld1Byb3Bvc2FsX3doaWxlX3RvcEAyOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhbGxvd2FuY2VzLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgNwogICAgZGlnIDEwCiAgICA8CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl93aGlsZUAzMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gYXNzZXRzLnB1c2goYWxsb3dhbmNlc1tpXS5hc3NldCkKICAgIGRpZyAxNwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyA4CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzQgLy8gMzQKICAgICoKICAgIHB1c2hpbnQgMzQgLy8gMzQKICAgIGV4dHJhY3QzIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZXh0cmFjdCAwIDgKICAgIGRpZyAxOAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhbGxvd2FuY2VzLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSA4CiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEAyOQoKbmV3UHJvcG9zYWxfYWZ0ZXJfd2hpbGVAMzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjM4CiAgICAvLyBjb25zdCB7IGFueUV4aXN0IH0gPSB0aGlzLmFsbG93YW5jZUNoZWNrKGVzY3JvdywgYXNzZXRzKQogICAgZGlnIDEzCiAgICBkaWcgMTcKICAgIGNhbGxzdWIgYWxsb3dhbmNlQ2hlY2sKICAgIHBvcAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjM5CiAgICAvLyBhc3NlcnQoIWFueUV4aXN0LCBFUlJfQUxMT1dBTkNFX0FMUkVBRFlfRVhJU1RTKQogICAgIQogICAgYXNzZXJ0IC8vIGFsbG93YW5jZSBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0MAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDIgLy8gMHgzYwogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDItMjQ4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KGFzc2V0cy5sZW5ndGggPiAwLCBFUlJfQUxMT1dBTkNFX0xJU1RfRU1QVFkpCiAgICAvLyAgIGNvbnN0IHsgYWxsRXhpc3QgfSA9IHRoaXMuYWxsb3dhbmNlQ2hlY2soZXNjcm93LCBhc3NldHMpCiAgICAvLyAgIGFzc2VydChhbGxFeGlzdCwgRVJSX0FMTE9XQU5DRV9ET0VTX05PVF9FWElTVCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIGNvbnN0IHsgZXNjcm93LCBhc3NldHMgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVBbGxvd2FuY2VzPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIGxlbgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gYXNzZXJ0KGFzc2V0cy5sZW5ndGggPiAwLCBFUlJfQUxMT1dBTkNFX0xJU1RfRU1QVFkpCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgYXNzZXJ0IC8vIEFsbG93YW5jZSBsaXN0IGNhbm5vdCBiZSBlbXB0eQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0NQogICAgLy8gY29uc3QgeyBhbGxFeGlzdCB9ID0gdGhpcy5hbGxvd2FuY2VDaGVjayhlc2Nyb3csIGFzc2V0cykKICAgIGNhbGxzdWIgYWxsb3dhbmNlQ2hlY2sKICAgIHBvcAogICAgcHVzaGludCAxNyAvLyAxNwogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ2CiAgICAvLyBhc3NlcnQoYWxsRXhpc3QsIEVSUl9BTExPV0FOQ0VfRE9FU19OT1RfRVhJU1QpCiAgICBhc3NlcnQgLy8gYWxsb3dhbmNlIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDQzIC8vIDB4NDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ5LTI1MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVOZXdFc2Nyb3c6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxOZXdFc2Nyb3c+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KCF0aGlzLmVzY3Jvd0V4aXN0cyhlc2Nyb3cpLCBFUlJfRVNDUk9XX0FMUkVBRFlfRVhJU1RTKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1MAogICAgLy8gY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxOZXdFc2Nyb3c+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTEKICAgIC8vIGFzc2VydCghdGhpcy5lc2Nyb3dFeGlzdHMoZXNjcm93KSwgRVJSX0VTQ1JPV19BTFJFQURZX0VYSVNUUykKICAgIGNhbGxzdWIgZXNjcm93RXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gRXNjcm93IGFscmVhZHkgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjUyCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZGlnIDIzCiAgICBieXRlYyA0NCAvLyAweDQ3CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1NC0yNTgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgLy8gICBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMuZXNjcm93RXhpc3RzKGVzY3JvdyksIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjU1CiAgICAvLyBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTYKICAgIC8vIGFzc2VydCh0aGlzLmVzY3Jvd0V4aXN0cyhlc2Nyb3cpLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgY2FsbHN1YiBlc2Nyb3dFeGlzdHMKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTcKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1OQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDUgLy8gMHg1MAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTktMzQxCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZGF0ZUZpZWxkczogewogICAgLy8gICBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIHN3aXRjaCAoZmllbGQpIHsKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQ29udGVudFBvbGljeTogewogICAgLy8gICAgICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gMzYsIEVSUl9JTlZBTElEX0NJRCkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0OiB7CiAgICAvLyAgICAgICBhc3NlcnQodmFsdWUubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9BQ1RJT05fTElNSVQpCiAgICAvLyAgICAgICBhc3NlcnQoYnRvaSh2YWx1ZSkgPiAwLCBFUlJfQUNUSU9OX0xJTUlUX01VU1RfQkVfR1JFQVRFUl9USEFOX1pFUk8pCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTWluUmV3YXJkc0ltcGFjdDogewogICAgLy8gICAgICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfTUlOSU1VTV9SRVdBUkRTX0lNUEFDVCkKICAgIC8vICAgICAgIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIC8vICAgICAgIGFzc2VydChidG9pKHZhbHVlKSA8PSAxMDAwLCBFUlJfTUlOX1JFV0FSRFNfSU1QQUNUX01VU1RfQkVfTEVTU19USEFOX09SX0VRVUFMX1RPXzEwMDBzKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdDogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdDogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxBa2l0YVNvY2lhbEFwcExpc3Q+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3Q6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8UGx1Z2luQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0OiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PE90aGVyQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0RmVlczogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFN0YWtpbmdGZWVzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdWJzY3JpcHRpb25GZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFN1YnNjcmlwdGlvbkZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05GVEZlZXM6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8TkZURmVlcz4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3dhcEZlZXM6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8U3dhcEZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOgogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzOgogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgICAgICBjb25zdCBzZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgICAgICB0aGlzLnZhbGlkYXRlU2V0dGluZ3Moc2V0dGluZ3MpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxTcGxpdFtdPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGRlZmF1bHQ6IHsKICAgIC8vICAgICAgIGFzc2VydChmYWxzZSwgJ1Vua25vd24gZmllbGQgaW4gdXBkYXRlIGZpZWxkcyBwcm9wb3NhbCBhY3Rpb24nKQogICAgLy8gICAgIH0KICAgIC8vICAgfQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjYwCiAgICAvLyBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI2MgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjYyLTI2NQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIC8vICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gMzYsIEVSUl9JTlZBTElEX0NJRCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjMKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDM2LCBFUlJfSU5WQUxJRF9DSUQpCiAgICBkaWcgMTEKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIENJRAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI2NAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjY2CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdDogewogICAgZGlnIDEyCiAgICBieXRlYyAxMyAvLyAicHJvcG9zYWxfYWN0aW9uX2xpbWl0IgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjYtMjcwCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdDogewogICAgLy8gICBhc3NlcnQodmFsdWUubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9BQ1RJT05fTElNSVQpCiAgICAvLyAgIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9BQ1RJT05fTElNSVRfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjcKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDgsIEVSUl9JTlZBTElEX1BST1BPU0FMX0FDVElPTl9MSU1JVCkKICAgIGRpZyAxMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uIGxpbWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjY4CiAgICAvLyBhc3NlcnQoYnRvaSh2YWx1ZSkgPiAwLCBFUlJfQUNUSU9OX0xJTUlUX01VU1RfQkVfR1JFQVRFUl9USEFOX1pFUk8pCiAgICBidG9pCiAgICBhc3NlcnQgLy8gQWN0aW9uIGxpbWl0IG11c3QgYmUgZ3JlYXRlciB0aGFuIHplcm8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjkKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3MQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjcxLTI3NgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIC8vICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfTUlOSU1VTV9SRVdBUkRTX0lNUEFDVCkKICAgIC8vICAgYXNzZXJ0KGJ0b2kodmFsdWUpID4gMCwgRVJSX01JTl9SRVdBUkRTX0lNUEFDVF9NVVNUX0JFX0dSRUFURVJfVEhBTl9aRVJPKQogICAgLy8gICBhc3NlcnQoYnRvaSh2YWx1ZSkgPD0gMTAwMCwgRVJSX01JTl9SRVdBUkRTX0lNUEFDVF9NVVNUX0JFX0xFU1NfVEhBTl9PUl9FUVVBTF9UT18xMDAwcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDgsIEVSUl9JTlZBTElEX01JTklNVU1fUkVXQVJEU19JTVBBQ1QpCiAgICBkaWcgMTEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1pbmltdW0gcmV3YXJkcyBpbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzMKICAgIC8vIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIGJ0b2kKICAgIGR1cAogICAgYXNzZXJ0IC8vIE1pbmltdW0gcmV3YXJkcyBpbXBhY3QgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3NAogICAgLy8gYXNzZXJ0KGJ0b2kodmFsdWUpIDw9IDEwMDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9MRVNTX1RIQU5fT1JfRVFVQUxfVE9fMTAwMHMpCiAgICBpbnRjIDUgLy8gMTAwMAogICAgPD0KICAgIGFzc2VydCAvLyBNaW5pbXVtIHJld2FyZHMgaW1wYWN0IG11c3QgYmUgbGVzcyB0aGFuIG9yIGVxdWFsIHRvIDEwMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzUKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQ1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdDogewogICAgZGlnIDEyCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3Ny0yODAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0OiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDI2IC8vICJzYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI4MS0yODQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3Q6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxBa2l0YVNvY2lhbEFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg1CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgZGlnIDEyCiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyODUtMjg4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgLy8gICBkZWNvZGVBcmM0PFBsdWdpbkFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg5CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0OiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDUgLy8gIm9hbCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg5LTI5MgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdDogewogICAgLy8gICBkZWNvZGVBcmM0PE90aGVyQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBibnogbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyOTMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDI3IC8vICJ3YWxsZXRfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjkzLTI5NgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldEZlZXM6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI5NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXM6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjggLy8gInNvY2lhbF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyOTctMzAwCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlczogewogICAgLy8gICBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjkgLy8gInN0YWtpbmdfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAxLTMwNAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3Rha2luZ0ZlZXM+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzA1CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlczogewogICAgZGlnIDEyCiAgICBieXRlYyAzMCAvLyAic3Vic2NyaXB0aW9uX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwNS0zMDgKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdWJzY3JpcHRpb25GZWVzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3Vic2NyaXB0aW9uRmVlcz4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBibnogbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDMxIC8vICJuZnRfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzA5LTMxMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05GVEZlZXM6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxORlRGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxMwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDMyIC8vICJzd2FwX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxMy0zMTYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTd2FwRmVlczogewogICAgLy8gICBkZWNvZGVBcmM0PFN3YXBGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDExIC8vICJha2l0YV9hc3NldHMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxNy0zMjAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0czogewogICAgLy8gICBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyMQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAxNSAvLyAidXBncmFkZV9hcHBfcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIGRpZyAxMgogICAgYnl0ZWMgMTYgLy8gImFkZF9wbHVnaW5fcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIGRpZyAxMgogICAgYnl0ZWMgMTcgLy8gInJlbW92ZV9wbHVnaW5fcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzI0CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6CiAgICBkaWcgMTIKICAgIGJ5dGVjIDE4IC8vICJhZGRfYWxsb3dhbmNlX3BzIgogICAgPT0KICAgIGJueiBuZXdQcm9wb3NhbF9ibG9ja0A2MwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyNQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAxOSAvLyAicmVtb3ZlX2FsbG93YW5jZV9wcyIKICAgID09CiAgICBibnogbmV3UHJvcG9zYWxfYmxvY2tANjMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAyMCAvLyAibmV3X2VzY3Jvd19wcyIKICAgID09CiAgICBibnogbmV3UHJvcG9zYWxfYmxvY2tANjMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjcKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjctMzMxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBzZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMudmFsaWRhdGVTZXR0aW5ncyhzZXR0aW5ncykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANjQKCm5ld1Byb3Bvc2FsX2Jsb2NrQDYzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyOQogICAgLy8gdGhpcy52YWxpZGF0ZVNldHRpbmdzKHNldHRpbmdzKQogICAgZGlnIDExCiAgICBjYWxsc3ViIHZhbGlkYXRlU2V0dGluZ3MKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMzMAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANjQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzMyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgZGlnIDEyCiAgICBieXRlYyAzMyAvLyAicmV2ZW51ZV9zcGxpdHMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMzMi0zMzUKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZXZlbnVlU3BsaXRzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3BsaXRbXT4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBhc3NlcnQgLy8gVW5rbm93biBmaWVsZCBpbiB1cGRhdGUgZmllbGRzIHByb3Bvc2FsIGFjdGlvbgogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfd2hpbGVANjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTkwCiAgICAvLyByZXR1cm4gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKDAsIGNpZCwgYWN0aW9ucywgb3JpZ2luLCBmZWUsIHBvd2VyKQogICAgaW50Y18wIC8vIDAKICAgIGRpZyA2CiAgICBkaWcgNQogICAgZGlnIDcKICAgIGRpZyA0CiAgICBkaWcgNgogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjcKICAgIC8vIG5ld1Byb3Bvc2FsKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB1aW50NjQgewogICAgaXRvYgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZWRpdFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5MwogICAgLy8gZWRpdFByb3Bvc2FsKGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NAogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQoaWQpLCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURSkKICAgIGRpZyAyCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBmZWVzUGFpZCB9ID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlCiAgICBkaWcgMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBmZWVzUGFpZCB9ID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlCiAgICBwdXNoaW50IDEwOSAvLyAxMDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NwogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldE9yaWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5OAogICAgLy8gY29uc3QgeyB0b3RhbCwgcG93ZXIgfSA9IHRoaXMucHJvcG9zYWxDb3N0KGFjdGlvbnMpCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDAwCiAgICAvLyBhc3NlcnQodG90YWwgPD0gZmVlc1BhaWQsIEVSUl9QQVlNRU5UX1JFUVVJUkVEKQogICAgZGlnIDEKICAgIHVuY292ZXIgNQogICAgPD0KICAgIGFzc2VydCAvLyBQYXltZW50IHJlcXVpcmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwMgogICAgLy8gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKGlkLCBjaWQsIGFjdGlvbnMsIG9yaWdpbiwgdG90YWwsIHBvd2VyKQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNQogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3BuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5OTMKICAgIC8vIGVkaXRQcm9wb3NhbChpZDogdWludDY0LCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZWRpdFByb3Bvc2FsV2l0aFBheW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgplZGl0UHJvcG9zYWxXaXRoUGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA1CiAgICAvLyBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudChwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwNgogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQoaWQpLCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURSkKICAgIGRpZyAyCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMDgKICAgIC8vIGNvbnN0IHsgZmVlc1BhaWQgfSA9IHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZQogICAgZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MwogICAgLy8gcHJvcG9zYWxzID0gQm94TWFwPHVpbnQ2NCwgUHJvcG9zYWxEZXRhaWxzPih7IGtleVByZWZpeDogQWtpdGFEQU9Cb3hQcmVmaXhQcm9wb3NhbHMgfSkKICAgIGJ5dGVjIDQgLy8gImwiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA4CiAgICAvLyBjb25zdCB7IGZlZXNQYWlkIH0gPSB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUKICAgIHB1c2hpbnQgMTA5IC8vIDEwOQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRPcmlnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDEwCiAgICAvLyBjb25zdCB7IHRvdGFsLCBwb3dlciB9ID0gdGhpcy5wcm9wb3NhbENvc3QoYWN0aW9ucykKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdAogICAgZGlnIDEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTIKICAgIC8vIGFzc2VydCh0b3RhbCA+IGZlZXNQYWlkLCBFUlJfUEFZTUVOVF9OT1RfUkVRVUlSRUQpCiAgICBkaWcgMQogICAgZGlnIDUKICAgID4KICAgIGFzc2VydCAvLyBQYXltZW50IG5vdCByZXF1aXJlZAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTQtMTAyMQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGRpZyA3CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTcKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxNC0xMDIxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRvdGFsIC0gZmVlc1BhaWQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHVuY292ZXIgOAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxOAogICAgLy8gYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkCiAgICBkaWcgMwogICAgdW5jb3ZlciA3CiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxNC0xMDIxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRvdGFsIC0gZmVlc1BhaWQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAyMwogICAgLy8gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKGlkLCBjaWQsIGFjdGlvbnMsIG9yaWdpbiwgdG90YWwsIHBvd2VyKQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNQogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3BuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA1CiAgICAvLyBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudChwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5kZWxldGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlbGV0ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMjYKICAgIC8vIGRlbGV0ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI3CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI3CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMjkKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBjcmVhdG9yIH0gPSB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgcHVzaGludHMgNjEgMzIgLy8gNjEsIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzEKICAgIC8vIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzEtMTAzMgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0IHx8CiAgICAvLyBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBibnogZGVsZXRlUHJvcG9zYWxfYm9vbF90cnVlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDMyCiAgICAvLyBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBkaWcgMQogICAgYnl0ZWMgMTIgLy8gMHgzMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDMxLTEwMzIKICAgIC8vIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgYnogZGVsZXRlUHJvcG9zYWxfYm9vbF9mYWxzZUA0CgpkZWxldGVQcm9wb3NhbF9ib29sX3RydWVAMzoKICAgIGludGNfMSAvLyAxCgpkZWxldGVQcm9wb3NhbF9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzMC0xMDM0CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgLy8gICBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICAvLyAgIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFCiAgICAvLyApCiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzYKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzYKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0T3JpZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzNwogICAgLy8gYXNzZXJ0KG9yaWdpbiA9PT0gY3JlYXRvciwgRVJSX0lOQ09SUkVDVF9TRU5ERVIpCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBJbmNvcnJlY3Qgc2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzOQogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZGVsZXRlKCkKICAgIGRpZyAyCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI2CiAgICAvLyBkZWxldGVQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRlbGV0ZVByb3Bvc2FsX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGRlbGV0ZVByb3Bvc2FsX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc3VibWl0UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpzdWJtaXRQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyBzdWJtaXRQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA0MwogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQocHJvcG9zYWxJRCksIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFKQogICAgZHVwCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNDUKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnZvdGluZ1RzID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgc3dhcAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNDUKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnZvdGluZ1RzID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIHB1c2hpbnQgOTMgLy8gOTMKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQ2CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPSBQcm9wb3NhbFN0YXR1c1ZvdGluZwogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyBzdWJtaXRQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnZvdGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnZvdGVQcm9wb3NhbDoKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18zIC8vICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQ5CiAgICAvLyB2b3RlUHJvcG9zYWwobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBwcm9wb3NhbElEOiB1aW50NjQsIHZvdGU6IFByb3Bvc2FsVm90ZVR5cGUpOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMwogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUwCiAgICAvLyBhc3NlcnQodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVEcmFmdCwgRVJSX0RBT19OT1RfSU5JVElBTElaRUQpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUwCiAgICAvLyBhc3NlcnQodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVEcmFmdCwgRVJSX0RBT19OT1RfSU5JVElBTElaRUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICAhPQogICAgYXNzZXJ0IC8vIERBTyBpcyBub3QgaW5pdGlhbGl6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUxCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBjb3ZlciAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA1MQogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNTMtMTA2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBEQU9Qcm9wb3NhbFZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDU2CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNTMtMTA2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBEQU9Qcm9wb3NhbFZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgdW5jb3ZlciAzCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDU3CiAgICAvLyBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIsCiAgICBwdXNoaW50IDIyNTAwIC8vIDIyNTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA1My0xMDYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjIKICAgIC8vIGNvbnN0IHsgc3RhdHVzIH0gPSB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA2MwogICAgLy8gYXNzZXJ0KHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNWb3RpbmcsIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFKQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgcHJvcG9zYWwgc3RhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDY1CiAgICAvLyBjb25zdCB2b3RlciA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjUKICAgIC8vIGNvbnN0IHZvdGVyID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRPcmlnaW4KICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjYKICAgIC8vIGNvbnN0IHByb3Bvc2FsID0gY2xvbmUodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUpCiAgICBzd2FwCiAgICBib3hfZ2V0CiAgICBzd2FwCiAgICBjb3ZlciAzCiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGlmICh0aGlzLnByb3Bvc2FsVm90ZXMoeyBwcm9wb3NhbElELCB2b3RlciB9KS5leGlzdHMpIHsKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1CiAgICAvLyBwcm9wb3NhbFZvdGVzID0gQm94TWFwPFByb3Bvc2FsVm90ZUtleSwgUHJvcG9zYWxWb3RlSW5mbz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDY4CiAgICAvLyBpZiAodGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXIgfSkuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA2OQogICAgLy8gY29uc3QgeyB0eXBlLCBwb3dlcjogcHJldmlvdXNQb3dlciB9ID0gdGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXIgfSkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCAwIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxMAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDcyCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBcHByb3ZlOiB7CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzItMTA3NQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQXBwcm92ZTogewogICAgLy8gICBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgLT0gcHJldmlvdXNQb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDczCiAgICAvLyBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgLT0gcHJldmlvdXNQb3dlcgogICAgZGlnIDMKICAgIHB1c2hpbnRzIDM3IDI0IC8vIDM3LCAyNAogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDYKICAgIC0KICAgIGl0b2IKICAgIGRpZyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMzcgMjQKICAgIHN3YXAKICAgIHJlcGxhY2UyIDAKICAgIHJlcGxhY2UyIDM3CiAgICBidXJ5IDIKCnZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTAKICAgIC8vIGNvbnN0IHBvd2VyID0gdGhpcy5nZXRHb3Zlcm5hbmNlUG93ZXIodm90ZXIpCiAgICBkaWcgMgogICAgY2FsbHN1YiBnZXRHb3Zlcm5hbmNlUG93ZXIKICAgIGR1cAogICAgYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA5MwogICAgLy8gYXNzZXJ0KHBvd2VyID4gMCwgRVJSX0ZPUkJJRERFTikKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTYKICAgIC8vIGNhc2UgUHJvcG9zYWxWb3RlVHlwZUFwcHJvdmU6IHsKICAgIGRpZyA0CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTYtMTA5OQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQXBwcm92ZTogewogICAgLy8gICBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgKz0gcG93ZXIKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBieiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTcKICAgIC8vIHByb3Bvc2FsLnZvdGVzLmFwcHJvdmFscyArPSBwb3dlcgogICAgZGlnIDEKICAgIGR1cAogICAgZXh0cmFjdCAzNyAyNAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiAwCiAgICByZXBsYWNlMiAzNwogICAgYnVyeSAyCgp2b3RlUHJvcG9zYWxfYmxvY2tAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTExNAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUgPSBjbG9uZShwcm9wb3NhbCkKICAgIGRpZyAzCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgZGlnIDIKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE1CiAgICAvLyB0aGlzLnByb3Bvc2FsVm90ZXMoeyBwcm9wb3NhbElELCB2b3RlciB9KS52YWx1ZSA9IHsgdHlwZTogdm90ZSwgcG93ZXIgfQogICAgZGlnIDYKICAgIGl0b2IKICAgIGRpZyA1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA0OQogICAgLy8gdm90ZVByb3Bvc2FsKG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgcHJvcG9zYWxJRDogdWludDY0LCB2b3RlOiBQcm9wb3NhbFZvdGVUeXBlKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgp2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTAwCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVSZWplY3Q6IHsKICAgIGRpZyA0CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMC0xMTAzCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVSZWplY3Q6IHsKICAgIC8vICAgcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyArPSBwb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMQogICAgLy8gcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyArPSBwb3dlcgogICAgZGlnIDEKICAgIGR1cAogICAgZXh0cmFjdCAzNyAyNAogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiA4CiAgICByZXBsYWNlMiAzNwogICAgYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMgogICAgLy8gYnJlYWs7CiAgICBiIHZvdGVQcm9wb3NhbF9ibG9ja0AzMwoKdm90ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNAogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQWJzdGFpbjogewogICAgZGlnIDQKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNC0xMTA3CiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBYnN0YWluOiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLmFic3RhaW5zICs9IHBvd2VyCiAgICAvLyAgIGJyZWFrOwogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNQogICAgLy8gcHJvcG9zYWwudm90ZXMuYWJzdGFpbnMgKz0gcG93ZXIKICAgIGRpZyAxCiAgICBkdXAKICAgIGV4dHJhY3QgMzcgMjQKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiAxNgogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYmxvY2tAMzMKCnZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA3NgogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlUmVqZWN0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzYtMTA3OQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlUmVqZWN0OiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLnJlamVjdGlvbnMgLT0gcHJldmlvdXNQb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA3NwogICAgLy8gcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyAtPSBwcmV2aW91c1Bvd2VyCiAgICBkaWcgMwogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNgogICAgLQogICAgaXRvYgogICAgZGlnIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZXh0cmFjdCAzNyAyNAogICAgc3dhcAogICAgcmVwbGFjZTIgOAogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzgKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxOAoKdm90ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA4MAogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQWJzdGFpbjogewogICAgZGlnIDcKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA4MC0xMDgzCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBYnN0YWluOiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLmFic3RhaW5zIC09IHByZXZpb3VzUG93ZXIKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbCBhY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDgxCiAgICAvLyBwcm9wb3NhbC52b3Rlcy5hYnN0YWlucyAtPSBwcmV2aW91c1Bvd2VyCiAgICBkaWcgMwogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA2CiAgICAtCiAgICBpdG9iCiAgICBkaWcgMgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBleHRyYWN0IDM3IDI0CiAgICBzd2FwCiAgICByZXBsYWNlMiAxNgogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwODIKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZmluYWxpemVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmZpbmFsaXplUHJvcG9zYWw6CiAgICBieXRlY18zIC8vICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE4CiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE5CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE5CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjEKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBjcmVhdG9yLCB2b3RlczogeyBhcHByb3ZhbHMsIHJlamVjdGlvbnMsIGFic3RhaW5zIH0sIHZvdGluZ1RzLCBhY3Rpb25zIH0gPSBjbG9uZSh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZSkKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGRpZyAxCiAgICBwdXNoaW50cyA2MSAzMiAvLyA2MSwgMzIKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMgogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGNvdmVyIDUKICAgIGR1cAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBjb3ZlciA1CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgNAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDkzIC8vIDkzCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGNvdmVyIDMKICAgIGRpZyAyCiAgICBwdXNoaW50IDExNyAvLyAxMTcKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDQKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTIzCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gY3JlYXRvciwgRVJSX0lOQ09SUkVDVF9TRU5ERVIpCiAgICB0eG4gU2VuZGVyCiAgICA9PQogICAgYXNzZXJ0IC8vIEluY29ycmVjdCBzZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTI0CiAgICAvLyBhc3NlcnQoc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c1ZvdGluZywgRVJSX0lOVkFMSURfUFJPUE9TQUxfU1RBVEUpCiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjcKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjcKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjgKICAgIC8vIGxldCBsb2NrZWQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEyOQogICAgLy8gaWYgKGFrdGEgPiAwICYmIGJvbmVzID4gMCkgewogICAgYnogZmluYWxpemVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDUKICAgIGRpZyAxCiAgICBieiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzAtMTEzNQogICAgLy8gY29uc3QgdG90YWxzID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0VG90YWxzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgW2FrdGEsIGJvbmVzXQogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzEKICAgIC8vIGFwcElkOiB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTMxCiAgICAvLyBhcHBJZDogdGhpcy5ha2l0YUFwcExpc3QudmFsdWUuc3Rha2luZywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTMzCiAgICAvLyBbYWt0YSwgYm9uZXNdCiAgICBkaWcgMwogICAgaXRvYgogICAgcHVzaGJ5dGVzIDB4MDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMwogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEzMC0xMTM1CiAgICAvLyBjb25zdCB0b3RhbHMgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRUb3RhbHM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlLnN0YWtpbmcsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBbYWt0YSwgYm9uZXNdCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHg2YmU4Y2VlNiAvLyBtZXRob2QgImdldFRvdGFscyh1aW50NjRbXSkodWludDY0LHVpbnQ2NClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAxNiAvLyAxNgogICAgKgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LHVpbnQ2NClbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTM3CiAgICAvLyBjb25zdCBsb2NrZWRBa3RhID0gdG90YWxzWzBdLmxvY2tlZAogICAgZHVwCiAgICBleHRyYWN0IDYgMTYKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzgKICAgIC8vIGNvbnN0IGxvY2tlZEJvbmVzID0gdG90YWxzWzFdLmxvY2tlZAogICAgc3dhcAogICAgZXh0cmFjdCAyMiAxNgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEzOQogICAgLy8gbG9ja2VkID0gbG9ja2VkQWt0YSA8IGxvY2tlZEJvbmVzID8gbG9ja2VkQWt0YSA6IGxvY2tlZEJvbmVzCiAgICBkdXAyCiAgICA8CiAgICBzd2FwCiAgICBjb3ZlciAyCiAgICBzZWxlY3QKICAgIGJ1cnkgMQoKZmluYWxpemVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE0MgogICAgLy8gY29uc3QgdG90YWxWb3RlczogdWludDY0ID0gYXBwcm92YWxzICsgcmVqZWN0aW9ucyArIGFic3RhaW5zCiAgICBkaWcgNwogICAgZGlnIDcKICAgIGR1cAogICAgY292ZXIgMgogICAgKwogICAgZHVwCiAgICBidXJ5IDEzCiAgICBkaWcgNwogICAgKwogICAgYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNDYKICAgIC8vIGlmIChyZWplY3Rpb25zID4gMCkgewogICAgYnogZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDkKICAgIC8vIGFzc2VydChhIDwgYiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRV9PRl9BUkdTKQogICAgZGlnIDcKICAgIGR1cAogICAgZGlnIDEyCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIDwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2Ugb2YgYXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgRElWSVNPUiksIGIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBtdWx3CiAgICB1bmNvdmVyIDIKICAgIGRpdncKCmZpbmFsaXplUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNTIKICAgIC8vIGNvbnN0IHsgZHVyYXRpb24sIHBhcnRpY2lwYXRpb24sIGFwcHJvdmFsIH0gPSB0aGlzLnByb3Bvc2FsQ29zdChhY3Rpb25zKQogICAgZGlnIDQKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIHBvcAogICAgZHVwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1NAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiAodm90aW5nVHMgKyBkdXJhdGlvbiksIEVSUl9WT1RJTkdfRFVSQVRJT05fTk9UX01FVCkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyA5CiAgICB1bmNvdmVyIDQKICAgICsKICAgID4KICAgIGFzc2VydCAvLyBWb3RpbmcgZHVyYXRpb24gbm90IG1ldAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkaWcgMQogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNTYKICAgIC8vIGFzc2VydCh0b3RhbFZvdGVzID49IHBhcnRpY2lwYXRpb25UaHJlc2hvbGQsIEVSUl9WT1RJTkdfUEFSVElDSVBBVElPTl9OT1RfTUVUKQogICAgZGlnIDEyCiAgICA8PQogICAgYXNzZXJ0IC8vIFZvdGluZyBwYXJ0aWNpcGF0aW9uIG5vdCBtZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTU4CiAgICAvLyBpZiAoYXBwcm92YWxQZXJjZW50YWdlID49IGFwcHJvdmFsKSB7CiAgICA+PQogICAgYnogZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlAMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTU5CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPSBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkCiAgICBkaWcgOAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDggLy8gMHgyOAogICAgYm94X3JlcGxhY2UKCmZpbmFsaXplUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE4CiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE2MQogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZAogICAgZGlnIDgKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMCAvLyAweDFlCiAgICBib3hfcmVwbGFjZQogICAgYiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTEKCmZpbmFsaXplUHJvcG9zYWxfZWxzZV9ib2R5QDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE0OQogICAgLy8gYXBwcm92YWxQZXJjZW50YWdlID0gT05FX0hVTkRSRURfUEVSQ0VOVAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgYiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZXhlY3V0ZVByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZXhlY3V0ZVByb3Bvc2FsOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gNAogICAgYnl0ZWNfMyAvLyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNjUKICAgIC8vIGV4ZWN1dGVQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE2NgogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgaXRvYgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTY2CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNjgKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBhY3Rpb25zIH0gPSBjbG9uZSh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZSkKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3MAogICAgLy8gYXNzZXJ0KHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCwgRVJSX0lOVkFMSURfUFJPUE9TQUxfU1RBVEUpCiAgICBieXRlYyA4IC8vIDB4MjgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAoKZXhlY3V0ZVByb3Bvc2FsX3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgMgogICAgcHVzaGludCAxMTkgLy8gMTE5CiAgICBpbnRjXzMgLy8gMgogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyAxCiAgICA+CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfd2hpbGVANTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBjb25zdCB7IHR5cGUsIGRhdGEgfSA9IGFjdGlvbnNbaV0KICAgIGRpZyAyCiAgICBkdXAKICAgIHB1c2hpbnQgMTE5IC8vIDExOQogICAgaW50Y18zIC8vIDIKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIDwKICAgIGFzc2VydCAvLyBpbmRleCBvdXQgb2YgYm91bmRzCiAgICBpbnRjXzMgLy8gMgogICAgZGlnIDEKICAgICoKICAgIHB1c2hpbnQgMTIxIC8vIDEyMQogICAgKwogICAgZGlnIDIKICAgIHN3YXAKICAgIGludGNfMyAvLyAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgcHVzaGludCAxMjEgLy8gMTIxCiAgICArCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDkKICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTE3IC8vIDExNwogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgZXh0cmFjdCAyIDAKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgMgogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgYnVyeSAxMAogICAgdW5jb3ZlciAyCiAgICBkaWcgMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyA0CiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGJ1cnkgMTIKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOgogICAgYnl0ZWMgMjIgLy8gMHgwYQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc3LTExODIKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVXBncmFkZUFwcDoKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsVXBncmFkZUFwcD4oZGF0YSkKICAgIC8vICAgLy8gdHJhY2sgdGhlIHByb3Bvc2FsIHRoYXQgY3JlYXRlZCB0aGUgZXhlY3V0aW9uIGJ5IGxlYXNlIHNvIHRoZXkgY2FuIGJlIHZlcmlmaWVkIHdpdGhvdXQgZXhwbGljaXQgcHJvcG9zYWwgaWQgYmVpbmcgcHJvdmlkZWQKICAgIC8vICAgdGhpcy5leGVjdXRpb25zKGV4ZWN1dGlvbktleSkudmFsdWUgPSB7IHByb3Bvc2FsSUQsIGluZGV4OiBpIH0KICAgIC8vICAgdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIC8vICAgYnJlYWsKICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBjb25zdCB7IGV4ZWN1dGlvbktleSwgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWQgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGdyYWRlQXBwPihkYXRhKQogICAgZGlnIDkKICAgIGR1cAogICAgZXh0cmFjdCAxMCAzMgogICAgZGlnIDEwCiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkaWcgMgogICAgcHVzaGludCA0NCAvLyA0NAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMwogICAgcHVzaGludCA1MiAvLyA1MgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTgwCiAgICAvLyB0aGlzLmV4ZWN1dGlvbnMoZXhlY3V0aW9uS2V5KS52YWx1ZSA9IHsgcHJvcG9zYWxJRCwgaW5kZXg6IGkgfQogICAgZGlnIDQKICAgIGl0b2IKICAgIGRpZyA4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NwogICAgLy8gZXhlY3V0aW9ucyA9IEJveE1hcDxieXRlczwzMj4sIEV4ZWN1dGlvbk1ldGFkYXRhPih7IGtleVByZWZpeDogQWJzdHJhY3RBY2NvdW50Qm94UHJlZml4RXhlY3V0aW9ucyB9KQogICAgcHVzaGJ5dGVzICJ4IgogICAgZGlnIDUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODAKICAgIC8vIHRoaXMuZXhlY3V0aW9ucyhleGVjdXRpb25LZXkpLnZhbHVlID0geyBwcm9wb3NhbElELCBpbmRleDogaSB9CiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4MQogICAgLy8gdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIGNhbGxzdWIgbmV3RXhlY3V0aW9uCiAgICBwb3AKCmV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MToKICAgIGRpZyA0CiAgICBidXJ5IDEKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX3doaWxlX3RvcEAyCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlRXhlY3V0ZVBsdWdpbjogewogICAgZGlnIDYKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4My0xMTg3CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIC8vICAgdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4NAogICAgLy8gY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIGRpZyA5CiAgICBkdXAKICAgIGV4dHJhY3QgMTIgMzIKICAgIGRpZyAxMAogICAgZHVwCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZGlnIDIKICAgIHB1c2hpbnQgNDYgLy8gNDYKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDMKICAgIHB1c2hpbnQgNTQgLy8gNTQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4NQogICAgLy8gdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIGNhbGxzdWIgbmV3RXhlY3V0aW9uCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTg2CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlRXhlY3V0ZVBsdWdpbjogewogICAgZGlnIDYKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4OC0xMTkyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXkgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVFeGVjdXRlUGx1Z2luPihkYXRhKQogICAgLy8gICB0aGlzLnJlbW92ZUV4ZWN1dGlvbihleGVjdXRpb25LZXkpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODkKICAgIC8vIGNvbnN0IHsgZXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIGRpZyA5CiAgICBleHRyYWN0IDIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDktNzUyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlRXhlY3V0aW9uS2V5Pih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2tleV0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzUwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzUwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc1MQogICAgLy8gYXJnczogW2tleV0KICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQ5LTc1MgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZUV4ZWN1dGlvbktleT4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtrZXldCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZDU4Njg1YWYgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVFeGVjdXRpb25LZXkoYnl0ZVszMl0pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE5MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGRQbHVnaW46IHsKICAgIGRpZyA2CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE5My0xMTk2CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjogewogICAgLy8gICB0aGlzLmFkZFBsdWdpbih7IG5hbWU6ICcnLCAuLi5kZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihkYXRhKSB9KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk0CiAgICAvLyB0aGlzLmFkZFBsdWdpbih7IG5hbWU6ICcnLCAuLi5kZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihkYXRhKSB9KQogICAgZGlnIDkKICAgIGR1cAogICAgZXh0cmFjdCAyIDgKICAgIGRpZyAxCiAgICBleHRyYWN0IDEwIDMyCiAgICBkaWcgMTEKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgNQogICAgZXh0cmFjdCA0NCAxCiAgICBkaWcgNgogICAgZXh0cmFjdCA0NSA4CiAgICBkaWcgNwogICAgZXh0cmFjdCA1MyA4CiAgICBkaWcgNQogICAgcHVzaGludCAxMDIgLy8gMTAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDYKICAgIHVuY292ZXIgNgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGRpZyA2CiAgICBwdXNoaW50IDQ4OCAvLyA0ODgKICAgIGdldGJpdAogICAgZGlnIDcKICAgIHB1c2hpbnQgNDg5IC8vIDQ4OQogICAgZ2V0Yml0CiAgICBkaWcgOAogICAgcHVzaGludCA0OTAgLy8gNDkwCiAgICBnZXRiaXQKICAgIGRpZyA5CiAgICBwdXNoaW50IDQ5MSAvLyA0OTEKICAgIGdldGJpdAogICAgZGlnIDEzCiAgICBleHRyYWN0IDY0IDgKICAgIGRpZyAxNAogICAgZXh0cmFjdCA3MiA4CiAgICBkaWcgMTUKICAgIGV4dHJhY3QgODAgOAogICAgZGlnIDE2CiAgICBleHRyYWN0IDg4IDgKICAgIHVuY292ZXIgMTcKICAgIGV4dHJhY3QgOTYgOAogICAgZGlnIDE1CiAgICBwdXNoaW50IDEwNCAvLyAxMDQKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMTYKICAgIHVuY292ZXIgMTIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDE2CiAgICBsZW4KICAgIHVuY292ZXIgMTcKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBwdXNoYnl0ZXMgMHgwMDZjCiAgICB1bmNvdmVyIDE4CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTcKICAgIGNvbmNhdAogICAgZGlnIDE2CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDE3CiAgICBjb25jYXQKICAgIHN3YXAKICAgIHB1c2hieXRlcyAweDAwNmUKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxMTAgLy8gMTEwCiAgICArCiAgICBzd2FwCiAgICB1bmNvdmVyIDE3CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTYKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxNQogICAgY29uY2F0CiAgICBkaWcgMQogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGNvbmNhdAogICAgZGlnIDE0CiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgKwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAxNQogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciAxNAogICAgc2V0Yml0CiAgICBpbnRjXzMgLy8gMgogICAgdW5jb3ZlciAxMwogICAgc2V0Yml0CiAgICBwdXNoaW50IDMgLy8gMwogICAgdW5jb3ZlciAxMgogICAgc2V0Yml0CiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA5CiAgICBjb25jYXQKICAgIHVuY292ZXIgOAogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBjb25jYXQKICAgIHVuY292ZXIgNQogICAgY29uY2F0CiAgICBkaWcgNAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciA1CiAgICBjb25jYXQKICAgIGRpZyAyCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGNvbmNhdAogICAgYnl0ZWMgNDEgLy8gMHgwMDAwCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGNhbGxzdWIgYWRkUGx1Z2luCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk1CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTcKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIGRpZyA2CiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTctMTIwMAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGROYW1lZFBsdWdpbjogewogICAgLy8gICB0aGlzLmFkZFBsdWdpbihkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGRhdGEpKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk4CiAgICAvLyB0aGlzLmFkZFBsdWdpbihkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGRhdGEpKQogICAgZGlnIDgKICAgIGNhbGxzdWIgYWRkUGx1Z2luCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk5CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlUGx1Z2luOiB7CiAgICBkaWcgNgogICAgYnl0ZWMgOCAvLyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDEtMTIwNAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVQbHVnaW46IHsKICAgIC8vICAgdGhpcy5yZW1vdmVQbHVnaW4oeyBuYW1lOiAnJywgLi4uZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oZGF0YSkgfSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwMgogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW4oeyBuYW1lOiAnJywgLi4uZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oZGF0YSkgfSkKICAgIGRpZyA5CiAgICBkdXAKICAgIGV4dHJhY3QgMiA4CiAgICBzd2FwCiAgICBleHRyYWN0IDEwIDMyCiAgICBkaWcgMTAKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBwdXNoYnl0ZXMgMHgwMDJjCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgcHVzaGJ5dGVzIDB4MDAyZTAwMDAKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBjYWxsc3ViIHJlbW92ZVBsdWdpbgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwMwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA1CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZU5hbWVkUGx1Z2luOiB7CiAgICBkaWcgNgogICAgYnl0ZWMgNDAgLy8gMHgyOQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA1LTEyMDgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIC8vICAgdGhpcy5yZW1vdmVQbHVnaW4oZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihkYXRhKSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwNgogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW4oZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihkYXRhKSkKICAgIGRpZyA4CiAgICBjYWxsc3ViIHJlbW92ZVBsdWdpbgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwNwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA5CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZEFsbG93YW5jZXM6IHsKICAgIGRpZyA2CiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDktMTIxMwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGRBbGxvd2FuY2VzOiB7CiAgICAvLyAgIGNvbnN0IHsgZXNjcm93LCBhbGxvd2FuY2VzIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkQWxsb3dhbmNlcz4oZGF0YSkKICAgIC8vICAgdGhpcy5hZGRBbGxvd2FuY2VzKGVzY3JvdywgYWxsb3dhbmNlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxMAogICAgLy8gY29uc3QgeyBlc2Nyb3csIGFsbG93YW5jZXMgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxBZGRBbGxvd2FuY2VzPihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjExCiAgICAvLyB0aGlzLmFkZEFsbG93YW5jZXMoZXNjcm93LCBhbGxvd2FuY2VzKQogICAgY2FsbHN1YiBhZGRBbGxvd2FuY2VzCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjEyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMTQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlQWxsb3dhbmNlczogewogICAgZGlnIDYKICAgIGJ5dGVjIDQyIC8vIDB4M2MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxNC0xMjE4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGRhdGEpCiAgICAvLyAgIHRoaXMucmVtb3ZlQWxsb3dhbmNlcyhlc2Nyb3csIGFzc2V0cykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxNQogICAgLy8gY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGRhdGEpCiAgICBkaWcgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTg5LTU5MgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZUFsbG93YW5jZXM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93LCBhc3NldHNdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5MAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5MAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1ODktNTkyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlQWxsb3dhbmNlcz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtlc2Nyb3csIGFzc2V0c10KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhkNWRkMzgyYiAvLyBtZXRob2QgImFyYzU4X3JlbW92ZUFsbG93YW5jZXMoc3RyaW5nLHVpbnQ2NFtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjE3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMTkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICBkaWcgNgogICAgYnl0ZWMgNDMgLy8gMHg0NgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjE5LTEyMjMKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICAvLyAgIGNvbnN0IHsgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsTmV3RXNjcm93PihkYXRhKQogICAgLy8gICB0aGlzLm5ld0VzY3Jvdyhlc2Nyb3cpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjAKICAgIC8vIGNvbnN0IHsgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsTmV3RXNjcm93PihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjE3LTYyMAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9uZXdFc2Nyb3c+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTgKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTgKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjE3LTYyMAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9uZXdFc2Nyb3c+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDBhOGNiMmMyIC8vIG1ldGhvZCAiYXJjNThfbmV3RXNjcm93KHN0cmluZyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjIyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZGlnIDYKICAgIGJ5dGVjIDQ0IC8vIDB4NDcKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIyNC0xMjI4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVRvZ2dsZUVzY3Jvd0xvY2s6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrPihkYXRhKQogICAgLy8gICB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2soZXNjcm93KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjI1CiAgICAvLyBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGRhdGEpCiAgICBkaWcgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjQtNjI3CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3RvZ2dsZUVzY3Jvd0xvY2s+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjUKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjUKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjI0LTYyNwogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF90b2dnbGVFc2Nyb3dMb2NrPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3Jvd10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgyNWI3MTNjYSAvLyBtZXRob2QgImFyYzU4X3RvZ2dsZUVzY3Jvd0xvY2soc3RyaW5nKSh1aW50NjQsYm9vbCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIHB1c2hpbnQgOSAvLyA5CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LGJvb2wxKQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjcKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIyOQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIGRpZyA2CiAgICBieXRlYyA0NSAvLyAweDUwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjktMTIzMwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIC8vICAgY29uc3QgeyBmaWVsZCwgdmFsdWUgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGRhdGVGaWVsZD4oZGF0YSkKICAgIC8vICAgdGhpcy51cGRhdGVGaWVsZChmaWVsZCwgdmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjMwCiAgICAvLyBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGR1cAogICAgY292ZXIgMwogICAgYnVyeSAxMQogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGJ1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMyLTYzNQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIC8vICAgdGhpcy5jb250ZW50UG9saWN5LnZhbHVlID0gdmFsdWUudG9GaXhlZCh7IGxlbmd0aDogMzYgfSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMzCiAgICAvLyB0aGlzLmNvbnRlbnRQb2xpY3kudmFsdWUgPSB2YWx1ZS50b0ZpeGVkKHsgbGVuZ3RoOiAzNiB9KQogICAgZGlnIDUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzgKICAgIC8vIGNvbnRlbnRQb2xpY3kgPSBHbG9iYWxTdGF0ZTxDSUQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3kgfSkKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzMKICAgIC8vIHRoaXMuY29udGVudFBvbGljeS52YWx1ZSA9IHZhbHVlLnRvRml4ZWQoeyBsZW5ndGg6IDM2IH0pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzNAogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTMgLy8gInByb3Bvc2FsX2FjdGlvbl9saW1pdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjM2LTYzOQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsQWN0aW9uTGltaXQ6IHsKICAgIC8vICAgdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlID0gYnRvaSh2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnByb3Bvc2FsQWN0aW9uTGltaXQudmFsdWUgPSBidG9pKHZhbHVlKQogICAgZGlnIDUKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNgogICAgLy8gcHJvcG9zYWxBY3Rpb25MaW1pdCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdCB9KQogICAgYnl0ZWMgMTMgLy8gInByb3Bvc2FsX2FjdGlvbl9saW1pdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzcKICAgIC8vIHRoaXMucHJvcG9zYWxBY3Rpb25MaW1pdC52YWx1ZSA9IGJ0b2kodmFsdWUpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzOAogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNNaW5SZXdhcmRzSW1wYWN0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQwLTY0MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIC8vICAgdGhpcy5taW5SZXdhcmRzSW1wYWN0LnZhbHVlID0gYnRvaSh2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQxCiAgICAvLyB0aGlzLm1pblJld2FyZHNJbXBhY3QudmFsdWUgPSBidG9pKHZhbHVlKQogICAgZGlnIDUKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MAogICAgLy8gbWluUmV3YXJkc0ltcGFjdCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTWluUmV3YXJkc0ltcGFjdCB9KQogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDEKICAgIC8vIHRoaXMubWluUmV3YXJkc0ltcGFjdC52YWx1ZSA9IGJ0b2kodmFsdWUpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1OToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyA5IC8vICJhYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0NC02NDgKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIC8vICAgY29uc3QgYWtpdGFBcHBMaXN0ID0gZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZSA9IGNsb25lKGFraXRhQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIGFraXRhQXBwTGlzdCA9IEdsb2JhbFN0YXRlPEFraXRhQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0IH0pCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQ2CiAgICAvLyB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZSA9IGNsb25lKGFraXRhQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0NwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2MToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyNiAvLyAic2FsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDktNjUzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0OiB7CiAgICAvLyAgIGNvbnN0IGFraXRhU29jaWFsQXBwTGlzdCA9IGRlY29kZUFyYzQ8QWtpdGFTb2NpYWxBcHBMaXN0Pih2YWx1ZSkKICAgIC8vICAgdGhpcy5ha2l0YVNvY2lhbEFwcExpc3QudmFsdWUgPSBjbG9uZShha2l0YVNvY2lhbEFwcExpc3QpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2MwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0CiAgICAvLyBha2l0YVNvY2lhbEFwcExpc3QgPSBHbG9iYWxTdGF0ZTxBa2l0YVNvY2lhbEFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCB9KQogICAgYnl0ZWMgMjYgLy8gInNhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTEKICAgIC8vIHRoaXMuYWtpdGFTb2NpYWxBcHBMaXN0LnZhbHVlID0gY2xvbmUoYWtpdGFTb2NpYWxBcHBMaXN0KQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjUyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTQtNjU4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgLy8gICBjb25zdCBwbHVnaW5BcHBMaXN0ID0gZGVjb2RlQXJjNDxQbHVnaW5BcHBMaXN0Pih2YWx1ZSkKICAgIC8vICAgdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gY2xvbmUocGx1Z2luQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDYKICAgIC8vIHBsdWdpbkFwcExpc3QgPSBHbG9iYWxTdGF0ZTxQbHVnaW5BcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQbHVnaW5BcHBMaXN0IH0pCiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NgogICAgLy8gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gY2xvbmUocGx1Z2luQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyA1IC8vICJvYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1OS02NjMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3Q6IHsKICAgIC8vICAgY29uc3Qgb3RoZXJBcHBMaXN0ID0gZGVjb2RlQXJjNDxPdGhlckFwcExpc3Q+KHZhbHVlKQogICAgLy8gICB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IGNsb25lKG90aGVyQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjYxCiAgICAvLyB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IGNsb25lKG90aGVyQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NjQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMjcgLy8gIndhbGxldF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NjQtNjY4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0RmVlczogewogICAgLy8gICBjb25zdCB3YWxsZXRGZWVzID0gZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy53YWxsZXRGZWVzLnZhbHVlID0gY2xvbmUod2FsbGV0RmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTAKICAgIC8vIHdhbGxldEZlZXMgPSBHbG9iYWxTdGF0ZTxXYWxsZXRGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzIH0pCiAgICBieXRlYyAyNyAvLyAid2FsbGV0X2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjY2CiAgICAvLyB0aGlzLndhbGxldEZlZXMudmFsdWUgPSBjbG9uZSh3YWxsZXRGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjY3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2OQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXM6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyOCAvLyAic29jaWFsX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2OS02NzMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzOiB7CiAgICAvLyAgIGNvbnN0IHNvY2lhbEZlZXMgPSBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICB0aGlzLnNvY2lhbEZlZXMudmFsdWUgPSBjbG9uZShzb2NpYWxGZWVzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MgogICAgLy8gc29jaWFsRmVlcyA9IEdsb2JhbFN0YXRlPFNvY2lhbEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMgfSkKICAgIGJ5dGVjIDI4IC8vICJzb2NpYWxfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzEKICAgIC8vIHRoaXMuc29jaWFsRmVlcy52YWx1ZSA9IGNsb25lKHNvY2lhbEZlZXMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzIKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc0CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyOSAvLyAic3Rha2luZ19mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzQtNjc4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIC8vICAgY29uc3Qgc3Rha2luZ0ZlZXMgPSBkZWNvZGVBcmM0PFN0YWtpbmdGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5zdGFraW5nRmVlcy52YWx1ZSA9IGNsb25lKHN0YWtpbmdGZWVzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NAogICAgLy8gc3Rha2luZ0ZlZXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nRmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXMgfSkKICAgIGJ5dGVjIDI5IC8vICJzdGFraW5nX2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc2CiAgICAvLyB0aGlzLnN0YWtpbmdGZWVzLnZhbHVlID0gY2xvbmUoc3Rha2luZ0ZlZXMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzcKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc5CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlczogewogICAgZGlnIDcKICAgIGJ5dGVjIDMwIC8vICJzdWJzY3JpcHRpb25fZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc5LTY4MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N1YnNjcmlwdGlvbkZlZXM6IHsKICAgIC8vICAgY29uc3Qgc3Vic2NyaXB0aW9uRmVlcyA9IGRlY29kZUFyYzQ8U3Vic2NyaXB0aW9uRmVlcz4odmFsdWUpCiAgICAvLyAgIHRoaXMuc3Vic2NyaXB0aW9uRmVlcy52YWx1ZSA9IGNsb25lKHN1YnNjcmlwdGlvbkZlZXMpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA3NQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2CiAgICAvLyBzdWJzY3JpcHRpb25GZWVzID0gR2xvYmFsU3RhdGU8U3Vic2NyaXB0aW9uRmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlcyB9KQogICAgYnl0ZWMgMzAgLy8gInN1YnNjcmlwdGlvbl9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4MQogICAgLy8gdGhpcy5zdWJzY3JpcHRpb25GZWVzLnZhbHVlID0gY2xvbmUoc3Vic2NyaXB0aW9uRmVlcykKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA3NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2ODQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMzEgLy8gIm5mdF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2ODQtNjg4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTkZURmVlczogewogICAgLy8gICBjb25zdCBuZnRGZWVzID0gZGVjb2RlQXJjNDxORlRGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5uZnRGZWVzLnZhbHVlID0gY2xvbmUobmZ0RmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIG5mdEZlZXMgPSBHbG9iYWxTdGF0ZTxORlRGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzIH0pCiAgICBieXRlYyAzMSAvLyAibmZ0X2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg2CiAgICAvLyB0aGlzLm5mdEZlZXMudmFsdWUgPSBjbG9uZShuZnRGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4OQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMzIgLy8gInN3YXBfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg5LTY5MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICAvLyAgIGNvbnN0IHN3YXBGZWVzID0gZGVjb2RlQXJjNDxTd2FwRmVlcz4odmFsdWUpCiAgICAvLyAgIHRoaXMuc3dhcEZlZXMudmFsdWUgPSBjbG9uZShzd2FwRmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAKICAgIC8vIHN3YXBGZWVzID0gR2xvYmFsU3RhdGU8U3dhcEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzIH0pCiAgICBieXRlYyAzMiAvLyAic3dhcF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5MQogICAgLy8gdGhpcy5zd2FwRmVlcy52YWx1ZSA9IGNsb25lKHN3YXBGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjkyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5NAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTEgLy8gImFraXRhX2Fzc2V0cyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njk0LTY5NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICAvLyAgIHRoaXMuYWtpdGFBc3NldHMudmFsdWUgPSBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5NQogICAgLy8gdGhpcy5ha2l0YUFzc2V0cy52YWx1ZSA9IGRlY29kZUFyYzQ8QWtpdGFBc3NldHM+KHZhbHVlKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njk2CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5OAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTUgLy8gInVwZ3JhZGVfYXBwX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2OTgtNzAyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3M6IHsKICAgIC8vICAgY29uc3QgdXBncmFkZUFwcFNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy51cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHVwZ3JhZGVBcHBTZXR0aW5ncykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjgKICAgIC8vIHVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE1IC8vICJ1cGdyYWRlX2FwcF9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDAKICAgIC8vIHRoaXMudXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZSh1cGdyYWRlQXBwU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzAzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDMtNzA3CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBhZGRQbHVnaW5TZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKGFkZFBsdWdpblNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MAogICAgLy8gYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZFBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcwNQogICAgLy8gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUoYWRkUGx1Z2luU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzA4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDE3IC8vICJyZW1vdmVfcGx1Z2luX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDgtNzEyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCByZW1vdmVQbHVnaW5TZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHJlbW92ZVBsdWdpblNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MgogICAgLy8gcmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE3IC8vICJyZW1vdmVfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcxMAogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocmVtb3ZlUGx1Z2luU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MTEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzEzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxOCAvLyAiYWRkX2FsbG93YW5jZV9wcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzEzLTcxNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgIGNvbnN0IGFkZEFsbG93YW5jZVNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5hZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKGFkZEFsbG93YW5jZVNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NgogICAgLy8gYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTggLy8gImFkZF9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE1CiAgICAvLyB0aGlzLmFkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUoYWRkQWxsb3dhbmNlU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MTYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxOSAvLyAicmVtb3ZlX2FsbG93YW5jZV9wcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE4LTcyMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgIGNvbnN0IHJlbW92ZUFsbG93YW5jZVNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHJlbW92ZUFsbG93YW5jZVNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIwCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocmVtb3ZlQWxsb3dhbmNlU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTmV3RXNjcm93UHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjMtNzI3CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTmV3RXNjcm93UHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBuZXdFc2Nyb3dTZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMubmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKG5ld0VzY3Jvd1NldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MAogICAgLy8gbmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcyNQogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUobmV3RXNjcm93U2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzI4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjgtNzMyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCB1cGRhdGVGaWVsZHNTZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMudXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHVwZGF0ZUZpZWxkc1NldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZGF0ZUZpZWxkc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjczMAogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUodXBkYXRlRmllbGRzU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzMzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgZGlnIDcKICAgIGJ5dGVjIDMzIC8vICJyZXZlbnVlX3NwbGl0cyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzMzLTczNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHM6IHsKICAgIC8vICAgY29uc3QgcmV2ZW51ZVNwbGl0cyA9IGRlY29kZUFyYzQ8U3BsaXRbXT4odmFsdWUpCiAgICAvLyAgIHRoaXMucmV2ZW51ZVNwbGl0cy52YWx1ZSA9IGNsb25lKHJldmVudWVTcGxpdHMpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NAogICAgLy8gcmV2ZW51ZVNwbGl0cyA9IEdsb2JhbFN0YXRlPFNwbGl0W10+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHMgfSkKICAgIGJ5dGVjIDMzIC8vICJyZXZlbnVlX3NwbGl0cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzUKICAgIC8vIHRoaXMucmV2ZW51ZVNwbGl0cy52YWx1ZSA9IGNsb25lKHJldmVudWVTcGxpdHMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX3doaWxlQDUyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMzcKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTIgLy8gMHgzMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTY1CiAgICAvLyBleGVjdXRlUHJvcG9zYWwocHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5kZWxldGVQcm9wb3NhbFZvdGVzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVsZXRlUHJvcG9zYWxWb3RlczoKICAgIGJ5dGVjXzMgLy8gIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQwCiAgICAvLyBkZWxldGVQcm9wb3NhbFZvdGVzKHByb3Bvc2FsSUQ6IHVpbnQ2NCwgdm90ZXJzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbMzJdW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0MQogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgaXRvYgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQxCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDMKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCB8fAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgYnl0ZWMgOCAvLyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDMtMTI0NAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkIHx8CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzUmVqZWN0ZWQgfHwKICAgIGJueiBkZWxldGVQcm9wb3NhbFZvdGVzX2Jvb2xfdHJ1ZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0NAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c1JlamVjdGVkIHx8CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0My0xMjQ0CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQgfHwKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZCB8fAogICAgYm56IGRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF90cnVlQDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQ1CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGJ5dGVjIDEyIC8vIDB4MzIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0My0xMjQ1CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQgfHwKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZCB8fAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgYnogZGVsZXRlUHJvcG9zYWxWb3Rlc19ib29sX2ZhbHNlQDUKCmRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF90cnVlQDQ6CiAgICBpbnRjXzEgLy8gMQoKZGVsZXRlUHJvcG9zYWxWb3Rlc19ib29sX21lcmdlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0Mi0xMjQ3CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCB8fAogICAgLy8gICB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzUmVqZWN0ZWQgfHwKICAgIC8vICAgdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgLy8gICBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURQogICAgLy8gKQogICAgYXNzZXJ0IC8vIGludmFsaWQgcHJvcG9zYWwgc3RhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQ5CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgdm90ZXJzLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA1CgpkZWxldGVQcm9wb3NhbFZvdGVzX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDkKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCB2b3RlcnMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyA0CiAgICBkaWcgMwogICAgPAogICAgYnogZGVsZXRlUHJvcG9zYWxWb3Rlc19hZnRlcl93aGlsZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxWb3Rlcyh7IHByb3Bvc2FsSUQsIHZvdGVyOiB2b3RlcnNbaV0gfSkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfVk9URV9OT1RfRk9VTkQpCiAgICBkaWcgMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3QzIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1CiAgICAvLyBwcm9wb3NhbFZvdGVzID0gQm94TWFwPFByb3Bvc2FsVm90ZUtleSwgUHJvcG9zYWxWb3RlSW5mbz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxWb3Rlcyh7IHByb3Bvc2FsSUQsIHZvdGVyOiB2b3RlcnNbaV0gfSkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfVk9URV9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIFZvdGUgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MQogICAgLy8gdGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXI6IHZvdGVyc1tpXSB9KS5kZWxldGUoKQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0OQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IHZvdGVycy5sZW5ndGg7IGkrKykgewogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgNQogICAgYiBkZWxldGVQcm9wb3NhbFZvdGVzX3doaWxlX3RvcEA3CgpkZWxldGVQcm9wb3NhbFZvdGVzX2FmdGVyX3doaWxlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0MAogICAgLy8gZGVsZXRlUHJvcG9zYWxWb3Rlcyhwcm9wb3NhbElEOiB1aW50NjQsIHZvdGVyczogQWNjb3VudFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpkZWxldGVQcm9wb3NhbFZvdGVzX2Jvb2xfZmFsc2VANToKICAgIGludGNfMCAvLyAwCiAgICBiIGRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF9tZXJnZUA2CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpzZXR1cENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc2V0dXBDb3N0CiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI2MQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0CiAgICBwb3AKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0UHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMjgKICAgIC8vIGFzc2VydCh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MwogICAgLy8gcHJvcG9zYWxzID0gQm94TWFwPHVpbnQ2NCwgUHJvcG9zYWxEZXRhaWxzPih7IGtleVByZWZpeDogQWtpdGFEQU9Cb3hQcmVmaXhQcm9wb3NhbHMgfSkKICAgIGJ5dGVjIDQgLy8gImwiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzI4CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyOQogICAgLy8gcmV0dXJuIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzI2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLm11c3RHZXRFeGVjdXRpb25bcm91dGluZ10oKSAtPiB2b2lkOgptdXN0R2V0RXhlY3V0aW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMzIKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBleGVjdXRpb25zID0gQm94TWFwPGJ5dGVzPDMyPiwgRXhlY3V0aW9uTWV0YWRhdGE+KHsga2V5UHJlZml4OiBBYnN0cmFjdEFjY291bnRCb3hQcmVmaXhFeGVjdXRpb25zIH0pCiAgICBwdXNoYnl0ZXMgIngiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM0CiAgICAvLyBhc3NlcnQodGhpcy5leGVjdXRpb25zKGxlYXNlKS5leGlzdHMsIEVSUl9FWEVDVVRJT05fS0VZX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRXhlY3V0aW9uIGtleSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM1CiAgICAvLyByZXR1cm4gdGhpcy5leGVjdXRpb25zKGxlYXNlKS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMzMgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wbHVnaW5FeGlzdHMoa2V5OiBieXRlcykgLT4gdWludDY0LCBieXRlczoKcGx1Z2luRXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMwogICAgLy8gcHJpdmF0ZSBwbHVnaW5FeGlzdHMoa2V5OiBQbHVnaW5LZXkpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNC0xMTcKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0UGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtba2V5XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gYXJnczogW1trZXldXQogICAgYnl0ZWMgMzYgLy8gMHgwMDAxMDAwMgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTQtMTE3CiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldFBsdWdpbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2tleV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgNTEgLy8gbWV0aG9kICJhcmM1OF9nZXRQbHVnaW5zKCh1aW50NjQsYWRkcmVzcyxzdHJpbmcpW10pKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NCx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sLHVpbnQ2NCx1aW50NjQpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOQogICAgLy8gcmV0dXJuIGluZm8uc3RhcnQgIT09IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgNiAwCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDIKICAgIGxlbgogICAgZGlnIDMKICAgIGludGNfMyAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzAgLy8gMAogICAgIT0KICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5uYW1lZFBsdWdpbkV4aXN0cyhuYW1lOiBieXRlcykgLT4gdWludDY0OgpuYW1lZFBsdWdpbkV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIHByaXZhdGUgbmFtZWRQbHVnaW5FeGlzdHMobmFtZTogc3RyaW5nKTogYm9vbGVhbiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzAtMTMzCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldE5hbWVkUGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIGFyZ3M6IFtbbmFtZV1dCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBieXRlYyAzNiAvLyAweDAwMDEwMDAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzAtMTMzCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldE5hbWVkUGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgcHVzaGJ5dGVzIDB4MDU3OTRkN2QgLy8gbWV0aG9kICJhcmM1OF9nZXROYW1lZFBsdWdpbnMoc3RyaW5nW10pKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NCx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sLHVpbnQ2NCx1aW50NjQpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzNQogICAgLy8gcmV0dXJuIGluZm8uc3RhcnQgIT09IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgNiAwCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDIKICAgIGxlbgogICAgZGlnIDMKICAgIGludGNfMyAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzAgLy8gMAogICAgIT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZXNjcm93RXhpc3RzKGVzY3JvdzogYnl0ZXMpIC0+IHVpbnQ2NDoKZXNjcm93RXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOAogICAgLy8gcHJpdmF0ZSBlc2Nyb3dFeGlzdHMoZXNjcm93OiBzdHJpbmcpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOS0xNDIKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE0MQogICAgLy8gYXJnczogW1tlc2Nyb3ddXQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgYnl0ZWMgMzYgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTM5LTE0MgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW1tlc2Nyb3ddXQogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hieXRlcyAweGEyNDAzZGRmIC8vIG1ldGhvZCAiYXJjNThfZ2V0RXNjcm93cyhzdHJpbmdbXSkodWludDY0LGJvb2wpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgOSAvLyA5CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50NjQsYm9vbDEpW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ0CiAgICAvLyByZXR1cm4gaW5mby5pZCAhPT0gMAogICAgZXh0cmFjdCA2IDkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgaW50Y18wIC8vIDAKICAgICE9CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmFsbG93YW5jZUNoZWNrKGVzY3JvdzogYnl0ZXMsIGFzc2V0czogYnl0ZXMpIC0+IGJ5dGVzLCBieXRlczoKYWxsb3dhbmNlQ2hlY2s6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBwcml2YXRlIGFsbG93YW5jZUNoZWNrKGVzY3Jvdzogc3RyaW5nLCBhc3NldHM6IHVpbnQ2NFtdKTogeyBleGlzdGVuY2VzOiBib29sZWFuW10sIGFueUV4aXN0OiBib29sZWFuLCBhbGxFeGlzdDogYm9vbGVhbiB9IHsKICAgIHByb3RvIDIgMgogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ4LTE1MQogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYXNzZXRzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTUwCiAgICAvLyBhcmdzOiBbZXNjcm93LCBhc3NldHNdCiAgICBmcmFtZV9kaWcgLTIKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ4LTE1MQogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYXNzZXRzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDAyZmU0NTE1IC8vIG1ldGhvZCAiYXJjNThfZ2V0QWxsb3dhbmNlcyhzdHJpbmcsdWludDY0W10pKHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwbiAyCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sMSlbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGxldCBleGlzdGVuY2VzOiBib29sZWFuW10gPSBbXQogICAgYnl0ZWMgNDEgLy8gMHgwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU0CiAgICAvLyBsZXQgYW55RXhpc3Q6IGJvb2xlYW4gPSBmYWxzZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGxldCBhbGxFeGlzdDogYm9vbGVhbiA9IHRydWUKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU2CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaW5mby5sZW5ndGg7IGkrKykgewogICAgaW50Y18wIC8vIDAKCmFsbG93YW5jZUNoZWNrX3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGluZm8ubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAxNAogICAgZnJhbWVfZGlnIDEwCiAgICA8CiAgICBieiBhbGxvd2FuY2VDaGVja19hZnRlcl93aGlsZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU3CiAgICAvLyBjb25zdCBleGlzdHMgPSBpbmZvW2ldLnN0YXJ0ICE9PSAwCiAgICBmcmFtZV9kaWcgOQogICAgZXh0cmFjdCA2IDAKICAgIGZyYW1lX2RpZyAxNAogICAgcHVzaGludCA1MCAvLyA1MAogICAgKgogICAgcHVzaGludCA1MCAvLyA1MAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDYKICAgIGludGNfMCAvLyAwCiAgICAhPQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gZXhpc3RlbmNlcy5wdXNoKGV4aXN0cykKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBmcmFtZV9idXJ5IDAKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTgKICAgIC8vIGV4aXN0ZW5jZXMucHVzaChleGlzdHMpCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMAogICAgdW5jb3ZlciAzCiAgICBzd2FwCiAgICByZXBsYWNlMiAwCiAgICBmcmFtZV9idXJ5IDEKICAgIHN3YXAKICAgIHB1c2hpbnQgNyAvLyA3CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIHN3YXAKICAgIHB1c2hpbnQgNyAvLyA3CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDUKICAgIDwKICAgIGJ6IGFsbG93YW5jZUNoZWNrX2FmdGVyX2lmX2Vsc2VAMTAKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMwogICAgLQogICAgYnplcm8KICAgIGZyYW1lX2RpZyAxCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGZyYW1lX2J1cnkgMQoKYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDQKICAgIGZyYW1lX2RpZyAyCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gZXhpc3RlbmNlcy5wdXNoKGV4aXN0cykKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDcKCmFsbG93YW5jZUNoZWNrX3doaWxlX3RvcEAxMToKICAgIGZyYW1lX2RpZyA4CiAgICBmcmFtZV9kaWcgNwogICAgPAogICAgYnogYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfd2hpbGVAMTMKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBnZXRiaXQKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgZnJhbWVfYnVyeSAxCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU4CiAgICAvLyBleGlzdGVuY2VzLnB1c2goZXhpc3RzKQogICAgaW50Y18yIC8vIDgKICAgICsKICAgIGZyYW1lX2J1cnkgNAogICAgYiBhbGxvd2FuY2VDaGVja193aGlsZV90b3BAMTEKCmFsbG93YW5jZUNoZWNrX2FmdGVyX3doaWxlQDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OQogICAgLy8gaWYgKGV4aXN0cykgewogICAgZnJhbWVfZGlnIDYKICAgIGJ6IGFsbG93YW5jZUNoZWNrX2Vsc2VfYm9keUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBhbnlFeGlzdCA9IHRydWUKICAgIGludGNfMSAvLyAxCiAgICBmcmFtZV9idXJ5IDEyCgphbGxvd2FuY2VDaGVja19hZnRlcl9pZl9lbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU2CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaW5mby5sZW5ndGg7IGkrKykgewogICAgZnJhbWVfZGlnIDE0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSAxNAogICAgZnJhbWVfZGlnIDEKICAgIGZyYW1lX2J1cnkgMTEKICAgIGIgYWxsb3dhbmNlQ2hlY2tfd2hpbGVfdG9wQDIKCmFsbG93YW5jZUNoZWNrX2Vsc2VfYm9keUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE2MgogICAgLy8gYWxsRXhpc3QgPSBmYWxzZQogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMTMKICAgIGIgYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfaWZfZWxzZUA2CgphbGxvd2FuY2VDaGVja19hZnRlcl93aGlsZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NgogICAgLy8gcmV0dXJuIHsgZXhpc3RlbmNlcywgYW55RXhpc3QsIGFsbEV4aXN0IH0KICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMgogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIDEzCiAgICBzZXRiaXQKICAgIHB1c2hieXRlcyAweDAwMDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDExCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8udmFsaWRFZGl0T3JTdWJtaXQocHJvcG9zYWxJRDogdWludDY0KSAtPiB1aW50NjQ6CnZhbGlkRWRpdE9yU3VibWl0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0OQogICAgLy8gcHJpdmF0ZSB2YWxpZEVkaXRPclN1Ym1pdChwcm9wb3NhbElEOiB1aW50NjQpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUwCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUxCiAgICAvLyBjb25zdCB7IHN0YXR1cywgY3JlYXRvciB9ID0gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgcHVzaGludHMgNjEgMzIgLy8gNjEsIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1MgogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldE9yaWdpbgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1NQogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICBieXRlY18wIC8vIDB4MDAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzU1LTM1NgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyBvcmlnaW4gPT09IGNyZWF0b3IKICAgIGJ6IHZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfZmFsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1NgogICAgLy8gb3JpZ2luID09PSBjcmVhdG9yCiAgICBmcmFtZV9kaWcgMQogICAgZnJhbWVfZGlnIDAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzU1LTM1NgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyBvcmlnaW4gPT09IGNyZWF0b3IKICAgIGJ6IHZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfZmFsc2VAMwogICAgaW50Y18xIC8vIDEKCnZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfbWVyZ2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNTQtMzU3CiAgICAvLyByZXR1cm4gKAogICAgLy8gICBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRHJhZnQgJiYKICAgIC8vICAgb3JpZ2luID09PSBjcmVhdG9yCiAgICAvLyApCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKdmFsaWRFZGl0T3JTdWJtaXRfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgdmFsaWRFZGl0T3JTdWJtaXRfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy52YWxpZGF0ZVNldHRpbmdzKHNldHRpbmdzOiBieXRlcykgLT4gYnl0ZXM6CnZhbGlkYXRlU2V0dGluZ3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzYwCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlU2V0dGluZ3Moc2V0dGluZ3M6IFByb3Bvc2FsU2V0dGluZ3MpOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2MQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmFwcHJvdmFsID4gMV8wMDAsIEVSUl9JTlZBTElEX01JTl9BUFBST1ZBTCkgLy8gMSUKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgaW50YyA1IC8vIDEwMDAKICAgID4KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1pbmltdW0gYXBwcm92YWwgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmFwcHJvdmFsIDw9IDEwMF8wMDAsIEVSUl9JTlZBTElEX01BWF9BUFBST1ZBTCkgLy8gMTAwJQogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gYXBwcm92YWwgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NAogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBhcnRpY2lwYXRpb24gPiAxXzAwMCkgLy8gMSUKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgaW50YyA1IC8vIDEwMDAKICAgID4KICAgIGFzc2VydAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBhcnRpY2lwYXRpb24gPD0gMTAwXzAwMCwgRVJSX0lOVkFMSURfTUFYX1BBUlRJQ0lQQVRJT04pIC8vIDEwMCUKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBtYXhpbXVtIHBhcnRpY2lwYXRpb24gcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NwogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmR1cmF0aW9uID4gMCwgRVJSX0lOVkFMSURfRFVSQVRJT04pCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBhc3NlcnQgLy8gSW52YWxpZCBkdXJhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2OQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBvd2VyID4gMCwgRVJSX0lOVkFMSURfTUlOX1BPV0VSKQogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYXNzZXJ0IC8vIEludmFsaWQgbWluaW11bSBwb3dlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3MAogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBvd2VyIDw9IDFfMDAwLCBFUlJfSU5WQUxJRF9NQVhfUE9XRVIpCiAgICBpbnRjIDUgLy8gMTAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gcG93ZXIKICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRHb3Zlcm5hbmNlUG93ZXIoYWNjb3VudDogYnl0ZXMpIC0+IHVpbnQ2NDoKZ2V0R292ZXJuYW5jZVBvd2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3MwogICAgLy8gcHJpdmF0ZSBnZXRHb3Zlcm5hbmNlUG93ZXIoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3NAogICAgLy8gY29uc3QgeyBzdGFraW5nIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNzQKICAgIC8vIGNvbnN0IHsgc3Rha2luZyB9ID0gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNzUKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3NQogICAgLy8gY29uc3QgeyBha3RhLCBib25lcyB9ID0gdGhpcy5ha2l0YUFzc2V0cy52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mzc3CiAgICAvLyBpZiAoYWt0YSA9PT0gMCB8fCBib25lcyA9PT0gMCkgewogICAgYnogZ2V0R292ZXJuYW5jZVBvd2VyX2lmX2JvZHlAMgogICAgZnJhbWVfZGlnIDQKICAgIGJueiBnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUAzCgpnZXRHb3Zlcm5hbmNlUG93ZXJfaWZfYm9keUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3OAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0R292ZXJuYW5jZVBvd2VyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozODEKICAgIC8vIGNvbnN0IGFrdGFQb3dlciA9IGdldFN0YWtpbmdQb3dlcihzdGFraW5nLCBhY2NvdW50LCBha3RhKQogICAgZnJhbWVfZGlnIDIKICAgIGR1cAogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgMwogICAgY2FsbHN1YiBnZXRTdGFraW5nUG93ZXIKICAgIGR1cAogICAgY292ZXIgMgogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzgyCiAgICAvLyBjb25zdCBib25lc1Bvd2VyID0gZ2V0U3Rha2luZ1Bvd2VyKHN0YWtpbmcsIGFjY291bnQsIGJvbmVzKQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgNAogICAgY2FsbHN1YiBnZXRTdGFraW5nUG93ZXIKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4NAogICAgLy8gaWYgKGFrdGFQb3dlciA9PT0gMCB8fCBib25lc1Bvd2VyID09PSAwKSB7CiAgICBieiBnZXRHb3Zlcm5hbmNlUG93ZXJfaWZfYm9keUA1CiAgICBmcmFtZV9kaWcgMQogICAgYm56IGdldEdvdmVybmFuY2VQb3dlcl9hZnRlcl9pZl9lbHNlQDYKCmdldEdvdmVybmFuY2VQb3dlcl9pZl9ib2R5QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mzg1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4OQogICAgLy8gaWYgKGFrdGFQb3dlciA8IGJvbmVzUG93ZXIpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgMQogICAgPAogICAgYnogZ2V0R292ZXJuYW5jZVBvd2VyX2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5MAogICAgLy8gcmV0dXJuIGFrdGFQb3dlcgogICAgcmV0c3ViCgpnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5MwogICAgLy8gcmV0dXJuIGJvbmVzUG93ZXIKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uY3JlYXRlT3JVcGRhdGVQcm9wb3NhbChpZDogdWludDY0LCBjaWQ6IGJ5dGVzLCBhY3Rpb25zOiBieXRlcywgb3JpZ2luOiBieXRlcywgZmVlc1BhaWQ6IHVpbnQ2NCwgcG93ZXJSZXF1aXJlZDogdWludDY0KSAtPiB1aW50NjQsIGJ5dGVzOgpjcmVhdGVPclVwZGF0ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5Ni00MDMKICAgIC8vIHByaXZhdGUgY3JlYXRlT3JVcGRhdGVQcm9wb3NhbCgKICAgIC8vICAgaWQ6IHVpbnQ2NCwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10sCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgZmVlc1BhaWQ6IHVpbnQ2NCwKICAgIC8vICAgcG93ZXJSZXF1aXJlZDogdWludDY0CiAgICAvLyApOiB1aW50NjQgewogICAgcHJvdG8gNiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA1CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPiAwLCBFUlJfRU1QVFlfQUNUSU9OX0xJU1QpCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBhc3NlcnQgLy8gQWN0aW9uIGxpc3QgY2Fubm90IGJlIGVtcHR5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA2CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPD0gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlLCBFUlJfVE9PX01BTllfQUNUSU9OUykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHByb3Bvc2FsQWN0aW9uTGltaXQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsQWN0aW9uTGltaXQgfSkKICAgIGJ5dGVjIDEzIC8vICJwcm9wb3NhbF9hY3Rpb25fbGltaXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA2CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPD0gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlLCBFUlJfVE9PX01BTllfQUNUSU9OUykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA8PQogICAgYXNzZXJ0IC8vIFRvbyBtYW55IGFjdGlvbnMgaW4gdGhlIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA4CiAgICAvLyBpZiAodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MDgKICAgIC8vIGlmICh0aGlzLnN0YXRlLnZhbHVlICE9PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAzNCAvLyAweDAyCiAgICAhPQogICAgYnogY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MDkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsIEVSUl9GT1JCSURERU4pCiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgID09CiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciB3YWxsZXQgY2FuIGRlbGV0ZSBhIHNwZW5kaW5nIGFjY291bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MTEKICAgIC8vIGlkID0gdGhpcy5uZXdQcm9wb3NhbElEKCkKICAgIGNhbGxzdWIgbmV3UHJvcG9zYWxJRAogICAgZnJhbWVfYnVyeSAtNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQyMQogICAgLy8gY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MjMKICAgIC8vIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDE3CiAgICAvLyBhcHByb3ZhbHM6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxMy00MjYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkLAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IFR4bi5zZW5kZXIsCiAgICAvLyAgIHZvdGluZ1RzOiAwLAogICAgLy8gICBjcmVhdGVkOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBmZWVzUGFpZCwKICAgIC8vICAgYWN0aW9uczogY2xvbmUoYWN0aW9ucykKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtNQogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxNAogICAgLy8gc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkLAogICAgYnl0ZWMgOCAvLyAweDI4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDEzLTQyNgogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgLy8gICBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQsCiAgICAvLyAgIGNpZCwKICAgIC8vICAgdm90ZXM6IHsKICAgIC8vICAgICBhcHByb3ZhbHM6IDAsCiAgICAvLyAgICAgcmVqZWN0aW9uczogMCwKICAgIC8vICAgICBhYnN0YWluczogMCwKICAgIC8vICAgfSwKICAgIC8vICAgY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZnJhbWVfZGlnIC01CiAgICBjb25jYXQKICAgIGJ5dGVjIDUyIC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0yCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjIDUzIC8vIDB4MDA3NwogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTQKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxMwogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgZnJhbWVfZGlnIC02CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDEzLTQyNgogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgLy8gICBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQsCiAgICAvLyAgIGNpZCwKICAgIC8vICAgdm90ZXM6IHsKICAgIC8vICAgICBhcHByb3ZhbHM6IDAsCiAgICAvLyAgICAgcmVqZWN0aW9uczogMCwKICAgIC8vICAgICBhYnN0YWluczogMCwKICAgIC8vICAgfSwKICAgIC8vICAgY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHJldHVybiBpZAogICAgZnJhbWVfZGlnIC02CiAgICBmcmFtZV9kaWcgLTQKICAgIHJldHN1YgoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDMxCiAgICAvLyBjb25zdCB1c2VyUG93ZXIgPSB0aGlzLmdldEdvdmVybmFuY2VQb3dlcihvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTMKICAgIGNhbGxzdWIgZ2V0R292ZXJuYW5jZVBvd2VyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDMzCiAgICAvLyBhc3NlcnQodXNlclBvd2VyID49IHBvd2VyUmVxdWlyZWQsIEVSUl9JTlNVRkZJQ0lFTlRfUFJPUE9TQUxfVEhSRVNIT0xEKQogICAgZnJhbWVfZGlnIC0xCiAgICA+PQogICAgYXNzZXJ0IC8vIEluc3VmZmljaWVudCBwcm9wb3NhbCB0aHJlc2hvbGQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MzYKICAgIC8vIGlmIChpZCA9PT0gMCkgewogICAgZnJhbWVfZGlnIC02CiAgICBibnogY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9lbHNlX2JvZHlANAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gaWQgPSB0aGlzLm5ld1Byb3Bvc2FsSUQoKQogICAgY2FsbHN1YiBuZXdQcm9wb3NhbElECiAgICBmcmFtZV9idXJ5IC02CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDM4CiAgICAvLyBjcmVhdGVkID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQ3CiAgICAvLyBhcHByb3ZhbHM6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0My00NTYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0RyYWZ0LAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgaXRvYgogICAgZnJhbWVfZGlnIC01CiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQ0CiAgICAvLyBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzRHJhZnQsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDMtNDU2CiAgICAvLyB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIHN0YXR1czogUHJvcG9zYWxTdGF0dXNEcmFmdCwKICAgIC8vICAgY2lkLAogICAgLy8gICB2b3RlczogewogICAgLy8gICAgIGFwcHJvdmFsczogMCwKICAgIC8vICAgICByZWplY3Rpb25zOiAwLAogICAgLy8gICAgIGFic3RhaW5zOiAwLAogICAgLy8gICB9LAogICAgLy8gICBjcmVhdG9yOiBvcmlnaW4sCiAgICAvLyAgIHZvdGluZ1RzOiAwLAogICAgLy8gICBjcmVhdGVkLAogICAgLy8gICBmZWVzUGFpZCwKICAgIC8vICAgYWN0aW9uczogY2xvbmUoYWN0aW9ucykKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAtNQogICAgY29uY2F0CiAgICBieXRlYyA1MiAvLyAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTMKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMgogICAgaXRvYgogICAgY29uY2F0CiAgICBieXRlYyA1MyAvLyAweDAwNzcKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC00CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDMKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIGZyYW1lX2RpZyAtNgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0My00NTYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0RyYWZ0LAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NTgKICAgIC8vIHJldHVybiBpZAogICAgZnJhbWVfZGlnIC02CiAgICBmcmFtZV9kaWcgLTQKICAgIHJldHN1YgoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9lbHNlX2JvZHlANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDAKICAgIC8vIGNyZWF0ZWQgPSB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUuY3JlYXRlZAogICAgZnJhbWVfZGlnIC02CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQwCiAgICAvLyBjcmVhdGVkID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlLmNyZWF0ZWQKICAgIHB1c2hpbnQgMTAxIC8vIDEwMQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBiIGNyZWF0ZU9yVXBkYXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5hZGRQbHVnaW4oZGF0YTogYnl0ZXMpIC0+IGJ5dGVzOgphZGRQbHVnaW46CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTAzCiAgICAvLyBwcml2YXRlIGFkZFBsdWdpbihkYXRhOiBQcm9wb3NhbEFkZE5hbWVkUGx1Z2luKTogdm9pZCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MjMKICAgIC8vIH0gPSBjbG9uZShkYXRhKTsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBmcmFtZV9kaWcgLTEKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgY292ZXIgMgogICAgZXh0cmFjdCAyIDAKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAyIDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAxMCAzMgogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDYxIC8vIDYxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgNDQgMQogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDQ1IDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA1MyA4CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMTA0IC8vIDEwNAogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDUwNCAvLyA1MDQKICAgIGdldGJpdAogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDUwNSAvLyA1MDUKICAgIGdldGJpdAogICAgZHVwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTA2IC8vIDUwNgogICAgZ2V0Yml0CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTA3IC8vIDUwNwogICAgZ2V0Yml0CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgNjQgOAogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDcyIDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA4MCA4CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgODggOAogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDk2IDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAxMDYgLy8gMTA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGZyYW1lX2RpZyAtMQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUyNQogICAgLy8gaWYgKHVzZUV4ZWN1dGlvbktleSkgewogICAgYnogYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUyNi01MzIKICAgIC8vIHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBmZWUsCiAgICAvLyAgIHBvd2VyLAogICAgLy8gICBkdXJhdGlvbiwKICAgIC8vICAgcGFydGljaXBhdGlvbiwKICAgIC8vICAgYXBwcm92YWwKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAxNAogICAgZnJhbWVfZGlnIDE1CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAxNgogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMTcKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDE4CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MjYKICAgIC8vIHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLnZhbHVlID0gewogICAgZnJhbWVfZGlnIDUKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMgogICAgYnl0ZWMgNDYgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBwbHVnaW5zID0gQm94TWFwPERBT1BsdWdpbktleSwgUHJvcG9zYWxTZXR0aW5ncz4oeyBrZXlQcmVmaXg6IEFic3RyYWN0QWNjb3VudEJveFByZWZpeFBsdWdpbnMgfSkKICAgIGJ5dGVjIDQ3IC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTI2LTUzMgogICAgLy8gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIGZlZSwKICAgIC8vICAgcG93ZXIsCiAgICAvLyAgIGR1cmF0aW9uLAogICAgLy8gICBwYXJ0aWNpcGF0aW9uLAogICAgLy8gICBhcHByb3ZhbAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAoKYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzUKICAgIC8vIGlmIChuYW1lICE9PSAnJykgewogICAgZnJhbWVfZGlnIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgICE9CiAgICBieiBhZGRQbHVnaW5fZWxzZV9ib2R5QDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzYtNTU0CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkTmFtZWRQbHVnaW4+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgbmFtZSwKICAgIC8vICAgICBwbHVnaW4sCiAgICAvLyAgICAgY2FsbGVyLAogICAgLy8gICAgIGVzY3JvdywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWxlZ2F0aW9uVHlwZSwKICAgIC8vICAgICBsYXN0VmFsaWQsCiAgICAvLyAgICAgY29vbGRvd24sCiAgICAvLyAgICAgbWV0aG9kcywKICAgIC8vICAgICB1c2VSb3VuZHMsCiAgICAvLyAgICAgdXNlRXhlY3V0aW9uS2V5LAogICAgLy8gICAgIGNvdmVyRmVlcywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWZhdWx0VG9Fc2Nyb3cKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzcKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzcKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTQ4CiAgICAvLyB1c2VSb3VuZHMsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTAKICAgIHNldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU0OQogICAgLy8gdXNlRXhlY3V0aW9uS2V5LAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDExCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTAKICAgIC8vIGNvdmVyRmVlcywKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMgogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTUyCiAgICAvLyBkZWZhdWx0VG9Fc2Nyb3cKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMwogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTM2LTU1NAogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZE5hbWVkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgcGx1Z2luLAogICAgLy8gICAgIGNhbGxlciwKICAgIC8vICAgICBlc2Nyb3csCiAgICAvLyAgICAgZmFsc2UsCiAgICAvLyAgICAgZGVsZWdhdGlvblR5cGUsCiAgICAvLyAgICAgbGFzdFZhbGlkLAogICAgLy8gICAgIGNvb2xkb3duLAogICAgLy8gICAgIG1ldGhvZHMsCiAgICAvLyAgICAgdXNlUm91bmRzLAogICAgLy8gICAgIHVzZUV4ZWN1dGlvbktleSwKICAgIC8vICAgICBjb3ZlckZlZXMsCiAgICAvLyAgICAgZmFsc2UsCiAgICAvLyAgICAgZGVmYXVsdFRvRXNjcm93CiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHg1NzJmZDFlYSAvLyBtZXRob2QgImFyYzU4X2FkZE5hbWVkUGx1Z2luKHN0cmluZyx1aW50NjQsYWRkcmVzcyxzdHJpbmcsYm9vbCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NClbXSxib29sLGJvb2wsYm9vbCxib29sLGJvb2wpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NDMKICAgIC8vIGZhbHNlLAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDYKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA4CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDkKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUzNi01NTQKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9hZGROYW1lZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmFkZFBsdWdpbl9hZnRlcl9pZl9lbHNlQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTc2CiAgICAvLyBpZiAoZXNjcm93ICE9PSAnJyAmJiBhbGxvd2FuY2VzLmxlbmd0aCA+IDApIHsKICAgIGZyYW1lX2RpZyA1CiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYnogYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMTAKICAgIGZyYW1lX2RpZyAxOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBieiBhZGRQbHVnaW5fYWZ0ZXJfaWZfZWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU3NwogICAgLy8gdGhpcy5hZGRBbGxvd2FuY2VzKGVzY3JvdywgYWxsb3dhbmNlcykKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMTkKICAgIGNhbGxzdWIgYWRkQWxsb3dhbmNlcwogICAgcG9wCgphZGRQbHVnaW5fYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmFkZFBsdWdpbl9lbHNlX2JvZHlANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTYtNTczCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU1NwogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU1NwogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NjcKICAgIC8vIHVzZVJvdW5kcywKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMAogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTY4CiAgICAvLyB1c2VFeGVjdXRpb25LZXksCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTEKICAgIHNldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2OQogICAgLy8gY292ZXJGZWVzLAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDEyCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NzEKICAgIC8vIGRlZmF1bHRUb0VzY3JvdwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDEzCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTYtNTczCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NTY3MjQ2MmUgLy8gbWV0aG9kICJhcmM1OF9hZGRQbHVnaW4odWludDY0LGFkZHJlc3Msc3RyaW5nLGJvb2wsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2MgogICAgLy8gZmFsc2UsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA3CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDgKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgOQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTU2LTU3MwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBwbHVnaW4sCiAgICAvLyAgICAgY2FsbGVyLAogICAgLy8gICAgIGVzY3JvdywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWxlZ2F0aW9uVHlwZSwKICAgIC8vICAgICBsYXN0VmFsaWQsCiAgICAvLyAgICAgY29vbGRvd24sCiAgICAvLyAgICAgbWV0aG9kcywKICAgIC8vICAgICB1c2VSb3VuZHMsCiAgICAvLyAgICAgdXNlRXhlY3V0aW9uS2V5LAogICAgLy8gICAgIGNvdmVyRmVlcywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWZhdWx0VG9Fc2Nyb3cKICAgIC8vICAgXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBiIGFkZFBsdWdpbl9hZnRlcl9pZl9lbHNlQDcKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmFkZEFsbG93YW5jZXMoZXNjcm93OiBieXRlcywgYWxsb3dhbmNlczogYnl0ZXMpIC0+IGJ5dGVzOgphZGRBbGxvd2FuY2VzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4MQogICAgLy8gcHJpdmF0ZSBhZGRBbGxvd2FuY2VzKGVzY3Jvdzogc3RyaW5nLCBhbGxvd2FuY2VzOiBBZGRBbGxvd2FuY2VJbmZvW10pOiB2b2lkIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4Mi01ODUKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9hZGRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYWxsb3dhbmNlc10KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgzCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgzCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4NAogICAgLy8gYXJnczogW2VzY3JvdywgYWxsb3dhbmNlc10KICAgIGZyYW1lX2RpZyAtMgogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1ODItNTg1CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkQWxsb3dhbmNlcz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtlc2Nyb3csIGFsbG93YW5jZXNdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4YmY0ZDdjNTcgLy8gbWV0aG9kICJhcmM1OF9hZGRBbGxvd2FuY2VzKHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZnJhbWVfZGlnIC0xCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnJlbW92ZVBsdWdpbihkYXRhOiBieXRlcykgLT4gYnl0ZXM6CnJlbW92ZVBsdWdpbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1OTUKICAgIC8vIHByaXZhdGUgcmVtb3ZlUGx1Z2luKGRhdGE6IFByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW4pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5NgogICAgLy8gY29uc3QgeyBuYW1lLCBwbHVnaW4sIGNhbGxlciwgZXNjcm93IH0gPSBkYXRhCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMiA4CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDEwIDMyCiAgICBjb3ZlciAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgZnJhbWVfZGlnIC0xCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTk4CiAgICAvLyBpZiAodGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkuZXhpc3RzKSB7CiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWMgNDYgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBwbHVnaW5zID0gQm94TWFwPERBT1BsdWdpbktleSwgUHJvcG9zYWxTZXR0aW5ncz4oeyBrZXlQcmVmaXg6IEFic3RyYWN0QWNjb3VudEJveFByZWZpeFBsdWdpbnMgfSkKICAgIGJ5dGVjIDQ3IC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1OTgKICAgIC8vIGlmICh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5OQogICAgLy8gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkuZGVsZXRlKCkKICAgIGZyYW1lX2RpZyA1CiAgICBib3hfZGVsCiAgICBwb3AKCnJlbW92ZVBsdWdpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAyCiAgICAvLyBpZiAobmFtZSAhPT0gJycpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYnogcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYwMy02MDYKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZW1vdmVOYW1lZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtuYW1lXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDQKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDQKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAzLTYwNgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZU5hbWVkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW25hbWVdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZTM1MGI5ZDQgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVOYW1lZFBsdWdpbihzdHJpbmcpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDcKICAgIC8vIHJldHVybgogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTAtNjEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW3BsdWdpbiwgY2FsbGVyLCBlc2Nyb3ddCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTAtNjEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW3BsdWdpbiwgY2FsbGVyLCBlc2Nyb3ddCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZWVmNDQ4ZmQgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVQbHVnaW4odWludDY0LGFkZHJlc3Msc3RyaW5nKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3RXhlY3V0aW9uKGtleTogYnl0ZXMsIGdyb3VwczogYnl0ZXMsIGZpcnN0VmFsaWQ6IHVpbnQ2NCwgbGFzdFZhbGlkOiB1aW50NjQpIC0+IGJ5dGVzOgpuZXdFeGVjdXRpb246CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQxCiAgICAvLyBwcml2YXRlIG5ld0V4ZWN1dGlvbihrZXk6IGJ5dGVzPDMyPiwgZ3JvdXBzOiBieXRlczwzMj5bXSwgZmlyc3RWYWxpZDogdWludDY0LCBsYXN0VmFsaWQ6IHVpbnQ2NCk6IHZvaWQgewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQyLTc0NQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZEV4ZWN1dGlvbktleT4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtrZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDMKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDMKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQ0CiAgICAvLyBhcmdzOiBba2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZF0KICAgIGZyYW1lX2RpZyAtNAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZnJhbWVfZGlnIC0yCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDItNzQ1CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkRXhlY3V0aW9uS2V5Pih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2tleSwgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWRdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NWNlYmVkNDMgLy8gbWV0aG9kICJhcmM1OF9hZGRFeGVjdXRpb25LZXkoYnl0ZVszMl0sYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC00CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0zCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBmcmFtZV9kaWcgLTMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3UHJvcG9zYWxJRCgpIC0+IHVpbnQ2NDoKbmV3UHJvcG9zYWxJRDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTYKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wcm9wb3NhbElELnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyBwcm9wb3NhbElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbElEIH0pCiAgICBieXRlYyAzNyAvLyAicHJvcG9zYWxfaWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzU2CiAgICAvLyBjb25zdCBpZCA9IHRoaXMucHJvcG9zYWxJRC52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTcKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSArPSAxCiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHByb3Bvc2FsSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsSUQgfSkKICAgIGJ5dGVjIDM3IC8vICJwcm9wb3NhbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTcKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc1OAogICAgLy8gcmV0dXJuIGlkCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnNldHVwQ29zdCgpIC0+IHVpbnQ2NDoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1NwogICAgLy8gY29uc3QgeyB3YWxsZXQ6IGFwcElkIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjU3CiAgICAvLyBjb25zdCB7IHdhbGxldDogYXBwSWQgfSA9IHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCA4OCAvLyA4OAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjU4CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnRGYWN0b3J5LnByb3RvdHlwZS5jb3N0Pih7IGFwcElkIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHg5YjY3ZmE2ZCAvLyBtZXRob2QgImNvc3QoKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0KGFjdGlvbnM6IGJ5dGVzKSAtPiBieXRlcywgYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNjEtMTI2MgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICAvLyBwcm9wb3NhbENvc3QoYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IFByb3Bvc2FsQ29zdEluZm8gewogICAgcHJvdG8gMSAyCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiA5CiAgICBieXRlY18zIC8vICIiCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUKICAgIC8vIGxldCB0b3RhbEFjdGlvbkRhdGFMZW5ndGg6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGR1cAoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rfd2hpbGVfdG9wQDUzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTAKICAgIGZyYW1lX2RpZyAxNAogICAgPgogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfd2hpbGVANTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDcKICAgIC8vIHRvdGFsQWN0aW9uRGF0YUxlbmd0aCArPSAoTWluREFPUHJvcG9zYWxBY3Rpb25NYnIgKyBhY3Rpb25zW2ldLmRhdGEubGVuZ3RoKQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDIgMAogICAgZnJhbWVfZGlnIDE0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxNAogICAgZnJhbWVfZGlnIDEwCiAgICBkaWcgMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyA0CiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgbGVuCiAgICBwdXNoaW50IDcgLy8gNwogICAgKwogICAgZnJhbWVfZGlnIDEzCiAgICArCiAgICBmcmFtZV9idXJ5IDEzCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X3doaWxlX3RvcEA1MwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfd2hpbGVANTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gTWluREFPUHJvcG9zYWxNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiB0b3RhbEFjdGlvbkRhdGFMZW5ndGgpCiAgICBwdXNoaW50IDQwMCAvLyA0MDAKICAgIGZyYW1lX2RpZyAxMwogICAgKgogICAgcHVzaGludCA1NDUwMCAvLyA1NDUwMAogICAgKwogICAgZnJhbWVfYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNjUKICAgIC8vIHRvdGFsOiAwLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjY0LTEyNzIKICAgIC8vIGxldCBpbmZvOiBQcm9wb3NhbENvc3RJbmZvID0gewogICAgLy8gICB0b3RhbDogMCwKICAgIC8vICAgbWJyOiAwLAogICAgLy8gICBmZWU6IDAsCiAgICAvLyAgIHBvd2VyOiAwLAogICAgLy8gICBkdXJhdGlvbjogMCwKICAgIC8vICAgcGFydGljaXBhdGlvbjogMCwKICAgIC8vICAgYXBwcm92YWw6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2J1cnkgNQogICAgcHVzaGJ5dGVzIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgZnJhbWVfYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDE0CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF93aGlsZV90b3BAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc0CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYWN0aW9ucy5sZW5ndGg7IGkrKykgewogICAgZnJhbWVfZGlnIDE0CiAgICBmcmFtZV9kaWcgMTAKICAgIDwKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX3doaWxlQDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3NgogICAgLy8gY29uc3QgeyB0eXBlLCBkYXRhIH0gPSBhY3Rpb25zW2ldCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMiAwCiAgICBmcmFtZV9kaWcgMTQKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDE0CiAgICBmcmFtZV9kaWcgMTAKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDAgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBmcmFtZV9idXJ5IDkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNAogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2J1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgZHVwCiAgICBmcmFtZV9idXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDYzLTQ2NQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY0CiAgICAvLyByZXR1cm4gdGhpcy51cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2OAogICAgLy8gdXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTUgLy8gInVwZ3JhZGVfYXBwX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2NAogICAgLy8gcmV0dXJuIHRoaXMudXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRQcm9wb3NhbFNldHRpbmdzQDUxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODAKICAgIC8vIGluZm8udG90YWwgKz0gc2V0dGluZ3MuZmVlCiAgICBmcmFtZV9kaWcgNwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyA4CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGRpZyAxCiAgICArCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIHJlcGxhY2UyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjgxCiAgICAvLyBpbmZvLmZlZSArPSBzZXR0aW5ncy5mZWUKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgKwogICAgaXRvYgogICAgcmVwbGFjZTIgMTYKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODMKICAgIC8vIGlmICh0eXBlID09PSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwKSB7CiAgICBmcmFtZV9kaWcgMTIKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4NAogICAgLy8gbWJyICs9IERBT0V4ZWN1dGlvbk1CUgogICAgZnJhbWVfZGlnIDExCiAgICBwdXNoaW50IDIyMTAwIC8vIDIyMTAwCiAgICArCiAgICBmcmFtZV9idXJ5IDExCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODkKICAgIC8vIGlmIChzZXR0aW5ncy5wb3dlciA+IGluZm8ucG93ZXIpIHsKICAgIGZyYW1lX2RpZyA4CiAgICBkdXAKICAgIGV4dHJhY3QgOCA4CiAgICBmcmFtZV9idXJ5IDMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfZGlnIDcKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICA+CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI5MAogICAgLy8gaW5mby5wb3dlciA9IHNldHRpbmdzLnBvd2VyCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDMKICAgIHJlcGxhY2UyIDI0CiAgICBmcmFtZV9idXJ5IDcKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI5MwogICAgLy8gaWYgKHNldHRpbmdzLmR1cmF0aW9uID4gaW5mby5kdXJhdGlvbikgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAxNiA4CiAgICBmcmFtZV9idXJ5IDAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk0CiAgICAvLyBpbmZvLmR1cmF0aW9uID0gc2V0dGluZ3MuZHVyYXRpb24KICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9kaWcgMAogICAgcmVwbGFjZTIgMzIKICAgIGZyYW1lX2J1cnkgNwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk3CiAgICAvLyBpZiAoc2V0dGluZ3MucGFydGljaXBhdGlvbiA+IGluZm8ucGFydGljaXBhdGlvbikgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAyNCA4CiAgICBmcmFtZV9idXJ5IDEKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk4CiAgICAvLyBpbmZvLnBhcnRpY2lwYXRpb24gPSBzZXR0aW5ncy5wYXJ0aWNpcGF0aW9uCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDEKICAgIHJlcGxhY2UyIDQwCiAgICBmcmFtZV9idXJ5IDcKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMwMQogICAgLy8gaWYgKHNldHRpbmdzLmFwcHJvdmFsID4gaW5mby5hcHByb3ZhbCkgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAzMiA4CiAgICBmcmFtZV9idXJ5IDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCA0OCAvLyA0OAogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X3doaWxlX3RvcEAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMwMgogICAgLy8gaW5mby5hcHByb3ZhbCA9IHNldHRpbmdzLmFwcHJvdmFsCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDIKICAgIHJlcGxhY2UyIDQ4CiAgICBmcmFtZV9idXJ5IDcKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rfd2hpbGVfdG9wQDMKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODUKICAgIC8vIH0gZWxzZSBpZiAodHlwZSA9PT0gUHJvcG9zYWxBY3Rpb25UeXBlQWRkUGx1Z2luIHx8IHR5cGUgPT09IFByb3Bvc2FsQWN0aW9uVHlwZUFkZE5hbWVkUGx1Z2luKSB7CiAgICBmcmFtZV9kaWcgOQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2lmX2JvZHlAOAogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDM1IC8vIDB4MTUKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEwCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9pZl9ib2R5QDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4NgogICAgLy8gbWJyICs9IHRoaXMucGx1Z2luc01icihTdHJpbmcob3AuYnplcm8oMjQpKSkKICAgIGZyYW1lX2RpZyAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gcmV0dXJuIE1pbkRBT1BsdWdpbk1CUiArIChCb3hDb3N0UGVyQnl0ZSAqIEJ5dGVzKGVzY3JvdykubGVuZ3RoKQogICAgcHVzaGludCAzMzMwMCAvLyAzMzMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODYKICAgIC8vIG1iciArPSB0aGlzLnBsdWdpbnNNYnIoU3RyaW5nKG9wLmJ6ZXJvKDI0KSkpCiAgICArCiAgICBmcmFtZV9idXJ5IDExCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTAKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY2CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjoKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBibnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NjcKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2Ny00NjkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIC8vICAgcmV0dXJuIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAzMwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY4CiAgICAvLyByZXR1cm4gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcwCiAgICAvLyBhZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTYgLy8gImFkZF9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY4CiAgICAvLyByZXR1cm4gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDcwCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAxMCAvLyAweDFlCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ3MC00NzQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlRXhlY3V0ZVBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICByZXR1cm4gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkudmFsdWUKICAgIC8vIH0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzEKICAgIC8vIGNvbnN0IHsgcGx1Z2luLCBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxFeGVjdXRlUGx1Z2luPihkYXRhKQogICAgZnJhbWVfZGlnIDQKICAgIGV4dHJhY3QgMiA4CiAgICBmcmFtZV9kaWcgNgogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzIKICAgIC8vIGFzc2VydCh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyA0NiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTEKICAgIC8vIHBsdWdpbnMgPSBCb3hNYXA8REFPUGx1Z2luS2V5LCBQcm9wb3NhbFNldHRpbmdzPih7IGtleVByZWZpeDogQWJzdHJhY3RBY2NvdW50Qm94UHJlZml4UGx1Z2lucyB9KQogICAgYnl0ZWMgNDcgLy8gInAiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzIKICAgIC8vIGFzc2VydCh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUHJvcG9zYWwgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzMKICAgIC8vIHJldHVybiB0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAzNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzUKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlRXhlY3V0ZVBsdWdpbjogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc1LTQ3NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDM3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NAogICAgLy8gcmVtb3ZlRXhlY3V0ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNDkgLy8gInJlbW92ZV9leGVjdXRlX3BsdWdpbl9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzYKICAgIC8vIHJldHVybiB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZVBsdWdpbjoKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA4IC8vIDB4MjgKICAgID09CiAgICBibnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA0MCAvLyAweDI5CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ3OS00ODEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIC8vICAgcmV0dXJuIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MAoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgwCiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyByZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTcgLy8gInJlbW92ZV9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgwCiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZEFsbG93YW5jZXM6IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4Mi00ODQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgLy8gICByZXR1cm4gdGhpcy5hZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gcmV0dXJuIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzYKICAgIC8vIGFkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE4IC8vICJhZGRfYWxsb3dhbmNlX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gcmV0dXJuIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODUKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlQWxsb3dhbmNlczogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQyIC8vIDB4M2MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg1LTQ4NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVBbGxvd2FuY2VzOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNzgKICAgIC8vIGNvbnN0IHNldHRpbmdzID0gdGhpcy5nZXRQcm9wb3NhbFNldHRpbmdzKHR5cGUsIGRhdGEpCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRQcm9wb3NhbFNldHRpbmdzQDUxCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4OAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVOZXdFc2Nyb3c6IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA0MyAvLyAweDQ2CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4OC00OTAKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICAvLyAgIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIC8vIH0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAKICAgIC8vIG5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAyMCAvLyAibmV3X2VzY3Jvd19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0NjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OTEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQ0IC8vIDB4NDcKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDkxLTQ5MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVUb2dnbGVFc2Nyb3dMb2NrOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2tQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDkyCiAgICAvLyByZXR1cm4gdGhpcy50b2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNUb2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNTAgLy8gInRvZ2dsZV9lc2Nyb3dfbG9ja19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OTIKICAgIC8vIHJldHVybiB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2tQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk0CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZGF0ZUZpZWxkczogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQ1IC8vIDB4NTAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk0LTQ5NgogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIC8vICAgcmV0dXJuIHRoaXMudXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyByZXR1cm4gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyB1cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMjEgLy8gInVwZGF0ZV9maWVsZHNfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyByZXR1cm4gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX3doaWxlQDE5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMDkKICAgIC8vIGlmIChpbmZvLnRvdGFsIDwgbWJyKSB7CiAgICBmcmFtZV9kaWcgNwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgMTEKICAgIDwKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUAyMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTAKICAgIC8vIGluZm8udG90YWwgPSBtYnIKICAgIGZyYW1lX2RpZyAxMQogICAgaXRvYgogICAgZnJhbWVfZGlnIDcKICAgIGRpZyAxCiAgICByZXBsYWNlMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxMQogICAgLy8gaW5mby5tYnIgPSBtYnIKICAgIHN3YXAKICAgIHJlcGxhY2UyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzEyCiAgICAvLyBpbmZvLmZlZSA9IDAKICAgIGZyYW1lX2RpZyA1CiAgICByZXBsYWNlMiAxNgogICAgZnJhbWVfYnVyeSA3CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTgKICAgIC8vIGlmICh0aGlzLnN0YXRlLnZhbHVlICE9PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQgJiYgVHhuLnNlbmRlciA9PT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzE4CiAgICAvLyBpZiAodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgPT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDM0IC8vIDB4MDIKICAgICE9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1CiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxOQogICAgLy8gaW5mby50b3RhbCA9IDAKICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9kaWcgNQogICAgZHVwCiAgICBjb3ZlciAyCiAgICByZXBsYWNlMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyMAogICAgLy8gaW5mby5mZWUgPSAwCiAgICBzd2FwCiAgICByZXBsYWNlMiAxNgogICAgZnJhbWVfYnVyeSA3CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMjMKICAgIC8vIHJldHVybiBpbmZvCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9lbHNlX2JvZHlAMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxNAogICAgLy8gaW5mby5tYnIgPSBtYnIKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgNwogICAgc3dhcAogICAgcmVwbGFjZTIgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTUKICAgIC8vIGluZm8uZmVlID0gaW5mby50b3RhbCAtIG1icgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgLQogICAgaXRvYgogICAgcmVwbGFjZTIgMTYKICAgIGZyYW1lX2J1cnkgNwogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDIyCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAHAAEIAqCNBugHwIQ9JjYBAAQVH3x1BndhbGxldAABbANvYWwBFAtpbml0aWFsaXplZAEoA2FhbAEeDGFraXRhX2Fzc2V0cwEyFXByb3Bvc2FsX2FjdGlvbl9saW1pdANwYWwOdXBncmFkZV9hcHBfcHMNYWRkX3BsdWdpbl9wcxByZW1vdmVfcGx1Z2luX3BzEGFkZF9hbGxvd2FuY2VfcHMTcmVtb3ZlX2FsbG93YW5jZV9wcw1uZXdfZXNjcm93X3BzEHVwZGF0ZV9maWVsZHNfcHMBCgd2ZXJzaW9uDmNvbnRlbnRfcG9saWN5Em1pbl9yZXdhcmRzX2ltcGFjdANzYWwLd2FsbGV0X2ZlZXMLc29jaWFsX2ZlZXMMc3Rha2luZ19mZWVzEXN1YnNjcmlwdGlvbl9mZWVzCG5mdF9mZWVzCXN3YXBfZmVlcw5yZXZlbnVlX3NwbGl0cwECARUEAAEAAgtwcm9wb3NhbF9pZAIAKgEfASkCAAABPAFGAUcBUAIACgFwEAAAAAAAAAFtAAAAAAAPQkAYcmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3BzFXRvZ2dsZV9lc2Nyb3dfbG9ja19wcwSJp2gmGAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAdzEYQAAIJwcoZycXK2eABOqRgN02GgCOAQCYMRkURDEYQQCBghEE2brNlARTxHVmBP0sk80EAZj+OwT+IMJpBMs35mgEKPgBVAQQOmuIBKSyNrYEYs6bWwTx/yiqBEkBO18ElpnRCASMVkdoBB+fiyAE277G0gSFTe3gNhoAjhEEAQR0BIIEjwneCjkKsQr8CyEMhw29EvUTdxOBE44TqQABACNDgAQOsLl7NhoAjgEBNgAxGYEEEjEYEERCA7eKAgGL/yJbSUEAIYv/JFtBABojRIv+IluL/iRbHYv/JFuLAB0fRgJMFERMiSJC/+OKAgErsYAEPBpvM7Iai/+yGov+shiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJESSJZJQhMFRJEVwYASRVJQQAHiwIkE0EAKCKMAIsAQAAFMQCMAImLAIASY29udHJvbGxlZF9hZGRyZXNzZUiMAImLAReMAEL/04oDASuxi/8WJwhQgATJBogJshqL/rIashqL/bIYgQayECKyAbO0PkcCVwQASwFXAAQpEkQVgRgSRIEUW0kyBw5BAAQijACJiwIyBwlJjACBgPUkDEEABCKMAImLAIGAowUKiwFXBAghBhZQSScwiP7rSwIdSE4CJzCI/uAdRQEhBpeMAIk2GgFJIlklCEsBFRJEVwIANhoCSRUkEkQXNhoDSRWBJBJENhoESRUkEkQXNhoFSRWByAESRDYaBkkVgYgCEkQ2GgdJFYHoAhJENhoIIicXZUQrEkRLBysTRCcXTwhnJw2BBWdPBhYiFlAnC0xnJxhPBmcnGU8FZ0sDVwAISwRXCAhLBVcQCEsGVxgISwdXIAhLCFcoCEsJVzAISwpXOAhLC1dACEsMV0gISw1XUAhLDlewCE8LTwtQTwpQTwlQTwhQTwdQTwZQTwVQTwRQTwNQTwJQTFAnCUxnSwNXeAhLBFeACEsFV4gISwZXkAhPA08DUE8CUExQJxpMZ0sDV2AISwRXaAhLBVdwCE4CUExQJw5MZ0sDV5gISwRXoAhLBVeoCEsGV7gISwdXWAhPCFfACE8FTwVQTwRQTwNQTFBMUCcFTGdLAlcACEsDVwgIUCcbTGdLAlcQCEsDVxgISwRXIAhLBVcoCE8DTwNQTwJQTFAnHExnSwJXMAhLA1c4CEsEV0AITgJQTFAnHUxnSwJXSAhLA1dQCEsEV1gITgJQTFAnHkxnSwJXYAhLA1doCEsEV3AISwVXeAhLBleACEsHV4gISwhXkAhLCVeYCEsKV6AISwtXqAhLDFewCEsNV7gISw5XwAhLD1fICEsQV9AITw5PDlBPDVBPDFBPC1BPClBPCVBPCFBPB1BPBlBPBVBPBFBPA1BPAlBMUCcfTGdLAlfYCE8DV+AIUCcgTGdLAVcAKCcPTGdLAVcoKCcQTGdLAVdQKCcxTGdLAVd4KCcRTGdLAVegKCcSTGdLAVfIKCcTTGdLAVfwKCcUTGdLAYMCmAIoWCcyTGdMgwLAAihYJxVMZychTGcnJSNnI0M2GgFJIlklCEsBFRJEVwIAMQAiKmVEcghEEkQiJw5lRIEQWzINEkQnF0xnI0M2GgFJIlklCEsBFRJEIiplRQEURCInCWVEgVhbiBXcsUsBcghEsgeyCCOyECKyAbYyAzIKMgOABDdIVUGyGk8CshpMshpPArIashqyGIEGshAisgGztwE+SVcEAExXAAQpEkRJFSQSREkXKkxnKUxQsCNDMQAyCRJEJweAAQFnI0MxADIJEkQnByciZyNDIkcPK0cDMRYjCUk4ECMSRDYaAUkVgSQSRDYaAiInBWVEgRhbMQCI+5VMiBVvTEkiW0yBGFsiSScHZUQnIhJAABQiJwdlRIABARJBAAwxADIJE0EABEsCRQFLBkk4BzIKEkw4CEsCEhBESwMiWUlFDEQiRQlLCEsLDEEEvUsDVwIASwlJTgIlC0sBTFlPAiMISUUMSw1LAQlLAxVPAiULSwRMWU8CTVJJRRVXAAFJRRknFhJBACxLE0kjWUsBFVJJVwIASYEoWUsBFVJLAYEsW08CgTRbTwIiWURLAUQMREL/kksXJwYSQQCHSxNJI1lLARVSSVcCAEsBVwIIRRlLAVcKIEUSSYEoWUsBgTtZSwJOAlJXAgBFEUsBV0AIRRhLAVdICEUXSwFXUAhFHUsBV1gIRRxMV2AIRRqB6QNTQQASSxVLFVBLG1BLGlBLGVCIECdISxZLEFBLD0kVFlcGAkxQTCcmUExQiA3KSBREQv8DSxcnIxJBACBLE0kjWUsBFVJXAgBJIllLAYEqWVJXAgCIDe0UREL+20sXJwoSQQCfSxNJI1lLARVSSVcCAExXAghLASRZSwKBKllPA04CUlcCADIDTwJMUEsBFRZXBgJPAlBMJyZQTFCxIiplRCckTwJQJzOyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJETFcGAEwiWUsBIllMIwlLAhVLAyVZTwJNUklFFEmBJFtESYHaAVNEgdkBU0EADDIGSxOBCVsMREL+OTIHQv/xSxcnJxJBAGdLE0kjWUsBFVJXAiCxIiplREsBFYEgEkSAAgABTwJQgARBvcaAshqyGrIYgQayECKyAbO0PklXBABLAVcABCkSRExXBgBMIllLASJZTCMJSwIVSwMlWU8CTVJJIllLARVSIllEQv3FSxcnCBJBAEJLE0kjWUsBFVJJVwIASwFXAghPAlcKIEsCgShZSwMVTwROAlJXAgBOAlBLARUWVwYCTwJQTCcmUExQiAxBSERC/XtLFycoEkEAH0sTSSNZSwEVUlcCAEkiWUsBgSpZUlcCAIgMZURC/VRLFycMEkEAeEsTSSNZSwEVUlcCAEkiWUsBJVlLAk8CSwJSVwIARRBLARVSSUUTIllJRQtEJylFESJFCEsHSwoMQQAtSxFXAgBLCElOAoEiC4EiWFcACEsSSU8CUEwiWSMIFlcGAlwARRIjCEUIQv/LSw1LEYgMjUiBEFMUREL81EsXJyoSQQA2SxNJI1lLARVSVwIASSJZSwElWUsCTwJLAlJXAgBLAhVPA08DTwJSSSJZRIgMTkiBEVNEQvyWSxcnKxJBAB5LE0kjWUsBFVJXAgBJIllLARVSVwIAiAvZFERC/HBLFycsEkEAHUsTSSNZSwEVUlcCAEkiWUsBFVJXAgCIC7NEQvxLSxcnLRJESxNJI1lLARVSVwIASSJZSwElWUsCTwJLAlJXAgBJTgNFEEsBFVJXAgBFDScYEkEACksLFYEkEkRC/ApLDCcNEkEADEsLSRUkEkQXREL79ksMJxkSQQARSwtJFSQSRBdJRCEFDkRC+91LDCcJEkD71UsMJxoSQPvNSwwnDhJA+8VLDCcFEkD7vUsMJxsSQPu1SwwnHBJA+61LDCcdEkD7pUsMJx4SQPudSwwnHxJA+5VLDCcgEkD7jUsMJwsSQPuFSwwnDxJAADBLDCcQEkAAKEsMJxESQAAgSwwnEhJAABhLDCcTEkAAEEsMJxQSQAAISwwnFRJBAAlLC4gMTUhC+0RLDCchEkRC+zsiSwZLBUsHSwRLBogMvEgWKUxQsCNDNhoBSRUkEkQXNhoCSRWBJBJENhoDSwKIC9NESwIWJwRMUIFtJLoXIicFZUSBGFsxAIj2O08CiBAUSwEiW08CgRhbSwFPBQ5ETwVPBU8ETwVPBU8FiAxdRgIjQzEWIwlJOBAjEkQ2GgFJFSQSRBc2GgJJFYEkEkQ2GgNLAogLbkRLAhYnBExQgW0kuhciJwVlRIEYWzEAiPXWTwKID69LASJbTwKBGFtLAUsFDURLBzgHMgoSTwg4CEsDTwcJEhBETwVPBU8ETwVPBU8FiAvlRgIjQzYaAUkVJBJEFxYnBExQRwK9RQFESSIjuklPAoMCPSC6TCgSQAAISwEnDBJBABkjRCInBWVEgRhbMQCI9V9LARJESwK8SCNDIkL/5DYaAUkVJBJEF0mICsJEMgdMFicETFBMFksBgV1PArsiJwa7I0MiK0kxFiMJSTgQIxJENhoBSRUkEkQXNhoCSU4DFSMSRCInB2VEKBNEFicESwFQSU4DSb1FAURLAjgHMgoSTwM4CIHkrwESEERJIiO6JwYSRCInBWVEgRhbMQCI9M9JTgNMvkxOA0RQgAF2TFBJvUUBQQAySb5ESVcAAUlOAkUKI1tFBycWEkEAkEsDgwIlGLoiW0sGCRZLAklOAlclGExcAFwlRQJLAogKb0lFCERLBCcWEkEAKEsBSVclGEkiW0sJCBZcAFwlRQJLA0m8SEsCv0sGFksFTFBLAUy/I0NLBCcGEkEAFksBSVclGEkkW0sJCBZcCFwlRQJC/81LBCcKEkRLAUlXJRhJgRBbSwkIFlwQXCVFAkL/sEsHJwYSQQAfSwODAiUYuiRbSwYJFksCSU4CVyUYTFwIXCVFAkL/ZUsHJwoSREsDgwIlGLqBEFtLBgkWSwJJTgJXJRhMXBBcJUUCQv8/K0k2GgFJFSQSRBcWJwRMUEcCvUUBREm+SExJIiO6SwGDAj0guksCgwIlGLpJIltOBUkkW04FgRBbTgRPAoFdJLoXTgNLAoF1WUsDFU8ETgJSTgIxABJEJwYSRCInC2VESSJbSU8CJFtMIkxBAFxLAUEAV7EiJwllRCJbSwMWgAIAAkxQSwMWUIAEa+jO5rIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBEAslCEwVEkRJVwYQIltMVxYQIltKDExOAk1FAUsHSwdJTgIISUUNSwcIRQtBAFRLB0lLDElOAwxEIQQdTwKXSwSIDLJISYEgW0sBgShbTwKBMFsyB0sJTwQIDURLASEEDkRLA08CHSEEl0sMDkQPQQAISwgiJwi7I0NLCCInCrtC//UhBEL/tyJHBCs2GgFJFSQSRBcWSScETFBHAr1FAURJvkhMIiO6JwgSRCJLAoF3JboXSwENQQT+SwJJgXcluhdLAklPAgxEJUsBC4F5CEsCTCW6F4F5CE8CTCO6SU4CRQlLA0mBdVlLARVSSVcCAEwiWUsCJQtLAkxZTwMjCElFCk8CSwEJSwMVTwIlC0sETFlPAk1SSSNZSwEVUklFDFcCAEUKJxYSQQA0SwlJVwogSwpJgShZSwEVUksCgSxbTwOBNFtLBBZLCExQgAF4SwVQTL+ICytISwRFAUL/TUsGJwoSQQAhSwlJVwwgSwpJgSpZSwEVUksCgS5bTwOBNluICv5IQv/QSwYnJxJBAChLCVcCILEiKmVESwEVgSASRIAE1YaFr7IashiyGoEGshAisgGzQv+gSwYnBhJBARRLCUlXAghLAVcKIEsLSYEoWUsBgTtZSwJPAksCUlcCAEsFVywBSwZXLQhLB1c1CEsFgWZZSwZPBksCUksGgegDU0sHgekDU0sIgeoDU0sJgesDU0sNV0AISw5XSAhLD1dQCEsQV1gITxFXYAhLD4FoWUsQTwxLAlJXAgBLEBVPEU8DTwJSgAIAbE8SUE8RUEsQFRZXBgJPEVBMgAIAblBLARWBbghMTxFQTxBQTw9QSwEWVwYCUEsOFU8CCCgiTw9UI08OVCVPDVSBA08MVE8CTFBPCVBPCFBPB1BPBlBPBVBLBBUWVwYCTwVQSwIWVwYCTwJMUEsBFU8DCBZXBgJQJylQTwJQTwNQTFBMUIgHQUhC/oRLBicjEkEACUsIiAcwSEL+c0sGJwgSQQA8SwlJVwIITFcKIEsKSYEoWUsBFVJXAgCAAgAsTwNQTwJQSwEVFlcGAk8CUEyABAAuAABQTFCICLpIQv4vSwYnKBJBAAlLCIgIqUhC/h5LBicMEkEAJEsISSJZSwElWUsCTwJLAlJXAgBLAhVPA08DTwJSiAhQSEL98ksGJyoSQQA6SwhJIllLASVZSwJPAksCUksCFU8DTwNPAlKxIiplRIAE1d04K7IaTwKyGrIYshqBBrIQIrIBs0L9sEsGJysSQQA2SwhJIllLARVSsSIqZUSABAqMssKyGrIYshqBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJEQv1ySwYnLBJBADdLCEkiWUsBFVKxIiplRIAEJbcTyrIashiyGoEGshAisgGztD5JVwQATFcABCkSRBWBCRJEQv0zSwYnLRJB/StLCEkiWUsBJVlLAk8CSwJSVwIASU4DRQtLARVSVwIARQcnGBJBAA9LBUkVgSQSRCcYTGdC/PVLBycNEkEACksFFycNTGdC/ONLBycZEkEACksFFycZTGdC/NFLBycJEkEACCcJSwZnQvzBSwcnGhJBAAgnGksGZ0L8sUsHJw4SQQAIJw5LBmdC/KFLBycFEkEACCcFSwZnQvyRSwcnGxJBAAgnG0sGZ0L8gUsHJxwSQQAIJxxLBmdC/HFLBycdEkEACCcdSwZnQvxhSwcnHhJBAAgnHksGZ0L8UUsHJx8SQQAIJx9LBmdC/EFLBycgEkEACCcgSwZnQvwxSwcnCxJBAAgnC0sGZ0L8IUsHJw8SQQAIJw9LBmdC/BFLBycQEkEACCcQSwZnQvwBSwcnERJBAAgnEUsGZ0L78UsHJxISQQAIJxJLBmdC++FLBycTEkEACCcTSwZnQvvRSwcnFBJBAAgnFEsGZ0L7wUsHJxUSQQAIJxVLBmdC+7FLBychEkH7qSchSwZnQvuhSwIiJwy7I0MrNhoBSRUkEkQXNhoCSU4CSSJZSU4DgSALJQhMFRJEFkknBExQRwK9RQFEIiO6JwgSQAAUSSIjuicKEkAACkkiI7onDBJBADYjRCJFBUsESwMMQQAnSwNXAgBLBUlOAoEgC4EgWEsDTFCAAXZMUEm9RQFEvEgjCEUFQv/RI0MiQv/HiAaCFilMULAjQzYaAYgGpEgpTFCwI0M2GgFJFSQSRBcWJwRMUEm9RQFEvkgpTFCwI0M2GgFJFYEgEkSAAXhMUEm9RQFEvkgpTFCwI0OKAQKxIiplRCcki/9QJzOyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJETFcGAEwiWUsBIllMIwlLAhVLAyVZTwJNUoEkWyITi/+JigEBsSIqZUSL/xUWVwYCi/9QJyRMUIAEBXlNfbIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRMVwYATCJZSwEiWUwjCUsCFUsDJVlPAk1SgSRbIhOJigEBsSIqZUSL/xUWVwYCi/9QJyRMUIAEokA937IashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBCQslCEwVEkRXBgkiWyITiYoCAiJJK0cGsSIqZUSL/hUWVwYCi/5QgAQC/kUVshqyGov/shqyGIEGshAisgGztD5HAlcEAExXAAQpEkRJIllJTgKBMgslCEwVEkQnKSIjIosOiwoMQQCmiwlXBgCLDoEyC4EyWIEpW0mMBiITKCJPAlSMAIsLSSJZSYwCSSMISRZXBgBPA0xcAIwBTIEHCCQKSYwDTIEHCCQKSYwFDEEADIsFiwMJr4sBTFCMASKMBIsCgRAISYwIIwiMB4sIiwcMQQAfiwCLBElOAlOLAYsISU4DTwJUjAEjCIwIJAiMBEL/2YsGQQAQI4wMiw4jCIwOiwGMC0L/WCKMDUL/7SgiiwxUI4sNVIACAANMUIsLUIv/jAGMAImKAQGL/xYnBExQSb1FAURJIiO6TIMCPSC6TCInBWVEgRhbMQCI6ltMKBJBAAyLAYsAEkEABCOMAIkiQv/5igEBi/+BIFtJIQUNRCEEDkSL/4EYW0khBQ1EIQQORIv/gRBbRIv/JFtJRCEFDkSL/4mKAQErSSInCWVEIlsiJwtlREkiW0lPAiRbTEEABYsEQAAEIowAiYsCSYv/iwOI6ldJTgKMAIv/iwSI6kuMAUEABYsBQAAEIowAiYsAiwEMQQABiYsBjACJigYCi/wiWUlEIicNZUQORCInB2VEJyITQQBDMQAyCRJEiAM5jPoxADIHIhaL+xWBJBJEJwiL+1AnNFBPA1BMUEwWUIv+FlAnNVCL/FCL+hYnBExQSbxITL+L+ov8iYv9iP9Fi/8PRIv6QAA6iALujPoyByIWi/sVgSQSRCiL+1AnNFCL/VBMUEwWUIv+FlAnNVCL/FCL+hYnBExQSbxITL+L+ov8iYv6FicETFCBZSS6F0L/vooBAYv/IlmL/4EqWYv/TwJLAlJJTgJXAgBMi/9XAghMi/9XCiBMi/+BPVmL/08CSwJSSU4CVwIATIv/VywBTIv/Vy0ITIv/VzUITIv/gWhZi/9OAlKL/4H4A1OL/4H5A1NJi/+B+gNTTIv/gfsDU0yL/1dACEyL/1dICEyL/1dQCEyL/1dYCEyL/1dgCEyL/4FqWYv/FYv/TgJSTEEAJYsOiw9QixBQixFQixJQiwVJFRZXBgJMUIsCJy5QTFAnL0xQTL+LASsTQQB5sSIqZUQoIosKVCgiiwtUKCKLDFQoIosNVIAEVy/R6rIaiwCyGosCshqLA7IaiwSyGiiyGosGshqLB7IaiwiyGosJshpPA7IaTwKyGkyyGiiyGrIashiBBrIQIrIBs4sFKxNBAA+LEyJZQQAIiwWLE4gAY0iL/4wAibEiKmVEKCKLClQoIosLVCgiiwxUKCKLDVSABFZyRi6yGosCshqLA7IaiwSyGiiyGosGshqLB7IaiwiyGosJshpPA7IaTwKyGkyyGiiyGrIashiBBrIQIrIBs0L/iIoCAbEiKmVEi/4VFlcGAov+UIAEv018V7IashqL/7IashiBBrIQIrIBs4v/iYoBAYv/IlmL/4EqWYv/TwJLAlJJTgJXAgBMi/9XAghJTwKL/1cKIE4Ci/8Vi/9OAlJJTgJXAgBJFRZXBgJMUEwnLlBMUCcvTFBJvUUBQQAEiwW8SIsBKxNBACCxIiplRIAE41C51LIaiwCyGrIYgQayECKyAbOL/4wAibEiKmVEgATu9Ej9shqLArIaiwOyGosEshqyGIEGshAisgGzi/+MAImKBAGxIiplRIv8FYEgEkSL/haL/xaABFzr7UOyGov8shqL/bIaTLIashqyGIEGshAisgGzi/2JIiclZURJIwgnJUxniSInCWVEgVhbsYAEm2f6bbIashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSQSRBeJigECIkcJK0cCIkmL/yJZSYwKiw4NQQBBi/9XAgCLDklOAiULSwFMWU8CIwhJjA6LCksBCUsDFU8CJQtLBExZTwJNUkkjWUsBFVJXAgAVgQcIiw0IjA1C/7KBkAOLDQuB5KkDCIwLIhaMBYA4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACMByKMDosOiwoMQQHli/9XAgCLDklOAiULSwFMWU8CIwhJjA6LCksBCUsDFU8CJQtLBExZTwJNUklXAAFJTgKMCUkjWUsBFVJJjARXAgCMBicWEkmMDEEAwiInD2VEjAiLB0kiW4sIIltMSwEIFk8CTFwASYEQW08CCBZcEIwHiwxBAHuLC4HUrAEIjAuLCElXCAiMAyRbiweBGFsNQQAIiweLA1wYjAeLCElXEAiMAIEQW4sHgSBbDUEACIsHiwBcIIwHiwhJVxgIjAGBGFuLB4EoWw1BAAiLB4sBXCiMB4sISVcgCIwCgSBbiweBMFsNQf8RiweLAlwwjAdC/waLCScGEkAACIsJJyMSQf9+iwuBlIQCCIwLQv9yiwknBhJAAAiLCScjEkEACiInEGVEjAhC/yuLCScKEkEAMYsEVwIIiwZJJFlLAYEqWVJXAgBJFRZXBgJMUEwnLlBMUCcvTFBJvUUBRL5IjAhC/vKLCScnEkEACiInMWVEjAhC/uCLCScIEkAACIsJJygSQQAKIicRZUSMCEL+xosJJwwSQQAKIicSZUSMCEL+tIsJJyoSQQAKIicTZUSMCEL+oosJJysSQQAKIicUZUSMCEL+kIsJJywSQQAKIicyZUSMCEL+fosJJy0SRCInFWVEjAhC/m6LByJbiwsMQQA8iwsWiwdLAVwATFwIiwVcEIwHIicHZUQnIhNBABYxADIJEkEADosHiwVJTgJcAExcEIwHiweL/4wBjACJiwtJFosHTFwISSJbTwIJFlwQjAdC/78=\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[2967],\"errorMessage\":\"Action limit must be greater than zero\"},{\"pc\":[1933,6440],\"errorMessage\":\"Action list cannot be empty\"},{\"pc\":[2672,2799],\"errorMessage\":\"Allowance list cannot be empty\"},{\"pc\":[1063],\"errorMessage\":\"Already initialized\"},{\"pc\":[3604,3620],\"errorMessage\":\"Box must have value\"},{\"pc\":[773,898,1777,2333,2469,4030,5026,5088,5756,5845,5932,6013,7345],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[3542],\"errorMessage\":\"DAO is not initialized\"},{\"pc\":[2845],\"errorMessage\":\"Escrow already exists\"},{\"pc\":[2882],\"errorMessage\":\"Escrow does not exist\"},{\"pc\":[2504,5705],\"errorMessage\":\"Execution key not found\"},{\"pc\":[2041],\"errorMessage\":\"First valid round must be greater than zero\"},{\"pc\":[3455,3947],\"errorMessage\":\"Incorrect sender\"},{\"pc\":[6534],\"errorMessage\":\"Insufficient proposal threshold\"},{\"pc\":[2947],\"errorMessage\":\"Invalid CID\"},{\"pc\":[6330],\"errorMessage\":\"Invalid duration\"},{\"pc\":[6310],\"errorMessage\":\"Invalid maximum approval percentage\"},{\"pc\":[6324],\"errorMessage\":\"Invalid maximum participation percentage\"},{\"pc\":[6340],\"errorMessage\":\"Invalid maximum power\"},{\"pc\":[6306],\"errorMessage\":\"Invalid minimum approval percentage\"},{\"pc\":[6336],\"errorMessage\":\"Invalid minimum power\"},{\"pc\":[2985],\"errorMessage\":\"Invalid minimum rewards impact\"},{\"pc\":[1925,3371,3574],\"errorMessage\":\"Invalid payment\"},{\"pc\":[702,724,4132],\"errorMessage\":\"Invalid percentage\"},{\"pc\":[4091],\"errorMessage\":\"Invalid percentage of args\"},{\"pc\":[2891,3760,3828,8004],\"errorMessage\":\"Invalid proposal action\"},{\"pc\":[2965],\"errorMessage\":\"Invalid proposal action limit\"},{\"pc\":[1672],\"errorMessage\":\"Invalid upgrade\"},{\"pc\":[2043],\"errorMessage\":\"Last valid round must be greater than first valid\"},{\"pc\":[5151],\"errorMessage\":\"Length must be 36\"},{\"pc\":[2988],\"errorMessage\":\"Minimum rewards impact must be greater than zero\"},{\"pc\":[2992],\"errorMessage\":\"Minimum rewards impact must be less than or equal to 1000\"},{\"pc\":[522],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[679],\"errorMessage\":\"OnCompletion must be UpdateApplication \\u0026\\u0026 can only call when not creating\"},{\"pc\":[1660],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[3352],\"errorMessage\":\"Payment not required\"},{\"pc\":[3251],\"errorMessage\":\"Payment required\"},{\"pc\":[2186,2226],\"errorMessage\":\"Plugin already exists\"},{\"pc\":[2366,2578,2617],\"errorMessage\":\"Plugin does not exist\"},{\"pc\":[2388],\"errorMessage\":\"Plugin has expired\"},{\"pc\":[2372],\"errorMessage\":\"Plugin is not executable\"},{\"pc\":[5621],\"errorMessage\":\"Proposal Vote not found\"},{\"pc\":[3410,3556,3882,4195,5549,5678,6247,7875],\"errorMessage\":\"Proposal does not exist\"},{\"pc\":[6447],\"errorMessage\":\"Too many actions in the proposal\"},{\"pc\":[3154],\"errorMessage\":\"Unknown field in update fields proposal action\"},{\"pc\":[2038],\"errorMessage\":\"Upgrade app action must have at least one group\"},{\"pc\":[1068],\"errorMessage\":\"Version cannot be empty\"},{\"pc\":[4126],\"errorMessage\":\"Voting duration not met\"},{\"pc\":[4144],\"errorMessage\":\"Voting participation not met\"},{\"pc\":[1698],\"errorMessage\":\"Wallet already setup\"},{\"pc\":[2745],\"errorMessage\":\"allowance already exists\"},{\"pc\":[2807],\"errorMessage\":\"allowance does not exist\"},{\"pc\":[1658,1715],\"errorMessage\":\"application exists\"},{\"pc\":[1060,1655,1665,1703,1853,1879,1890,2298,2421,3223,3324,3443,3539,3587,3956,3981,4445,4944,4993,5055,5721,5797,5884,5965,6263,6353,6360,6445,6452,6820,6941,7037,7170,7202,7245,7297,7310,7610,7820,7895,7921,7939,7957,7975,7993,8009,8047],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[1972,2347,2483,2703,4298,5607,5770,5859,6054,7404,7557],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[4235],\"errorMessage\":\"index out of bounds\"},{\"pc\":[1052],\"errorMessage\":\"invalid number of bytes for ((uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64))\"},{\"pc\":[5944],\"errorMessage\":\"invalid number of bytes for (len+(uint64,bool1)[])\"},{\"pc\":[4042],\"errorMessage\":\"invalid number of bytes for (len+(uint64,uint64)[])\"},{\"pc\":[6028],\"errorMessage\":\"invalid number of bytes for (len+(uint8,uint64,uint64,uint64,uint64,uint64,uint64,bool1)[])\"},{\"pc\":[5537],\"errorMessage\":\"invalid number of bytes for (len+uint8[32][])\"},{\"pc\":[782],\"errorMessage\":\"invalid number of bytes for (len+uint8[])\"},{\"pc\":[992,1646,1691],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[5093],\"errorMessage\":\"invalid number of bytes for (uint64,bool1)\"},{\"pc\":[903],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64)\"},{\"pc\":[1032],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\"},{\"pc\":[1042],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\"},{\"pc\":[1003,1021,1782,3187,3288,3398,3473,3523,3870,4182,5030,5515,5667,7350],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[3534],\"errorMessage\":\"invalid number of bytes for uint8\"},{\"pc\":[5695],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[1013,1845,3197,3298],\"errorMessage\":\"invalid number of bytes for uint8[36]\"},{\"pc\":[3206,3307,3438,3479,3582,3951,4206,5580],\"errorMessage\":\"invalid proposal state\"},{\"pc\":[2428,4452,6482,6555,7252],\"errorMessage\":\"invalid size\"},{\"pc\":[2712],\"errorMessage\":\"max array length exceeded\"},{\"pc\":[1799,1813,3676,6464],\"errorMessage\":\"only the creator wallet can delete a spending account\"},{\"pc\":[1836,3280,3515],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"AkitaDAO\",\"structs\":{\"AkitaAppList\":[{\"name\":\"staking\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"pool\",\"type\":\"uint64\"},{\"name\":\"prizeBox\",\"type\":\"uint64\"},{\"name\":\"subscriptions\",\"type\":\"uint64\"},{\"name\":\"gate\",\"type\":\"uint64\"},{\"name\":\"auction\",\"type\":\"uint64\"},{\"name\":\"hyperSwap\",\"type\":\"uint64\"},{\"name\":\"raffle\",\"type\":\"uint64\"},{\"name\":\"metaMerkles\",\"type\":\"uint64\"},{\"name\":\"marketplace\",\"type\":\"uint64\"},{\"name\":\"wallet\",\"type\":\"uint64\"}],\"AkitaAssets\":[{\"name\":\"akta\",\"type\":\"uint64\"},{\"name\":\"bones\",\"type\":\"uint64\"}],\"AkitaDAOApps\":[{\"name\":\"staking\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"pool\",\"type\":\"uint64\"},{\"name\":\"prizeBox\",\"type\":\"uint64\"},{\"name\":\"subscriptions\",\"type\":\"uint64\"},{\"name\":\"gate\",\"type\":\"uint64\"},{\"name\":\"auction\",\"type\":\"uint64\"},{\"name\":\"hyperSwap\",\"type\":\"uint64\"},{\"name\":\"raffle\",\"type\":\"uint64\"},{\"name\":\"metaMerkles\",\"type\":\"uint64\"},{\"name\":\"marketplace\",\"type\":\"uint64\"},{\"name\":\"akitaNfd\",\"type\":\"uint64\"},{\"name\":\"optin\",\"type\":\"uint64\"},{\"name\":\"revenueManager\",\"type\":\"uint64\"},{\"name\":\"update\",\"type\":\"uint64\"},{\"name\":\"social\",\"type\":\"uint64\"},{\"name\":\"graph\",\"type\":\"uint64\"},{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"moderation\",\"type\":\"uint64\"},{\"name\":\"vrfBeacon\",\"type\":\"uint64\"},{\"name\":\"nfdRegistry\",\"type\":\"uint64\"},{\"name\":\"assetInbox\",\"type\":\"uint64\"},{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"poll\",\"type\":\"uint64\"}],\"AkitaDAOFees\":[{\"name\":\"walletCreateFee\",\"type\":\"uint64\"},{\"name\":\"walletReferrerPercentage\",\"type\":\"uint64\"},{\"name\":\"postFee\",\"type\":\"uint64\"},{\"name\":\"reactFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"},{\"name\":\"poolCreationFee\",\"type\":\"uint64\"},{\"name\":\"poolImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"poolImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"subscriptionServiceCreationFee\",\"type\":\"uint64\"},{\"name\":\"subscriptionPaymentPercentage\",\"type\":\"uint64\"},{\"name\":\"subscriptionTriggerPercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMin\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMax\",\"type\":\"uint64\"},{\"name\":\"marketplaceComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceRoyaltyDefaultPercentage\",\"type\":\"uint64\"},{\"name\":\"shuffleSalePercentage\",\"type\":\"uint64\"},{\"name\":\"omnigemSaleFee\",\"type\":\"uint64\"},{\"name\":\"auctionCreationFee\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"auctionComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"auctionRafflePercentage\",\"type\":\"uint64\"},{\"name\":\"raffleCreationFee\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"raffleComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"swapFeeImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"swapFeeImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"swapComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"swapLiquidityPercentage\",\"type\":\"uint64\"},{\"name\":\"krbyPercentage\",\"type\":\"uint64\"},{\"name\":\"moderatorPercentage\",\"type\":\"uint64\"}],\"AkitaSocialAppList\":[{\"name\":\"social\",\"type\":\"uint64\"},{\"name\":\"graph\",\"type\":\"uint64\"},{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"moderation\",\"type\":\"uint64\"}],\"DAOPluginKey\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ExecutionMetadata\":[{\"name\":\"proposalID\",\"type\":\"uint64\"},{\"name\":\"index\",\"type\":\"uint64\"}],\"NFTFees\":[{\"name\":\"marketplaceSalePercentageMin\",\"type\":\"uint64\"},{\"name\":\"marketplaceSalePercentageMax\",\"type\":\"uint64\"},{\"name\":\"marketplaceComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"marketplaceRoyaltyDefaultPercentage\",\"type\":\"uint64\"},{\"name\":\"shuffleSalePercentage\",\"type\":\"uint64\"},{\"name\":\"omnigemSaleFee\",\"type\":\"uint64\"},{\"name\":\"auctionCreationFee\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"auctionSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"auctionComposablePercentage\",\"type\":\"uint64\"},{\"name\":\"auctionRafflePercentage\",\"type\":\"uint64\"},{\"name\":\"raffleCreationFee\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMin\",\"type\":\"uint64\"},{\"name\":\"raffleSaleImpactTaxMax\",\"type\":\"uint64\"},{\"name\":\"raffleComposablePercentage\",\"type\":\"uint64\"}],\"Object752A5B25\":[{\"name\":\"upgradeApp\",\"type\":\"ProposalSettings\"},{\"name\":\"addPlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"removeExecutePlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"removePlugin\",\"type\":\"ProposalSettings\"},{\"name\":\"addAllowance\",\"type\":\"ProposalSettings\"},{\"name\":\"removeAllowance\",\"type\":\"ProposalSettings\"},{\"name\":\"newEscrow\",\"type\":\"ProposalSettings\"},{\"name\":\"toggleEscrowLock\",\"type\":\"ProposalSettings\"},{\"name\":\"updateFields\",\"type\":\"ProposalSettings\"}],\"OtherAppList\":[{\"name\":\"vrfBeacon\",\"type\":\"uint64\"},{\"name\":\"nfdRegistry\",\"type\":\"uint64\"},{\"name\":\"assetInbox\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"poll\",\"type\":\"uint64\"},{\"name\":\"akitaNfd\",\"type\":\"uint64\"}],\"PluginAppList\":[{\"name\":\"optin\",\"type\":\"uint64\"},{\"name\":\"revenueManager\",\"type\":\"uint64\"},{\"name\":\"update\",\"type\":\"uint64\"}],\"ProposalCostInfo\":[{\"name\":\"total\",\"type\":\"uint64\"},{\"name\":\"mbr\",\"type\":\"uint64\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"}],\"ProposalDetails\":[{\"name\":\"status\",\"type\":\"uint8\"},{\"name\":\"cid\",\"type\":\"byte[36]\"},{\"name\":\"votes\",\"type\":\"ProposalVoteTotals\"},{\"name\":\"creator\",\"type\":\"address\"},{\"name\":\"votingTs\",\"type\":\"uint64\"},{\"name\":\"created\",\"type\":\"uint64\"},{\"name\":\"feesPaid\",\"type\":\"uint64\"},{\"name\":\"actions\",\"type\":\"(uint8,byte[])[]\"}],\"ProposalSettings\":[{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"}],\"ProposalVoteInfo\":[{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"power\",\"type\":\"uint64\"}],\"ProposalVoteKey\":[{\"name\":\"proposalID\",\"type\":\"uint64\"},{\"name\":\"voter\",\"type\":\"address\"}],\"ProposalVoteTotals\":[{\"name\":\"approvals\",\"type\":\"uint64\"},{\"name\":\"rejections\",\"type\":\"uint64\"},{\"name\":\"abstains\",\"type\":\"uint64\"}],\"SocialFees\":[{\"name\":\"postFee\",\"type\":\"uint64\"},{\"name\":\"reactFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"StakingFees\":[{\"name\":\"creationFee\",\"type\":\"uint64\"},{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"SubscriptionFees\":[{\"name\":\"serviceCreationFee\",\"type\":\"uint64\"},{\"name\":\"paymentPercentage\",\"type\":\"uint64\"},{\"name\":\"triggerPercentage\",\"type\":\"uint64\"}],\"SwapFees\":[{\"name\":\"impactTaxMin\",\"type\":\"uint64\"},{\"name\":\"impactTaxMax\",\"type\":\"uint64\"}],\"WalletFees\":[{\"name\":\"createFee\",\"type\":\"uint64\"},{\"name\":\"referrerPercentage\",\"type\":\"uint64\"}]},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akta\"},{\"type\":\"byte[36]\",\"name\":\"contentPolicy\"},{\"type\":\"uint64\",\"name\":\"minRewardsImpact\"},{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"name\":\"apps\",\"struct\":\"AkitaDAOApps\"},{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"name\":\"fees\",\"struct\":\"AkitaDAOFees\"},{\"type\":\"((uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64),(uint64,uint64,uint64,uint64,uint64))\",\"name\":\"proposalSettings\",\"struct\":\"Object752A5B25\"},{\"type\":\"((uint64,string),uint8,uint64)[]\",\"name\":\"revenueSplits\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"update\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"desc\":\"\\nThe Akita DAO contract supports its own upgrade via a secure, auditable process. Here's a step-by-step trace of how a self-upgrade is executed:\\n\\n1. Proposal Creation:\\n   - A member submits a proposal to upgrade the DAO by including a ProposalAction of type `UpgradeApp`.\\n   - This proposal references the ARC58 contract and specifies the required upgrade parameters, such as the new application code and any associated execution keys.\\n\\n2. Proposal Approval:\\n   - The DAO community votes on the proposal. If it receives sufficient approvals as defined in the proposal’s settings, its status changes to `Approved`.\\n\\n3. Execution Initiation:\\n   - Upon approval, the DAO creates an execution entry in the ARC58 contract using the parameters from the upgrade proposal.\\n   - This sets up the conditions under which an upgrade may be performed, such as locking execution to a valid round interval and binding it to a unique execution key (via the transaction lease).\\n\\n4. Upgrade Execution:\\n   - A transaction group is submitted where the ARC58 account (or an authorized delegate) triggers the actual `update` method on the DAO contract.\\n   - The `update` method receives the `proposalID` and index of the upgrade action.\\n   - The DAO contract performs the following validation checks before actually permitting the upgrade:\\n       a. Confirms the referenced proposal exists and has status `Approved`.\\n       b. Ensures the action type matches `UpgradeApp`.\\n       c. Verifies the current app ID matches the proposal’s intended target.\\n       d. Ensures the transaction lease matches the authorized execution key from the proposal.\\n       e. Validates that the group context (via groupId and round) satisfies execution constraints—e.g., only the specified group may proceed and only within a permitted round window.\\n   - Only if all conditions are satisfied is the application update allowed to continue.\\n\\nThis architecture ensures upgrades are strictly governed, requiring DAO consensus, group-based transaction atomicity, and explicit validation checks at execution time.\"},{\"name\":\"setup\",\"args\":[{\"type\":\"string\",\"name\":\"nickname\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"partiallyInitialize\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"initialize\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"newProposal\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editProposalWithPayment\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"deleteProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"submitProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"voteProposal\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"uint8\",\"name\":\"vote\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"finalizeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"executeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"deleteProposalVotes\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"address[]\",\"name\":\"voters\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"setupCost\",\"args\":[],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalCost\",\"args\":[{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"ProposalCostInfo\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"(uint8,byte[36],(uint64,uint64,uint64),address,uint64,uint64,uint64,(uint8,byte[])[])\",\"struct\":\"ProposalDetails\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"mustGetExecution\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"lease\"}],\"returns\":{\"type\":\"(uint64,uint64)\",\"struct\":\"ExecutionMetadata\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"addAllowancesProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"YWRkX2FsbG93YW5jZV9wcw==\",\"desc\":\"proposal settings for adding an allowance\"},\"addPluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"YWRkX3BsdWdpbl9wcw==\",\"desc\":\"proposal settings for adding a plugin\"},\"akitaAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaAppList\",\"key\":\"YWFs\",\"desc\":\"the list of akita contract ids\"},\"akitaAssets\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaAssets\",\"key\":\"YWtpdGFfYXNzZXRz\",\"desc\":\"the akita assets\"},\"akitaSocialAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"AkitaSocialAppList\",\"key\":\"c2Fs\",\"desc\":\"the list of akita social contract ids\"},\"contentPolicy\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMBytes\",\"key\":\"Y29udGVudF9wb2xpY3k=\",\"desc\":\"the raw 36 byte content policy of the protocol\"},\"minRewardsImpact\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWluX3Jld2FyZHNfaW1wYWN0\",\"desc\":\"the minimum impact score to qualify for daily disbursement\"},\"newEscrowProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"bmV3X2VzY3Jvd19wcw==\",\"desc\":\"proposal settings for creating a new escrow\"},\"nftFees\":{\"keyType\":\"AVMString\",\"valueType\":\"NFTFees\",\"key\":\"bmZ0X2ZlZXM=\",\"desc\":\"fees associated with NFT sales\"},\"otherAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"OtherAppList\",\"key\":\"b2Fs\",\"desc\":\"the list of other contract ids we use\"},\"pluginAppList\":{\"keyType\":\"AVMString\",\"valueType\":\"PluginAppList\",\"key\":\"cGFs\",\"desc\":\"the list of plugin contract ids\"},\"proposalActionLimit\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfYWN0aW9uX2xpbWl0\",\"desc\":\"the number of actions allowed in a proposal\"},\"proposalID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cHJvcG9zYWxfaWQ=\",\"desc\":\"the next proposal id\"},\"removeAllowancesProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX2FsbG93YW5jZV9wcw==\",\"desc\":\"proposal settings for removing an allowance\"},\"removeExecutePluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3Bz\",\"desc\":\"proposal settings for removing a plugin execution\"},\"removePluginProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"cmVtb3ZlX3BsdWdpbl9wcw==\",\"desc\":\"proposal settings for removing a plugin\"},\"revenueSplits\":{\"keyType\":\"AVMString\",\"valueType\":\"((uint64,string),uint8,uint64)[]\",\"key\":\"cmV2ZW51ZV9zcGxpdHM=\",\"desc\":\"the revenue manager contract id\"},\"socialFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SocialFees\",\"key\":\"c29jaWFsX2ZlZXM=\",\"desc\":\"fees associated with akita social\"},\"stakingFees\":{\"keyType\":\"AVMString\",\"valueType\":\"StakingFees\",\"key\":\"c3Rha2luZ19mZWVz\",\"desc\":\"fees associated with staking assets\"},\"state\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"aW5pdGlhbGl6ZWQ=\",\"desc\":\"state of the DAO\"},\"subscriptionFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SubscriptionFees\",\"key\":\"c3Vic2NyaXB0aW9uX2ZlZXM=\",\"desc\":\"fees associated with subscriptions\"},\"swapFees\":{\"keyType\":\"AVMString\",\"valueType\":\"SwapFees\",\"key\":\"c3dhcF9mZWVz\",\"desc\":\"fees associated with swaps\"},\"toggleEscrowLockProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dG9nZ2xlX2VzY3Jvd19sb2NrX3Bz\",\"desc\":\"proposal settings for toggling an escrow lock\"},\"updateFieldsProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dXBkYXRlX2ZpZWxkc19wcw==\",\"desc\":\"proposal settings for updating fields\"},\"upgradeAppProposalSettings\":{\"keyType\":\"AVMString\",\"valueType\":\"ProposalSettings\",\"key\":\"dXBncmFkZV9hcHBfcHM=\",\"desc\":\"proposal settings for upgrading applications\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the version number of the DAO\"},\"wallet\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"d2FsbGV0\",\"desc\":\"the arc58 wallet the DAO controls\"},\"walletFees\":{\"keyType\":\"AVMString\",\"valueType\":\"WalletFees\",\"key\":\"d2FsbGV0X2ZlZXM=\",\"desc\":\"the fees for akita wallet operations\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"executions\":{\"keyType\":\"AVMBytes\",\"valueType\":\"ExecutionMetadata\",\"prefix\":\"eA==\",\"desc\":\"extra execution information for the DAO\"},\"plugins\":{\"keyType\":\"DAOPluginKey\",\"valueType\":\"ProposalSettings\",\"prefix\":\"cA==\",\"desc\":\"Plugins that add functionality to the controlledAddress and the account that has permission to use it.\"},\"proposalVotes\":{\"keyType\":\"ProposalVoteKey\",\"valueType\":\"ProposalVoteInfo\",\"prefix\":\"dg==\",\"desc\":\"votes by proposal id \\u0026 address\"},\"proposals\":{\"keyType\":\"uint64\",\"valueType\":\"ProposalDetails\",\"prefix\":\"bA==\",\"desc\":\"voting state of a proposal\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMiAxMDAwMDAgMTAwMCAxMDAwMDAwCiAgICBieXRlY2Jsb2NrIDB4MDAgMHgxNTFmN2M3NSAid2FsbGV0IiAiIiAibCIgIm9hbCIgMHgxNCAiaW5pdGlhbGl6ZWQiIDB4MjggImFhbCIgMHgxZSAiYWtpdGFfYXNzZXRzIiAweDMyICJwcm9wb3NhbF9hY3Rpb25fbGltaXQiICJwYWwiICJ1cGdyYWRlX2FwcF9wcyIgImFkZF9wbHVnaW5fcHMiICJyZW1vdmVfcGx1Z2luX3BzIiAiYWRkX2FsbG93YW5jZV9wcyIgInJlbW92ZV9hbGxvd2FuY2VfcHMiICJuZXdfZXNjcm93X3BzIiAidXBkYXRlX2ZpZWxkc19wcyIgMHgwYSAidmVyc2lvbiIgImNvbnRlbnRfcG9saWN5IiAibWluX3Jld2FyZHNfaW1wYWN0IiAic2FsIiAid2FsbGV0X2ZlZXMiICJzb2NpYWxfZmVlcyIgInN0YWtpbmdfZmVlcyIgInN1YnNjcmlwdGlvbl9mZWVzIiAibmZ0X2ZlZXMiICJzd2FwX2ZlZXMiICJyZXZlbnVlX3NwbGl0cyIgMHgwMiAweDE1IDB4MDAwMTAwMDIgInByb3Bvc2FsX2lkIiAweDAwMmEgMHgxZiAweDI5IDB4MDAwMCAweDNjIDB4NDYgMHg0NyAweDUwIDB4MDAwYSAicCIgMHgwMDAwMDAwMDAwMDAwMTZkMDAwMDAwMDAwMDBmNDI0MCAicmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3BzIiAidG9nZ2xlX2VzY3Jvd19sb2NrX3BzIiAweDg5YTc2ODI2IDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwIDB4MDA3NwogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJueiBtYWluX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMgogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBpbml0aWFsVmFsdWU6ICcnLCBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgYnl0ZWMgMjMgLy8gInZlcnNpb24iCiAgICBieXRlY18zIC8vICIiCiAgICBhcHBfZ2xvYmFsX3B1dAoKbWFpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQtMjUKICAgIC8vIEBjb250cmFjdCh7IHN0YXRlVG90YWxzOiB7IGdsb2JhbEJ5dGVzOiBBa2l0YURBT051bUdsb2JhbEJ5dGVzLCBnbG9iYWxVaW50czogQWtpdGFEQU9OdW1HbG9iYWxVaW50cyB9IH0pCiAgICAvLyBleHBvcnQgY2xhc3MgQWtpdGFEQU8gZXh0ZW5kcyBDb250cmFjdCB7CiAgICBwdXNoYnl0ZXMgMHhlYTkxODBkZCAvLyBtZXRob2QgInVwZGF0ZShzdHJpbmcpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5fdXBkYXRlX3JvdXRlQDQKCm1haW5fc3dpdGNoX2Nhc2VfbmV4dEA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0LTI1CiAgICAvLyBAY29udHJhY3QoeyBzdGF0ZVRvdGFsczogeyBnbG9iYWxCeXRlczogQWtpdGFEQU9OdW1HbG9iYWxCeXRlcywgZ2xvYmFsVWludHM6IEFraXRhREFPTnVtR2xvYmFsVWludHMgfSB9KQogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDI1CiAgICBwdXNoYnl0ZXNzIDB4ZDliYWNkOTQgMHg1M2M0NzU2NiAweGZkMmM5M2NkIDB4MDE5OGZlM2IgMHhmZTIwYzI2OSAweGNiMzdlNjY4IDB4MjhmODAxNTQgMHgxMDNhNmI4OCAweGE0YjIzNmI2IDB4NjJjZTliNWIgMHhmMWZmMjhhYSAweDQ5MDEzYjVmIDB4OTY5OWQxMDggMHg4YzU2NDc2OCAweDFmOWY4YjIwIDB4ZGJiZWM2ZDIgMHg4NTRkZWRlMCAvLyBtZXRob2QgInNldHVwKHN0cmluZyl1aW50NjQiLCBtZXRob2QgInBhcnRpYWxseUluaXRpYWxpemUoKXZvaWQiLCBtZXRob2QgImluaXRpYWxpemUoKXZvaWQiLCBtZXRob2QgIm5ld1Byb3Bvc2FsKHBheSxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXVpbnQ2NCIsIG1ldGhvZCAiZWRpdFByb3Bvc2FsKHVpbnQ2NCxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXZvaWQiLCBtZXRob2QgImVkaXRQcm9wb3NhbFdpdGhQYXltZW50KHBheSx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIiwgbWV0aG9kICJkZWxldGVQcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAic3VibWl0UHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgInZvdGVQcm9wb3NhbChwYXksdWludDY0LHVpbnQ4KXZvaWQiLCBtZXRob2QgImZpbmFsaXplUHJvcG9zYWwodWludDY0KXZvaWQiLCBtZXRob2QgImV4ZWN1dGVQcm9wb3NhbCh1aW50NjQpdm9pZCIsIG1ldGhvZCAiZGVsZXRlUHJvcG9zYWxWb3Rlcyh1aW50NjQsYWRkcmVzc1tdKXZvaWQiLCBtZXRob2QgInNldHVwQ29zdCgpdWludDY0IiwgbWV0aG9kICJwcm9wb3NhbENvc3QoKHVpbnQ4LGJ5dGVbXSlbXSkodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAiZ2V0UHJvcG9zYWwodWludDY0KSh1aW50OCxieXRlWzM2XSwodWludDY0LHVpbnQ2NCx1aW50NjQpLGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQsKHVpbnQ4LGJ5dGVbXSlbXSkiLCBtZXRob2QgIm11c3RHZXRFeGVjdXRpb24oYnl0ZVszMl0pKHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJvcFVwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggc2V0dXAgcGFydGlhbGx5SW5pdGlhbGl6ZSBpbml0aWFsaXplIG5ld1Byb3Bvc2FsIGVkaXRQcm9wb3NhbCBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudCBkZWxldGVQcm9wb3NhbCBzdWJtaXRQcm9wb3NhbCB2b3RlUHJvcG9zYWwgZmluYWxpemVQcm9wb3NhbCBleGVjdXRlUHJvcG9zYWwgZGVsZXRlUHJvcG9zYWxWb3RlcyBzZXR1cENvc3QgcHJvcG9zYWxDb3N0IGdldFByb3Bvc2FsIG11c3RHZXRFeGVjdXRpb24gbWFpbl9vcFVwX3JvdXRlQDIzCiAgICBlcnIKCm1haW5fb3BVcF9yb3V0ZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM4CiAgICAvLyBvcFVwKCk6IHZvaWQgeyB9CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgptYWluX2NyZWF0ZV9Ob09wQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0LTI1CiAgICAvLyBAY29udHJhY3QoeyBzdGF0ZVRvdGFsczogeyBnbG9iYWxCeXRlczogQWtpdGFEQU9OdW1HbG9iYWxCeXRlcywgZ2xvYmFsVWludHM6IEFraXRhREFPTnVtR2xvYmFsVWludHMgfSB9KQogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPIGV4dGVuZHMgQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4MGViMGI5N2IgLy8gbWV0aG9kICJjcmVhdGUoc3RyaW5nLHVpbnQ2NCxieXRlWzM2XSx1aW50NjQsKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwoKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkpLCgodWludDY0LHN0cmluZyksdWludDgsdWludDY0KVtdKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKbWFpbl91cGRhdGVfcm91dGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjp3aWRlUmF0aW8obnVtZXJhdG9yczogYnl0ZXMsIGRlbm9taW5hdG9yczogYnl0ZXMpIC0+IHVpbnQ2NDoKd2lkZVJhdGlvOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTMKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiB3aWRlUmF0aW8obnVtZXJhdG9yczogW3VpbnQ2NCwgdWludDY0XSwgZGVub21pbmF0b3JzOiBbdWludDY0LCB1aW50NjRdKTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTQKICAgIC8vIGFzc2VydChkZW5vbWluYXRvcnNbMF0gPiAwICYmIGRlbm9taW5hdG9yc1sxXSA+IDAsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBieiB3aWRlUmF0aW9fYm9vbF9mYWxzZUAzCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnogd2lkZVJhdGlvX2Jvb2xfZmFsc2VAMwogICAgaW50Y18xIC8vIDEKCndpZGVSYXRpb19ib29sX21lcmdlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjExNAogICAgLy8gYXNzZXJ0KGRlbm9taW5hdG9yc1swXSA+IDAgJiYgZGVub21pbmF0b3JzWzFdID4gMCwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTE1CiAgICAvLyBjb25zdCBbb3ZlcmZsb3csIHJlc3VsdF0gPSBvcC5kaXZtb2R3KC4uLm9wLm11bHcoLi4ubnVtZXJhdG9ycyksIC4uLm9wLm11bHcoLi4uZGVub21pbmF0b3JzKSkKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgLTIKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgbXVsdwogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyAwCiAgICBtdWx3CiAgICBkaXZtb2R3CiAgICBwb3BuIDIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTE2CiAgICAvLyBhc3NlcnQob3ZlcmZsb3cgPT09IDAsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICAhCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjExNwogICAgLy8gcmV0dXJuIHJlc3VsdAogICAgc3dhcAogICAgcmV0c3ViCgp3aWRlUmF0aW9fYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgd2lkZVJhdGlvX2Jvb2xfbWVyZ2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldE9yaWdpbihlc2Nyb3dGYWN0b3J5OiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiBieXRlczoKZ2V0T3JpZ2luOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTQKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnZXRPcmlnaW4oZXNjcm93RmFjdG9yeTogdWludDY0LCBhZGRyZXNzOiBBY2NvdW50KTogQWNjb3VudCB7CiAgICBwcm90byAyIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTgyLTE4NQogICAgLy8gY29uc3QgZGF0YSA9IGFiaUNhbGw8dHlwZW9mIEVzY3Jvd0ZhY3RvcnkucHJvdG90eXBlLmdldD4oewogICAgLy8gICBhcHBJZDogZXNjcm93RmFjdG9yeSwKICAgIC8vICAgYXJnczogW2FkZHJlc3NdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4M2MxYTZmMzMgLy8gbWV0aG9kICJnZXQoYWRkcmVzcylieXRlW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4NwogICAgLy8gaWYgKEJ5dGVzKGRhdGEpLmxlbmd0aCA9PT0gMCB8fCBCeXRlcyhkYXRhKS5sZW5ndGggIT09IDgpIHsKICAgIGxlbgogICAgZHVwCiAgICBieiBnZXRPcmlnaW5faWZfYm9keUA3CiAgICBmcmFtZV9kaWcgMgogICAgaW50Y18yIC8vIDgKICAgICE9CiAgICBieiBnZXRPcmlnaW5fYWZ0ZXJfaWZfZWxzZUA4CgpnZXRPcmlnaW5faWZfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODgKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCgpnZXRPcmlnaW5fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTcKICAgIC8vIGlmICh3YWxsZXQgPT09IDApIHsKICAgIGZyYW1lX2RpZyAwCiAgICBibnogZ2V0T3JpZ2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTgKICAgIC8vIHJldHVybiBUeG4uc2VuZGVyCiAgICB0eG4gU2VuZGVyCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0T3JpZ2luX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGZyYW1lX2RpZyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBwdXNoYnl0ZXMgImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjAxCiAgICAvLyByZXR1cm4gZ2V0T3JpZ2luQWNjb3VudChBcHBsaWNhdGlvbih3YWxsZXQpKQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldE9yaWdpbl9hZnRlcl9pZl9lbHNlQDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE5MQogICAgLy8gcmV0dXJuIGJ0b2koZGF0YSkKICAgIGZyYW1lX2RpZyAxCiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTk1CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKQogICAgYiBnZXRPcmlnaW5fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA5CgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0U3Rha2luZ1Bvd2VyKHN0YWtpbmdBcHA6IHVpbnQ2NCwgdXNlcjogYnl0ZXMsIGFzc2V0OiB1aW50NjQpIC0+IHVpbnQ2NDoKZ2V0U3Rha2luZ1Bvd2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NTUKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnZXRTdGFraW5nUG93ZXIoc3Rha2luZ0FwcDogdWludDY0LCB1c2VyOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgYnl0ZWNfMyAvLyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NTYtNDY1CiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0SW5mbz4oewogICAgLy8gICBhcHBJZDogc3Rha2luZ0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHVzZXIsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQsCiAgICAvLyAgICAgICB0eXBlOiBTVEFLSU5HX1RZUEVfTE9DSywKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDYwLTQ2MwogICAgLy8gewogICAgLy8gICBhc3NldCwKICAgIC8vICAgdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICAvLyB9LAogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ2MgogICAgLy8gdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICBieXRlYyA4IC8vIDB4MjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDYwLTQ2MwogICAgLy8gewogICAgLy8gICBhc3NldCwKICAgIC8vICAgdHlwZTogU1RBS0lOR19UWVBFX0xPQ0ssCiAgICAvLyB9LAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1Ni00NjUKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBzdGFraW5nQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgdXNlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldCwKICAgIC8vICAgICAgIHR5cGU6IFNUQUtJTkdfVFlQRV9MT0NLLAogICAgLy8gICAgIH0sCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YzkwNjg4MDkgLy8gbWV0aG9kICJnZXRJbmZvKGFkZHJlc3MsKHVpbnQ2NCx1aW50OCkpKHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0zCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cG4gMgogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgcHVzaGludCAyMCAvLyAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NjcKICAgIC8vIGlmIChpbmZvLmV4cGlyYXRpb24gPD0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCkgewogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgPD0KICAgIGJ6IGdldFN0YWtpbmdQb3dlcl9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDY4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRTdGFraW5nUG93ZXJfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzEKICAgIC8vIGNvbnN0IHJlbWFpbmluZ1RpbWU6IHVpbnQ2NCA9IGluZm8uZXhwaXJhdGlvbiAtIEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAKICAgIGZyYW1lX2RpZyAyCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAtCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzMKICAgIC8vIGlmIChyZW1haW5pbmdUaW1lIDwgT05FX1dFRUspIHsKICAgIHB1c2hpbnQgNjA0ODAwIC8vIDYwNDgwMAogICAgPAogICAgYnogZ2V0U3Rha2luZ1Bvd2VyX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NzQKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldFN0YWtpbmdQb3dlcl9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ3NwogICAgLy8gY29uc3QgcmVtYWluaW5nRGF5czogdWludDY0ID0gcmVtYWluaW5nVGltZSAvIE9ORV9EQVkKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoaW50IDg2NDAwIC8vIDg2NDAwCiAgICAvCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ3OAogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyh3aWRlUmF0aW8oW2luZm8uYW1vdW50LCAxXzAwMF8wMDBdLCBbT05FX1lFQVJfSU5fREFZUywgMV8wMDBfMDAwXSksIHJlbWFpbmluZ0RheXMpLCAxXzAwMF8wMDApCiAgICBmcmFtZV9kaWcgMQogICAgZXh0cmFjdCA0IDgKICAgIGludGMgNiAvLyAxMDAwMDAwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGR1cAogICAgYnl0ZWMgNDggLy8gMHgwMDAwMDAwMDAwMDAwMTZkMDAwMDAwMDAwMDBmNDI0MAogICAgY2FsbHN1YiB3aWRlUmF0aW8KICAgIGRpZyAyCiAgICBtdWx3CiAgICBwb3AKICAgIGNvdmVyIDIKICAgIGJ5dGVjIDQ4IC8vIDB4MDAwMDAwMDAwMDAwMDE2ZDAwMDAwMDAwMDAwZjQyNDAKICAgIGNhbGxzdWIgd2lkZVJhdGlvCiAgICBtdWx3CiAgICBidXJ5IDEKICAgIGludGMgNiAvLyAxMDAwMDAwCiAgICBkaXZ3CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2MwogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyMDAgLy8gMjAwCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMjY0IC8vIDI2NAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYwIC8vIDM2MAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpLCh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSwodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCksKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4NAogICAgLy8gYXNzZXJ0KHRoaXMudmVyc2lvbi52YWx1ZSA9PT0gJycsIEVSUl9BTFJFQURZX0lOSVRJQUxJWkVEKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMgogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBpbml0aWFsVmFsdWU6ICcnLCBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgYnl0ZWMgMjMgLy8gInZlcnNpb24iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg0CiAgICAvLyBhc3NlcnQodGhpcy52ZXJzaW9uLnZhbHVlID09PSAnJywgRVJSX0FMUkVBRFlfSU5JVElBTElaRUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAiIgogICAgPT0KICAgIGFzc2VydCAvLyBBbHJlYWR5IGluaXRpYWxpemVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg1CiAgICAvLyBhc3NlcnQodmVyc2lvbiAhPT0gJycsIEVSUl9WRVJTSU9OX0NBTk5PVF9CRV9FTVBUWSkKICAgIGRpZyA3CiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIFZlcnNpb24gY2Fubm90IGJlIGVtcHR5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsgaW5pdGlhbFZhbHVlOiAnJywga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDIzIC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4NwogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gdmVyc2lvbgogICAgdW5jb3ZlciA4CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwcm9wb3NhbEFjdGlvbkxpbWl0ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0IH0pCiAgICBieXRlYyAxMyAvLyAicHJvcG9zYWxfYWN0aW9uX2xpbWl0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc4OAogICAgLy8gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlID0gNQogICAgcHVzaGludCA1IC8vIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg5CiAgICAvLyB0aGlzLmFraXRhQXNzZXRzLnZhbHVlID0geyBha3RhLCBib25lczogMCB9CiAgICB1bmNvdmVyIDYKICAgIGl0b2IKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MgogICAgLy8gYWtpdGFBc3NldHMgPSBHbG9iYWxTdGF0ZTxBa2l0YUFzc2V0cz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMgfSkKICAgIGJ5dGVjIDExIC8vICJha2l0YV9hc3NldHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzg5CiAgICAvLyB0aGlzLmFraXRhQXNzZXRzLnZhbHVlID0geyBha3RhLCBib25lczogMCB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBjb250ZW50UG9saWN5ID0gR2xvYmFsU3RhdGU8Q0lEPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNDb250ZW50UG9saWN5IH0pCiAgICBieXRlYyAyNCAvLyAiY29udGVudF9wb2xpY3kiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkwCiAgICAvLyB0aGlzLmNvbnRlbnRQb2xpY3kudmFsdWUgPSBjb250ZW50UG9saWN5CiAgICB1bmNvdmVyIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDAKICAgIC8vIG1pblJld2FyZHNJbXBhY3QgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3QgfSkKICAgIGJ5dGVjIDI1IC8vICJtaW5fcmV3YXJkc19pbXBhY3QiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkxCiAgICAvLyB0aGlzLm1pblJld2FyZHNJbXBhY3QudmFsdWUgPSBtaW5SZXdhcmRzSW1wYWN0CiAgICB1bmNvdmVyIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk0CiAgICAvLyBzdGFraW5nOiBhcHBzLnN0YWtpbmcsCiAgICBkaWcgMwogICAgZXh0cmFjdCAwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OTUKICAgIC8vIHJld2FyZHM6IGFwcHMucmV3YXJkcywKICAgIGRpZyA0CiAgICBleHRyYWN0IDggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5NgogICAgLy8gcG9vbDogYXBwcy5wb29sLAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5NwogICAgLy8gcHJpemVCb3g6IGFwcHMucHJpemVCb3gsCiAgICBkaWcgNgogICAgZXh0cmFjdCAyNCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk4CiAgICAvLyBzdWJzY3JpcHRpb25zOiBhcHBzLnN1YnNjcmlwdGlvbnMsCiAgICBkaWcgNwogICAgZXh0cmFjdCAzMiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Nzk5CiAgICAvLyBnYXRlOiBhcHBzLmdhdGUsCiAgICBkaWcgOAogICAgZXh0cmFjdCA0MCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAwCiAgICAvLyBhdWN0aW9uOiBhcHBzLmF1Y3Rpb24sCiAgICBkaWcgOQogICAgZXh0cmFjdCA0OCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAxCiAgICAvLyBoeXBlclN3YXA6IGFwcHMuaHlwZXJTd2FwLAogICAgZGlnIDEwCiAgICBleHRyYWN0IDU2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDIKICAgIC8vIHJhZmZsZTogYXBwcy5yYWZmbGUsCiAgICBkaWcgMTEKICAgIGV4dHJhY3QgNjQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwMwogICAgLy8gbWV0YU1lcmtsZXM6IGFwcHMubWV0YU1lcmtsZXMsCiAgICBkaWcgMTIKICAgIGV4dHJhY3QgNzIgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwNAogICAgLy8gbWFya2V0cGxhY2U6IGFwcHMubWFya2V0cGxhY2UsCiAgICBkaWcgMTMKICAgIGV4dHJhY3QgODAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwNQogICAgLy8gd2FsbGV0OiBhcHBzLndhbGxldCwKICAgIGRpZyAxNAogICAgZXh0cmFjdCAxNzYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc5My04MDYKICAgIC8vIHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlID0gewogICAgLy8gICBzdGFraW5nOiBhcHBzLnN0YWtpbmcsCiAgICAvLyAgIHJld2FyZHM6IGFwcHMucmV3YXJkcywKICAgIC8vICAgcG9vbDogYXBwcy5wb29sLAogICAgLy8gICBwcml6ZUJveDogYXBwcy5wcml6ZUJveCwKICAgIC8vICAgc3Vic2NyaXB0aW9uczogYXBwcy5zdWJzY3JpcHRpb25zLAogICAgLy8gICBnYXRlOiBhcHBzLmdhdGUsCiAgICAvLyAgIGF1Y3Rpb246IGFwcHMuYXVjdGlvbiwKICAgIC8vICAgaHlwZXJTd2FwOiBhcHBzLmh5cGVyU3dhcCwKICAgIC8vICAgcmFmZmxlOiBhcHBzLnJhZmZsZSwKICAgIC8vICAgbWV0YU1lcmtsZXM6IGFwcHMubWV0YU1lcmtsZXMsCiAgICAvLyAgIG1hcmtldHBsYWNlOiBhcHBzLm1hcmtldHBsYWNlLAogICAgLy8gICB3YWxsZXQ6IGFwcHMud2FsbGV0LAogICAgLy8gfQogICAgdW5jb3ZlciAxMQogICAgdW5jb3ZlciAxMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDEwCiAgICBjb25jYXQKICAgIHVuY292ZXIgOQogICAgY29uY2F0CiAgICB1bmNvdmVyIDgKICAgIGNvbmNhdAogICAgdW5jb3ZlciA3CiAgICBjb25jYXQKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIGFraXRhQXBwTGlzdCA9IEdsb2JhbFN0YXRlPEFraXRhQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0IH0pCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzkzLTgwNgogICAgLy8gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUgPSB7CiAgICAvLyAgIHN0YWtpbmc6IGFwcHMuc3Rha2luZywKICAgIC8vICAgcmV3YXJkczogYXBwcy5yZXdhcmRzLAogICAgLy8gICBwb29sOiBhcHBzLnBvb2wsCiAgICAvLyAgIHByaXplQm94OiBhcHBzLnByaXplQm94LAogICAgLy8gICBzdWJzY3JpcHRpb25zOiBhcHBzLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGdhdGU6IGFwcHMuZ2F0ZSwKICAgIC8vICAgYXVjdGlvbjogYXBwcy5hdWN0aW9uLAogICAgLy8gICBoeXBlclN3YXA6IGFwcHMuaHlwZXJTd2FwLAogICAgLy8gICByYWZmbGU6IGFwcHMucmFmZmxlLAogICAgLy8gICBtZXRhTWVya2xlczogYXBwcy5tZXRhTWVya2xlcywKICAgIC8vICAgbWFya2V0cGxhY2U6IGFwcHMubWFya2V0cGxhY2UsCiAgICAvLyAgIHdhbGxldDogYXBwcy53YWxsZXQsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgwOQogICAgLy8gc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIGRpZyAzCiAgICBleHRyYWN0IDEyMCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODEwCiAgICAvLyBncmFwaDogYXBwcy5ncmFwaCwKICAgIGRpZyA0CiAgICBleHRyYWN0IDEyOCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODExCiAgICAvLyBpbXBhY3Q6IGFwcHMuaW1wYWN0LAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTM2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgZGlnIDYKICAgIGV4dHJhY3QgMTQ0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDgtODEzCiAgICAvLyB0aGlzLmFraXRhU29jaWFsQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIC8vICAgZ3JhcGg6IGFwcHMuZ3JhcGgsCiAgICAvLyAgIGltcGFjdDogYXBwcy5pbXBhY3QsCiAgICAvLyAgIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgLy8gfQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0CiAgICAvLyBha2l0YVNvY2lhbEFwcExpc3QgPSBHbG9iYWxTdGF0ZTxBa2l0YVNvY2lhbEFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCB9KQogICAgYnl0ZWMgMjYgLy8gInNhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MDgtODEzCiAgICAvLyB0aGlzLmFraXRhU29jaWFsQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgc29jaWFsOiBhcHBzLnNvY2lhbCwKICAgIC8vICAgZ3JhcGg6IGFwcHMuZ3JhcGgsCiAgICAvLyAgIGltcGFjdDogYXBwcy5pbXBhY3QsCiAgICAvLyAgIG1vZGVyYXRpb246IGFwcHMubW9kZXJhdGlvbgogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTYKICAgIC8vIG9wdGluOiBhcHBzLm9wdGluLAogICAgZGlnIDMKICAgIGV4dHJhY3QgOTYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgxNwogICAgLy8gcmV2ZW51ZU1hbmFnZXI6IGFwcHMucmV2ZW51ZU1hbmFnZXIsCiAgICBkaWcgNAogICAgZXh0cmFjdCAxMDQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgxOAogICAgLy8gdXBkYXRlOiBhcHBzLnVwZGF0ZQogICAgZGlnIDUKICAgIGV4dHJhY3QgMTEyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MTUtODE5CiAgICAvLyB0aGlzLnBsdWdpbkFwcExpc3QudmFsdWUgPSB7CiAgICAvLyAgIG9wdGluOiBhcHBzLm9wdGluLAogICAgLy8gICByZXZlbnVlTWFuYWdlcjogYXBwcy5yZXZlbnVlTWFuYWdlciwKICAgIC8vICAgdXBkYXRlOiBhcHBzLnVwZGF0ZQogICAgLy8gfQogICAgY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcGx1Z2luQXBwTGlzdCA9IEdsb2JhbFN0YXRlPFBsdWdpbkFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QgfSkKICAgIGJ5dGVjIDE0IC8vICJwYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODE1LTgxOQogICAgLy8gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gewogICAgLy8gICBvcHRpbjogYXBwcy5vcHRpbiwKICAgIC8vICAgcmV2ZW51ZU1hbmFnZXI6IGFwcHMucmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIHVwZGF0ZTogYXBwcy51cGRhdGUKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODIyCiAgICAvLyB2cmZCZWFjb246IGFwcHMudnJmQmVhY29uLAogICAgZGlnIDMKICAgIGV4dHJhY3QgMTUyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjMKICAgIC8vIG5mZFJlZ2lzdHJ5OiBhcHBzLm5mZFJlZ2lzdHJ5LAogICAgZGlnIDQKICAgIGV4dHJhY3QgMTYwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjQKICAgIC8vIGFzc2V0SW5ib3g6IGFwcHMuYXNzZXRJbmJveCwKICAgIGRpZyA1CiAgICBleHRyYWN0IDE2OCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODI1CiAgICAvLyBlc2Nyb3c6IGFwcHMuZXNjcm93LAogICAgZGlnIDYKICAgIGV4dHJhY3QgMTg0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjYKICAgIC8vIGFraXRhTmZkOiBhcHBzLmFraXRhTmZkLAogICAgZGlnIDcKICAgIGV4dHJhY3QgODggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgyNwogICAgLy8gcG9sbDogYXBwcy5wb2xsCiAgICB1bmNvdmVyIDgKICAgIGV4dHJhY3QgMTkyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MjEtODI4CiAgICAvLyB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IHsKICAgIC8vICAgdnJmQmVhY29uOiBhcHBzLnZyZkJlYWNvbiwKICAgIC8vICAgbmZkUmVnaXN0cnk6IGFwcHMubmZkUmVnaXN0cnksCiAgICAvLyAgIGFzc2V0SW5ib3g6IGFwcHMuYXNzZXRJbmJveCwKICAgIC8vICAgZXNjcm93OiBhcHBzLmVzY3JvdywKICAgIC8vICAgYWtpdGFOZmQ6IGFwcHMuYWtpdGFOZmQsCiAgICAvLyAgIHBvbGw6IGFwcHMucG9sbAogICAgLy8gfQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgdW5jb3ZlciA0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgyMS04MjgKICAgIC8vIHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlID0gewogICAgLy8gICB2cmZCZWFjb246IGFwcHMudnJmQmVhY29uLAogICAgLy8gICBuZmRSZWdpc3RyeTogYXBwcy5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXNzZXRJbmJveDogYXBwcy5hc3NldEluYm94LAogICAgLy8gICBlc2Nyb3c6IGFwcHMuZXNjcm93LAogICAgLy8gICBha2l0YU5mZDogYXBwcy5ha2l0YU5mZCwKICAgIC8vICAgcG9sbDogYXBwcy5wb2xsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzMQogICAgLy8gY3JlYXRlRmVlOiBmZWVzLndhbGxldENyZWF0ZUZlZSwKICAgIGRpZyAyCiAgICBleHRyYWN0IDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzMgogICAgLy8gcmVmZXJyZXJQZXJjZW50YWdlOiBmZWVzLndhbGxldFJlZmVycmVyUGVyY2VudGFnZQogICAgZGlnIDMKICAgIGV4dHJhY3QgOCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODMwLTgzMwogICAgLy8gdGhpcy53YWxsZXRGZWVzLnZhbHVlID0gewogICAgLy8gICBjcmVhdGVGZWU6IGZlZXMud2FsbGV0Q3JlYXRlRmVlLAogICAgLy8gICByZWZlcnJlclBlcmNlbnRhZ2U6IGZlZXMud2FsbGV0UmVmZXJyZXJQZXJjZW50YWdlCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MAogICAgLy8gd2FsbGV0RmVlcyA9IEdsb2JhbFN0YXRlPFdhbGxldEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldEZlZXMgfSkKICAgIGJ5dGVjIDI3IC8vICJ3YWxsZXRfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzAtODMzCiAgICAvLyB0aGlzLndhbGxldEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGNyZWF0ZUZlZTogZmVlcy53YWxsZXRDcmVhdGVGZWUsCiAgICAvLyAgIHJlZmVycmVyUGVyY2VudGFnZTogZmVlcy53YWxsZXRSZWZlcnJlclBlcmNlbnRhZ2UKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM2CiAgICAvLyBwb3N0RmVlOiBmZWVzLnBvc3RGZWUsCiAgICBkaWcgMgogICAgZXh0cmFjdCAxNiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM3CiAgICAvLyByZWFjdEZlZTogZmVlcy5yZWFjdEZlZSwKICAgIGRpZyAzCiAgICBleHRyYWN0IDI0IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzgKICAgIC8vIGltcGFjdFRheE1pbjogZmVlcy5pbXBhY3RUYXhNaW4sCiAgICBkaWcgNAogICAgZXh0cmFjdCAzMiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBpbXBhY3RUYXhNYXg6IGZlZXMuaW1wYWN0VGF4TWF4LAogICAgZGlnIDUKICAgIGV4dHJhY3QgNDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjgzNS04NDAKICAgIC8vIHRoaXMuc29jaWFsRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgcG9zdEZlZTogZmVlcy5wb3N0RmVlLAogICAgLy8gICByZWFjdEZlZTogZmVlcy5yZWFjdEZlZSwKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLmltcGFjdFRheE1pbiwKICAgIC8vICAgaW1wYWN0VGF4TWF4OiBmZWVzLmltcGFjdFRheE1heCwKICAgIC8vIH0KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MgogICAgLy8gc29jaWFsRmVlcyA9IEdsb2JhbFN0YXRlPFNvY2lhbEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMgfSkKICAgIGJ5dGVjIDI4IC8vICJzb2NpYWxfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MzUtODQwCiAgICAvLyB0aGlzLnNvY2lhbEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIHBvc3RGZWU6IGZlZXMucG9zdEZlZSwKICAgIC8vICAgcmVhY3RGZWU6IGZlZXMucmVhY3RGZWUsCiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5pbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5pbXBhY3RUYXhNYXgsCiAgICAvLyB9CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0MwogICAgLy8gY3JlYXRpb25GZWU6IGZlZXMucG9vbENyZWF0aW9uRmVlLAogICAgZGlnIDIKICAgIGV4dHJhY3QgNDggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0NAogICAgLy8gaW1wYWN0VGF4TWluOiBmZWVzLnBvb2xJbXBhY3RUYXhNaW4sCiAgICBkaWcgMwogICAgZXh0cmFjdCA1NiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQ1CiAgICAvLyBpbXBhY3RUYXhNYXg6IGZlZXMucG9vbEltcGFjdFRheE1heAogICAgZGlnIDQKICAgIGV4dHJhY3QgNjQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0Mi04NDYKICAgIC8vIHRoaXMuc3Rha2luZ0ZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGNyZWF0aW9uRmVlOiBmZWVzLnBvb2xDcmVhdGlvbkZlZSwKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLnBvb2xJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5wb29sSW1wYWN0VGF4TWF4CiAgICAvLyB9CiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU0CiAgICAvLyBzdGFraW5nRmVlcyA9IEdsb2JhbFN0YXRlPFN0YWtpbmdGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdGFraW5nRmVlcyB9KQogICAgYnl0ZWMgMjkgLy8gInN0YWtpbmdfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDItODQ2CiAgICAvLyB0aGlzLnN0YWtpbmdGZWVzLnZhbHVlID0gewogICAgLy8gICBjcmVhdGlvbkZlZTogZmVlcy5wb29sQ3JlYXRpb25GZWUsCiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5wb29sSW1wYWN0VGF4TWluLAogICAgLy8gICBpbXBhY3RUYXhNYXg6IGZlZXMucG9vbEltcGFjdFRheE1heAogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDkKICAgIC8vIHNlcnZpY2VDcmVhdGlvbkZlZTogZmVlcy5zdWJzY3JpcHRpb25TZXJ2aWNlQ3JlYXRpb25GZWUsCiAgICBkaWcgMgogICAgZXh0cmFjdCA3MiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODUwCiAgICAvLyBwYXltZW50UGVyY2VudGFnZTogZmVlcy5zdWJzY3JpcHRpb25QYXltZW50UGVyY2VudGFnZSwKICAgIGRpZyAzCiAgICBleHRyYWN0IDgwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTEKICAgIC8vIHRyaWdnZXJQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblRyaWdnZXJQZXJjZW50YWdlLAogICAgZGlnIDQKICAgIGV4dHJhY3QgODggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0OC04NTIKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgc2VydmljZUNyZWF0aW9uRmVlOiBmZWVzLnN1YnNjcmlwdGlvblNlcnZpY2VDcmVhdGlvbkZlZSwKICAgIC8vICAgcGF5bWVudFBlcmNlbnRhZ2U6IGZlZXMuc3Vic2NyaXB0aW9uUGF5bWVudFBlcmNlbnRhZ2UsCiAgICAvLyAgIHRyaWdnZXJQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblRyaWdnZXJQZXJjZW50YWdlLAogICAgLy8gfQogICAgY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NgogICAgLy8gc3Vic2NyaXB0aW9uRmVlcyA9IEdsb2JhbFN0YXRlPFN1YnNjcmlwdGlvbkZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N1YnNjcmlwdGlvbkZlZXMgfSkKICAgIGJ5dGVjIDMwIC8vICJzdWJzY3JpcHRpb25fZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NDgtODUyCiAgICAvLyB0aGlzLnN1YnNjcmlwdGlvbkZlZXMudmFsdWUgPSB7CiAgICAvLyAgIHNlcnZpY2VDcmVhdGlvbkZlZTogZmVlcy5zdWJzY3JpcHRpb25TZXJ2aWNlQ3JlYXRpb25GZWUsCiAgICAvLyAgIHBheW1lbnRQZXJjZW50YWdlOiBmZWVzLnN1YnNjcmlwdGlvblBheW1lbnRQZXJjZW50YWdlLAogICAgLy8gICB0cmlnZ2VyUGVyY2VudGFnZTogZmVlcy5zdWJzY3JpcHRpb25UcmlnZ2VyUGVyY2VudGFnZSwKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODU1CiAgICAvLyBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWluOiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNaW4sCiAgICBkaWcgMgogICAgZXh0cmFjdCA5NiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODU2CiAgICAvLyBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4OiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNYXgsCiAgICBkaWcgMwogICAgZXh0cmFjdCAxMDQgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg1NwogICAgLy8gbWFya2V0cGxhY2VDb21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5tYXJrZXRwbGFjZUNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgZGlnIDQKICAgIGV4dHJhY3QgMTEyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTgKICAgIC8vIG1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlLAogICAgZGlnIDUKICAgIGV4dHJhY3QgMTIwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTkKICAgIC8vIHNodWZmbGVTYWxlUGVyY2VudGFnZTogZmVlcy5zaHVmZmxlU2FsZVBlcmNlbnRhZ2UsCiAgICBkaWcgNgogICAgZXh0cmFjdCAxMjggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2MAogICAgLy8gb21uaWdlbVNhbGVGZWU6IGZlZXMub21uaWdlbVNhbGVGZWUsCiAgICBkaWcgNwogICAgZXh0cmFjdCAxMzYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2MQogICAgLy8gYXVjdGlvbkNyZWF0aW9uRmVlOiBmZWVzLmF1Y3Rpb25DcmVhdGlvbkZlZSwKICAgIGRpZyA4CiAgICBleHRyYWN0IDE0NCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYyCiAgICAvLyBhdWN0aW9uU2FsZUltcGFjdFRheE1pbjogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1pbiwKICAgIGRpZyA5CiAgICBleHRyYWN0IDE1MiA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYzCiAgICAvLyBhdWN0aW9uU2FsZUltcGFjdFRheE1heDogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1heCwKICAgIGRpZyAxMAogICAgZXh0cmFjdCAxNjAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2NAogICAgLy8gYXVjdGlvbkNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLmF1Y3Rpb25Db21wb3NhYmxlUGVyY2VudGFnZSwKICAgIGRpZyAxMQogICAgZXh0cmFjdCAxNjggOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2NQogICAgLy8gYXVjdGlvblJhZmZsZVBlcmNlbnRhZ2U6IGZlZXMuYXVjdGlvblJhZmZsZVBlcmNlbnRhZ2UsCiAgICBkaWcgMTIKICAgIGV4dHJhY3QgMTc2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NjYKICAgIC8vIHJhZmZsZUNyZWF0aW9uRmVlOiBmZWVzLnJhZmZsZUNyZWF0aW9uRmVlLAogICAgZGlnIDEzCiAgICBleHRyYWN0IDE4NCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODY3CiAgICAvLyByYWZmbGVTYWxlSW1wYWN0VGF4TWluOiBmZWVzLnJhZmZsZVNhbGVJbXBhY3RUYXhNaW4sCiAgICBkaWcgMTQKICAgIGV4dHJhY3QgMTkyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NjgKICAgIC8vIHJhZmZsZVNhbGVJbXBhY3RUYXhNYXg6IGZlZXMucmFmZmxlU2FsZUltcGFjdFRheE1heCwKICAgIGRpZyAxNQogICAgZXh0cmFjdCAyMDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2OQogICAgLy8gcmFmZmxlQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMucmFmZmxlQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICBkaWcgMTYKICAgIGV4dHJhY3QgMjA4IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NTQtODcwCiAgICAvLyB0aGlzLm5mdEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIG1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNaW46IGZlZXMubWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1pbiwKICAgIC8vICAgbWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1heDogZmVlcy5tYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4LAogICAgLy8gICBtYXJrZXRwbGFjZUNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIG1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlOiBmZWVzLm1hcmtldHBsYWNlUm95YWx0eURlZmF1bHRQZXJjZW50YWdlLAogICAgLy8gICBzaHVmZmxlU2FsZVBlcmNlbnRhZ2U6IGZlZXMuc2h1ZmZsZVNhbGVQZXJjZW50YWdlLAogICAgLy8gICBvbW5pZ2VtU2FsZUZlZTogZmVlcy5vbW5pZ2VtU2FsZUZlZSwKICAgIC8vICAgYXVjdGlvbkNyZWF0aW9uRmVlOiBmZWVzLmF1Y3Rpb25DcmVhdGlvbkZlZSwKICAgIC8vICAgYXVjdGlvblNhbGVJbXBhY3RUYXhNaW46IGZlZXMuYXVjdGlvblNhbGVJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGF1Y3Rpb25TYWxlSW1wYWN0VGF4TWF4OiBmZWVzLmF1Y3Rpb25TYWxlSW1wYWN0VGF4TWF4LAogICAgLy8gICBhdWN0aW9uQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMuYXVjdGlvbkNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgLy8gICBhdWN0aW9uUmFmZmxlUGVyY2VudGFnZTogZmVlcy5hdWN0aW9uUmFmZmxlUGVyY2VudGFnZSwKICAgIC8vICAgcmFmZmxlQ3JlYXRpb25GZWU6IGZlZXMucmFmZmxlQ3JlYXRpb25GZWUsCiAgICAvLyAgIHJhZmZsZVNhbGVJbXBhY3RUYXhNaW46IGZlZXMucmFmZmxlU2FsZUltcGFjdFRheE1pbiwKICAgIC8vICAgcmFmZmxlU2FsZUltcGFjdFRheE1heDogZmVlcy5yYWZmbGVTYWxlSW1wYWN0VGF4TWF4LAogICAgLy8gICByYWZmbGVDb21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5yYWZmbGVDb21wb3NhYmxlUGVyY2VudGFnZSwKICAgIC8vIH0KICAgIHVuY292ZXIgMTQKICAgIHVuY292ZXIgMTQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDEyCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgY29uY2F0CiAgICB1bmNvdmVyIDYKICAgIGNvbmNhdAogICAgdW5jb3ZlciA1CiAgICBjb25jYXQKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4CiAgICAvLyBuZnRGZWVzID0gR2xvYmFsU3RhdGU8TkZURmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTkZURmVlcyB9KQogICAgYnl0ZWMgMzEgLy8gIm5mdF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg1NC04NzAKICAgIC8vIHRoaXMubmZ0RmVlcy52YWx1ZSA9IHsKICAgIC8vICAgbWFya2V0cGxhY2VTYWxlUGVyY2VudGFnZU1pbjogZmVlcy5tYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWluLAogICAgLy8gICBtYXJrZXRwbGFjZVNhbGVQZXJjZW50YWdlTWF4OiBmZWVzLm1hcmtldHBsYWNlU2FsZVBlcmNlbnRhZ2VNYXgsCiAgICAvLyAgIG1hcmtldHBsYWNlQ29tcG9zYWJsZVBlcmNlbnRhZ2U6IGZlZXMubWFya2V0cGxhY2VDb21wb3NhYmxlUGVyY2VudGFnZSwKICAgIC8vICAgbWFya2V0cGxhY2VSb3lhbHR5RGVmYXVsdFBlcmNlbnRhZ2U6IGZlZXMubWFya2V0cGxhY2VSb3lhbHR5RGVmYXVsdFBlcmNlbnRhZ2UsCiAgICAvLyAgIHNodWZmbGVTYWxlUGVyY2VudGFnZTogZmVlcy5zaHVmZmxlU2FsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIG9tbmlnZW1TYWxlRmVlOiBmZWVzLm9tbmlnZW1TYWxlRmVlLAogICAgLy8gICBhdWN0aW9uQ3JlYXRpb25GZWU6IGZlZXMuYXVjdGlvbkNyZWF0aW9uRmVlLAogICAgLy8gICBhdWN0aW9uU2FsZUltcGFjdFRheE1pbjogZmVlcy5hdWN0aW9uU2FsZUltcGFjdFRheE1pbiwKICAgIC8vICAgYXVjdGlvblNhbGVJbXBhY3RUYXhNYXg6IGZlZXMuYXVjdGlvblNhbGVJbXBhY3RUYXhNYXgsCiAgICAvLyAgIGF1Y3Rpb25Db21wb3NhYmxlUGVyY2VudGFnZTogZmVlcy5hdWN0aW9uQ29tcG9zYWJsZVBlcmNlbnRhZ2UsCiAgICAvLyAgIGF1Y3Rpb25SYWZmbGVQZXJjZW50YWdlOiBmZWVzLmF1Y3Rpb25SYWZmbGVQZXJjZW50YWdlLAogICAgLy8gICByYWZmbGVDcmVhdGlvbkZlZTogZmVlcy5yYWZmbGVDcmVhdGlvbkZlZSwKICAgIC8vICAgcmFmZmxlU2FsZUltcGFjdFRheE1pbjogZmVlcy5yYWZmbGVTYWxlSW1wYWN0VGF4TWluLAogICAgLy8gICByYWZmbGVTYWxlSW1wYWN0VGF4TWF4OiBmZWVzLnJhZmZsZVNhbGVJbXBhY3RUYXhNYXgsCiAgICAvLyAgIHJhZmZsZUNvbXBvc2FibGVQZXJjZW50YWdlOiBmZWVzLnJhZmZsZUNvbXBvc2FibGVQZXJjZW50YWdlLAogICAgLy8gfQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzMKICAgIC8vIGltcGFjdFRheE1pbjogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWluLAogICAgZGlnIDIKICAgIGV4dHJhY3QgMjE2IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzQKICAgIC8vIGltcGFjdFRheE1heDogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWF4LAogICAgdW5jb3ZlciAzCiAgICBleHRyYWN0IDIyNCA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODcyLTg3NQogICAgLy8gdGhpcy5zd2FwRmVlcy52YWx1ZSA9IHsKICAgIC8vICAgaW1wYWN0VGF4TWluOiBmZWVzLnN3YXBGZWVJbXBhY3RUYXhNaW4sCiAgICAvLyAgIGltcGFjdFRheE1heDogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWF4LAogICAgLy8gfQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAKICAgIC8vIHN3YXBGZWVzID0gR2xvYmFsU3RhdGU8U3dhcEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzIH0pCiAgICBieXRlYyAzMiAvLyAic3dhcF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3Mi04NzUKICAgIC8vIHRoaXMuc3dhcEZlZXMudmFsdWUgPSB7CiAgICAvLyAgIGltcGFjdFRheE1pbjogZmVlcy5zd2FwRmVlSW1wYWN0VGF4TWluLAogICAgLy8gICBpbXBhY3RUYXhNYXg6IGZlZXMuc3dhcEZlZUltcGFjdFRheE1heCwKICAgIC8vIH0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc3CiAgICAvLyB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGdyYWRlQXBwKQogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0MAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4CiAgICAvLyB1cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxNSAvLyAidXBncmFkZV9hcHBfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc3CiAgICAvLyB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGdyYWRlQXBwKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NzgKICAgIC8vIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MuYWRkUGx1Z2luKQogICAgZGlnIDEKICAgIGV4dHJhY3QgNDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MAogICAgLy8gYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZFBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3OAogICAgLy8gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5hZGRQbHVnaW4pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg3OQogICAgLy8gdGhpcy5yZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlRXhlY3V0ZVBsdWdpbikKICAgIGRpZyAxCiAgICBleHRyYWN0IDgwIDQwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQKICAgIC8vIHJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlRXhlY3V0ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDQ5IC8vICJyZW1vdmVfZXhlY3V0ZV9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODc5CiAgICAvLyB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVFeGVjdXRlUGx1Z2luKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlUGx1Z2luKQogICAgZGlnIDEKICAgIGV4dHJhY3QgMTIwIDQwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIKICAgIC8vIHJlbW92ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxNyAvLyAicmVtb3ZlX3BsdWdpbl9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MucmVtb3ZlUGx1Z2luKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLmFkZEFsbG93YW5jZSkKICAgIGRpZyAxCiAgICBleHRyYWN0IDE2MCA0MAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2CiAgICAvLyBhZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAxOCAvLyAiYWRkX2FsbG93YW5jZV9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLmFkZEFsbG93YW5jZSkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVBbGxvd2FuY2UpCiAgICBkaWcgMQogICAgZXh0cmFjdCAyMDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5yZW1vdmVBbGxvd2FuY2UpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4MwogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5uZXdFc2Nyb3cpCiAgICBkaWcgMQogICAgZXh0cmFjdCAyNDAgNDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MAogICAgLy8gbmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4MwogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy5uZXdFc2Nyb3cpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NAogICAgLy8gdGhpcy50b2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHByb3Bvc2FsU2V0dGluZ3MudG9nZ2xlRXNjcm93TG9jaykKICAgIGRpZyAxCiAgICBwdXNoaW50cyAyODAgNDAgLy8gMjgwLCA0MAogICAgZXh0cmFjdDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNUb2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNTAgLy8gInRvZ2dsZV9lc2Nyb3dfbG9ja19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODQKICAgIC8vIHRoaXMudG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZShwcm9wb3NhbFNldHRpbmdzLnRvZ2dsZUVzY3Jvd0xvY2spCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGRhdGVGaWVsZHMpCiAgICBzd2FwCiAgICBwdXNoaW50cyAzMjAgNDAgLy8gMzIwLCA0MAogICAgZXh0cmFjdDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZGF0ZUZpZWxkc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocHJvcG9zYWxTZXR0aW5ncy51cGRhdGVGaWVsZHMpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0CiAgICAvLyByZXZlbnVlU3BsaXRzID0gR2xvYmFsU3RhdGU8U3BsaXRbXT4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0cyB9KQogICAgYnl0ZWMgMzMgLy8gInJldmVudWVfc3BsaXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg4NwogICAgLy8gdGhpcy5yZXZlbnVlU3BsaXRzLnZhbHVlID0gY2xvbmUocmV2ZW51ZVNwbGl0cykKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHByb3Bvc2FsSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsSUQgfSkKICAgIGJ5dGVjIDM3IC8vICJwcm9wb3NhbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4ODkKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSA9IDEKICAgIGludGNfMSAvLyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc2MwogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIyCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy53YWxsZXQudmFsdWUuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIyCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy53YWxsZXQudmFsdWUuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkyMwogICAgLy8gY29uc3QgdXBkYXRlUGx1Z2luID0gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlLnVwZGF0ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcGx1Z2luQXBwTGlzdCA9IEdsb2JhbFN0YXRlPFBsdWdpbkFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QgfSkKICAgIGJ5dGVjIDE0IC8vICJwYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTIzCiAgICAvLyBjb25zdCB1cGRhdGVQbHVnaW4gPSB0aGlzLnBsdWdpbkFwcExpc3QudmFsdWUudXBkYXRlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjQKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCB1cGdyYWRlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsgaW5pdGlhbFZhbHVlOiAnJywga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDIzIC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkyNQogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gbmV3VmVyc2lvbgogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjAKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc2V0dXBbcm91dGluZ10oKSAtPiB2b2lkOgpzZXR1cDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjkKICAgIC8vIHNldHVwKG5pY2tuYW1lOiBzdHJpbmcpOiB1aW50NjQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzAKICAgIC8vIGFzc2VydCghdGhpcy53YWxsZXQuaGFzVmFsdWUsIEVSUl9XQUxMRVRfQUxSRUFEWV9TRVRVUCkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzAKICAgIC8vIGFzc2VydCghdGhpcy53YWxsZXQuaGFzVmFsdWUsIEVSUl9XQUxMRVRfQUxSRUFEWV9TRVRVUCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBXYWxsZXQgYWxyZWFkeSBzZXR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzMgogICAgLy8gY29uc3QgeyB3YWxsZXQ6IGFwcElkIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzIKICAgIC8vIGNvbnN0IHsgd2FsbGV0OiBhcHBJZCB9ID0gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDg4IC8vIDg4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzNAogICAgLy8gY29uc3QgY29zdCA9IHRoaXMuc2V0dXBDb3N0KCkKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MzYtOTQ4CiAgICAvLyBjb25zdCB3YWxsZXRJRCA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50RmFjdG9yeS5wcm90b3R5cGUubmV3QWNjb3VudD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24oYXBwSWQpLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IGNvc3QsCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgIEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIG5pY2tuYW1lLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NDAKICAgIC8vIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihhcHBJZCkuYWRkcmVzcywKICAgIGRpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzOS05NDIKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihhcHBJZCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBjb3N0LAogICAgLy8gfSksCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTM2LTk0OAogICAgLy8gY29uc3Qgd2FsbGV0SUQgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudEZhY3RvcnkucHJvdG90eXBlLm5ld0FjY291bnQ+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGFwcElkKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBjb3N0LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBuaWNrbmFtZSwKICAgIC8vICAgICBHbG9iYWwuemVyb0FkZHJlc3MsCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NDMKICAgIC8vIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk0NAogICAgLy8gR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk0NgogICAgLy8gR2xvYmFsLnplcm9BZGRyZXNzLAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTM2LTk0OAogICAgLy8gY29uc3Qgd2FsbGV0SUQgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudEZhY3RvcnkucHJvdG90eXBlLm5ld0FjY291bnQ+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGFwcElkKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBjb3N0LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBuaWNrbmFtZSwKICAgIC8vICAgICBHbG9iYWwuemVyb0FkZHJlc3MsCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgzNzQ4NTU0MSAvLyBtZXRob2QgIm5ld0FjY291bnQocGF5LGFkZHJlc3MsYWRkcmVzcyxzdHJpbmcsYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBnaXR4biAxIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGR1cAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTUwCiAgICAvLyB0aGlzLndhbGxldC52YWx1ZSA9IEFwcGxpY2F0aW9uKHdhbGxldElEKQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MjkKICAgIC8vIHNldHVwKG5pY2tuYW1lOiBzdHJpbmcpOiB1aW50NjQgewogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucGFydGlhbGx5SW5pdGlhbGl6ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnBhcnRpYWxseUluaXRpYWxpemU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTU2CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzLCBFUlJfRk9SQklEREVOKQogICAgdHhuIFNlbmRlcgogICAgZ2xvYmFsIENyZWF0b3JBZGRyZXNzCiAgICA9PQogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3Igd2FsbGV0IGNhbiBkZWxldGUgYSBzcGVuZGluZyBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1NwogICAgLy8gdGhpcy5zdGF0ZS52YWx1ZSA9IERhb1N0YXRlUGFydGlhbGx5SW5pdGlhbGl6ZWQKICAgIHB1c2hieXRlcyAweDAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1NQogICAgLy8gcGFydGlhbGx5SW5pdGlhbGl6ZSgpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmluaXRpYWxpemVbcm91dGluZ10oKSAtPiB2b2lkOgppbml0aWFsaXplOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk2MQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcywgRVJSX0ZPUkJJRERFTikKICAgIHR4biBTZW5kZXIKICAgIGdsb2JhbCBDcmVhdG9yQWRkcmVzcwogICAgPT0KICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIHRoaXMuc3RhdGUudmFsdWUgPSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQKICAgIGJ5dGVjIDM0IC8vIDB4MDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTYwCiAgICAvLyBpbml0aWFsaXplKCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpuZXdQcm9wb3NhbDoKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDE1CiAgICBieXRlY18zIC8vICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjcKICAgIC8vIG5ld1Byb3Bvc2FsKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB1aW50NjQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTY4CiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OAogICAgLy8gb3RoZXJBcHBMaXN0ID0gR2xvYmFsU3RhdGU8T3RoZXJBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3QgfSkKICAgIGJ5dGVjIDUgLy8gIm9hbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjgKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0T3JpZ2luCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTY5CiAgICAvLyBjb25zdCB7IHRvdGFsLCBwb3dlciB9ID0gdGhpcy5wcm9wb3NhbENvc3QoYWN0aW9ucykKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3MQogICAgLy8gbGV0IGZlZTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzMKICAgIC8vIHRoaXMuc3RhdGUudmFsdWUgPT09IERhb1N0YXRlRnVsbHlJbml0aWFsaXplZCB8fAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3MwogICAgLy8gdGhpcy5zdGF0ZS52YWx1ZSA9PT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgMzQgLy8gMHgwMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzMtOTc0CiAgICAvLyB0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQgfHwKICAgIC8vICh0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZVBhcnRpYWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgIT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykKICAgIGJueiBuZXdQcm9wb3NhbF9pZl9ib2R5QDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vICh0aGlzLnN0YXRlLnZhbHVlID09PSBEYW9TdGF0ZVBhcnRpYWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgIT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAKICAgIC8vIHN0YXRlID0gR2xvYmFsU3RhdGU8RGFvU3RhdGU+KHsgaW5pdGlhbFZhbHVlOiBEYW9TdGF0ZURyYWZ0LCBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQgfSkKICAgIGJ5dGVjIDcgLy8gImluaXRpYWxpemVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3NAogICAgLy8gKHRoaXMuc3RhdGUudmFsdWUgPT09IERhb1N0YXRlUGFydGlhbGx5SW5pdGlhbGl6ZWQgJiYgVHhuLnNlbmRlciAhPT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDAxCiAgICA9PQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1CiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgICE9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDUKCm5ld1Byb3Bvc2FsX2lmX2JvZHlANDoKICAgIGRpZyAyCiAgICBidXJ5IDEKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzktOTg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IGZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgNgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk4MgogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NzktOTg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IGZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICBkaWcgMgogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc5CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPiAwLCBFUlJfRU1QVFlfQUNUSU9OX0xJU1QpCiAgICBkaWcgMwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGJ1cnkgMTIKICAgIGFzc2VydCAvLyBBY3Rpb24gbGlzdCBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CgpuZXdQcm9wb3NhbF93aGlsZV90b3BANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgOAogICAgZGlnIDExCiAgICA8CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl93aGlsZUA2OAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE4MgogICAgLy8gc3dpdGNoIChhY3Rpb25zW2ldLnR5cGUpIHsKICAgIGRpZyAzCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDkKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBidXJ5IDEyCiAgICBkaWcgMTMKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDIxCiAgICBleHRyYWN0IDAgMQogICAgZHVwCiAgICBidXJ5IDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZ3JhZGVBcHA6IHsKICAgIGJ5dGVjIDIyIC8vIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgzLTE5MQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICAvLyAgIC8vIFVwZ3JhZGVBcHAgYWN0aW9ucyBhcmUgdmFsaWRhdGVkIGxhdGVyIGR1cmluZyBleGVjdXRpb24KICAgIC8vICAgLy8gVGhleSBuZWVkIGV4ZWN1dGlvbiBrZXkgYW5kIHdhbGxldCBidWlsZCB2YWxpZGF0aW9uCiAgICAvLyAgIGNvbnN0IHsgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWQgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGdyYWRlQXBwPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGFzc2VydChncm91cHMubGVuZ3RoID4gMCwgJ1VwZ3JhZGUgYXBwIGFjdGlvbiBtdXN0IGhhdmUgYXQgbGVhc3Qgb25lIGdyb3VwJykKICAgIC8vICAgYXNzZXJ0KGZpcnN0VmFsaWQgPiAwLCAnRmlyc3QgdmFsaWQgcm91bmQgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybycpCiAgICAvLyAgIGFzc2VydChsYXN0VmFsaWQgPiBmaXJzdFZhbGlkLCAnTGFzdCB2YWxpZCByb3VuZCBtdXN0IGJlIGdyZWF0ZXIgdGhhbiBmaXJzdCB2YWxpZCcpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg2CiAgICAvLyBjb25zdCB7IGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsVXBncmFkZUFwcD4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZGlnIDEKICAgIHB1c2hpbnQgNDQgLy8gNDQKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNTIgLy8gNTIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg3CiAgICAvLyBhc3NlcnQoZ3JvdXBzLmxlbmd0aCA+IDAsICdVcGdyYWRlIGFwcCBhY3Rpb24gbXVzdCBoYXZlIGF0IGxlYXN0IG9uZSBncm91cCcpCiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgYXNzZXJ0IC8vIFVwZ3JhZGUgYXBwIGFjdGlvbiBtdXN0IGhhdmUgYXQgbGVhc3Qgb25lIGdyb3VwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTg4CiAgICAvLyBhc3NlcnQoZmlyc3RWYWxpZCA+IDAsICdGaXJzdCB2YWxpZCByb3VuZCBtdXN0IGJlIGdyZWF0ZXIgdGhhbiB6ZXJvJykKICAgIGRpZyAxCiAgICBhc3NlcnQgLy8gRmlyc3QgdmFsaWQgcm91bmQgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE4OQogICAgLy8gYXNzZXJ0KGxhc3RWYWxpZCA+IGZpcnN0VmFsaWQsICdMYXN0IHZhbGlkIHJvdW5kIG11c3QgYmUgZ3JlYXRlciB0aGFuIGZpcnN0IHZhbGlkJykKICAgIDwKICAgIGFzc2VydCAvLyBMYXN0IHZhbGlkIHJvdW5kIG11c3QgYmUgZ3JlYXRlciB0aGFuIGZpcnN0IHZhbGlkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTItMTk5CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3csIGZlZSwgcG93ZXIsIGR1cmF0aW9uLCBwYXJ0aWNpcGF0aW9uLCBhcHByb3ZhbCwgdXNlRXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGlmICh1c2VFeGVjdXRpb25LZXkpIHsKICAgIC8vICAgICB0aGlzLnZhbGlkYXRlU2V0dGluZ3MoeyBmZWUsIHBvd2VyLCBkdXJhdGlvbiwgcGFydGljaXBhdGlvbiwgYXBwcm92YWwgfSkKICAgIC8vICAgfQogICAgLy8gICBhc3NlcnQoIXRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9BTFJFQURZX0VYSVNUUykKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTkzCiAgICAvLyBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3csIGZlZSwgcG93ZXIsIGR1cmF0aW9uLCBwYXJ0aWNpcGF0aW9uLCBhcHByb3ZhbCwgdXNlRXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAyIDgKICAgIGJ1cnkgMjUKICAgIGRpZyAxCiAgICBleHRyYWN0IDEwIDMyCiAgICBidXJ5IDE4CiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgcHVzaGludCA1OSAvLyA1OQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxNwogICAgZGlnIDEKICAgIGV4dHJhY3QgNjQgOAogICAgYnVyeSAyNAogICAgZGlnIDEKICAgIGV4dHJhY3QgNzIgOAogICAgYnVyeSAyMwogICAgZGlnIDEKICAgIGV4dHJhY3QgODAgOAogICAgYnVyeSAyOQogICAgZGlnIDEKICAgIGV4dHJhY3QgODggOAogICAgYnVyeSAyOAogICAgc3dhcAogICAgZXh0cmFjdCA5NiA4CiAgICBidXJ5IDI2CiAgICBwdXNoaW50IDQ4OSAvLyA0ODkKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gaWYgKHVzZUV4ZWN1dGlvbktleSkgewogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5NQogICAgLy8gdGhpcy52YWxpZGF0ZVNldHRpbmdzKHsgZmVlLCBwb3dlciwgZHVyYXRpb24sIHBhcnRpY2lwYXRpb24sIGFwcHJvdmFsIH0pCiAgICBkaWcgMjEKICAgIGRpZyAyMQogICAgY29uY2F0CiAgICBkaWcgMjcKICAgIGNvbmNhdAogICAgZGlnIDI2CiAgICBjb25jYXQKICAgIGRpZyAyNQogICAgY29uY2F0CiAgICBjYWxsc3ViIHZhbGlkYXRlU2V0dGluZ3MKICAgIHBvcAoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxOTcKICAgIC8vIGFzc2VydCghdGhpcy5wbHVnaW5FeGlzdHMoeyBwbHVnaW4sIGNhbGxlciwgZXNjcm93IH0pLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgZGlnIDIyCiAgICBkaWcgMTYKICAgIGNvbmNhdAogICAgZGlnIDE1CiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWMgMzggLy8gMHgwMDJhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgY2FsbHN1YiBwbHVnaW5FeGlzdHMKICAgIHBvcAogICAgIQogICAgYXNzZXJ0IC8vIFBsdWdpbiBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE5OAogICAgLy8gYnJlYWs7CiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGROYW1lZFBsdWdpbjogewogICAgZGlnIDIzCiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMC0yMDQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KCF0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMQogICAgLy8gY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gYXNzZXJ0KCF0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0FMUkVBRFlfRVhJU1RTKQogICAgY2FsbHN1YiBuYW1lZFBsdWdpbkV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFBsdWdpbiBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA1CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgMTAgLy8gMHgxZQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMDUtMjEzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBwbHVnaW4sIGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbEV4ZWN1dGVQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgY29uc3QgcGx1Z2luSW5mbyA9IHRoaXMuZ2V0UGx1Z2luKHsgcGx1Z2luLCBjYWxsZXI6IEdsb2JhbC56ZXJvQWRkcmVzcywgZXNjcm93IH0pCiAgICAvLyAgIGFzc2VydChwbHVnaW5JbmZvLnN0YXJ0ICE9PSAwLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICBhc3NlcnQocGx1Z2luSW5mby51c2VFeGVjdXRpb25LZXksIEVSUl9OT1RfRVhFQ1VUQUJMRV9QTFVHSU4pCiAgICAvLyAgIGNvbnN0IGVwb2NoUmVmID0gcGx1Z2luSW5mby51c2VSb3VuZHMgPyBHbG9iYWwucm91bmQgOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICAvLyAgIGFzc2VydChwbHVnaW5JbmZvLmxhc3RWYWxpZCA+IGVwb2NoUmVmLCBFUlJfUExVR0lOX0VYUElSRUQpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA2CiAgICAvLyBjb25zdCB7IHBsdWdpbiwgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgc3dhcAogICAgZXh0cmFjdCAyIDgKICAgIGRpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAzCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIwNwogICAgLy8gY29uc3QgcGx1Z2luSW5mbyA9IHRoaXMuZ2V0UGx1Z2luKHsgcGx1Z2luLCBjYWxsZXI6IEdsb2JhbC56ZXJvQWRkcmVzcywgZXNjcm93IH0pCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjIDM4IC8vIDB4MDAyYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjMtMTI2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldFBsdWdpbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2tleV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjUKICAgIC8vIGFyZ3M6IFtba2V5XV0KICAgIGJ5dGVjIDM2IC8vIDB4MDAwMTAwMDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIzLTEyNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRQbHVnaW5zPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW1trZXldXQogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIGJ5dGVjIDUxIC8vIG1ldGhvZCAiYXJjNThfZ2V0UGx1Z2lucygodWludDY0LGFkZHJlc3Msc3RyaW5nKVtdKSh1aW50NjQsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQsdWludDY0KVtdLGJvb2wsYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0KVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2VydChwbHVnaW5JbmZvLnN0YXJ0ICE9PSAwLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgc3dhcAogICAgZXh0cmFjdCA2IDAKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMgogICAgbGVuCiAgICBkaWcgMwogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDIwCiAgICBkdXAKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjA5CiAgICAvLyBhc3NlcnQocGx1Z2luSW5mby51c2VFeGVjdXRpb25LZXksIEVSUl9OT1RfRVhFQ1VUQUJMRV9QTFVHSU4pCiAgICBkdXAKICAgIHB1c2hpbnQgMjE4IC8vIDIxOAogICAgZ2V0Yml0CiAgICBhc3NlcnQgLy8gUGx1Z2luIGlzIG5vdCBleGVjdXRhYmxlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBjb25zdCBlcG9jaFJlZiA9IHBsdWdpbkluZm8udXNlUm91bmRzID8gR2xvYmFsLnJvdW5kIDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgcHVzaGludCAyMTcgLy8gMjE3CiAgICBnZXRiaXQKICAgIGJ6IG5ld1Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTkKICAgIGdsb2JhbCBSb3VuZAoKbmV3UHJvcG9zYWxfdGVybmFyeV9tZXJnZUAyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTEKICAgIC8vIGFzc2VydChwbHVnaW5JbmZvLmxhc3RWYWxpZCA+IGVwb2NoUmVmLCBFUlJfUExVR0lOX0VYUElSRUQpCiAgICBkaWcgMTkKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBleHRyYWN0X3VpbnQ2NAogICAgPAogICAgYXNzZXJ0IC8vIFBsdWdpbiBoYXMgZXhwaXJlZAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxMgogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX3Rlcm5hcnlfZmFsc2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBjb25zdCBlcG9jaFJlZiA9IHBsdWdpbkluZm8udXNlUm91bmRzID8gR2xvYmFsLnJvdW5kIDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgYiBuZXdQcm9wb3NhbF90ZXJuYXJ5X21lcmdlQDIwCgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxNAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE0LTIxOAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICAvLyAgIGNvbnN0IHsgZXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgLy8gICBhc3NlcnQodGhpcy5leGVjdXRpb25FeGlzdHMoZXhlY3V0aW9uS2V5KSwgRVJSX0VYRUNVVElPTl9LRVlfTk9UX0ZPVU5EKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIxNQogICAgLy8gY29uc3QgeyBleGVjdXRpb25LZXkgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVFeGVjdXRlUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzAtMTczCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEV4ZWN1dGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2xlYXNlXV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE3MgogICAgLy8gYXJnczogW1tsZWFzZV1dCiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgcHVzaGJ5dGVzIDB4MDAwMQogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzAtMTczCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEV4ZWN1dGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2xlYXNlXV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBwdXNoYnl0ZXMgMHg0MWJkYzY4MCAvLyBtZXRob2QgImFyYzU4X2dldEV4ZWN1dGlvbnMoYnl0ZVszMl1bXSkoYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNzUKICAgIC8vIHJldHVybiBpbmZvLmdyb3Vwcy5sZW5ndGggPiAwCiAgICBzd2FwCiAgICBleHRyYWN0IDYgMAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBzd2FwCiAgICBpbnRjXzEgLy8gMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAyCiAgICBsZW4KICAgIGRpZyAzCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBhc3NlcnQodGhpcy5leGVjdXRpb25FeGlzdHMoZXhlY3V0aW9uS2V5KSwgRVJSX0VYRUNVVElPTl9LRVlfTk9UX0ZPVU5EKQogICAgYXNzZXJ0IC8vIEV4ZWN1dGlvbiBrZXkgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjE3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlUGx1Z2luOiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDggLy8gMHgyOAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMTktMjIzCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZVBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgY2FsbGVyLCBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9ET0VTX05PVF9FWElTVCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjAKICAgIC8vIGNvbnN0IHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMiA4CiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QgMTAgMzIKICAgIGRpZyAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyMQogICAgLy8gYXNzZXJ0KHRoaXMucGx1Z2luRXhpc3RzKHsgcGx1Z2luLCBjYWxsZXIsIGVzY3JvdyB9KSwgRVJSX1BMVUdJTl9ET0VTX05PVF9FWElTVCkKICAgIGNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyAzOCAvLyAweDAwMmEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBjYWxsc3ViIHBsdWdpbkV4aXN0cwogICAgcG9wCiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjIyCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDAgLy8gMHgyOQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjQtMjI4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZU5hbWVkUGx1Z2luOiB7CiAgICAvLyAgIGNvbnN0IHsgbmFtZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIGFzc2VydCh0aGlzLm5hbWVkUGx1Z2luRXhpc3RzKG5hbWUpLCBFUlJfUExVR0lOX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyNQogICAgLy8gY29uc3QgeyBuYW1lIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW4+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gYXNzZXJ0KHRoaXMubmFtZWRQbHVnaW5FeGlzdHMobmFtZSksIEVSUl9QTFVHSU5fRE9FU19OT1RfRVhJU1QpCiAgICBjYWxsc3ViIG5hbWVkUGx1Z2luRXhpc3RzCiAgICBhc3NlcnQgLy8gUGx1Z2luIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjI3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgZGlnIDIzCiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIyOS0yNDEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgLy8gICBjb25zdCB7IGVzY3JvdywgYWxsb3dhbmNlcyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbEFkZEFsbG93YW5jZXM+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KGFsbG93YW5jZXMubGVuZ3RoID4gMCwgRVJSX0FMTE9XQU5DRV9MSVNUX0VNUFRZKQogICAgLy8gCiAgICAvLyAgIGxldCBhc3NldHM6IHVpbnQ2NFtdID0gW10KICAgIC8vICAgZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFsbG93YW5jZXMubGVuZ3RoOyBpKyspIHsKICAgIC8vICAgICBhc3NldHMucHVzaChhbGxvd2FuY2VzW2ldLmFzc2V0KQogICAgLy8gICB9CiAgICAvLyAKICAgIC8vICAgY29uc3QgeyBhbnlFeGlzdCB9ID0gdGhpcy5hbGxvd2FuY2VDaGVjayhlc2Nyb3csIGFzc2V0cykKICAgIC8vICAgYXNzZXJ0KCFhbnlFeGlzdCwgRVJSX0FMTE9XQU5DRV9BTFJFQURZX0VYSVNUUykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGNvbnN0IHsgZXNjcm93LCBhbGxvd2FuY2VzIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkQWxsb3dhbmNlcz4oYWN0aW9uc1tpXS5kYXRhKQogICAgZGlnIDE5CiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGJ1cnkgMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgYnVyeSAxOQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzMQogICAgLy8gYXNzZXJ0KGFsbG93YW5jZXMubGVuZ3RoID4gMCwgRVJSX0FMTE9XQU5DRV9MSVNUX0VNUFRZKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIGFzc2VydCAvLyBBbGxvd2FuY2UgbGlzdCBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzMKICAgIC8vIGxldCBhc3NldHM6IHVpbnQ2NFtdID0gW10KICAgIGJ5dGVjIDQxIC8vIDB4MDAwMAogICAgYnVyeSAxNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzNAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFsbG93YW5jZXMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDgKCm5ld1Byb3Bvc2FsX3doaWxlX3RvcEAyOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhbGxvd2FuY2VzLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgNwogICAgZGlnIDEwCiAgICA8CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl93aGlsZUAzMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gYXNzZXRzLnB1c2goYWxsb3dhbmNlc1tpXS5hc3NldCkKICAgIGRpZyAxNwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyA4CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzQgLy8gMzQKICAgICoKICAgIHB1c2hpbnQgMzQgLy8gMzQKICAgIGV4dHJhY3QzIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZXh0cmFjdCAwIDgKICAgIGRpZyAxOAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyMzQKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhbGxvd2FuY2VzLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSA4CiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEAyOQoKbmV3UHJvcG9zYWxfYWZ0ZXJfd2hpbGVAMzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjM4CiAgICAvLyBjb25zdCB7IGFueUV4aXN0IH0gPSB0aGlzLmFsbG93YW5jZUNoZWNrKGVzY3JvdywgYXNzZXRzKQogICAgZGlnIDEzCiAgICBkaWcgMTcKICAgIGNhbGxzdWIgYWxsb3dhbmNlQ2hlY2sKICAgIHBvcAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjM5CiAgICAvLyBhc3NlcnQoIWFueUV4aXN0LCBFUlJfQUxMT1dBTkNFX0FMUkVBRFlfRVhJU1RTKQogICAgIQogICAgYXNzZXJ0IC8vIGFsbG93YW5jZSBhbHJlYWR5IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0MAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDIgLy8gMHgzYwogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDItMjQ4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KGFzc2V0cy5sZW5ndGggPiAwLCBFUlJfQUxMT1dBTkNFX0xJU1RfRU1QVFkpCiAgICAvLyAgIGNvbnN0IHsgYWxsRXhpc3QgfSA9IHRoaXMuYWxsb3dhbmNlQ2hlY2soZXNjcm93LCBhc3NldHMpCiAgICAvLyAgIGFzc2VydChhbGxFeGlzdCwgRVJSX0FMTE9XQU5DRV9ET0VTX05PVF9FWElTVCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMzQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIGNvbnN0IHsgZXNjcm93LCBhc3NldHMgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVBbGxvd2FuY2VzPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIGxlbgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gYXNzZXJ0KGFzc2V0cy5sZW5ndGggPiAwLCBFUlJfQUxMT1dBTkNFX0xJU1RfRU1QVFkpCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgYXNzZXJ0IC8vIEFsbG93YW5jZSBsaXN0IGNhbm5vdCBiZSBlbXB0eQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI0NQogICAgLy8gY29uc3QgeyBhbGxFeGlzdCB9ID0gdGhpcy5hbGxvd2FuY2VDaGVjayhlc2Nyb3csIGFzc2V0cykKICAgIGNhbGxzdWIgYWxsb3dhbmNlQ2hlY2sKICAgIHBvcAogICAgcHVzaGludCAxNyAvLyAxNwogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ2CiAgICAvLyBhc3NlcnQoYWxsRXhpc3QsIEVSUl9BTExPV0FOQ0VfRE9FU19OT1RfRVhJU1QpCiAgICBhc3NlcnQgLy8gYWxsb3dhbmNlIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ3CiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICBkaWcgMjMKICAgIGJ5dGVjIDQzIC8vIDB4NDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjQ5LTI1MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVOZXdFc2Nyb3c6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxOZXdFc2Nyb3c+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KCF0aGlzLmVzY3Jvd0V4aXN0cyhlc2Nyb3cpLCBFUlJfRVNDUk9XX0FMUkVBRFlfRVhJU1RTKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1MAogICAgLy8gY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxOZXdFc2Nyb3c+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTEKICAgIC8vIGFzc2VydCghdGhpcy5lc2Nyb3dFeGlzdHMoZXNjcm93KSwgRVJSX0VTQ1JPV19BTFJFQURZX0VYSVNUUykKICAgIGNhbGxzdWIgZXNjcm93RXhpc3RzCiAgICAhCiAgICBhc3NlcnQgLy8gRXNjcm93IGFscmVhZHkgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjUyCiAgICAvLyBicmVhawogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZGlnIDIzCiAgICBieXRlYyA0NCAvLyAweDQ3CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1NC0yNTgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgLy8gICBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGFjdGlvbnNbaV0uZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMuZXNjcm93RXhpc3RzKGVzY3JvdyksIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjU1CiAgICAvLyBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGFjdGlvbnNbaV0uZGF0YSkKICAgIGRpZyAxOQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTYKICAgIC8vIGFzc2VydCh0aGlzLmVzY3Jvd0V4aXN0cyhlc2Nyb3cpLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgY2FsbHN1YiBlc2Nyb3dFeGlzdHMKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTcKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI1OQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIGRpZyAyMwogICAgYnl0ZWMgNDUgLy8gMHg1MAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNTktMzQxCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZGF0ZUZpZWxkczogewogICAgLy8gICBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihhY3Rpb25zW2ldLmRhdGEpCiAgICAvLyAgIHN3aXRjaCAoZmllbGQpIHsKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQ29udGVudFBvbGljeTogewogICAgLy8gICAgICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gMzYsIEVSUl9JTlZBTElEX0NJRCkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0OiB7CiAgICAvLyAgICAgICBhc3NlcnQodmFsdWUubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9BQ1RJT05fTElNSVQpCiAgICAvLyAgICAgICBhc3NlcnQoYnRvaSh2YWx1ZSkgPiAwLCBFUlJfQUNUSU9OX0xJTUlUX01VU1RfQkVfR1JFQVRFUl9USEFOX1pFUk8pCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTWluUmV3YXJkc0ltcGFjdDogewogICAgLy8gICAgICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfTUlOSU1VTV9SRVdBUkRTX0lNUEFDVCkKICAgIC8vICAgICAgIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIC8vICAgICAgIGFzc2VydChidG9pKHZhbHVlKSA8PSAxMDAwLCBFUlJfTUlOX1JFV0FSRFNfSU1QQUNUX01VU1RfQkVfTEVTU19USEFOX09SX0VRVUFMX1RPXzEwMDBzKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdDogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdDogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxBa2l0YVNvY2lhbEFwcExpc3Q+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3Q6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8UGx1Z2luQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0OiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PE90aGVyQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0RmVlczogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFN0YWtpbmdGZWVzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdWJzY3JpcHRpb25GZWVzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PFN1YnNjcmlwdGlvbkZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05GVEZlZXM6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8TkZURmVlcz4odmFsdWUpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3dhcEZlZXM6IHsKICAgIC8vICAgICAgIGRlY29kZUFyYzQ8U3dhcEZlZXM+KHZhbHVlKQogICAgLy8gICAgICAgYnJlYWsKICAgIC8vICAgICB9CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICAvLyAgICAgICBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6CiAgICAvLyAgICAgY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOgogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzOgogICAgLy8gICAgIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgICAgICBjb25zdCBzZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgICAgICB0aGlzLnZhbGlkYXRlU2V0dGluZ3Moc2V0dGluZ3MpCiAgICAvLyAgICAgICBicmVhawogICAgLy8gICAgIH0KICAgIC8vICAgICBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgLy8gICAgICAgZGVjb2RlQXJjNDxTcGxpdFtdPih2YWx1ZSkKICAgIC8vICAgICAgIGJyZWFrCiAgICAvLyAgICAgfQogICAgLy8gICAgIGRlZmF1bHQ6IHsKICAgIC8vICAgICAgIGFzc2VydChmYWxzZSwgJ1Vua25vd24gZmllbGQgaW4gdXBkYXRlIGZpZWxkcyBwcm9wb3NhbCBhY3Rpb24nKQogICAgLy8gICAgIH0KICAgIC8vICAgfQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjYwCiAgICAvLyBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihhY3Rpb25zW2ldLmRhdGEpCiAgICBkaWcgMTkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI2MgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjYyLTI2NQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIC8vICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gMzYsIEVSUl9JTlZBTElEX0NJRCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjMKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDM2LCBFUlJfSU5WQUxJRF9DSUQpCiAgICBkaWcgMTEKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIENJRAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI2NAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjY2CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdDogewogICAgZGlnIDEyCiAgICBieXRlYyAxMyAvLyAicHJvcG9zYWxfYWN0aW9uX2xpbWl0IgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjYtMjcwCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdDogewogICAgLy8gICBhc3NlcnQodmFsdWUubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9BQ1RJT05fTElNSVQpCiAgICAvLyAgIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9BQ1RJT05fTElNSVRfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjcKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDgsIEVSUl9JTlZBTElEX1BST1BPU0FMX0FDVElPTl9MSU1JVCkKICAgIGRpZyAxMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uIGxpbWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjY4CiAgICAvLyBhc3NlcnQoYnRvaSh2YWx1ZSkgPiAwLCBFUlJfQUNUSU9OX0xJTUlUX01VU1RfQkVfR1JFQVRFUl9USEFOX1pFUk8pCiAgICBidG9pCiAgICBhc3NlcnQgLy8gQWN0aW9uIGxpbWl0IG11c3QgYmUgZ3JlYXRlciB0aGFuIHplcm8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNjkKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3MQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjcxLTI3NgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIC8vICAgYXNzZXJ0KHZhbHVlLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfTUlOSU1VTV9SRVdBUkRTX0lNUEFDVCkKICAgIC8vICAgYXNzZXJ0KGJ0b2kodmFsdWUpID4gMCwgRVJSX01JTl9SRVdBUkRTX0lNUEFDVF9NVVNUX0JFX0dSRUFURVJfVEhBTl9aRVJPKQogICAgLy8gICBhc3NlcnQoYnRvaSh2YWx1ZSkgPD0gMTAwMCwgRVJSX01JTl9SRVdBUkRTX0lNUEFDVF9NVVNUX0JFX0xFU1NfVEhBTl9PUl9FUVVBTF9UT18xMDAwcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGFzc2VydCh2YWx1ZS5sZW5ndGggPT09IDgsIEVSUl9JTlZBTElEX01JTklNVU1fUkVXQVJEU19JTVBBQ1QpCiAgICBkaWcgMTEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1pbmltdW0gcmV3YXJkcyBpbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzMKICAgIC8vIGFzc2VydChidG9pKHZhbHVlKSA+IDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9HUkVBVEVSX1RIQU5fWkVSTykKICAgIGJ0b2kKICAgIGR1cAogICAgYXNzZXJ0IC8vIE1pbmltdW0gcmV3YXJkcyBpbXBhY3QgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3NAogICAgLy8gYXNzZXJ0KGJ0b2kodmFsdWUpIDw9IDEwMDAsIEVSUl9NSU5fUkVXQVJEU19JTVBBQ1RfTVVTVF9CRV9MRVNTX1RIQU5fT1JfRVFVQUxfVE9fMTAwMHMpCiAgICBpbnRjIDUgLy8gMTAwMAogICAgPD0KICAgIGFzc2VydCAvLyBNaW5pbXVtIHJld2FyZHMgaW1wYWN0IG11c3QgYmUgbGVzcyB0aGFuIG9yIGVxdWFsIHRvIDEwMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyNzUKICAgIC8vIGJyZWFrCiAgICBiIG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CgpuZXdQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQ1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdDogewogICAgZGlnIDEyCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI3Ny0yODAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0OiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDI2IC8vICJzYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI4MS0yODQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3Q6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxBa2l0YVNvY2lhbEFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg1CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgZGlnIDEyCiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyODUtMjg4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgLy8gICBkZWNvZGVBcmM0PFBsdWdpbkFwcExpc3Q+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg5CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0OiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDUgLy8gIm9hbCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mjg5LTI5MgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdDogewogICAgLy8gICBkZWNvZGVBcmM0PE90aGVyQXBwTGlzdD4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBibnogbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyOTMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDI3IC8vICJ3YWxsZXRfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjkzLTI5NgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldEZlZXM6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjI5NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXM6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjggLy8gInNvY2lhbF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoyOTctMzAwCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlczogewogICAgLy8gICBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIGRpZyAxMgogICAgYnl0ZWMgMjkgLy8gInN0YWtpbmdfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzAxLTMwNAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3Rha2luZ0ZlZXM+KHZhbHVlKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYm56IG5ld1Byb3Bvc2FsX3doaWxlX3RvcEA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzA1CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlczogewogICAgZGlnIDEyCiAgICBieXRlYyAzMCAvLyAic3Vic2NyaXB0aW9uX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwNS0zMDgKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTdWJzY3JpcHRpb25GZWVzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3Vic2NyaXB0aW9uRmVlcz4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBibnogbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDMxIC8vICJuZnRfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzA5LTMxMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05GVEZlZXM6IHsKICAgIC8vICAgZGVjb2RlQXJjNDxORlRGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxMwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDMyIC8vICJzd2FwX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxMy0zMTYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTd2FwRmVlczogewogICAgLy8gICBkZWNvZGVBcmM0PFN3YXBGZWVzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDExIC8vICJha2l0YV9hc3NldHMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMxNy0zMjAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0czogewogICAgLy8gICBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJueiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyMQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAxNSAvLyAidXBncmFkZV9hcHBfcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIGRpZyAxMgogICAgYnl0ZWMgMTYgLy8gImFkZF9wbHVnaW5fcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzIzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczoKICAgIGRpZyAxMgogICAgYnl0ZWMgMTcgLy8gInJlbW92ZV9wbHVnaW5fcHMiCiAgICA9PQogICAgYm56IG5ld1Byb3Bvc2FsX2Jsb2NrQDYzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzI0CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6CiAgICBkaWcgMTIKICAgIGJ5dGVjIDE4IC8vICJhZGRfYWxsb3dhbmNlX3BzIgogICAgPT0KICAgIGJueiBuZXdQcm9wb3NhbF9ibG9ja0A2MwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyNQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAxOSAvLyAicmVtb3ZlX2FsbG93YW5jZV9wcyIKICAgID09CiAgICBibnogbmV3UHJvcG9zYWxfYmxvY2tANjMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzOgogICAgZGlnIDEyCiAgICBieXRlYyAyMCAvLyAibmV3X2VzY3Jvd19wcyIKICAgID09CiAgICBibnogbmV3UHJvcG9zYWxfYmxvY2tANjMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjcKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICBkaWcgMTIKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozMjctMzMxCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBzZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMudmFsaWRhdGVTZXR0aW5ncyhzZXR0aW5ncykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IG5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANjQKCm5ld1Byb3Bvc2FsX2Jsb2NrQDYzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMyOQogICAgLy8gdGhpcy52YWxpZGF0ZVNldHRpbmdzKHNldHRpbmdzKQogICAgZGlnIDExCiAgICBjYWxsc3ViIHZhbGlkYXRlU2V0dGluZ3MKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMzMAogICAgLy8gYnJlYWsKICAgIGIgbmV3UHJvcG9zYWxfd2hpbGVfdG9wQDcKCm5ld1Byb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANjQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzMyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgZGlnIDEyCiAgICBieXRlYyAzMyAvLyAicmV2ZW51ZV9zcGxpdHMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMzMi0zMzUKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZXZlbnVlU3BsaXRzOiB7CiAgICAvLyAgIGRlY29kZUFyYzQ8U3BsaXRbXT4odmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBhc3NlcnQgLy8gVW5rbm93biBmaWVsZCBpbiB1cGRhdGUgZmllbGRzIHByb3Bvc2FsIGFjdGlvbgogICAgYiBuZXdQcm9wb3NhbF93aGlsZV90b3BANwoKbmV3UHJvcG9zYWxfYWZ0ZXJfd2hpbGVANjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTkwCiAgICAvLyByZXR1cm4gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKDAsIGNpZCwgYWN0aW9ucywgb3JpZ2luLCBmZWUsIHBvd2VyKQogICAgaW50Y18wIC8vIDAKICAgIGRpZyA2CiAgICBkaWcgNQogICAgZGlnIDcKICAgIGRpZyA0CiAgICBkaWcgNgogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NjcKICAgIC8vIG5ld1Byb3Bvc2FsKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB1aW50NjQgewogICAgaXRvYgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZWRpdFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5MwogICAgLy8gZWRpdFByb3Bvc2FsKGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NAogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQoaWQpLCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURSkKICAgIGRpZyAyCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBmZWVzUGFpZCB9ID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlCiAgICBkaWcgMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBmZWVzUGFpZCB9ID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlCiAgICBwdXNoaW50IDEwOSAvLyAxMDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5NwogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldE9yaWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk5OAogICAgLy8gY29uc3QgeyB0b3RhbCwgcG93ZXIgfSA9IHRoaXMucHJvcG9zYWxDb3N0KGFjdGlvbnMpCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDAwCiAgICAvLyBhc3NlcnQodG90YWwgPD0gZmVlc1BhaWQsIEVSUl9QQVlNRU5UX1JFUVVJUkVEKQogICAgZGlnIDEKICAgIHVuY292ZXIgNQogICAgPD0KICAgIGFzc2VydCAvLyBQYXltZW50IHJlcXVpcmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwMgogICAgLy8gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKGlkLCBjaWQsIGFjdGlvbnMsIG9yaWdpbiwgdG90YWwsIHBvd2VyKQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNQogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3BuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5OTMKICAgIC8vIGVkaXRQcm9wb3NhbChpZDogdWludDY0LCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZWRpdFByb3Bvc2FsV2l0aFBheW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgplZGl0UHJvcG9zYWxXaXRoUGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA1CiAgICAvLyBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudChwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwNgogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQoaWQpLCBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURSkKICAgIGRpZyAyCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMDgKICAgIC8vIGNvbnN0IHsgZmVlc1BhaWQgfSA9IHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZQogICAgZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MwogICAgLy8gcHJvcG9zYWxzID0gQm94TWFwPHVpbnQ2NCwgUHJvcG9zYWxEZXRhaWxzPih7IGtleVByZWZpeDogQWtpdGFEQU9Cb3hQcmVmaXhQcm9wb3NhbHMgfSkKICAgIGJ5dGVjIDQgLy8gImwiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA4CiAgICAvLyBjb25zdCB7IGZlZXNQYWlkIH0gPSB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUKICAgIHB1c2hpbnQgMTA5IC8vIDEwOQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRPcmlnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDEwCiAgICAvLyBjb25zdCB7IHRvdGFsLCBwb3dlciB9ID0gdGhpcy5wcm9wb3NhbENvc3QoYWN0aW9ucykKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdAogICAgZGlnIDEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTIKICAgIC8vIGFzc2VydCh0b3RhbCA+IGZlZXNQYWlkLCBFUlJfUEFZTUVOVF9OT1RfUkVRVUlSRUQpCiAgICBkaWcgMQogICAgZGlnIDUKICAgID4KICAgIGFzc2VydCAvLyBQYXltZW50IG5vdCByZXF1aXJlZAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTQtMTAyMQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGRpZyA3CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMTcKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxNC0xMDIxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRvdGFsIC0gZmVlc1BhaWQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHVuY292ZXIgOAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxOAogICAgLy8gYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkCiAgICBkaWcgMwogICAgdW5jb3ZlciA3CiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAxNC0xMDIxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRvdGFsIC0gZmVlc1BhaWQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAyMwogICAgLy8gdGhpcy5jcmVhdGVPclVwZGF0ZVByb3Bvc2FsKGlkLCBjaWQsIGFjdGlvbnMsIG9yaWdpbiwgdG90YWwsIHBvd2VyKQogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgNQogICAgY2FsbHN1YiBjcmVhdGVPclVwZGF0ZVByb3Bvc2FsCiAgICBwb3BuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDA1CiAgICAvLyBlZGl0UHJvcG9zYWxXaXRoUGF5bWVudChwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGlkOiB1aW50NjQsIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5kZWxldGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlbGV0ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMjYKICAgIC8vIGRlbGV0ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI3CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI3CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMjkKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBjcmVhdG9yIH0gPSB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgcHVzaGludHMgNjEgMzIgLy8gNjEsIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzEKICAgIC8vIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzEtMTAzMgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0IHx8CiAgICAvLyBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBibnogZGVsZXRlUHJvcG9zYWxfYm9vbF90cnVlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDMyCiAgICAvLyBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBkaWcgMQogICAgYnl0ZWMgMTIgLy8gMHgzMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDMxLTEwMzIKICAgIC8vIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgYnogZGVsZXRlUHJvcG9zYWxfYm9vbF9mYWxzZUA0CgpkZWxldGVQcm9wb3NhbF9ib29sX3RydWVAMzoKICAgIGludGNfMSAvLyAxCgpkZWxldGVQcm9wb3NhbF9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzMC0xMDM0CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNEcmFmdCB8fAogICAgLy8gICBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICAvLyAgIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFCiAgICAvLyApCiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzYKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMzYKICAgIC8vIGNvbnN0IG9yaWdpbiA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0T3JpZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzNwogICAgLy8gYXNzZXJ0KG9yaWdpbiA9PT0gY3JlYXRvciwgRVJSX0lOQ09SUkVDVF9TRU5ERVIpCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBJbmNvcnJlY3Qgc2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTAzOQogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZGVsZXRlKCkKICAgIGRpZyAyCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDI2CiAgICAvLyBkZWxldGVQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRlbGV0ZVByb3Bvc2FsX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGRlbGV0ZVByb3Bvc2FsX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc3VibWl0UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpzdWJtaXRQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyBzdWJtaXRQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA0MwogICAgLy8gYXNzZXJ0KHRoaXMudmFsaWRFZGl0T3JTdWJtaXQocHJvcG9zYWxJRCksIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFKQogICAgZHVwCiAgICBjYWxsc3ViIHZhbGlkRWRpdE9yU3VibWl0CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNDUKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnZvdGluZ1RzID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgc3dhcAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNDUKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnZvdGluZ1RzID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIHB1c2hpbnQgOTMgLy8gOTMKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQ2CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPSBQcm9wb3NhbFN0YXR1c1ZvdGluZwogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyBzdWJtaXRQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnZvdGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CnZvdGVQcm9wb3NhbDoKICAgIGludGNfMCAvLyAwCiAgICBieXRlY18zIC8vICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDQ5CiAgICAvLyB2b3RlUHJvcG9zYWwobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBwcm9wb3NhbElEOiB1aW50NjQsIHZvdGU6IFByb3Bvc2FsVm90ZVR5cGUpOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgY292ZXIgMwogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUwCiAgICAvLyBhc3NlcnQodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVEcmFmdCwgRVJSX0RBT19OT1RfSU5JVElBTElaRUQpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUwCiAgICAvLyBhc3NlcnQodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVEcmFmdCwgRVJSX0RBT19OT1RfSU5JVElBTElaRUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICAhPQogICAgYXNzZXJ0IC8vIERBTyBpcyBub3QgaW5pdGlhbGl6ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUxCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBjb3ZlciAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA1MQogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNTMtMTA2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBEQU9Qcm9wb3NhbFZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDU2CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNTMtMTA2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBEQU9Qcm9wb3NhbFZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgdW5jb3ZlciAzCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDU3CiAgICAvLyBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIsCiAgICBwdXNoaW50IDIyNTAwIC8vIDIyNTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA1My0xMDYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjIKICAgIC8vIGNvbnN0IHsgc3RhdHVzIH0gPSB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA2MwogICAgLy8gYXNzZXJ0KHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNWb3RpbmcsIEVSUl9JTlZBTElEX1BST1BPU0FMX1NUQVRFKQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgcHJvcG9zYWwgc3RhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDY1CiAgICAvLyBjb25zdCB2b3RlciA9IGdldE9yaWdpbih0aGlzLm90aGVyQXBwTGlzdC52YWx1ZS5lc2Nyb3csIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBvdGhlckFwcExpc3QgPSBHbG9iYWxTdGF0ZTxPdGhlckFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCB9KQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjUKICAgIC8vIGNvbnN0IHZvdGVyID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRPcmlnaW4KICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjYKICAgIC8vIGNvbnN0IHByb3Bvc2FsID0gY2xvbmUodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUpCiAgICBzd2FwCiAgICBib3hfZ2V0CiAgICBzd2FwCiAgICBjb3ZlciAzCiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGlmICh0aGlzLnByb3Bvc2FsVm90ZXMoeyBwcm9wb3NhbElELCB2b3RlciB9KS5leGlzdHMpIHsKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1CiAgICAvLyBwcm9wb3NhbFZvdGVzID0gQm94TWFwPFByb3Bvc2FsVm90ZUtleSwgUHJvcG9zYWxWb3RlSW5mbz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDY4CiAgICAvLyBpZiAodGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXIgfSkuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA2OQogICAgLy8gY29uc3QgeyB0eXBlLCBwb3dlcjogcHJldmlvdXNQb3dlciB9ID0gdGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXIgfSkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCAwIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxMAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBidXJ5IDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDcyCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBcHByb3ZlOiB7CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzItMTA3NQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQXBwcm92ZTogewogICAgLy8gICBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgLT0gcHJldmlvdXNQb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDczCiAgICAvLyBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgLT0gcHJldmlvdXNQb3dlcgogICAgZGlnIDMKICAgIHB1c2hpbnRzIDM3IDI0IC8vIDM3LCAyNAogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDYKICAgIC0KICAgIGl0b2IKICAgIGRpZyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMzcgMjQKICAgIHN3YXAKICAgIHJlcGxhY2UyIDAKICAgIHJlcGxhY2UyIDM3CiAgICBidXJ5IDIKCnZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTAKICAgIC8vIGNvbnN0IHBvd2VyID0gdGhpcy5nZXRHb3Zlcm5hbmNlUG93ZXIodm90ZXIpCiAgICBkaWcgMgogICAgY2FsbHN1YiBnZXRHb3Zlcm5hbmNlUG93ZXIKICAgIGR1cAogICAgYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA5MwogICAgLy8gYXNzZXJ0KHBvd2VyID4gMCwgRVJSX0ZPUkJJRERFTikKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTYKICAgIC8vIGNhc2UgUHJvcG9zYWxWb3RlVHlwZUFwcHJvdmU6IHsKICAgIGRpZyA0CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTYtMTA5OQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQXBwcm92ZTogewogICAgLy8gICBwcm9wb3NhbC52b3Rlcy5hcHByb3ZhbHMgKz0gcG93ZXIKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBieiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwOTcKICAgIC8vIHByb3Bvc2FsLnZvdGVzLmFwcHJvdmFscyArPSBwb3dlcgogICAgZGlnIDEKICAgIGR1cAogICAgZXh0cmFjdCAzNyAyNAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiAwCiAgICByZXBsYWNlMiAzNwogICAgYnVyeSAyCgp2b3RlUHJvcG9zYWxfYmxvY2tAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTExNAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUgPSBjbG9uZShwcm9wb3NhbCkKICAgIGRpZyAzCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgZGlnIDIKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE1CiAgICAvLyB0aGlzLnByb3Bvc2FsVm90ZXMoeyBwcm9wb3NhbElELCB2b3RlciB9KS52YWx1ZSA9IHsgdHlwZTogdm90ZSwgcG93ZXIgfQogICAgZGlnIDYKICAgIGl0b2IKICAgIGRpZyA1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA0OQogICAgLy8gdm90ZVByb3Bvc2FsKG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgcHJvcG9zYWxJRDogdWludDY0LCB2b3RlOiBQcm9wb3NhbFZvdGVUeXBlKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgp2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTAwCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVSZWplY3Q6IHsKICAgIGRpZyA0CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMC0xMTAzCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVSZWplY3Q6IHsKICAgIC8vICAgcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyArPSBwb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMQogICAgLy8gcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyArPSBwb3dlcgogICAgZGlnIDEKICAgIGR1cAogICAgZXh0cmFjdCAzNyAyNAogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiA4CiAgICByZXBsYWNlMiAzNwogICAgYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwMgogICAgLy8gYnJlYWs7CiAgICBiIHZvdGVQcm9wb3NhbF9ibG9ja0AzMwoKdm90ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNAogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQWJzdGFpbjogewogICAgZGlnIDQKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNC0xMTA3CiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBYnN0YWluOiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLmFic3RhaW5zICs9IHBvd2VyCiAgICAvLyAgIGJyZWFrOwogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwNQogICAgLy8gcHJvcG9zYWwudm90ZXMuYWJzdGFpbnMgKz0gcG93ZXIKICAgIGRpZyAxCiAgICBkdXAKICAgIGV4dHJhY3QgMzcgMjQKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICArCiAgICBpdG9iCiAgICByZXBsYWNlMiAxNgogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYmxvY2tAMzMKCnZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA3NgogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlUmVqZWN0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzYtMTA3OQogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlUmVqZWN0OiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLnJlamVjdGlvbnMgLT0gcHJldmlvdXNQb3dlcgogICAgLy8gICBicmVhazsKICAgIC8vIH0KICAgIGJ6IHZvdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA3NwogICAgLy8gcHJvcG9zYWwudm90ZXMucmVqZWN0aW9ucyAtPSBwcmV2aW91c1Bvd2VyCiAgICBkaWcgMwogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNgogICAgLQogICAgaXRvYgogICAgZGlnIDIKICAgIGR1cAogICAgY292ZXIgMgogICAgZXh0cmFjdCAzNyAyNAogICAgc3dhcAogICAgcmVwbGFjZTIgOAogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNzgKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxOAoKdm90ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA4MAogICAgLy8gY2FzZSBQcm9wb3NhbFZvdGVUeXBlQWJzdGFpbjogewogICAgZGlnIDcKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTA4MC0xMDgzCiAgICAvLyBjYXNlIFByb3Bvc2FsVm90ZVR5cGVBYnN0YWluOiB7CiAgICAvLyAgIHByb3Bvc2FsLnZvdGVzLmFic3RhaW5zIC09IHByZXZpb3VzUG93ZXIKICAgIC8vICAgYnJlYWs7CiAgICAvLyB9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwcm9wb3NhbCBhY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDgxCiAgICAvLyBwcm9wb3NhbC52b3Rlcy5hYnN0YWlucyAtPSBwcmV2aW91c1Bvd2VyCiAgICBkaWcgMwogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA2CiAgICAtCiAgICBpdG9iCiAgICBkaWcgMgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBleHRyYWN0IDM3IDI0CiAgICBzd2FwCiAgICByZXBsYWNlMiAxNgogICAgcmVwbGFjZTIgMzcKICAgIGJ1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwODIKICAgIC8vIGJyZWFrOwogICAgYiB2b3RlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZmluYWxpemVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmZpbmFsaXplUHJvcG9zYWw6CiAgICBieXRlY18zIC8vICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE4CiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE5CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE5CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjEKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBjcmVhdG9yLCB2b3RlczogeyBhcHByb3ZhbHMsIHJlamVjdGlvbnMsIGFic3RhaW5zIH0sIHZvdGluZ1RzLCBhY3Rpb25zIH0gPSBjbG9uZSh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZSkKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGRpZyAxCiAgICBwdXNoaW50cyA2MSAzMiAvLyA2MSwgMzIKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMgogICAgcHVzaGludHMgMzcgMjQgLy8gMzcsIDI0CiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGNvdmVyIDUKICAgIGR1cAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBjb3ZlciA1CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgNAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDkzIC8vIDkzCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGNvdmVyIDMKICAgIGRpZyAyCiAgICBwdXNoaW50IDExNyAvLyAxMTcKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDQKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTIzCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gY3JlYXRvciwgRVJSX0lOQ09SUkVDVF9TRU5ERVIpCiAgICB0eG4gU2VuZGVyCiAgICA9PQogICAgYXNzZXJ0IC8vIEluY29ycmVjdCBzZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTI0CiAgICAvLyBhc3NlcnQoc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c1ZvdGluZywgRVJSX0lOVkFMSURfUFJPUE9TQUxfU1RBVEUpCiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjcKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjcKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMjgKICAgIC8vIGxldCBsb2NrZWQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEyOQogICAgLy8gaWYgKGFrdGEgPiAwICYmIGJvbmVzID4gMCkgewogICAgYnogZmluYWxpemVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDUKICAgIGRpZyAxCiAgICBieiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzAtMTEzNQogICAgLy8gY29uc3QgdG90YWxzID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0VG90YWxzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgW2FrdGEsIGJvbmVzXQogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzEKICAgIC8vIGFwcElkOiB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTMxCiAgICAvLyBhcHBJZDogdGhpcy5ha2l0YUFwcExpc3QudmFsdWUuc3Rha2luZywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTMzCiAgICAvLyBbYWt0YSwgYm9uZXNdCiAgICBkaWcgMwogICAgaXRvYgogICAgcHVzaGJ5dGVzIDB4MDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMwogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEzMC0xMTM1CiAgICAvLyBjb25zdCB0b3RhbHMgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRUb3RhbHM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlLnN0YWtpbmcsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBbYWt0YSwgYm9uZXNdCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHg2YmU4Y2VlNiAvLyBtZXRob2QgImdldFRvdGFscyh1aW50NjRbXSkodWludDY0LHVpbnQ2NClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAxNiAvLyAxNgogICAgKgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LHVpbnQ2NClbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTM3CiAgICAvLyBjb25zdCBsb2NrZWRBa3RhID0gdG90YWxzWzBdLmxvY2tlZAogICAgZHVwCiAgICBleHRyYWN0IDYgMTYKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMzgKICAgIC8vIGNvbnN0IGxvY2tlZEJvbmVzID0gdG90YWxzWzFdLmxvY2tlZAogICAgc3dhcAogICAgZXh0cmFjdCAyMiAxNgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEzOQogICAgLy8gbG9ja2VkID0gbG9ja2VkQWt0YSA8IGxvY2tlZEJvbmVzID8gbG9ja2VkQWt0YSA6IGxvY2tlZEJvbmVzCiAgICBkdXAyCiAgICA8CiAgICBzd2FwCiAgICBjb3ZlciAyCiAgICBzZWxlY3QKICAgIGJ1cnkgMQoKZmluYWxpemVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE0MgogICAgLy8gY29uc3QgdG90YWxWb3RlczogdWludDY0ID0gYXBwcm92YWxzICsgcmVqZWN0aW9ucyArIGFic3RhaW5zCiAgICBkaWcgNwogICAgZGlnIDcKICAgIGR1cAogICAgY292ZXIgMgogICAgKwogICAgZHVwCiAgICBidXJ5IDEzCiAgICBkaWcgNwogICAgKwogICAgYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNDYKICAgIC8vIGlmIChyZWplY3Rpb25zID4gMCkgewogICAgYnogZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDkKICAgIC8vIGFzc2VydChhIDwgYiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRV9PRl9BUkdTKQogICAgZGlnIDcKICAgIGR1cAogICAgZGlnIDEyCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIDwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2Ugb2YgYXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgRElWSVNPUiksIGIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBtdWx3CiAgICB1bmNvdmVyIDIKICAgIGRpdncKCmZpbmFsaXplUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNTIKICAgIC8vIGNvbnN0IHsgZHVyYXRpb24sIHBhcnRpY2lwYXRpb24sIGFwcHJvdmFsIH0gPSB0aGlzLnByb3Bvc2FsQ29zdChhY3Rpb25zKQogICAgZGlnIDQKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3QKICAgIHBvcAogICAgZHVwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1NAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiAodm90aW5nVHMgKyBkdXJhdGlvbiksIEVSUl9WT1RJTkdfRFVSQVRJT05fTk9UX01FVCkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyA5CiAgICB1bmNvdmVyIDQKICAgICsKICAgID4KICAgIGFzc2VydCAvLyBWb3RpbmcgZHVyYXRpb24gbm90IG1ldAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkaWcgMQogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNTYKICAgIC8vIGFzc2VydCh0b3RhbFZvdGVzID49IHBhcnRpY2lwYXRpb25UaHJlc2hvbGQsIEVSUl9WT1RJTkdfUEFSVElDSVBBVElPTl9OT1RfTUVUKQogICAgZGlnIDEyCiAgICA8PQogICAgYXNzZXJ0IC8vIFZvdGluZyBwYXJ0aWNpcGF0aW9uIG5vdCBtZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTU4CiAgICAvLyBpZiAoYXBwcm92YWxQZXJjZW50YWdlID49IGFwcHJvdmFsKSB7CiAgICA+PQogICAgYnogZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlAMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTU5CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPSBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkCiAgICBkaWcgOAogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjIDggLy8gMHgyOAogICAgYm94X3JlcGxhY2UKCmZpbmFsaXplUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTE4CiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZmluYWxpemVQcm9wb3NhbF9lbHNlX2JvZHlAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE2MQogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZAogICAgZGlnIDgKICAgIGludGNfMCAvLyAwCiAgICBieXRlYyAxMCAvLyAweDFlCiAgICBib3hfcmVwbGFjZQogICAgYiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTEKCmZpbmFsaXplUHJvcG9zYWxfZWxzZV9ib2R5QDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE0OQogICAgLy8gYXBwcm92YWxQZXJjZW50YWdlID0gT05FX0hVTkRSRURfUEVSQ0VOVAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgYiBmaW5hbGl6ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZXhlY3V0ZVByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZXhlY3V0ZVByb3Bvc2FsOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gNAogICAgYnl0ZWNfMyAvLyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNjUKICAgIC8vIGV4ZWN1dGVQcm9wb3NhbChwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE2NgogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgaXRvYgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTY2CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNjgKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBhY3Rpb25zIH0gPSBjbG9uZSh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZSkKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3MAogICAgLy8gYXNzZXJ0KHN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCwgRVJSX0lOVkFMSURfUFJPUE9TQUxfU1RBVEUpCiAgICBieXRlYyA4IC8vIDB4MjgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBwcm9wb3NhbCBzdGF0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAoKZXhlY3V0ZVByb3Bvc2FsX3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhY3Rpb25zLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgMgogICAgcHVzaGludCAxMTkgLy8gMTE5CiAgICBpbnRjXzMgLy8gMgogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyAxCiAgICA+CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfd2hpbGVANTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBjb25zdCB7IHR5cGUsIGRhdGEgfSA9IGFjdGlvbnNbaV0KICAgIGRpZyAyCiAgICBkdXAKICAgIHB1c2hpbnQgMTE5IC8vIDExOQogICAgaW50Y18zIC8vIDIKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIDwKICAgIGFzc2VydCAvLyBpbmRleCBvdXQgb2YgYm91bmRzCiAgICBpbnRjXzMgLy8gMgogICAgZGlnIDEKICAgICoKICAgIHB1c2hpbnQgMTIxIC8vIDEyMQogICAgKwogICAgZGlnIDIKICAgIHN3YXAKICAgIGludGNfMyAvLyAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgcHVzaGludCAxMjEgLy8gMTIxCiAgICArCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDkKICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTE3IC8vIDExNwogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgZXh0cmFjdCAyIDAKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgMgogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgYnVyeSAxMAogICAgdW5jb3ZlciAyCiAgICBkaWcgMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyA0CiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGJ1cnkgMTIKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOgogICAgYnl0ZWMgMjIgLy8gMHgwYQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc3LTExODIKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVXBncmFkZUFwcDoKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsVXBncmFkZUFwcD4oZGF0YSkKICAgIC8vICAgLy8gdHJhY2sgdGhlIHByb3Bvc2FsIHRoYXQgY3JlYXRlZCB0aGUgZXhlY3V0aW9uIGJ5IGxlYXNlIHNvIHRoZXkgY2FuIGJlIHZlcmlmaWVkIHdpdGhvdXQgZXhwbGljaXQgcHJvcG9zYWwgaWQgYmVpbmcgcHJvdmlkZWQKICAgIC8vICAgdGhpcy5leGVjdXRpb25zKGV4ZWN1dGlvbktleSkudmFsdWUgPSB7IHByb3Bvc2FsSUQsIGluZGV4OiBpIH0KICAgIC8vICAgdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIC8vICAgYnJlYWsKICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBjb25zdCB7IGV4ZWN1dGlvbktleSwgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWQgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGdyYWRlQXBwPihkYXRhKQogICAgZGlnIDkKICAgIGR1cAogICAgZXh0cmFjdCAxMCAzMgogICAgZGlnIDEwCiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkaWcgMgogICAgcHVzaGludCA0NCAvLyA0NAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMwogICAgcHVzaGludCA1MiAvLyA1MgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTgwCiAgICAvLyB0aGlzLmV4ZWN1dGlvbnMoZXhlY3V0aW9uS2V5KS52YWx1ZSA9IHsgcHJvcG9zYWxJRCwgaW5kZXg6IGkgfQogICAgZGlnIDQKICAgIGl0b2IKICAgIGRpZyA4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5NwogICAgLy8gZXhlY3V0aW9ucyA9IEJveE1hcDxieXRlczwzMj4sIEV4ZWN1dGlvbk1ldGFkYXRhPih7IGtleVByZWZpeDogQWJzdHJhY3RBY2NvdW50Qm94UHJlZml4RXhlY3V0aW9ucyB9KQogICAgcHVzaGJ5dGVzICJ4IgogICAgZGlnIDUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODAKICAgIC8vIHRoaXMuZXhlY3V0aW9ucyhleGVjdXRpb25LZXkpLnZhbHVlID0geyBwcm9wb3NhbElELCBpbmRleDogaSB9CiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4MQogICAgLy8gdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIGNhbGxzdWIgbmV3RXhlY3V0aW9uCiAgICBwb3AKCmV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MToKICAgIGRpZyA0CiAgICBidXJ5IDEKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX3doaWxlX3RvcEAyCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlRXhlY3V0ZVBsdWdpbjogewogICAgZGlnIDYKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4My0xMTg3CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIC8vICAgdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4NAogICAgLy8gY29uc3QgeyBleGVjdXRpb25LZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIGRpZyA5CiAgICBkdXAKICAgIGV4dHJhY3QgMTIgMzIKICAgIGRpZyAxMAogICAgZHVwCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZGlnIDIKICAgIHB1c2hpbnQgNDYgLy8gNDYKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDMKICAgIHB1c2hpbnQgNTQgLy8gNTQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4NQogICAgLy8gdGhpcy5uZXdFeGVjdXRpb24oZXhlY3V0aW9uS2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZCkKICAgIGNhbGxzdWIgbmV3RXhlY3V0aW9uCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTg2CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlRXhlY3V0ZVBsdWdpbjogewogICAgZGlnIDYKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE4OC0xMTkyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUV4ZWN1dGVQbHVnaW46IHsKICAgIC8vICAgY29uc3QgeyBleGVjdXRpb25LZXkgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxSZW1vdmVFeGVjdXRlUGx1Z2luPihkYXRhKQogICAgLy8gICB0aGlzLnJlbW92ZUV4ZWN1dGlvbihleGVjdXRpb25LZXkpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExODkKICAgIC8vIGNvbnN0IHsgZXhlY3V0aW9uS2V5IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIGRpZyA5CiAgICBleHRyYWN0IDIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDktNzUyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlRXhlY3V0aW9uS2V5Pih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2tleV0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzUwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzUwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc1MQogICAgLy8gYXJnczogW2tleV0KICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQ5LTc1MgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZUV4ZWN1dGlvbktleT4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtrZXldCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZDU4Njg1YWYgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVFeGVjdXRpb25LZXkoYnl0ZVszMl0pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE5MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGRQbHVnaW46IHsKICAgIGRpZyA2CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE5My0xMTk2CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjogewogICAgLy8gICB0aGlzLmFkZFBsdWdpbih7IG5hbWU6ICcnLCAuLi5kZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihkYXRhKSB9KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk0CiAgICAvLyB0aGlzLmFkZFBsdWdpbih7IG5hbWU6ICcnLCAuLi5kZWNvZGVBcmM0PFByb3Bvc2FsQWRkUGx1Z2luPihkYXRhKSB9KQogICAgZGlnIDkKICAgIGR1cAogICAgZXh0cmFjdCAyIDgKICAgIGRpZyAxCiAgICBleHRyYWN0IDEwIDMyCiAgICBkaWcgMTEKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgNQogICAgZXh0cmFjdCA0NCAxCiAgICBkaWcgNgogICAgZXh0cmFjdCA0NSA4CiAgICBkaWcgNwogICAgZXh0cmFjdCA1MyA4CiAgICBkaWcgNQogICAgcHVzaGludCAxMDIgLy8gMTAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDYKICAgIHVuY292ZXIgNgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGRpZyA2CiAgICBwdXNoaW50IDQ4OCAvLyA0ODgKICAgIGdldGJpdAogICAgZGlnIDcKICAgIHB1c2hpbnQgNDg5IC8vIDQ4OQogICAgZ2V0Yml0CiAgICBkaWcgOAogICAgcHVzaGludCA0OTAgLy8gNDkwCiAgICBnZXRiaXQKICAgIGRpZyA5CiAgICBwdXNoaW50IDQ5MSAvLyA0OTEKICAgIGdldGJpdAogICAgZGlnIDEzCiAgICBleHRyYWN0IDY0IDgKICAgIGRpZyAxNAogICAgZXh0cmFjdCA3MiA4CiAgICBkaWcgMTUKICAgIGV4dHJhY3QgODAgOAogICAgZGlnIDE2CiAgICBleHRyYWN0IDg4IDgKICAgIHVuY292ZXIgMTcKICAgIGV4dHJhY3QgOTYgOAogICAgZGlnIDE1CiAgICBwdXNoaW50IDEwNCAvLyAxMDQKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMTYKICAgIHVuY292ZXIgMTIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDE2CiAgICBsZW4KICAgIHVuY292ZXIgMTcKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBwdXNoYnl0ZXMgMHgwMDZjCiAgICB1bmNvdmVyIDE4CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTcKICAgIGNvbmNhdAogICAgZGlnIDE2CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDE3CiAgICBjb25jYXQKICAgIHN3YXAKICAgIHB1c2hieXRlcyAweDAwNmUKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxMTAgLy8gMTEwCiAgICArCiAgICBzd2FwCiAgICB1bmNvdmVyIDE3CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTYKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxNQogICAgY29uY2F0CiAgICBkaWcgMQogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGNvbmNhdAogICAgZGlnIDE0CiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgKwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAxNQogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciAxNAogICAgc2V0Yml0CiAgICBpbnRjXzMgLy8gMgogICAgdW5jb3ZlciAxMwogICAgc2V0Yml0CiAgICBwdXNoaW50IDMgLy8gMwogICAgdW5jb3ZlciAxMgogICAgc2V0Yml0CiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA5CiAgICBjb25jYXQKICAgIHVuY292ZXIgOAogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBjb25jYXQKICAgIHVuY292ZXIgNQogICAgY29uY2F0CiAgICBkaWcgNAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciA1CiAgICBjb25jYXQKICAgIGRpZyAyCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGNvbmNhdAogICAgYnl0ZWMgNDEgLy8gMHgwMDAwCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGNhbGxzdWIgYWRkUGx1Z2luCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk1CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDE4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTcKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIGRpZyA2CiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOTctMTIwMAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGROYW1lZFBsdWdpbjogewogICAgLy8gICB0aGlzLmFkZFBsdWdpbihkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGRhdGEpKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAMjIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk4CiAgICAvLyB0aGlzLmFkZFBsdWdpbihkZWNvZGVBcmM0PFByb3Bvc2FsQWRkTmFtZWRQbHVnaW4+KGRhdGEpKQogICAgZGlnIDgKICAgIGNhbGxzdWIgYWRkUGx1Z2luCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTk5CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlUGx1Z2luOiB7CiAgICBkaWcgNgogICAgYnl0ZWMgOCAvLyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDEtMTIwNAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVQbHVnaW46IHsKICAgIC8vICAgdGhpcy5yZW1vdmVQbHVnaW4oeyBuYW1lOiAnJywgLi4uZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oZGF0YSkgfSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwMgogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW4oeyBuYW1lOiAnJywgLi4uZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZVBsdWdpbj4oZGF0YSkgfSkKICAgIGRpZyA5CiAgICBkdXAKICAgIGV4dHJhY3QgMiA4CiAgICBzd2FwCiAgICBleHRyYWN0IDEwIDMyCiAgICBkaWcgMTAKICAgIGR1cAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBwdXNoYnl0ZXMgMHgwMDJjCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgcHVzaGJ5dGVzIDB4MDAyZTAwMDAKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBjYWxsc3ViIHJlbW92ZVBsdWdpbgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwMwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA1CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZU5hbWVkUGx1Z2luOiB7CiAgICBkaWcgNgogICAgYnl0ZWMgNDAgLy8gMHgyOQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA1LTEyMDgKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIC8vICAgdGhpcy5yZW1vdmVQbHVnaW4oZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihkYXRhKSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwNgogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW4oZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luPihkYXRhKSkKICAgIGRpZyA4CiAgICBjYWxsc3ViIHJlbW92ZVBsdWdpbgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIwNwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUAzMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjA5CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZEFsbG93YW5jZXM6IHsKICAgIGRpZyA2CiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMDktMTIxMwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVBZGRBbGxvd2FuY2VzOiB7CiAgICAvLyAgIGNvbnN0IHsgZXNjcm93LCBhbGxvd2FuY2VzIH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsQWRkQWxsb3dhbmNlcz4oZGF0YSkKICAgIC8vICAgdGhpcy5hZGRBbGxvd2FuY2VzKGVzY3JvdywgYWxsb3dhbmNlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxMAogICAgLy8gY29uc3QgeyBlc2Nyb3csIGFsbG93YW5jZXMgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxBZGRBbGxvd2FuY2VzPihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjExCiAgICAvLyB0aGlzLmFkZEFsbG93YW5jZXMoZXNjcm93LCBhbGxvd2FuY2VzKQogICAgY2FsbHN1YiBhZGRBbGxvd2FuY2VzCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjEyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMTQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlQWxsb3dhbmNlczogewogICAgZGlnIDYKICAgIGJ5dGVjIDQyIC8vIDB4M2MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxNC0xMjE4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZUFsbG93YW5jZXM6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGRhdGEpCiAgICAvLyAgIHRoaXMucmVtb3ZlQWxsb3dhbmNlcyhlc2Nyb3csIGFzc2V0cykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIxNQogICAgLy8gY29uc3QgeyBlc2Nyb3csIGFzc2V0cyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFJlbW92ZUFsbG93YW5jZXM+KGRhdGEpCiAgICBkaWcgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTg5LTU5MgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZUFsbG93YW5jZXM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93LCBhc3NldHNdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5MAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5MAogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1ODktNTkyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlQWxsb3dhbmNlcz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtlc2Nyb3csIGFzc2V0c10KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhkNWRkMzgyYiAvLyBtZXRob2QgImFyYzU4X3JlbW92ZUFsbG93YW5jZXMoc3RyaW5nLHVpbnQ2NFtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjE3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMTkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICBkaWcgNgogICAgYnl0ZWMgNDMgLy8gMHg0NgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjE5LTEyMjMKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICAvLyAgIGNvbnN0IHsgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsTmV3RXNjcm93PihkYXRhKQogICAgLy8gICB0aGlzLm5ld0VzY3Jvdyhlc2Nyb3cpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjAKICAgIC8vIGNvbnN0IHsgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsTmV3RXNjcm93PihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjE3LTYyMAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9uZXdFc2Nyb3c+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTgKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTgKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjE3LTYyMAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9uZXdFc2Nyb3c+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDBhOGNiMmMyIC8vIG1ldGhvZCAiYXJjNThfbmV3RXNjcm93KHN0cmluZyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjIyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDQyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZGlnIDYKICAgIGJ5dGVjIDQ0IC8vIDB4NDcKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIyNC0xMjI4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVRvZ2dsZUVzY3Jvd0xvY2s6IHsKICAgIC8vICAgY29uc3QgeyBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrPihkYXRhKQogICAgLy8gICB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2soZXNjcm93KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjI1CiAgICAvLyBjb25zdCB7IGVzY3JvdyB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2s+KGRhdGEpCiAgICBkaWcgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjQtNjI3CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3RvZ2dsZUVzY3Jvd0xvY2s+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbZXNjcm93XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjUKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MjUKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjI0LTYyNwogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF90b2dnbGVFc2Nyb3dMb2NrPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3Jvd10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgyNWI3MTNjYSAvLyBtZXRob2QgImFyYzU4X3RvZ2dsZUVzY3Jvd0xvY2soc3RyaW5nKSh1aW50NjQsYm9vbCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIHB1c2hpbnQgOSAvLyA5CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LGJvb2wxKQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjcKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTIyOQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIGRpZyA2CiAgICBieXRlYyA0NSAvLyAweDUwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMjktMTIzMwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIC8vICAgY29uc3QgeyBmaWVsZCwgdmFsdWUgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxVcGRhdGVGaWVsZD4oZGF0YSkKICAgIC8vICAgdGhpcy51cGRhdGVGaWVsZChmaWVsZCwgdmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjMwCiAgICAvLyBjb25zdCB7IGZpZWxkLCB2YWx1ZSB9ID0gZGVjb2RlQXJjNDxQcm9wb3NhbFVwZGF0ZUZpZWxkPihkYXRhKQogICAgZGlnIDgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgaW50Y18zIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGR1cAogICAgY292ZXIgMwogICAgYnVyeSAxMQogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGJ1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMyLTYzNQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3k6IHsKICAgIC8vICAgdGhpcy5jb250ZW50UG9saWN5LnZhbHVlID0gdmFsdWUudG9GaXhlZCh7IGxlbmd0aDogMzYgfSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMzCiAgICAvLyB0aGlzLmNvbnRlbnRQb2xpY3kudmFsdWUgPSB2YWx1ZS50b0ZpeGVkKHsgbGVuZ3RoOiAzNiB9KQogICAgZGlnIDUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzgKICAgIC8vIGNvbnRlbnRQb2xpY3kgPSBHbG9iYWxTdGF0ZTxDSUQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0NvbnRlbnRQb2xpY3kgfSkKICAgIGJ5dGVjIDI0IC8vICJjb250ZW50X3BvbGljeSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzMKICAgIC8vIHRoaXMuY29udGVudFBvbGljeS52YWx1ZSA9IHZhbHVlLnRvRml4ZWQoeyBsZW5ndGg6IDM2IH0pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzNAogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzYKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbEFjdGlvbkxpbWl0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTMgLy8gInByb3Bvc2FsX2FjdGlvbl9saW1pdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjM2LTYzOQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsQWN0aW9uTGltaXQ6IHsKICAgIC8vICAgdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlID0gYnRvaSh2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnByb3Bvc2FsQWN0aW9uTGltaXQudmFsdWUgPSBidG9pKHZhbHVlKQogICAgZGlnIDUKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNgogICAgLy8gcHJvcG9zYWxBY3Rpb25MaW1pdCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUHJvcG9zYWxBY3Rpb25MaW1pdCB9KQogICAgYnl0ZWMgMTMgLy8gInByb3Bvc2FsX2FjdGlvbl9saW1pdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MzcKICAgIC8vIHRoaXMucHJvcG9zYWxBY3Rpb25MaW1pdC52YWx1ZSA9IGJ0b2kodmFsdWUpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYzOAogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDAKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNNaW5SZXdhcmRzSW1wYWN0OiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQwLTY0MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c01pblJld2FyZHNJbXBhY3Q6IHsKICAgIC8vICAgdGhpcy5taW5SZXdhcmRzSW1wYWN0LnZhbHVlID0gYnRvaSh2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQxCiAgICAvLyB0aGlzLm1pblJld2FyZHNJbXBhY3QudmFsdWUgPSBidG9pKHZhbHVlKQogICAgZGlnIDUKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MAogICAgLy8gbWluUmV3YXJkc0ltcGFjdCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTWluUmV3YXJkc0ltcGFjdCB9KQogICAgYnl0ZWMgMjUgLy8gIm1pbl9yZXdhcmRzX2ltcGFjdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDEKICAgIC8vIHRoaXMubWluUmV3YXJkc0ltcGFjdC52YWx1ZSA9IGJ0b2kodmFsdWUpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1OToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyA5IC8vICJhYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0NC02NDgKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3Q6IHsKICAgIC8vICAgY29uc3QgYWtpdGFBcHBMaXN0ID0gZGVjb2RlQXJjNDxBa2l0YUFwcExpc3Q+KHZhbHVlKQogICAgLy8gICB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZSA9IGNsb25lKGFraXRhQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIGFraXRhQXBwTGlzdCA9IEdsb2JhbFN0YXRlPEFraXRhQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0IH0pCiAgICBieXRlYyA5IC8vICJhYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjQ2CiAgICAvLyB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZSA9IGNsb25lKGFraXRhQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY0NwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2MToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyNiAvLyAic2FsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NDktNjUzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0OiB7CiAgICAvLyAgIGNvbnN0IGFraXRhU29jaWFsQXBwTGlzdCA9IGRlY29kZUFyYzQ8QWtpdGFTb2NpYWxBcHBMaXN0Pih2YWx1ZSkKICAgIC8vICAgdGhpcy5ha2l0YVNvY2lhbEFwcExpc3QudmFsdWUgPSBjbG9uZShha2l0YVNvY2lhbEFwcExpc3QpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2MwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0CiAgICAvLyBha2l0YVNvY2lhbEFwcExpc3QgPSBHbG9iYWxTdGF0ZTxBa2l0YVNvY2lhbEFwcExpc3Q+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCB9KQogICAgYnl0ZWMgMjYgLy8gInNhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTEKICAgIC8vIHRoaXMuYWtpdGFTb2NpYWxBcHBMaXN0LnZhbHVlID0gY2xvbmUoYWtpdGFTb2NpYWxBcHBMaXN0KQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjUyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDYzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTQtNjU4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdDogewogICAgLy8gICBjb25zdCBwbHVnaW5BcHBMaXN0ID0gZGVjb2RlQXJjNDxQbHVnaW5BcHBMaXN0Pih2YWx1ZSkKICAgIC8vICAgdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gY2xvbmUocGx1Z2luQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDYKICAgIC8vIHBsdWdpbkFwcExpc3QgPSBHbG9iYWxTdGF0ZTxQbHVnaW5BcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQbHVnaW5BcHBMaXN0IH0pCiAgICBieXRlYyAxNCAvLyAicGFsIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NgogICAgLy8gdGhpcy5wbHVnaW5BcHBMaXN0LnZhbHVlID0gY2xvbmUocGx1Z2luQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1NwogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NTkKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3Q6IHsKICAgIGRpZyA3CiAgICBieXRlYyA1IC8vICJvYWwiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY1OS02NjMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3Q6IHsKICAgIC8vICAgY29uc3Qgb3RoZXJBcHBMaXN0ID0gZGVjb2RlQXJjNDxPdGhlckFwcExpc3Q+KHZhbHVlKQogICAgLy8gICB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IGNsb25lKG90aGVyQXBwTGlzdCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjYxCiAgICAvLyB0aGlzLm90aGVyQXBwTGlzdC52YWx1ZSA9IGNsb25lKG90aGVyQXBwTGlzdCkKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA2NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NjQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMjcgLy8gIndhbGxldF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NjQtNjY4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0RmVlczogewogICAgLy8gICBjb25zdCB3YWxsZXRGZWVzID0gZGVjb2RlQXJjNDxXYWxsZXRGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy53YWxsZXRGZWVzLnZhbHVlID0gY2xvbmUod2FsbGV0RmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTAKICAgIC8vIHdhbGxldEZlZXMgPSBHbG9iYWxTdGF0ZTxXYWxsZXRGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzIH0pCiAgICBieXRlYyAyNyAvLyAid2FsbGV0X2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjY2CiAgICAvLyB0aGlzLndhbGxldEZlZXMudmFsdWUgPSBjbG9uZSh3YWxsZXRGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjY3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDY5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2OQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXM6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyOCAvLyAic29jaWFsX2ZlZXMiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY2OS02NzMKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzOiB7CiAgICAvLyAgIGNvbnN0IHNvY2lhbEZlZXMgPSBkZWNvZGVBcmM0PFNvY2lhbEZlZXM+KHZhbHVlKQogICAgLy8gICB0aGlzLnNvY2lhbEZlZXMudmFsdWUgPSBjbG9uZShzb2NpYWxGZWVzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MgogICAgLy8gc29jaWFsRmVlcyA9IEdsb2JhbFN0YXRlPFNvY2lhbEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMgfSkKICAgIGJ5dGVjIDI4IC8vICJzb2NpYWxfZmVlcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzEKICAgIC8vIHRoaXMuc29jaWFsRmVlcy52YWx1ZSA9IGNsb25lKHNvY2lhbEZlZXMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzIKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc0CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIGRpZyA3CiAgICBieXRlYyAyOSAvLyAic3Rha2luZ19mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzQtNjc4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXM6IHsKICAgIC8vICAgY29uc3Qgc3Rha2luZ0ZlZXMgPSBkZWNvZGVBcmM0PFN0YWtpbmdGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5zdGFraW5nRmVlcy52YWx1ZSA9IGNsb25lKHN0YWtpbmdGZWVzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NAogICAgLy8gc3Rha2luZ0ZlZXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nRmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Rha2luZ0ZlZXMgfSkKICAgIGJ5dGVjIDI5IC8vICJzdGFraW5nX2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc2CiAgICAvLyB0aGlzLnN0YWtpbmdGZWVzLnZhbHVlID0gY2xvbmUoc3Rha2luZ0ZlZXMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NzcKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VANzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc5CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlczogewogICAgZGlnIDcKICAgIGJ5dGVjIDMwIC8vICJzdWJzY3JpcHRpb25fZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njc5LTY4MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N1YnNjcmlwdGlvbkZlZXM6IHsKICAgIC8vICAgY29uc3Qgc3Vic2NyaXB0aW9uRmVlcyA9IGRlY29kZUFyYzQ8U3Vic2NyaXB0aW9uRmVlcz4odmFsdWUpCiAgICAvLyAgIHRoaXMuc3Vic2NyaXB0aW9uRmVlcy52YWx1ZSA9IGNsb25lKHN1YnNjcmlwdGlvbkZlZXMpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA3NQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2CiAgICAvLyBzdWJzY3JpcHRpb25GZWVzID0gR2xvYmFsU3RhdGU8U3Vic2NyaXB0aW9uRmVlcz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU3Vic2NyaXB0aW9uRmVlcyB9KQogICAgYnl0ZWMgMzAgLy8gInN1YnNjcmlwdGlvbl9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4MQogICAgLy8gdGhpcy5zdWJzY3JpcHRpb25GZWVzLnZhbHVlID0gY2xvbmUoc3Vic2NyaXB0aW9uRmVlcykKICAgIGRpZyA2CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4MgogICAgLy8gYnJlYWsKICAgIGIgZXhlY3V0ZVByb3Bvc2FsX2Jsb2NrQDUxCgpleGVjdXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA3NToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2ODQKICAgIC8vIGNhc2UgQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMzEgLy8gIm5mdF9mZWVzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2ODQtNjg4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTkZURmVlczogewogICAgLy8gICBjb25zdCBuZnRGZWVzID0gZGVjb2RlQXJjNDxORlRGZWVzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5uZnRGZWVzLnZhbHVlID0gY2xvbmUobmZ0RmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIG5mdEZlZXMgPSBHbG9iYWxTdGF0ZTxORlRGZWVzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNORlRGZWVzIH0pCiAgICBieXRlYyAzMSAvLyAibmZ0X2ZlZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg2CiAgICAvLyB0aGlzLm5mdEZlZXMudmFsdWUgPSBjbG9uZShuZnRGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg3CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY4OQogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMzIgLy8gInN3YXBfZmVlcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njg5LTY5MwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzOiB7CiAgICAvLyAgIGNvbnN0IHN3YXBGZWVzID0gZGVjb2RlQXJjNDxTd2FwRmVlcz4odmFsdWUpCiAgICAvLyAgIHRoaXMuc3dhcEZlZXMudmFsdWUgPSBjbG9uZShzd2FwRmVlcykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAKICAgIC8vIHN3YXBGZWVzID0gR2xvYmFsU3RhdGU8U3dhcEZlZXM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N3YXBGZWVzIH0pCiAgICBieXRlYyAzMiAvLyAic3dhcF9mZWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5MQogICAgLy8gdGhpcy5zd2FwRmVlcy52YWx1ZSA9IGNsb25lKHN3YXBGZWVzKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjkyCiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDc5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5NAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTEgLy8gImFraXRhX2Fzc2V0cyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njk0LTY5NwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzOiB7CiAgICAvLyAgIHRoaXMuYWtpdGFBc3NldHMudmFsdWUgPSBkZWNvZGVBcmM0PEFraXRhQXNzZXRzPih2YWx1ZSkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5NQogICAgLy8gdGhpcy5ha2l0YUFzc2V0cy52YWx1ZSA9IGRlY29kZUFyYzQ8QWtpdGFBc3NldHM+KHZhbHVlKQogICAgZGlnIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Njk2CiAgICAvLyBicmVhawogICAgYiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKCmV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjY5OAogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzOiB7CiAgICBkaWcgNwogICAgYnl0ZWMgMTUgLy8gInVwZ3JhZGVfYXBwX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2OTgtNzAyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3M6IHsKICAgIC8vICAgY29uc3QgdXBncmFkZUFwcFNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy51cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHVwZ3JhZGVBcHBTZXR0aW5ncykKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGV4ZWN1dGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDgzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjgKICAgIC8vIHVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE1IC8vICJ1cGdyYWRlX2FwcF9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDAKICAgIC8vIHRoaXMudXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MudmFsdWUgPSBjbG9uZSh1cGdyYWRlQXBwU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzAzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDMtNzA3CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBhZGRQbHVnaW5TZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKGFkZFBsdWdpblNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MAogICAgLy8gYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZFBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE2IC8vICJhZGRfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcwNQogICAgLy8gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUoYWRkUGx1Z2luU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzA4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDE3IC8vICJyZW1vdmVfcGx1Z2luX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MDgtNzEyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCByZW1vdmVQbHVnaW5TZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHJlbW92ZVBsdWdpblNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MgogICAgLy8gcmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE3IC8vICJyZW1vdmVfcGx1Z2luX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcxMAogICAgLy8gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocmVtb3ZlUGx1Z2luU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MTEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzEzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxOCAvLyAiYWRkX2FsbG93YW5jZV9wcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzEzLTcxNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgIGNvbnN0IGFkZEFsbG93YW5jZVNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5hZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKGFkZEFsbG93YW5jZVNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NgogICAgLy8gYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTggLy8gImFkZF9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE1CiAgICAvLyB0aGlzLmFkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUoYWRkQWxsb3dhbmNlU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MTYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAODk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3M6IHsKICAgIGRpZyA3CiAgICBieXRlYyAxOSAvLyAicmVtb3ZlX2FsbG93YW5jZV9wcyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzE4LTcyMgogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzOiB7CiAgICAvLyAgIGNvbnN0IHJlbW92ZUFsbG93YW5jZVNldHRpbmdzID0gZGVjb2RlQXJjNDxQcm9wb3NhbFNldHRpbmdzPih2YWx1ZSkKICAgIC8vICAgdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHJlbW92ZUFsbG93YW5jZVNldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIwCiAgICAvLyB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUocmVtb3ZlQWxsb3dhbmNlU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzIzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTmV3RXNjcm93UHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjMtNzI3CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzTmV3RXNjcm93UHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCBuZXdFc2Nyb3dTZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMubmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKG5ld0VzY3Jvd1NldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MAogICAgLy8gbmV3RXNjcm93UHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c05ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIwIC8vICJuZXdfZXNjcm93X3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcyNQogICAgLy8gdGhpcy5uZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUobmV3RXNjcm93U2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzI4CiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgZGlnIDcKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MjgtNzMyCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5nczogewogICAgLy8gICBjb25zdCB1cGRhdGVGaWVsZHNTZXR0aW5ncyA9IGRlY29kZUFyYzQ8UHJvcG9zYWxTZXR0aW5ncz4odmFsdWUpCiAgICAvLyAgIHRoaXMudXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZSA9IGNsb25lKHVwZGF0ZUZpZWxkc1NldHRpbmdzKQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyA9IEdsb2JhbFN0YXRlPFByb3Bvc2FsU2V0dGluZ3M+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1VwZGF0ZUZpZWxkc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDIxIC8vICJ1cGRhdGVfZmllbGRzX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjczMAogICAgLy8gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlID0gY2xvbmUodXBkYXRlRmllbGRzU2V0dGluZ3MpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzEKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX2lmX2Vsc2VAOTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzMzCiAgICAvLyBjYXNlIEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmV2ZW51ZVNwbGl0czogewogICAgZGlnIDcKICAgIGJ5dGVjIDMzIC8vICJyZXZlbnVlX3NwbGl0cyIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzMzLTczNwogICAgLy8gY2FzZSBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHM6IHsKICAgIC8vICAgY29uc3QgcmV2ZW51ZVNwbGl0cyA9IGRlY29kZUFyYzQ8U3BsaXRbXT4odmFsdWUpCiAgICAvLyAgIHRoaXMucmV2ZW51ZVNwbGl0cy52YWx1ZSA9IGNsb25lKHJldmVudWVTcGxpdHMpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBleGVjdXRlUHJvcG9zYWxfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2NAogICAgLy8gcmV2ZW51ZVNwbGl0cyA9IEdsb2JhbFN0YXRlPFNwbGl0W10+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHMgfSkKICAgIGJ5dGVjIDMzIC8vICJyZXZlbnVlX3NwbGl0cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzUKICAgIC8vIHRoaXMucmV2ZW51ZVNwbGl0cy52YWx1ZSA9IGNsb25lKHJldmVudWVTcGxpdHMpCiAgICBkaWcgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3MzYKICAgIC8vIGJyZWFrCiAgICBiIGV4ZWN1dGVQcm9wb3NhbF9ibG9ja0A1MQoKZXhlY3V0ZVByb3Bvc2FsX2FmdGVyX3doaWxlQDUyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyMzcKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgYnl0ZWMgMTIgLy8gMHgzMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTY1CiAgICAvLyBleGVjdXRlUHJvcG9zYWwocHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5kZWxldGVQcm9wb3NhbFZvdGVzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVsZXRlUHJvcG9zYWxWb3RlczoKICAgIGJ5dGVjXzMgLy8gIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQwCiAgICAvLyBkZWxldGVQcm9wb3NhbFZvdGVzKHByb3Bvc2FsSUQ6IHVpbnQ2NCwgdm90ZXJzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbMzJdW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0MQogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgaXRvYgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQxCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQcm9wb3NhbCBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDMKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCB8fAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgYnl0ZWMgOCAvLyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDMtMTI0NAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkIHx8CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzUmVqZWN0ZWQgfHwKICAgIGJueiBkZWxldGVQcm9wb3NhbFZvdGVzX2Jvb2xfdHJ1ZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0NAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c1JlamVjdGVkIHx8CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGJ5dGVjIDEwIC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0My0xMjQ0CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQgfHwKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZCB8fAogICAgYm56IGRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF90cnVlQDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQ1CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRXhlY3V0ZWQsCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGJ5dGVjIDEyIC8vIDB4MzIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0My0xMjQ1CiAgICAvLyB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQgfHwKICAgIC8vIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNSZWplY3RlZCB8fAogICAgLy8gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgYnogZGVsZXRlUHJvcG9zYWxWb3Rlc19ib29sX2ZhbHNlQDUKCmRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF90cnVlQDQ6CiAgICBpbnRjXzEgLy8gMQoKZGVsZXRlUHJvcG9zYWxWb3Rlc19ib29sX21lcmdlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0Mi0xMjQ3CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlLnN0YXR1cyA9PT0gUHJvcG9zYWxTdGF0dXNBcHByb3ZlZCB8fAogICAgLy8gICB0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS52YWx1ZS5zdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzUmVqZWN0ZWQgfHwKICAgIC8vICAgdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUuc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0V4ZWN1dGVkLAogICAgLy8gICBFUlJfSU5WQUxJRF9QUk9QT1NBTF9TVEFURQogICAgLy8gKQogICAgYXNzZXJ0IC8vIGludmFsaWQgcHJvcG9zYWwgc3RhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjQ5CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgdm90ZXJzLmxlbmd0aDsgaSsrKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA1CgpkZWxldGVQcm9wb3NhbFZvdGVzX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNDkKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCB2b3RlcnMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyA0CiAgICBkaWcgMwogICAgPAogICAgYnogZGVsZXRlUHJvcG9zYWxWb3Rlc19hZnRlcl93aGlsZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxWb3Rlcyh7IHByb3Bvc2FsSUQsIHZvdGVyOiB2b3RlcnNbaV0gfSkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfVk9URV9OT1RfRk9VTkQpCiAgICBkaWcgMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3QzIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk1CiAgICAvLyBwcm9wb3NhbFZvdGVzID0gQm94TWFwPFByb3Bvc2FsVm90ZUtleSwgUHJvcG9zYWxWb3RlSW5mbz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxWb3Rlcyh7IHByb3Bvc2FsSUQsIHZvdGVyOiB2b3RlcnNbaV0gfSkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfVk9URV9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIFZvdGUgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1MQogICAgLy8gdGhpcy5wcm9wb3NhbFZvdGVzKHsgcHJvcG9zYWxJRCwgdm90ZXI6IHZvdGVyc1tpXSB9KS5kZWxldGUoKQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0OQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IHZvdGVycy5sZW5ndGg7IGkrKykgewogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgNQogICAgYiBkZWxldGVQcm9wb3NhbFZvdGVzX3doaWxlX3RvcEA3CgpkZWxldGVQcm9wb3NhbFZvdGVzX2FmdGVyX3doaWxlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI0MAogICAgLy8gZGVsZXRlUHJvcG9zYWxWb3Rlcyhwcm9wb3NhbElEOiB1aW50NjQsIHZvdGVyczogQWNjb3VudFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpkZWxldGVQcm9wb3NhbFZvdGVzX2Jvb2xfZmFsc2VANToKICAgIGludGNfMCAvLyAwCiAgICBiIGRlbGV0ZVByb3Bvc2FsVm90ZXNfYm9vbF9tZXJnZUA2CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpzZXR1cENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uc2V0dXBDb3N0CiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI2MQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0CiAgICBwb3AKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0UHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMjgKICAgIC8vIGFzc2VydCh0aGlzLnByb3Bvc2Fscyhwcm9wb3NhbElEKS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo5MwogICAgLy8gcHJvcG9zYWxzID0gQm94TWFwPHVpbnQ2NCwgUHJvcG9zYWxEZXRhaWxzPih7IGtleVByZWZpeDogQWtpdGFEQU9Cb3hQcmVmaXhQcm9wb3NhbHMgfSkKICAgIGJ5dGVjIDQgLy8gImwiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzI4CiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyOQogICAgLy8gcmV0dXJuIHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLnZhbHVlCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzI2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLm11c3RHZXRFeGVjdXRpb25bcm91dGluZ10oKSAtPiB2b2lkOgptdXN0R2V0RXhlY3V0aW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMzIKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBleGVjdXRpb25zID0gQm94TWFwPGJ5dGVzPDMyPiwgRXhlY3V0aW9uTWV0YWRhdGE+KHsga2V5UHJlZml4OiBBYnN0cmFjdEFjY291bnRCb3hQcmVmaXhFeGVjdXRpb25zIH0pCiAgICBwdXNoYnl0ZXMgIngiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM0CiAgICAvLyBhc3NlcnQodGhpcy5leGVjdXRpb25zKGxlYXNlKS5leGlzdHMsIEVSUl9FWEVDVVRJT05fS0VZX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRXhlY3V0aW9uIGtleSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzM1CiAgICAvLyByZXR1cm4gdGhpcy5leGVjdXRpb25zKGxlYXNlKS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMzMgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wbHVnaW5FeGlzdHMoa2V5OiBieXRlcykgLT4gdWludDY0LCBieXRlczoKcGx1Z2luRXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExMwogICAgLy8gcHJpdmF0ZSBwbHVnaW5FeGlzdHMoa2V5OiBQbHVnaW5LZXkpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNC0xMTcKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0UGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtba2V5XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gYXJnczogW1trZXldXQogICAgYnl0ZWMgMzYgLy8gMHgwMDAxMDAwMgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMTQtMTE3CiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldFBsdWdpbnM+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbW2tleV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgNTEgLy8gbWV0aG9kICJhcmM1OF9nZXRQbHVnaW5zKCh1aW50NjQsYWRkcmVzcyxzdHJpbmcpW10pKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NCx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sLHVpbnQ2NCx1aW50NjQpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjExOQogICAgLy8gcmV0dXJuIGluZm8uc3RhcnQgIT09IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgNiAwCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDIKICAgIGxlbgogICAgZGlnIDMKICAgIGludGNfMyAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzAgLy8gMAogICAgIT0KICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5uYW1lZFBsdWdpbkV4aXN0cyhuYW1lOiBieXRlcykgLT4gdWludDY0OgpuYW1lZFBsdWdpbkV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIHByaXZhdGUgbmFtZWRQbHVnaW5FeGlzdHMobmFtZTogc3RyaW5nKTogYm9vbGVhbiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzAtMTMzCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldE5hbWVkUGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIGFyZ3M6IFtbbmFtZV1dCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBieXRlYyAzNiAvLyAweDAwMDEwMDAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzAtMTMzCiAgICAvLyBjb25zdCBpbmZvID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldE5hbWVkUGx1Z2lucz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgcHVzaGJ5dGVzIDB4MDU3OTRkN2QgLy8gbWV0aG9kICJhcmM1OF9nZXROYW1lZFBsdWdpbnMoc3RyaW5nW10pKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NCx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sLHVpbnQ2NCx1aW50NjQpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzNQogICAgLy8gcmV0dXJuIGluZm8uc3RhcnQgIT09IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgNiAwCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDIKICAgIGxlbgogICAgZGlnIDMKICAgIGludGNfMyAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzAgLy8gMAogICAgIT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZXNjcm93RXhpc3RzKGVzY3JvdzogYnl0ZXMpIC0+IHVpbnQ2NDoKZXNjcm93RXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOAogICAgLy8gcHJpdmF0ZSBlc2Nyb3dFeGlzdHMoZXNjcm93OiBzdHJpbmcpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOS0xNDIKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtbZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE0MQogICAgLy8gYXJnczogW1tlc2Nyb3ddXQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgYnl0ZWMgMzYgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTM5LTE0MgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW1tlc2Nyb3ddXQogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hieXRlcyAweGEyNDAzZGRmIC8vIG1ldGhvZCAiYXJjNThfZ2V0RXNjcm93cyhzdHJpbmdbXSkodWludDY0LGJvb2wpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgOSAvLyA5CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50NjQsYm9vbDEpW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ0CiAgICAvLyByZXR1cm4gaW5mby5pZCAhPT0gMAogICAgZXh0cmFjdCA2IDkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgaW50Y18wIC8vIDAKICAgICE9CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmFsbG93YW5jZUNoZWNrKGVzY3JvdzogYnl0ZXMsIGFzc2V0czogYnl0ZXMpIC0+IGJ5dGVzLCBieXRlczoKYWxsb3dhbmNlQ2hlY2s6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBwcml2YXRlIGFsbG93YW5jZUNoZWNrKGVzY3Jvdzogc3RyaW5nLCBhc3NldHM6IHVpbnQ2NFtdKTogeyBleGlzdGVuY2VzOiBib29sZWFuW10sIGFueUV4aXN0OiBib29sZWFuLCBhbGxFeGlzdDogYm9vbGVhbiB9IHsKICAgIHByb3RvIDIgMgogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ4LTE1MQogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYXNzZXRzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTUwCiAgICAvLyBhcmdzOiBbZXNjcm93LCBhc3NldHNdCiAgICBmcmFtZV9kaWcgLTIKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ4LTE1MQogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYXNzZXRzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDAyZmU0NTE1IC8vIG1ldGhvZCAiYXJjNThfZ2V0QWxsb3dhbmNlcyhzdHJpbmcsdWludDY0W10pKHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwbiAyCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sMSlbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGxldCBleGlzdGVuY2VzOiBib29sZWFuW10gPSBbXQogICAgYnl0ZWMgNDEgLy8gMHgwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU0CiAgICAvLyBsZXQgYW55RXhpc3Q6IGJvb2xlYW4gPSBmYWxzZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGxldCBhbGxFeGlzdDogYm9vbGVhbiA9IHRydWUKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU2CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaW5mby5sZW5ndGg7IGkrKykgewogICAgaW50Y18wIC8vIDAKCmFsbG93YW5jZUNoZWNrX3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGluZm8ubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAxNAogICAgZnJhbWVfZGlnIDEwCiAgICA8CiAgICBieiBhbGxvd2FuY2VDaGVja19hZnRlcl93aGlsZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU3CiAgICAvLyBjb25zdCBleGlzdHMgPSBpbmZvW2ldLnN0YXJ0ICE9PSAwCiAgICBmcmFtZV9kaWcgOQogICAgZXh0cmFjdCA2IDAKICAgIGZyYW1lX2RpZyAxNAogICAgcHVzaGludCA1MCAvLyA1MAogICAgKgogICAgcHVzaGludCA1MCAvLyA1MAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDYKICAgIGludGNfMCAvLyAwCiAgICAhPQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gZXhpc3RlbmNlcy5wdXNoKGV4aXN0cykKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBmcmFtZV9idXJ5IDAKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxNTgKICAgIC8vIGV4aXN0ZW5jZXMucHVzaChleGlzdHMpCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMAogICAgdW5jb3ZlciAzCiAgICBzd2FwCiAgICByZXBsYWNlMiAwCiAgICBmcmFtZV9idXJ5IDEKICAgIHN3YXAKICAgIHB1c2hpbnQgNyAvLyA3CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIHN3YXAKICAgIHB1c2hpbnQgNyAvLyA3CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDUKICAgIDwKICAgIGJ6IGFsbG93YW5jZUNoZWNrX2FmdGVyX2lmX2Vsc2VAMTAKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMwogICAgLQogICAgYnplcm8KICAgIGZyYW1lX2RpZyAxCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGZyYW1lX2J1cnkgMQoKYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDQKICAgIGZyYW1lX2RpZyAyCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gZXhpc3RlbmNlcy5wdXNoKGV4aXN0cykKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDcKCmFsbG93YW5jZUNoZWNrX3doaWxlX3RvcEAxMToKICAgIGZyYW1lX2RpZyA4CiAgICBmcmFtZV9kaWcgNwogICAgPAogICAgYnogYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfd2hpbGVAMTMKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBnZXRiaXQKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgZnJhbWVfYnVyeSAxCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU4CiAgICAvLyBleGlzdGVuY2VzLnB1c2goZXhpc3RzKQogICAgaW50Y18yIC8vIDgKICAgICsKICAgIGZyYW1lX2J1cnkgNAogICAgYiBhbGxvd2FuY2VDaGVja193aGlsZV90b3BAMTEKCmFsbG93YW5jZUNoZWNrX2FmdGVyX3doaWxlQDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE1OQogICAgLy8gaWYgKGV4aXN0cykgewogICAgZnJhbWVfZGlnIDYKICAgIGJ6IGFsbG93YW5jZUNoZWNrX2Vsc2VfYm9keUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBhbnlFeGlzdCA9IHRydWUKICAgIGludGNfMSAvLyAxCiAgICBmcmFtZV9idXJ5IDEyCgphbGxvd2FuY2VDaGVja19hZnRlcl9pZl9lbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTU2CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaW5mby5sZW5ndGg7IGkrKykgewogICAgZnJhbWVfZGlnIDE0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSAxNAogICAgZnJhbWVfZGlnIDEKICAgIGZyYW1lX2J1cnkgMTEKICAgIGIgYWxsb3dhbmNlQ2hlY2tfd2hpbGVfdG9wQDIKCmFsbG93YW5jZUNoZWNrX2Vsc2VfYm9keUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE2MgogICAgLy8gYWxsRXhpc3QgPSBmYWxzZQogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMTMKICAgIGIgYWxsb3dhbmNlQ2hlY2tfYWZ0ZXJfaWZfZWxzZUA2CgphbGxvd2FuY2VDaGVja19hZnRlcl93aGlsZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NgogICAgLy8gcmV0dXJuIHsgZXhpc3RlbmNlcywgYW55RXhpc3QsIGFsbEV4aXN0IH0KICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMgogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIDEzCiAgICBzZXRiaXQKICAgIHB1c2hieXRlcyAweDAwMDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDExCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8udmFsaWRFZGl0T3JTdWJtaXQocHJvcG9zYWxJRDogdWludDY0KSAtPiB1aW50NjQ6CnZhbGlkRWRpdE9yU3VibWl0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0OQogICAgLy8gcHJpdmF0ZSB2YWxpZEVkaXRPclN1Ym1pdChwcm9wb3NhbElEOiB1aW50NjQpOiBib29sZWFuIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1MAogICAgLy8gYXNzZXJ0KHRoaXMucHJvcG9zYWxzKHByb3Bvc2FsSUQpLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUwCiAgICAvLyBhc3NlcnQodGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkuZXhpc3RzLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUxCiAgICAvLyBjb25zdCB7IHN0YXR1cywgY3JlYXRvciB9ID0gdGhpcy5wcm9wb3NhbHMocHJvcG9zYWxJRCkudmFsdWUKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgcHVzaGludHMgNjEgMzIgLy8gNjEsIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1MgogICAgLy8gY29uc3Qgb3JpZ2luID0gZ2V0T3JpZ2luKHRoaXMub3RoZXJBcHBMaXN0LnZhbHVlLmVzY3JvdywgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG90aGVyQXBwTGlzdCA9IEdsb2JhbFN0YXRlPE90aGVyQXBwTGlzdD4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0IH0pCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCBvcmlnaW4gPSBnZXRPcmlnaW4odGhpcy5vdGhlckFwcExpc3QudmFsdWUuZXNjcm93LCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldE9yaWdpbgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1NQogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICBieXRlY18wIC8vIDB4MDAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzU1LTM1NgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyBvcmlnaW4gPT09IGNyZWF0b3IKICAgIGJ6IHZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfZmFsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM1NgogICAgLy8gb3JpZ2luID09PSBjcmVhdG9yCiAgICBmcmFtZV9kaWcgMQogICAgZnJhbWVfZGlnIDAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzU1LTM1NgogICAgLy8gc3RhdHVzID09PSBQcm9wb3NhbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyBvcmlnaW4gPT09IGNyZWF0b3IKICAgIGJ6IHZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfZmFsc2VAMwogICAgaW50Y18xIC8vIDEKCnZhbGlkRWRpdE9yU3VibWl0X2Jvb2xfbWVyZ2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNTQtMzU3CiAgICAvLyByZXR1cm4gKAogICAgLy8gICBzdGF0dXMgPT09IFByb3Bvc2FsU3RhdHVzRHJhZnQgJiYKICAgIC8vICAgb3JpZ2luID09PSBjcmVhdG9yCiAgICAvLyApCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKdmFsaWRFZGl0T3JTdWJtaXRfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgdmFsaWRFZGl0T3JTdWJtaXRfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy52YWxpZGF0ZVNldHRpbmdzKHNldHRpbmdzOiBieXRlcykgLT4gYnl0ZXM6CnZhbGlkYXRlU2V0dGluZ3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzYwCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlU2V0dGluZ3Moc2V0dGluZ3M6IFByb3Bvc2FsU2V0dGluZ3MpOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2MQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmFwcHJvdmFsID4gMV8wMDAsIEVSUl9JTlZBTElEX01JTl9BUFBST1ZBTCkgLy8gMSUKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgaW50YyA1IC8vIDEwMDAKICAgID4KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1pbmltdW0gYXBwcm92YWwgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmFwcHJvdmFsIDw9IDEwMF8wMDAsIEVSUl9JTlZBTElEX01BWF9BUFBST1ZBTCkgLy8gMTAwJQogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gYXBwcm92YWwgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NAogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBhcnRpY2lwYXRpb24gPiAxXzAwMCkgLy8gMSUKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgaW50YyA1IC8vIDEwMDAKICAgID4KICAgIGFzc2VydAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBhcnRpY2lwYXRpb24gPD0gMTAwXzAwMCwgRVJSX0lOVkFMSURfTUFYX1BBUlRJQ0lQQVRJT04pIC8vIDEwMCUKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBtYXhpbXVtIHBhcnRpY2lwYXRpb24gcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2NwogICAgLy8gYXNzZXJ0KHNldHRpbmdzLmR1cmF0aW9uID4gMCwgRVJSX0lOVkFMSURfRFVSQVRJT04pCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBhc3NlcnQgLy8gSW52YWxpZCBkdXJhdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM2OQogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBvd2VyID4gMCwgRVJSX0lOVkFMSURfTUlOX1BPV0VSKQogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYXNzZXJ0IC8vIEludmFsaWQgbWluaW11bSBwb3dlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3MAogICAgLy8gYXNzZXJ0KHNldHRpbmdzLnBvd2VyIDw9IDFfMDAwLCBFUlJfSU5WQUxJRF9NQVhfUE9XRVIpCiAgICBpbnRjIDUgLy8gMTAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gcG93ZXIKICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRHb3Zlcm5hbmNlUG93ZXIoYWNjb3VudDogYnl0ZXMpIC0+IHVpbnQ2NDoKZ2V0R292ZXJuYW5jZVBvd2VyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3MwogICAgLy8gcHJpdmF0ZSBnZXRHb3Zlcm5hbmNlUG93ZXIoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3NAogICAgLy8gY29uc3QgeyBzdGFraW5nIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNzQKICAgIC8vIGNvbnN0IHsgc3Rha2luZyB9ID0gdGhpcy5ha2l0YUFwcExpc3QudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNzUKICAgIC8vIGNvbnN0IHsgYWt0YSwgYm9uZXMgfSA9IHRoaXMuYWtpdGFBc3NldHMudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIGFraXRhQXNzZXRzID0gR2xvYmFsU3RhdGU8QWtpdGFBc3NldHM+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzIH0pCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3NQogICAgLy8gY29uc3QgeyBha3RhLCBib25lcyB9ID0gdGhpcy5ha2l0YUFzc2V0cy52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mzc3CiAgICAvLyBpZiAoYWt0YSA9PT0gMCB8fCBib25lcyA9PT0gMCkgewogICAgYnogZ2V0R292ZXJuYW5jZVBvd2VyX2lmX2JvZHlAMgogICAgZnJhbWVfZGlnIDQKICAgIGJueiBnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUAzCgpnZXRHb3Zlcm5hbmNlUG93ZXJfaWZfYm9keUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM3OAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0R292ZXJuYW5jZVBvd2VyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozODEKICAgIC8vIGNvbnN0IGFrdGFQb3dlciA9IGdldFN0YWtpbmdQb3dlcihzdGFraW5nLCBhY2NvdW50LCBha3RhKQogICAgZnJhbWVfZGlnIDIKICAgIGR1cAogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgMwogICAgY2FsbHN1YiBnZXRTdGFraW5nUG93ZXIKICAgIGR1cAogICAgY292ZXIgMgogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzgyCiAgICAvLyBjb25zdCBib25lc1Bvd2VyID0gZ2V0U3Rha2luZ1Bvd2VyKHN0YWtpbmcsIGFjY291bnQsIGJvbmVzKQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgNAogICAgY2FsbHN1YiBnZXRTdGFraW5nUG93ZXIKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4NAogICAgLy8gaWYgKGFrdGFQb3dlciA9PT0gMCB8fCBib25lc1Bvd2VyID09PSAwKSB7CiAgICBieiBnZXRHb3Zlcm5hbmNlUG93ZXJfaWZfYm9keUA1CiAgICBmcmFtZV9kaWcgMQogICAgYm56IGdldEdvdmVybmFuY2VQb3dlcl9hZnRlcl9pZl9lbHNlQDYKCmdldEdvdmVybmFuY2VQb3dlcl9pZl9ib2R5QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6Mzg1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM4OQogICAgLy8gaWYgKGFrdGFQb3dlciA8IGJvbmVzUG93ZXIpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgMQogICAgPAogICAgYnogZ2V0R292ZXJuYW5jZVBvd2VyX2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5MAogICAgLy8gcmV0dXJuIGFrdGFQb3dlcgogICAgcmV0c3ViCgpnZXRHb3Zlcm5hbmNlUG93ZXJfYWZ0ZXJfaWZfZWxzZUA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5MwogICAgLy8gcmV0dXJuIGJvbmVzUG93ZXIKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uY3JlYXRlT3JVcGRhdGVQcm9wb3NhbChpZDogdWludDY0LCBjaWQ6IGJ5dGVzLCBhY3Rpb25zOiBieXRlcywgb3JpZ2luOiBieXRlcywgZmVlc1BhaWQ6IHVpbnQ2NCwgcG93ZXJSZXF1aXJlZDogdWludDY0KSAtPiB1aW50NjQsIGJ5dGVzOgpjcmVhdGVPclVwZGF0ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM5Ni00MDMKICAgIC8vIHByaXZhdGUgY3JlYXRlT3JVcGRhdGVQcm9wb3NhbCgKICAgIC8vICAgaWQ6IHVpbnQ2NCwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10sCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgZmVlc1BhaWQ6IHVpbnQ2NCwKICAgIC8vICAgcG93ZXJSZXF1aXJlZDogdWludDY0CiAgICAvLyApOiB1aW50NjQgewogICAgcHJvdG8gNiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA1CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPiAwLCBFUlJfRU1QVFlfQUNUSU9OX0xJU1QpCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBhc3NlcnQgLy8gQWN0aW9uIGxpc3QgY2Fubm90IGJlIGVtcHR5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA2CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPD0gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlLCBFUlJfVE9PX01BTllfQUNUSU9OUykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHByb3Bvc2FsQWN0aW9uTGltaXQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsQWN0aW9uTGltaXQgfSkKICAgIGJ5dGVjIDEzIC8vICJwcm9wb3NhbF9hY3Rpb25fbGltaXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA2CiAgICAvLyBhc3NlcnQoYWN0aW9ucy5sZW5ndGggPD0gdGhpcy5wcm9wb3NhbEFjdGlvbkxpbWl0LnZhbHVlLCBFUlJfVE9PX01BTllfQUNUSU9OUykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA8PQogICAgYXNzZXJ0IC8vIFRvbyBtYW55IGFjdGlvbnMgaW4gdGhlIHByb3Bvc2FsCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDA4CiAgICAvLyBpZiAodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MDgKICAgIC8vIGlmICh0aGlzLnN0YXRlLnZhbHVlICE9PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAzNCAvLyAweDAyCiAgICAhPQogICAgYnogY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MDkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsIEVSUl9GT1JCSURERU4pCiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgID09CiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciB3YWxsZXQgY2FuIGRlbGV0ZSBhIHNwZW5kaW5nIGFjY291bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MTEKICAgIC8vIGlkID0gdGhpcy5uZXdQcm9wb3NhbElEKCkKICAgIGNhbGxzdWIgbmV3UHJvcG9zYWxJRAogICAgZnJhbWVfYnVyeSAtNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQyMQogICAgLy8gY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MjMKICAgIC8vIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDE3CiAgICAvLyBhcHByb3ZhbHM6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxMy00MjYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkLAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IFR4bi5zZW5kZXIsCiAgICAvLyAgIHZvdGluZ1RzOiAwLAogICAgLy8gICBjcmVhdGVkOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBmZWVzUGFpZCwKICAgIC8vICAgYWN0aW9uczogY2xvbmUoYWN0aW9ucykKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtNQogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxNAogICAgLy8gc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0FwcHJvdmVkLAogICAgYnl0ZWMgOCAvLyAweDI4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDEzLTQyNgogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgLy8gICBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQsCiAgICAvLyAgIGNpZCwKICAgIC8vICAgdm90ZXM6IHsKICAgIC8vICAgICBhcHByb3ZhbHM6IDAsCiAgICAvLyAgICAgcmVqZWN0aW9uczogMCwKICAgIC8vICAgICBhYnN0YWluczogMCwKICAgIC8vICAgfSwKICAgIC8vICAgY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZnJhbWVfZGlnIC01CiAgICBjb25jYXQKICAgIGJ5dGVjIDUyIC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0yCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjIDUzIC8vIDB4MDA3NwogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTQKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQxMwogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgZnJhbWVfZGlnIC02CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDEzLTQyNgogICAgLy8gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlID0gewogICAgLy8gICBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzQXBwcm92ZWQsCiAgICAvLyAgIGNpZCwKICAgIC8vICAgdm90ZXM6IHsKICAgIC8vICAgICBhcHByb3ZhbHM6IDAsCiAgICAvLyAgICAgcmVqZWN0aW9uczogMCwKICAgIC8vICAgICBhYnN0YWluczogMCwKICAgIC8vICAgfSwKICAgIC8vICAgY3JlYXRvcjogVHhuLnNlbmRlciwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQ6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHJldHVybiBpZAogICAgZnJhbWVfZGlnIC02CiAgICBmcmFtZV9kaWcgLTQKICAgIHJldHN1YgoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDMxCiAgICAvLyBjb25zdCB1c2VyUG93ZXIgPSB0aGlzLmdldEdvdmVybmFuY2VQb3dlcihvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTMKICAgIGNhbGxzdWIgZ2V0R292ZXJuYW5jZVBvd2VyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDMzCiAgICAvLyBhc3NlcnQodXNlclBvd2VyID49IHBvd2VyUmVxdWlyZWQsIEVSUl9JTlNVRkZJQ0lFTlRfUFJPUE9TQUxfVEhSRVNIT0xEKQogICAgZnJhbWVfZGlnIC0xCiAgICA+PQogICAgYXNzZXJ0IC8vIEluc3VmZmljaWVudCBwcm9wb3NhbCB0aHJlc2hvbGQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MzYKICAgIC8vIGlmIChpZCA9PT0gMCkgewogICAgZnJhbWVfZGlnIC02CiAgICBibnogY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9lbHNlX2JvZHlANAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gaWQgPSB0aGlzLm5ld1Byb3Bvc2FsSUQoKQogICAgY2FsbHN1YiBuZXdQcm9wb3NhbElECiAgICBmcmFtZV9idXJ5IC02CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDM4CiAgICAvLyBjcmVhdGVkID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQ3CiAgICAvLyBhcHByb3ZhbHM6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0My00NTYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0RyYWZ0LAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgaXRvYgogICAgZnJhbWVfZGlnIC01CiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQ0CiAgICAvLyBzdGF0dXM6IFByb3Bvc2FsU3RhdHVzRHJhZnQsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDMtNDU2CiAgICAvLyB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIHN0YXR1czogUHJvcG9zYWxTdGF0dXNEcmFmdCwKICAgIC8vICAgY2lkLAogICAgLy8gICB2b3RlczogewogICAgLy8gICAgIGFwcHJvdmFsczogMCwKICAgIC8vICAgICByZWplY3Rpb25zOiAwLAogICAgLy8gICAgIGFic3RhaW5zOiAwLAogICAgLy8gICB9LAogICAgLy8gICBjcmVhdG9yOiBvcmlnaW4sCiAgICAvLyAgIHZvdGluZ1RzOiAwLAogICAgLy8gICBjcmVhdGVkLAogICAgLy8gICBmZWVzUGFpZCwKICAgIC8vICAgYWN0aW9uczogY2xvbmUoYWN0aW9ucykKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAtNQogICAgY29uY2F0CiAgICBieXRlYyA1MiAvLyAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTMKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMgogICAgaXRvYgogICAgY29uY2F0CiAgICBieXRlYyA1MyAvLyAweDAwNzcKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC00CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDMKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIGZyYW1lX2RpZyAtNgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBwcm9wb3NhbHMgPSBCb3hNYXA8dWludDY0LCBQcm9wb3NhbERldGFpbHM+KHsga2V5UHJlZml4OiBBa2l0YURBT0JveFByZWZpeFByb3Bvc2FscyB9KQogICAgYnl0ZWMgNCAvLyAibCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ0My00NTYKICAgIC8vIHRoaXMucHJvcG9zYWxzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgc3RhdHVzOiBQcm9wb3NhbFN0YXR1c0RyYWZ0LAogICAgLy8gICBjaWQsCiAgICAvLyAgIHZvdGVzOiB7CiAgICAvLyAgICAgYXBwcm92YWxzOiAwLAogICAgLy8gICAgIHJlamVjdGlvbnM6IDAsCiAgICAvLyAgICAgYWJzdGFpbnM6IDAsCiAgICAvLyAgIH0sCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdm90aW5nVHM6IDAsCiAgICAvLyAgIGNyZWF0ZWQsCiAgICAvLyAgIGZlZXNQYWlkLAogICAgLy8gICBhY3Rpb25zOiBjbG9uZShhY3Rpb25zKQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NTgKICAgIC8vIHJldHVybiBpZAogICAgZnJhbWVfZGlnIC02CiAgICBmcmFtZV9kaWcgLTQKICAgIHJldHN1YgoKY3JlYXRlT3JVcGRhdGVQcm9wb3NhbF9lbHNlX2JvZHlANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NDAKICAgIC8vIGNyZWF0ZWQgPSB0aGlzLnByb3Bvc2FscyhpZCkudmFsdWUuY3JlYXRlZAogICAgZnJhbWVfZGlnIC02CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTMKICAgIC8vIHByb3Bvc2FscyA9IEJveE1hcDx1aW50NjQsIFByb3Bvc2FsRGV0YWlscz4oeyBrZXlQcmVmaXg6IEFraXRhREFPQm94UHJlZml4UHJvcG9zYWxzIH0pCiAgICBieXRlYyA0IC8vICJsIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDQwCiAgICAvLyBjcmVhdGVkID0gdGhpcy5wcm9wb3NhbHMoaWQpLnZhbHVlLmNyZWF0ZWQKICAgIHB1c2hpbnQgMTAxIC8vIDEwMQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBiIGNyZWF0ZU9yVXBkYXRlUHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5hZGRQbHVnaW4oZGF0YTogYnl0ZXMpIC0+IGJ5dGVzOgphZGRQbHVnaW46CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTAzCiAgICAvLyBwcml2YXRlIGFkZFBsdWdpbihkYXRhOiBQcm9wb3NhbEFkZE5hbWVkUGx1Z2luKTogdm9pZCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MjMKICAgIC8vIH0gPSBjbG9uZShkYXRhKTsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBmcmFtZV9kaWcgLTEKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgY292ZXIgMgogICAgZXh0cmFjdCAyIDAKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAyIDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAxMCAzMgogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDYxIC8vIDYxCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgNDQgMQogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDQ1IDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA1MyA4CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMTA0IC8vIDEwNAogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDUwNCAvLyA1MDQKICAgIGdldGJpdAogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDUwNSAvLyA1MDUKICAgIGdldGJpdAogICAgZHVwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTA2IC8vIDUwNgogICAgZ2V0Yml0CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTA3IC8vIDUwNwogICAgZ2V0Yml0CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgNjQgOAogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDcyIDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA4MCA4CiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgODggOAogICAgc3dhcAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDk2IDgKICAgIHN3YXAKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAxMDYgLy8gMTA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGZyYW1lX2RpZyAtMQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUyNQogICAgLy8gaWYgKHVzZUV4ZWN1dGlvbktleSkgewogICAgYnogYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUyNi01MzIKICAgIC8vIHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBmZWUsCiAgICAvLyAgIHBvd2VyLAogICAgLy8gICBkdXJhdGlvbiwKICAgIC8vICAgcGFydGljaXBhdGlvbiwKICAgIC8vICAgYXBwcm92YWwKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAxNAogICAgZnJhbWVfZGlnIDE1CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAxNgogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMTcKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDE4CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MjYKICAgIC8vIHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLnZhbHVlID0gewogICAgZnJhbWVfZGlnIDUKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMgogICAgYnl0ZWMgNDYgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBwbHVnaW5zID0gQm94TWFwPERBT1BsdWdpbktleSwgUHJvcG9zYWxTZXR0aW5ncz4oeyBrZXlQcmVmaXg6IEFic3RyYWN0QWNjb3VudEJveFByZWZpeFBsdWdpbnMgfSkKICAgIGJ5dGVjIDQ3IC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTI2LTUzMgogICAgLy8gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIGZlZSwKICAgIC8vICAgcG93ZXIsCiAgICAvLyAgIGR1cmF0aW9uLAogICAgLy8gICBwYXJ0aWNpcGF0aW9uLAogICAgLy8gICBhcHByb3ZhbAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAoKYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzUKICAgIC8vIGlmIChuYW1lICE9PSAnJykgewogICAgZnJhbWVfZGlnIDEKICAgIGJ5dGVjXzMgLy8gIiIKICAgICE9CiAgICBieiBhZGRQbHVnaW5fZWxzZV9ib2R5QDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzYtNTU0CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkTmFtZWRQbHVnaW4+KHsKICAgIC8vICAgYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgbmFtZSwKICAgIC8vICAgICBwbHVnaW4sCiAgICAvLyAgICAgY2FsbGVyLAogICAgLy8gICAgIGVzY3JvdywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWxlZ2F0aW9uVHlwZSwKICAgIC8vICAgICBsYXN0VmFsaWQsCiAgICAvLyAgICAgY29vbGRvd24sCiAgICAvLyAgICAgbWV0aG9kcywKICAgIC8vICAgICB1c2VSb3VuZHMsCiAgICAvLyAgICAgdXNlRXhlY3V0aW9uS2V5LAogICAgLy8gICAgIGNvdmVyRmVlcywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWZhdWx0VG9Fc2Nyb3cKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzcKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1MzcKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTQ4CiAgICAvLyB1c2VSb3VuZHMsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTAKICAgIHNldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU0OQogICAgLy8gdXNlRXhlY3V0aW9uS2V5LAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDExCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTAKICAgIC8vIGNvdmVyRmVlcywKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMgogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTUyCiAgICAvLyBkZWZhdWx0VG9Fc2Nyb3cKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMwogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTM2LTU1NAogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZE5hbWVkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgcGx1Z2luLAogICAgLy8gICAgIGNhbGxlciwKICAgIC8vICAgICBlc2Nyb3csCiAgICAvLyAgICAgZmFsc2UsCiAgICAvLyAgICAgZGVsZWdhdGlvblR5cGUsCiAgICAvLyAgICAgbGFzdFZhbGlkLAogICAgLy8gICAgIGNvb2xkb3duLAogICAgLy8gICAgIG1ldGhvZHMsCiAgICAvLyAgICAgdXNlUm91bmRzLAogICAgLy8gICAgIHVzZUV4ZWN1dGlvbktleSwKICAgIC8vICAgICBjb3ZlckZlZXMsCiAgICAvLyAgICAgZmFsc2UsCiAgICAvLyAgICAgZGVmYXVsdFRvRXNjcm93CiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHg1NzJmZDFlYSAvLyBtZXRob2QgImFyYzU4X2FkZE5hbWVkUGx1Z2luKHN0cmluZyx1aW50NjQsYWRkcmVzcyxzdHJpbmcsYm9vbCx1aW50OCx1aW50NjQsdWludDY0LChieXRlWzRdLHVpbnQ2NClbXSxib29sLGJvb2wsYm9vbCxib29sLGJvb2wpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NDMKICAgIC8vIGZhbHNlLAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDYKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA4CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDkKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjUzNi01NTQKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9hZGROYW1lZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmFkZFBsdWdpbl9hZnRlcl9pZl9lbHNlQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTc2CiAgICAvLyBpZiAoZXNjcm93ICE9PSAnJyAmJiBhbGxvd2FuY2VzLmxlbmd0aCA+IDApIHsKICAgIGZyYW1lX2RpZyA1CiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYnogYWRkUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMTAKICAgIGZyYW1lX2RpZyAxOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBieiBhZGRQbHVnaW5fYWZ0ZXJfaWZfZWxzZUAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU3NwogICAgLy8gdGhpcy5hZGRBbGxvd2FuY2VzKGVzY3JvdywgYWxsb3dhbmNlcykKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMTkKICAgIGNhbGxzdWIgYWRkQWxsb3dhbmNlcwogICAgcG9wCgphZGRQbHVnaW5fYWZ0ZXJfaWZfZWxzZUAxMDoKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmFkZFBsdWdpbl9lbHNlX2JvZHlANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTYtNTczCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU1NwogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU1NwogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NjcKICAgIC8vIHVzZVJvdW5kcywKICAgIGJ5dGVjXzAgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAxMAogICAgc2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTY4CiAgICAvLyB1c2VFeGVjdXRpb25LZXksCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTEKICAgIHNldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2OQogICAgLy8gY292ZXJGZWVzLAogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDEyCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NzEKICAgIC8vIGRlZmF1bHRUb0VzY3JvdwogICAgYnl0ZWNfMCAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIDEzCiAgICBzZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1NTYtNTczCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHBsdWdpbiwKICAgIC8vICAgICBjYWxsZXIsCiAgICAvLyAgICAgZXNjcm93LAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlbGVnYXRpb25UeXBlLAogICAgLy8gICAgIGxhc3RWYWxpZCwKICAgIC8vICAgICBjb29sZG93biwKICAgIC8vICAgICBtZXRob2RzLAogICAgLy8gICAgIHVzZVJvdW5kcywKICAgIC8vICAgICB1c2VFeGVjdXRpb25LZXksCiAgICAvLyAgICAgY292ZXJGZWVzLAogICAgLy8gICAgIGZhbHNlLAogICAgLy8gICAgIGRlZmF1bHRUb0VzY3JvdwogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NTY3MjQ2MmUgLy8gbWV0aG9kICJhcmM1OF9hZGRQbHVnaW4odWludDY0LGFkZHJlc3Msc3RyaW5nLGJvb2wsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCxib29sKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU2MgogICAgLy8gZmFsc2UsCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA3CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDgKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgOQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBieXRlY18wIC8vIDB4MDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTU2LTU3MwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBwbHVnaW4sCiAgICAvLyAgICAgY2FsbGVyLAogICAgLy8gICAgIGVzY3JvdywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWxlZ2F0aW9uVHlwZSwKICAgIC8vICAgICBsYXN0VmFsaWQsCiAgICAvLyAgICAgY29vbGRvd24sCiAgICAvLyAgICAgbWV0aG9kcywKICAgIC8vICAgICB1c2VSb3VuZHMsCiAgICAvLyAgICAgdXNlRXhlY3V0aW9uS2V5LAogICAgLy8gICAgIGNvdmVyRmVlcywKICAgIC8vICAgICBmYWxzZSwKICAgIC8vICAgICBkZWZhdWx0VG9Fc2Nyb3cKICAgIC8vICAgXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBiIGFkZFBsdWdpbl9hZnRlcl9pZl9lbHNlQDcKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmFkZEFsbG93YW5jZXMoZXNjcm93OiBieXRlcywgYWxsb3dhbmNlczogYnl0ZXMpIC0+IGJ5dGVzOgphZGRBbGxvd2FuY2VzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4MQogICAgLy8gcHJpdmF0ZSBhZGRBbGxvd2FuY2VzKGVzY3Jvdzogc3RyaW5nLCBhbGxvd2FuY2VzOiBBZGRBbGxvd2FuY2VJbmZvW10pOiB2b2lkIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4Mi01ODUKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9hZGRBbGxvd2FuY2VzPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2VzY3JvdywgYWxsb3dhbmNlc10KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgzCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyB3YWxsZXQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0IH0pCiAgICBieXRlY18yIC8vICJ3YWxsZXQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTgzCiAgICAvLyBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU4NAogICAgLy8gYXJnczogW2VzY3JvdywgYWxsb3dhbmNlc10KICAgIGZyYW1lX2RpZyAtMgogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1ODItNTg1CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkQWxsb3dhbmNlcz4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtlc2Nyb3csIGFsbG93YW5jZXNdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4YmY0ZDdjNTcgLy8gbWV0aG9kICJhcmM1OF9hZGRBbGxvd2FuY2VzKHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZnJhbWVfZGlnIC0xCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnJlbW92ZVBsdWdpbihkYXRhOiBieXRlcykgLT4gYnl0ZXM6CnJlbW92ZVBsdWdpbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1OTUKICAgIC8vIHByaXZhdGUgcmVtb3ZlUGx1Z2luKGRhdGE6IFByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW4pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5NgogICAgLy8gY29uc3QgeyBuYW1lLCBwbHVnaW4sIGNhbGxlciwgZXNjcm93IH0gPSBkYXRhCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICB1bmNvdmVyIDIKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICBzd2FwCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMiA4CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDEwIDMyCiAgICBjb3ZlciAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgZnJhbWVfZGlnIC0xCiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTk4CiAgICAvLyBpZiAodGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkuZXhpc3RzKSB7CiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWMgNDYgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBwbHVnaW5zID0gQm94TWFwPERBT1BsdWdpbktleSwgUHJvcG9zYWxTZXR0aW5ncz4oeyBrZXlQcmVmaXg6IEFic3RyYWN0QWNjb3VudEJveFByZWZpeFBsdWdpbnMgfSkKICAgIGJ5dGVjIDQ3IC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo1OTgKICAgIC8vIGlmICh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjU5OQogICAgLy8gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkuZGVsZXRlKCkKICAgIGZyYW1lX2RpZyA1CiAgICBib3hfZGVsCiAgICBwb3AKCnJlbW92ZVBsdWdpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAyCiAgICAvLyBpZiAobmFtZSAhPT0gJycpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBieXRlY18zIC8vICIiCiAgICAhPQogICAgYnogcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYwMy02MDYKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZW1vdmVOYW1lZFBsdWdpbj4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtuYW1lXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDQKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDQKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjAzLTYwNgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3JlbW92ZU5hbWVkUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW25hbWVdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZTM1MGI5ZDQgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVOYW1lZFBsdWdpbihzdHJpbmcpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MDcKICAgIC8vIHJldHVybgogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKcmVtb3ZlUGx1Z2luX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTAtNjEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW3BsdWdpbiwgY2FsbGVyLCBlc2Nyb3ddCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czozNAogICAgLy8gd2FsbGV0ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCB9KQogICAgYnl0ZWNfMiAvLyAid2FsbGV0IgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXBwSWQ6IHRoaXMud2FsbGV0LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2MTAtNjEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVtb3ZlUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW3BsdWdpbiwgY2FsbGVyLCBlc2Nyb3ddCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZWVmNDQ4ZmQgLy8gbWV0aG9kICJhcmM1OF9yZW1vdmVQbHVnaW4odWludDY0LGFkZHJlc3Msc3RyaW5nKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3RXhlY3V0aW9uKGtleTogYnl0ZXMsIGdyb3VwczogYnl0ZXMsIGZpcnN0VmFsaWQ6IHVpbnQ2NCwgbGFzdFZhbGlkOiB1aW50NjQpIC0+IGJ5dGVzOgpuZXdFeGVjdXRpb246CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQxCiAgICAvLyBwcml2YXRlIG5ld0V4ZWN1dGlvbihrZXk6IGJ5dGVzPDMyPiwgZ3JvdXBzOiBieXRlczwzMj5bXSwgZmlyc3RWYWxpZDogdWludDY0LCBsYXN0VmFsaWQ6IHVpbnQ2NCk6IHZvaWQgewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQyLTc0NQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2FkZEV4ZWN1dGlvbktleT4oewogICAgLy8gICBhcHBJZDogdGhpcy53YWxsZXQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtrZXksIGdyb3VwcywgZmlyc3RWYWxpZCwgbGFzdFZhbGlkXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDMKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MzQKICAgIC8vIHdhbGxldCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQgfSkKICAgIGJ5dGVjXzIgLy8gIndhbGxldCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDMKICAgIC8vIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzQ0CiAgICAvLyBhcmdzOiBba2V5LCBncm91cHMsIGZpcnN0VmFsaWQsIGxhc3RWYWxpZF0KICAgIGZyYW1lX2RpZyAtNAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZnJhbWVfZGlnIC0yCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NDItNzQ1CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfYWRkRXhlY3V0aW9uS2V5Pih7CiAgICAvLyAgIGFwcElkOiB0aGlzLndhbGxldC52YWx1ZSwKICAgIC8vICAgYXJnczogW2tleSwgZ3JvdXBzLCBmaXJzdFZhbGlkLCBsYXN0VmFsaWRdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NWNlYmVkNDMgLy8gbWV0aG9kICJhcmM1OF9hZGRFeGVjdXRpb25LZXkoYnl0ZVszMl0sYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC00CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0zCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBmcmFtZV9kaWcgLTMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ubmV3UHJvcG9zYWxJRCgpIC0+IHVpbnQ2NDoKbmV3UHJvcG9zYWxJRDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTYKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wcm9wb3NhbElELnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyBwcm9wb3NhbElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNQcm9wb3NhbElEIH0pCiAgICBieXRlYyAzNyAvLyAicHJvcG9zYWxfaWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzU2CiAgICAvLyBjb25zdCBpZCA9IHRoaXMucHJvcG9zYWxJRC52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTcKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSArPSAxCiAgICBkdXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHByb3Bvc2FsSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1Byb3Bvc2FsSUQgfSkKICAgIGJ5dGVjIDM3IC8vICJwcm9wb3NhbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NTcKICAgIC8vIHRoaXMucHJvcG9zYWxJRC52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjc1OAogICAgLy8gcmV0dXJuIGlkCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnNldHVwQ29zdCgpIC0+IHVpbnQ2NDoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5zZXR1cENvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI1NwogICAgLy8gY29uc3QgeyB3YWxsZXQ6IGFwcElkIH0gPSB0aGlzLmFraXRhQXBwTGlzdC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0MgogICAgLy8gYWtpdGFBcHBMaXN0ID0gR2xvYmFsU3RhdGU8QWtpdGFBcHBMaXN0Pih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QgfSkKICAgIGJ5dGVjIDkgLy8gImFhbCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjU3CiAgICAvLyBjb25zdCB7IHdhbGxldDogYXBwSWQgfSA9IHRoaXMuYWtpdGFBcHBMaXN0LnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGludCA4OCAvLyA4OAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjU4CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnRGYWN0b3J5LnByb3RvdHlwZS5jb3N0Pih7IGFwcElkIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHg5YjY3ZmE2ZCAvLyBtZXRob2QgImNvc3QoKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0KGFjdGlvbnM6IGJ5dGVzKSAtPiBieXRlcywgYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNjEtMTI2MgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICAvLyBwcm9wb3NhbENvc3QoYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IFByb3Bvc2FsQ29zdEluZm8gewogICAgcHJvdG8gMSAyCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiA5CiAgICBieXRlY18zIC8vICIiCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDUKICAgIC8vIGxldCB0b3RhbEFjdGlvbkRhdGFMZW5ndGg6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGR1cAoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rfd2hpbGVfdG9wQDUzOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwNgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTAKICAgIGZyYW1lX2RpZyAxNAogICAgPgogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfd2hpbGVANTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMDcKICAgIC8vIHRvdGFsQWN0aW9uRGF0YUxlbmd0aCArPSAoTWluREFPUHJvcG9zYWxBY3Rpb25NYnIgKyBhY3Rpb25zW2ldLmRhdGEubGVuZ3RoKQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDIgMAogICAgZnJhbWVfZGlnIDE0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxNAogICAgZnJhbWVfZGlnIDEwCiAgICBkaWcgMQogICAgLSAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyA0CiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgbGVuCiAgICBwdXNoaW50IDcgLy8gNwogICAgKwogICAgZnJhbWVfZGlnIDEzCiAgICArCiAgICBmcmFtZV9idXJ5IDEzCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X3doaWxlX3RvcEA1MwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfd2hpbGVANTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTEwCiAgICAvLyByZXR1cm4gTWluREFPUHJvcG9zYWxNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiB0b3RhbEFjdGlvbkRhdGFMZW5ndGgpCiAgICBwdXNoaW50IDQwMCAvLyA0MDAKICAgIGZyYW1lX2RpZyAxMwogICAgKgogICAgcHVzaGludCA1NDUwMCAvLyA1NDUwMAogICAgKwogICAgZnJhbWVfYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNjUKICAgIC8vIHRvdGFsOiAwLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjY0LTEyNzIKICAgIC8vIGxldCBpbmZvOiBQcm9wb3NhbENvc3RJbmZvID0gewogICAgLy8gICB0b3RhbDogMCwKICAgIC8vICAgbWJyOiAwLAogICAgLy8gICBmZWU6IDAsCiAgICAvLyAgIHBvd2VyOiAwLAogICAgLy8gICBkdXJhdGlvbjogMCwKICAgIC8vICAgcGFydGljaXBhdGlvbjogMCwKICAgIC8vICAgYXBwcm92YWw6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2J1cnkgNQogICAgcHVzaGJ5dGVzIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgZnJhbWVfYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFjdGlvbnMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDE0CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF93aGlsZV90b3BAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc0CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYWN0aW9ucy5sZW5ndGg7IGkrKykgewogICAgZnJhbWVfZGlnIDE0CiAgICBmcmFtZV9kaWcgMTAKICAgIDwKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX3doaWxlQDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3NgogICAgLy8gY29uc3QgeyB0eXBlLCBkYXRhIH0gPSBhY3Rpb25zW2ldCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMiAwCiAgICBmcmFtZV9kaWcgMTQKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDE0CiAgICBmcmFtZV9kaWcgMTAKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDAgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBmcmFtZV9idXJ5IDkKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNAogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2J1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICBieXRlYyAyMiAvLyAweDBhCiAgICA9PQogICAgZHVwCiAgICBmcmFtZV9idXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDYzLTQ2NQogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnVwZ3JhZGVBcHBQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY0CiAgICAvLyByZXR1cm4gdGhpcy51cGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo2OAogICAgLy8gdXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNVcGdyYWRlQXBwUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTUgLy8gInVwZ3JhZGVfYXBwX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2NAogICAgLy8gcmV0dXJuIHRoaXMudXBncmFkZUFwcFByb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRQcm9wb3NhbFNldHRpbmdzQDUxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODAKICAgIC8vIGluZm8udG90YWwgKz0gc2V0dGluZ3MuZmVlCiAgICBmcmFtZV9kaWcgNwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2RpZyA4CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGRpZyAxCiAgICArCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIHJlcGxhY2UyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjgxCiAgICAvLyBpbmZvLmZlZSArPSBzZXR0aW5ncy5mZWUKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgKwogICAgaXRvYgogICAgcmVwbGFjZTIgMTYKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODMKICAgIC8vIGlmICh0eXBlID09PSBQcm9wb3NhbEFjdGlvblR5cGVVcGdyYWRlQXBwKSB7CiAgICBmcmFtZV9kaWcgMTIKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4NAogICAgLy8gbWJyICs9IERBT0V4ZWN1dGlvbk1CUgogICAgZnJhbWVfZGlnIDExCiAgICBwdXNoaW50IDIyMTAwIC8vIDIyMTAwCiAgICArCiAgICBmcmFtZV9idXJ5IDExCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODkKICAgIC8vIGlmIChzZXR0aW5ncy5wb3dlciA+IGluZm8ucG93ZXIpIHsKICAgIGZyYW1lX2RpZyA4CiAgICBkdXAKICAgIGV4dHJhY3QgOCA4CiAgICBmcmFtZV9idXJ5IDMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfZGlnIDcKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICA+CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI5MAogICAgLy8gaW5mby5wb3dlciA9IHNldHRpbmdzLnBvd2VyCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDMKICAgIHJlcGxhY2UyIDI0CiAgICBmcmFtZV9idXJ5IDcKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI5MwogICAgLy8gaWYgKHNldHRpbmdzLmR1cmF0aW9uID4gaW5mby5kdXJhdGlvbikgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAxNiA4CiAgICBmcmFtZV9idXJ5IDAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk0CiAgICAvLyBpbmZvLmR1cmF0aW9uID0gc2V0dGluZ3MuZHVyYXRpb24KICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9kaWcgMAogICAgcmVwbGFjZTIgMzIKICAgIGZyYW1lX2J1cnkgNwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk3CiAgICAvLyBpZiAoc2V0dGluZ3MucGFydGljaXBhdGlvbiA+IGluZm8ucGFydGljaXBhdGlvbikgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAyNCA4CiAgICBmcmFtZV9idXJ5IDEKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjk4CiAgICAvLyBpbmZvLnBhcnRpY2lwYXRpb24gPSBzZXR0aW5ncy5wYXJ0aWNpcGF0aW9uCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDEKICAgIHJlcGxhY2UyIDQwCiAgICBmcmFtZV9idXJ5IDcKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMwMQogICAgLy8gaWYgKHNldHRpbmdzLmFwcHJvdmFsID4gaW5mby5hcHByb3ZhbCkgewogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgZXh0cmFjdCAzMiA4CiAgICBmcmFtZV9idXJ5IDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgNwogICAgcHVzaGludCA0OCAvLyA0OAogICAgZXh0cmFjdF91aW50NjQKICAgID4KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X3doaWxlX3RvcEAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMwMgogICAgLy8gaW5mby5hcHByb3ZhbCA9IHNldHRpbmdzLmFwcHJvdmFsCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIDIKICAgIHJlcGxhY2UyIDQ4CiAgICBmcmFtZV9idXJ5IDcKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3Rfd2hpbGVfdG9wQDMKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODUKICAgIC8vIH0gZWxzZSBpZiAodHlwZSA9PT0gUHJvcG9zYWxBY3Rpb25UeXBlQWRkUGx1Z2luIHx8IHR5cGUgPT09IFByb3Bvc2FsQWN0aW9uVHlwZUFkZE5hbWVkUGx1Z2luKSB7CiAgICBmcmFtZV9kaWcgOQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICA9PQogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2lmX2JvZHlAOAogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDM1IC8vIDB4MTUKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDEwCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9pZl9ib2R5QDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4NgogICAgLy8gbWJyICs9IHRoaXMucGx1Z2luc01icihTdHJpbmcob3AuYnplcm8oMjQpKSkKICAgIGZyYW1lX2RpZyAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gcmV0dXJuIE1pbkRBT1BsdWdpbk1CUiArIChCb3hDb3N0UGVyQnl0ZSAqIEJ5dGVzKGVzY3JvdykubGVuZ3RoKQogICAgcHVzaGludCAzMzMwMCAvLyAzMzMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyODYKICAgIC8vIG1iciArPSB0aGlzLnBsdWdpbnNNYnIoU3RyaW5nKG9wLmJ6ZXJvKDI0KSkpCiAgICArCiAgICBmcmFtZV9idXJ5IDExCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMTAKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY2CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZFBsdWdpbjoKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBibnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NjcKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAzNSAvLyAweDE1CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ2Ny00NjkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkTmFtZWRQbHVnaW46IHsKICAgIC8vICAgcmV0dXJuIHRoaXMuYWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAzMwoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY4CiAgICAvLyByZXR1cm4gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcwCiAgICAvLyBhZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTYgLy8gImFkZF9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDY4CiAgICAvLyByZXR1cm4gdGhpcy5hZGRQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDcwCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUV4ZWN1dGVQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAxMCAvLyAweDFlCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ3MC00NzQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlRXhlY3V0ZVBsdWdpbjogewogICAgLy8gICBjb25zdCB7IHBsdWdpbiwgZXNjcm93IH0gPSBkZWNvZGVBcmM0PFByb3Bvc2FsRXhlY3V0ZVBsdWdpbj4oZGF0YSkKICAgIC8vICAgYXNzZXJ0KHRoaXMucGx1Z2lucyh7IHBsdWdpbiwgZXNjcm93IH0pLmV4aXN0cywgRVJSX1BST1BPU0FMX0RPRVNfTk9UX0VYSVNUKQogICAgLy8gICByZXR1cm4gdGhpcy5wbHVnaW5zKHsgcGx1Z2luLCBlc2Nyb3cgfSkudmFsdWUKICAgIC8vIH0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzEKICAgIC8vIGNvbnN0IHsgcGx1Z2luLCBlc2Nyb3cgfSA9IGRlY29kZUFyYzQ8UHJvcG9zYWxFeGVjdXRlUGx1Z2luPihkYXRhKQogICAgZnJhbWVfZGlnIDQKICAgIGV4dHJhY3QgMiA4CiAgICBmcmFtZV9kaWcgNgogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQxNgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzIKICAgIC8vIGFzc2VydCh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyA0NiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTEKICAgIC8vIHBsdWdpbnMgPSBCb3hNYXA8REFPUGx1Z2luS2V5LCBQcm9wb3NhbFNldHRpbmdzPih7IGtleVByZWZpeDogQWJzdHJhY3RBY2NvdW50Qm94UHJlZml4UGx1Z2lucyB9KQogICAgYnl0ZWMgNDcgLy8gInAiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzIKICAgIC8vIGFzc2VydCh0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9QUk9QT1NBTF9ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUHJvcG9zYWwgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzMKICAgIC8vIHJldHVybiB0aGlzLnBsdWdpbnMoeyBwbHVnaW4sIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUAzNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzUKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlRXhlY3V0ZVBsdWdpbjogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDM5IC8vIDB4MWYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc1LTQ3NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVFeGVjdXRlUGx1Z2luOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDM3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3NAogICAgLy8gcmVtb3ZlRXhlY3V0ZVBsdWdpblByb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVFeGVjdXRlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNDkgLy8gInJlbW92ZV9leGVjdXRlX3BsdWdpbl9wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzYKICAgIC8vIHJldHVybiB0aGlzLnJlbW92ZUV4ZWN1dGVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDc4CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVJlbW92ZVBsdWdpbjoKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA4IC8vIDB4MjgKICAgID09CiAgICBibnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0NzkKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA0MCAvLyAweDI5CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ3OS00ODEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlTmFtZWRQbHVnaW46IHsKICAgIC8vICAgcmV0dXJuIHRoaXMucmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MAoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYmxvY2tAMzk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgwCiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyByZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUmVtb3ZlUGx1Z2luUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTcgLy8gInJlbW92ZV9wbHVnaW5fcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgwCiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVQbHVnaW5Qcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDgyCiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZUFkZEFsbG93YW5jZXM6IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyAxMiAvLyAweDMyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4Mi00ODQKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlQWRkQWxsb3dhbmNlczogewogICAgLy8gICByZXR1cm4gdGhpcy5hZGRBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYnogc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gcmV0dXJuIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NzYKICAgIC8vIGFkZEFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgfSkKICAgIGJ5dGVjIDE4IC8vICJhZGRfYWxsb3dhbmNlX3BzIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gcmV0dXJuIHRoaXMuYWRkQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODUKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlUmVtb3ZlQWxsb3dhbmNlczogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQyIC8vIDB4M2MKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg1LTQ4NwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVSZW1vdmVBbGxvd2FuY2VzOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnJlbW92ZUFsbG93YW5jZXNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo3OAogICAgLy8gcmVtb3ZlQWxsb3dhbmNlc1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMTkgLy8gInJlbW92ZV9hbGxvd2FuY2VfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDg2CiAgICAvLyByZXR1cm4gdGhpcy5yZW1vdmVBbGxvd2FuY2VzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEyNzgKICAgIC8vIGNvbnN0IHNldHRpbmdzID0gdGhpcy5nZXRQcm9wb3NhbFNldHRpbmdzKHR5cGUsIGRhdGEpCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5nZXRQcm9wb3NhbFNldHRpbmdzQDUxCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4OAogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVOZXdFc2Nyb3c6IHsKICAgIGZyYW1lX2RpZyA5CiAgICBieXRlYyA0MyAvLyAweDQ2CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjQ4OC00OTAKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlTmV3RXNjcm93OiB7CiAgICAvLyAgIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIC8vIH0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODAKICAgIC8vIG5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNOZXdFc2Nyb3dQcm9wb3NhbFNldHRpbmdzIH0pCiAgICBieXRlYyAyMCAvLyAibmV3X2VzY3Jvd19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHJldHVybiB0aGlzLm5ld0VzY3Jvd1Byb3Bvc2FsU2V0dGluZ3MudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9idXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMjc4CiAgICAvLyBjb25zdCBzZXR0aW5ncyA9IHRoaXMuZ2V0UHJvcG9zYWxTZXR0aW5ncyh0eXBlLCBkYXRhKQogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8uZ2V0UHJvcG9zYWxTZXR0aW5nc0A1MQoKc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaWZfZWxzZUA0NjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OTEKICAgIC8vIGNhc2UgUHJvcG9zYWxBY3Rpb25UeXBlVG9nZ2xlRXNjcm93TG9jazogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQ0IC8vIDB4NDcKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDkxLTQ5MwogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVUb2dnbGVFc2Nyb3dMb2NrOiB7CiAgICAvLyAgIHJldHVybiB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2tQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICAvLyB9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDkyCiAgICAvLyByZXR1cm4gdGhpcy50b2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdG9nZ2xlRXNjcm93TG9ja1Byb3Bvc2FsU2V0dGluZ3MgPSBHbG9iYWxTdGF0ZTxQcm9wb3NhbFNldHRpbmdzPih7IGtleTogQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNUb2dnbGVFc2Nyb3dMb2NrUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgNTAgLy8gInRvZ2dsZV9lc2Nyb3dfbG9ja19wcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo0OTIKICAgIC8vIHJldHVybiB0aGlzLnRvZ2dsZUVzY3Jvd0xvY2tQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX2lmX2Vsc2VANDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk0CiAgICAvLyBjYXNlIFByb3Bvc2FsQWN0aW9uVHlwZVVwZGF0ZUZpZWxkczogewogICAgZnJhbWVfZGlnIDkKICAgIGJ5dGVjIDQ1IC8vIDB4NTAKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk0LTQ5NgogICAgLy8gY2FzZSBQcm9wb3NhbEFjdGlvblR5cGVVcGRhdGVGaWVsZHM6IHsKICAgIC8vICAgcmV0dXJuIHRoaXMudXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncy52YWx1ZQogICAgLy8gfQogICAgYXNzZXJ0IC8vIEludmFsaWQgcHJvcG9zYWwgYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyByZXR1cm4gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyB1cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzID0gR2xvYmFsU3RhdGU8UHJvcG9zYWxTZXR0aW5ncz4oeyBrZXk6IEFraXRhREFPR2xvYmFsU3RhdGVLZXlzVXBkYXRlRmllbGRzUHJvcG9zYWxTZXR0aW5ncyB9KQogICAgYnl0ZWMgMjEgLy8gInVwZGF0ZV9maWVsZHNfcHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyByZXR1cm4gdGhpcy51cGRhdGVGaWVsZHNQcm9wb3NhbFNldHRpbmdzLnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI3OAogICAgLy8gY29uc3Qgc2V0dGluZ3MgPSB0aGlzLmdldFByb3Bvc2FsU2V0dGluZ3ModHlwZSwgZGF0YSkKICAgIGIgc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBTy5wcm9wb3NhbENvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLmdldFByb3Bvc2FsU2V0dGluZ3NANTEKCnNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2FmdGVyX3doaWxlQDE5OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMDkKICAgIC8vIGlmIChpbmZvLnRvdGFsIDwgbWJyKSB7CiAgICBmcmFtZV9kaWcgNwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgMTEKICAgIDwKICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU8ucHJvcG9zYWxDb3N0X2Vsc2VfYm9keUAyMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTAKICAgIC8vIGluZm8udG90YWwgPSBtYnIKICAgIGZyYW1lX2RpZyAxMQogICAgaXRvYgogICAgZnJhbWVfZGlnIDcKICAgIGRpZyAxCiAgICByZXBsYWNlMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxMQogICAgLy8gaW5mby5tYnIgPSBtYnIKICAgIHN3YXAKICAgIHJlcGxhY2UyIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzEyCiAgICAvLyBpbmZvLmZlZSA9IDAKICAgIGZyYW1lX2RpZyA1CiAgICByZXBsYWNlMiAxNgogICAgZnJhbWVfYnVyeSA3CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTgKICAgIC8vIGlmICh0aGlzLnN0YXRlLnZhbHVlICE9PSBEYW9TdGF0ZUZ1bGx5SW5pdGlhbGl6ZWQgJiYgVHhuLnNlbmRlciA9PT0gR2xvYmFsLmNyZWF0b3JBZGRyZXNzKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjMwCiAgICAvLyBzdGF0ZSA9IEdsb2JhbFN0YXRlPERhb1N0YXRlPih7IGluaXRpYWxWYWx1ZTogRGFvU3RhdGVEcmFmdCwga2V5OiBBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0luaXRpYWxpemVkIH0pCiAgICBieXRlYyA3IC8vICJpbml0aWFsaXplZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vY29udHJhY3QuYWxnby50czoxMzE4CiAgICAvLyBpZiAodGhpcy5zdGF0ZS52YWx1ZSAhPT0gRGFvU3RhdGVGdWxseUluaXRpYWxpemVkICYmIFR4bi5zZW5kZXIgPT09IEdsb2JhbC5jcmVhdG9yQWRkcmVzcykgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDM0IC8vIDB4MDIKICAgICE9CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1CiAgICB0eG4gU2VuZGVyCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxOQogICAgLy8gaW5mby50b3RhbCA9IDAKICAgIGZyYW1lX2RpZyA3CiAgICBmcmFtZV9kaWcgNQogICAgZHVwCiAgICBjb3ZlciAyCiAgICByZXBsYWNlMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMyMAogICAgLy8gaW5mby5mZWUgPSAwCiAgICBzd2FwCiAgICByZXBsYWNlMiAxNgogICAgZnJhbWVfYnVyeSA3CgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMjMKICAgIC8vIHJldHVybiBpbmZvCiAgICBmcmFtZV9kaWcgNwogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9idXJ5IDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9lbHNlX2JvZHlAMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxNAogICAgLy8gaW5mby5tYnIgPSBtYnIKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgNwogICAgc3dhcAogICAgcmVwbGFjZTIgOAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMTUKICAgIC8vIGluZm8uZmVlID0gaW5mby50b3RhbCAtIG1icgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgLQogICAgaXRvYgogICAgcmVwbGFjZTIgMTYKICAgIGZyYW1lX2J1cnkgNwogICAgYiBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPLnByb3Bvc2FsQ29zdF9hZnRlcl9pZl9lbHNlQDIyCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAHAAEIAqCNBugHwIQ9JjYBAAQVH3x1BndhbGxldAABbANvYWwBFAtpbml0aWFsaXplZAEoA2FhbAEeDGFraXRhX2Fzc2V0cwEyFXByb3Bvc2FsX2FjdGlvbl9saW1pdANwYWwOdXBncmFkZV9hcHBfcHMNYWRkX3BsdWdpbl9wcxByZW1vdmVfcGx1Z2luX3BzEGFkZF9hbGxvd2FuY2VfcHMTcmVtb3ZlX2FsbG93YW5jZV9wcw1uZXdfZXNjcm93X3BzEHVwZGF0ZV9maWVsZHNfcHMBCgd2ZXJzaW9uDmNvbnRlbnRfcG9saWN5Em1pbl9yZXdhcmRzX2ltcGFjdANzYWwLd2FsbGV0X2ZlZXMLc29jaWFsX2ZlZXMMc3Rha2luZ19mZWVzEXN1YnNjcmlwdGlvbl9mZWVzCG5mdF9mZWVzCXN3YXBfZmVlcw5yZXZlbnVlX3NwbGl0cwECARUEAAEAAgtwcm9wb3NhbF9pZAIAKgEfASkCAAABPAFGAUcBUAIACgFwEAAAAAAAAAFtAAAAAAAPQkAYcmVtb3ZlX2V4ZWN1dGVfcGx1Z2luX3BzFXRvZ2dsZV9lc2Nyb3dfbG9ja19wcwSJp2gmGAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAdzEYQAAIJwcoZycXK2eABOqRgN02GgCOAQCYMRkURDEYQQCBghEE2brNlARTxHVmBP0sk80EAZj+OwT+IMJpBMs35mgEKPgBVAQQOmuIBKSyNrYEYs6bWwTx/yiqBEkBO18ElpnRCASMVkdoBB+fiyAE277G0gSFTe3gNhoAjhEEAQR0BIIEjwneCjkKsQr8CyEMhw29EvUTdxOBE44TqQABACNDgAQOsLl7NhoAjgEBNgAxGYEEEjEYEERCA7eKAgGL/yJbSUEAIYv/JFtBABojRIv+IluL/iRbHYv/JFuLAB0fRgJMFERMiSJC/+OKAgErsYAEPBpvM7Iai/+yGov+shiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJESSJZJQhMFRJEVwYASRVJQQAHiwIkE0EAKCKMAIsAQAAFMQCMAImLAIASY29udHJvbGxlZF9hZGRyZXNzZUiMAImLAReMAEL/04oDASuxi/8WJwhQgATJBogJshqL/rIashqL/bIYgQayECKyAbO0PkcCVwQASwFXAAQpEkQVgRgSRIEUW0kyBw5BAAQijACJiwIyBwlJjACBgPUkDEEABCKMAImLAIGAowUKiwFXBAghBhZQSScwiP7rSwIdSE4CJzCI/uAdRQEhBpeMAIk2GgFJIlklCEsBFRJEVwIANhoCSRUkEkQXNhoDSRWBJBJENhoESRUkEkQXNhoFSRWByAESRDYaBkkVgYgCEkQ2GgdJFYHoAhJENhoIIicXZUQrEkRLBysTRCcXTwhnJw2BBWdPBhYiFlAnC0xnJxhPBmcnGU8FZ0sDVwAISwRXCAhLBVcQCEsGVxgISwdXIAhLCFcoCEsJVzAISwpXOAhLC1dACEsMV0gISw1XUAhLDlewCE8LTwtQTwpQTwlQTwhQTwdQTwZQTwVQTwRQTwNQTwJQTFAnCUxnSwNXeAhLBFeACEsFV4gISwZXkAhPA08DUE8CUExQJxpMZ0sDV2AISwRXaAhLBVdwCE4CUExQJw5MZ0sDV5gISwRXoAhLBVeoCEsGV7gISwdXWAhPCFfACE8FTwVQTwRQTwNQTFBMUCcFTGdLAlcACEsDVwgIUCcbTGdLAlcQCEsDVxgISwRXIAhLBVcoCE8DTwNQTwJQTFAnHExnSwJXMAhLA1c4CEsEV0AITgJQTFAnHUxnSwJXSAhLA1dQCEsEV1gITgJQTFAnHkxnSwJXYAhLA1doCEsEV3AISwVXeAhLBleACEsHV4gISwhXkAhLCVeYCEsKV6AISwtXqAhLDFewCEsNV7gISw5XwAhLD1fICEsQV9AITw5PDlBPDVBPDFBPC1BPClBPCVBPCFBPB1BPBlBPBVBPBFBPA1BPAlBMUCcfTGdLAlfYCE8DV+AIUCcgTGdLAVcAKCcPTGdLAVcoKCcQTGdLAVdQKCcxTGdLAVd4KCcRTGdLAVegKCcSTGdLAVfIKCcTTGdLAVfwKCcUTGdLAYMCmAIoWCcyTGdMgwLAAihYJxVMZychTGcnJSNnI0M2GgFJIlklCEsBFRJEVwIAMQAiKmVEcghEEkQiJw5lRIEQWzINEkQnF0xnI0M2GgFJIlklCEsBFRJEIiplRQEURCInCWVEgVhbiBXcsUsBcghEsgeyCCOyECKyAbYyAzIKMgOABDdIVUGyGk8CshpMshpPArIashqyGIEGshAisgGztwE+SVcEAExXAAQpEkRJFSQSREkXKkxnKUxQsCNDMQAyCRJEJweAAQFnI0MxADIJEkQnByciZyNDIkcPK0cDMRYjCUk4ECMSRDYaAUkVgSQSRDYaAiInBWVEgRhbMQCI+5VMiBVvTEkiW0yBGFsiSScHZUQnIhJAABQiJwdlRIABARJBAAwxADIJE0EABEsCRQFLBkk4BzIKEkw4CEsCEhBESwMiWUlFDEQiRQlLCEsLDEEEvUsDVwIASwlJTgIlC0sBTFlPAiMISUUMSw1LAQlLAxVPAiULSwRMWU8CTVJJRRVXAAFJRRknFhJBACxLE0kjWUsBFVJJVwIASYEoWUsBFVJLAYEsW08CgTRbTwIiWURLAUQMREL/kksXJwYSQQCHSxNJI1lLARVSSVcCAEsBVwIIRRlLAVcKIEUSSYEoWUsBgTtZSwJOAlJXAgBFEUsBV0AIRRhLAVdICEUXSwFXUAhFHUsBV1gIRRxMV2AIRRqB6QNTQQASSxVLFVBLG1BLGlBLGVCIECdISxZLEFBLD0kVFlcGAkxQTCcmUExQiA3KSBREQv8DSxcnIxJBACBLE0kjWUsBFVJXAgBJIllLAYEqWVJXAgCIDe0UREL+20sXJwoSQQCfSxNJI1lLARVSSVcCAExXAghLASRZSwKBKllPA04CUlcCADIDTwJMUEsBFRZXBgJPAlBMJyZQTFCxIiplRCckTwJQJzOyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJETFcGAEwiWUsBIllMIwlLAhVLAyVZTwJNUklFFEmBJFtESYHaAVNEgdkBU0EADDIGSxOBCVsMREL+OTIHQv/xSxcnJxJBAGdLE0kjWUsBFVJXAiCxIiplREsBFYEgEkSAAgABTwJQgARBvcaAshqyGrIYgQayECKyAbO0PklXBABLAVcABCkSRExXBgBMIllLASJZTCMJSwIVSwMlWU8CTVJJIllLARVSIllEQv3FSxcnCBJBAEJLE0kjWUsBFVJJVwIASwFXAghPAlcKIEsCgShZSwMVTwROAlJXAgBOAlBLARUWVwYCTwJQTCcmUExQiAxBSERC/XtLFycoEkEAH0sTSSNZSwEVUlcCAEkiWUsBgSpZUlcCAIgMZURC/VRLFycMEkEAeEsTSSNZSwEVUlcCAEkiWUsBJVlLAk8CSwJSVwIARRBLARVSSUUTIllJRQtEJylFESJFCEsHSwoMQQAtSxFXAgBLCElOAoEiC4EiWFcACEsSSU8CUEwiWSMIFlcGAlwARRIjCEUIQv/LSw1LEYgMjUiBEFMUREL81EsXJyoSQQA2SxNJI1lLARVSVwIASSJZSwElWUsCTwJLAlJXAgBLAhVPA08DTwJSSSJZRIgMTkiBEVNEQvyWSxcnKxJBAB5LE0kjWUsBFVJXAgBJIllLARVSVwIAiAvZFERC/HBLFycsEkEAHUsTSSNZSwEVUlcCAEkiWUsBFVJXAgCIC7NEQvxLSxcnLRJESxNJI1lLARVSVwIASSJZSwElWUsCTwJLAlJXAgBJTgNFEEsBFVJXAgBFDScYEkEACksLFYEkEkRC/ApLDCcNEkEADEsLSRUkEkQXREL79ksMJxkSQQARSwtJFSQSRBdJRCEFDkRC+91LDCcJEkD71UsMJxoSQPvNSwwnDhJA+8VLDCcFEkD7vUsMJxsSQPu1SwwnHBJA+61LDCcdEkD7pUsMJx4SQPudSwwnHxJA+5VLDCcgEkD7jUsMJwsSQPuFSwwnDxJAADBLDCcQEkAAKEsMJxESQAAgSwwnEhJAABhLDCcTEkAAEEsMJxQSQAAISwwnFRJBAAlLC4gMTUhC+0RLDCchEkRC+zsiSwZLBUsHSwRLBogMvEgWKUxQsCNDNhoBSRUkEkQXNhoCSRWBJBJENhoDSwKIC9NESwIWJwRMUIFtJLoXIicFZUSBGFsxAIj2O08CiBAUSwEiW08CgRhbSwFPBQ5ETwVPBU8ETwVPBU8FiAxdRgIjQzEWIwlJOBAjEkQ2GgFJFSQSRBc2GgJJFYEkEkQ2GgNLAogLbkRLAhYnBExQgW0kuhciJwVlRIEYWzEAiPXWTwKID69LASJbTwKBGFtLAUsFDURLBzgHMgoSTwg4CEsDTwcJEhBETwVPBU8ETwVPBU8FiAvlRgIjQzYaAUkVJBJEFxYnBExQRwK9RQFESSIjuklPAoMCPSC6TCgSQAAISwEnDBJBABkjRCInBWVEgRhbMQCI9V9LARJESwK8SCNDIkL/5DYaAUkVJBJEF0mICsJEMgdMFicETFBMFksBgV1PArsiJwa7I0MiK0kxFiMJSTgQIxJENhoBSRUkEkQXNhoCSU4DFSMSRCInB2VEKBNEFicESwFQSU4DSb1FAURLAjgHMgoSTwM4CIHkrwESEERJIiO6JwYSRCInBWVEgRhbMQCI9M9JTgNMvkxOA0RQgAF2TFBJvUUBQQAySb5ESVcAAUlOAkUKI1tFBycWEkEAkEsDgwIlGLoiW0sGCRZLAklOAlclGExcAFwlRQJLAogKb0lFCERLBCcWEkEAKEsBSVclGEkiW0sJCBZcAFwlRQJLA0m8SEsCv0sGFksFTFBLAUy/I0NLBCcGEkEAFksBSVclGEkkW0sJCBZcCFwlRQJC/81LBCcKEkRLAUlXJRhJgRBbSwkIFlwQXCVFAkL/sEsHJwYSQQAfSwODAiUYuiRbSwYJFksCSU4CVyUYTFwIXCVFAkL/ZUsHJwoSREsDgwIlGLqBEFtLBgkWSwJJTgJXJRhMXBBcJUUCQv8/K0k2GgFJFSQSRBcWJwRMUEcCvUUBREm+SExJIiO6SwGDAj0guksCgwIlGLpJIltOBUkkW04FgRBbTgRPAoFdJLoXTgNLAoF1WUsDFU8ETgJSTgIxABJEJwYSRCInC2VESSJbSU8CJFtMIkxBAFxLAUEAV7EiJwllRCJbSwMWgAIAAkxQSwMWUIAEa+jO5rIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBEAslCEwVEkRJVwYQIltMVxYQIltKDExOAk1FAUsHSwdJTgIISUUNSwcIRQtBAFRLB0lLDElOAwxEIQQdTwKXSwSIDLJISYEgW0sBgShbTwKBMFsyB0sJTwQIDURLASEEDkRLA08CHSEEl0sMDkQPQQAISwgiJwi7I0NLCCInCrtC//UhBEL/tyJHBCs2GgFJFSQSRBcWSScETFBHAr1FAURJvkhMIiO6JwgSRCJLAoF3JboXSwENQQT+SwJJgXcluhdLAklPAgxEJUsBC4F5CEsCTCW6F4F5CE8CTCO6SU4CRQlLA0mBdVlLARVSSVcCAEwiWUsCJQtLAkxZTwMjCElFCk8CSwEJSwMVTwIlC0sETFlPAk1SSSNZSwEVUklFDFcCAEUKJxYSQQA0SwlJVwogSwpJgShZSwEVUksCgSxbTwOBNFtLBBZLCExQgAF4SwVQTL+ICytISwRFAUL/TUsGJwoSQQAhSwlJVwwgSwpJgSpZSwEVUksCgS5bTwOBNluICv5IQv/QSwYnJxJBAChLCVcCILEiKmVESwEVgSASRIAE1YaFr7IashiyGoEGshAisgGzQv+gSwYnBhJBARRLCUlXAghLAVcKIEsLSYEoWUsBgTtZSwJPAksCUlcCAEsFVywBSwZXLQhLB1c1CEsFgWZZSwZPBksCUksGgegDU0sHgekDU0sIgeoDU0sJgesDU0sNV0AISw5XSAhLD1dQCEsQV1gITxFXYAhLD4FoWUsQTwxLAlJXAgBLEBVPEU8DTwJSgAIAbE8SUE8RUEsQFRZXBgJPEVBMgAIAblBLARWBbghMTxFQTxBQTw9QSwEWVwYCUEsOFU8CCCgiTw9UI08OVCVPDVSBA08MVE8CTFBPCVBPCFBPB1BPBlBPBVBLBBUWVwYCTwVQSwIWVwYCTwJMUEsBFU8DCBZXBgJQJylQTwJQTwNQTFBMUIgHQUhC/oRLBicjEkEACUsIiAcwSEL+c0sGJwgSQQA8SwlJVwIITFcKIEsKSYEoWUsBFVJXAgCAAgAsTwNQTwJQSwEVFlcGAk8CUEyABAAuAABQTFCICLpIQv4vSwYnKBJBAAlLCIgIqUhC/h5LBicMEkEAJEsISSJZSwElWUsCTwJLAlJXAgBLAhVPA08DTwJSiAhQSEL98ksGJyoSQQA6SwhJIllLASVZSwJPAksCUksCFU8DTwNPAlKxIiplRIAE1d04K7IaTwKyGrIYshqBBrIQIrIBs0L9sEsGJysSQQA2SwhJIllLARVSsSIqZUSABAqMssKyGrIYshqBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJEQv1ySwYnLBJBADdLCEkiWUsBFVKxIiplRIAEJbcTyrIashiyGoEGshAisgGztD5JVwQATFcABCkSRBWBCRJEQv0zSwYnLRJB/StLCEkiWUsBJVlLAk8CSwJSVwIASU4DRQtLARVSVwIARQcnGBJBAA9LBUkVgSQSRCcYTGdC/PVLBycNEkEACksFFycNTGdC/ONLBycZEkEACksFFycZTGdC/NFLBycJEkEACCcJSwZnQvzBSwcnGhJBAAgnGksGZ0L8sUsHJw4SQQAIJw5LBmdC/KFLBycFEkEACCcFSwZnQvyRSwcnGxJBAAgnG0sGZ0L8gUsHJxwSQQAIJxxLBmdC/HFLBycdEkEACCcdSwZnQvxhSwcnHhJBAAgnHksGZ0L8UUsHJx8SQQAIJx9LBmdC/EFLBycgEkEACCcgSwZnQvwxSwcnCxJBAAgnC0sGZ0L8IUsHJw8SQQAIJw9LBmdC/BFLBycQEkEACCcQSwZnQvwBSwcnERJBAAgnEUsGZ0L78UsHJxISQQAIJxJLBmdC++FLBycTEkEACCcTSwZnQvvRSwcnFBJBAAgnFEsGZ0L7wUsHJxUSQQAIJxVLBmdC+7FLBychEkH7qSchSwZnQvuhSwIiJwy7I0MrNhoBSRUkEkQXNhoCSU4CSSJZSU4DgSALJQhMFRJEFkknBExQRwK9RQFEIiO6JwgSQAAUSSIjuicKEkAACkkiI7onDBJBADYjRCJFBUsESwMMQQAnSwNXAgBLBUlOAoEgC4EgWEsDTFCAAXZMUEm9RQFEvEgjCEUFQv/RI0MiQv/HiAaCFilMULAjQzYaAYgGpEgpTFCwI0M2GgFJFSQSRBcWJwRMUEm9RQFEvkgpTFCwI0M2GgFJFYEgEkSAAXhMUEm9RQFEvkgpTFCwI0OKAQKxIiplRCcki/9QJzOyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJETFcGAEwiWUsBIllMIwlLAhVLAyVZTwJNUoEkWyITi/+JigEBsSIqZUSL/xUWVwYCi/9QJyRMUIAEBXlNfbIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRMVwYATCJZSwEiWUwjCUsCFUsDJVlPAk1SgSRbIhOJigEBsSIqZUSL/xUWVwYCi/9QJyRMUIAEokA937IashqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBCQslCEwVEkRXBgkiWyITiYoCAiJJK0cGsSIqZUSL/hUWVwYCi/5QgAQC/kUVshqyGov/shqyGIEGshAisgGztD5HAlcEAExXAAQpEkRJIllJTgKBMgslCEwVEkQnKSIjIosOiwoMQQCmiwlXBgCLDoEyC4EyWIEpW0mMBiITKCJPAlSMAIsLSSJZSYwCSSMISRZXBgBPA0xcAIwBTIEHCCQKSYwDTIEHCCQKSYwFDEEADIsFiwMJr4sBTFCMASKMBIsCgRAISYwIIwiMB4sIiwcMQQAfiwCLBElOAlOLAYsISU4DTwJUjAEjCIwIJAiMBEL/2YsGQQAQI4wMiw4jCIwOiwGMC0L/WCKMDUL/7SgiiwxUI4sNVIACAANMUIsLUIv/jAGMAImKAQGL/xYnBExQSb1FAURJIiO6TIMCPSC6TCInBWVEgRhbMQCI6ltMKBJBAAyLAYsAEkEABCOMAIkiQv/5igEBi/+BIFtJIQUNRCEEDkSL/4EYW0khBQ1EIQQORIv/gRBbRIv/JFtJRCEFDkSL/4mKAQErSSInCWVEIlsiJwtlREkiW0lPAiRbTEEABYsEQAAEIowAiYsCSYv/iwOI6ldJTgKMAIv/iwSI6kuMAUEABYsBQAAEIowAiYsAiwEMQQABiYsBjACJigYCi/wiWUlEIicNZUQORCInB2VEJyITQQBDMQAyCRJEiAM5jPoxADIHIhaL+xWBJBJEJwiL+1AnNFBPA1BMUEwWUIv+FlAnNVCL/FCL+hYnBExQSbxITL+L+ov8iYv9iP9Fi/8PRIv6QAA6iALujPoyByIWi/sVgSQSRCiL+1AnNFCL/VBMUEwWUIv+FlAnNVCL/FCL+hYnBExQSbxITL+L+ov8iYv6FicETFCBZSS6F0L/vooBAYv/IlmL/4EqWYv/TwJLAlJJTgJXAgBMi/9XAghMi/9XCiBMi/+BPVmL/08CSwJSSU4CVwIATIv/VywBTIv/Vy0ITIv/VzUITIv/gWhZi/9OAlKL/4H4A1OL/4H5A1NJi/+B+gNTTIv/gfsDU0yL/1dACEyL/1dICEyL/1dQCEyL/1dYCEyL/1dgCEyL/4FqWYv/FYv/TgJSTEEAJYsOiw9QixBQixFQixJQiwVJFRZXBgJMUIsCJy5QTFAnL0xQTL+LASsTQQB5sSIqZUQoIosKVCgiiwtUKCKLDFQoIosNVIAEVy/R6rIaiwCyGosCshqLA7IaiwSyGiiyGosGshqLB7IaiwiyGosJshpPA7IaTwKyGkyyGiiyGrIashiBBrIQIrIBs4sFKxNBAA+LEyJZQQAIiwWLE4gAY0iL/4wAibEiKmVEKCKLClQoIosLVCgiiwxUKCKLDVSABFZyRi6yGosCshqLA7IaiwSyGiiyGosGshqLB7IaiwiyGosJshpPA7IaTwKyGkyyGiiyGrIashiBBrIQIrIBs0L/iIoCAbEiKmVEi/4VFlcGAov+UIAEv018V7IashqL/7IashiBBrIQIrIBs4v/iYoBAYv/IlmL/4EqWYv/TwJLAlJJTgJXAgBMi/9XAghJTwKL/1cKIE4Ci/8Vi/9OAlJJTgJXAgBJFRZXBgJMUEwnLlBMUCcvTFBJvUUBQQAEiwW8SIsBKxNBACCxIiplRIAE41C51LIaiwCyGrIYgQayECKyAbOL/4wAibEiKmVEgATu9Ej9shqLArIaiwOyGosEshqyGIEGshAisgGzi/+MAImKBAGxIiplRIv8FYEgEkSL/haL/xaABFzr7UOyGov8shqL/bIaTLIashqyGIEGshAisgGzi/2JIiclZURJIwgnJUxniSInCWVEgVhbsYAEm2f6bbIashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSQSRBeJigECIkcJK0cCIkmL/yJZSYwKiw4NQQBBi/9XAgCLDklOAiULSwFMWU8CIwhJjA6LCksBCUsDFU8CJQtLBExZTwJNUkkjWUsBFVJXAgAVgQcIiw0IjA1C/7KBkAOLDQuB5KkDCIwLIhaMBYA4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACMByKMDosOiwoMQQHli/9XAgCLDklOAiULSwFMWU8CIwhJjA6LCksBCUsDFU8CJQtLBExZTwJNUklXAAFJTgKMCUkjWUsBFVJJjARXAgCMBicWEkmMDEEAwiInD2VEjAiLB0kiW4sIIltMSwEIFk8CTFwASYEQW08CCBZcEIwHiwxBAHuLC4HUrAEIjAuLCElXCAiMAyRbiweBGFsNQQAIiweLA1wYjAeLCElXEAiMAIEQW4sHgSBbDUEACIsHiwBcIIwHiwhJVxgIjAGBGFuLB4EoWw1BAAiLB4sBXCiMB4sISVcgCIwCgSBbiweBMFsNQf8RiweLAlwwjAdC/waLCScGEkAACIsJJyMSQf9+iwuBlIQCCIwLQv9yiwknBhJAAAiLCScjEkEACiInEGVEjAhC/yuLCScKEkEAMYsEVwIIiwZJJFlLAYEqWVJXAgBJFRZXBgJMUEwnLlBMUCcvTFBJvUUBRL5IjAhC/vKLCScnEkEACiInMWVEjAhC/uCLCScIEkAACIsJJygSQQAKIicRZUSMCEL+xosJJwwSQQAKIicSZUSMCEL+tIsJJyoSQQAKIicTZUSMCEL+oosJJysSQQAKIicUZUSMCEL+kIsJJywSQQAKIicyZUSMCEL+fosJJy0SRCInFWVEjAhC/m6LByJbiwsMQQA8iwsWiwdLAVwATFwIiwVcEIwHIicHZUQnIhNBABYxADIJEkEADosHiwVJTgJcAExcEIwHiweL/4wBjACJiwtJFosHTFwISSJbTwIJFlwQjAdC/78=\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "b3d48efd5b8aa940c9f7c0d3d2702e170454f5d55edd12cc9ca4f0e478832d27"
@@ -2,26 +2,322 @@
 package akitadao
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendSetup calls the setup ABI method.
+	SendSetup(ctx context.Context, params algokit.CallParams[SetupArgs]) (*SetupMethodResult, error)
+	// SendPartiallyInitialize calls the partiallyInitialize ABI method.
+	SendPartiallyInitialize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendInitialize calls the initialize ABI method.
+	SendInitialize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendNewProposal calls the newProposal ABI method.
+	SendNewProposal(ctx context.Context, params algokit.CallParams[NewProposalArgs]) (*NewProposalMethodResult, error)
+	// SendEditProposal calls the editProposal ABI method.
+	SendEditProposal(ctx context.Context, params algokit.CallParams[EditProposalArgs]) error
+	// SendEditProposalWithPayment calls the editProposalWithPayment ABI method.
+	SendEditProposalWithPayment(ctx context.Context, params algokit.CallParams[EditProposalWithPaymentArgs]) error
+	// SendDeleteProposal calls the deleteProposal ABI method.
+	SendDeleteProposal(ctx context.Context, params algokit.CallParams[DeleteProposalArgs]) error
+	// SendSubmitProposal calls the submitProposal ABI method.
+	SendSubmitProposal(ctx context.Context, params algokit.CallParams[SubmitProposalArgs]) error
+	// SendVoteProposal calls the voteProposal ABI method.
+	SendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) error
+	// SendFinalizeProposal calls the finalizeProposal ABI method.
+	SendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) error
+	// SendExecuteProposal calls the executeProposal ABI method.
+	SendExecuteProposal(ctx context.Context, params algokit.CallParams[ExecuteProposalArgs]) error
+	// SendDeleteProposalVotes calls the deleteProposalVotes ABI method.
+	SendDeleteProposalVotes(ctx context.Context, params algokit.CallParams[DeleteProposalVotesArgs]) error
+	// SendSetupCost calls the setupCost ABI method (readonly).
+	SendSetupCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*SetupCostMethodResult, error)
+	// SendProposalCost calls the proposalCost ABI method (readonly).
+	SendProposalCost(ctx context.Context, params algokit.CallParams[ProposalCostArgs]) (*ProposalCostMethodResult, error)
+	// SendGetProposal calls the getProposal ABI method (readonly).
+	SendGetProposal(ctx context.Context, params algokit.CallParams[GetProposalArgs]) (*GetProposalMethodResult, error)
+	// SendMustGetExecution calls the mustGetExecution ABI method (readonly).
+	SendMustGetExecution(ctx context.Context, params algokit.CallParams[MustGetExecutionArgs]) (*MustGetExecutionMethodResult, error)
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapExecutions reads the value for key in the executions box map.
+	GetBoxMapExecutions(ctx context.Context, key []byte) (ExecutionMetadata, error)
+	// GetBoxMapPlugins reads the value for key in the plugins box map.
+	GetBoxMapPlugins(ctx context.Context, key DaoPluginKey) (ProposalSettings, error)
+	// GetBoxMapProposalVotes reads the value for key in the proposalVotes box map.
+	GetBoxMapProposalVotes(ctx context.Context, key ProposalVoteKey) (ProposalVoteInfo, error)
+	// GetBoxMapProposals reads the value for key in the proposals box map.
+	GetBoxMapProposals(ctx context.Context, key uint64) (ProposalDetails, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the AkitaDao smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +329,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("AkitaDao has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,44 +378,84 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match AkitaDao")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendSetup calls the setup ABI method and waits for confirmation.
+// SendSetup calls the setup ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSetup(ctx context.Context, params algokit.CallParams[SetupArgs]) (*SetupMethodResult, error) {
-	methodArgs := argsToInterfaceSetup(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSetup(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setup",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendSetup(ctx context.Context, params algokit.CallParams[SetupArgs]) (*SetupMethodResult, error) {
+	comp, err := c.NewGroup().Setup(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*SetupMethodResult)
+	return typedResult, nil
+}
 
+// decodeSetupResult decodes the return value of a setup call.
+func (c *Client) decodeSetupResult(result *algokit.SendAppTransactionResult) (*SetupMethodResult, error) {
 	typedResult := &SetupMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -94,60 +469,81 @@ func (c *Client) SendSetup(ctx context.Context, params algokit.CallParams[SetupA
 	return typedResult, nil
 }
 
-// SendPartiallyInitialize calls the partiallyInitialize ABI method and waits for confirmation.
-func (c *Client) SendPartiallyInitialize(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "partiallyInitialize",
-		MethodArgs: methodArgs,
-	})
+// SendPartiallyInitialize calls the partiallyInitialize ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendPartiallyInitialize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendPartiallyInitialize(ctx, params)
+	return err
 }
 
-// SendInitialize calls the initialize ABI method and waits for confirmation.
-func (c *Client) SendInitialize(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendPartiallyInitialize(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().PartiallyInitialize(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "initialize",
-		MethodArgs: methodArgs,
-	})
+// SendInitialize calls the initialize ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendInitialize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendInitialize(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendInitialize(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Initialize(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendNewProposal calls the newProposal ABI method and waits for confirmation.
+// SendNewProposal calls the newProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendNewProposal(ctx context.Context, params algokit.CallParams[NewProposalArgs]) (*NewProposalMethodResult, error) {
-	methodArgs := argsToInterfaceNewProposal(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendNewProposal(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "newProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendNewProposal(ctx context.Context, params algokit.CallParams[NewProposalArgs]) (*NewProposalMethodResult, error) {
+	comp, err := c.NewGroup().NewProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*NewProposalMethodResult)
+	return typedResult, nil
+}
 
+// decodeNewProposalResult decodes the return value of a newProposal call.
+func (c *Client) decodeNewProposalResult(result *algokit.SendAppTransactionResult) (*NewProposalMethodResult, error) {
 	typedResult := &NewProposalMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -161,226 +557,224 @@ func (c *Client) SendNewProposal(ctx context.Context, params algokit.CallParams[
 	return typedResult, nil
 }
 
-// SendEditProposal calls the editProposal ABI method and waits for confirmation.
+// SendEditProposal calls the editProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditProposal(ctx context.Context, params algokit.CallParams[EditProposalArgs]) error {
-	methodArgs := argsToInterfaceEditProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditProposal(ctx context.Context, params algokit.CallParams[EditProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditProposalWithPayment calls the editProposalWithPayment ABI method and waits for confirmation.
+// SendEditProposalWithPayment calls the editProposalWithPayment ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditProposalWithPayment(ctx context.Context, params algokit.CallParams[EditProposalWithPaymentArgs]) error {
-	methodArgs := argsToInterfaceEditProposalWithPayment(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editProposalWithPayment",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditProposalWithPayment(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditProposalWithPayment(ctx context.Context, params algokit.CallParams[EditProposalWithPaymentArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditProposalWithPayment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteProposal calls the deleteProposal ABI method and waits for confirmation.
+// SendDeleteProposal calls the deleteProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDeleteProposal(ctx context.Context, params algokit.CallParams[DeleteProposalArgs]) error {
-	methodArgs := argsToInterfaceDeleteProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteProposal(ctx context.Context, params algokit.CallParams[DeleteProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSubmitProposal calls the submitProposal ABI method and waits for confirmation.
+// SendSubmitProposal calls the submitProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSubmitProposal(ctx context.Context, params algokit.CallParams[SubmitProposalArgs]) error {
-	methodArgs := argsToInterfaceSubmitProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "submitProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSubmitProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSubmitProposal(ctx context.Context, params algokit.CallParams[SubmitProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SubmitProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendVoteProposal calls the voteProposal ABI method and waits for confirmation.
+// SendVoteProposal calls the voteProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) error {
-	methodArgs := argsToInterfaceVoteProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "voteProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendVoteProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendVoteProposal(ctx context.Context, params algokit.CallParams[VoteProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().VoteProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendFinalizeProposal calls the finalizeProposal ABI method and waits for confirmation.
+// SendFinalizeProposal calls the finalizeProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) error {
-	methodArgs := argsToInterfaceFinalizeProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "finalizeProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendFinalizeProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendFinalizeProposal(ctx context.Context, params algokit.CallParams[FinalizeProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().FinalizeProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendExecuteProposal calls the executeProposal ABI method and waits for confirmation.
+// SendExecuteProposal calls the executeProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendExecuteProposal(ctx context.Context, params algokit.CallParams[ExecuteProposalArgs]) error {
-	methodArgs := argsToInterfaceExecuteProposal(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "executeProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendExecuteProposal(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendExecuteProposal(ctx context.Context, params algokit.CallParams[ExecuteProposalArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ExecuteProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteProposalVotes calls the deleteProposalVotes ABI method and waits for confirmation.
+// SendDeleteProposalVotes calls the deleteProposalVotes ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDeleteProposalVotes(ctx context.Context, params algokit.CallParams[DeleteProposalVotesArgs]) error {
-	methodArgs := argsToInterfaceDeleteProposalVotes(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteProposalVotes",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteProposalVotes(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteProposalVotes(ctx context.Context, params algokit.CallParams[DeleteProposalVotesArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteProposalVotes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetupCost calls the setupCost ABI method and waits for confirmation.
-func (c *Client) SendSetupCost(ctx context.Context) (*SetupCostMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendSetupCost calls the setupCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendSetupCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*SetupCostMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSetupCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "setupCost",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendSetupCost(ctx context.Context, params algokit.CallParams[struct{}]) (*SetupCostMethodResult, error) {
+	comp, err := c.NewGroup().SetupCost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*SetupCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeSetupCostResult decodes the return value of a setupCost call.
+func (c *Client) decodeSetupCostResult(result *algokit.SendAppTransactionResult) (*SetupCostMethodResult, error) {
 	typedResult := &SetupCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -394,28 +788,31 @@ func (c *Client) SendSetupCost(ctx context.Context) (*SetupCostMethodResult, err
 	return typedResult, nil
 }
 
-// SendProposalCost calls the proposalCost ABI method and waits for confirmation.
+// SendProposalCost calls the proposalCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalCost(ctx context.Context, params algokit.CallParams[ProposalCostArgs]) (*ProposalCostMethodResult, error) {
-	methodArgs := argsToInterfaceProposalCost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalCost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalCost(ctx context.Context, params algokit.CallParams[ProposalCostArgs]) (*ProposalCostMethodResult, error) {
+	comp, err := c.NewGroup().ProposalCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalCostResult decodes the return value of a proposalCost call.
+func (c *Client) decodeProposalCostResult(result *algokit.SendAppTransactionResult) (*ProposalCostMethodResult, error) {
 	typedResult := &ProposalCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -431,65 +828,69 @@ func (c *Client) SendProposalCost(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendGetProposal calls the getProposal ABI method and waits for confirmation.
+// SendGetProposal calls the getProposal ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetProposal(ctx context.Context, params algokit.CallParams[GetProposalArgs]) (*GetProposalMethodResult, error) {
-	methodArgs := argsToInterfaceGetProposal(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetProposal(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getProposal",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetProposal(ctx context.Context, params algokit.CallParams[GetProposalArgs]) (*GetProposalMethodResult, error) {
+	comp, err := c.NewGroup().GetProposal(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetProposalMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetProposalResult decodes the return value of a getProposal call.
+func (c *Client) decodeGetProposalResult(result *algokit.SendAppTransactionResult) (*GetProposalMethodResult, error) {
 	typedResult := &GetProposalMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if vals, ok := result.ABIReturn.([]interface{}); ok {
-			if err := algokit.TupleToStruct(vals, &typedResult.Return); err != nil {
-				return nil, fmt.Errorf("failed to decode return: %w", err)
-			}
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendMustGetExecution calls the mustGetExecution ABI method and waits for confirmation.
+// SendMustGetExecution calls the mustGetExecution ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetExecution(ctx context.Context, params algokit.CallParams[MustGetExecutionArgs]) (*MustGetExecutionMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetExecution(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetExecution(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetExecution",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetExecution(ctx context.Context, params algokit.CallParams[MustGetExecutionArgs]) (*MustGetExecutionMethodResult, error) {
+	comp, err := c.NewGroup().MustGetExecution(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MustGetExecutionMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetExecutionResult decodes the return value of a mustGetExecution call.
+func (c *Client) decodeMustGetExecutionResult(result *algokit.SendAppTransactionResult) (*MustGetExecutionMethodResult, error) {
 	typedResult := &MustGetExecutionMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -505,33 +906,46 @@ func (c *Client) SendMustGetExecution(ctx context.Context, params algokit.CallPa
 	return typedResult, nil
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-func argsToInterfaceCreate(args CreateArgs) []interface{} {
-	return []interface{}{
-		args.Version,
-		args.Akta,
-		args.ContentPolicy,
-		args.MinRewardsImpact,
-		args.Apps,
-		args.Fees,
-		args.ProposalSettings,
-		args.RevenueSplits,
+func argsToInterfaceCreate(args CreateArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 8)
+	methodArgs = append(methodArgs, args.Version)
+	methodArgs = append(methodArgs, args.Akta)
+	methodArgs = append(methodArgs, args.ContentPolicy)
+	methodArgs = append(methodArgs, args.MinRewardsImpact)
+	methodArgs = append(methodArgs, args.Apps)
+	methodArgs = append(methodArgs, args.Fees)
+	methodArgs = append(methodArgs, args.ProposalSettings)
+	if v, err := toABIValue(args.RevenueSplits); err != nil {
+		return nil, fmt.Errorf("invalid revenueSplits argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceUpdate(args UpdateArgs) []interface{} {
@@ -546,29 +960,41 @@ func argsToInterfaceSetup(args SetupArgs) []interface{} {
 	}
 }
 
-func argsToInterfaceNewProposal(args NewProposalArgs) []interface{} {
-	return []interface{}{
-		args.Payment,
-		args.Cid,
-		args.Actions,
+func argsToInterfaceNewProposal(args NewProposalArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 3)
+	methodArgs = append(methodArgs, args.Payment)
+	methodArgs = append(methodArgs, args.Cid)
+	if v, err := toABIValue(args.Actions); err != nil {
+		return nil, fmt.Errorf("invalid actions argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
-func argsToInterfaceEditProposal(args EditProposalArgs) []interface{} {
-	return []interface{}{
-		args.ID,
-		args.Cid,
-		args.Actions,
+func argsToInterfaceEditProposal(args EditProposalArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 3)
+	methodArgs = append(methodArgs, args.ID)
+	methodArgs = append(methodArgs, args.Cid)
+	if v, err := toABIValue(args.Actions); err != nil {
+		return nil, fmt.Errorf("invalid actions argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
-func argsToInterfaceEditProposalWithPayment(args EditProposalWithPaymentArgs) []interface{} {
-	return []interface{}{
-		args.Payment,
-		args.ID,
-		args.Cid,
-		args.Actions,
+func argsToInterfaceEditProposalWithPayment(args EditProposalWithPaymentArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 4)
+	methodArgs = append(methodArgs, args.Payment)
+	methodArgs = append(methodArgs, args.ID)
+	methodArgs = append(methodArgs, args.Cid)
+	if v, err := toABIValue(args.Actions); err != nil {
+		return nil, fmt.Errorf("invalid actions argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceDeleteProposal(args DeleteProposalArgs) []interface{} {
@@ -610,10 +1036,14 @@ func argsToInterfaceDeleteProposalVotes(args DeleteProposalVotesArgs) []interfac
 	}
 }
 
-func argsToInterfaceProposalCost(args ProposalCostArgs) []interface{} {
-	return []interface{}{
-		args.Actions,
+func argsToInterfaceProposalCost(args ProposalCostArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Actions); err != nil {
+		return nil, fmt.Errorf("invalid actions argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceGetProposal(args GetProposalArgs) []interface{} {
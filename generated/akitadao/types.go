@@ -7,119 +7,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// ExecutionMetadata is a generated struct type.
-type ExecutionMetadata struct {
-	ProposalID uint64 `json:"proposalID"`
-	Index      uint64 `json:"index"`
-}
-
-// ProposalSettings is a generated struct type.
-type ProposalSettings struct {
-	Fee           uint64 `json:"fee"`
-	Power         uint64 `json:"power"`
-	Duration      uint64 `json:"duration"`
-	Participation uint64 `json:"participation"`
-	Approval      uint64 `json:"approval"`
-}
-
-// SocialFees is a generated struct type.
-type SocialFees struct {
-	PostFee      uint64 `json:"postFee"`
-	ReactFee     uint64 `json:"reactFee"`
-	ImpactTaxMin uint64 `json:"impactTaxMin"`
-	ImpactTaxMax uint64 `json:"impactTaxMax"`
-}
-
-// Object752a5b25 is a generated struct type.
-type Object752a5b25 struct {
-	UpgradeApp          ProposalSettings `json:"upgradeApp"`
-	AddPlugin           ProposalSettings `json:"addPlugin"`
-	RemoveExecutePlugin ProposalSettings `json:"removeExecutePlugin"`
-	RemovePlugin        ProposalSettings `json:"removePlugin"`
-	AddAllowance        ProposalSettings `json:"addAllowance"`
-	RemoveAllowance     ProposalSettings `json:"removeAllowance"`
-	NewEscrow           ProposalSettings `json:"newEscrow"`
-	ToggleEscrowLock    ProposalSettings `json:"toggleEscrowLock"`
-	UpdateFields        ProposalSettings `json:"updateFields"`
-}
-
-// AkitaAssets is a generated struct type.
-type AkitaAssets struct {
-	Akta  uint64 `json:"akta"`
-	Bones uint64 `json:"bones"`
-}
-
-// DaoPluginKey is a generated struct type.
-type DaoPluginKey struct {
-	Plugin uint64 `json:"plugin"`
-	Escrow string `json:"escrow"`
-}
-
-// PluginAppList is a generated struct type.
-type PluginAppList struct {
-	Optin          uint64 `json:"optin"`
-	RevenueManager uint64 `json:"revenueManager"`
-	Update         uint64 `json:"update"`
-}
-
-// ProposalDetails is a generated struct type.
-type ProposalDetails struct {
-	Status   uint8              `json:"status"`
-	Cid      [36]byte           `json:"cid"`
-	Votes    ProposalVoteTotals `json:"votes"`
-	Creator  types.Address      `json:"creator"`
-	VotingTs uint64             `json:"votingTs"`
-	Created  uint64             `json:"created"`
-	FeesPaid uint64             `json:"feesPaid"`
-	Actions  [][]interface{}    `json:"actions"`
-}
-
-// ProposalVoteKey is a generated struct type.
-type ProposalVoteKey struct {
-	ProposalID uint64        `json:"proposalID"`
-	Voter      types.Address `json:"voter"`
-}
-
-// ProposalVoteTotals is a generated struct type.
-type ProposalVoteTotals struct {
-	Approvals  uint64 `json:"approvals"`
-	Rejections uint64 `json:"rejections"`
-	Abstains   uint64 `json:"abstains"`
-}
-
-// WalletFees is a generated struct type.
-type WalletFees struct {
-	CreateFee          uint64 `json:"createFee"`
-	ReferrerPercentage uint64 `json:"referrerPercentage"`
-}
-
-// OtherAppList is a generated struct type.
-type OtherAppList struct {
-	VrfBeacon   uint64 `json:"vrfBeacon"`
-	NfdRegistry uint64 `json:"nfdRegistry"`
-	AssetInbox  uint64 `json:"assetInbox"`
-	Escrow      uint64 `json:"escrow"`
-	Poll        uint64 `json:"poll"`
-	AkitaNfd    uint64 `json:"akitaNfd"`
-}
-
-// ProposalCostInfo is a generated struct type.
-type ProposalCostInfo struct {
-	Total         uint64 `json:"total"`
-	MBR           uint64 `json:"mbr"`
-	Fee           uint64 `json:"fee"`
-	Power         uint64 `json:"power"`
-	Duration      uint64 `json:"duration"`
-	Participation uint64 `json:"participation"`
-	Approval      uint64 `json:"approval"`
-}
-
-// SwapFees is a generated struct type.
-type SwapFees struct {
-	ImpactTaxMin uint64 `json:"impactTaxMin"`
-	ImpactTaxMax uint64 `json:"impactTaxMax"`
-}
-
 // AkitaAppList is a generated struct type.
 type AkitaAppList struct {
 	Staking       uint64 `json:"staking"`
@@ -136,6 +23,12 @@ type AkitaAppList struct {
 	Wallet        uint64 `json:"wallet"`
 }
 
+// AkitaAssets is a generated struct type.
+type AkitaAssets struct {
+	Akta  uint64 `json:"akta"`
+	Bones uint64 `json:"bones"`
+}
+
 // AkitaDaoApps is a generated struct type.
 type AkitaDaoApps struct {
 	Staking        uint64 `json:"staking"`
@@ -210,6 +103,18 @@ type AkitaSocialAppList struct {
 	Moderation uint64 `json:"moderation"`
 }
 
+// DaoPluginKey is a generated struct type.
+type DaoPluginKey struct {
+	Plugin uint64 `json:"plugin"`
+	Escrow string `json:"escrow"`
+}
+
+// ExecutionMetadata is a generated struct type.
+type ExecutionMetadata struct {
+	ProposalID uint64 `json:"proposalID"`
+	Index      uint64 `json:"index"`
+}
+
 // NFTFees is a generated struct type.
 type NFTFees struct {
 	MarketplaceSalePercentageMin        uint64 `json:"marketplaceSalePercentageMin"`
@@ -229,12 +134,95 @@ type NFTFees struct {
 	RaffleComposablePercentage          uint64 `json:"raffleComposablePercentage"`
 }
 
+// Object752a5b25 is a generated struct type.
+type Object752a5b25 struct {
+	UpgradeApp          ProposalSettings `json:"upgradeApp"`
+	AddPlugin           ProposalSettings `json:"addPlugin"`
+	RemoveExecutePlugin ProposalSettings `json:"removeExecutePlugin"`
+	RemovePlugin        ProposalSettings `json:"removePlugin"`
+	AddAllowance        ProposalSettings `json:"addAllowance"`
+	RemoveAllowance     ProposalSettings `json:"removeAllowance"`
+	NewEscrow           ProposalSettings `json:"newEscrow"`
+	ToggleEscrowLock    ProposalSettings `json:"toggleEscrowLock"`
+	UpdateFields        ProposalSettings `json:"updateFields"`
+}
+
+// OtherAppList is a generated struct type.
+type OtherAppList struct {
+	VrfBeacon   uint64 `json:"vrfBeacon"`
+	NfdRegistry uint64 `json:"nfdRegistry"`
+	AssetInbox  uint64 `json:"assetInbox"`
+	Escrow      uint64 `json:"escrow"`
+	Poll        uint64 `json:"poll"`
+	AkitaNfd    uint64 `json:"akitaNfd"`
+}
+
+// PluginAppList is a generated struct type.
+type PluginAppList struct {
+	Optin          uint64 `json:"optin"`
+	RevenueManager uint64 `json:"revenueManager"`
+	Update         uint64 `json:"update"`
+}
+
+// ProposalCostInfo is a generated struct type.
+type ProposalCostInfo struct {
+	Total         uint64 `json:"total"`
+	MBR           uint64 `json:"mbr"`
+	Fee           uint64 `json:"fee"`
+	Power         uint64 `json:"power"`
+	Duration      uint64 `json:"duration"`
+	Participation uint64 `json:"participation"`
+	Approval      uint64 `json:"approval"`
+}
+
+// ProposalDetails is a generated struct type.
+type ProposalDetails struct {
+	Status   uint8                   `json:"status"`
+	Cid      [36]byte                `json:"cid"`
+	Votes    ProposalVoteTotals      `json:"votes"`
+	Creator  types.Address           `json:"creator"`
+	VotingTs uint64                  `json:"votingTs"`
+	Created  uint64                  `json:"created"`
+	FeesPaid uint64                  `json:"feesPaid"`
+	Actions  []Tuple2[uint8, []byte] `json:"actions"`
+}
+
+// ProposalSettings is a generated struct type.
+type ProposalSettings struct {
+	Fee           uint64 `json:"fee"`
+	Power         uint64 `json:"power"`
+	Duration      uint64 `json:"duration"`
+	Participation uint64 `json:"participation"`
+	Approval      uint64 `json:"approval"`
+}
+
 // ProposalVoteInfo is a generated struct type.
 type ProposalVoteInfo struct {
 	Type  uint8  `json:"type"`
 	Power uint64 `json:"power"`
 }
 
+// ProposalVoteKey is a generated struct type.
+type ProposalVoteKey struct {
+	ProposalID uint64        `json:"proposalID"`
+	Voter      types.Address `json:"voter"`
+}
+
+// ProposalVoteTotals is a generated struct type.
+type ProposalVoteTotals struct {
+	Approvals  uint64 `json:"approvals"`
+	Rejections uint64 `json:"rejections"`
+	Abstains   uint64 `json:"abstains"`
+}
+
+// SocialFees is a generated struct type.
+type SocialFees struct {
+	PostFee      uint64 `json:"postFee"`
+	ReactFee     uint64 `json:"reactFee"`
+	ImpactTaxMin uint64 `json:"impactTaxMin"`
+	ImpactTaxMax uint64 `json:"impactTaxMax"`
+}
+
 // StakingFees is a generated struct type.
 type StakingFees struct {
 	CreationFee  uint64 `json:"creationFee"`
@@ -249,132 +237,144 @@ type SubscriptionFees struct {
 	TriggerPercentage  uint64 `json:"triggerPercentage"`
 }
 
+// SwapFees is a generated struct type.
+type SwapFees struct {
+	ImpactTaxMin uint64 `json:"impactTaxMin"`
+	ImpactTaxMax uint64 `json:"impactTaxMax"`
+}
+
+// WalletFees is a generated struct type.
+type WalletFees struct {
+	CreateFee          uint64 `json:"createFee"`
+	ReferrerPercentage uint64 `json:"referrerPercentage"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version          string
-	Akta             uint64
-	ContentPolicy    [36]byte
-	MinRewardsImpact uint64
-	Apps             AkitaDaoApps
-	Fees             AkitaDaoFees
-	ProposalSettings Object752a5b25
-	RevenueSplits    [][]interface{}
+	Version          string                                          `json:"version"`
+	Akta             uint64                                          `json:"akta"`
+	ContentPolicy    [36]byte                                        `json:"contentPolicy"`
+	MinRewardsImpact uint64                                          `json:"minRewardsImpact"`
+	Apps             AkitaDaoApps                                    `json:"apps"`
+	Fees             AkitaDaoFees                                    `json:"fees"`
+	ProposalSettings Object752a5b25                                  `json:"proposalSettings"`
+	RevenueSplits    []Tuple3[Tuple2[uint64, string], uint8, uint64] `json:"revenueSplits"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // SetupArgs holds the arguments for the setup method.
 type SetupArgs struct {
-	Nickname string
+	Nickname string `json:"nickname"`
 }
 
 // SetupMethodResult holds the result of calling setup.
 type SetupMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // NewProposalArgs holds the arguments for the newProposal method.
 type NewProposalArgs struct {
-	Payment transaction.TransactionWithSigner
-	Cid     [36]byte
-	Actions [][]interface{}
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Cid     [36]byte                          `json:"cid"`
+	Actions []Tuple2[uint8, []byte]           `json:"actions"`
 }
 
 // NewProposalMethodResult holds the result of calling newProposal.
 type NewProposalMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EditProposalArgs holds the arguments for the editProposal method.
 type EditProposalArgs struct {
-	ID      uint64
-	Cid     [36]byte
-	Actions [][]interface{}
+	ID      uint64                  `json:"id"`
+	Cid     [36]byte                `json:"cid"`
+	Actions []Tuple2[uint8, []byte] `json:"actions"`
 }
 
 // EditProposalWithPaymentArgs holds the arguments for the editProposalWithPayment method.
 type EditProposalWithPaymentArgs struct {
-	Payment transaction.TransactionWithSigner
-	ID      uint64
-	Cid     [36]byte
-	Actions [][]interface{}
+	Payment transaction.TransactionWithSigner `json:"-"`
+	ID      uint64                            `json:"id"`
+	Cid     [36]byte                          `json:"cid"`
+	Actions []Tuple2[uint8, []byte]           `json:"actions"`
 }
 
 // DeleteProposalArgs holds the arguments for the deleteProposal method.
 type DeleteProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // SubmitProposalArgs holds the arguments for the submitProposal method.
 type SubmitProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // VoteProposalArgs holds the arguments for the voteProposal method.
 type VoteProposalArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	ProposalID uint64
-	Vote       uint8
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	ProposalID uint64                            `json:"proposalID"`
+	Vote       uint8                             `json:"vote"`
 }
 
 // FinalizeProposalArgs holds the arguments for the finalizeProposal method.
 type FinalizeProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // ExecuteProposalArgs holds the arguments for the executeProposal method.
 type ExecuteProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // DeleteProposalVotesArgs holds the arguments for the deleteProposalVotes method.
 type DeleteProposalVotesArgs struct {
-	ProposalID uint64
-	Voters     []types.Address
+	ProposalID uint64          `json:"proposalID"`
+	Voters     []types.Address `json:"voters"`
 }
 
 // SetupCostMethodResult holds the result of calling setupCost.
 type SetupCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // ProposalCostArgs holds the arguments for the proposalCost method.
 type ProposalCostArgs struct {
-	Actions [][]interface{}
+	Actions []Tuple2[uint8, []byte] `json:"actions"`
 }
 
 // ProposalCostMethodResult holds the result of calling proposalCost.
 type ProposalCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalCostInfo
+	Return ProposalCostInfo `json:"return"`
 }
 
 // GetProposalArgs holds the arguments for the getProposal method.
 type GetProposalArgs struct {
-	ProposalID uint64
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // GetProposalMethodResult holds the result of calling getProposal.
 type GetProposalMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalDetails
+	Return ProposalDetails `json:"return"`
 }
 
 // MustGetExecutionArgs holds the arguments for the mustGetExecution method.
 type MustGetExecutionArgs struct {
-	Lease [32]byte
+	Lease [32]byte `json:"lease"`
 }
 
 // MustGetExecutionMethodResult holds the result of calling mustGetExecution.
 type MustGetExecutionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ExecutionMetadata
+	Return ExecutionMetadata `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,4 +2,11 @@
 package akitadaoplugin
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"AkitaDAOPlugin\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"daoAppID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"setup\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"nickname\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"newProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"submitProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"voteProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"uint8\",\"name\":\"vote\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalizeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"executeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{},\"state\":{\"schema\":{\"global\":{\"ints\":1,\"bytes\":0},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"daoAppID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZGFvX2lk\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggNgogICAgYnl0ZWNibG9jayAiZGFvX2lkIiAic3BlbmRpbmdfYWRkcmVzcyIgMHgxNTFmN2M3NSAweDhjNTY0NzY4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPUGx1Z2luIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDExCiAgICBwdXNoYnl0ZXNzIDB4NjY5ZDMwZGEgMHgwNTY3N2YwZSAweDU1ZmUzYjEwIDB4NjIyNTYwYTQgMHhmMDM0OTNiNCAweGNmN2UxYWU2IDB4OTM0MDE4MTMgLy8gbWV0aG9kICJzZXR1cCh1aW50NjQsYm9vbCxzdHJpbmcpdm9pZCIsIG1ldGhvZCAibmV3UHJvcG9zYWwodWludDY0LGJvb2wsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl1aW50NjQiLCBtZXRob2QgImVkaXRQcm9wb3NhbCh1aW50NjQsYm9vbCx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIiwgbWV0aG9kICJzdWJtaXRQcm9wb3NhbCh1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAidm90ZVByb3Bvc2FsKHVpbnQ2NCxib29sLHVpbnQ2NCx1aW50OCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZVByb3Bvc2FsKHVpbnQ2NCxib29sLHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJleGVjdXRlUHJvcG9zYWwodWludDY0LGJvb2wsdWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBzZXR1cCBuZXdQcm9wb3NhbCBlZGl0UHJvcG9zYWwgc3VibWl0UHJvcG9zYWwgdm90ZVByb3Bvc2FsIGZpbmFsaXplUHJvcG9zYWwgZXhlY3V0ZVByb3Bvc2FsCiAgICBlcnIKCm1haW5fY3JlYXRlX05vT3BAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPUGx1Z2luIGV4dGVuZHMgQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4MjQwZDJmNjcgLy8gbWV0aG9kICJjcmVhdGUodWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJla2V5QWRkcmVzcyhyZWtleUJhY2s6IHVpbnQ2NCwgd2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgpyZWtleUFkZHJlc3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMQogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIHJla2V5QWRkcmVzcyhyZWtleUJhY2s6IGJvb2xlYW4sIHdhbGxldDogQXBwbGljYXRpb24pOiBBY2NvdW50IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMjIKICAgIC8vIGlmICghcmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJueiByZWtleUFkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMwogICAgLy8gcmV0dXJuIEdsb2JhbC56ZXJvQWRkcmVzcwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICByZXRzdWIKCnJla2V5QWRkcmVzc19hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyNgogICAgLy8gcmV0dXJuIHdhbGxldC5hZGRyZXNzCiAgICBmcmFtZV9kaWcgLTEKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBT1BsdWdpbi5jcmVhdGVbcm91dGluZ10oKSAtPiB2b2lkOgpjcmVhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyMgogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjI0CiAgICAvLyB0aGlzLmRhb0FwcElELnZhbHVlID0gQXBwbGljYXRpb24oZGFvQXBwSUQpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjIKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLnNldHVwW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0dXA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyOQogICAgLy8gc2V0dXAod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBuaWNrbmFtZTogc3RyaW5nKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjMyLTM3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuc2V0dXA+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW25pY2tuYW1lXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czozNgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czozMi0zNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnNldHVwPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtuaWNrbmFtZV0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhkOWJhY2Q5NCAvLyBtZXRob2QgInNldHVwKHN0cmluZyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyOQogICAgLy8gc2V0dXAod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBuaWNrbmFtZTogc3RyaW5nKTogdm9pZCB7CiAgICByZXR1cm4gLy8gb24gZXJyb3I6IGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU9QbHVnaW4ubmV3UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpuZXdQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjQwCiAgICAvLyBuZXdQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHN3YXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18xIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDUKICAgIC8vIHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NQogICAgLy8gdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNJbml0aWFsaXplZCkKICAgIHB1c2hieXRlcyAiaW5pdGlhbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NC00NwogICAgLy8gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQpCiAgICAvLyApWzBdCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0My00OAogICAgLy8gY29uc3QgaW5pdGlhbGl6ZWQgPSBkZWNvZGVBcmM0PGJvb2xlYW4+KAogICAgLy8gICBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgICB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICAgIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQpCiAgICAvLyAgIClbMF0KICAgIC8vICkKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjUxCiAgICAvLyBsZXQgdG90YWw6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTIKICAgIC8vIGlmIChpbml0aWFsaXplZCkgewogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo1My01OAogICAgLy8gKHsgdG90YWwgfSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5wcm9wb3NhbENvc3Q+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW2FjdGlvbnNdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjU1CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjU1CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo1NwogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgZGlnIDUKICAgIGRpZyA3CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTMtNTgKICAgIC8vICh7IHRvdGFsIH0gPSBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUucHJvcG9zYWxDb3N0Pih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFthY3Rpb25zXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkucmV0dXJuVmFsdWUpCiAgICBieXRlY18zIC8vIG1ldGhvZCAicHJvcG9zYWxDb3N0KCh1aW50OCxieXRlW10pW10pKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDQKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIFJla2V5VG8KICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIHB1c2hpbnQgNTYgLy8gNTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yICh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBwdXNoaW50IDQgLy8gNAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMQoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo2MwogICAgLy8gcmVjZWl2ZXI6IHRoaXMuZGFvQXBwSUQudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjY3LTc2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLm5ld1Byb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBhY3Rpb25zUGF5bWVudCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgMgogICAgZHVwCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjEtNjUKICAgIC8vIGNvbnN0IGFjdGlvbnNQYXltZW50ID0gaXR4bi5wYXltZW50KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICByZWNlaXZlcjogdGhpcy5kYW9BcHBJRC52YWx1ZS5hZGRyZXNzLAogICAgLy8gICBhbW91bnQ6IHRvdGFsLAogICAgLy8gfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjY3LTc2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLm5ld1Byb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBhY3Rpb25zUGF5bWVudCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo3NQogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgZGlnIDYKICAgIGRpZyA4CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjctNzYKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUubmV3UHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgwMTk4ZmUzYiAvLyBtZXRob2QgIm5ld1Byb3Bvc2FsKHBheSxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA1CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZ2l0eG4gMSBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0MAogICAgLy8gbmV3UHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHVpbnQ2NCB7CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmVkaXRQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmVkaXRQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjc5CiAgICAvLyBlZGl0UHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZDogdWludDY0LCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgY292ZXIgMgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBjb3ZlciA1CiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBjb3ZlciA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgNQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODItODYKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBmZWVzUGFpZCB9ID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLmdldFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtpZF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjgyLTg2CiAgICAvLyBjb25zdCB7IHN0YXR1cywgZmVlc1BhaWQgfSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5nZXRQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbaWRdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDFmOWY4YjIwIC8vIG1ldGhvZCAiZ2V0UHJvcG9zYWwodWludDY0KSh1aW50OCxieXRlWzM2XSwodWludDY0LHVpbnQ2NCx1aW50NjQpLGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQsKHVpbnQ4LGJ5dGVbXSlbXSkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZHVwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgZXh0cmFjdCA0IDEKICAgIHN3YXAKICAgIHB1c2hpbnQgMTEzIC8vIDExMwogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgNAogICAgY292ZXIgNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2Jhc2UudHM6NAogICAgLy8gcmV0dXJuIG5ldyBhcmM0LlVpbnQ4KHYpCiAgICBwdXNoYnl0ZXMgMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgKICAgIC8vIGFzc2VydChzdGF0dXMgIT09IHVpbnQ4KDApLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICAhPQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5MC05NAogICAgLy8gY29uc3QgeyB0b3RhbCB9ID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnByb3Bvc2FsQ29zdD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbYWN0aW9uc10sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWNfMyAvLyBtZXRob2QgInByb3Bvc2FsQ29zdCgodWludDgsYnl0ZVtdKVtdKSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIGludGNfMyAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCA1NiAvLyA1NgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5NgogICAgLy8gaWYgKGZlZXNQYWlkIDwgdG90YWwpIHsKICAgIDwKICAgIGJ6IGVkaXRQcm9wb3NhbF9lbHNlX2JvZHlANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTkKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5OQogICAgLy8gcmVjZWl2ZXI6IHRoaXMuZGFvQXBwSUQudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMAogICAgLy8gYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkLAogICAgZGlnIDQKICAgIGRpZyA2CiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgZGlnIDUKICAgIGR1cAogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjk3LTEwMQogICAgLy8gY29uc3QgYWN0aW9uc1BheW1lbnQgPSBpdHhuLnBheW1lbnQoewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogdG90YWwgLSBmZWVzUGFpZCwKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjExMgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhjYjM3ZTY2OCAvLyBtZXRob2QgImVkaXRQcm9wb3NhbFdpdGhQYXltZW50KHBheSx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA4CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmVkaXRQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo3OQogICAgLy8gZWRpdFByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgaWQ6IHVpbnQ2NCwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmVkaXRQcm9wb3NhbF9lbHNlX2JvZHlANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjExNS0xMjQKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5lZGl0UHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMTcKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIGNvdmVyIDIKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMTUtMTI0CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpZCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZmUyMGMyNjkgLy8gbWV0aG9kICJlZGl0UHJvcG9zYWwodWludDY0LGJ5dGVbMzZdLCh1aW50OCxieXRlW10pW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA2CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIFJla2V5VG8KICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgYiBlZGl0UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA5CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLnN1Ym1pdFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKc3VibWl0UHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIHN1Ym1pdFByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMS0xMzYKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5zdWJtaXRQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbcHJvcG9zYWxJRF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzMKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMzCiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzUKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciA0CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxLTEzNgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnN1Ym1pdFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtwcm9wb3NhbElEXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIHB1c2hieXRlcyAweDEwM2E2Yjg4IC8vIG1ldGhvZCAic3VibWl0UHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBzdWJtaXRQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBT1BsdWdpbi52b3RlUHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgp2b3RlUHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHZvdGVQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIHByb3Bvc2FsSUQ6IHVpbnQ2NCwgdm90ZTogUHJvcG9zYWxWb3RlVHlwZSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjgKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIGJ5dGVjXzEgLy8gInNwZW5kaW5nX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDgtMTU3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUudm90ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHByb3Bvc2FsSUQsCiAgICAvLyAgICAgdm90ZQogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ1CiAgICAvLyBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIKICAgIHB1c2hpbnQgMjI1MDAgLy8gMjI1MDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgMQogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE0Mi0xNDYKICAgIC8vIGNvbnN0IG1iclBheW1lbnQgPSBpdHhuLnBheW1lbnQoewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogREFPUHJvcG9zYWxWb3Rlc01CUgogICAgLy8gfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE0OC0xNTcKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS52b3RlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG1iclBheW1lbnQsCiAgICAvLyAgICAgcHJvcG9zYWxJRCwKICAgIC8vICAgICB2b3RlCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciA0CiAgICB1bmNvdmVyIDUKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDgtMTU3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUudm90ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHByb3Bvc2FsSUQsCiAgICAvLyAgICAgdm90ZQogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4YTRiMjM2YjYgLy8gbWV0aG9kICJ2b3RlUHJvcG9zYWwocGF5LHVpbnQ2NCx1aW50OCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gdm90ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0LCB2b3RlOiBQcm9wb3NhbFZvdGVUeXBlKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmZpbmFsaXplUHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2My0xNjgKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5maW5hbGl6ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtwcm9wb3NhbElEXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NQogICAgLy8gYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNjUKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NwogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNjMtMTY4CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZmluYWxpemVQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbcHJvcG9zYWxJRF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHg2MmNlOWI1YiAvLyBtZXRob2QgImZpbmFsaXplUHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmV4ZWN1dGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmV4ZWN1dGVQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3MQogICAgLy8gZXhlY3V0ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NC0xNzkKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5leGVjdXRlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW3Byb3Bvc2FsSURdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc2CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NgogICAgLy8gYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgNAogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NC0xNzkKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5leGVjdXRlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW3Byb3Bvc2FsSURdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZjFmZjI4YWEgLy8gbWV0aG9kICJleGVjdXRlUHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBleGVjdXRlUHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4K\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAEAAEIBiYEBmRhb19pZBBzcGVuZGluZ19hZGRyZXNzBBUffHUEjFZHaDEZFEQxGEEAOYIHBGadMNoEBWd/DgRV/jsQBGIlYKQE8DSTtATPfhrmBJNAGBM2GgCOBwAuAIsBXgJ7AsMDLQN1AIAEJA0vZzYaAI4BABIAigIBi/5AAAMyA4mL/3IIRIk2GgFJFSQSRBcoTGcjQzYaAUkVJBJEFzYaAkkVIxJEIlM2GgNJIlmBAghLARUSREsCKWVITLEiKGVETwNPBIj/roAE2brNlLIaTwKyGrIgshiyACWyECKyAbO0PklXBABMVwAEKhJEFSQSQzYaAUkVJBJEF0k2GgJJFSMSRCJTTDYaA0lOAhWBJBJENhoETCllSCIoZUSAC2luaXRpYWxpemVkZUgiUyJMQQA6sSIoZURLBUsHiP83K7IaSwSyGrIgshhLAbIAJbIQIrIBs7Q+SVcEAEsBVwAEKhJEFYE4EkSBBFtFASIoZURJcghEsUsCsgiyB0sCSbIAI7IQIrIBtksGSwiI/ueABAGY/juyGksGshpLBbIasiCyALIYJbIQIrIBs7cBPklXBABMVwAEKhJESRUkEkQqTFCwI0M2GgFJFSQSRBdJNhoCSRUjEkQiU04CNhoDSU4ESRUkEkQ2GgRJTgUVgSQSRDYaBUlOA04FTCllSElOBbEiKGVEgAQfn4sgshpPArIaSbIYSwGyACWyECKyAbO0PklXAAQqEkRJVwQBTIFxW0lOBE4HgAEAE0SxK7IaTwOyGrIYsgAlshAisgGztD5JVwQASwFXAAQqEkQVgTgSRIEEW0lOBAxBAEgiKGVESXIIREsESwYJsbIIsgdLBUmyACOyECKyAbZPA08DiP3ngATLN+ZoshpLCLIaSweyGksGshqyILIAshglshAisgGzI0OxIihlRE4CiP26gAT+IMJpshpLB7IaSwayGksFshqyILIYSwKyACWyECKyAbNC/842GgFJFSQSRBc2GgJJFSMSRCJTNhoDSRUkEkRLAillSEyxIihlRE8DTwSI/WeABBA6a4iyGk8CshqyILIYsgAlshAisgGzI0M2GgFJFSQSRBc2GgJJFSMSRCJTNhoDSRUkEkQ2GgRJFSMSREsDKWVIIihlRElyCESxgeSvAbIIsgdLAbIAI7IQIrIBtk8ETwWI/QGABKSyNrayGk8EshpPA7IasiCyGLIAJbIQIrIBsyNDNhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kVJBJESwIpZUhMsSIoZURPA08EiPy1gARizptbshpPArIasiCyGLIAJbIQIrIBsyNDNhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kVJBJESwIpZUhMsSIoZURPA08EiPxtgATx/yiqshpPArIasiCyGLIAJbIQIrIBsyND\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[242,359,446,556,607],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[46],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[574],\"errorMessage\":\"Proposal does not exist\"},{\"pc\":[138,377,630,862],\"errorMessage\":\"application exists\"},{\"pc\":[197,289,316,373,521,626,699,780,858,958,1030],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[186],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[364,612],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64,uint64,uint64,uint64,uint64)\"},{\"pc\":[170,264,475,759,831,937,1009],\"errorMessage\":\"invalid number of bytes for bool8\"},{\"pc\":[147,161,246,254,451,465,490,750,769,822,841,928,947,1000,1019],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[849],\"errorMessage\":\"invalid number of bytes for uint8\"},{\"pc\":[278,501],\"errorMessage\":\"invalid number of bytes for uint8[36]\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"AkitaDAOPlugin\",\"structs\":{},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"daoAppID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"setup\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"nickname\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"newProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"id\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"(uint8,byte[])[]\",\"name\":\"actions\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"submitProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"voteProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"},{\"type\":\"uint8\",\"name\":\"vote\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"finalizeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"executeProposal\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64\",\"name\":\"proposalID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"daoAppID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZGFvX2lk\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggNgogICAgYnl0ZWNibG9jayAiZGFvX2lkIiAic3BlbmRpbmdfYWRkcmVzcyIgMHgxNTFmN2M3NSAweDhjNTY0NzY4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPUGx1Z2luIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDExCiAgICBwdXNoYnl0ZXNzIDB4NjY5ZDMwZGEgMHgwNTY3N2YwZSAweDU1ZmUzYjEwIDB4NjIyNTYwYTQgMHhmMDM0OTNiNCAweGNmN2UxYWU2IDB4OTM0MDE4MTMgLy8gbWV0aG9kICJzZXR1cCh1aW50NjQsYm9vbCxzdHJpbmcpdm9pZCIsIG1ldGhvZCAibmV3UHJvcG9zYWwodWludDY0LGJvb2wsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl1aW50NjQiLCBtZXRob2QgImVkaXRQcm9wb3NhbCh1aW50NjQsYm9vbCx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIiwgbWV0aG9kICJzdWJtaXRQcm9wb3NhbCh1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAidm90ZVByb3Bvc2FsKHVpbnQ2NCxib29sLHVpbnQ2NCx1aW50OCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZVByb3Bvc2FsKHVpbnQ2NCxib29sLHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJleGVjdXRlUHJvcG9zYWwodWludDY0LGJvb2wsdWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBzZXR1cCBuZXdQcm9wb3NhbCBlZGl0UHJvcG9zYWwgc3VibWl0UHJvcG9zYWwgdm90ZVByb3Bvc2FsIGZpbmFsaXplUHJvcG9zYWwgZXhlY3V0ZVByb3Bvc2FsCiAgICBlcnIKCm1haW5fY3JlYXRlX05vT3BAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPUGx1Z2luIGV4dGVuZHMgQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4MjQwZDJmNjcgLy8gbWV0aG9kICJjcmVhdGUodWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJla2V5QWRkcmVzcyhyZWtleUJhY2s6IHVpbnQ2NCwgd2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgpyZWtleUFkZHJlc3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMQogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIHJla2V5QWRkcmVzcyhyZWtleUJhY2s6IGJvb2xlYW4sIHdhbGxldDogQXBwbGljYXRpb24pOiBBY2NvdW50IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMjIKICAgIC8vIGlmICghcmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJueiByZWtleUFkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMwogICAgLy8gcmV0dXJuIEdsb2JhbC56ZXJvQWRkcmVzcwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICByZXRzdWIKCnJla2V5QWRkcmVzc19hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyNgogICAgLy8gcmV0dXJuIHdhbGxldC5hZGRyZXNzCiAgICBmcmFtZV9kaWcgLTEKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBT1BsdWdpbi5jcmVhdGVbcm91dGluZ10oKSAtPiB2b2lkOgpjcmVhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyMgogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjI0CiAgICAvLyB0aGlzLmRhb0FwcElELnZhbHVlID0gQXBwbGljYXRpb24oZGFvQXBwSUQpCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MjIKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLnNldHVwW3JvdXRpbmddKCkgLT4gdm9pZDoKc2V0dXA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyOQogICAgLy8gc2V0dXAod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBuaWNrbmFtZTogc3RyaW5nKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjMyLTM3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuc2V0dXA+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW25pY2tuYW1lXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjM0CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czozNgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czozMi0zNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnNldHVwPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtuaWNrbmFtZV0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhkOWJhY2Q5NCAvLyBtZXRob2QgInNldHVwKHN0cmluZyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoyOQogICAgLy8gc2V0dXAod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBuaWNrbmFtZTogc3RyaW5nKTogdm9pZCB7CiAgICByZXR1cm4gLy8gb24gZXJyb3I6IGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo6QWtpdGFEQU9QbHVnaW4ubmV3UHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpuZXdQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjQwCiAgICAvLyBuZXdQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIGNpZDogQ0lELCBhY3Rpb25zOiBQcm9wb3NhbEFjdGlvbltdKTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBkdXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHN3YXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18xIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NDUKICAgIC8vIHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NQogICAgLy8gdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NgogICAgLy8gQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNJbml0aWFsaXplZCkKICAgIHB1c2hieXRlcyAiaW5pdGlhbGl6ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0NC00NwogICAgLy8gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQpCiAgICAvLyApWzBdCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0My00OAogICAgLy8gY29uc3QgaW5pdGlhbGl6ZWQgPSBkZWNvZGVBcmM0PGJvb2xlYW4+KAogICAgLy8gICBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgICB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICAgIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzSW5pdGlhbGl6ZWQpCiAgICAvLyAgIClbMF0KICAgIC8vICkKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjUxCiAgICAvLyBsZXQgdG90YWw6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTIKICAgIC8vIGlmIChpbml0aWFsaXplZCkgewogICAgYnogbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo1My01OAogICAgLy8gKHsgdG90YWwgfSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5wcm9wb3NhbENvc3Q+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW2FjdGlvbnNdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjU1CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjU1CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo1NwogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgZGlnIDUKICAgIGRpZyA3CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NTMtNTgKICAgIC8vICh7IHRvdGFsIH0gPSBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUucHJvcG9zYWxDb3N0Pih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFthY3Rpb25zXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkucmV0dXJuVmFsdWUpCiAgICBieXRlY18zIC8vIG1ldGhvZCAicHJvcG9zYWxDb3N0KCh1aW50OCxieXRlW10pW10pKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDQKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIFJla2V5VG8KICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIHB1c2hpbnQgNTYgLy8gNTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yICh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBwdXNoaW50IDQgLy8gNAogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMQoKbmV3UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjMKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo2MwogICAgLy8gcmVjZWl2ZXI6IHRoaXMuZGFvQXBwSUQudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjY3LTc2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLm5ld1Byb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBhY3Rpb25zUGF5bWVudCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgMgogICAgZHVwCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjEtNjUKICAgIC8vIGNvbnN0IGFjdGlvbnNQYXltZW50ID0gaXR4bi5wYXltZW50KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICByZWNlaXZlcjogdGhpcy5kYW9BcHBJRC52YWx1ZS5hZGRyZXNzLAogICAgLy8gICBhbW91bnQ6IHRvdGFsLAogICAgLy8gfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjY3LTc2CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLm5ld1Byb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBhY3Rpb25zUGF5bWVudCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo3NQogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgZGlnIDYKICAgIGRpZyA4CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6NjctNzYKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUubmV3UHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgwMTk4ZmUzYiAvLyBtZXRob2QgIm5ld1Byb3Bvc2FsKHBheSxieXRlWzM2XSwodWludDgsYnl0ZVtdKVtdKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA1CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZ2l0eG4gMSBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo0MAogICAgLy8gbmV3UHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHVpbnQ2NCB7CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmVkaXRQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmVkaXRQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjc5CiAgICAvLyBlZGl0UHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZDogdWludDY0LCBjaWQ6IENJRCwgYWN0aW9uczogUHJvcG9zYWxBY3Rpb25bXSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgY292ZXIgMgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBjb3ZlciA1CiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBjb3ZlciA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgNQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODItODYKICAgIC8vIGNvbnN0IHsgc3RhdHVzLCBmZWVzUGFpZCB9ID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLmdldFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtpZF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjgyLTg2CiAgICAvLyBjb25zdCB7IHN0YXR1cywgZmVlc1BhaWQgfSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5nZXRQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbaWRdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDFmOWY4YjIwIC8vIG1ldGhvZCAiZ2V0UHJvcG9zYWwodWludDY0KSh1aW50OCxieXRlWzM2XSwodWludDY0LHVpbnQ2NCx1aW50NjQpLGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQsKHVpbnQ4LGJ5dGVbXSlbXSkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZHVwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgZXh0cmFjdCA0IDEKICAgIHN3YXAKICAgIHB1c2hpbnQgMTEzIC8vIDExMwogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgNAogICAgY292ZXIgNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2Jhc2UudHM6NAogICAgLy8gcmV0dXJuIG5ldyBhcmM0LlVpbnQ4KHYpCiAgICBwdXNoYnl0ZXMgMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6ODgKICAgIC8vIGFzc2VydChzdGF0dXMgIT09IHVpbnQ4KDApLCBFUlJfUFJPUE9TQUxfRE9FU19OT1RfRVhJU1QpCiAgICAhPQogICAgYXNzZXJ0IC8vIFByb3Bvc2FsIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5MC05NAogICAgLy8gY29uc3QgeyB0b3RhbCB9ID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnByb3Bvc2FsQ29zdD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbYWN0aW9uc10sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWNfMyAvLyBtZXRob2QgInByb3Bvc2FsQ29zdCgodWludDgsYnl0ZVtdKVtdKSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIGludGNfMyAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCA1NiAvLyA1NgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5NgogICAgLy8gaWYgKGZlZXNQYWlkIDwgdG90YWwpIHsKICAgIDwKICAgIGJ6IGVkaXRQcm9wb3NhbF9lbHNlX2JvZHlANwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OTkKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo5OQogICAgLy8gcmVjZWl2ZXI6IHRoaXMuZGFvQXBwSUQudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEwMAogICAgLy8gYW1vdW50OiB0b3RhbCAtIGZlZXNQYWlkLAogICAgZGlnIDQKICAgIGRpZyA2CiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgZGlnIDUKICAgIGR1cAogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjk3LTEwMQogICAgLy8gY29uc3QgYWN0aW9uc1BheW1lbnQgPSBpdHhuLnBheW1lbnQoewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogdG90YWwgLSBmZWVzUGFpZCwKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjExMgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMDMtMTEzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsV2l0aFBheW1lbnQ+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjdGlvbnNQYXltZW50LAogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHhjYjM3ZTY2OCAvLyBtZXRob2QgImVkaXRQcm9wb3NhbFdpdGhQYXltZW50KHBheSx1aW50NjQsYnl0ZVszNl0sKHVpbnQ4LGJ5dGVbXSlbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA4CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmVkaXRQcm9wb3NhbF9hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czo3OQogICAgLy8gZWRpdFByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgaWQ6IHVpbnQ2NCwgY2lkOiBDSUQsIGFjdGlvbnM6IFByb3Bvc2FsQWN0aW9uW10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmVkaXRQcm9wb3NhbF9lbHNlX2JvZHlANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjExNS0xMjQKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5lZGl0UHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGlkLAogICAgLy8gICAgIGNpZCwKICAgIC8vICAgICBhY3Rpb25zCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMTcKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTE3CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIGNvdmVyIDIKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMTUtMTI0CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZWRpdFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpZCwKICAgIC8vICAgICBjaWQsCiAgICAvLyAgICAgYWN0aW9ucwogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZmUyMGMyNjkgLy8gbWV0aG9kICJlZGl0UHJvcG9zYWwodWludDY0LGJ5dGVbMzZdLCh1aW50OCxieXRlW10pW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA2CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIFJla2V5VG8KICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgZGlnIDIKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgYiBlZGl0UHJvcG9zYWxfYWZ0ZXJfaWZfZWxzZUA5CgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLnN1Ym1pdFByb3Bvc2FsW3JvdXRpbmddKCkgLT4gdm9pZDoKc3VibWl0UHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIHN1Ym1pdFByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEzMS0xMzYKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5zdWJtaXRQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbcHJvcG9zYWxJRF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzMKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE4CiAgICAvLyBkYW9BcHBJRCA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogQWtpdGFEQU9QbHVnaW5HbG9iYWxTdGF0ZUtleXNEQU9BcHBJRCB9KQogICAgYnl0ZWNfMCAvLyAiZGFvX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMzCiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzUKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciA0CiAgICBjYWxsc3ViIHJla2V5QWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTMxLTEzNgogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFEQU8ucHJvdG90eXBlLnN1Ym1pdFByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtwcm9wb3NhbElEXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIHB1c2hieXRlcyAweDEwM2E2Yjg4IC8vIG1ldGhvZCAic3VibWl0UHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBzdWJtaXRQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIHByb3Bvc2FsSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjpBa2l0YURBT1BsdWdpbi52b3RlUHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgp2b3RlUHJvcG9zYWw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHZvdGVQcm9wb3NhbCh3YWxsZXQ6IEFwcGxpY2F0aW9uLCByZWtleUJhY2s6IGJvb2xlYW4sIHByb3Bvc2FsSUQ6IHVpbnQ2NCwgdm90ZTogUHJvcG9zYWxWb3RlVHlwZSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjgKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIGJ5dGVjXzEgLy8gInNwZW5kaW5nX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2Ni0yNjkKICAgIC8vIGNvbnN0IFtzcGVuZGluZ0FkZHJlc3NCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDgtMTU3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUudm90ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHByb3Bvc2FsSUQsCiAgICAvLyAgICAgdm90ZQogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTQ1CiAgICAvLyBhbW91bnQ6IERBT1Byb3Bvc2FsVm90ZXNNQlIKICAgIHB1c2hpbnQgMjI1MDAgLy8gMjI1MDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgMQogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE0Mi0xNDYKICAgIC8vIGNvbnN0IG1iclBheW1lbnQgPSBpdHhuLnBheW1lbnQoewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmRhb0FwcElELnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogREFPUHJvcG9zYWxWb3Rlc01CUgogICAgLy8gfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE0OC0xNTcKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS52b3RlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG1iclBheW1lbnQsCiAgICAvLyAgICAgcHJvcG9zYWxJRCwKICAgIC8vICAgICB2b3RlCiAgICAvLyAgIF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciA0CiAgICB1bmNvdmVyIDUKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNDgtMTU3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUudm90ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHByb3Bvc2FsSUQsCiAgICAvLyAgICAgdm90ZQogICAgLy8gICBdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4YTRiMjM2YjYgLy8gbWV0aG9kICJ2b3RlUHJvcG9zYWwocGF5LHVpbnQ2NCx1aW50OCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgaW50Y18zIC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gdm90ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0LCB2b3RlOiBQcm9wb3NhbFZvdGVUeXBlKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmZpbmFsaXplUHJvcG9zYWxbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZVByb3Bvc2FsOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2My0xNjgKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5maW5hbGl6ZVByb3Bvc2FsPih7CiAgICAvLyAgIHNlbmRlciwKICAgIC8vICAgYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICAvLyAgIGFyZ3M6IFtwcm9wb3NhbElEXSwKICAgIC8vICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NQogICAgLy8gYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTgKICAgIC8vIGRhb0FwcElEID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBBa2l0YURBT1BsdWdpbkdsb2JhbFN0YXRlS2V5c0RBT0FwcElEIH0pCiAgICBieXRlY18wIC8vICJkYW9faWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNjUKICAgIC8vIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE2NwogICAgLy8gcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgcmVrZXlBZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxNjMtMTY4CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YURBTy5wcm90b3R5cGUuZmluYWxpemVQcm9wb3NhbD4oewogICAgLy8gICBzZW5kZXIsCiAgICAvLyAgIGFwcElkOiB0aGlzLmRhb0FwcElELnZhbHVlLAogICAgLy8gICBhcmdzOiBbcHJvcG9zYWxJRF0sCiAgICAvLyAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCkKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHg2MmNlOWI1YiAvLyBtZXRob2QgImZpbmFsaXplUHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBmaW5hbGl6ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhREFPUGx1Z2luLmV4ZWN1dGVQcm9wb3NhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmV4ZWN1dGVQcm9wb3NhbDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3MQogICAgLy8gZXhlY3V0ZVByb3Bvc2FsKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgcHJvcG9zYWxJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMSAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NC0xNzkKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5leGVjdXRlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW3Byb3Bvc2FsSURdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc2CiAgICAvLyBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9kYW8vY29udHJhY3QuYWxnby50czoxOAogICAgLy8gZGFvQXBwSUQgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEFraXRhREFPUGx1Z2luR2xvYmFsU3RhdGVLZXlzREFPQXBwSUQgfSkKICAgIGJ5dGVjXzAgLy8gImRhb19pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NgogICAgLy8gYXBwSWQ6IHRoaXMuZGFvQXBwSUQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgNAogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL2Rhby9jb250cmFjdC5hbGdvLnRzOjE3NC0xNzkKICAgIC8vIGFiaUNhbGw8dHlwZW9mIEFraXRhREFPLnByb3RvdHlwZS5leGVjdXRlUHJvcG9zYWw+KHsKICAgIC8vICAgc2VuZGVyLAogICAgLy8gICBhcHBJZDogdGhpcy5kYW9BcHBJRC52YWx1ZSwKICAgIC8vICAgYXJnczogW3Byb3Bvc2FsSURdLAogICAgLy8gICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrLCB3YWxsZXQpCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4ZjFmZjI4YWEgLy8gbWV0aG9kICJleGVjdXRlUHJvcG9zYWwodWludDY0KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgdW5jb3ZlciAyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBSZWtleVRvCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICBpbnRjXzMgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvZGFvL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBleGVjdXRlUHJvcG9zYWwod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBwcm9wb3NhbElEOiB1aW50NjQpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4K\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAEAAEIBiYEBmRhb19pZBBzcGVuZGluZ19hZGRyZXNzBBUffHUEjFZHaDEZFEQxGEEAOYIHBGadMNoEBWd/DgRV/jsQBGIlYKQE8DSTtATPfhrmBJNAGBM2GgCOBwAuAIsBXgJ7AsMDLQN1AIAEJA0vZzYaAI4BABIAigIBi/5AAAMyA4mL/3IIRIk2GgFJFSQSRBcoTGcjQzYaAUkVJBJEFzYaAkkVIxJEIlM2GgNJIlmBAghLARUSREsCKWVITLEiKGVETwNPBIj/roAE2brNlLIaTwKyGrIgshiyACWyECKyAbO0PklXBABMVwAEKhJEFSQSQzYaAUkVJBJEF0k2GgJJFSMSRCJTTDYaA0lOAhWBJBJENhoETCllSCIoZUSAC2luaXRpYWxpemVkZUgiUyJMQQA6sSIoZURLBUsHiP83K7IaSwSyGrIgshhLAbIAJbIQIrIBs7Q+SVcEAEsBVwAEKhJEFYE4EkSBBFtFASIoZURJcghEsUsCsgiyB0sCSbIAI7IQIrIBtksGSwiI/ueABAGY/juyGksGshpLBbIasiCyALIYJbIQIrIBs7cBPklXBABMVwAEKhJESRUkEkQqTFCwI0M2GgFJFSQSRBdJNhoCSRUjEkQiU04CNhoDSU4ESRUkEkQ2GgRJTgUVgSQSRDYaBUlOA04FTCllSElOBbEiKGVEgAQfn4sgshpPArIaSbIYSwGyACWyECKyAbO0PklXAAQqEkRJVwQBTIFxW0lOBE4HgAEAE0SxK7IaTwOyGrIYsgAlshAisgGztD5JVwQASwFXAAQqEkQVgTgSRIEEW0lOBAxBAEgiKGVESXIIREsESwYJsbIIsgdLBUmyACOyECKyAbZPA08DiP3ngATLN+ZoshpLCLIaSweyGksGshqyILIAshglshAisgGzI0OxIihlRE4CiP26gAT+IMJpshpLB7IaSwayGksFshqyILIYSwKyACWyECKyAbNC/842GgFJFSQSRBc2GgJJFSMSRCJTNhoDSRUkEkRLAillSEyxIihlRE8DTwSI/WeABBA6a4iyGk8CshqyILIYsgAlshAisgGzI0M2GgFJFSQSRBc2GgJJFSMSRCJTNhoDSRUkEkQ2GgRJFSMSREsDKWVIIihlRElyCESxgeSvAbIIsgdLAbIAI7IQIrIBtk8ETwWI/QGABKSyNrayGk8EshpPA7IasiCyGLIAJbIQIrIBsyNDNhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kVJBJESwIpZUhMsSIoZURPA08EiPy1gARizptbshpPArIasiCyGLIAJbIQIrIBsyNDNhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kVJBJESwIpZUhMsSIoZURPA08EiPxtgATx/yiqshpPArIasiCyGLIAJbIQIrIBsyND\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "54ba34711f8bd2c0e360dc7fb3514169dc94b7d3bc2263ed34ab6f629079f0b1"
@@ -7,66 +7,66 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	DaoAppID uint64
+	DaoAppID uint64 `json:"daoAppID"`
 }
 
 // SetupArgs holds the arguments for the setup method.
 type SetupArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Nickname  string
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Nickname  string `json:"nickname"`
 }
 
 // NewProposalArgs holds the arguments for the newProposal method.
 type NewProposalArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Cid       [36]byte
-	Actions   [][]interface{}
+	Wallet    uint64                  `json:"wallet"`
+	RekeyBack bool                    `json:"rekeyBack"`
+	Cid       [36]byte                `json:"cid"`
+	Actions   []Tuple2[uint8, []byte] `json:"actions"`
 }
 
 // NewProposalMethodResult holds the result of calling newProposal.
 type NewProposalMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EditProposalArgs holds the arguments for the editProposal method.
 type EditProposalArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Cid       [36]byte
-	Actions   [][]interface{}
+	Wallet    uint64                  `json:"wallet"`
+	RekeyBack bool                    `json:"rekeyBack"`
+	ID        uint64                  `json:"id"`
+	Cid       [36]byte                `json:"cid"`
+	Actions   []Tuple2[uint8, []byte] `json:"actions"`
 }
 
 // SubmitProposalArgs holds the arguments for the submitProposal method.
 type SubmitProposalArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	ProposalID uint64
+	Wallet     uint64 `json:"wallet"`
+	RekeyBack  bool   `json:"rekeyBack"`
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // VoteProposalArgs holds the arguments for the voteProposal method.
 type VoteProposalArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	ProposalID uint64
-	Vote       uint8
+	Wallet     uint64 `json:"wallet"`
+	RekeyBack  bool   `json:"rekeyBack"`
+	ProposalID uint64 `json:"proposalID"`
+	Vote       uint8  `json:"vote"`
 }
 
 // FinalizeProposalArgs holds the arguments for the finalizeProposal method.
 type FinalizeProposalArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	ProposalID uint64
+	Wallet     uint64 `json:"wallet"`
+	RekeyBack  bool   `json:"rekeyBack"`
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // ExecuteProposalArgs holds the arguments for the executeProposal method.
 type ExecuteProposalArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	ProposalID uint64
+	Wallet     uint64 `json:"wallet"`
+	RekeyBack  bool   `json:"rekeyBack"`
+	ProposalID uint64 `json:"proposalID"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
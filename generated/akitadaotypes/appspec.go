@@ -2,4 +2,11 @@
 package akitadaotypes
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"AkitaDAOTypes\",\"arcs\":[22,28],\"methods\":[{\"name\":\"proposalUpgradeAppShape\",\"args\":[{\"type\":\"(uint64,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalUpgradeApp\"}],\"returns\":{\"type\":\"(uint64,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalUpgradeApp\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalAddPluginShape\",\"args\":[{\"type\":\"(uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddPlugin\"}],\"returns\":{\"type\":\"(uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalAddNamedPluginShape\",\"args\":[{\"type\":\"(string,uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddNamedPlugin\"}],\"returns\":{\"type\":\"(string,uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalRemovePluginShape\",\"args\":[{\"type\":\"(uint64,address,string)\",\"name\":\"shape\",\"struct\":\"ProposalRemovePlugin\"}],\"returns\":{\"type\":\"(uint64,address,string)\",\"struct\":\"ProposalRemovePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalRemoveNamedPluginShape\",\"args\":[{\"type\":\"(string,uint64,address,string)\",\"name\":\"shape\",\"struct\":\"ProposalRemoveNamedPlugin\"}],\"returns\":{\"type\":\"(string,uint64,address,string)\",\"struct\":\"ProposalRemoveNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalExecutePluginShape\",\"args\":[{\"type\":\"(uint64,string,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalExecutePlugin\"}],\"returns\":{\"type\":\"(uint64,string,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalExecutePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalExecuteNamedPluginShape\",\"args\":[{\"type\":\"(string,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalExecuteNamedPlugin\"}],\"returns\":{\"type\":\"(string,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalExecuteNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalRemoveExecutePluginShape\",\"args\":[{\"type\":\"(byte[32])\",\"name\":\"shape\",\"struct\":\"ProposalRemoveExecutePlugin\"}],\"returns\":{\"type\":\"(byte[32])\",\"struct\":\"ProposalRemoveExecutePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalAddAllowancesShape\",\"args\":[{\"type\":\"(string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddAllowances\"}],\"returns\":{\"type\":\"(string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddAllowances\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalRemoveAllowancesShape\",\"args\":[{\"type\":\"(string,uint64[])\",\"name\":\"shape\",\"struct\":\"ProposalRemoveAllowances\"}],\"returns\":{\"type\":\"(string,uint64[])\",\"struct\":\"ProposalRemoveAllowances\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalNewEscrowShape\",\"args\":[{\"type\":\"(string)\",\"name\":\"shape\",\"struct\":\"ProposalNewEscrow\"}],\"returns\":{\"type\":\"(string)\",\"struct\":\"ProposalNewEscrow\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalToggleEscrowLockShape\",\"args\":[{\"type\":\"(string)\",\"name\":\"shape\",\"struct\":\"ProposalToggleEscrowLock\"}],\"returns\":{\"type\":\"(string)\",\"struct\":\"ProposalToggleEscrowLock\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"proposalUpdateFieldShape\",\"args\":[{\"type\":\"(string,byte[])\",\"name\":\"shape\",\"struct\":\"ProposalUpdateField\"}],\"returns\":{\"type\":\"(string,byte[])\",\"struct\":\"ProposalUpdateField\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}}],\"structs\":{\"ProposalAddAllowances\":[{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalAddNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"delegationType\",\"type\":\"uint8\"},{\"name\":\"lastValid\",\"type\":\"uint64\"},{\"name\":\"cooldown\",\"type\":\"uint64\"},{\"name\":\"methods\",\"type\":\"(byte[4],uint64)[]\"},{\"name\":\"useRounds\",\"type\":\"bool\"},{\"name\":\"useExecutionKey\",\"type\":\"bool\"},{\"name\":\"coverFees\",\"type\":\"bool\"},{\"name\":\"defaultToEscrow\",\"type\":\"bool\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"},{\"name\":\"sourceLink\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalAddPlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"delegationType\",\"type\":\"uint8\"},{\"name\":\"lastValid\",\"type\":\"uint64\"},{\"name\":\"cooldown\",\"type\":\"uint64\"},{\"name\":\"methods\",\"type\":\"(byte[4],uint64)[]\"},{\"name\":\"useRounds\",\"type\":\"bool\"},{\"name\":\"useExecutionKey\",\"type\":\"bool\"},{\"name\":\"coverFees\",\"type\":\"bool\"},{\"name\":\"defaultToEscrow\",\"type\":\"bool\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"},{\"name\":\"sourceLink\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalExecuteNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}],\"ProposalExecutePlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}],\"ProposalNewEscrow\":[{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalRemoveAllowances\":[{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"assets\",\"type\":\"uint64[]\"}],\"ProposalRemoveExecutePlugin\":[{\"name\":\"executionKey\",\"type\":\"byte[32]\"}],\"ProposalRemoveNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalRemovePlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalToggleEscrowLock\":[{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalUpdateField\":[{\"name\":\"field\",\"type\":\"string\"},{\"name\":\"value\",\"type\":\"byte[]\"}],\"ProposalUpgradeApp\":[{\"name\":\"app\",\"type\":\"uint64\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":0,\"bytes\":0},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMQogICAgYnl0ZWNibG9jayAweDE1MWY3Yzc1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6NAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPVHlwZXMgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gTnVtQXBwQXJncwogICAgYnogbWFpbl9fX2FsZ290c19fLmRlZmF1bHRDcmVhdGVAMjAKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0CiAgICBwdXNoYnl0ZXNzIDB4OTJiMzU4OTYgMHg3ZWU0YmFiNyAweDgyZDVmM2ZmIDB4ZjM5NDFhMmMgMHhmMWNmMjJjYyAweGJkNGVmNzMwIDB4ZWJhYjVlMTQgMHg2YjhhYmQyZiAweGZjYWY4NDIwIDB4NTVjZTVjYTkgMHhhNjM4YmUyMyAweDUwYWFiODFkIDB4MTQ5ZDNjY2IgLy8gbWV0aG9kICJwcm9wb3NhbFVwZ3JhZGVBcHBTaGFwZSgodWludDY0LGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHVpbnQ2NCxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJwcm9wb3NhbEFkZFBsdWdpblNoYXBlKCh1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pKSh1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pIiwgbWV0aG9kICJwcm9wb3NhbEFkZE5hbWVkUGx1Z2luU2hhcGUoKHN0cmluZyx1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pKShzdHJpbmcsdWludDY0LGFkZHJlc3Msc3RyaW5nLHVpbnQ4LHVpbnQ2NCx1aW50NjQsKGJ5dGVbNF0sdWludDY0KVtdLGJvb2wsYm9vbCxib29sLGJvb2wsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxzdHJpbmcsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sKVtdKSIsIG1ldGhvZCAicHJvcG9zYWxSZW1vdmVQbHVnaW5TaGFwZSgodWludDY0LGFkZHJlc3Msc3RyaW5nKSkodWludDY0LGFkZHJlc3Msc3RyaW5nKSIsIG1ldGhvZCAicHJvcG9zYWxSZW1vdmVOYW1lZFBsdWdpblNoYXBlKChzdHJpbmcsdWludDY0LGFkZHJlc3Msc3RyaW5nKSkoc3RyaW5nLHVpbnQ2NCxhZGRyZXNzLHN0cmluZykiLCBtZXRob2QgInByb3Bvc2FsRXhlY3V0ZVBsdWdpblNoYXBlKCh1aW50NjQsc3RyaW5nLGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHVpbnQ2NCxzdHJpbmcsYnl0ZVszMl0sYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAicHJvcG9zYWxFeGVjdXRlTmFtZWRQbHVnaW5TaGFwZSgoc3RyaW5nLGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHN0cmluZyxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJwcm9wb3NhbFJlbW92ZUV4ZWN1dGVQbHVnaW5TaGFwZSgoYnl0ZVszMl0pKShieXRlWzMyXSkiLCBtZXRob2QgInByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlKChzdHJpbmcsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sKVtdKSkoc3RyaW5nLCh1aW50NjQsdWludDgsdWludDY0LHVpbnQ2NCx1aW50NjQsYm9vbClbXSkiLCBtZXRob2QgInByb3Bvc2FsUmVtb3ZlQWxsb3dhbmNlc1NoYXBlKChzdHJpbmcsdWludDY0W10pKShzdHJpbmcsdWludDY0W10pIiwgbWV0aG9kICJwcm9wb3NhbE5ld0VzY3Jvd1NoYXBlKChzdHJpbmcpKShzdHJpbmcpIiwgbWV0aG9kICJwcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2tTaGFwZSgoc3RyaW5nKSkoc3RyaW5nKSIsIG1ldGhvZCAicHJvcG9zYWxVcGRhdGVGaWVsZFNoYXBlKChzdHJpbmcsYnl0ZVtdKSkoc3RyaW5nLGJ5dGVbXSkiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBwcm9wb3NhbFVwZ3JhZGVBcHBTaGFwZSBwcm9wb3NhbEFkZFBsdWdpblNoYXBlIHByb3Bvc2FsQWRkTmFtZWRQbHVnaW5TaGFwZSBwcm9wb3NhbFJlbW92ZVBsdWdpblNoYXBlIHByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW5TaGFwZSBwcm9wb3NhbEV4ZWN1dGVQbHVnaW5TaGFwZSBwcm9wb3NhbEV4ZWN1dGVOYW1lZFBsdWdpblNoYXBlIHByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpblNoYXBlIHByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlIHByb3Bvc2FsUmVtb3ZlQWxsb3dhbmNlc1NoYXBlIHByb3Bvc2FsTmV3RXNjcm93U2hhcGUgcHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrU2hhcGUgcHJvcG9zYWxVcGRhdGVGaWVsZFNoYXBlCiAgICBlcnIKCm1haW5fX19hbGdvdHNfXy5kZWZhdWx0Q3JlYXRlQDIwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQKICAgIC8vIGV4cG9ydCBjbGFzcyBBa2l0YURBT1R5cGVzIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICEKICAgICYmCiAgICByZXR1cm4gLy8gb24gZXJyb3I6IE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AgJiYgY2FuIG9ubHkgY2FsbCB3aGVuIGNyZWF0aW5nCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsVXBncmFkZUFwcFNoYXBlW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvcG9zYWxVcGdyYWRlQXBwU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsQWRkUGx1Z2luU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbEFkZFBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjEwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxBZGROYW1lZFBsdWdpblNoYXBlW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvcG9zYWxBZGROYW1lZFBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjE1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxSZW1vdmVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsUmVtb3ZlUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MjAKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MjUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbEV4ZWN1dGVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsRXhlY3V0ZVBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjMwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxFeGVjdXRlTmFtZWRQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsRXhlY3V0ZU5hbWVkUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MzUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbFJlbW92ZUV4ZWN1dGVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDhbMzJdKQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbEFkZEFsbG93YW5jZXNTaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQ1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxSZW1vdmVBbGxvd2FuY2VzU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFJlbW92ZUFsbG93YW5jZXNTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo1MAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsTmV3RXNjcm93U2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbE5ld0VzY3Jvd1NoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjU1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2tTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo2MAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsVXBkYXRlRmllbGRTaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsVXBkYXRlRmllbGRTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo2NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyABASYBBBUffHUxG0EAajEZFEQxGESCDQSSs1iWBH7kurcEgtXz/wTzlBosBPHPIswEvU73MATrq14UBGuKvS8E/K+EIARVzlypBKY4viMEUKq4HQQUnTzLNhoAjg0ACQARABkAIQApADEAOQBBAFAAWABgAGgAcAAxGRQxGBQQQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDNhoBSRWBIBJEKExQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQw==\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[19],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[129],\"errorMessage\":\"OnCompletion must be NoOp \\u0026\\u0026 can only call when creating\"},{\"pc\":[194],\"errorMessage\":\"invalid number of bytes for (uint8[32])\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"AkitaDAOTypes\",\"structs\":{\"ProposalAddAllowances\":[{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalAddNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"delegationType\",\"type\":\"uint8\"},{\"name\":\"lastValid\",\"type\":\"uint64\"},{\"name\":\"cooldown\",\"type\":\"uint64\"},{\"name\":\"methods\",\"type\":\"(byte[4],uint64)[]\"},{\"name\":\"useRounds\",\"type\":\"bool\"},{\"name\":\"useExecutionKey\",\"type\":\"bool\"},{\"name\":\"coverFees\",\"type\":\"bool\"},{\"name\":\"defaultToEscrow\",\"type\":\"bool\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"},{\"name\":\"sourceLink\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalAddPlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"delegationType\",\"type\":\"uint8\"},{\"name\":\"lastValid\",\"type\":\"uint64\"},{\"name\":\"cooldown\",\"type\":\"uint64\"},{\"name\":\"methods\",\"type\":\"(byte[4],uint64)[]\"},{\"name\":\"useRounds\",\"type\":\"bool\"},{\"name\":\"useExecutionKey\",\"type\":\"bool\"},{\"name\":\"coverFees\",\"type\":\"bool\"},{\"name\":\"defaultToEscrow\",\"type\":\"bool\"},{\"name\":\"fee\",\"type\":\"uint64\"},{\"name\":\"power\",\"type\":\"uint64\"},{\"name\":\"duration\",\"type\":\"uint64\"},{\"name\":\"participation\",\"type\":\"uint64\"},{\"name\":\"approval\",\"type\":\"uint64\"},{\"name\":\"sourceLink\",\"type\":\"string\"},{\"name\":\"allowances\",\"type\":\"(uint64,uint8,uint64,uint64,uint64,bool)[]\"}],\"ProposalExecuteNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}],\"ProposalExecutePlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}],\"ProposalNewEscrow\":[{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalRemoveAllowances\":[{\"name\":\"escrow\",\"type\":\"string\"},{\"name\":\"assets\",\"type\":\"uint64[]\"}],\"ProposalRemoveExecutePlugin\":[{\"name\":\"executionKey\",\"type\":\"byte[32]\"}],\"ProposalRemoveNamedPlugin\":[{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalRemovePlugin\":[{\"name\":\"plugin\",\"type\":\"uint64\"},{\"name\":\"caller\",\"type\":\"address\"},{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalToggleEscrowLock\":[{\"name\":\"escrow\",\"type\":\"string\"}],\"ProposalUpdateField\":[{\"name\":\"field\",\"type\":\"string\"},{\"name\":\"value\",\"type\":\"byte[]\"}],\"ProposalUpgradeApp\":[{\"name\":\"app\",\"type\":\"uint64\"},{\"name\":\"executionKey\",\"type\":\"byte[32]\"},{\"name\":\"groups\",\"type\":\"byte[32][]\"},{\"name\":\"firstValid\",\"type\":\"uint64\"},{\"name\":\"lastValid\",\"type\":\"uint64\"}]},\"methods\":[{\"name\":\"proposalUpgradeAppShape\",\"args\":[{\"type\":\"(uint64,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalUpgradeApp\"}],\"returns\":{\"type\":\"(uint64,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalUpgradeApp\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalAddPluginShape\",\"args\":[{\"type\":\"(uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddPlugin\"}],\"returns\":{\"type\":\"(uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalAddNamedPluginShape\",\"args\":[{\"type\":\"(string,uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddNamedPlugin\"}],\"returns\":{\"type\":\"(string,uint64,address,string,uint8,uint64,uint64,(byte[4],uint64)[],bool,bool,bool,bool,uint64,uint64,uint64,uint64,uint64,string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalRemovePluginShape\",\"args\":[{\"type\":\"(uint64,address,string)\",\"name\":\"shape\",\"struct\":\"ProposalRemovePlugin\"}],\"returns\":{\"type\":\"(uint64,address,string)\",\"struct\":\"ProposalRemovePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalRemoveNamedPluginShape\",\"args\":[{\"type\":\"(string,uint64,address,string)\",\"name\":\"shape\",\"struct\":\"ProposalRemoveNamedPlugin\"}],\"returns\":{\"type\":\"(string,uint64,address,string)\",\"struct\":\"ProposalRemoveNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalExecutePluginShape\",\"args\":[{\"type\":\"(uint64,string,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalExecutePlugin\"}],\"returns\":{\"type\":\"(uint64,string,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalExecutePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalExecuteNamedPluginShape\",\"args\":[{\"type\":\"(string,byte[32],byte[32][],uint64,uint64)\",\"name\":\"shape\",\"struct\":\"ProposalExecuteNamedPlugin\"}],\"returns\":{\"type\":\"(string,byte[32],byte[32][],uint64,uint64)\",\"struct\":\"ProposalExecuteNamedPlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalRemoveExecutePluginShape\",\"args\":[{\"type\":\"(byte[32])\",\"name\":\"shape\",\"struct\":\"ProposalRemoveExecutePlugin\"}],\"returns\":{\"type\":\"(byte[32])\",\"struct\":\"ProposalRemoveExecutePlugin\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalAddAllowancesShape\",\"args\":[{\"type\":\"(string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"name\":\"shape\",\"struct\":\"ProposalAddAllowances\"}],\"returns\":{\"type\":\"(string,(uint64,uint8,uint64,uint64,uint64,bool)[])\",\"struct\":\"ProposalAddAllowances\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalRemoveAllowancesShape\",\"args\":[{\"type\":\"(string,uint64[])\",\"name\":\"shape\",\"struct\":\"ProposalRemoveAllowances\"}],\"returns\":{\"type\":\"(string,uint64[])\",\"struct\":\"ProposalRemoveAllowances\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalNewEscrowShape\",\"args\":[{\"type\":\"(string)\",\"name\":\"shape\",\"struct\":\"ProposalNewEscrow\"}],\"returns\":{\"type\":\"(string)\",\"struct\":\"ProposalNewEscrow\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalToggleEscrowLockShape\",\"args\":[{\"type\":\"(string)\",\"name\":\"shape\",\"struct\":\"ProposalToggleEscrowLock\"}],\"returns\":{\"type\":\"(string)\",\"struct\":\"ProposalToggleEscrowLock\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"proposalUpdateFieldShape\",\"args\":[{\"type\":\"(string,byte[])\",\"name\":\"shape\",\"struct\":\"ProposalUpdateField\"}],\"returns\":{\"type\":\"(string,byte[])\",\"struct\":\"ProposalUpdateField\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true}],\"state\":{\"keys\":{\"global\":{},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMQogICAgYnl0ZWNibG9jayAweDE1MWY3Yzc1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6NAogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhREFPVHlwZXMgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gTnVtQXBwQXJncwogICAgYnogbWFpbl9fX2FsZ290c19fLmRlZmF1bHRDcmVhdGVAMjAKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYXNzZXJ0CiAgICBwdXNoYnl0ZXNzIDB4OTJiMzU4OTYgMHg3ZWU0YmFiNyAweDgyZDVmM2ZmIDB4ZjM5NDFhMmMgMHhmMWNmMjJjYyAweGJkNGVmNzMwIDB4ZWJhYjVlMTQgMHg2YjhhYmQyZiAweGZjYWY4NDIwIDB4NTVjZTVjYTkgMHhhNjM4YmUyMyAweDUwYWFiODFkIDB4MTQ5ZDNjY2IgLy8gbWV0aG9kICJwcm9wb3NhbFVwZ3JhZGVBcHBTaGFwZSgodWludDY0LGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHVpbnQ2NCxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJwcm9wb3NhbEFkZFBsdWdpblNoYXBlKCh1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pKSh1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pIiwgbWV0aG9kICJwcm9wb3NhbEFkZE5hbWVkUGx1Z2luU2hhcGUoKHN0cmluZyx1aW50NjQsYWRkcmVzcyxzdHJpbmcsdWludDgsdWludDY0LHVpbnQ2NCwoYnl0ZVs0XSx1aW50NjQpW10sYm9vbCxib29sLGJvb2wsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHN0cmluZywodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsdWludDY0LGJvb2wpW10pKShzdHJpbmcsdWludDY0LGFkZHJlc3Msc3RyaW5nLHVpbnQ4LHVpbnQ2NCx1aW50NjQsKGJ5dGVbNF0sdWludDY0KVtdLGJvb2wsYm9vbCxib29sLGJvb2wsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxzdHJpbmcsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sKVtdKSIsIG1ldGhvZCAicHJvcG9zYWxSZW1vdmVQbHVnaW5TaGFwZSgodWludDY0LGFkZHJlc3Msc3RyaW5nKSkodWludDY0LGFkZHJlc3Msc3RyaW5nKSIsIG1ldGhvZCAicHJvcG9zYWxSZW1vdmVOYW1lZFBsdWdpblNoYXBlKChzdHJpbmcsdWludDY0LGFkZHJlc3Msc3RyaW5nKSkoc3RyaW5nLHVpbnQ2NCxhZGRyZXNzLHN0cmluZykiLCBtZXRob2QgInByb3Bvc2FsRXhlY3V0ZVBsdWdpblNoYXBlKCh1aW50NjQsc3RyaW5nLGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHVpbnQ2NCxzdHJpbmcsYnl0ZVszMl0sYnl0ZVszMl1bXSx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAicHJvcG9zYWxFeGVjdXRlTmFtZWRQbHVnaW5TaGFwZSgoc3RyaW5nLGJ5dGVbMzJdLGJ5dGVbMzJdW10sdWludDY0LHVpbnQ2NCkpKHN0cmluZyxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NCx1aW50NjQpIiwgbWV0aG9kICJwcm9wb3NhbFJlbW92ZUV4ZWN1dGVQbHVnaW5TaGFwZSgoYnl0ZVszMl0pKShieXRlWzMyXSkiLCBtZXRob2QgInByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlKChzdHJpbmcsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sKVtdKSkoc3RyaW5nLCh1aW50NjQsdWludDgsdWludDY0LHVpbnQ2NCx1aW50NjQsYm9vbClbXSkiLCBtZXRob2QgInByb3Bvc2FsUmVtb3ZlQWxsb3dhbmNlc1NoYXBlKChzdHJpbmcsdWludDY0W10pKShzdHJpbmcsdWludDY0W10pIiwgbWV0aG9kICJwcm9wb3NhbE5ld0VzY3Jvd1NoYXBlKChzdHJpbmcpKShzdHJpbmcpIiwgbWV0aG9kICJwcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2tTaGFwZSgoc3RyaW5nKSkoc3RyaW5nKSIsIG1ldGhvZCAicHJvcG9zYWxVcGRhdGVGaWVsZFNoYXBlKChzdHJpbmcsYnl0ZVtdKSkoc3RyaW5nLGJ5dGVbXSkiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBwcm9wb3NhbFVwZ3JhZGVBcHBTaGFwZSBwcm9wb3NhbEFkZFBsdWdpblNoYXBlIHByb3Bvc2FsQWRkTmFtZWRQbHVnaW5TaGFwZSBwcm9wb3NhbFJlbW92ZVBsdWdpblNoYXBlIHByb3Bvc2FsUmVtb3ZlTmFtZWRQbHVnaW5TaGFwZSBwcm9wb3NhbEV4ZWN1dGVQbHVnaW5TaGFwZSBwcm9wb3NhbEV4ZWN1dGVOYW1lZFBsdWdpblNoYXBlIHByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpblNoYXBlIHByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlIHByb3Bvc2FsUmVtb3ZlQWxsb3dhbmNlc1NoYXBlIHByb3Bvc2FsTmV3RXNjcm93U2hhcGUgcHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrU2hhcGUgcHJvcG9zYWxVcGRhdGVGaWVsZFNoYXBlCiAgICBlcnIKCm1haW5fX19hbGdvdHNfXy5kZWZhdWx0Q3JlYXRlQDIwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQKICAgIC8vIGV4cG9ydCBjbGFzcyBBa2l0YURBT1R5cGVzIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICEKICAgICYmCiAgICByZXR1cm4gLy8gb24gZXJyb3I6IE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AgJiYgY2FuIG9ubHkgY2FsbCB3aGVuIGNyZWF0aW5nCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsVXBncmFkZUFwcFNoYXBlW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvcG9zYWxVcGdyYWRlQXBwU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsQWRkUGx1Z2luU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbEFkZFBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjEwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxBZGROYW1lZFBsdWdpblNoYXBlW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvcG9zYWxBZGROYW1lZFBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjE1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxSZW1vdmVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsUmVtb3ZlUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MjAKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFJlbW92ZU5hbWVkUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MjUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbEV4ZWN1dGVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsRXhlY3V0ZVBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjMwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxFeGVjdXRlTmFtZWRQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsRXhlY3V0ZU5hbWVkUGx1Z2luU2hhcGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6MzUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbFJlbW92ZUV4ZWN1dGVQbHVnaW5TaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsUmVtb3ZlRXhlY3V0ZVBsdWdpblNoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQwCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDhbMzJdKQogICAgYnl0ZWNfMCAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18wIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo6QWtpdGFEQU9UeXBlcy5wcm9wb3NhbEFkZEFsbG93YW5jZXNTaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsQWRkQWxsb3dhbmNlc1NoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjQ1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxSZW1vdmVBbGxvd2FuY2VzU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFJlbW92ZUFsbG93YW5jZXNTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo1MAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsTmV3RXNjcm93U2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbE5ld0VzY3Jvd1NoYXBlOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjU1CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzAgLy8gMHgxNTFmN2M3NQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMCAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvZGFvL3R5cGVzLmFsZ28udHM6OkFraXRhREFPVHlwZXMucHJvcG9zYWxUb2dnbGVFc2Nyb3dMb2NrU2hhcGVbcm91dGluZ10oKSAtPiB2b2lkOgpwcm9wb3NhbFRvZ2dsZUVzY3Jvd0xvY2tTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo2MAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L2Rhby90eXBlcy5hbGdvLnRzOjpBa2l0YURBT1R5cGVzLnByb3Bvc2FsVXBkYXRlRmllbGRTaGFwZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnByb3Bvc2FsVXBkYXRlRmllbGRTaGFwZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9kYW8vdHlwZXMuYWxnby50czo2NQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18wIC8vIDB4MTUxZjdjNzUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzAgLy8gMQogICAgcmV0dXJuCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyABASYBBBUffHUxG0EAajEZFEQxGESCDQSSs1iWBH7kurcEgtXz/wTzlBosBPHPIswEvU73MATrq14UBGuKvS8E/K+EIARVzlypBKY4viMEUKq4HQQUnTzLNhoAjg0ACQARABkAIQApADEAOQBBAFAAWABgAGgAcAAxGRQxGBQQQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDNhoBSRWBIBJEKExQsCJDKDYaAVCwIkMoNhoBULAiQyg2GgFQsCJDKDYaAVCwIkMoNhoBULAiQw==\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "e6f95c18746d6bc5c66e9994882c78fdb60f6d2ce7a51df7211b03c4d20701b2"
@@ -2,26 +2,304 @@
 package akitadaotypes
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendProposalUpgradeAppShape calls the proposalUpgradeAppShape ABI method (readonly).
+	SendProposalUpgradeAppShape(ctx context.Context, params algokit.CallParams[ProposalUpgradeAppShapeArgs]) (*ProposalUpgradeAppShapeMethodResult, error)
+	// SendProposalAddPluginShape calls the proposalAddPluginShape ABI method (readonly).
+	SendProposalAddPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddPluginShapeArgs]) (*ProposalAddPluginShapeMethodResult, error)
+	// SendProposalAddNamedPluginShape calls the proposalAddNamedPluginShape ABI method (readonly).
+	SendProposalAddNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddNamedPluginShapeArgs]) (*ProposalAddNamedPluginShapeMethodResult, error)
+	// SendProposalRemovePluginShape calls the proposalRemovePluginShape ABI method (readonly).
+	SendProposalRemovePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemovePluginShapeArgs]) (*ProposalRemovePluginShapeMethodResult, error)
+	// SendProposalRemoveNamedPluginShape calls the proposalRemoveNamedPluginShape ABI method (readonly).
+	SendProposalRemoveNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveNamedPluginShapeArgs]) (*ProposalRemoveNamedPluginShapeMethodResult, error)
+	// SendProposalExecutePluginShape calls the proposalExecutePluginShape ABI method (readonly).
+	SendProposalExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalExecutePluginShapeArgs]) (*ProposalExecutePluginShapeMethodResult, error)
+	// SendProposalExecuteNamedPluginShape calls the proposalExecuteNamedPluginShape ABI method (readonly).
+	SendProposalExecuteNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalExecuteNamedPluginShapeArgs]) (*ProposalExecuteNamedPluginShapeMethodResult, error)
+	// SendProposalRemoveExecutePluginShape calls the proposalRemoveExecutePluginShape ABI method (readonly).
+	SendProposalRemoveExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveExecutePluginShapeArgs]) (*ProposalRemoveExecutePluginShapeMethodResult, error)
+	// SendProposalAddAllowancesShape calls the proposalAddAllowancesShape ABI method (readonly).
+	SendProposalAddAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalAddAllowancesShapeArgs]) (*ProposalAddAllowancesShapeMethodResult, error)
+	// SendProposalRemoveAllowancesShape calls the proposalRemoveAllowancesShape ABI method (readonly).
+	SendProposalRemoveAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalRemoveAllowancesShapeArgs]) (*ProposalRemoveAllowancesShapeMethodResult, error)
+	// SendProposalNewEscrowShape calls the proposalNewEscrowShape ABI method (readonly).
+	SendProposalNewEscrowShape(ctx context.Context, params algokit.CallParams[ProposalNewEscrowShapeArgs]) (*ProposalNewEscrowShapeMethodResult, error)
+	// SendProposalToggleEscrowLockShape calls the proposalToggleEscrowLockShape ABI method (readonly).
+	SendProposalToggleEscrowLockShape(ctx context.Context, params algokit.CallParams[ProposalToggleEscrowLockShapeArgs]) (*ProposalToggleEscrowLockShapeMethodResult, error)
+	// SendProposalUpdateFieldShape calls the proposalUpdateFieldShape ABI method (readonly).
+	SendProposalUpdateFieldShape(ctx context.Context, params algokit.CallParams[ProposalUpdateFieldShapeArgs]) (*ProposalUpdateFieldShapeMethodResult, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the AkitaDaoTypes smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +311,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("AkitaDaoTypes has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,44 +360,84 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match AkitaDaoTypes")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendProposalUpgradeAppShape calls the proposalUpgradeAppShape ABI method and waits for confirmation.
+// SendProposalUpgradeAppShape calls the proposalUpgradeAppShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalUpgradeAppShape(ctx context.Context, params algokit.CallParams[ProposalUpgradeAppShapeArgs]) (*ProposalUpgradeAppShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalUpgradeAppShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalUpgradeAppShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalUpgradeAppShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalUpgradeAppShape(ctx context.Context, params algokit.CallParams[ProposalUpgradeAppShapeArgs]) (*ProposalUpgradeAppShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalUpgradeAppShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalUpgradeAppShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalUpgradeAppShapeResult decodes the return value of a proposalUpgradeAppShape call.
+func (c *Client) decodeProposalUpgradeAppShapeResult(result *algokit.SendAppTransactionResult) (*ProposalUpgradeAppShapeMethodResult, error) {
 	typedResult := &ProposalUpgradeAppShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -96,102 +453,107 @@ func (c *Client) SendProposalUpgradeAppShape(ctx context.Context, params algokit
 	return typedResult, nil
 }
 
-// SendProposalAddPluginShape calls the proposalAddPluginShape ABI method and waits for confirmation.
+// SendProposalAddPluginShape calls the proposalAddPluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalAddPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddPluginShapeArgs]) (*ProposalAddPluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalAddPluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalAddPluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalAddPluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalAddPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddPluginShapeArgs]) (*ProposalAddPluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalAddPluginShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalAddPluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalAddPluginShapeResult decodes the return value of a proposalAddPluginShape call.
+func (c *Client) decodeProposalAddPluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalAddPluginShapeMethodResult, error) {
 	typedResult := &ProposalAddPluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if vals, ok := result.ABIReturn.([]interface{}); ok {
-			if err := algokit.TupleToStruct(vals, &typedResult.Return); err != nil {
-				return nil, fmt.Errorf("failed to decode return: %w", err)
-			}
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendProposalAddNamedPluginShape calls the proposalAddNamedPluginShape ABI method and waits for confirmation.
+// SendProposalAddNamedPluginShape calls the proposalAddNamedPluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalAddNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddNamedPluginShapeArgs]) (*ProposalAddNamedPluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalAddNamedPluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalAddNamedPluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalAddNamedPluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalAddNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalAddNamedPluginShapeArgs]) (*ProposalAddNamedPluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalAddNamedPluginShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalAddNamedPluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalAddNamedPluginShapeResult decodes the return value of a proposalAddNamedPluginShape call.
+func (c *Client) decodeProposalAddNamedPluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalAddNamedPluginShapeMethodResult, error) {
 	typedResult := &ProposalAddNamedPluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if vals, ok := result.ABIReturn.([]interface{}); ok {
-			if err := algokit.TupleToStruct(vals, &typedResult.Return); err != nil {
-				return nil, fmt.Errorf("failed to decode return: %w", err)
-			}
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendProposalRemovePluginShape calls the proposalRemovePluginShape ABI method and waits for confirmation.
+// SendProposalRemovePluginShape calls the proposalRemovePluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalRemovePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemovePluginShapeArgs]) (*ProposalRemovePluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalRemovePluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalRemovePluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalRemovePluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalRemovePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemovePluginShapeArgs]) (*ProposalRemovePluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalRemovePluginShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalRemovePluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalRemovePluginShapeResult decodes the return value of a proposalRemovePluginShape call.
+func (c *Client) decodeProposalRemovePluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalRemovePluginShapeMethodResult, error) {
 	typedResult := &ProposalRemovePluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -207,28 +569,31 @@ func (c *Client) SendProposalRemovePluginShape(ctx context.Context, params algok
 	return typedResult, nil
 }
 
-// SendProposalRemoveNamedPluginShape calls the proposalRemoveNamedPluginShape ABI method and waits for confirmation.
+// SendProposalRemoveNamedPluginShape calls the proposalRemoveNamedPluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalRemoveNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveNamedPluginShapeArgs]) (*ProposalRemoveNamedPluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalRemoveNamedPluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalRemoveNamedPluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalRemoveNamedPluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalRemoveNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveNamedPluginShapeArgs]) (*ProposalRemoveNamedPluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalRemoveNamedPluginShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalRemoveNamedPluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalRemoveNamedPluginShapeResult decodes the return value of a proposalRemoveNamedPluginShape call.
+func (c *Client) decodeProposalRemoveNamedPluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalRemoveNamedPluginShapeMethodResult, error) {
 	typedResult := &ProposalRemoveNamedPluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -244,28 +609,31 @@ func (c *Client) SendProposalRemoveNamedPluginShape(ctx context.Context, params
 	return typedResult, nil
 }
 
-// SendProposalExecutePluginShape calls the proposalExecutePluginShape ABI method and waits for confirmation.
+// SendProposalExecutePluginShape calls the proposalExecutePluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalExecutePluginShapeArgs]) (*ProposalExecutePluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalExecutePluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalExecutePluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalExecutePluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalExecutePluginShapeArgs]) (*ProposalExecutePluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalExecutePluginShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalExecutePluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalExecutePluginShapeResult decodes the return value of a proposalExecutePluginShape call.
+func (c *Client) decodeProposalExecutePluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalExecutePluginShapeMethodResult, error) {
 	typedResult := &ProposalExecutePluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -281,28 +649,31 @@ func (c *Client) SendProposalExecutePluginShape(ctx context.Context, params algo
 	return typedResult, nil
 }
 
-// SendProposalExecuteNamedPluginShape calls the proposalExecuteNamedPluginShape ABI method and waits for confirmation.
+// SendProposalExecuteNamedPluginShape calls the proposalExecuteNamedPluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalExecuteNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalExecuteNamedPluginShapeArgs]) (*ProposalExecuteNamedPluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalExecuteNamedPluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalExecuteNamedPluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalExecuteNamedPluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalExecuteNamedPluginShape(ctx context.Context, params algokit.CallParams[ProposalExecuteNamedPluginShapeArgs]) (*ProposalExecuteNamedPluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalExecuteNamedPluginShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalExecuteNamedPluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalExecuteNamedPluginShapeResult decodes the return value of a proposalExecuteNamedPluginShape call.
+func (c *Client) decodeProposalExecuteNamedPluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalExecuteNamedPluginShapeMethodResult, error) {
 	typedResult := &ProposalExecuteNamedPluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -318,28 +689,31 @@ func (c *Client) SendProposalExecuteNamedPluginShape(ctx context.Context, params
 	return typedResult, nil
 }
 
-// SendProposalRemoveExecutePluginShape calls the proposalRemoveExecutePluginShape ABI method and waits for confirmation.
+// SendProposalRemoveExecutePluginShape calls the proposalRemoveExecutePluginShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalRemoveExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveExecutePluginShapeArgs]) (*ProposalRemoveExecutePluginShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalRemoveExecutePluginShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalRemoveExecutePluginShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalRemoveExecutePluginShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalRemoveExecutePluginShape(ctx context.Context, params algokit.CallParams[ProposalRemoveExecutePluginShapeArgs]) (*ProposalRemoveExecutePluginShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalRemoveExecutePluginShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalRemoveExecutePluginShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalRemoveExecutePluginShapeResult decodes the return value of a proposalRemoveExecutePluginShape call.
+func (c *Client) decodeProposalRemoveExecutePluginShapeResult(result *algokit.SendAppTransactionResult) (*ProposalRemoveExecutePluginShapeMethodResult, error) {
 	typedResult := &ProposalRemoveExecutePluginShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -355,65 +729,69 @@ func (c *Client) SendProposalRemoveExecutePluginShape(ctx context.Context, param
 	return typedResult, nil
 }
 
-// SendProposalAddAllowancesShape calls the proposalAddAllowancesShape ABI method and waits for confirmation.
+// SendProposalAddAllowancesShape calls the proposalAddAllowancesShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalAddAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalAddAllowancesShapeArgs]) (*ProposalAddAllowancesShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalAddAllowancesShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalAddAllowancesShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalAddAllowancesShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalAddAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalAddAllowancesShapeArgs]) (*ProposalAddAllowancesShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalAddAllowancesShape(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ProposalAddAllowancesShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalAddAllowancesShapeResult decodes the return value of a proposalAddAllowancesShape call.
+func (c *Client) decodeProposalAddAllowancesShapeResult(result *algokit.SendAppTransactionResult) (*ProposalAddAllowancesShapeMethodResult, error) {
 	typedResult := &ProposalAddAllowancesShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if vals, ok := result.ABIReturn.([]interface{}); ok {
-			if err := algokit.TupleToStruct(vals, &typedResult.Return); err != nil {
-				return nil, fmt.Errorf("failed to decode return: %w", err)
-			}
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendProposalRemoveAllowancesShape calls the proposalRemoveAllowancesShape ABI method and waits for confirmation.
+// SendProposalRemoveAllowancesShape calls the proposalRemoveAllowancesShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalRemoveAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalRemoveAllowancesShapeArgs]) (*ProposalRemoveAllowancesShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalRemoveAllowancesShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalRemoveAllowancesShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalRemoveAllowancesShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalRemoveAllowancesShape(ctx context.Context, params algokit.CallParams[ProposalRemoveAllowancesShapeArgs]) (*ProposalRemoveAllowancesShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalRemoveAllowancesShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalRemoveAllowancesShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalRemoveAllowancesShapeResult decodes the return value of a proposalRemoveAllowancesShape call.
+func (c *Client) decodeProposalRemoveAllowancesShapeResult(result *algokit.SendAppTransactionResult) (*ProposalRemoveAllowancesShapeMethodResult, error) {
 	typedResult := &ProposalRemoveAllowancesShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -429,28 +807,31 @@ func (c *Client) SendProposalRemoveAllowancesShape(ctx context.Context, params a
 	return typedResult, nil
 }
 
-// SendProposalNewEscrowShape calls the proposalNewEscrowShape ABI method and waits for confirmation.
+// SendProposalNewEscrowShape calls the proposalNewEscrowShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalNewEscrowShape(ctx context.Context, params algokit.CallParams[ProposalNewEscrowShapeArgs]) (*ProposalNewEscrowShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalNewEscrowShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalNewEscrowShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalNewEscrowShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalNewEscrowShape(ctx context.Context, params algokit.CallParams[ProposalNewEscrowShapeArgs]) (*ProposalNewEscrowShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalNewEscrowShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalNewEscrowShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalNewEscrowShapeResult decodes the return value of a proposalNewEscrowShape call.
+func (c *Client) decodeProposalNewEscrowShapeResult(result *algokit.SendAppTransactionResult) (*ProposalNewEscrowShapeMethodResult, error) {
 	typedResult := &ProposalNewEscrowShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -466,28 +847,31 @@ func (c *Client) SendProposalNewEscrowShape(ctx context.Context, params algokit.
 	return typedResult, nil
 }
 
-// SendProposalToggleEscrowLockShape calls the proposalToggleEscrowLockShape ABI method and waits for confirmation.
+// SendProposalToggleEscrowLockShape calls the proposalToggleEscrowLockShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalToggleEscrowLockShape(ctx context.Context, params algokit.CallParams[ProposalToggleEscrowLockShapeArgs]) (*ProposalToggleEscrowLockShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalToggleEscrowLockShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalToggleEscrowLockShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalToggleEscrowLockShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalToggleEscrowLockShape(ctx context.Context, params algokit.CallParams[ProposalToggleEscrowLockShapeArgs]) (*ProposalToggleEscrowLockShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalToggleEscrowLockShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalToggleEscrowLockShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalToggleEscrowLockShapeResult decodes the return value of a proposalToggleEscrowLockShape call.
+func (c *Client) decodeProposalToggleEscrowLockShapeResult(result *algokit.SendAppTransactionResult) (*ProposalToggleEscrowLockShapeMethodResult, error) {
 	typedResult := &ProposalToggleEscrowLockShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -503,28 +887,31 @@ func (c *Client) SendProposalToggleEscrowLockShape(ctx context.Context, params a
 	return typedResult, nil
 }
 
-// SendProposalUpdateFieldShape calls the proposalUpdateFieldShape ABI method and waits for confirmation.
+// SendProposalUpdateFieldShape calls the proposalUpdateFieldShape ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendProposalUpdateFieldShape(ctx context.Context, params algokit.CallParams[ProposalUpdateFieldShapeArgs]) (*ProposalUpdateFieldShapeMethodResult, error) {
-	methodArgs := argsToInterfaceProposalUpdateFieldShape(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendProposalUpdateFieldShape(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "proposalUpdateFieldShape",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendProposalUpdateFieldShape(ctx context.Context, params algokit.CallParams[ProposalUpdateFieldShapeArgs]) (*ProposalUpdateFieldShapeMethodResult, error) {
+	comp, err := c.NewGroup().ProposalUpdateFieldShape(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*ProposalUpdateFieldShapeMethodResult)
+	return typedResult, nil
+}
 
+// decodeProposalUpdateFieldShapeResult decodes the return value of a proposalUpdateFieldShape call.
+func (c *Client) decodeProposalUpdateFieldShapeResult(result *algokit.SendAppTransactionResult) (*ProposalUpdateFieldShapeMethodResult, error) {
 	typedResult := &ProposalUpdateFieldShapeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -546,16 +933,24 @@ func argsToInterfaceProposalUpgradeAppShape(args ProposalUpgradeAppShapeArgs) []
 	}
 }
 
-func argsToInterfaceProposalAddPluginShape(args ProposalAddPluginShapeArgs) []interface{} {
-	return []interface{}{
-		args.Shape,
+func argsToInterfaceProposalAddPluginShape(args ProposalAddPluginShapeArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Shape); err != nil {
+		return nil, fmt.Errorf("invalid shape argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
-func argsToInterfaceProposalAddNamedPluginShape(args ProposalAddNamedPluginShapeArgs) []interface{} {
-	return []interface{}{
-		args.Shape,
+func argsToInterfaceProposalAddNamedPluginShape(args ProposalAddNamedPluginShapeArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Shape); err != nil {
+		return nil, fmt.Errorf("invalid shape argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceProposalRemovePluginShape(args ProposalRemovePluginShapeArgs) []interface{} {
@@ -588,10 +983,14 @@ func argsToInterfaceProposalRemoveExecutePluginShape(args ProposalRemoveExecuteP
 	}
 }
 
-func argsToInterfaceProposalAddAllowancesShape(args ProposalAddAllowancesShapeArgs) []interface{} {
-	return []interface{}{
-		args.Shape,
+func argsToInterfaceProposalAddAllowancesShape(args ProposalAddAllowancesShapeArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Shape); err != nil {
+		return nil, fmt.Errorf("invalid shape argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceProposalRemoveAllowancesShape(args ProposalRemoveAllowancesShapeArgs) []interface{} {
@@ -6,56 +6,70 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// ProposalRemoveNamedPlugin is a generated struct type.
-type ProposalRemoveNamedPlugin struct {
-	Name   string        `json:"name"`
-	Plugin uint64        `json:"plugin"`
-	Caller types.Address `json:"caller"`
-	Escrow string        `json:"escrow"`
+// ProposalAddAllowances is a generated struct type.
+type ProposalAddAllowances struct {
+	Escrow     string                                                `json:"escrow"`
+	Allowances []Tuple6[uint64, uint8, uint64, uint64, uint64, bool] `json:"allowances"`
 }
 
-// ProposalRemovePlugin is a generated struct type.
-type ProposalRemovePlugin struct {
-	Plugin uint64        `json:"plugin"`
-	Caller types.Address `json:"caller"`
-	Escrow string        `json:"escrow"`
+// ProposalAddNamedPlugin is a generated struct type.
+type ProposalAddNamedPlugin struct {
+	Name            string                                                `json:"name"`
+	Plugin          uint64                                                `json:"plugin"`
+	Caller          types.Address                                         `json:"caller"`
+	Escrow          string                                                `json:"escrow"`
+	DelegationType  uint8                                                 `json:"delegationType"`
+	LastValid       uint64                                                `json:"lastValid"`
+	Cooldown        uint64                                                `json:"cooldown"`
+	Methods         []Tuple2[[4]byte, uint64]                             `json:"methods"`
+	UseRounds       bool                                                  `json:"useRounds"`
+	UseExecutionKey bool                                                  `json:"useExecutionKey"`
+	CoverFees       bool                                                  `json:"coverFees"`
+	DefaultToEscrow bool                                                  `json:"defaultToEscrow"`
+	Fee             uint64                                                `json:"fee"`
+	Power           uint64                                                `json:"power"`
+	Duration        uint64                                                `json:"duration"`
+	Participation   uint64                                                `json:"participation"`
+	Approval        uint64                                                `json:"approval"`
+	SourceLink      string                                                `json:"sourceLink"`
+	Allowances      []Tuple6[uint64, uint8, uint64, uint64, uint64, bool] `json:"allowances"`
 }
 
-// ProposalUpgradeApp is a generated struct type.
-type ProposalUpgradeApp struct {
-	App          uint64     `json:"app"`
+// ProposalAddPlugin is a generated struct type.
+type ProposalAddPlugin struct {
+	Plugin          uint64                                                `json:"plugin"`
+	Caller          types.Address                                         `json:"caller"`
+	Escrow          string                                                `json:"escrow"`
+	DelegationType  uint8                                                 `json:"delegationType"`
+	LastValid       uint64                                                `json:"lastValid"`
+	Cooldown        uint64                                                `json:"cooldown"`
+	Methods         []Tuple2[[4]byte, uint64]                             `json:"methods"`
+	UseRounds       bool                                                  `json:"useRounds"`
+	UseExecutionKey bool                                                  `json:"useExecutionKey"`
+	CoverFees       bool                                                  `json:"coverFees"`
+	DefaultToEscrow bool                                                  `json:"defaultToEscrow"`
+	Fee             uint64                                                `json:"fee"`
+	Power           uint64                                                `json:"power"`
+	Duration        uint64                                                `json:"duration"`
+	Participation   uint64                                                `json:"participation"`
+	Approval        uint64                                                `json:"approval"`
+	SourceLink      string                                                `json:"sourceLink"`
+	Allowances      []Tuple6[uint64, uint8, uint64, uint64, uint64, bool] `json:"allowances"`
+}
+
+// ProposalExecuteNamedPlugin is a generated struct type.
+type ProposalExecuteNamedPlugin struct {
+	Name         string     `json:"name"`
 	ExecutionKey [32]byte   `json:"executionKey"`
 	Groups       [][32]byte `json:"groups"`
 	FirstValid   uint64     `json:"firstValid"`
 	LastValid    uint64     `json:"lastValid"`
 }
 
-// ProposalAddNamedPlugin is a generated struct type.
-type ProposalAddNamedPlugin struct {
-	Name            string          `json:"name"`
-	Plugin          uint64          `json:"plugin"`
-	Caller          types.Address   `json:"caller"`
-	Escrow          string          `json:"escrow"`
-	DelegationType  uint8           `json:"delegationType"`
-	LastValid       uint64          `json:"lastValid"`
-	Cooldown        uint64          `json:"cooldown"`
-	Methods         [][]interface{} `json:"methods"`
-	UseRounds       bool            `json:"useRounds"`
-	UseExecutionKey bool            `json:"useExecutionKey"`
-	CoverFees       bool            `json:"coverFees"`
-	DefaultToEscrow bool            `json:"defaultToEscrow"`
-	Fee             uint64          `json:"fee"`
-	Power           uint64          `json:"power"`
-	Duration        uint64          `json:"duration"`
-	Participation   uint64          `json:"participation"`
-	Approval        uint64          `json:"approval"`
-	SourceLink      string          `json:"sourceLink"`
-	Allowances      [][]interface{} `json:"allowances"`
-}
-
-// ProposalExecuteNamedPlugin is a generated struct type.
-type ProposalExecuteNamedPlugin struct {
-	Name         string     `json:"name"`
+// ProposalExecutePlugin is a generated struct type.
+type ProposalExecutePlugin struct {
+	Plugin       uint64     `json:"plugin"`
+	Escrow       string     `json:"escrow"`
 	ExecutionKey [32]byte   `json:"executionKey"`
 	Groups       [][32]byte `json:"groups"`
 	FirstValid   uint64     `json:"firstValid"`
@@ -67,11 +81,32 @@ type ProposalNewEscrow struct {
 	Escrow string `json:"escrow"`
 }
 
+// ProposalRemoveAllowances is a generated struct type.
+type ProposalRemoveAllowances struct {
+	Escrow string   `json:"escrow"`
+	Assets []uint64 `json:"assets"`
+}
+
 // ProposalRemoveExecutePlugin is a generated struct type.
 type ProposalRemoveExecutePlugin struct {
 	ExecutionKey [32]byte `json:"executionKey"`
 }
 
+// ProposalRemoveNamedPlugin is a generated struct type.
+type ProposalRemoveNamedPlugin struct {
+	Name   string        `json:"name"`
+	Plugin uint64        `json:"plugin"`
+	Caller types.Address `json:"caller"`
+	Escrow string        `json:"escrow"`
+}
+
+// ProposalRemovePlugin is a generated struct type.
+type ProposalRemovePlugin struct {
+	Plugin uint64        `json:"plugin"`
+	Caller types.Address `json:"caller"`
+	Escrow string        `json:"escrow"`
+}
+
 // ProposalToggleEscrowLock is a generated struct type.
 type ProposalToggleEscrowLock struct {
 	Escrow string `json:"escrow"`
@@ -83,189 +118,154 @@ type ProposalUpdateField struct {
 	Value []byte `json:"value"`
 }
 
-// ProposalAddAllowances is a generated struct type.
-type ProposalAddAllowances struct {
-	Escrow     string          `json:"escrow"`
-	Allowances [][]interface{} `json:"allowances"`
-}
-
-// ProposalAddPlugin is a generated struct type.
-type ProposalAddPlugin struct {
-	Plugin          uint64          `json:"plugin"`
-	Caller          types.Address   `json:"caller"`
-	Escrow          string          `json:"escrow"`
-	DelegationType  uint8           `json:"delegationType"`
-	LastValid       uint64          `json:"lastValid"`
-	Cooldown        uint64          `json:"cooldown"`
-	Methods         [][]interface{} `json:"methods"`
-	UseRounds       bool            `json:"useRounds"`
-	UseExecutionKey bool            `json:"useExecutionKey"`
-	CoverFees       bool            `json:"coverFees"`
-	DefaultToEscrow bool            `json:"defaultToEscrow"`
-	Fee             uint64          `json:"fee"`
-	Power           uint64          `json:"power"`
-	Duration        uint64          `json:"duration"`
-	Participation   uint64          `json:"participation"`
-	Approval        uint64          `json:"approval"`
-	SourceLink      string          `json:"sourceLink"`
-	Allowances      [][]interface{} `json:"allowances"`
-}
-
-// ProposalExecutePlugin is a generated struct type.
-type ProposalExecutePlugin struct {
-	Plugin       uint64     `json:"plugin"`
-	Escrow       string     `json:"escrow"`
+// ProposalUpgradeApp is a generated struct type.
+type ProposalUpgradeApp struct {
+	App          uint64     `json:"app"`
 	ExecutionKey [32]byte   `json:"executionKey"`
 	Groups       [][32]byte `json:"groups"`
 	FirstValid   uint64     `json:"firstValid"`
 	LastValid    uint64     `json:"lastValid"`
 }
 
-// ProposalRemoveAllowances is a generated struct type.
-type ProposalRemoveAllowances struct {
-	Escrow string   `json:"escrow"`
-	Assets []uint64 `json:"assets"`
-}
-
 // ProposalUpgradeAppShapeArgs holds the arguments for the proposalUpgradeAppShape method.
 type ProposalUpgradeAppShapeArgs struct {
-	Shape ProposalUpgradeApp
+	Shape ProposalUpgradeApp `json:"shape"`
 }
 
 // ProposalUpgradeAppShapeMethodResult holds the result of calling proposalUpgradeAppShape.
 type ProposalUpgradeAppShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalUpgradeApp
+	Return ProposalUpgradeApp `json:"return"`
 }
 
 // ProposalAddPluginShapeArgs holds the arguments for the proposalAddPluginShape method.
 type ProposalAddPluginShapeArgs struct {
-	Shape ProposalAddPlugin
+	Shape ProposalAddPlugin `json:"shape"`
 }
 
 // ProposalAddPluginShapeMethodResult holds the result of calling proposalAddPluginShape.
 type ProposalAddPluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalAddPlugin
+	Return ProposalAddPlugin `json:"return"`
 }
 
 // ProposalAddNamedPluginShapeArgs holds the arguments for the proposalAddNamedPluginShape method.
 type ProposalAddNamedPluginShapeArgs struct {
-	Shape ProposalAddNamedPlugin
+	Shape ProposalAddNamedPlugin `json:"shape"`
 }
 
 // ProposalAddNamedPluginShapeMethodResult holds the result of calling proposalAddNamedPluginShape.
 type ProposalAddNamedPluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalAddNamedPlugin
+	Return ProposalAddNamedPlugin `json:"return"`
 }
 
 // ProposalRemovePluginShapeArgs holds the arguments for the proposalRemovePluginShape method.
 type ProposalRemovePluginShapeArgs struct {
-	Shape ProposalRemovePlugin
+	Shape ProposalRemovePlugin `json:"shape"`
 }
 
 // ProposalRemovePluginShapeMethodResult holds the result of calling proposalRemovePluginShape.
 type ProposalRemovePluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalRemovePlugin
+	Return ProposalRemovePlugin `json:"return"`
 }
 
 // ProposalRemoveNamedPluginShapeArgs holds the arguments for the proposalRemoveNamedPluginShape method.
 type ProposalRemoveNamedPluginShapeArgs struct {
-	Shape ProposalRemoveNamedPlugin
+	Shape ProposalRemoveNamedPlugin `json:"shape"`
 }
 
 // ProposalRemoveNamedPluginShapeMethodResult holds the result of calling proposalRemoveNamedPluginShape.
 type ProposalRemoveNamedPluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalRemoveNamedPlugin
+	Return ProposalRemoveNamedPlugin `json:"return"`
 }
 
 // ProposalExecutePluginShapeArgs holds the arguments for the proposalExecutePluginShape method.
 type ProposalExecutePluginShapeArgs struct {
-	Shape ProposalExecutePlugin
+	Shape ProposalExecutePlugin `json:"shape"`
 }
 
 // ProposalExecutePluginShapeMethodResult holds the result of calling proposalExecutePluginShape.
 type ProposalExecutePluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalExecutePlugin
+	Return ProposalExecutePlugin `json:"return"`
 }
 
 // ProposalExecuteNamedPluginShapeArgs holds the arguments for the proposalExecuteNamedPluginShape method.
 type ProposalExecuteNamedPluginShapeArgs struct {
-	Shape ProposalExecuteNamedPlugin
+	Shape ProposalExecuteNamedPlugin `json:"shape"`
 }
 
 // ProposalExecuteNamedPluginShapeMethodResult holds the result of calling proposalExecuteNamedPluginShape.
 type ProposalExecuteNamedPluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalExecuteNamedPlugin
+	Return ProposalExecuteNamedPlugin `json:"return"`
 }
 
 // ProposalRemoveExecutePluginShapeArgs holds the arguments for the proposalRemoveExecutePluginShape method.
 type ProposalRemoveExecutePluginShapeArgs struct {
-	Shape ProposalRemoveExecutePlugin
+	Shape ProposalRemoveExecutePlugin `json:"shape"`
 }
 
 // ProposalRemoveExecutePluginShapeMethodResult holds the result of calling proposalRemoveExecutePluginShape.
 type ProposalRemoveExecutePluginShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalRemoveExecutePlugin
+	Return ProposalRemoveExecutePlugin `json:"return"`
 }
 
 // ProposalAddAllowancesShapeArgs holds the arguments for the proposalAddAllowancesShape method.
 type ProposalAddAllowancesShapeArgs struct {
-	Shape ProposalAddAllowances
+	Shape ProposalAddAllowances `json:"shape"`
 }
 
 // ProposalAddAllowancesShapeMethodResult holds the result of calling proposalAddAllowancesShape.
 type ProposalAddAllowancesShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalAddAllowances
+	Return ProposalAddAllowances `json:"return"`
 }
 
 // ProposalRemoveAllowancesShapeArgs holds the arguments for the proposalRemoveAllowancesShape method.
 type ProposalRemoveAllowancesShapeArgs struct {
-	Shape ProposalRemoveAllowances
+	Shape ProposalRemoveAllowances `json:"shape"`
 }
 
 // ProposalRemoveAllowancesShapeMethodResult holds the result of calling proposalRemoveAllowancesShape.
 type ProposalRemoveAllowancesShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalRemoveAllowances
+	Return ProposalRemoveAllowances `json:"return"`
 }
 
 // ProposalNewEscrowShapeArgs holds the arguments for the proposalNewEscrowShape method.
 type ProposalNewEscrowShapeArgs struct {
-	Shape ProposalNewEscrow
+	Shape ProposalNewEscrow `json:"shape"`
 }
 
 // ProposalNewEscrowShapeMethodResult holds the result of calling proposalNewEscrowShape.
 type ProposalNewEscrowShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalNewEscrow
+	Return ProposalNewEscrow `json:"return"`
 }
 
 // ProposalToggleEscrowLockShapeArgs holds the arguments for the proposalToggleEscrowLockShape method.
 type ProposalToggleEscrowLockShapeArgs struct {
-	Shape ProposalToggleEscrowLock
+	Shape ProposalToggleEscrowLock `json:"shape"`
 }
 
 // ProposalToggleEscrowLockShapeMethodResult holds the result of calling proposalToggleEscrowLockShape.
 type ProposalToggleEscrowLockShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalToggleEscrowLock
+	Return ProposalToggleEscrowLock `json:"return"`
 }
 
 // ProposalUpdateFieldShapeArgs holds the arguments for the proposalUpdateFieldShape method.
 type ProposalUpdateFieldShapeArgs struct {
-	Shape ProposalUpdateField
+	Shape ProposalUpdateField `json:"shape"`
 }
 
 // ProposalUpdateFieldShapeMethodResult holds the result of calling proposalUpdateFieldShape.
 type ProposalUpdateFieldShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ProposalUpdateField
+	Return ProposalUpdateField `json:"return"`
 }
@@ -2,4 +2,11 @@
 package akitasocial
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"AkitaSocial\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint64\",\"name\":\"akitaDAOEscrow\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"post\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editPost\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gatedReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"reply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gatedEditReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"vote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"bool\",\"name\":\"isUp\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"editVote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"bool\",\"name\":\"flip\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gatedReact\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"react\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"deleteReaction\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"setPostFlag\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"bool\",\"name\":\"flagged\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"initMeta\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"address\",\"name\":\"user\"},{\"type\":\"bool\",\"name\":\"automated\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFD\"},{\"type\":\"uint64\",\"name\":\"akitaNFT\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"createPayWall\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"((uint8,uint64,uint64)[],(uint8,uint64,uint64)[])\",\"name\":\"payWall\",\"struct\":\"ViewPayWallValue\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateMeta\",\"args\":[{\"type\":\"uint64\",\"name\":\"followGateID\"},{\"type\":\"uint64\",\"name\":\"addressGateID\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFD\"},{\"type\":\"uint64\",\"name\":\"akitaNFT\"},{\"type\":\"uint64\",\"name\":\"defaultPayWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateFollowerMeta\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"newFollowerIndex\"},{\"type\":\"uint64\",\"name\":\"newFollowerCount\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"isBanned\",\"args\":[{\"type\":\"address\",\"name\":\"account\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getUserSocialImpact\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getMetaExists\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getMeta\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"(bool,uint64,uint64,uint64,uint64,uint64,uint64,bool,uint64,uint64,uint64)\",\"struct\":\"MetaValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getPostExists\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getPost\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(address,uint64,uint64,bool,uint64,bool,uint8,byte[])\",\"struct\":\"PostValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getVote\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(uint64,bool)\",\"struct\":\"VoteListValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getVotes\",\"args\":[{\"type\":\"byte[32][]\",\"name\":\"refs\"}],\"returns\":{\"type\":\"(uint64,bool)[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getReactionExists\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"mbr\",\"args\":[{\"type\":\"byte[]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AkitaSocialMBRData\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"payWallMbr\",\"args\":[{\"type\":\"((uint8,uint64,uint64)[],(uint8,uint64,uint64)[])\",\"name\":\"paywall\",\"struct\":\"ViewPayWallValue\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"checkTipMbrRequirements\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"address\",\"name\":\"creator\"},{\"type\":\"uint64\",\"name\":\"wallet\"}],\"returns\":{\"type\":\"(uint8,uint64)\",\"struct\":\"tipMBRInfo\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateAkitaDAOEscrow\",\"args\":[{\"type\":\"uint64\",\"name\":\"app\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"update\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"AkitaSocialMBRData\":[{\"name\":\"follows\",\"type\":\"uint64\"},{\"name\":\"blocks\",\"type\":\"uint64\"},{\"name\":\"posts\",\"type\":\"uint64\"},{\"name\":\"votes\",\"type\":\"uint64\"},{\"name\":\"votelist\",\"type\":\"uint64\"},{\"name\":\"reactions\",\"type\":\"uint64\"},{\"name\":\"reactionlist\",\"type\":\"uint64\"},{\"name\":\"meta\",\"type\":\"uint64\"},{\"name\":\"moderators\",\"type\":\"uint64\"},{\"name\":\"banned\",\"type\":\"uint64\"},{\"name\":\"actions\",\"type\":\"uint64\"}],\"MetaValue\":[{\"name\":\"initialized\",\"type\":\"bool\"},{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"streak\",\"type\":\"uint64\"},{\"name\":\"startDate\",\"type\":\"uint64\"},{\"name\":\"lastActive\",\"type\":\"uint64\"},{\"name\":\"followerIndex\",\"type\":\"uint64\"},{\"name\":\"followerCount\",\"type\":\"uint64\"},{\"name\":\"automated\",\"type\":\"bool\"},{\"name\":\"followGateID\",\"type\":\"uint64\"},{\"name\":\"addressGateID\",\"type\":\"uint64\"},{\"name\":\"defaultPayWallID\",\"type\":\"uint64\"}],\"PostValue\":[{\"name\":\"creator\",\"type\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint64\"},{\"name\":\"gateID\",\"type\":\"uint64\"},{\"name\":\"usePayWall\",\"type\":\"bool\"},{\"name\":\"payWallID\",\"type\":\"uint64\"},{\"name\":\"againstContentPolicy\",\"type\":\"bool\"},{\"name\":\"postType\",\"type\":\"uint8\"},{\"name\":\"ref\",\"type\":\"byte[]\"}],\"ReactionListKey\":[{\"name\":\"user\",\"type\":\"byte[16]\"},{\"name\":\"ref\",\"type\":\"byte[16]\"},{\"name\":\"NFT\",\"type\":\"uint64\"}],\"ReactionsKey\":[{\"name\":\"ref\",\"type\":\"byte[32]\"},{\"name\":\"NFT\",\"type\":\"uint64\"}],\"ViewPayWallValue\":[{\"name\":\"userPayInfo\",\"type\":\"(uint8,uint64,uint64)[]\"},{\"name\":\"agentPayInfo\",\"type\":\"(uint8,uint64,uint64)[]\"}],\"VoteListKey\":[{\"name\":\"user\",\"type\":\"byte[16]\"},{\"name\":\"ref\",\"type\":\"byte[16]\"}],\"VoteListValue\":[{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"isUp\",\"type\":\"bool\"}],\"VotesValue\":[{\"name\":\"voteCount\",\"type\":\"uint64\"},{\"name\":\"isNegative\",\"type\":\"bool\"}],\"tipMBRInfo\":[{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"arc58\",\"type\":\"uint64\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":3,\"bytes\":1},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"akitaDAOEscrow\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZXNjcm93\",\"desc\":\"the app ID for the akita DAO escrow to use\"},\"payWallId\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cGF5d2FsbF9pZA==\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"meta\":{\"keyType\":\"address\",\"valueType\":\"MetaValue\",\"prefix\":\"bQ==\",\"desc\":\"The meta data for each user\"},\"paywall\":{\"keyType\":\"uint64\",\"valueType\":\"ViewPayWallValue\",\"prefix\":\"dw==\",\"desc\":\"Pay wall information for posts\"},\"posts\":{\"keyType\":\"AVMBytes\",\"valueType\":\"PostValue\",\"prefix\":\"cA==\",\"desc\":\"All the posts on the network\"},\"reactionlist\":{\"keyType\":\"ReactionListKey\",\"valueType\":\"AVMBytes\",\"prefix\":\"ZQ==\",\"desc\":\"Who has reacted to what\"},\"reactions\":{\"keyType\":\"ReactionsKey\",\"valueType\":\"uint64\",\"prefix\":\"cg==\",\"desc\":\"Counters for each post to track reactions\"},\"votelist\":{\"keyType\":\"VoteListKey\",\"valueType\":\"VoteListValue\",\"prefix\":\"bw==\",\"desc\":\"User votes and their impact\"},\"votes\":{\"keyType\":\"AVMBytes\",\"valueType\":\"VotesValue\",\"prefix\":\"dg==\",\"desc\":\"Counters for each post to track votes\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMzIgMTAwMDAwIDEzMjAwIDMxNzAwIDYzMDcyMDAwIDg2NDAwIDYwNjAwCiAgICBieXRlY2Jsb2NrICJha2l0YV9kYW8iIDB4MTUxZjdjNzUgInAiIDB4MDAgImNvbnRyb2xsZWRfYWRkcmVzcyIgIm0iIDB4MTQgImFraXRhX2VzY3JvdyIgImFraXRhX2Fzc2V0cyIgInNhbCIgInNvY2lhbF9mZWVzIiAweDAwMDAgIndhbGxldCIgMHg4MCAweDBhIDB4MDMgIm8iICJwYWwiIDB4MDEgInciICJwYXl3YWxsX2lkIiAiYWFsIiAweDAwMDEgMHg2ODM1ZTNiYyAiYSIgMHgwMiAiciIgMHgyN2UzYmI0ZiAweDg0MjY5Yzc4ICJ2ZXJzaW9uIiAweDU4MmZmMzgyIDB4NmNjM2Y2MDYgMHgwMDAwMDAwMDAwMDAwMDAwMDAgMHgwMDNkCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYm56IG1haW5fYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIHBheVdhbGxJZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhU29jaWFsR2xvYmFsU3RhdGVLZXlzUGF5d2FsbElELCBpbml0aWFsVmFsdWU6IDEgfSkKICAgIGJ5dGVjIDIwIC8vICJwYXl3YWxsX2lkIgogICAgaW50Y18xIC8vIDEKICAgIGFwcF9nbG9iYWxfcHV0CgptYWluX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgcHVzaGJ5dGVzIDB4ZWE5MTgwZGQgLy8gbWV0aG9kICJ1cGRhdGUoc3RyaW5nKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBtYWluX3VwZGF0ZV9yb3V0ZUA0CgptYWluX3N3aXRjaF9jYXNlX25leHRANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDQwCiAgICBwdXNoYnl0ZXNzIDB4ODNmMTQ3NDggMHg3MTA1YmJhZCAweGJhNGJmNzk0IDB4ZDVjOGU3YjggMHgyZmZjMzEyMyAweDcyNzI0Zjk2IDB4MWM5OWZlOWUgMHgwMmU5MjYzMSAweDg0ZmE0YjVlIDB4NmU3ZDQyYzIgMHhkMGU1YjE4ZCAweDZlNWI3NzAyIDB4ZWFjNmQ5MjIgMHg4NTY0MzNlYSAweGQwOWYzZGU4IDB4NjlhNGVmOTcgMHgzMzA2YjMyYSAvLyBtZXRob2QgImluaXQoKXZvaWQiLCBtZXRob2QgInBvc3QocGF5LGF4ZmVyLHVpbnQ2NCxieXRlWzI0XSxieXRlWzM2XSx1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZWRpdFBvc3QocGF5LGF4ZmVyLGJ5dGVbMzZdLGJ5dGVbMzJdKXZvaWQiLCBtZXRob2QgImdhdGVkUmVwbHkocGF5LGF4ZmVyLGFwcGwsdWludDY0LGJ5dGVbMjRdLGJ5dGVbMzZdLGJ5dGVbXSx1aW50OCx1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVwbHkocGF5LGF4ZmVyLHVpbnQ2NCxieXRlWzI0XSxieXRlWzM2XSxieXRlW10sdWludDgsdWludDY0LGJvb2wsdWludDY0KXZvaWQiLCBtZXRob2QgImdhdGVkRWRpdFJlcGx5KHBheSxheGZlcixhcHBsLGJ5dGVbMzZdLGJ5dGVbMzJdKXZvaWQiLCBtZXRob2QgImVkaXRSZXBseShwYXksYXhmZXIsYnl0ZVszNl0sYnl0ZVszMl0pdm9pZCIsIG1ldGhvZCAidm90ZShwYXksYXhmZXIsYnl0ZVtdLHVpbnQ4LGJvb2wpdm9pZCIsIG1ldGhvZCAiZWRpdFZvdGUocGF5LGF4ZmVyLGJ5dGVbMzJdLGJvb2wpdm9pZCIsIG1ldGhvZCAiZ2F0ZWRSZWFjdChwYXksYXhmZXIsYXBwbCxieXRlW10sdWludDgsdWludDY0KXZvaWQiLCBtZXRob2QgInJlYWN0KHBheSxheGZlcixieXRlW10sdWludDgsdWludDY0KXZvaWQiLCBtZXRob2QgImRlbGV0ZVJlYWN0aW9uKGJ5dGVbMzJdLHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJzZXRQb3N0RmxhZyhieXRlWzMyXSxib29sKXZvaWQiLCBtZXRob2QgImluaXRNZXRhKHBheSxhZGRyZXNzLGJvb2wsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IiwgbWV0aG9kICJjcmVhdGVQYXlXYWxsKHBheSwoKHVpbnQ4LHVpbnQ2NCx1aW50NjQpW10sKHVpbnQ4LHVpbnQ2NCx1aW50NjQpW10pKXVpbnQ2NCIsIG1ldGhvZCAidXBkYXRlTWV0YSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJ1cGRhdGVGb2xsb3dlck1ldGEoYWRkcmVzcyx1aW50NjQsdWludDY0KXZvaWQiCiAgICBieXRlYyAyOCAvLyBtZXRob2QgImlzQmFubmVkKGFkZHJlc3MpYm9vbCIKICAgIHB1c2hieXRlc3MgMHhlODY5OTM0ZCAweGU2ZTY3YmRjIDB4NzM5ZWE3MGIgMHhjMDIyYmU4OSAweDk4NGJhZWY1IDB4ZjRlYTUwZTQgMHhkMjAyMDVjMiAweGYxMzYwMGQxIDB4OTJlNmRkM2IgMHhhMTM0YTI3OCAweDM0NDE3NWYwIDB4MWVhZDIwYTkgMHgzM2U5MmM5NCAweDg1NGRlZGUwIC8vIG1ldGhvZCAiZ2V0VXNlclNvY2lhbEltcGFjdChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0TWV0YUV4aXN0cyhhZGRyZXNzKWJvb2wiLCBtZXRob2QgImdldE1ldGEoYWRkcmVzcykoYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sLHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAiZ2V0UG9zdEV4aXN0cyhieXRlWzMyXSlib29sIiwgbWV0aG9kICJnZXRQb3N0KGJ5dGVbMzJdKShhZGRyZXNzLHVpbnQ2NCx1aW50NjQsYm9vbCx1aW50NjQsYm9vbCx1aW50OCxieXRlW10pIiwgbWV0aG9kICJnZXRWb3RlKGJ5dGVbMzJdKSh1aW50NjQsYm9vbCkiLCBtZXRob2QgImdldFZvdGVzKGJ5dGVbMzJdW10pKHVpbnQ2NCxib29sKVtdIiwgbWV0aG9kICJnZXRSZWFjdGlvbkV4aXN0cyhieXRlWzMyXSx1aW50NjQpYm9vbCIsIG1ldGhvZCAibWJyKGJ5dGVbXSkodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiLCBtZXRob2QgInBheVdhbGxNYnIoKCh1aW50OCx1aW50NjQsdWludDY0KVtdLCh1aW50OCx1aW50NjQsdWludDY0KVtdKSl1aW50NjQiLCBtZXRob2QgImNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzKHVpbnQ2NCxhZGRyZXNzLHVpbnQ2NCkodWludDgsdWludDY0KSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU9Fc2Nyb3codWludDY0KXZvaWQiLCBtZXRob2QgInVwZGF0ZUFraXRhREFPKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcFVwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggaW5pdCBwb3N0IGVkaXRQb3N0IGdhdGVkUmVwbHkgcmVwbHkgZ2F0ZWRFZGl0UmVwbHkgZWRpdFJlcGx5IHZvdGUgZWRpdFZvdGUgZ2F0ZWRSZWFjdCByZWFjdCBkZWxldGVSZWFjdGlvbiBzZXRQb3N0RmxhZyBpbml0TWV0YSBjcmVhdGVQYXlXYWxsIHVwZGF0ZU1ldGEgdXBkYXRlRm9sbG93ZXJNZXRhIGlzQmFubmVkIGdldFVzZXJTb2NpYWxJbXBhY3QgZ2V0TWV0YUV4aXN0cyBnZXRNZXRhIGdldFBvc3RFeGlzdHMgZ2V0UG9zdCBnZXRWb3RlIGdldFZvdGVzIGdldFJlYWN0aW9uRXhpc3RzIG1iciBwYXlXYWxsTWJyIGNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzIHVwZGF0ZUFraXRhREFPRXNjcm93IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAzOAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMzg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEA0MDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgcHVzaGJ5dGVzIDB4ODhjOTQwZjggLy8gbWV0aG9kICJjcmVhdGUoc3RyaW5nLHVpbnQ2NCx1aW50NjQpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgptYWluX3VwZGF0ZV9yb3V0ZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OmIxNihiOiBieXRlcykgLT4gYnl0ZXM6CmIxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMwogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIGIxNihiOiBieXRlcyk6IGJ5dGVzPDE2PiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNAogICAgLy8gcmV0dXJuIGIuc2xpY2UoMCwgMTYpLnRvRml4ZWQoeyBsZW5ndGg6IDE2IH0pCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgaW50Y18wIC8vIDAKICAgIGRpZyAxCiAgICA+PQogICAgaW50Y18wIC8vIDAKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBmcmFtZV9kaWcgLTEKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDE2CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjppbXBhY3RSYW5nZShpbXBhY3Q6IHVpbnQ2NCwgbWluOiB1aW50NjQsIG1heDogdWludDY0KSAtPiB1aW50NjQ6CmltcGFjdFJhbmdlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjYKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBpbXBhY3RSYW5nZShpbXBhY3Q6IHVpbnQ2NCwgbWluOiB1aW50NjQsIG1heDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjcKICAgIC8vIGNvbnN0IG1pbkltcGFjdDogdWludDY0ID0gKGltcGFjdCA+IDEpID8gaW1wYWN0IC0gMSA6IDEKICAgIGZyYW1lX2RpZyAtMwogICAgaW50Y18xIC8vIDEKICAgID4KICAgIGJ6IGltcGFjdFJhbmdlX3Rlcm5hcnlfZmFsc2VAMgogICAgZnJhbWVfZGlnIC0zCiAgICBpbnRjXzEgLy8gMQogICAgLQoKaW1wYWN0UmFuZ2VfdGVybmFyeV9tZXJnZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjgKICAgIC8vIHJldHVybiBtYXggLSAoKChtYXggLSBtaW4pICogbWluSW1wYWN0KSAvIElNUEFDVF9ESVZJU09SKQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgLTIKICAgIC0KICAgICoKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvCiAgICBmcmFtZV9kaWcgLTEKICAgIHN3YXAKICAgIC0KICAgIHJldHN1YgoKaW1wYWN0UmFuZ2VfdGVybmFyeV9mYWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjcKICAgIC8vIGNvbnN0IG1pbkltcGFjdDogdWludDY0ID0gKGltcGFjdCA+IDEpID8gaW1wYWN0IC0gMSA6IDEKICAgIGludGNfMSAvLyAxCiAgICBiIGltcGFjdFJhbmdlX3Rlcm5hcnlfbWVyZ2VAMwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXRJRDogdWludDY0KSAtPiBieXRlczoKcmVmZXJyZXJPclplcm9BZGRyZXNzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNTYKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiByZWZlcnJlck9yWmVyb0FkZHJlc3Mod2FsbGV0SUQ6IEFwcGxpY2F0aW9uKTogQWNjb3VudCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTU3CiAgICAvLyByZXR1cm4gcmVmZXJyZXJPcih3YWxsZXRJRCwgR2xvYmFsLnplcm9BZGRyZXNzKQogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE1MAogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBmcmFtZV9kaWcgLTEKICAgIGJueiByZWZlcnJlck9yWmVyb0FkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAzCiAgICBmcmFtZV9kaWcgMAoKcmVmZXJyZXJPclplcm9BZGRyZXNzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVmZXJyZXJPckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNTcKICAgIC8vIHJldHVybiByZWZlcnJlck9yKHdhbGxldElELCBHbG9iYWwuemVyb0FkZHJlc3MpCiAgICBzd2FwCiAgICByZXRzdWIKCnJlZmVycmVyT3JaZXJvQWRkcmVzc19hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2OS0xNzIKICAgIC8vIGNvbnN0IFtyZWZlcnJlckJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVmZXJyZXIpCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTcxCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNSZWZlcnJlcikKICAgIHB1c2hieXRlcyAicmVmZXJyZXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2OS0xNzIKICAgIC8vIGNvbnN0IFtyZWZlcnJlckJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVmZXJyZXIpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE1NwogICAgLy8gcmV0dXJuIHJlZmVycmVyT3Iod2FsbGV0SUQsIEdsb2JhbC56ZXJvQWRkcmVzcykKICAgIGIgcmVmZXJyZXJPclplcm9BZGRyZXNzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVmZXJyZXJPckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFdhbGxldElEVXNpbmdBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc2CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbiwgYWRkcmVzczogQWNjb3VudCk6IEFwcGxpY2F0aW9uIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTIKICAgIHB1c2hieXRlcyAib2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2MAogICAgLy8gcmV0dXJuIGdldE90aGVyQXBwTGlzdChha2l0YURBTykuZXNjcm93CiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODItMTg1CiAgICAvLyBjb25zdCBkYXRhID0gYWJpQ2FsbDx0eXBlb2YgRXNjcm93RmFjdG9yeS5wcm90b3R5cGUuZ2V0Pih7CiAgICAvLyAgIGFwcElkOiBlc2Nyb3dGYWN0b3J5LAogICAgLy8gICBhcmdzOiBbYWRkcmVzc10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHgzYzFhNmYzMyAvLyBtZXRob2QgImdldChhZGRyZXNzKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4NwogICAgLy8gaWYgKEJ5dGVzKGRhdGEpLmxlbmd0aCA9PT0gMCB8fCBCeXRlcyhkYXRhKS5sZW5ndGggIT09IDgpIHsKICAgIGxlbgogICAgZHVwCiAgICBieiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9faWZfYm9keUA2CiAgICBmcmFtZV9kaWcgMQogICAgaW50Y18yIC8vIDgKICAgICE9CiAgICBieiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaWZfZWxzZUA3CgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9faWZfYm9keUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODgKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAoKZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc4CiAgICAvLyByZXR1cm4gQXBwbGljYXRpb24oZ2V0V2FsbGV0SUQoZXNjcm93RmFjdG9yeSwgYWRkcmVzcykpCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTkxCiAgICAvLyByZXR1cm4gYnRvaShkYXRhKQogICAgZnJhbWVfZGlnIDAKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc4CiAgICAvLyByZXR1cm4gQXBwbGljYXRpb24oZ2V0V2FsbGV0SUQoZXNjcm93RmFjdG9yeSwgYWRkcmVzcykpCiAgICBiIGdldFdhbGxldElEVXNpbmdBa2l0YURBT19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldFdhbGxldElEQDgKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnYXRlQ2hlY2soZ2F0ZVR4bjogdWludDY0LCBha2l0YURBTzogdWludDY0LCBjYWxsZXI6IGJ5dGVzLCBpZDogdWludDY0KSAtPiB1aW50NjQ6CmdhdGVDaGVjazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI3CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2F0ZUNoZWNrKGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLCBha2l0YURBTzogQXBwbGljYXRpb24sIGNhbGxlcjogQWNjb3VudCwgaWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDIxIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzAKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIGJ6IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMwCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIE9uQ29tcGxldGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBibnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzEKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBOdW1BcHBBcmdzCiAgICBwdXNoaW50IDQgLy8gNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMCAvLyAwCiAgICBndHhuc2FzIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGJ5dGVzIDB4NDM5MjI2NTUgLy8gbWV0aG9kICJtdXN0Q2hlY2soYWRkcmVzcyx1aW50NjQsYnl0ZVtdW10pdm9pZCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzIKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMwogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMSAvLyAxCiAgICBndHhuc2FzIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMzCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzNAogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgZnJhbWVfZGlnIC00CiAgICBwdXNoaW50IDIgLy8gMgogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzNAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI4LTIzNQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gICBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyAgIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIC8vICkKICAgIHJldHN1YgoKZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANzoKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOC0yMzUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vICAgZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gICBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICAvLyApCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpzZW5kUmVmZXJyYWxQYXltZW50KGFraXRhREFPOiB1aW50NjQsIGFzc2V0OiB1aW50NjQsIGFtb3VudDogdWludDY0KSAtPiBieXRlczoKc2VuZFJlZmVycmFsUGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTU4CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gc2VuZFJlZmVycmFsUGF5bWVudChha2l0YURBTzogQXBwbGljYXRpb24sIGFzc2V0OiB1aW50NjQsIGFtb3VudDogdWludDY0KTogUmVmZXJyYWxQYXltZW50SW5mbyB7CiAgICBwcm90byAzIDEKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTkKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyhha2l0YURBTywgVHhuLnNlbmRlcikKICAgIGZyYW1lX2RpZyAtMwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTYwCiAgICAvLyBjb25zdCByZWZlcnJlciA9IHJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXQpCiAgICBjYWxsc3ViIHJlZmVycmVyT3JaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NjMKICAgIC8vIGlmIChhbW91bnQgPiAwICYmIHJlZmVycmVyICE9PSBHbG9iYWwuemVyb0FkZHJlc3MpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgYnogc2VuZFJlZmVycmFsUGF5bWVudF9hZnRlcl9pZl9lbHNlQDYKICAgIGZyYW1lX2RpZyA2CiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgICE9CiAgICBieiBzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2NAogICAgLy8gY29uc3QgW3dhbGxldEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzKSkKICAgIGZyYW1lX2RpZyAtMwogICAgcHVzaGJ5dGVzICJ3YWxsZXRfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTY1CiAgICAvLyBjb25zdCB7IHJlZmVycmVyUGVyY2VudGFnZSB9ID0gZ2V0V2FsbGV0RmVlcyhha2l0YURBTykKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNQogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBwKSwgRElWSVNPUikKICAgIGZyYW1lX2RpZyAtMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTY4CiAgICAvLyBpZiAocmVmZXJyYWxGZWUgPT09IDAgJiYgYW1vdW50ID4gMCkgewogICAgYm56IHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA1CiAgICBmcmFtZV9kaWcgLTEKICAgIGJ6IHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU2OQogICAgLy8gcmVmZXJyYWxGZWUgPSAxCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSAzCgpzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTc1CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZnJhbWVfYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU3NgogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgKyBPTkVfV0VFSyksCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBwdXNoaW50IDYwNDgwMCAvLyA2MDQ4MDAKICAgICsKICAgIGZyYW1lX2J1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NzcKICAgIC8vIFt7IGFkZHJlc3M6IHJlZmVycmVyLCBhbW91bnQ6IHJlZmVycmFsRmVlIH1dLAogICAgZnJhbWVfZGlnIDMKICAgIGl0b2IKICAgIGZyYW1lX2RpZyA2CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgZnJhbWVfZGlnIC0zCiAgICBieXRlYyAyMSAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTMKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLnJld2FyZHMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ5NgogICAgLy8gbGV0IGNvc3Q6IHVpbnQ2NCA9IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMjUzMDAgLy8gMjUzMDAKICAgICoKICAgIHB1c2hpbnQgMzUzMDAgLy8gMzUzMDAKICAgICsKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTcKICAgIC8vIGlmIChhc3NldCA9PT0gMCkgewogICAgZnJhbWVfZGlnIC0yCiAgICBibnogc2VuZFJlZmVycmFsUGF5bWVudF9lbHNlX2JvZHlAOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTgtNTA5CiAgICAvLyBpZCA9IGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAyCiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAzCiAgICAvLyBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICBmcmFtZV9kaWcgMQogICAgZnJhbWVfZGlnIDMKICAgICsKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUwMS01MDQKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDk4LTUwOQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50RGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKSArIHN1bQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgIGFsbG9jYXRpb25zCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTA1CiAgICAvLyB0aW1lVG9VbmxvY2ssCiAgICBmcmFtZV9kaWcgNQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MDYKICAgIC8vIGV4cGlyYXRpb24sCiAgICBmcmFtZV9kaWcgMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTgtNTA5CiAgICAvLyBpZCA9IGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDdiN2RjNWZjIC8vIG1ldGhvZCAiY3JlYXRlSW5zdGFudERpc2J1cnNlbWVudChwYXksdWludDY0LHVpbnQ2NCwoYWRkcmVzcyx1aW50NjQpW10pdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBnaXR4biAxIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKCnNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTQ0CiAgICAvLyByZXR1cm4geyBpZCwgY29zdCB9CiAgICBpdG9iCiAgICBmcmFtZV9kaWcgMQogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU3Mi01NzkKICAgIC8vIGNvbnN0IHsgY29zdDogcmVmZXJyYWxNYnIgfSA9IGNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQoCiAgICAvLyAgIGFraXRhREFPLAogICAgLy8gICBhc3NldCwKICAgIC8vICAgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgKyBPTkVfV0VFSyksCiAgICAvLyAgIFt7IGFkZHJlc3M6IHJlZmVycmVyLCBhbW91bnQ6IHJlZmVycmFsRmVlIH1dLAogICAgLy8gICByZWZlcnJhbEZlZQogICAgLy8gKQogICAgZXh0cmFjdCA4IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTgxCiAgICAvLyByZXR1cm4geyBsZWZ0b3ZlcjogKGFtb3VudCAtIHJlZmVycmFsRmVlKSwgcmVmZXJyYWxNYnIgfQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgMwogICAgLQogICAgaXRvYgogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKc2VuZFJlZmVycmFsUGF5bWVudF9lbHNlX2JvZHlAOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTExCiAgICAvLyBpZiAoIUFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MuaXNPcHRlZEluKEFzc2V0KGFzc2V0KSkpIHsKICAgIGZyYW1lX2RpZyA0CiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgLTIKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBieiBzZW5kUmVmZXJyYWxQYXltZW50X2lmX2JvZHlAMTAKICAgIGZyYW1lX2RpZyAxCgpzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUyNS01NDEKICAgIC8vIGlkID0gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlSW5zdGFudEFzYURpc2J1cnNlbWVudD4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogTWluRGlzYnVyc2VtZW50c01CUiArIChVc2VyQWxsb2NhdGlvbk1CUiAqIGFsbG9jYXRpb25zLmxlbmd0aCkKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgICAgYXNzZXRSZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI5CiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGZyYW1lX2RpZyAxCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MjgtNTMxCiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gfSksCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzMi01MzYKICAgIC8vIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pLAogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzMwogICAgLy8gYXNzZXRSZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGR1cAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZnJhbWVfZGlnIDMKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MzItNTM2CiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICBhc3NldEFtb3VudDogc3VtLAogICAgLy8gICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyB9KSwKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI1LTU0MQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgICB9KSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICBhbGxvY2F0aW9ucwogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzNwogICAgLy8gdGltZVRvVW5sb2NrLAogICAgZnJhbWVfZGlnIDUKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTM4CiAgICAvLyBleHBpcmF0aW9uLAogICAgZnJhbWVfZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI1LTU0MQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgICB9KSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICBhbGxvY2F0aW9ucwogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YWYxYTE0ZjIgLy8gbWV0aG9kICJjcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50KHBheSxheGZlcix1aW50NjQsdWludDY0LChhZGRyZXNzLHVpbnQ2NClbXSl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGdpdHhuIDIgTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgZnJhbWVfYnVyeSAxCiAgICBiIHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUAxMgoKc2VuZFJlZmVycmFsUGF5bWVudF9pZl9ib2R5QDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTIKICAgIC8vIGNvc3QgKz0gR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICBmcmFtZV9kaWcgMQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUxMy01MjIKICAgIC8vIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBBc3NldChhc3NldCkKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTE3CiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTE4CiAgICAvLyBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTYtNTE5CiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIC8vIH0pLAogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTMtNTIyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgQXNzZXQoYXNzZXQpCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTIwCiAgICAvLyBBc3NldChhc3NldCkKICAgIGZyYW1lX2RpZyAtMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTMtNTIyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgQXNzZXQoYXNzZXQpCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHgzOTRlYWViMiAvLyBtZXRob2QgIm9wdEluKHBheSx1aW50NjQpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgYiBzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VAMTEKCnNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1ODQKICAgIC8vIHJldHVybiB7IGxlZnRvdmVyOiBhbW91bnQsIHJlZmVycmFsTWJyOiAwIH0KICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgaW50Y18wIC8vIDAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MTUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDI5IC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxNwogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gdmVyc2lvbgogICAgdW5jb3ZlciAzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzE4CiAgICAvLyB0aGlzLmFraXRhREFPLnZhbHVlID0gYWtpdGFEQU8KICAgIHVuY292ZXIgMgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFraXRhREFPRXNjcm93CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxNQogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmluaXRbcm91dGluZ10oKSAtPiB2b2lkOgppbml0OgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjMKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyMwogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5NAogICAgLy8gY29uc3QgYWtpdGFBc3NldHNCeXRlcyA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzKSlbMF0KICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjMKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjQKICAgIC8vIGFzc2VydCghR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MuaXNPcHRlZEluKGFrdGEpLCBFUlJfQUxSRUFEWV9PUFRFRF9JTikKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBkaWcgMQogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBhbHJlYWR5IG9wdGVkIGluCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzI2LTczMgogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyOAogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzI5CiAgICAvLyBhc3NldEFtb3VudDogMCwKICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjYtNzMxCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjYtNzMyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjczNAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFrdGEpKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzM0CiAgICAvLyBpZiAoIXRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcy5pc09wdGVkSW4oYWt0YSkpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBzd2FwCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIGJ1cnkgMQogICAgYm56IGluaXRfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgc3dhcAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDcKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgYnl0ZWMgMTIgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUwCiAgICAvLyBjb25zdCBbcGx1Z2luQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QpKQogICAgZHVwCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6ODMKICAgIC8vIGNvbnN0IHsgcmV2ZW51ZU1hbmFnZXIgfSA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGR1cAogICAgZXh0cmFjdCA4IDgKICAgIHN3YXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBzd2FwCiAgICBieXRlYyAxMiAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzAtNzMKICAgIC8vIGNvbnN0IGVzY3JvdyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkLAogICAgLy8gICBhcmdzOiBbW25hbWVdXSwKICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHhhMjQwM2RkZiAvLyBtZXRob2QgImFyYzU4X2dldEVzY3Jvd3Moc3RyaW5nW10pKHVpbnQ2NCxib29sKVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjcyCiAgICAvLyBhcmdzOiBbW25hbWVdXSwKICAgIHB1c2hieXRlcyAweDAwMDEwMDAyMDAwYTcyNjU3NjVmNzM2ZjYzNjk2MTZjCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MC03MwogICAgLy8gY29uc3QgZXNjcm93ID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEVzY3Jvd3M+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dLAogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDkgLy8gOQogICAgKgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LGJvb2wxKVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzUKICAgIC8vIGFzc2VydChlc2Nyb3cuaWQgIT09IDAsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBleHRyYWN0IDYgOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBlc2Nyb3cgZm9yIHRoaXMgb3BlcmF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWMgMzAgLy8gbWV0aG9kICJhcmM1OF9yZWtleVRvUGx1Z2luKHVpbnQ2NCxib29sLHN0cmluZyx1aW50NjRbXSwodWludDY0LHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MgogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MwogICAgLy8gbmFtZSwKICAgIHB1c2hieXRlcyAweDAwMGE3MjY1NzY1ZjczNmY2MzY5NjE2YwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjk0CiAgICAvLyBbMF0sIC8vIGFsbCB0aGUgYWtpdGEgZXNjcm93cyBoYXZlIG1ldGhvZCByZXN0cmljdGlvbnMgd2l0aCBvcHRpbiBiZWluZyBpbmRleCAwCiAgICBwdXNoYnl0ZXMgMHgwMDAxMDAwMDAwMDAwMDAwMDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjk1CiAgICAvLyBbXQogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTAxCiAgICAvLyB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU4OAogICAgLy8gbGV0IGNvdW50OiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5MAogICAgLy8gaWYgKCFlc2Nyb3cuaXNPcHRlZEluKGFzc2V0KSkgewogICAgZGlnIDEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogaW5pdF9hZnRlcl9pZl9lbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTkKICAgIC8vIGNvbnN0IFtzcGxpdHNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZXZlbnVlU3BsaXRzKSkKICAgIGRpZyA0CiAgICBwdXNoYnl0ZXMgInJldmVudWVfc3BsaXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTQKICAgIC8vIGNvdW50ICs9IHNwbGl0cy5sZW5ndGgKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTEKICAgIC8vIGNvdW50ICs9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5NAogICAgLy8gY291bnQgKz0gc3BsaXRzLmxlbmd0aAogICAgKwogICAgYnVyeSA0Cgppbml0X2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEwNQogICAgLy8gY29uc3QgbWJyQW1vdW50OiB1aW50NjQgPSBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBvcHRJbkNvdW50CiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGRpZyA0CiAgICAqCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExNAogICAgLy8gcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExNAogICAgLy8gcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTEzLTExNgogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExMAogICAgLy8gd2FsbGV0LAogICAgZGlnIDEKICAgIGR1cAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTEyCiAgICAvLyBbYXNzZXQuaWRdLAogICAgZGlnIDIKICAgIGl0b2IKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBieXRlYyAyMyAvLyBtZXRob2QgIm9wdEluKHVpbnQ2NCxib29sLHVpbnQ2NFtdLHBheSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MgogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTIwCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3M+KHsgYXBwSWQ6IHdhbGxldCB9KQogICAgaXR4bl9uZXh0CiAgICBieXRlYyAzMSAvLyBtZXRob2QgImFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzKCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTMyCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCmluaXRfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyMgogICAgLy8gaW5pdCgpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLnBvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpwb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0NS03NTQKICAgIC8vIHBvc3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFsyNF0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc1NQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzU1CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHBvc3RfYWZ0ZXJfaWZfZWxzZUAzCiAgICBkdXAKCnBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc1OQogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSB0aW1lc3RhbXAgPD0gTUFYX1RJTUVTVEFNUF9EUklGVCwgRVJSX1RJTUVTVEFNUF9UT09fT0xEKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDkKICAgIGR1cAogICAgY292ZXIgMgogICAgLQogICAgcHVzaGludCA2MCAvLyA2MAogICAgPD0KICAgIGFzc2VydCAvLyBQcm92aWRlZCB0aW1lc3RhbXAgaXMgdG9vIGZhciBpbiB0aGUgcGFzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2MgogICAgLy8gY29uc3QgcG9zdEtleSA9IG9wLnNoYTI1NihvcmlnaW4uYnl0ZXMuY29uY2F0KGl0b2IodGltZXN0YW1wKSkuY29uY2F0KG5vbmNlKSkKICAgIGl0b2IKICAgIGRpZyAxCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBjb25jYXQKICAgIHNoYTI1NgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2NAogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblBvc3QpCiAgICBkaWcgMTAKICAgIGJ5dGVjIDE0IC8vIDB4MGEKICAgIGNhbGxzdWIgdmFsaWRhdGVUaXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NjUKICAgIC8vIHRoaXMuY3JlYXRlUG9zdChwb3N0S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIGNpZCwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlUG9zdCwgb3AuYnplcm8oMzIpIGFzIGJ5dGVzPDMyPikKICAgIGludGNfMyAvLyAzMgogICAgYnplcm8KICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgZGlnIDEyCiAgICBkaWcgOQogICAgZGlnIDkKICAgIGRpZyA5CiAgICBkaWcgOQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICB1bmNvdmVyIDgKICAgIGNhbGxzdWIgY3JlYXRlUG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0NS03NTQKICAgIC8vIHBvc3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnBvc3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5lZGl0UG9zdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmVkaXRQb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2OC03NzMKICAgIC8vIGVkaXRQb3N0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNCAvLyBheGZlcgogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGF4ZmVyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NzQKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc3NAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBlZGl0UG9zdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZWRpdFBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NzcKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzc4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IsIHJlZiwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIHBvc3RUeXBlIH0gPSB0aGlzLnBvc3RzKGFtZW5kbWVudCkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMgogICAgcHVzaGludCA1OSAvLyA1OQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgNAogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyAzCiAgICBwdXNoaW50IDQ4IC8vIDQ4CiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIGRpZyA0CiAgICBwdXNoaW50IDQ5IC8vIDQ5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA1CiAgICBwdXNoaW50IDU4IC8vIDU4CiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0CiAgICAvLyByZXR1cm4gY3JlYXRvciA9PT0gb3JpZ2luCiAgICB1bmNvdmVyIDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzc5CiAgICAvLyBhc3NlcnQodGhpcy5pc0NyZWF0b3IoY3JlYXRvciwgd2FsbGV0KSwgRVJSX05PVF9ZT1VSX1BPU1RfVE9fRURJVCkKICAgIGFzc2VydCAvLyBOb3QgeW91ciBwb3N0IHRvIGVkaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODAKICAgIC8vIGFzc2VydCghdGhpcy5pc1JlcGx5KHBvc3RUeXBlKSwgRVJSX0lTX0FfUkVQTFkpCiAgICBkdXAKICAgIGNhbGxzdWIgaXNSZXBseQogICAgIQogICAgYXNzZXJ0IC8vIElzIGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODEKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODUKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNAogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODgKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZGlnIDcKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBleHRyYWN0MwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgNwogICAgYm94X2RlbAogICAgcG9wCiAgICB1bmNvdmVyIDcKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3OTAKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25Qb3N0KQogICAgZGlnIDExCiAgICBieXRlYyAxNCAvLyAweDBhCiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzkxCiAgICAvLyB0aGlzLmNyZWF0ZVBvc3QoZWRpdEtleSwgb3JpZ2luLCBtYnJQYXltZW50LCBjaWQsIGdhdGVJRCwgdXNlUGF5V2FsbCwgcGF5V2FsbElELCBQb3N0VHlwZUVkaXRQb3N0LCBhbWVuZG1lbnQpCiAgICB1bmNvdmVyIDQKICAgIGRpZyAxMgogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDYKICAgIHVuY292ZXIgNgogICAgdW5jb3ZlciA2CiAgICBieXRlYyAyNSAvLyAweDAyCiAgICB1bmNvdmVyIDgKICAgIGNhbGxzdWIgY3JlYXRlUG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2OC03NzMKICAgIC8vIGVkaXRQb3N0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZWRpdFBvc3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGVkaXRQb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2F0ZWRSZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkUmVwbHk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzk0LTgwNgogICAgLy8gZ2F0ZWRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDMgLy8gMwogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMjRdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgwNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZFJlcGx5X2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnYXRlZFJlcGx5X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MDkKICAgIC8vIGNvbnN0IHsgcmVmQnl0ZXMsIGNyZWF0b3I6IGZhbGxiYWNrIH0gPSB0aGlzLnRvQnl0ZXMzMih0eXBlLCByZWYpCiAgICBkaWcgNgogICAgZGlnIDgKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxMgogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSB0aW1lc3RhbXAgPD0gTUFYX1RJTUVTVEFNUF9EUklGVCwgRVJSX1RJTUVTVEFNUF9UT09fT0xEKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDEzCiAgICBkdXAKICAgIGNvdmVyIDQKICAgIC0KICAgIHB1c2hpbnQgNjAgLy8gNjAKICAgIDw9CiAgICBhc3NlcnQgLy8gUHJvdmlkZWQgdGltZXN0YW1wIGlzIHRvbyBmYXIgaW4gdGhlIHBhc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNAogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gY29uc3QgeyBjcmVhdG9yLCBnYXRlSUQ6IHBvc3RHYXRlSUQgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgb3JpZ2luLCBwb3N0R2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHBvc3RHYXRlSUQpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDE3CiAgICBzd2FwCiAgICB1bmNvdmVyIDcKICAgIGR1cAogICAgY292ZXIgNAogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGdhdGVDaGVjawogICAgYXNzZXJ0IC8vIEdhdGUgY2hlY2sgZmFpbGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODE4CiAgICAvLyBjb25zdCBjID0gKGZhbGxiYWNrID09PSBHbG9iYWwuemVyb0FkZHJlc3MpID8gY3JlYXRvciA6IGZhbGxiYWNrCiAgICBkaWcgMgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODE5CiAgICAvLyBjb25zdCBhZGRlZE1iciA9IHRoaXMuY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3NhcnkocmVmQnl0ZXMsIGMpCiAgICBkaWcgMgogICAgc3dhcAogICAgY2FsbHN1YiBjcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyMgogICAgLy8gY29uc3QgcmVwbHlLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChpdG9iKHRpbWVzdGFtcCkpLmNvbmNhdChub25jZSkpCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIGRpZyAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxMgogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MjQKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25SZWFjdCkKICAgIGRpZyAxNQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODI1CiAgICAvLyB0aGlzLmNyZWF0ZVJlcGx5KHJlcGx5S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIGFkZGVkTWJyLCBjaWQsIHJlZkJ5dGVzLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgUG9zdFR5cGVSZXBseSwgb3AuYnplcm8oMzIpIGFzIGJ5dGVzPDMyPikKICAgIGludGNfMyAvLyAzMgogICAgYnplcm8KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgZGlnIDE3CiAgICB1bmNvdmVyIDQKICAgIGRpZyAxMwogICAgdW5jb3ZlciA2CiAgICBkaWcgMTEKICAgIGRpZyAxMQogICAgZGlnIDExCiAgICBieXRlYyAxOCAvLyAweDAxCiAgICB1bmNvdmVyIDEwCiAgICBjYWxsc3ViIGNyZWF0ZVJlcGx5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzk0LTgwNgogICAgLy8gZ2F0ZWRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdhdGVkUmVwbHlfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGdhdGVkUmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5yZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyOC04MzkKICAgIC8vIHJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICB0aW1lc3RhbXA6IHVpbnQ2NCwKICAgIC8vICAgbm9uY2U6IGJ5dGVzPDI0PiwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIGdhdGVJRDogdWludDY0LAogICAgLy8gICB1c2VQYXlXYWxsOiBib29sZWFuLAogICAgLy8gICBwYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAyIC8vIDIKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMjRdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NDAKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0MAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiByZXBseV9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKcmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0MgogICAgLy8gY29uc3QgeyByZWZCeXRlcywgY3JlYXRvcjogZmFsbGJhY2sgfSA9IHRoaXMudG9CeXRlczMyKHR5cGUsIHJlZikKICAgIGRpZyA2CiAgICBkaWcgOAogICAgY2FsbHN1YiB0b0J5dGVzMzIKICAgIGR1cAogICAgZXh0cmFjdCAwIDMyCiAgICBzd2FwCiAgICBleHRyYWN0IDMyIDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ1CiAgICAvLyBhc3NlcnQoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIHRpbWVzdGFtcCA8PSBNQVhfVElNRVNUQU1QX0RSSUZULCBFUlJfVElNRVNUQU1QX1RPT19PTEQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBkaWcgMTMKICAgIGR1cAogICAgY292ZXIgNAogICAgLQogICAgcHVzaGludCA2MCAvLyA2MAogICAgPD0KICAgIGFzc2VydCAvLyBQcm92aWRlZCB0aW1lc3RhbXAgaXMgdG9vIGZhciBpbiB0aGUgcGFzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ3CiAgICAvLyBhc3NlcnQodGhpcy5wb3N0cyhyZWZCeXRlcykuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IsIGdhdGVJRDogcG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhyZWZCeXRlcykudmFsdWUKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIHN3YXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYXNzZXJ0KHBvc3RHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NTEKICAgIC8vIGNvbnN0IGMgPSAoZmFsbGJhY2sgPT09IEdsb2JhbC56ZXJvQWRkcmVzcykgPyBjcmVhdG9yIDogZmFsbGJhY2sKICAgIGRpZyAxCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgID09CiAgICBzZWxlY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NTIKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgYykKICAgIGRpZyAxCiAgICBzd2FwCiAgICBjYWxsc3ViIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODU1CiAgICAvLyBjb25zdCByZXBseUtleSA9IG9wLnNoYTI1NihvcmlnaW4uYnl0ZXMuY29uY2F0KGl0b2IodGltZXN0YW1wKSkuY29uY2F0KG5vbmNlKSkKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBkaWcgMTIKICAgIGNvbmNhdAogICAgc2hhMjU2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODU3CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgMTQKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg1OAogICAgLy8gdGhpcy5jcmVhdGVSZXBseShyZXBseUtleSwgb3JpZ2luLCBtYnJQYXltZW50LCBhZGRlZE1iciwgY2lkLCByZWZCeXRlcywgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlUmVwbHksIG9wLmJ6ZXJvKDMyKSBhcyBieXRlczwzMj4pCiAgICBpbnRjXzMgLy8gMzIKICAgIGJ6ZXJvCiAgICBzd2FwCiAgICB1bmNvdmVyIDIKICAgIGRpZyAxNgogICAgdW5jb3ZlciA0CiAgICBkaWcgMTMKICAgIHVuY292ZXIgNgogICAgZGlnIDExCiAgICBkaWcgMTEKICAgIGRpZyAxMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgdW5jb3ZlciAxMAogICAgY2FsbHN1YiBjcmVhdGVSZXBseQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyOC04MzkKICAgIC8vIHJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICB0aW1lc3RhbXA6IHVpbnQ2NCwKICAgIC8vICAgbm9uY2U6IGJ5dGVzPDI0PiwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIGdhdGVJRDogdWludDY0LAogICAgLy8gICB1c2VQYXlXYWxsOiBib29sZWFuLAogICAgLy8gICBwYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpyZXBseV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgcmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nYXRlZEVkaXRSZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkRWRpdFJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg2MS04NjcKICAgIC8vIGdhdGVkRWRpdFJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBnYXRlVHhuOiBndHhuLkFwcGxpY2F0aW9uQ2FsbFR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFtZW5kbWVudDogYnl0ZXM8MzI+LAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAzIC8vIDMKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBhcHBsCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg2OAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZEVkaXRSZXBseV9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZ2F0ZWRFZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzEKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUkVQTFlfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZXBseSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzIKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgcmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgcG9zdFR5cGUgfSA9IHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIGRpZyAyCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDMKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDQKICAgIHB1c2hpbnQgNDkgLy8gNDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDUKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTQKICAgIC8vIHJldHVybiBjcmVhdG9yID09PSBvcmlnaW4KICAgIHVuY292ZXIgNgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzMKICAgIC8vIGFzc2VydCh0aGlzLmlzQ3JlYXRvcihjcmVhdG9yLCB3YWxsZXQpLCBFUlJfTk9UX1lPVVJfUE9TVF9UT19FRElUKQogICAgYXNzZXJ0IC8vIE5vdCB5b3VyIHBvc3QgdG8gZWRpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg3NAogICAgLy8gYXNzZXJ0KHRoaXMuaXNSZXBseShwb3N0VHlwZSksIEVSUl9OT1RfQV9SRVBMWSkKICAgIGR1cAogICAgY2FsbHN1YiBpc1JlcGx5CiAgICBhc3NlcnQgLy8gTm90IGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzUKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzgKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNQogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBkaWcgNwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgOAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgOAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NAogICAgLy8gY29uc3QgcGFyZW50UG9zdFJlZiA9IHJlZi5zbGljZSgzNiwgNjgpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBkaWcgNgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICBkaWcgMQogICAgPj0KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCA2OCAvLyA2OAogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICB1bmNvdmVyIDgKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gY29uc3QgeyBnYXRlSUQ6IG9nUG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhwYXJlbnRQb3N0UmVmKS52YWx1ZQogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODg2CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgb2dQb3N0R2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NgogICAgLy8gYXNzZXJ0KGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIG9nUG9zdEdhdGVJRCksIEVSUl9GQUlMRURfR0FURSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMTQKICAgIHN3YXAKICAgIGRpZyA5CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgZ2F0ZUNoZWNrCiAgICBhc3NlcnQgLy8gR2F0ZSBjaGVjayBmYWlsZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4ODgKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25SZWFjdCkKICAgIGRpZyAxMwogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODg5CiAgICAvLyB0aGlzLmNyZWF0ZVJlcGx5KGVkaXRLZXksIG9yaWdpbiwgbWJyUGF5bWVudCwgMCwgY2lkLCBwYXJlbnRQb3N0UmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgUG9zdFR5cGVFZGl0UmVwbHksIGFtZW5kbWVudCkKICAgIHN3YXAKICAgIHVuY292ZXIgNgogICAgZGlnIDE0CiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciA4CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgOAogICAgdW5jb3ZlciA4CiAgICB1bmNvdmVyIDgKICAgIGJ5dGVjIDE1IC8vIDB4MDMKICAgIHVuY292ZXIgMTAKICAgIGNhbGxzdWIgY3JlYXRlUmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NjEtODY3CiAgICAvLyBnYXRlZEVkaXRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIGNpZDogQ0lELAogICAgLy8gICBhbWVuZG1lbnQ6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2F0ZWRFZGl0UmVwbHlfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGdhdGVkRWRpdFJlcGx5X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZWRpdFJlcGx5W3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg5Mi04OTcKICAgIC8vIGVkaXRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFtZW5kbWVudDogYnl0ZXM8MzI+CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODk4CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4OTgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZWRpdFJlcGx5X2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgplZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDEKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUkVQTFlfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZXBseSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDIKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgcmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgcG9zdFR5cGUgfSA9IHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIGRpZyAyCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDMKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDQKICAgIHB1c2hpbnQgNDkgLy8gNDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDUKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTQKICAgIC8vIHJldHVybiBjcmVhdG9yID09PSBvcmlnaW4KICAgIHVuY292ZXIgNgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDMKICAgIC8vIGFzc2VydCh0aGlzLmlzQ3JlYXRvcihjcmVhdG9yLCB3YWxsZXQpLCBFUlJfTk9UX1lPVVJfUE9TVF9UT19FRElUKQogICAgYXNzZXJ0IC8vIE5vdCB5b3VyIHBvc3QgdG8gZWRpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkwNAogICAgLy8gYXNzZXJ0KHRoaXMuaXNSZXBseShwb3N0VHlwZSksIEVSUl9OT1RfQV9SRVBMWSkKICAgIGR1cAogICAgY2FsbHN1YiBpc1JlcGx5CiAgICBhc3NlcnQgLy8gTm90IGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDUKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNAogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MTEKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBkaWcgNwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgOAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgOAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxNAogICAgLy8gY29uc3QgcGFyZW50UG9zdFJlZiA9IHJlZi5zbGljZSgzNiwgNjgpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBkaWcgNgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICBkaWcgMQogICAgPj0KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCA2OCAvLyA2OAogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICB1bmNvdmVyIDgKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxNQogICAgLy8gY29uc3QgeyBnYXRlSUQ6IG9nUG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhwYXJlbnRQb3N0UmVmKS52YWx1ZQogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTE2CiAgICAvLyBhc3NlcnQob2dQb3N0R2F0ZUlEID09PSAwLCBFUlJfSEFTX0dBVEUpCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBoYXMgYSBnYXRlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTE4CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgMTIKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxOQogICAgLy8gdGhpcy5jcmVhdGVSZXBseShlZGl0S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIDAsIGNpZCwgcGFyZW50UG9zdFJlZiwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlRWRpdFJlcGx5LCBhbWVuZG1lbnQpCiAgICBzd2FwCiAgICB1bmNvdmVyIDYKICAgIGRpZyAxMwogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgNwogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDgKICAgIHVuY292ZXIgOAogICAgdW5jb3ZlciA4CiAgICBieXRlYyAxNSAvLyAweDAzCiAgICB1bmNvdmVyIDEwCiAgICBjYWxsc3ViIGNyZWF0ZVJlcGx5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODkyLTg5NwogICAgLy8gZWRpdFJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZWRpdFJlcGx5X2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBlZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52b3RlW3JvdXRpbmddKCkgLT4gdm9pZDoKdm90ZToKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MjItOTI4CiAgICAvLyB2b3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBpc1VwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkyOQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHZvdGVfYWZ0ZXJfaWZfZWxzZUA2CiAgICBkdXAKICAgIGJ1cnkgOQoKdm90ZV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTMyCiAgICAvLyBsZXQgeyByZWZCeXRlcywgY3JlYXRvciB9ID0gdGhpcy50b0J5dGVzMzIodHlwZSwgcmVmKQogICAgZGlnIDMKICAgIGR1cAogICAgZGlnIDYKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgYnVyeSAxMAogICAgZXh0cmFjdCAzMiAzMgogICAgYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkzMwogICAgLy8gaWYgKHR5cGUgPT09IFJlZlR5cGVQb3N0KSB7CiAgICBieXRlYyAxNCAvLyAweDBhCiAgICA9PQogICAgYnogdm90ZV9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDgKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkzNAogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKTsKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MzUKICAgIC8vICh7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlKTsKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDEwCgp2b3RlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MzgKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgY3JlYXRvcikKICAgIGRpZyA3CiAgICBkdXAKICAgIGRpZyAxMQogICAgY2FsbHN1YiBjcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0MAogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblJlYWN0KQogICAgZGlnIDcKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0MQogICAgLy8gY29uc3QgbWJyTmVlZGVkOiB1aW50NjQgPSB0aGlzLm1icihCeXRlcygnJykpLnZvdGVsaXN0ICsgYWRkZWRNYnIKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQyCiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUob3JpZ2luLCBtYnJQYXltZW50LCBtYnJOZWVkZWQsIHJlZkJ5dGVzLCBpc1VwKQogICAgZGlnIDEwCiAgICBkaWcgOQogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDMKICAgIGRpZyA2CiAgICBjYWxsc3ViIGNyZWF0ZVZvdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MjItOTI4CiAgICAvLyB2b3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBpc1VwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgdm90ZV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDcKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmVkaXRWb3RlW3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFZvdGU6CiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQ1LTk1MAogICAgLy8gZWRpdFZvdGUoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBmbGlwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTUxCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NTEKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZWRpdFZvdGVfYWZ0ZXJfaWZfZWxzZUA4CiAgICBkdXAKICAgIGJ1cnkgOAoKZWRpdFZvdGVfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NAogICAgLy8gY29uc3Qgdm90ZUxpc3RLZXk6IFZvdGVMaXN0S2V5ID0geyB1c2VyOiBiMTYob3JpZ2luLmJ5dGVzKSwgcmVmOiBiMTYocmVmKSB9CiAgICBkaWcgNwogICAgY2FsbHN1YiBiMTYKICAgIGRpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MgogICAgLy8gdm90ZWxpc3QgPSBCb3hNYXA8Vm90ZUxpc3RLZXksIFZvdGVMaXN0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFZvdGVMaXN0IH0pCiAgICBieXRlYyAxNiAvLyAibyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NQogICAgLy8gYXNzZXJ0KHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLmV4aXN0cywgRVJSX0hBVkVOVF9WT1RFRCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gWW91IGhhdmVuJ3Qgdm90ZWQgb24gdGhpcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NwogICAgLy8gY29uc3QgeyBpbXBhY3QsIGlzVXAgfSA9IHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIHB1c2hpbnQgNjQgLy8gNjQKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2MAogICAgLy8gdGhpcy51cGRhdGVWb3RlcyhyZWYsICFpc1VwLCBpbXBhY3QpCiAgICAhCiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS5kZWxldGUoKQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTY2CiAgICAvLyBpZiAoIWZsaXApIHsKICAgIGRpZyAyCiAgICBibnogZWRpdFZvdGVfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTY4LTk3MwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkudm90ZWxpc3QKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTcxCiAgICAvLyBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkudm90ZWxpc3QKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NjgtOTcyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS52b3RlbGlzdAogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2OC05NzMKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLnZvdGVsaXN0CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCmVkaXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5lZGl0Vm90ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0NS05NTAKICAgIC8vIGVkaXRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzPDMyPiwKICAgIC8vICAgZmxpcDogYm9vbGVhbgogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgplZGl0Vm90ZV9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTc5CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgNAogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgwCiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUob3JpZ2luLCBtYnJQYXltZW50LCAwLCByZWYsICFpc1VwKQogICAgZGlnIDcKICAgIGRpZyA2CiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDYKICAgIGRpZyAxMAogICAgY2FsbHN1YiBjcmVhdGVWb3RlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQ1LTk1MAogICAgLy8gZWRpdFZvdGUoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBmbGlwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIGIgZWRpdFZvdGVfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmVkaXRWb3RlQDUKCmVkaXRWb3RlX2FmdGVyX2lmX2Vsc2VAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGVkaXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2F0ZWRSZWFjdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkUmVhY3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgzLTk5MAogICAgLy8gZ2F0ZWRSZWFjdCgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIE5GVDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDMgLy8gMwogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTkxCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OTEKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZ2F0ZWRSZWFjdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZ2F0ZWRSZWFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTkzCiAgICAvLyBjb25zdCB7IHJlZkJ5dGVzLCBjcmVhdG9yOiBmYWxsYmFjayB9ID0gdGhpcy50b0J5dGVzMzIodHlwZSwgcmVmKQogICAgZGlnIDQKICAgIGRpZyA2CiAgICBjYWxsc3ViIHRvQnl0ZXMzMgogICAgZHVwCiAgICBleHRyYWN0IDAgMzIKICAgIHN3YXAKICAgIGV4dHJhY3QgMzIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5NQogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBjcmVhdG9yLCBnYXRlSUQgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OTcKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgb3JpZ2luLCBnYXRlSUQpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgZ2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAxMQogICAgc3dhcAogICAgdW5jb3ZlciA2CiAgICBkdXAKICAgIGNvdmVyIDQKICAgIHVuY292ZXIgMwogICAgY2FsbHN1YiBnYXRlQ2hlY2sKICAgIGFzc2VydCAvLyBHYXRlIGNoZWNrIGZhaWxlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5OQogICAgLy8gY29uc3QgYyA9IChmYWxsYmFjayA9PT0gR2xvYmFsLnplcm9BZGRyZXNzKSA/IGNyZWF0b3IgOiBmYWxsYmFjawogICAgZGlnIDIKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgPT0KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMDAKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgYykKICAgIGRpZyAyCiAgICBzd2FwCiAgICBjYWxsc3ViIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAwMgogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblJlYWN0KQogICAgZGlnIDkKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMDMKICAgIC8vIHRoaXMuY3JlYXRlUmVhY3Rpb24ob3JpZ2luLCBtYnJQYXltZW50LCBhZGRlZE1iciwgcmVmQnl0ZXMsIE5GVCkKICAgIGRpZyAxMAogICAgc3dhcAogICAgdW5jb3ZlciAzCiAgICBkaWcgNgogICAgY2FsbHN1YiBjcmVhdGVSZWFjdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk4My05OTAKICAgIC8vIGdhdGVkUmVhY3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBORlQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnYXRlZFJlYWN0X2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnYXRlZFJlYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwucmVhY3Rbcm91dGluZ10oKSAtPiB2b2lkOgpyZWFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDA2LTEwMTIKICAgIC8vIHJlYWN0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBORlQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAyIC8vIDIKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxMwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxMwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiByZWFjdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKcmVhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMTUKICAgIC8vIGNvbnN0IHsgcmVmQnl0ZXMsIGNyZWF0b3I6IGZhbGxiYWNrIH0gPSB0aGlzLnRvQnl0ZXMzMih0eXBlLCByZWYpCiAgICBkaWcgNAogICAgZGlnIDYKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxNwogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMTgKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgZ2F0ZUlEOiBwb3N0R2F0ZUlEIH0gPSB0aGlzLnBvc3RzKHJlZkJ5dGVzKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18zIC8vIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxOQogICAgLy8gYXNzZXJ0KHBvc3RHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDIxCiAgICAvLyBjb25zdCBjID0gKGZhbGxiYWNrID09PSBHbG9iYWwuemVyb0FkZHJlc3MpID8gY3JlYXRvciA6IGZhbGxiYWNrCiAgICBkaWcgMQogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAyMgogICAgLy8gY29uc3QgYWRkZWRNYnIgPSB0aGlzLmNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5KHJlZkJ5dGVzLCBjKQogICAgZGlnIDEKICAgIHN3YXAKICAgIGNhbGxzdWIgY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI0CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgOAogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAyNQogICAgLy8gdGhpcy5jcmVhdGVSZWFjdGlvbihvcmlnaW4sIG1iclBheW1lbnQsIGFkZGVkTWJyLCByZWZCeXRlcywgTkZUKQogICAgdW5jb3ZlciAyCiAgICBkaWcgOQogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDMKICAgIGRpZyA2CiAgICBjYWxsc3ViIGNyZWF0ZVJlYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAwNi0xMDEyCiAgICAvLyByZWFjdCgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgTkZUOiB1aW50NjQKICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKcmVhY3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHJlYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZGVsZXRlUmVhY3Rpb25bcm91dGluZ10oKSAtPiB2b2lkOgpkZWxldGVSZWFjdGlvbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI4CiAgICAvLyBkZWxldGVSZWFjdGlvbihyZWY6IGJ5dGVzPDMyPiwgTkZUOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IGRlbGV0ZVJlYWN0aW9uX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpkZWxldGVSZWFjdGlvbl9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzMgogICAgLy8gYXNzZXJ0KCF0aGlzLmlzQmFubmVkKG9yaWdpbiksIEVSUl9CQU5ORUQpCiAgICBkdXAKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBiYW5uZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDUKICAgIGR1cAogICAgY292ZXIgMwogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzMwogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM0CiAgICAvLyBjb25zdCB7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmKS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM1CiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCbG9ja2VkKGNyZWF0b3IsIG9yaWdpbiksIEVSUl9CTE9DS0VEKQogICAgZGlnIDEKICAgIGNhbGxzdWIgaXNCbG9ja2VkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJsb2NrZWQgYnkgdGhlIHVzZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM3CiAgICAvLyBjb25zdCByZWFjdGlvbkxpc3RLZXk6IFJlYWN0aW9uTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZiksIE5GVCB9CiAgICBkdXAKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMgogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcmVhY3Rpb25saXN0ID0gQm94TWFwPFJlYWN0aW9uTGlzdEtleSwgYnl0ZXM8MD4+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFJlYWN0aW9uTGlzdCB9KQogICAgcHVzaGJ5dGVzICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzOQogICAgLy8gYXNzZXJ0KHRoaXMucmVhY3Rpb25saXN0KHJlYWN0aW9uTGlzdEtleSkuZXhpc3RzLCBFUlJfQUxSRUFEWV9SRUFDVEVEKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgYWxyZWFkeSByZWFjdGVkIHRvIHRoaXMgcG9zdCB3aXRoIHRoaXMgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA0MQogICAgLy8gdGhpcy5yZWFjdGlvbnMoeyByZWYsIE5GVCB9KS52YWx1ZSAtPSAxCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQKICAgIC8vIHJlYWN0aW9ucyA9IEJveE1hcDxSZWFjdGlvbnNLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25zIH0pCiAgICBieXRlYyAyNiAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNDEKICAgIC8vIHRoaXMucmVhY3Rpb25zKHsgcmVmLCBORlQgfSkudmFsdWUgLT0gMQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgYnRvaQogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGl0b2IKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyB0aGlzLnJlYWN0aW9ubGlzdChyZWFjdGlvbkxpc3RLZXkpLmRlbGV0ZSgpCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQ0LTEwNTAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLnJlYWN0aW9ubGlzdCwKICAgIC8vIAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQ3CiAgICAvLyBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkucmVhY3Rpb25saXN0LAogICAgcHVzaGJ5dGVzICIiCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNDQtMTA0OQogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkucmVhY3Rpb25saXN0LAogICAgLy8gCiAgICAvLyAgIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA0NC0xMDUwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS5yZWFjdGlvbmxpc3QsCiAgICAvLyAKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMjgKICAgIC8vIGRlbGV0ZVJlYWN0aW9uKHJlZjogYnl0ZXM8MzI+LCBORlQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZGVsZXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGRlbGV0ZVJlYWN0aW9uX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuc2V0UG9zdEZsYWdbcm91dGluZ10oKSAtPiB2b2lkOgpzZXRQb3N0RmxhZzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDUzCiAgICAvLyBzZXRQb3N0RmxhZyhyZWY6IGJ5dGVzPDMyPiwgZmxhZ2dlZDogYm9vbGVhbik6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA1NQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5tb2RlcmF0aW9uKS5hZGRyZXNzLCAnRVJSOk5PVF9NT0RFUkFUSU9OJykKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24pLmFkZHJlc3MsICdFUlI6Tk9UX01PREVSQVRJT04nKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDkgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24pLmFkZHJlc3MsICdFUlI6Tk9UX01PREVSQVRJT04nKQogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gRVJSOk5PVF9NT0RFUkFUSU9OCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHBvc3RzID0gQm94TWFwPGJ5dGVzPDMyPiwgUG9zdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhQb3N0cyB9KQogICAgYnl0ZWNfMiAvLyAicCIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA1NgogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU3CiAgICAvLyB0aGlzLnBvc3RzKHJlZikudmFsdWUuYWdhaW5zdENvbnRlbnRQb2xpY3kgPSBmbGFnZ2VkCiAgICBkdXAKICAgIHB1c2hpbnQgNTcgLy8gNTcKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMwogICAgc2V0Yml0CiAgICBwdXNoaW50IDU3IC8vIDU3CiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNTMKICAgIC8vIHNldFBvc3RGbGFnKHJlZjogYnl0ZXM8MzI+LCBmbGFnZ2VkOiBib29sZWFuKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pbml0TWV0YVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmluaXRNZXRhOgogICAgaW50Y18wIC8vIDAKICAgIHB1c2hieXRlcyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2NwogICAgLy8gaW5pdE1ldGEoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdXNlcjogQWNjb3VudCwKICAgIC8vICAgYXV0b21hdGVkOiBib29sZWFuLAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICk6IHVpbnQ2NCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCB1c2VyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCB1c2VyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHN3YXAKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBpbml0TWV0YV9hZnRlcl9pZl9lbHNlQDEyCiAgICBkaWcgNQogICAgYnVyeSA5Cgppbml0TWV0YV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzAKICAgIC8vIGNvbnN0IHVzZXJJc1NlbmRlciA9IChUeG4uc2VuZGVyID09PSB1c2VyKQogICAgdHhuIFNlbmRlcgogICAgZGlnIDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgTWV0YVZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlYyA1IC8vICJtIgogICAgZGlnIDEwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDcyCiAgICAvLyBhc3NlcnQoIXRoaXMubWV0YShvcmlnaW4pLmV4aXN0cywgRVJSX01FVEFfQUxSRUFEWV9FWElTVFMpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBNZXRhIGJveCB2YWx1ZXMgYWxyZWFkeSBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzQtMTA4MQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGRpZyA3CiAgICBkdXAKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3NwogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDc0LTEwODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS5tZXRhICsgSW1wYWN0TWV0YU1CUgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3OAogICAgLy8gYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICBwdXNoYnl0ZXMgIiIKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLm1icgogICAgcHVzaGludCA1NiAvLyA1NgogICAgZXh0cmFjdF91aW50NjQKICAgIGludGMgNiAvLyAzMTcwMAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzQtMTA4MQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODMKICAgIC8vIGNvbnN0IGltcGFjdCA9IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDgzCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODMKICAgIC8vIGNvbnN0IGltcGFjdCA9IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDg1LTEwOTAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihpbXBhY3QpLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODcKICAgIC8vIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihpbXBhY3QpLmFkZHJlc3MsCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA4OAogICAgLy8gYW1vdW50OiBJbXBhY3RNZXRhTUJSCiAgICBpbnRjIDYgLy8gMzE3MDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA4NS0xMDg5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogQXBwbGljYXRpb24oaW1wYWN0KS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogSW1wYWN0TWV0YU1CUgogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODUtMTA5MAogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGltcGFjdCkuYWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IEltcGFjdE1ldGFNQlIKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOTIKICAgIC8vIGlmIChhdXRvbWF0ZWQpIHsKICAgIGRpZyA1CiAgICBieiBpbml0TWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDkzCiAgICAvLyB0aGlzLmNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbiwgdXNlcklzU2VuZGVyLCB3YWxsZXQuaWQsIHRydWUpCiAgICBkaWcgOQogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGRpZyAzCiAgICBpbnRjXzEgLy8gMQogICAgY2FsbHN1YiBjcmVhdGVEZWZhdWx0TWV0YQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOTUtMTEwMwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogaW1wYWN0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3JpZ2luLAogICAgLy8gICAgIDAsCiAgICAvLyAgICAgMCwKICAgIC8vICAgICAwCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA5OQogICAgLy8gMCwKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA5NS0xMTAzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbEltcGFjdC5wcm90b3R5cGUuY2FjaGVNZXRhPih7CiAgICAvLyAgIGFwcElkOiBpbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBvcmlnaW4sCiAgICAvLyAgICAgMCwKICAgIC8vICAgICAwLAogICAgLy8gICAgIDAKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGJ5dGVjIDI3IC8vIG1ldGhvZCAiY2FjaGVNZXRhKGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTA1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKCmluaXRNZXRhX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pbml0TWV0YUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2NwogICAgLy8gaW5pdE1ldGEoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdXNlcjogQWNjb3VudCwKICAgIC8vICAgYXV0b21hdGVkOiBib29sZWFuLAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICk6IHVpbnQ2NCB7CiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgppbml0TWV0YV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEwOAogICAgLy8gdGhpcy5jcmVhdGVEZWZhdWx0TWV0YShvcmlnaW4sIHVzZXJJc1NlbmRlciwgd2FsbGV0LmlkLCBmYWxzZSkKICAgIGRpZyA5CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgZGlnIDMKICAgIGludGNfMCAvLyAwCiAgICBjYWxsc3ViIGNyZWF0ZURlZmF1bHRNZXRhCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMC0xMTE4CiAgICAvLyBjb25zdCBpbXBhY3RTY29yZSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5jYWNoZU1ldGE+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBvcmlnaW4sCiAgICAvLyAgICAgc3Vic2NyaXB0aW9uSW5kZXgsCiAgICAvLyAgICAgTkZELAogICAgLy8gICAgIGFraXRhTkZUCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMTEKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0LAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTEwLTExMTgKICAgIC8vIGNvbnN0IGltcGFjdFNjb3JlID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG9yaWdpbiwKICAgIC8vICAgICBzdWJzY3JpcHRpb25JbmRleCwKICAgIC8vICAgICBORkQsCiAgICAvLyAgICAgYWtpdGFORlQKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDI3IC8vIG1ldGhvZCAiY2FjaGVNZXRhKGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMAogICAgLy8gcmV0dXJuIGltcGFjdFNjb3JlICsgdGhpcy5nZXRTb2NpYWxJbXBhY3RTY29yZShvcmlnaW4pCiAgICBzd2FwCiAgICBjYWxsc3ViIGdldFNvY2lhbEltcGFjdFNjb3JlCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA2MC0xMDY3CiAgICAvLyBpbml0TWV0YSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB1c2VyOiBBY2NvdW50LAogICAgLy8gICBhdXRvbWF0ZWQ6IGJvb2xlYW4sCiAgICAvLyAgIHN1YnNjcmlwdGlvbkluZGV4OiB1aW50NjQsCiAgICAvLyAgIE5GRDogdWludDY0LAogICAgLy8gICBha2l0YU5GVDogdWludDY0LAogICAgLy8gKTogdWludDY0IHsKICAgIGIgaW5pdE1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmluaXRNZXRhQDcKCmluaXRNZXRhX2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjkKICAgIC8vIGNvbnN0IG9yaWdpbiA9IG9yaWdpbk9yKHdhbGxldCwgdXNlcikKICAgIGIgaW5pdE1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckAxMwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUGF5V2FsbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZVBheVdhbGw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMwogICAgLy8gY3JlYXRlUGF5V2FsbChtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sIHBheVdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyNC0xMTMyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuRXE6IHRoaXMucGF5V2FsbE1icihwYXlXYWxsKQogICAgLy8gICAgIH0KICAgIC8vICAgfQogICAgLy8gKQogICAgZGlnIDEKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyNwogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTI0LTExMzIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogdGhpcy5wYXlXYWxsTWJyKHBheVdhbGwpCiAgICAvLyAgICAgfQogICAgLy8gICB9CiAgICAvLyApCiAgICA9PQogICAgdW5jb3ZlciAyCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTI5CiAgICAvLyBncmVhdGVyVGhhbkVxOiB0aGlzLnBheVdhbGxNYnIocGF5V2FsbCkKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwucGF5V2FsbE1icgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMjQtMTEzMgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiB0aGlzLnBheVdhbGxNYnIocGF5V2FsbCkKICAgIC8vICAgICB9CiAgICAvLyAgIH0KICAgIC8vICkKICAgIGNvdmVyIDIKICAgID49CiAgICB1bmNvdmVyIDIKICAgICYmCiAgICBhc3NlcnQgLy8gYXNzZXJ0IHRhcmdldCBpcyBtYXRjaCBmb3IgY29uZGl0aW9ucwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzQKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wYXlXYWxsSWQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIHBheVdhbGxJZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhU29jaWFsR2xvYmFsU3RhdGVLZXlzUGF5d2FsbElELCBpbml0aWFsVmFsdWU6IDEgfSkKICAgIGJ5dGVjIDIwIC8vICJwYXl3YWxsX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzQKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wYXlXYWxsSWQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEzNQogICAgLy8gdGhpcy5wYXlXYWxsSWQudmFsdWUrKwogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBwYXlXYWxsSWQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YVNvY2lhbEdsb2JhbFN0YXRlS2V5c1BheXdhbGxJRCwgaW5pdGlhbFZhbHVlOiAxIH0pCiAgICBieXRlYyAyMCAvLyAicGF5d2FsbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTM1CiAgICAvLyB0aGlzLnBheVdhbGxJZC52YWx1ZSsrCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzcKICAgIC8vIHRoaXMucGF5d2FsbChpZCkudmFsdWUgPSBjbG9uZShwYXlXYWxsKQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEzNwogICAgLy8gdGhpcy5wYXl3YWxsKGlkKS52YWx1ZSA9IGNsb25lKHBheVdhbGwpCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgdW5jb3ZlciAyCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMwogICAgLy8gY3JlYXRlUGF5V2FsbChtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sIHBheVdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwudXBkYXRlTWV0YVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZU1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE0Mi0xMTQ5CiAgICAvLyB1cGRhdGVNZXRhKAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgYWRkcmVzc0dhdGVJRDogdWludDY0LAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICAgZGVmYXVsdFBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwbiAyCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUwCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUwCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHVwZGF0ZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzCiAgICBkdXAKCnVwZGF0ZU1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUzCiAgICAvLyBhc3NlcnQodGhpcy5tZXRhKG9yaWdpbikuZXhpc3RzLCBFUlJfTUVUQV9ET0VTTlRfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIE1ldGEgYm94IHZhbHVlcyBkb250IGV4aXN0IHlldAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTUKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmZvbGxvd0dhdGVJRCA9IGZvbGxvd0dhdGVJRAogICAgZHVwCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICBkaWcgMTIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE1NgogICAgLy8gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUuYWRkcmVzc0dhdGVJRCA9IGFkZHJlc3NHYXRlSUQKICAgIGR1cAogICAgcHVzaGludCA1OCAvLyA1OAogICAgZGlnIDExCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTcKICAgIC8vIGFzc2VydCh0aGlzLnBheXdhbGwoZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzLCAnRVJSOk5PUEFZV0FMTCcpCiAgICBkaWcgNAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyBhc3NlcnQodGhpcy5wYXl3YWxsKGRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cywgJ0VSUjpOT1BBWVdBTEwnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRVJSOk5PUEFZV0FMTAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTgKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQgPSBkZWZhdWx0UGF5V2FsbElECiAgICBwdXNoaW50IDY2IC8vIDY2CiAgICBkaWcgNgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTYwCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE2MAogICAgLy8gY29uc3QgaW1wYWN0ID0gZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDkgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTYwCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNjEtMTE2OQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogaW1wYWN0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3JpZ2luLAogICAgLy8gICAgIHN1YnNjcmlwdGlvbkluZGV4LAogICAgLy8gICAgIE5GRCwKICAgIC8vICAgICBha2l0YU5GVAogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWMgMjcgLy8gbWV0aG9kICJjYWNoZU1ldGEoYWRkcmVzcyx1aW50NjQsdWludDY0LHVpbnQ2NCl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA3CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDYKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNDItMTE0OQogICAgLy8gdXBkYXRlTWV0YSgKICAgIC8vICAgZm9sbG93R2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwKICAgIC8vICAgTkZEOiB1aW50NjQsCiAgICAvLyAgIGFraXRhTkZUOiB1aW50NjQsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICByZXR1cm4gLy8gb24gZXJyb3I6IGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKCnVwZGF0ZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHVwZGF0ZU1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVGb2xsb3dlck1ldGFbcm91dGluZ10oKSAtPiB2b2lkOgp1cGRhdGVGb2xsb3dlck1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdXBkYXRlRm9sbG93ZXJNZXRhKGFkZHJlc3M6IEFjY291bnQsIG5ld0ZvbGxvd2VySW5kZXg6IHVpbnQ2NCwgbmV3Rm9sbG93ZXJDb3VudDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNzMKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ3JhcGgpLmFkZHJlc3MsIEVSUl9OT1RfR1JBUEgpCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCkuYWRkcmVzcywgRVJSX05PVF9HUkFQSCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA5IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCkuYWRkcmVzcywgRVJSX05PVF9HUkFQSCkKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBOb3QgdGhlIHNvY2lhbCBncmFwaCBhcHAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNzQKICAgIC8vIHRoaXMubWV0YShhZGRyZXNzKS52YWx1ZS5mb2xsb3dlckluZGV4ID0gbmV3Rm9sbG93ZXJJbmRleAogICAgZHVwCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICB1bmNvdmVyIDQKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3NQogICAgLy8gdGhpcy5tZXRhKGFkZHJlc3MpLnZhbHVlLmZvbGxvd2VyQ291bnQgPSBuZXdGb2xsb3dlckNvdW50CiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdXBkYXRlRm9sbG93ZXJNZXRhKGFkZHJlc3M6IEFjY291bnQsIG5ld0ZvbGxvd2VySW5kZXg6IHVpbnQ2NCwgbmV3Rm9sbG93ZXJDb3VudDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmlzQmFubmVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODAKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNCYW5uZWQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRVc2VyU29jaWFsSW1wYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0VXNlclNvY2lhbEltcGFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTg4CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExOTAKICAgIC8vIHJldHVybiB0aGlzLmdldFNvY2lhbEltcGFjdFNjb3JlKHVzZXIpCiAgICBjYWxsc3ViIGdldFNvY2lhbEltcGFjdFNjb3JlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4OAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRNZXRhRXhpc3RzW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YUV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTkzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExOTUKICAgIC8vIHJldHVybiB0aGlzLm1ldGEodXNlcikuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTkzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTk4CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMDAKICAgIC8vIHJldHVybiB0aGlzLm1ldGEodXNlcikudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE5OAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRQb3N0RXhpc3RzW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0UG9zdEV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA0CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA2CiAgICAvLyByZXR1cm4gdGhpcy5wb3N0cyhyZWYpLmV4aXN0cwogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIwNAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0UG9zdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFBvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIwOQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxMQogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjEyCiAgICAvLyByZXR1cm4gdGhpcy5wb3N0cyhyZWYpLnZhbHVlCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA5CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldFZvdGVbcm91dGluZ10oKSAtPiB2b2lkOgpnZXRWb3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMTUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnZXRWb3RlX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnZXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjIwCiAgICAvLyBjb25zdCB2b3RlTGlzdEtleTogVm90ZUxpc3RLZXkgPSB7IHVzZXI6IGIxNihvcmlnaW4uYnl0ZXMpLCByZWY6IGIxNihyZWYpIH0KICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMwogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIyMQogICAgLy8gYXNzZXJ0KHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLmV4aXN0cywgRVJSX0hBVkVOVF9WT1RFRCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gWW91IGhhdmVuJ3Qgdm90ZWQgb24gdGhpcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjMKICAgIC8vIHJldHVybiB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnZXRWb3RlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnZXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0Vm90ZXNbcm91dGluZ10oKSAtPiB2b2lkOgpnZXRWb3RlczoKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDIKICAgIHB1c2hieXRlcyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDMyCiAgICAqCiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4WzMyXVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZ2V0Vm90ZXNfYWZ0ZXJfaWZfZWxzZUAxMQogICAgZHVwCiAgICBidXJ5IDcKCmdldFZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIzMQogICAgLy8gY29uc3Qgdm90ZXM6IFZvdGVMaXN0VmFsdWVbXSA9IFtdCiAgICBieXRlYyAxMSAvLyAweDAwMDAKICAgIGJ1cnkgNgogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgNQoKZ2V0Vm90ZXNfZm9yX2hlYWRlckAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMzIKICAgIC8vIGZvciAoY29uc3QgcmVmIG9mIHJlZnMpIHsKICAgIGRpZyA0CiAgICBkaWcgMwogICAgPAogICAgYnogZ2V0Vm90ZXNfYWZ0ZXJfZm9yQDgKICAgIGRpZyAzCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDUKICAgIGludGNfMyAvLyAzMgogICAgKgogICAgaW50Y18zIC8vIDMyCiAgICBleHRyYWN0MyAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjMzCiAgICAvLyBjb25zdCB2b3RlTGlzdEtleTogVm90ZUxpc3RLZXkgPSB7IHVzZXI6IGIxNihvcmlnaW4uYnl0ZXMpLCByZWY6IGIxNihyZWYpIH0KICAgIGRpZyA3CiAgICBjYWxsc3ViIGIxNgogICAgc3dhcAogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMzQKICAgIC8vIGlmICh0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogZ2V0Vm90ZXNfZWxzZV9ib2R5QDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjM1CiAgICAvLyB2b3Rlcy5wdXNoKHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlKQogICAgZGlnIDcKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkaWcgNgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgNgoKZ2V0Vm90ZXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgZGlnIDQKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDUKICAgIGIgZ2V0Vm90ZXNfZm9yX2hlYWRlckAyCgpnZXRWb3Rlc19lbHNlX2JvZHlANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjM3CiAgICAvLyB2b3Rlcy5wdXNoKHsgaW1wYWN0OiAwLCBpc1VwOiBmYWxzZSB9KQogICAgZGlnIDUKICAgIGR1cAogICAgYnl0ZWMgMzIgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgNgogICAgYiBnZXRWb3Rlc19hZnRlcl9pZl9lbHNlQDYKCmdldFZvdGVzX2FmdGVyX2ZvckA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBkaWcgNgogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdldFZvdGVzX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnZXRWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDEyCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRSZWFjdGlvbkV4aXN0c1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFJlYWN0aW9uRXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDQKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDYKICAgIC8vIHJldHVybiB0aGlzLnJlYWN0aW9ucyh7IHJlZiwgTkZUIH0pLmV4aXN0cwogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQKICAgIC8vIHJlYWN0aW9ucyA9IEJveE1hcDxSZWFjdGlvbnNLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25zIH0pCiAgICBieXRlYyAyNiAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDYKICAgIC8vIHJldHVybiB0aGlzLnJlYWN0aW9ucyh7IHJlZiwgTkZUIH0pLmV4aXN0cwogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTI0NAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnJbcm91dGluZ10oKSAtPiB2b2lkOgptYnI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTQKICAgIC8vIG1icihyZWY6IGJ5dGVzKTogQWtpdGFTb2NpYWxNQlJEYXRhIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLm1icgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5wYXlXYWxsTWJyW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF5V2FsbE1icjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMAogICAgLy8gcGF5V2FsbE1icihwYXl3YWxsOiBWaWV3UGF5V2FsbFZhbHVlKTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLnBheVdhbGxNYnIKICAgIHBvcAogICAgaXRvYgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50c1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjUxCiAgICAvLyBjaGVja1RpcE1iclJlcXVpcmVtZW50cyhha2l0YURBTzogQXBwbGljYXRpb24sIGNyZWF0b3I6IEFjY291bnQsIHdhbGxldDogQXBwbGljYXRpb24pOiB0aXBNQlJJbmZvIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50cwogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdC51cGRhdGVBa2l0YURBT0VzY3Jvd1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPRXNjcm93OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM3CiAgICAvLyB1cGRhdGVBa2l0YURBT0VzY3JvdyhhcHA6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM4CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDEyIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMzgKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIE9ubHkgdGhlIEFraXRhIERBTyBjYW4gY2FsbCB0aGlzIGZ1bmN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFwcAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzNwogICAgLy8gdXBkYXRlQWtpdGFEQU9Fc2Nyb3coYXBwOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpVcGdyYWRlYWJsZUFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjQ4CiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiBbJ1VwZGF0ZUFwcGxpY2F0aW9uJ10gfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBieXRlYyAxMiAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTEKICAgIC8vIGNvbnN0IHVwZGF0ZVBsdWdpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkudXBkYXRlCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBhcHAgdXBncmFkZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDI5IC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTMKICAgIC8vIHRoaXMudmVyc2lvbi52YWx1ZSA9IG5ld1ZlcnNpb24KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0OAogICAgLy8gQGFiaW1ldGhvZCh7IGFsbG93QWN0aW9uczogWydVcGRhdGVBcHBsaWNhdGlvbiddIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgMTIgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MAogICAgLy8gdGhpcy5ha2l0YURBTy52YWx1ZSA9IGFraXRhREFPCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzgKICAgIC8vIHVwZGF0ZUFraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNSZXBseShwb3N0VHlwZTogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNSZXBseToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NwogICAgLy8gcHJpdmF0ZSBpc1JlcGx5KHBvc3RUeXBlOiBQb3N0VHlwZSk6IGJvb2xlYW4gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIHJldHVybiBwb3N0VHlwZSA9PT0gUG9zdFR5cGVSZXBseSB8fCBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgPT0KICAgIGJueiBpc1JlcGx5X2Jvb2xfdHJ1ZUAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGJ5dGVjIDE1IC8vIDB4MDMKICAgID09CiAgICBieiBpc1JlcGx5X2Jvb2xfZmFsc2VAMwoKaXNSZXBseV9ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIHJldHVybiBwb3N0VHlwZSA9PT0gUG9zdFR5cGVSZXBseSB8fCBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIHJldHN1YgoKaXNSZXBseV9ib29sX2ZhbHNlQDM6CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU4CiAgICAvLyByZXR1cm4gcG9zdFR5cGUgPT09IFBvc3RUeXBlUmVwbHkgfHwgcG9zdFR5cGUgPT09IFBvc3RUeXBlRWRpdFJlcGx5CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQW1lbmRlZChyZWY6IGJ5dGVzLCBwb3N0VHlwZTogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNBbWVuZGVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxCiAgICAvLyBwcml2YXRlIGlzQW1lbmRlZChyZWY6IGJ5dGVzLCBwb3N0VHlwZTogUG9zdFR5cGUpOiBib29sZWFuIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4CiAgICAvLyBjYXNlIFBvc3RUeXBlUG9zdDoKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4LTY5CiAgICAvLyBjYXNlIFBvc3RUeXBlUG9zdDoKICAgIC8vICAgcmV0dXJuIDM2CiAgICBieiBpc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjkKICAgIC8vIHJldHVybiAzNgogICAgcHVzaGludCAzNiAvLyAzNgoKaXNBbWVuZGVkX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRCYXNlUmVmTGVuZ3RoQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyByZXR1cm4gcmVmLmxlbmd0aCA+IGJhc2VMZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgbGVuCiAgICA8CiAgICByZXRzdWIKCmlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzAKICAgIC8vIGNhc2UgUG9zdFR5cGVSZXBseToKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MC03MQogICAgLy8gY2FzZSBQb3N0VHlwZVJlcGx5OgogICAgLy8gICByZXR1cm4gNjgKICAgIGJ6IGlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MQogICAgLy8gcmV0dXJuIDY4CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODMKICAgIC8vIGNvbnN0IGJhc2VMZW5ndGggPSB0aGlzLmdldEJhc2VSZWZMZW5ndGgocG9zdFR5cGUpCiAgICBiIGlzQW1lbmRlZF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0QmFzZVJlZkxlbmd0aEAxMAoKaXNBbWVuZGVkX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gY2FzZSBQb3N0VHlwZUVkaXRQb3N0OgogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlYyAyNSAvLyAweDAyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyLTczCiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFBvc3Q6CiAgICAvLyAgIHJldHVybiA2OAogICAgYnogaXNBbWVuZGVkX2FmdGVyX2lmX2Vsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjczCiAgICAvLyByZXR1cm4gNjgKICAgIHB1c2hpbnQgNjggLy8gNjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MwogICAgLy8gY29uc3QgYmFzZUxlbmd0aCA9IHRoaXMuZ2V0QmFzZVJlZkxlbmd0aChwb3N0VHlwZSkKICAgIGIgaXNBbWVuZGVkX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRCYXNlUmVmTGVuZ3RoQDEwCgppc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0CiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFJlcGx5OgogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlYyAxNSAvLyAweDAzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0LTc1CiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFJlcGx5OgogICAgLy8gICByZXR1cm4gMTAwCiAgICBieiBpc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzUKICAgIC8vIHJldHVybiAxMDAKICAgIHB1c2hpbnQgMTAwIC8vIDEwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyBjb25zdCBiYXNlTGVuZ3RoID0gdGhpcy5nZXRCYXNlUmVmTGVuZ3RoKHBvc3RUeXBlKQogICAgYiBpc0FtZW5kZWRfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldEJhc2VSZWZMZW5ndGhAMTAKCmlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzcKICAgIC8vIHJldHVybiAzNgogICAgcHVzaGludCAzNiAvLyAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyBjb25zdCBiYXNlTGVuZ3RoID0gdGhpcy5nZXRCYXNlUmVmTGVuZ3RoKHBvc3RUeXBlKQogICAgYiBpc0FtZW5kZWRfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldEJhc2VSZWZMZW5ndGhAMTAKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLnRvQnl0ZXMzMih0eXBlOiBieXRlcywgcmVmOiBieXRlcykgLT4gYnl0ZXM6CnRvQnl0ZXMzMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NwogICAgLy8gcHJpdmF0ZSB0b0J5dGVzMzIodHlwZTogUmVmVHlwZSwgcmVmOiBieXRlcyk6IHsgcmVmQnl0ZXM6IGJ5dGVzPDMyPiwgY3JlYXRvcjogQWNjb3VudCB9IHsKICAgIHByb3RvIDIgMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4OQogICAgLy8gbGV0IGNyZWF0b3I6IEFjY291bnQgPSBHbG9iYWwuemVyb0FkZHJlc3MKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBjYXNlIFJlZlR5cGVQb3N0OgogICAgZnJhbWVfZGlnIC0yCiAgICBieXRlYyAxNCAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxLTk0CiAgICAvLyBjYXNlIFJlZlR5cGVQb3N0OgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gMzIsIEVSUl9JTlZBTElEX1JFRl9MRU5HVEgpCiAgICAvLyAgIHJlZkJ5dGVzID0gcmVmLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICAvLyAgIGJyZWFrCiAgICBieiB0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTIKICAgIC8vIGFzc2VydChyZWYubGVuZ3RoID09PSAzMiwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCByZWZlcmVuY2UgbGVuZ3RoLCBtdXN0IGJlIDMyIGJ5dGVzCiAgICBmcmFtZV9kaWcgLTEKCnRvQnl0ZXMzMl9ibG9ja0AxOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIHJldHVybiB7IHJlZkJ5dGVzLCBjcmVhdG9yIH0KICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBmcmFtZV9kaWcgMQogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NQogICAgLy8gY2FzZSBSZWZUeXBlQXNzZXQ6CiAgICBmcmFtZV9kaWcgLTIKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NS0xMDAKICAgIC8vIGNhc2UgUmVmVHlwZUFzc2V0OgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIC8vICAgYXNzZXJ0KEFzc2V0KGJ0b2kocmVmKSkudG90YWwgPiAwLCBFUlJfSU5WQUxJRF9BU1NFVCkKICAgIC8vICAgcmVmQnl0ZXMgPSByZWYuY29uY2F0KG9wLmJ6ZXJvKDI0KSkudG9GaXhlZCh7IGxlbmd0aDogMzIgfSkKICAgIC8vICAgY3JlYXRvciA9IEFzc2V0KGJ0b2kocmVmKSkuY3JlYXRvcgogICAgLy8gICBicmVhawogICAgYnogdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2CiAgICAvLyBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHJlZmVyZW5jZSBsZW5ndGgsIG11c3QgYmUgMzIgYnl0ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NwogICAgLy8gYXNzZXJ0KEFzc2V0KGJ0b2kocmVmKSkudG90YWwgPiAwLCBFUlJfSU5WQUxJRF9BU1NFVCkKICAgIGZyYW1lX2RpZyAtMQogICAgYnRvaQogICAgZHVwCiAgICBhc3NldF9wYXJhbXNfZ2V0IEFzc2V0VG90YWwKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGFzc2VydCAvLyBJbnZhbGlkIGFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgKICAgIC8vIHJlZkJ5dGVzID0gcmVmLmNvbmNhdChvcC5iemVybygyNCkpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBiemVybwogICAgZnJhbWVfZGlnIC0xCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OQogICAgLy8gY3JlYXRvciA9IEFzc2V0KGJ0b2kocmVmKSkuY3JlYXRvcgogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIHN3YXAKICAgIGZyYW1lX2J1cnkgMQogICAgYXNzZXJ0IC8vIGFzc2V0IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMAogICAgLy8gYnJlYWsKICAgIGIgdG9CeXRlczMyX2Jsb2NrQDE5Cgp0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gY2FzZSBSZWZUeXBlQWRkcmVzczoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxLTExMQogICAgLy8gY2FzZSBSZWZUeXBlQWRkcmVzczoKICAgIC8vICAgYXNzZXJ0KHJlZi5sZW5ndGggPT09IDMyLCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgLy8gICByZWZCeXRlcyA9IHJlZi50b0ZpeGVkKHsgbGVuZ3RoOiAzMiB9KQogICAgLy8gICBjcmVhdG9yID0gQWNjb3VudChyZWZCeXRlcykKICAgIC8vIAogICAgLy8gICBpZiAodGhpcy5tZXRhKGNyZWF0b3IpLmV4aXN0cykgewogICAgLy8gICAgIGNvbnN0IHsgYWRkcmVzc0dhdGVJRCB9ID0gdGhpcy5tZXRhKGNyZWF0b3IpLnZhbHVlCiAgICAvLyAgICAgYXNzZXJ0KGFkZHJlc3NHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgIC8vICAgfQogICAgLy8gCiAgICAvLyAgIGJyZWFrCiAgICBieiB0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMgogICAgLy8gYXNzZXJ0KHJlZi5sZW5ndGggPT09IDMyLCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHJlZmVyZW5jZSBsZW5ndGgsIG11c3QgYmUgMzIgYnl0ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDYKICAgIC8vIGlmICh0aGlzLm1ldGEoY3JlYXRvcikuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHRvQnl0ZXMzMl9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyBjb25zdCB7IGFkZHJlc3NHYXRlSUQgfSA9IHRoaXMubWV0YShjcmVhdG9yKS52YWx1ZQogICAgZnJhbWVfZGlnIDAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBwdXNoaW50IDU4IC8vIDU4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOAogICAgLy8gYXNzZXJ0KGFkZHJlc3NHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKCnRvQnl0ZXMzMl9hZnRlcl9pZl9lbHNlQDEwOgogICAgZnJhbWVfZGlnIC0xCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gYnJlYWsKICAgIGIgdG9CeXRlczMyX2Jsb2NrQDE5Cgp0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTIKICAgIC8vIGNhc2UgUmVmVHlwZUFwcDoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyLTExNwogICAgLy8gY2FzZSBSZWZUeXBlQXBwOgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIC8vICAgYXNzZXJ0KEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuYXBwcm92YWxQcm9ncmFtLmxlbmd0aCA+IDAsIEVSUl9JTlZBTElEX0FQUCkKICAgIC8vICAgcmVmQnl0ZXMgPSByZWYuY29uY2F0KG9wLmJ6ZXJvKDI0KSkudG9GaXhlZCh7IGxlbmd0aDogMzIgfSkKICAgIC8vICAgY3JlYXRvciA9IEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuY3JlYXRvcgogICAgLy8gICBicmVhawogICAgYnogdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTMKICAgIC8vIGFzc2VydChyZWYubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcmVmZXJlbmNlIGxlbmd0aCwgbXVzdCBiZSAzMiBieXRlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gYXNzZXJ0KEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuYXBwcm92YWxQcm9ncmFtLmxlbmd0aCA+IDAsIEVSUl9JTlZBTElEX0FQUCkKICAgIGZyYW1lX2RpZyAtMQogICAgYnRvaQogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBcHByb3ZhbFByb2dyYW0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGxlbgogICAgYXNzZXJ0IC8vIEludmFsaWQgQXBwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyByZWZCeXRlcyA9IHJlZi5jb25jYXQob3AuYnplcm8oMjQpKS50b0ZpeGVkKHsgbGVuZ3RoOiAzMiB9KQogICAgcHVzaGludCAyNCAvLyAyNAogICAgYnplcm8KICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE2CiAgICAvLyBjcmVhdG9yID0gQXBwbGljYXRpb24oYnRvaShyZWYpKS5jcmVhdG9yCiAgICBhcHBfcGFyYW1zX2dldCBBcHBDcmVhdG9yCiAgICBzd2FwCiAgICBmcmFtZV9idXJ5IDEKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTcKICAgIC8vIGJyZWFrCiAgICBiIHRvQnl0ZXMzMl9ibG9ja0AxOQoKdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyBjYXNlIFJlZlR5cGVFeHRlcm5hbDoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MzIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4LTEyNAogICAgLy8gY2FzZSBSZWZUeXBlRXh0ZXJuYWw6CiAgICAvLyAgIC8vIEV4dGVybmFsIHJlZnMgKFR3aXR0ZXIsIEZhcmNhc3RlciwgZXRjLikgLSByZWYgaXMgdGhlIHBsYXRmb3JtLXByZWZpeGVkIGlkZW50aWZpZXIKICAgIC8vICAgLy8gS2V5IGlzIGRlcml2ZWQgZGV0ZXJtaW5pc3RpY2FsbHk6IHNoYTI1NihyZWYpIHdoZXJlIHJlZiA9ICJwbGF0Zm9ybTpleHRlcm5hbElkIgogICAgLy8gICAvLyBDcmVhdG9yIGlzIHplcm8gYWRkcmVzcyBzaW5jZSBleHRlcm5hbCBjb250ZW50IGhhcyBubyBBbGdvcmFuZCBjcmVhdG9yCiAgICAvLyAgIHJlZkJ5dGVzID0gb3Auc2hhMjU2KHJlZikKICAgIC8vICAgY3JlYXRvciA9IEdsb2JhbC56ZXJvQWRkcmVzcwogICAgLy8gICBicmVhawogICAgYXNzZXJ0IC8vIEludmFsaWQgcmVwbHkgdHlwZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gcmVmQnl0ZXMgPSBvcC5zaGEyNTYocmVmKQogICAgZnJhbWVfZGlnIC0xCiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWwuemVyb0FkZHJlc3MKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyBicmVhawogICAgYiB0b0J5dGVzMzJfYmxvY2tAMTkKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmxvY2tlZCh1c2VyOiBieXRlcywgYmxvY2tlZDogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNCbG9ja2VkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEzMgogICAgLy8gcHJpdmF0ZSBpc0Jsb2NrZWQodXNlcjogQWNjb3VudCwgYmxvY2tlZDogQWNjb3VudCk6IGJvb2xlYW4gewogICAgcHJvdG8gMiAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTMzLTEzNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsR3JhcGgucHJvdG90eXBlLmlzQmxvY2tlZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgLy8gICBhcmdzOiBbdXNlciwgYmxvY2tlZF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA5IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTMzLTEzNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsR3JhcGgucHJvdG90eXBlLmlzQmxvY2tlZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgLy8gICBhcmdzOiBbdXNlciwgYmxvY2tlZF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHg0MzAzNjY4ZSAvLyBtZXRob2QgImlzQmxvY2tlZChhZGRyZXNzLGFkZHJlc3MpYm9vbCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRTb2NpYWxJbXBhY3RTY29yZShhY2NvdW50OiBieXRlcykgLT4gdWludDY0OgpnZXRTb2NpYWxJbXBhY3RTY29yZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHByaXZhdGUgZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNDIKICAgIC8vIGlmICghdGhpcy5tZXRhKGFjY291bnQpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRTb2NpYWxJbXBhY3RTY29yZV9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTQ2CiAgICAvLyBjb25zdCB7IHN0cmVhaywgc3RhcnREYXRlIH0gPSBjbG9uZSh0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUpCiAgICBmcmFtZV9kaWcgNwogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGZyYW1lX2J1cnkgNgogICAgcHVzaGludCAxNyAvLyAxNwogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE0OQogICAgLy8gaWYgKHN0cmVhayA+PSA2MCkgewogICAgcHVzaGludCA2MCAvLyA2MAogICAgPj0KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTUwCiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gMTAwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGZyYW1lX2J1cnkgNAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gY29uc3QgYWNjb3VudEFnZTogdWludDY0ID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIHN0YXJ0RGF0ZQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZnJhbWVfZGlnIDUKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBpZiAoYWNjb3VudEFnZSA+PSBUV09fWUVBUlMpIHsKICAgIGludGMgNyAvLyA2MzA3MjAwMAogICAgPj0KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTYxCiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gNzUKICAgIGZyYW1lX2RpZyA0CiAgICBwdXNoaW50IDc1IC8vIDc1CiAgICArCiAgICBmcmFtZV9idXJ5IDQKCmdldFNvY2lhbEltcGFjdFNjb3JlX2FmdGVyX2lmX2Vsc2VAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MAogICAgLy8gdm90ZXMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBWb3Rlc1ZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyBpZiAodGhpcy52b3RlcyhhY2NvdW50LmJ5dGVzKS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE2OQogICAgLy8gY29uc3QgeyB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMudm90ZXMoYWNjb3VudC5ieXRlcykudmFsdWUKICAgIGZyYW1lX2RpZyAwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIHB1c2hpbnQgNjQgLy8gNjQKICAgIGdldGJpdAogICAgZnJhbWVfYnVyeSAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBsZXQgaW1wYWN0OiB1aW50NjQgPSAodm90ZUNvdW50ICogNzUpIC8gMTAwXzAwMAogICAgcHVzaGludCA3NSAvLyA3NQogICAgKgogICAgaW50YyA0IC8vIDEwMDAwMAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNzIKICAgIC8vIGlmIChpbXBhY3QgPiA3NSkgewogICAgcHVzaGludCA3NSAvLyA3NQogICAgPgogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE3MwogICAgLy8gaW1wYWN0ID0gNzUKICAgIHB1c2hpbnQgNzUgLy8gNzUKICAgIGZyYW1lX2J1cnkgMgoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNzYKICAgIC8vIGlmIChpc05lZ2F0aXZlKSB7CiAgICBmcmFtZV9kaWcgMwogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyBpZiAoc29jaWFsSW1wYWN0ID4gaW1wYWN0KSB7CiAgICBmcmFtZV9kaWcgNAogICAgZnJhbWVfZGlnIDIKICAgID4KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE3OQogICAgLy8gc29jaWFsSW1wYWN0IC09IGltcGFjdAogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyAyCiAgICAtCiAgICBmcmFtZV9idXJ5IDQKCmdldFNvY2lhbEltcGFjdFNjb3JlX2FmdGVyX2lmX2Vsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTg5CiAgICAvLyByZXR1cm4gc29jaWFsSW1wYWN0CiAgICBmcmFtZV9kaWcgNAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIHNvY2lhbEltcGFjdCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDE2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE4NQogICAgLy8gc29jaWFsSW1wYWN0ICs9IGltcGFjdAogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyAyCiAgICArCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gKGFjY291bnRBZ2UgKiA3NSkgLyBUV09fWUVBUlMKICAgIGZyYW1lX2RpZyAxCiAgICBwdXNoaW50IDc1IC8vIDc1CiAgICAqCiAgICBpbnRjIDcgLy8gNjMwNzIwMDAKICAgIC8KICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA4CgpnZXRTb2NpYWxJbXBhY3RTY29yZV9lbHNlX2JvZHlANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIHNvY2lhbEltcGFjdCArPSAoc3RyZWFrICogMTAwKSAvIDYwCiAgICBmcmFtZV9kaWcgNgogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICAqCiAgICBwdXNoaW50IDYwIC8vIDYwCiAgICAvCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRVc2VySW1wYWN0KGFjY291bnQ6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFVzZXJJbXBhY3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTkyCiAgICAvLyBwcml2YXRlIGdldFVzZXJJbXBhY3QoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTMtMTk2CiAgICAvLyBjb25zdCBpbXBhY3QgPSBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbEltcGFjdC5wcm90b3R5cGUuZ2V0VXNlckltcGFjdFdpdGhvdXRTb2NpYWw+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFthY2NvdW50XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTQKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0LAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5My0xOTYKICAgIC8vIGNvbnN0IGltcGFjdCA9IGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5nZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdCwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4ZjgxYzdiNjIgLy8gbWV0aG9kICJnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbChhZGRyZXNzKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTgKICAgIC8vIHJldHVybiBpbXBhY3QgKyB0aGlzLmdldFNvY2lhbEltcGFjdFNjb3JlKGFjY291bnQpCiAgICBmcmFtZV9kaWcgLTEKICAgIGNhbGxzdWIgZ2V0U29jaWFsSW1wYWN0U2NvcmUKICAgICsKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwudGlwQ3JlYXRvcihjcmVhdG9yOiBieXRlcywgZmVlOiB1aW50NjQsIHRheDogdWludDY0KSAtPiB1aW50NjQ6CnRpcENyZWF0b3I6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjkwCiAgICAvLyBwcml2YXRlIHRpcENyZWF0b3IoY3JlYXRvcjogQWNjb3VudCwgZmVlOiB1aW50NjQsIHRheDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTEKICAgIC8vIGlmIChjcmVhdG9yID09PSBHbG9iYWwuemVyb0FkZHJlc3MpIHsKICAgIGZyYW1lX2RpZyAtMwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgYnogdGlwQ3JlYXRvcl9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTIKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5MgogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5NAogICAgLy8gY29uc3QgYWtpdGFBc3NldHNCeXRlcyA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzKSlbMF0KICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTIKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjkzLTI5OQogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBmZWUsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5My0yOTgKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogZmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5My0yOTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogZmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzAwCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDMKICAgIC8vIGxldCB3YWxsZXQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICBmcmFtZV9kaWcgLTMKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDQKICAgIC8vIGlmICh0aGlzLm1ldGEoY3JlYXRvcikuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHRpcENyZWF0b3JfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzA1CiAgICAvLyB3YWxsZXQgPSB0aGlzLm1ldGEoY3JlYXRvcikudmFsdWUud2FsbGV0CiAgICBmcmFtZV9kaWcgMAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSA2Cgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDgKICAgIC8vIGNvbnN0IHsgdHlwZSwgYXJjNTggfSA9IHRoaXMuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHModGhpcy5ha2l0YURBTy52YWx1ZSwgY3JlYXRvciwgQXBwbGljYXRpb24od2FsbGV0KSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDgKICAgIC8vIGNvbnN0IHsgdHlwZSwgYXJjNTggfSA9IHRoaXMuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHModGhpcy5ha2l0YURBTy52YWx1ZSwgY3JlYXRvciwgQXBwbGljYXRpb24od2FsbGV0KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9kaWcgLTMKICAgIGZyYW1lX2RpZyA2CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50cwogICAgZHVwCiAgICBleHRyYWN0IDAgMQogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzA5CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB7IGxlZnRvdmVyIH0gPSBzZW5kUmVmZXJyYWxQYXltZW50KHRoaXMuYWtpdGFEQU8udmFsdWUsIGFrdGEsIHRheCkKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiBzZW5kUmVmZXJyYWxQYXltZW50CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxMQogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxMQogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTMKICAgIC8vIGlmICh0eXBlID09PSBUaXBTZW5kVHlwZUFSQzU4KSB7CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBieiB0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxNAogICAgLy8gdGhpcy5hcmM1OFNlbmRSZWFjdGlvblBheW1lbnRzKEFwcGxpY2F0aW9uKHdhbGxldCksIGFrdGEsIGxlZnRvdmVyLCAocmVhY3RGZWUgLSB0YXgpKQogICAgZnJhbWVfZGlnIC0xCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBmcmFtZV9kaWcgNgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjE3CiAgICAvLyBjb25zdCBvcHRpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkub3B0aW4KICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMTcKICAgIC8vIGNvbnN0IG9wdGluID0gZ2V0UGx1Z2luQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5vcHRpbgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIxNwogICAgLy8gY29uc3Qgb3B0aW4gPSBnZXRQbHVnaW5BcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm9wdGluCiAgICBkdXAKICAgIGV4dHJhY3QgMCA4CiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMTktMjI4CiAgICAvLyBpdHhuQ29tcG9zZS5iZWdpbjx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3Jla2V5VG9QbHVnaW4+KHsKICAgIC8vICAgYXBwSWQ6IHdhbGxldCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG9wdGluLAogICAgLy8gICAgIHRydWUsIC8vIGdsb2JhbAogICAgLy8gICAgICcnLCAvLyBkZWZhdWx0IGFjY291bnQKICAgIC8vICAgICBbXSwgLy8gbm8gbWV0aG9kIG9mZnNldHMKICAgIC8vICAgICBbXSAvLyBubyBmdW5kcyByZXF1ZXN0CiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICBieXRlYyAzMCAvLyBtZXRob2QgImFyYzU4X3Jla2V5VG9QbHVnaW4odWludDY0LGJvb2wsc3RyaW5nLHVpbnQ2NFtdLCh1aW50NjQsdWludDY0KVtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjMKICAgIC8vIHRydWUsIC8vIGdsb2JhbAogICAgYnl0ZWMgMTMgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vICcnLCAvLyBkZWZhdWx0IGFjY291bnQKICAgIGJ5dGVjIDExIC8vIDB4MDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjUKICAgIC8vIFtdLCAvLyBubyBtZXRob2Qgb2Zmc2V0cwogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gW10gLy8gbm8gZnVuZHMgcmVxdWVzdAogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIxOS0yMjgKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3B0aW4sCiAgICAvLyAgICAgdHJ1ZSwgLy8gZ2xvYmFsCiAgICAvLyAgICAgJycsIC8vIGRlZmF1bHQgYWNjb3VudAogICAgLy8gICAgIFtdLCAvLyBubyBtZXRob2Qgb2Zmc2V0cwogICAgLy8gICAgIFtdIC8vIG5vIGZ1bmRzIHJlcXVlc3QKICAgIC8vICAgXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMzAtMjQxCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KHsKICAgIC8vICAgYXBwSWQ6IG9wdGluLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgd2FsbGV0LAogICAgLy8gICAgIHRydWUsIC8vIHJla2V5IGJhY2sgaW1tZWRpYXRlbHkKICAgIC8vICAgICBbYXNzZXRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSwKICAgIC8vICAgICAgIHJlY2VpdmVyOiBvcmlnaW4KICAgIC8vICAgICB9KQogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNwogICAgLy8gYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNi0yMzkKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlLAogICAgLy8gICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzMC0yNDEKICAgIC8vIGl0eG5Db21wb3NlLm5leHQ8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogb3B0aW4sCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwgLy8gcmVrZXkgYmFjayBpbW1lZGlhdGVseQogICAgLy8gICAgIFthc3NldF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlLAogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbgogICAgLy8gICAgIH0pCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyB3YWxsZXQsCiAgICBkaWcgMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gW2Fzc2V0XSwKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGl0b2IKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMwLTI0MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLCAvLyByZWtleSBiYWNrIGltbWVkaWF0ZWx5CiAgICAvLyAgICAgW2Fzc2V0XSwKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICAvLyAgICAgICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyAgICAgfSkKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBieXRlYyAyMyAvLyBtZXRob2QgIm9wdEluKHVpbnQ2NCxib29sLHVpbnQ2NFtdLHBheSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjIzCiAgICAvLyB0cnVlLCAvLyBnbG9iYWwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMwLTI0MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLCAvLyByZWtleSBiYWNrIGltbWVkaWF0ZWx5CiAgICAvLyAgICAgW2Fzc2V0XSwKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICAvLyAgICAgICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyAgICAgfSkKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjQzLTI0NQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIGJ5dGVjIDMxIC8vIG1ldGhvZCAiYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3MoKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjQ3LTI1MwogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHRheCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyApCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBkaWcgMQogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDgtMjUyCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiB0YXgsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjU1LTI2MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHJlbWFpbmRlciwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyApCiAgICBpdHhuX25leHQKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNTYtMjYwCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgIGFzc2V0QW1vdW50OiByZW1haW5kZXIsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjYzCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTUKICAgIC8vIHJldHVybiBhcmM1OAogICAgZnJhbWVfZGlnIDMKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTgKICAgIC8vIHRoaXMuc2VuZERpcmVjdFJlYWN0aW9uUGF5bWVudHMoY3JlYXRvciwgYWt0YSwgbGVmdG92ZXIsIChyZWFjdEZlZSAtIHRheCkpCiAgICBmcmFtZV9kaWcgLTEKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjY5CiAgICAvLyBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjY5CiAgICAvLyBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgc3dhcAogICAgZnJhbWVfYnVyeSAxCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBpZiAocmVtYWluZGVyID4gMCAmJiBjcmVhdG9yLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBieiB0aXBDcmVhdG9yX2Vsc2VfYm9keUAxMQogICAgZnJhbWVfZGlnIC0zCiAgICBmcmFtZV9kaWcgMgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGJ6IHRpcENyZWF0b3JfZWxzZV9ib2R5QDExCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBpdHhuLnN1Ym1pdEdyb3VwKHRheFR4biwgeGZlclR4bikKICAgIGl0eG5fYmVnaW4KICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBmcmFtZV9kaWcgNAogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgZnJhbWVfZGlnIDEKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI2OC0yNzIKICAgIC8vIGNvbnN0IHRheFR4biA9IGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgYXNzZXRBbW91bnQ6IHRheCwKICAgIC8vICAgeGZlckFzc2V0OiBhc3NldAogICAgLy8gfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyODEKICAgIC8vIGl0eG4uc3VibWl0R3JvdXAodGF4VHhuLCB4ZmVyVHhuKQogICAgaXR4bl9uZXh0CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZnJhbWVfZGlnIDUKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAtMwogICAgaXR4bl9maWVsZCBBc3NldFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjc1LTI3OQogICAgLy8gY29uc3QgeGZlclR4biA9IGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IGNyZWF0b3IsCiAgICAvLyAgIGFzc2V0QW1vdW50OiByZW1haW5kZXIsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBpdHhuLnN1Ym1pdEdyb3VwKHRheFR4biwgeGZlclR4bikKICAgIGl0eG5fc3VibWl0Cgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzE5CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2Vsc2VfYm9keUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyODQKICAgIC8vIGFzc2V0QW1vdW50OiB0YXggKyByZW1haW5kZXIKICAgIGZyYW1lX2RpZyA0CiAgICBmcmFtZV9kaWcgNQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI4NgogICAgLy8gdGF4VHhuLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICBmcmFtZV9kaWcgMgogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAxCiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNjgtMjcyCiAgICAvLyBjb25zdCB0YXhUeG4gPSBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiB0YXgsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjg2CiAgICAvLyB0YXhUeG4uc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICBiIHRpcENyZWF0b3JfYWZ0ZXJfaWZfZWxzZUAxMgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3Nhcnkoa2V5OiBieXRlcywgY3JlYXRvcjogYnl0ZXMpIC0+IHVpbnQ2NDoKY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3Nhcnk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBwcml2YXRlIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5KGtleTogYnl0ZXM8MzI+LCBjcmVhdG9yOiBBY2NvdW50KTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTIKICAgIGNvbmNhdAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzIzCiAgICAvLyBpZiAoIXRoaXMucG9zdHMoa2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMzNAogICAgLy8gdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMyNC0zNDAKICAgIC8vIHRoaXMucG9zdHMoa2V5KS52YWx1ZSA9IHsKICAgIC8vICAgcmVmOiBvcC5iemVybygwKSwKICAgIC8vICAgLyoqCiAgICAvLyAgICAqIHdoZW4gYSB1c2VyIHJlYWN0cyB0byBjb250ZW50IG90aGVyIHRoYW4gcG9zdHMKICAgIC8vICAgICogd2Ugc2V0IHRoZSBjcmVhdG9yIHRvIHRoZSBmb2xsb3dpbmc6CiAgICAvLyAgICAqIC0gQXNzZXRJRDogQXNzZXQgQ3JlYXRvcgogICAgLy8gICAgKiAtIEFkZHJlc3M6IEFjY291bnQKICAgIC8vICAgICogLSAgIEFwcElEOiBBcHBsaWNhdGlvbiBDcmVhdG9yCiAgICAvLyAgICAqLwogICAgLy8gICBjcmVhdG9yLAogICAgLy8gICB0aW1lc3RhbXA6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGdhdGVJRDogMCwKICAgIC8vICAgdXNlUGF5V2FsbDogZmFsc2UsCiAgICAvLyAgIHBheVdhbGxJRDogMCwKICAgIC8vICAgYWdhaW5zdENvbnRlbnRQb2xpY3k6IGZhbHNlLAogICAgLy8gICBwb3N0VHlwZTogUG9zdFR5cGVQb3N0LAogICAgLy8gfQogICAgaXRvYgogICAgZnJhbWVfZGlnIC0xCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMzUKICAgIC8vIGdhdGVJRDogMCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzI0LTM0MAogICAgLy8gdGhpcy5wb3N0cyhrZXkpLnZhbHVlID0gewogICAgLy8gICByZWY6IG9wLmJ6ZXJvKDApLAogICAgLy8gICAvKioKICAgIC8vICAgICogd2hlbiBhIHVzZXIgcmVhY3RzIHRvIGNvbnRlbnQgb3RoZXIgdGhhbiBwb3N0cwogICAgLy8gICAgKiB3ZSBzZXQgdGhlIGNyZWF0b3IgdG8gdGhlIGZvbGxvd2luZzoKICAgIC8vICAgICogLSBBc3NldElEOiBBc3NldCBDcmVhdG9yCiAgICAvLyAgICAqIC0gQWRkcmVzczogQWNjb3VudAogICAgLy8gICAgKiAtICAgQXBwSUQ6IEFwcGxpY2F0aW9uIENyZWF0b3IKICAgIC8vICAgICovCiAgICAvLyAgIGNyZWF0b3IsCiAgICAvLyAgIHRpbWVzdGFtcDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZ2F0ZUlEOiAwLAogICAgLy8gICB1c2VQYXlXYWxsOiBmYWxzZSwKICAgIC8vICAgcGF5V2FsbElEOiAwLAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZVBvc3QsCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MDAKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBwdXNoYnl0ZXMgMHgwMDAwMDAzZDAwMDAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzQyCiAgICAvLyByZXR1cm4gdGhpcy5tYnIoQnl0ZXMoJycpKS5wb3N0cwogICAgcHVzaGJ5dGVzICIiCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICByZXRzdWIKCmNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDQKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVTdHJlYWsoYWNjb3VudDogYnl0ZXMpIC0+IHZvaWQ6CnVwZGF0ZVN0cmVhazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDcKICAgIC8vIHByaXZhdGUgdXBkYXRlU3RyZWFrKGFjY291bnQ6IEFjY291bnQpOiB2b2lkIHsKICAgIHByb3RvIDEgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDgKICAgIC8vIGFzc2VydCh0aGlzLm1ldGEoYWNjb3VudCkuZXhpc3RzLCBFUlJfTUVUQV9ET0VTTlRfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBNZXRhIGJveCB2YWx1ZXMgZG9udCBleGlzdCB5ZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNTAKICAgIC8vIGNvbnN0IHsgc3RhcnREYXRlLCBsYXN0QWN0aXZlIH0gPSB0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBkdXAKICAgIHB1c2hpbnQgMTcgLy8gMTcKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICBwdXNoaW50IDI1IC8vIDI1CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb3ZlciAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCB0aGlzV2luZG93U3RhcnQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSAoKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSBzdGFydERhdGUpICUgT05FX0RBWSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICAtCiAgICBpbnRjIDggLy8gODY0MDAKICAgICUKICAgIC0KICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM1MwogICAgLy8gY29uc3QgbGFzdFdpbmRvd1N0YXJ0OiB1aW50NjQgPSB0aGlzV2luZG93U3RhcnQgLSBPTkVfREFZCiAgICBpbnRjIDggLy8gODY0MDAKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNTUKICAgIC8vIHRoaXMubWV0YShhY2NvdW50KS52YWx1ZS5sYXN0QWN0aXZlID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBwdXNoaW50IDI1IC8vIDI1CiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzU4CiAgICAvLyBpZiAobGFzdFdpbmRvd1N0YXJ0ID4gbGFzdEFjdGl2ZSkgewogICAgPAogICAgYnogdXBkYXRlU3RyZWFrX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM1OQogICAgLy8gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlLnN0cmVhayA9IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgMAogICAgcHVzaGludCA5IC8vIDkKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNjAKICAgIC8vIHJldHVybgogICAgcmV0c3ViCgp1cGRhdGVTdHJlYWtfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2NQogICAgLy8gaWYgKGxhc3RBY3RpdmUgPCB0aGlzV2luZG93U3RhcnQpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgMgogICAgPAogICAgYnogdXBkYXRlU3RyZWFrX2FmdGVyX2lmX2Vsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2NgogICAgLy8gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlLnN0cmVhayArPSAxCiAgICBmcmFtZV9kaWcgMAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgcHVzaGludCA5IC8vIDkKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCgp1cGRhdGVTdHJlYWtfYWZ0ZXJfaWZfZWxzZUA0OgogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVWb3RlcyhyZWY6IGJ5dGVzLCBpc1VwOiB1aW50NjQsIGltcGFjdDogdWludDY0KSAtPiB2b2lkOgp1cGRhdGVWb3RlczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDIKICAgIC8vIHByaXZhdGUgdXBkYXRlVm90ZXMocmVmOiBieXRlczwzMj4sIGlzVXA6IGJvb2xlYW4sIGltcGFjdDogdWludDY0KTogdm9pZCB7CiAgICBwcm90byAzIDAKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAKICAgIC8vIHZvdGVzID0gQm94TWFwPGJ5dGVzPDMyPiwgVm90ZXNWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZXMgfSkKICAgIHB1c2hieXRlcyAidiIKICAgIGZyYW1lX2RpZyAtMwogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNzMKICAgIC8vIGlmICghdGhpcy52b3RlcyhyZWYpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogdXBkYXRlVm90ZXNfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzc0CiAgICAvLyByZXR1cm4geyBuZXdDb3VudDogaW1wYWN0LCBpc05lZ2F0aXZlOiAhaXNVcCB9CiAgICBmcmFtZV9kaWcgLTIKICAgICEKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAoKdXBkYXRlVm90ZXNfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNhbGNWb3Rlc0AyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBkdXAKICAgIGV4dHJhY3QgMCA4CiAgICBzd2FwCiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDQKICAgIC8vIHRoaXMudm90ZXMocmVmKS52YWx1ZSA9IHsgdm90ZUNvdW50LCBpc05lZ2F0aXZlIH0KICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAzCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICByZXRzdWIKCnVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNzcKICAgIC8vIGNvbnN0IHsgaXNOZWdhdGl2ZSwgdm90ZUNvdW50IH0gPSB0aGlzLnZvdGVzKHJlZikudmFsdWUKICAgIGZyYW1lX2RpZyAzCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBnZXRiaXQKICAgIGZyYW1lX2J1cnkgMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozODAKICAgIC8vIGNvbnN0IGRpZmZlcmluZ0RpcmVjdGlvbnMgPSAoaXNVcCAmJiBpc05lZ2F0aXZlKSB8fCAoIWlzVXAgJiYgIWlzTmVnYXRpdmUpCiAgICBmcmFtZV9kaWcgLTIKICAgIGJ6IHVwZGF0ZVZvdGVzX29yX2NvbnRkQDUKICAgIGZyYW1lX2RpZyAxCiAgICBibnogdXBkYXRlVm90ZXNfYm9vbF90cnVlQDcKCnVwZGF0ZVZvdGVzX29yX2NvbnRkQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzgwCiAgICAvLyBjb25zdCBkaWZmZXJpbmdEaXJlY3Rpb25zID0gKGlzVXAgJiYgaXNOZWdhdGl2ZSkgfHwgKCFpc1VwICYmICFpc05lZ2F0aXZlKQogICAgZnJhbWVfZGlnIC0yCiAgICBibnogdXBkYXRlVm90ZXNfYm9vbF9mYWxzZUA4CiAgICBmcmFtZV9kaWcgMQogICAgYm56IHVwZGF0ZVZvdGVzX2Jvb2xfZmFsc2VAOAoKdXBkYXRlVm90ZXNfYm9vbF90cnVlQDc6CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSAwCgp1cGRhdGVWb3Rlc19ib29sX21lcmdlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzgyCiAgICAvLyBpZiAodm90ZUNvdW50ID09PSAwKSB7CiAgICBmcmFtZV9kaWcgMgogICAgYm56IHVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozODMKICAgIC8vIHJldHVybiB7IG5ld0NvdW50OiBpbXBhY3QsIGlzTmVnYXRpdmU6ICFpc1VwIH0KICAgIGZyYW1lX2RpZyAtMgogICAgIQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDMKICAgIHNldGJpdAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAzCiAgICAvLyBjb25zdCB7IG5ld0NvdW50OiB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMuY2FsY1ZvdGVzKHJlZiwgaXNVcCwgaW1wYWN0KQogICAgYiB1cGRhdGVWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY2FsY1ZvdGVzQDIwCgp1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4NgogICAgLy8gaWYgKGltcGFjdCA9PT0gdm90ZUNvdW50ICYmIGRpZmZlcmluZ0RpcmVjdGlvbnMpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfZGlnIDIKICAgID09CiAgICBieiB1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE0CiAgICBmcmFtZV9kaWcgMAogICAgYnogdXBkYXRlVm90ZXNfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4NwogICAgLy8gcmV0dXJuIHsgbmV3Q291bnQ6IDAsIGlzTmVnYXRpdmU6IGZhbHNlIH0KICAgIGJ5dGVjIDMyIC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAzCiAgICAvLyBjb25zdCB7IG5ld0NvdW50OiB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMuY2FsY1ZvdGVzKHJlZiwgaXNVcCwgaW1wYWN0KQogICAgYiB1cGRhdGVWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY2FsY1ZvdGVzQDIwCgp1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM5MgogICAgLy8gY29uc3QgZmxpcCA9IGltcGFjdCA+IHZvdGVDb3VudCAmJiBkaWZmZXJpbmdEaXJlY3Rpb25zCiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2RpZyAyCiAgICA+CiAgICBmcmFtZV9kaWcgMAogICAgJiYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozOTMKICAgIC8vIGlmIChmbGlwKSB7CiAgICBieiB1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk0CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gaW1wYWN0IC0gdm90ZUNvdW50CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2RpZyAyCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk1CiAgICAvLyByZXR1cm4geyBuZXdDb3VudCwgaXNOZWdhdGl2ZTogIWlzTmVnYXRpdmUgfQogICAgZnJhbWVfZGlnIDEKICAgICEKICAgIHN3YXAKICAgIGl0b2IKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMwogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBiIHVwZGF0ZVZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jYWxjVm90ZXNAMjAKCnVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk4CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gZGlmZmVyaW5nRGlyZWN0aW9ucyA/IHZvdGVDb3VudCAtIGltcGFjdCA6IHZvdGVDb3VudCArIGltcGFjdAogICAgZnJhbWVfZGlnIDAKICAgIGJ6IHVwZGF0ZVZvdGVzX3Rlcm5hcnlfZmFsc2VAMTgKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgLTEKICAgIC0KCnVwZGF0ZVZvdGVzX3Rlcm5hcnlfbWVyZ2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk5CiAgICAvLyByZXR1cm4geyBuZXdDb3VudCwgaXNOZWdhdGl2ZSB9CiAgICBpdG9iCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMQogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBiIHVwZGF0ZVZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jYWxjVm90ZXNAMjAKCnVwZGF0ZVZvdGVzX3Rlcm5hcnlfZmFsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk4CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gZGlmZmVyaW5nRGlyZWN0aW9ucyA/IHZvdGVDb3VudCAtIGltcGFjdCA6IHZvdGVDb3VudCArIGltcGFjdAogICAgZnJhbWVfZGlnIDIKICAgIGZyYW1lX2RpZyAtMQogICAgKwogICAgYiB1cGRhdGVWb3Rlc190ZXJuYXJ5X21lcmdlQDE5Cgp1cGRhdGVWb3Rlc19ib29sX2ZhbHNlQDg6CiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCiAgICBiIHVwZGF0ZVZvdGVzX2Jvb2xfbWVyZ2VAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlVm90ZUxpc3QocmVmOiBieXRlcywgaXNVcDogdWludDY0LCBhY2NvdW50OiBieXRlcywgaW1wYWN0OiB1aW50NjQpIC0+IHZvaWQ6CmNyZWF0ZVZvdGVMaXN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQwNwogICAgLy8gcHJpdmF0ZSBjcmVhdGVWb3RlTGlzdChyZWY6IGJ5dGVzPDMyPiwgaXNVcDogYm9vbGVhbiwgYWNjb3VudDogQWNjb3VudCwgaW1wYWN0OiB1aW50NjQpOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQwOAogICAgLy8gY29uc3Qgdm90ZUxpc3RLZXk6IFZvdGVMaXN0S2V5ID0geyB1c2VyOiBiMTYoYWNjb3VudC5ieXRlcyksIHJlZjogYjE2KHJlZikgfQogICAgZnJhbWVfZGlnIC0yCiAgICBjYWxsc3ViIGIxNgogICAgZnJhbWVfZGlnIC00CiAgICBjYWxsc3ViIGIxNgogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDA5CiAgICAvLyB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS52YWx1ZSA9IHsgaW1wYWN0LCBpc1VwIH0KICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIC0zCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDkKICAgIC8vIHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlID0geyBpbXBhY3QsIGlzVXAgfQogICAgc3dhcAogICAgYm94X3B1dAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jcmVhdGVQb3N0KHBvc3RLZXk6IGJ5dGVzLCBvcmlnaW46IGJ5dGVzLCBtYnJQYXltZW50OiB1aW50NjQsIGNpZDogYnl0ZXMsIGdhdGVJRDogdWludDY0LCB1c2VQYXlXYWxsOiB1aW50NjQsIHBheVdhbGxJRDogdWludDY0LCBwb3N0VHlwZTogYnl0ZXMsIGFtZW5kbWVudE9mOiBieXRlcykgLT4gdm9pZDoKY3JlYXRlUG9zdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MTItNDIyCiAgICAvLyBwcml2YXRlIGNyZWF0ZVBvc3QoCiAgICAvLyAgIHBvc3RLZXk6IGJ5dGVzPDMyPiwKICAgIC8vICAgb3JpZ2luOiBBY2NvdW50LAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGNpZDogQ0lELAogICAgLy8gICBnYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgdXNlUGF5V2FsbDogYm9vbGVhbiwKICAgIC8vICAgcGF5V2FsbElEOiB1aW50NjQsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZSwKICAgIC8vICAgYW1lbmRtZW50T2Y6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gOSAwCiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDIzCiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCYW5uZWQob3JpZ2luKSwgRVJSX0JBTk5FRCkKICAgIGZyYW1lX2RpZyAtOAogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJhbm5lZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNQogICAgLy8gKCF1c2VQYXlXYWxsICYmIHBheVdhbGxJRCA9PT0gMCkgfHwgKAogICAgZnJhbWVfZGlnIC00CiAgICBibnogY3JlYXRlUG9zdF9vcl9jb250ZEAyCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ6IGNyZWF0ZVBvc3RfYm9vbF90cnVlQDcKCmNyZWF0ZVBvc3Rfb3JfY29udGRAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjYtNDI5CiAgICAvLyB1c2VQYXlXYWxsICYmICgKICAgIC8vICAgcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgLy8gICBwYXlXYWxsSUQgPT09IDAgJiYgdGhpcy5wYXl3YWxsKHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cwogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBieiBjcmVhdGVQb3N0X2Jvb2xfZmFsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNwogICAgLy8gcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgZnJhbWVfZGlnIC0zCiAgICBieiBjcmVhdGVQb3N0X29yX2NvbnRkQDUKICAgIGZyYW1lX2RpZyAtMwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjcKICAgIC8vIHBheVdhbGxJRCAhPT0gMCAmJiB0aGlzLnBheXdhbGwocGF5V2FsbElEKS5leGlzdHMgfHwKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGNyZWF0ZVBvc3RfYm9vbF90cnVlQDcKCmNyZWF0ZVBvc3Rfb3JfY29udGRANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHBheVdhbGxJRCA9PT0gMCAmJiB0aGlzLnBheXdhbGwodGhpcy5tZXRhKG9yaWdpbikudmFsdWUuZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzCiAgICBmcmFtZV9kaWcgLTMKICAgIGJueiBjcmVhdGVQb3N0X2Jvb2xfZmFsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtOAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDI4CiAgICAvLyBwYXlXYWxsSUQgPT09IDAgJiYgdGhpcy5wYXl3YWxsKHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cwogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGV4dHJhY3QgNjYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHBheVdhbGxJRCA9PT0gMCAmJiB0aGlzLnBheXdhbGwodGhpcy5tZXRhKG9yaWdpbikudmFsdWUuZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IGNyZWF0ZVBvc3RfYm9vbF9mYWxzZUA4CgpjcmVhdGVQb3N0X2Jvb2xfdHJ1ZUA3OgogICAgaW50Y18xIC8vIDEKCmNyZWF0ZVBvc3RfYm9vbF9tZXJnZUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNC00MzIKICAgIC8vIGFzc2VydCgKICAgIC8vICAgKCF1c2VQYXlXYWxsICYmIHBheVdhbGxJRCA9PT0gMCkgfHwgKAogICAgLy8gICAgIHVzZVBheVdhbGwgJiYgKAogICAgLy8gICAgICAgcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgLy8gICAgICAgcGF5V2FsbElEID09PSAwICYmIHRoaXMucGF5d2FsbCh0aGlzLm1ldGEob3JpZ2luKS52YWx1ZS5kZWZhdWx0UGF5V2FsbElEKS5leGlzdHMKICAgIC8vICAgICApCiAgICAvLyAgICksCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWVdBTEwKICAgIC8vICkKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheXdhbGwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MzQKICAgIC8vIGNvbnN0IGlzRWRpdFBvc3QgPSBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UG9zdAogICAgZnJhbWVfZGlnIC0yCiAgICBieXRlYyAyNSAvLyAweDAyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQzNgogICAgLy8gY29uc3QgZWRpdEV4dHJhTWJyOiB1aW50NjQgPSBpc0VkaXRQb3N0ID8gRWRpdEJhY2tSZWZNQlIgOiAwCiAgICBkdXAKICAgIGludGMgNSAvLyAxMzIwMAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gdGhpcy52YWxpZGF0ZVBvc3RQYXltZW50KG1iclBheW1lbnQsIGNpZCwgaXNFZGl0UG9zdCwgZWRpdEV4dHJhTWJyKQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgLTYKICAgIGRpZyAzCiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgdmFsaWRhdGVQb3N0UGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ0MQogICAgLy8gdGhpcy51cGRhdGVTdHJlYWsob3JpZ2luKQogICAgZnJhbWVfZGlnIC04CiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ0MwogICAgLy8gY29uc3QgaW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KG9yaWdpbikKICAgIGZyYW1lX2RpZyAtOAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICBmcmFtZV9idXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZnJhbWVfZGlnIC05CiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQ1CiAgICAvLyBhc3NlcnQoIXRoaXMucG9zdHMocG9zdEtleSkuZXhpc3RzLCAnRVJSOlBPU1RfRVhJU1RTJykKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIEVSUjpQT1NUX0VYSVNUUwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ1MC00NTIKICAgIC8vIGNvbnN0IHBvc3RSZWY6IGJ5dGVzID0gaXNFZGl0UG9zdAogICAgLy8gICA/IEJ5dGVzKGNpZCkuY29uY2F0KGFtZW5kbWVudE9mKQogICAgLy8gICA6IEJ5dGVzKGNpZCkKICAgIGJ6IGNyZWF0ZVBvc3RfdGVybmFyeV9mYWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ1MQogICAgLy8gPyBCeXRlcyhjaWQpLmNvbmNhdChhbWVuZG1lbnRPZikKICAgIGZyYW1lX2RpZyAtNgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKCmNyZWF0ZVBvc3RfdGVybmFyeV9tZXJnZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NTcKICAgIC8vIHRpbWVzdGFtcDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NTQtNDYzCiAgICAvLyB0aGlzLnBvc3RzKHBvc3RLZXkpLnZhbHVlID0gewogICAgLy8gICByZWY6IHBvc3RSZWYsCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBnYXRlSUQ6IGdhdGVJRCwKICAgIC8vICAgdXNlUGF5V2FsbCwKICAgIC8vICAgcGF5V2FsbElELAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBwb3N0VHlwZSwKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtOAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTUKICAgIGl0b2IKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIC00CiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0zCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTIKICAgIGNvbmNhdAogICAgc3dhcAogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjIDMzIC8vIDB4MDAzZAogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAwCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2NAogICAgLy8gdGhpcy51cGRhdGVWb3Rlcyhwb3N0S2V5LCB0cnVlLCBpbXBhY3QpCiAgICBmcmFtZV9kaWcgLTkKICAgIGludGNfMSAvLyAxCiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDY1CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGVMaXN0KHBvc3RLZXksIHRydWUsIG9yaWdpbiwgaW1wYWN0KQogICAgZnJhbWVfZGlnIC05CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIC04CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgY3JlYXRlVm90ZUxpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjAyCiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBkaWcgMQogICAgYnl0ZWMgMTAgLy8gInNvY2lhbF9mZWVzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gY29uc3QgeyBwb3N0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDQKICAgIC8vIGNvbnN0IHsgbGVmdG92ZXIgfSA9IHNlbmRSZWZlcnJhbFBheW1lbnQodGhpcy5ha2l0YURBTy52YWx1ZSwgYWt0YSwgcG9zdEZlZSkKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzZW5kUmVmZXJyYWxQYXltZW50CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDYtMjEyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGxlZnRvdmVyLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDYtMjExCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGxlZnRvdmVyLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIwNi0yMTIKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogbGVmdG92ZXIsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIHJldHN1YgoKY3JlYXRlUG9zdF90ZXJuYXJ5X2ZhbHNlQDExOgogICAgZnJhbWVfZGlnIC02CiAgICBiIGNyZWF0ZVBvc3RfdGVybmFyeV9tZXJnZUAxMgoKY3JlYXRlUG9zdF9ib29sX2ZhbHNlQDg6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVQb3N0X2Jvb2xfbWVyZ2VAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUmVwbHkocmVwbHlLZXk6IGJ5dGVzLCBvcmlnaW46IGJ5dGVzLCBtYnJQYXltZW50OiB1aW50NjQsIG1ick5lZWRlZDogdWludDY0LCBjaWQ6IGJ5dGVzLCBwYXJlbnRSZWY6IGJ5dGVzLCBnYXRlSUQ6IHVpbnQ2NCwgdXNlUGF5V2FsbDogdWludDY0LCBwYXlXYWxsSUQ6IHVpbnQ2NCwgcG9zdFR5cGU6IGJ5dGVzLCBhbWVuZG1lbnRPZjogYnl0ZXMpIC0+IHZvaWQ6CmNyZWF0ZVJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2OS00ODEKICAgIC8vIHByaXZhdGUgY3JlYXRlUmVwbHkoCiAgICAvLyAgIHJlcGx5S2V5OiBieXRlczwzMj4sCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBtYnJOZWVkZWQ6IHVpbnQ2NCwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHBhcmVudFJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBnYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgdXNlUGF5V2FsbDogYm9vbGVhbiwKICAgIC8vICAgcGF5V2FsbElEOiB1aW50NjQsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZSwKICAgIC8vICAgYW1lbmRtZW50T2Y6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gMTEgMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0ODIKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jhbm5lZChvcmlnaW4pLCBFUlJfQkFOTkVEKQogICAgZnJhbWVfZGlnIC0xMAogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJhbm5lZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTYKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gY29uc3QgeyBjcmVhdG9yIH0gPSB0aGlzLnBvc3RzKHBhcmVudFJlZikudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDg0CiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCbG9ja2VkKGNyZWF0b3IsIG9yaWdpbiksIEVSUl9CTE9DS0VEKQogICAgZHVwCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIGlzQmxvY2tlZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBibG9ja2VkIGJ5IHRoZSB1c2VyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDg4CiAgICAvLyB0aGlzLnVwZGF0ZVN0cmVhayhvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MAogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MAogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDkwCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OTEKICAgIC8vIGNvbnN0IGNyZWF0b3JJbXBhY3QgPSB0aGlzLmdldFVzZXJJbXBhY3QoY3JlYXRvcikKICAgIGRpZyAxCiAgICBjYWxsc3ViIGdldFVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OTIKICAgIC8vIGNvbnN0IHRheFBlcmNlbnRhZ2UgPSBha2l0YVNvY2lhbEZlZSh0aGlzLmFraXRhREFPLnZhbHVlLCBjcmVhdG9ySW1wYWN0KQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MgogICAgLy8gY29uc3QgdGF4UGVyY2VudGFnZSA9IGFraXRhU29jaWFsRmVlKHRoaXMuYWtpdGFEQU8udmFsdWUsIGNyZWF0b3JJbXBhY3QpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2OQogICAgLy8gY29uc3QgW3NvY2lhbEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzKSkKICAgIGJ5dGVjIDEwIC8vICJzb2NpYWxfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTIxCiAgICAvLyBjb25zdCB7IGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKGFraXRhREFPKQogICAgZHVwCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTIyCiAgICAvLyByZXR1cm4gaW1wYWN0UmFuZ2UoaW1wYWN0LCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCkKICAgIGNhbGxzdWIgaW1wYWN0UmFuZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5NQogICAgLy8gY29uc3QgaXNFZGl0UmVwbHkgPSBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIGZyYW1lX2RpZyAtMgogICAgYnl0ZWMgMTUgLy8gMHgwMwogICAgPT0KICAgIGR1cAogICAgY292ZXIgMgogICAgY292ZXIgNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5OAogICAgLy8gZXh0cmEgKz0gdGhpcy50aXBDcmVhdG9yKGNyZWF0b3IsIHJlYWN0RmVlLCB0YXgpCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBjYWxsc3ViIHRpcENyZWF0b3IKICAgIGZyYW1lX2RpZyAtOAogICAgKwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUwMAogICAgLy8gaWYgKGlzRWRpdFJlcGx5KSB7CiAgICBieiBjcmVhdGVSZXBseV9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MDEKICAgIC8vIGV4dHJhICs9IEVkaXRCYWNrUmVmTUJSCiAgICBpbnRjIDUgLy8gMTMyMDAKICAgICsKCmNyZWF0ZVJlcGx5X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MDQKICAgIC8vIHRoaXMudmFsaWRhdGVQb3N0UGF5bWVudChtYnJQYXltZW50LCBjaWQsIGlzRWRpdFJlcGx5LCBleHRyYSkKICAgIGZyYW1lX2RpZyAtOQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgdmFsaWRhdGVQb3N0UGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTExCiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTA2CiAgICAvLyBhc3NlcnQoIXRoaXMucG9zdHMocmVwbHlLZXkpLmV4aXN0cywgJ0VSUjpQT1NUX0VYSVNUUycpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBFUlI6UE9TVF9FWElTVFMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MTEtNTEzCiAgICAvLyBjb25zdCByZXBseVJlZjogYnl0ZXMgPSBpc0VkaXRSZXBseQogICAgLy8gICA/IEJ5dGVzKGNpZCkuY29uY2F0KHBhcmVudFJlZikuY29uY2F0KGFtZW5kbWVudE9mKQogICAgLy8gICA6IEJ5dGVzKGNpZCkuY29uY2F0KHBhcmVudFJlZikKICAgIGJ6IGNyZWF0ZVJlcGx5X3Rlcm5hcnlfZmFsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUxMgogICAgLy8gPyBCeXRlcyhjaWQpLmNvbmNhdChwYXJlbnRSZWYpLmNvbmNhdChhbWVuZG1lbnRPZikKICAgIGZyYW1lX2RpZyAtNwogICAgZnJhbWVfZGlnIC02CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CgpjcmVhdGVSZXBseV90ZXJuYXJ5X21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTE4CiAgICAvLyB0aW1lc3RhbXA6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTE1LTUyNAogICAgLy8gdGhpcy5wb3N0cyhyZXBseUtleSkudmFsdWUgPSB7CiAgICAvLyAgIHJlZjogcmVwbHlSZWYsCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBnYXRlSUQ6IGdhdGVJRCwKICAgIC8vICAgdXNlUGF5V2FsbCwKICAgIC8vICAgcGF5V2FsbElELAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBwb3N0VHlwZSwKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtMTAKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC01CiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtNAogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMwogICAgaXRvYgogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MDAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyAzMyAvLyAweDAwM2QKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMAogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MjYKICAgIC8vIGNvbnN0IHNlbmRlckltcGFjdCA9IHRoaXMuZ2V0VXNlckltcGFjdChvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIGdldFVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MjcKICAgIC8vIHRoaXMudXBkYXRlVm90ZXMocmVwbHlLZXksIHRydWUsIHNlbmRlckltcGFjdCkKICAgIGZyYW1lX2RpZyAtMTEKICAgIGludGNfMSAvLyAxCiAgICBkaWcgMgogICAgY2FsbHN1YiB1cGRhdGVWb3RlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUyOAogICAgLy8gdGhpcy5jcmVhdGVWb3RlTGlzdChyZXBseUtleSwgdHJ1ZSwgb3JpZ2luLCBzZW5kZXJJbXBhY3QpCiAgICBmcmFtZV9kaWcgLTExCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIC0xMAogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGNyZWF0ZVZvdGVMaXN0CiAgICByZXRzdWIKCmNyZWF0ZVJlcGx5X3Rlcm5hcnlfZmFsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MTMKICAgIC8vIDogQnl0ZXMoY2lkKS5jb25jYXQocGFyZW50UmVmKQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgLTYKICAgIGNvbmNhdAogICAgYiBjcmVhdGVSZXBseV90ZXJuYXJ5X21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZVZvdGUob3JpZ2luOiBieXRlcywgbWJyUGF5bWVudDogdWludDY0LCBtYnJOZWVkZWQ6IHVpbnQ2NCwgcmVmOiBieXRlcywgaXNVcDogdWludDY0KSAtPiB2b2lkOgpjcmVhdGVWb3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUzMS01MzcKICAgIC8vIHByaXZhdGUgY3JlYXRlVm90ZSgKICAgIC8vICAgb3JpZ2luOiBBY2NvdW50LAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIG1ick5lZWRlZDogdWludDY0LAogICAgLy8gICByZWY6IGJ5dGVzPDMyPiwKICAgIC8vICAgaXNVcDogYm9vbGVhbgogICAgLy8gKTogdm9pZCB7CiAgICBwcm90byA1IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MzgKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jhbm5lZChvcmlnaW4pLCBFUlJfQkFOTkVEKQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNCYW5uZWQKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgaXMgYmFubmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHBvc3RzID0gQm94TWFwPGJ5dGVzPDMyPiwgUG9zdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhQb3N0cyB9KQogICAgYnl0ZWNfMiAvLyAicCIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTM5CiAgICAvLyBhc3NlcnQodGhpcy5wb3N0cyhyZWYpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0MQogICAgLy8gY29uc3QgeyBjcmVhdG9yIH0gPSB0aGlzLnBvc3RzKHJlZikudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDIKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jsb2NrZWQoY3JlYXRvciwgb3JpZ2luKSwgRVJSX0JMT0NLRUQpCiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgaXNCbG9ja2VkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJsb2NrZWQgYnkgdGhlIHVzZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDQKICAgIC8vIGNvbnN0IHZvdGVMaXN0S2V5OiBWb3RlTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZikgfQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIGIxNgogICAgZnJhbWVfZGlnIC0yCiAgICBjYWxsc3ViIGIxNgogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIHZvdGVsaXN0ID0gQm94TWFwPFZvdGVMaXN0S2V5LCBWb3RlTGlzdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhWb3RlTGlzdCB9KQogICAgYnl0ZWMgMTYgLy8gIm8iCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDUKICAgIC8vIGFzc2VydCghdGhpcy52b3RlbGlzdCh2b3RlTGlzdEtleSkuZXhpc3RzLCBFUlJfQUxSRUFEWV9WT1RFRCkKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIFlvdSd2ZSBhbHJlYWR5IHZvdGVkIG9uIHRoaXMgcG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0NgogICAgLy8gYXNzZXJ0KG9yaWdpbiAhPT0gY3JlYXRvciwgRVJSX05PX1NFTEZfVk9URSkKICAgIGZyYW1lX2RpZyAtNQogICAgIT0KICAgIGFzc2VydCAvLyBDYW5ub3Qgdm90ZSBvbiB5b3VyIG93biBjb250ZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgTWV0YVZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlYyA1IC8vICJtIgogICAgZnJhbWVfZGlnIC01CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDgKICAgIC8vIGNvbnN0IHNlbmRlcklzQXV0b21hdGVkID0gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUuYXV0b21hdGVkCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCAzOTIgLy8gMzkyCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDkKICAgIC8vIGFzc2VydCghc2VuZGVySXNBdXRvbWF0ZWQsIEVSUl9BVVRPTUFURURfQUNDT1VOVCkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGlzIGFuIGF1dG9tYXRlZCBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTUxCiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1MQogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjk0CiAgICAvLyBjb25zdCBha2l0YUFzc2V0c0J5dGVzID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMpKVswXQogICAgYnl0ZWMgOCAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1MQogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1NQogICAgLy8gdGhpcy51cGRhdGVTdHJlYWsob3JpZ2luKQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1NgogICAgLy8gY29uc3QgeyByZWFjdEZlZSwgaW1wYWN0VGF4TWluLCBpbXBhY3RUYXhNYXggfSA9IGdldFNvY2lhbEZlZXModGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTYKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2OQogICAgLy8gY29uc3QgW3NvY2lhbEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzKSkKICAgIGJ5dGVjIDEwIC8vICJzb2NpYWxfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTYKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgZHVwCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgMgogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTgKICAgIC8vIGlmIChpc1VwKSB7CiAgICBmcmFtZV9kaWcgLTEKICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjAKICAgIC8vIGNvbnN0IHJlY2lwaWVudEltcGFjdCA9IHRoaXMuZ2V0VXNlckltcGFjdChjcmVhdG9yKQogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTYxCiAgICAvLyBjb25zdCB0YXhQZXJjZW50YWdlID0gaW1wYWN0UmFuZ2UocmVjaXBpZW50SW1wYWN0LCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCkKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgMwogICAgY2FsbHN1YiBpbXBhY3RSYW5nZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNQogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBwKSwgRElWSVNPUikKICAgIHVuY292ZXIgMgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjQKICAgIC8vIGNvbnN0IGV4dHJhID0gdGhpcy50aXBDcmVhdG9yKGNyZWF0b3IsIHJlYWN0RmVlLCB0YXgpCiAgICBjYWxsc3ViIHRpcENyZWF0b3IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjYtNTczCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ick5lZWRlZCArIGV4dHJhCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjkKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTY2LTU3MwogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBtYnJOZWVkZWQgKyBleHRyYQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NzAKICAgIC8vIGFtb3VudDogbWJyTmVlZGVkICsgZXh0cmEKICAgIGZyYW1lX2RpZyAtMwogICAgdW5jb3ZlciAzCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTY2LTU3MwogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBtYnJOZWVkZWQgKyBleHRyYQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKCmNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU5NAogICAgLy8gY29uc3Qgc2VuZGVySW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KG9yaWdpbikKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTk1CiAgICAvLyB0aGlzLnVwZGF0ZVZvdGVzKHJlZiwgaXNVcCwgc2VuZGVySW1wYWN0KQogICAgZnJhbWVfZGlnIC0yCiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTk2CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGVMaXN0KHJlZiwgaXNVcCwgb3JpZ2luLCBzZW5kZXJJbXBhY3QpCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfZGlnIC01CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgY3JlYXRlVm90ZUxpc3QKICAgIHJldHN1YgoKY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NzUtNTgyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ick5lZWRlZAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTc4CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU3NS01ODIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyTmVlZGVkCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFtb3VudAogICAgZnJhbWVfZGlnIC0zCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODQtNTkwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHJlYWN0RmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODYKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODYKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgMQogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU4NC01ODkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogcmVhY3RGZWUsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTg0LTU5MAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiByZWFjdEZlZSwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUmVhY3Rpb24ob3JpZ2luOiBieXRlcywgbWJyUGF5bWVudDogdWludDY0LCBtYnJOZWVkZWQ6IHVpbnQ2NCwgcmVmOiBieXRlcywgTkZUOiB1aW50NjQpIC0+IHZvaWQ6CmNyZWF0ZVJlYWN0aW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU5OS02MDUKICAgIC8vIHByaXZhdGUgY3JlYXRlUmVhY3Rpb24oCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBtYnJOZWVkZWQ6IHVpbnQ2NCwKICAgIC8vICAgcmVmOiBieXRlczwzMj4sCiAgICAvLyAgIE5GVDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHByb3RvIDUgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYwNgogICAgLy8gYXNzZXJ0KCF0aGlzLmlzQmFubmVkKG9yaWdpbiksIEVSUl9CQU5ORUQpCiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBiYW5uZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MDcKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKHJlZikuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjA4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmKS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MDkKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jsb2NrZWQoY3JlYXRvciwgb3JpZ2luKSwgRVJSX0JMT0NLRUQpCiAgICBkdXAKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiBpc0Jsb2NrZWQKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgaXMgYmxvY2tlZCBieSB0aGUgdXNlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXNzZXJ0KEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2Uob3JpZ2luLCBORlQpWzBdID4gMCwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GVCkKICAgIGZyYW1lX2RpZyAtNQogICAgZnJhbWVfZGlnIC0xCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYXNzZXJ0IC8vIFVzZXIgZG9lcyBub3Qgb3duIHRoaXMgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjEzCiAgICAvLyBjb25zdCByZWFjdGlvbkxpc3RLZXk6IFJlYWN0aW9uTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZiksIE5GVCB9CiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgYjE2CiAgICBmcmFtZV9kaWcgLTIKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2CiAgICAvLyByZWFjdGlvbmxpc3QgPSBCb3hNYXA8UmVhY3Rpb25MaXN0S2V5LCBieXRlczwwPj4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25MaXN0IH0pCiAgICBwdXNoYnl0ZXMgImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYxNQogICAgLy8gYXNzZXJ0KCF0aGlzLnJlYWN0aW9ubGlzdChyZWFjdGlvbkxpc3RLZXkpLmV4aXN0cywgRVJSX0FMUkVBRFlfUkVBQ1RFRCkKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBhbHJlYWR5IHJlYWN0ZWQgdG8gdGhpcyBwb3N0IHdpdGggdGhpcyBORlQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MTkKICAgIC8vIHRoaXMudXBkYXRlU3RyZWFrKG9yaWdpbikKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiB1cGRhdGVTdHJlYWsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjEKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIxCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlLCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIxCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlLCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAxCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYyMgogICAgLy8gY29uc3QgcmVjaXBpZW50SW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KGNyZWF0b3IpCiAgICBkaWcgNAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIzCiAgICAvLyBjb25zdCB0YXggPSBpbXBhY3RSYW5nZShyZWNpcGllbnRJbXBhY3QsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4KQogICAgY292ZXIgMgogICAgY2FsbHN1YiBpbXBhY3RSYW5nZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYyNQogICAgLy8gY29uc3QgcmVhY3Rpb25LZXk6IFJlYWN0aW9uc0tleSA9IHsgcmVmLCBORlQgfQogICAgZnJhbWVfZGlnIC0yCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGZyYW1lX2RpZyAtMgogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NAogICAgLy8gcmVhY3Rpb25zID0gQm94TWFwPFJlYWN0aW9uc0tleSwgdWludDY0Pih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhSZWFjdGlvbnMgfSkKICAgIGJ5dGVjIDI2IC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjYKICAgIC8vIGNvbnN0IHJlYWN0aW9uRXhpc3RzID0gdGhpcy5yZWFjdGlvbnMocmVhY3Rpb25LZXkpLmV4aXN0cwogICAgYm94X2xlbgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb3ZlciA1CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjkKICAgIC8vIGV4dHJhICs9IHRoaXMudGlwQ3JlYXRvcihjcmVhdG9yLCByZWFjdEZlZSwgdGF4KQogICAgY292ZXIgMwogICAgY2FsbHN1YiB0aXBDcmVhdG9yCiAgICBmcmFtZV9kaWcgLTMKICAgICsKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2ODIKICAgIC8vIGNvbnN0IHsgcmVhY3Rpb25saXN0LCByZWFjdGlvbnMgfSA9IHRoaXMubWJyKEJ5dGVzKCcnKSkKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBkdXAKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4My02ODUKICAgIC8vIGNvbnN0IG1ickFtb3VudDogdWludDY0ID0gcmVhY3Rpb25FeGlzdHMKICAgIC8vICAgPyByZWFjdGlvbmxpc3QKICAgIC8vICAgOiByZWFjdGlvbnMgKyByZWFjdGlvbmxpc3QKICAgIGJ6IGNyZWF0ZVJlYWN0aW9uX3Rlcm5hcnlfZmFsc2VANgoKY3JlYXRlUmVhY3Rpb25fdGVybmFyeV9tZXJnZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4Ny02OTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyQW1vdW50ICsgZXh0cmEKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5MAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2ODctNjk0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ickFtb3VudCArIGV4dHJhCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5MQogICAgLy8gYW1vdW50OiBtYnJBbW91bnQgKyBleHRyYQogICAgdW5jb3ZlciAyCiAgICBmcmFtZV9kaWcgMwogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4Ny02OTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyQW1vdW50ICsgZXh0cmEKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjM0CiAgICAvLyBpZiAocmVhY3Rpb25FeGlzdHMpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBieiBjcmVhdGVSZWFjdGlvbl9lbHNlX2JvZHlAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYzNQogICAgLy8gdGhpcy5yZWFjdGlvbnMocmVhY3Rpb25LZXkpLnZhbHVlICs9IDEKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgYm94X3B1dAoKY3JlYXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0MAogICAgLy8gdGhpcy5yZWFjdGlvbmxpc3QocmVhY3Rpb25MaXN0S2V5KS5jcmVhdGUoKQogICAgZnJhbWVfZGlnIDAKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIHJldHN1YgoKY3JlYXRlUmVhY3Rpb25fZWxzZV9ib2R5QDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnJlYWN0aW9ucyhyZWFjdGlvbktleSkudmFsdWUgPSAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgZnJhbWVfZGlnIDEKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIGIgY3JlYXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzCgpjcmVhdGVSZWFjdGlvbl90ZXJuYXJ5X2ZhbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njg1CiAgICAvLyA6IHJlYWN0aW9ucyArIHJlYWN0aW9ubGlzdAogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIGIgY3JlYXRlUmVhY3Rpb25fdGVybmFyeV9tZXJnZUA3CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52YWxpZGF0ZVRpcCh0aXA6IHVpbnQ2NCwgYWN0aW9uOiBieXRlcykgLT4gdm9pZDoKdmFsaWRhdGVUaXA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjQzCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlVGlwKHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCBhY3Rpb246IFRpcEFjdGlvbikgewogICAgcHJvdG8gMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjQ0CiAgICAvLyBjb25zdCBha3RhID0gQXNzZXQoZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjY5CiAgICAvLyBjb25zdCBbc29jaWFsRmVlc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMpKQogICAgc3dhcAogICAgYnl0ZWMgMTAgLy8gInNvY2lhbF9mZWVzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0NQogICAgLy8gY29uc3QgeyBwb3N0RmVlLCByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0Ni02NTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICB0aXAsCiAgICAvLyAgIHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IChhY3Rpb24gPT09IFRpcEFjdGlvblBvc3QpID8gcG9zdEZlZSA6IHJlYWN0RmVlCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1RSQU5TRkVSCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTIKICAgIGd0eG5zIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDYtNjU0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgdGlwLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAoYWN0aW9uID09PSBUaXBBY3Rpb25Qb3N0KSA/IHBvc3RGZWUgOiByZWFjdEZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgWGZlckFzc2V0CiAgICB1bmNvdmVyIDQKICAgID09CiAgICAmJgogICAgZnJhbWVfZGlnIC0yCiAgICBndHhucyBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY1MQogICAgLy8gYXNzZXRBbW91bnQ6IChhY3Rpb24gPT09IFRpcEFjdGlvblBvc3QpID8gcG9zdEZlZSA6IHJlYWN0RmVlCiAgICBmcmFtZV9kaWcgLTEKICAgIGJ5dGVjIDE0IC8vIDB4MGEKICAgID09CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDYtNjU0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgdGlwLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAoYWN0aW9uID09PSBUaXBBY3Rpb25Qb3N0KSA/IHBvc3RGZWUgOiByZWFjdEZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCB0cmFuc2ZlcgogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52YWxpZGF0ZVBvc3RQYXltZW50KG1iclBheW1lbnQ6IHVpbnQ2NCwgY2lkOiBieXRlcywgaXNBbWVuZG1lbnQ6IHVpbnQ2NCwgZXh0cmFBbW91bnQ6IHVpbnQ2NCkgLT4gdm9pZDoKdmFsaWRhdGVQb3N0UGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NTctNjYyCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlUG9zdFBheW1lbnQoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGlzQW1lbmRtZW50OiBib29sZWFuLAogICAgLy8gICBleHRyYUFtb3VudDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgcHVzaGJ5dGVzICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjMKICAgIC8vIGNvbnN0IHsgcG9zdHMsIHZvdGVzLCB2b3RlbGlzdCB9ID0gdGhpcy5tYnIoY2lkKQogICAgZnJhbWVfZGlnIC0zCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGR1cAogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGludGNfMyAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjQKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHN3YXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjk0CiAgICAvLyBjb25zdCBha2l0YUFzc2V0c0J5dGVzID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMpKVswXQogICAgZHVwCiAgICBieXRlYyA4IC8vICJha2l0YV9hc3NldHMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU0OAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPLCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTQ5CiAgICAvLyBjb25zdCByZWZlcnJlciA9IHJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXQpCiAgICBjYWxsc3ViIHJlZmVycmVyT3JaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTEKICAgIC8vIGlmIChyZWZlcnJlciA9PT0gR2xvYmFsLnplcm9BZGRyZXNzKSB7CiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgID09CiAgICBieiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTIKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAoKdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmFsRmVlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY2CiAgICAvLyBsZXQgYW1vdW50OiB1aW50NjQgPSBwb3N0cyArIHZvdGVzICsgdm90ZWxpc3QgKyByZWZlcnJhbEZlZUFtb3VudCArIGV4dHJhQW1vdW50CiAgICBmcmFtZV9kaWcgMgogICAgZnJhbWVfZGlnIDMKICAgICsKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICArCiAgICBmcmFtZV9kaWcgLTEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjcKICAgIC8vIGlmIChpc0FtZW5kbWVudCkgewogICAgZnJhbWVfZGlnIC0yCiAgICBieiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY2OAogICAgLy8gYW1vdW50ICs9IEFtZW5kbWVudE1CUgogICAgaW50YyA1IC8vIDEzMjAwCiAgICArCgp2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NzEtNjc4CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY3NAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NzEtNjc4CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgQW1vdW50CiAgICB1bmNvdmVyIDIKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgcmV0c3ViCgp2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGZyYW1lX2RpZyA1CiAgICBieXRlYyAyMSAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0ODIKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLnJld2FyZHMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ4MwogICAgLy8gbGV0IGNvc3Q6IHVpbnQ2NCA9IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9uc0xlbmd0aCkKICAgIGludGMgOSAvLyA2MDYwMAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ4NQogICAgLy8gaWYgKGFzc2V0ICE9PSAwICYmICFBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBmcmFtZV9kaWcgNgogICAgYnogdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pZl9lbHNlQDgKICAgIGZyYW1lX2RpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgNgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGJueiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0ODMKICAgIC8vIGxldCBjb3N0OiB1aW50NjQgPSBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnNMZW5ndGgpCiAgICBpbnRjIDkgLy8gNjA2MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDg2CiAgICAvLyBjb3N0ICs9IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICArCiAgICBmcmFtZV9idXJ5IDAKCnZhbGlkYXRlUG9zdFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA4OgogICAgZnJhbWVfZGlnIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjUKICAgIC8vIGNvbnN0IHJlZmVycmFsRmVlQW1vdW50ID0gcmVmZXJyYWxGZWUodGhpcy5ha2l0YURBTy52YWx1ZSwgYWt0YSkKICAgIGIgdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmFsRmVlQDkKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbjogYnl0ZXMsIGluaXRpYWxpemVkOiB1aW50NjQsIHdhbGxldDogdWludDY0LCBhdXRvbWF0ZWQ6IHVpbnQ2NCkgLT4gdm9pZDoKY3JlYXRlRGVmYXVsdE1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njk3CiAgICAvLyBwcml2YXRlIGNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbjogQWNjb3VudCwgaW5pdGlhbGl6ZWQ6IGJvb2xlYW4sIHdhbGxldDogdWludDY0LCBhdXRvbWF0ZWQ6IGJvb2xlYW4pOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMgogICAgLy8gc3RhcnREYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMwogICAgLy8gbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5OC03MTAKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlID0gewogICAgLy8gICBpbml0aWFsaXplZCwKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBzdHJlYWs6IDEsCiAgICAvLyAgIHN0YXJ0RGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZm9sbG93ZXJJbmRleDogMCwKICAgIC8vICAgZm9sbG93ZXJDb3VudDogMCwKICAgIC8vICAgYXV0b21hdGVkLAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IDAsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IDAsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IDAKICAgIC8vIH0KICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtMwogICAgc2V0Yml0CiAgICBmcmFtZV9kaWcgLTIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMQogICAgLy8gc3RyZWFrOiAxLAogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2OTgtNzEwCiAgICAvLyB0aGlzLm1ldGEob3JpZ2luKS52YWx1ZSA9IHsKICAgIC8vICAgaW5pdGlhbGl6ZWQsCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgc3RyZWFrOiAxLAogICAgLy8gICBzdGFydERhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGxhc3RBY3RpdmU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZvbGxvd2VySW5kZXg6IDAsCiAgICAvLyAgIGZvbGxvd2VyQ291bnQ6IDAsCiAgICAvLyAgIGF1dG9tYXRlZCwKICAgIC8vICAgZm9sbG93R2F0ZUlEOiAwLAogICAgLy8gICBhZGRyZXNzR2F0ZUlEOiAwLAogICAgLy8gICBkZWZhdWx0UGF5V2FsbElEOiAwCiAgICAvLyB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MDQKICAgIC8vIGZvbGxvd2VySW5kZXg6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5OC03MTAKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlID0gewogICAgLy8gICBpbml0aWFsaXplZCwKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBzdHJlYWs6IDEsCiAgICAvLyAgIHN0YXJ0RGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZm9sbG93ZXJJbmRleDogMCwKICAgIC8vICAgZm9sbG93ZXJDb3VudDogMCwKICAgIC8vICAgYXV0b21hdGVkLAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IDAsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IDAsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtMQogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtNAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njk4LTcxMAogICAgLy8gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUgPSB7CiAgICAvLyAgIGluaXRpYWxpemVkLAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIHN0cmVhazogMSwKICAgIC8vICAgc3RhcnREYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBsYXN0QWN0aXZlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBmb2xsb3dlckluZGV4OiAwLAogICAgLy8gICBmb2xsb3dlckNvdW50OiAwLAogICAgLy8gICBhdXRvbWF0ZWQsCiAgICAvLyAgIGZvbGxvd0dhdGVJRDogMCwKICAgIC8vICAgYWRkcmVzc0dhdGVJRDogMCwKICAgIC8vICAgZGVmYXVsdFBheVdhbGxJRDogMAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZChhY2NvdW50OiBieXRlcykgLT4gdWludDY0OgpzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODAtMTE4MQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICAvLyBpc0Jhbm5lZChhY2NvdW50OiBBY2NvdW50KTogYm9vbGVhbiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTgyLTExODUKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbE1vZGVyYXRpb24ucHJvdG90eXBlLmlzQmFubmVkPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTgzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24sCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4Mi0xMTg1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxNb2RlcmF0aW9uLnByb3RvdHlwZS5pc0Jhbm5lZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24sCiAgICAvLyAgIGFyZ3M6IFthY2NvdW50XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDI4IC8vIG1ldGhvZCAiaXNCYW5uZWQoYWRkcmVzcylib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyKHJlZjogYnl0ZXMpIC0+IGJ5dGVzOgpzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE0CiAgICAvLyBtYnIocmVmOiBieXRlcyk6IEFraXRhU29jaWFsTUJSRGF0YSB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxOAogICAgLy8gcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgcHVzaGludCA0MDAgLy8gNDAwCiAgICAqCiAgICBwdXNoaW50IDQwMTAwIC8vIDQwMTAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDdiZDQwMDAwMDAwMDAwMDAzZDU0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxOQogICAgLy8gdm90ZXM6IFZvdGVzTUJSLAogICAgcHVzaGludCAxOTMwMCAvLyAxOTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE1LTI3CiAgICAvLyByZXR1cm4gewogICAgLy8gICBmb2xsb3dzOiBGb2xsb3dzTUJSLAogICAgLy8gICBibG9ja3M6IEJsb2Nrc01CUiwKICAgIC8vICAgcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICAvLyAgIHZvdGVzOiBWb3Rlc01CUiwKICAgIC8vICAgdm90ZWxpc3Q6IFZvdGVsaXN0TUJSLAogICAgLy8gICByZWFjdGlvbnM6IFJlYWN0aW9uc01CUiwKICAgIC8vICAgcmVhY3Rpb25saXN0OiBSZWFjdGlvbmxpc3RNQlIsCiAgICAvLyAgIG1ldGE6IE1ldGFNQlIsCiAgICAvLyAgIG1vZGVyYXRvcnM6IE1vZGVyYXRvcnNNQlIsCiAgICAvLyAgIGJhbm5lZDogQmFubmVkTUJSLAogICAgLy8gICBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoyMQogICAgLy8gcmVhY3Rpb25zOiBSZWFjdGlvbnNNQlIsCiAgICBwdXNoaW50IDIyMTAwIC8vIDIyMTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjIyCiAgICAvLyByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIHB1c2hpbnQgMTg5MDAgLy8gMTg5MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxNS0yNwogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgZm9sbG93czogRm9sbG93c01CUiwKICAgIC8vICAgYmxvY2tzOiBCbG9ja3NNQlIsCiAgICAvLyAgIHBvc3RzOiBNaW5Qb3N0c01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIHJlZi5sZW5ndGgpLAogICAgLy8gICB2b3RlczogVm90ZXNNQlIsCiAgICAvLyAgIHZvdGVsaXN0OiBWb3RlbGlzdE1CUiwKICAgIC8vICAgcmVhY3Rpb25zOiBSZWFjdGlvbnNNQlIsCiAgICAvLyAgIHJlYWN0aW9ubGlzdDogUmVhY3Rpb25saXN0TUJSLAogICAgLy8gICBtZXRhOiBNZXRhTUJSLAogICAgLy8gICBtb2RlcmF0b3JzOiBNb2RlcmF0b3JzTUJSLAogICAgLy8gICBiYW5uZWQ6IEJhbm5lZE1CUiwKICAgIC8vICAgYWN0aW9uczogQWN0aW9uc01CUgogICAgLy8gfQogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjIzCiAgICAvLyBtZXRhOiBNZXRhTUJSLAogICAgcHVzaGludCA0NTMwMCAvLyA0NTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE1LTI3CiAgICAvLyByZXR1cm4gewogICAgLy8gICBmb2xsb3dzOiBGb2xsb3dzTUJSLAogICAgLy8gICBibG9ja3M6IEJsb2Nrc01CUiwKICAgIC8vICAgcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICAvLyAgIHZvdGVzOiBWb3Rlc01CUiwKICAgIC8vICAgdm90ZWxpc3Q6IFZvdGVsaXN0TUJSLAogICAgLy8gICByZWFjdGlvbnM6IFJlYWN0aW9uc01CUiwKICAgIC8vICAgcmVhY3Rpb25saXN0OiBSZWFjdGlvbmxpc3RNQlIsCiAgICAvLyAgIG1ldGE6IE1ldGFNQlIsCiAgICAvLyAgIG1vZGVyYXRvcnM6IE1vZGVyYXRvcnNNQlIsCiAgICAvLyAgIGJhbm5lZDogQmFubmVkTUJSLAogICAgLy8gICBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICAvLyB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjI2CiAgICAvLyBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICBwdXNoaW50IDI5NzAwIC8vIDI5NzAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLnBheVdhbGxNYnIocGF5d2FsbDogYnl0ZXMpIC0+IHVpbnQ2NCwgYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5wYXlXYWxsTWJyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjMwCiAgICAvLyBwYXlXYWxsTWJyKHBheXdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgcHJvdG8gMSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzMKICAgIC8vIFBheVdhbGxQYXlPcHRpb25TaXplICogKHBheXdhbGwuYWdlbnRQYXlJbmZvLmxlbmd0aCArIHBheXdhbGwudXNlclBheUluZm8ubGVuZ3RoKQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDIgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgdW5jb3ZlciAzCiAgICBzdWJzdHJpbmczCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMi0zNAogICAgLy8gQm94Q29zdFBlckJ5dGUgKiAoCiAgICAvLyAgIFBheVdhbGxQYXlPcHRpb25TaXplICogKHBheXdhbGwuYWdlbnRQYXlJbmZvLmxlbmd0aCArIHBheXdhbGwudXNlclBheUluZm8ubGVuZ3RoKQogICAgLy8gKQogICAgcHVzaGludCA2ODAwIC8vIDY4MDAKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMQogICAgLy8gcmV0dXJuIE1pblBheVdhbGxNQlIgKyAoCiAgICBwdXNoaW50IDUyMDAgLy8gNTIwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjMxLTM1CiAgICAvLyByZXR1cm4gTWluUGF5V2FsbE1CUiArICgKICAgIC8vICAgQm94Q29zdFBlckJ5dGUgKiAoCiAgICAvLyAgICAgUGF5V2FsbFBheU9wdGlvblNpemUgKiAocGF5d2FsbC5hZ2VudFBheUluZm8ubGVuZ3RoICsgcGF5d2FsbC51c2VyUGF5SW5mby5sZW5ndGgpCiAgICAvLyAgICkKICAgIC8vICkKICAgICsKICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzKGFraXRhREFPOiB1aW50NjQsIGNyZWF0b3I6IGJ5dGVzLCB3YWxsZXQ6IHVpbnQ2NCkgLT4gYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1MQogICAgLy8gY2hlY2tUaXBNYnJSZXF1aXJlbWVudHMoYWtpdGFEQU86IEFwcGxpY2F0aW9uLCBjcmVhdG9yOiBBY2NvdW50LCB3YWxsZXQ6IEFwcGxpY2F0aW9uKTogdGlwTUJSSW5mbyB7CiAgICBwcm90byAzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1MgogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKGFraXRhREFPKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTQKICAgIC8vIGlmICghY3JlYXRvci5pc09wdGVkSW4oYWt0YSkgJiYgd2FsbGV0LmlkICE9PSAwKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzX2FmdGVyX2lmX2Vsc2VANQogICAgZnJhbWVfZGlnIC0xCiAgICBieiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHNfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzktNDgKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfY2FuQ2FsbD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgLy8gICAgIHRydWUsCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgICcnLAogICAgLy8gICAgIG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KCkKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDE3IC8vICJwYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NDIKICAgIC8vIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgZXh0cmFjdCAwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0NAogICAgLy8gR2xvYmFsLnplcm9BZGRyZXNzLAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzktNDgKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfY2FuQ2FsbD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgLy8gICAgIHRydWUsCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgICcnLAogICAgLy8gICAgIG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KCkKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDQ3MjdhZjIxIC8vIG1ldGhvZCAiYXJjNThfY2FuQ2FsbCh1aW50NjQsYm9vbCxhZGRyZXNzLHN0cmluZyxieXRlWzRdKWJvb2wiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0MwogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjQ1CiAgICAvLyAnJywKICAgIGJ5dGVjIDExIC8vIDB4MDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0NgogICAgLy8gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oKQogICAgYnl0ZWMgMjMgLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjM5LTQ4CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2NhbkNhbGw+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBnZXRQbHVnaW5BcHBMaXN0KGFraXRhREFPKS5vcHRpbiwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICAnJywKICAgIC8vICAgICBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPigpCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1NgogICAgLy8gaWYgKGNhbkNhbGxBcmM1OE9wdEluKSB7CiAgICBieiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHNfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTkKICAgIC8vIGFyYzU4OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjU3LTYwCiAgICAvLyByZXR1cm4gewogICAgLy8gICB0eXBlOiBUaXBTZW5kVHlwZUFSQzU4LAogICAgLy8gICBhcmM1ODogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyB9CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTgKICAgIC8vIHR5cGU6IFRpcFNlbmRUeXBlQVJDNTgsCiAgICBieXRlYyA2IC8vIDB4MTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1Ny02MAogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgdHlwZTogVGlwU2VuZFR5cGVBUkM1OCwKICAgIC8vICAgYXJjNTg6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgLy8gfQogICAgc3dhcAogICAgY29uY2F0CiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50c19hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NjQtNjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIHR5cGU6IFRpcFNlbmRUeXBlRGlyZWN0LAogICAgLy8gICBhcmM1ODogMAogICAgLy8gfQogICAgcHVzaGJ5dGVzIDB4MGEwMDAwMDAwMDAwMDAwMDAwCiAgICByZXRzdWIK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAKAAEIIKCNBpBn1PcBgM6JHoCjBbjZAyYiCWFraXRhX2RhbwQVH3x1AXABABJjb250cm9sbGVkX2FkZHJlc3MBbQEUDGFraXRhX2VzY3Jvdwxha2l0YV9hc3NldHMDc2FsC3NvY2lhbF9mZWVzAgAABndhbGxldAGAAQoBAwFvA3BhbAEBAXcKcGF5d2FsbF9pZANhYWwCAAEEaDXjvAFhAQIBcgQn47tPBIQmnHgHdmVyc2lvbgRYL/OCBGzD9gYJAAAAAAAAAAAAAgA9MRhAAAQnFCNngATqkYDdNhoAjgEBADEZFEQxGEEA6YIRBIPxR0gEcQW7rQS6S/eUBNXI57gEL/wxIwRyck+WBByZ/p4EAukmMQSE+kteBG59QsIE0OWxjQRuW3cCBOrG2SIEhWQz6gTQnz3oBGmk75cEMwazKiccgg4E6GmTTQTm5nvcBHOepwsEwCK+iQSYS671BPTqUOQE0gIFwgTxNgDRBJLm3TsEoTSieAQ0QXXwBB6tIKkEM+kslASFTe3gNhoAjiAC8gRXBPEF4QbtB9kJFQo4Ct8LlgxNDOUNfQ25DvAPMg/eEBkQLxBBEFsQbxCIEKAQ6RGaEb8R2RHnEgoSXAABACNDgASIyUD4NhoAjgECsAAxGYEEEjEYEERCEguKAQGL/xUiSwEPIksCTwJNgRBLAg+BEE8DTwJNi/9OAlJJFYEQEkSJigMBi/0jDUEAE4v9IwmL/4v+CQuB6AcKi/9MCYkjQv/tigEBMgOL/0AABIsATImL/4AIcmVmZXJyZXJlSEL/7YoCAYv+gANvYWxlSIEYW7GABDwabzOyGov/shqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBAghMFRJEVwYASRVJQQAHiwEkE0EABCKMAImLABdC//eKBAGL/DgYi/0nFWVIgShbEkEAOov8OBlAADOL/DgbgQQSQQApi/wiwhqABEOSJlUSQQAai/wjwhqL/hJBAA+L/IECwhqL/xYSQQACI4kiiYoDASKAAEcEi/0xAIj/SYj/J4v/QQFziwYyAxNBAWuL/YALd2FsbGV0X2ZlZXNlSCRbSSEEDkSL/x0hBJdJjANAAAiL/0EAAyOMAzIHjAUyB4GA9SQIjAKLAxaLBkxQJxZMUEmMAIv9JxVlSCRbjAQiWYHUxQELgeSTAgiMAYv+QABfsYsESXIIRIsBiwMIsgiyByOyECKyAbaLBRaLAhaABHt9xfyyGkyyGrIaiwCyGrIYgQayECKyAbO3AT5JVwQATFcABCkSREkVJBJEFxaLARZQVwgIi/+LAwkWTFCMAImLBHIIRIv+cABFAUEAZ4sBsYsESXIIRIsBsgiyByOyECKyAbZJcghEi/6yEYsDshKyFIEEshAisgG2iwUWiwIWgASvGhTyshpMshqyGosAshqyGIEGshAisgGztwI+SVcEAExXAAQpEkRJFSQSRBdMjAFC/3iLATIQCLGLBElyCEQyELIIsgcjshAisgG2i/4WgAQ5Tq6yshqyGrIYgQayECKyAbNC/2iL/xYiFlCMAIk2GgFJIlmBAghLARUSRFcCADYaAkkVJBJEFzYaA0kVJBJEFycdTwNnKE8CZycHTGcjQ4AARwMiKGVEJwhlSCJbSTIKSwFwAEUBFESxMgpLAbIRIrISshSBBLIQIrIBsyInB2VEcghETHAARQFAASYiKGVMSU4CRQdESScMZUhJTgJFBEknEWVISVcICEwkW0UGTCcMZUixgASiQD3fshqAEAABAAIACnJldl9zb2NpYWyyGrIYgQayECKyAbO0PklXBABLAVcABCkSREkiWYEJC4ECCEwVEkRXBgkiW0lEIicHZURMSwESRLEnHrIaTLIaJw2yGoAMAApyZXZfc29jaWFsshqACgABAAAAAAAAAACyGicLshpMshiBBrIQIrIBcghEIkUFSwFwAEUBQAAaSwSADnJldmVudWVfc3BsaXRzZUgiWSMIRQQyEEsEC7YiJwdlRHIIRLIHsggjshAisgG2SwFJFksCFicWTFAnF7IaTLIaJw2yGrIaSwOyGIEGshAisgG2Jx+yGrIYgQayECKyAbMjQzEWgQIJSTgQIxJEMRYjCUk4EIEEEkQ2GgFJFSQSRBc2GgJJFYEYEkQ2GgNJFYEkEkQ2GgRJFSQSRBc2GgVJFSMSRCJTNhoGSRUkEkQXIihlRDEAiPvYSTEATEAANEkyB0sJSU4CCYE8DkQWSwFMUEsIUAFLCicOiBbMJa9MTwJLDEsJSwlLCUsJK08IiBMCI0NLAScEZUhC/8QxFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSRWBJBJENhoCSRUlEkQiKGVEMQCI+2RJMQBMQACwSSpLBElOAlBJvUUBREm+SExJIiW6SwKBO1lLAxVPBE4CUlcCAEsCgSgkuhdLA4EwI7oiU0sEgTEkuhdLBYE6I7pLCicEZUhPBhJESYgNAhRESwRMiA0RFERPBklOA0sHUEsLSU4EUAEnGEsBUE8HTFBLB75ESwEVFlcGAk8CUEsBgTtZTwIiTwJYTFBLB7xITwdMv0sLJw6IFdpPBEsMTwVPBk8GTwYnGU8IiBISI0NLAScEZUhC/0gxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSRUkEkQXNhoCSRWBGBJENhoDSRWBJBJENhoESSJZgQIISwEVEkRXAgA2GgVJFSMSRDYaBkkVJBJEFzYaB0kVIxJEIlM2GghJFSQSRBciKGVEMQCI+ilJMQBMQACBSUsGSwiIDGJJVwAgTFcgIDIHSw1JTgQJgTwORCpLAlBJvUUBREkiJbpMgSgkuhciKGVESxFMTwdJTgRPA4j6OkRLAjIDEk8DTwNPAk1LAkyID65PAxZLAkxQSwxQAUsPJwaIFNUlr0xPA0sRTwRLDU8GSwtLC0sLJxJPCogSCSNDSwEnBGVIQv93MRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVJBJEFzYaAkkVgRgSRDYaA0kVgSQSRDYaBEkiWYECCEsBFRJEVwIANhoFSRUjEkQ2GgZJFSQSRBc2GgdJFSMSRCJTNhoISRUkEkQXIihlRDEAiPkpSTEATEAAbUlLBksIiAtiSVcAIExXICAyB0sNSU4ECYE8DkQqSwJQSb1FAURJIiW6TIEoJLoXFERLATIDEk1LAUyIDsRPAhZPA0lPAlBLDFABSw4nBogT6SWvTE8CSxBPBEsNTwZLC0sLSwsnEk8KiBEdI0NLAScEZUhC/4sxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSRWBJBJENhoCSRUlEkQiKGVEMQCI+HBJMQBMQADwSSpLBElOAlBJvUUBREm+SExJIiW6SwKBO1lLAxVPBE4CUlcCAEsCgSgkuhdLA4EwI7oiU0sEgTEkuhdLBYE6I7pLCicEZUhPBhJESYgKDkRLBEyICh4URE8GSU4ESwdQSwtJTgVQAScYSwFQSwdMUEsIvkRLARUWVwYCTwJQSwGBO1lPAiJPAlhMUEsIvEhPCEy/SwYVgSRLAQ+BJEsCTwJNgURLAg+BRE8DTwJNTwhOAlJJFSUSRCpLAVCBKCS6FyIoZURLDkxLCU8DiPfyREsNJwaIEqpMTwZLDiJPCE8FTwhPCE8IJw9PCogP4SNDSwEnBGVIQv8IMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVgSQSRDYaAkkVJRJEIihlRDEAiPdASTEATEAA40kqSwRJTgJQSb1FAURJvkhMSSIluksCgTtZSwMVTwROAlJXAgBLAoEoJLoXSwOBMCO6IlNLBIExJLoXSwWBOiO6SwonBGVITwYSREmICN5ESwRMiAjuFERPBklOBEsHUEsLSU4EUAEnGEsBUEsHTFBLCL5ESwEVFlcGAk8CUEsBgTtZTwIiTwJYTFBLCLxITwhMv0sGFYEkSwEPgSRLAk8CTYFESwIPgURPA08CTU8ITgJSSRUlEkQqSwFQgSgkuhcUREsMJwaIEYdMTwZLDSJPB08FTwhPCE8IJw9PCogOviNDSwEnBGVIQv8VIkcCMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkiWYECCEsBFRJEVwIANhoCSRUjEkQ2GgNJFSMSRCJTIihlRDEAiPYISTEATEAAUElFCUsDSUsGiAg+SVcAIEUKVyAgRQsnDhJBAA4qSwhQSb1FAUQiJbpFCksHSUsLiAuuSwcnBogQ4IAAiBINJVsISwpLCU8CTwNLBogO9yNDSwEnBGVIRQlC/6gigAAxFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSRUlEkQ2GgJJFSMSRCJTIihlRDEAiPVySTEATEAAcUlFCEsHiPT7SwRJTgKI9PNLARWBEBJESRWBEBJEUCcQTFBJvUUBREm+SEkiW0yBQFMUSUULTwNMTwKIC6e8SEsCQAAXsYAAiBFrJVuyCEsHsgcjshAisgGzI0NLBCcGiBAhSwdLBiJLBksKiA5BQv/oSwEnBGVIRQhC/4cxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSSJZgQIISwEVEkRXAgA2GgJJFSMSRDYaA0kVJBJEFyIoZUQxAIj0okkxAExAAFpJSwRLBogG20lXACBMVyAgKksCUEm9RQFESSIlukyBKCS6FyIoZURLC0xPBklOBE8DiPS/REsCMgMSTwNPA08CTUsCTIgKM0sJJwaID2VLCkxPA0sGiA55I0NLAScEZUhC/54xFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSSJZgQIISwEVEkRXAgA2GgJJFSMSRDYaA0kVJBJEFyIoZUQxAIjz90kxAExAAEdJSwRLBogGMElXACBMVyAgKksCUEm9RQFESSIlukyBKCS6FxRESwEyAxJNSwFMiAmeSwgnBogO0E8CSwlPAk8DSwaIDeEjQ0sBJwRlSEL/sTYaAUkVJRJENhoCSRUkEkQXIihlRDEAiPOGSTEATEAAbklJiA+OFEQqSwVJTgNQSb1FAUQiJbpLAYgGaBRESYjy9ksCiPLxSwEVgRASREkVgRASRFBLBRZMSwFQgAFlTFBJvUUBRE8DTwJQJxpMUEm+RBcjCRa/vEixgACID2uBMFuyCLIHI7IQIrIBsyNDSwEnBGVIQv+KNhoBSRUlEkQ2GgJJFSMSRCJTMQAiKGVEJwllSIEYW3IIRBJEKk8CUEm9RQFESYE5I7oiTwNUgTlMuyNDIoAAMRYjCUk4ECMSRDYaAUcCFSUSRDYaAkkVIxJEIlNMNhoDSU4CFSQSRDYaBElOAhUkEkQ2GgVJTgIVJBJEIihlREyI8oVJQADiSwVFCTEASwYSJwVLClC9RQEUREsHSTgHMgoSTDgIgACIDqiBOFshBggSEEQiKGVEJwllSIEQW0lFCrFyCEQhBrIIsgcjshAisgGzSwVBAEJLCUlPAksDI4gOArEiFicbshpMshpJshpJshqyGksHshiBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJEIhYpTFCwI0NLCUlPAksDIogNwLEiKGVEJwllSIEQWycbshpLAbIaSwWyGksEshpLA7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSQSRBdMiATkCEL/qUknBGVIRQlC/xgxFiMJSTgQIxJENhoBSwE4BzIKEk8COAhPAogOEk4CD08CEEQiJxRlREkjCCcUTGcWJxNLAVBJvEhPAr8pTFCwI0M2GgFJFSQSRDYaAkkVJBJENhoDSRUkEkQ2GgRJFSQSRDYaBUkVJBJENhoGRwIVJBJEFyIoZUQxAIjxGEkxAExAAGFJJwVLAVBJvUUBREmBMksMu0mBOksLu0sEFicTTFC9RQFEgUJLBrsiKGVEJwllSIEQW7EnG7IaTLIaSweyGksGshpLBbIashiBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJDSwEnBGVIQv+XNhoBSRUlEkQ2GgJJFSQSRDYaA0kVJBJEMQAiKGVEJwllSCRbcghEEkQnBU8DUEmBIU8Eu4EpTwK7I0M2GgFJFSUSRIgMdSsiTwJUKUxQsCNDNhoBSRUlEkSIA4wWKUxQsCNDNhoBSRUlEkQnBUxQvUUBKyJPAlQpTFCwI0M2GgFJFSUSRCcFTFC+RClMULAjQzYaAUkVJRJEKkxQvUUBKyJPAlQpTFCwI0M2GgFJFSUSRCpMUEm9RQFEvkgpTFCwI0M2GgFJFSUSRCIoZUQxAIjv1EkxAExAAChJiO9hSwOI71xLARWBEBJESRWBEBJEUCcQTFBJvUUBRL5IKUxQsCNDSwEnBGVIQv/QIkcCgAA2GgFHAiJZSU4CJQuBAghMFRJEIihlRDEAiO97STEATEAAfklFBycLRQYiRQVLBEsDDEEAZUsDVwIASwUlCyVYSweI7upMiO7mSwEVgRASREkVgRASRFAnEExQSUUJvUUBQQAgSwe+REsGSU8CUEwiWSMIFlcGAlwARQZLBCMIRQVC/6lLBUknIFBMIlkjCBZXBgJcAEUGQv/hKUsGULAjQ0sBJwRlSEUHQv96NhoBSRUlEkQ2GgJJFSQSRBcWUCcaTFC9RQErIk8CVClMULAjQzYaAUkiWYECCEsBFRJEVwIAiAr8KUxQsCNDNhoBiAtASBYpTFCwI0M2GgFJFSQSRBc2GgJJFSUSRDYaA0kVJBJEF4gLRylMULAjQzYaAUkVJBJEFzEAIihlRCcMZUhyCEQSRCcHTGcjQzYaAUkiWYECCEsBFRJEVwIAMQAiKGVESScMZUhyCERPAhJEJxFlSIEQWzINEkQnHUxnI0M2GgFJFSQSRBcxACIoZUQnDGVIcghEEkQoTGcjQ4oBAYv/JxISQAAIi/8nDxJBAAIjiSKJigIBi/8rEkEAB4Eki/4VDImL/ycSEkEABYFEQv/ui/8nGRJBAAWBREL/4Yv/Jw8SQQAFgWRC/9SBJEL/z4oCASIyA4v+Jw4SQQATi/8VJRJEi/9JFSUSRIsBUIwAiYv+JwYSQQAli/8VJBJEi/8XSXEARESBGK+L/0xQSU4CFSUSRHELTIwBREL/yIv+gAEeEkEAJYv/FSUSRCcFi/9QSYwAvUUBQQAJiwC+RIE6WxREi/9JjAFC/5qL/oABKBJBACaL/xUkEkSL/xdJcgBEFUSBGK+L/0xQSU4CFSUSRHIHTIwBREL/a4v+gAEyEkSL/wEyA4wBQv9aigIBsSIoZUQnCWVIJFuABEMDZo6yGov+shqL/7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSMSRCJTiYoBASKAAEcFJwWL/1BJvUUBQAAEIowAiYsHvkRJgQlbSU4CjAaBEVuMBYE8D0EAgYFkjAQyB4sFCUmMASEHD0EAX4sEgUsIjASAAXaL/1BJjAC9RQFBADSLAL5ESSJbTIFAU4wDgUsLIQQKSYwCgUsNQQAEgUuMAosDQQAaiwSLAg1BAAyLBIsCCYwEiwSMAIkijARC//WLBIsCCIwEQv/riwGBSwshBwqLBAiMBEL/mIsGgWQLgTwKjARC/3aKAQGxIihlRCcJZUiBEFuABPgce2KyGov/shqyGIEGshAisgGztD5JVwQATFcABCkSREkVJBJEF4v/iP8FCImKAwEiSYAARwSL/TIDEkEAKCIoZUQnCGVIIluxIicHZURyCERMshGL/rISshSBBLIQIrIBsyKMAIkijAYnBYv9UEmMAL1FAUEACIsAvkQjW4wGIihlRIv9iwaICCtJVwABTCNbjAMiKGVESScIZUgiW0mMAov/iOvxIluMBCIoZUQnCmVIJFtMJwYSQQCti/8JiwZJJwRlSEwiKGVEJxFlSElXAAhMIluxJx6yGkyyGicNshonC7IaJwuyGicLshpLAbIYgQayECKyAbYyEEsDsgeyCCOyECKyAbZLARaLAklOAxYnFkxQJxeyGkyyGicNshqyGrIYgQayECKyAbYnH7IaTLIYgQayECKyAbYiJwdlRHIIREsBshGLBLISshSBBLIQIrIBtrIRshSyEoEEshAisgGziwOMAImL/wlJjAUiJwdlRHIITIwBREEAN4v9iwJwAEUBQQAssYsCSbIRiwSyEosBshSBBLIQIrIBtrIRiwWyEov9shSBBLIQIrIBsyKMAImLBIsFCLGLArIRshKLAbIUgQSyECKyAbNC/+GKAgEqi/5QSb1FAUAAKzIHFov/TFAiFkxLAVArUExQgAYAAAA9AABQiwBJvEhMv4AAiAY3gRBbTIkiTImKAQAnBYv/UEcCvUUBREm+SEmBEVtMgRlbSU4CTgMyB0lPAgkhCBgJSU4DIQgJMgcWTwOBGU8CuwxBAAojFosAgQlPAruJiwGLAgxBAA+LAEm+RIEJWyMIFoEJTLuJigMAgABHAoABdov9UEm9RQFAAB+L/hSL/xYrIk8DVFBJVwAITIFAUysiTwJUUIsDTL+JiwO+REmBQFOMASJbjAKL/kEABYsBQAAKi/5AAGiLAUAAYyOMAIsCQAAPi/4Ui/8WKyJPA1RQQv+0i/+LAhJBAAqLAEEABScgQv+ii/+LAg2LABBBABOL/4sCCYsBFEwWKyJPA1RQQv+EiwBBAA+LAov/CRYrIosBVFBC/3CLAov/CEL/7iKMAEL/mooEAIv+iOhZi/yI6FRLARWBEBJESRWBEBJEUIv/Fisii/1UUCcQTwJQTL+JigkAIoAAi/iIBKYURIv8QAAFi/1BADCL/EEA44v9QQANi/0WJxNMUL1FAUAAGYv9QADMJwWL+FC+RFdCCCcTTFC9RQFBALgjRIv+JxkSSSEFC4v5i/pLA08DiAOUi/iI/laL+Ij8NYwBKov3UEmMAL1FARREQQCCi/qL/1AyBxaL+ExQi/sWUCsii/xUUIv9FlArUIv+UExJFRZXBgJMUEwnIVBMUIsASbxITL+L9yOLAUlOA4j+Xov3I4v4TwOI/xIiKGVESScIZUgiW0sBJwplSCJbTwJLAk8CiOhnIluxIicHZURyCERPArIRshSyEoEEshAisgGziYv6Qv9+IkL/RYoLACKL9ogDpRREKov6UCIlukmL9oj6hhREi/aI/ZYiKGVEJwplSCRbSwGI+2siKGVEJwplSEmBEFtMgRhbiOcZSSEEDkRLAR0hBJeL/icPEklOAk4ETwNPA08CiPt5i/gITEEAAyEFCIv3i/mLAUlOA08EiAJ3Kov1UEmMAL1FARREQQBQi/mL+lCL/1AyBxaL9kxQi/sWUCsii/xUUIv9FlArUIv+UExJFRZXBgJMUEwnIVBMUIsASbxITL+L9oj61ov1I0sCiP1Ii/Uji/ZPA4j9/ImL+Yv6UEL/sIoFAIv7iALIFEQqi/5QSb1FAUQiJbpHAov7iPmjFESL+4jmMIv+iOYrSwEVgRASREkVgRASRFAnEExQvUUBFESL+xNEJwWL+1C+RIGIA1MURCIoZUQnCGVIIluL+4j8dyIoZUQnCmVISSRbTEmBEFtOAoEYW0yL/0EAS4sASYj6OosCiwOI5fRJIQQORE8CSU8CHSEEl4j6YYv8OAcyChKL/DgIi/1PAwgSEESL+4j6Cov+i/9LAoj8e4v+i/+L+08DiP0uiYv8OAcyChKL/DgIi/0SEESxIicHZURyCESLAbIRshSyEoEEshAisgGzQv+6igUAi/uIAdYURCqL/lBJvUUBRCIlukmL+4j4shREi/uL/3AASESL+4jlN4v+iOUySwEVgRASREkVgRASRFCL/xZMSwFQgAFlTFBJTgO9RQEURIv7iPuOIihlRCcKZUhJJFtLAYEQW08CgRhbSwSI+VhOAojlFIv+FSUSRIv+TwNQJxpMUElOBL1JTgJOBUhOA4j5dIv9CEyAAIgBd0mBMFtOAoEoW04CQQAxi/w4BzIKEov8OAhPAosDCBIQRIsCQQAQiwFJvkQXIwgWv4sAIrlIiSMWiwFMv0L/8YsECEL/yYoCACIoZURJJwhlSCJbTCcKZUhJIltMJFuL/jgUMgoSi/44EU8EEhCL/jgSi/8nDhJPA08ETwJNEhBEiYoEAIAASYv9iADrSYEQW0xJgRhbTCVbIihlTElPAkRJJwhlSCJbTDEAiOSAiOReMgMSQQAmIosCiwMIiwQICIv/CIv+QQADIQUIi/w4BzIKEov8OAhPAhIQRImLBScVZUgkW4wBIQmMAIsGQQAViwFyCESLBnAARQFAAAchCTIQCIwAiwBC/66KBAAyB0krIov9VIv+FlAjFlBPAhZQTBZQIhZMSwFQSwFQKyKL/1RQSwFQSwFQTFAnBYv8UEy/iYoBAbEiKGVEJwllSIEYWyccshqL/7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSMSRCJTiYoBAYv/FYGQAwuBpLkCCBaAEAAAAAAAAHvUAAAAAAAAPVRMUIHklgEWTEsBUExQgdSsARZQgdSTARZMSwFQgfThAhZQSwFQTFCBhOgBFlCJigECi/+BAlmL/xWL/0sCTwJSIlmL/yJZi/9MTwNSIlkIgZA1C4HQKAiL/4mKAwGL/ScIZUgiW4v+THAARQFAAFWL/0EAULGL/ScRZUhXAAgyA4AERyevIbIaTLIaJw2yGrIaJwuyGicXshqL/7IYgQayECKyAbO0PklXBABMVwAEKRJESRUjEkQiU0EACDIQFicGTFCJgAkKAAAAAAAAAACJ\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[1904,2659,2963,3882,4668,4905,5391,5557,5621,5860,6342,6408,6645,7143,7510],\"errorMessage\":\"Box must have value\"},{\"pc\":[652,969,1099,1374,4189,4267,4516,5521,5769,7834,8054],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[7136],\"errorMessage\":\"Cannot vote on your own content\"},{\"pc\":[4457],\"errorMessage\":\"ERR:NOPAYWALL\"},{\"pc\":[3956],\"errorMessage\":\"ERR:NOT_MODERATION\"},{\"pc\":[6704,6977],\"errorMessage\":\"ERR:POST_EXISTS\"},{\"pc\":[1394],\"errorMessage\":\"Escrow does not exist\"},{\"pc\":[2167,2751,3570],\"errorMessage\":\"Gate check failed\"},{\"pc\":[5428],\"errorMessage\":\"Invalid App\"},{\"pc\":[5161],\"errorMessage\":\"Invalid app upgrade\"},{\"pc\":[5335],\"errorMessage\":\"Invalid asset\"},{\"pc\":[4099,7240,7282,7500,7686],\"errorMessage\":\"Invalid payment\"},{\"pc\":[6660],\"errorMessage\":\"Invalid paywall\"},{\"pc\":[823,6916,7209],\"errorMessage\":\"Invalid percentage\"},{\"pc\":[5300,5327,5373,5419],\"errorMessage\":\"Invalid reference length, must be 32 bytes\"},{\"pc\":[5458],\"errorMessage\":\"Invalid reply type\"},{\"pc\":[7595],\"errorMessage\":\"Invalid transfer\"},{\"pc\":[1868],\"errorMessage\":\"Is a reply\"},{\"pc\":[1876,2631,2935],\"errorMessage\":\"Is already amended\"},{\"pc\":[535],\"errorMessage\":\"Length must be 16\"},{\"pc\":[2727,3031,5349,5442],\"errorMessage\":\"Length must be 32\"},{\"pc\":[4074],\"errorMessage\":\"Meta box values already exist\"},{\"pc\":[4434,6273],\"errorMessage\":\"Meta box values dont exist yet\"},{\"pc\":[2623,2927],\"errorMessage\":\"Not a reply\"},{\"pc\":[4570],\"errorMessage\":\"Not the social graph app\"},{\"pc\":[1862,2618,2922],\"errorMessage\":\"Not your post to edit\"},{\"pc\":[232],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[493],\"errorMessage\":\"OnCompletion must be UpdateApplication \\u0026\\u0026 can only call when not creating\"},{\"pc\":[5109,5150,5191],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[1800,2139,2395,3196,3542,3713,3820,3965,4715,7087,7329],\"errorMessage\":\"Post not found\"},{\"pc\":[1684,2130,2386],\"errorMessage\":\"Provided timestamp is too far in the past\"},{\"pc\":[2556,2860],\"errorMessage\":\"Reply not found\"},{\"pc\":[3870,7392],\"errorMessage\":\"This account already reacted to this post with this NFT\"},{\"pc\":[3808,6600,6857,7078,7320],\"errorMessage\":\"This account is banned\"},{\"pc\":[3830,6872,7099,7340],\"errorMessage\":\"This account is blocked by the user\"},{\"pc\":[2407,3042,3725,5396],\"errorMessage\":\"This has a gate\"},{\"pc\":[7149],\"errorMessage\":\"This is an automated account\"},{\"pc\":[7348],\"errorMessage\":\"User does not own this NFT\"},{\"pc\":[1404],\"errorMessage\":\"Wrong escrow for this operation\"},{\"pc\":[3348,4779],\"errorMessage\":\"You haven't voted on this\"},{\"pc\":[7132],\"errorMessage\":\"You've already voted on this post\"},{\"pc\":[1246],\"errorMessage\":\"already opted in\"},{\"pc\":[906,999,1017,1034,1123,1274,1463,1515,3954,4117,4568,5107,5146,5189,5426,5448,5818,6060,6114,6820,7291,7711],\"errorMessage\":\"application exists\"},{\"pc\":[4327],\"errorMessage\":\"assert target is match for conditions\"},{\"pc\":[5334,5355],\"errorMessage\":\"asset exists\"},{\"pc\":[1229,1271,1292,1399,1512,1659,1775,2090,2153,2346,2531,2740,2835,3147,3297,3505,3556,3676,3789,3944,4047,4103,4217,4332,4411,4466,4559,4735,4824,5100,5138,5182,5476,5727,5803,5815,5868,5888,5911,5939,6057,6108,6785,6817,6881,6896,7153,7168,7288,7401,7543,7627,7796],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[4865],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[1387],\"errorMessage\":\"invalid number of bytes for (len+(uint64,bool1)[])\"},{\"pc\":[4820],\"errorMessage\":\"invalid number of bytes for (len+uint8[32][])\"},{\"pc\":[662,2047,2303,3122,3481,3652,5024],\"errorMessage\":\"invalid number of bytes for (len+uint8[])\"},{\"pc\":[1185,5129],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[1644,2075,2331,3141,3291,3936,4010,5526,7839,8059],\"errorMessage\":\"invalid number of bytes for bool8\"},{\"pc\":[974,1104,1196,1205,1608,1635,1654,2014,2066,2085,2270,2322,2341,3500,3671,3784,4023,4033,4043,4193,4272,4365,4373,4381,4389,4397,4406,4520,4545,4553,4989,5058,5075,5093,5175,5774],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[2058,2314,3133,3492,3663],\"errorMessage\":\"invalid number of bytes for uint8\"},{\"pc\":[1618,2024,2280],\"errorMessage\":\"invalid number of bytes for uint8[24]\"},{\"pc\":[1771,2527,2831,3283,3776,3928,4002,4537,4596,4618,4636,4662,4682,4707,4731,4981,5067],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[1627,1763,2033,2289,2519,2823],\"errorMessage\":\"invalid number of bytes for uint8[36]\"},{\"pc\":[3332,3338,3846,3852,4763,4769,4881,4887,5307,6563,6569,7116,7122,7365,7371,7434],\"errorMessage\":\"invalid size\"},{\"pc\":[4911,4939],\"errorMessage\":\"max array length exceeded\"},{\"pc\":[2006,2510,3467],\"errorMessage\":\"transaction type is appl\"},{\"pc\":[1600,1754,1995,2262,2499,2814,3108,3275,3456,3638],\"errorMessage\":\"transaction type is axfer\"},{\"pc\":[1589,1743,1983,2251,2487,2803,3097,3264,3444,3627,3993,4301],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"AkitaSocial\",\"structs\":{\"AkitaSocialMBRData\":[{\"name\":\"follows\",\"type\":\"uint64\"},{\"name\":\"blocks\",\"type\":\"uint64\"},{\"name\":\"posts\",\"type\":\"uint64\"},{\"name\":\"votes\",\"type\":\"uint64\"},{\"name\":\"votelist\",\"type\":\"uint64\"},{\"name\":\"reactions\",\"type\":\"uint64\"},{\"name\":\"reactionlist\",\"type\":\"uint64\"},{\"name\":\"meta\",\"type\":\"uint64\"},{\"name\":\"moderators\",\"type\":\"uint64\"},{\"name\":\"banned\",\"type\":\"uint64\"},{\"name\":\"actions\",\"type\":\"uint64\"}],\"MetaValue\":[{\"name\":\"initialized\",\"type\":\"bool\"},{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"streak\",\"type\":\"uint64\"},{\"name\":\"startDate\",\"type\":\"uint64\"},{\"name\":\"lastActive\",\"type\":\"uint64\"},{\"name\":\"followerIndex\",\"type\":\"uint64\"},{\"name\":\"followerCount\",\"type\":\"uint64\"},{\"name\":\"automated\",\"type\":\"bool\"},{\"name\":\"followGateID\",\"type\":\"uint64\"},{\"name\":\"addressGateID\",\"type\":\"uint64\"},{\"name\":\"defaultPayWallID\",\"type\":\"uint64\"}],\"PostValue\":[{\"name\":\"creator\",\"type\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint64\"},{\"name\":\"gateID\",\"type\":\"uint64\"},{\"name\":\"usePayWall\",\"type\":\"bool\"},{\"name\":\"payWallID\",\"type\":\"uint64\"},{\"name\":\"againstContentPolicy\",\"type\":\"bool\"},{\"name\":\"postType\",\"type\":\"uint8\"},{\"name\":\"ref\",\"type\":\"byte[]\"}],\"ReactionListKey\":[{\"name\":\"user\",\"type\":\"byte[16]\"},{\"name\":\"ref\",\"type\":\"byte[16]\"},{\"name\":\"NFT\",\"type\":\"uint64\"}],\"ReactionsKey\":[{\"name\":\"ref\",\"type\":\"byte[32]\"},{\"name\":\"NFT\",\"type\":\"uint64\"}],\"ViewPayWallValue\":[{\"name\":\"userPayInfo\",\"type\":\"(uint8,uint64,uint64)[]\"},{\"name\":\"agentPayInfo\",\"type\":\"(uint8,uint64,uint64)[]\"}],\"VoteListKey\":[{\"name\":\"user\",\"type\":\"byte[16]\"},{\"name\":\"ref\",\"type\":\"byte[16]\"}],\"VoteListValue\":[{\"name\":\"impact\",\"type\":\"uint64\"},{\"name\":\"isUp\",\"type\":\"bool\"}],\"VotesValue\":[{\"name\":\"voteCount\",\"type\":\"uint64\"},{\"name\":\"isNegative\",\"type\":\"bool\"}],\"tipMBRInfo\":[{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"arc58\",\"type\":\"uint64\"}]},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint64\",\"name\":\"akitaDAOEscrow\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"post\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editPost\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gatedReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"reply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"uint64\",\"name\":\"timestamp\"},{\"type\":\"byte[24]\",\"name\":\"nonce\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"bool\",\"name\":\"usePayWall\"},{\"type\":\"uint64\",\"name\":\"payWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gatedEditReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editReply\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[36]\",\"name\":\"cid\"},{\"type\":\"byte[32]\",\"name\":\"amendment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"vote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"bool\",\"name\":\"isUp\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"editVote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"bool\",\"name\":\"flip\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gatedReact\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"react\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"axfer\",\"name\":\"tip\"},{\"type\":\"byte[]\",\"name\":\"ref\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"deleteReaction\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"setPostFlag\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"bool\",\"name\":\"flagged\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"initMeta\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"address\",\"name\":\"user\"},{\"type\":\"bool\",\"name\":\"automated\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFD\"},{\"type\":\"uint64\",\"name\":\"akitaNFT\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"createPayWall\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"((uint8,uint64,uint64)[],(uint8,uint64,uint64)[])\",\"name\":\"payWall\",\"struct\":\"ViewPayWallValue\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"updateMeta\",\"args\":[{\"type\":\"uint64\",\"name\":\"followGateID\"},{\"type\":\"uint64\",\"name\":\"addressGateID\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFD\"},{\"type\":\"uint64\",\"name\":\"akitaNFT\"},{\"type\":\"uint64\",\"name\":\"defaultPayWallID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"updateFollowerMeta\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"newFollowerIndex\"},{\"type\":\"uint64\",\"name\":\"newFollowerCount\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"isBanned\",\"args\":[{\"type\":\"address\",\"name\":\"account\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getUserSocialImpact\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getMetaExists\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getMeta\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"(bool,uint64,uint64,uint64,uint64,uint64,uint64,bool,uint64,uint64,uint64)\",\"struct\":\"MetaValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getPostExists\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getPost\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(address,uint64,uint64,bool,uint64,bool,uint8,byte[])\",\"struct\":\"PostValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getVote\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(uint64,bool)\",\"struct\":\"VoteListValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getVotes\",\"args\":[{\"type\":\"byte[32][]\",\"name\":\"refs\"}],\"returns\":{\"type\":\"(uint64,bool)[]\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getReactionExists\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"ref\"},{\"type\":\"uint64\",\"name\":\"NFT\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"mbr\",\"args\":[{\"type\":\"byte[]\",\"name\":\"ref\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"AkitaSocialMBRData\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"payWallMbr\",\"args\":[{\"type\":\"((uint8,uint64,uint64)[],(uint8,uint64,uint64)[])\",\"name\":\"paywall\",\"struct\":\"ViewPayWallValue\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"checkTipMbrRequirements\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"address\",\"name\":\"creator\"},{\"type\":\"uint64\",\"name\":\"wallet\"}],\"returns\":{\"type\":\"(uint8,uint64)\",\"struct\":\"tipMBRInfo\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"updateAkitaDAOEscrow\",\"args\":[{\"type\":\"uint64\",\"name\":\"app\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"update\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"akitaDAOEscrow\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZXNjcm93\",\"desc\":\"the app ID for the akita DAO escrow to use\"},\"payWallId\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cGF5d2FsbF9pZA==\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"meta\":{\"keyType\":\"address\",\"valueType\":\"MetaValue\",\"prefix\":\"bQ==\",\"desc\":\"The meta data for each user\"},\"paywall\":{\"keyType\":\"uint64\",\"valueType\":\"ViewPayWallValue\",\"prefix\":\"dw==\",\"desc\":\"Pay wall information for posts\"},\"posts\":{\"keyType\":\"AVMBytes\",\"valueType\":\"PostValue\",\"prefix\":\"cA==\",\"desc\":\"All the posts on the network\"},\"reactionlist\":{\"keyType\":\"ReactionListKey\",\"valueType\":\"AVMBytes\",\"prefix\":\"ZQ==\",\"desc\":\"Who has reacted to what\"},\"reactions\":{\"keyType\":\"ReactionsKey\",\"valueType\":\"uint64\",\"prefix\":\"cg==\",\"desc\":\"Counters for each post to track reactions\"},\"votelist\":{\"keyType\":\"VoteListKey\",\"valueType\":\"VoteListValue\",\"prefix\":\"bw==\",\"desc\":\"User votes and their impact\"},\"votes\":{\"keyType\":\"AVMBytes\",\"valueType\":\"VotesValue\",\"prefix\":\"dg==\",\"desc\":\"Counters for each post to track votes\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMzIgMTAwMDAwIDEzMjAwIDMxNzAwIDYzMDcyMDAwIDg2NDAwIDYwNjAwCiAgICBieXRlY2Jsb2NrICJha2l0YV9kYW8iIDB4MTUxZjdjNzUgInAiIDB4MDAgImNvbnRyb2xsZWRfYWRkcmVzcyIgIm0iIDB4MTQgImFraXRhX2VzY3JvdyIgImFraXRhX2Fzc2V0cyIgInNhbCIgInNvY2lhbF9mZWVzIiAweDAwMDAgIndhbGxldCIgMHg4MCAweDBhIDB4MDMgIm8iICJwYWwiIDB4MDEgInciICJwYXl3YWxsX2lkIiAiYWFsIiAweDAwMDEgMHg2ODM1ZTNiYyAiYSIgMHgwMiAiciIgMHgyN2UzYmI0ZiAweDg0MjY5Yzc4ICJ2ZXJzaW9uIiAweDU4MmZmMzgyIDB4NmNjM2Y2MDYgMHgwMDAwMDAwMDAwMDAwMDAwMDAgMHgwMDNkCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYm56IG1haW5fYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIHBheVdhbGxJZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhU29jaWFsR2xvYmFsU3RhdGVLZXlzUGF5d2FsbElELCBpbml0aWFsVmFsdWU6IDEgfSkKICAgIGJ5dGVjIDIwIC8vICJwYXl3YWxsX2lkIgogICAgaW50Y18xIC8vIDEKICAgIGFwcF9nbG9iYWxfcHV0CgptYWluX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgcHVzaGJ5dGVzIDB4ZWE5MTgwZGQgLy8gbWV0aG9kICJ1cGRhdGUoc3RyaW5nKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBtYWluX3VwZGF0ZV9yb3V0ZUA0CgptYWluX3N3aXRjaF9jYXNlX25leHRANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgYXNzZXJ0IC8vIE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBieiBtYWluX2NyZWF0ZV9Ob09wQDQwCiAgICBwdXNoYnl0ZXNzIDB4ODNmMTQ3NDggMHg3MTA1YmJhZCAweGJhNGJmNzk0IDB4ZDVjOGU3YjggMHgyZmZjMzEyMyAweDcyNzI0Zjk2IDB4MWM5OWZlOWUgMHgwMmU5MjYzMSAweDg0ZmE0YjVlIDB4NmU3ZDQyYzIgMHhkMGU1YjE4ZCAweDZlNWI3NzAyIDB4ZWFjNmQ5MjIgMHg4NTY0MzNlYSAweGQwOWYzZGU4IDB4NjlhNGVmOTcgMHgzMzA2YjMyYSAvLyBtZXRob2QgImluaXQoKXZvaWQiLCBtZXRob2QgInBvc3QocGF5LGF4ZmVyLHVpbnQ2NCxieXRlWzI0XSxieXRlWzM2XSx1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZWRpdFBvc3QocGF5LGF4ZmVyLGJ5dGVbMzZdLGJ5dGVbMzJdKXZvaWQiLCBtZXRob2QgImdhdGVkUmVwbHkocGF5LGF4ZmVyLGFwcGwsdWludDY0LGJ5dGVbMjRdLGJ5dGVbMzZdLGJ5dGVbXSx1aW50OCx1aW50NjQsYm9vbCx1aW50NjQpdm9pZCIsIG1ldGhvZCAicmVwbHkocGF5LGF4ZmVyLHVpbnQ2NCxieXRlWzI0XSxieXRlWzM2XSxieXRlW10sdWludDgsdWludDY0LGJvb2wsdWludDY0KXZvaWQiLCBtZXRob2QgImdhdGVkRWRpdFJlcGx5KHBheSxheGZlcixhcHBsLGJ5dGVbMzZdLGJ5dGVbMzJdKXZvaWQiLCBtZXRob2QgImVkaXRSZXBseShwYXksYXhmZXIsYnl0ZVszNl0sYnl0ZVszMl0pdm9pZCIsIG1ldGhvZCAidm90ZShwYXksYXhmZXIsYnl0ZVtdLHVpbnQ4LGJvb2wpdm9pZCIsIG1ldGhvZCAiZWRpdFZvdGUocGF5LGF4ZmVyLGJ5dGVbMzJdLGJvb2wpdm9pZCIsIG1ldGhvZCAiZ2F0ZWRSZWFjdChwYXksYXhmZXIsYXBwbCxieXRlW10sdWludDgsdWludDY0KXZvaWQiLCBtZXRob2QgInJlYWN0KHBheSxheGZlcixieXRlW10sdWludDgsdWludDY0KXZvaWQiLCBtZXRob2QgImRlbGV0ZVJlYWN0aW9uKGJ5dGVbMzJdLHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJzZXRQb3N0RmxhZyhieXRlWzMyXSxib29sKXZvaWQiLCBtZXRob2QgImluaXRNZXRhKHBheSxhZGRyZXNzLGJvb2wsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IiwgbWV0aG9kICJjcmVhdGVQYXlXYWxsKHBheSwoKHVpbnQ4LHVpbnQ2NCx1aW50NjQpW10sKHVpbnQ4LHVpbnQ2NCx1aW50NjQpW10pKXVpbnQ2NCIsIG1ldGhvZCAidXBkYXRlTWV0YSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJ1cGRhdGVGb2xsb3dlck1ldGEoYWRkcmVzcyx1aW50NjQsdWludDY0KXZvaWQiCiAgICBieXRlYyAyOCAvLyBtZXRob2QgImlzQmFubmVkKGFkZHJlc3MpYm9vbCIKICAgIHB1c2hieXRlc3MgMHhlODY5OTM0ZCAweGU2ZTY3YmRjIDB4NzM5ZWE3MGIgMHhjMDIyYmU4OSAweDk4NGJhZWY1IDB4ZjRlYTUwZTQgMHhkMjAyMDVjMiAweGYxMzYwMGQxIDB4OTJlNmRkM2IgMHhhMTM0YTI3OCAweDM0NDE3NWYwIDB4MWVhZDIwYTkgMHgzM2U5MmM5NCAweDg1NGRlZGUwIC8vIG1ldGhvZCAiZ2V0VXNlclNvY2lhbEltcGFjdChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0TWV0YUV4aXN0cyhhZGRyZXNzKWJvb2wiLCBtZXRob2QgImdldE1ldGEoYWRkcmVzcykoYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCxib29sLHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAiZ2V0UG9zdEV4aXN0cyhieXRlWzMyXSlib29sIiwgbWV0aG9kICJnZXRQb3N0KGJ5dGVbMzJdKShhZGRyZXNzLHVpbnQ2NCx1aW50NjQsYm9vbCx1aW50NjQsYm9vbCx1aW50OCxieXRlW10pIiwgbWV0aG9kICJnZXRWb3RlKGJ5dGVbMzJdKSh1aW50NjQsYm9vbCkiLCBtZXRob2QgImdldFZvdGVzKGJ5dGVbMzJdW10pKHVpbnQ2NCxib29sKVtdIiwgbWV0aG9kICJnZXRSZWFjdGlvbkV4aXN0cyhieXRlWzMyXSx1aW50NjQpYm9vbCIsIG1ldGhvZCAibWJyKGJ5dGVbXSkodWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCkiLCBtZXRob2QgInBheVdhbGxNYnIoKCh1aW50OCx1aW50NjQsdWludDY0KVtdLCh1aW50OCx1aW50NjQsdWludDY0KVtdKSl1aW50NjQiLCBtZXRob2QgImNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzKHVpbnQ2NCxhZGRyZXNzLHVpbnQ2NCkodWludDgsdWludDY0KSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU9Fc2Nyb3codWludDY0KXZvaWQiLCBtZXRob2QgInVwZGF0ZUFraXRhREFPKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcFVwKCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggaW5pdCBwb3N0IGVkaXRQb3N0IGdhdGVkUmVwbHkgcmVwbHkgZ2F0ZWRFZGl0UmVwbHkgZWRpdFJlcGx5IHZvdGUgZWRpdFZvdGUgZ2F0ZWRSZWFjdCByZWFjdCBkZWxldGVSZWFjdGlvbiBzZXRQb3N0RmxhZyBpbml0TWV0YSBjcmVhdGVQYXlXYWxsIHVwZGF0ZU1ldGEgdXBkYXRlRm9sbG93ZXJNZXRhIGlzQmFubmVkIGdldFVzZXJTb2NpYWxJbXBhY3QgZ2V0TWV0YUV4aXN0cyBnZXRNZXRhIGdldFBvc3RFeGlzdHMgZ2V0UG9zdCBnZXRWb3RlIGdldFZvdGVzIGdldFJlYWN0aW9uRXhpc3RzIG1iciBwYXlXYWxsTWJyIGNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzIHVwZGF0ZUFraXRhREFPRXNjcm93IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAzOAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMzg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEA0MDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNwogICAgLy8gZXhwb3J0IGNsYXNzIEFraXRhU29jaWFsIGV4dGVuZHMgY2xhc3NlcyhCYXNlU29jaWFsLCBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCkgewogICAgcHVzaGJ5dGVzIDB4ODhjOTQwZjggLy8gbWV0aG9kICJjcmVhdGUoc3RyaW5nLHVpbnQ2NCx1aW50NjQpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgptYWluX3VwZGF0ZV9yb3V0ZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OmIxNihiOiBieXRlcykgLT4gYnl0ZXM6CmIxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMwogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIGIxNihiOiBieXRlcyk6IGJ5dGVzPDE2PiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNAogICAgLy8gcmV0dXJuIGIuc2xpY2UoMCwgMTYpLnRvRml4ZWQoeyBsZW5ndGg6IDE2IH0pCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgaW50Y18wIC8vIDAKICAgIGRpZyAxCiAgICA+PQogICAgaW50Y18wIC8vIDAKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBmcmFtZV9kaWcgLTEKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDE2CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjppbXBhY3RSYW5nZShpbXBhY3Q6IHVpbnQ2NCwgbWluOiB1aW50NjQsIG1heDogdWludDY0KSAtPiB1aW50NjQ6CmltcGFjdFJhbmdlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjYKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBpbXBhY3RSYW5nZShpbXBhY3Q6IHVpbnQ2NCwgbWluOiB1aW50NjQsIG1heDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjcKICAgIC8vIGNvbnN0IG1pbkltcGFjdDogdWludDY0ID0gKGltcGFjdCA+IDEpID8gaW1wYWN0IC0gMSA6IDEKICAgIGZyYW1lX2RpZyAtMwogICAgaW50Y18xIC8vIDEKICAgID4KICAgIGJ6IGltcGFjdFJhbmdlX3Rlcm5hcnlfZmFsc2VAMgogICAgZnJhbWVfZGlnIC0zCiAgICBpbnRjXzEgLy8gMQogICAgLQoKaW1wYWN0UmFuZ2VfdGVybmFyeV9tZXJnZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjgKICAgIC8vIHJldHVybiBtYXggLSAoKChtYXggLSBtaW4pICogbWluSW1wYWN0KSAvIElNUEFDVF9ESVZJU09SKQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgLTIKICAgIC0KICAgICoKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvCiAgICBmcmFtZV9kaWcgLTEKICAgIHN3YXAKICAgIC0KICAgIHJldHN1YgoKaW1wYWN0UmFuZ2VfdGVybmFyeV9mYWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMjcKICAgIC8vIGNvbnN0IG1pbkltcGFjdDogdWludDY0ID0gKGltcGFjdCA+IDEpID8gaW1wYWN0IC0gMSA6IDEKICAgIGludGNfMSAvLyAxCiAgICBiIGltcGFjdFJhbmdlX3Rlcm5hcnlfbWVyZ2VAMwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXRJRDogdWludDY0KSAtPiBieXRlczoKcmVmZXJyZXJPclplcm9BZGRyZXNzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNTYKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiByZWZlcnJlck9yWmVyb0FkZHJlc3Mod2FsbGV0SUQ6IEFwcGxpY2F0aW9uKTogQWNjb3VudCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTU3CiAgICAvLyByZXR1cm4gcmVmZXJyZXJPcih3YWxsZXRJRCwgR2xvYmFsLnplcm9BZGRyZXNzKQogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE1MAogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBmcmFtZV9kaWcgLTEKICAgIGJueiByZWZlcnJlck9yWmVyb0FkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAzCiAgICBmcmFtZV9kaWcgMAoKcmVmZXJyZXJPclplcm9BZGRyZXNzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVmZXJyZXJPckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNTcKICAgIC8vIHJldHVybiByZWZlcnJlck9yKHdhbGxldElELCBHbG9iYWwuemVyb0FkZHJlc3MpCiAgICBzd2FwCiAgICByZXRzdWIKCnJlZmVycmVyT3JaZXJvQWRkcmVzc19hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2OS0xNzIKICAgIC8vIGNvbnN0IFtyZWZlcnJlckJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVmZXJyZXIpCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTcxCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNSZWZlcnJlcikKICAgIHB1c2hieXRlcyAicmVmZXJyZXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2OS0xNzIKICAgIC8vIGNvbnN0IFtyZWZlcnJlckJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVmZXJyZXIpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE1NwogICAgLy8gcmV0dXJuIHJlZmVycmVyT3Iod2FsbGV0SUQsIEdsb2JhbC56ZXJvQWRkcmVzcykKICAgIGIgcmVmZXJyZXJPclplcm9BZGRyZXNzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVmZXJyZXJPckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFdhbGxldElEVXNpbmdBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc2CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbiwgYWRkcmVzczogQWNjb3VudCk6IEFwcGxpY2F0aW9uIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTIKICAgIHB1c2hieXRlcyAib2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2MAogICAgLy8gcmV0dXJuIGdldE90aGVyQXBwTGlzdChha2l0YURBTykuZXNjcm93CiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODItMTg1CiAgICAvLyBjb25zdCBkYXRhID0gYWJpQ2FsbDx0eXBlb2YgRXNjcm93RmFjdG9yeS5wcm90b3R5cGUuZ2V0Pih7CiAgICAvLyAgIGFwcElkOiBlc2Nyb3dGYWN0b3J5LAogICAgLy8gICBhcmdzOiBbYWRkcmVzc10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHgzYzFhNmYzMyAvLyBtZXRob2QgImdldChhZGRyZXNzKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4NwogICAgLy8gaWYgKEJ5dGVzKGRhdGEpLmxlbmd0aCA9PT0gMCB8fCBCeXRlcyhkYXRhKS5sZW5ndGggIT09IDgpIHsKICAgIGxlbgogICAgZHVwCiAgICBieiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9faWZfYm9keUA2CiAgICBmcmFtZV9kaWcgMQogICAgaW50Y18yIC8vIDgKICAgICE9CiAgICBieiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaWZfZWxzZUA3CgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9faWZfYm9keUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxODgKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAoKZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc4CiAgICAvLyByZXR1cm4gQXBwbGljYXRpb24oZ2V0V2FsbGV0SUQoZXNjcm93RmFjdG9yeSwgYWRkcmVzcykpCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTkxCiAgICAvLyByZXR1cm4gYnRvaShkYXRhKQogICAgZnJhbWVfZGlnIDAKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc4CiAgICAvLyByZXR1cm4gQXBwbGljYXRpb24oZ2V0V2FsbGV0SUQoZXNjcm93RmFjdG9yeSwgYWRkcmVzcykpCiAgICBiIGdldFdhbGxldElEVXNpbmdBa2l0YURBT19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldFdhbGxldElEQDgKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnYXRlQ2hlY2soZ2F0ZVR4bjogdWludDY0LCBha2l0YURBTzogdWludDY0LCBjYWxsZXI6IGJ5dGVzLCBpZDogdWludDY0KSAtPiB1aW50NjQ6CmdhdGVDaGVjazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI3CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2F0ZUNoZWNrKGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLCBha2l0YURBTzogQXBwbGljYXRpb24sIGNhbGxlcjogQWNjb3VudCwgaWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDIxIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzAKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIGJ6IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMwCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIE9uQ29tcGxldGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBibnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzEKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBOdW1BcHBBcmdzCiAgICBwdXNoaW50IDQgLy8gNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMCAvLyAwCiAgICBndHhuc2FzIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGJ5dGVzIDB4NDM5MjI2NTUgLy8gbWV0aG9kICJtdXN0Q2hlY2soYWRkcmVzcyx1aW50NjQsYnl0ZVtdW10pdm9pZCIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzIKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMwogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGludGNfMSAvLyAxCiAgICBndHhuc2FzIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMzCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzNAogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgZnJhbWVfZGlnIC00CiAgICBwdXNoaW50IDIgLy8gMgogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzNAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI4LTIzNQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gICBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyAgIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIC8vICkKICAgIHJldHN1YgoKZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANzoKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOC0yMzUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vICAgZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gICBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICAvLyApCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpzZW5kUmVmZXJyYWxQYXltZW50KGFraXRhREFPOiB1aW50NjQsIGFzc2V0OiB1aW50NjQsIGFtb3VudDogdWludDY0KSAtPiBieXRlczoKc2VuZFJlZmVycmFsUGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTU4CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gc2VuZFJlZmVycmFsUGF5bWVudChha2l0YURBTzogQXBwbGljYXRpb24sIGFzc2V0OiB1aW50NjQsIGFtb3VudDogdWludDY0KTogUmVmZXJyYWxQYXltZW50SW5mbyB7CiAgICBwcm90byAzIDEKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTkKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyhha2l0YURBTywgVHhuLnNlbmRlcikKICAgIGZyYW1lX2RpZyAtMwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTYwCiAgICAvLyBjb25zdCByZWZlcnJlciA9IHJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXQpCiAgICBjYWxsc3ViIHJlZmVycmVyT3JaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NjMKICAgIC8vIGlmIChhbW91bnQgPiAwICYmIHJlZmVycmVyICE9PSBHbG9iYWwuemVyb0FkZHJlc3MpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgYnogc2VuZFJlZmVycmFsUGF5bWVudF9hZnRlcl9pZl9lbHNlQDYKICAgIGZyYW1lX2RpZyA2CiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgICE9CiAgICBieiBzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2NAogICAgLy8gY29uc3QgW3dhbGxldEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXRGZWVzKSkKICAgIGZyYW1lX2RpZyAtMwogICAgcHVzaGJ5dGVzICJ3YWxsZXRfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTY1CiAgICAvLyBjb25zdCB7IHJlZmVycmVyUGVyY2VudGFnZSB9ID0gZ2V0V2FsbGV0RmVlcyhha2l0YURBTykKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNQogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBwKSwgRElWSVNPUikKICAgIGZyYW1lX2RpZyAtMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTY4CiAgICAvLyBpZiAocmVmZXJyYWxGZWUgPT09IDAgJiYgYW1vdW50ID4gMCkgewogICAgYm56IHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA1CiAgICBmcmFtZV9kaWcgLTEKICAgIGJ6IHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU2OQogICAgLy8gcmVmZXJyYWxGZWUgPSAxCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSAzCgpzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTc1CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZnJhbWVfYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU3NgogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgKyBPTkVfV0VFSyksCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBwdXNoaW50IDYwNDgwMCAvLyA2MDQ4MDAKICAgICsKICAgIGZyYW1lX2J1cnkgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NzcKICAgIC8vIFt7IGFkZHJlc3M6IHJlZmVycmVyLCBhbW91bnQ6IHJlZmVycmFsRmVlIH1dLAogICAgZnJhbWVfZGlnIDMKICAgIGl0b2IKICAgIGZyYW1lX2RpZyA2CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgZnJhbWVfZGlnIC0zCiAgICBieXRlYyAyMSAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTMKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLnJld2FyZHMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ5NgogICAgLy8gbGV0IGNvc3Q6IHVpbnQ2NCA9IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMjUzMDAgLy8gMjUzMDAKICAgICoKICAgIHB1c2hpbnQgMzUzMDAgLy8gMzUzMDAKICAgICsKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTcKICAgIC8vIGlmIChhc3NldCA9PT0gMCkgewogICAgZnJhbWVfZGlnIC0yCiAgICBibnogc2VuZFJlZmVycmFsUGF5bWVudF9lbHNlX2JvZHlAOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTgtNTA5CiAgICAvLyBpZCA9IGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAyCiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAzCiAgICAvLyBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICBmcmFtZV9kaWcgMQogICAgZnJhbWVfZGlnIDMKICAgICsKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUwMS01MDQKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDk4LTUwOQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50RGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKSArIHN1bQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgIGFsbG9jYXRpb25zCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTA1CiAgICAvLyB0aW1lVG9VbmxvY2ssCiAgICBmcmFtZV9kaWcgNQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MDYKICAgIC8vIGV4cGlyYXRpb24sCiAgICBmcmFtZV9kaWcgMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0OTgtNTA5CiAgICAvLyBpZCA9IGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgpICsgc3VtCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDdiN2RjNWZjIC8vIG1ldGhvZCAiY3JlYXRlSW5zdGFudERpc2J1cnNlbWVudChwYXksdWludDY0LHVpbnQ2NCwoYWRkcmVzcyx1aW50NjQpW10pdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBnaXR4biAxIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKCnNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTQ0CiAgICAvLyByZXR1cm4geyBpZCwgY29zdCB9CiAgICBpdG9iCiAgICBmcmFtZV9kaWcgMQogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU3Mi01NzkKICAgIC8vIGNvbnN0IHsgY29zdDogcmVmZXJyYWxNYnIgfSA9IGNyZWF0ZUluc3RhbnREaXNidXJzZW1lbnQoCiAgICAvLyAgIGFraXRhREFPLAogICAgLy8gICBhc3NldCwKICAgIC8vICAgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgKyBPTkVfV0VFSyksCiAgICAvLyAgIFt7IGFkZHJlc3M6IHJlZmVycmVyLCBhbW91bnQ6IHJlZmVycmFsRmVlIH1dLAogICAgLy8gICByZWZlcnJhbEZlZQogICAgLy8gKQogICAgZXh0cmFjdCA4IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTgxCiAgICAvLyByZXR1cm4geyBsZWZ0b3ZlcjogKGFtb3VudCAtIHJlZmVycmFsRmVlKSwgcmVmZXJyYWxNYnIgfQogICAgZnJhbWVfZGlnIC0xCiAgICBmcmFtZV9kaWcgMwogICAgLQogICAgaXRvYgogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKc2VuZFJlZmVycmFsUGF5bWVudF9lbHNlX2JvZHlAOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTExCiAgICAvLyBpZiAoIUFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MuaXNPcHRlZEluKEFzc2V0KGFzc2V0KSkpIHsKICAgIGZyYW1lX2RpZyA0CiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgLTIKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBieiBzZW5kUmVmZXJyYWxQYXltZW50X2lmX2JvZHlAMTAKICAgIGZyYW1lX2RpZyAxCgpzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUyNS01NDEKICAgIC8vIGlkID0gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlSW5zdGFudEFzYURpc2J1cnNlbWVudD4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogTWluRGlzYnVyc2VtZW50c01CUiArIChVc2VyQWxsb2NhdGlvbk1CUiAqIGFsbG9jYXRpb25zLmxlbmd0aCkKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgICAgYXNzZXRSZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgYWxsb2NhdGlvbnMKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI5CiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGZyYW1lX2RpZyAxCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MjgtNTMxCiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gfSksCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzMi01MzYKICAgIC8vIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IEFwcGxpY2F0aW9uKHJld2FyZHNBcHApLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pLAogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzMwogICAgLy8gYXNzZXRSZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGR1cAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZnJhbWVfZGlnIDMKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MzItNTM2CiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICBhc3NldEFtb3VudDogc3VtLAogICAgLy8gICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyB9KSwKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI1LTU0MQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgICB9KSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICBhbGxvY2F0aW9ucwogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUzNwogICAgLy8gdGltZVRvVW5sb2NrLAogICAgZnJhbWVfZGlnIDUKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTM4CiAgICAvLyBleHBpcmF0aW9uLAogICAgZnJhbWVfZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTI1LTU0MQogICAgLy8gaWQgPSBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnMubGVuZ3RoKQogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgICB9KSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICBhbGxvY2F0aW9ucwogICAgLy8gICBdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YWYxYTE0ZjIgLy8gbWV0aG9kICJjcmVhdGVJbnN0YW50QXNhRGlzYnVyc2VtZW50KHBheSxheGZlcix1aW50NjQsdWludDY0LChhZGRyZXNzLHVpbnQ2NClbXSl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGdpdHhuIDIgTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgZnJhbWVfYnVyeSAxCiAgICBiIHNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUAxMgoKc2VuZFJlZmVycmFsUGF5bWVudF9pZl9ib2R5QDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTIKICAgIC8vIGNvc3QgKz0gR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICBmcmFtZV9kaWcgMQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUxMy01MjIKICAgIC8vIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBBc3NldChhc3NldCkKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTE3CiAgICAvLyByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTE4CiAgICAvLyBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTYtNTE5CiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIC8vIH0pLAogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTMtNTIyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgQXNzZXQoYXNzZXQpCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTIwCiAgICAvLyBBc3NldChhc3NldCkKICAgIGZyYW1lX2RpZyAtMgogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1MTMtNTIyCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogQXBwbGljYXRpb24ocmV3YXJkc0FwcCkuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgQXNzZXQoYXNzZXQpCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHgzOTRlYWViMiAvLyBtZXRob2QgIm9wdEluKHBheSx1aW50NjQpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgYiBzZW5kUmVmZXJyYWxQYXltZW50X2FmdGVyX2lmX2Vsc2VAMTEKCnNlbmRSZWZlcnJhbFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1ODQKICAgIC8vIHJldHVybiB7IGxlZnRvdmVyOiBhbW91bnQsIHJlZmVycmFsTWJyOiAwIH0KICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgaW50Y18wIC8vIDAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MTUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDI5IC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxNwogICAgLy8gdGhpcy52ZXJzaW9uLnZhbHVlID0gdmVyc2lvbgogICAgdW5jb3ZlciAzCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzE4CiAgICAvLyB0aGlzLmFraXRhREFPLnZhbHVlID0gYWtpdGFEQU8KICAgIHVuY292ZXIgMgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFraXRhREFPRXNjcm93CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcxNQogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmluaXRbcm91dGluZ10oKSAtPiB2b2lkOgppbml0OgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjMKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyMwogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5NAogICAgLy8gY29uc3QgYWtpdGFBc3NldHNCeXRlcyA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzKSlbMF0KICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjMKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjQKICAgIC8vIGFzc2VydCghR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MuaXNPcHRlZEluKGFrdGEpLCBFUlJfQUxSRUFEWV9PUFRFRF9JTikKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBkaWcgMQogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBhbHJlYWR5IG9wdGVkIGluCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzI2LTczMgogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyOAogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzI5CiAgICAvLyBhc3NldEFtb3VudDogMCwKICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjYtNzMxCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MjYtNzMyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjczNAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFrdGEpKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzM0CiAgICAvLyBpZiAoIXRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcy5pc09wdGVkSW4oYWt0YSkpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBzd2FwCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIGJ1cnkgMQogICAgYm56IGluaXRfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgc3dhcAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDcKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgYnl0ZWMgMTIgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjUwCiAgICAvLyBjb25zdCBbcGx1Z2luQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1BsdWdpbkFwcExpc3QpKQogICAgZHVwCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6ODMKICAgIC8vIGNvbnN0IHsgcmV2ZW51ZU1hbmFnZXIgfSA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGR1cAogICAgZXh0cmFjdCA4IDgKICAgIHN3YXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBzd2FwCiAgICBieXRlYyAxMiAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzAtNzMKICAgIC8vIGNvbnN0IGVzY3JvdyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkLAogICAgLy8gICBhcmdzOiBbW25hbWVdXSwKICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICBwdXNoYnl0ZXMgMHhhMjQwM2RkZiAvLyBtZXRob2QgImFyYzU4X2dldEVzY3Jvd3Moc3RyaW5nW10pKHVpbnQ2NCxib29sKVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjcyCiAgICAvLyBhcmdzOiBbW25hbWVdXSwKICAgIHB1c2hieXRlcyAweDAwMDEwMDAyMDAwYTcyNjU3NjVmNzM2ZjYzNjk2MTZjCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MC03MwogICAgLy8gY29uc3QgZXNjcm93ID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEVzY3Jvd3M+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dLAogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDkgLy8gOQogICAgKgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LGJvb2wxKVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzUKICAgIC8vIGFzc2VydChlc2Nyb3cuaWQgIT09IDAsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBleHRyYWN0IDYgOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBlc2Nyb3cgZm9yIHRoaXMgb3BlcmF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWMgMzAgLy8gbWV0aG9kICJhcmM1OF9yZWtleVRvUGx1Z2luKHVpbnQ2NCxib29sLHN0cmluZyx1aW50NjRbXSwodWludDY0LHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MgogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MwogICAgLy8gbmFtZSwKICAgIHB1c2hieXRlcyAweDAwMGE3MjY1NzY1ZjczNmY2MzY5NjE2YwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjk0CiAgICAvLyBbMF0sIC8vIGFsbCB0aGUgYWtpdGEgZXNjcm93cyBoYXZlIG1ldGhvZCByZXN0cmljdGlvbnMgd2l0aCBvcHRpbiBiZWluZyBpbmRleCAwCiAgICBwdXNoYnl0ZXMgMHgwMDAxMDAwMDAwMDAwMDAwMDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjk1CiAgICAvLyBbXQogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTAxCiAgICAvLyB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU4OAogICAgLy8gbGV0IGNvdW50OiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5MAogICAgLy8gaWYgKCFlc2Nyb3cuaXNPcHRlZEluKGFzc2V0KSkgewogICAgZGlnIDEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogaW5pdF9hZnRlcl9pZl9lbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTkKICAgIC8vIGNvbnN0IFtzcGxpdHNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNSZXZlbnVlU3BsaXRzKSkKICAgIGRpZyA0CiAgICBwdXNoYnl0ZXMgInJldmVudWVfc3BsaXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTQKICAgIC8vIGNvdW50ICs9IHNwbGl0cy5sZW5ndGgKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTEKICAgIC8vIGNvdW50ICs9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5NAogICAgLy8gY291bnQgKz0gc3BsaXRzLmxlbmd0aAogICAgKwogICAgYnVyeSA0Cgppbml0X2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEwNQogICAgLy8gY29uc3QgbWJyQW1vdW50OiB1aW50NjQgPSBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBvcHRJbkNvdW50CiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGRpZyA0CiAgICAqCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExNAogICAgLy8gcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExNAogICAgLy8gcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTEzLTExNgogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExMAogICAgLy8gd2FsbGV0LAogICAgZGlnIDEKICAgIGR1cAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTEyCiAgICAvLyBbYXNzZXQuaWRdLAogICAgZGlnIDIKICAgIGl0b2IKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMDctMTE4CiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBSZXZlbnVlTWFuYWdlclBsdWdpblN0dWIucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIFthc3NldC5pZF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBieXRlYyAyMyAvLyBtZXRob2QgIm9wdEluKHVpbnQ2NCxib29sLHVpbnQ2NFtdLHBheSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5MgogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTIwCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3M+KHsgYXBwSWQ6IHdhbGxldCB9KQogICAgaXR4bl9uZXh0CiAgICBieXRlYyAzMSAvLyBtZXRob2QgImFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzKCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTMyCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCmluaXRfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyMgogICAgLy8gaW5pdCgpOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLnBvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpwb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0NS03NTQKICAgIC8vIHBvc3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFsyNF0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszNl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc1NQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzU1CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHBvc3RfYWZ0ZXJfaWZfZWxzZUAzCiAgICBkdXAKCnBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc1OQogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSB0aW1lc3RhbXAgPD0gTUFYX1RJTUVTVEFNUF9EUklGVCwgRVJSX1RJTUVTVEFNUF9UT09fT0xEKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDkKICAgIGR1cAogICAgY292ZXIgMgogICAgLQogICAgcHVzaGludCA2MCAvLyA2MAogICAgPD0KICAgIGFzc2VydCAvLyBQcm92aWRlZCB0aW1lc3RhbXAgaXMgdG9vIGZhciBpbiB0aGUgcGFzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2MgogICAgLy8gY29uc3QgcG9zdEtleSA9IG9wLnNoYTI1NihvcmlnaW4uYnl0ZXMuY29uY2F0KGl0b2IodGltZXN0YW1wKSkuY29uY2F0KG5vbmNlKSkKICAgIGl0b2IKICAgIGRpZyAxCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBjb25jYXQKICAgIHNoYTI1NgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2NAogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblBvc3QpCiAgICBkaWcgMTAKICAgIGJ5dGVjIDE0IC8vIDB4MGEKICAgIGNhbGxzdWIgdmFsaWRhdGVUaXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NjUKICAgIC8vIHRoaXMuY3JlYXRlUG9zdChwb3N0S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIGNpZCwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlUG9zdCwgb3AuYnplcm8oMzIpIGFzIGJ5dGVzPDMyPikKICAgIGludGNfMyAvLyAzMgogICAgYnplcm8KICAgIHN3YXAKICAgIHVuY292ZXIgMgogICAgZGlnIDEyCiAgICBkaWcgOQogICAgZGlnIDkKICAgIGRpZyA5CiAgICBkaWcgOQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICB1bmNvdmVyIDgKICAgIGNhbGxzdWIgY3JlYXRlUG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0NS03NTQKICAgIC8vIHBvc3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnBvc3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5lZGl0UG9zdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmVkaXRQb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2OC03NzMKICAgIC8vIGVkaXRQb3N0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNCAvLyBheGZlcgogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGF4ZmVyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NzQKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc3NAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBlZGl0UG9zdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZWRpdFBvc3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3NzcKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzc4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IsIHJlZiwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIHBvc3RUeXBlIH0gPSB0aGlzLnBvc3RzKGFtZW5kbWVudCkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMgogICAgcHVzaGludCA1OSAvLyA1OQogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAzCiAgICBsZW4KICAgIHVuY292ZXIgNAogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyAzCiAgICBwdXNoaW50IDQ4IC8vIDQ4CiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIGRpZyA0CiAgICBwdXNoaW50IDQ5IC8vIDQ5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA1CiAgICBwdXNoaW50IDU4IC8vIDU4CiAgICBpbnRjXzEgLy8gMQogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0CiAgICAvLyByZXR1cm4gY3JlYXRvciA9PT0gb3JpZ2luCiAgICB1bmNvdmVyIDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzc5CiAgICAvLyBhc3NlcnQodGhpcy5pc0NyZWF0b3IoY3JlYXRvciwgd2FsbGV0KSwgRVJSX05PVF9ZT1VSX1BPU1RfVE9fRURJVCkKICAgIGFzc2VydCAvLyBOb3QgeW91ciBwb3N0IHRvIGVkaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODAKICAgIC8vIGFzc2VydCghdGhpcy5pc1JlcGx5KHBvc3RUeXBlKSwgRVJSX0lTX0FfUkVQTFkpCiAgICBkdXAKICAgIGNhbGxzdWIgaXNSZXBseQogICAgIQogICAgYXNzZXJ0IC8vIElzIGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODEKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODUKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNAogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3ODgKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICB1bmNvdmVyIDcKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZGlnIDcKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBleHRyYWN0MwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgNwogICAgYm94X2RlbAogICAgcG9wCiAgICB1bmNvdmVyIDcKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3OTAKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25Qb3N0KQogICAgZGlnIDExCiAgICBieXRlYyAxNCAvLyAweDBhCiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzkxCiAgICAvLyB0aGlzLmNyZWF0ZVBvc3QoZWRpdEtleSwgb3JpZ2luLCBtYnJQYXltZW50LCBjaWQsIGdhdGVJRCwgdXNlUGF5V2FsbCwgcGF5V2FsbElELCBQb3N0VHlwZUVkaXRQb3N0LCBhbWVuZG1lbnQpCiAgICB1bmNvdmVyIDQKICAgIGRpZyAxMgogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDYKICAgIHVuY292ZXIgNgogICAgdW5jb3ZlciA2CiAgICBieXRlYyAyNSAvLyAweDAyCiAgICB1bmNvdmVyIDgKICAgIGNhbGxzdWIgY3JlYXRlUG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc2OC03NzMKICAgIC8vIGVkaXRQb3N0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZWRpdFBvc3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGVkaXRQb3N0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2F0ZWRSZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkUmVwbHk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzk0LTgwNgogICAgLy8gZ2F0ZWRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDMgLy8gMwogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMjRdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgwNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZFJlcGx5X2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnYXRlZFJlcGx5X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MDkKICAgIC8vIGNvbnN0IHsgcmVmQnl0ZXMsIGNyZWF0b3I6IGZhbGxiYWNrIH0gPSB0aGlzLnRvQnl0ZXMzMih0eXBlLCByZWYpCiAgICBkaWcgNgogICAgZGlnIDgKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxMgogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSB0aW1lc3RhbXAgPD0gTUFYX1RJTUVTVEFNUF9EUklGVCwgRVJSX1RJTUVTVEFNUF9UT09fT0xEKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDEzCiAgICBkdXAKICAgIGNvdmVyIDQKICAgIC0KICAgIHB1c2hpbnQgNjAgLy8gNjAKICAgIDw9CiAgICBhc3NlcnQgLy8gUHJvdmlkZWQgdGltZXN0YW1wIGlzIHRvbyBmYXIgaW4gdGhlIHBhc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNAogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gY29uc3QgeyBjcmVhdG9yLCBnYXRlSUQ6IHBvc3RHYXRlSUQgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgb3JpZ2luLCBwb3N0R2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHBvc3RHYXRlSUQpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDE3CiAgICBzd2FwCiAgICB1bmNvdmVyIDcKICAgIGR1cAogICAgY292ZXIgNAogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGdhdGVDaGVjawogICAgYXNzZXJ0IC8vIEdhdGUgY2hlY2sgZmFpbGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODE4CiAgICAvLyBjb25zdCBjID0gKGZhbGxiYWNrID09PSBHbG9iYWwuemVyb0FkZHJlc3MpID8gY3JlYXRvciA6IGZhbGxiYWNrCiAgICBkaWcgMgogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODE5CiAgICAvLyBjb25zdCBhZGRlZE1iciA9IHRoaXMuY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3NhcnkocmVmQnl0ZXMsIGMpCiAgICBkaWcgMgogICAgc3dhcAogICAgY2FsbHN1YiBjcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyMgogICAgLy8gY29uc3QgcmVwbHlLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChpdG9iKHRpbWVzdGFtcCkpLmNvbmNhdChub25jZSkpCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIGRpZyAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxMgogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MjQKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25SZWFjdCkKICAgIGRpZyAxNQogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODI1CiAgICAvLyB0aGlzLmNyZWF0ZVJlcGx5KHJlcGx5S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIGFkZGVkTWJyLCBjaWQsIHJlZkJ5dGVzLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgUG9zdFR5cGVSZXBseSwgb3AuYnplcm8oMzIpIGFzIGJ5dGVzPDMyPikKICAgIGludGNfMyAvLyAzMgogICAgYnplcm8KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgZGlnIDE3CiAgICB1bmNvdmVyIDQKICAgIGRpZyAxMwogICAgdW5jb3ZlciA2CiAgICBkaWcgMTEKICAgIGRpZyAxMQogICAgZGlnIDExCiAgICBieXRlYyAxOCAvLyAweDAxCiAgICB1bmNvdmVyIDEwCiAgICBjYWxsc3ViIGNyZWF0ZVJlcGx5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Nzk0LTgwNgogICAgLy8gZ2F0ZWRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHRpbWVzdGFtcDogdWludDY0LAogICAgLy8gICBub25jZTogYnl0ZXM8MjQ+LAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgZ2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIHVzZVBheVdhbGw6IGJvb2xlYW4sCiAgICAvLyAgIHBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdhdGVkUmVwbHlfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGdhdGVkUmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5yZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyOC04MzkKICAgIC8vIHJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICB0aW1lc3RhbXA6IHVpbnQ2NCwKICAgIC8vICAgbm9uY2U6IGJ5dGVzPDI0PiwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIGdhdGVJRDogdWludDY0LAogICAgLy8gICB1c2VQYXlXYWxsOiBib29sZWFuLAogICAgLy8gICBwYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAyIC8vIDIKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMjRdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgOAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NDAKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0MAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiByZXBseV9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKcmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0MgogICAgLy8gY29uc3QgeyByZWZCeXRlcywgY3JlYXRvcjogZmFsbGJhY2sgfSA9IHRoaXMudG9CeXRlczMyKHR5cGUsIHJlZikKICAgIGRpZyA2CiAgICBkaWcgOAogICAgY2FsbHN1YiB0b0J5dGVzMzIKICAgIGR1cAogICAgZXh0cmFjdCAwIDMyCiAgICBzd2FwCiAgICBleHRyYWN0IDMyIDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ1CiAgICAvLyBhc3NlcnQoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIHRpbWVzdGFtcCA8PSBNQVhfVElNRVNUQU1QX0RSSUZULCBFUlJfVElNRVNUQU1QX1RPT19PTEQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBkaWcgMTMKICAgIGR1cAogICAgY292ZXIgNAogICAgLQogICAgcHVzaGludCA2MCAvLyA2MAogICAgPD0KICAgIGFzc2VydCAvLyBQcm92aWRlZCB0aW1lc3RhbXAgaXMgdG9vIGZhciBpbiB0aGUgcGFzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ3CiAgICAvLyBhc3NlcnQodGhpcy5wb3N0cyhyZWZCeXRlcykuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODQ4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IsIGdhdGVJRDogcG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhyZWZCeXRlcykudmFsdWUKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIHN3YXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYXNzZXJ0KHBvc3RHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NTEKICAgIC8vIGNvbnN0IGMgPSAoZmFsbGJhY2sgPT09IEdsb2JhbC56ZXJvQWRkcmVzcykgPyBjcmVhdG9yIDogZmFsbGJhY2sKICAgIGRpZyAxCiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgID09CiAgICBzZWxlY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NTIKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgYykKICAgIGRpZyAxCiAgICBzd2FwCiAgICBjYWxsc3ViIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODU1CiAgICAvLyBjb25zdCByZXBseUtleSA9IG9wLnNoYTI1NihvcmlnaW4uYnl0ZXMuY29uY2F0KGl0b2IodGltZXN0YW1wKSkuY29uY2F0KG5vbmNlKSkKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBkaWcgMTIKICAgIGNvbmNhdAogICAgc2hhMjU2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODU3CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgMTQKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg1OAogICAgLy8gdGhpcy5jcmVhdGVSZXBseShyZXBseUtleSwgb3JpZ2luLCBtYnJQYXltZW50LCBhZGRlZE1iciwgY2lkLCByZWZCeXRlcywgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlUmVwbHksIG9wLmJ6ZXJvKDMyKSBhcyBieXRlczwzMj4pCiAgICBpbnRjXzMgLy8gMzIKICAgIGJ6ZXJvCiAgICBzd2FwCiAgICB1bmNvdmVyIDIKICAgIGRpZyAxNgogICAgdW5jb3ZlciA0CiAgICBkaWcgMTMKICAgIHVuY292ZXIgNgogICAgZGlnIDExCiAgICBkaWcgMTEKICAgIGRpZyAxMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgdW5jb3ZlciAxMAogICAgY2FsbHN1YiBjcmVhdGVSZXBseQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgyOC04MzkKICAgIC8vIHJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICB0aW1lc3RhbXA6IHVpbnQ2NCwKICAgIC8vICAgbm9uY2U6IGJ5dGVzPDI0PiwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIGdhdGVJRDogdWludDY0LAogICAgLy8gICB1c2VQYXlXYWxsOiBib29sZWFuLAogICAgLy8gICBwYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpyZXBseV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgcmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nYXRlZEVkaXRSZXBseVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkRWRpdFJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg2MS04NjcKICAgIC8vIGdhdGVkRWRpdFJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBnYXRlVHhuOiBndHhuLkFwcGxpY2F0aW9uQ2FsbFR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFtZW5kbWVudDogYnl0ZXM8MzI+LAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAzIC8vIDMKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBhcHBsCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzNiAvLyAzNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzZdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg2OAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZEVkaXRSZXBseV9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZ2F0ZWRFZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzEKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUkVQTFlfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZXBseSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzIKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgcmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgcG9zdFR5cGUgfSA9IHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIGRpZyAyCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDMKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDQKICAgIHB1c2hpbnQgNDkgLy8gNDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDUKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTQKICAgIC8vIHJldHVybiBjcmVhdG9yID09PSBvcmlnaW4KICAgIHVuY292ZXIgNgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzMKICAgIC8vIGFzc2VydCh0aGlzLmlzQ3JlYXRvcihjcmVhdG9yLCB3YWxsZXQpLCBFUlJfTk9UX1lPVVJfUE9TVF9UT19FRElUKQogICAgYXNzZXJ0IC8vIE5vdCB5b3VyIHBvc3QgdG8gZWRpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg3NAogICAgLy8gYXNzZXJ0KHRoaXMuaXNSZXBseShwb3N0VHlwZSksIEVSUl9OT1RfQV9SRVBMWSkKICAgIGR1cAogICAgY2FsbHN1YiBpc1JlcGx5CiAgICBhc3NlcnQgLy8gTm90IGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzUKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NzgKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNQogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4ODEKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBkaWcgNwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgOAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgOAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NAogICAgLy8gY29uc3QgcGFyZW50UG9zdFJlZiA9IHJlZi5zbGljZSgzNiwgNjgpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBkaWcgNgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICBkaWcgMQogICAgPj0KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCA2OCAvLyA2OAogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICB1bmNvdmVyIDgKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NQogICAgLy8gY29uc3QgeyBnYXRlSUQ6IG9nUG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhwYXJlbnRQb3N0UmVmKS52YWx1ZQogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODg2CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgb2dQb3N0R2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg4NgogICAgLy8gYXNzZXJ0KGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIG9nUG9zdEdhdGVJRCksIEVSUl9GQUlMRURfR0FURSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMTQKICAgIHN3YXAKICAgIGRpZyA5CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgZ2F0ZUNoZWNrCiAgICBhc3NlcnQgLy8gR2F0ZSBjaGVjayBmYWlsZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4ODgKICAgIC8vIHRoaXMudmFsaWRhdGVUaXAodGlwLCBUaXBBY3Rpb25SZWFjdCkKICAgIGRpZyAxMwogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODg5CiAgICAvLyB0aGlzLmNyZWF0ZVJlcGx5KGVkaXRLZXksIG9yaWdpbiwgbWJyUGF5bWVudCwgMCwgY2lkLCBwYXJlbnRQb3N0UmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgUG9zdFR5cGVFZGl0UmVwbHksIGFtZW5kbWVudCkKICAgIHN3YXAKICAgIHVuY292ZXIgNgogICAgZGlnIDE0CiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciA4CiAgICB1bmNvdmVyIDUKICAgIHVuY292ZXIgOAogICAgdW5jb3ZlciA4CiAgICB1bmNvdmVyIDgKICAgIGJ5dGVjIDE1IC8vIDB4MDMKICAgIHVuY292ZXIgMTAKICAgIGNhbGxzdWIgY3JlYXRlUmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NjEtODY3CiAgICAvLyBnYXRlZEVkaXRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIGNpZDogQ0lELAogICAgLy8gICBhbWVuZG1lbnQ6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2F0ZWRFZGl0UmVwbHlfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGdhdGVkRWRpdFJlcGx5X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZWRpdFJlcGx5W3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg5Mi04OTcKICAgIC8vIGVkaXRSZXBseSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGFtZW5kbWVudDogYnl0ZXM8MzI+CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzM2XQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODk4CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4OTgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZWRpdFJlcGx5X2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgplZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgNAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDEKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKGFtZW5kbWVudCkuZXhpc3RzLCBFUlJfUkVQTFlfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZXBseSBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDIKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgcmVmLCBnYXRlSUQsIHVzZVBheVdhbGwsIHBheVdhbGxJRCwgcG9zdFR5cGUgfSA9IHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIHN3YXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIGRpZyAyCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciA0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDIKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDMKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDQKICAgIHB1c2hpbnQgNDkgLy8gNDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDUKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTQKICAgIC8vIHJldHVybiBjcmVhdG9yID09PSBvcmlnaW4KICAgIHVuY292ZXIgNgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDMKICAgIC8vIGFzc2VydCh0aGlzLmlzQ3JlYXRvcihjcmVhdG9yLCB3YWxsZXQpLCBFUlJfTk9UX1lPVVJfUE9TVF9UT19FRElUKQogICAgYXNzZXJ0IC8vIE5vdCB5b3VyIHBvc3QgdG8gZWRpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkwNAogICAgLy8gYXNzZXJ0KHRoaXMuaXNSZXBseShwb3N0VHlwZSksIEVSUl9OT1RfQV9SRVBMWSkKICAgIGR1cAogICAgY2FsbHN1YiBpc1JlcGx5CiAgICBhc3NlcnQgLy8gTm90IGEgcmVwbHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDUKICAgIC8vIGFzc2VydCghdGhpcy5pc0FtZW5kZWQocmVmLCBwb3N0VHlwZSksIEVSUl9JU19BTFJFQURZX0FNRU5ERUQpCiAgICBkaWcgNAogICAgc3dhcAogICAgY2FsbHN1YiBpc0FtZW5kZWQKICAgICEKICAgIGFzc2VydCAvLyBJcyBhbHJlYWR5IGFtZW5kZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGNvbnN0IGVkaXRLZXkgPSBvcC5zaGEyNTYob3JpZ2luLmJ5dGVzLmNvbmNhdChhbWVuZG1lbnQpLmNvbmNhdChCeXRlcyhjaWQpKSkKICAgIHVuY292ZXIgNgogICAgZHVwCiAgICBjb3ZlciA0CiAgICBkaWcgNwogICAgY29uY2F0CiAgICBkaWcgMTEKICAgIGR1cAogICAgY292ZXIgNAogICAgY29uY2F0CiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MTEKICAgIC8vIHRoaXMucG9zdHMoYW1lbmRtZW50KS52YWx1ZS5yZWYgPSByZWYuY29uY2F0KEJ5dGVzKCdhJykuY29uY2F0KGVkaXRLZXkpKQogICAgYnl0ZWMgMjQgLy8gImEiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBkaWcgNwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgOAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIGV4dHJhY3QzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyA4CiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgOAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxNAogICAgLy8gY29uc3QgcGFyZW50UG9zdFJlZiA9IHJlZi5zbGljZSgzNiwgNjgpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBkaWcgNgogICAgbGVuCiAgICBwdXNoaW50IDM2IC8vIDM2CiAgICBkaWcgMQogICAgPj0KICAgIHB1c2hpbnQgMzYgLy8gMzYKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgcHVzaGludCA2OCAvLyA2OAogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICB1bmNvdmVyIDgKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxNQogICAgLy8gY29uc3QgeyBnYXRlSUQ6IG9nUG9zdEdhdGVJRCB9ID0gdGhpcy5wb3N0cyhwYXJlbnRQb3N0UmVmKS52YWx1ZQogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTE2CiAgICAvLyBhc3NlcnQob2dQb3N0R2F0ZUlEID09PSAwLCBFUlJfSEFTX0dBVEUpCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBoYXMgYSBnYXRlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTE4CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgMTIKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxOQogICAgLy8gdGhpcy5jcmVhdGVSZXBseShlZGl0S2V5LCBvcmlnaW4sIG1iclBheW1lbnQsIDAsIGNpZCwgcGFyZW50UG9zdFJlZiwgZ2F0ZUlELCB1c2VQYXlXYWxsLCBwYXlXYWxsSUQsIFBvc3RUeXBlRWRpdFJlcGx5LCBhbWVuZG1lbnQpCiAgICBzd2FwCiAgICB1bmNvdmVyIDYKICAgIGRpZyAxMwogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgNwogICAgdW5jb3ZlciA1CiAgICB1bmNvdmVyIDgKICAgIHVuY292ZXIgOAogICAgdW5jb3ZlciA4CiAgICBieXRlYyAxNSAvLyAweDAzCiAgICB1bmNvdmVyIDEwCiAgICBjYWxsc3ViIGNyZWF0ZVJlcGx5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODkyLTg5NwogICAgLy8gZWRpdFJlcGx5KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICBjaWQ6IENJRCwKICAgIC8vICAgYW1lbmRtZW50OiBieXRlczwzMj4KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZWRpdFJlcGx5X2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBlZGl0UmVwbHlfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52b3RlW3JvdXRpbmddKCkgLT4gdm9pZDoKdm90ZToKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MjItOTI4CiAgICAvLyB2b3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBpc1VwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkyOQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHZvdGVfYWZ0ZXJfaWZfZWxzZUA2CiAgICBkdXAKICAgIGJ1cnkgOQoKdm90ZV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTMyCiAgICAvLyBsZXQgeyByZWZCeXRlcywgY3JlYXRvciB9ID0gdGhpcy50b0J5dGVzMzIodHlwZSwgcmVmKQogICAgZGlnIDMKICAgIGR1cAogICAgZGlnIDYKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgYnVyeSAxMAogICAgZXh0cmFjdCAzMiAzMgogICAgYnVyeSAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkzMwogICAgLy8gaWYgKHR5cGUgPT09IFJlZlR5cGVQb3N0KSB7CiAgICBieXRlYyAxNCAvLyAweDBhCiAgICA9PQogICAgYnogdm90ZV9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDgKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkzNAogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKTsKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MzUKICAgIC8vICh7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlKTsKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDEwCgp2b3RlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MzgKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgY3JlYXRvcikKICAgIGRpZyA3CiAgICBkdXAKICAgIGRpZyAxMQogICAgY2FsbHN1YiBjcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0MAogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblJlYWN0KQogICAgZGlnIDcKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0MQogICAgLy8gY29uc3QgbWJyTmVlZGVkOiB1aW50NjQgPSB0aGlzLm1icihCeXRlcygnJykpLnZvdGVsaXN0ICsgYWRkZWRNYnIKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQyCiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUob3JpZ2luLCBtYnJQYXltZW50LCBtYnJOZWVkZWQsIHJlZkJ5dGVzLCBpc1VwKQogICAgZGlnIDEwCiAgICBkaWcgOQogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDMKICAgIGRpZyA2CiAgICBjYWxsc3ViIGNyZWF0ZVZvdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5MjItOTI4CiAgICAvLyB2b3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBpc1VwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgdm90ZV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDcKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmVkaXRWb3RlW3JvdXRpbmddKCkgLT4gdm9pZDoKZWRpdFZvdGU6CiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQ1LTk1MAogICAgLy8gZWRpdFZvdGUoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBmbGlwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTUxCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NTEKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZWRpdFZvdGVfYWZ0ZXJfaWZfZWxzZUA4CiAgICBkdXAKICAgIGJ1cnkgOAoKZWRpdFZvdGVfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NAogICAgLy8gY29uc3Qgdm90ZUxpc3RLZXk6IFZvdGVMaXN0S2V5ID0geyB1c2VyOiBiMTYob3JpZ2luLmJ5dGVzKSwgcmVmOiBiMTYocmVmKSB9CiAgICBkaWcgNwogICAgY2FsbHN1YiBiMTYKICAgIGRpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MgogICAgLy8gdm90ZWxpc3QgPSBCb3hNYXA8Vm90ZUxpc3RLZXksIFZvdGVMaXN0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFZvdGVMaXN0IH0pCiAgICBieXRlYyAxNiAvLyAibyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NQogICAgLy8gYXNzZXJ0KHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLmV4aXN0cywgRVJSX0hBVkVOVF9WT1RFRCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gWW91IGhhdmVuJ3Qgdm90ZWQgb24gdGhpcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk1NwogICAgLy8gY29uc3QgeyBpbXBhY3QsIGlzVXAgfSA9IHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIHB1c2hpbnQgNjQgLy8gNjQKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2MAogICAgLy8gdGhpcy51cGRhdGVWb3RlcyhyZWYsICFpc1VwLCBpbXBhY3QpCiAgICAhCiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgdW5jb3ZlciAyCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS5kZWxldGUoKQogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTY2CiAgICAvLyBpZiAoIWZsaXApIHsKICAgIGRpZyAyCiAgICBibnogZWRpdFZvdGVfYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTY4LTk3MwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkudm90ZWxpc3QKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTcxCiAgICAvLyBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkudm90ZWxpc3QKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NjgtOTcyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS52b3RlbGlzdAogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2OC05NzMKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLnZvdGVsaXN0CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCmVkaXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5lZGl0Vm90ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk0NS05NTAKICAgIC8vIGVkaXRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzPDMyPiwKICAgIC8vICAgZmxpcDogYm9vbGVhbgogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgplZGl0Vm90ZV9hZnRlcl9pZl9lbHNlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTc5CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgNAogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgwCiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUob3JpZ2luLCBtYnJQYXltZW50LCAwLCByZWYsICFpc1VwKQogICAgZGlnIDcKICAgIGRpZyA2CiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDYKICAgIGRpZyAxMAogICAgY2FsbHN1YiBjcmVhdGVWb3RlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTQ1LTk1MAogICAgLy8gZWRpdFZvdGUoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBmbGlwOiBib29sZWFuCiAgICAvLyApOiB2b2lkIHsKICAgIGIgZWRpdFZvdGVfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmVkaXRWb3RlQDUKCmVkaXRWb3RlX2FmdGVyX2lmX2Vsc2VAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGVkaXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2F0ZWRSZWFjdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkUmVhY3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgzLTk5MAogICAgLy8gZ2F0ZWRSZWFjdCgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sCiAgICAvLyAgIHJlZjogYnl0ZXMsCiAgICAvLyAgIHR5cGU6IFJlZlR5cGUsCiAgICAvLyAgIE5GVDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDMgLy8gMwogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDQgLy8gYXhmZXIKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBheGZlcgogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTkxCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OTEKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZ2F0ZWRSZWFjdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKZ2F0ZWRSZWFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTkzCiAgICAvLyBjb25zdCB7IHJlZkJ5dGVzLCBjcmVhdG9yOiBmYWxsYmFjayB9ID0gdGhpcy50b0J5dGVzMzIodHlwZSwgcmVmKQogICAgZGlnIDQKICAgIGRpZyA2CiAgICBjYWxsc3ViIHRvQnl0ZXMzMgogICAgZHVwCiAgICBleHRyYWN0IDAgMzIKICAgIHN3YXAKICAgIGV4dHJhY3QgMzIgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5NQogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5NgogICAgLy8gY29uc3QgeyBjcmVhdG9yLCBnYXRlSUQgfSA9IHRoaXMucG9zdHMocmVmQnl0ZXMpLnZhbHVlCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OTcKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgb3JpZ2luLCBnYXRlSUQpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgZ2F0ZUlEKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAxMQogICAgc3dhcAogICAgdW5jb3ZlciA2CiAgICBkdXAKICAgIGNvdmVyIDQKICAgIHVuY292ZXIgMwogICAgY2FsbHN1YiBnYXRlQ2hlY2sKICAgIGFzc2VydCAvLyBHYXRlIGNoZWNrIGZhaWxlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk5OQogICAgLy8gY29uc3QgYyA9IChmYWxsYmFjayA9PT0gR2xvYmFsLnplcm9BZGRyZXNzKSA/IGNyZWF0b3IgOiBmYWxsYmFjawogICAgZGlnIDIKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgPT0KICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMDAKICAgIC8vIGNvbnN0IGFkZGVkTWJyID0gdGhpcy5jcmVhdGVFbXB0eVBvc3RJZk5lY2Vzc2FyeShyZWZCeXRlcywgYykKICAgIGRpZyAyCiAgICBzd2FwCiAgICBjYWxsc3ViIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAwMgogICAgLy8gdGhpcy52YWxpZGF0ZVRpcCh0aXAsIFRpcEFjdGlvblJlYWN0KQogICAgZGlnIDkKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgY2FsbHN1YiB2YWxpZGF0ZVRpcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMDMKICAgIC8vIHRoaXMuY3JlYXRlUmVhY3Rpb24ob3JpZ2luLCBtYnJQYXltZW50LCBhZGRlZE1iciwgcmVmQnl0ZXMsIE5GVCkKICAgIGRpZyAxMAogICAgc3dhcAogICAgdW5jb3ZlciAzCiAgICBkaWcgNgogICAgY2FsbHN1YiBjcmVhdGVSZWFjdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk4My05OTAKICAgIC8vIGdhdGVkUmVhY3QoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdGlwOiBndHhuLkFzc2V0VHJhbnNmZXJUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBORlQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnYXRlZFJlYWN0X2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnYXRlZFJlYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwucmVhY3Rbcm91dGluZ10oKSAtPiB2b2lkOgpyZWFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDA2LTEwMTIKICAgIC8vIHJlYWN0KAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLAogICAgLy8gICByZWY6IGJ5dGVzLAogICAgLy8gICB0eXBlOiBSZWZUeXBlLAogICAgLy8gICBORlQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgcHVzaGludCAyIC8vIDIKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgcHVzaGludCA0IC8vIGF4ZmVyCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXhmZXIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxMwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxMwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiByZWFjdF9hZnRlcl9pZl9lbHNlQDMKICAgIGR1cAoKcmVhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMTUKICAgIC8vIGNvbnN0IHsgcmVmQnl0ZXMsIGNyZWF0b3I6IGZhbGxiYWNrIH0gPSB0aGlzLnRvQnl0ZXMzMih0eXBlLCByZWYpCiAgICBkaWcgNAogICAgZGlnIDYKICAgIGNhbGxzdWIgdG9CeXRlczMyCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBkaWcgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxNwogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmQnl0ZXMpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMTgKICAgIC8vIGNvbnN0IHsgY3JlYXRvciwgZ2F0ZUlEOiBwb3N0R2F0ZUlEIH0gPSB0aGlzLnBvc3RzKHJlZkJ5dGVzKS52YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18zIC8vIDMyCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxOQogICAgLy8gYXNzZXJ0KHBvc3RHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDIxCiAgICAvLyBjb25zdCBjID0gKGZhbGxiYWNrID09PSBHbG9iYWwuemVyb0FkZHJlc3MpID8gY3JlYXRvciA6IGZhbGxiYWNrCiAgICBkaWcgMQogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAyMgogICAgLy8gY29uc3QgYWRkZWRNYnIgPSB0aGlzLmNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5KHJlZkJ5dGVzLCBjKQogICAgZGlnIDEKICAgIHN3YXAKICAgIGNhbGxzdWIgY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI0CiAgICAvLyB0aGlzLnZhbGlkYXRlVGlwKHRpcCwgVGlwQWN0aW9uUmVhY3QpCiAgICBkaWcgOAogICAgYnl0ZWMgNiAvLyAweDE0CiAgICBjYWxsc3ViIHZhbGlkYXRlVGlwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAyNQogICAgLy8gdGhpcy5jcmVhdGVSZWFjdGlvbihvcmlnaW4sIG1iclBheW1lbnQsIGFkZGVkTWJyLCByZWZCeXRlcywgTkZUKQogICAgdW5jb3ZlciAyCiAgICBkaWcgOQogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDMKICAgIGRpZyA2CiAgICBjYWxsc3ViIGNyZWF0ZVJlYWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAwNi0xMDEyCiAgICAvLyByZWFjdCgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB0aXA6IGd0eG4uQXNzZXRUcmFuc2ZlclR4biwKICAgIC8vICAgcmVmOiBieXRlcywKICAgIC8vICAgdHlwZTogUmVmVHlwZSwKICAgIC8vICAgTkZUOiB1aW50NjQKICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKcmVhY3RfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHJlYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZGVsZXRlUmVhY3Rpb25bcm91dGluZ10oKSAtPiB2b2lkOgpkZWxldGVSZWFjdGlvbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI4CiAgICAvLyBkZWxldGVSZWFjdGlvbihyZWY6IGJ5dGVzPDMyPiwgTkZUOiB1aW50NjQpOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDI5CiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IGRlbGV0ZVJlYWN0aW9uX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpkZWxldGVSZWFjdGlvbl9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzMgogICAgLy8gYXNzZXJ0KCF0aGlzLmlzQmFubmVkKG9yaWdpbiksIEVSUl9CQU5ORUQpCiAgICBkdXAKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBiYW5uZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZGlnIDUKICAgIGR1cAogICAgY292ZXIgMwogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzMwogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM0CiAgICAvLyBjb25zdCB7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmKS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM1CiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCbG9ja2VkKGNyZWF0b3IsIG9yaWdpbiksIEVSUl9CTE9DS0VEKQogICAgZGlnIDEKICAgIGNhbGxzdWIgaXNCbG9ja2VkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJsb2NrZWQgYnkgdGhlIHVzZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDM3CiAgICAvLyBjb25zdCByZWFjdGlvbkxpc3RLZXk6IFJlYWN0aW9uTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZiksIE5GVCB9CiAgICBkdXAKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMgogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NgogICAgLy8gcmVhY3Rpb25saXN0ID0gQm94TWFwPFJlYWN0aW9uTGlzdEtleSwgYnl0ZXM8MD4+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFJlYWN0aW9uTGlzdCB9KQogICAgcHVzaGJ5dGVzICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAzOQogICAgLy8gYXNzZXJ0KHRoaXMucmVhY3Rpb25saXN0KHJlYWN0aW9uTGlzdEtleSkuZXhpc3RzLCBFUlJfQUxSRUFEWV9SRUFDVEVEKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgYWxyZWFkeSByZWFjdGVkIHRvIHRoaXMgcG9zdCB3aXRoIHRoaXMgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA0MQogICAgLy8gdGhpcy5yZWFjdGlvbnMoeyByZWYsIE5GVCB9KS52YWx1ZSAtPSAxCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQKICAgIC8vIHJlYWN0aW9ucyA9IEJveE1hcDxSZWFjdGlvbnNLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25zIH0pCiAgICBieXRlYyAyNiAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNDEKICAgIC8vIHRoaXMucmVhY3Rpb25zKHsgcmVmLCBORlQgfSkudmFsdWUgLT0gMQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgYnRvaQogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGl0b2IKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQyCiAgICAvLyB0aGlzLnJlYWN0aW9ubGlzdChyZWFjdGlvbkxpc3RLZXkpLmRlbGV0ZSgpCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQ0LTEwNTAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLnJlYWN0aW9ubGlzdCwKICAgIC8vIAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDQ3CiAgICAvLyBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkucmVhY3Rpb25saXN0LAogICAgcHVzaGJ5dGVzICIiCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNDQtMTA0OQogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKEJ5dGVzKCcnKSkucmVhY3Rpb25saXN0LAogICAgLy8gCiAgICAvLyAgIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA0NC0xMDUwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS5yZWFjdGlvbmxpc3QsCiAgICAvLyAKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMjgKICAgIC8vIGRlbGV0ZVJlYWN0aW9uKHJlZjogYnl0ZXM8MzI+LCBORlQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZGVsZXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIGRlbGV0ZVJlYWN0aW9uX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuc2V0UG9zdEZsYWdbcm91dGluZ10oKSAtPiB2b2lkOgpzZXRQb3N0RmxhZzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDUzCiAgICAvLyBzZXRQb3N0RmxhZyhyZWY6IGJ5dGVzPDMyPiwgZmxhZ2dlZDogYm9vbGVhbik6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA1NQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5tb2RlcmF0aW9uKS5hZGRyZXNzLCAnRVJSOk5PVF9NT0RFUkFUSU9OJykKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24pLmFkZHJlc3MsICdFUlI6Tk9UX01PREVSQVRJT04nKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDkgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24pLmFkZHJlc3MsICdFUlI6Tk9UX01PREVSQVRJT04nKQogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gRVJSOk5PVF9NT0RFUkFUSU9OCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHBvc3RzID0gQm94TWFwPGJ5dGVzPDMyPiwgUG9zdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhQb3N0cyB9KQogICAgYnl0ZWNfMiAvLyAicCIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA1NgogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDU3CiAgICAvLyB0aGlzLnBvc3RzKHJlZikudmFsdWUuYWdhaW5zdENvbnRlbnRQb2xpY3kgPSBmbGFnZ2VkCiAgICBkdXAKICAgIHB1c2hpbnQgNTcgLy8gNTcKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMwogICAgc2V0Yml0CiAgICBwdXNoaW50IDU3IC8vIDU3CiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNTMKICAgIC8vIHNldFBvc3RGbGFnKHJlZjogYnl0ZXM8MzI+LCBmbGFnZ2VkOiBib29sZWFuKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pbml0TWV0YVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmluaXRNZXRhOgogICAgaW50Y18wIC8vIDAKICAgIHB1c2hieXRlcyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2NwogICAgLy8gaW5pdE1ldGEoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdXNlcjogQWNjb3VudCwKICAgIC8vICAgYXV0b21hdGVkOiBib29sZWFuLAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICk6IHVpbnQ2NCB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIGludGNfMSAvLyBwYXkKICAgID09CiAgICBhc3NlcnQgLy8gdHJhbnNhY3Rpb24gdHlwZSBpcyBwYXkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCB1c2VyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCB1c2VyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHN3YXAKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBpbml0TWV0YV9hZnRlcl9pZl9lbHNlQDEyCiAgICBkaWcgNQogICAgYnVyeSA5Cgppbml0TWV0YV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDEzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzAKICAgIC8vIGNvbnN0IHVzZXJJc1NlbmRlciA9IChUeG4uc2VuZGVyID09PSB1c2VyKQogICAgdHhuIFNlbmRlcgogICAgZGlnIDYKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgTWV0YVZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlYyA1IC8vICJtIgogICAgZGlnIDEwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDcyCiAgICAvLyBhc3NlcnQoIXRoaXMubWV0YShvcmlnaW4pLmV4aXN0cywgRVJSX01FVEFfQUxSRUFEWV9FWElTVFMpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBNZXRhIGJveCB2YWx1ZXMgYWxyZWFkeSBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzQtMTA4MQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGRpZyA3CiAgICBkdXAKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3NwogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDc0LTEwODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdGhpcy5tYnIoQnl0ZXMoJycpKS5tZXRhICsgSW1wYWN0TWV0YU1CUgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3OAogICAgLy8gYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICBwdXNoYnl0ZXMgIiIKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLm1icgogICAgcHVzaGludCA1NiAvLyA1NgogICAgZXh0cmFjdF91aW50NjQKICAgIGludGMgNiAvLyAzMTcwMAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNzQtMTA4MQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icihCeXRlcygnJykpLm1ldGEgKyBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODMKICAgIC8vIGNvbnN0IGltcGFjdCA9IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDgzCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODMKICAgIC8vIGNvbnN0IGltcGFjdCA9IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDg1LTEwOTAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihpbXBhY3QpLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBJbXBhY3RNZXRhTUJSCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODcKICAgIC8vIHJlY2VpdmVyOiBBcHBsaWNhdGlvbihpbXBhY3QpLmFkZHJlc3MsCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA4OAogICAgLy8gYW1vdW50OiBJbXBhY3RNZXRhTUJSCiAgICBpbnRjIDYgLy8gMzE3MDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA4NS0xMDg5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogQXBwbGljYXRpb24oaW1wYWN0KS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogSW1wYWN0TWV0YU1CUgogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwODUtMTA5MAogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEFwcGxpY2F0aW9uKGltcGFjdCkuYWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IEltcGFjdE1ldGFNQlIKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOTIKICAgIC8vIGlmIChhdXRvbWF0ZWQpIHsKICAgIGRpZyA1CiAgICBieiBpbml0TWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDkzCiAgICAvLyB0aGlzLmNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbiwgdXNlcklzU2VuZGVyLCB3YWxsZXQuaWQsIHRydWUpCiAgICBkaWcgOQogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGRpZyAzCiAgICBpbnRjXzEgLy8gMQogICAgY2FsbHN1YiBjcmVhdGVEZWZhdWx0TWV0YQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOTUtMTEwMwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogaW1wYWN0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3JpZ2luLAogICAgLy8gICAgIDAsCiAgICAvLyAgICAgMCwKICAgIC8vICAgICAwCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA5OQogICAgLy8gMCwKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA5NS0xMTAzCiAgICAvLyBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbEltcGFjdC5wcm90b3R5cGUuY2FjaGVNZXRhPih7CiAgICAvLyAgIGFwcElkOiBpbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBvcmlnaW4sCiAgICAvLyAgICAgMCwKICAgIC8vICAgICAwLAogICAgLy8gICAgIDAKICAgIC8vICAgXQogICAgLy8gfSkKICAgIGJ5dGVjIDI3IC8vIG1ldGhvZCAiY2FjaGVNZXRhKGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDcKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTA1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKCmluaXRNZXRhX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pbml0TWV0YUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2NwogICAgLy8gaW5pdE1ldGEoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgdXNlcjogQWNjb3VudCwKICAgIC8vICAgYXV0b21hdGVkOiBib29sZWFuLAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICk6IHVpbnQ2NCB7CiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgppbml0TWV0YV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEwOAogICAgLy8gdGhpcy5jcmVhdGVEZWZhdWx0TWV0YShvcmlnaW4sIHVzZXJJc1NlbmRlciwgd2FsbGV0LmlkLCBmYWxzZSkKICAgIGRpZyA5CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgZGlnIDMKICAgIGludGNfMCAvLyAwCiAgICBjYWxsc3ViIGNyZWF0ZURlZmF1bHRNZXRhCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMC0xMTE4CiAgICAvLyBjb25zdCBpbXBhY3RTY29yZSA9IGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5jYWNoZU1ldGE+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBvcmlnaW4sCiAgICAvLyAgICAgc3Vic2NyaXB0aW9uSW5kZXgsCiAgICAvLyAgICAgTkZELAogICAgLy8gICAgIGFraXRhTkZUCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTExMQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMTEKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0LAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTEwLTExMTgKICAgIC8vIGNvbnN0IGltcGFjdFNjb3JlID0gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG9yaWdpbiwKICAgIC8vICAgICBzdWJzY3JpcHRpb25JbmRleCwKICAgIC8vICAgICBORkQsCiAgICAvLyAgICAgYWtpdGFORlQKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDI3IC8vIG1ldGhvZCAiY2FjaGVNZXRhKGFkZHJlc3MsdWludDY0LHVpbnQ2NCx1aW50NjQpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMAogICAgLy8gcmV0dXJuIGltcGFjdFNjb3JlICsgdGhpcy5nZXRTb2NpYWxJbXBhY3RTY29yZShvcmlnaW4pCiAgICBzd2FwCiAgICBjYWxsc3ViIGdldFNvY2lhbEltcGFjdFNjb3JlCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA2MC0xMDY3CiAgICAvLyBpbml0TWV0YSgKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICB1c2VyOiBBY2NvdW50LAogICAgLy8gICBhdXRvbWF0ZWQ6IGJvb2xlYW4sCiAgICAvLyAgIHN1YnNjcmlwdGlvbkluZGV4OiB1aW50NjQsCiAgICAvLyAgIE5GRDogdWludDY0LAogICAgLy8gICBha2l0YU5GVDogdWludDY0LAogICAgLy8gKTogdWludDY0IHsKICAgIGIgaW5pdE1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmluaXRNZXRhQDcKCmluaXRNZXRhX2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwNjkKICAgIC8vIGNvbnN0IG9yaWdpbiA9IG9yaWdpbk9yKHdhbGxldCwgdXNlcikKICAgIGIgaW5pdE1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckAxMwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUGF5V2FsbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZVBheVdhbGw6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMwogICAgLy8gY3JlYXRlUGF5V2FsbChtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sIHBheVdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyNC0xMTMyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuRXE6IHRoaXMucGF5V2FsbE1icihwYXlXYWxsKQogICAgLy8gICAgIH0KICAgIC8vICAgfQogICAgLy8gKQogICAgZGlnIDEKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyNwogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTI0LTExMzIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogdGhpcy5wYXlXYWxsTWJyKHBheVdhbGwpCiAgICAvLyAgICAgfQogICAgLy8gICB9CiAgICAvLyApCiAgICA9PQogICAgdW5jb3ZlciAyCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTI5CiAgICAvLyBncmVhdGVyVGhhbkVxOiB0aGlzLnBheVdhbGxNYnIocGF5V2FsbCkKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwucGF5V2FsbE1icgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMjQtMTEzMgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiB0aGlzLnBheVdhbGxNYnIocGF5V2FsbCkKICAgIC8vICAgICB9CiAgICAvLyAgIH0KICAgIC8vICkKICAgIGNvdmVyIDIKICAgID49CiAgICB1bmNvdmVyIDIKICAgICYmCiAgICBhc3NlcnQgLy8gYXNzZXJ0IHRhcmdldCBpcyBtYXRjaCBmb3IgY29uZGl0aW9ucwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzQKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wYXlXYWxsSWQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIHBheVdhbGxJZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IEFraXRhU29jaWFsR2xvYmFsU3RhdGVLZXlzUGF5d2FsbElELCBpbml0aWFsVmFsdWU6IDEgfSkKICAgIGJ5dGVjIDIwIC8vICJwYXl3YWxsX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzQKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5wYXlXYWxsSWQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEzNQogICAgLy8gdGhpcy5wYXlXYWxsSWQudmFsdWUrKwogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBwYXlXYWxsSWQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBBa2l0YVNvY2lhbEdsb2JhbFN0YXRlS2V5c1BheXdhbGxJRCwgaW5pdGlhbFZhbHVlOiAxIH0pCiAgICBieXRlYyAyMCAvLyAicGF5d2FsbF9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTM1CiAgICAvLyB0aGlzLnBheVdhbGxJZC52YWx1ZSsrCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMzcKICAgIC8vIHRoaXMucGF5d2FsbChpZCkudmFsdWUgPSBjbG9uZShwYXlXYWxsKQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEzNwogICAgLy8gdGhpcy5wYXl3YWxsKGlkKS52YWx1ZSA9IGNsb25lKHBheVdhbGwpCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgdW5jb3ZlciAyCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyMwogICAgLy8gY3JlYXRlUGF5V2FsbChtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sIHBheVdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwudXBkYXRlTWV0YVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZU1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE0Mi0xMTQ5CiAgICAvLyB1cGRhdGVNZXRhKAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgYWRkcmVzc0dhdGVJRDogdWludDY0LAogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LAogICAgLy8gICBORkQ6IHVpbnQ2NCwKICAgIC8vICAgYWtpdGFORlQ6IHVpbnQ2NCwKICAgIC8vICAgZGVmYXVsdFBheVdhbGxJRDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA1CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwbiAyCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUwCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUwCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlcikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICB0eG4gU2VuZGVyCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzkKICAgIC8vIGlmICh3YWxsZXRJRC5pZCA9PT0gMCkgewogICAgYm56IHVwZGF0ZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzCiAgICBkdXAKCnVwZGF0ZU1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTUzCiAgICAvLyBhc3NlcnQodGhpcy5tZXRhKG9yaWdpbikuZXhpc3RzLCBFUlJfTUVUQV9ET0VTTlRfRVhJU1QpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIE1ldGEgYm94IHZhbHVlcyBkb250IGV4aXN0IHlldAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTUKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmZvbGxvd0dhdGVJRCA9IGZvbGxvd0dhdGVJRAogICAgZHVwCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICBkaWcgMTIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE1NgogICAgLy8gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUuYWRkcmVzc0dhdGVJRCA9IGFkZHJlc3NHYXRlSUQKICAgIGR1cAogICAgcHVzaGludCA1OCAvLyA1OAogICAgZGlnIDExCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTcKICAgIC8vIGFzc2VydCh0aGlzLnBheXdhbGwoZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzLCAnRVJSOk5PUEFZV0FMTCcpCiAgICBkaWcgNAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyBhc3NlcnQodGhpcy5wYXl3YWxsKGRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cywgJ0VSUjpOT1BBWVdBTEwnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRVJSOk5PUEFZV0FMTAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNTgKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQgPSBkZWZhdWx0UGF5V2FsbElECiAgICBwdXNoaW50IDY2IC8vIDY2CiAgICBkaWcgNgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTYwCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE2MAogICAgLy8gY29uc3QgaW1wYWN0ID0gZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDkgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTYwCiAgICAvLyBjb25zdCBpbXBhY3QgPSBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNjEtMTE2OQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmNhY2hlTWV0YT4oewogICAgLy8gICBhcHBJZDogaW1wYWN0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3JpZ2luLAogICAgLy8gICAgIHN1YnNjcmlwdGlvbkluZGV4LAogICAgLy8gICAgIE5GRCwKICAgIC8vICAgICBha2l0YU5GVAogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgYnl0ZWMgMjcgLy8gbWV0aG9kICJjYWNoZU1ldGEoYWRkcmVzcyx1aW50NjQsdWludDY0LHVpbnQ2NCl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA3CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDYKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNDItMTE0OQogICAgLy8gdXBkYXRlTWV0YSgKICAgIC8vICAgZm9sbG93R2F0ZUlEOiB1aW50NjQsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwKICAgIC8vICAgTkZEOiB1aW50NjQsCiAgICAvLyAgIGFraXRhTkZUOiB1aW50NjQsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IHVpbnQ2NAogICAgLy8gKTogdm9pZCB7CiAgICByZXR1cm4gLy8gb24gZXJyb3I6IGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKCnVwZGF0ZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIGJ5dGVjIDQgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICBiIHVwZGF0ZU1ldGFfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA0CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVGb2xsb3dlck1ldGFbcm91dGluZ10oKSAtPiB2b2lkOgp1cGRhdGVGb2xsb3dlck1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdXBkYXRlRm9sbG93ZXJNZXRhKGFkZHJlc3M6IEFjY291bnQsIG5ld0ZvbGxvd2VySW5kZXg6IHVpbnQ2NCwgbmV3Rm9sbG93ZXJDb3VudDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNzMKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ3JhcGgpLmFkZHJlc3MsIEVSUl9OT1RfR1JBUEgpCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCkuYWRkcmVzcywgRVJSX05PVF9HUkFQSCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA5IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCkuYWRkcmVzcywgRVJSX05PVF9HUkFQSCkKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBOb3QgdGhlIHNvY2lhbCBncmFwaCBhcHAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICB1bmNvdmVyIDMKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNzQKICAgIC8vIHRoaXMubWV0YShhZGRyZXNzKS52YWx1ZS5mb2xsb3dlckluZGV4ID0gbmV3Rm9sbG93ZXJJbmRleAogICAgZHVwCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICB1bmNvdmVyIDQKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3NQogICAgLy8gdGhpcy5tZXRhKGFkZHJlc3MpLnZhbHVlLmZvbGxvd2VyQ291bnQgPSBuZXdGb2xsb3dlckNvdW50CiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdXBkYXRlRm9sbG93ZXJNZXRhKGFkZHJlc3M6IEFjY291bnQsIG5ld0ZvbGxvd2VySW5kZXg6IHVpbnQ2NCwgbmV3Rm9sbG93ZXJDb3VudDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmlzQmFubmVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODAKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNCYW5uZWQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRVc2VyU29jaWFsSW1wYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0VXNlclNvY2lhbEltcGFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTg4CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExOTAKICAgIC8vIHJldHVybiB0aGlzLmdldFNvY2lhbEltcGFjdFNjb3JlKHVzZXIpCiAgICBjYWxsc3ViIGdldFNvY2lhbEltcGFjdFNjb3JlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4OAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRNZXRhRXhpc3RzW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YUV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTkzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExOTUKICAgIC8vIHJldHVybiB0aGlzLm1ldGEodXNlcikuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTkzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTk4CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMDAKICAgIC8vIHJldHVybiB0aGlzLm1ldGEodXNlcikudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE5OAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRQb3N0RXhpc3RzW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0UG9zdEV4aXN0czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA0CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA2CiAgICAvLyByZXR1cm4gdGhpcy5wb3N0cyhyZWYpLmV4aXN0cwogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIwNAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0UG9zdFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFBvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIwOQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxMQogICAgLy8gYXNzZXJ0KHRoaXMucG9zdHMocmVmKS5leGlzdHMsIEVSUl9QT1NUX05PVF9GT1VORCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gUG9zdCBub3QgZm91bmQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjEyCiAgICAvLyByZXR1cm4gdGhpcy5wb3N0cyhyZWYpLnZhbHVlCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjA5CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldFZvdGVbcm91dGluZ10oKSAtPiB2b2lkOgpnZXRWb3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMTUKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNwogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnZXRWb3RlX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnZXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjIwCiAgICAvLyBjb25zdCB2b3RlTGlzdEtleTogVm90ZUxpc3RLZXkgPSB7IHVzZXI6IGIxNihvcmlnaW4uYnl0ZXMpLCByZWY6IGIxNihyZWYpIH0KICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMwogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIyMQogICAgLy8gYXNzZXJ0KHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLmV4aXN0cywgRVJSX0hBVkVOVF9WT1RFRCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gWW91IGhhdmVuJ3Qgdm90ZWQgb24gdGhpcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjMKICAgIC8vIHJldHVybiB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIxNQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnZXRWb3RlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnZXRWb3RlX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0Vm90ZXNbcm91dGluZ10oKSAtPiB2b2lkOgpnZXRWb3RlczoKICAgIGludGNfMCAvLyAwCiAgICBkdXBuIDIKICAgIHB1c2hieXRlcyAiIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDMyCiAgICAqCiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4WzMyXVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjgKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHR4biBTZW5kZXIKICAgIGNhbGxzdWIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTQ2CiAgICAvLyByZXR1cm4gb3JpZ2luT3Iod2FsbGV0SUQsIFR4bi5zZW5kZXIpCiAgICB0eG4gU2VuZGVyCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogZ2V0Vm90ZXNfYWZ0ZXJfaWZfZWxzZUAxMQogICAgZHVwCiAgICBidXJ5IDcKCmdldFZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTIzMQogICAgLy8gY29uc3Qgdm90ZXM6IFZvdGVMaXN0VmFsdWVbXSA9IFtdCiAgICBieXRlYyAxMSAvLyAweDAwMDAKICAgIGJ1cnkgNgogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgNQoKZ2V0Vm90ZXNfZm9yX2hlYWRlckAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMzIKICAgIC8vIGZvciAoY29uc3QgcmVmIG9mIHJlZnMpIHsKICAgIGRpZyA0CiAgICBkaWcgMwogICAgPAogICAgYnogZ2V0Vm90ZXNfYWZ0ZXJfZm9yQDgKICAgIGRpZyAzCiAgICBleHRyYWN0IDIgMAogICAgZGlnIDUKICAgIGludGNfMyAvLyAzMgogICAgKgogICAgaW50Y18zIC8vIDMyCiAgICBleHRyYWN0MyAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjMzCiAgICAvLyBjb25zdCB2b3RlTGlzdEtleTogVm90ZUxpc3RLZXkgPSB7IHVzZXI6IGIxNihvcmlnaW4uYnl0ZXMpLCByZWY6IGIxNihyZWYpIH0KICAgIGRpZyA3CiAgICBjYWxsc3ViIGIxNgogICAgc3dhcAogICAgY2FsbHN1YiBiMTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGJ1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMzQKICAgIC8vIGlmICh0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogZ2V0Vm90ZXNfZWxzZV9ib2R5QDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjM1CiAgICAvLyB2b3Rlcy5wdXNoKHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlKQogICAgZGlnIDcKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkaWcgNgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgNgoKZ2V0Vm90ZXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgZGlnIDQKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDUKICAgIGIgZ2V0Vm90ZXNfZm9yX2hlYWRlckAyCgpnZXRWb3Rlc19lbHNlX2JvZHlANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjM3CiAgICAvLyB2b3Rlcy5wdXNoKHsgaW1wYWN0OiAwLCBpc1VwOiBmYWxzZSB9KQogICAgZGlnIDUKICAgIGR1cAogICAgYnl0ZWMgMzIgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgNgogICAgYiBnZXRWb3Rlc19hZnRlcl9pZl9lbHNlQDYKCmdldFZvdGVzX2FmdGVyX2ZvckA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBkaWcgNgogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmdldFZvdGVzX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBkaWcgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgNCAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE0NgogICAgLy8gcmV0dXJuIG9yaWdpbk9yKHdhbGxldElELCBUeG4uc2VuZGVyKQogICAgYiBnZXRWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDEyCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRSZWFjdGlvbkV4aXN0c1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFJlYWN0aW9uRXhpc3RzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDQKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDYKICAgIC8vIHJldHVybiB0aGlzLnJlYWN0aW9ucyh7IHJlZiwgTkZUIH0pLmV4aXN0cwogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQKICAgIC8vIHJlYWN0aW9ucyA9IEJveE1hcDxSZWFjdGlvbnNLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25zIH0pCiAgICBieXRlYyAyNiAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyNDYKICAgIC8vIHJldHVybiB0aGlzLnJlYWN0aW9ucyh7IHJlZiwgTkZUIH0pLmV4aXN0cwogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTI0NAogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDIKICAgIHNldGJpdAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnJbcm91dGluZ10oKSAtPiB2b2lkOgptYnI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTQKICAgIC8vIG1icihyZWY6IGJ5dGVzKTogQWtpdGFTb2NpYWxNQlJEYXRhIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCAyIDAKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLm1icgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5wYXlXYWxsTWJyW3JvdXRpbmddKCkgLT4gdm9pZDoKcGF5V2FsbE1icjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMAogICAgLy8gcGF5V2FsbE1icihwYXl3YWxsOiBWaWV3UGF5V2FsbFZhbHVlKTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLnBheVdhbGxNYnIKICAgIHBvcAogICAgaXRvYgogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50c1tyb3V0aW5nXSgpIC0+IHZvaWQ6CmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjUxCiAgICAvLyBjaGVja1RpcE1iclJlcXVpcmVtZW50cyhha2l0YURBTzogQXBwbGljYXRpb24sIGNyZWF0b3I6IEFjY291bnQsIHdhbGxldDogQXBwbGljYXRpb24pOiB0aXBNQlJJbmZvIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50cwogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdC51cGRhdGVBa2l0YURBT0VzY3Jvd1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPRXNjcm93OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM3CiAgICAvLyB1cGRhdGVBa2l0YURBT0VzY3JvdyhhcHA6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM4CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDEyIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMzgKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIE9ubHkgdGhlIEFraXRhIERBTyBjYW4gY2FsbCB0aGlzIGZ1bmN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFwcAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzNwogICAgLy8gdXBkYXRlQWtpdGFEQU9Fc2Nyb3coYXBwOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpVcGdyYWRlYWJsZUFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjQ4CiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiBbJ1VwZGF0ZUFwcGxpY2F0aW9uJ10gfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBieXRlYyAxMiAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTEKICAgIC8vIGNvbnN0IHVwZGF0ZVBsdWdpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkudXBkYXRlCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBhcHAgdXBncmFkZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIGJ5dGVjIDI5IC8vICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTMKICAgIC8vIHRoaXMudmVyc2lvbi52YWx1ZSA9IG5ld1ZlcnNpb24KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0OAogICAgLy8gQGFiaW1ldGhvZCh7IGFsbG93QWN0aW9uczogWydVcGRhdGVBcHBsaWNhdGlvbiddIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgMTIgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MAogICAgLy8gdGhpcy5ha2l0YURBTy52YWx1ZSA9IGFraXRhREFPCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzgKICAgIC8vIHVwZGF0ZUFraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNSZXBseShwb3N0VHlwZTogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNSZXBseToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NwogICAgLy8gcHJpdmF0ZSBpc1JlcGx5KHBvc3RUeXBlOiBQb3N0VHlwZSk6IGJvb2xlYW4gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIHJldHVybiBwb3N0VHlwZSA9PT0gUG9zdFR5cGVSZXBseSB8fCBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgPT0KICAgIGJueiBpc1JlcGx5X2Jvb2xfdHJ1ZUAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGJ5dGVjIDE1IC8vIDB4MDMKICAgID09CiAgICBieiBpc1JlcGx5X2Jvb2xfZmFsc2VAMwoKaXNSZXBseV9ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTgKICAgIC8vIHJldHVybiBwb3N0VHlwZSA9PT0gUG9zdFR5cGVSZXBseSB8fCBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIHJldHN1YgoKaXNSZXBseV9ib29sX2ZhbHNlQDM6CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU4CiAgICAvLyByZXR1cm4gcG9zdFR5cGUgPT09IFBvc3RUeXBlUmVwbHkgfHwgcG9zdFR5cGUgPT09IFBvc3RUeXBlRWRpdFJlcGx5CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQW1lbmRlZChyZWY6IGJ5dGVzLCBwb3N0VHlwZTogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNBbWVuZGVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgxCiAgICAvLyBwcml2YXRlIGlzQW1lbmRlZChyZWY6IGJ5dGVzLCBwb3N0VHlwZTogUG9zdFR5cGUpOiBib29sZWFuIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4CiAgICAvLyBjYXNlIFBvc3RUeXBlUG9zdDoKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4LTY5CiAgICAvLyBjYXNlIFBvc3RUeXBlUG9zdDoKICAgIC8vICAgcmV0dXJuIDM2CiAgICBieiBpc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjkKICAgIC8vIHJldHVybiAzNgogICAgcHVzaGludCAzNiAvLyAzNgoKaXNBbWVuZGVkX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRCYXNlUmVmTGVuZ3RoQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyByZXR1cm4gcmVmLmxlbmd0aCA+IGJhc2VMZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgbGVuCiAgICA8CiAgICByZXRzdWIKCmlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzAKICAgIC8vIGNhc2UgUG9zdFR5cGVSZXBseToKICAgIGZyYW1lX2RpZyAtMQogICAgYnl0ZWMgMTggLy8gMHgwMQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MC03MQogICAgLy8gY2FzZSBQb3N0VHlwZVJlcGx5OgogICAgLy8gICByZXR1cm4gNjgKICAgIGJ6IGlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MQogICAgLy8gcmV0dXJuIDY4CiAgICBwdXNoaW50IDY4IC8vIDY4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6ODMKICAgIC8vIGNvbnN0IGJhc2VMZW5ndGggPSB0aGlzLmdldEJhc2VSZWZMZW5ndGgocG9zdFR5cGUpCiAgICBiIGlzQW1lbmRlZF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuZ2V0QmFzZVJlZkxlbmd0aEAxMAoKaXNBbWVuZGVkX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gY2FzZSBQb3N0VHlwZUVkaXRQb3N0OgogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlYyAyNSAvLyAweDAyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcyLTczCiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFBvc3Q6CiAgICAvLyAgIHJldHVybiA2OAogICAgYnogaXNBbWVuZGVkX2FmdGVyX2lmX2Vsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjczCiAgICAvLyByZXR1cm4gNjgKICAgIHB1c2hpbnQgNjggLy8gNjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4MwogICAgLy8gY29uc3QgYmFzZUxlbmd0aCA9IHRoaXMuZ2V0QmFzZVJlZkxlbmd0aChwb3N0VHlwZSkKICAgIGIgaXNBbWVuZGVkX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRCYXNlUmVmTGVuZ3RoQDEwCgppc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0CiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFJlcGx5OgogICAgZnJhbWVfZGlnIC0xCiAgICBieXRlYyAxNSAvLyAweDAzCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjc0LTc1CiAgICAvLyBjYXNlIFBvc3RUeXBlRWRpdFJlcGx5OgogICAgLy8gICByZXR1cm4gMTAwCiAgICBieiBpc0FtZW5kZWRfYWZ0ZXJfaWZfZWxzZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzUKICAgIC8vIHJldHVybiAxMDAKICAgIHB1c2hpbnQgMTAwIC8vIDEwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyBjb25zdCBiYXNlTGVuZ3RoID0gdGhpcy5nZXRCYXNlUmVmTGVuZ3RoKHBvc3RUeXBlKQogICAgYiBpc0FtZW5kZWRfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldEJhc2VSZWZMZW5ndGhAMTAKCmlzQW1lbmRlZF9hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NzcKICAgIC8vIHJldHVybiAzNgogICAgcHVzaGludCAzNiAvLyAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyBjb25zdCBiYXNlTGVuZ3RoID0gdGhpcy5nZXRCYXNlUmVmTGVuZ3RoKHBvc3RUeXBlKQogICAgYiBpc0FtZW5kZWRfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmdldEJhc2VSZWZMZW5ndGhAMTAKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLnRvQnl0ZXMzMih0eXBlOiBieXRlcywgcmVmOiBieXRlcykgLT4gYnl0ZXM6CnRvQnl0ZXMzMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4NwogICAgLy8gcHJpdmF0ZSB0b0J5dGVzMzIodHlwZTogUmVmVHlwZSwgcmVmOiBieXRlcyk6IHsgcmVmQnl0ZXM6IGJ5dGVzPDMyPiwgY3JlYXRvcjogQWNjb3VudCB9IHsKICAgIHByb3RvIDIgMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo4OQogICAgLy8gbGV0IGNyZWF0b3I6IEFjY291bnQgPSBHbG9iYWwuemVyb0FkZHJlc3MKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxCiAgICAvLyBjYXNlIFJlZlR5cGVQb3N0OgogICAgZnJhbWVfZGlnIC0yCiAgICBieXRlYyAxNCAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjkxLTk0CiAgICAvLyBjYXNlIFJlZlR5cGVQb3N0OgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gMzIsIEVSUl9JTlZBTElEX1JFRl9MRU5HVEgpCiAgICAvLyAgIHJlZkJ5dGVzID0gcmVmLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICAvLyAgIGJyZWFrCiAgICBieiB0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTIKICAgIC8vIGFzc2VydChyZWYubGVuZ3RoID09PSAzMiwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCByZWZlcmVuY2UgbGVuZ3RoLCBtdXN0IGJlIDMyIGJ5dGVzCiAgICBmcmFtZV9kaWcgLTEKCnRvQnl0ZXMzMl9ibG9ja0AxOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIHJldHVybiB7IHJlZkJ5dGVzLCBjcmVhdG9yIH0KICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBmcmFtZV9kaWcgMQogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NQogICAgLy8gY2FzZSBSZWZUeXBlQXNzZXQ6CiAgICBmcmFtZV9kaWcgLTIKICAgIGJ5dGVjIDYgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NS0xMDAKICAgIC8vIGNhc2UgUmVmVHlwZUFzc2V0OgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIC8vICAgYXNzZXJ0KEFzc2V0KGJ0b2kocmVmKSkudG90YWwgPiAwLCBFUlJfSU5WQUxJRF9BU1NFVCkKICAgIC8vICAgcmVmQnl0ZXMgPSByZWYuY29uY2F0KG9wLmJ6ZXJvKDI0KSkudG9GaXhlZCh7IGxlbmd0aDogMzIgfSkKICAgIC8vICAgY3JlYXRvciA9IEFzc2V0KGJ0b2kocmVmKSkuY3JlYXRvcgogICAgLy8gICBicmVhawogICAgYnogdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjk2CiAgICAvLyBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHJlZmVyZW5jZSBsZW5ndGgsIG11c3QgYmUgMzIgYnl0ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5NwogICAgLy8gYXNzZXJ0KEFzc2V0KGJ0b2kocmVmKSkudG90YWwgPiAwLCBFUlJfSU5WQUxJRF9BU1NFVCkKICAgIGZyYW1lX2RpZyAtMQogICAgYnRvaQogICAgZHVwCiAgICBhc3NldF9wYXJhbXNfZ2V0IEFzc2V0VG90YWwKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGFzc2VydCAvLyBJbnZhbGlkIGFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OTgKICAgIC8vIHJlZkJ5dGVzID0gcmVmLmNvbmNhdChvcC5iemVybygyNCkpLnRvRml4ZWQoeyBsZW5ndGg6IDMyIH0pCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBiemVybwogICAgZnJhbWVfZGlnIC0xCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgY292ZXIgMgogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gTGVuZ3RoIG11c3QgYmUgMzIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo5OQogICAgLy8gY3JlYXRvciA9IEFzc2V0KGJ0b2kocmVmKSkuY3JlYXRvcgogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIHN3YXAKICAgIGZyYW1lX2J1cnkgMQogICAgYXNzZXJ0IC8vIGFzc2V0IGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMAogICAgLy8gYnJlYWsKICAgIGIgdG9CeXRlczMyX2Jsb2NrQDE5Cgp0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gY2FzZSBSZWZUeXBlQWRkcmVzczoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTAxLTExMQogICAgLy8gY2FzZSBSZWZUeXBlQWRkcmVzczoKICAgIC8vICAgYXNzZXJ0KHJlZi5sZW5ndGggPT09IDMyLCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgLy8gICByZWZCeXRlcyA9IHJlZi50b0ZpeGVkKHsgbGVuZ3RoOiAzMiB9KQogICAgLy8gICBjcmVhdG9yID0gQWNjb3VudChyZWZCeXRlcykKICAgIC8vIAogICAgLy8gICBpZiAodGhpcy5tZXRhKGNyZWF0b3IpLmV4aXN0cykgewogICAgLy8gICAgIGNvbnN0IHsgYWRkcmVzc0dhdGVJRCB9ID0gdGhpcy5tZXRhKGNyZWF0b3IpLnZhbHVlCiAgICAvLyAgICAgYXNzZXJ0KGFkZHJlc3NHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgIC8vICAgfQogICAgLy8gCiAgICAvLyAgIGJyZWFrCiAgICBieiB0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwMgogICAgLy8gYXNzZXJ0KHJlZi5sZW5ndGggPT09IDMyLCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHJlZmVyZW5jZSBsZW5ndGgsIG11c3QgYmUgMzIgYnl0ZXMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMDYKICAgIC8vIGlmICh0aGlzLm1ldGEoY3JlYXRvcikuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHRvQnl0ZXMzMl9hZnRlcl9pZl9lbHNlQDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyBjb25zdCB7IGFkZHJlc3NHYXRlSUQgfSA9IHRoaXMubWV0YShjcmVhdG9yKS52YWx1ZQogICAgZnJhbWVfZGlnIDAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBwdXNoaW50IDU4IC8vIDU4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEwOAogICAgLy8gYXNzZXJ0KGFkZHJlc3NHYXRlSUQgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGhhcyBhIGdhdGUKCnRvQnl0ZXMzMl9hZnRlcl9pZl9lbHNlQDEwOgogICAgZnJhbWVfZGlnIC0xCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gYnJlYWsKICAgIGIgdG9CeXRlczMyX2Jsb2NrQDE5Cgp0b0J5dGVzMzJfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTIKICAgIC8vIGNhc2UgUmVmVHlwZUFwcDoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTEyLTExNwogICAgLy8gY2FzZSBSZWZUeXBlQXBwOgogICAgLy8gICBhc3NlcnQocmVmLmxlbmd0aCA9PT0gOCwgRVJSX0lOVkFMSURfUkVGX0xFTkdUSCkKICAgIC8vICAgYXNzZXJ0KEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuYXBwcm92YWxQcm9ncmFtLmxlbmd0aCA+IDAsIEVSUl9JTlZBTElEX0FQUCkKICAgIC8vICAgcmVmQnl0ZXMgPSByZWYuY29uY2F0KG9wLmJ6ZXJvKDI0KSkudG9GaXhlZCh7IGxlbmd0aDogMzIgfSkKICAgIC8vICAgY3JlYXRvciA9IEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuY3JlYXRvcgogICAgLy8gICBicmVhawogICAgYnogdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTMKICAgIC8vIGFzc2VydChyZWYubGVuZ3RoID09PSA4LCBFUlJfSU5WQUxJRF9SRUZfTEVOR1RIKQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcmVmZXJlbmNlIGxlbmd0aCwgbXVzdCBiZSAzMiBieXRlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gYXNzZXJ0KEFwcGxpY2F0aW9uKGJ0b2kocmVmKSkuYXBwcm92YWxQcm9ncmFtLmxlbmd0aCA+IDAsIEVSUl9JTlZBTElEX0FQUCkKICAgIGZyYW1lX2RpZyAtMQogICAgYnRvaQogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBcHByb3ZhbFByb2dyYW0KICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGxlbgogICAgYXNzZXJ0IC8vIEludmFsaWQgQXBwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE1CiAgICAvLyByZWZCeXRlcyA9IHJlZi5jb25jYXQob3AuYnplcm8oMjQpKS50b0ZpeGVkKHsgbGVuZ3RoOiAzMiB9KQogICAgcHVzaGludCAyNCAvLyAyNAogICAgYnplcm8KICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGxlbgogICAgaW50Y18zIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIExlbmd0aCBtdXN0IGJlIDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE2CiAgICAvLyBjcmVhdG9yID0gQXBwbGljYXRpb24oYnRvaShyZWYpKS5jcmVhdG9yCiAgICBhcHBfcGFyYW1zX2dldCBBcHBDcmVhdG9yCiAgICBzd2FwCiAgICBmcmFtZV9idXJ5IDEKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTcKICAgIC8vIGJyZWFrCiAgICBiIHRvQnl0ZXMzMl9ibG9ja0AxOQoKdG9CeXRlczMyX2FmdGVyX2lmX2Vsc2VAMTQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyBjYXNlIFJlZlR5cGVFeHRlcm5hbDoKICAgIGZyYW1lX2RpZyAtMgogICAgcHVzaGJ5dGVzIDB4MzIKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4LTEyNAogICAgLy8gY2FzZSBSZWZUeXBlRXh0ZXJuYWw6CiAgICAvLyAgIC8vIEV4dGVybmFsIHJlZnMgKFR3aXR0ZXIsIEZhcmNhc3RlciwgZXRjLikgLSByZWYgaXMgdGhlIHBsYXRmb3JtLXByZWZpeGVkIGlkZW50aWZpZXIKICAgIC8vICAgLy8gS2V5IGlzIGRlcml2ZWQgZGV0ZXJtaW5pc3RpY2FsbHk6IHNoYTI1NihyZWYpIHdoZXJlIHJlZiA9ICJwbGF0Zm9ybTpleHRlcm5hbElkIgogICAgLy8gICAvLyBDcmVhdG9yIGlzIHplcm8gYWRkcmVzcyBzaW5jZSBleHRlcm5hbCBjb250ZW50IGhhcyBubyBBbGdvcmFuZCBjcmVhdG9yCiAgICAvLyAgIHJlZkJ5dGVzID0gb3Auc2hhMjU2KHJlZikKICAgIC8vICAgY3JlYXRvciA9IEdsb2JhbC56ZXJvQWRkcmVzcwogICAgLy8gICBicmVhawogICAgYXNzZXJ0IC8vIEludmFsaWQgcmVwbHkgdHlwZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gcmVmQnl0ZXMgPSBvcC5zaGEyNTYocmVmKQogICAgZnJhbWVfZGlnIC0xCiAgICBzaGEyNTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWwuemVyb0FkZHJlc3MKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyBicmVhawogICAgYiB0b0J5dGVzMzJfYmxvY2tAMTkKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmxvY2tlZCh1c2VyOiBieXRlcywgYmxvY2tlZDogYnl0ZXMpIC0+IHVpbnQ2NDoKaXNCbG9ja2VkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEzMgogICAgLy8gcHJpdmF0ZSBpc0Jsb2NrZWQodXNlcjogQWNjb3VudCwgYmxvY2tlZDogQWNjb3VudCk6IGJvb2xlYW4gewogICAgcHJvdG8gMiAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTMzLTEzNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsR3JhcGgucHJvdG90eXBlLmlzQmxvY2tlZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgLy8gICBhcmdzOiBbdXNlciwgYmxvY2tlZF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ncmFwaCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA5IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTMzLTEzNgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsR3JhcGgucHJvdG90eXBlLmlzQmxvY2tlZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdyYXBoLAogICAgLy8gICBhcmdzOiBbdXNlciwgYmxvY2tlZF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHg0MzAzNjY4ZSAvLyBtZXRob2QgImlzQmxvY2tlZChhZGRyZXNzLGFkZHJlc3MpYm9vbCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRTb2NpYWxJbXBhY3RTY29yZShhY2NvdW50OiBieXRlcykgLT4gdWludDY0OgpnZXRTb2NpYWxJbXBhY3RTY29yZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHByaXZhdGUgZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNDIKICAgIC8vIGlmICghdGhpcy5tZXRhKGFjY291bnQpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRTb2NpYWxJbXBhY3RTY29yZV9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTQ2CiAgICAvLyBjb25zdCB7IHN0cmVhaywgc3RhcnREYXRlIH0gPSBjbG9uZSh0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUpCiAgICBmcmFtZV9kaWcgNwogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGZyYW1lX2J1cnkgNgogICAgcHVzaGludCAxNyAvLyAxNwogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE0OQogICAgLy8gaWYgKHN0cmVhayA+PSA2MCkgewogICAgcHVzaGludCA2MCAvLyA2MAogICAgPj0KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTUwCiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gMTAwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGZyYW1lX2J1cnkgNAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE1OAogICAgLy8gY29uc3QgYWNjb3VudEFnZTogdWludDY0ID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIHN0YXJ0RGF0ZQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZnJhbWVfZGlnIDUKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTYwCiAgICAvLyBpZiAoYWNjb3VudEFnZSA+PSBUV09fWUVBUlMpIHsKICAgIGludGMgNyAvLyA2MzA3MjAwMAogICAgPj0KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTYxCiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gNzUKICAgIGZyYW1lX2RpZyA0CiAgICBwdXNoaW50IDc1IC8vIDc1CiAgICArCiAgICBmcmFtZV9idXJ5IDQKCmdldFNvY2lhbEltcGFjdFNjb3JlX2FmdGVyX2lmX2Vsc2VAODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MAogICAgLy8gdm90ZXMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBWb3Rlc1ZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhWb3RlcyB9KQogICAgcHVzaGJ5dGVzICJ2IgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyBpZiAodGhpcy52b3RlcyhhY2NvdW50LmJ5dGVzKS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE2OQogICAgLy8gY29uc3QgeyB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMudm90ZXMoYWNjb3VudC5ieXRlcykudmFsdWUKICAgIGZyYW1lX2RpZyAwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIHB1c2hpbnQgNjQgLy8gNjQKICAgIGdldGJpdAogICAgZnJhbWVfYnVyeSAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyBsZXQgaW1wYWN0OiB1aW50NjQgPSAodm90ZUNvdW50ICogNzUpIC8gMTAwXzAwMAogICAgcHVzaGludCA3NSAvLyA3NQogICAgKgogICAgaW50YyA0IC8vIDEwMDAwMAogICAgLwogICAgZHVwCiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNzIKICAgIC8vIGlmIChpbXBhY3QgPiA3NSkgewogICAgcHVzaGludCA3NSAvLyA3NQogICAgPgogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE3MwogICAgLy8gaW1wYWN0ID0gNzUKICAgIHB1c2hpbnQgNzUgLy8gNzUKICAgIGZyYW1lX2J1cnkgMgoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNzYKICAgIC8vIGlmIChpc05lZ2F0aXZlKSB7CiAgICBmcmFtZV9kaWcgMwogICAgYnogZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyBpZiAoc29jaWFsSW1wYWN0ID4gaW1wYWN0KSB7CiAgICBmcmFtZV9kaWcgNAogICAgZnJhbWVfZGlnIDIKICAgID4KICAgIGJ6IGdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE3OQogICAgLy8gc29jaWFsSW1wYWN0IC09IGltcGFjdAogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyAyCiAgICAtCiAgICBmcmFtZV9idXJ5IDQKCmdldFNvY2lhbEltcGFjdFNjb3JlX2FmdGVyX2lmX2Vsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTg5CiAgICAvLyByZXR1cm4gc29jaWFsSW1wYWN0CiAgICBmcmFtZV9kaWcgNAogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCmdldFNvY2lhbEltcGFjdFNjb3JlX2Vsc2VfYm9keUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIHNvY2lhbEltcGFjdCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDE2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE4NQogICAgLy8gc29jaWFsSW1wYWN0ICs9IGltcGFjdAogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyAyCiAgICArCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUAxOAoKZ2V0U29jaWFsSW1wYWN0U2NvcmVfZWxzZV9ib2R5QDc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyBzb2NpYWxJbXBhY3QgKz0gKGFjY291bnRBZ2UgKiA3NSkgLyBUV09fWUVBUlMKICAgIGZyYW1lX2RpZyAxCiAgICBwdXNoaW50IDc1IC8vIDc1CiAgICAqCiAgICBpbnRjIDcgLy8gNjMwNzIwMDAKICAgIC8KICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA4CgpnZXRTb2NpYWxJbXBhY3RTY29yZV9lbHNlX2JvZHlANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIHNvY2lhbEltcGFjdCArPSAoc3RyZWFrICogMTAwKSAvIDYwCiAgICBmcmFtZV9kaWcgNgogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICAqCiAgICBwdXNoaW50IDYwIC8vIDYwCiAgICAvCiAgICBmcmFtZV9idXJ5IDQKICAgIGIgZ2V0U29jaWFsSW1wYWN0U2NvcmVfYWZ0ZXJfaWZfZWxzZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5nZXRVc2VySW1wYWN0KGFjY291bnQ6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFVzZXJJbXBhY3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTkyCiAgICAvLyBwcml2YXRlIGdldFVzZXJJbXBhY3QoYWNjb3VudDogQWNjb3VudCk6IHVpbnQ2NCB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTMtMTk2CiAgICAvLyBjb25zdCBpbXBhY3QgPSBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbEltcGFjdC5wcm90b3R5cGUuZ2V0VXNlckltcGFjdFdpdGhvdXRTb2NpYWw+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICAvLyAgIGFyZ3M6IFthY2NvdW50XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTQKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuaW1wYWN0LAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5NAogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjE5My0xOTYKICAgIC8vIGNvbnN0IGltcGFjdCA9IGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5nZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmltcGFjdCwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4ZjgxYzdiNjIgLy8gbWV0aG9kICJnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbChhZGRyZXNzKXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxOTgKICAgIC8vIHJldHVybiBpbXBhY3QgKyB0aGlzLmdldFNvY2lhbEltcGFjdFNjb3JlKGFjY291bnQpCiAgICBmcmFtZV9kaWcgLTEKICAgIGNhbGxzdWIgZ2V0U29jaWFsSW1wYWN0U2NvcmUKICAgICsKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwudGlwQ3JlYXRvcihjcmVhdG9yOiBieXRlcywgZmVlOiB1aW50NjQsIHRheDogdWludDY0KSAtPiB1aW50NjQ6CnRpcENyZWF0b3I6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjkwCiAgICAvLyBwcml2YXRlIHRpcENyZWF0b3IoY3JlYXRvcjogQWNjb3VudCwgZmVlOiB1aW50NjQsIHRheDogdWludDY0KTogdWludDY0IHsKICAgIHByb3RvIDMgMQogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTEKICAgIC8vIGlmIChjcmVhdG9yID09PSBHbG9iYWwuemVyb0FkZHJlc3MpIHsKICAgIGZyYW1lX2RpZyAtMwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICA9PQogICAgYnogdGlwQ3JlYXRvcl9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTIKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5MgogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5NAogICAgLy8gY29uc3QgYWtpdGFBc3NldHNCeXRlcyA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXNzZXRzKSlbMF0KICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyOTIKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjkzLTI5OQogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBmZWUsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5My0yOTgKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogZmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI5My0yOTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogZmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzAwCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDMKICAgIC8vIGxldCB3YWxsZXQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OAogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeE1ldGEgfSkKICAgIGJ5dGVjIDUgLy8gIm0iCiAgICBmcmFtZV9kaWcgLTMKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDQKICAgIC8vIGlmICh0aGlzLm1ldGEoY3JlYXRvcikuZXhpc3RzKSB7CiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IHRpcENyZWF0b3JfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzA1CiAgICAvLyB3YWxsZXQgPSB0aGlzLm1ldGEoY3JlYXRvcikudmFsdWUud2FsbGV0CiAgICBmcmFtZV9kaWcgMAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGludGNfMSAvLyAxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSA2Cgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDgKICAgIC8vIGNvbnN0IHsgdHlwZSwgYXJjNTggfSA9IHRoaXMuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHModGhpcy5ha2l0YURBTy52YWx1ZSwgY3JlYXRvciwgQXBwbGljYXRpb24od2FsbGV0KSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDgKICAgIC8vIGNvbnN0IHsgdHlwZSwgYXJjNTggfSA9IHRoaXMuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHModGhpcy5ha2l0YURBTy52YWx1ZSwgY3JlYXRvciwgQXBwbGljYXRpb24od2FsbGV0KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9kaWcgLTMKICAgIGZyYW1lX2RpZyA2CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50cwogICAgZHVwCiAgICBleHRyYWN0IDAgMQogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzA5CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMDkKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB7IGxlZnRvdmVyIH0gPSBzZW5kUmVmZXJyYWxQYXltZW50KHRoaXMuYWtpdGFEQU8udmFsdWUsIGFrdGEsIHRheCkKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiBzZW5kUmVmZXJyYWxQYXltZW50CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxMQogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxMQogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTMKICAgIC8vIGlmICh0eXBlID09PSBUaXBTZW5kVHlwZUFSQzU4KSB7CiAgICBieXRlYyA2IC8vIDB4MTQKICAgID09CiAgICBieiB0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMxNAogICAgLy8gdGhpcy5hcmM1OFNlbmRSZWFjdGlvblBheW1lbnRzKEFwcGxpY2F0aW9uKHdhbGxldCksIGFrdGEsIGxlZnRvdmVyLCAocmVhY3RGZWUgLSB0YXgpKQogICAgZnJhbWVfZGlnIC0xCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBmcmFtZV9kaWcgNgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyA0IC8vICJjb250cm9sbGVkX2FkZHJlc3MiCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MS0xNjQKICAgIC8vIGNvbnN0IFtjb250cm9sbGVkQWNjb3VudEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXRJRCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjE3CiAgICAvLyBjb25zdCBvcHRpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkub3B0aW4KICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMTcKICAgIC8vIGNvbnN0IG9wdGluID0gZ2V0UGx1Z2luQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5vcHRpbgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAxNyAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIxNwogICAgLy8gY29uc3Qgb3B0aW4gPSBnZXRQbHVnaW5BcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm9wdGluCiAgICBkdXAKICAgIGV4dHJhY3QgMCA4CiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMTktMjI4CiAgICAvLyBpdHhuQ29tcG9zZS5iZWdpbjx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3Jla2V5VG9QbHVnaW4+KHsKICAgIC8vICAgYXBwSWQ6IHdhbGxldCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG9wdGluLAogICAgLy8gICAgIHRydWUsIC8vIGdsb2JhbAogICAgLy8gICAgICcnLCAvLyBkZWZhdWx0IGFjY291bnQKICAgIC8vICAgICBbXSwgLy8gbm8gbWV0aG9kIG9mZnNldHMKICAgIC8vICAgICBbXSAvLyBubyBmdW5kcyByZXF1ZXN0CiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICBieXRlYyAzMCAvLyBtZXRob2QgImFyYzU4X3Jla2V5VG9QbHVnaW4odWludDY0LGJvb2wsc3RyaW5nLHVpbnQ2NFtdLCh1aW50NjQsdWludDY0KVtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjMKICAgIC8vIHRydWUsIC8vIGdsb2JhbAogICAgYnl0ZWMgMTMgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vICcnLCAvLyBkZWZhdWx0IGFjY291bnQKICAgIGJ5dGVjIDExIC8vIDB4MDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMjUKICAgIC8vIFtdLCAvLyBubyBtZXRob2Qgb2Zmc2V0cwogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gW10gLy8gbm8gZnVuZHMgcmVxdWVzdAogICAgYnl0ZWMgMTEgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIxOS0yMjgKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgb3B0aW4sCiAgICAvLyAgICAgdHJ1ZSwgLy8gZ2xvYmFsCiAgICAvLyAgICAgJycsIC8vIGRlZmF1bHQgYWNjb3VudAogICAgLy8gICAgIFtdLCAvLyBubyBtZXRob2Qgb2Zmc2V0cwogICAgLy8gICAgIFtdIC8vIG5vIGZ1bmRzIHJlcXVlc3QKICAgIC8vICAgXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMzAtMjQxCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KHsKICAgIC8vICAgYXBwSWQ6IG9wdGluLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgd2FsbGV0LAogICAgLy8gICAgIHRydWUsIC8vIHJla2V5IGJhY2sgaW1tZWRpYXRlbHkKICAgIC8vICAgICBbYXNzZXRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSwKICAgIC8vICAgICAgIHJlY2VpdmVyOiBvcmlnaW4KICAgIC8vICAgICB9KQogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNwogICAgLy8gYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGRpZyAzCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNi0yMzkKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlLAogICAgLy8gICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzMC0yNDEKICAgIC8vIGl0eG5Db21wb3NlLm5leHQ8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogb3B0aW4sCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwgLy8gcmVrZXkgYmFjayBpbW1lZGlhdGVseQogICAgLy8gICAgIFthc3NldF0sCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlLAogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbgogICAgLy8gICAgIH0pCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyB3YWxsZXQsCiAgICBkaWcgMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gW2Fzc2V0XSwKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGl0b2IKICAgIGJ5dGVjIDIyIC8vIDB4MDAwMQogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMwLTI0MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLCAvLyByZWtleSBiYWNrIGltbWVkaWF0ZWx5CiAgICAvLyAgICAgW2Fzc2V0XSwKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICAvLyAgICAgICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyAgICAgfSkKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBieXRlYyAyMyAvLyBtZXRob2QgIm9wdEluKHVpbnQ2NCxib29sLHVpbnQ2NFtdLHBheSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjIzCiAgICAvLyB0cnVlLCAvLyBnbG9iYWwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjMwLTI0MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHdhbGxldCwKICAgIC8vICAgICB0cnVlLCAvLyByZWtleSBiYWNrIGltbWVkaWF0ZWx5CiAgICAvLyAgICAgW2Fzc2V0XSwKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgYW1vdW50OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UsCiAgICAvLyAgICAgICByZWNlaXZlcjogb3JpZ2luCiAgICAvLyAgICAgfSkKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjQzLTI0NQogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIGJ5dGVjIDMxIC8vIG1ldGhvZCAiYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3MoKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjQ3LTI1MwogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHRheCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyApCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBkaWcgMQogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGZyYW1lX2RpZyA0CiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNDgtMjUyCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiB0YXgsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjU1LTI2MQogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHJlbWFpbmRlciwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyApCiAgICBpdHhuX25leHQKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNTYtMjYwCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgIGFzc2V0QW1vdW50OiByZW1haW5kZXIsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjYzCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTUKICAgIC8vIHJldHVybiBhcmM1OAogICAgZnJhbWVfZGlnIDMKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMTgKICAgIC8vIHRoaXMuc2VuZERpcmVjdFJlYWN0aW9uUGF5bWVudHMoY3JlYXRvciwgYWt0YSwgbGVmdG92ZXIsIChyZWFjdEZlZSAtIHRheCkpCiAgICBmcmFtZV9kaWcgLTEKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjY5CiAgICAvLyBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjY5CiAgICAvLyBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgc3dhcAogICAgZnJhbWVfYnVyeSAxCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBpZiAocmVtYWluZGVyID4gMCAmJiBjcmVhdG9yLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBieiB0aXBDcmVhdG9yX2Vsc2VfYm9keUAxMQogICAgZnJhbWVfZGlnIC0zCiAgICBmcmFtZV9kaWcgMgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGJ6IHRpcENyZWF0b3JfZWxzZV9ib2R5QDExCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBpdHhuLnN1Ym1pdEdyb3VwKHRheFR4biwgeGZlclR4bikKICAgIGl0eG5fYmVnaW4KICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBmcmFtZV9kaWcgNAogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgZnJhbWVfZGlnIDEKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI2OC0yNzIKICAgIC8vIGNvbnN0IHRheFR4biA9IGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgYXNzZXRBbW91bnQ6IHRheCwKICAgIC8vICAgeGZlckFzc2V0OiBhc3NldAogICAgLy8gfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyODEKICAgIC8vIGl0eG4uc3VibWl0R3JvdXAodGF4VHhuLCB4ZmVyVHhuKQogICAgaXR4bl9uZXh0CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZnJhbWVfZGlnIDUKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAtMwogICAgaXR4bl9maWVsZCBBc3NldFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjc1LTI3OQogICAgLy8gY29uc3QgeGZlclR4biA9IGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IGNyZWF0b3IsCiAgICAvLyAgIGFzc2V0QW1vdW50OiByZW1haW5kZXIsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBpdHhuLnN1Ym1pdEdyb3VwKHRheFR4biwgeGZlclR4bikKICAgIGl0eG5fc3VibWl0Cgp0aXBDcmVhdG9yX2FmdGVyX2lmX2Vsc2VAMTI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzE5CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp0aXBDcmVhdG9yX2Vsc2VfYm9keUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyODQKICAgIC8vIGFzc2V0QW1vdW50OiB0YXggKyByZW1haW5kZXIKICAgIGZyYW1lX2RpZyA0CiAgICBmcmFtZV9kaWcgNQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjI4NgogICAgLy8gdGF4VHhuLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICBmcmFtZV9kaWcgMgogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAxCiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyNjgtMjcyCiAgICAvLyBjb25zdCB0YXhUeG4gPSBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0QW1vdW50OiB0YXgsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mjg2CiAgICAvLyB0YXhUeG4uc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICBiIHRpcENyZWF0b3JfYWZ0ZXJfaWZfZWxzZUAxMgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3Nhcnkoa2V5OiBieXRlcywgY3JlYXRvcjogYnl0ZXMpIC0+IHVpbnQ2NDoKY3JlYXRlRW1wdHlQb3N0SWZOZWNlc3Nhcnk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBwcml2YXRlIGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5KGtleTogYnl0ZXM8MzI+LCBjcmVhdG9yOiBBY2NvdW50KTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTIKICAgIGNvbmNhdAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzIzCiAgICAvLyBpZiAoIXRoaXMucG9zdHMoa2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMzNAogICAgLy8gdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjMyNC0zNDAKICAgIC8vIHRoaXMucG9zdHMoa2V5KS52YWx1ZSA9IHsKICAgIC8vICAgcmVmOiBvcC5iemVybygwKSwKICAgIC8vICAgLyoqCiAgICAvLyAgICAqIHdoZW4gYSB1c2VyIHJlYWN0cyB0byBjb250ZW50IG90aGVyIHRoYW4gcG9zdHMKICAgIC8vICAgICogd2Ugc2V0IHRoZSBjcmVhdG9yIHRvIHRoZSBmb2xsb3dpbmc6CiAgICAvLyAgICAqIC0gQXNzZXRJRDogQXNzZXQgQ3JlYXRvcgogICAgLy8gICAgKiAtIEFkZHJlc3M6IEFjY291bnQKICAgIC8vICAgICogLSAgIEFwcElEOiBBcHBsaWNhdGlvbiBDcmVhdG9yCiAgICAvLyAgICAqLwogICAgLy8gICBjcmVhdG9yLAogICAgLy8gICB0aW1lc3RhbXA6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGdhdGVJRDogMCwKICAgIC8vICAgdXNlUGF5V2FsbDogZmFsc2UsCiAgICAvLyAgIHBheVdhbGxJRDogMCwKICAgIC8vICAgYWdhaW5zdENvbnRlbnRQb2xpY3k6IGZhbHNlLAogICAgLy8gICBwb3N0VHlwZTogUG9zdFR5cGVQb3N0LAogICAgLy8gfQogICAgaXRvYgogICAgZnJhbWVfZGlnIC0xCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozMzUKICAgIC8vIGdhdGVJRDogMCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzI0LTM0MAogICAgLy8gdGhpcy5wb3N0cyhrZXkpLnZhbHVlID0gewogICAgLy8gICByZWY6IG9wLmJ6ZXJvKDApLAogICAgLy8gICAvKioKICAgIC8vICAgICogd2hlbiBhIHVzZXIgcmVhY3RzIHRvIGNvbnRlbnQgb3RoZXIgdGhhbiBwb3N0cwogICAgLy8gICAgKiB3ZSBzZXQgdGhlIGNyZWF0b3IgdG8gdGhlIGZvbGxvd2luZzoKICAgIC8vICAgICogLSBBc3NldElEOiBBc3NldCBDcmVhdG9yCiAgICAvLyAgICAqIC0gQWRkcmVzczogQWNjb3VudAogICAgLy8gICAgKiAtICAgQXBwSUQ6IEFwcGxpY2F0aW9uIENyZWF0b3IKICAgIC8vICAgICovCiAgICAvLyAgIGNyZWF0b3IsCiAgICAvLyAgIHRpbWVzdGFtcDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZ2F0ZUlEOiAwLAogICAgLy8gICB1c2VQYXlXYWxsOiBmYWxzZSwKICAgIC8vICAgcGF5V2FsbElEOiAwLAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZVBvc3QsCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MDAKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBwdXNoYnl0ZXMgMHgwMDAwMDAzZDAwMDAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzQyCiAgICAvLyByZXR1cm4gdGhpcy5tYnIoQnl0ZXMoJycpKS5wb3N0cwogICAgcHVzaGJ5dGVzICIiCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICByZXRzdWIKCmNyZWF0ZUVtcHR5UG9zdElmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDQKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVTdHJlYWsoYWNjb3VudDogYnl0ZXMpIC0+IHZvaWQ6CnVwZGF0ZVN0cmVhazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDcKICAgIC8vIHByaXZhdGUgdXBkYXRlU3RyZWFrKGFjY291bnQ6IEFjY291bnQpOiB2b2lkIHsKICAgIHByb3RvIDEgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNDgKICAgIC8vIGFzc2VydCh0aGlzLm1ldGEoYWNjb3VudCkuZXhpc3RzLCBFUlJfTUVUQV9ET0VTTlRfRVhJU1QpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBNZXRhIGJveCB2YWx1ZXMgZG9udCBleGlzdCB5ZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNTAKICAgIC8vIGNvbnN0IHsgc3RhcnREYXRlLCBsYXN0QWN0aXZlIH0gPSB0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBkdXAKICAgIHB1c2hpbnQgMTcgLy8gMTcKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICBwdXNoaW50IDI1IC8vIDI1CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb3ZlciAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCB0aGlzV2luZG93U3RhcnQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSAoKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSBzdGFydERhdGUpICUgT05FX0RBWSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICAtCiAgICBpbnRjIDggLy8gODY0MDAKICAgICUKICAgIC0KICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM1MwogICAgLy8gY29uc3QgbGFzdFdpbmRvd1N0YXJ0OiB1aW50NjQgPSB0aGlzV2luZG93U3RhcnQgLSBPTkVfREFZCiAgICBpbnRjIDggLy8gODY0MDAKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNTUKICAgIC8vIHRoaXMubWV0YShhY2NvdW50KS52YWx1ZS5sYXN0QWN0aXZlID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaXRvYgogICAgdW5jb3ZlciAzCiAgICBwdXNoaW50IDI1IC8vIDI1CiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzU4CiAgICAvLyBpZiAobGFzdFdpbmRvd1N0YXJ0ID4gbGFzdEFjdGl2ZSkgewogICAgPAogICAgYnogdXBkYXRlU3RyZWFrX2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM1OQogICAgLy8gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlLnN0cmVhayA9IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgMAogICAgcHVzaGludCA5IC8vIDkKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNjAKICAgIC8vIHJldHVybgogICAgcmV0c3ViCgp1cGRhdGVTdHJlYWtfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2NQogICAgLy8gaWYgKGxhc3RBY3RpdmUgPCB0aGlzV2luZG93U3RhcnQpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgMgogICAgPAogICAgYnogdXBkYXRlU3RyZWFrX2FmdGVyX2lmX2Vsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2NgogICAgLy8gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlLnN0cmVhayArPSAxCiAgICBmcmFtZV9kaWcgMAogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgcHVzaGludCA5IC8vIDkKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCgp1cGRhdGVTdHJlYWtfYWZ0ZXJfaWZfZWxzZUA0OgogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC51cGRhdGVWb3RlcyhyZWY6IGJ5dGVzLCBpc1VwOiB1aW50NjQsIGltcGFjdDogdWludDY0KSAtPiB2b2lkOgp1cGRhdGVWb3RlczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDIKICAgIC8vIHByaXZhdGUgdXBkYXRlVm90ZXMocmVmOiBieXRlczwzMj4sIGlzVXA6IGJvb2xlYW4sIGltcGFjdDogdWludDY0KTogdm9pZCB7CiAgICBwcm90byAzIDAKICAgIHB1c2hieXRlcyAiIgogICAgZHVwbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAKICAgIC8vIHZvdGVzID0gQm94TWFwPGJ5dGVzPDMyPiwgVm90ZXNWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZXMgfSkKICAgIHB1c2hieXRlcyAidiIKICAgIGZyYW1lX2RpZyAtMwogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNzMKICAgIC8vIGlmICghdGhpcy52b3RlcyhyZWYpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogdXBkYXRlVm90ZXNfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzc0CiAgICAvLyByZXR1cm4geyBuZXdDb3VudDogaW1wYWN0LCBpc05lZ2F0aXZlOiAhaXNVcCB9CiAgICBmcmFtZV9kaWcgLTIKICAgICEKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzCiAgICBzZXRiaXQKICAgIGNvbmNhdAoKdXBkYXRlVm90ZXNfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNhbGNWb3Rlc0AyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBkdXAKICAgIGV4dHJhY3QgMCA4CiAgICBzd2FwCiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDQKICAgIC8vIHRoaXMudm90ZXMocmVmKS52YWx1ZSA9IHsgdm90ZUNvdW50LCBpc05lZ2F0aXZlIH0KICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMgogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAzCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICByZXRzdWIKCnVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNzcKICAgIC8vIGNvbnN0IHsgaXNOZWdhdGl2ZSwgdm90ZUNvdW50IH0gPSB0aGlzLnZvdGVzKHJlZikudmFsdWUKICAgIGZyYW1lX2RpZyAzCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgZHVwCiAgICBwdXNoaW50IDY0IC8vIDY0CiAgICBnZXRiaXQKICAgIGZyYW1lX2J1cnkgMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozODAKICAgIC8vIGNvbnN0IGRpZmZlcmluZ0RpcmVjdGlvbnMgPSAoaXNVcCAmJiBpc05lZ2F0aXZlKSB8fCAoIWlzVXAgJiYgIWlzTmVnYXRpdmUpCiAgICBmcmFtZV9kaWcgLTIKICAgIGJ6IHVwZGF0ZVZvdGVzX29yX2NvbnRkQDUKICAgIGZyYW1lX2RpZyAxCiAgICBibnogdXBkYXRlVm90ZXNfYm9vbF90cnVlQDcKCnVwZGF0ZVZvdGVzX29yX2NvbnRkQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzgwCiAgICAvLyBjb25zdCBkaWZmZXJpbmdEaXJlY3Rpb25zID0gKGlzVXAgJiYgaXNOZWdhdGl2ZSkgfHwgKCFpc1VwICYmICFpc05lZ2F0aXZlKQogICAgZnJhbWVfZGlnIC0yCiAgICBibnogdXBkYXRlVm90ZXNfYm9vbF9mYWxzZUA4CiAgICBmcmFtZV9kaWcgMQogICAgYm56IHVwZGF0ZVZvdGVzX2Jvb2xfZmFsc2VAOAoKdXBkYXRlVm90ZXNfYm9vbF90cnVlQDc6CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSAwCgp1cGRhdGVWb3Rlc19ib29sX21lcmdlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzgyCiAgICAvLyBpZiAodm90ZUNvdW50ID09PSAwKSB7CiAgICBmcmFtZV9kaWcgMgogICAgYm56IHVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozODMKICAgIC8vIHJldHVybiB7IG5ld0NvdW50OiBpbXBhY3QsIGlzTmVnYXRpdmU6ICFpc1VwIH0KICAgIGZyYW1lX2RpZyAtMgogICAgIQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDMKICAgIHNldGJpdAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAzCiAgICAvLyBjb25zdCB7IG5ld0NvdW50OiB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMuY2FsY1ZvdGVzKHJlZiwgaXNVcCwgaW1wYWN0KQogICAgYiB1cGRhdGVWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY2FsY1ZvdGVzQDIwCgp1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4NgogICAgLy8gaWYgKGltcGFjdCA9PT0gdm90ZUNvdW50ICYmIGRpZmZlcmluZ0RpcmVjdGlvbnMpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfZGlnIDIKICAgID09CiAgICBieiB1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE0CiAgICBmcmFtZV9kaWcgMAogICAgYnogdXBkYXRlVm90ZXNfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4NwogICAgLy8gcmV0dXJuIHsgbmV3Q291bnQ6IDAsIGlzTmVnYXRpdmU6IGZhbHNlIH0KICAgIGJ5dGVjIDMyIC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDAzCiAgICAvLyBjb25zdCB7IG5ld0NvdW50OiB2b3RlQ291bnQsIGlzTmVnYXRpdmUgfSA9IHRoaXMuY2FsY1ZvdGVzKHJlZiwgaXNVcCwgaW1wYWN0KQogICAgYiB1cGRhdGVWb3Rlc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY2FsY1ZvdGVzQDIwCgp1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM5MgogICAgLy8gY29uc3QgZmxpcCA9IGltcGFjdCA+IHZvdGVDb3VudCAmJiBkaWZmZXJpbmdEaXJlY3Rpb25zCiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2RpZyAyCiAgICA+CiAgICBmcmFtZV9kaWcgMAogICAgJiYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozOTMKICAgIC8vIGlmIChmbGlwKSB7CiAgICBieiB1cGRhdGVWb3Rlc19hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk0CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gaW1wYWN0IC0gdm90ZUNvdW50CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2RpZyAyCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk1CiAgICAvLyByZXR1cm4geyBuZXdDb3VudCwgaXNOZWdhdGl2ZTogIWlzTmVnYXRpdmUgfQogICAgZnJhbWVfZGlnIDEKICAgICEKICAgIHN3YXAKICAgIGl0b2IKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgMwogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBiIHVwZGF0ZVZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jYWxjVm90ZXNAMjAKCnVwZGF0ZVZvdGVzX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk4CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gZGlmZmVyaW5nRGlyZWN0aW9ucyA/IHZvdGVDb3VudCAtIGltcGFjdCA6IHZvdGVDb3VudCArIGltcGFjdAogICAgZnJhbWVfZGlnIDAKICAgIGJ6IHVwZGF0ZVZvdGVzX3Rlcm5hcnlfZmFsc2VAMTgKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgLTEKICAgIC0KCnVwZGF0ZVZvdGVzX3Rlcm5hcnlfbWVyZ2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk5CiAgICAvLyByZXR1cm4geyBuZXdDb3VudCwgaXNOZWdhdGl2ZSB9CiAgICBpdG9iCiAgICBieXRlY18zIC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMQogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDMKICAgIC8vIGNvbnN0IHsgbmV3Q291bnQ6IHZvdGVDb3VudCwgaXNOZWdhdGl2ZSB9ID0gdGhpcy5jYWxjVm90ZXMocmVmLCBpc1VwLCBpbXBhY3QpCiAgICBiIHVwZGF0ZVZvdGVzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jYWxjVm90ZXNAMjAKCnVwZGF0ZVZvdGVzX3Rlcm5hcnlfZmFsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Mzk4CiAgICAvLyBjb25zdCBuZXdDb3VudDogdWludDY0ID0gZGlmZmVyaW5nRGlyZWN0aW9ucyA/IHZvdGVDb3VudCAtIGltcGFjdCA6IHZvdGVDb3VudCArIGltcGFjdAogICAgZnJhbWVfZGlnIDIKICAgIGZyYW1lX2RpZyAtMQogICAgKwogICAgYiB1cGRhdGVWb3Rlc190ZXJuYXJ5X21lcmdlQDE5Cgp1cGRhdGVWb3Rlc19ib29sX2ZhbHNlQDg6CiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAwCiAgICBiIHVwZGF0ZVZvdGVzX2Jvb2xfbWVyZ2VAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlVm90ZUxpc3QocmVmOiBieXRlcywgaXNVcDogdWludDY0LCBhY2NvdW50OiBieXRlcywgaW1wYWN0OiB1aW50NjQpIC0+IHZvaWQ6CmNyZWF0ZVZvdGVMaXN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQwNwogICAgLy8gcHJpdmF0ZSBjcmVhdGVWb3RlTGlzdChyZWY6IGJ5dGVzPDMyPiwgaXNVcDogYm9vbGVhbiwgYWNjb3VudDogQWNjb3VudCwgaW1wYWN0OiB1aW50NjQpOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQwOAogICAgLy8gY29uc3Qgdm90ZUxpc3RLZXk6IFZvdGVMaXN0S2V5ID0geyB1c2VyOiBiMTYoYWNjb3VudC5ieXRlcyksIHJlZjogYjE2KHJlZikgfQogICAgZnJhbWVfZGlnIC0yCiAgICBjYWxsc3ViIGIxNgogICAgZnJhbWVfZGlnIC00CiAgICBjYWxsc3ViIGIxNgogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDA5CiAgICAvLyB0aGlzLnZvdGVsaXN0KHZvdGVMaXN0S2V5KS52YWx1ZSA9IHsgaW1wYWN0LCBpc1VwIH0KICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIC0zCiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyCiAgICAvLyB2b3RlbGlzdCA9IEJveE1hcDxWb3RlTGlzdEtleSwgVm90ZUxpc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4Vm90ZUxpc3QgfSkKICAgIGJ5dGVjIDE2IC8vICJvIgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MDkKICAgIC8vIHRoaXMudm90ZWxpc3Qodm90ZUxpc3RLZXkpLnZhbHVlID0geyBpbXBhY3QsIGlzVXAgfQogICAgc3dhcAogICAgYm94X3B1dAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5jcmVhdGVQb3N0KHBvc3RLZXk6IGJ5dGVzLCBvcmlnaW46IGJ5dGVzLCBtYnJQYXltZW50OiB1aW50NjQsIGNpZDogYnl0ZXMsIGdhdGVJRDogdWludDY0LCB1c2VQYXlXYWxsOiB1aW50NjQsIHBheVdhbGxJRDogdWludDY0LCBwb3N0VHlwZTogYnl0ZXMsIGFtZW5kbWVudE9mOiBieXRlcykgLT4gdm9pZDoKY3JlYXRlUG9zdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MTItNDIyCiAgICAvLyBwcml2YXRlIGNyZWF0ZVBvc3QoCiAgICAvLyAgIHBvc3RLZXk6IGJ5dGVzPDMyPiwKICAgIC8vICAgb3JpZ2luOiBBY2NvdW50LAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGNpZDogQ0lELAogICAgLy8gICBnYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgdXNlUGF5V2FsbDogYm9vbGVhbiwKICAgIC8vICAgcGF5V2FsbElEOiB1aW50NjQsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZSwKICAgIC8vICAgYW1lbmRtZW50T2Y6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gOSAwCiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDIzCiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCYW5uZWQob3JpZ2luKSwgRVJSX0JBTk5FRCkKICAgIGZyYW1lX2RpZyAtOAogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJhbm5lZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNQogICAgLy8gKCF1c2VQYXlXYWxsICYmIHBheVdhbGxJRCA9PT0gMCkgfHwgKAogICAgZnJhbWVfZGlnIC00CiAgICBibnogY3JlYXRlUG9zdF9vcl9jb250ZEAyCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ6IGNyZWF0ZVBvc3RfYm9vbF90cnVlQDcKCmNyZWF0ZVBvc3Rfb3JfY29udGRAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjYtNDI5CiAgICAvLyB1c2VQYXlXYWxsICYmICgKICAgIC8vICAgcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgLy8gICBwYXlXYWxsSUQgPT09IDAgJiYgdGhpcy5wYXl3YWxsKHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cwogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBieiBjcmVhdGVQb3N0X2Jvb2xfZmFsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNwogICAgLy8gcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgZnJhbWVfZGlnIC0zCiAgICBieiBjcmVhdGVQb3N0X29yX2NvbnRkQDUKICAgIGZyYW1lX2RpZyAtMwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjcKICAgIC8vIHBheVdhbGxJRCAhPT0gMCAmJiB0aGlzLnBheXdhbGwocGF5V2FsbElEKS5leGlzdHMgfHwKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGNyZWF0ZVBvc3RfYm9vbF90cnVlQDcKCmNyZWF0ZVBvc3Rfb3JfY29udGRANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHBheVdhbGxJRCA9PT0gMCAmJiB0aGlzLnBheXdhbGwodGhpcy5tZXRhKG9yaWdpbikudmFsdWUuZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzCiAgICBmcmFtZV9kaWcgLTMKICAgIGJueiBjcmVhdGVQb3N0X2Jvb2xfZmFsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtOAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDI4CiAgICAvLyBwYXlXYWxsSUQgPT09IDAgJiYgdGhpcy5wYXl3YWxsKHRoaXMubWV0YShvcmlnaW4pLnZhbHVlLmRlZmF1bHRQYXlXYWxsSUQpLmV4aXN0cwogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGV4dHJhY3QgNjYgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM4CiAgICAvLyBwYXl3YWxsID0gQm94TWFwPHVpbnQ2NCwgVmlld1BheVdhbGxWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsYm94UHJlZml4UGF5V2FsbCB9KQogICAgYnl0ZWMgMTkgLy8gInciCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIHBheVdhbGxJRCA9PT0gMCAmJiB0aGlzLnBheXdhbGwodGhpcy5tZXRhKG9yaWdpbikudmFsdWUuZGVmYXVsdFBheVdhbGxJRCkuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGJ6IGNyZWF0ZVBvc3RfYm9vbF9mYWxzZUA4CgpjcmVhdGVQb3N0X2Jvb2xfdHJ1ZUA3OgogICAgaW50Y18xIC8vIDEKCmNyZWF0ZVBvc3RfYm9vbF9tZXJnZUA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQyNC00MzIKICAgIC8vIGFzc2VydCgKICAgIC8vICAgKCF1c2VQYXlXYWxsICYmIHBheVdhbGxJRCA9PT0gMCkgfHwgKAogICAgLy8gICAgIHVzZVBheVdhbGwgJiYgKAogICAgLy8gICAgICAgcGF5V2FsbElEICE9PSAwICYmIHRoaXMucGF5d2FsbChwYXlXYWxsSUQpLmV4aXN0cyB8fAogICAgLy8gICAgICAgcGF5V2FsbElEID09PSAwICYmIHRoaXMucGF5d2FsbCh0aGlzLm1ldGEob3JpZ2luKS52YWx1ZS5kZWZhdWx0UGF5V2FsbElEKS5leGlzdHMKICAgIC8vICAgICApCiAgICAvLyAgICksCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWVdBTEwKICAgIC8vICkKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheXdhbGwKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0MzQKICAgIC8vIGNvbnN0IGlzRWRpdFBvc3QgPSBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UG9zdAogICAgZnJhbWVfZGlnIC0yCiAgICBieXRlYyAyNSAvLyAweDAyCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQzNgogICAgLy8gY29uc3QgZWRpdEV4dHJhTWJyOiB1aW50NjQgPSBpc0VkaXRQb3N0ID8gRWRpdEJhY2tSZWZNQlIgOiAwCiAgICBkdXAKICAgIGludGMgNSAvLyAxMzIwMAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gdGhpcy52YWxpZGF0ZVBvc3RQYXltZW50KG1iclBheW1lbnQsIGNpZCwgaXNFZGl0UG9zdCwgZWRpdEV4dHJhTWJyKQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgLTYKICAgIGRpZyAzCiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgdmFsaWRhdGVQb3N0UGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ0MQogICAgLy8gdGhpcy51cGRhdGVTdHJlYWsob3JpZ2luKQogICAgZnJhbWVfZGlnIC04CiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ0MwogICAgLy8gY29uc3QgaW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KG9yaWdpbikKICAgIGZyYW1lX2RpZyAtOAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICBmcmFtZV9idXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZnJhbWVfZGlnIC05CiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDQ1CiAgICAvLyBhc3NlcnQoIXRoaXMucG9zdHMocG9zdEtleSkuZXhpc3RzLCAnRVJSOlBPU1RfRVhJU1RTJykKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIEVSUjpQT1NUX0VYSVNUUwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ1MC00NTIKICAgIC8vIGNvbnN0IHBvc3RSZWY6IGJ5dGVzID0gaXNFZGl0UG9zdAogICAgLy8gICA/IEJ5dGVzKGNpZCkuY29uY2F0KGFtZW5kbWVudE9mKQogICAgLy8gICA6IEJ5dGVzKGNpZCkKICAgIGJ6IGNyZWF0ZVBvc3RfdGVybmFyeV9mYWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ1MQogICAgLy8gPyBCeXRlcyhjaWQpLmNvbmNhdChhbWVuZG1lbnRPZikKICAgIGZyYW1lX2RpZyAtNgogICAgZnJhbWVfZGlnIC0xCiAgICBjb25jYXQKCmNyZWF0ZVBvc3RfdGVybmFyeV9tZXJnZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NTcKICAgIC8vIHRpbWVzdGFtcDogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NTQtNDYzCiAgICAvLyB0aGlzLnBvc3RzKHBvc3RLZXkpLnZhbHVlID0gewogICAgLy8gICByZWY6IHBvc3RSZWYsCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBnYXRlSUQ6IGdhdGVJRCwKICAgIC8vICAgdXNlUGF5V2FsbCwKICAgIC8vICAgcGF5V2FsbElELAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBwb3N0VHlwZSwKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtOAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTUKICAgIGl0b2IKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfZGlnIC00CiAgICBzZXRiaXQKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0zCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgLTIKICAgIGNvbmNhdAogICAgc3dhcAogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjIDMzIC8vIDB4MDAzZAogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAwCiAgICBkdXAKICAgIGJveF9kZWwKICAgIHBvcAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2NAogICAgLy8gdGhpcy51cGRhdGVWb3Rlcyhwb3N0S2V5LCB0cnVlLCBpbXBhY3QpCiAgICBmcmFtZV9kaWcgLTkKICAgIGludGNfMSAvLyAxCiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDY1CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGVMaXN0KHBvc3RLZXksIHRydWUsIG9yaWdpbiwgaW1wYWN0KQogICAgZnJhbWVfZGlnIC05CiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIC04CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgY3JlYXRlVm90ZUxpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MjAyCiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBkaWcgMQogICAgYnl0ZWMgMTAgLy8gInNvY2lhbF9mZWVzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gY29uc3QgeyBwb3N0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDQKICAgIC8vIGNvbnN0IHsgbGVmdG92ZXIgfSA9IHNlbmRSZWZlcnJhbFBheW1lbnQodGhpcy5ha2l0YURBTy52YWx1ZSwgYWt0YSwgcG9zdEZlZSkKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBzZW5kUmVmZXJyYWxQYXltZW50CiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDYtMjEyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGxlZnRvdmVyLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDgKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoyMDYtMjExCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGxlZnRvdmVyLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjIwNi0yMTIKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogbGVmdG92ZXIsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIHJldHN1YgoKY3JlYXRlUG9zdF90ZXJuYXJ5X2ZhbHNlQDExOgogICAgZnJhbWVfZGlnIC02CiAgICBiIGNyZWF0ZVBvc3RfdGVybmFyeV9tZXJnZUAxMgoKY3JlYXRlUG9zdF9ib29sX2ZhbHNlQDg6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVQb3N0X2Jvb2xfbWVyZ2VAOQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUmVwbHkocmVwbHlLZXk6IGJ5dGVzLCBvcmlnaW46IGJ5dGVzLCBtYnJQYXltZW50OiB1aW50NjQsIG1ick5lZWRlZDogdWludDY0LCBjaWQ6IGJ5dGVzLCBwYXJlbnRSZWY6IGJ5dGVzLCBnYXRlSUQ6IHVpbnQ2NCwgdXNlUGF5V2FsbDogdWludDY0LCBwYXlXYWxsSUQ6IHVpbnQ2NCwgcG9zdFR5cGU6IGJ5dGVzLCBhbWVuZG1lbnRPZjogYnl0ZXMpIC0+IHZvaWQ6CmNyZWF0ZVJlcGx5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2OS00ODEKICAgIC8vIHByaXZhdGUgY3JlYXRlUmVwbHkoCiAgICAvLyAgIHJlcGx5S2V5OiBieXRlczwzMj4sCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBtYnJOZWVkZWQ6IHVpbnQ2NCwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIHBhcmVudFJlZjogYnl0ZXM8MzI+LAogICAgLy8gICBnYXRlSUQ6IHVpbnQ2NCwKICAgIC8vICAgdXNlUGF5V2FsbDogYm9vbGVhbiwKICAgIC8vICAgcGF5V2FsbElEOiB1aW50NjQsCiAgICAvLyAgIHBvc3RUeXBlOiBQb3N0VHlwZSwKICAgIC8vICAgYW1lbmRtZW50T2Y6IGJ5dGVzPDMyPiwKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gMTEgMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0ODIKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jhbm5lZChvcmlnaW4pLCBFUlJfQkFOTkVEKQogICAgZnJhbWVfZGlnIC0xMAogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJhbm5lZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTYKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4MwogICAgLy8gY29uc3QgeyBjcmVhdG9yIH0gPSB0aGlzLnBvc3RzKHBhcmVudFJlZikudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDg0CiAgICAvLyBhc3NlcnQoIXRoaXMuaXNCbG9ja2VkKGNyZWF0b3IsIG9yaWdpbiksIEVSUl9CTE9DS0VEKQogICAgZHVwCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIGlzQmxvY2tlZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBibG9ja2VkIGJ5IHRoZSB1c2VyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDg4CiAgICAvLyB0aGlzLnVwZGF0ZVN0cmVhayhvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MAogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MAogICAgLy8gY29uc3QgeyByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDkwCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlIH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OTEKICAgIC8vIGNvbnN0IGNyZWF0b3JJbXBhY3QgPSB0aGlzLmdldFVzZXJJbXBhY3QoY3JlYXRvcikKICAgIGRpZyAxCiAgICBjYWxsc3ViIGdldFVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0OTIKICAgIC8vIGNvbnN0IHRheFBlcmNlbnRhZ2UgPSBha2l0YVNvY2lhbEZlZSh0aGlzLmFraXRhREFPLnZhbHVlLCBjcmVhdG9ySW1wYWN0KQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5MgogICAgLy8gY29uc3QgdGF4UGVyY2VudGFnZSA9IGFraXRhU29jaWFsRmVlKHRoaXMuYWtpdGFEQU8udmFsdWUsIGNyZWF0b3JJbXBhY3QpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2OQogICAgLy8gY29uc3QgW3NvY2lhbEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzKSkKICAgIGJ5dGVjIDEwIC8vICJzb2NpYWxfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTIxCiAgICAvLyBjb25zdCB7IGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKGFraXRhREFPKQogICAgZHVwCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTIyCiAgICAvLyByZXR1cm4gaW1wYWN0UmFuZ2UoaW1wYWN0LCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCkKICAgIGNhbGxzdWIgaW1wYWN0UmFuZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5NQogICAgLy8gY29uc3QgaXNFZGl0UmVwbHkgPSBwb3N0VHlwZSA9PT0gUG9zdFR5cGVFZGl0UmVwbHkKICAgIGZyYW1lX2RpZyAtMgogICAgYnl0ZWMgMTUgLy8gMHgwMwogICAgPT0KICAgIGR1cAogICAgY292ZXIgMgogICAgY292ZXIgNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ5OAogICAgLy8gZXh0cmEgKz0gdGhpcy50aXBDcmVhdG9yKGNyZWF0b3IsIHJlYWN0RmVlLCB0YXgpCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBjYWxsc3ViIHRpcENyZWF0b3IKICAgIGZyYW1lX2RpZyAtOAogICAgKwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUwMAogICAgLy8gaWYgKGlzRWRpdFJlcGx5KSB7CiAgICBieiBjcmVhdGVSZXBseV9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MDEKICAgIC8vIGV4dHJhICs9IEVkaXRCYWNrUmVmTUJSCiAgICBpbnRjIDUgLy8gMTMyMDAKICAgICsKCmNyZWF0ZVJlcGx5X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MDQKICAgIC8vIHRoaXMudmFsaWRhdGVQb3N0UGF5bWVudChtYnJQYXltZW50LCBjaWQsIGlzRWRpdFJlcGx5LCBleHRyYSkKICAgIGZyYW1lX2RpZyAtOQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDQKICAgIGNhbGxzdWIgdmFsaWRhdGVQb3N0UGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjM2CiAgICAvLyBwb3N0cyA9IEJveE1hcDxieXRlczwzMj4sIFBvc3RWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UG9zdHMgfSkKICAgIGJ5dGVjXzIgLy8gInAiCiAgICBmcmFtZV9kaWcgLTExCiAgICBjb25jYXQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTA2CiAgICAvLyBhc3NlcnQoIXRoaXMucG9zdHMocmVwbHlLZXkpLmV4aXN0cywgJ0VSUjpQT1NUX0VYSVNUUycpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgICEKICAgIGFzc2VydCAvLyBFUlI6UE9TVF9FWElTVFMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MTEtNTEzCiAgICAvLyBjb25zdCByZXBseVJlZjogYnl0ZXMgPSBpc0VkaXRSZXBseQogICAgLy8gICA/IEJ5dGVzKGNpZCkuY29uY2F0KHBhcmVudFJlZikuY29uY2F0KGFtZW5kbWVudE9mKQogICAgLy8gICA6IEJ5dGVzKGNpZCkuY29uY2F0KHBhcmVudFJlZikKICAgIGJ6IGNyZWF0ZVJlcGx5X3Rlcm5hcnlfZmFsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUxMgogICAgLy8gPyBCeXRlcyhjaWQpLmNvbmNhdChwYXJlbnRSZWYpLmNvbmNhdChhbWVuZG1lbnRPZikKICAgIGZyYW1lX2RpZyAtNwogICAgZnJhbWVfZGlnIC02CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CgpjcmVhdGVSZXBseV90ZXJuYXJ5X21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTE4CiAgICAvLyB0aW1lc3RhbXA6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTE1LTUyNAogICAgLy8gdGhpcy5wb3N0cyhyZXBseUtleSkudmFsdWUgPSB7CiAgICAvLyAgIHJlZjogcmVwbHlSZWYsCiAgICAvLyAgIGNyZWF0b3I6IG9yaWdpbiwKICAgIC8vICAgdGltZXN0YW1wOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBnYXRlSUQ6IGdhdGVJRCwKICAgIC8vICAgdXNlUGF5V2FsbCwKICAgIC8vICAgcGF5V2FsbElELAogICAgLy8gICBhZ2FpbnN0Q29udGVudFBvbGljeTogZmFsc2UsCiAgICAvLyAgIHBvc3RUeXBlOiBwb3N0VHlwZSwKICAgIC8vIH0KICAgIGl0b2IKICAgIGZyYW1lX2RpZyAtMTAKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC01CiAgICBpdG9iCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtNAogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMwogICAgaXRvYgogICAgY29uY2F0CiAgICBieXRlY18zIC8vIDB4MDAKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGR1cAogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlYyAzMyAvLyAweDAwM2QKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9kaWcgMAogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MjYKICAgIC8vIGNvbnN0IHNlbmRlckltcGFjdCA9IHRoaXMuZ2V0VXNlckltcGFjdChvcmlnaW4pCiAgICBmcmFtZV9kaWcgLTEwCiAgICBjYWxsc3ViIGdldFVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MjcKICAgIC8vIHRoaXMudXBkYXRlVm90ZXMocmVwbHlLZXksIHRydWUsIHNlbmRlckltcGFjdCkKICAgIGZyYW1lX2RpZyAtMTEKICAgIGludGNfMSAvLyAxCiAgICBkaWcgMgogICAgY2FsbHN1YiB1cGRhdGVWb3RlcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUyOAogICAgLy8gdGhpcy5jcmVhdGVWb3RlTGlzdChyZXBseUtleSwgdHJ1ZSwgb3JpZ2luLCBzZW5kZXJJbXBhY3QpCiAgICBmcmFtZV9kaWcgLTExCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfZGlnIC0xMAogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGNyZWF0ZVZvdGVMaXN0CiAgICByZXRzdWIKCmNyZWF0ZVJlcGx5X3Rlcm5hcnlfZmFsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MTMKICAgIC8vIDogQnl0ZXMoY2lkKS5jb25jYXQocGFyZW50UmVmKQogICAgZnJhbWVfZGlnIC03CiAgICBmcmFtZV9kaWcgLTYKICAgIGNvbmNhdAogICAgYiBjcmVhdGVSZXBseV90ZXJuYXJ5X21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZVZvdGUob3JpZ2luOiBieXRlcywgbWJyUGF5bWVudDogdWludDY0LCBtYnJOZWVkZWQ6IHVpbnQ2NCwgcmVmOiBieXRlcywgaXNVcDogdWludDY0KSAtPiB2b2lkOgpjcmVhdGVWb3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjUzMS01MzcKICAgIC8vIHByaXZhdGUgY3JlYXRlVm90ZSgKICAgIC8vICAgb3JpZ2luOiBBY2NvdW50LAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIG1ick5lZWRlZDogdWludDY0LAogICAgLy8gICByZWY6IGJ5dGVzPDMyPiwKICAgIC8vICAgaXNVcDogYm9vbGVhbgogICAgLy8gKTogdm9pZCB7CiAgICBwcm90byA1IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1MzgKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jhbm5lZChvcmlnaW4pLCBFUlJfQkFOTkVEKQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuaXNCYW5uZWQKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgaXMgYmFubmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MzYKICAgIC8vIHBvc3RzID0gQm94TWFwPGJ5dGVzPDMyPiwgUG9zdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhQb3N0cyB9KQogICAgYnl0ZWNfMiAvLyAicCIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTM5CiAgICAvLyBhc3NlcnQodGhpcy5wb3N0cyhyZWYpLmV4aXN0cywgRVJSX1BPU1RfTk9UX0ZPVU5EKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBQb3N0IG5vdCBmb3VuZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0MQogICAgLy8gY29uc3QgeyBjcmVhdG9yIH0gPSB0aGlzLnBvc3RzKHJlZikudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzMgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDIKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jsb2NrZWQoY3JlYXRvciwgb3JpZ2luKSwgRVJSX0JMT0NLRUQpCiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgaXNCbG9ja2VkCiAgICAhCiAgICBhc3NlcnQgLy8gVGhpcyBhY2NvdW50IGlzIGJsb2NrZWQgYnkgdGhlIHVzZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDQKICAgIC8vIGNvbnN0IHZvdGVMaXN0S2V5OiBWb3RlTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZikgfQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIGIxNgogICAgZnJhbWVfZGlnIC0yCiAgICBjYWxsc3ViIGIxNgogICAgZGlnIDEKICAgIGxlbgogICAgcHVzaGludCAxNiAvLyAxNgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDIKICAgIC8vIHZvdGVsaXN0ID0gQm94TWFwPFZvdGVMaXN0S2V5LCBWb3RlTGlzdFZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhWb3RlTGlzdCB9KQogICAgYnl0ZWMgMTYgLy8gIm8iCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDUKICAgIC8vIGFzc2VydCghdGhpcy52b3RlbGlzdCh2b3RlTGlzdEtleSkuZXhpc3RzLCBFUlJfQUxSRUFEWV9WT1RFRCkKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIFlvdSd2ZSBhbHJlYWR5IHZvdGVkIG9uIHRoaXMgcG9zdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU0NgogICAgLy8gYXNzZXJ0KG9yaWdpbiAhPT0gY3JlYXRvciwgRVJSX05PX1NFTEZfVk9URSkKICAgIGZyYW1lX2RpZyAtNQogICAgIT0KICAgIGFzc2VydCAvLyBDYW5ub3Qgdm90ZSBvbiB5b3VyIG93biBjb250ZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NDgKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgTWV0YVZhbHVlPih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlYyA1IC8vICJtIgogICAgZnJhbWVfZGlnIC01CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDgKICAgIC8vIGNvbnN0IHNlbmRlcklzQXV0b21hdGVkID0gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUuYXV0b21hdGVkCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCAzOTIgLy8gMzkyCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NDkKICAgIC8vIGFzc2VydCghc2VuZGVySXNBdXRvbWF0ZWQsIEVSUl9BVVRPTUFURURfQUNDT1VOVCkKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGlzIGFuIGF1dG9tYXRlZCBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTUxCiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1MQogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjk0CiAgICAvLyBjb25zdCBha2l0YUFzc2V0c0J5dGVzID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMpKVswXQogICAgYnl0ZWMgOCAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1MQogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1NQogICAgLy8gdGhpcy51cGRhdGVTdHJlYWsob3JpZ2luKQogICAgZnJhbWVfZGlnIC01CiAgICBjYWxsc3ViIHVwZGF0ZVN0cmVhawogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU1NgogICAgLy8gY29uc3QgeyByZWFjdEZlZSwgaW1wYWN0VGF4TWluLCBpbXBhY3RUYXhNYXggfSA9IGdldFNvY2lhbEZlZXModGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTYKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo2OQogICAgLy8gY29uc3QgW3NvY2lhbEZlZXNCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNTb2NpYWxGZWVzKSkKICAgIGJ5dGVjIDEwIC8vICJzb2NpYWxfZmVlcyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTYKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgZHVwCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgMgogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NTgKICAgIC8vIGlmIChpc1VwKSB7CiAgICBmcmFtZV9kaWcgLTEKICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjAKICAgIC8vIGNvbnN0IHJlY2lwaWVudEltcGFjdCA9IHRoaXMuZ2V0VXNlckltcGFjdChjcmVhdG9yKQogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTYxCiAgICAvLyBjb25zdCB0YXhQZXJjZW50YWdlID0gaW1wYWN0UmFuZ2UocmVjaXBpZW50SW1wYWN0LCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCkKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9kaWcgMwogICAgY2FsbHN1YiBpbXBhY3RSYW5nZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDQKICAgIC8vIGFzc2VydChwIDw9IERJVklTT1IsIEVSUl9JTlZBTElEX1BFUkNFTlRBR0UpCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwZXJjZW50YWdlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNQogICAgLy8gcmV0dXJuIG9wLmRpdncoLi4ub3AubXVsdyhhLCBwKSwgRElWSVNPUikKICAgIHVuY292ZXIgMgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjQKICAgIC8vIGNvbnN0IGV4dHJhID0gdGhpcy50aXBDcmVhdG9yKGNyZWF0b3IsIHJlYWN0RmVlLCB0YXgpCiAgICBjYWxsc3ViIHRpcENyZWF0b3IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjYtNTczCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ick5lZWRlZCArIGV4dHJhCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NjkKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTY2LTU3MwogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBtYnJOZWVkZWQgKyBleHRyYQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NzAKICAgIC8vIGFtb3VudDogbWJyTmVlZGVkICsgZXh0cmEKICAgIGZyYW1lX2RpZyAtMwogICAgdW5jb3ZlciAzCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTY2LTU3MwogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBtYnJOZWVkZWQgKyBleHRyYQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKCmNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU5NAogICAgLy8gY29uc3Qgc2VuZGVySW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KG9yaWdpbikKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTk1CiAgICAvLyB0aGlzLnVwZGF0ZVZvdGVzKHJlZiwgaXNVcCwgc2VuZGVySW1wYWN0KQogICAgZnJhbWVfZGlnIC0yCiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICBjYWxsc3ViIHVwZGF0ZVZvdGVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTk2CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGVMaXN0KHJlZiwgaXNVcCwgb3JpZ2luLCBzZW5kZXJJbXBhY3QpCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfZGlnIC01CiAgICB1bmNvdmVyIDMKICAgIGNhbGxzdWIgY3JlYXRlVm90ZUxpc3QKICAgIHJldHN1YgoKY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1NzUtNTgyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ick5lZWRlZAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTc4CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU3NS01ODIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyTmVlZGVkCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFtb3VudAogICAgZnJhbWVfZGlnIC0zCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODQtNTkwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHJlYWN0RmVlLAogICAgLy8gICAgIHhmZXJBc3NldDogYWt0YQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODYKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo1ODYKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgMQogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU4NC01ODkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogcmVhY3RGZWUsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NTg0LTU5MAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiByZWFjdEZlZSwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VANAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo6QWtpdGFTb2NpYWwuY3JlYXRlUmVhY3Rpb24ob3JpZ2luOiBieXRlcywgbWJyUGF5bWVudDogdWludDY0LCBtYnJOZWVkZWQ6IHVpbnQ2NCwgcmVmOiBieXRlcywgTkZUOiB1aW50NjQpIC0+IHZvaWQ6CmNyZWF0ZVJlYWN0aW9uOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjU5OS02MDUKICAgIC8vIHByaXZhdGUgY3JlYXRlUmVhY3Rpb24oCiAgICAvLyAgIG9yaWdpbjogQWNjb3VudCwKICAgIC8vICAgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBtYnJOZWVkZWQ6IHVpbnQ2NCwKICAgIC8vICAgcmVmOiBieXRlczwzMj4sCiAgICAvLyAgIE5GVDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHByb3RvIDUgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYwNgogICAgLy8gYXNzZXJ0KCF0aGlzLmlzQmFubmVkKG9yaWdpbiksIEVSUl9CQU5ORUQpCiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZAogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBpcyBiYW5uZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czozNgogICAgLy8gcG9zdHMgPSBCb3hNYXA8Ynl0ZXM8MzI+LCBQb3N0VmFsdWU+KHsga2V5UHJlZml4OiBBa2l0YVNvY2lhbEJveFByZWZpeFBvc3RzIH0pCiAgICBieXRlY18yIC8vICJwIgogICAgZnJhbWVfZGlnIC0yCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MDcKICAgIC8vIGFzc2VydCh0aGlzLnBvc3RzKHJlZikuZXhpc3RzLCBFUlJfUE9TVF9OT1RfRk9VTkQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIFBvc3Qgbm90IGZvdW5kCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjA4CiAgICAvLyBjb25zdCB7IGNyZWF0b3IgfSA9IHRoaXMucG9zdHMocmVmKS52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMyAvLyAzMgogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MDkKICAgIC8vIGFzc2VydCghdGhpcy5pc0Jsb2NrZWQoY3JlYXRvciwgb3JpZ2luKSwgRVJSX0JMT0NLRUQpCiAgICBkdXAKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiBpc0Jsb2NrZWQKICAgICEKICAgIGFzc2VydCAvLyBUaGlzIGFjY291bnQgaXMgYmxvY2tlZCBieSB0aGUgdXNlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYxMQogICAgLy8gYXNzZXJ0KEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2Uob3JpZ2luLCBORlQpWzBdID4gMCwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GVCkKICAgIGZyYW1lX2RpZyAtNQogICAgZnJhbWVfZGlnIC0xCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYXNzZXJ0IC8vIFVzZXIgZG9lcyBub3Qgb3duIHRoaXMgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjEzCiAgICAvLyBjb25zdCByZWFjdGlvbkxpc3RLZXk6IFJlYWN0aW9uTGlzdEtleSA9IHsgdXNlcjogYjE2KG9yaWdpbi5ieXRlcyksIHJlZjogYjE2KHJlZiksIE5GVCB9CiAgICBmcmFtZV9kaWcgLTUKICAgIGNhbGxzdWIgYjE2CiAgICBmcmFtZV9kaWcgLTIKICAgIGNhbGxzdWIgYjE2CiAgICBkaWcgMQogICAgbGVuCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgc2l6ZQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBzaXplCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ2CiAgICAvLyByZWFjdGlvbmxpc3QgPSBCb3hNYXA8UmVhY3Rpb25MaXN0S2V5LCBieXRlczwwPj4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4UmVhY3Rpb25MaXN0IH0pCiAgICBwdXNoYnl0ZXMgImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYxNQogICAgLy8gYXNzZXJ0KCF0aGlzLnJlYWN0aW9ubGlzdChyZWFjdGlvbkxpc3RLZXkpLmV4aXN0cywgRVJSX0FMUkVBRFlfUkVBQ1RFRCkKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgYWNjb3VudCBhbHJlYWR5IHJlYWN0ZWQgdG8gdGhpcyBwb3N0IHdpdGggdGhpcyBORlQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MTkKICAgIC8vIHRoaXMudXBkYXRlU3RyZWFrKG9yaWdpbikKICAgIGZyYW1lX2RpZyAtNQogICAgY2FsbHN1YiB1cGRhdGVTdHJlYWsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjEKICAgIC8vIGNvbnN0IHsgcmVhY3RGZWUsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4IH0gPSBnZXRTb2NpYWxGZWVzKHRoaXMuYWtpdGFEQU8udmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIxCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlLCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NjkKICAgIC8vIGNvbnN0IFtzb2NpYWxGZWVzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzU29jaWFsRmVlcykpCiAgICBieXRlYyAxMCAvLyAic29jaWFsX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIxCiAgICAvLyBjb25zdCB7IHJlYWN0RmVlLCBpbXBhY3RUYXhNaW4sIGltcGFjdFRheE1heCB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyAxCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgdW5jb3ZlciAyCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYyMgogICAgLy8gY29uc3QgcmVjaXBpZW50SW1wYWN0ID0gdGhpcy5nZXRVc2VySW1wYWN0KGNyZWF0b3IpCiAgICBkaWcgNAogICAgY2FsbHN1YiBnZXRVc2VySW1wYWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjIzCiAgICAvLyBjb25zdCB0YXggPSBpbXBhY3RSYW5nZShyZWNpcGllbnRJbXBhY3QsIGltcGFjdFRheE1pbiwgaW1wYWN0VGF4TWF4KQogICAgY292ZXIgMgogICAgY2FsbHN1YiBpbXBhY3RSYW5nZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYyNQogICAgLy8gY29uc3QgcmVhY3Rpb25LZXk6IFJlYWN0aW9uc0tleSA9IHsgcmVmLCBORlQgfQogICAgZnJhbWVfZGlnIC0yCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIHNpemUKICAgIGZyYW1lX2RpZyAtMgogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo0NAogICAgLy8gcmVhY3Rpb25zID0gQm94TWFwPFJlYWN0aW9uc0tleSwgdWludDY0Pih7IGtleVByZWZpeDogQWtpdGFTb2NpYWxCb3hQcmVmaXhSZWFjdGlvbnMgfSkKICAgIGJ5dGVjIDI2IC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjYKICAgIC8vIGNvbnN0IHJlYWN0aW9uRXhpc3RzID0gdGhpcy5yZWFjdGlvbnMocmVhY3Rpb25LZXkpLmV4aXN0cwogICAgYm94X2xlbgogICAgZHVwCiAgICBjb3ZlciAyCiAgICBjb3ZlciA1CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2MjkKICAgIC8vIGV4dHJhICs9IHRoaXMudGlwQ3JlYXRvcihjcmVhdG9yLCByZWFjdEZlZSwgdGF4KQogICAgY292ZXIgMwogICAgY2FsbHN1YiB0aXBDcmVhdG9yCiAgICBmcmFtZV9kaWcgLTMKICAgICsKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2ODIKICAgIC8vIGNvbnN0IHsgcmVhY3Rpb25saXN0LCByZWFjdGlvbnMgfSA9IHRoaXMubWJyKEJ5dGVzKCcnKSkKICAgIHB1c2hieXRlcyAiIgogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyCiAgICBkdXAKICAgIHB1c2hpbnQgNDggLy8gNDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgY292ZXIgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4My02ODUKICAgIC8vIGNvbnN0IG1ickFtb3VudDogdWludDY0ID0gcmVhY3Rpb25FeGlzdHMKICAgIC8vICAgPyByZWFjdGlvbmxpc3QKICAgIC8vICAgOiByZWFjdGlvbnMgKyByZWFjdGlvbmxpc3QKICAgIGJ6IGNyZWF0ZVJlYWN0aW9uX3Rlcm5hcnlfZmFsc2VANgoKY3JlYXRlUmVhY3Rpb25fdGVybmFyeV9tZXJnZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4Ny02OTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyQW1vdW50ICsgZXh0cmEKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5MAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2ODctNjk0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG1ickFtb3VudCArIGV4dHJhCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5MQogICAgLy8gYW1vdW50OiBtYnJBbW91bnQgKyBleHRyYQogICAgdW5jb3ZlciAyCiAgICBmcmFtZV9kaWcgMwogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY4Ny02OTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogbWJyQW1vdW50ICsgZXh0cmEKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjM0CiAgICAvLyBpZiAocmVhY3Rpb25FeGlzdHMpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBieiBjcmVhdGVSZWFjdGlvbl9lbHNlX2JvZHlAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjYzNQogICAgLy8gdGhpcy5yZWFjdGlvbnMocmVhY3Rpb25LZXkpLnZhbHVlICs9IDEKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgYm94X3B1dAoKY3JlYXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0MAogICAgLy8gdGhpcy5yZWFjdGlvbmxpc3QocmVhY3Rpb25MaXN0S2V5KS5jcmVhdGUoKQogICAgZnJhbWVfZGlnIDAKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIHJldHN1YgoKY3JlYXRlUmVhY3Rpb25fZWxzZV9ib2R5QDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnJlYWN0aW9ucyhyZWFjdGlvbktleSkudmFsdWUgPSAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgZnJhbWVfZGlnIDEKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIGIgY3JlYXRlUmVhY3Rpb25fYWZ0ZXJfaWZfZWxzZUAzCgpjcmVhdGVSZWFjdGlvbl90ZXJuYXJ5X2ZhbHNlQDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njg1CiAgICAvLyA6IHJlYWN0aW9ucyArIHJlYWN0aW9ubGlzdAogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIGIgY3JlYXRlUmVhY3Rpb25fdGVybmFyeV9tZXJnZUA3CgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52YWxpZGF0ZVRpcCh0aXA6IHVpbnQ2NCwgYWN0aW9uOiBieXRlcykgLT4gdm9pZDoKdmFsaWRhdGVUaXA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjQzCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlVGlwKHRpcDogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCBhY3Rpb246IFRpcEFjdGlvbikgewogICAgcHJvdG8gMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjQ0CiAgICAvLyBjb25zdCBha3RhID0gQXNzZXQoZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBkdXAKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDQKICAgIC8vIGNvbnN0IGFrdGEgPSBBc3NldChnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjY5CiAgICAvLyBjb25zdCBbc29jaWFsRmVlc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1NvY2lhbEZlZXMpKQogICAgc3dhcAogICAgYnl0ZWMgMTAgLy8gInNvY2lhbF9mZWVzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0NQogICAgLy8gY29uc3QgeyBwb3N0RmVlLCByZWFjdEZlZSB9ID0gZ2V0U29jaWFsRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY0Ni02NTQKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICB0aXAsCiAgICAvLyAgIHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFrdGEsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IChhY3Rpb24gPT09IFRpcEFjdGlvblBvc3QpID8gcG9zdEZlZSA6IHJlYWN0RmVlCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1RSQU5TRkVSCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTIKICAgIGd0eG5zIEFzc2V0UmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDkKICAgIC8vIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDYtNjU0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgdGlwLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAoYWN0aW9uID09PSBUaXBBY3Rpb25Qb3N0KSA/IHBvc3RGZWUgOiByZWFjdEZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgWGZlckFzc2V0CiAgICB1bmNvdmVyIDQKICAgID09CiAgICAmJgogICAgZnJhbWVfZGlnIC0yCiAgICBndHhucyBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY1MQogICAgLy8gYXNzZXRBbW91bnQ6IChhY3Rpb24gPT09IFRpcEFjdGlvblBvc3QpID8gcG9zdEZlZSA6IHJlYWN0RmVlCiAgICBmcmFtZV9kaWcgLTEKICAgIGJ5dGVjIDE0IC8vIDB4MGEKICAgID09CiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgNAogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NDYtNjU0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgdGlwLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBha3RhLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAoYWN0aW9uID09PSBUaXBBY3Rpb25Qb3N0KSA/IHBvc3RGZWUgOiByZWFjdEZlZQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCB0cmFuc2ZlcgogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC52YWxpZGF0ZVBvc3RQYXltZW50KG1iclBheW1lbnQ6IHVpbnQ2NCwgY2lkOiBieXRlcywgaXNBbWVuZG1lbnQ6IHVpbnQ2NCwgZXh0cmFBbW91bnQ6IHVpbnQ2NCkgLT4gdm9pZDoKdmFsaWRhdGVQb3N0UGF5bWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NTctNjYyCiAgICAvLyBwcml2YXRlIHZhbGlkYXRlUG9zdFBheW1lbnQoCiAgICAvLyAgIG1iclBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwKICAgIC8vICAgY2lkOiBDSUQsCiAgICAvLyAgIGlzQW1lbmRtZW50OiBib29sZWFuLAogICAgLy8gICBleHRyYUFtb3VudDogdWludDY0CiAgICAvLyApOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgcHVzaGJ5dGVzICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjMKICAgIC8vIGNvbnN0IHsgcG9zdHMsIHZvdGVzLCB2b3RlbGlzdCB9ID0gdGhpcy5tYnIoY2lkKQogICAgZnJhbWVfZGlnIC0zCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5tYnIKICAgIGR1cAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGR1cAogICAgcHVzaGludCAyNCAvLyAyNAogICAgZXh0cmFjdF91aW50NjQKICAgIHN3YXAKICAgIGludGNfMyAvLyAzMgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjQKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHN3YXAKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjk0CiAgICAvLyBjb25zdCBha2l0YUFzc2V0c0J5dGVzID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBc3NldHMpKVswXQogICAgZHVwCiAgICBieXRlYyA4IC8vICJha2l0YV9hc3NldHMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU0OAogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPLCBUeG4uc2VuZGVyKQogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTQ5CiAgICAvLyBjb25zdCByZWZlcnJlciA9IHJlZmVycmVyT3JaZXJvQWRkcmVzcyh3YWxsZXQpCiAgICBjYWxsc3ViIHJlZmVycmVyT3JaZXJvQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTEKICAgIC8vIGlmIChyZWZlcnJlciA9PT0gR2xvYmFsLnplcm9BZGRyZXNzKSB7CiAgICBnbG9iYWwgWmVyb0FkZHJlc3MKICAgID09CiAgICBieiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NTIKICAgIC8vIHJldHVybiAwCiAgICBpbnRjXzAgLy8gMAoKdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmFsRmVlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6NjY2CiAgICAvLyBsZXQgYW1vdW50OiB1aW50NjQgPSBwb3N0cyArIHZvdGVzICsgdm90ZWxpc3QgKyByZWZlcnJhbEZlZUFtb3VudCArIGV4dHJhQW1vdW50CiAgICBmcmFtZV9kaWcgMgogICAgZnJhbWVfZGlnIDMKICAgICsKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICArCiAgICBmcmFtZV9kaWcgLTEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjcKICAgIC8vIGlmIChpc0FtZW5kbWVudCkgewogICAgZnJhbWVfZGlnIC0yCiAgICBieiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY2OAogICAgLy8gYW1vdW50ICs9IEFtZW5kbWVudE1CUgogICAgaW50YyA1IC8vIDEzMjAwCiAgICArCgp2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NzEtNjc4CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY3NAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NzEtNjc4CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgQW1vdW50CiAgICB1bmNvdmVyIDIKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgcmV0c3ViCgp2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGZyYW1lX2RpZyA1CiAgICBieXRlYyAyMSAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0ODIKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLnJld2FyZHMKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ4MwogICAgLy8gbGV0IGNvc3Q6IHVpbnQ2NCA9IE1pbkRpc2J1cnNlbWVudHNNQlIgKyAoVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9uc0xlbmd0aCkKICAgIGludGMgOSAvLyA2MDYwMAogICAgZnJhbWVfYnVyeSAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ4NQogICAgLy8gaWYgKGFzc2V0ICE9PSAwICYmICFBcHBsaWNhdGlvbihyZXdhcmRzQXBwKS5hZGRyZXNzLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBmcmFtZV9kaWcgNgogICAgYnogdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pZl9lbHNlQDgKICAgIGZyYW1lX2RpZyAxCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgNgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGJueiB2YWxpZGF0ZVBvc3RQYXltZW50X2FmdGVyX2lmX2Vsc2VAOAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0ODMKICAgIC8vIGxldCBjb3N0OiB1aW50NjQgPSBNaW5EaXNidXJzZW1lbnRzTUJSICsgKFVzZXJBbGxvY2F0aW9uTUJSICogYWxsb2NhdGlvbnNMZW5ndGgpCiAgICBpbnRjIDkgLy8gNjA2MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDg2CiAgICAvLyBjb3N0ICs9IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgZ2xvYmFsIEFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICArCiAgICBmcmFtZV9idXJ5IDAKCnZhbGlkYXRlUG9zdFBheW1lbnRfYWZ0ZXJfaWZfZWxzZUA4OgogICAgZnJhbWVfZGlnIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2NjUKICAgIC8vIGNvbnN0IHJlZmVycmFsRmVlQW1vdW50ID0gcmVmZXJyYWxGZWUodGhpcy5ha2l0YURBTy52YWx1ZSwgYWt0YSkKICAgIGIgdmFsaWRhdGVQb3N0UGF5bWVudF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJlZmVycmFsRmVlQDkKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbjogYnl0ZXMsIGluaXRpYWxpemVkOiB1aW50NjQsIHdhbGxldDogdWludDY0LCBhdXRvbWF0ZWQ6IHVpbnQ2NCkgLT4gdm9pZDoKY3JlYXRlRGVmYXVsdE1ldGE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njk3CiAgICAvLyBwcml2YXRlIGNyZWF0ZURlZmF1bHRNZXRhKG9yaWdpbjogQWNjb3VudCwgaW5pdGlhbGl6ZWQ6IGJvb2xlYW4sIHdhbGxldDogdWludDY0LCBhdXRvbWF0ZWQ6IGJvb2xlYW4pOiB2b2lkIHsKICAgIHByb3RvIDQgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMgogICAgLy8gc3RhcnREYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMwogICAgLy8gbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5OC03MTAKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlID0gewogICAgLy8gICBpbml0aWFsaXplZCwKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBzdHJlYWs6IDEsCiAgICAvLyAgIHN0YXJ0RGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZm9sbG93ZXJJbmRleDogMCwKICAgIC8vICAgZm9sbG93ZXJDb3VudDogMCwKICAgIC8vICAgYXV0b21hdGVkLAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IDAsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IDAsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IDAKICAgIC8vIH0KICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtMwogICAgc2V0Yml0CiAgICBmcmFtZV9kaWcgLTIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjcwMQogICAgLy8gc3RyZWFrOiAxLAogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo2OTgtNzEwCiAgICAvLyB0aGlzLm1ldGEob3JpZ2luKS52YWx1ZSA9IHsKICAgIC8vICAgaW5pdGlhbGl6ZWQsCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgc3RyZWFrOiAxLAogICAgLy8gICBzdGFydERhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGxhc3RBY3RpdmU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyAgIGZvbGxvd2VySW5kZXg6IDAsCiAgICAvLyAgIGZvbGxvd2VyQ291bnQ6IDAsCiAgICAvLyAgIGF1dG9tYXRlZCwKICAgIC8vICAgZm9sbG93R2F0ZUlEOiAwLAogICAgLy8gICBhZGRyZXNzR2F0ZUlEOiAwLAogICAgLy8gICBkZWZhdWx0UGF5V2FsbElEOiAwCiAgICAvLyB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czo3MDQKICAgIC8vIGZvbGxvd2VySW5kZXg6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjY5OC03MTAKICAgIC8vIHRoaXMubWV0YShvcmlnaW4pLnZhbHVlID0gewogICAgLy8gICBpbml0aWFsaXplZCwKICAgIC8vICAgd2FsbGV0LAogICAgLy8gICBzdHJlYWs6IDEsCiAgICAvLyAgIHN0YXJ0RGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgbGFzdEFjdGl2ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgZm9sbG93ZXJJbmRleDogMCwKICAgIC8vICAgZm9sbG93ZXJDb3VudDogMCwKICAgIC8vICAgYXV0b21hdGVkLAogICAgLy8gICBmb2xsb3dHYXRlSUQ6IDAsCiAgICAvLyAgIGFkZHJlc3NHYXRlSUQ6IDAsCiAgICAvLyAgIGRlZmF1bHRQYXlXYWxsSUQ6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2RpZyAtMQogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjQ4CiAgICAvLyBtZXRhID0gQm94TWFwPEFjY291bnQsIE1ldGFWYWx1ZT4oeyBrZXlQcmVmaXg6IEFraXRhU29jaWFsQm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWMgNSAvLyAibSIKICAgIGZyYW1lX2RpZyAtNAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6Njk4LTcxMAogICAgLy8gdGhpcy5tZXRhKG9yaWdpbikudmFsdWUgPSB7CiAgICAvLyAgIGluaXRpYWxpemVkLAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIHN0cmVhazogMSwKICAgIC8vICAgc3RhcnREYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBsYXN0QWN0aXZlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gICBmb2xsb3dlckluZGV4OiAwLAogICAgLy8gICBmb2xsb3dlckNvdW50OiAwLAogICAgLy8gICBhdXRvbWF0ZWQsCiAgICAvLyAgIGZvbGxvd0dhdGVJRDogMCwKICAgIC8vICAgYWRkcmVzc0dhdGVJRDogMCwKICAgIC8vICAgZGVmYXVsdFBheVdhbGxJRDogMAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbC5pc0Jhbm5lZChhY2NvdW50OiBieXRlcykgLT4gdWludDY0OgpzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6OkFraXRhU29jaWFsLmlzQmFubmVkOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODAtMTE4MQogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICAvLyBpc0Jhbm5lZChhY2NvdW50OiBBY2NvdW50KTogYm9vbGVhbiB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTgyLTExODUKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBa2l0YVNvY2lhbE1vZGVyYXRpb24ucHJvdG90eXBlLmlzQmFubmVkPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvY29udHJhY3QuYWxnby50czoxMTgzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24sCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgYnl0ZWMgOSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubW9kZXJhdGlvbiwKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2NvbnRyYWN0LmFsZ28udHM6MTE4Mi0xMTg1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxNb2RlcmF0aW9uLnByb3RvdHlwZS5pc0Jhbm5lZD4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1vZGVyYXRpb24sCiAgICAvLyAgIGFyZ3M6IFthY2NvdW50XQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDI4IC8vIG1ldGhvZCAiaXNCYW5uZWQoYWRkcmVzcylib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICByZXRzdWIKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyKHJlZjogYnl0ZXMpIC0+IGJ5dGVzOgpzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwubWJyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE0CiAgICAvLyBtYnIocmVmOiBieXRlcyk6IEFraXRhU29jaWFsTUJSRGF0YSB7CiAgICBwcm90byAxIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxOAogICAgLy8gcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICBmcmFtZV9kaWcgLTEKICAgIGxlbgogICAgcHVzaGludCA0MDAgLy8gNDAwCiAgICAqCiAgICBwdXNoaW50IDQwMTAwIC8vIDQwMTAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDdiZDQwMDAwMDAwMDAwMDAzZDU0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxOQogICAgLy8gdm90ZXM6IFZvdGVzTUJSLAogICAgcHVzaGludCAxOTMwMCAvLyAxOTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE1LTI3CiAgICAvLyByZXR1cm4gewogICAgLy8gICBmb2xsb3dzOiBGb2xsb3dzTUJSLAogICAgLy8gICBibG9ja3M6IEJsb2Nrc01CUiwKICAgIC8vICAgcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICAvLyAgIHZvdGVzOiBWb3Rlc01CUiwKICAgIC8vICAgdm90ZWxpc3Q6IFZvdGVsaXN0TUJSLAogICAgLy8gICByZWFjdGlvbnM6IFJlYWN0aW9uc01CUiwKICAgIC8vICAgcmVhY3Rpb25saXN0OiBSZWFjdGlvbmxpc3RNQlIsCiAgICAvLyAgIG1ldGE6IE1ldGFNQlIsCiAgICAvLyAgIG1vZGVyYXRvcnM6IE1vZGVyYXRvcnNNQlIsCiAgICAvLyAgIGJhbm5lZDogQmFubmVkTUJSLAogICAgLy8gICBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoyMQogICAgLy8gcmVhY3Rpb25zOiBSZWFjdGlvbnNNQlIsCiAgICBwdXNoaW50IDIyMTAwIC8vIDIyMTAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjIyCiAgICAvLyByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIHB1c2hpbnQgMTg5MDAgLy8gMTg5MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czoxNS0yNwogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgZm9sbG93czogRm9sbG93c01CUiwKICAgIC8vICAgYmxvY2tzOiBCbG9ja3NNQlIsCiAgICAvLyAgIHBvc3RzOiBNaW5Qb3N0c01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIHJlZi5sZW5ndGgpLAogICAgLy8gICB2b3RlczogVm90ZXNNQlIsCiAgICAvLyAgIHZvdGVsaXN0OiBWb3RlbGlzdE1CUiwKICAgIC8vICAgcmVhY3Rpb25zOiBSZWFjdGlvbnNNQlIsCiAgICAvLyAgIHJlYWN0aW9ubGlzdDogUmVhY3Rpb25saXN0TUJSLAogICAgLy8gICBtZXRhOiBNZXRhTUJSLAogICAgLy8gICBtb2RlcmF0b3JzOiBNb2RlcmF0b3JzTUJSLAogICAgLy8gICBiYW5uZWQ6IEJhbm5lZE1CUiwKICAgIC8vICAgYWN0aW9uczogQWN0aW9uc01CUgogICAgLy8gfQogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjIzCiAgICAvLyBtZXRhOiBNZXRhTUJSLAogICAgcHVzaGludCA0NTMwMCAvLyA0NTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjE1LTI3CiAgICAvLyByZXR1cm4gewogICAgLy8gICBmb2xsb3dzOiBGb2xsb3dzTUJSLAogICAgLy8gICBibG9ja3M6IEJsb2Nrc01CUiwKICAgIC8vICAgcG9zdHM6IE1pblBvc3RzTUJSICsgKEJveENvc3RQZXJCeXRlICogcmVmLmxlbmd0aCksCiAgICAvLyAgIHZvdGVzOiBWb3Rlc01CUiwKICAgIC8vICAgdm90ZWxpc3Q6IFZvdGVsaXN0TUJSLAogICAgLy8gICByZWFjdGlvbnM6IFJlYWN0aW9uc01CUiwKICAgIC8vICAgcmVhY3Rpb25saXN0OiBSZWFjdGlvbmxpc3RNQlIsCiAgICAvLyAgIG1ldGE6IE1ldGFNQlIsCiAgICAvLyAgIG1vZGVyYXRvcnM6IE1vZGVyYXRvcnNNQlIsCiAgICAvLyAgIGJhbm5lZDogQmFubmVkTUJSLAogICAgLy8gICBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICAvLyB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjI2CiAgICAvLyBhY3Rpb25zOiBBY3Rpb25zTUJSCiAgICBwdXNoaW50IDI5NzAwIC8vIDI5NzAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MTUtMjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGZvbGxvd3M6IEZvbGxvd3NNQlIsCiAgICAvLyAgIGJsb2NrczogQmxvY2tzTUJSLAogICAgLy8gICBwb3N0czogTWluUG9zdHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiByZWYubGVuZ3RoKSwKICAgIC8vICAgdm90ZXM6IFZvdGVzTUJSLAogICAgLy8gICB2b3RlbGlzdDogVm90ZWxpc3RNQlIsCiAgICAvLyAgIHJlYWN0aW9uczogUmVhY3Rpb25zTUJSLAogICAgLy8gICByZWFjdGlvbmxpc3Q6IFJlYWN0aW9ubGlzdE1CUiwKICAgIC8vICAgbWV0YTogTWV0YU1CUiwKICAgIC8vICAgbW9kZXJhdG9yczogTW9kZXJhdG9yc01CUiwKICAgIC8vICAgYmFubmVkOiBCYW5uZWRNQlIsCiAgICAvLyAgIGFjdGlvbnM6IEFjdGlvbnNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLnBheVdhbGxNYnIocGF5d2FsbDogYnl0ZXMpIC0+IHVpbnQ2NCwgYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5wYXlXYWxsTWJyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjMwCiAgICAvLyBwYXlXYWxsTWJyKHBheXdhbGw6IFZpZXdQYXlXYWxsVmFsdWUpOiB1aW50NjQgewogICAgcHJvdG8gMSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzMKICAgIC8vIFBheVdhbGxQYXlPcHRpb25TaXplICogKHBheXdhbGwuYWdlbnRQYXlJbmZvLmxlbmd0aCArIHBheXdhbGwudXNlclBheUluZm8ubGVuZ3RoKQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDIgLy8gMgogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgbGVuCiAgICBmcmFtZV9kaWcgLTEKICAgIGRpZyAyCiAgICB1bmNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZnJhbWVfZGlnIC0xCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGZyYW1lX2RpZyAtMQogICAgc3dhcAogICAgdW5jb3ZlciAzCiAgICBzdWJzdHJpbmczCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMi0zNAogICAgLy8gQm94Q29zdFBlckJ5dGUgKiAoCiAgICAvLyAgIFBheVdhbGxQYXlPcHRpb25TaXplICogKHBheXdhbGwuYWdlbnRQYXlJbmZvLmxlbmd0aCArIHBheXdhbGwudXNlclBheUluZm8ubGVuZ3RoKQogICAgLy8gKQogICAgcHVzaGludCA2ODAwIC8vIDY4MDAKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czozMQogICAgLy8gcmV0dXJuIE1pblBheVdhbGxNQlIgKyAoCiAgICBwdXNoaW50IDUyMDAgLy8gNTIwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjMxLTM1CiAgICAvLyByZXR1cm4gTWluUGF5V2FsbE1CUiArICgKICAgIC8vICAgQm94Q29zdFBlckJ5dGUgKiAoCiAgICAvLyAgICAgUGF5V2FsbFBheU9wdGlvblNpemUgKiAocGF5d2FsbC5hZ2VudFBheUluZm8ubGVuZ3RoICsgcGF5d2FsbC51c2VyUGF5SW5mby5sZW5ndGgpCiAgICAvLyAgICkKICAgIC8vICkKICAgICsKICAgIGZyYW1lX2RpZyAtMQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzKGFraXRhREFPOiB1aW50NjQsIGNyZWF0b3I6IGJ5dGVzLCB3YWxsZXQ6IHVpbnQ2NCkgLT4gYnl0ZXM6CnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50czoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1MQogICAgLy8gY2hlY2tUaXBNYnJSZXF1aXJlbWVudHMoYWtpdGFEQU86IEFwcGxpY2F0aW9uLCBjcmVhdG9yOiBBY2NvdW50LCB3YWxsZXQ6IEFwcGxpY2F0aW9uKTogdGlwTUJSSW5mbyB7CiAgICBwcm90byAzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDggLy8gImFraXRhX2Fzc2V0cyIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1MgogICAgLy8gY29uc3QgYWt0YSA9IEFzc2V0KGdldEFraXRhQXNzZXRzKGFraXRhREFPKS5ha3RhKQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTQKICAgIC8vIGlmICghY3JlYXRvci5pc09wdGVkSW4oYWt0YSkgJiYgd2FsbGV0LmlkICE9PSAwKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjpCYXNlU29jaWFsLmNoZWNrVGlwTWJyUmVxdWlyZW1lbnRzX2FmdGVyX2lmX2Vsc2VANQogICAgZnJhbWVfZGlnIC0xCiAgICBieiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHNfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzktNDgKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfY2FuQ2FsbD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgLy8gICAgIHRydWUsCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgICcnLAogICAgLy8gICAgIG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KCkKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTMKICAgIGJ5dGVjIDE3IC8vICJwYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NDIKICAgIC8vIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgZXh0cmFjdCAwIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0NAogICAgLy8gR2xvYmFsLnplcm9BZGRyZXNzLAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6MzktNDgKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfY2FuQ2FsbD4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGdldFBsdWdpbkFwcExpc3QoYWtpdGFEQU8pLm9wdGluLAogICAgLy8gICAgIHRydWUsCiAgICAvLyAgICAgR2xvYmFsLnplcm9BZGRyZXNzLAogICAgLy8gICAgICcnLAogICAgLy8gICAgIG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBPcHRJblBsdWdpbi5wcm90b3R5cGUub3B0SW4+KCkKICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDQ3MjdhZjIxIC8vIG1ldGhvZCAiYXJjNThfY2FuQ2FsbCh1aW50NjQsYm9vbCxhZGRyZXNzLHN0cmluZyxieXRlWzRdKWJvb2wiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0MwogICAgLy8gdHJ1ZSwKICAgIGJ5dGVjIDEzIC8vIDB4ODAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjQ1CiAgICAvLyAnJywKICAgIGJ5dGVjIDExIC8vIDB4MDAwMAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo0NgogICAgLy8gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oKQogICAgYnl0ZWMgMjMgLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjM5LTQ4CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2NhbkNhbGw+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBnZXRQbHVnaW5BcHBMaXN0KGFraXRhREFPKS5vcHRpbiwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIEdsb2JhbC56ZXJvQWRkcmVzcywKICAgIC8vICAgICAnJywKICAgIC8vICAgICBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPigpCiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1NgogICAgLy8gaWYgKGNhbkNhbGxBcmM1OE9wdEluKSB7CiAgICBieiBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6OkJhc2VTb2NpYWwuY2hlY2tUaXBNYnJSZXF1aXJlbWVudHNfYWZ0ZXJfaWZfZWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTkKICAgIC8vIGFyYzU4OiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9iYXNlLnRzOjU3LTYwCiAgICAvLyByZXR1cm4gewogICAgLy8gICB0eXBlOiBUaXBTZW5kVHlwZUFSQzU4LAogICAgLy8gICBhcmM1ODogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlCiAgICAvLyB9CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NTgKICAgIC8vIHR5cGU6IFRpcFNlbmRUeXBlQVJDNTgsCiAgICBieXRlYyA2IC8vIDB4MTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo1Ny02MAogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgdHlwZTogVGlwU2VuZFR5cGVBUkM1OCwKICAgIC8vICAgYXJjNTg6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZQogICAgLy8gfQogICAgc3dhcAogICAgY29uY2F0CiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvYmFzZS50czo6QmFzZVNvY2lhbC5jaGVja1RpcE1iclJlcXVpcmVtZW50c19hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2Jhc2UudHM6NjQtNjcKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIHR5cGU6IFRpcFNlbmRUeXBlRGlyZWN0LAogICAgLy8gICBhcmM1ODogMAogICAgLy8gfQogICAgcHVzaGJ5dGVzIDB4MGEwMDAwMDAwMDAwMDAwMDAwCiAgICByZXRzdWIK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAKAAEIIKCNBpBn1PcBgM6JHoCjBbjZAyYiCWFraXRhX2RhbwQVH3x1AXABABJjb250cm9sbGVkX2FkZHJlc3MBbQEUDGFraXRhX2VzY3Jvdwxha2l0YV9hc3NldHMDc2FsC3NvY2lhbF9mZWVzAgAABndhbGxldAGAAQoBAwFvA3BhbAEBAXcKcGF5d2FsbF9pZANhYWwCAAEEaDXjvAFhAQIBcgQn47tPBIQmnHgHdmVyc2lvbgRYL/OCBGzD9gYJAAAAAAAAAAAAAgA9MRhAAAQnFCNngATqkYDdNhoAjgEBADEZFEQxGEEA6YIRBIPxR0gEcQW7rQS6S/eUBNXI57gEL/wxIwRyck+WBByZ/p4EAukmMQSE+kteBG59QsIE0OWxjQRuW3cCBOrG2SIEhWQz6gTQnz3oBGmk75cEMwazKiccgg4E6GmTTQTm5nvcBHOepwsEwCK+iQSYS671BPTqUOQE0gIFwgTxNgDRBJLm3TsEoTSieAQ0QXXwBB6tIKkEM+kslASFTe3gNhoAjiAC8gRXBPEF4QbtB9kJFQo4Ct8LlgxNDOUNfQ25DvAPMg/eEBkQLxBBEFsQbxCIEKAQ6RGaEb8R2RHnEgoSXAABACNDgASIyUD4NhoAjgECsAAxGYEEEjEYEERCEguKAQGL/xUiSwEPIksCTwJNgRBLAg+BEE8DTwJNi/9OAlJJFYEQEkSJigMBi/0jDUEAE4v9IwmL/4v+CQuB6AcKi/9MCYkjQv/tigEBMgOL/0AABIsATImL/4AIcmVmZXJyZXJlSEL/7YoCAYv+gANvYWxlSIEYW7GABDwabzOyGov/shqyGIEGshAisgGztD5JVwQASwFXAAQpEkRJIlmBAghMFRJEVwYASRVJQQAHiwEkE0EABCKMAImLABdC//eKBAGL/DgYi/0nFWVIgShbEkEAOov8OBlAADOL/DgbgQQSQQApi/wiwhqABEOSJlUSQQAai/wjwhqL/hJBAA+L/IECwhqL/xYSQQACI4kiiYoDASKAAEcEi/0xAIj/SYj/J4v/QQFziwYyAxNBAWuL/YALd2FsbGV0X2ZlZXNlSCRbSSEEDkSL/x0hBJdJjANAAAiL/0EAAyOMAzIHjAUyB4GA9SQIjAKLAxaLBkxQJxZMUEmMAIv9JxVlSCRbjAQiWYHUxQELgeSTAgiMAYv+QABfsYsESXIIRIsBiwMIsgiyByOyECKyAbaLBRaLAhaABHt9xfyyGkyyGrIaiwCyGrIYgQayECKyAbO3AT5JVwQATFcABCkSREkVJBJEFxaLARZQVwgIi/+LAwkWTFCMAImLBHIIRIv+cABFAUEAZ4sBsYsESXIIRIsBsgiyByOyECKyAbZJcghEi/6yEYsDshKyFIEEshAisgG2iwUWiwIWgASvGhTyshpMshqyGosAshqyGIEGshAisgGztwI+SVcEAExXAAQpEkRJFSQSRBdMjAFC/3iLATIQCLGLBElyCEQyELIIsgcjshAisgG2i/4WgAQ5Tq6yshqyGrIYgQayECKyAbNC/2iL/xYiFlCMAIk2GgFJIlmBAghLARUSRFcCADYaAkkVJBJEFzYaA0kVJBJEFycdTwNnKE8CZycHTGcjQ4AARwMiKGVEJwhlSCJbSTIKSwFwAEUBFESxMgpLAbIRIrISshSBBLIQIrIBsyInB2VEcghETHAARQFAASYiKGVMSU4CRQdESScMZUhJTgJFBEknEWVISVcICEwkW0UGTCcMZUixgASiQD3fshqAEAABAAIACnJldl9zb2NpYWyyGrIYgQayECKyAbO0PklXBABLAVcABCkSREkiWYEJC4ECCEwVEkRXBgkiW0lEIicHZURMSwESRLEnHrIaTLIaJw2yGoAMAApyZXZfc29jaWFsshqACgABAAAAAAAAAACyGicLshpMshiBBrIQIrIBcghEIkUFSwFwAEUBQAAaSwSADnJldmVudWVfc3BsaXRzZUgiWSMIRQQyEEsEC7YiJwdlRHIIRLIHsggjshAisgG2SwFJFksCFicWTFAnF7IaTLIaJw2yGrIaSwOyGIEGshAisgG2Jx+yGrIYgQayECKyAbMjQzEWgQIJSTgQIxJEMRYjCUk4EIEEEkQ2GgFJFSQSRBc2GgJJFYEYEkQ2GgNJFYEkEkQ2GgRJFSQSRBc2GgVJFSMSRCJTNhoGSRUkEkQXIihlRDEAiPvYSTEATEAANEkyB0sJSU4CCYE8DkQWSwFMUEsIUAFLCicOiBbMJa9MTwJLDEsJSwlLCUsJK08IiBMCI0NLAScEZUhC/8QxFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSRWBJBJENhoCSRUlEkQiKGVEMQCI+2RJMQBMQACwSSpLBElOAlBJvUUBREm+SExJIiW6SwKBO1lLAxVPBE4CUlcCAEsCgSgkuhdLA4EwI7oiU0sEgTEkuhdLBYE6I7pLCicEZUhPBhJESYgNAhRESwRMiA0RFERPBklOA0sHUEsLSU4EUAEnGEsBUE8HTFBLB75ESwEVFlcGAk8CUEsBgTtZTwIiTwJYTFBLB7xITwdMv0sLJw6IFdpPBEsMTwVPBk8GTwYnGU8IiBISI0NLAScEZUhC/0gxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSRUkEkQXNhoCSRWBGBJENhoDSRWBJBJENhoESSJZgQIISwEVEkRXAgA2GgVJFSMSRDYaBkkVJBJEFzYaB0kVIxJEIlM2GghJFSQSRBciKGVEMQCI+ilJMQBMQACBSUsGSwiIDGJJVwAgTFcgIDIHSw1JTgQJgTwORCpLAlBJvUUBREkiJbpMgSgkuhciKGVESxFMTwdJTgRPA4j6OkRLAjIDEk8DTwNPAk1LAkyID65PAxZLAkxQSwxQAUsPJwaIFNUlr0xPA0sRTwRLDU8GSwtLC0sLJxJPCogSCSNDSwEnBGVIQv93MRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVJBJEFzYaAkkVgRgSRDYaA0kVgSQSRDYaBEkiWYECCEsBFRJEVwIANhoFSRUjEkQ2GgZJFSQSRBc2GgdJFSMSRCJTNhoISRUkEkQXIihlRDEAiPkpSTEATEAAbUlLBksIiAtiSVcAIExXICAyB0sNSU4ECYE8DkQqSwJQSb1FAURJIiW6TIEoJLoXFERLATIDEk1LAUyIDsRPAhZPA0lPAlBLDFABSw4nBogT6SWvTE8CSxBPBEsNTwZLC0sLSwsnEk8KiBEdI0NLAScEZUhC/4sxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSRWBJBJENhoCSRUlEkQiKGVEMQCI+HBJMQBMQADwSSpLBElOAlBJvUUBREm+SExJIiW6SwKBO1lLAxVPBE4CUlcCAEsCgSgkuhdLA4EwI7oiU0sEgTEkuhdLBYE6I7pLCicEZUhPBhJESYgKDkRLBEyICh4URE8GSU4ESwdQSwtJTgVQAScYSwFQSwdMUEsIvkRLARUWVwYCTwJQSwGBO1lPAiJPAlhMUEsIvEhPCEy/SwYVgSRLAQ+BJEsCTwJNgURLAg+BRE8DTwJNTwhOAlJJFSUSRCpLAVCBKCS6FyIoZURLDkxLCU8DiPfyREsNJwaIEqpMTwZLDiJPCE8FTwhPCE8IJw9PCogP4SNDSwEnBGVIQv8IMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVgSQSRDYaAkkVJRJEIihlRDEAiPdASTEATEAA40kqSwRJTgJQSb1FAURJvkhMSSIluksCgTtZSwMVTwROAlJXAgBLAoEoJLoXSwOBMCO6IlNLBIExJLoXSwWBOiO6SwonBGVITwYSREmICN5ESwRMiAjuFERPBklOBEsHUEsLSU4EUAEnGEsBUEsHTFBLCL5ESwEVFlcGAk8CUEsBgTtZTwIiTwJYTFBLCLxITwhMv0sGFYEkSwEPgSRLAk8CTYFESwIPgURPA08CTU8ITgJSSRUlEkQqSwFQgSgkuhcUREsMJwaIEYdMTwZLDSJPB08FTwhPCE8IJw9PCogOviNDSwEnBGVIQv8VIkcCMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkiWYECCEsBFRJEVwIANhoCSRUjEkQ2GgNJFSMSRCJTIihlRDEAiPYISTEATEAAUElFCUsDSUsGiAg+SVcAIEUKVyAgRQsnDhJBAA4qSwhQSb1FAUQiJbpFCksHSUsLiAuuSwcnBogQ4IAAiBINJVsISwpLCU8CTwNLBogO9yNDSwEnBGVIRQlC/6gigAAxFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSRUlEkQ2GgJJFSMSRCJTIihlRDEAiPVySTEATEAAcUlFCEsHiPT7SwRJTgKI9PNLARWBEBJESRWBEBJEUCcQTFBJvUUBREm+SEkiW0yBQFMUSUULTwNMTwKIC6e8SEsCQAAXsYAAiBFrJVuyCEsHsgcjshAisgGzI0NLBCcGiBAhSwdLBiJLBksKiA5BQv/oSwEnBGVIRQhC/4cxFoEDCUk4ECMSRDEWgQIJSTgQgQQSRDEWIwlJOBCBBhJENhoBSSJZgQIISwEVEkRXAgA2GgJJFSMSRDYaA0kVJBJEFyIoZUQxAIj0okkxAExAAFpJSwRLBogG20lXACBMVyAgKksCUEm9RQFESSIlukyBKCS6FyIoZURLC0xPBklOBE8DiPS/REsCMgMSTwNPA08CTUsCTIgKM0sJJwaID2VLCkxPA0sGiA55I0NLAScEZUhC/54xFoECCUk4ECMSRDEWIwlJOBCBBBJENhoBSSJZgQIISwEVEkRXAgA2GgJJFSMSRDYaA0kVJBJEFyIoZUQxAIjz90kxAExAAEdJSwRLBogGMElXACBMVyAgKksCUEm9RQFESSIlukyBKCS6FxRESwEyAxJNSwFMiAmeSwgnBogO0E8CSwlPAk8DSwaIDeEjQ0sBJwRlSEL/sTYaAUkVJRJENhoCSRUkEkQXIihlRDEAiPOGSTEATEAAbklJiA+OFEQqSwVJTgNQSb1FAUQiJbpLAYgGaBRESYjy9ksCiPLxSwEVgRASREkVgRASRFBLBRZMSwFQgAFlTFBJvUUBRE8DTwJQJxpMUEm+RBcjCRa/vEixgACID2uBMFuyCLIHI7IQIrIBsyNDSwEnBGVIQv+KNhoBSRUlEkQ2GgJJFSMSRCJTMQAiKGVEJwllSIEYW3IIRBJEKk8CUEm9RQFESYE5I7oiTwNUgTlMuyNDIoAAMRYjCUk4ECMSRDYaAUcCFSUSRDYaAkkVIxJEIlNMNhoDSU4CFSQSRDYaBElOAhUkEkQ2GgVJTgIVJBJEIihlREyI8oVJQADiSwVFCTEASwYSJwVLClC9RQEUREsHSTgHMgoSTDgIgACIDqiBOFshBggSEEQiKGVEJwllSIEQW0lFCrFyCEQhBrIIsgcjshAisgGzSwVBAEJLCUlPAksDI4gOArEiFicbshpMshpJshpJshqyGksHshiBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJEIhYpTFCwI0NLCUlPAksDIogNwLEiKGVEJwllSIEQWycbshpLAbIaSwWyGksEshpLA7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSQSRBdMiATkCEL/qUknBGVIRQlC/xgxFiMJSTgQIxJENhoBSwE4BzIKEk8COAhPAogOEk4CD08CEEQiJxRlREkjCCcUTGcWJxNLAVBJvEhPAr8pTFCwI0M2GgFJFSQSRDYaAkkVJBJENhoDSRUkEkQ2GgRJFSQSRDYaBUkVJBJENhoGRwIVJBJEFyIoZUQxAIjxGEkxAExAAGFJJwVLAVBJvUUBREmBMksMu0mBOksLu0sEFicTTFC9RQFEgUJLBrsiKGVEJwllSIEQW7EnG7IaTLIaSweyGksGshpLBbIashiBBrIQIrIBs7Q+SVcEAExXAAQpEkQVJBJDSwEnBGVIQv+XNhoBSRUlEkQ2GgJJFSQSRDYaA0kVJBJEMQAiKGVEJwllSCRbcghEEkQnBU8DUEmBIU8Eu4EpTwK7I0M2GgFJFSUSRIgMdSsiTwJUKUxQsCNDNhoBSRUlEkSIA4wWKUxQsCNDNhoBSRUlEkQnBUxQvUUBKyJPAlQpTFCwI0M2GgFJFSUSRCcFTFC+RClMULAjQzYaAUkVJRJEKkxQvUUBKyJPAlQpTFCwI0M2GgFJFSUSRCpMUEm9RQFEvkgpTFCwI0M2GgFJFSUSRCIoZUQxAIjv1EkxAExAAChJiO9hSwOI71xLARWBEBJESRWBEBJEUCcQTFBJvUUBRL5IKUxQsCNDSwEnBGVIQv/QIkcCgAA2GgFHAiJZSU4CJQuBAghMFRJEIihlRDEAiO97STEATEAAfklFBycLRQYiRQVLBEsDDEEAZUsDVwIASwUlCyVYSweI7upMiO7mSwEVgRASREkVgRASRFAnEExQSUUJvUUBQQAgSwe+REsGSU8CUEwiWSMIFlcGAlwARQZLBCMIRQVC/6lLBUknIFBMIlkjCBZXBgJcAEUGQv/hKUsGULAjQ0sBJwRlSEUHQv96NhoBSRUlEkQ2GgJJFSQSRBcWUCcaTFC9RQErIk8CVClMULAjQzYaAUkiWYECCEsBFRJEVwIAiAr8KUxQsCNDNhoBiAtASBYpTFCwI0M2GgFJFSQSRBc2GgJJFSUSRDYaA0kVJBJEF4gLRylMULAjQzYaAUkVJBJEFzEAIihlRCcMZUhyCEQSRCcHTGcjQzYaAUkiWYECCEsBFRJEVwIAMQAiKGVESScMZUhyCERPAhJEJxFlSIEQWzINEkQnHUxnI0M2GgFJFSQSRBcxACIoZUQnDGVIcghEEkQoTGcjQ4oBAYv/JxISQAAIi/8nDxJBAAIjiSKJigIBi/8rEkEAB4Eki/4VDImL/ycSEkEABYFEQv/ui/8nGRJBAAWBREL/4Yv/Jw8SQQAFgWRC/9SBJEL/z4oCASIyA4v+Jw4SQQATi/8VJRJEi/9JFSUSRIsBUIwAiYv+JwYSQQAli/8VJBJEi/8XSXEARESBGK+L/0xQSU4CFSUSRHELTIwBREL/yIv+gAEeEkEAJYv/FSUSRCcFi/9QSYwAvUUBQQAJiwC+RIE6WxREi/9JjAFC/5qL/oABKBJBACaL/xUkEkSL/xdJcgBEFUSBGK+L/0xQSU4CFSUSRHIHTIwBREL/a4v+gAEyEkSL/wEyA4wBQv9aigIBsSIoZUQnCWVIJFuABEMDZo6yGov+shqL/7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSMSRCJTiYoBASKAAEcFJwWL/1BJvUUBQAAEIowAiYsHvkRJgQlbSU4CjAaBEVuMBYE8D0EAgYFkjAQyB4sFCUmMASEHD0EAX4sEgUsIjASAAXaL/1BJjAC9RQFBADSLAL5ESSJbTIFAU4wDgUsLIQQKSYwCgUsNQQAEgUuMAosDQQAaiwSLAg1BAAyLBIsCCYwEiwSMAIkijARC//WLBIsCCIwEQv/riwGBSwshBwqLBAiMBEL/mIsGgWQLgTwKjARC/3aKAQGxIihlRCcJZUiBEFuABPgce2KyGov/shqyGIEGshAisgGztD5JVwQATFcABCkSREkVJBJEF4v/iP8FCImKAwEiSYAARwSL/TIDEkEAKCIoZUQnCGVIIluxIicHZURyCERMshGL/rISshSBBLIQIrIBsyKMAIkijAYnBYv9UEmMAL1FAUEACIsAvkQjW4wGIihlRIv9iwaICCtJVwABTCNbjAMiKGVESScIZUgiW0mMAov/iOvxIluMBCIoZUQnCmVIJFtMJwYSQQCti/8JiwZJJwRlSEwiKGVEJxFlSElXAAhMIluxJx6yGkyyGicNshonC7IaJwuyGicLshpLAbIYgQayECKyAbYyEEsDsgeyCCOyECKyAbZLARaLAklOAxYnFkxQJxeyGkyyGicNshqyGrIYgQayECKyAbYnH7IaTLIYgQayECKyAbYiJwdlRHIIREsBshGLBLISshSBBLIQIrIBtrIRshSyEoEEshAisgGziwOMAImL/wlJjAUiJwdlRHIITIwBREEAN4v9iwJwAEUBQQAssYsCSbIRiwSyEosBshSBBLIQIrIBtrIRiwWyEov9shSBBLIQIrIBsyKMAImLBIsFCLGLArIRshKLAbIUgQSyECKyAbNC/+GKAgEqi/5QSb1FAUAAKzIHFov/TFAiFkxLAVArUExQgAYAAAA9AABQiwBJvEhMv4AAiAY3gRBbTIkiTImKAQAnBYv/UEcCvUUBREm+SEmBEVtMgRlbSU4CTgMyB0lPAgkhCBgJSU4DIQgJMgcWTwOBGU8CuwxBAAojFosAgQlPAruJiwGLAgxBAA+LAEm+RIEJWyMIFoEJTLuJigMAgABHAoABdov9UEm9RQFAAB+L/hSL/xYrIk8DVFBJVwAITIFAUysiTwJUUIsDTL+JiwO+REmBQFOMASJbjAKL/kEABYsBQAAKi/5AAGiLAUAAYyOMAIsCQAAPi/4Ui/8WKyJPA1RQQv+0i/+LAhJBAAqLAEEABScgQv+ii/+LAg2LABBBABOL/4sCCYsBFEwWKyJPA1RQQv+EiwBBAA+LAov/CRYrIosBVFBC/3CLAov/CEL/7iKMAEL/mooEAIv+iOhZi/yI6FRLARWBEBJESRWBEBJEUIv/Fisii/1UUCcQTwJQTL+JigkAIoAAi/iIBKYURIv8QAAFi/1BADCL/EEA44v9QQANi/0WJxNMUL1FAUAAGYv9QADMJwWL+FC+RFdCCCcTTFC9RQFBALgjRIv+JxkSSSEFC4v5i/pLA08DiAOUi/iI/laL+Ij8NYwBKov3UEmMAL1FARREQQCCi/qL/1AyBxaL+ExQi/sWUCsii/xUUIv9FlArUIv+UExJFRZXBgJMUEwnIVBMUIsASbxITL+L9yOLAUlOA4j+Xov3I4v4TwOI/xIiKGVESScIZUgiW0sBJwplSCJbTwJLAk8CiOhnIluxIicHZURyCERPArIRshSyEoEEshAisgGziYv6Qv9+IkL/RYoLACKL9ogDpRREKov6UCIlukmL9oj6hhREi/aI/ZYiKGVEJwplSCRbSwGI+2siKGVEJwplSEmBEFtMgRhbiOcZSSEEDkRLAR0hBJeL/icPEklOAk4ETwNPA08CiPt5i/gITEEAAyEFCIv3i/mLAUlOA08EiAJ3Kov1UEmMAL1FARREQQBQi/mL+lCL/1AyBxaL9kxQi/sWUCsii/xUUIv9FlArUIv+UExJFRZXBgJMUEwnIVBMUIsASbxITL+L9oj61ov1I0sCiP1Ii/Uji/ZPA4j9/ImL+Yv6UEL/sIoFAIv7iALIFEQqi/5QSb1FAUQiJbpHAov7iPmjFESL+4jmMIv+iOYrSwEVgRASREkVgRASRFAnEExQvUUBFESL+xNEJwWL+1C+RIGIA1MURCIoZUQnCGVIIluL+4j8dyIoZUQnCmVISSRbTEmBEFtOAoEYW0yL/0EAS4sASYj6OosCiwOI5fRJIQQORE8CSU8CHSEEl4j6YYv8OAcyChKL/DgIi/1PAwgSEESL+4j6Cov+i/9LAoj8e4v+i/+L+08DiP0uiYv8OAcyChKL/DgIi/0SEESxIicHZURyCESLAbIRshSyEoEEshAisgGzQv+6igUAi/uIAdYURCqL/lBJvUUBRCIlukmL+4j4shREi/uL/3AASESL+4jlN4v+iOUySwEVgRASREkVgRASRFCL/xZMSwFQgAFlTFBJTgO9RQEURIv7iPuOIihlRCcKZUhJJFtLAYEQW08CgRhbSwSI+VhOAojlFIv+FSUSRIv+TwNQJxpMUElOBL1JTgJOBUhOA4j5dIv9CEyAAIgBd0mBMFtOAoEoW04CQQAxi/w4BzIKEov8OAhPAosDCBIQRIsCQQAQiwFJvkQXIwgWv4sAIrlIiSMWiwFMv0L/8YsECEL/yYoCACIoZURJJwhlSCJbTCcKZUhJIltMJFuL/jgUMgoSi/44EU8EEhCL/jgSi/8nDhJPA08ETwJNEhBEiYoEAIAASYv9iADrSYEQW0xJgRhbTCVbIihlTElPAkRJJwhlSCJbTDEAiOSAiOReMgMSQQAmIosCiwMIiwQICIv/CIv+QQADIQUIi/w4BzIKEov8OAhPAhIQRImLBScVZUgkW4wBIQmMAIsGQQAViwFyCESLBnAARQFAAAchCTIQCIwAiwBC/66KBAAyB0krIov9VIv+FlAjFlBPAhZQTBZQIhZMSwFQSwFQKyKL/1RQSwFQSwFQTFAnBYv8UEy/iYoBAbEiKGVEJwllSIEYWyccshqL/7IashiBBrIQIrIBs7Q+SVcEAExXAAQpEkRJFSMSRCJTiYoBAYv/FYGQAwuBpLkCCBaAEAAAAAAAAHvUAAAAAAAAPVRMUIHklgEWTEsBUExQgdSsARZQgdSTARZMSwFQgfThAhZQSwFQTFCBhOgBFlCJigECi/+BAlmL/xWL/0sCTwJSIlmL/yJZi/9MTwNSIlkIgZA1C4HQKAiL/4mKAwGL/ScIZUgiW4v+THAARQFAAFWL/0EAULGL/ScRZUhXAAgyA4AERyevIbIaTLIaJw2yGrIaJwuyGicXshqL/7IYgQayECKyAbO0PklXBABMVwAEKRJESRUjEkQiU0EACDIQFicGTFCJgAkKAAAAAAAAAACJ\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "0245e2362d5b40738b3c6d6ab92018e66796f2c686ea51eae4377603d5f53735"
@@ -2,26 +2,358 @@
 package akitasocial
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendInit calls the init ABI method.
+	SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendPost calls the post ABI method.
+	SendPost(ctx context.Context, params algokit.CallParams[PostArgs]) error
+	// SendEditPost calls the editPost ABI method.
+	SendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) error
+	// SendGatedReply calls the gatedReply ABI method.
+	SendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) error
+	// SendReply calls the reply ABI method.
+	SendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) error
+	// SendGatedEditReply calls the gatedEditReply ABI method.
+	SendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) error
+	// SendEditReply calls the editReply ABI method.
+	SendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) error
+	// SendVote calls the vote ABI method.
+	SendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) error
+	// SendEditVote calls the editVote ABI method.
+	SendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) error
+	// SendGatedReact calls the gatedReact ABI method.
+	SendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) error
+	// SendReact calls the react ABI method.
+	SendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) error
+	// SendDeleteReaction calls the deleteReaction ABI method.
+	SendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) error
+	// SendSetPostFlag calls the setPostFlag ABI method.
+	SendSetPostFlag(ctx context.Context, params algokit.CallParams[SetPostFlagArgs]) error
+	// SendInitMeta calls the initMeta ABI method.
+	SendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error)
+	// SendCreatePayWall calls the createPayWall ABI method.
+	SendCreatePayWall(ctx context.Context, params algokit.CallParams[CreatePayWallArgs]) (*CreatePayWallMethodResult, error)
+	// SendUpdateMeta calls the updateMeta ABI method.
+	SendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) error
+	// SendUpdateFollowerMeta calls the updateFollowerMeta ABI method.
+	SendUpdateFollowerMeta(ctx context.Context, params algokit.CallParams[UpdateFollowerMetaArgs]) error
+	// SendIsBanned calls the isBanned ABI method (readonly).
+	SendIsBanned(ctx context.Context, params algokit.CallParams[IsBannedArgs]) (*IsBannedMethodResult, error)
+	// SendGetUserSocialImpact calls the getUserSocialImpact ABI method (readonly).
+	SendGetUserSocialImpact(ctx context.Context, params algokit.CallParams[GetUserSocialImpactArgs]) (*GetUserSocialImpactMethodResult, error)
+	// SendGetMetaExists calls the getMetaExists ABI method (readonly).
+	SendGetMetaExists(ctx context.Context, params algokit.CallParams[GetMetaExistsArgs]) (*GetMetaExistsMethodResult, error)
+	// SendGetMeta calls the getMeta ABI method (readonly).
+	SendGetMeta(ctx context.Context, params algokit.CallParams[GetMetaArgs]) (*GetMetaMethodResult, error)
+	// SendGetPostExists calls the getPostExists ABI method (readonly).
+	SendGetPostExists(ctx context.Context, params algokit.CallParams[GetPostExistsArgs]) (*GetPostExistsMethodResult, error)
+	// SendGetPost calls the getPost ABI method (readonly).
+	SendGetPost(ctx context.Context, params algokit.CallParams[GetPostArgs]) (*GetPostMethodResult, error)
+	// SendGetVote calls the getVote ABI method (readonly).
+	SendGetVote(ctx context.Context, params algokit.CallParams[GetVoteArgs]) (*GetVoteMethodResult, error)
+	// SendGetVotes calls the getVotes ABI method (readonly).
+	SendGetVotes(ctx context.Context, params algokit.CallParams[GetVotesArgs]) (*GetVotesMethodResult, error)
+	// SendGetReactionExists calls the getReactionExists ABI method (readonly).
+	SendGetReactionExists(ctx context.Context, params algokit.CallParams[GetReactionExistsArgs]) (*GetReactionExistsMethodResult, error)
+	// SendMBR calls the mbr ABI method.
+	SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error)
+	// SendPayWallMBR calls the payWallMbr ABI method.
+	SendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error)
+	// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method.
+	SendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error)
+	// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method.
+	SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error
+	// SendUpdateAkitaDao calls the updateAkitaDAO ABI method.
+	SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapMeta reads the value for key in the meta box map.
+	GetBoxMapMeta(ctx context.Context, key types.Address) (MetaValue, error)
+	// GetBoxMapPaywall reads the value for key in the paywall box map.
+	GetBoxMapPaywall(ctx context.Context, key uint64) (ViewPayWallValue, error)
+	// GetBoxMapPosts reads the value for key in the posts box map.
+	GetBoxMapPosts(ctx context.Context, key []byte) (PostValue, error)
+	// GetBoxMapReactionlist reads the value for key in the reactionlist box map.
+	GetBoxMapReactionlist(ctx context.Context, key ReactionListKey) ([]byte, error)
+	// GetBoxMapReactions reads the value for key in the reactions box map.
+	GetBoxMapReactions(ctx context.Context, key ReactionsKey) (uint64, error)
+	// GetBoxMapVotelist reads the value for key in the votelist box map.
+	GetBoxMapVotelist(ctx context.Context, key VoteListKey) (VoteListValue, error)
+	// GetBoxMapVotes reads the value for key in the votes box map.
+	GetBoxMapVotes(ctx context.Context, key []byte) (VotesValue, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the AkitaSocial smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +365,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("AkitaSocial has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,372 +414,397 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
-// NewGroup creates a new Composer for building atomic transaction groups.
-func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
 	}
+	return c.params.Algorand.Algod(), nil
 }
 
-// SendInit calls the init ABI method and waits for confirmation.
-func (c *Client) SendInit(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match AkitaSocial")
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "init",
-		MethodArgs: methodArgs,
-	})
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
 	if err != nil {
 		return err
 	}
-
-	_ = result
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
 	return nil
 }
 
-// SendPost calls the post ABI method and waits for confirmation.
+// NewGroup creates a new Composer for building atomic transaction groups.
+func (c *Client) NewGroup() *Composer {
+	return &Composer{client: c}
+}
+
+// SendInit calls the init ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return err
+	}
+	_, err = c.sendInit(ctx, params)
+	return err
+}
+
+func (c *Client) sendInit(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Init(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
+
+// SendPost calls the post ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendPost(ctx context.Context, params algokit.CallParams[PostArgs]) error {
-	methodArgs := argsToInterfacePost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "post",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPost(ctx context.Context, params algokit.CallParams[PostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Post(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditPost calls the editPost ABI method and waits for confirmation.
+// SendEditPost calls the editPost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) error {
-	methodArgs := argsToInterfaceEditPost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editPost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditPost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedReply calls the gatedReply ABI method and waits for confirmation.
+// SendGatedReply calls the gatedReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) error {
-	methodArgs := argsToInterfaceGatedReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendReply calls the reply ABI method and waits for confirmation.
+// SendReply calls the reply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) error {
-	methodArgs := argsToInterfaceReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "reply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Reply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedEditReply calls the gatedEditReply ABI method and waits for confirmation.
+// SendGatedEditReply calls the gatedEditReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) error {
-	methodArgs := argsToInterfaceGatedEditReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedEditReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedEditReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedEditReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditReply calls the editReply ABI method and waits for confirmation.
+// SendEditReply calls the editReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) error {
-	methodArgs := argsToInterfaceEditReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendVote calls the vote ABI method and waits for confirmation.
+// SendVote calls the vote ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) error {
-	methodArgs := argsToInterfaceVote(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "vote",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendVote(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Vote(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditVote calls the editVote ABI method and waits for confirmation.
+// SendEditVote calls the editVote ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) error {
-	methodArgs := argsToInterfaceEditVote(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editVote",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditVote(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditVote(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedReact calls the gatedReact ABI method and waits for confirmation.
+// SendGatedReact calls the gatedReact ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) error {
-	methodArgs := argsToInterfaceGatedReact(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedReact",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedReact(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedReact(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendReact calls the react ABI method and waits for confirmation.
+// SendReact calls the react ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) error {
-	methodArgs := argsToInterfaceReact(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "react",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendReact(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().React(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteReaction calls the deleteReaction ABI method and waits for confirmation.
+// SendDeleteReaction calls the deleteReaction ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) error {
-	methodArgs := argsToInterfaceDeleteReaction(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteReaction",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteReaction(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteReaction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetPostFlag calls the setPostFlag ABI method and waits for confirmation.
+// SendSetPostFlag calls the setPostFlag ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSetPostFlag(ctx context.Context, params algokit.CallParams[SetPostFlagArgs]) error {
-	methodArgs := argsToInterfaceSetPostFlag(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setPostFlag",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetPostFlag(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetPostFlag(ctx context.Context, params algokit.CallParams[SetPostFlagArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetPostFlag(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendInitMeta calls the initMeta ABI method and waits for confirmation.
+// SendInitMeta calls the initMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error) {
-	methodArgs := argsToInterfaceInitMeta(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendInitMeta(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "initMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error) {
+	comp, err := c.NewGroup().InitMeta(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*InitMetaMethodResult)
+	return typedResult, nil
+}
 
+// decodeInitMetaResult decodes the return value of a initMeta call.
+func (c *Client) decodeInitMetaResult(result *algokit.SendAppTransactionResult) (*InitMetaMethodResult, error) {
 	typedResult := &InitMetaMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -422,28 +818,31 @@ func (c *Client) SendInitMeta(ctx context.Context, params algokit.CallParams[Ini
 	return typedResult, nil
 }
 
-// SendCreatePayWall calls the createPayWall ABI method and waits for confirmation.
+// SendCreatePayWall calls the createPayWall ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendCreatePayWall(ctx context.Context, params algokit.CallParams[CreatePayWallArgs]) (*CreatePayWallMethodResult, error) {
-	methodArgs := argsToInterfaceCreatePayWall(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendCreatePayWall(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "createPayWall",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendCreatePayWall(ctx context.Context, params algokit.CallParams[CreatePayWallArgs]) (*CreatePayWallMethodResult, error) {
+	comp, err := c.NewGroup().CreatePayWall(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*CreatePayWallMethodResult)
+	return typedResult, nil
+}
 
+// decodeCreatePayWallResult decodes the return value of a createPayWall call.
+func (c *Client) decodeCreatePayWallResult(result *algokit.SendAppTransactionResult) (*CreatePayWallMethodResult, error) {
 	typedResult := &CreatePayWallMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -457,80 +856,79 @@ func (c *Client) SendCreatePayWall(ctx context.Context, params algokit.CallParam
 	return typedResult, nil
 }
 
-// SendUpdateMeta calls the updateMeta ABI method and waits for confirmation.
+// SendUpdateMeta calls the updateMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) error {
-	methodArgs := argsToInterfaceUpdateMeta(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateMeta(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateFollowerMeta calls the updateFollowerMeta ABI method and waits for confirmation.
+// SendUpdateFollowerMeta calls the updateFollowerMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateFollowerMeta(ctx context.Context, params algokit.CallParams[UpdateFollowerMetaArgs]) error {
-	methodArgs := argsToInterfaceUpdateFollowerMeta(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateFollowerMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateFollowerMeta(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateFollowerMeta(ctx context.Context, params algokit.CallParams[UpdateFollowerMetaArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateFollowerMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendIsBanned calls the isBanned ABI method and waits for confirmation.
+// SendIsBanned calls the isBanned ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendIsBanned(ctx context.Context, params algokit.CallParams[IsBannedArgs]) (*IsBannedMethodResult, error) {
-	methodArgs := argsToInterfaceIsBanned(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendIsBanned(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "isBanned",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendIsBanned(ctx context.Context, params algokit.CallParams[IsBannedArgs]) (*IsBannedMethodResult, error) {
+	comp, err := c.NewGroup().IsBanned(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*IsBannedMethodResult)
+	return typedResult, nil
+}
 
+// decodeIsBannedResult decodes the return value of a isBanned call.
+func (c *Client) decodeIsBannedResult(result *algokit.SendAppTransactionResult) (*IsBannedMethodResult, error) {
 	typedResult := &IsBannedMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -544,28 +942,31 @@ func (c *Client) SendIsBanned(ctx context.Context, params algokit.CallParams[IsB
 	return typedResult, nil
 }
 
-// SendGetUserSocialImpact calls the getUserSocialImpact ABI method and waits for confirmation.
+// SendGetUserSocialImpact calls the getUserSocialImpact ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetUserSocialImpact(ctx context.Context, params algokit.CallParams[GetUserSocialImpactArgs]) (*GetUserSocialImpactMethodResult, error) {
-	methodArgs := argsToInterfaceGetUserSocialImpact(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetUserSocialImpact(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getUserSocialImpact",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetUserSocialImpact(ctx context.Context, params algokit.CallParams[GetUserSocialImpactArgs]) (*GetUserSocialImpactMethodResult, error) {
+	comp, err := c.NewGroup().GetUserSocialImpact(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetUserSocialImpactMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetUserSocialImpactResult decodes the return value of a getUserSocialImpact call.
+func (c *Client) decodeGetUserSocialImpactResult(result *algokit.SendAppTransactionResult) (*GetUserSocialImpactMethodResult, error) {
 	typedResult := &GetUserSocialImpactMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -579,28 +980,31 @@ func (c *Client) SendGetUserSocialImpact(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendGetMetaExists calls the getMetaExists ABI method and waits for confirmation.
+// SendGetMetaExists calls the getMetaExists ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetMetaExists(ctx context.Context, params algokit.CallParams[GetMetaExistsArgs]) (*GetMetaExistsMethodResult, error) {
-	methodArgs := argsToInterfaceGetMetaExists(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetMetaExists(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getMetaExists",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetMetaExists(ctx context.Context, params algokit.CallParams[GetMetaExistsArgs]) (*GetMetaExistsMethodResult, error) {
+	comp, err := c.NewGroup().GetMetaExists(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetMetaExistsMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetMetaExistsResult decodes the return value of a getMetaExists call.
+func (c *Client) decodeGetMetaExistsResult(result *algokit.SendAppTransactionResult) (*GetMetaExistsMethodResult, error) {
 	typedResult := &GetMetaExistsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -614,28 +1018,31 @@ func (c *Client) SendGetMetaExists(ctx context.Context, params algokit.CallParam
 	return typedResult, nil
 }
 
-// SendGetMeta calls the getMeta ABI method and waits for confirmation.
+// SendGetMeta calls the getMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetMeta(ctx context.Context, params algokit.CallParams[GetMetaArgs]) (*GetMetaMethodResult, error) {
-	methodArgs := argsToInterfaceGetMeta(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetMeta(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetMeta(ctx context.Context, params algokit.CallParams[GetMetaArgs]) (*GetMetaMethodResult, error) {
+	comp, err := c.NewGroup().GetMeta(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetMetaMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetMetaResult decodes the return value of a getMeta call.
+func (c *Client) decodeGetMetaResult(result *algokit.SendAppTransactionResult) (*GetMetaMethodResult, error) {
 	typedResult := &GetMetaMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -651,28 +1058,31 @@ func (c *Client) SendGetMeta(ctx context.Context, params algokit.CallParams[GetM
 	return typedResult, nil
 }
 
-// SendGetPostExists calls the getPostExists ABI method and waits for confirmation.
+// SendGetPostExists calls the getPostExists ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetPostExists(ctx context.Context, params algokit.CallParams[GetPostExistsArgs]) (*GetPostExistsMethodResult, error) {
-	methodArgs := argsToInterfaceGetPostExists(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetPostExists(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getPostExists",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetPostExists(ctx context.Context, params algokit.CallParams[GetPostExistsArgs]) (*GetPostExistsMethodResult, error) {
+	comp, err := c.NewGroup().GetPostExists(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetPostExistsMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetPostExistsResult decodes the return value of a getPostExists call.
+func (c *Client) decodeGetPostExistsResult(result *algokit.SendAppTransactionResult) (*GetPostExistsMethodResult, error) {
 	typedResult := &GetPostExistsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -686,28 +1096,31 @@ func (c *Client) SendGetPostExists(ctx context.Context, params algokit.CallParam
 	return typedResult, nil
 }
 
-// SendGetPost calls the getPost ABI method and waits for confirmation.
+// SendGetPost calls the getPost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetPost(ctx context.Context, params algokit.CallParams[GetPostArgs]) (*GetPostMethodResult, error) {
-	methodArgs := argsToInterfaceGetPost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetPost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getPost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetPost(ctx context.Context, params algokit.CallParams[GetPostArgs]) (*GetPostMethodResult, error) {
+	comp, err := c.NewGroup().GetPost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetPostMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetPostResult decodes the return value of a getPost call.
+func (c *Client) decodeGetPostResult(result *algokit.SendAppTransactionResult) (*GetPostMethodResult, error) {
 	typedResult := &GetPostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -723,28 +1136,31 @@ func (c *Client) SendGetPost(ctx context.Context, params algokit.CallParams[GetP
 	return typedResult, nil
 }
 
-// SendGetVote calls the getVote ABI method and waits for confirmation.
+// SendGetVote calls the getVote ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetVote(ctx context.Context, params algokit.CallParams[GetVoteArgs]) (*GetVoteMethodResult, error) {
-	methodArgs := argsToInterfaceGetVote(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetVote(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getVote",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetVote(ctx context.Context, params algokit.CallParams[GetVoteArgs]) (*GetVoteMethodResult, error) {
+	comp, err := c.NewGroup().GetVote(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetVoteMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetVoteResult decodes the return value of a getVote call.
+func (c *Client) decodeGetVoteResult(result *algokit.SendAppTransactionResult) (*GetVoteMethodResult, error) {
 	typedResult := &GetVoteMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -760,63 +1176,69 @@ func (c *Client) SendGetVote(ctx context.Context, params algokit.CallParams[GetV
 	return typedResult, nil
 }
 
-// SendGetVotes calls the getVotes ABI method and waits for confirmation.
+// SendGetVotes calls the getVotes ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetVotes(ctx context.Context, params algokit.CallParams[GetVotesArgs]) (*GetVotesMethodResult, error) {
-	methodArgs := argsToInterfaceGetVotes(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetVotes(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getVotes",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetVotes(ctx context.Context, params algokit.CallParams[GetVotesArgs]) (*GetVotesMethodResult, error) {
+	comp, err := c.NewGroup().GetVotes(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetVotesMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetVotesResult decodes the return value of a getVotes call.
+func (c *Client) decodeGetVotesResult(result *algokit.SendAppTransactionResult) (*GetVotesMethodResult, error) {
 	typedResult := &GetVotesMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetReactionExists calls the getReactionExists ABI method and waits for confirmation.
+// SendGetReactionExists calls the getReactionExists ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetReactionExists(ctx context.Context, params algokit.CallParams[GetReactionExistsArgs]) (*GetReactionExistsMethodResult, error) {
-	methodArgs := argsToInterfaceGetReactionExists(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetReactionExists(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getReactionExists",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetReactionExists(ctx context.Context, params algokit.CallParams[GetReactionExistsArgs]) (*GetReactionExistsMethodResult, error) {
+	comp, err := c.NewGroup().GetReactionExists(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetReactionExistsMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetReactionExistsResult decodes the return value of a getReactionExists call.
+func (c *Client) decodeGetReactionExistsResult(result *algokit.SendAppTransactionResult) (*GetReactionExistsMethodResult, error) {
 	typedResult := &GetReactionExistsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -830,28 +1252,31 @@ func (c *Client) SendGetReactionExists(ctx context.Context, params algokit.CallP
 	return typedResult, nil
 }
 
-// SendMBR calls the mbr ABI method and waits for confirmation.
+// SendMBR calls the mbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
-	methodArgs := argsToInterfaceMBR(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mbr",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
+	comp, err := c.NewGroup().MBR(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MBRMethodResult)
+	return typedResult, nil
+}
 
+// decodeMBRResult decodes the return value of a mbr call.
+func (c *Client) decodeMBRResult(result *algokit.SendAppTransactionResult) (*MBRMethodResult, error) {
 	typedResult := &MBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -867,28 +1292,31 @@ func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]
 	return typedResult, nil
 }
 
-// SendPayWallMBR calls the payWallMbr ABI method and waits for confirmation.
+// SendPayWallMBR calls the payWallMbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error) {
-	methodArgs := argsToInterfacePayWallMBR(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendPayWallMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "payWallMbr",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error) {
+	comp, err := c.NewGroup().PayWallMBR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*PayWallMBRMethodResult)
+	return typedResult, nil
+}
 
+// decodePayWallMBRResult decodes the return value of a payWallMbr call.
+func (c *Client) decodePayWallMBRResult(result *algokit.SendAppTransactionResult) (*PayWallMBRMethodResult, error) {
 	typedResult := &PayWallMBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -902,28 +1330,31 @@ func (c *Client) SendPayWallMBR(ctx context.Context, params algokit.CallParams[P
 	return typedResult, nil
 }
 
-// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method and waits for confirmation.
+// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error) {
-	methodArgs := argsToInterfaceCheckTipMBRRequirements(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendCheckTipMBRRequirements(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "checkTipMbrRequirements",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error) {
+	comp, err := c.NewGroup().CheckTipMBRRequirements(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*CheckTipMBRRequirementsMethodResult)
+	return typedResult, nil
+}
 
+// decodeCheckTipMBRRequirementsResult decodes the return value of a checkTipMbrRequirements call.
+func (c *Client) decodeCheckTipMBRRequirementsResult(result *algokit.SendAppTransactionResult) (*CheckTipMBRRequirementsMethodResult, error) {
 	typedResult := &CheckTipMBRRequirementsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -939,72 +1370,77 @@ func (c *Client) SendCheckTipMBRRequirements(ctx context.Context, params algokit
 	return typedResult, nil
 }
 
-// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation.
+// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDaoEscrow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAOEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateAkitaDaoEscrow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDaoEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation.
+// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDao(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAO",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendUpdateAkitaDao(ctx, params)
+	return err
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDao(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
 func argsToInterfaceCreate(args CreateArgs) []interface{} {
@@ -1152,11 +1588,15 @@ func argsToInterfaceInitMeta(args InitMetaArgs) []interface{} {
 	}
 }
 
-func argsToInterfaceCreatePayWall(args CreatePayWallArgs) []interface{} {
-	return []interface{}{
-		args.MBRPayment,
-		args.PayWall,
+func argsToInterfaceCreatePayWall(args CreatePayWallArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 2)
+	methodArgs = append(methodArgs, args.MBRPayment)
+	if v, err := toABIValue(args.PayWall); err != nil {
+		return nil, fmt.Errorf("invalid payWall argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceUpdateMeta(args UpdateMetaArgs) []interface{} {
@@ -1239,10 +1679,14 @@ func argsToInterfaceMBR(args MBRArgs) []interface{} {
 	}
 }
 
-func argsToInterfacePayWallMBR(args PayWallMBRArgs) []interface{} {
-	return []interface{}{
-		args.Paywall,
+func argsToInterfacePayWallMBR(args PayWallMBRArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Paywall); err != nil {
+		return nil, fmt.Errorf("invalid paywall argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceCheckTipMBRRequirements(args CheckTipMBRRequirementsArgs) []interface{} {
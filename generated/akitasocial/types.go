@@ -56,24 +56,24 @@ type ReactionListKey struct {
 	NFT  uint64   `json:"NFT"`
 }
 
-// ViewPayWallValue is a generated struct type.
-type ViewPayWallValue struct {
-	UserPayInfo  [][]interface{} `json:"userPayInfo"`
-	AgentPayInfo [][]interface{} `json:"agentPayInfo"`
-}
-
-// VotesValue is a generated struct type.
-type VotesValue struct {
-	VoteCount  uint64 `json:"voteCount"`
-	IsNegative bool   `json:"isNegative"`
-}
-
 // ReactionsKey is a generated struct type.
 type ReactionsKey struct {
 	Ref [32]byte `json:"ref"`
 	NFT uint64   `json:"NFT"`
 }
 
+// TipMBRInfo is a generated struct type.
+type TipMBRInfo struct {
+	Type  uint8  `json:"type"`
+	Arc58 uint64 `json:"arc58"`
+}
+
+// ViewPayWallValue is a generated struct type.
+type ViewPayWallValue struct {
+	UserPayInfo  []Tuple3[uint8, uint64, uint64] `json:"userPayInfo"`
+	AgentPayInfo []Tuple3[uint8, uint64, uint64] `json:"agentPayInfo"`
+}
+
 // VoteListKey is a generated struct type.
 type VoteListKey struct {
 	User [16]byte `json:"user"`
@@ -86,326 +86,326 @@ type VoteListValue struct {
 	IsUp   bool   `json:"isUp"`
 }
 
-// TipMBRInfo is a generated struct type.
-type TipMBRInfo struct {
-	Type  uint8  `json:"type"`
-	Arc58 uint64 `json:"arc58"`
+// VotesValue is a generated struct type.
+type VotesValue struct {
+	VoteCount  uint64 `json:"voteCount"`
+	IsNegative bool   `json:"isNegative"`
 }
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // PostArgs holds the arguments for the post method.
 type PostArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	GateID     uint64
-	UsePayWall bool
-	PayWallID  uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Timestamp  uint64                            `json:"timestamp"`
+	Nonce      [24]byte                          `json:"nonce"`
+	Cid        [36]byte                          `json:"cid"`
+	GateID     uint64                            `json:"gateID"`
+	UsePayWall bool                              `json:"usePayWall"`
+	PayWallID  uint64                            `json:"payWallID"`
 }
 
 // EditPostArgs holds the arguments for the editPost method.
 type EditPostArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Cid        [36]byte
-	Amendment  [32]byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Cid        [36]byte                          `json:"cid"`
+	Amendment  [32]byte                          `json:"amendment"`
 }
 
 // GatedReplyArgs holds the arguments for the gatedReply method.
 type GatedReplyArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	GateTXN    transaction.TransactionWithSigner
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	Ref        []byte
-	Type       uint8
-	GateID     uint64
-	UsePayWall bool
-	PayWallID  uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	GateTXN    transaction.TransactionWithSigner `json:"-"`
+	Timestamp  uint64                            `json:"timestamp"`
+	Nonce      [24]byte                          `json:"nonce"`
+	Cid        [36]byte                          `json:"cid"`
+	Ref        []byte                            `json:"ref"`
+	Type       uint8                             `json:"type"`
+	GateID     uint64                            `json:"gateID"`
+	UsePayWall bool                              `json:"usePayWall"`
+	PayWallID  uint64                            `json:"payWallID"`
 }
 
 // ReplyArgs holds the arguments for the reply method.
 type ReplyArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	Ref        []byte
-	Type       uint8
-	GateID     uint64
-	UsePayWall bool
-	PayWallID  uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Timestamp  uint64                            `json:"timestamp"`
+	Nonce      [24]byte                          `json:"nonce"`
+	Cid        [36]byte                          `json:"cid"`
+	Ref        []byte                            `json:"ref"`
+	Type       uint8                             `json:"type"`
+	GateID     uint64                            `json:"gateID"`
+	UsePayWall bool                              `json:"usePayWall"`
+	PayWallID  uint64                            `json:"payWallID"`
 }
 
 // GatedEditReplyArgs holds the arguments for the gatedEditReply method.
 type GatedEditReplyArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	GateTXN    transaction.TransactionWithSigner
-	Cid        [36]byte
-	Amendment  [32]byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	GateTXN    transaction.TransactionWithSigner `json:"-"`
+	Cid        [36]byte                          `json:"cid"`
+	Amendment  [32]byte                          `json:"amendment"`
 }
 
 // EditReplyArgs holds the arguments for the editReply method.
 type EditReplyArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Cid        [36]byte
-	Amendment  [32]byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Cid        [36]byte                          `json:"cid"`
+	Amendment  [32]byte                          `json:"amendment"`
 }
 
 // VoteArgs holds the arguments for the vote method.
 type VoteArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Ref        []byte
-	Type       uint8
-	IsUp       bool
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Ref        []byte                            `json:"ref"`
+	Type       uint8                             `json:"type"`
+	IsUp       bool                              `json:"isUp"`
 }
 
 // EditVoteArgs holds the arguments for the editVote method.
 type EditVoteArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Ref        [32]byte
-	Flip       bool
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Ref        [32]byte                          `json:"ref"`
+	Flip       bool                              `json:"flip"`
 }
 
 // GatedReactArgs holds the arguments for the gatedReact method.
 type GatedReactArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	GateTXN    transaction.TransactionWithSigner
-	Ref        []byte
-	Type       uint8
-	NFT        uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	GateTXN    transaction.TransactionWithSigner `json:"-"`
+	Ref        []byte                            `json:"ref"`
+	Type       uint8                             `json:"type"`
+	NFT        uint64                            `json:"NFT"`
 }
 
 // ReactArgs holds the arguments for the react method.
 type ReactArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Tip        transaction.TransactionWithSigner
-	Ref        []byte
-	Type       uint8
-	NFT        uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Tip        transaction.TransactionWithSigner `json:"-"`
+	Ref        []byte                            `json:"ref"`
+	Type       uint8                             `json:"type"`
+	NFT        uint64                            `json:"NFT"`
 }
 
 // DeleteReactionArgs holds the arguments for the deleteReaction method.
 type DeleteReactionArgs struct {
-	Ref [32]byte
-	NFT uint64
+	Ref [32]byte `json:"ref"`
+	NFT uint64   `json:"NFT"`
 }
 
 // SetPostFlagArgs holds the arguments for the setPostFlag method.
 type SetPostFlagArgs struct {
-	Ref     [32]byte
-	Flagged bool
+	Ref     [32]byte `json:"ref"`
+	Flagged bool     `json:"flagged"`
 }
 
 // InitMetaArgs holds the arguments for the initMeta method.
 type InitMetaArgs struct {
-	MBRPayment        transaction.TransactionWithSigner
-	User              types.Address
-	Automated         bool
-	SubscriptionIndex uint64
-	Nfd               uint64
-	AkitaNFT          uint64
+	MBRPayment        transaction.TransactionWithSigner `json:"-"`
+	User              types.Address                     `json:"user"`
+	Automated         bool                              `json:"automated"`
+	SubscriptionIndex uint64                            `json:"subscriptionIndex"`
+	Nfd               uint64                            `json:"NFD"`
+	AkitaNFT          uint64                            `json:"akitaNFT"`
 }
 
 // InitMetaMethodResult holds the result of calling initMeta.
 type InitMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CreatePayWallArgs holds the arguments for the createPayWall method.
 type CreatePayWallArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	PayWall    ViewPayWallValue
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	PayWall    ViewPayWallValue                  `json:"payWall"`
 }
 
 // CreatePayWallMethodResult holds the result of calling createPayWall.
 type CreatePayWallMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateMetaArgs holds the arguments for the updateMeta method.
 type UpdateMetaArgs struct {
-	FollowGateID      uint64
-	AddressGateID     uint64
-	SubscriptionIndex uint64
-	Nfd               uint64
-	AkitaNFT          uint64
-	DefaultPayWallID  uint64
+	FollowGateID      uint64 `json:"followGateID"`
+	AddressGateID     uint64 `json:"addressGateID"`
+	SubscriptionIndex uint64 `json:"subscriptionIndex"`
+	Nfd               uint64 `json:"NFD"`
+	AkitaNFT          uint64 `json:"akitaNFT"`
+	DefaultPayWallID  uint64 `json:"defaultPayWallID"`
 }
 
 // UpdateFollowerMetaArgs holds the arguments for the updateFollowerMeta method.
 type UpdateFollowerMetaArgs struct {
-	Address          types.Address
-	NewFollowerIndex uint64
-	NewFollowerCount uint64
+	Address          types.Address `json:"address"`
+	NewFollowerIndex uint64        `json:"newFollowerIndex"`
+	NewFollowerCount uint64        `json:"newFollowerCount"`
 }
 
 // IsBannedArgs holds the arguments for the isBanned method.
 type IsBannedArgs struct {
-	Account types.Address
+	Account types.Address `json:"account"`
 }
 
 // IsBannedMethodResult holds the result of calling isBanned.
 type IsBannedMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetUserSocialImpactArgs holds the arguments for the getUserSocialImpact method.
 type GetUserSocialImpactArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // GetUserSocialImpactMethodResult holds the result of calling getUserSocialImpact.
 type GetUserSocialImpactMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetMetaExistsArgs holds the arguments for the getMetaExists method.
 type GetMetaExistsArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // GetMetaExistsMethodResult holds the result of calling getMetaExists.
 type GetMetaExistsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetMetaArgs holds the arguments for the getMeta method.
 type GetMetaArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // GetMetaMethodResult holds the result of calling getMeta.
 type GetMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return MetaValue
+	Return MetaValue `json:"return"`
 }
 
 // GetPostExistsArgs holds the arguments for the getPostExists method.
 type GetPostExistsArgs struct {
-	Ref [32]byte
+	Ref [32]byte `json:"ref"`
 }
 
 // GetPostExistsMethodResult holds the result of calling getPostExists.
 type GetPostExistsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetPostArgs holds the arguments for the getPost method.
 type GetPostArgs struct {
-	Ref [32]byte
+	Ref [32]byte `json:"ref"`
 }
 
 // GetPostMethodResult holds the result of calling getPost.
 type GetPostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return PostValue
+	Return PostValue `json:"return"`
 }
 
 // GetVoteArgs holds the arguments for the getVote method.
 type GetVoteArgs struct {
-	Ref [32]byte
+	Ref [32]byte `json:"ref"`
 }
 
 // GetVoteMethodResult holds the result of calling getVote.
 type GetVoteMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return VoteListValue
+	Return VoteListValue `json:"return"`
 }
 
 // GetVotesArgs holds the arguments for the getVotes method.
 type GetVotesArgs struct {
-	Refs [][32]byte
+	Refs [][32]byte `json:"refs"`
 }
 
 // GetVotesMethodResult holds the result of calling getVotes.
 type GetVotesMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple2[uint64, bool] `json:"return"`
 }
 
 // GetReactionExistsArgs holds the arguments for the getReactionExists method.
 type GetReactionExistsArgs struct {
-	Ref [32]byte
-	NFT uint64
+	Ref [32]byte `json:"ref"`
+	NFT uint64   `json:"NFT"`
 }
 
 // GetReactionExistsMethodResult holds the result of calling getReactionExists.
 type GetReactionExistsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Ref []byte
+	Ref []byte `json:"ref"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AkitaSocialMBRData
+	Return AkitaSocialMBRData `json:"return"`
 }
 
 // PayWallMBRArgs holds the arguments for the payWallMbr method.
 type PayWallMBRArgs struct {
-	Paywall ViewPayWallValue
+	Paywall ViewPayWallValue `json:"paywall"`
 }
 
 // PayWallMBRMethodResult holds the result of calling payWallMbr.
 type PayWallMBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CheckTipMBRRequirementsArgs holds the arguments for the checkTipMbrRequirements method.
 type CheckTipMBRRequirementsArgs struct {
-	AkitaDao uint64
-	Creator  types.Address
-	Wallet   uint64
+	AkitaDao uint64        `json:"akitaDAO"`
+	Creator  types.Address `json:"creator"`
+	Wallet   uint64        `json:"wallet"`
 }
 
 // CheckTipMBRRequirementsMethodResult holds the result of calling checkTipMbrRequirements.
 type CheckTipMBRRequirementsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return TipMBRInfo
+	Return TipMBRInfo `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -7,18 +7,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// ViewPayWallValue is a generated struct type.
-type ViewPayWallValue struct {
-	UserPayInfo  [][]interface{} `json:"userPayInfo"`
-	AgentPayInfo [][]interface{} `json:"agentPayInfo"`
-}
-
-// TipMBRInfo is a generated struct type.
-type TipMBRInfo struct {
-	Type  uint8  `json:"type"`
-	Arc58 uint64 `json:"arc58"`
-}
-
 // AkitaSocialMBRData is a generated struct type.
 type AkitaSocialMBRData struct {
 	Follows      uint64 `json:"follows"`
@@ -46,120 +34,132 @@ type FollowsKey struct {
 	Follower [16]byte `json:"follower"`
 }
 
+// TipMBRInfo is a generated struct type.
+type TipMBRInfo struct {
+	Type  uint8  `json:"type"`
+	Arc58 uint64 `json:"arc58"`
+}
+
+// ViewPayWallValue is a generated struct type.
+type ViewPayWallValue struct {
+	UserPayInfo  []Tuple3[uint8, uint64, uint64] `json:"userPayInfo"`
+	AgentPayInfo []Tuple3[uint8, uint64, uint64] `json:"agentPayInfo"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao uint64
-	Version  string
+	AkitaDao uint64 `json:"akitaDao"`
+	Version  string `json:"version"`
 }
 
 // BlockArgs holds the arguments for the block method.
 type BlockArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Address    types.Address
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Address    types.Address                     `json:"address"`
 }
 
 // UnblockArgs holds the arguments for the unblock method.
 type UnblockArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GatedFollowArgs holds the arguments for the gatedFollow method.
 type GatedFollowArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	GateTXN    transaction.TransactionWithSigner
-	Address    types.Address
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	GateTXN    transaction.TransactionWithSigner `json:"-"`
+	Address    types.Address                     `json:"address"`
 }
 
 // FollowArgs holds the arguments for the follow method.
 type FollowArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Address    types.Address
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Address    types.Address                     `json:"address"`
 }
 
 // UnfollowArgs holds the arguments for the unfollow method.
 type UnfollowArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // IsBlockedArgs holds the arguments for the isBlocked method.
 type IsBlockedArgs struct {
-	User    types.Address
-	Blocked types.Address
+	User    types.Address `json:"user"`
+	Blocked types.Address `json:"blocked"`
 }
 
 // IsBlockedMethodResult holds the result of calling isBlocked.
 type IsBlockedMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // IsFollowingArgs holds the arguments for the isFollowing method.
 type IsFollowingArgs struct {
-	Follower types.Address
-	User     types.Address
+	Follower types.Address `json:"follower"`
+	User     types.Address `json:"user"`
 }
 
 // IsFollowingMethodResult holds the result of calling isFollowing.
 type IsFollowingMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetFollowIndexArgs holds the arguments for the getFollowIndex method.
 type GetFollowIndexArgs struct {
-	Follower types.Address
-	User     types.Address
+	Follower types.Address `json:"follower"`
+	User     types.Address `json:"user"`
 }
 
 // GetFollowIndexMethodResult holds the result of calling getFollowIndex.
 type GetFollowIndexMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Ref []byte
+	Ref []byte `json:"ref"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AkitaSocialMBRData
+	Return AkitaSocialMBRData `json:"return"`
 }
 
 // PayWallMBRArgs holds the arguments for the payWallMbr method.
 type PayWallMBRArgs struct {
-	Paywall ViewPayWallValue
+	Paywall ViewPayWallValue `json:"paywall"`
 }
 
 // PayWallMBRMethodResult holds the result of calling payWallMbr.
 type PayWallMBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CheckTipMBRRequirementsArgs holds the arguments for the checkTipMbrRequirements method.
 type CheckTipMBRRequirementsArgs struct {
-	AkitaDao uint64
-	Creator  types.Address
-	Wallet   uint64
+	AkitaDao uint64        `json:"akitaDAO"`
+	Creator  types.Address `json:"creator"`
+	Wallet   uint64        `json:"wallet"`
 }
 
 // CheckTipMBRRequirementsMethodResult holds the result of calling checkTipMbrRequirements.
 type CheckTipMBRRequirementsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return TipMBRInfo
+	Return TipMBRInfo `json:"return"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
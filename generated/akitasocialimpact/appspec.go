@@ -2,4 +2,11 @@
 package akitasocialimpact
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"AkitaSocialImpact\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"string\",\"name\":\"version\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"cacheMeta\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFDAppID\"},{\"type\":\"uint64\",\"name\":\"akitaAssetID\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateSubscriptionStateModifier\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"newModifier\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"getUserImpactWithoutSocial\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getUserImpact\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getMeta\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"ImpactMetaValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"ImpactMetaValue\":[{\"name\":\"subscriptionIndex\",\"type\":\"uint64\"},{\"name\":\"NFD\",\"type\":\"uint64\"},{\"name\":\"nfdTimeChanged\",\"type\":\"uint64\"},{\"name\":\"nfdImpact\",\"type\":\"uint64\"},{\"name\":\"akitaNFT\",\"type\":\"uint64\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":1,\"bytes\":1},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"meta\":{\"keyType\":\"address\",\"valueType\":\"ImpactMetaValue\",\"prefix\":\"bQ==\",\"desc\":\"A map of the meta data for each user\"},\"subscriptionStateModifier\":{\"keyType\":\"uint64\",\"valueType\":\"uint64\",\"prefix\":\"cw==\",\"desc\":\"A map of how each akita subscription affects impact calculation\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMzIgMTAwMDAwMDAwMDAwMCAyMDAwMDAwMDAwMDAgMzE1MzYwMDAgMTAwMDAwMDAwMDAKICAgIGJ5dGVjYmxvY2sgImFraXRhX2RhbyIgMHgxNTFmN2M3NSAibSIgIiIgInNhbCIgIm9hbCIgMHg2YzEzZWRlNCAiaS50aW1lQ2hhbmdlZCIgYmFzZTMyKEFLQ1RSREs0T1dOV0hUUEg0WFBLTE5XTkxaMzMzVkUzNVNLUTRGR1FLM1pKQTRGSUhDTFEpICJ3YWxsZXQiICJhYWwiICJha2l0YV9hc3NldHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwCiAgICAvLyBleHBvcnQgY2xhc3MgQWtpdGFTb2NpYWxJbXBhY3QgZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJ6IG1haW5fY3JlYXRlX05vT3BAMTEKICAgIHB1c2hieXRlc3MgMHgyN2UzYmI0ZiAweDlkNzRmNWExIDB4ZjgxYzdiNjIgMHhkNTc0YmIxMCAweGI2ZWM2YTVkIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgImNhY2hlTWV0YShhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0KXVpbnQ2NCIsIG1ldGhvZCAidXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihwYXksdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0VXNlckltcGFjdChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0TWV0YShhZGRyZXNzKSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU8odWludDY0KXZvaWQiLCBtZXRob2QgIm9wVXAoKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjYWNoZU1ldGEgdXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciBnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbCBnZXRVc2VySW1wYWN0IGdldE1ldGEgdXBkYXRlQWtpdGFEQU8gbWFpbl9vcFVwX3JvdXRlQDkKICAgIGVycgoKbWFpbl9vcFVwX3JvdXRlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjAKICAgIC8vIGV4cG9ydCBjbGFzcyBBa2l0YVNvY2lhbEltcGFjdCBleHRlbmRzIEFraXRhQmFzZUNvbnRyYWN0IHsKICAgIHB1c2hieXRlcyAweDZmOTgxN2Y2IC8vIG1ldGhvZCAiY3JlYXRlKHVpbnQ2NCxzdHJpbmcpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyNwogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgcHVzaGJ5dGVzICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDcKICAgIC8vIHRoaXMudmVyc2lvbi52YWx1ZSA9IHZlcnNpb24KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzA4CiAgICAvLyB0aGlzLmFraXRhREFPLnZhbHVlID0gQXBwbGljYXRpb24oYWtpdGFEQU8pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuY2FjaGVNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKY2FjaGVNZXRhOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gNQogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMxMwogICAgLy8gY2FjaGVNZXRhKGFkZHJlc3M6IEFjY291bnQsIHN1YnNjcmlwdGlvbkluZGV4OiB1aW50NjQsIE5GREFwcElEOiB1aW50NjQsIGFraXRhQXNzZXRJRDogdWludDY0KTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzE0CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCkuYWRkcmVzcywgRVJSX05PVF9TT0NJQUwpCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMxNAogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwpLmFkZHJlc3MsIEVSUl9OT1RfU09DSUFMKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDQgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzE0CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCkuYWRkcmVzcywgRVJSX05PVF9TT0NJQUwpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gTm90IGEgc29jaWFsIHBsdWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTYKICAgIC8vIGlmIChzdWJzY3JpcHRpb25JbmRleCAhPT0gMCkgewogICAgYnogY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTcKICAgIC8vIGFzc2VydCh0aGlzLmlzU3Vic2NyaWJlZChhZGRyZXNzLCBzdWJzY3JpcHRpb25JbmRleCkuYWN0aXZlLCBFUlJfTk9UX0FfU1VCU0NSSVBUSU9OKQogICAgZGlnIDQKICAgIGRpZyA0CiAgICBjYWxsc3ViIGlzU3Vic2NyaWJlZAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgYXNzZXJ0IC8vIE5vdCBhbiBha2l0YSBzdWJzY3JpcHRpb24gY29udHJhY3QKCmNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyMAogICAgLy8gbGV0IG5mZFRpbWVDaGFuZ2VkOiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyMQogICAgLy8gbGV0IG5mZEltcGFjdDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMjIKICAgIC8vIGlmIChORkRBcHBJRCAhPT0gMCkgewogICAgZGlnIDEKICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NzcKICAgIC8vIGNvbnN0IFtuZmROYW1lQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoTkZEQXBwLCBCeXRlcyhORkRHbG9iYWxTdGF0ZUtleXNOYW1lKSkKICAgIGRpZyAxCiAgICBkdXAKICAgIHB1c2hieXRlcyAiaS5uYW1lIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3OS04MgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIE5GRFJlZ2lzdHJ5LnByb3RvdHlwZS5pc1ZhbGlkTmZkQXBwSWQ+KHsKICAgIC8vICAgYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXJnczogW1N0cmluZyhuZmROYW1lQnl0ZXMpLCBORkRBcHAuaWRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4MAogICAgLy8gYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6ODAKICAgIC8vIGFwcElkOiBnZXRPdGhlckFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubmZkUmVnaXN0cnksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjgwCiAgICAvLyBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm5mZFJlZ2lzdHJ5LAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjgxCiAgICAvLyBhcmdzOiBbU3RyaW5nKG5mZE5hbWVCeXRlcyksIE5GREFwcC5pZF0KICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3OS04MgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIE5GRFJlZ2lzdHJ5LnByb3RvdHlwZS5pc1ZhbGlkTmZkQXBwSWQ+KHsKICAgIC8vICAgYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXJnczogW1N0cmluZyhuZmROYW1lQnl0ZXMpLCBORkRBcHAuaWRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4NGJlMjJmYzYgLy8gbWV0aG9kICJpc1ZhbGlkTmZkQXBwSWQoc3RyaW5nLHVpbnQ2NClib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNAogICAgLy8gYXNzZXJ0KHRoaXMuaXNORkQobmZkQXBwKSwgRVJSX05PVF9BTl9ORkQpCiAgICBhc3NlcnQgLy8gTm90IGFuIE5GRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4Ny05MAogICAgLy8gY29uc3QgY2FBbGdvRGF0YSA9IGFiaUNhbGw8dHlwZW9mIE5GRC5wcm90b3R5cGUucmVhZEZpZWxkPih7CiAgICAvLyAgIGFwcElkOiBORkRBcHAuaWQsCiAgICAvLyAgIGFyZ3M6IFtCeXRlcyhORkRNZXRhS2V5VmVyaWZpZWRBZGRyZXNzZXMpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIGJ5dGVjIDYgLy8gbWV0aG9kICJyZWFkRmllbGQoYnl0ZVtdKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjg5CiAgICAvLyBhcmdzOiBbQnl0ZXMoTkZETWV0YUtleVZlcmlmaWVkQWRkcmVzc2VzKV0KICAgIHB1c2hieXRlcyAweDAwMGQ3NjJlNjM2MTQxNmM2NzZmMmUzMDJlNjE3MwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4Ny05MAogICAgLy8gY29uc3QgY2FBbGdvRGF0YSA9IGFiaUNhbGw8dHlwZW9mIE5GRC5wcm90b3R5cGUucmVhZEZpZWxkPih7CiAgICAvLyAgIGFwcElkOiBORkRBcHAuaWQsCiAgICAvLyAgIGFyZ3M6IFtCeXRlcyhORkRNZXRhS2V5VmVyaWZpZWRBZGRyZXNzZXMpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo5MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGNhQWxnb0RhdGEubGVuZ3RoOyBpICs9IDMyKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CgpjYWNoZU1ldGFfd2hpbGVfdG9wQDE1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo5MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGNhQWxnb0RhdGEubGVuZ3RoOyBpICs9IDMyKSB7CiAgICBkaWcgMTMKICAgIGxlbgogICAgZHVwCiAgICBidXJ5IDcKICAgIGRpZyA5CiAgICA+CiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfd2hpbGVAMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OTMKICAgIC8vIGNvbnN0IGFkZHIgPSBjYUFsZ29EYXRhLnNsaWNlKGksIGkgKyAzMikKICAgIGRpZyA4CiAgICBkdXAKICAgIGRpZyA3CiAgICBkdXAKICAgIGNvdmVyIDMKICAgID49CiAgICBkaWcgMQogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzd2FwCiAgICBpbnRjXzMgLy8gMzIKICAgICsKICAgIGR1cAogICAgYnVyeSAxMgogICAgZHVwCiAgICBkaWcgMwogICAgPj0KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGRpZyAyCiAgICBzd2FwCiAgICBzZWxlY3QKICAgIGRpZyAxNQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk0CiAgICAvLyBpZiAoYWRkciAhPT0gR2xvYmFsLnplcm9BZGRyZXNzLmJ5dGVzICYmIGFkZHIgPT09IGFjY291bnQuYnl0ZXMpIHsKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgIT0KICAgIGJ6IGNhY2hlTWV0YV93aGlsZV90b3BAMTUKICAgIGRpZyAxNAogICAgZGlnIDUKICAgID09CiAgICBieiBjYWNoZU1ldGFfd2hpbGVfdG9wQDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk1CiAgICAvLyByZXR1cm4gdHJ1ZQogICAgaW50Y18xIC8vIDEKCmNhY2hlTWV0YV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmFkZHJlc3NWZXJpZmllZE9uTkZEQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMjUKICAgIC8vIGFzc2VydCh0aGlzLmFkZHJlc3NWZXJpZmllZE9uTkZEKGFkZHJlc3MsIG5mZEFwcCksIEVSUl9VU0VSX0RPRVNfTk9UX09XTl9ORkQpCiAgICBhc3NlcnQgLy8gVXNlciBkb2VzIG5vdCBvd24gdGhpcyBORkQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzI2CiAgICAvLyBjb25zdCBbdGltZUNoYW5nZWRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhuZmRBcHAsIEJ5dGVzKE5GREdsb2JhbFN0YXRlS2V5c1RpbWVDaGFuZ2VkKSkKICAgIGRpZyAxCiAgICBkdXAKICAgIGJ5dGVjIDcgLy8gImkudGltZUNoYW5nZWQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNwogICAgLy8gbmZkVGltZUNoYW5nZWQgPSBidG9pKHRpbWVDaGFuZ2VkQnl0ZXMpCiAgICBidG9pCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjQwCiAgICAvLyBsZXQgbmZkSW1wYWN0OiB1aW50NjQgPSA1MAogICAgcHVzaGludCA1MCAvLyA1MAogICAgYnVyeSA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0MgogICAgLy8gY29uc3QgW3BhcmVudEFwcElEQnl0ZXMsIHBhcmVudEFwcElEQnl0ZXNFeGlzdF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhORkRBcHAsIEJ5dGVzKE5GREdsb2JhbFN0YXRlS2V5c1BhcmVudEFwcElEKSkKICAgIHB1c2hieXRlcyAiaS5wYXJlbnRBcHBJRCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBzd2FwCiAgICBidXJ5IDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0NAogICAgLy8gaWYgKHBhcmVudEFwcElEQnl0ZXNFeGlzdCAmJiBidG9pKHBhcmVudEFwcElEQnl0ZXMpID09PSBnZXRPdGhlckFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuYWtpdGFOZmQpIHsKICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI1CiAgICBkaWcgMTEKICAgIGJ0b2kKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjQ0CiAgICAvLyBpZiAocGFyZW50QXBwSURCeXRlc0V4aXN0ICYmIGJ0b2kocGFyZW50QXBwSURCeXRlcykgPT09IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ha2l0YU5mZCkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTUKICAgIC8vIGNvbnN0IFtvdGhlckFwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3QpKQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNDQKICAgIC8vIGlmIChwYXJlbnRBcHBJREJ5dGVzRXhpc3QgJiYgYnRvaShwYXJlbnRBcHBJREJ5dGVzKSA9PT0gZ2V0T3RoZXJBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmFraXRhTmZkKSB7CiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgPT0KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0NQogICAgLy8gbmZkSW1wYWN0ICs9IDUwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGJ1cnkgOAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMjU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0OAogICAgLy8gY29uc3QgdmVyc2lvbiA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKE5GREFwcCwgQnl0ZXMoTkZER2xvYmFsU3RhdGVLZXlzVmVyc2lvbikpWzBdLnNsaWNlKDAsIDIpCiAgICBkaWcgMQogICAgcHVzaGJ5dGVzICJpLnZlciIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDEKICAgID49CiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBwdXNoaW50IDIgLy8gMgogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDIgLy8gMgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNTAKICAgIC8vIGlmICh2ZXJzaW9uICE9PSBCeXRlcygnMy4nKSkgewogICAgcHVzaGJ5dGVzICIzLiIKICAgICE9CiAgICBibnogY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMzAKICAgIC8vIGNvbnN0IGRvbWFpbiA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkRG9tYWluKQogICAgZGlnIDEKICAgIGR1cAogICAgcHVzaGJ5dGVzICJ2LmRvbWFpbiIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMQogICAgLy8gY29uc3QgdHdpdHRlciA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkVHdpdHRlcikKICAgIGRpZyAxCiAgICBwdXNoYnl0ZXMgInYudHdpdHRlciIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMgogICAgLy8gY29uc3QgZGlzY29yZCA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkRGlzY29yZCkKICAgIGRpZyAyCiAgICBwdXNoYnl0ZXMgInYuZGlzY29yZCIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMwogICAgLy8gY29uc3QgdGVsZWdyYW0gPSB0aGlzLm5mZFJlYWRGaWVsZChORkRBcHAsIE5GRE1ldGFLZXlWZXJpZmllZFRlbGVncmFtKQogICAgdW5jb3ZlciAzCiAgICBwdXNoYnl0ZXMgInYudGVsZWdyYW0iCiAgICBjYWxsc3ViIG5mZFJlYWRGaWVsZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMzUKICAgIC8vIHJldHVybiB7IGRvbWFpbiwgdHdpdHRlciwgZGlzY29yZCwgdGVsZWdyYW0gfQogICAgZGlnIDMKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgICsKICAgIGRpZyA0CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBwdXNoYnl0ZXMgMHgwMDA4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgKwogICAgZGlnIDUKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICBkaWcgMQogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgICsKICAgIGRpZyA1CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDYKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjU0CiAgICAvLyBjb25zdCB7IGRvbWFpbiwgdHdpdHRlciwgZGlzY29yZCwgdGVsZWdyYW0gfSA9IHRoaXMuZ2V0TkZEU29jaWFsRmllbGRzKE5GREFwcCkKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgcHVzaGludCAyIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBwdXNoaW50IDQgLy8gNAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAzCiAgICB1bmNvdmVyIDMKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxMwogICAgZGlnIDIKICAgIHB1c2hpbnQgNiAvLyA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI1NwogICAgLy8gaWYgKGRvbWFpbi5sZW5ndGggPiAwKSB7CiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI1OAogICAgLy8gbmZkSW1wYWN0ICs9IDEwCiAgICBkaWcgNwogICAgcHVzaGludCAxMCAvLyAxMAogICAgKwogICAgYnVyeSA4CgpjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAyOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjYyCiAgICAvLyBpZiAodHdpdHRlci5sZW5ndGggPiAwKSB7CiAgICBkaWcgOQogICAgbGVuCiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNjMKICAgIC8vIG5mZEltcGFjdCArPSAyMAogICAgZGlnIDcKICAgIHB1c2hpbnQgMjAgLy8gMjAKICAgICsKICAgIGJ1cnkgOAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI2NwogICAgLy8gaWYgKGRpc2NvcmQubGVuZ3RoID4gMCkgewogICAgZGlnIDEyCiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDMzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI2OAogICAgLy8gbmZkSW1wYWN0ICs9IDEwCiAgICBkaWcgNwogICAgcHVzaGludCAxMCAvLyAxMAogICAgKwogICAgYnVyeSA4CgpjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAodGVsZWdyYW0ubGVuZ3RoID4gMCkgewogICAgZGlnIDEwCiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjczCiAgICAvLyBuZmRJbXBhY3QgKz0gMTAKICAgIGRpZyA3CiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCiAgICBidXJ5IDgKCmNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMzMQogICAgLy8gaWYgKGFraXRhQXNzZXRJRCAhPT0gMCkgewogICAgZHVwCiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEwMwogICAgLy8gcmV0dXJuIGFraXRhTkZULmNyZWF0b3IgPT09IEFjY291bnQoQWtpdGFORlRDcmVhdG9yQWRkcmVzcykKICAgIGR1cG4gMgogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGJ5dGVjIDggLy8gYWRkciBBS0NUUkRLNE9XTldIVFBINFhQS0xOV05MWjMzM1ZFMzVTS1E0RkdRSzNaSkE0RklIQ0xUUkczUEZJCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMzMKICAgIC8vIGFzc2VydCh0aGlzLmlzQWtpdGFORlQoYWtpdGFORlQpLCBFUlJfTk9UX0FOX0FLSVRBX05GVCkKICAgIGFzc2VydCAvLyBOb3QgYW4gYWtpdGEgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM5CiAgICAvLyByZXR1cm4gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShhY2NvdW50LCBORlQpWzBdID4gMAogICAgZGlnIDUKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMzNAogICAgLy8gYXNzZXJ0KHRoaXMudXNlckhvbGRzKGFkZHJlc3MsIGFraXRhTkZUKSwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GVCkKICAgIGFzc2VydCAvLyBVc2VyIGRvZXMgbm90IG93biB0aGlzIE5GVAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzM3LTM0MwogICAgLy8gdGhpcy5tZXRhKGFkZHJlc3MpLnZhbHVlID0gewogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleCwKICAgIC8vICAgTkZEOiBORkRBcHBJRCwKICAgIC8vICAgbmZkVGltZUNoYW5nZWQsCiAgICAvLyAgIG5mZEltcGFjdCwKICAgIC8vICAgYWtpdGFORlQ6IGFraXRhQXNzZXRJRCwKICAgIC8vIH0KICAgIGRpZyAzCiAgICBpdG9iCiAgICBkaWcgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBkaWcgNwogICAgaXRvYgogICAgY29uY2F0CiAgICBkaWcgOAogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNQogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBJbXBhY3RNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBJbXBhY3RCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlY18yIC8vICJtIgogICAgZGlnIDcKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMzctMzQzCiAgICAvLyB0aGlzLm1ldGEoYWRkcmVzcykudmFsdWUgPSB7CiAgICAvLyAgIHN1YnNjcmlwdGlvbkluZGV4LAogICAgLy8gICBORkQ6IE5GREFwcElELAogICAgLy8gICBuZmRUaW1lQ2hhbmdlZCwKICAgIC8vICAgbmZkSW1wYWN0LAogICAgLy8gICBha2l0YU5GVDogYWtpdGFBc3NldElELAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTMKICAgIC8vIGNhY2hlTWV0YShhZGRyZXNzOiBBY2NvdW50LCBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LCBORkRBcHBJRDogdWludDY0LCBha2l0YUFzc2V0SUQ6IHVpbnQ2NCk6IHVpbnQ2NCB7CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpjYWNoZU1ldGFfYWZ0ZXJfd2hpbGVAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk5CiAgICAvLyByZXR1cm4gZmFsc2UKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNQogICAgLy8gYXNzZXJ0KHRoaXMuYWRkcmVzc1ZlcmlmaWVkT25ORkQoYWRkcmVzcywgbmZkQXBwKSwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GRCkKICAgIGIgY2FjaGVNZXRhX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuYWRkcmVzc1ZlcmlmaWVkT25ORkRAMjEKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC51cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ4CiAgICAvLyB1cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwgbmV3TW9kaWZpZXI6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDkgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTEKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25JbmRleCkudmFsdWUgPSBuZXdNb2RpZmllcgogICAgc3dhcAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNwogICAgLy8gc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciA9IEJveE1hcDx1aW50NjQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEltcGFjdEJveFByZWZpeFN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIgfSkKICAgIHB1c2hieXRlcyAicyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTEKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25JbmRleCkudmFsdWUgPSBuZXdNb2RpZmllcgogICAgc3dhcAogICAgaXRvYgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTMtMzYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKCkuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkdXAKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM1NgogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzUzLTM2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icigpLnN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHN3YXAKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTcKICAgIC8vIGFtb3VudDogdGhpcy5tYnIoKS5zdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyCiAgICBwdXNoaW50IDkzMDAgLy8gOTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTMtMzYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKCkuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ4CiAgICAvLyB1cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwgbmV3TW9kaWZpZXI6IHVpbnQ2NCk6IHZvaWQgewogICAgcmV0dXJuIC8vIG9uIGVycm9yOiBJbnZhbGlkIHBheW1lbnQKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFVzZXJJbXBhY3RXaXRob3V0U29jaWFsOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM2OAogICAgLy8gcmV0dXJuIHRoaXMudXNlckltcGFjdChhZGRyZXNzLCBmYWxzZSkKICAgIGludGNfMCAvLyAwCiAgICBjYWxsc3ViIHVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzY2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGl0b2IKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRVc2VySW1wYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0VXNlckltcGFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzcxCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNzMKICAgIC8vIHJldHVybiB0aGlzLnVzZXJJbXBhY3QoYWRkcmVzcywgdHJ1ZSkKICAgIGludGNfMSAvLyAxCiAgICBjYWxsc3ViIHVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzcxCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGl0b2IKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mzc2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNQogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBJbXBhY3RNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBJbXBhY3RCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlY18yIC8vICJtIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM3OAogICAgLy8gcmV0dXJuIHRoaXMubWV0YSh1c2VyKS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mzc2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo6QWtpdGFCYXNlQ29udHJhY3QudXBkYXRlQWtpdGFEQU9bcm91dGluZ10oKSAtPiB2b2lkOgp1cGRhdGVBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjMyCiAgICAvLyBjb25zdCBbd2FsbGV0SURdID0gb3AuQXBwR2xvYmFsLmdldEV4VWludDY0KHRoaXMuYWtpdGFEQU8udmFsdWUsIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0KSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjMyCiAgICAvLyBjb25zdCBbd2FsbGV0SURdID0gb3AuQXBwR2xvYmFsLmdldEV4VWludDY0KHRoaXMuYWtpdGFEQU8udmFsdWUsIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyA5IC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5pc1N1YnNjcmliZWQoYWRkcmVzczogYnl0ZXMsIGlkOiB1aW50NjQpIC0+IGJ5dGVzOgppc1N1YnNjcmliZWQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQyCiAgICAvLyBwcml2YXRlIGlzU3Vic2NyaWJlZChhZGRyZXNzOiBBY2NvdW50LCBpZDogdWludDY0KTogeyBhY3RpdmU6IGJvb2xlYW47IHNlcnZpY2VJRDogdWludDY0OyBzdHJlYWs6IHVpbnQ2NCB9IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0My00NgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN1YnNjcmlwdGlvbnMucHJvdG90eXBlLmdldFN1YnNjcmlwdGlvbj4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGFyZ3M6IFt7IGFkZHJlc3MsIGlkIH1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdWJzY3JpcHRpb25zLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdWJzY3JpcHRpb25zLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDEwIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ1CiAgICAvLyBhcmdzOiBbeyBhZGRyZXNzLCBpZCB9XQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0My00NgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN1YnNjcmlwdGlvbnMucHJvdG90eXBlLmdldFN1YnNjcmlwdGlvbj4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGFyZ3M6IFt7IGFkZHJlc3MsIGlkIH1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4MjViODEyYTcgLy8gbWV0aG9kICJnZXRTdWJzY3JpcHRpb24oKGFkZHJlc3MsdWludDY0KSkoYm9vbCxhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXBuIDIKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDEwNSAvLyAxMDUKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChib29sMSx1aW50OFszMl0sdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ4CiAgICAvLyBpZiAoIWluZm8uZXhpc3RzKSB7CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBibnogaXNTdWJzY3JpYmVkX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0OS01MwogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgYWN0aXZlOiBmYWxzZSwKICAgIC8vICAgc2VydmljZUlEOiAwLAogICAgLy8gICBzdHJlYWs6IDAsCiAgICAvLyB9CiAgICBwdXNoYnl0ZXMgMHgwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBzd2FwCiAgICByZXRzdWIKCmlzU3Vic2NyaWJlZF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB0b0FraXRhID0gaW5mby5yZWNpcGllbnQgPT09IHRoaXMuYWtpdGFEQU8udmFsdWUuYWRkcmVzcwogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgZXh0cmFjdCA1IDMyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB0b0FraXRhID0gaW5mby5yZWNpcGllbnQgPT09IHRoaXMuYWtpdGFEQU8udmFsdWUuYWRkcmVzcwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjYxCiAgICAvLyBjb25zdCBub3REb25hdGluZyA9IGluZm8uc2VydmljZUlEICE9PSAwCiAgICBkaWcgMQogICAgcHVzaGludCAzNyAvLyAzNwogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjMKICAgIC8vIGNvbnN0IHByZXZpb3VzV2luZG93U3RhcnQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSAoKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gaW5mby5zdGFydERhdGUpICUgaW5mby5pbnRlcnZhbCkgKyBpbmZvLmludGVydmFsKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZHVwCiAgICBkaWcgNAogICAgcHVzaGludCA0NSAvLyA0NQogICAgZXh0cmFjdF91aW50NjQKICAgIC0KICAgIGRpZyA0CiAgICBwdXNoaW50IDYxIC8vIDYxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgZGlnIDEKICAgICUKICAgICsKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjcKICAgIC8vIGNvbnN0IG5vdFN0YWxlID0gaW5mby5sYXN0UGF5bWVudCA+IHByZXZpb3VzV2luZG93U3RhcnQKICAgIGRpZyAzCiAgICBwdXNoaW50IDg1IC8vIDg1CiAgICBleHRyYWN0X3VpbnQ2NAogICAgPAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3MAogICAgLy8gYWN0aXZlOiB0b0FraXRhICYmIG5vdERvbmF0aW5nICYmIG5vdFN0YWxlLAogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgJiYKICAgICYmCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjcyCiAgICAvLyBzdHJlYWs6IGluZm8uc3RyZWFrLAogICAgdW5jb3ZlciAyCiAgICBleHRyYWN0IDkzIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjktNzMKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGFjdGl2ZTogdG9Ba2l0YSAmJiBub3REb25hdGluZyAmJiBub3RTdGFsZSwKICAgIC8vICAgc2VydmljZUlEOiBpbmZvLnNlcnZpY2VJRCwKICAgIC8vICAgc3RyZWFrOiBpbmZvLnN0cmVhaywKICAgIC8vIH0KICAgIHB1c2hieXRlcyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzCiAgICBzZXRiaXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LnVzZXJJbXBhY3QoYWNjb3VudDogYnl0ZXMsIGluY2x1ZGVTb2NpYWw6IHVpbnQ2NCkgLT4gdWludDY0Ogp1c2VySW1wYWN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMDYKICAgIC8vIHByaXZhdGUgdXNlckltcGFjdChhY2NvdW50OiBBY2NvdW50LCBpbmNsdWRlU29jaWFsOiBib29sZWFuKTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMDgKICAgIC8vIGxldCBzdWJzY3JpcHRpb25JbmRleDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTA5LTExMAogICAgLy8gbGV0IE5GRDogdWludDY0ID0gMAogICAgLy8gbGV0IGFraXRhTkZUOiB1aW50NjQgPSAwCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjUKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgSW1wYWN0TWV0YVZhbHVlPih7IGtleVByZWZpeDogSW1wYWN0Qm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWNfMiAvLyAibSIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTEyCiAgICAvLyBpZiAodGhpcy5tZXRhKGFjY291bnQpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTMKICAgIC8vIGNvbnN0IG1ldGEgPSBjbG9uZSh0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUpCiAgICBmcmFtZV9kaWcgMTYKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjExNAogICAgLy8gc3Vic2NyaXB0aW9uSW5kZXggPSBtZXRhLnN1YnNjcmlwdGlvbkluZGV4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTUKICAgIC8vIE5GRCA9IG1ldGEuTkZECiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTYKICAgIC8vIGFraXRhTkZUID0gbWV0YS5ha2l0YU5GVAogICAgaW50Y18zIC8vIDMyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxNQoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzNgogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTM2CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMzYKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzOQogICAgLy8gaWYgKGFrdGEgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQwCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOAoKdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldFN0YWtpbmdJbXBhY3RTY29yZUA0NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTkzCiAgICAvLyBsZXQgc3Vic2NyaWJlckltcGFjdDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxOTUKICAgIC8vIGNvbnN0IHN1YnNjcmlwdGlvblN0YXRlID0gdGhpcy5pc1N1YnNjcmliZWQoYWNjb3VudCwgc3Vic2NyaXB0aW9uSW5kZXgpCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAxMwogICAgY2FsbHN1YiBpc1N1YnNjcmliZWQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE5NwogICAgLy8gaWYgKCFzdWJzY3JpcHRpb25TdGF0ZS5hY3RpdmUpIHsKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIGJ6IHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdWJzY3JpYmVySW1wYWN0U2NvcmVANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjAxCiAgICAvLyBjb25zdCBtb2RpZmllciA9IHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25TdGF0ZS5zZXJ2aWNlSUQpLnZhbHVlCiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBleHRyYWN0IDEgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNwogICAgLy8gc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciA9IEJveE1hcDx1aW50NjQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEltcGFjdEJveFByZWZpeFN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIgfSkKICAgIHB1c2hieXRlcyAicyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMDEKICAgIC8vIGNvbnN0IG1vZGlmaWVyID0gdGhpcy5zdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHN1YnNjcmlwdGlvblN0YXRlLnNlcnZpY2VJRCkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwNAogICAgLy8gaWYgKHN1YnNjcmlwdGlvblN0YXRlLnN0cmVhayA+PSAxMikgewogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTEKICAgIHB1c2hpbnQgMTIgLy8gMTIKICAgID49CiAgICBieiB1c2VySW1wYWN0X2Vsc2VfYm9keUA0OQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMDUKICAgIC8vIHN1YnNjcmliZXJJbXBhY3QgKz0gMjUwIC8gbW9kaWZpZXIKICAgIHB1c2hpbnQgMjUwIC8vIDI1MAogICAgc3dhcAogICAgLwogICAgZnJhbWVfYnVyeSA5Cgp1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0U3Vic2NyaWJlckltcGFjdFNjb3JlQDUxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjEKICAgIC8vIGNvbnN0IHNvY2lhbEltcGFjdDogdWludDY0ID0gaW5jbHVkZVNvY2lhbCA/IHRoaXMuZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudCkgOiAwIC8vIFNvY2lhbCBBY3Rpdml0eSB8IHVwIHRvIDI1MAogICAgZnJhbWVfZGlnIC0xCiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMTQtMjE3CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWwucHJvdG90eXBlLmdldFVzZXJTb2NpYWxJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwsCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA0IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIxNQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwsCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE0LTIxNwogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsLnByb3RvdHlwZS5nZXRVc2VyU29jaWFsSW1wYWN0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc29jaWFsLAogICAgLy8gICBhcmdzOiBbYWRkcmVzc10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhlODY5OTM0ZCAvLyBtZXRob2QgImdldFVzZXJTb2NpYWxJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDcKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjIKICAgIC8vIGNvbnN0IG5mZFNjb3JlOiB1aW50NjQgPSBORkQgIT09IDAgPyB0aGlzLmdldE5GREltcGFjdFNjb3JlKGFjY291bnQsIEFwcGxpY2F0aW9uKE5GRCkpIDogMCAvLyBORkQgfCB1cCB0byAxNTAKICAgIGZyYW1lX2RpZyAxNAogICAgYnogdXNlckltcGFjdF90ZXJuYXJ5X2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjgwCiAgICAvLyBjb25zdCB7IE5GRCwgbmZkVGltZUNoYW5nZWQsIG5mZEltcGFjdCB9ID0gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlCiAgICBmcmFtZV9kaWcgMTYKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgdGltZUNoYW5nZWQgPSBidG9pKG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKE5GRCwgQnl0ZXMoTkZER2xvYmFsU3RhdGVLZXlzVGltZUNoYW5nZWQpKVswXSkKICAgIGRpZyAyCiAgICBieXRlYyA3IC8vICJpLnRpbWVDaGFuZ2VkIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyODMKICAgIC8vIGFzc2VydChORkRBcHAuaWQgPT09IEFwcGxpY2F0aW9uKE5GRCkuaWQsIEVSUl9JTlZBTElEX05GRCkKICAgIGZyYW1lX2RpZyAxNAogICAgdW5jb3ZlciA0CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgTkZECiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI4NAogICAgLy8gYXNzZXJ0KG5mZFRpbWVDaGFuZ2VkID09PSB0aW1lQ2hhbmdlZCwgRVJSX05GRF9DSEFOR0VEKQogICAgdW5jb3ZlciAyCiAgICA9PQogICAgYXNzZXJ0IC8vIE5GRCBjaGFuZ2VkIHNpbmNlIGltcGFjdCBsYXN0IGNhbGN1bGF0ZWQKICAgIGZyYW1lX2J1cnkgNgoKdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3NAogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTc0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNzQKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3NwogICAgLy8gaWYgKGFrdGEgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTc4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNQoKdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldEhlbGRBa3RhSW1wYWN0U2NvcmVAMjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEyNAogICAgLy8gY29uc3QgbmZ0SW1wYWN0OiB1aW50NjQgPSBha2l0YU5GVCAhPT0gMCA/IHRoaXMuZ2V0TkZUSW1wYWN0U2NvcmUoYWNjb3VudCwgQXNzZXQoYWtpdGFORlQpKSA6IDAgLy8gSG9sZHMgQUtDL09tbmlnZW0gfCA1MAogICAgZnJhbWVfZGlnIDE1CiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VAMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkwCiAgICAvLyBjb25zdCBwcmVmaXggPSBhc3NldC51bml0TmFtZS5zbGljZSgwLCAzKQogICAgZnJhbWVfZGlnIDE1CiAgICBkdXAKICAgIGFzc2V0X3BhcmFtc19nZXQgQXNzZXRVbml0TmFtZQogICAgYXNzZXJ0IC8vIGFzc2V0IGV4aXN0cwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMQogICAgPj0KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHB1c2hpbnQgMyAvLyAzCiAgICBkaWcgMgogICAgPj0KICAgIHB1c2hpbnQgMyAvLyAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkxCiAgICAvLyBjb25zdCBiYWxhbmNlID0gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShhY2NvdW50LCBhc3NldClbMF0KICAgIGZyYW1lX2RpZyAtMgogICAgZGlnIDEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkyCiAgICAvLyBpZiAoYXNzZXQuY3JlYXRvciA9PT0gQWNjb3VudChBa2l0YU5GVENyZWF0b3JBZGRyZXNzKSAmJiBiYWxhbmNlID4gMCkgewogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGJ5dGVjIDggLy8gYWRkciBBS0NUUkRLNE9XTldIVFBINFhQS0xOV05MWjMzM1ZFMzVTS1E0RkdRSzNaSkE0RklIQ0xUUkczUEZJCiAgICA9PQogICAgYnogdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDIxCiAgICBmcmFtZV9kaWcgMwogICAgYnogdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI5MwogICAgLy8gaWYgKHByZWZpeCA9PT0gQnl0ZXMoQWtpdGFDb2xsZWN0aW9uc1ByZWZpeEFLQykpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoYnl0ZXMgIkFLQyIKICAgID09CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mjk0CiAgICAvLyByZXR1cm4gNTAKICAgIHB1c2hpbnQgNTAgLy8gNTAKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI2CiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gc3Rha2VkQWt0YUltcGFjdCArIHN1YnNjcmliZXJJbXBhY3QgKyBzb2NpYWxJbXBhY3QgKyBuZmRTY29yZSArIGhlbGRBa2l0YUltcGFjdCArIG5mdEltcGFjdAogICAgZnJhbWVfZGlnIDgKICAgIGZyYW1lX2RpZyA5CiAgICArCiAgICBmcmFtZV9kaWcgNwogICAgKwogICAgZnJhbWVfZGlnIDYKICAgICsKICAgIGZyYW1lX2RpZyA1CiAgICArCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI3CiAgICAvLyBpZiAodG90YWwgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI4CiAgICAvLyByZXR1cm4gMQogICAgaW50Y18xIC8vIDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzMQogICAgLy8gcmV0dXJuIHRvdGFsCiAgICBmcmFtZV9kaWcgMTAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI5NgogICAgLy8gaWYgKHByZWZpeCA9PT0gQnl0ZXMoQWtpdGFDb2xsZWN0aW9uc1ByZWZpeEFPRykpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoYnl0ZXMgIkFPRyIKICAgID09CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mjk3CiAgICAvLyByZXR1cm4gMjUKICAgIHB1c2hpbnQgMjUgLy8gMjUKICAgIGIgdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDExCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMwMAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCiAgICBiIHVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMQoKdXNlckltcGFjdF90ZXJuYXJ5X2ZhbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjQKICAgIC8vIGNvbnN0IG5mdEltcGFjdDogdWludDY0ID0gYWtpdGFORlQgIT09IDAgPyB0aGlzLmdldE5GVEltcGFjdFNjb3JlKGFjY291bnQsIEFzc2V0KGFraXRhTkZUKSkgOiAwIC8vIEhvbGRzIEFLQy9PbW5pZ2VtIHwgNTAKICAgIGludGNfMCAvLyAwCiAgICBiIHVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMQoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODEKICAgIC8vIGNvbnN0IGJhbGFuY2UgPSBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKGFjY291bnQsIGFrdGEpWzBdCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAyCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTg0CiAgICAvLyBpZiAoYmFsYW5jZSA8IFRFTl9USE9VU0FORF9BS0lUQSkgewogICAgaW50YyA3IC8vIDEwMDAwMDAwMDAwCiAgICA8CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTg1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjMKICAgIC8vIGNvbnN0IGhlbGRBa2l0YUltcGFjdCA9IHRoaXMuZ2V0SGVsZEFrdGFJbXBhY3RTY29yZShhY2NvdW50KSAvLyBIZWxkIEFLVEEgfCB1cCB0byA1MAogICAgYiB1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0SGVsZEFrdGFJbXBhY3RTY29yZUAyOAoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODgKICAgIC8vIGNvbnN0IGNhcHBlZCA9IGJhbGFuY2UgPj0gT05FX01JTExJT05fQUtJVEEgPyBPTkVfTUlMTElPTl9BS0lUQSA6IGJhbGFuY2UKICAgIGZyYW1lX2RpZyAzCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAwMDAwMDAwCiAgICA+PQogICAgaW50YyA0IC8vIDEwMDAwMDAwMDAwMDAKICAgIHN3YXAKICAgIHNlbGVjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODkKICAgIC8vIHJldHVybiAoY2FwcGVkICogNTApIC8gT05FX01JTExJT05fQUtJVEEKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgICoKICAgIGludGMgNCAvLyAxMDAwMDAwMDAwMDAwCiAgICAvCiAgICBmcmFtZV9idXJ5IDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTIzCiAgICAvLyBjb25zdCBoZWxkQWtpdGFJbXBhY3QgPSB0aGlzLmdldEhlbGRBa3RhSW1wYWN0U2NvcmUoYWNjb3VudCkgLy8gSGVsZCBBS1RBIHwgdXAgdG8gNTAKICAgIGIgdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldEhlbGRBa3RhSW1wYWN0U2NvcmVAMjgKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjIKICAgIC8vIGNvbnN0IG5mZFNjb3JlOiB1aW50NjQgPSBORkQgIT09IDAgPyB0aGlzLmdldE5GREltcGFjdFNjb3JlKGFjY291bnQsIEFwcGxpY2F0aW9uKE5GRCkpIDogMCAvLyBORkQgfCB1cCB0byAxNTAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDYKICAgIGIgdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDgKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjEKICAgIC8vIGNvbnN0IHNvY2lhbEltcGFjdDogdWludDY0ID0gaW5jbHVkZVNvY2lhbCA/IHRoaXMuZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudCkgOiAwIC8vIFNvY2lhbCBBY3Rpdml0eSB8IHVwIHRvIDI1MAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNwogICAgYiB1c2VySW1wYWN0X3Rlcm5hcnlfbWVyZ2VANQoKdXNlckltcGFjdF9lbHNlX2JvZHlANDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwNwogICAgLy8gc3Vic2NyaWJlckltcGFjdCArPSAoc3Vic2NyaXB0aW9uU3RhdGUuc3RyZWFrICogMjApIC8gbW9kaWZpZXIKICAgIGZyYW1lX2RpZyAxMQogICAgcHVzaGludCAyMCAvLyAyMAogICAgKgogICAgc3dhcAogICAgLwogICAgZnJhbWVfYnVyeSA5CiAgICBiIHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdWJzY3JpYmVySW1wYWN0U2NvcmVANTEKCnVzZXJJbXBhY3RfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQzLTE1MgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWNjb3VudCwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogYWt0YSwKICAgIC8vICAgICAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gICAgIH0KICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQ0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDEwIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0Ny0xNTAKICAgIC8vIHsKICAgIC8vICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gfQogICAgZnJhbWVfZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQ5CiAgICAvLyB0eXBlOiBTVEFLSU5HX1RZUEVfU09GVCwKICAgIHB1c2hieXRlcyAweDE0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0Ny0xNTAKICAgIC8vIHsKICAgIC8vICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gfQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0My0xNTIKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjY291bnQsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgICAgICB0eXBlOiBTVEFLSU5HX1RZUEVfU09GVCwKICAgIC8vICAgICB9CiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhjOTA2ODgwOSAvLyBtZXRob2QgImdldEluZm8oYWRkcmVzcywodWludDY0LHVpbnQ4KSkodWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNTQKICAgIC8vIGNvbnN0IGVsYXBzZWQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSBpbmZvLmxhc3RVcGRhdGUKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyAxCiAgICBwdXNoaW50IDEyIC8vIDEyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLQogICAgZnJhbWVfYnVyeSA0CiAgICBwdXNoaW50IDQgLy8gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNTcKICAgIC8vIGlmIChpbmZvLmFtb3VudCA8IFRFTl9USE9VU0FORF9BS0lUQSB8fCBlbGFwc2VkIDwgVEhJUlRZX0RBWVMpIHsKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTIKICAgIGludGMgNyAvLyAxMDAwMDAwMDAwMAogICAgPAogICAgYm56IHVzZXJJbXBhY3RfaWZfYm9keUAzOQogICAgZnJhbWVfZGlnIDQKICAgIHB1c2hpbnQgMjU5MjAwMCAvLyAyNTkyMDAwCiAgICA8CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VANDAKCnVzZXJJbXBhY3RfaWZfYm9keUAzOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTU4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTkKICAgIC8vIGNvbnN0IHN0YWtlZEFrdGFJbXBhY3QgPSB0aGlzLmdldFN0YWtpbmdJbXBhY3RTY29yZShhY2NvdW50KSAvLyBTdGFrZWQgQUtUQSB8IHVwIHRvIDI1MAogICAgYiB1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0U3Rha2luZ0ltcGFjdFNjb3JlQDQ0Cgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VANDA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE2MgogICAgLy8gY29uc3QgYW10Q2FwcGVkID0gaW5mby5hbW91bnQgPj0gVFdPX0hVTkRSRURfVEhPVVNBTkRfQUtJVEEgPyBUV09fSFVORFJFRF9USE9VU0FORF9BS0lUQSA6IGluZm8uYW1vdW50CiAgICBmcmFtZV9kaWcgMTIKICAgIGludGMgNSAvLyAyMDAwMDAwMDAwMDAKICAgID49CiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VANDIKICAgIGludGMgNSAvLyAyMDAwMDAwMDAwMDAKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUA0MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTY1CiAgICAvLyBjb25zdCBtYXhTY29yZTogdWludDY0ID0gKGFtdENhcHBlZCAqIDI1MCkgLyBUV09fSFVORFJFRF9USE9VU0FORF9BS0lUQQogICAgcHVzaGludCAyNTAgLy8gMjUwCiAgICAqCiAgICBpbnRjIDUgLy8gMjAwMDAwMDAwMDAwCiAgICAvCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE2OAogICAgLy8gY29uc3QgdGltZUNhcHBlZCA9IGVsYXBzZWQgPj0gT05FX1lFQVIgPyBPTkVfWUVBUiA6IGVsYXBzZWQKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgPj0KICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgc3dhcAogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3MAogICAgLy8gcmV0dXJuICh0aW1lQ2FwcGVkICogbWF4U2NvcmUpIC8gT05FX1lFQVIKICAgICoKICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgLwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjExOQogICAgLy8gY29uc3Qgc3Rha2VkQWt0YUltcGFjdCA9IHRoaXMuZ2V0U3Rha2luZ0ltcGFjdFNjb3JlKGFjY291bnQpIC8vIFN0YWtlZCBBS1RBIHwgdXAgdG8gMjUwCiAgICBiIHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdGFraW5nSW1wYWN0U2NvcmVANDQKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA0MjoKICAgIGZyYW1lX2RpZyAxMgogICAgYiB1c2VySW1wYWN0X3Rlcm5hcnlfbWVyZ2VANDMKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5uZmRSZWFkRmllbGQoTkZEQXBwOiB1aW50NjQsIGZpZWxkOiBieXRlcykgLT4gYnl0ZXM6Cm5mZFJlYWRGaWVsZDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjIwCiAgICAvLyBwcml2YXRlIG5mZFJlYWRGaWVsZChORkRBcHA6IEFwcGxpY2F0aW9uLCBmaWVsZDogc3RyaW5nKTogYnl0ZXMgewogICAgcHJvdG8gMiAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIyMS0yMjQKICAgIC8vIGNvbnN0IGZpZWxkQnl0ZXMgPSBhYmlDYWxsPHR5cGVvZiBORkQucHJvdG90eXBlLnJlYWRGaWVsZD4oewogICAgLy8gICBhcHBJZDogTkZEQXBwLmlkLAogICAgLy8gICBhcmdzOiBbQnl0ZXMoZmllbGQpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjIzCiAgICAvLyBhcmdzOiBbQnl0ZXMoZmllbGQpXQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMjEtMjI0CiAgICAvLyBjb25zdCBmaWVsZEJ5dGVzID0gYWJpQ2FsbDx0eXBlb2YgTkZELnByb3RvdHlwZS5yZWFkRmllbGQ+KHsKICAgIC8vICAgYXBwSWQ6IE5GREFwcC5pZCwKICAgIC8vICAgYXJnczogW0J5dGVzKGZpZWxkKV0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBieXRlYyA2IC8vIG1ldGhvZCAicmVhZEZpZWxkKGJ5dGVbXSlieXRlW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCA2IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjI2CiAgICAvLyByZXR1cm4gZmllbGRCeXRlcwogICAgcmV0c3ViCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAIAAEIIICglKWNHYCgt4fpBYDnhA+AyK+gJSYMCWFraXRhX2RhbwQVH3x1AW0AA3NhbANvYWwEbBPt5A1pLnRpbWVDaGFuZ2VkIAKFOI1cdZtjzefl3qW2zV53vdSb7JUOFNBW8pBwqDiXBndhbGxldANhYWwMYWtpdGFfYXNzZXRzMRkURDEYQQA7ggcEJ+O7TwSddPWhBPgce2IE1XS7EAS27GpdBDPpLJQEhU3t4DYaAI4HADsDJgNqA30DkAOjAAEAI0OABG+YF/Y2GgCOAQABADYaAUkVJBJEFzYaAkkiWYECCEsBFRJEVwIAgAd2ZXJzaW9uTGcoTGcjQyJHBStHAzYaAUkVJRJENhoCSRUkEkQXSTYaA0lOAkkVJBJEF0w2GgRJFSQSRBdMMQAiKGVEJwRlSCJbcghEEkRBAApLBEsEiAM7IlNEIkUHIkUISwFBAllLAUmABmkubmFtZWVIsSIoZUQnBWVIJFtLARUWVwYCTwJQgARL4i/GshqyGksEshqyGIEGshAisgGztD5JVwQATFcABCkSREkVIxJEIlNEsScGshqADwANdi5jYUFsZ28uMC5hc7IashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJESSJZgQIITBUSRFcGAEUOIkUJSw0VSUUHSwkNQQHySwhJSwdJTgMPSwFLA08CTUwlCElFDElLAw9MTwNPAk1JSwIMSwJMTUsPTgJSSUUQMgMTQf++Sw5LBRJB/7YjREsBSScHZUgXRQiBMkUJgA1pLnBhcmVudEFwcElEZUxFDUEAFksLFyIoZUQnBWVIgShbEkEABIFkRQhLAYAFaS52ZXJlSEkVIksBDyJLAk8CTYECSwIPgQJPA08CTVKAAjMuE0ABFksBSYAIdi5kb21haW6IBK5LAYAJdi50d2l0dGVyiASeSwKACXYuZGlzY29yZIgEjk8DgAp2LnRlbGVncmFtiAR9SwMVFlcGAk8EUEkVJAhLBBUWVwYCTwVQSwEWVwYCgAIACExQSwEVTwMISwUVFlcGAk8GUEsBFlcGAk8DTFBLARVPAwhLBRUWVwYCTwZQTBZXBgJPAkxQTwRQTwNQTwJQTFBJIllLAYECWUsCTwJLAlJXAgBLAoEEWUsDTwNLAlJXAgBFDUsCgQZZSwNPAksCUlcCAEUQSwIVTwNOAlJXAgBFDBVBAAdLB4EKCEUISwkVQQAHSweBFAhFCEsMFUEAB0sHgQoIRQhLChVBAAdLB4EKCEUISUEAEEcCcQtEJwgSREsFTHAASERLAxZLAhZQSwcWUEsIFkxLAVBLAhZQKksHUEy/KUxQsCNDIkL+STEWIwlJOBAjEkQ2GgFJFSQSRBc2GgJJFSQSRBcxACIoZUQnCWVIcghEEkRMFoABc0xQTBa/STgHMgoSTDgIgdRIEhBDNhoBSRUlEkQiiADoFilMULAjQzYaAUkVJRJEI4gA1RYpTFCwI0M2GgFJFSUSRCpMUL5EKUxQsCNDNhoBSRUkEkQXMQAiKGVEJwllSHIIRBJEKExnI0OKAgGxIihlRCcKZUglW4v/Fov+TFCABCW4EqeyGrIashiBBrIQIrIBs7Q+RwJXBABMVwAEKRJESRWBaRJEIlNAABWAEQAAAAAAAAAAAAAAAAAAAAAATImLAElXBSAiKGVEcghEEksBgSVbMgdJSwSBLVsJSwSBPVtMSwEYCAlLA4FVWwxPAksCEBBPAlddCIABACJPA1RPAhZQTFBMiYoCASJJK0cKIkcCKov+UEm9RQFBABKLEL5ESSJbjA1JJFuMDiVbjA8iKGVEJwtlSCJbSYwCQAFxIowIIowJi/6LDYj/HUmMASJTQQAiiwFJVwEIgAFzTFC+RBdMgQlbSYwLgQwPQQE1gfoBTAqMCYv/QQEjsSIoZUQnBGVIIluABOhpk02yGov+shqyGIEGshAisgGztD5JVwQATFcABCkSREkVJBJEF4wHiw5BAOKLEL5ESSRbSwGBEFtPAoEYW0sCJwdlSBeLDk8EEkRPAhJEjAYiKGVEJwtlSCJbSYwCQACDIowFiw9BAHeLD0lxA0RJFSJLAQ8iSwJPAk2BA0sCD4EDTwNPAk1SjACL/ksBcABIjANxC0QnCBJBAECLA0EAO4sAgANBS0MSQQAggTKLCIsJCIsHCIsGCIsFCAhJjApAAAQjjACJiwqMAImLAIADQU9HEkEABYEZQv/SIkL/ziJC/8qL/osCcABISYwDIQcMQQAGIowFQv9qiwNJIQQPIQRMTYEyCyEECowFQv9VIowGQv88IowHQv8NiwuBFAtMCowJQv7GsSIoZUQnCmVIIluLAhaAARRQgATJBogJshqL/rIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkQVgRgSRDIHSwGBDFsJjASBBFtJjAwhBwxAAAuLBIGAmp4BDEEABiKMCEL+LosMIQUPQQAcIQWB+gELIQUKiwRJIQYPIQZMTQshBgqMCEL+CosMQv/higIBsYv/FRZXBgKL/1AnBrIashqL/rIYgQayECKyAbO0PklXBABLAVcABCkSREkiWYECCEwVEkRXBgCJ\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[1121,1340,1404,1493],\"errorMessage\":\"Box must have value\"},{\"pc\":[414,470,1211,1476,1795,1926],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[1519],\"errorMessage\":\"Invalid NFD\"},{\"pc\":[1070],\"errorMessage\":\"Invalid payment\"},{\"pc\":[1523],\"errorMessage\":\"NFD changed since impact last calculated\"},{\"pc\":[319],\"errorMessage\":\"Not a social plugin\"},{\"pc\":[422],\"errorMessage\":\"Not an NFD\"},{\"pc\":[957],\"errorMessage\":\"Not an akita NFT\"},{\"pc\":[332],\"errorMessage\":\"Not an akita subscription contract\"},{\"pc\":[135],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[1045,1151],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[564],\"errorMessage\":\"User does not own this NFD\"},{\"pc\":[964],\"errorMessage\":\"User does not own this NFT\"},{\"pc\":[317,1043,1149,1256],\"errorMessage\":\"application exists\"},{\"pc\":[953,1555,1594],\"errorMessage\":\"asset exists\"},{\"pc\":[308,361,607,1036,1142,1164,1253,1358,1435,1529,1744],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[1217],\"errorMessage\":\"invalid number of bytes for (bool1,uint8[32],uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64,uint64)\"},{\"pc\":[480,1936],\"errorMessage\":\"invalid number of bytes for (len+uint8[])\"},{\"pc\":[236],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[1800],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64)\"},{\"pc\":[419],\"errorMessage\":\"invalid number of bytes for bool8\"},{\"pc\":[221,277,290,300,1020,1029,1135,1481],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[269,1078,1097,1116],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[1012],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"AkitaSocialImpact\",\"structs\":{\"ImpactMetaValue\":[{\"name\":\"subscriptionIndex\",\"type\":\"uint64\"},{\"name\":\"NFD\",\"type\":\"uint64\"},{\"name\":\"nfdTimeChanged\",\"type\":\"uint64\"},{\"name\":\"nfdImpact\",\"type\":\"uint64\"},{\"name\":\"akitaNFT\",\"type\":\"uint64\"}]},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"string\",\"name\":\"version\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"cacheMeta\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"NFDAppID\"},{\"type\":\"uint64\",\"name\":\"akitaAssetID\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"updateSubscriptionStateModifier\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"uint64\",\"name\":\"subscriptionIndex\"},{\"type\":\"uint64\",\"name\":\"newModifier\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"getUserImpactWithoutSocial\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getUserImpact\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getMeta\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"ImpactMetaValue\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"meta\":{\"keyType\":\"address\",\"valueType\":\"ImpactMetaValue\",\"prefix\":\"bQ==\",\"desc\":\"A map of the meta data for each user\"},\"subscriptionStateModifier\":{\"keyType\":\"uint64\",\"valueType\":\"uint64\",\"prefix\":\"cw==\",\"desc\":\"A map of how each akita subscription affects impact calculation\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMzIgMTAwMDAwMDAwMDAwMCAyMDAwMDAwMDAwMDAgMzE1MzYwMDAgMTAwMDAwMDAwMDAKICAgIGJ5dGVjYmxvY2sgImFraXRhX2RhbyIgMHgxNTFmN2M3NSAibSIgIiIgInNhbCIgIm9hbCIgMHg2YzEzZWRlNCAiaS50aW1lQ2hhbmdlZCIgYmFzZTMyKEFLQ1RSREs0T1dOV0hUUEg0WFBLTE5XTkxaMzMzVkUzNVNLUTRGR1FLM1pKQTRGSUhDTFEpICJ3YWxsZXQiICJhYWwiICJha2l0YV9hc3NldHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwCiAgICAvLyBleHBvcnQgY2xhc3MgQWtpdGFTb2NpYWxJbXBhY3QgZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJ6IG1haW5fY3JlYXRlX05vT3BAMTEKICAgIHB1c2hieXRlc3MgMHgyN2UzYmI0ZiAweDlkNzRmNWExIDB4ZjgxYzdiNjIgMHhkNTc0YmIxMCAweGI2ZWM2YTVkIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgImNhY2hlTWV0YShhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0KXVpbnQ2NCIsIG1ldGhvZCAidXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihwYXksdWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0VXNlckltcGFjdChhZGRyZXNzKXVpbnQ2NCIsIG1ldGhvZCAiZ2V0TWV0YShhZGRyZXNzKSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU8odWludDY0KXZvaWQiLCBtZXRob2QgIm9wVXAoKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjYWNoZU1ldGEgdXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciBnZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbCBnZXRVc2VySW1wYWN0IGdldE1ldGEgdXBkYXRlQWtpdGFEQU8gbWFpbl9vcFVwX3JvdXRlQDkKICAgIGVycgoKbWFpbl9vcFVwX3JvdXRlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjAKICAgIC8vIGV4cG9ydCBjbGFzcyBBa2l0YVNvY2lhbEltcGFjdCBleHRlbmRzIEFraXRhQmFzZUNvbnRyYWN0IHsKICAgIHB1c2hieXRlcyAweDZmOTgxN2Y2IC8vIG1ldGhvZCAiY3JlYXRlKHVpbnQ2NCxzdHJpbmcpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyNwogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgcHVzaGJ5dGVzICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDcKICAgIC8vIHRoaXMudmVyc2lvbi52YWx1ZSA9IHZlcnNpb24KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzA4CiAgICAvLyB0aGlzLmFraXRhREFPLnZhbHVlID0gQXBwbGljYXRpb24oYWtpdGFEQU8pCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMDUKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuY2FjaGVNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKY2FjaGVNZXRhOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gNQogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMxMwogICAgLy8gY2FjaGVNZXRhKGFkZHJlc3M6IEFjY291bnQsIHN1YnNjcmlwdGlvbkluZGV4OiB1aW50NjQsIE5GREFwcElEOiB1aW50NjQsIGFraXRhQXNzZXRJRDogdWludDY0KTogdWludDY0IHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzE0CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCkuYWRkcmVzcywgRVJSX05PVF9TT0NJQUwpCiAgICB0eG4gU2VuZGVyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMxNAogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwpLmFkZHJlc3MsIEVSUl9OT1RfU09DSUFMKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDUKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFTb2NpYWxBcHBMaXN0KSkKICAgIGJ5dGVjIDQgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzE0CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCkuYWRkcmVzcywgRVJSX05PVF9TT0NJQUwpCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gTm90IGEgc29jaWFsIHBsdWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTYKICAgIC8vIGlmIChzdWJzY3JpcHRpb25JbmRleCAhPT0gMCkgewogICAgYnogY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTcKICAgIC8vIGFzc2VydCh0aGlzLmlzU3Vic2NyaWJlZChhZGRyZXNzLCBzdWJzY3JpcHRpb25JbmRleCkuYWN0aXZlLCBFUlJfTk9UX0FfU1VCU0NSSVBUSU9OKQogICAgZGlnIDQKICAgIGRpZyA0CiAgICBjYWxsc3ViIGlzU3Vic2NyaWJlZAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgYXNzZXJ0IC8vIE5vdCBhbiBha2l0YSBzdWJzY3JpcHRpb24gY29udHJhY3QKCmNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyMAogICAgLy8gbGV0IG5mZFRpbWVDaGFuZ2VkOiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyMQogICAgLy8gbGV0IG5mZEltcGFjdDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMjIKICAgIC8vIGlmIChORkRBcHBJRCAhPT0gMCkgewogICAgZGlnIDEKICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NzcKICAgIC8vIGNvbnN0IFtuZmROYW1lQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoTkZEQXBwLCBCeXRlcyhORkRHbG9iYWxTdGF0ZUtleXNOYW1lKSkKICAgIGRpZyAxCiAgICBkdXAKICAgIHB1c2hieXRlcyAiaS5uYW1lIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3OS04MgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIE5GRFJlZ2lzdHJ5LnByb3RvdHlwZS5pc1ZhbGlkTmZkQXBwSWQ+KHsKICAgIC8vICAgYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXJnczogW1N0cmluZyhuZmROYW1lQnl0ZXMpLCBORkRBcHAuaWRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4MAogICAgLy8gYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6ODAKICAgIC8vIGFwcElkOiBnZXRPdGhlckFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkubmZkUmVnaXN0cnksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBieXRlYyA1IC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjgwCiAgICAvLyBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm5mZFJlZ2lzdHJ5LAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjgxCiAgICAvLyBhcmdzOiBbU3RyaW5nKG5mZE5hbWVCeXRlcyksIE5GREFwcC5pZF0KICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3OS04MgogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIE5GRFJlZ2lzdHJ5LnByb3RvdHlwZS5pc1ZhbGlkTmZkQXBwSWQ+KHsKICAgIC8vICAgYXBwSWQ6IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5uZmRSZWdpc3RyeSwKICAgIC8vICAgYXJnczogW1N0cmluZyhuZmROYW1lQnl0ZXMpLCBORkRBcHAuaWRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4NGJlMjJmYzYgLy8gbWV0aG9kICJpc1ZhbGlkTmZkQXBwSWQoc3RyaW5nLHVpbnQ2NClib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBkaWcgNAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNAogICAgLy8gYXNzZXJ0KHRoaXMuaXNORkQobmZkQXBwKSwgRVJSX05PVF9BTl9ORkQpCiAgICBhc3NlcnQgLy8gTm90IGFuIE5GRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4Ny05MAogICAgLy8gY29uc3QgY2FBbGdvRGF0YSA9IGFiaUNhbGw8dHlwZW9mIE5GRC5wcm90b3R5cGUucmVhZEZpZWxkPih7CiAgICAvLyAgIGFwcElkOiBORkRBcHAuaWQsCiAgICAvLyAgIGFyZ3M6IFtCeXRlcyhORkRNZXRhS2V5VmVyaWZpZWRBZGRyZXNzZXMpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIGJ5dGVjIDYgLy8gbWV0aG9kICJyZWFkRmllbGQoYnl0ZVtdKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjg5CiAgICAvLyBhcmdzOiBbQnl0ZXMoTkZETWV0YUtleVZlcmlmaWVkQWRkcmVzc2VzKV0KICAgIHB1c2hieXRlcyAweDAwMGQ3NjJlNjM2MTQxNmM2NzZmMmUzMDJlNjE3MwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo4Ny05MAogICAgLy8gY29uc3QgY2FBbGdvRGF0YSA9IGFiaUNhbGw8dHlwZW9mIE5GRC5wcm90b3R5cGUucmVhZEZpZWxkPih7CiAgICAvLyAgIGFwcElkOiBORkRBcHAuaWQsCiAgICAvLyAgIGFyZ3M6IFtCeXRlcyhORkRNZXRhS2V5VmVyaWZpZWRBZGRyZXNzZXMpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ4W10pCiAgICBleHRyYWN0IDYgMAogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo5MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGNhQWxnb0RhdGEubGVuZ3RoOyBpICs9IDMyKSB7CiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CgpjYWNoZU1ldGFfd2hpbGVfdG9wQDE1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo5MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGNhQWxnb0RhdGEubGVuZ3RoOyBpICs9IDMyKSB7CiAgICBkaWcgMTMKICAgIGxlbgogICAgZHVwCiAgICBidXJ5IDcKICAgIGRpZyA5CiAgICA+CiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfd2hpbGVAMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OTMKICAgIC8vIGNvbnN0IGFkZHIgPSBjYUFsZ29EYXRhLnNsaWNlKGksIGkgKyAzMikKICAgIGRpZyA4CiAgICBkdXAKICAgIGRpZyA3CiAgICBkdXAKICAgIGNvdmVyIDMKICAgID49CiAgICBkaWcgMQogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzd2FwCiAgICBpbnRjXzMgLy8gMzIKICAgICsKICAgIGR1cAogICAgYnVyeSAxMgogICAgZHVwCiAgICBkaWcgMwogICAgPj0KICAgIHN3YXAKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIGR1cAogICAgZGlnIDIKICAgIDwKICAgIGRpZyAyCiAgICBzd2FwCiAgICBzZWxlY3QKICAgIGRpZyAxNQogICAgY292ZXIgMgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk0CiAgICAvLyBpZiAoYWRkciAhPT0gR2xvYmFsLnplcm9BZGRyZXNzLmJ5dGVzICYmIGFkZHIgPT09IGFjY291bnQuYnl0ZXMpIHsKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgIT0KICAgIGJ6IGNhY2hlTWV0YV93aGlsZV90b3BAMTUKICAgIGRpZyAxNAogICAgZGlnIDUKICAgID09CiAgICBieiBjYWNoZU1ldGFfd2hpbGVfdG9wQDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk1CiAgICAvLyByZXR1cm4gdHJ1ZQogICAgaW50Y18xIC8vIDEKCmNhY2hlTWV0YV9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmFkZHJlc3NWZXJpZmllZE9uTkZEQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMjUKICAgIC8vIGFzc2VydCh0aGlzLmFkZHJlc3NWZXJpZmllZE9uTkZEKGFkZHJlc3MsIG5mZEFwcCksIEVSUl9VU0VSX0RPRVNfTk9UX09XTl9ORkQpCiAgICBhc3NlcnQgLy8gVXNlciBkb2VzIG5vdCBvd24gdGhpcyBORkQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzI2CiAgICAvLyBjb25zdCBbdGltZUNoYW5nZWRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhuZmRBcHAsIEJ5dGVzKE5GREdsb2JhbFN0YXRlS2V5c1RpbWVDaGFuZ2VkKSkKICAgIGRpZyAxCiAgICBkdXAKICAgIGJ5dGVjIDcgLy8gImkudGltZUNoYW5nZWQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNwogICAgLy8gbmZkVGltZUNoYW5nZWQgPSBidG9pKHRpbWVDaGFuZ2VkQnl0ZXMpCiAgICBidG9pCiAgICBidXJ5IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjQwCiAgICAvLyBsZXQgbmZkSW1wYWN0OiB1aW50NjQgPSA1MAogICAgcHVzaGludCA1MCAvLyA1MAogICAgYnVyeSA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0MgogICAgLy8gY29uc3QgW3BhcmVudEFwcElEQnl0ZXMsIHBhcmVudEFwcElEQnl0ZXNFeGlzdF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhORkRBcHAsIEJ5dGVzKE5GREdsb2JhbFN0YXRlS2V5c1BhcmVudEFwcElEKSkKICAgIHB1c2hieXRlcyAiaS5wYXJlbnRBcHBJRCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBzd2FwCiAgICBidXJ5IDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0NAogICAgLy8gaWYgKHBhcmVudEFwcElEQnl0ZXNFeGlzdCAmJiBidG9pKHBhcmVudEFwcElEQnl0ZXMpID09PSBnZXRPdGhlckFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuYWtpdGFOZmQpIHsKICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI1CiAgICBkaWcgMTEKICAgIGJ0b2kKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjQ0CiAgICAvLyBpZiAocGFyZW50QXBwSURCeXRlc0V4aXN0ICYmIGJ0b2kocGFyZW50QXBwSURCeXRlcykgPT09IGdldE90aGVyQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5ha2l0YU5mZCkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTUKICAgIC8vIGNvbnN0IFtvdGhlckFwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNPdGhlckFwcExpc3QpKQogICAgYnl0ZWMgNSAvLyAib2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNDQKICAgIC8vIGlmIChwYXJlbnRBcHBJREJ5dGVzRXhpc3QgJiYgYnRvaShwYXJlbnRBcHBJREJ5dGVzKSA9PT0gZ2V0T3RoZXJBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmFraXRhTmZkKSB7CiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgPT0KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0NQogICAgLy8gbmZkSW1wYWN0ICs9IDUwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGJ1cnkgOAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMjU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI0OAogICAgLy8gY29uc3QgdmVyc2lvbiA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKE5GREFwcCwgQnl0ZXMoTkZER2xvYmFsU3RhdGVLZXlzVmVyc2lvbikpWzBdLnNsaWNlKDAsIDIpCiAgICBkaWcgMQogICAgcHVzaGJ5dGVzICJpLnZlciIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDEKICAgID49CiAgICBpbnRjXzAgLy8gMAogICAgZGlnIDIKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBwdXNoaW50IDIgLy8gMgogICAgZGlnIDIKICAgID49CiAgICBwdXNoaW50IDIgLy8gMgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNTAKICAgIC8vIGlmICh2ZXJzaW9uICE9PSBCeXRlcygnMy4nKSkgewogICAgcHVzaGJ5dGVzICIzLiIKICAgICE9CiAgICBibnogY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VANQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMzAKICAgIC8vIGNvbnN0IGRvbWFpbiA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkRG9tYWluKQogICAgZGlnIDEKICAgIGR1cAogICAgcHVzaGJ5dGVzICJ2LmRvbWFpbiIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMQogICAgLy8gY29uc3QgdHdpdHRlciA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkVHdpdHRlcikKICAgIGRpZyAxCiAgICBwdXNoYnl0ZXMgInYudHdpdHRlciIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMgogICAgLy8gY29uc3QgZGlzY29yZCA9IHRoaXMubmZkUmVhZEZpZWxkKE5GREFwcCwgTkZETWV0YUtleVZlcmlmaWVkRGlzY29yZCkKICAgIGRpZyAyCiAgICBwdXNoYnl0ZXMgInYuZGlzY29yZCIKICAgIGNhbGxzdWIgbmZkUmVhZEZpZWxkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIzMwogICAgLy8gY29uc3QgdGVsZWdyYW0gPSB0aGlzLm5mZFJlYWRGaWVsZChORkRBcHAsIE5GRE1ldGFLZXlWZXJpZmllZFRlbGVncmFtKQogICAgdW5jb3ZlciAzCiAgICBwdXNoYnl0ZXMgInYudGVsZWdyYW0iCiAgICBjYWxsc3ViIG5mZFJlYWRGaWVsZAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMzUKICAgIC8vIHJldHVybiB7IGRvbWFpbiwgdHdpdHRlciwgZGlzY29yZCwgdGVsZWdyYW0gfQogICAgZGlnIDMKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgICsKICAgIGRpZyA0CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDUKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBwdXNoYnl0ZXMgMHgwMDA4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHVuY292ZXIgMwogICAgKwogICAgZGlnIDUKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNgogICAgY29uY2F0CiAgICBkaWcgMQogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgICsKICAgIGRpZyA1CiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDYKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjU0CiAgICAvLyBjb25zdCB7IGRvbWFpbiwgdHdpdHRlciwgZGlzY29yZCwgdGVsZWdyYW0gfSA9IHRoaXMuZ2V0TkZEU29jaWFsRmllbGRzKE5GREFwcCkKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgcHVzaGludCAyIC8vIDIKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgc3Vic3RyaW5nMwogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAyCiAgICBwdXNoaW50IDQgLy8gNAogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAzCiAgICB1bmNvdmVyIDMKICAgIGRpZyAyCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgYnVyeSAxMwogICAgZGlnIDIKICAgIHB1c2hpbnQgNiAvLyA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDMKICAgIHVuY292ZXIgMgogICAgZGlnIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICBidXJ5IDEyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI1NwogICAgLy8gaWYgKGRvbWFpbi5sZW5ndGggPiAwKSB7CiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDI5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI1OAogICAgLy8gbmZkSW1wYWN0ICs9IDEwCiAgICBkaWcgNwogICAgcHVzaGludCAxMCAvLyAxMAogICAgKwogICAgYnVyeSA4CgpjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAyOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjYyCiAgICAvLyBpZiAodHdpdHRlci5sZW5ndGggPiAwKSB7CiAgICBkaWcgOQogICAgbGVuCiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNjMKICAgIC8vIG5mZEltcGFjdCArPSAyMAogICAgZGlnIDcKICAgIHB1c2hpbnQgMjAgLy8gMjAKICAgICsKICAgIGJ1cnkgOAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VAMzE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI2NwogICAgLy8gaWYgKGRpc2NvcmQubGVuZ3RoID4gMCkgewogICAgZGlnIDEyCiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDMzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI2OAogICAgLy8gbmZkSW1wYWN0ICs9IDEwCiAgICBkaWcgNwogICAgcHVzaGludCAxMCAvLyAxMAogICAgKwogICAgYnVyeSA4CgpjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUAzMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAodGVsZWdyYW0ubGVuZ3RoID4gMCkgewogICAgZGlnIDEwCiAgICBsZW4KICAgIGJ6IGNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjczCiAgICAvLyBuZmRJbXBhY3QgKz0gMTAKICAgIGRpZyA3CiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCiAgICBidXJ5IDgKCmNhY2hlTWV0YV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMzMQogICAgLy8gaWYgKGFraXRhQXNzZXRJRCAhPT0gMCkgewogICAgZHVwCiAgICBieiBjYWNoZU1ldGFfYWZ0ZXJfaWZfZWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEwMwogICAgLy8gcmV0dXJuIGFraXRhTkZULmNyZWF0b3IgPT09IEFjY291bnQoQWtpdGFORlRDcmVhdG9yQWRkcmVzcykKICAgIGR1cG4gMgogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGJ5dGVjIDggLy8gYWRkciBBS0NUUkRLNE9XTldIVFBINFhQS0xOV05MWjMzM1ZFMzVTS1E0RkdRSzNaSkE0RklIQ0xUUkczUEZJCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMzMKICAgIC8vIGFzc2VydCh0aGlzLmlzQWtpdGFORlQoYWtpdGFORlQpLCBFUlJfTk9UX0FOX0FLSVRBX05GVCkKICAgIGFzc2VydCAvLyBOb3QgYW4gYWtpdGEgTkZUCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM5CiAgICAvLyByZXR1cm4gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShhY2NvdW50LCBORlQpWzBdID4gMAogICAgZGlnIDUKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMzNAogICAgLy8gYXNzZXJ0KHRoaXMudXNlckhvbGRzKGFkZHJlc3MsIGFraXRhTkZUKSwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GVCkKICAgIGFzc2VydCAvLyBVc2VyIGRvZXMgbm90IG93biB0aGlzIE5GVAoKY2FjaGVNZXRhX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzM3LTM0MwogICAgLy8gdGhpcy5tZXRhKGFkZHJlc3MpLnZhbHVlID0gewogICAgLy8gICBzdWJzY3JpcHRpb25JbmRleCwKICAgIC8vICAgTkZEOiBORkRBcHBJRCwKICAgIC8vICAgbmZkVGltZUNoYW5nZWQsCiAgICAvLyAgIG5mZEltcGFjdCwKICAgIC8vICAgYWtpdGFORlQ6IGFraXRhQXNzZXRJRCwKICAgIC8vIH0KICAgIGRpZyAzCiAgICBpdG9iCiAgICBkaWcgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBkaWcgNwogICAgaXRvYgogICAgY29uY2F0CiAgICBkaWcgOAogICAgaXRvYgogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNQogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBJbXBhY3RNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBJbXBhY3RCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlY18yIC8vICJtIgogICAgZGlnIDcKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMzctMzQzCiAgICAvLyB0aGlzLm1ldGEoYWRkcmVzcykudmFsdWUgPSB7CiAgICAvLyAgIHN1YnNjcmlwdGlvbkluZGV4LAogICAgLy8gICBORkQ6IE5GREFwcElELAogICAgLy8gICBuZmRUaW1lQ2hhbmdlZCwKICAgIC8vICAgbmZkSW1wYWN0LAogICAgLy8gICBha2l0YU5GVDogYWtpdGFBc3NldElELAogICAgLy8gfQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozMTMKICAgIC8vIGNhY2hlTWV0YShhZGRyZXNzOiBBY2NvdW50LCBzdWJzY3JpcHRpb25JbmRleDogdWludDY0LCBORkRBcHBJRDogdWludDY0LCBha2l0YUFzc2V0SUQ6IHVpbnQ2NCk6IHVpbnQ2NCB7CiAgICBieXRlY18xIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpjYWNoZU1ldGFfYWZ0ZXJfd2hpbGVAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjk5CiAgICAvLyByZXR1cm4gZmFsc2UKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMyNQogICAgLy8gYXNzZXJ0KHRoaXMuYWRkcmVzc1ZlcmlmaWVkT25ORkQoYWRkcmVzcywgbmZkQXBwKSwgRVJSX1VTRVJfRE9FU19OT1RfT1dOX05GRCkKICAgIGIgY2FjaGVNZXRhX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuYWRkcmVzc1ZlcmlmaWVkT25ORkRAMjEKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC51cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlU3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ4CiAgICAvLyB1cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwgbmV3TW9kaWZpZXI6IHVpbnQ2NCk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDkgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgPT0KICAgIGFzc2VydCAvLyBPbmx5IHRoZSBBa2l0YSBEQU8gY2FuIGNhbGwgdGhpcyBmdW5jdGlvbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTEKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25JbmRleCkudmFsdWUgPSBuZXdNb2RpZmllcgogICAgc3dhcAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNwogICAgLy8gc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciA9IEJveE1hcDx1aW50NjQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEltcGFjdEJveFByZWZpeFN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIgfSkKICAgIHB1c2hieXRlcyAicyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTEKICAgIC8vIHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25JbmRleCkudmFsdWUgPSBuZXdNb2RpZmllcgogICAgc3dhcAogICAgaXRvYgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTMtMzYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKCkuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkdXAKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM1NgogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzUzLTM2MAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB0aGlzLm1icigpLnN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHN3YXAKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTcKICAgIC8vIGFtb3VudDogdGhpcy5tYnIoKS5zdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyCiAgICBwdXNoaW50IDkzMDAgLy8gOTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNTMtMzYwCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHRoaXMubWJyKCkuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcgogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzQ4CiAgICAvLyB1cGRhdGVTdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgc3Vic2NyaXB0aW9uSW5kZXg6IHVpbnQ2NCwgbmV3TW9kaWZpZXI6IHVpbnQ2NCk6IHZvaWQgewogICAgcmV0dXJuIC8vIG9uIGVycm9yOiBJbnZhbGlkIHBheW1lbnQKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRVc2VySW1wYWN0V2l0aG91dFNvY2lhbFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdldFVzZXJJbXBhY3RXaXRob3V0U29jaWFsOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNjYKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM2OAogICAgLy8gcmV0dXJuIHRoaXMudXNlckltcGFjdChhZGRyZXNzLCBmYWxzZSkKICAgIGludGNfMCAvLyAwCiAgICBjYWxsc3ViIHVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzY2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGl0b2IKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRVc2VySW1wYWN0W3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0VXNlckltcGFjdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzcxCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czozNzMKICAgIC8vIHJldHVybiB0aGlzLnVzZXJJbXBhY3QoYWRkcmVzcywgdHJ1ZSkKICAgIGludGNfMSAvLyAxCiAgICBjYWxsc3ViIHVzZXJJbXBhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MzcxCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGl0b2IKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRNZXRhW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0TWV0YToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mzc2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNQogICAgLy8gbWV0YSA9IEJveE1hcDxBY2NvdW50LCBJbXBhY3RNZXRhVmFsdWU+KHsga2V5UHJlZml4OiBJbXBhY3RCb3hQcmVmaXhNZXRhIH0pCiAgICBieXRlY18yIC8vICJtIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjM3OAogICAgLy8gcmV0dXJuIHRoaXMubWV0YSh1c2VyKS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mzc2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo6QWtpdGFCYXNlQ29udHJhY3QudXBkYXRlQWtpdGFEQU9bcm91dGluZ10oKSAtPiB2b2lkOgp1cGRhdGVBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjMyCiAgICAvLyBjb25zdCBbd2FsbGV0SURdID0gb3AuQXBwR2xvYmFsLmdldEV4VWludDY0KHRoaXMuYWtpdGFEQU8udmFsdWUsIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0KSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjMyCiAgICAvLyBjb25zdCBbd2FsbGV0SURdID0gb3AuQXBwR2xvYmFsLmdldEV4VWludDY0KHRoaXMuYWtpdGFEQU8udmFsdWUsIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzV2FsbGV0KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyA5IC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5pc1N1YnNjcmliZWQoYWRkcmVzczogYnl0ZXMsIGlkOiB1aW50NjQpIC0+IGJ5dGVzOgppc1N1YnNjcmliZWQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQyCiAgICAvLyBwcml2YXRlIGlzU3Vic2NyaWJlZChhZGRyZXNzOiBBY2NvdW50LCBpZDogdWludDY0KTogeyBhY3RpdmU6IGJvb2xlYW47IHNlcnZpY2VJRDogdWludDY0OyBzdHJlYWs6IHVpbnQ2NCB9IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0My00NgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN1YnNjcmlwdGlvbnMucHJvdG90eXBlLmdldFN1YnNjcmlwdGlvbj4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGFyZ3M6IFt7IGFkZHJlc3MsIGlkIH1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdWJzY3JpcHRpb25zLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdWJzY3JpcHRpb25zLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDEwIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICBpbnRjXzMgLy8gMzIKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ1CiAgICAvLyBhcmdzOiBbeyBhZGRyZXNzLCBpZCB9XQogICAgZnJhbWVfZGlnIC0xCiAgICBpdG9iCiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0My00NgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN1YnNjcmlwdGlvbnMucHJvdG90eXBlLmdldFN1YnNjcmlwdGlvbj4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN1YnNjcmlwdGlvbnMsCiAgICAvLyAgIGFyZ3M6IFt7IGFkZHJlc3MsIGlkIH1dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4MjViODEyYTcgLy8gbWV0aG9kICJnZXRTdWJzY3JpcHRpb24oKGFkZHJlc3MsdWludDY0KSkoYm9vbCxhZGRyZXNzLHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXBuIDIKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDEwNSAvLyAxMDUKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChib29sMSx1aW50OFszMl0sdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjQ4CiAgICAvLyBpZiAoIWluZm8uZXhpc3RzKSB7CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBibnogaXNTdWJzY3JpYmVkX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo0OS01MwogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgYWN0aXZlOiBmYWxzZSwKICAgIC8vICAgc2VydmljZUlEOiAwLAogICAgLy8gICBzdHJlYWs6IDAsCiAgICAvLyB9CiAgICBwdXNoYnl0ZXMgMHgwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBzd2FwCiAgICByZXRzdWIKCmlzU3Vic2NyaWJlZF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB0b0FraXRhID0gaW5mby5yZWNpcGllbnQgPT09IHRoaXMuYWtpdGFEQU8udmFsdWUuYWRkcmVzcwogICAgZnJhbWVfZGlnIDAKICAgIGR1cAogICAgZXh0cmFjdCA1IDMyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB0b0FraXRhID0gaW5mby5yZWNpcGllbnQgPT09IHRoaXMuYWtpdGFEQU8udmFsdWUuYWRkcmVzcwogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjYxCiAgICAvLyBjb25zdCBub3REb25hdGluZyA9IGluZm8uc2VydmljZUlEICE9PSAwCiAgICBkaWcgMQogICAgcHVzaGludCAzNyAvLyAzNwogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjMKICAgIC8vIGNvbnN0IHByZXZpb3VzV2luZG93U3RhcnQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSAoKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gaW5mby5zdGFydERhdGUpICUgaW5mby5pbnRlcnZhbCkgKyBpbmZvLmludGVydmFsKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZHVwCiAgICBkaWcgNAogICAgcHVzaGludCA0NSAvLyA0NQogICAgZXh0cmFjdF91aW50NjQKICAgIC0KICAgIGRpZyA0CiAgICBwdXNoaW50IDYxIC8vIDYxCiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgZGlnIDEKICAgICUKICAgICsKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjcKICAgIC8vIGNvbnN0IG5vdFN0YWxlID0gaW5mby5sYXN0UGF5bWVudCA+IHByZXZpb3VzV2luZG93U3RhcnQKICAgIGRpZyAzCiAgICBwdXNoaW50IDg1IC8vIDg1CiAgICBleHRyYWN0X3VpbnQ2NAogICAgPAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo3MAogICAgLy8gYWN0aXZlOiB0b0FraXRhICYmIG5vdERvbmF0aW5nICYmIG5vdFN0YWxlLAogICAgdW5jb3ZlciAyCiAgICBkaWcgMgogICAgJiYKICAgICYmCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjcyCiAgICAvLyBzdHJlYWs6IGluZm8uc3RyZWFrLAogICAgdW5jb3ZlciAyCiAgICBleHRyYWN0IDkzIDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6NjktNzMKICAgIC8vIHJldHVybiB7CiAgICAvLyAgIGFjdGl2ZTogdG9Ba2l0YSAmJiBub3REb25hdGluZyAmJiBub3RTdGFsZSwKICAgIC8vICAgc2VydmljZUlEOiBpbmZvLnNlcnZpY2VJRCwKICAgIC8vICAgc3RyZWFrOiBpbmZvLnN0cmVhaywKICAgIC8vIH0KICAgIHB1c2hieXRlcyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAzCiAgICBzZXRiaXQKICAgIHVuY292ZXIgMgogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LnVzZXJJbXBhY3QoYWNjb3VudDogYnl0ZXMsIGluY2x1ZGVTb2NpYWw6IHVpbnQ2NCkgLT4gdWludDY0Ogp1c2VySW1wYWN0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMDYKICAgIC8vIHByaXZhdGUgdXNlckltcGFjdChhY2NvdW50OiBBY2NvdW50LCBpbmNsdWRlU29jaWFsOiBib29sZWFuKTogdWludDY0IHsKICAgIHByb3RvIDIgMQogICAgaW50Y18wIC8vIDAKICAgIGR1cAogICAgYnl0ZWNfMyAvLyAiIgogICAgZHVwbiAxMAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMDgKICAgIC8vIGxldCBzdWJzY3JpcHRpb25JbmRleDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTA5LTExMAogICAgLy8gbGV0IE5GRDogdWludDY0ID0gMAogICAgLy8gbGV0IGFraXRhTkZUOiB1aW50NjQgPSAwCiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjUKICAgIC8vIG1ldGEgPSBCb3hNYXA8QWNjb3VudCwgSW1wYWN0TWV0YVZhbHVlPih7IGtleVByZWZpeDogSW1wYWN0Qm94UHJlZml4TWV0YSB9KQogICAgYnl0ZWNfMiAvLyAibSIKICAgIGZyYW1lX2RpZyAtMgogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTEyCiAgICAvLyBpZiAodGhpcy5tZXRhKGFjY291bnQpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTMKICAgIC8vIGNvbnN0IG1ldGEgPSBjbG9uZSh0aGlzLm1ldGEoYWNjb3VudCkudmFsdWUpCiAgICBmcmFtZV9kaWcgMTYKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjExNAogICAgLy8gc3Vic2NyaXB0aW9uSW5kZXggPSBtZXRhLnN1YnNjcmlwdGlvbkluZGV4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTUKICAgIC8vIE5GRCA9IG1ldGEuTkZECiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTYKICAgIC8vIGFraXRhTkZUID0gbWV0YS5ha2l0YU5GVAogICAgaW50Y18zIC8vIDMyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfYnVyeSAxNQoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzNgogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTM2CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMzYKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzOQogICAgLy8gaWYgKGFrdGEgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQwCiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOAoKdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldFN0YWtpbmdJbXBhY3RTY29yZUA0NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTkzCiAgICAvLyBsZXQgc3Vic2NyaWJlckltcGFjdDogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxOTUKICAgIC8vIGNvbnN0IHN1YnNjcmlwdGlvblN0YXRlID0gdGhpcy5pc1N1YnNjcmliZWQoYWNjb3VudCwgc3Vic2NyaXB0aW9uSW5kZXgpCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAxMwogICAgY2FsbHN1YiBpc1N1YnNjcmliZWQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE5NwogICAgLy8gaWYgKCFzdWJzY3JpcHRpb25TdGF0ZS5hY3RpdmUpIHsKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIGJ6IHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdWJzY3JpYmVySW1wYWN0U2NvcmVANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjAxCiAgICAvLyBjb25zdCBtb2RpZmllciA9IHRoaXMuc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllcihzdWJzY3JpcHRpb25TdGF0ZS5zZXJ2aWNlSUQpLnZhbHVlCiAgICBmcmFtZV9kaWcgMQogICAgZHVwCiAgICBleHRyYWN0IDEgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyNwogICAgLy8gc3Vic2NyaXB0aW9uU3RhdGVNb2RpZmllciA9IEJveE1hcDx1aW50NjQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IEltcGFjdEJveFByZWZpeFN1YnNjcmlwdGlvblN0YXRlTW9kaWZpZXIgfSkKICAgIHB1c2hieXRlcyAicyIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMDEKICAgIC8vIGNvbnN0IG1vZGlmaWVyID0gdGhpcy5zdWJzY3JpcHRpb25TdGF0ZU1vZGlmaWVyKHN1YnNjcmlwdGlvblN0YXRlLnNlcnZpY2VJRCkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwNAogICAgLy8gaWYgKHN1YnNjcmlwdGlvblN0YXRlLnN0cmVhayA+PSAxMikgewogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTEKICAgIHB1c2hpbnQgMTIgLy8gMTIKICAgID49CiAgICBieiB1c2VySW1wYWN0X2Vsc2VfYm9keUA0OQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMDUKICAgIC8vIHN1YnNjcmliZXJJbXBhY3QgKz0gMjUwIC8gbW9kaWZpZXIKICAgIHB1c2hpbnQgMjUwIC8vIDI1MAogICAgc3dhcAogICAgLwogICAgZnJhbWVfYnVyeSA5Cgp1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0U3Vic2NyaWJlckltcGFjdFNjb3JlQDUxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjEKICAgIC8vIGNvbnN0IHNvY2lhbEltcGFjdDogdWludDY0ID0gaW5jbHVkZVNvY2lhbCA/IHRoaXMuZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudCkgOiAwIC8vIFNvY2lhbCBBY3Rpdml0eSB8IHVwIHRvIDI1MAogICAgZnJhbWVfZGlnIC0xCiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMTQtMjE3CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWwucHJvdG90eXBlLmdldFVzZXJTb2NpYWxJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwsCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnNvY2lhbCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyA0IC8vICJzYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIxNQogICAgLy8gYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zb2NpYWwsCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjE0LTIxNwogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsLnByb3RvdHlwZS5nZXRVc2VyU29jaWFsSW1wYWN0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc29jaWFsLAogICAgLy8gICBhcmdzOiBbYWRkcmVzc10KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhlODY5OTM0ZCAvLyBtZXRob2QgImdldFVzZXJTb2NpYWxJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0yCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDcKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjIKICAgIC8vIGNvbnN0IG5mZFNjb3JlOiB1aW50NjQgPSBORkQgIT09IDAgPyB0aGlzLmdldE5GREltcGFjdFNjb3JlKGFjY291bnQsIEFwcGxpY2F0aW9uKE5GRCkpIDogMCAvLyBORkQgfCB1cCB0byAxNTAKICAgIGZyYW1lX2RpZyAxNAogICAgYnogdXNlckltcGFjdF90ZXJuYXJ5X2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjgwCiAgICAvLyBjb25zdCB7IE5GRCwgbmZkVGltZUNoYW5nZWQsIG5mZEltcGFjdCB9ID0gdGhpcy5tZXRhKGFjY291bnQpLnZhbHVlCiAgICBmcmFtZV9kaWcgMTYKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgdGltZUNoYW5nZWQgPSBidG9pKG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKE5GRCwgQnl0ZXMoTkZER2xvYmFsU3RhdGVLZXlzVGltZUNoYW5nZWQpKVswXSkKICAgIGRpZyAyCiAgICBieXRlYyA3IC8vICJpLnRpbWVDaGFuZ2VkIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyODMKICAgIC8vIGFzc2VydChORkRBcHAuaWQgPT09IEFwcGxpY2F0aW9uKE5GRCkuaWQsIEVSUl9JTlZBTElEX05GRCkKICAgIGZyYW1lX2RpZyAxNAogICAgdW5jb3ZlciA0CiAgICA9PQogICAgYXNzZXJ0IC8vIEludmFsaWQgTkZECiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI4NAogICAgLy8gYXNzZXJ0KG5mZFRpbWVDaGFuZ2VkID09PSB0aW1lQ2hhbmdlZCwgRVJSX05GRF9DSEFOR0VEKQogICAgdW5jb3ZlciAyCiAgICA9PQogICAgYXNzZXJ0IC8vIE5GRCBjaGFuZ2VkIHNpbmNlIGltcGFjdCBsYXN0IGNhbGN1bGF0ZWQKICAgIGZyYW1lX2J1cnkgNgoKdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3NAogICAgLy8gY29uc3QgYWt0YSA9IGdldEFraXRhQXNzZXRzKHRoaXMuYWtpdGFEQU8udmFsdWUpLmFrdGEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTc0CiAgICAvLyBjb25zdCBha3RhID0gZ2V0QWtpdGFBc3NldHModGhpcy5ha2l0YURBTy52YWx1ZSkuYWt0YQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OTQKICAgIC8vIGNvbnN0IGFraXRhQXNzZXRzQnl0ZXMgPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFzc2V0cykpWzBdCiAgICBieXRlYyAxMSAvLyAiYWtpdGFfYXNzZXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNzQKICAgIC8vIGNvbnN0IGFrdGEgPSBnZXRBa2l0YUFzc2V0cyh0aGlzLmFraXRhREFPLnZhbHVlKS5ha3RhCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3NwogICAgLy8gaWYgKGFrdGEgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTc4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNQoKdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldEhlbGRBa3RhSW1wYWN0U2NvcmVAMjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEyNAogICAgLy8gY29uc3QgbmZ0SW1wYWN0OiB1aW50NjQgPSBha2l0YU5GVCAhPT0gMCA/IHRoaXMuZ2V0TkZUSW1wYWN0U2NvcmUoYWNjb3VudCwgQXNzZXQoYWtpdGFORlQpKSA6IDAgLy8gSG9sZHMgQUtDL09tbmlnZW0gfCA1MAogICAgZnJhbWVfZGlnIDE1CiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VAMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkwCiAgICAvLyBjb25zdCBwcmVmaXggPSBhc3NldC51bml0TmFtZS5zbGljZSgwLCAzKQogICAgZnJhbWVfZGlnIDE1CiAgICBkdXAKICAgIGFzc2V0X3BhcmFtc19nZXQgQXNzZXRVbml0TmFtZQogICAgYXNzZXJ0IC8vIGFzc2V0IGV4aXN0cwogICAgZHVwCiAgICBsZW4KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMQogICAgPj0KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHB1c2hpbnQgMyAvLyAzCiAgICBkaWcgMgogICAgPj0KICAgIHB1c2hpbnQgMyAvLyAzCiAgICB1bmNvdmVyIDMKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBmcmFtZV9idXJ5IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkxCiAgICAvLyBjb25zdCBiYWxhbmNlID0gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShhY2NvdW50LCBhc3NldClbMF0KICAgIGZyYW1lX2RpZyAtMgogICAgZGlnIDEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjkyCiAgICAvLyBpZiAoYXNzZXQuY3JlYXRvciA9PT0gQWNjb3VudChBa2l0YU5GVENyZWF0b3JBZGRyZXNzKSAmJiBiYWxhbmNlID4gMCkgewogICAgYXNzZXRfcGFyYW1zX2dldCBBc3NldENyZWF0b3IKICAgIGFzc2VydCAvLyBhc3NldCBleGlzdHMKICAgIGJ5dGVjIDggLy8gYWRkciBBS0NUUkRLNE9XTldIVFBINFhQS0xOV05MWjMzM1ZFMzVTS1E0RkdRSzNaSkE0RklIQ0xUUkczUEZJCiAgICA9PQogICAgYnogdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDIxCiAgICBmcmFtZV9kaWcgMwogICAgYnogdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI5MwogICAgLy8gaWYgKHByZWZpeCA9PT0gQnl0ZXMoQWtpdGFDb2xsZWN0aW9uc1ByZWZpeEFLQykpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoYnl0ZXMgIkFLQyIKICAgID09CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mjk0CiAgICAvLyByZXR1cm4gNTAKICAgIHB1c2hpbnQgNTAgLy8gNTAKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI2CiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gc3Rha2VkQWt0YUltcGFjdCArIHN1YnNjcmliZXJJbXBhY3QgKyBzb2NpYWxJbXBhY3QgKyBuZmRTY29yZSArIGhlbGRBa2l0YUltcGFjdCArIG5mdEltcGFjdAogICAgZnJhbWVfZGlnIDgKICAgIGZyYW1lX2RpZyA5CiAgICArCiAgICBmcmFtZV9kaWcgNwogICAgKwogICAgZnJhbWVfZGlnIDYKICAgICsKICAgIGZyYW1lX2RpZyA1CiAgICArCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI3CiAgICAvLyBpZiAodG90YWwgPT09IDApIHsKICAgIGJueiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTI4CiAgICAvLyByZXR1cm4gMQogICAgaW50Y18xIC8vIDEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjEzMQogICAgLy8gcmV0dXJuIHRvdGFsCiAgICBmcmFtZV9kaWcgMTAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjI5NgogICAgLy8gaWYgKHByZWZpeCA9PT0gQnl0ZXMoQWtpdGFDb2xsZWN0aW9uc1ByZWZpeEFPRykpIHsKICAgIGZyYW1lX2RpZyAwCiAgICBwdXNoYnl0ZXMgIkFPRyIKICAgID09CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6Mjk3CiAgICAvLyByZXR1cm4gMjUKICAgIHB1c2hpbnQgMjUgLy8gMjUKICAgIGIgdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDExCgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjMwMAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCiAgICBiIHVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMQoKdXNlckltcGFjdF90ZXJuYXJ5X2ZhbHNlQDEwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjQKICAgIC8vIGNvbnN0IG5mdEltcGFjdDogdWludDY0ID0gYWtpdGFORlQgIT09IDAgPyB0aGlzLmdldE5GVEltcGFjdFNjb3JlKGFjY291bnQsIEFzc2V0KGFraXRhTkZUKSkgOiAwIC8vIEhvbGRzIEFLQy9PbW5pZ2VtIHwgNTAKICAgIGludGNfMCAvLyAwCiAgICBiIHVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUAxMQoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODEKICAgIC8vIGNvbnN0IGJhbGFuY2UgPSBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKGFjY291bnQsIGFrdGEpWzBdCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAyCiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTg0CiAgICAvLyBpZiAoYmFsYW5jZSA8IFRFTl9USE9VU0FORF9BS0lUQSkgewogICAgaW50YyA3IC8vIDEwMDAwMDAwMDAwCiAgICA8CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VAMjcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTg1CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjMKICAgIC8vIGNvbnN0IGhlbGRBa2l0YUltcGFjdCA9IHRoaXMuZ2V0SGVsZEFrdGFJbXBhY3RTY29yZShhY2NvdW50KSAvLyBIZWxkIEFLVEEgfCB1cCB0byA1MAogICAgYiB1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0SGVsZEFrdGFJbXBhY3RTY29yZUAyOAoKdXNlckltcGFjdF9hZnRlcl9pZl9lbHNlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODgKICAgIC8vIGNvbnN0IGNhcHBlZCA9IGJhbGFuY2UgPj0gT05FX01JTExJT05fQUtJVEEgPyBPTkVfTUlMTElPTl9BS0lUQSA6IGJhbGFuY2UKICAgIGZyYW1lX2RpZyAzCiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAwMDAwMDAwCiAgICA+PQogICAgaW50YyA0IC8vIDEwMDAwMDAwMDAwMDAKICAgIHN3YXAKICAgIHNlbGVjdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxODkKICAgIC8vIHJldHVybiAoY2FwcGVkICogNTApIC8gT05FX01JTExJT05fQUtJVEEKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgICoKICAgIGludGMgNCAvLyAxMDAwMDAwMDAwMDAwCiAgICAvCiAgICBmcmFtZV9idXJ5IDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTIzCiAgICAvLyBjb25zdCBoZWxkQWtpdGFJbXBhY3QgPSB0aGlzLmdldEhlbGRBa3RhSW1wYWN0U2NvcmUoYWNjb3VudCkgLy8gSGVsZCBBS1RBIHwgdXAgdG8gNTAKICAgIGIgdXNlckltcGFjdF9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6OkFraXRhU29jaWFsSW1wYWN0LmdldEhlbGRBa3RhSW1wYWN0U2NvcmVAMjgKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjIKICAgIC8vIGNvbnN0IG5mZFNjb3JlOiB1aW50NjQgPSBORkQgIT09IDAgPyB0aGlzLmdldE5GREltcGFjdFNjb3JlKGFjY291bnQsIEFwcGxpY2F0aW9uKE5GRCkpIDogMCAvLyBORkQgfCB1cCB0byAxNTAKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDYKICAgIGIgdXNlckltcGFjdF90ZXJuYXJ5X21lcmdlQDgKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMjEKICAgIC8vIGNvbnN0IHNvY2lhbEltcGFjdDogdWludDY0ID0gaW5jbHVkZVNvY2lhbCA/IHRoaXMuZ2V0U29jaWFsSW1wYWN0U2NvcmUoYWNjb3VudCkgOiAwIC8vIFNvY2lhbCBBY3Rpdml0eSB8IHVwIHRvIDI1MAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgNwogICAgYiB1c2VySW1wYWN0X3Rlcm5hcnlfbWVyZ2VANQoKdXNlckltcGFjdF9lbHNlX2JvZHlANDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIwNwogICAgLy8gc3Vic2NyaWJlckltcGFjdCArPSAoc3Vic2NyaXB0aW9uU3RhdGUuc3RyZWFrICogMjApIC8gbW9kaWZpZXIKICAgIGZyYW1lX2RpZyAxMQogICAgcHVzaGludCAyMCAvLyAyMAogICAgKgogICAgc3dhcAogICAgLwogICAgZnJhbWVfYnVyeSA5CiAgICBiIHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdWJzY3JpYmVySW1wYWN0U2NvcmVANTEKCnVzZXJJbXBhY3RfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQzLTE1MgogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWNjb3VudCwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogYWt0YSwKICAgIC8vICAgICAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gICAgIH0KICAgIC8vICAgXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQ0CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDEwIC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0NAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0Ny0xNTAKICAgIC8vIHsKICAgIC8vICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gfQogICAgZnJhbWVfZGlnIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTQ5CiAgICAvLyB0eXBlOiBTVEFLSU5HX1RZUEVfU09GVCwKICAgIHB1c2hieXRlcyAweDE0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0Ny0xNTAKICAgIC8vIHsKICAgIC8vICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgIHR5cGU6IFNUQUtJTkdfVFlQRV9TT0ZULAogICAgLy8gfQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE0My0xNTIKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFjY291bnQsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQ6IGFrdGEsCiAgICAvLyAgICAgICB0eXBlOiBTVEFLSU5HX1RZUEVfU09GVCwKICAgIC8vICAgICB9CiAgICAvLyAgIF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhjOTA2ODgwOSAvLyBtZXRob2QgImdldEluZm8oYWRkcmVzcywodWludDY0LHVpbnQ4KSkodWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMSAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNTQKICAgIC8vIGNvbnN0IGVsYXBzZWQ6IHVpbnQ2NCA9IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgLSBpbmZvLmxhc3RVcGRhdGUKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyAxCiAgICBwdXNoaW50IDEyIC8vIDEyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLQogICAgZnJhbWVfYnVyeSA0CiAgICBwdXNoaW50IDQgLy8gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxNTcKICAgIC8vIGlmIChpbmZvLmFtb3VudCA8IFRFTl9USE9VU0FORF9BS0lUQSB8fCBlbGFwc2VkIDwgVEhJUlRZX0RBWVMpIHsKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMTIKICAgIGludGMgNyAvLyAxMDAwMDAwMDAwMAogICAgPAogICAgYm56IHVzZXJJbXBhY3RfaWZfYm9keUAzOQogICAgZnJhbWVfZGlnIDQKICAgIHB1c2hpbnQgMjU5MjAwMCAvLyAyNTkyMDAwCiAgICA8CiAgICBieiB1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VANDAKCnVzZXJJbXBhY3RfaWZfYm9keUAzOToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTU4CiAgICAvLyByZXR1cm4gMAogICAgaW50Y18wIC8vIDAKICAgIGZyYW1lX2J1cnkgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoxMTkKICAgIC8vIGNvbnN0IHN0YWtlZEFrdGFJbXBhY3QgPSB0aGlzLmdldFN0YWtpbmdJbXBhY3RTY29yZShhY2NvdW50KSAvLyBTdGFrZWQgQUtUQSB8IHVwIHRvIDI1MAogICAgYiB1c2VySW1wYWN0X2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czo6QWtpdGFTb2NpYWxJbXBhY3QuZ2V0U3Rha2luZ0ltcGFjdFNjb3JlQDQ0Cgp1c2VySW1wYWN0X2FmdGVyX2lmX2Vsc2VANDA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE2MgogICAgLy8gY29uc3QgYW10Q2FwcGVkID0gaW5mby5hbW91bnQgPj0gVFdPX0hVTkRSRURfVEhPVVNBTkRfQUtJVEEgPyBUV09fSFVORFJFRF9USE9VU0FORF9BS0lUQSA6IGluZm8uYW1vdW50CiAgICBmcmFtZV9kaWcgMTIKICAgIGludGMgNSAvLyAyMDAwMDAwMDAwMDAKICAgID49CiAgICBieiB1c2VySW1wYWN0X3Rlcm5hcnlfZmFsc2VANDIKICAgIGludGMgNSAvLyAyMDAwMDAwMDAwMDAKCnVzZXJJbXBhY3RfdGVybmFyeV9tZXJnZUA0MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MTY1CiAgICAvLyBjb25zdCBtYXhTY29yZTogdWludDY0ID0gKGFtdENhcHBlZCAqIDI1MCkgLyBUV09fSFVORFJFRF9USE9VU0FORF9BS0lUQQogICAgcHVzaGludCAyNTAgLy8gMjUwCiAgICAqCiAgICBpbnRjIDUgLy8gMjAwMDAwMDAwMDAwCiAgICAvCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE2OAogICAgLy8gY29uc3QgdGltZUNhcHBlZCA9IGVsYXBzZWQgPj0gT05FX1lFQVIgPyBPTkVfWUVBUiA6IGVsYXBzZWQKICAgIGZyYW1lX2RpZyA0CiAgICBkdXAKICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgPj0KICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgc3dhcAogICAgc2VsZWN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjE3MAogICAgLy8gcmV0dXJuICh0aW1lQ2FwcGVkICogbWF4U2NvcmUpIC8gT05FX1lFQVIKICAgICoKICAgIGludGMgNiAvLyAzMTUzNjAwMAogICAgLwogICAgZnJhbWVfYnVyeSA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjExOQogICAgLy8gY29uc3Qgc3Rha2VkQWt0YUltcGFjdCA9IHRoaXMuZ2V0U3Rha2luZ0ltcGFjdFNjb3JlKGFjY291bnQpIC8vIFN0YWtlZCBBS1RBIHwgdXAgdG8gMjUwCiAgICBiIHVzZXJJbXBhY3RfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5nZXRTdGFraW5nSW1wYWN0U2NvcmVANDQKCnVzZXJJbXBhY3RfdGVybmFyeV9mYWxzZUA0MjoKICAgIGZyYW1lX2RpZyAxMgogICAgYiB1c2VySW1wYWN0X3Rlcm5hcnlfbWVyZ2VANDMKCgovLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjpBa2l0YVNvY2lhbEltcGFjdC5uZmRSZWFkRmllbGQoTkZEQXBwOiB1aW50NjQsIGZpZWxkOiBieXRlcykgLT4gYnl0ZXM6Cm5mZFJlYWRGaWVsZDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjIwCiAgICAvLyBwcml2YXRlIG5mZFJlYWRGaWVsZChORkRBcHA6IEFwcGxpY2F0aW9uLCBmaWVsZDogc3RyaW5nKTogYnl0ZXMgewogICAgcHJvdG8gMiAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc29jaWFsL2ltcGFjdC5hbGdvLnRzOjIyMS0yMjQKICAgIC8vIGNvbnN0IGZpZWxkQnl0ZXMgPSBhYmlDYWxsPHR5cGVvZiBORkQucHJvdG90eXBlLnJlYWRGaWVsZD4oewogICAgLy8gICBhcHBJZDogTkZEQXBwLmlkLAogICAgLy8gICBhcmdzOiBbQnl0ZXMoZmllbGQpXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjIzCiAgICAvLyBhcmdzOiBbQnl0ZXMoZmllbGQpXQogICAgZnJhbWVfZGlnIC0xCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBmcmFtZV9kaWcgLTEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3NvY2lhbC9pbXBhY3QuYWxnby50czoyMjEtMjI0CiAgICAvLyBjb25zdCBmaWVsZEJ5dGVzID0gYWJpQ2FsbDx0eXBlb2YgTkZELnByb3RvdHlwZS5yZWFkRmllbGQ+KHsKICAgIC8vICAgYXBwSWQ6IE5GREFwcC5pZCwKICAgIC8vICAgYXJnczogW0J5dGVzKGZpZWxkKV0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBieXRlYyA2IC8vIG1ldGhvZCAicmVhZEZpZWxkKGJ5dGVbXSlieXRlW10iCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50OFtdKQogICAgZXh0cmFjdCA2IDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zb2NpYWwvaW1wYWN0LmFsZ28udHM6MjI2CiAgICAvLyByZXR1cm4gZmllbGRCeXRlcwogICAgcmV0c3ViCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAIAAEIIICglKWNHYCgt4fpBYDnhA+AyK+gJSYMCWFraXRhX2RhbwQVH3x1AW0AA3NhbANvYWwEbBPt5A1pLnRpbWVDaGFuZ2VkIAKFOI1cdZtjzefl3qW2zV53vdSb7JUOFNBW8pBwqDiXBndhbGxldANhYWwMYWtpdGFfYXNzZXRzMRkURDEYQQA7ggcEJ+O7TwSddPWhBPgce2IE1XS7EAS27GpdBDPpLJQEhU3t4DYaAI4HADsDJgNqA30DkAOjAAEAI0OABG+YF/Y2GgCOAQABADYaAUkVJBJEFzYaAkkiWYECCEsBFRJEVwIAgAd2ZXJzaW9uTGcoTGcjQyJHBStHAzYaAUkVJRJENhoCSRUkEkQXSTYaA0lOAkkVJBJEF0w2GgRJFSQSRBdMMQAiKGVEJwRlSCJbcghEEkRBAApLBEsEiAM7IlNEIkUHIkUISwFBAllLAUmABmkubmFtZWVIsSIoZUQnBWVIJFtLARUWVwYCTwJQgARL4i/GshqyGksEshqyGIEGshAisgGztD5JVwQATFcABCkSREkVIxJEIlNEsScGshqADwANdi5jYUFsZ28uMC5hc7IashiBBrIQIrIBs7Q+SVcEAEsBVwAEKRJESSJZgQIITBUSRFcGAEUOIkUJSw0VSUUHSwkNQQHySwhJSwdJTgMPSwFLA08CTUwlCElFDElLAw9MTwNPAk1JSwIMSwJMTUsPTgJSSUUQMgMTQf++Sw5LBRJB/7YjREsBSScHZUgXRQiBMkUJgA1pLnBhcmVudEFwcElEZUxFDUEAFksLFyIoZUQnBWVIgShbEkEABIFkRQhLAYAFaS52ZXJlSEkVIksBDyJLAk8CTYECSwIPgQJPA08CTVKAAjMuE0ABFksBSYAIdi5kb21haW6IBK5LAYAJdi50d2l0dGVyiASeSwKACXYuZGlzY29yZIgEjk8DgAp2LnRlbGVncmFtiAR9SwMVFlcGAk8EUEkVJAhLBBUWVwYCTwVQSwEWVwYCgAIACExQSwEVTwMISwUVFlcGAk8GUEsBFlcGAk8DTFBLARVPAwhLBRUWVwYCTwZQTBZXBgJPAkxQTwRQTwNQTwJQTFBJIllLAYECWUsCTwJLAlJXAgBLAoEEWUsDTwNLAlJXAgBFDUsCgQZZSwNPAksCUlcCAEUQSwIVTwNOAlJXAgBFDBVBAAdLB4EKCEUISwkVQQAHSweBFAhFCEsMFUEAB0sHgQoIRQhLChVBAAdLB4EKCEUISUEAEEcCcQtEJwgSREsFTHAASERLAxZLAhZQSwcWUEsIFkxLAVBLAhZQKksHUEy/KUxQsCNDIkL+STEWIwlJOBAjEkQ2GgFJFSQSRBc2GgJJFSQSRBcxACIoZUQnCWVIcghEEkRMFoABc0xQTBa/STgHMgoSTDgIgdRIEhBDNhoBSRUlEkQiiADoFilMULAjQzYaAUkVJRJEI4gA1RYpTFCwI0M2GgFJFSUSRCpMUL5EKUxQsCNDNhoBSRUkEkQXMQAiKGVEJwllSHIIRBJEKExnI0OKAgGxIihlRCcKZUglW4v/Fov+TFCABCW4EqeyGrIashiBBrIQIrIBs7Q+RwJXBABMVwAEKRJESRWBaRJEIlNAABWAEQAAAAAAAAAAAAAAAAAAAAAATImLAElXBSAiKGVEcghEEksBgSVbMgdJSwSBLVsJSwSBPVtMSwEYCAlLA4FVWwxPAksCEBBPAlddCIABACJPA1RPAhZQTFBMiYoCASJJK0cKIkcCKov+UEm9RQFBABKLEL5ESSJbjA1JJFuMDiVbjA8iKGVEJwtlSCJbSYwCQAFxIowIIowJi/6LDYj/HUmMASJTQQAiiwFJVwEIgAFzTFC+RBdMgQlbSYwLgQwPQQE1gfoBTAqMCYv/QQEjsSIoZUQnBGVIIluABOhpk02yGov+shqyGIEGshAisgGztD5JVwQATFcABCkSREkVJBJEF4wHiw5BAOKLEL5ESSRbSwGBEFtPAoEYW0sCJwdlSBeLDk8EEkRPAhJEjAYiKGVEJwtlSCJbSYwCQACDIowFiw9BAHeLD0lxA0RJFSJLAQ8iSwJPAk2BA0sCD4EDTwNPAk1SjACL/ksBcABIjANxC0QnCBJBAECLA0EAO4sAgANBS0MSQQAggTKLCIsJCIsHCIsGCIsFCAhJjApAAAQjjACJiwqMAImLAIADQU9HEkEABYEZQv/SIkL/ziJC/8qL/osCcABISYwDIQcMQQAGIowFQv9qiwNJIQQPIQRMTYEyCyEECowFQv9VIowGQv88IowHQv8NiwuBFAtMCowJQv7GsSIoZUQnCmVIIluLAhaAARRQgATJBogJshqL/rIashqyGIEGshAisgGztD5JVwQASwFXAAQpEkQVgRgSRDIHSwGBDFsJjASBBFtJjAwhBwxAAAuLBIGAmp4BDEEABiKMCEL+LosMIQUPQQAcIQWB+gELIQUKiwRJIQYPIQZMTQshBgqMCEL+CosMQv/higIBsYv/FRZXBgKL/1AnBrIashqL/rIYgQayECKyAbO0PklXBABLAVcABCkSREkiWYECCEwVEkRXBgCJ\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "0b4d03f079b10059c2ade4ed50d89ee4ae43b661a40068e48b0a589bef30f89b"
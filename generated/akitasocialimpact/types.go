@@ -18,67 +18,67 @@ type ImpactMetaValue struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao uint64
-	Version  string
+	AkitaDao uint64 `json:"akitaDAO"`
+	Version  string `json:"version"`
 }
 
 // CacheMetaArgs holds the arguments for the cacheMeta method.
 type CacheMetaArgs struct {
-	Address           types.Address
-	SubscriptionIndex uint64
-	NfdAppID          uint64
-	AkitaAssetID      uint64
+	Address           types.Address `json:"address"`
+	SubscriptionIndex uint64        `json:"subscriptionIndex"`
+	NfdAppID          uint64        `json:"NFDAppID"`
+	AkitaAssetID      uint64        `json:"akitaAssetID"`
 }
 
 // CacheMetaMethodResult holds the result of calling cacheMeta.
 type CacheMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateSubscriptionStateModifierArgs holds the arguments for the updateSubscriptionStateModifier method.
 type UpdateSubscriptionStateModifierArgs struct {
-	Payment           transaction.TransactionWithSigner
-	SubscriptionIndex uint64
-	NewModifier       uint64
+	Payment           transaction.TransactionWithSigner `json:"-"`
+	SubscriptionIndex uint64                            `json:"subscriptionIndex"`
+	NewModifier       uint64                            `json:"newModifier"`
 }
 
 // GetUserImpactWithoutSocialArgs holds the arguments for the getUserImpactWithoutSocial method.
 type GetUserImpactWithoutSocialArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GetUserImpactWithoutSocialMethodResult holds the result of calling getUserImpactWithoutSocial.
 type GetUserImpactWithoutSocialMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetUserImpactArgs holds the arguments for the getUserImpact method.
 type GetUserImpactArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GetUserImpactMethodResult holds the result of calling getUserImpact.
 type GetUserImpactMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetMetaArgs holds the arguments for the getMeta method.
 type GetMetaArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // GetMetaMethodResult holds the result of calling getMeta.
 type GetMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ImpactMetaValue
+	Return ImpactMetaValue `json:"return"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
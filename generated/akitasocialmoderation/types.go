@@ -7,109 +7,109 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// Action is a generated struct type.
+type Action struct {
+	Content [36]byte `json:"content"`
+}
+
 // ObjectAed1fa93 is a generated struct type.
 type ObjectAed1fa93 struct {
 	Exists     bool   `json:"exists"`
 	LastActive uint64 `json:"lastActive"`
 }
 
-// Action is a generated struct type.
-type Action struct {
-	Content [36]byte `json:"content"`
-}
-
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // AddModeratorArgs holds the arguments for the addModerator method.
 type AddModeratorArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Address    types.Address
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Address    types.Address                     `json:"address"`
 }
 
 // RemoveModeratorArgs holds the arguments for the removeModerator method.
 type RemoveModeratorArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // BanArgs holds the arguments for the ban method.
 type BanArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Address    types.Address
-	Expiration uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Address    types.Address                     `json:"address"`
+	Expiration uint64                            `json:"expiration"`
 }
 
 // UnbanArgs holds the arguments for the unban method.
 type UnbanArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // FlagPostArgs holds the arguments for the flagPost method.
 type FlagPostArgs struct {
-	Ref [32]byte
+	Ref [32]byte `json:"ref"`
 }
 
 // UnflagPostArgs holds the arguments for the unflagPost method.
 type UnflagPostArgs struct {
-	Ref [32]byte
+	Ref [32]byte `json:"ref"`
 }
 
 // AddActionArgs holds the arguments for the addAction method.
 type AddActionArgs struct {
-	MBRPayment  transaction.TransactionWithSigner
-	ActionAppID uint64
-	Content     [36]byte
+	MBRPayment  transaction.TransactionWithSigner `json:"-"`
+	ActionAppID uint64                            `json:"actionAppID"`
+	Content     [36]byte                          `json:"content"`
 }
 
 // RemoveActionArgs holds the arguments for the removeAction method.
 type RemoveActionArgs struct {
-	ActionAppID uint64
+	ActionAppID uint64 `json:"actionAppID"`
 }
 
 // IsBannedArgs holds the arguments for the isBanned method.
 type IsBannedArgs struct {
-	Account types.Address
+	Account types.Address `json:"account"`
 }
 
 // IsBannedMethodResult holds the result of calling isBanned.
 type IsBannedMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // IsModeratorArgs holds the arguments for the isModerator method.
 type IsModeratorArgs struct {
-	Account types.Address
+	Account types.Address `json:"account"`
 }
 
 // IsModeratorMethodResult holds the result of calling isModerator.
 type IsModeratorMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // ModeratorMetaArgs holds the arguments for the moderatorMeta method.
 type ModeratorMetaArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // ModeratorMetaMethodResult holds the result of calling moderatorMeta.
 type ModeratorMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ObjectAed1fa93
+	Return ObjectAed1fa93 `json:"return"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
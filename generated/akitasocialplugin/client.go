@@ -2,26 +2,342 @@
 package akitasocialplugin
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendPost calls the post ABI method.
+	SendPost(ctx context.Context, params algokit.CallParams[PostArgs]) error
+	// SendEditPost calls the editPost ABI method.
+	SendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) error
+	// SendGatedReply calls the gatedReply ABI method.
+	SendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) error
+	// SendReply calls the reply ABI method.
+	SendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) error
+	// SendGatedEditReply calls the gatedEditReply ABI method.
+	SendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) error
+	// SendEditReply calls the editReply ABI method.
+	SendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) error
+	// SendVote calls the vote ABI method.
+	SendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) error
+	// SendEditVote calls the editVote ABI method.
+	SendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) error
+	// SendGatedReact calls the gatedReact ABI method.
+	SendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) error
+	// SendReact calls the react ABI method.
+	SendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) error
+	// SendDeleteReaction calls the deleteReaction ABI method.
+	SendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) error
+	// SendGatedFollow calls the gatedFollow ABI method.
+	SendGatedFollow(ctx context.Context, params algokit.CallParams[GatedFollowArgs]) error
+	// SendFollow calls the follow ABI method.
+	SendFollow(ctx context.Context, params algokit.CallParams[FollowArgs]) error
+	// SendUnfollow calls the unfollow ABI method.
+	SendUnfollow(ctx context.Context, params algokit.CallParams[UnfollowArgs]) error
+	// SendBlock calls the block ABI method.
+	SendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) error
+	// SendUnblock calls the unblock ABI method.
+	SendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) error
+	// SendAddModerator calls the addModerator ABI method.
+	SendAddModerator(ctx context.Context, params algokit.CallParams[AddModeratorArgs]) error
+	// SendRemoveModerator calls the removeModerator ABI method.
+	SendRemoveModerator(ctx context.Context, params algokit.CallParams[RemoveModeratorArgs]) error
+	// SendBan calls the ban ABI method.
+	SendBan(ctx context.Context, params algokit.CallParams[BanArgs]) error
+	// SendFlagPost calls the flagPost ABI method.
+	SendFlagPost(ctx context.Context, params algokit.CallParams[FlagPostArgs]) error
+	// SendUnflagPost calls the unflagPost ABI method.
+	SendUnflagPost(ctx context.Context, params algokit.CallParams[UnflagPostArgs]) error
+	// SendUnban calls the unban ABI method.
+	SendUnban(ctx context.Context, params algokit.CallParams[UnbanArgs]) error
+	// SendAddAction calls the addAction ABI method.
+	SendAddAction(ctx context.Context, params algokit.CallParams[AddActionArgs]) error
+	// SendRemoveAction calls the removeAction ABI method.
+	SendRemoveAction(ctx context.Context, params algokit.CallParams[RemoveActionArgs]) error
+	// SendInitMeta calls the initMeta ABI method.
+	SendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error)
+	// SendUpdateMeta calls the updateMeta ABI method.
+	SendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) error
+	// SendUpdateAkitaDao calls the updateAkitaDAO ABI method.
+	SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendMBR calls the mbr ABI method.
+	SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error)
+	// SendPayWallMBR calls the payWallMbr ABI method.
+	SendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error)
+	// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method.
+	SendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error)
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the AkitaSocialPlugin smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +349,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("AkitaSocialPlugin has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,668 +398,660 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match AkitaSocialPlugin")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendPost calls the post ABI method and waits for confirmation.
+// SendPost calls the post ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendPost(ctx context.Context, params algokit.CallParams[PostArgs]) error {
-	methodArgs := argsToInterfacePost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "post",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPost(ctx context.Context, params algokit.CallParams[PostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Post(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditPost calls the editPost ABI method and waits for confirmation.
+// SendEditPost calls the editPost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) error {
-	methodArgs := argsToInterfaceEditPost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editPost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditPost(ctx context.Context, params algokit.CallParams[EditPostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditPost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedReply calls the gatedReply ABI method and waits for confirmation.
+// SendGatedReply calls the gatedReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) error {
-	methodArgs := argsToInterfaceGatedReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedReply(ctx context.Context, params algokit.CallParams[GatedReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendReply calls the reply ABI method and waits for confirmation.
+// SendReply calls the reply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) error {
-	methodArgs := argsToInterfaceReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "reply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendReply(ctx context.Context, params algokit.CallParams[ReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Reply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedEditReply calls the gatedEditReply ABI method and waits for confirmation.
+// SendGatedEditReply calls the gatedEditReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) error {
-	methodArgs := argsToInterfaceGatedEditReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedEditReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedEditReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedEditReply(ctx context.Context, params algokit.CallParams[GatedEditReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedEditReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditReply calls the editReply ABI method and waits for confirmation.
+// SendEditReply calls the editReply ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) error {
-	methodArgs := argsToInterfaceEditReply(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editReply",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditReply(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditReply(ctx context.Context, params algokit.CallParams[EditReplyArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditReply(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendVote calls the vote ABI method and waits for confirmation.
+// SendVote calls the vote ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) error {
-	methodArgs := argsToInterfaceVote(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "vote",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendVote(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendVote(ctx context.Context, params algokit.CallParams[VoteArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Vote(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendEditVote calls the editVote ABI method and waits for confirmation.
+// SendEditVote calls the editVote ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) error {
-	methodArgs := argsToInterfaceEditVote(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "editVote",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendEditVote(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendEditVote(ctx context.Context, params algokit.CallParams[EditVoteArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().EditVote(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedReact calls the gatedReact ABI method and waits for confirmation.
+// SendGatedReact calls the gatedReact ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) error {
-	methodArgs := argsToInterfaceGatedReact(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedReact",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedReact(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedReact(ctx context.Context, params algokit.CallParams[GatedReactArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedReact(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendReact calls the react ABI method and waits for confirmation.
+// SendReact calls the react ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) error {
-	methodArgs := argsToInterfaceReact(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "react",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendReact(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendReact(ctx context.Context, params algokit.CallParams[ReactArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().React(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteReaction calls the deleteReaction ABI method and waits for confirmation.
+// SendDeleteReaction calls the deleteReaction ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) error {
-	methodArgs := argsToInterfaceDeleteReaction(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteReaction",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteReaction(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteReaction(ctx context.Context, params algokit.CallParams[DeleteReactionArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteReaction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedFollow calls the gatedFollow ABI method and waits for confirmation.
+// SendGatedFollow calls the gatedFollow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedFollow(ctx context.Context, params algokit.CallParams[GatedFollowArgs]) error {
-	methodArgs := argsToInterfaceGatedFollow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedFollow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedFollow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedFollow(ctx context.Context, params algokit.CallParams[GatedFollowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedFollow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendFollow calls the follow ABI method and waits for confirmation.
+// SendFollow calls the follow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendFollow(ctx context.Context, params algokit.CallParams[FollowArgs]) error {
-	methodArgs := argsToInterfaceFollow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "follow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendFollow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendFollow(ctx context.Context, params algokit.CallParams[FollowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Follow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnfollow calls the unfollow ABI method and waits for confirmation.
+// SendUnfollow calls the unfollow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUnfollow(ctx context.Context, params algokit.CallParams[UnfollowArgs]) error {
-	methodArgs := argsToInterfaceUnfollow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unfollow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnfollow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnfollow(ctx context.Context, params algokit.CallParams[UnfollowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Unfollow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendBlock calls the block ABI method and waits for confirmation.
+// SendBlock calls the block ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) error {
-	methodArgs := argsToInterfaceBlock(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "block",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBlock(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Block(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnblock calls the unblock ABI method and waits for confirmation.
+// SendUnblock calls the unblock ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) error {
-	methodArgs := argsToInterfaceUnblock(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unblock",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnblock(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Unblock(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendAddModerator calls the addModerator ABI method and waits for confirmation.
+// SendAddModerator calls the addModerator ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendAddModerator(ctx context.Context, params algokit.CallParams[AddModeratorArgs]) error {
-	methodArgs := argsToInterfaceAddModerator(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "addModerator",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAddModerator(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAddModerator(ctx context.Context, params algokit.CallParams[AddModeratorArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AddModerator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRemoveModerator calls the removeModerator ABI method and waits for confirmation.
+// SendRemoveModerator calls the removeModerator ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRemoveModerator(ctx context.Context, params algokit.CallParams[RemoveModeratorArgs]) error {
-	methodArgs := argsToInterfaceRemoveModerator(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "removeModerator",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRemoveModerator(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRemoveModerator(ctx context.Context, params algokit.CallParams[RemoveModeratorArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RemoveModerator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendBan calls the ban ABI method and waits for confirmation.
+// SendBan calls the ban ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendBan(ctx context.Context, params algokit.CallParams[BanArgs]) error {
-	methodArgs := argsToInterfaceBan(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "ban",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBan(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBan(ctx context.Context, params algokit.CallParams[BanArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Ban(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendFlagPost calls the flagPost ABI method and waits for confirmation.
+// SendFlagPost calls the flagPost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendFlagPost(ctx context.Context, params algokit.CallParams[FlagPostArgs]) error {
-	methodArgs := argsToInterfaceFlagPost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "flagPost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendFlagPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendFlagPost(ctx context.Context, params algokit.CallParams[FlagPostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().FlagPost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnflagPost calls the unflagPost ABI method and waits for confirmation.
+// SendUnflagPost calls the unflagPost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUnflagPost(ctx context.Context, params algokit.CallParams[UnflagPostArgs]) error {
-	methodArgs := argsToInterfaceUnflagPost(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unflagPost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnflagPost(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnflagPost(ctx context.Context, params algokit.CallParams[UnflagPostArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UnflagPost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnban calls the unban ABI method and waits for confirmation.
+// SendUnban calls the unban ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUnban(ctx context.Context, params algokit.CallParams[UnbanArgs]) error {
-	methodArgs := argsToInterfaceUnban(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unban",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnban(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnban(ctx context.Context, params algokit.CallParams[UnbanArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Unban(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendAddAction calls the addAction ABI method and waits for confirmation.
+// SendAddAction calls the addAction ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendAddAction(ctx context.Context, params algokit.CallParams[AddActionArgs]) error {
-	methodArgs := argsToInterfaceAddAction(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "addAction",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAddAction(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAddAction(ctx context.Context, params algokit.CallParams[AddActionArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AddAction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRemoveAction calls the removeAction ABI method and waits for confirmation.
+// SendRemoveAction calls the removeAction ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRemoveAction(ctx context.Context, params algokit.CallParams[RemoveActionArgs]) error {
-	methodArgs := argsToInterfaceRemoveAction(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "removeAction",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRemoveAction(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRemoveAction(ctx context.Context, params algokit.CallParams[RemoveActionArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RemoveAction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendInitMeta calls the initMeta ABI method and waits for confirmation.
+// SendInitMeta calls the initMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error) {
-	methodArgs := argsToInterfaceInitMeta(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendInitMeta(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "initMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendInitMeta(ctx context.Context, params algokit.CallParams[InitMetaArgs]) (*InitMetaMethodResult, error) {
+	comp, err := c.NewGroup().InitMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*InitMetaMethodResult)
+	return typedResult, nil
+}
 
+// decodeInitMetaResult decodes the return value of a initMeta call.
+func (c *Client) decodeInitMetaResult(result *algokit.SendAppTransactionResult) (*InitMetaMethodResult, error) {
 	typedResult := &InitMetaMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -718,96 +1065,104 @@ func (c *Client) SendInitMeta(ctx context.Context, params algokit.CallParams[Ini
 	return typedResult, nil
 }
 
-// SendUpdateMeta calls the updateMeta ABI method and waits for confirmation.
+// SendUpdateMeta calls the updateMeta ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) error {
-	methodArgs := argsToInterfaceUpdateMeta(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateMeta",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateMeta(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateMeta(ctx context.Context, params algokit.CallParams[UpdateMetaArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateMeta(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation.
+// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDao(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAO",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendUpdateAkitaDao(ctx, params)
+	return err
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDao(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendMBR calls the mbr ABI method and waits for confirmation.
+// SendMBR calls the mbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
-	methodArgs := argsToInterfaceMBR(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mbr",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]) (*MBRMethodResult, error) {
+	comp, err := c.NewGroup().MBR(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MBRMethodResult)
+	return typedResult, nil
+}
 
+// decodeMBRResult decodes the return value of a mbr call.
+func (c *Client) decodeMBRResult(result *algokit.SendAppTransactionResult) (*MBRMethodResult, error) {
 	typedResult := &MBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -823,28 +1178,31 @@ func (c *Client) SendMBR(ctx context.Context, params algokit.CallParams[MBRArgs]
 	return typedResult, nil
 }
 
-// SendPayWallMBR calls the payWallMbr ABI method and waits for confirmation.
+// SendPayWallMBR calls the payWallMbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error) {
-	methodArgs := argsToInterfacePayWallMBR(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendPayWallMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "payWallMbr",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendPayWallMBR(ctx context.Context, params algokit.CallParams[PayWallMBRArgs]) (*PayWallMBRMethodResult, error) {
+	comp, err := c.NewGroup().PayWallMBR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*PayWallMBRMethodResult)
+	return typedResult, nil
+}
 
+// decodePayWallMBRResult decodes the return value of a payWallMbr call.
+func (c *Client) decodePayWallMBRResult(result *algokit.SendAppTransactionResult) (*PayWallMBRMethodResult, error) {
 	typedResult := &PayWallMBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -858,28 +1216,31 @@ func (c *Client) SendPayWallMBR(ctx context.Context, params algokit.CallParams[P
 	return typedResult, nil
 }
 
-// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method and waits for confirmation.
+// SendCheckTipMBRRequirements calls the checkTipMbrRequirements ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error) {
-	methodArgs := argsToInterfaceCheckTipMBRRequirements(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendCheckTipMBRRequirements(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "checkTipMbrRequirements",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendCheckTipMBRRequirements(ctx context.Context, params algokit.CallParams[CheckTipMBRRequirementsArgs]) (*CheckTipMBRRequirementsMethodResult, error) {
+	comp, err := c.NewGroup().CheckTipMBRRequirements(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*CheckTipMBRRequirementsMethodResult)
+	return typedResult, nil
+}
 
+// decodeCheckTipMBRRequirementsResult decodes the return value of a checkTipMbrRequirements call.
+func (c *Client) decodeCheckTipMBRRequirementsResult(result *algokit.SendAppTransactionResult) (*CheckTipMBRRequirementsMethodResult, error) {
 	typedResult := &CheckTipMBRRequirementsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1168,10 +1529,14 @@ func argsToInterfaceMBR(args MBRArgs) []interface{} {
 	}
 }
 
-func argsToInterfacePayWallMBR(args PayWallMBRArgs) []interface{} {
-	return []interface{}{
-		args.Paywall,
+func argsToInterfacePayWallMBR(args PayWallMBRArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Paywall); err != nil {
+		return nil, fmt.Errorf("invalid paywall argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceCheckTipMBRRequirements(args CheckTipMBRRequirementsArgs) []interface{} {
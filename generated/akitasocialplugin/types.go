@@ -21,296 +21,296 @@ type AkitaSocialMBRData struct {
 	Actions      uint64 `json:"actions"`
 }
 
-// ViewPayWallValue is a generated struct type.
-type ViewPayWallValue struct {
-	UserPayInfo  [][]interface{} `json:"userPayInfo"`
-	AgentPayInfo [][]interface{} `json:"agentPayInfo"`
-}
-
 // TipMBRInfo is a generated struct type.
 type TipMBRInfo struct {
 	Type  uint8  `json:"type"`
 	Arc58 uint64 `json:"arc58"`
 }
 
+// ViewPayWallValue is a generated struct type.
+type ViewPayWallValue struct {
+	UserPayInfo  []Tuple3[uint8, uint64, uint64] `json:"userPayInfo"`
+	AgentPayInfo []Tuple3[uint8, uint64, uint64] `json:"agentPayInfo"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
-	Escrow   uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
+	Escrow   uint64 `json:"escrow"`
 }
 
 // PostArgs holds the arguments for the post method.
 type PostArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	GateID     uint64
-	UsePayWall bool
-	PayWallID  uint64
+	Wallet     uint64   `json:"wallet"`
+	RekeyBack  bool     `json:"rekeyBack"`
+	Timestamp  uint64   `json:"timestamp"`
+	Nonce      [24]byte `json:"nonce"`
+	Cid        [36]byte `json:"cid"`
+	GateID     uint64   `json:"gateID"`
+	UsePayWall bool     `json:"usePayWall"`
+	PayWallID  uint64   `json:"payWallID"`
 }
 
 // EditPostArgs holds the arguments for the editPost method.
 type EditPostArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Cid       [36]byte
-	Amendment [32]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Cid       [36]byte `json:"cid"`
+	Amendment [32]byte `json:"amendment"`
 }
 
 // GatedReplyArgs holds the arguments for the gatedReply method.
 type GatedReplyArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	Ref        []byte
-	Type       uint8
-	GateID     uint64
-	Args       [][]byte
-	UsePayWall bool
-	PayWallID  uint64
+	Wallet     uint64   `json:"wallet"`
+	RekeyBack  bool     `json:"rekeyBack"`
+	Timestamp  uint64   `json:"timestamp"`
+	Nonce      [24]byte `json:"nonce"`
+	Cid        [36]byte `json:"cid"`
+	Ref        []byte   `json:"ref"`
+	Type       uint8    `json:"type"`
+	GateID     uint64   `json:"gateID"`
+	Args       [][]byte `json:"args"`
+	UsePayWall bool     `json:"usePayWall"`
+	PayWallID  uint64   `json:"payWallID"`
 }
 
 // ReplyArgs holds the arguments for the reply method.
 type ReplyArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Timestamp  uint64
-	Nonce      [24]byte
-	Cid        [36]byte
-	Ref        []byte
-	Type       uint8
-	GateID     uint64
-	UsePayWall bool
-	PayWallID  uint64
+	Wallet     uint64   `json:"wallet"`
+	RekeyBack  bool     `json:"rekeyBack"`
+	Timestamp  uint64   `json:"timestamp"`
+	Nonce      [24]byte `json:"nonce"`
+	Cid        [36]byte `json:"cid"`
+	Ref        []byte   `json:"ref"`
+	Type       uint8    `json:"type"`
+	GateID     uint64   `json:"gateID"`
+	UsePayWall bool     `json:"usePayWall"`
+	PayWallID  uint64   `json:"payWallID"`
 }
 
 // GatedEditReplyArgs holds the arguments for the gatedEditReply method.
 type GatedEditReplyArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Cid       [36]byte
-	Amendment [32]byte
-	Args      [][]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Cid       [36]byte `json:"cid"`
+	Amendment [32]byte `json:"amendment"`
+	Args      [][]byte `json:"args"`
 }
 
 // EditReplyArgs holds the arguments for the editReply method.
 type EditReplyArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Cid       [36]byte
-	Amendment [32]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Cid       [36]byte `json:"cid"`
+	Amendment [32]byte `json:"amendment"`
 }
 
 // VoteArgs holds the arguments for the vote method.
 type VoteArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       []byte
-	Type      uint8
-	IsUp      bool
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Ref       []byte `json:"ref"`
+	Type      uint8  `json:"type"`
+	IsUp      bool   `json:"isUp"`
 }
 
 // EditVoteArgs holds the arguments for the editVote method.
 type EditVoteArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       [32]byte
-	Flip      bool
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ref       [32]byte `json:"ref"`
+	Flip      bool     `json:"flip"`
 }
 
 // GatedReactArgs holds the arguments for the gatedReact method.
 type GatedReactArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       []byte
-	Type      uint8
-	NFT       uint64
-	Args      [][]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ref       []byte   `json:"ref"`
+	Type      uint8    `json:"type"`
+	NFT       uint64   `json:"NFT"`
+	Args      [][]byte `json:"args"`
 }
 
 // ReactArgs holds the arguments for the react method.
 type ReactArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       []byte
-	Type      uint8
-	NFT       uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Ref       []byte `json:"ref"`
+	Type      uint8  `json:"type"`
+	NFT       uint64 `json:"NFT"`
 }
 
 // DeleteReactionArgs holds the arguments for the deleteReaction method.
 type DeleteReactionArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       [32]byte
-	NFT       uint64
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ref       [32]byte `json:"ref"`
+	NFT       uint64   `json:"NFT"`
 }
 
 // GatedFollowArgs holds the arguments for the gatedFollow method.
 type GatedFollowArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
-	Args      [][]byte
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
+	Args      [][]byte      `json:"args"`
 }
 
 // FollowArgs holds the arguments for the follow method.
 type FollowArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // UnfollowArgs holds the arguments for the unfollow method.
 type UnfollowArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // BlockArgs holds the arguments for the block method.
 type BlockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // UnblockArgs holds the arguments for the unblock method.
 type UnblockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // AddModeratorArgs holds the arguments for the addModerator method.
 type AddModeratorArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // RemoveModeratorArgs holds the arguments for the removeModerator method.
 type RemoveModeratorArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // BanArgs holds the arguments for the ban method.
 type BanArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Address    types.Address
-	Expiration uint64
+	Wallet     uint64        `json:"wallet"`
+	RekeyBack  bool          `json:"rekeyBack"`
+	Address    types.Address `json:"address"`
+	Expiration uint64        `json:"expiration"`
 }
 
 // FlagPostArgs holds the arguments for the flagPost method.
 type FlagPostArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       [32]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ref       [32]byte `json:"ref"`
 }
 
 // UnflagPostArgs holds the arguments for the unflagPost method.
 type UnflagPostArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ref       [32]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ref       [32]byte `json:"ref"`
 }
 
 // UnbanArgs holds the arguments for the unban method.
 type UnbanArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // AddActionArgs holds the arguments for the addAction method.
 type AddActionArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	ActionAppID uint64
-	Content     [36]byte
+	Wallet      uint64   `json:"wallet"`
+	RekeyBack   bool     `json:"rekeyBack"`
+	ActionAppID uint64   `json:"actionAppID"`
+	Content     [36]byte `json:"content"`
 }
 
 // RemoveActionArgs holds the arguments for the removeAction method.
 type RemoveActionArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	ActionAppID uint64
+	Wallet      uint64 `json:"wallet"`
+	RekeyBack   bool   `json:"rekeyBack"`
+	ActionAppID uint64 `json:"actionAppID"`
 }
 
 // InitMetaArgs holds the arguments for the initMeta method.
 type InitMetaArgs struct {
-	Wallet            uint64
-	RekeyBack         bool
-	User              types.Address
-	Automated         bool
-	SubscriptionIndex uint64
-	Nfd               uint64
-	AkitaNFT          uint64
+	Wallet            uint64        `json:"wallet"`
+	RekeyBack         bool          `json:"rekeyBack"`
+	User              types.Address `json:"user"`
+	Automated         bool          `json:"automated"`
+	SubscriptionIndex uint64        `json:"subscriptionIndex"`
+	Nfd               uint64        `json:"NFD"`
+	AkitaNFT          uint64        `json:"akitaNFT"`
 }
 
 // InitMetaMethodResult holds the result of calling initMeta.
 type InitMetaMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateMetaArgs holds the arguments for the updateMeta method.
 type UpdateMetaArgs struct {
-	Wallet            uint64
-	RekeyBack         bool
-	FollowGateID      uint64
-	AddressGateID     uint64
-	SubscriptionIndex uint64
-	Nfd               uint64
-	AkitaNFT          uint64
-	DefaultPayWallID  uint64
+	Wallet            uint64 `json:"wallet"`
+	RekeyBack         bool   `json:"rekeyBack"`
+	FollowGateID      uint64 `json:"followGateID"`
+	AddressGateID     uint64 `json:"addressGateID"`
+	SubscriptionIndex uint64 `json:"subscriptionIndex"`
+	Nfd               uint64 `json:"NFD"`
+	AkitaNFT          uint64 `json:"akitaNFT"`
+	DefaultPayWallID  uint64 `json:"defaultPayWallID"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Ref []byte
+	Ref []byte `json:"ref"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AkitaSocialMBRData
+	Return AkitaSocialMBRData `json:"return"`
 }
 
 // PayWallMBRArgs holds the arguments for the payWallMbr method.
 type PayWallMBRArgs struct {
-	Paywall ViewPayWallValue
+	Paywall ViewPayWallValue `json:"paywall"`
 }
 
 // PayWallMBRMethodResult holds the result of calling payWallMbr.
 type PayWallMBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CheckTipMBRRequirementsArgs holds the arguments for the checkTipMbrRequirements method.
 type CheckTipMBRRequirementsArgs struct {
-	AkitaDao uint64
-	Creator  types.Address
-	Wallet   uint64
+	AkitaDao uint64        `json:"akitaDAO"`
+	Creator  types.Address `json:"creator"`
+	Wallet   uint64        `json:"wallet"`
 }
 
 // CheckTipMBRRequirementsMethodResult holds the result of calling checkTipMbrRequirements.
 type CheckTipMBRRequirementsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return TipMBRInfo
+	Return TipMBRInfo `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -3,19 +3,20 @@ package asamintplugin
 
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // MintArgs holds the arguments for the mint method.
 type MintArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Assets     [][]interface{}
-	MBRPayment transaction.TransactionWithSigner
+	Wallet     uint64                                                                                                              `json:"wallet"`
+	RekeyBack  bool                                                                                                                `json:"rekeyBack"`
+	Assets     []Tuple10[string, string, uint64, uint64, types.Address, types.Address, types.Address, types.Address, bool, string] `json:"assets"`
+	MBRPayment transaction.TransactionWithSigner                                                                                   `json:"-"`
 }
 
 // MintMethodResult holds the result of calling mint.
 type MintMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []uint64
+	Return []uint64 `json:"return"`
 }
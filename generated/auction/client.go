@@ -2,26 +2,326 @@
 package auction
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendInit calls the init ABI method.
+	SendInit(ctx context.Context, params algokit.CallParams[InitArgs]) error
+	// SendGatedBid calls the gatedBid ABI method.
+	SendGatedBid(ctx context.Context, params algokit.CallParams[GatedBidArgs]) error
+	// SendBid calls the bid ABI method.
+	SendBid(ctx context.Context, params algokit.CallParams[BidArgs]) error
+	// SendGatedBidASA calls the gatedBidAsa ABI method.
+	SendGatedBidASA(ctx context.Context, params algokit.CallParams[GatedBidASAArgs]) error
+	// SendBidASA calls the bidAsa ABI method.
+	SendBidASA(ctx context.Context, params algokit.CallParams[BidASAArgs]) error
+	// SendRefundBid calls the refundBid ABI method.
+	SendRefundBid(ctx context.Context, params algokit.CallParams[RefundBidArgs]) error
+	// SendRaffle calls the raffle ABI method.
+	SendRaffle(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendFindWinner calls the findWinner ABI method.
+	SendFindWinner(ctx context.Context, params algokit.CallParams[FindWinnerArgs]) error
+	// SendRefundMBR calls the refundMBR ABI method.
+	SendRefundMBR(ctx context.Context, params algokit.CallParams[RefundMBRArgs]) error
+	// SendClaimPrize calls the claimPrize ABI method.
+	SendClaimPrize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendClaimRafflePrize calls the claimRafflePrize ABI method.
+	SendClaimRafflePrize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendClearWeightsBoxes calls the clearWeightsBoxes ABI method.
+	SendClearWeightsBoxes(ctx context.Context, params algokit.CallParams[ClearWeightsBoxesArgs]) (*ClearWeightsBoxesMethodResult, error)
+	// SendIsLive calls the isLive ABI method (readonly).
+	SendIsLive(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*IsLiveMethodResult, error)
+	// SendHasBid calls the hasBid ABI method (readonly).
+	SendHasBid(ctx context.Context, params algokit.CallParams[HasBidArgs]) (*HasBidMethodResult, error)
+	// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method.
+	SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error
+	// SendUpdateAkitaDao calls the updateAkitaDAO ABI method.
+	SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendOptin calls the optin ABI method.
+	SendOptin(ctx context.Context, params algokit.CallParams[OptinArgs]) error
+	// SendMBR calls the mbr ABI method (readonly).
+	SendMBR(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*MBRMethodResult, error)
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapBids reads the value for key in the bids box map.
+	GetBoxMapBids(ctx context.Context, key uint64) (BidInfo, error)
+	// GetBoxMapBidsByAddress reads the value for key in the bidsByAddress box map.
+	GetBoxMapBidsByAddress(ctx context.Context, key types.Address) (uint64, error)
+	// GetBoxMapLocations reads the value for key in the locations box map.
+	GetBoxMapLocations(ctx context.Context, key uint64) (types.Address, error)
+	// GetBoxMapWeights reads the value for key in the weights box map.
+	GetBoxMapWeights(ctx context.Context, key uint64) ([4096]uint64, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the Auction smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +333,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("Auction has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,300 +382,351 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match Auction")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendInit calls the init ABI method and waits for confirmation.
+// SendInit calls the init ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendInit(ctx context.Context, params algokit.CallParams[InitArgs]) error {
-	methodArgs := argsToInterfaceInit(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "init",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendInit(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendInit(ctx context.Context, params algokit.CallParams[InitArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Init(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedBid calls the gatedBid ABI method and waits for confirmation.
+// SendGatedBid calls the gatedBid ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedBid(ctx context.Context, params algokit.CallParams[GatedBidArgs]) error {
-	methodArgs := argsToInterfaceGatedBid(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedBid",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedBid(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedBid(ctx context.Context, params algokit.CallParams[GatedBidArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedBid(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendBid calls the bid ABI method and waits for confirmation.
+// SendBid calls the bid ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendBid(ctx context.Context, params algokit.CallParams[BidArgs]) error {
-	methodArgs := argsToInterfaceBid(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "bid",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBid(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBid(ctx context.Context, params algokit.CallParams[BidArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Bid(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedBidASA calls the gatedBidAsa ABI method and waits for confirmation.
+// SendGatedBidASA calls the gatedBidAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedBidASA(ctx context.Context, params algokit.CallParams[GatedBidASAArgs]) error {
-	methodArgs := argsToInterfaceGatedBidASA(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedBidAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedBidASA(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedBidASA(ctx context.Context, params algokit.CallParams[GatedBidASAArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedBidASA(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendBidASA calls the bidAsa ABI method and waits for confirmation.
+// SendBidASA calls the bidAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendBidASA(ctx context.Context, params algokit.CallParams[BidASAArgs]) error {
-	methodArgs := argsToInterfaceBidASA(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "bidAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBidASA(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBidASA(ctx context.Context, params algokit.CallParams[BidASAArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().BidASA(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRefundBid calls the refundBid ABI method and waits for confirmation.
+// SendRefundBid calls the refundBid ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRefundBid(ctx context.Context, params algokit.CallParams[RefundBidArgs]) error {
-	methodArgs := argsToInterfaceRefundBid(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "refundBid",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendRefundBid(ctx, params)
+	return err
 }
 
-// SendRaffle calls the raffle ABI method and waits for confirmation.
-func (c *Client) SendRaffle(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendRefundBid(ctx context.Context, params algokit.CallParams[RefundBidArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RefundBid(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "raffle",
-		MethodArgs: methodArgs,
-	})
+// SendRaffle calls the raffle ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendRaffle(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRaffle(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRaffle(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Raffle(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendFindWinner calls the findWinner ABI method and waits for confirmation.
+// SendFindWinner calls the findWinner ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendFindWinner(ctx context.Context, params algokit.CallParams[FindWinnerArgs]) error {
-	methodArgs := argsToInterfaceFindWinner(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "findWinner",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendFindWinner(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendFindWinner(ctx context.Context, params algokit.CallParams[FindWinnerArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().FindWinner(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRefundMBR calls the refundMBR ABI method and waits for confirmation.
+// SendRefundMBR calls the refundMBR ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRefundMBR(ctx context.Context, params algokit.CallParams[RefundMBRArgs]) error {
-	methodArgs := argsToInterfaceRefundMBR(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "refundMBR",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendRefundMBR(ctx, params)
+	return err
 }
 
-// SendClaimPrize calls the claimPrize ABI method and waits for confirmation.
-func (c *Client) SendClaimPrize(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendRefundMBR(ctx context.Context, params algokit.CallParams[RefundMBRArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().RefundMBR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "claimPrize",
-		MethodArgs: methodArgs,
-	})
+// SendClaimPrize calls the claimPrize ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendClaimPrize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendClaimPrize(ctx, params)
+	return err
 }
 
-// SendClaimRafflePrize calls the claimRafflePrize ABI method and waits for confirmation.
-func (c *Client) SendClaimRafflePrize(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendClaimPrize(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ClaimPrize(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "claimRafflePrize",
-		MethodArgs: methodArgs,
-	})
+// SendClaimRafflePrize calls the claimRafflePrize ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendClaimRafflePrize(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendClaimRafflePrize(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendClaimRafflePrize(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ClaimRafflePrize(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendClearWeightsBoxes calls the clearWeightsBoxes ABI method and waits for confirmation.
+// SendClearWeightsBoxes calls the clearWeightsBoxes ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendClearWeightsBoxes(ctx context.Context, params algokit.CallParams[ClearWeightsBoxesArgs]) (*ClearWeightsBoxesMethodResult, error) {
-	methodArgs := argsToInterfaceClearWeightsBoxes(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendClearWeightsBoxes(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "clearWeightsBoxes",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendClearWeightsBoxes(ctx context.Context, params algokit.CallParams[ClearWeightsBoxesArgs]) (*ClearWeightsBoxesMethodResult, error) {
+	comp, err := c.NewGroup().ClearWeightsBoxes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ClearWeightsBoxesMethodResult)
+	return typedResult, nil
+}
 
+// decodeClearWeightsBoxesResult decodes the return value of a clearWeightsBoxes call.
+func (c *Client) decodeClearWeightsBoxesResult(result *algokit.SendAppTransactionResult) (*ClearWeightsBoxesMethodResult, error) {
 	typedResult := &ClearWeightsBoxesMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -350,18 +740,32 @@ func (c *Client) SendClearWeightsBoxes(ctx context.Context, params algokit.CallP
 	return typedResult, nil
 }
 
-// SendIsLive calls the isLive ABI method and waits for confirmation.
-func (c *Client) SendIsLive(ctx context.Context) (*IsLiveMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendIsLive calls the isLive ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendIsLive(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*IsLiveMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendIsLive(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "isLive",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendIsLive(ctx context.Context, params algokit.CallParams[struct{}]) (*IsLiveMethodResult, error) {
+	comp, err := c.NewGroup().IsLive(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*IsLiveMethodResult)
+	return typedResult, nil
+}
 
+// decodeIsLiveResult decodes the return value of a isLive call.
+func (c *Client) decodeIsLiveResult(result *algokit.SendAppTransactionResult) (*IsLiveMethodResult, error) {
 	typedResult := &IsLiveMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -375,28 +779,31 @@ func (c *Client) SendIsLive(ctx context.Context) (*IsLiveMethodResult, error) {
 	return typedResult, nil
 }
 
-// SendHasBid calls the hasBid ABI method and waits for confirmation.
+// SendHasBid calls the hasBid ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendHasBid(ctx context.Context, params algokit.CallParams[HasBidArgs]) (*HasBidMethodResult, error) {
-	methodArgs := argsToInterfaceHasBid(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendHasBid(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "hasBid",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendHasBid(ctx context.Context, params algokit.CallParams[HasBidArgs]) (*HasBidMethodResult, error) {
+	comp, err := c.NewGroup().HasBid(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*HasBidMethodResult)
+	return typedResult, nil
+}
 
+// decodeHasBidResult decodes the return value of a hasBid call.
+func (c *Client) decodeHasBidResult(result *algokit.SendAppTransactionResult) (*HasBidMethodResult, error) {
 	typedResult := &HasBidMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -410,113 +817,130 @@ func (c *Client) SendHasBid(ctx context.Context, params algokit.CallParams[HasBi
 	return typedResult, nil
 }
 
-// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation.
+// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDaoEscrow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAOEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateAkitaDaoEscrow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDaoEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation.
+// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDao(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAO",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendUpdateAkitaDao(ctx, params)
+	return err
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDao(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendOptin calls the optin ABI method and waits for confirmation.
+// SendOptin calls the optin ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // optin tells the contract to opt into an asa
 func (c *Client) SendOptin(ctx context.Context, params algokit.CallParams[OptinArgs]) error {
-	methodArgs := argsToInterfaceOptin(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "optin",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOptin(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOptin(ctx context.Context, params algokit.CallParams[OptinArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Optin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendMBR calls the mbr ABI method and waits for confirmation.
-func (c *Client) SendMBR(ctx context.Context) (*MBRMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendMBR calls the mbr ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendMBR(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*MBRMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMBR(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "mbr",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendMBR(ctx context.Context, params algokit.CallParams[struct{}]) (*MBRMethodResult, error) {
+	comp, err := c.NewGroup().MBR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MBRMethodResult)
+	return typedResult, nil
+}
 
+// decodeMBRResult decodes the return value of a mbr call.
+func (c *Client) decodeMBRResult(result *algokit.SendAppTransactionResult) (*MBRMethodResult, error) {
 	typedResult := &MBRMethodResult{
 		SendAppTransactionResult: *result,
 	}
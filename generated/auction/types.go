@@ -13,12 +13,6 @@ type AkitaConfig struct {
 	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
-// FunderInfo is a generated struct type.
-type FunderInfo struct {
-	Account types.Address `json:"account"`
-	Amount  uint64        `json:"amount"`
-}
-
 // AuctionMBRData is a generated struct type.
 type AuctionMBRData struct {
 	Bids          uint64 `json:"bids"`
@@ -41,127 +35,133 @@ type FindWinnerCursors struct {
 	CurrentRangeStart uint64 `json:"currentRangeStart"`
 }
 
+// FunderInfo is a generated struct type.
+type FunderInfo struct {
+	Account types.Address `json:"account"`
+	Amount  uint64        `json:"amount"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Prize              uint64
-	IsPrizeBox         bool
-	BidAsset           uint64
-	BidFee             uint64
-	StartingBid        uint64
-	BidMinimumIncrease uint64
-	StartTimestamp     uint64
-	EndTimestamp       uint64
-	Funder             FunderInfo
-	Seller             types.Address
-	CreatorRoyalty     uint64
-	GateID             uint64
-	Marketplace        types.Address
-	Version            string
-	AkitaConfig        AkitaConfig
+	Prize              uint64        `json:"prize"`
+	IsPrizeBox         bool          `json:"isPrizeBox"`
+	BidAsset           uint64        `json:"bidAsset"`
+	BidFee             uint64        `json:"bidFee"`
+	StartingBid        uint64        `json:"startingBid"`
+	BidMinimumIncrease uint64        `json:"bidMinimumIncrease"`
+	StartTimestamp     uint64        `json:"startTimestamp"`
+	EndTimestamp       uint64        `json:"endTimestamp"`
+	Funder             FunderInfo    `json:"funder"`
+	Seller             types.Address `json:"seller"`
+	CreatorRoyalty     uint64        `json:"creatorRoyalty"`
+	GateID             uint64        `json:"gateID"`
+	Marketplace        types.Address `json:"marketplace"`
+	Version            string        `json:"version"`
+	AkitaConfig        AkitaConfig   `json:"akitaConfig"`
 }
 
 // InitArgs holds the arguments for the init method.
 type InitArgs struct {
-	Payment          transaction.TransactionWithSigner
-	WeightListLength uint64
+	Payment          transaction.TransactionWithSigner `json:"-"`
+	WeightListLength uint64                            `json:"weightListLength"`
 }
 
 // GatedBidArgs holds the arguments for the gatedBid method.
 type GatedBidArgs struct {
-	Payment     transaction.TransactionWithSigner
-	GateTXN     transaction.TransactionWithSigner
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	GateTXN     transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // BidArgs holds the arguments for the bid method.
 type BidArgs struct {
-	Payment     transaction.TransactionWithSigner
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // GatedBidASAArgs holds the arguments for the gatedBidAsa method.
 type GatedBidASAArgs struct {
-	Payment     transaction.TransactionWithSigner
-	AssetXfer   transaction.TransactionWithSigner
-	GateTXN     transaction.TransactionWithSigner
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	GateTXN     transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // BidASAArgs holds the arguments for the bidAsa method.
 type BidASAArgs struct {
-	Payment     transaction.TransactionWithSigner
-	AssetXfer   transaction.TransactionWithSigner
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // RefundBidArgs holds the arguments for the refundBid method.
 type RefundBidArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // FindWinnerArgs holds the arguments for the findWinner method.
 type FindWinnerArgs struct {
-	IterationAmount uint64
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // RefundMBRArgs holds the arguments for the refundMBR method.
 type RefundMBRArgs struct {
-	IterationAmount uint64
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // ClearWeightsBoxesArgs holds the arguments for the clearWeightsBoxes method.
 type ClearWeightsBoxesArgs struct {
-	IterationAmount uint64
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // ClearWeightsBoxesMethodResult holds the result of calling clearWeightsBoxes.
 type ClearWeightsBoxesMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // IsLiveMethodResult holds the result of calling isLive.
 type IsLiveMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // HasBidArgs holds the arguments for the hasBid method.
 type HasBidArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // HasBidMethodResult holds the result of calling hasBid.
 type HasBidMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OptinArgs holds the arguments for the optin method.
 type OptinArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AuctionMBRData
+	Return AuctionMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -4,6 +4,7 @@ package auctionfactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	auction "github.com/kylebeee/algokit-client-generator-go/generated/auction"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -17,127 +18,151 @@ type AuctionMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	ChildVersion   string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	ChildVersion   string `json:"childVersion"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // NewAuctionArgs holds the arguments for the newAuction method.
 type NewAuctionArgs struct {
-	Payment            transaction.TransactionWithSigner
-	AssetXfer          transaction.TransactionWithSigner
-	Name               string
-	Proof              [][32]byte
-	BidAssetID         uint64
-	BidFee             uint64
-	StartingBid        uint64
-	BidMinimumIncrease uint64
-	StartTimestamp     uint64
-	EndTimestamp       uint64
-	GateID             uint64
-	Marketplace        types.Address
-	WeightsListCount   uint64
+	Payment            transaction.TransactionWithSigner `json:"-"`
+	AssetXfer          transaction.TransactionWithSigner `json:"-"`
+	Name               string                            `json:"name"`
+	Proof              [][32]byte                        `json:"proof"`
+	BidAssetID         uint64                            `json:"bidAssetID"`
+	BidFee             uint64                            `json:"bidFee"`
+	StartingBid        uint64                            `json:"startingBid"`
+	BidMinimumIncrease uint64                            `json:"bidMinimumIncrease"`
+	StartTimestamp     uint64                            `json:"startTimestamp"`
+	EndTimestamp       uint64                            `json:"endTimestamp"`
+	GateID             uint64                            `json:"gateID"`
+	Marketplace        types.Address                     `json:"marketplace"`
+	WeightsListCount   uint64                            `json:"weightsListCount"`
 }
 
 // NewAuctionMethodResult holds the result of calling newAuction.
 type NewAuctionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the auction app created by newAuction,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewAuctionMethodResult) Child() (*auction.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return auction.NewClientFromSpec(params)
 }
 
 // NewPrizeBoxAuctionArgs holds the arguments for the newPrizeBoxAuction method.
 type NewPrizeBoxAuctionArgs struct {
-	Payment            transaction.TransactionWithSigner
-	PrizeBoxID         uint64
-	BidAssetID         uint64
-	BidFee             uint64
-	StartingBid        uint64
-	BidMinimumIncrease uint64
-	StartTimestamp     uint64
-	EndTimestamp       uint64
-	GateID             uint64
-	Marketplace        types.Address
-	WeightsListCount   uint64
+	Payment            transaction.TransactionWithSigner `json:"-"`
+	PrizeBoxID         uint64                            `json:"prizeBoxID"`
+	BidAssetID         uint64                            `json:"bidAssetID"`
+	BidFee             uint64                            `json:"bidFee"`
+	StartingBid        uint64                            `json:"startingBid"`
+	BidMinimumIncrease uint64                            `json:"bidMinimumIncrease"`
+	StartTimestamp     uint64                            `json:"startTimestamp"`
+	EndTimestamp       uint64                            `json:"endTimestamp"`
+	GateID             uint64                            `json:"gateID"`
+	Marketplace        types.Address                     `json:"marketplace"`
+	WeightsListCount   uint64                            `json:"weightsListCount"`
 }
 
 // NewPrizeBoxAuctionMethodResult holds the result of calling newPrizeBoxAuction.
 type NewPrizeBoxAuctionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the auction app created by newPrizeBoxAuction,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewPrizeBoxAuctionMethodResult) Child() (*auction.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return auction.NewClientFromSpec(params)
 }
 
 // DeleteAuctionAppArgs holds the arguments for the deleteAuctionApp method.
 type DeleteAuctionAppArgs struct {
-	AppID uint64
+	AppID uint64 `json:"appId"`
 }
 
 // CancelAuctionArgs holds the arguments for the cancelAuction method.
 type CancelAuctionArgs struct {
-	AppID uint64
+	AppID uint64 `json:"appId"`
 }
 
 // NewAuctionCostArgs holds the arguments for the newAuctionCost method.
 type NewAuctionCostArgs struct {
-	IsPrizeBox       bool
-	BidAssetID       uint64
-	WeightsListCount uint64
+	IsPrizeBox       bool   `json:"isPrizeBox"`
+	BidAssetID       uint64 `json:"bidAssetID"`
+	WeightsListCount uint64 `json:"weightsListCount"`
 }
 
 // NewAuctionCostMethodResult holds the result of calling newAuctionCost.
 type NewAuctionCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AuctionMBRData
+	Return AuctionMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -16,121 +16,121 @@ type AuctionMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	Factory  uint64
-	AkitaDao uint64
+	Version  string `json:"version"`
+	Factory  uint64 `json:"factory"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // NewArgs holds the arguments for the new method.
 type NewArgs struct {
-	Wallet             uint64
-	RekeyBack          bool
-	PrizeID            uint64
-	PrizeAmount        uint64
-	Name               string
-	Proof              [][32]byte
-	BidAssetID         uint64
-	BidFee             uint64
-	StartingBid        uint64
-	BidMinimumIncrease uint64
-	StartTimestamp     uint64
-	Endtimestamp       uint64
-	GateID             uint64
-	Marketplace        types.Address
-	WeightsListCount   uint64
+	Wallet             uint64        `json:"wallet"`
+	RekeyBack          bool          `json:"rekeyBack"`
+	PrizeID            uint64        `json:"prizeID"`
+	PrizeAmount        uint64        `json:"prizeAmount"`
+	Name               string        `json:"name"`
+	Proof              [][32]byte    `json:"proof"`
+	BidAssetID         uint64        `json:"bidAssetID"`
+	BidFee             uint64        `json:"bidFee"`
+	StartingBid        uint64        `json:"startingBid"`
+	BidMinimumIncrease uint64        `json:"bidMinimumIncrease"`
+	StartTimestamp     uint64        `json:"startTimestamp"`
+	Endtimestamp       uint64        `json:"endtimestamp"`
+	GateID             uint64        `json:"gateID"`
+	Marketplace        types.Address `json:"marketplace"`
+	WeightsListCount   uint64        `json:"weightsListCount"`
 }
 
 // NewMethodResult holds the result of calling new.
 type NewMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // ClearWeightsBoxesArgs holds the arguments for the clearWeightsBoxes method.
 type ClearWeightsBoxesArgs struct {
-	Wallet          uint64
-	RekeyBack       bool
-	AuctionAppID    uint64
-	IterationAmount uint64
+	Wallet          uint64 `json:"wallet"`
+	RekeyBack       bool   `json:"rekeyBack"`
+	AuctionAppID    uint64 `json:"auctionAppID"`
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // DeleteAuctionAppArgs holds the arguments for the deleteAuctionApp method.
 type DeleteAuctionAppArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // BidArgs holds the arguments for the bid method.
 type BidArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	AppID       uint64
-	Amount      uint64
-	Args        [][]byte
-	Marketplace types.Address
+	Wallet      uint64        `json:"wallet"`
+	RekeyBack   bool          `json:"rekeyBack"`
+	AppID       uint64        `json:"appId"`
+	Amount      uint64        `json:"amount"`
+	Args        [][]byte      `json:"args"`
+	Marketplace types.Address `json:"marketplace"`
 }
 
 // RefundBidArgs holds the arguments for the refundBid method.
 type RefundBidArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	ID        uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	ID        uint64 `json:"id"`
 }
 
 // ClaimPrizeArgs holds the arguments for the claimPrize method.
 type ClaimPrizeArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // ClaimRafflePrizeArgs holds the arguments for the claimRafflePrize method.
 type ClaimRafflePrizeArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // RaffleArgs holds the arguments for the raffle method.
 type RaffleArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // FindWinnerArgs holds the arguments for the findWinner method.
 type FindWinnerArgs struct {
-	Wallet          uint64
-	RekeyBack       bool
-	AuctionAppID    uint64
-	IterationAmount uint64
+	Wallet          uint64 `json:"wallet"`
+	RekeyBack       bool   `json:"rekeyBack"`
+	AuctionAppID    uint64 `json:"auctionAppID"`
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // DeleteApplicationArgs holds the arguments for the deleteApplication method.
 type DeleteApplicationArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // CancelArgs holds the arguments for the cancel method.
 type CancelArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return AuctionMBRData
+	Return AuctionMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
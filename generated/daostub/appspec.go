@@ -2,4 +2,11 @@
 package daostub
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"DAOStub\",\"arcs\":[22,28],\"methods\":[{\"name\":\"isValidUpgrade\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"lease\"},{\"type\":\"uint64\",\"name\":\"appBeingUpgraded\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{},\"state\":{\"schema\":{\"global\":{\"ints\":0,\"bytes\":0},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTUKICAgIC8vIGNsYXNzIERBT1N0dWIgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gTnVtQXBwQXJncwogICAgYnogbWFpbl9fX2FsZ290c19fLmRlZmF1bHRDcmVhdGVANQogICAgcHVzaGJ5dGVzIDB4MmMyZjUxYzMgLy8gbWV0aG9kICJpc1ZhbGlkVXBncmFkZShieXRlWzMyXSx1aW50NjQpYm9vbCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5faXNWYWxpZFVwZ3JhZGVfcm91dGVAMwogICAgZXJyCgptYWluX2lzVmFsaWRVcGdyYWRlX3JvdXRlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYKICAgIC8vIGlzVmFsaWRVcGdyYWRlKGxlYXNlOiBieXRlczwzMj4sIGFwcEJlaW5nVXBncmFkZWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcCAmJiBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBiIGlzVmFsaWRVcGdyYWRlCgptYWluX19fYWxnb3RzX18uZGVmYXVsdENyZWF0ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvdXBkYXRlLWFraXRhLWRhby9jb250cmFjdC5hbGdvLnRzOjE1CiAgICAvLyBjbGFzcyBEQU9TdHViIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICEKICAgICYmCiAgICByZXR1cm4gLy8gb24gZXJyb3I6IE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AgJiYgY2FuIG9ubHkgY2FsbCB3aGVuIGNyZWF0aW5nCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvdXBkYXRlLWFraXRhLWRhby9jb250cmFjdC5hbGdvLnRzOjpEQU9TdHViLmlzVmFsaWRVcGdyYWRlW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNWYWxpZFVwZ3JhZGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYKICAgIC8vIGlzVmFsaWRVcGdyYWRlKGxlYXNlOiBieXRlczwzMj4sIGFwcEJlaW5nVXBncmFkZWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGxlbgogICAgcHVzaGludCA4IC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgcHVzaGJ5dGVzIDB4MTUxZjdjNzUwMAogICAgbG9nCiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CzEbQQAYgAQsL1HDNhoAjgEAAQAxGRQxGBBEQgAIMRkUMRgUEEM2GgEVgSASRDYaAhWBCBJEgAUVH3x1ALCBAUM=\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[37],\"errorMessage\":\"OnCompletion must be NoOp \\u0026\\u0026 can only call when creating\"},{\"pc\":[26],\"errorMessage\":\"OnCompletion must be NoOp \\u0026\\u0026 can only call when not creating\"},{\"pc\":[53],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[45],\"errorMessage\":\"invalid number of bytes for uint8[32]\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"DAOStub\",\"structs\":{},\"methods\":[{\"name\":\"isValidUpgrade\",\"args\":[{\"type\":\"byte[32]\",\"name\":\"lease\"},{\"type\":\"uint64\",\"name\":\"appBeingUpgraded\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{}}},\"bareActions\":{\"create\":[\"NoOp\"],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTUKICAgIC8vIGNsYXNzIERBT1N0dWIgZXh0ZW5kcyBDb250cmFjdCB7CiAgICB0eG4gTnVtQXBwQXJncwogICAgYnogbWFpbl9fX2FsZ290c19fLmRlZmF1bHRDcmVhdGVANQogICAgcHVzaGJ5dGVzIDB4MmMyZjUxYzMgLy8gbWV0aG9kICJpc1ZhbGlkVXBncmFkZShieXRlWzMyXSx1aW50NjQpYm9vbCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIG1haW5faXNWYWxpZFVwZ3JhZGVfcm91dGVAMwogICAgZXJyCgptYWluX2lzVmFsaWRVcGdyYWRlX3JvdXRlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYKICAgIC8vIGlzVmFsaWRVcGdyYWRlKGxlYXNlOiBieXRlczwzMj4sIGFwcEJlaW5nVXBncmFkZWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcCAmJiBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBiIGlzVmFsaWRVcGdyYWRlCgptYWluX19fYWxnb3RzX18uZGVmYXVsdENyZWF0ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvdXBkYXRlLWFraXRhLWRhby9jb250cmFjdC5hbGdvLnRzOjE1CiAgICAvLyBjbGFzcyBEQU9TdHViIGV4dGVuZHMgQ29udHJhY3QgewogICAgdHhuIE9uQ29tcGxldGlvbgogICAgIQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICEKICAgICYmCiAgICByZXR1cm4gLy8gb24gZXJyb3I6IE9uQ29tcGxldGlvbiBtdXN0IGJlIE5vT3AgJiYgY2FuIG9ubHkgY2FsbCB3aGVuIGNyZWF0aW5nCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvdXBkYXRlLWFraXRhLWRhby9jb250cmFjdC5hbGdvLnRzOjpEQU9TdHViLmlzVmFsaWRVcGdyYWRlW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNWYWxpZFVwZ3JhZGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy91cGRhdGUtYWtpdGEtZGFvL2NvbnRyYWN0LmFsZ28udHM6MTYKICAgIC8vIGlzVmFsaWRVcGdyYWRlKGxlYXNlOiBieXRlczwzMj4sIGFwcEJlaW5nVXBncmFkZWQ6IHVpbnQ2NCk6IGJvb2xlYW4gewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGxlbgogICAgcHVzaGludCA4IC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgcHVzaGJ5dGVzIDB4MTUxZjdjNzUwMAogICAgbG9nCiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CzEbQQAYgAQsL1HDNhoAjgEAAQAxGRQxGBBEQgAIMRkUMRgUEEM2GgEVgSASRDYaAhWBCBJEgAUVH3x1ALCBAUM=\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "8381fd3538a01d0f90f0cd82db805353b62d831ed3f4112a60b057470df0dbec"
@@ -7,12 +7,12 @@ import (
 
 // IsValidUpgradeArgs holds the arguments for the isValidUpgrade method.
 type IsValidUpgradeArgs struct {
-	Lease            [32]byte
-	AppBeingUpgraded uint64
+	Lease            [32]byte `json:"lease"`
+	AppBeingUpgraded uint64   `json:"appBeingUpgraded"`
 }
 
 // IsValidUpgradeMethodResult holds the result of calling isValidUpgrade.
 type IsValidUpgradeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
@@ -7,22 +7,22 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Registry uint64
+	Registry uint64 `json:"registry"`
 }
 
 // MintArgs holds the arguments for the mint method.
 type MintArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Amount    uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Amount    uint64 `json:"amount"`
 }
 
 // RedeemArgs holds the arguments for the redeem method.
 type RedeemArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -8,12 +8,12 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Creator []byte
+	Creator []byte `json:"creator"`
 }
 
 // RekeyArgs holds the arguments for the rekey method.
 type RekeyArgs struct {
-	RekeyTo types.Address
+	RekeyTo types.Address `json:"rekeyTo"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
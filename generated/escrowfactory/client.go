@@ -2,26 +2,300 @@
 package escrowfactory
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendNew calls the new ABI method.
+	SendNew(ctx context.Context, params algokit.CallParams[NewArgs]) (*NewMethodResult, error)
+	// SendRegister calls the register ABI method.
+	SendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) error
+	// SendDelete calls the delete ABI method.
+	SendDelete(ctx context.Context, params algokit.CallParams[DeleteArgs]) error
+	// SendCost calls the cost ABI method (readonly).
+	SendCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*CostMethodResult, error)
+	// SendRegisterCost calls the registerCost ABI method (readonly).
+	SendRegisterCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RegisterCostMethodResult, error)
+	// SendExists calls the exists ABI method (readonly).
+	SendExists(ctx context.Context, params algokit.CallParams[ExistsArgs]) (*ExistsMethodResult, error)
+	// SendGet calls the get ABI method (readonly).
+	SendGet(ctx context.Context, params algokit.CallParams[GetArgs]) (*GetMethodResult, error)
+	// SendMustGet calls the mustGet ABI method (readonly).
+	SendMustGet(ctx context.Context, params algokit.CallParams[MustGetArgs]) (*MustGetMethodResult, error)
+	// SendGetList calls the getList ABI method (readonly).
+	SendGetList(ctx context.Context, params algokit.CallParams[GetListArgs]) (*GetListMethodResult, error)
+	// SendMustGetList calls the mustGetList ABI method (readonly).
+	SendMustGetList(ctx context.Context, params algokit.CallParams[MustGetListArgs]) (*MustGetListMethodResult, error)
+	// GetBoxMapWalletIDsByAccounts reads the value for key in the walletIDsByAccounts box map.
+	GetBoxMapWalletIDsByAccounts(ctx context.Context, key []byte) ([]byte, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the EscrowFactory smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +307,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("EscrowFactory has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,46 +356,87 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match EscrowFactory")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendNew calls the new ABI method and waits for confirmation.
+// SendNew calls the new ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendNew(ctx context.Context, params algokit.CallParams[NewArgs]) (*NewMethodResult, error) {
-	methodArgs := argsToInterfaceNew(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "new",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendNew(ctx, params)
+}
+
+func (c *Client) sendNew(ctx context.Context, params algokit.CallParams[NewArgs]) (*NewMethodResult, error) {
+	comp, err := c.NewGroup().New(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*NewMethodResult)
+	return typedResult, nil
+}
 
+// decodeNewResult decodes the return value of a new call.
+func (c *Client) decodeNewResult(result *algokit.SendAppTransactionResult) (*NewMethodResult, error) {
 	typedResult := &NewMethodResult{
 		SendAppTransactionResult: *result,
+		childParams:              c.params,
 	}
 
 	if result.ABIReturn != nil {
@@ -94,70 +448,80 @@ func (c *Client) SendNew(ctx context.Context, params algokit.CallParams[NewArgs]
 	return typedResult, nil
 }
 
-// SendRegister calls the register ABI method and waits for confirmation.
+// SendRegister calls the register ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) error {
-	methodArgs := argsToInterfaceRegister(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "register",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendRegister(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendRegister(ctx context.Context, params algokit.CallParams[RegisterArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Register(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDelete calls the delete ABI method and waits for confirmation.
+// SendDelete calls the delete ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDelete(ctx context.Context, params algokit.CallParams[DeleteArgs]) error {
-	methodArgs := argsToInterfaceDelete(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "delete",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDelete(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDelete(ctx context.Context, params algokit.CallParams[DeleteArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Delete(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendCost calls the cost ABI method and waits for confirmation.
-func (c *Client) SendCost(ctx context.Context) (*CostMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendCost calls the cost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*CostMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "cost",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendCost(ctx context.Context, params algokit.CallParams[struct{}]) (*CostMethodResult, error) {
+	comp, err := c.NewGroup().Cost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*CostMethodResult)
+	return typedResult, nil
+}
 
+// decodeCostResult decodes the return value of a cost call.
+func (c *Client) decodeCostResult(result *algokit.SendAppTransactionResult) (*CostMethodResult, error) {
 	typedResult := &CostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -171,18 +535,32 @@ func (c *Client) SendCost(ctx context.Context) (*CostMethodResult, error) {
 	return typedResult, nil
 }
 
-// SendRegisterCost calls the registerCost ABI method and waits for confirmation.
-func (c *Client) SendRegisterCost(ctx context.Context) (*RegisterCostMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendRegisterCost calls the registerCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendRegisterCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*RegisterCostMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRegisterCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "registerCost",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendRegisterCost(ctx context.Context, params algokit.CallParams[struct{}]) (*RegisterCostMethodResult, error) {
+	comp, err := c.NewGroup().RegisterCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*RegisterCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeRegisterCostResult decodes the return value of a registerCost call.
+func (c *Client) decodeRegisterCostResult(result *algokit.SendAppTransactionResult) (*RegisterCostMethodResult, error) {
 	typedResult := &RegisterCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -196,28 +574,31 @@ func (c *Client) SendRegisterCost(ctx context.Context) (*RegisterCostMethodResul
 	return typedResult, nil
 }
 
-// SendExists calls the exists ABI method and waits for confirmation.
+// SendExists calls the exists ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendExists(ctx context.Context, params algokit.CallParams[ExistsArgs]) (*ExistsMethodResult, error) {
-	methodArgs := argsToInterfaceExists(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "exists",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendExists(ctx, params)
+}
+
+func (c *Client) sendExists(ctx context.Context, params algokit.CallParams[ExistsArgs]) (*ExistsMethodResult, error) {
+	comp, err := c.NewGroup().Exists(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ExistsMethodResult)
+	return typedResult, nil
+}
 
+// decodeExistsResult decodes the return value of a exists call.
+func (c *Client) decodeExistsResult(result *algokit.SendAppTransactionResult) (*ExistsMethodResult, error) {
 	typedResult := &ExistsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -231,28 +612,31 @@ func (c *Client) SendExists(ctx context.Context, params algokit.CallParams[Exist
 	return typedResult, nil
 }
 
-// SendGet calls the get ABI method and waits for confirmation.
+// SendGet calls the get ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGet(ctx context.Context, params algokit.CallParams[GetArgs]) (*GetMethodResult, error) {
-	methodArgs := argsToInterfaceGet(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "get",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	return c.sendGet(ctx, params)
+}
 
+func (c *Client) sendGet(ctx context.Context, params algokit.CallParams[GetArgs]) (*GetMethodResult, error) {
+	comp, err := c.NewGroup().Get(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetMethodResult)
+	return typedResult, nil
+}
+
+// decodeGetResult decodes the return value of a get call.
+func (c *Client) decodeGetResult(result *algokit.SendAppTransactionResult) (*GetMethodResult, error) {
 	typedResult := &GetMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -266,28 +650,31 @@ func (c *Client) SendGet(ctx context.Context, params algokit.CallParams[GetArgs]
 	return typedResult, nil
 }
 
-// SendMustGet calls the mustGet ABI method and waits for confirmation.
+// SendMustGet calls the mustGet ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGet(ctx context.Context, params algokit.CallParams[MustGetArgs]) (*MustGetMethodResult, error) {
-	methodArgs := argsToInterfaceMustGet(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGet",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGet(ctx, params)
+}
+
+func (c *Client) sendMustGet(ctx context.Context, params algokit.CallParams[MustGetArgs]) (*MustGetMethodResult, error) {
+	comp, err := c.NewGroup().MustGet(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MustGetMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetResult decodes the return value of a mustGet call.
+func (c *Client) decodeMustGetResult(result *algokit.SendAppTransactionResult) (*MustGetMethodResult, error) {
 	typedResult := &MustGetMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -301,28 +688,31 @@ func (c *Client) SendMustGet(ctx context.Context, params algokit.CallParams[Must
 	return typedResult, nil
 }
 
-// SendGetList calls the getList ABI method and waits for confirmation.
+// SendGetList calls the getList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetList(ctx context.Context, params algokit.CallParams[GetListArgs]) (*GetListMethodResult, error) {
-	methodArgs := argsToInterfaceGetList(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return nil, err
 	}
+	return c.sendGetList(ctx, params)
+}
 
+func (c *Client) sendGetList(ctx context.Context, params algokit.CallParams[GetListArgs]) (*GetListMethodResult, error) {
+	comp, err := c.NewGroup().GetList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetListMethodResult)
+	return typedResult, nil
+}
+
+// decodeGetListResult decodes the return value of a getList call.
+func (c *Client) decodeGetListResult(result *algokit.SendAppTransactionResult) (*GetListMethodResult, error) {
 	typedResult := &GetListMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -336,28 +726,31 @@ func (c *Client) SendGetList(ctx context.Context, params algokit.CallParams[GetL
 	return typedResult, nil
 }
 
-// SendMustGetList calls the mustGetList ABI method and waits for confirmation.
+// SendMustGetList calls the mustGetList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetList(ctx context.Context, params algokit.CallParams[MustGetListArgs]) (*MustGetListMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetList(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetList(ctx, params)
+}
+
+func (c *Client) sendMustGetList(ctx context.Context, params algokit.CallParams[MustGetListArgs]) (*MustGetListMethodResult, error) {
+	comp, err := c.NewGroup().MustGetList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MustGetListMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetListResult decodes the return value of a mustGetList call.
+func (c *Client) decodeMustGetListResult(result *algokit.SendAppTransactionResult) (*MustGetListMethodResult, error) {
 	typedResult := &MustGetListMethodResult{
 		SendAppTransactionResult: *result,
 	}
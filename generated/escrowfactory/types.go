@@ -4,94 +4,107 @@ package escrowfactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	escrow "github.com/kylebeee/algokit-client-generator-go/generated/escrow"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // NewArgs holds the arguments for the new method.
 type NewArgs struct {
-	Payment transaction.TransactionWithSigner
+	Payment transaction.TransactionWithSigner `json:"-"`
 }
 
 // NewMethodResult holds the result of calling new.
 type NewMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the escrow app created by new,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewMethodResult) Child() (*escrow.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return escrow.NewClientFromSpec(params)
 }
 
 // RegisterArgs holds the arguments for the register method.
 type RegisterArgs struct {
-	Payment transaction.TransactionWithSigner
-	App     uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	App     uint64                            `json:"app"`
 }
 
 // DeleteArgs holds the arguments for the delete method.
 type DeleteArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // CostMethodResult holds the result of calling cost.
 type CostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // RegisterCostMethodResult holds the result of calling registerCost.
 type RegisterCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // ExistsArgs holds the arguments for the exists method.
 type ExistsArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // ExistsMethodResult holds the result of calling exists.
 type ExistsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetArgs holds the arguments for the get method.
 type GetArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GetMethodResult holds the result of calling get.
 type GetMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
 
 // MustGetArgs holds the arguments for the mustGet method.
 type MustGetArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // MustGetMethodResult holds the result of calling mustGet.
 type MustGetMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
 
 // GetListArgs holds the arguments for the getList method.
 type GetListArgs struct {
-	Addresses []types.Address
+	Addresses []types.Address `json:"addresses"`
 }
 
 // GetListMethodResult holds the result of calling getList.
 type GetListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]byte
+	Return [][]byte `json:"return"`
 }
 
 // MustGetListArgs holds the arguments for the mustGetList method.
 type MustGetListArgs struct {
-	Addresses []types.Address
+	Addresses []types.Address `json:"addresses"`
 }
 
 // MustGetListMethodResult holds the result of calling mustGetList.
 type MustGetListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]byte
+	Return [][]byte `json:"return"`
 }
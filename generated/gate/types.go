@@ -18,91 +18,91 @@ type GateFilterEntryWithArgs struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // RegisterArgs holds the arguments for the register method.
 type RegisterArgs struct {
-	Payment transaction.TransactionWithSigner
-	Filters [][]interface{}
-	Args    [][]byte
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Filters []Tuple3[uint64, uint64, uint8]   `json:"filters"`
+	Args    [][]byte                          `json:"args"`
 }
 
 // RegisterMethodResult holds the result of calling register.
 type RegisterMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CheckArgs holds the arguments for the check method.
 type CheckArgs struct {
-	Caller types.Address
-	GateID uint64
-	Args   [][]byte
+	Caller types.Address `json:"caller"`
+	GateID uint64        `json:"gateID"`
+	Args   [][]byte      `json:"args"`
 }
 
 // CheckMethodResult holds the result of calling check.
 type CheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // MustCheckArgs holds the arguments for the mustCheck method.
 type MustCheckArgs struct {
-	Caller types.Address
-	GateID uint64
-	Args   [][]byte
+	Caller types.Address `json:"caller"`
+	GateID uint64        `json:"gateID"`
+	Args   [][]byte      `json:"args"`
 }
 
 // CostArgs holds the arguments for the cost method.
 type CostArgs struct {
-	Filters [][]interface{}
-	Args    [][]byte
+	Filters []Tuple3[uint64, uint64, uint8] `json:"filters"`
+	Args    [][]byte                        `json:"args"`
 }
 
 // CostMethodResult holds the result of calling cost.
 type CostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // SizeArgs holds the arguments for the size method.
 type SizeArgs struct {
-	GateID uint64
+	GateID uint64 `json:"gateID"`
 }
 
 // SizeMethodResult holds the result of calling size.
 type SizeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetGateArgs holds the arguments for the getGate method.
 type GetGateArgs struct {
-	GateID uint64
+	GateID uint64 `json:"gateID"`
 }
 
 // GetGateMethodResult holds the result of calling getGate.
 type GetGateMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple5[uint64, uint64, uint64, uint8, []byte] `json:"return"`
 }
 
 // GateFilterEntryWithArgsShapeArgs holds the arguments for the gateFilterEntryWithArgsShape method.
 type GateFilterEntryWithArgsShapeArgs struct {
-	Shape GateFilterEntryWithArgs
+	Shape GateFilterEntryWithArgs `json:"shape"`
 }
 
 // GateFilterEntryWithArgsShapeMethodResult holds the result of calling gateFilterEntryWithArgsShape.
 type GateFilterEntryWithArgsShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return GateFilterEntryWithArgs
+	Return GateFilterEntryWithArgs `json:"return"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
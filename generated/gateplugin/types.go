@@ -7,15 +7,15 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	GateAppID uint64
+	GateAppID uint64 `json:"gateAppID"`
 }
 
 // RegisterArgs holds the arguments for the register method.
 type RegisterArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Filters   [][]interface{}
-	Args      [][]byte
+	Wallet    uint64                          `json:"wallet"`
+	RekeyBack bool                            `json:"rekeyBack"`
+	Filters   []Tuple3[uint64, uint64, uint8] `json:"filters"`
+	Args      [][]byte                        `json:"args"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -7,12 +7,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// Object57cb3c34 is a generated struct type.
-type Object57cb3c34 struct {
-	Root uint64 `json:"root"`
-	Data uint64 `json:"data"`
-}
-
 // HashKey is a generated struct type.
 type HashKey struct {
 	ID   uint64   `json:"id"`
@@ -27,6 +21,12 @@ type HyperSwapMBRData struct {
 	Mm           Object57cb3c34 `json:"mm"`
 }
 
+// Object57cb3c34 is a generated struct type.
+type Object57cb3c34 struct {
+	Root uint64 `json:"root"`
+	Data uint64 `json:"data"`
+}
+
 // OfferValue is a generated struct type.
 type OfferValue struct {
 	State              uint8    `json:"state"`
@@ -53,97 +53,97 @@ type RefundValue struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OfferArgs holds the arguments for the offer method.
 type OfferArgs struct {
-	Payment            transaction.TransactionWithSigner
-	Root               [32]byte
-	Leaves             uint64
-	ParticipantsRoot   [32]byte
-	ParticipantsLeaves uint64
-	Expiration         uint64
+	Payment            transaction.TransactionWithSigner `json:"-"`
+	Root               [32]byte                          `json:"root"`
+	Leaves             uint64                            `json:"leaves"`
+	ParticipantsRoot   [32]byte                          `json:"participantsRoot"`
+	ParticipantsLeaves uint64                            `json:"participantsLeaves"`
+	Expiration         uint64                            `json:"expiration"`
 }
 
 // AcceptArgs holds the arguments for the accept method.
 type AcceptArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	ID         uint64
-	Proof      [][32]byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	ID         uint64                            `json:"id"`
+	Proof      [][32]byte                        `json:"proof"`
 }
 
 // EscrowArgs holds the arguments for the escrow method.
 type EscrowArgs struct {
-	Payment  transaction.TransactionWithSigner
-	ID       uint64
-	Receiver types.Address
-	Amount   uint64
-	Proof    [][32]byte
+	Payment  transaction.TransactionWithSigner `json:"-"`
+	ID       uint64                            `json:"id"`
+	Receiver types.Address                     `json:"receiver"`
+	Amount   uint64                            `json:"amount"`
+	Proof    [][32]byte                        `json:"proof"`
 }
 
 // EscrowASAArgs holds the arguments for the escrowAsa method.
 type EscrowASAArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	AssetXfer  transaction.TransactionWithSigner
-	ID         uint64
-	Receiver   types.Address
-	Asset      uint64
-	Amount     uint64
-	Proof      [][32]byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	AssetXfer  transaction.TransactionWithSigner `json:"-"`
+	ID         uint64                            `json:"id"`
+	Receiver   types.Address                     `json:"receiver"`
+	Asset      uint64                            `json:"asset"`
+	Amount     uint64                            `json:"amount"`
+	Proof      [][32]byte                        `json:"proof"`
 }
 
 // DisburseArgs holds the arguments for the disburse method.
 type DisburseArgs struct {
-	ID             uint64
-	ReceiverWallet uint64
-	Receiver       types.Address
-	Asset          uint64
-	Amount         uint64
+	ID             uint64        `json:"id"`
+	ReceiverWallet uint64        `json:"receiverWallet"`
+	Receiver       types.Address `json:"receiver"`
+	Asset          uint64        `json:"asset"`
+	Amount         uint64        `json:"amount"`
 }
 
 // CancelArgs holds the arguments for the cancel method.
 type CancelArgs struct {
-	ID    uint64
-	Proof [][32]byte
+	ID    uint64     `json:"id"`
+	Proof [][32]byte `json:"proof"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	ID       uint64
-	Receiver types.Address
-	Asset    uint64
-	Amount   uint64
-	Proof    [][32]byte
+	ID       uint64        `json:"id"`
+	Receiver types.Address `json:"receiver"`
+	Asset    uint64        `json:"asset"`
+	Amount   uint64        `json:"amount"`
+	Proof    [][32]byte    `json:"proof"`
 }
 
 // CleanupParticipantArgs holds the arguments for the cleanupParticipant method.
 type CleanupParticipantArgs struct {
-	ID          uint64
-	Participant types.Address
+	ID          uint64        `json:"id"`
+	Participant types.Address `json:"participant"`
 }
 
 // CleanupOfferArgs holds the arguments for the cleanupOffer method.
 type CleanupOfferArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return HyperSwapMBRData
+	Return HyperSwapMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -6,12 +6,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// Object57cb3c34 is a generated struct type.
-type Object57cb3c34 struct {
-	Root uint64 `json:"root"`
-	Data uint64 `json:"data"`
-}
-
 // HyperSwapMBRData is a generated struct type.
 type HyperSwapMBRData struct {
 	Offers       uint64         `json:"offers"`
@@ -20,81 +14,87 @@ type HyperSwapMBRData struct {
 	Mm           Object57cb3c34 `json:"mm"`
 }
 
+// Object57cb3c34 is a generated struct type.
+type Object57cb3c34 struct {
+	Root uint64 `json:"root"`
+	Data uint64 `json:"data"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OfferArgs holds the arguments for the offer method.
 type OfferArgs struct {
-	Wallet            uint64
-	RekeyBack         bool
-	Root              [32]byte
-	Leaves            uint64
-	ParticipantsRoot  [32]byte
-	ParticipantLeaves uint64
-	Expiration        uint64
+	Wallet            uint64   `json:"wallet"`
+	RekeyBack         bool     `json:"rekeyBack"`
+	Root              [32]byte `json:"root"`
+	Leaves            uint64   `json:"leaves"`
+	ParticipantsRoot  [32]byte `json:"participantsRoot"`
+	ParticipantLeaves uint64   `json:"participantLeaves"`
+	Expiration        uint64   `json:"expiration"`
 }
 
 // AcceptArgs holds the arguments for the accept method.
 type AcceptArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Proof     [][32]byte
+	Wallet    uint64     `json:"wallet"`
+	RekeyBack bool       `json:"rekeyBack"`
+	ID        uint64     `json:"id"`
+	Proof     [][32]byte `json:"proof"`
 }
 
 // EscrowArgs holds the arguments for the escrow method.
 type EscrowArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Receiver  types.Address
-	Asset     uint64
-	Amount    uint64
-	Proof     [][32]byte
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	ID        uint64        `json:"id"`
+	Receiver  types.Address `json:"receiver"`
+	Asset     uint64        `json:"asset"`
+	Amount    uint64        `json:"amount"`
+	Proof     [][32]byte    `json:"proof"`
 }
 
 // DisburseArgs holds the arguments for the disburse method.
 type DisburseArgs struct {
-	Wallet         uint64
-	RekeyBack      bool
-	ID             uint64
-	ReceiverWallet uint64
-	Receiver       types.Address
-	Asset          uint64
-	Amount         uint64
+	Wallet         uint64        `json:"wallet"`
+	RekeyBack      bool          `json:"rekeyBack"`
+	ID             uint64        `json:"id"`
+	ReceiverWallet uint64        `json:"receiverWallet"`
+	Receiver       types.Address `json:"receiver"`
+	Asset          uint64        `json:"asset"`
+	Amount         uint64        `json:"amount"`
 }
 
 // CancelArgs holds the arguments for the cancel method.
 type CancelArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Proof     [][32]byte
+	Wallet    uint64     `json:"wallet"`
+	RekeyBack bool       `json:"rekeyBack"`
+	ID        uint64     `json:"id"`
+	Proof     [][32]byte `json:"proof"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Receiver  types.Address
-	Asset     uint64
-	Amount    uint64
-	Proof     [][32]byte
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	ID        uint64        `json:"id"`
+	Receiver  types.Address `json:"receiver"`
+	Asset     uint64        `json:"asset"`
+	Amount    uint64        `json:"amount"`
+	Proof     [][32]byte    `json:"proof"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return HyperSwapMBRData
+	Return HyperSwapMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
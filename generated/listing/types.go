@@ -15,54 +15,54 @@ type FunderInfo struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Prize          uint64
-	IsPrizeBox     bool
-	Price          uint64
-	PaymentAsset   uint64
-	Expiration     uint64
-	Seller         types.Address
-	Funder         FunderInfo
-	ReservedFor    types.Address
-	CreatorRoyalty uint64
-	GateID         uint64
-	Marketplace    types.Address
-	Version        string
-	AkitaDao       uint64
+	Prize          uint64        `json:"prize"`
+	IsPrizeBox     bool          `json:"isPrizeBox"`
+	Price          uint64        `json:"price"`
+	PaymentAsset   uint64        `json:"paymentAsset"`
+	Expiration     uint64        `json:"expiration"`
+	Seller         types.Address `json:"seller"`
+	Funder         FunderInfo    `json:"funder"`
+	ReservedFor    types.Address `json:"reservedFor"`
+	CreatorRoyalty uint64        `json:"creatorRoyalty"`
+	GateID         uint64        `json:"gateID"`
+	Marketplace    types.Address `json:"marketplace"`
+	Version        string        `json:"version"`
+	AkitaDao       uint64        `json:"akitaDAO"`
 }
 
 // PurchaseArgs holds the arguments for the purchase method.
 type PurchaseArgs struct {
-	Payment     transaction.TransactionWithSigner
-	Buyer       types.Address
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	Buyer       types.Address                     `json:"buyer"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // PurchaseASAArgs holds the arguments for the purchaseAsa method.
 type PurchaseASAArgs struct {
-	AssetXfer   transaction.TransactionWithSigner
-	Buyer       types.Address
-	Marketplace types.Address
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	Buyer       types.Address                     `json:"buyer"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // DelistArgs holds the arguments for the delist method.
 type DelistArgs struct {
-	Caller types.Address
+	Caller types.Address `json:"caller"`
 }
 
 // ChangePriceArgs holds the arguments for the changePrice method.
 type ChangePriceArgs struct {
-	Price uint64
+	Price uint64 `json:"price"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OptinArgs holds the arguments for the optin method.
 type OptinArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
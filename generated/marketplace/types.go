@@ -9,127 +9,127 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	ChildVersion   string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	ChildVersion   string `json:"childVersion"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // ListArgs holds the arguments for the list method.
 type ListArgs struct {
-	Payment      transaction.TransactionWithSigner
-	AssetXfer    transaction.TransactionWithSigner
-	Price        uint64
-	PaymentAsset uint64
-	Expiration   uint64
-	ReservedFor  types.Address
-	GateID       uint64
-	Marketplace  types.Address
-	Name         string
-	Proof        [][32]byte
+	Payment      transaction.TransactionWithSigner `json:"-"`
+	AssetXfer    transaction.TransactionWithSigner `json:"-"`
+	Price        uint64                            `json:"price"`
+	PaymentAsset uint64                            `json:"paymentAsset"`
+	Expiration   uint64                            `json:"expiration"`
+	ReservedFor  types.Address                     `json:"reservedFor"`
+	GateID       uint64                            `json:"gateID"`
+	Marketplace  types.Address                     `json:"marketplace"`
+	Name         string                            `json:"name"`
+	Proof        [][32]byte                        `json:"proof"`
 }
 
 // ListMethodResult holds the result of calling list.
 type ListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // ListPrizeBoxArgs holds the arguments for the listPrizeBox method.
 type ListPrizeBoxArgs struct {
-	Payment      transaction.TransactionWithSigner
-	PrizeID      uint64
-	Price        uint64
-	PaymentAsset uint64
-	Expiration   uint64
-	ReservedFor  types.Address
-	GateID       uint64
-	Marketplace  types.Address
+	Payment      transaction.TransactionWithSigner `json:"-"`
+	PrizeID      uint64                            `json:"prizeID"`
+	Price        uint64                            `json:"price"`
+	PaymentAsset uint64                            `json:"paymentAsset"`
+	Expiration   uint64                            `json:"expiration"`
+	ReservedFor  types.Address                     `json:"reservedFor"`
+	GateID       uint64                            `json:"gateID"`
+	Marketplace  types.Address                     `json:"marketplace"`
 }
 
 // ListPrizeBoxMethodResult holds the result of calling listPrizeBox.
 type ListPrizeBoxMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GatedPurchaseArgs holds the arguments for the gatedPurchase method.
 type GatedPurchaseArgs struct {
-	Payment     transaction.TransactionWithSigner
-	GateTXN     transaction.TransactionWithSigner
-	AppID       uint64
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	GateTXN     transaction.TransactionWithSigner `json:"-"`
+	AppID       uint64                            `json:"appId"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // PurchaseArgs holds the arguments for the purchase method.
 type PurchaseArgs struct {
-	Payment     transaction.TransactionWithSigner
-	AppID       uint64
-	Marketplace types.Address
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	AppID       uint64                            `json:"appId"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // GatedPurchaseASAArgs holds the arguments for the gatedPurchaseAsa method.
 type GatedPurchaseASAArgs struct {
-	AssetXfer   transaction.TransactionWithSigner
-	GateTXN     transaction.TransactionWithSigner
-	AppID       uint64
-	Marketplace types.Address
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	GateTXN     transaction.TransactionWithSigner `json:"-"`
+	AppID       uint64                            `json:"appId"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // PurchaseASAArgs holds the arguments for the purchaseAsa method.
 type PurchaseASAArgs struct {
-	AssetXfer   transaction.TransactionWithSigner
-	AppID       uint64
-	Marketplace types.Address
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	AppID       uint64                            `json:"appId"`
+	Marketplace types.Address                     `json:"marketplace"`
 }
 
 // DelistArgs holds the arguments for the delist method.
 type DelistArgs struct {
-	AppID uint64
+	AppID uint64 `json:"appId"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -8,60 +8,60 @@ import (
 
 // CreateApplicationArgs holds the arguments for the createApplication method.
 type CreateApplicationArgs struct {
-	Version  string
-	Factory  uint64
-	AkitaDao uint64
+	Version  string `json:"version"`
+	Factory  uint64 `json:"factory"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // ListArgs holds the arguments for the list method.
 type ListArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	Asset        uint64
-	AssetAmount  uint64
-	Price        uint64
-	PaymentAsset uint64
-	Expiration   uint64
-	ReservedFor  types.Address
-	GateID       uint64
-	Marketplace  types.Address
-	Name         string
-	Proof        [][32]byte
+	Wallet       uint64        `json:"wallet"`
+	RekeyBack    bool          `json:"rekeyBack"`
+	Asset        uint64        `json:"asset"`
+	AssetAmount  uint64        `json:"assetAmount"`
+	Price        uint64        `json:"price"`
+	PaymentAsset uint64        `json:"paymentAsset"`
+	Expiration   uint64        `json:"expiration"`
+	ReservedFor  types.Address `json:"reservedFor"`
+	GateID       uint64        `json:"gateID"`
+	Marketplace  types.Address `json:"marketplace"`
+	Name         string        `json:"name"`
+	Proof        [][32]byte    `json:"proof"`
 }
 
 // ListMethodResult holds the result of calling list.
 type ListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // PurchaseArgs holds the arguments for the purchase method.
 type PurchaseArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	AppID       uint64
-	Marketplace types.Address
-	Args        [][]byte
+	Wallet      uint64        `json:"wallet"`
+	RekeyBack   bool          `json:"rekeyBack"`
+	AppID       uint64        `json:"appId"`
+	Marketplace types.Address `json:"marketplace"`
+	Args        [][]byte      `json:"args"`
 }
 
 // ChangePriceArgs holds the arguments for the changePrice method.
 type ChangePriceArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Price     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Price     uint64 `json:"price"`
 }
 
 // DelistArgs holds the arguments for the delist method.
 type DelistArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,26 +2,310 @@
 package metamerkles
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendAddRoot calls the addRoot ABI method.
+	SendAddRoot(ctx context.Context, params algokit.CallParams[AddRootArgs]) error
+	// SendDeleteRoot calls the deleteRoot ABI method.
+	SendDeleteRoot(ctx context.Context, params algokit.CallParams[DeleteRootArgs]) error
+	// SendUpdateRoot calls the updateRoot ABI method.
+	SendUpdateRoot(ctx context.Context, params algokit.CallParams[UpdateRootArgs]) error
+	// SendAddData calls the addData ABI method.
+	SendAddData(ctx context.Context, params algokit.CallParams[AddDataArgs]) error
+	// SendDeleteData calls the deleteData ABI method.
+	SendDeleteData(ctx context.Context, params algokit.CallParams[DeleteDataArgs]) error
+	// SendVerify calls the verify ABI method.
+	SendVerify(ctx context.Context, params algokit.CallParams[VerifyArgs]) (*VerifyMethodResult, error)
+	// SendRead calls the read ABI method (readonly).
+	SendRead(ctx context.Context, params algokit.CallParams[ReadArgs]) (*ReadMethodResult, error)
+	// SendVerifiedRead calls the verifiedRead ABI method.
+	SendVerifiedRead(ctx context.Context, params algokit.CallParams[VerifiedReadArgs]) (*VerifiedReadMethodResult, error)
+	// SendVerifiedMustRead calls the verifiedMustRead ABI method.
+	SendVerifiedMustRead(ctx context.Context, params algokit.CallParams[VerifiedMustReadArgs]) (*VerifiedMustReadMethodResult, error)
+	// SendAddType calls the addType ABI method.
+	SendAddType(ctx context.Context, params algokit.CallParams[AddTypeArgs]) error
+	// SendRootCosts calls the rootCosts ABI method (readonly).
+	SendRootCosts(ctx context.Context, params algokit.CallParams[RootCostsArgs]) (*RootCostsMethodResult, error)
+	// SendDataCosts calls the dataCosts ABI method (readonly).
+	SendDataCosts(ctx context.Context, params algokit.CallParams[DataCostsArgs]) (*DataCostsMethodResult, error)
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapData reads the value for key in the data box map.
+	GetBoxMapData(ctx context.Context, key DataKey) (string, error)
+	// GetBoxMapRoots reads the value for key in the roots box map.
+	GetBoxMapRoots(ctx context.Context, key RootKey) ([]byte, error)
+	// GetBoxMapTypes reads the value for key in the types box map.
+	GetBoxMapTypes(ctx context.Context, key uint64) (TypesValue, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the MetaMerkles smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +317,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("MetaMerkles has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,183 +366,213 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match MetaMerkles")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendAddRoot calls the addRoot ABI method and waits for confirmation.
+// SendAddRoot calls the addRoot ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Creates two boxes and adds a merkle root
 // using a `RootKey` to the root box map and also a list type to the
 // metadata attached to the root in the data box map
 func (c *Client) SendAddRoot(ctx context.Context, params algokit.CallParams[AddRootArgs]) error {
-	methodArgs := argsToInterfaceAddRoot(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "addRoot",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAddRoot(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAddRoot(ctx context.Context, params algokit.CallParams[AddRootArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AddRoot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteRoot calls the deleteRoot ABI method and waits for confirmation.
+// SendDeleteRoot calls the deleteRoot ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Deletes the merkle root from the root box map
 func (c *Client) SendDeleteRoot(ctx context.Context, params algokit.CallParams[DeleteRootArgs]) error {
-	methodArgs := argsToInterfaceDeleteRoot(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteRoot",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteRoot(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteRoot(ctx context.Context, params algokit.CallParams[DeleteRootArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteRoot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateRoot calls the updateRoot ABI method and waits for confirmation.
+// SendUpdateRoot calls the updateRoot ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Replaces the merkle root with another
 func (c *Client) SendUpdateRoot(ctx context.Context, params algokit.CallParams[UpdateRootArgs]) error {
-	methodArgs := argsToInterfaceUpdateRoot(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateRoot",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateRoot(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateRoot(ctx context.Context, params algokit.CallParams[UpdateRootArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateRoot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendAddData calls the addData ABI method and waits for confirmation.
+// SendAddData calls the addData ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Registers a key & value in the data box map that
 // corresponds to a merkle root in the root box map
 func (c *Client) SendAddData(ctx context.Context, params algokit.CallParams[AddDataArgs]) error {
-	methodArgs := argsToInterfaceAddData(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "addData",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAddData(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAddData(ctx context.Context, params algokit.CallParams[AddDataArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AddData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDeleteData calls the deleteData ABI method and waits for confirmation.
+// SendDeleteData calls the deleteData ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Deletes a metadata key & value pair from the data box map
 func (c *Client) SendDeleteData(ctx context.Context, params algokit.CallParams[DeleteDataArgs]) error {
-	methodArgs := argsToInterfaceDeleteData(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deleteData",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeleteData(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeleteData(ctx context.Context, params algokit.CallParams[DeleteDataArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DeleteData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendVerify calls the verify ABI method and waits for confirmation.
+// SendVerify calls the verify ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // verify an inclusion in a double sha256 based merkle tree
 func (c *Client) SendVerify(ctx context.Context, params algokit.CallParams[VerifyArgs]) (*VerifyMethodResult, error) {
-	methodArgs := argsToInterfaceVerify(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendVerify(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "verify",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendVerify(ctx context.Context, params algokit.CallParams[VerifyArgs]) (*VerifyMethodResult, error) {
+	comp, err := c.NewGroup().Verify(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*VerifyMethodResult)
+	return typedResult, nil
+}
 
+// decodeVerifyResult decodes the return value of a verify call.
+func (c *Client) decodeVerifyResult(result *algokit.SendAppTransactionResult) (*VerifyMethodResult, error) {
 	typedResult := &VerifyMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -233,29 +586,32 @@ func (c *Client) SendVerify(ctx context.Context, params algokit.CallParams[Verif
 	return typedResult, nil
 }
 
-// SendRead calls the read ABI method and waits for confirmation.
+// SendRead calls the read ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Fetch a metadata properties
 func (c *Client) SendRead(ctx context.Context, params algokit.CallParams[ReadArgs]) (*ReadMethodResult, error) {
-	methodArgs := argsToInterfaceRead(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRead(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "read",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendRead(ctx context.Context, params algokit.CallParams[ReadArgs]) (*ReadMethodResult, error) {
+	comp, err := c.NewGroup().Read(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*ReadMethodResult)
+	return typedResult, nil
+}
 
+// decodeReadResult decodes the return value of a read call.
+func (c *Client) decodeReadResult(result *algokit.SendAppTransactionResult) (*ReadMethodResult, error) {
 	typedResult := &ReadMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -269,7 +625,8 @@ func (c *Client) SendRead(ctx context.Context, params algokit.CallParams[ReadArg
 	return typedResult, nil
 }
 
-// SendVerifiedRead calls the verifiedRead ABI method and waits for confirmation.
+// SendVerifiedRead calls the verifiedRead ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Read metadata from box storage and verify the data provided is included
 // in the merkle tree given a sha256'd 32 byte merkle tree root & a proof
 // thats pre-computed off chain.
@@ -279,26 +636,28 @@ func (c *Client) SendRead(ctx context.Context, params algokit.CallParams[ReadArg
 // & check against the underlying data's schema
 // & check against the underlying data's list type or purpose
 func (c *Client) SendVerifiedRead(ctx context.Context, params algokit.CallParams[VerifiedReadArgs]) (*VerifiedReadMethodResult, error) {
-	methodArgs := argsToInterfaceVerifiedRead(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendVerifiedRead(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "verifiedRead",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendVerifiedRead(ctx context.Context, params algokit.CallParams[VerifiedReadArgs]) (*VerifiedReadMethodResult, error) {
+	comp, err := c.NewGroup().VerifiedRead(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*VerifiedReadMethodResult)
+	return typedResult, nil
+}
 
+// decodeVerifiedReadResult decodes the return value of a verifiedRead call.
+func (c *Client) decodeVerifiedReadResult(result *algokit.SendAppTransactionResult) (*VerifiedReadMethodResult, error) {
 	typedResult := &VerifiedReadMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -312,7 +671,8 @@ func (c *Client) SendVerifiedRead(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendVerifiedMustRead calls the verifiedMustRead ABI method and waits for confirmation.
+// SendVerifiedMustRead calls the verifiedMustRead ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // Read metadata from box storage and verify the data provided is included
 // in the merkle tree given a sha256'd 32 byte merkle tree root & a proof
 // thats pre-computed off chain.
@@ -322,26 +682,28 @@ func (c *Client) SendVerifiedRead(ctx context.Context, params algokit.CallParams
 // & check against the underlying data's schema
 // & check against the underlying data's list type or purpose
 func (c *Client) SendVerifiedMustRead(ctx context.Context, params algokit.CallParams[VerifiedMustReadArgs]) (*VerifiedMustReadMethodResult, error) {
-	methodArgs := argsToInterfaceVerifiedMustRead(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendVerifiedMustRead(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "verifiedMustRead",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendVerifiedMustRead(ctx context.Context, params algokit.CallParams[VerifiedMustReadArgs]) (*VerifiedMustReadMethodResult, error) {
+	comp, err := c.NewGroup().VerifiedMustRead(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*VerifiedMustReadMethodResult)
+	return typedResult, nil
+}
 
+// decodeVerifiedMustReadResult decodes the return value of a verifiedMustRead call.
+func (c *Client) decodeVerifiedMustReadResult(result *algokit.SendAppTransactionResult) (*VerifiedMustReadMethodResult, error) {
 	typedResult := &VerifiedMustReadMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -355,54 +717,55 @@ func (c *Client) SendVerifiedMustRead(ctx context.Context, params algokit.CallPa
 	return typedResult, nil
 }
 
-// SendAddType calls the addType ABI method and waits for confirmation.
+// SendAddType calls the addType ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendAddType(ctx context.Context, params algokit.CallParams[AddTypeArgs]) error {
-	methodArgs := argsToInterfaceAddType(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "addType",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendAddType(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendAddType(ctx context.Context, params algokit.CallParams[AddTypeArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().AddType(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendRootCosts calls the rootCosts ABI method and waits for confirmation.
+// SendRootCosts calls the rootCosts ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendRootCosts(ctx context.Context, params algokit.CallParams[RootCostsArgs]) (*RootCostsMethodResult, error) {
-	methodArgs := argsToInterfaceRootCosts(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRootCosts(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "rootCosts",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendRootCosts(ctx context.Context, params algokit.CallParams[RootCostsArgs]) (*RootCostsMethodResult, error) {
+	comp, err := c.NewGroup().RootCosts(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*RootCostsMethodResult)
+	return typedResult, nil
+}
 
+// decodeRootCostsResult decodes the return value of a rootCosts call.
+func (c *Client) decodeRootCostsResult(result *algokit.SendAppTransactionResult) (*RootCostsMethodResult, error) {
 	typedResult := &RootCostsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -416,28 +779,31 @@ func (c *Client) SendRootCosts(ctx context.Context, params algokit.CallParams[Ro
 	return typedResult, nil
 }
 
-// SendDataCosts calls the dataCosts ABI method and waits for confirmation.
+// SendDataCosts calls the dataCosts ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDataCosts(ctx context.Context, params algokit.CallParams[DataCostsArgs]) (*DataCostsMethodResult, error) {
-	methodArgs := argsToInterfaceDataCosts(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendDataCosts(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "dataCosts",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendDataCosts(ctx context.Context, params algokit.CallParams[DataCostsArgs]) (*DataCostsMethodResult, error) {
+	comp, err := c.NewGroup().DataCosts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*DataCostsMethodResult)
+	return typedResult, nil
+}
 
+// decodeDataCostsResult decodes the return value of a dataCosts call.
+func (c *Client) decodeDataCostsResult(result *algokit.SendAppTransactionResult) (*DataCostsMethodResult, error) {
 	typedResult := &DataCostsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -28,124 +28,124 @@ type TypesValue struct {
 
 // AddRootArgs holds the arguments for the addRoot method.
 type AddRootArgs struct {
-	Payment transaction.TransactionWithSigner
-	Name    string
-	Root    [32]byte
-	Type    uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Name    string                            `json:"name"`
+	Root    [32]byte                          `json:"root"`
+	Type    uint64                            `json:"type"`
 }
 
 // DeleteRootArgs holds the arguments for the deleteRoot method.
 type DeleteRootArgs struct {
-	Name string
+	Name string `json:"name"`
 }
 
 // UpdateRootArgs holds the arguments for the updateRoot method.
 type UpdateRootArgs struct {
-	Name    string
-	NewRoot [32]byte
+	Name    string   `json:"name"`
+	NewRoot [32]byte `json:"newRoot"`
 }
 
 // AddDataArgs holds the arguments for the addData method.
 type AddDataArgs struct {
-	Payment transaction.TransactionWithSigner
-	Name    string
-	Key     string
-	Value   string
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Name    string                            `json:"name"`
+	Key     string                            `json:"key"`
+	Value   string                            `json:"value"`
 }
 
 // DeleteDataArgs holds the arguments for the deleteData method.
 type DeleteDataArgs struct {
-	Name string
-	Key  string
+	Name string `json:"name"`
+	Key  string `json:"key"`
 }
 
 // VerifyArgs holds the arguments for the verify method.
 type VerifyArgs struct {
-	Address types.Address
-	Name    string
-	Leaf    [32]byte
-	Proof   [][32]byte
-	Type    uint64
+	Address types.Address `json:"address"`
+	Name    string        `json:"name"`
+	Leaf    [32]byte      `json:"leaf"`
+	Proof   [][32]byte    `json:"proof"`
+	Type    uint64        `json:"type"`
 }
 
 // VerifyMethodResult holds the result of calling verify.
 type VerifyMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // ReadArgs holds the arguments for the read method.
 type ReadArgs struct {
-	Address types.Address
-	Name    string
-	Key     string
+	Address types.Address `json:"address"`
+	Name    string        `json:"name"`
+	Key     string        `json:"key"`
 }
 
 // ReadMethodResult holds the result of calling read.
 type ReadMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return string
+	Return string `json:"return"`
 }
 
 // VerifiedReadArgs holds the arguments for the verifiedRead method.
 type VerifiedReadArgs struct {
-	Address types.Address
-	Name    string
-	Leaf    [32]byte
-	Proof   [][32]byte
-	Type    uint64
-	Key     string
+	Address types.Address `json:"address"`
+	Name    string        `json:"name"`
+	Leaf    [32]byte      `json:"leaf"`
+	Proof   [][32]byte    `json:"proof"`
+	Type    uint64        `json:"type"`
+	Key     string        `json:"key"`
 }
 
 // VerifiedReadMethodResult holds the result of calling verifiedRead.
 type VerifiedReadMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return string
+	Return string `json:"return"`
 }
 
 // VerifiedMustReadArgs holds the arguments for the verifiedMustRead method.
 type VerifiedMustReadArgs struct {
-	Address types.Address
-	Name    string
-	Leaf    [32]byte
-	Proof   [][32]byte
-	Type    uint64
-	Key     string
+	Address types.Address `json:"address"`
+	Name    string        `json:"name"`
+	Leaf    [32]byte      `json:"leaf"`
+	Proof   [][32]byte    `json:"proof"`
+	Type    uint64        `json:"type"`
+	Key     string        `json:"key"`
 }
 
 // VerifiedMustReadMethodResult holds the result of calling verifiedMustRead.
 type VerifiedMustReadMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return string
+	Return string `json:"return"`
 }
 
 // AddTypeArgs holds the arguments for the addType method.
 type AddTypeArgs struct {
-	Payment     transaction.TransactionWithSigner
-	Description string
-	SchemaList  []uint8
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	Description string                            `json:"description"`
+	SchemaList  []uint8                           `json:"schemaList"`
 }
 
 // RootCostsArgs holds the arguments for the rootCosts method.
 type RootCostsArgs struct {
-	Name string
+	Name string `json:"name"`
 }
 
 // RootCostsMethodResult holds the result of calling rootCosts.
 type RootCostsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // DataCostsArgs holds the arguments for the dataCosts method.
 type DataCostsArgs struct {
-	Name  string
-	Key   string
-	Value string
+	Name  string `json:"name"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 // DataCostsMethodResult holds the result of calling dataCosts.
 type DataCostsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
@@ -8,5 +8,5 @@ import (
 // PingMethodResult holds the result of calling ping.
 type PingMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
@@ -7,12 +7,12 @@ import (
 
 // GetArgs holds the arguments for the get method.
 type GetArgs struct {
-	Round    uint64
-	UserData []byte
+	Round    uint64 `json:"round"`
+	UserData []byte `json:"userData"`
 }
 
 // GetMethodResult holds the result of calling get.
 type GetMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
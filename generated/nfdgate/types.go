@@ -9,71 +9,71 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // CostArgs holds the arguments for the cost method.
 type CostArgs struct {
-	Args []byte
+	Args []byte `json:"args"`
 }
 
 // CostMethodResult holds the result of calling cost.
 type CostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // RegisterArgs holds the arguments for the register method.
 type RegisterArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Args       []byte
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Args       []byte                            `json:"args"`
 }
 
 // RegisterMethodResult holds the result of calling register.
 type RegisterMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CheckArgs holds the arguments for the check method.
 type CheckArgs struct {
-	Caller     types.Address
-	RegistryID uint64
-	Args       []byte
+	Caller     types.Address `json:"caller"`
+	RegistryID uint64        `json:"registryID"`
+	Args       []byte        `json:"args"`
 }
 
 // CheckMethodResult holds the result of calling check.
 type CheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetCheckShapeArgs holds the arguments for the getCheckShape method.
 type GetCheckShapeArgs struct {
-	Shape uint64
+	Shape uint64 `json:"shape"`
 }
 
 // GetCheckShapeMethodResult holds the result of calling getCheckShape.
 type GetCheckShapeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetEntryArgs holds the arguments for the getEntry method.
 type GetEntryArgs struct {
-	RegistryID uint64
+	RegistryID uint64 `json:"registryID"`
 }
 
 // GetEntryMethodResult holds the result of calling getEntry.
 type GetEntryMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []byte
+	Return []byte `json:"return"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -8,125 +8,125 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Registry uint64
+	Registry uint64 `json:"registry"`
 }
 
 // DeleteFieldsArgs holds the arguments for the deleteFields method.
 type DeleteFieldsArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	AppID      uint64
-	FieldNames [][]byte
+	Wallet     uint64   `json:"wallet"`
+	RekeyBack  bool     `json:"rekeyBack"`
+	AppID      uint64   `json:"appId"`
+	FieldNames [][]byte `json:"fieldNames"`
 }
 
 // UpdateFieldsArgs holds the arguments for the updateFields method.
 type UpdateFieldsArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	AppID        uint64
-	FieldAndVals [][]byte
+	Wallet       uint64   `json:"wallet"`
+	RekeyBack    bool     `json:"rekeyBack"`
+	AppID        uint64   `json:"appId"`
+	FieldAndVals [][]byte `json:"fieldAndVals"`
 }
 
 // OfferForSaleArgs holds the arguments for the offerForSale method.
 type OfferForSaleArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	AppID       uint64
-	SellAmount  uint64
-	ReservedFor types.Address
+	Wallet      uint64        `json:"wallet"`
+	RekeyBack   bool          `json:"rekeyBack"`
+	AppID       uint64        `json:"appId"`
+	SellAmount  uint64        `json:"sellAmount"`
+	ReservedFor types.Address `json:"reservedFor"`
 }
 
 // CancelSaleArgs holds the arguments for the cancelSale method.
 type CancelSaleArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // PostOfferArgs holds the arguments for the postOffer method.
 type PostOfferArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Offer     uint64
-	Note      string
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Offer     uint64 `json:"offer"`
+	Note      string `json:"note"`
 }
 
 // PurchaseArgs holds the arguments for the purchase method.
 type PurchaseArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // UpdateHashArgs holds the arguments for the updateHash method.
 type UpdateHashArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Hash      []byte
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Hash      []byte `json:"hash"`
 }
 
 // ContractLockArgs holds the arguments for the contractLock method.
 type ContractLockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Lock      bool
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Lock      bool   `json:"lock"`
 }
 
 // SegmentLockArgs holds the arguments for the segmentLock method.
 type SegmentLockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Lock      bool
-	UsdPrice  uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Lock      bool   `json:"lock"`
+	UsdPrice  uint64 `json:"usdPrice"`
 }
 
 // VaultOptInLockArgs holds the arguments for the vaultOptInLock method.
 type VaultOptInLockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Lock      bool
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Lock      bool   `json:"lock"`
 }
 
 // VaultOptInArgs holds the arguments for the vaultOptIn method.
 type VaultOptInArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Assets    []uint64
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	AppID     uint64   `json:"appId"`
+	Assets    []uint64 `json:"assets"`
 }
 
 // VaultSendArgs holds the arguments for the vaultSend method.
 type VaultSendArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	AppID       uint64
-	Amount      uint64
-	Receiver    types.Address
-	Note        string
-	Asset       uint64
-	OtherAssets []uint64
+	Wallet      uint64        `json:"wallet"`
+	RekeyBack   bool          `json:"rekeyBack"`
+	AppID       uint64        `json:"appId"`
+	Amount      uint64        `json:"amount"`
+	Receiver    types.Address `json:"receiver"`
+	Note        string        `json:"note"`
+	Asset       uint64        `json:"asset"`
+	OtherAssets []uint64      `json:"otherAssets"`
 }
 
 // RenewArgs holds the arguments for the renew method.
 type RenewArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Years     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Years     uint64 `json:"years"`
 }
 
 // SetPrimaryAddressArgs holds the arguments for the setPrimaryAddress method.
 type SetPrimaryAddressArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	FieldName string
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	AppID     uint64        `json:"appId"`
+	FieldName string        `json:"fieldName"`
+	Address   types.Address `json:"address"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -7,8 +7,8 @@ import (
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Assets     []uint64
-	MBRPayment transaction.TransactionWithSigner
+	Wallet     uint64                            `json:"wallet"`
+	RekeyBack  bool                              `json:"rekeyBack"`
+	Assets     []uint64                          `json:"assets"`
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
 }
@@ -1,9 +1,13 @@
 // Code generated by algokit-client-generator-go. DO NOT EDIT.
 package payplugin
 
+import (
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
 // PayArgs holds the arguments for the pay method.
 type PayArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Payments  [][]interface{}
+	Wallet    uint64                                  `json:"wallet"`
+	RekeyBack bool                                    `json:"rekeyBack"`
+	Payments  []Tuple3[types.Address, uint64, uint64] `json:"payments"`
 }
@@ -8,13 +8,13 @@ import (
 
 // MintArgs holds the arguments for the mint method.
 type MintArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Recipient types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Recipient types.Address `json:"recipient"`
 }
 
 // MintMethodResult holds the result of calling mint.
 type MintMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
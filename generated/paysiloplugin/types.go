@@ -8,14 +8,14 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Recipient types.Address
+	Recipient types.Address `json:"recipient"`
 }
 
 // PayArgs holds the arguments for the pay method.
 type PayArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Payments  [][]interface{}
+	Wallet    uint64                   `json:"wallet"`
+	RekeyBack bool                     `json:"rekeyBack"`
+	Payments  []Tuple2[uint64, uint64] `json:"payments"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,4 +2,11 @@
 package poll
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"Poll\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"endTime\"},{\"type\":\"uint64\",\"name\":\"maxSelected\"},{\"type\":\"string\",\"name\":\"question\"},{\"type\":\"string[]\",\"name\":\"options\"},{\"type\":\"uint64\",\"name\":\"gateID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"deleteBoxes\",\"args\":[{\"type\":\"address[]\",\"name\":\"addresses\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gatedVote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"uint64[]\",\"name\":\"votes\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"vote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"uint64[]\",\"name\":\"votes\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"hasVoted\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{},\"state\":{\"schema\":{\"global\":{\"ints\":11,\"bytes\":8},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"boxCount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Ym94X2NvdW50\",\"desc\":\"the number of boxes created during the poll\"},\"endTime\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW5kX3RpbWU=\",\"desc\":\"the time the poll ends as a unix timestamp\"},\"gateID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9pZA==\",\"desc\":\"the gate id to be used for filtering who can interact with this poll\"},\"maxSelected\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3NlbGVjdGVk\",\"desc\":\"the maximum number of selections in a multiple choice poll\"},\"optionCount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"b3B0aW9uX2NvdW50\",\"desc\":\"the number of options in the poll\"},\"optionFive\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX2ZpdmU=\"},\"optionFour\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX2ZvdXI=\"},\"optionOne\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX29uZQ==\",\"desc\":\"the options and vote counts of the poll\"},\"optionThree\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX3RocmVl\"},\"optionTwo\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX3R3bw==\"},\"question\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"cXVlc3Rpb24=\",\"desc\":\"the question being asked\"},\"type\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"dHlwZQ==\",\"desc\":\"The type of poll: SingleChoice, MultipleChoice, SingleChoiceImpact or MultipleChoiceImpact\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"},\"votesFive\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfZml2ZQ==\"},\"votesFour\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfZm91cg==\"},\"votesOne\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfb25l\",\"desc\":\"the number of votes for each option\"},\"votesThree\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfdGhyZWU=\"},\"votesTwo\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfdHdv\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"votes\":{\"keyType\":\"address\",\"valueType\":\"AVMBytes\",\"desc\":\"A map of addresses to empty bytes to track who has voted\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDIgOCAxNTMwMAogICAgYnl0ZWNibG9jayAidm90ZXNfb25lIiAidm90ZXNfdHdvIiAidm90ZXNfdGhyZWUiICJ2b3Rlc19mb3VyIiAidm90ZXNfZml2ZSIgImFraXRhX2RhbyIgInR5cGUiICJib3hfY291bnQiICJlbmRfdGltZSIgImdhdGVfaWQiICJtYXhfc2VsZWN0ZWQiICJvcHRpb25fY291bnQiIDB4MTUxZjdjNzUKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdm90ZXNUaHJlZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUaHJlZSB9KQogICAgYnl0ZWNfMiAvLyAidm90ZXNfdGhyZWUiCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODUKICAgIC8vIHZvdGVzRm91ciA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGb3VyIH0pCiAgICBieXRlY18zIC8vICJ2b3Rlc19mb3VyIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAoKbWFpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjU3CiAgICAvLyBleHBvcnQgY2xhc3MgUG9sbCBleHRlbmRzIEFraXRhQmFzZUNvbnRyYWN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYnogbWFpbl9jcmVhdGVfTm9PcEAxMgogICAgcHVzaGJ5dGVzcyAweGUxNDE5MDgwIDB4NWJjZjkxMDAgMHhkZDMwOWM3YiAweDVhN2Y0OTMyIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgImRlbGV0ZUJveGVzKGFkZHJlc3NbXSl2b2lkIiwgbWV0aG9kICJnYXRlZFZvdGUocGF5LGFwcGwsdWludDY0W10pdm9pZCIsIG1ldGhvZCAidm90ZShwYXksdWludDY0W10pdm9pZCIsIG1ldGhvZCAiaGFzVm90ZWQoYWRkcmVzcylib29sIiwgbWV0aG9kICJ1cGRhdGVBa2l0YURBTyh1aW50NjQpdm9pZCIsIG1ldGhvZCAib3BVcCgpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGRlbGV0ZUJveGVzIGdhdGVkVm90ZSB2b3RlIGhhc1ZvdGVkIHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAxMAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NTcKICAgIC8vIGV4cG9ydCBjbGFzcyBQb2xsIGV4dGVuZHMgQWtpdGFCYXNlQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4OWZhODM1YTggLy8gbWV0aG9kICJjcmVhdGUodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsc3RyaW5nLHN0cmluZ1tdLHVpbnQ2NCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggY3JlYXRlCiAgICBlcnIKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmNyZWF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZToKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE0MQogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgY292ZXIgMwogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGNvdmVyIDUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MQogICAgLy8gYXNzZXJ0KEdsb2JhbC5jYWxsZXJBcHBsaWNhdGlvbklkICE9PSAwLCBFUlJfQkFEX0RFUExPWUVSKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBNdXN0IGJlIGRlcGxveWVkIGJ5IGFuIGFwcGxpY2F0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MgogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPCBlbmRUaW1lLCBFUlJfSU5WQUxJRF9FTkRfVElNRSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyA0CiAgICA8CiAgICBhc3NlcnQgLy8gRW5kIHRpbWUgbXVzdCBiZSBpbiB0aGUgZnV0dXJlCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MwogICAgLy8gYXNzZXJ0KHR5cGUuYXNVaW50NjQoKSA8IE11bHRpcGxlQ2hvaWNlSW1wYWN0LmFzVWludDY0KCksIEVSUl9JTlZBTElEX1BPTExfVFlQRSkKICAgIGRpZyA0CiAgICBidG9pCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICA8CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwb2xsIHR5cGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBBcHBsaWNhdGlvbihha2l0YURBTykKICAgIHVuY292ZXIgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gdGhpcy50eXBlLnZhbHVlID0gdHlwZQogICAgdW5jb3ZlciA1CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NAogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyA5IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTcKICAgIC8vIHRoaXMuZ2F0ZUlELnZhbHVlID0gZ2F0ZUlECiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTgKICAgIC8vIHRoaXMuZW5kVGltZS52YWx1ZSA9IGVuZFRpbWUKICAgIHVuY292ZXIgMwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzQKICAgIC8vIHF1ZXN0aW9uID0gR2xvYmFsU3RhdGU8c3RyaW5nPih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5UXVlc3Rpb24gfSkKICAgIHB1c2hieXRlcyAicXVlc3Rpb24iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1OQogICAgLy8gdGhpcy5xdWVzdGlvbi52YWx1ZSA9IHF1ZXN0aW9uCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjEKICAgIC8vIGFzc2VydChvcHRpb25zLmxlbmd0aCA+PSAyICYmIG9wdGlvbnMubGVuZ3RoIDw9IDUsIEVSUl9JTlZBTElEX09QVElPTl9DT1VOVCkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBpbnRjXzIgLy8gMgogICAgPj0KICAgIGJ6IGNyZWF0ZV9ib29sX2ZhbHNlQDQKICAgIGR1cAogICAgcHVzaGludCA1IC8vIDUKICAgIDw9CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUA0CiAgICBpbnRjXzEgLy8gMQoKY3JlYXRlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTYxCiAgICAvLyBhc3NlcnQob3B0aW9ucy5sZW5ndGggPj0gMiAmJiBvcHRpb25zLmxlbmd0aCA8PSA1LCBFUlJfSU5WQUxJRF9PUFRJT05fQ09VTlQpCiAgICBhc3NlcnQgLy8gSW52YWxpZCBudW1iZXIgb2Ygb3B0aW9ucywgbXVzdCBiZSBiZXR3ZWVuIDIgYW5kIDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTYzCiAgICAvLyBpZiAodHlwZSA9PT0gTXVsdGlwbGVDaG9pY2UgfHwgdHlwZSA9PT0gTXVsdGlwbGVDaG9pY2VJbXBhY3QpIHsKICAgIGRpZyAzCiAgICBwdXNoYnl0ZXMgMHgxNAogICAgPT0KICAgIGJueiBjcmVhdGVfaWZfYm9keUA3CiAgICBkaWcgMwogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICBieiBjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxMgoKY3JlYXRlX2lmX2JvZHlANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyBhc3NlcnQobWF4U2VsZWN0ZWQgPj0gMiAmJiBtYXhTZWxlY3RlZCA8PSBvcHRpb25zLmxlbmd0aCAtIDEsIEVSUl9JTlZBTElEX01BWF9TRUxFQ1RJT04pCiAgICBkaWcgMgogICAgaW50Y18yIC8vIDIKICAgID49CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUAxMAogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZGlnIDMKICAgID49CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCmNyZWF0ZV9ib29sX21lcmdlQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIGFzc2VydChtYXhTZWxlY3RlZCA+PSAyICYmIG1heFNlbGVjdGVkIDw9IG9wdGlvbnMubGVuZ3RoIC0gMSwgRVJSX0lOVkFMSURfTUFYX1NFTEVDVElPTikKICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gc2VsZWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcwCiAgICAvLyBtYXhTZWxlY3RlZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU1heFNlbGVjdGVkIH0pCiAgICBieXRlYyAxMCAvLyAibWF4X3NlbGVjdGVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjUKICAgIC8vIHRoaXMubWF4U2VsZWN0ZWQudmFsdWUgPSBtYXhTZWxlY3RlZAogICAgZGlnIDMKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjgKICAgIC8vIG9wdGlvbkNvdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5T3B0aW9uQ291bnQgfSkKICAgIGJ5dGVjIDExIC8vICJvcHRpb25fY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE2OAogICAgLy8gdGhpcy5vcHRpb25Db3VudC52YWx1ZSA9IG9wdGlvbnMubGVuZ3RoCiAgICBkaWcgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gYm94Q291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlCb3hDb3VudCB9KQogICAgYnl0ZWMgNyAvLyAiYm94X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjkKICAgIC8vIHRoaXMuYm94Q291bnQudmFsdWUgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyB0aGlzLm9wdGlvbk9uZS52YWx1ZSA9IG9wdGlvbnNbMF0KICAgIGRpZyAyCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBidXJ5IDcKICAgIGRpZyAxCiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIGRpZyAyCiAgICBjb3ZlciAyCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzYKICAgIC8vIG9wdGlvbk9uZSA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU9wdGlvbk9uZSB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzEKICAgIC8vIHRoaXMub3B0aW9uT25lLnZhbHVlID0gb3B0aW9uc1swXQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTcyCiAgICAvLyB0aGlzLm9wdGlvblR3by52YWx1ZSA9IG9wdGlvbnNbMV0KICAgIGludGNfMSAvLyAxCiAgICBkaWcgMgogICAgPAogICAgYXNzZXJ0IC8vIGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkdXAKICAgIGludGNfMiAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzcKICAgIC8vIG9wdGlvblR3byA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU9wdGlvblR3byB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fdHdvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzIKICAgIC8vIHRoaXMub3B0aW9uVHdvLnZhbHVlID0gb3B0aW9uc1sxXQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTc0CiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gMykgewogICAgcHVzaGludCAzIC8vIDMKICAgID49CiAgICBieiBjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzUKICAgIC8vIHRoaXMub3B0aW9uVGhyZWUudmFsdWUgPSBvcHRpb25zWzJdCiAgICBpbnRjXzIgLy8gMgogICAgZGlnIDEKICAgIDwKICAgIGFzc2VydCAvLyBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDQKICAgIGR1cAogICAgcHVzaGludCA0IC8vIDQKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIGV4dHJhY3QzCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3OAogICAgLy8gb3B0aW9uVGhyZWUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25UaHJlZSB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fdGhyZWUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE3NQogICAgLy8gdGhpcy5vcHRpb25UaHJlZS52YWx1ZSA9IG9wdGlvbnNbMl0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gNCkgewogICAgZHVwCiAgICBwdXNoaW50IDQgLy8gNAogICAgPj0KICAgIGJ6IGNyZWF0ZV9hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE3OQogICAgLy8gdGhpcy5vcHRpb25Gb3VyLnZhbHVlID0gb3B0aW9uc1szXQogICAgcHVzaGludCAzIC8vIDMKICAgIGRpZyAxCiAgICA8CiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyA0CiAgICBkdXAKICAgIHB1c2hpbnQgNiAvLyA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzkKICAgIC8vIG9wdGlvbkZvdXIgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25Gb3VyIH0pCiAgICBwdXNoYnl0ZXMgIm9wdGlvbl9mb3VyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzkKICAgIC8vIHRoaXMub3B0aW9uRm91ci52YWx1ZSA9IG9wdGlvbnNbM10KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gNSkgewogICAgZHVwCiAgICBwdXNoaW50IDUgLy8gNQogICAgPj0KICAgIGJ6IGNyZWF0ZV9hZnRlcl9pZl9lbHNlQDE4CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4MwogICAgLy8gdGhpcy5vcHRpb25GaXZlLnZhbHVlID0gb3B0aW9uc1s0XQogICAgcHVzaGludCA0IC8vIDQKICAgIGRpZyAxCiAgICA8CiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyA0CiAgICBkdXAKICAgIGludGNfMyAvLyA4CiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODAKICAgIC8vIG9wdGlvbkZpdmUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25GaXZlIH0pCiAgICBwdXNoYnl0ZXMgIm9wdGlvbl9maXZlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxODMKICAgIC8vIHRoaXMub3B0aW9uRml2ZS52YWx1ZSA9IG9wdGlvbnNbNF0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTQxCiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKY3JlYXRlX2Jvb2xfZmFsc2VAMTA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVfYm9vbF9tZXJnZUAxMQoKY3JlYXRlX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGNyZWF0ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmRlbGV0ZUJveGVzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVsZXRlQm94ZXM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4NwogICAgLy8gZGVsZXRlQm94ZXMoYWRkcmVzc2VzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbMzJdW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4OAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiB0aGlzLmVuZFRpbWUudmFsdWUsIEVSUl9QT0xMX0FDVElWRSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjY2CiAgICAvLyBlbmRUaW1lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5RW5kVGltZSB9KQogICAgYnl0ZWMgOCAvLyAiZW5kX3RpbWUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4OAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiB0aGlzLmVuZFRpbWUudmFsdWUsIEVSUl9QT0xMX0FDVElWRSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBhc3NlcnQgLy8gUG9sbCBpcyBzdGlsbCBhY3RpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYWRkcmVzc2VzLmxlbmd0aDsgaSArPSAxKSB7CiAgICBpbnRjXzAgLy8gMAoKZGVsZXRlQm94ZXNfd2hpbGVfdG9wQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5MAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFkZHJlc3Nlcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZHVwCiAgICBkaWcgMgogICAgPAogICAgYnogZGVsZXRlQm94ZXNfYWZ0ZXJfd2hpbGVANQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIHRoaXMudm90ZXMoYWRkcmVzc2VzW2ldKS5kZWxldGUoKQogICAgZGlnIDIKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICAqCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBleHRyYWN0MyAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5NC0yMDAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBhZGRyZXNzZXNbaV0sCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgICBub3RlOiAnTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlJywKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5OAogICAgLy8gbm90ZTogJ01CUiByZWZ1bmQgZm9yIHBvbGwgdm90ZScsCiAgICBwdXNoYnl0ZXMgIk1CUiByZWZ1bmQgZm9yIHBvbGwgdm90ZSIKICAgIGl0eG5fZmllbGQgTm90ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTcKICAgIC8vIGFtb3VudDogdm90ZXNNQlIsCiAgICBpbnRjIDQgLy8gMTUzMDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5NC0xOTkKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBhZGRyZXNzZXNbaV0sCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgICBub3RlOiAnTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlJywKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTk0LTIwMAogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IGFkZHJlc3Nlc1tpXSwKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICAgIG5vdGU6ICdNQlIgcmVmdW5kIGZvciBwb2xsIHZvdGUnLAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSAtPSAxCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gYm94Q291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlCb3hDb3VudCB9KQogICAgYnl0ZWMgNyAvLyAiYm94X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIHRoaXMuYm94Q291bnQudmFsdWUgLT0gMQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyBib3hDb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUJveENvdW50IH0pCiAgICBieXRlYyA3IC8vICJib3hfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSAtPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTAKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhZGRyZXNzZXMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDEKICAgIGIgZGVsZXRlQm94ZXNfd2hpbGVfdG9wQDIKCmRlbGV0ZUJveGVzX2FmdGVyX3doaWxlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4NwogICAgLy8gZGVsZXRlQm94ZXMoYWRkcmVzc2VzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmdhdGVkVm90ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkVm90ZToKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjA4LTIxMgogICAgLy8gZ2F0ZWRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICB2b3RlczogdWludDY0W10KICAgIC8vICk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXBwbAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIHVuY292ZXIgMgogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTMKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTMKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIGFzc2VydCAvLyBQb2xsIGhhcyBlbmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTQKICAgIC8vIGFzc2VydCghdGhpcy52b3RlcyhUeG4uc2VuZGVyKS5leGlzdHMsIEVSUl9BTFJFQURZX1ZPVEVEKQogICAgdHhuIFNlbmRlcgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gVXNlciBoYXMgYWxyZWFkeSB2b3RlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTUKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gNSAmJiB2b3Rlcy5sZW5ndGggPj0gMSwgRVJSX0lOVkFMSURfVk9URSkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICA8PQogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VANAogICAgZHVwCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUA0CiAgICBpbnRjXzEgLy8gMQoKZ2F0ZWRWb3RlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhc3NlcnQodm90ZXMubGVuZ3RoIDw9IDUgJiYgdm90ZXMubGVuZ3RoID49IDEsIEVSUl9JTlZBTElEX1ZPVEUpCiAgICBhc3NlcnQgLy8gSW52YWxpZCB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIsIHRoaXMuZ2F0ZUlELnZhbHVlKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgYnVyeSA3CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NAogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyA5IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgc3dhcAogICAgYnVyeSA3CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgZGlnIDMKICAgIGd0eG5zIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIHN3YXAKICAgIHB1c2hieXRlcyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjkKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzAKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIGRpZyAyCiAgICBndHhucyBPbkNvbXBsZXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgYm56IGdhdGVkVm90ZV9ib29sX2ZhbHNlQDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMQogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICBkaWcgMgogICAgZ3R4bnMgTnVtQXBwQXJncwogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzEKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMyCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hieXRlcyAweDQzOTIyNjU1IC8vIG1ldGhvZCAibXVzdENoZWNrKGFkZHJlc3MsdWludDY0LGJ5dGVbXVtdKXZvaWQiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMyCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMzCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIGRpZyAyCiAgICBpbnRjXzEgLy8gMQogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA2CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMzCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzQKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGRpZyAyCiAgICBpbnRjXzIgLy8gMgogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA1CiAgICBpdG9iCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjM0CiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgaW50Y18xIC8vIDEKCmdhdGVkVm90ZV9ib29sX21lcmdlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhc3NlcnQgLy8gR2F0ZSBjaGVjayBmYWlsZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE3LTIyNAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZGlnIDMKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjIwCiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTctMjI0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIyMQogICAgLy8gYW1vdW50OiB2b3Rlc01CUiwKICAgIGludGMgNCAvLyAxNTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTctMjI0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjI2CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUodm90ZXMpCiAgICBkaWcgMQogICAgY2FsbHN1YiBjcmVhdGVWb3RlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjA4LTIxMgogICAgLy8gZ2F0ZWRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICB2b3RlczogdWludDY0W10KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTM6CiAgICBpbnRjXzAgLy8gMAogICAgYiBnYXRlZFZvdGVfYm9vbF9tZXJnZUAxNAoKZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGdhdGVkVm90ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLnZvdGVbcm91dGluZ10oKSAtPiB2b2lkOgp2b3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIHZvdGUobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCB2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIHVuY292ZXIgMgogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIGFzc2VydCAvLyBQb2xsIGhhcyBlbmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzEKICAgIC8vIGFzc2VydCghdGhpcy52b3RlcyhUeG4uc2VuZGVyKS5leGlzdHMsIEVSUl9BTFJFQURZX1ZPVEVEKQogICAgdHhuIFNlbmRlcgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gVXNlciBoYXMgYWxyZWFkeSB2b3RlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzIKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gNSAmJiB2b3Rlcy5sZW5ndGggPj0gMSwgRVJSX0lOVkFMSURfVk9URSkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICA8PQogICAgYnogdm90ZV9ib29sX2ZhbHNlQDQKICAgIGR1cAogICAgYnogdm90ZV9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgp2b3RlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBhc3NlcnQodm90ZXMubGVuZ3RoIDw9IDUgJiYgdm90ZXMubGVuZ3RoID49IDEsIEVSUl9JTlZBTElEX1ZPVEUpCiAgICBhc3NlcnQgLy8gSW52YWxpZCB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBhc3NlcnQodGhpcy5nYXRlSUQudmFsdWUgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjY0CiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDkgLy8gImdhdGVfaWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzMwogICAgLy8gYXNzZXJ0KHRoaXMuZ2F0ZUlELnZhbHVlID09PSAwLCBFUlJfSEFTX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgaGFzIGEgZ2F0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzQtMjQxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzcKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzNC0yNDEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBzd2FwCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjM4CiAgICAvLyBhbW91bnQ6IHZvdGVzTUJSLAogICAgaW50YyA0IC8vIDE1MzAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzNC0yNDEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIHRoaXMuY3JlYXRlVm90ZSh2b3RlcykKICAgIGRpZyAxCiAgICBjYWxsc3ViIGNyZWF0ZVZvdGUKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIHZvdGUobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCB2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgdm90ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmhhc1ZvdGVkW3JvdXRpbmddKCkgLT4gdm9pZDoKaGFzVm90ZWQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjI0NgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjI0OAogICAgLy8gcmV0dXJuIHRoaXMudm90ZXModXNlcikuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjQ2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHB1c2hieXRlcyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjIDEyIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA1IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmNyZWF0ZVZvdGUodm90ZXM6IGJ5dGVzKSAtPiBieXRlczoKY3JlYXRlVm90ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTUKICAgIC8vIHByaXZhdGUgY3JlYXRlVm90ZSh2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTYKICAgIC8vIGxldCBpbXBhY3Q6IHVpbnQ2NCA9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QgfHwgdGhpcy50eXBlLnZhbHVlID09PSBNdWx0aXBsZUNob2ljZUltcGFjdCkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QgfHwgdGhpcy50eXBlLnZhbHVlID09PSBNdWx0aXBsZUNob2ljZUltcGFjdCkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDFlCiAgICA9PQogICAgYm56IGNyZWF0ZVZvdGVfaWZfYm9keUAyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2MgogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFVpbnQ4Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNiAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTcKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFNpbmdsZUNob2ljZUltcGFjdCB8fCB0aGlzLnR5cGUudmFsdWUgPT09IE11bHRpcGxlQ2hvaWNlSW1wYWN0KSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMwoKY3JlYXRlVm90ZV9pZl9ib2R5QDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk4CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA1IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk4CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBzd2FwCiAgICBwdXNoYnl0ZXMgInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KGFraXRhREFPKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkNTc0YmIxMCAvLyBtZXRob2QgImdldFVzZXJJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjIDEyIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGZyYW1lX2J1cnkgNAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlIHx8IHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlSW1wYWN0KSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2MgogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFVpbnQ4Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNiAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTAxCiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2UgfHwgdGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoYnl0ZXMgMHgwYQogICAgPT0KICAgIGJueiBjcmVhdGVWb3RlX2lmX2JvZHlANQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlIHx8IHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlSW1wYWN0KSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzIDB4MWUKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2Vsc2VfYm9keUAyMAoKY3JlYXRlVm90ZV9pZl9ib2R5QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMgogICAgLy8gYXNzZXJ0KHZvdGVzLmxlbmd0aCA9PT0gMSwgRVJSX0lOVkFMSURfVk9URV9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG51bWJlciBvZiB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA0CiAgICAvLyBpZiAodm90ZXNbMF0gPT09IDApIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18yIC8vIDIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgYm56IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgyCiAgICAvLyB2b3Rlc09uZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNPbmUgfSkKICAgIGJ5dGVjXzAgLy8gInZvdGVzX29uZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdm90ZXNPbmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzT25lIH0pCiAgICBieXRlY18wIC8vICJ2b3Rlc19vbmUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwNQogICAgLy8gdGhpcy52b3Rlc09uZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzUKICAgIC8vIHRoaXMudm90ZXMoVHhuLnNlbmRlcikuY3JlYXRlKCkKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyB0aGlzLmJveENvdW50LnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyBib3hDb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUJveENvdW50IH0pCiAgICBieXRlYyA3IC8vICJib3hfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEzNgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSArPSAxCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzIKICAgIC8vIGJveENvdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Qm94Q291bnQgfSkKICAgIGJ5dGVjIDcgLy8gImJveF9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyB0aGlzLmJveENvdW50LnZhbHVlICs9IDEKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjcmVhdGVWb3RlX2Vsc2VfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDYKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbMF0gPT09IDEpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4MwogICAgLy8gdm90ZXNUd28gPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVHdvIH0pCiAgICBieXRlY18xIC8vICJ2b3Rlc190d28iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwNwogICAgLy8gdGhpcy52b3Rlc1R3by52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwOAogICAgLy8gfSBlbHNlIGlmICh2b3Rlc1swXSA9PT0gMikgewogICAgZnJhbWVfZGlnIDIKICAgIGludGNfMiAvLyAyCiAgICA9PQogICAgYnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA5CiAgICAvLyB0aGlzLnZvdGVzVGhyZWUudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDkKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSAxCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDkKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTAKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbMF0gPT09IDMpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBwdXNoaW50IDMgLy8gMwogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODUKICAgIC8vIHZvdGVzRm91ciA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGb3VyIH0pCiAgICBieXRlY18zIC8vICJ2b3Rlc19mb3VyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTEKICAgIC8vIHRoaXMudm90ZXNGb3VyLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdm90ZXNGb3VyID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZvdXIgfSkKICAgIGJ5dGVjXzMgLy8gInZvdGVzX2ZvdXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIGIgY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4CgpjcmVhdGVWb3RlX2Vsc2VfYm9keUAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEyCiAgICAvLyB9IGVsc2UgaWYgKHZvdGVzWzBdID09PSA0KSB7CiAgICBmcmFtZV9kaWcgMgogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEzCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSAxCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NgogICAgLy8gdm90ZXNGaXZlID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZpdmUgfSkKICAgIGJ5dGVjIDQgLy8gInZvdGVzX2ZpdmUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMwogICAgLy8gdGhpcy52b3Rlc0ZpdmUudmFsdWUgKz0gMQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEzCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDIwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTYKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gdGhpcy5tYXhTZWxlY3RlZC52YWx1ZSwgRVJSX0lOVkFMSURfVk9URV9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzAKICAgIC8vIG1heFNlbGVjdGVkID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5TWF4U2VsZWN0ZWQgfSkKICAgIGJ5dGVjIDEwIC8vICJtYXhfc2VsZWN0ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gYXNzZXJ0KHZvdGVzLmxlbmd0aCA8PSB0aGlzLm1heFNlbGVjdGVkLnZhbHVlLCBFUlJfSU5WQUxJRF9WT1RFX0NPVU5UKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBudW1iZXIgb2Ygdm90ZSBhcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IHZvdGVzLmxlbmd0aDsgaSArPSAxKSB7CiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAxCgpjcmVhdGVWb3RlX3doaWxlX3RvcEAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgdm90ZXMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgMAogICAgPAogICAgYnogY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExOQogICAgLy8gYXNzZXJ0KHZvdGVzW2ldIDw9IHRoaXMub3B0aW9uQ291bnQudmFsdWUgLSAxLCBFUlJfSU5WQUxJRF9WT1RFX09QVElPTikKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyAxCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2OAogICAgLy8gb3B0aW9uQ291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25Db3VudCB9KQogICAgYnl0ZWMgMTEgLy8gIm9wdGlvbl9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTE5CiAgICAvLyBhc3NlcnQodm90ZXNbaV0gPD0gdGhpcy5vcHRpb25Db3VudC52YWx1ZSAtIDEsIEVSUl9JTlZBTElEX1ZPVEVfT1BUSU9OKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkaWcgMQogICAgPj0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHZvdGUgb3B0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyMQogICAgLy8gaWYgKHZvdGVzW2ldID09PSAwKSB7CiAgICBibnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IGltcGFjdAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIHRoaXMudm90ZXNPbmUudmFsdWUgKz0gaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIHRoaXMudm90ZXNPbmUudmFsdWUgKz0gaW1wYWN0CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCB2b3Rlcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIDEKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDEKICAgIGIgY3JlYXRlVm90ZV93aGlsZV90b3BAMjEKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDI0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbaV0gPT09IDEpIHsKICAgIGZyYW1lX2RpZyAzCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gdGhpcy52b3Rlc1R3by52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IGltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IGltcGFjdAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIGIgY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM2CgpjcmVhdGVWb3RlX2Vsc2VfYm9keUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI1CiAgICAvLyB9IGVsc2UgaWYgKHZvdGVzW2ldID09PSAyKSB7CiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18yIC8vIDIKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2Vsc2VfYm9keUAyOAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyB2b3Rlc1RocmVlID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc1RocmVlIH0pCiAgICBieXRlY18yIC8vICJ2b3Rlc190aHJlZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyB0aGlzLnZvdGVzVGhyZWUudmFsdWUgKz0gaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSBpbXBhY3QKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBiIGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgoKY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyNwogICAgLy8gfSBlbHNlIGlmICh2b3Rlc1tpXSA9PT0gMykgewogICAgZnJhbWVfZGlnIDMKICAgIHB1c2hpbnQgMyAvLyAzCiAgICA9PQogICAgYnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyB0aGlzLnZvdGVzRm91ci52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg1CiAgICAvLyB2b3Rlc0ZvdXIgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRm91ciB9KQogICAgYnl0ZWNfMyAvLyAidm90ZXNfZm91ciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyB0aGlzLnZvdGVzRm91ci52YWx1ZSArPSBpbXBhY3QKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9kaWcgNAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdm90ZXNGb3VyID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZvdXIgfSkKICAgIGJ5dGVjXzMgLy8gInZvdGVzX2ZvdXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gaW1wYWN0CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzYKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDMwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbaV0gPT09IDQpIHsKICAgIGZyYW1lX2RpZyAzCiAgICBwdXNoaW50IDQgLy8gNAogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIHRoaXMudm90ZXNGaXZlLnZhbHVlICs9IGltcGFjdAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHZvdGVzRml2ZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGaXZlIH0pCiAgICBieXRlYyA0IC8vICJ2b3Rlc19maXZlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIHRoaXMudm90ZXNGaXZlLnZhbHVlICs9IGltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSBpbXBhY3QKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBiIGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgo=\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAFAAECCMR3Jg0Jdm90ZXNfb25lCXZvdGVzX3R3bwt2b3Rlc190aHJlZQp2b3Rlc19mb3VyCnZvdGVzX2ZpdmUJYWtpdGFfZGFvBHR5cGUJYm94X2NvdW50CGVuZF90aW1lB2dhdGVfaWQMbWF4X3NlbGVjdGVkDG9wdGlvbl9jb3VudAQVH3x1MRhAABAoImcpImcqImcrImcnBCJnMRkURDEYQQA0ggYE4UGQgARbz5EABN0wnHsEWn9JMgQz6SyUBIVN7eA2GgCOBgGcAhEC1gMzA00AAQAjQ4AEn6g1qDYaAI4BAAEAIjYaAUkVJRJEFzYaAklOAkkVIxJENhoDSRUlEkQXNhoESRUlEkQXTgM2GgVJIlkkCEsBFRJEVwIANhoGSU4CTgU2GgdJFSUSRBcyDUQyB0sEDERLBBeBKAxEJwVPBmcnBk8FZycJTGcnCE8DZ4AIcXVlc3Rpb25MZyJZSSQPQQEGSYEFDkEA/yNESwOAARQSQAAJSwOAASgSQQAXSwIkD0EA4EkjCUsDD0EA1yNEJwpLA2cnC0sBSU4CZycHImdLAlcCAElFB0sBREkiWUpZJAhLAk4CWFcCAIAKb3B0aW9uX29uZUxnI0sCDERJJFlKWSQIWFcCAIAKb3B0aW9uX3R3b0xngQMPQQAjJEsBDERLBEmBBFlKWSQIWFcCAIAMb3B0aW9uX3RocmVlTGdJgQQPQQAjgQNLAQxESwRJgQZZSlkkCFhXAgCAC29wdGlvbl9mb3VyTGdJgQUPQQAigQRLAQxESwRJJVlKWSQIWFcCAIALb3B0aW9uX2ZpdmVMZyNDIkL/JiJC/v42GgFHAiJZSU4CgSALJAhMFRJEMgciJwhlRA1EIklLAgxBAE9LAlcCAEsBSU4CgSALgSBYSbxIsYAYTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlsgUhBLIIsgcjshAisgGzIicHZUQjCScHTGcjCEUBQv+qI0MigAAxFiQJSTgQIxJEMRYjCUk4EIEGEkQ2GgFHAiJZSU4CSSULJAhPAhUSRDIHIicIZUQORDEAvUUBFESBBQ5BAH9JQQB7I0QiJwVlRDEARQciJwllTEUHREsDOBhMgANhYWxlSIEoWxJBAFFLAjgZQABKSwI4G4EEEkEAQEsCIsIagARDkiZVEkEAMUsCI8IaSwYSQQAmSwIkwhpLBRYSQQAaI0RLA0k4BzIKEkw4CCEEEhBESwGIAKdII0MiQv/jIkL/gjEWIwlJOBAjEkQ2GgFHAiJZSU4CSSULJAhPAhUSRDIHIicIZUQORDEAvUUBFESBBQ5BACVJQQAhI0QiJwllRBRESwJJOAcyChJMOAghBBIQREsBiABGSCNDIkL/3DYaAUkVgSASRL1FAYABACJPAlQnDExQsCNDNhoBSRUlEkQXMQAiJwVlRIAGd2FsbGV0ZUhyCEQSRCcFTGcjQ4oBAYAARwMjIicGZUSAAR4SQAAMIicGZUSAASgSQQA9IicFZUQxALFMgANzYWxlSIEQW4AE1XS7ELIashiyGoEGshAisgGztD5JVwQATFcABCcMEkRJFSUSRBeMBCInBmVEgAEKEkAADCInBmVEgAEeEkEAf4v/IlkjEkSL/yRbSYwCQAAeIihlRCMIKExnMQAiuUgiJwdlRCMIJwdMZ4v/jACJiwIjEkEADCIpZUQjCClMZ0L/2IsCJBJBAAwiKmVEIwgqTGdC/8WLAoEDEkEADCIrZUQjCCtMZ0L/sYsCgQQSQf+pIicEZUQjCCcETGdC/5uL/yJZSYwAIicKZUQORCKMAYsBiwAMQf+Ci/9XAgCLASULW0mMAyInC2VEIwlLAQ9EQAATIihlRIsECChMZ4sBIwiMAUL/yosDIxJBAA0iKWVEiwQIKUxnQv/jiwMkEkEADSIqZUSLBAgqTGdC/8+LA4EDEkEADSIrZUSLBAgrTGdC/7qLA4EEEkH/siInBGVEiwQIJwRMZ0L/ow==\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[1183],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[316],\"errorMessage\":\"End time must be in the future\"},{\"pc\":[910],\"errorMessage\":\"Gate check failed\"},{\"pc\":[407],\"errorMessage\":\"Invalid maximum selection\"},{\"pc\":[371],\"errorMessage\":\"Invalid number of options, must be between 2 and 5\"},{\"pc\":[1222,1356],\"errorMessage\":\"Invalid number of vote args\"},{\"pc\":[926,1023],\"errorMessage\":\"Invalid payment\"},{\"pc\":[323],\"errorMessage\":\"Invalid poll type\"},{\"pc\":[817,1000],\"errorMessage\":\"Invalid vote args\"},{\"pc\":[1391],\"errorMessage\":\"Invalid vote option\"},{\"pc\":[310],\"errorMessage\":\"Must be deployed by an application\"},{\"pc\":[162],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[1092],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[798,981],\"errorMessage\":\"Poll has ended\"},{\"pc\":[656],\"errorMessage\":\"Poll is still active\"},{\"pc\":[1007],\"errorMessage\":\"This has a gate\"},{\"pc\":[805,988],\"errorMessage\":\"User has already voted\"},{\"pc\":[1090],\"errorMessage\":\"application exists\"},{\"pc\":[654,730,796,822,834,979,1005,1077,1111,1123,1135,1196,1208,1236,1251,1273,1292,1312,1333,1354,1385,1398,1424,1444,1465,1487],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[435,469,505,548,590,680],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[789,972],\"errorMessage\":\"invalid number of bytes for (len+uint64[])\"},{\"pc\":[647],\"errorMessage\":\"invalid number of bytes for (len+uint8[32][])\"},{\"pc\":[287],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[242,262,271,306,1069,1188],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[254],\"errorMessage\":\"invalid number of bytes for uint8\"},{\"pc\":[1044],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[769],\"errorMessage\":\"transaction type is appl\"},{\"pc\":[758,952],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"Poll\",\"structs\":{},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"uint64\",\"name\":\"endTime\"},{\"type\":\"uint64\",\"name\":\"maxSelected\"},{\"type\":\"string\",\"name\":\"question\"},{\"type\":\"string[]\",\"name\":\"options\"},{\"type\":\"uint64\",\"name\":\"gateID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"deleteBoxes\",\"args\":[{\"type\":\"address[]\",\"name\":\"addresses\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gatedVote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"uint64[]\",\"name\":\"votes\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"vote\",\"args\":[{\"type\":\"pay\",\"name\":\"mbrPayment\"},{\"type\":\"uint64[]\",\"name\":\"votes\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"hasVoted\",\"args\":[{\"type\":\"address\",\"name\":\"user\"}],\"returns\":{\"type\":\"bool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"boxCount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Ym94X2NvdW50\",\"desc\":\"the number of boxes created during the poll\"},\"endTime\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW5kX3RpbWU=\",\"desc\":\"the time the poll ends as a unix timestamp\"},\"gateID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9pZA==\",\"desc\":\"the gate id to be used for filtering who can interact with this poll\"},\"maxSelected\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X3NlbGVjdGVk\",\"desc\":\"the maximum number of selections in a multiple choice poll\"},\"optionCount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"b3B0aW9uX2NvdW50\",\"desc\":\"the number of options in the poll\"},\"optionFive\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX2ZpdmU=\"},\"optionFour\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX2ZvdXI=\"},\"optionOne\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX29uZQ==\",\"desc\":\"the options and vote counts of the poll\"},\"optionThree\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX3RocmVl\"},\"optionTwo\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"b3B0aW9uX3R3bw==\"},\"question\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"cXVlc3Rpb24=\",\"desc\":\"the question being asked\"},\"type\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"dHlwZQ==\",\"desc\":\"The type of poll: SingleChoice, MultipleChoice, SingleChoiceImpact or MultipleChoiceImpact\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"},\"votesFive\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfZml2ZQ==\"},\"votesFour\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfZm91cg==\"},\"votesOne\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfb25l\",\"desc\":\"the number of votes for each option\"},\"votesThree\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfdGhyZWU=\"},\"votesTwo\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dm90ZXNfdHdv\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"votes\":{\"keyType\":\"address\",\"valueType\":\"AVMBytes\",\"desc\":\"A map of addresses to empty bytes to track who has voted\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDIgOCAxNTMwMAogICAgYnl0ZWNibG9jayAidm90ZXNfb25lIiAidm90ZXNfdHdvIiAidm90ZXNfdGhyZWUiICJ2b3Rlc19mb3VyIiAidm90ZXNfZml2ZSIgImFraXRhX2RhbyIgInR5cGUiICJib3hfY291bnQiICJlbmRfdGltZSIgImdhdGVfaWQiICJtYXhfc2VsZWN0ZWQiICJvcHRpb25fY291bnQiIDB4MTUxZjdjNzUKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NAogICAgLy8gdm90ZXNUaHJlZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUaHJlZSB9KQogICAgYnl0ZWNfMiAvLyAidm90ZXNfdGhyZWUiCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODUKICAgIC8vIHZvdGVzRm91ciA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGb3VyIH0pCiAgICBieXRlY18zIC8vICJ2b3Rlc19mb3VyIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAoKbWFpbl9hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjU3CiAgICAvLyBleHBvcnQgY2xhc3MgUG9sbCBleHRlbmRzIEFraXRhQmFzZUNvbnRyYWN0IHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYnogbWFpbl9jcmVhdGVfTm9PcEAxMgogICAgcHVzaGJ5dGVzcyAweGUxNDE5MDgwIDB4NWJjZjkxMDAgMHhkZDMwOWM3YiAweDVhN2Y0OTMyIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgImRlbGV0ZUJveGVzKGFkZHJlc3NbXSl2b2lkIiwgbWV0aG9kICJnYXRlZFZvdGUocGF5LGFwcGwsdWludDY0W10pdm9pZCIsIG1ldGhvZCAidm90ZShwYXksdWludDY0W10pdm9pZCIsIG1ldGhvZCAiaGFzVm90ZWQoYWRkcmVzcylib29sIiwgbWV0aG9kICJ1cGRhdGVBa2l0YURBTyh1aW50NjQpdm9pZCIsIG1ldGhvZCAib3BVcCgpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGRlbGV0ZUJveGVzIGdhdGVkVm90ZSB2b3RlIGhhc1ZvdGVkIHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAxMAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NTcKICAgIC8vIGV4cG9ydCBjbGFzcyBQb2xsIGV4dGVuZHMgQWtpdGFCYXNlQ29udHJhY3QgewogICAgcHVzaGJ5dGVzIDB4OWZhODM1YTggLy8gbWV0aG9kICJjcmVhdGUodWludDY0LHVpbnQ4LHVpbnQ2NCx1aW50NjQsc3RyaW5nLHN0cmluZ1tdLHVpbnQ2NCl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggY3JlYXRlCiAgICBlcnIKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmNyZWF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmNyZWF0ZToKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE0MQogICAgLy8gQGFiaW1ldGhvZCh7IG9uQ3JlYXRlOiAncmVxdWlyZScgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA0CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgY292ZXIgMwogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBkaWcgMQogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3V0ZjhbXSkKICAgIGV4dHJhY3QgMiAwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGNvdmVyIDUKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzMgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MQogICAgLy8gYXNzZXJ0KEdsb2JhbC5jYWxsZXJBcHBsaWNhdGlvbklkICE9PSAwLCBFUlJfQkFEX0RFUExPWUVSKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgIGFzc2VydCAvLyBNdXN0IGJlIGRlcGxveWVkIGJ5IGFuIGFwcGxpY2F0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MgogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPCBlbmRUaW1lLCBFUlJfSU5WQUxJRF9FTkRfVElNRSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGRpZyA0CiAgICA8CiAgICBhc3NlcnQgLy8gRW5kIHRpbWUgbXVzdCBiZSBpbiB0aGUgZnV0dXJlCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1MwogICAgLy8gYXNzZXJ0KHR5cGUuYXNVaW50NjQoKSA8IE11bHRpcGxlQ2hvaWNlSW1wYWN0LmFzVWludDY0KCksIEVSUl9JTlZBTElEX1BPTExfVFlQRSkKICAgIGRpZyA0CiAgICBidG9pCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICA8CiAgICBhc3NlcnQgLy8gSW52YWxpZCBwb2xsIHR5cGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBBcHBsaWNhdGlvbihha2l0YURBTykKICAgIHVuY292ZXIgNgogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1NgogICAgLy8gdGhpcy50eXBlLnZhbHVlID0gdHlwZQogICAgdW5jb3ZlciA1CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NAogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyA5IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTcKICAgIC8vIHRoaXMuZ2F0ZUlELnZhbHVlID0gZ2F0ZUlECiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNTgKICAgIC8vIHRoaXMuZW5kVGltZS52YWx1ZSA9IGVuZFRpbWUKICAgIHVuY292ZXIgMwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzQKICAgIC8vIHF1ZXN0aW9uID0gR2xvYmFsU3RhdGU8c3RyaW5nPih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5UXVlc3Rpb24gfSkKICAgIHB1c2hieXRlcyAicXVlc3Rpb24iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE1OQogICAgLy8gdGhpcy5xdWVzdGlvbi52YWx1ZSA9IHF1ZXN0aW9uCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjEKICAgIC8vIGFzc2VydChvcHRpb25zLmxlbmd0aCA+PSAyICYmIG9wdGlvbnMubGVuZ3RoIDw9IDUsIEVSUl9JTlZBTElEX09QVElPTl9DT1VOVCkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBpbnRjXzIgLy8gMgogICAgPj0KICAgIGJ6IGNyZWF0ZV9ib29sX2ZhbHNlQDQKICAgIGR1cAogICAgcHVzaGludCA1IC8vIDUKICAgIDw9CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUA0CiAgICBpbnRjXzEgLy8gMQoKY3JlYXRlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTYxCiAgICAvLyBhc3NlcnQob3B0aW9ucy5sZW5ndGggPj0gMiAmJiBvcHRpb25zLmxlbmd0aCA8PSA1LCBFUlJfSU5WQUxJRF9PUFRJT05fQ09VTlQpCiAgICBhc3NlcnQgLy8gSW52YWxpZCBudW1iZXIgb2Ygb3B0aW9ucywgbXVzdCBiZSBiZXR3ZWVuIDIgYW5kIDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTYzCiAgICAvLyBpZiAodHlwZSA9PT0gTXVsdGlwbGVDaG9pY2UgfHwgdHlwZSA9PT0gTXVsdGlwbGVDaG9pY2VJbXBhY3QpIHsKICAgIGRpZyAzCiAgICBwdXNoYnl0ZXMgMHgxNAogICAgPT0KICAgIGJueiBjcmVhdGVfaWZfYm9keUA3CiAgICBkaWcgMwogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICBieiBjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxMgoKY3JlYXRlX2lmX2JvZHlANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyBhc3NlcnQobWF4U2VsZWN0ZWQgPj0gMiAmJiBtYXhTZWxlY3RlZCA8PSBvcHRpb25zLmxlbmd0aCAtIDEsIEVSUl9JTlZBTElEX01BWF9TRUxFQ1RJT04pCiAgICBkaWcgMgogICAgaW50Y18yIC8vIDIKICAgID49CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUAxMAogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZGlnIDMKICAgID49CiAgICBieiBjcmVhdGVfYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCmNyZWF0ZV9ib29sX21lcmdlQDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIGFzc2VydChtYXhTZWxlY3RlZCA+PSAyICYmIG1heFNlbGVjdGVkIDw9IG9wdGlvbnMubGVuZ3RoIC0gMSwgRVJSX0lOVkFMSURfTUFYX1NFTEVDVElPTikKICAgIGFzc2VydCAvLyBJbnZhbGlkIG1heGltdW0gc2VsZWN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcwCiAgICAvLyBtYXhTZWxlY3RlZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU1heFNlbGVjdGVkIH0pCiAgICBieXRlYyAxMCAvLyAibWF4X3NlbGVjdGVkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjUKICAgIC8vIHRoaXMubWF4U2VsZWN0ZWQudmFsdWUgPSBtYXhTZWxlY3RlZAogICAgZGlnIDMKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjgKICAgIC8vIG9wdGlvbkNvdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5T3B0aW9uQ291bnQgfSkKICAgIGJ5dGVjIDExIC8vICJvcHRpb25fY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE2OAogICAgLy8gdGhpcy5vcHRpb25Db3VudC52YWx1ZSA9IG9wdGlvbnMubGVuZ3RoCiAgICBkaWcgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gYm94Q291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlCb3hDb3VudCB9KQogICAgYnl0ZWMgNyAvLyAiYm94X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNjkKICAgIC8vIHRoaXMuYm94Q291bnQudmFsdWUgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTcxCiAgICAvLyB0aGlzLm9wdGlvbk9uZS52YWx1ZSA9IG9wdGlvbnNbMF0KICAgIGRpZyAyCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBidXJ5IDcKICAgIGRpZyAxCiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIGRpZyAyCiAgICBjb3ZlciAyCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzYKICAgIC8vIG9wdGlvbk9uZSA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU9wdGlvbk9uZSB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzEKICAgIC8vIHRoaXMub3B0aW9uT25lLnZhbHVlID0gb3B0aW9uc1swXQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTcyCiAgICAvLyB0aGlzLm9wdGlvblR3by52YWx1ZSA9IG9wdGlvbnNbMV0KICAgIGludGNfMSAvLyAxCiAgICBkaWcgMgogICAgPAogICAgYXNzZXJ0IC8vIGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkdXAKICAgIGludGNfMiAvLyAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzcKICAgIC8vIG9wdGlvblR3byA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleU9wdGlvblR3byB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fdHdvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzIKICAgIC8vIHRoaXMub3B0aW9uVHdvLnZhbHVlID0gb3B0aW9uc1sxXQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTc0CiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gMykgewogICAgcHVzaGludCAzIC8vIDMKICAgID49CiAgICBieiBjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzUKICAgIC8vIHRoaXMub3B0aW9uVGhyZWUudmFsdWUgPSBvcHRpb25zWzJdCiAgICBpbnRjXzIgLy8gMgogICAgZGlnIDEKICAgIDwKICAgIGFzc2VydCAvLyBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDQKICAgIGR1cAogICAgcHVzaGludCA0IC8vIDQKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAyCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIGV4dHJhY3QzCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3OAogICAgLy8gb3B0aW9uVGhyZWUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25UaHJlZSB9KQogICAgcHVzaGJ5dGVzICJvcHRpb25fdGhyZWUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE3NQogICAgLy8gdGhpcy5vcHRpb25UaHJlZS52YWx1ZSA9IG9wdGlvbnNbMl0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTc4CiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gNCkgewogICAgZHVwCiAgICBwdXNoaW50IDQgLy8gNAogICAgPj0KICAgIGJ6IGNyZWF0ZV9hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE3OQogICAgLy8gdGhpcy5vcHRpb25Gb3VyLnZhbHVlID0gb3B0aW9uc1szXQogICAgcHVzaGludCAzIC8vIDMKICAgIGRpZyAxCiAgICA8CiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyA0CiAgICBkdXAKICAgIHB1c2hpbnQgNiAvLyA2CiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzkKICAgIC8vIG9wdGlvbkZvdXIgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25Gb3VyIH0pCiAgICBwdXNoYnl0ZXMgIm9wdGlvbl9mb3VyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxNzkKICAgIC8vIHRoaXMub3B0aW9uRm91ci52YWx1ZSA9IG9wdGlvbnNbM10KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBpZiAob3B0aW9ucy5sZW5ndGggPj0gNSkgewogICAgZHVwCiAgICBwdXNoaW50IDUgLy8gNQogICAgPj0KICAgIGJ6IGNyZWF0ZV9hZnRlcl9pZl9lbHNlQDE4CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4MwogICAgLy8gdGhpcy5vcHRpb25GaXZlLnZhbHVlID0gb3B0aW9uc1s0XQogICAgcHVzaGludCA0IC8vIDQKICAgIGRpZyAxCiAgICA8CiAgICBhc3NlcnQgLy8gaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGRpZyA0CiAgICBkdXAKICAgIGludGNfMyAvLyA4CiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwMgogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBleHRyYWN0MwogICAgZXh0cmFjdCAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODAKICAgIC8vIG9wdGlvbkZpdmUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25GaXZlIH0pCiAgICBwdXNoYnl0ZXMgIm9wdGlvbl9maXZlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxODMKICAgIC8vIHRoaXMub3B0aW9uRml2ZS52YWx1ZSA9IG9wdGlvbnNbNF0KICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAxODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTQxCiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKY3JlYXRlX2Jvb2xfZmFsc2VAMTA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVfYm9vbF9tZXJnZUAxMQoKY3JlYXRlX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGNyZWF0ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmRlbGV0ZUJveGVzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGVsZXRlQm94ZXM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4NwogICAgLy8gZGVsZXRlQm94ZXMoYWRkcmVzc2VzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cG4gMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgICoKICAgIGludGNfMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbMzJdW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4OAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiB0aGlzLmVuZFRpbWUudmFsdWUsIEVSUl9QT0xMX0FDVElWRSkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjY2CiAgICAvLyBlbmRUaW1lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5RW5kVGltZSB9KQogICAgYnl0ZWMgOCAvLyAiZW5kX3RpbWUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4OAogICAgLy8gYXNzZXJ0KEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPiB0aGlzLmVuZFRpbWUudmFsdWUsIEVSUl9QT0xMX0FDVElWRSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBhc3NlcnQgLy8gUG9sbCBpcyBzdGlsbCBhY3RpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYWRkcmVzc2VzLmxlbmd0aDsgaSArPSAxKSB7CiAgICBpbnRjXzAgLy8gMAoKZGVsZXRlQm94ZXNfd2hpbGVfdG9wQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5MAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFkZHJlc3Nlcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZHVwCiAgICBkaWcgMgogICAgPAogICAgYnogZGVsZXRlQm94ZXNfYWZ0ZXJfd2hpbGVANQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIHRoaXMudm90ZXMoYWRkcmVzc2VzW2ldKS5kZWxldGUoKQogICAgZGlnIDIKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMQogICAgZHVwCiAgICBjb3ZlciAyCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICAqCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBleHRyYWN0MyAvLyBvbiBlcnJvcjogaW5kZXggYWNjZXNzIGlzIG91dCBvZiBib3VuZHMKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5NC0yMDAKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBhZGRyZXNzZXNbaV0sCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgICBub3RlOiAnTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlJywKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5OAogICAgLy8gbm90ZTogJ01CUiByZWZ1bmQgZm9yIHBvbGwgdm90ZScsCiAgICBwdXNoYnl0ZXMgIk1CUiByZWZ1bmQgZm9yIHBvbGwgdm90ZSIKICAgIGl0eG5fZmllbGQgTm90ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTcKICAgIC8vIGFtb3VudDogdm90ZXNNQlIsCiAgICBpbnRjIDQgLy8gMTUzMDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE5NC0xOTkKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiBhZGRyZXNzZXNbaV0sCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgICBub3RlOiAnTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlJywKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTk0LTIwMAogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgcmVjZWl2ZXI6IGFkZHJlc3Nlc1tpXSwKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICAgIG5vdGU6ICdNQlIgcmVmdW5kIGZvciBwb2xsIHZvdGUnLAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSAtPSAxCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo3MgogICAgLy8gYm94Q291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlCb3hDb3VudCB9KQogICAgYnl0ZWMgNyAvLyAiYm94X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMDIKICAgIC8vIHRoaXMuYm94Q291bnQudmFsdWUgLT0gMQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyBib3hDb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUJveENvdW50IH0pCiAgICBieXRlYyA3IC8vICJib3hfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIwMgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSAtPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxOTAKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhZGRyZXNzZXMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDEKICAgIGIgZGVsZXRlQm94ZXNfd2hpbGVfdG9wQDIKCmRlbGV0ZUJveGVzX2FmdGVyX3doaWxlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjE4NwogICAgLy8gZGVsZXRlQm94ZXMoYWRkcmVzc2VzOiBBY2NvdW50W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmdhdGVkVm90ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmdhdGVkVm90ZToKICAgIGludGNfMCAvLyAwCiAgICBwdXNoYnl0ZXMgIiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjA4LTIxMgogICAgLy8gZ2F0ZWRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICB2b3RlczogdWludDY0W10KICAgIC8vICk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXBwbAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIHVuY292ZXIgMgogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTMKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTMKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIGFzc2VydCAvLyBQb2xsIGhhcyBlbmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTQKICAgIC8vIGFzc2VydCghdGhpcy52b3RlcyhUeG4uc2VuZGVyKS5leGlzdHMsIEVSUl9BTFJFQURZX1ZPVEVEKQogICAgdHhuIFNlbmRlcgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gVXNlciBoYXMgYWxyZWFkeSB2b3RlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTUKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gNSAmJiB2b3Rlcy5sZW5ndGggPj0gMSwgRVJSX0lOVkFMSURfVk9URSkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICA8PQogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VANAogICAgZHVwCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUA0CiAgICBpbnRjXzEgLy8gMQoKZ2F0ZWRWb3RlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE1CiAgICAvLyBhc3NlcnQodm90ZXMubGVuZ3RoIDw9IDUgJiYgdm90ZXMubGVuZ3RoID49IDEsIEVSUl9JTlZBTElEX1ZPVEUpCiAgICBhc3NlcnQgLy8gSW52YWxpZCB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIsIHRoaXMuZ2F0ZUlELnZhbHVlKSwgRVJSX0ZBSUxFRF9HQVRFKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgYnVyeSA3CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NAogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyA5IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgc3dhcAogICAgYnVyeSA3CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOQogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgZGlnIDMKICAgIGd0eG5zIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIHN3YXAKICAgIHB1c2hieXRlcyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjkKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzAKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIGRpZyAyCiAgICBndHhucyBPbkNvbXBsZXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgYm56IGdhdGVkVm90ZV9ib29sX2ZhbHNlQDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMQogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICBkaWcgMgogICAgZ3R4bnMgTnVtQXBwQXJncwogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzEKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMyCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hieXRlcyAweDQzOTIyNjU1IC8vIG1ldGhvZCAibXVzdENoZWNrKGFkZHJlc3MsdWludDY0LGJ5dGVbXVtdKXZvaWQiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMyCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgYnogZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMzCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIGRpZyAyCiAgICBpbnRjXzEgLy8gMQogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA2CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMzCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzQKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGRpZyAyCiAgICBpbnRjXzIgLy8gMgogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyA1CiAgICBpdG9iCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjM0CiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDEpID09PSBuZXcgQWRkcmVzcyhjYWxsZXIpLmJ5dGVzICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICBieiBnYXRlZFZvdGVfYm9vbF9mYWxzZUAxMwogICAgaW50Y18xIC8vIDEKCmdhdGVkVm90ZV9ib29sX21lcmdlQDE0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTYKICAgIC8vIGFzc2VydChnYXRlQ2hlY2soZ2F0ZVR4biwgdGhpcy5ha2l0YURBTy52YWx1ZSwgVHhuLnNlbmRlciwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhc3NlcnQgLy8gR2F0ZSBjaGVjayBmYWlsZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjE3LTIyNAogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB2b3Rlc01CUiwKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgZGlnIDMKICAgIGR1cAogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjIwCiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTctMjI0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgc3dhcAogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIyMQogICAgLy8gYW1vdW50OiB2b3Rlc01CUiwKICAgIGludGMgNCAvLyAxNTMwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMTctMjI0CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBheW1lbnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjI2CiAgICAvLyB0aGlzLmNyZWF0ZVZvdGUodm90ZXMpCiAgICBkaWcgMQogICAgY2FsbHN1YiBjcmVhdGVWb3RlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjA4LTIxMgogICAgLy8gZ2F0ZWRWb3RlKAogICAgLy8gICBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICB2b3RlczogdWludDY0W10KICAgIC8vICk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VAMTM6CiAgICBpbnRjXzAgLy8gMAogICAgYiBnYXRlZFZvdGVfYm9vbF9tZXJnZUAxNAoKZ2F0ZWRWb3RlX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIGdhdGVkVm90ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLnZvdGVbcm91dGluZ10oKSAtPiB2b2lkOgp2b3RlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIHZvdGUobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCB2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgY292ZXIgMgogICAgZHVwCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgaW50Y18yIC8vIDIKICAgICsKICAgIHVuY292ZXIgMgogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2NgogICAgLy8gZW5kVGltZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUVuZFRpbWUgfSkKICAgIGJ5dGVjIDggLy8gImVuZF90aW1lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzAKICAgIC8vIGFzc2VydChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZS52YWx1ZSwgRVJSX1BPTExfRU5ERUQpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIGFzc2VydCAvLyBQb2xsIGhhcyBlbmRlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzEKICAgIC8vIGFzc2VydCghdGhpcy52b3RlcyhUeG4uc2VuZGVyKS5leGlzdHMsIEVSUl9BTFJFQURZX1ZPVEVEKQogICAgdHhuIFNlbmRlcgogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gVXNlciBoYXMgYWxyZWFkeSB2b3RlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzIKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gNSAmJiB2b3Rlcy5sZW5ndGggPj0gMSwgRVJSX0lOVkFMSURfVk9URSkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICA8PQogICAgYnogdm90ZV9ib29sX2ZhbHNlQDQKICAgIGR1cAogICAgYnogdm90ZV9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgp2b3RlX2Jvb2xfbWVyZ2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBhc3NlcnQodm90ZXMubGVuZ3RoIDw9IDUgJiYgdm90ZXMubGVuZ3RoID49IDEsIEVSUl9JTlZBTElEX1ZPVEUpCiAgICBhc3NlcnQgLy8gSW52YWxpZCB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBhc3NlcnQodGhpcy5nYXRlSUQudmFsdWUgPT09IDAsIEVSUl9IQVNfR0FURSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjY0CiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDkgLy8gImdhdGVfaWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzMwogICAgLy8gYXNzZXJ0KHRoaXMuZ2F0ZUlELnZhbHVlID09PSAwLCBFUlJfSEFTX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgIQogICAgYXNzZXJ0IC8vIFRoaXMgaGFzIGEgZ2F0ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzQtMjQxCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHZvdGVzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMzcKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzNC0yNDEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICBzd2FwCiAgICBndHhucyBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjM4CiAgICAvLyBhbW91bnQ6IHZvdGVzTUJSLAogICAgaW50YyA0IC8vIDE1MzAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjIzNC0yNDEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBtYnJQYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogdm90ZXNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIHRoaXMuY3JlYXRlVm90ZSh2b3RlcykKICAgIGRpZyAxCiAgICBjYWxsc3ViIGNyZWF0ZVZvdGUKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIHZvdGUobWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCB2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnZvdGVfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgdm90ZV9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmhhc1ZvdGVkW3JvdXRpbmddKCkgLT4gdm9pZDoKaGFzVm90ZWQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjI0NgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCAzMiAvLyAzMgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDhbMzJdCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjI0OAogICAgLy8gcmV0dXJuIHRoaXMudm90ZXModXNlcikuZXhpc3RzCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MjQ2CiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHB1c2hieXRlcyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjIDEyIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18zIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA1IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNSAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjpQb2xsLmNyZWF0ZVZvdGUodm90ZXM6IGJ5dGVzKSAtPiBieXRlczoKY3JlYXRlVm90ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTUKICAgIC8vIHByaXZhdGUgY3JlYXRlVm90ZSh2b3RlczogdWludDY0W10pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTYKICAgIC8vIGxldCBpbXBhY3Q6IHVpbnQ2NCA9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QgfHwgdGhpcy50eXBlLnZhbHVlID09PSBNdWx0aXBsZUNob2ljZUltcGFjdCkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk3CiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QgfHwgdGhpcy50eXBlLnZhbHVlID09PSBNdWx0aXBsZUNob2ljZUltcGFjdCkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDFlCiAgICA9PQogICAgYm56IGNyZWF0ZVZvdGVfaWZfYm9keUAyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2MgogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFVpbnQ4Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNiAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6OTcKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFNpbmdsZUNob2ljZUltcGFjdCB8fCB0aGlzLnR5cGUudmFsdWUgPT09IE11bHRpcGxlQ2hvaWNlSW1wYWN0KSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzIDB4MjgKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMwoKY3JlYXRlVm90ZV9pZl9ib2R5QDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk4CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA1IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjk4CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBzd2FwCiAgICBwdXNoYnl0ZXMgInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KGFraXRhREFPKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkNTc0YmIxMCAvLyBtZXRob2QgImdldFVzZXJJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjIDEyIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMyAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGZyYW1lX2J1cnkgNAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlIHx8IHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlSW1wYWN0KSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2MgogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFVpbnQ4Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNiAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTAxCiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2UgfHwgdGhpcy50eXBlLnZhbHVlID09PSBTaW5nbGVDaG9pY2VJbXBhY3QpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBwdXNoYnl0ZXMgMHgwYQogICAgPT0KICAgIGJueiBjcmVhdGVWb3RlX2lmX2JvZHlANQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NjIKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxVaW50OD4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDYgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlIHx8IHRoaXMudHlwZS52YWx1ZSA9PT0gU2luZ2xlQ2hvaWNlSW1wYWN0KSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzIDB4MWUKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2Vsc2VfYm9keUAyMAoKY3JlYXRlVm90ZV9pZl9ib2R5QDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwMgogICAgLy8gYXNzZXJ0KHZvdGVzLmxlbmd0aCA9PT0gMSwgRVJSX0lOVkFMSURfVk9URV9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIG51bWJlciBvZiB2b3RlIGFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA0CiAgICAvLyBpZiAodm90ZXNbMF0gPT09IDApIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18yIC8vIDIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgYm56IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgyCiAgICAvLyB2b3Rlc09uZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNPbmUgfSkKICAgIGJ5dGVjXzAgLy8gInZvdGVzX29uZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4MgogICAgLy8gdm90ZXNPbmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzT25lIH0pCiAgICBieXRlY18wIC8vICJ2b3Rlc19vbmUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwNQogICAgLy8gdGhpcy52b3Rlc09uZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzUKICAgIC8vIHRoaXMudm90ZXMoVHhuLnNlbmRlcikuY3JlYXRlKCkKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyB0aGlzLmJveENvdW50LnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjcyCiAgICAvLyBib3hDb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleUJveENvdW50IH0pCiAgICBieXRlYyA3IC8vICJib3hfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEzNgogICAgLy8gdGhpcy5ib3hDb3VudC52YWx1ZSArPSAxCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzIKICAgIC8vIGJveENvdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Qm94Q291bnQgfSkKICAgIGJ5dGVjIDcgLy8gImJveF9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyB0aGlzLmJveENvdW50LnZhbHVlICs9IDEKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjcmVhdGVWb3RlX2Vsc2VfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDYKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbMF0gPT09IDEpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IDEKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA3CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4MwogICAgLy8gdm90ZXNUd28gPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVHdvIH0pCiAgICBieXRlY18xIC8vICJ2b3Rlc190d28iCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwNwogICAgLy8gdGhpcy52b3Rlc1R3by52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEwOAogICAgLy8gfSBlbHNlIGlmICh2b3Rlc1swXSA9PT0gMikgewogICAgZnJhbWVfZGlnIDIKICAgIGludGNfMiAvLyAyCiAgICA9PQogICAgYnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTA5CiAgICAvLyB0aGlzLnZvdGVzVGhyZWUudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDkKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSAxCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMDkKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTAKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbMF0gPT09IDMpIHsKICAgIGZyYW1lX2RpZyAyCiAgICBwdXNoaW50IDMgLy8gMwogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gMQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODUKICAgIC8vIHZvdGVzRm91ciA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGb3VyIH0pCiAgICBieXRlY18zIC8vICJ2b3Rlc19mb3VyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTEKICAgIC8vIHRoaXMudm90ZXNGb3VyLnZhbHVlICs9IDEKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdm90ZXNGb3VyID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZvdXIgfSkKICAgIGJ5dGVjXzMgLy8gInZvdGVzX2ZvdXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIGIgY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4CgpjcmVhdGVWb3RlX2Vsc2VfYm9keUAxMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEyCiAgICAvLyB9IGVsc2UgaWYgKHZvdGVzWzBdID09PSA0KSB7CiAgICBmcmFtZV9kaWcgMgogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEzCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSAxCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NgogICAgLy8gdm90ZXNGaXZlID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZpdmUgfSkKICAgIGJ5dGVjIDQgLy8gInZvdGVzX2ZpdmUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExMwogICAgLy8gdGhpcy52b3Rlc0ZpdmUudmFsdWUgKz0gMQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTEzCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSAxCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzgKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDIwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTYKICAgIC8vIGFzc2VydCh2b3Rlcy5sZW5ndGggPD0gdGhpcy5tYXhTZWxlY3RlZC52YWx1ZSwgRVJSX0lOVkFMSURfVk9URV9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6NzAKICAgIC8vIG1heFNlbGVjdGVkID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9sbEdsb2JhbFN0YXRlS2V5TWF4U2VsZWN0ZWQgfSkKICAgIGJ5dGVjIDEwIC8vICJtYXhfc2VsZWN0ZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gYXNzZXJ0KHZvdGVzLmxlbmd0aCA8PSB0aGlzLm1heFNlbGVjdGVkLnZhbHVlLCBFUlJfSU5WQUxJRF9WT1RFX0NPVU5UKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDw9CiAgICBhc3NlcnQgLy8gSW52YWxpZCBudW1iZXIgb2Ygdm90ZSBhcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IHZvdGVzLmxlbmd0aDsgaSArPSAxKSB7CiAgICBpbnRjXzAgLy8gMAogICAgZnJhbWVfYnVyeSAxCgpjcmVhdGVWb3RlX3doaWxlX3RvcEAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgdm90ZXMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGZyYW1lX2RpZyAxCiAgICBmcmFtZV9kaWcgMAogICAgPAogICAgYnogY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjExOQogICAgLy8gYXNzZXJ0KHZvdGVzW2ldIDw9IHRoaXMub3B0aW9uQ291bnQudmFsdWUgLSAxLCBFUlJfSU5WQUxJRF9WT1RFX09QVElPTikKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyAxCiAgICBpbnRjXzMgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSAzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo2OAogICAgLy8gb3B0aW9uQ291bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlPcHRpb25Db3VudCB9KQogICAgYnl0ZWMgMTEgLy8gIm9wdGlvbl9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTE5CiAgICAvLyBhc3NlcnQodm90ZXNbaV0gPD0gdGhpcy5vcHRpb25Db3VudC52YWx1ZSAtIDEsIEVSUl9JTlZBTElEX1ZPVEVfT1BUSU9OKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkaWcgMQogICAgPj0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHZvdGUgb3B0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyMQogICAgLy8gaWYgKHZvdGVzW2ldID09PSAwKSB7CiAgICBibnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyB0aGlzLnZvdGVzT25lLnZhbHVlICs9IGltcGFjdAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIHRoaXMudm90ZXNPbmUudmFsdWUgKz0gaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODIKICAgIC8vIHZvdGVzT25lID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc09uZSB9KQogICAgYnl0ZWNfMCAvLyAidm90ZXNfb25lIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIHRoaXMudm90ZXNPbmUudmFsdWUgKz0gaW1wYWN0CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAoKY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCB2b3Rlcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZnJhbWVfZGlnIDEKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDEKICAgIGIgY3JlYXRlVm90ZV93aGlsZV90b3BAMjEKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDI0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjMKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbaV0gPT09IDEpIHsKICAgIGZyYW1lX2RpZyAzCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfZWxzZV9ib2R5QDI2CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gdGhpcy52b3Rlc1R3by52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IGltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjgzCiAgICAvLyB2b3Rlc1R3byA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNUd28gfSkKICAgIGJ5dGVjXzEgLy8gInZvdGVzX3R3byIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyB0aGlzLnZvdGVzVHdvLnZhbHVlICs9IGltcGFjdAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIGIgY3JlYXRlVm90ZV9hZnRlcl9pZl9lbHNlQDM2CgpjcmVhdGVWb3RlX2Vsc2VfYm9keUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI1CiAgICAvLyB9IGVsc2UgaWYgKHZvdGVzW2ldID09PSAyKSB7CiAgICBmcmFtZV9kaWcgMwogICAgaW50Y18yIC8vIDIKICAgID09CiAgICBieiBjcmVhdGVWb3RlX2Vsc2VfYm9keUAyOAogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg0CiAgICAvLyB2b3Rlc1RocmVlID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc1RocmVlIH0pCiAgICBieXRlY18yIC8vICJ2b3Rlc190aHJlZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyB0aGlzLnZvdGVzVGhyZWUudmFsdWUgKz0gaW1wYWN0CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODQKICAgIC8vIHZvdGVzVGhyZWUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzVGhyZWUgfSkKICAgIGJ5dGVjXzIgLy8gInZvdGVzX3RocmVlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIHRoaXMudm90ZXNUaHJlZS52YWx1ZSArPSBpbXBhY3QKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBiIGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgoKY3JlYXRlVm90ZV9lbHNlX2JvZHlAMjg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyNwogICAgLy8gfSBlbHNlIGlmICh2b3Rlc1tpXSA9PT0gMykgewogICAgZnJhbWVfZGlnIDMKICAgIHB1c2hpbnQgMyAvLyAzCiAgICA9PQogICAgYnogY3JlYXRlVm90ZV9lbHNlX2JvZHlAMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyB0aGlzLnZvdGVzRm91ci52YWx1ZSArPSBpbXBhY3QKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg1CiAgICAvLyB2b3Rlc0ZvdXIgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRm91ciB9KQogICAgYnl0ZWNfMyAvLyAidm90ZXNfZm91ciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyB0aGlzLnZvdGVzRm91ci52YWx1ZSArPSBpbXBhY3QKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBmcmFtZV9kaWcgNAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdm90ZXNGb3VyID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb2xsR2xvYmFsU3RhdGVLZXlWb3Rlc0ZvdXIgfSkKICAgIGJ5dGVjXzMgLy8gInZvdGVzX2ZvdXIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gdGhpcy52b3Rlc0ZvdXIudmFsdWUgKz0gaW1wYWN0CiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgYiBjcmVhdGVWb3RlX2FmdGVyX2lmX2Vsc2VAMzYKCmNyZWF0ZVZvdGVfZWxzZV9ib2R5QDMwOgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMjkKICAgIC8vIH0gZWxzZSBpZiAodm90ZXNbaV0gPT09IDQpIHsKICAgIGZyYW1lX2RpZyAzCiAgICBwdXNoaW50IDQgLy8gNAogICAgPT0KICAgIGJ6IGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIHRoaXMudm90ZXNGaXZlLnZhbHVlICs9IGltcGFjdAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6ODYKICAgIC8vIHZvdGVzRml2ZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9sbEdsb2JhbFN0YXRlS2V5Vm90ZXNGaXZlIH0pCiAgICBieXRlYyA0IC8vICJ2b3Rlc19maXZlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3BvbGwvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIHRoaXMudm90ZXNGaXZlLnZhbHVlICs9IGltcGFjdAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyA0CiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvcG9sbC9jb250cmFjdC5hbGdvLnRzOjg2CiAgICAvLyB2b3Rlc0ZpdmUgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvbGxHbG9iYWxTdGF0ZUtleVZvdGVzRml2ZSB9KQogICAgYnl0ZWMgNCAvLyAidm90ZXNfZml2ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9wb2xsL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyB0aGlzLnZvdGVzRml2ZS52YWx1ZSArPSBpbXBhY3QKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICBiIGNyZWF0ZVZvdGVfYWZ0ZXJfaWZfZWxzZUAzNgo=\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAFAAECCMR3Jg0Jdm90ZXNfb25lCXZvdGVzX3R3bwt2b3Rlc190aHJlZQp2b3Rlc19mb3VyCnZvdGVzX2ZpdmUJYWtpdGFfZGFvBHR5cGUJYm94X2NvdW50CGVuZF90aW1lB2dhdGVfaWQMbWF4X3NlbGVjdGVkDG9wdGlvbl9jb3VudAQVH3x1MRhAABAoImcpImcqImcrImcnBCJnMRkURDEYQQA0ggYE4UGQgARbz5EABN0wnHsEWn9JMgQz6SyUBIVN7eA2GgCOBgGcAhEC1gMzA00AAQAjQ4AEn6g1qDYaAI4BAAEAIjYaAUkVJRJEFzYaAklOAkkVIxJENhoDSRUlEkQXNhoESRUlEkQXTgM2GgVJIlkkCEsBFRJEVwIANhoGSU4CTgU2GgdJFSUSRBcyDUQyB0sEDERLBBeBKAxEJwVPBmcnBk8FZycJTGcnCE8DZ4AIcXVlc3Rpb25MZyJZSSQPQQEGSYEFDkEA/yNESwOAARQSQAAJSwOAASgSQQAXSwIkD0EA4EkjCUsDD0EA1yNEJwpLA2cnC0sBSU4CZycHImdLAlcCAElFB0sBREkiWUpZJAhLAk4CWFcCAIAKb3B0aW9uX29uZUxnI0sCDERJJFlKWSQIWFcCAIAKb3B0aW9uX3R3b0xngQMPQQAjJEsBDERLBEmBBFlKWSQIWFcCAIAMb3B0aW9uX3RocmVlTGdJgQQPQQAjgQNLAQxESwRJgQZZSlkkCFhXAgCAC29wdGlvbl9mb3VyTGdJgQUPQQAigQRLAQxESwRJJVlKWSQIWFcCAIALb3B0aW9uX2ZpdmVMZyNDIkL/JiJC/v42GgFHAiJZSU4CgSALJAhMFRJEMgciJwhlRA1EIklLAgxBAE9LAlcCAEsBSU4CgSALgSBYSbxIsYAYTUJSIHJlZnVuZCBmb3IgcG9sbCB2b3RlsgUhBLIIsgcjshAisgGzIicHZUQjCScHTGcjCEUBQv+qI0MigAAxFiQJSTgQIxJEMRYjCUk4EIEGEkQ2GgFHAiJZSU4CSSULJAhPAhUSRDIHIicIZUQORDEAvUUBFESBBQ5BAH9JQQB7I0QiJwVlRDEARQciJwllTEUHREsDOBhMgANhYWxlSIEoWxJBAFFLAjgZQABKSwI4G4EEEkEAQEsCIsIagARDkiZVEkEAMUsCI8IaSwYSQQAmSwIkwhpLBRYSQQAaI0RLA0k4BzIKEkw4CCEEEhBESwGIAKdII0MiQv/jIkL/gjEWIwlJOBAjEkQ2GgFHAiJZSU4CSSULJAhPAhUSRDIHIicIZUQORDEAvUUBFESBBQ5BACVJQQAhI0QiJwllRBRESwJJOAcyChJMOAghBBIQREsBiABGSCNDIkL/3DYaAUkVgSASRL1FAYABACJPAlQnDExQsCNDNhoBSRUlEkQXMQAiJwVlRIAGd2FsbGV0ZUhyCEQSRCcFTGcjQ4oBAYAARwMjIicGZUSAAR4SQAAMIicGZUSAASgSQQA9IicFZUQxALFMgANzYWxlSIEQW4AE1XS7ELIashiyGoEGshAisgGztD5JVwQATFcABCcMEkRJFSUSRBeMBCInBmVEgAEKEkAADCInBmVEgAEeEkEAf4v/IlkjEkSL/yRbSYwCQAAeIihlRCMIKExnMQAiuUgiJwdlRCMIJwdMZ4v/jACJiwIjEkEADCIpZUQjCClMZ0L/2IsCJBJBAAwiKmVEIwgqTGdC/8WLAoEDEkEADCIrZUQjCCtMZ0L/sYsCgQQSQf+pIicEZUQjCCcETGdC/5uL/yJZSYwAIicKZUQORCKMAYsBiwAMQf+Ci/9XAgCLASULW0mMAyInC2VEIwlLAQ9EQAATIihlRIsECChMZ4sBIwiMAUL/yosDIxJBAA0iKWVEiwQIKUxnQv/jiwMkEkEADSIqZUSLBAgqTGdC/8+LA4EDEkEADSIrZUSLBAgrTGdC/7qLA4EEEkH/siInBGVEiwQIJwRMZ0L/ow==\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "bf5a32a92b7360338d554f8c1afd6f0d0f1914b6bb41d0d600c655df99d834b1"
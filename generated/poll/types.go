@@ -9,47 +9,47 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao    uint64
-	Type        uint8
-	EndTime     uint64
-	MaxSelected uint64
-	Question    string
-	Options     []string
-	GateID      uint64
+	AkitaDao    uint64   `json:"akitaDAO"`
+	Type        uint8    `json:"type"`
+	EndTime     uint64   `json:"endTime"`
+	MaxSelected uint64   `json:"maxSelected"`
+	Question    string   `json:"question"`
+	Options     []string `json:"options"`
+	GateID      uint64   `json:"gateID"`
 }
 
 // DeleteBoxesArgs holds the arguments for the deleteBoxes method.
 type DeleteBoxesArgs struct {
-	Addresses []types.Address
+	Addresses []types.Address `json:"addresses"`
 }
 
 // GatedVoteArgs holds the arguments for the gatedVote method.
 type GatedVoteArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	GateTXN    transaction.TransactionWithSigner
-	Votes      []uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	GateTXN    transaction.TransactionWithSigner `json:"-"`
+	Votes      []uint64                          `json:"votes"`
 }
 
 // VoteArgs holds the arguments for the vote method.
 type VoteArgs struct {
-	MBRPayment transaction.TransactionWithSigner
-	Votes      []uint64
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
+	Votes      []uint64                          `json:"votes"`
 }
 
 // HasVotedArgs holds the arguments for the hasVoted method.
 type HasVotedArgs struct {
-	User types.Address
+	User types.Address `json:"user"`
 }
 
 // HasVotedMethodResult holds the result of calling hasVoted.
 type HasVotedMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -3,82 +3,95 @@ package pollfactory
 
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	poll "github.com/kylebeee/algokit-client-generator-go/generated/poll"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	ChildVersion   string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	ChildVersion   string `json:"childVersion"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // NewArgs holds the arguments for the new method.
 type NewArgs struct {
-	Payment     transaction.TransactionWithSigner
-	Type        uint8
-	EndTime     uint64
-	MaxSelected uint64
-	Question    string
-	Options     []string
-	GateID      uint64
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	Type        uint8                             `json:"type"`
+	EndTime     uint64                            `json:"endTime"`
+	MaxSelected uint64                            `json:"maxSelected"`
+	Question    string                            `json:"question"`
+	Options     []string                          `json:"options"`
+	GateID      uint64                            `json:"gateID"`
 }
 
 // NewMethodResult holds the result of calling new.
 type NewMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the poll app created by new,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewMethodResult) Child() (*poll.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return poll.NewClientFromSpec(params)
 }
 
 // NewPollCostMethodResult holds the result of calling newPollCost.
 type NewPollCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
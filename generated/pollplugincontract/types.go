@@ -8,49 +8,49 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	Factory  uint64
-	AkitaDao uint64
+	Version  string `json:"version"`
+	Factory  uint64 `json:"factory"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // NewArgs holds the arguments for the new method.
 type NewArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	Type        uint8
-	EndTime     uint64
-	MaxSelected uint64
-	Question    string
-	Options     []string
-	GateID      uint64
+	Wallet      uint64   `json:"wallet"`
+	RekeyBack   bool     `json:"rekeyBack"`
+	Type        uint8    `json:"type"`
+	EndTime     uint64   `json:"endTime"`
+	MaxSelected uint64   `json:"maxSelected"`
+	Question    string   `json:"question"`
+	Options     []string `json:"options"`
+	GateID      uint64   `json:"gateID"`
 }
 
 // NewMethodResult holds the result of calling new.
 type NewMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // DeleteBoxesArgs holds the arguments for the deleteBoxes method.
 type DeleteBoxesArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	PollAppID uint64
-	Addresses []types.Address
+	Wallet    uint64          `json:"wallet"`
+	RekeyBack bool            `json:"rekeyBack"`
+	PollAppID uint64          `json:"pollAppID"`
+	Addresses []types.Address `json:"addresses"`
 }
 
 // VoteArgs holds the arguments for the vote method.
 type VoteArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	PollAppID uint64
-	Votes     []uint64
-	Args      [][]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	PollAppID uint64   `json:"pollAppID"`
+	Votes     []uint64 `json:"votes"`
+	Args      [][]byte `json:"args"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
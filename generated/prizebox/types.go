@@ -9,23 +9,23 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Owner types.Address
+	Owner types.Address `json:"owner"`
 }
 
 // OptinArgs holds the arguments for the optin method.
 type OptinArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // TransferArgs holds the arguments for the transfer method.
 type TransferArgs struct {
-	NewOwner types.Address
+	NewOwner types.Address `json:"newOwner"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	Assets [][]interface{}
+	Assets []Tuple2[uint64, uint64] `json:"assets"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
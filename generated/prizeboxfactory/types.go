@@ -4,69 +4,82 @@ package prizeboxfactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	prizebox "github.com/kylebeee/algokit-client-generator-go/generated/prizebox"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MintArgs holds the arguments for the mint method.
 type MintArgs struct {
-	Payment transaction.TransactionWithSigner
-	Owner   types.Address
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Owner   types.Address                     `json:"owner"`
 }
 
 // MintMethodResult holds the result of calling mint.
 type MintMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the prizebox app created by mint,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *MintMethodResult) Child() (*prizebox.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return prizebox.NewClientFromSpec(params)
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
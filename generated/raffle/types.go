@@ -7,6 +7,24 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// EntryData is a generated struct type.
+type EntryData struct {
+	Account     types.Address `json:"account"`
+	Marketplace types.Address `json:"marketplace"`
+}
+
+// FindWinnerCursors is a generated struct type.
+type FindWinnerCursors struct {
+	Index       uint64 `json:"index"`
+	AmountIndex uint64 `json:"amountIndex"`
+}
+
+// FunderInfo is a generated struct type.
+type FunderInfo struct {
+	Account types.Address `json:"account"`
+	Amount  uint64        `json:"amount"`
+}
+
 // RaffleMBRData is a generated struct type.
 type RaffleMBRData struct {
 	Entries          uint64 `json:"entries"`
@@ -34,134 +52,116 @@ type RaffleState struct {
 	RefundMBRCursor uint64        `json:"refundMBRCursor"`
 }
 
-// FunderInfo is a generated struct type.
-type FunderInfo struct {
-	Account types.Address `json:"account"`
-	Amount  uint64        `json:"amount"`
-}
-
-// EntryData is a generated struct type.
-type EntryData struct {
-	Account     types.Address `json:"account"`
-	Marketplace types.Address `json:"marketplace"`
-}
-
-// FindWinnerCursors is a generated struct type.
-type FindWinnerCursors struct {
-	Index       uint64 `json:"index"`
-	AmountIndex uint64 `json:"amountIndex"`
-}
-
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Prize          uint64
-	IsPrizeBox     bool
-	TicketAsset    uint64
-	StartTimestamp uint64
-	EndTimestamp   uint64
-	Seller         types.Address
-	Funder         FunderInfo
-	CreatorRoyalty uint64
-	MinTickets     uint64
-	MaxTickets     uint64
-	GateID         uint64
-	Marketplace    types.Address
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Prize          uint64        `json:"prize"`
+	IsPrizeBox     bool          `json:"isPrizeBox"`
+	TicketAsset    uint64        `json:"ticketAsset"`
+	StartTimestamp uint64        `json:"startTimestamp"`
+	EndTimestamp   uint64        `json:"endTimestamp"`
+	Seller         types.Address `json:"seller"`
+	Funder         FunderInfo    `json:"funder"`
+	CreatorRoyalty uint64        `json:"creatorRoyalty"`
+	MinTickets     uint64        `json:"minTickets"`
+	MaxTickets     uint64        `json:"maxTickets"`
+	GateID         uint64        `json:"gateID"`
+	Marketplace    types.Address `json:"marketplace"`
+	AkitaDao       uint64        `json:"akitaDAO"`
+	AkitaDaoEscrow uint64        `json:"akitaDAOEscrow"`
 }
 
 // InitArgs holds the arguments for the init method.
 type InitArgs struct {
-	Payment          transaction.TransactionWithSigner
-	WeightListLength uint64
+	Payment          transaction.TransactionWithSigner `json:"-"`
+	WeightListLength uint64                            `json:"weightListLength"`
 }
 
 // RefundMBRArgs holds the arguments for the refundMBR method.
 type RefundMBRArgs struct {
-	IterationAmount uint64
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // ClearWeightsBoxesMethodResult holds the result of calling clearWeightsBoxes.
 type ClearWeightsBoxesMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EnterArgs holds the arguments for the enter method.
 type EnterArgs struct {
-	Payment     transaction.TransactionWithSigner
-	Marketplace types.Address
-	Args        [][]byte
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
+	Args        [][]byte                          `json:"args"`
 }
 
 // EnterASAArgs holds the arguments for the enterAsa method.
 type EnterASAArgs struct {
-	Payment     transaction.TransactionWithSigner
-	AssetXfer   transaction.TransactionWithSigner
-	Marketplace types.Address
-	Args        [][]byte
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	Marketplace types.Address                     `json:"marketplace"`
+	Args        [][]byte                          `json:"args"`
 }
 
 // AddArgs holds the arguments for the add method.
 type AddArgs struct {
-	Payment transaction.TransactionWithSigner
-	Args    [][]byte
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Args    [][]byte                          `json:"args"`
 }
 
 // GatedAddASAArgs holds the arguments for the gatedAddAsa method.
 type GatedAddASAArgs struct {
-	GateTXN   transaction.TransactionWithSigner
-	AssetXfer transaction.TransactionWithSigner
+	GateTXN   transaction.TransactionWithSigner `json:"-"`
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
 }
 
 // AddASAArgs holds the arguments for the addAsa method.
 type AddASAArgs struct {
-	AssetXfer transaction.TransactionWithSigner
-	Args      [][]byte
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
+	Args      [][]byte                          `json:"args"`
 }
 
 // FindWinnerArgs holds the arguments for the findWinner method.
 type FindWinnerArgs struct {
-	IterationAmount uint64
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // IsLiveMethodResult holds the result of calling isLive.
 type IsLiveMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetStateMethodResult holds the result of calling getState.
 type GetStateMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RaffleState
+	Return RaffleState `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RaffleMBRData
+	Return RaffleMBRData `json:"return"`
 }
 
 // OptinArgs holds the arguments for the optin method.
 type OptinArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -4,6 +4,7 @@ package rafflefactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	raffle "github.com/kylebeee/algokit-client-generator-go/generated/raffle"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -16,107 +17,131 @@ type RaffleMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	ChildVersion   string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	ChildVersion   string `json:"childVersion"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // NewRaffleArgs holds the arguments for the newRaffle method.
 type NewRaffleArgs struct {
-	Payment          transaction.TransactionWithSigner
-	AssetXfer        transaction.TransactionWithSigner
-	TicketAsset      uint64
-	StartTimestamp   uint64
-	EndTimestamp     uint64
-	MinTickets       uint64
-	MaxTickets       uint64
-	GateID           uint64
-	Marketplace      types.Address
-	Name             string
-	Proof            [][32]byte
-	WeightsListCount uint64
+	Payment          transaction.TransactionWithSigner `json:"-"`
+	AssetXfer        transaction.TransactionWithSigner `json:"-"`
+	TicketAsset      uint64                            `json:"ticketAsset"`
+	StartTimestamp   uint64                            `json:"startTimestamp"`
+	EndTimestamp     uint64                            `json:"endTimestamp"`
+	MinTickets       uint64                            `json:"minTickets"`
+	MaxTickets       uint64                            `json:"maxTickets"`
+	GateID           uint64                            `json:"gateID"`
+	Marketplace      types.Address                     `json:"marketplace"`
+	Name             string                            `json:"name"`
+	Proof            [][32]byte                        `json:"proof"`
+	WeightsListCount uint64                            `json:"weightsListCount"`
 }
 
 // NewRaffleMethodResult holds the result of calling newRaffle.
 type NewRaffleMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the raffle app created by newRaffle,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewRaffleMethodResult) Child() (*raffle.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return raffle.NewClientFromSpec(params)
 }
 
 // NewPrizeBoxRaffleArgs holds the arguments for the newPrizeBoxRaffle method.
 type NewPrizeBoxRaffleArgs struct {
-	Payment          transaction.TransactionWithSigner
-	PrizeBox         uint64
-	TicketAsset      uint64
-	StartTimestamp   uint64
-	EndTimestamp     uint64
-	MinTickets       uint64
-	MaxTickets       uint64
-	GateID           uint64
-	Marketplace      types.Address
-	WeightsListCount uint64
+	Payment          transaction.TransactionWithSigner `json:"-"`
+	PrizeBox         uint64                            `json:"prizeBox"`
+	TicketAsset      uint64                            `json:"ticketAsset"`
+	StartTimestamp   uint64                            `json:"startTimestamp"`
+	EndTimestamp     uint64                            `json:"endTimestamp"`
+	MinTickets       uint64                            `json:"minTickets"`
+	MaxTickets       uint64                            `json:"maxTickets"`
+	GateID           uint64                            `json:"gateID"`
+	Marketplace      types.Address                     `json:"marketplace"`
+	WeightsListCount uint64                            `json:"weightsListCount"`
 }
 
 // NewPrizeBoxRaffleMethodResult holds the result of calling newPrizeBoxRaffle.
 type NewPrizeBoxRaffleMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the raffle app created by newPrizeBoxRaffle,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewPrizeBoxRaffleMethodResult) Child() (*raffle.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return raffle.NewClientFromSpec(params)
 }
 
 // DeleteRaffleArgs holds the arguments for the deleteRaffle method.
 type DeleteRaffleArgs struct {
-	AppID uint64
+	AppID uint64 `json:"appId"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RaffleMBRData
+	Return RaffleMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
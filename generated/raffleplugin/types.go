@@ -15,112 +15,112 @@ type RaffleMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version string
-	Factory uint64
+	Version string `json:"version"`
+	Factory uint64 `json:"factory"`
 }
 
 // NewRaffleArgs holds the arguments for the newRaffle method.
 type NewRaffleArgs struct {
-	Wallet           uint64
-	RekeyBack        bool
-	PrizeID          uint64
-	PrizeAmount      uint64
-	TicketAssetID    uint64
-	StartTimestamp   uint64
-	EndTimestamp     uint64
-	MinTickets       uint64
-	MaxTickets       uint64
-	GateID           uint64
-	Marketplace      types.Address
-	Name             string
-	Proof            [][32]byte
-	WeightsListCount uint64
+	Wallet           uint64        `json:"wallet"`
+	RekeyBack        bool          `json:"rekeyBack"`
+	PrizeID          uint64        `json:"prizeID"`
+	PrizeAmount      uint64        `json:"prizeAmount"`
+	TicketAssetID    uint64        `json:"ticketAssetID"`
+	StartTimestamp   uint64        `json:"startTimestamp"`
+	EndTimestamp     uint64        `json:"endTimestamp"`
+	MinTickets       uint64        `json:"minTickets"`
+	MaxTickets       uint64        `json:"maxTickets"`
+	GateID           uint64        `json:"gateID"`
+	Marketplace      types.Address `json:"marketplace"`
+	Name             string        `json:"name"`
+	Proof            [][32]byte    `json:"proof"`
+	WeightsListCount uint64        `json:"weightsListCount"`
 }
 
 // NewRaffleMethodResult holds the result of calling newRaffle.
 type NewRaffleMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // NewPrizeBoxRaffleArgs holds the arguments for the newPrizeBoxRaffle method.
 type NewPrizeBoxRaffleArgs struct {
-	Wallet           uint64
-	RekeyBack        bool
-	PrizeBox         uint64
-	TicketAssetID    uint64
-	StartTimestamp   uint64
-	EndTimestamp     uint64
-	MinTickets       uint64
-	MaxTickets       uint64
-	GateID           uint64
-	Marketplace      types.Address
-	WeightsListCount uint64
+	Wallet           uint64        `json:"wallet"`
+	RekeyBack        bool          `json:"rekeyBack"`
+	PrizeBox         uint64        `json:"prizeBox"`
+	TicketAssetID    uint64        `json:"ticketAssetID"`
+	StartTimestamp   uint64        `json:"startTimestamp"`
+	EndTimestamp     uint64        `json:"endTimestamp"`
+	MinTickets       uint64        `json:"minTickets"`
+	MaxTickets       uint64        `json:"maxTickets"`
+	GateID           uint64        `json:"gateID"`
+	Marketplace      types.Address `json:"marketplace"`
+	WeightsListCount uint64        `json:"weightsListCount"`
 }
 
 // NewPrizeBoxRaffleMethodResult holds the result of calling newPrizeBoxRaffle.
 type NewPrizeBoxRaffleMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EnterArgs holds the arguments for the enter method.
 type EnterArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	AppID       uint64
-	Amount      uint64
-	Marketplace types.Address
-	Args        [][]byte
+	Wallet      uint64        `json:"wallet"`
+	RekeyBack   bool          `json:"rekeyBack"`
+	AppID       uint64        `json:"appId"`
+	Amount      uint64        `json:"amount"`
+	Marketplace types.Address `json:"marketplace"`
+	Args        [][]byte      `json:"args"`
 }
 
 // AddArgs holds the arguments for the add method.
 type AddArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Amount    uint64
-	Args      [][]byte
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	AppID     uint64   `json:"appId"`
+	Amount    uint64   `json:"amount"`
+	Args      [][]byte `json:"args"`
 }
 
 // RaffleArgs holds the arguments for the raffle method.
 type RaffleArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // FindWinnerArgs holds the arguments for the findWinner method.
 type FindWinnerArgs struct {
-	Wallet          uint64
-	RekeyBack       bool
-	AppID           uint64
-	IterationAmount uint64
+	Wallet          uint64 `json:"wallet"`
+	RekeyBack       bool   `json:"rekeyBack"`
+	AppID           uint64 `json:"appId"`
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // ClaimRafflePrizeArgs holds the arguments for the claimRafflePrize method.
 type ClaimRafflePrizeArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // DeleteRaffleApplicationArgs holds the arguments for the deleteRaffleApplication method.
 type DeleteRaffleApplicationArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RaffleMBRData
+	Return RaffleMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
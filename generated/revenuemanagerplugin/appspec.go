@@ -2,4 +2,11 @@
 package revenuemanagerplugin
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"RevenueManagerPlugin\",\"desc\":\"high level overview of how revenue manager works:\\n\\n1. DAO installs it twice:  \\n - once with execution key that can do anything\\n - once globally with methods restricted to: optin, startEscrowDisbursement, processEscrowAllocation\\n2. DAO proposes 'new escrow'\\n2. DAO proposes 'execute plugin' -\\u003e revenue-manager:newReceiveEscrow\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"optIn\",\"desc\":\"optin exists because revenue manager uses additional metadata to track optin counts for processing payments\\nrevenue escrows must be locked to avoid mistracking optins to ensure all opted in assets get processed\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"assets\"},{\"type\":\"pay\",\"name\":\"mbrPayment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"newReceiveEscrow\",\"desc\":\"Creates a new receive escrow with direct splits\\nUse this when you want to store splits directly in this contract\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"escrow\"},{\"type\":\"address\",\"name\":\"source\"},{\"type\":\"bool\",\"name\":\"allocatable\"},{\"type\":\"bool\",\"name\":\"optinAllowed\"},{\"type\":\"((uint64,string),uint8,uint64)[]\",\"name\":\"splits\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"newReceiveEscrowWithRef\",\"desc\":\"Creates a new receive escrow with a reference to splits stored in another contract\\nUse this when you want splits to be dynamically read from another contract's global state\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"escrow\"},{\"type\":\"address\",\"name\":\"source\"},{\"type\":\"bool\",\"name\":\"allocatable\"},{\"type\":\"bool\",\"name\":\"optinAllowed\"},{\"type\":\"(uint64,byte[])\",\"name\":\"splitRef\",\"struct\":\"SplitRef\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"startEscrowDisbursement\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"processEscrowAllocation\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"ids\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalizeEscrowDisbursement\",\"desc\":\"Cleans up processed asset boxes and resets escrow back to idle state\\nMust be called after all assets have been processed (escrow in Finalization phase)\\nCan be called in batches - pass the asset IDs that were processed to delete their tracking boxes\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"ids\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}}],\"structs\":{\"EscrowAssetKey\":[{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"asset\",\"type\":\"uint64\"}],\"ReceiveEscrow\":[{\"name\":\"source\",\"type\":\"address\"},{\"name\":\"allocatable\",\"type\":\"bool\"},{\"name\":\"optinAllowed\",\"type\":\"bool\"},{\"name\":\"optinCount\",\"type\":\"uint64\"},{\"name\":\"phase\",\"type\":\"uint8\"},{\"name\":\"allocationCounter\",\"type\":\"uint64\"},{\"name\":\"lastDisbursement\",\"type\":\"uint64\"},{\"name\":\"creationDate\",\"type\":\"uint64\"}],\"SplitRef\":[{\"name\":\"app\",\"type\":\"uint64\"},{\"name\":\"key\",\"type\":\"byte[]\"}],\"WalletEscrowKey\":[{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":1,\"bytes\":1},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"escrows\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"ReceiveEscrow\",\"prefix\":\"ZQ==\",\"desc\":\"box map of all the escrows\"},\"receiveAssets\":{\"keyType\":\"EscrowAssetKey\",\"valueType\":\"AVMBytes\",\"prefix\":\"YQ==\",\"desc\":\"box map of escrow assets that have already been processed during this allocation\"},\"splitRefs\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"SplitRef\",\"prefix\":\"cg==\",\"desc\":\"references to splits stored in other contracts (alternative to direct splits)\"},\"splits\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"((uint64,string),uint8,uint64)[]\",\"prefix\":\"cw==\",\"desc\":\"how to split revenue \\u0026 where to send it\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMiAxMDAwMDAKICAgIGJ5dGVjYmxvY2sgInNwZW5kaW5nX2FkZHJlc3MiICIiIDB4MDAwYSAiZSIgMHgwMCAweDFlICJha2l0YV9kYW8iIDB4MTQgMHg2ODM1ZTNiYyAweDAwMDEwMDAyIDB4YTI0MDNkZGYgMHgxNTFmN2M3NSAweDAwMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI5CiAgICAvLyBleHBvcnQgY2xhc3MgUmV2ZW51ZU1hbmFnZXJQbHVnaW4gZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJ6IG1haW5fY3JlYXRlX05vT3BAMTIKICAgIGJ5dGVjIDggLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIHB1c2hieXRlc3MgMHhlYzhkNDNlNSAweDJkODBlNmZjIDB4ODRmMzRjMDQgMHhmZTQxMGY0NCAweDdlN2MwZjcwIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgIm5ld1JlY2VpdmVFc2Nyb3codWludDY0LGJvb2wsc3RyaW5nLGFkZHJlc3MsYm9vbCxib29sLCgodWludDY0LHN0cmluZyksdWludDgsdWludDY0KVtdKXZvaWQiLCBtZXRob2QgIm5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmKHVpbnQ2NCxib29sLHN0cmluZyxhZGRyZXNzLGJvb2wsYm9vbCwodWludDY0LGJ5dGVbXSkpdm9pZCIsIG1ldGhvZCAic3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQodWludDY0LGJvb2wpdm9pZCIsIG1ldGhvZCAicHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb24odWludDY0LGJvb2wsdWludDY0W10pdm9pZCIsIG1ldGhvZCAiZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQodWludDY0LGJvb2wsdWludDY0W10pdm9pZCIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU8odWludDY0KXZvaWQiLCBtZXRob2QgIm9wVXAoKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBvcHRJbiBuZXdSZWNlaXZlRXNjcm93IG5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmIHN0YXJ0RXNjcm93RGlzYnVyc2VtZW50IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uIGZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAxMAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI5CiAgICAvLyBleHBvcnQgY2xhc3MgUmV2ZW51ZU1hbmFnZXJQbHVnaW4gZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICBwdXNoYnl0ZXMgMHhjZDlhZDY3ZSAvLyBtZXRob2QgImNyZWF0ZShzdHJpbmcsdWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldEVzY3Jvdyh3YWxsZXQ6IHVpbnQ2NCkgLT4gYnl0ZXM6CmdldEVzY3JvdzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjczCiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0RXNjcm93KHdhbGxldDogQXBwbGljYXRpb24pOiBzdHJpbmcgewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI3Ni0yNzkKICAgIC8vIGNvbnN0IFtjdXJyZW50UGx1Z2luQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ3VycmVudFBsdWdpbikKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNzgKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0N1cnJlbnRQbHVnaW4pCiAgICBwdXNoYnl0ZXMgImN1cnJlbnRfcGx1Z2luIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNzYtMjc5CiAgICAvLyBjb25zdCBbY3VycmVudFBsdWdpbkJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0N1cnJlbnRQbHVnaW4pCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4MgogICAgLy8gcmV0dXJuIGN1cnJlbnRQbHVnaW4uZXNjcm93CiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6bXVzdEdldEVzY3Jvd0luZm8od2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgptdXN0R2V0RXNjcm93SW5mbzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjkzCiAgICAvLyBleHBvcnQgZnVuY3Rpb24gbXVzdEdldEVzY3Jvd0luZm8od2FsbGV0OiBBcHBsaWNhdGlvbik6IEVzY3Jvd0luZm8gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4NgogICAgLy8gY29uc3QgZXNjcm93ID0gZ2V0RXNjcm93KHdhbGxldCkKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiBnZXRFc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mjg3LTI5MAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQuaWQsCiAgICAvLyAgIGFyZ3M6IFtbZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4OQogICAgLy8gYXJnczogW1tlc2Nyb3ddXQogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDkgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4Ny0yOTAKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbW2VzY3Jvd11dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgMTAgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWMgMTEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCA5IC8vIDkKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rKHVpbnQ2NCxib29sMSlbXSkKICAgIGV4dHJhY3QgNiA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI5NQogICAgLy8gYXNzZXJ0KGluZm8uaWQgPiAwLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mjk2CiAgICAvLyByZXR1cm4gaW5mbwogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrOiB1aW50NjQsIHdhbGxldDogdWludDY0KSAtPiB2b2lkOgpyZWtleUJhY2tJZk5lY2Vzc2FyeToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzA3CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrOiBib29sZWFuLCB3YWxsZXQ6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICBwcm90byAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzA4CiAgICAvLyBpZiAocmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJ6IHJla2V5QmFja0lmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZnJhbWVfZGlnIC0xCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzEwLTMxNwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiAwLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTUKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTQKICAgIC8vIGFtb3VudDogMCwKICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZHVwCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTAtMzE2CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgcmVjZWl2ZXI6IHNlbmRlciwKICAgIC8vICAgICBhbW91bnQ6IDAsCiAgICAvLyAgICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KSwKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzEwLTMxNwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiAwLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCnJla2V5QmFja0lmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMzoKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJla2V5QWRkcmVzcyhyZWtleUJhY2s6IHVpbnQ2NCwgd2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgpyZWtleUFkZHJlc3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMQogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIHJla2V5QWRkcmVzcyhyZWtleUJhY2s6IGJvb2xlYW4sIHdhbGxldDogQXBwbGljYXRpb24pOiBBY2NvdW50IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMjIKICAgIC8vIGlmICghcmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJueiByZWtleUFkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMwogICAgLy8gcmV0dXJuIEdsb2JhbC56ZXJvQWRkcmVzcwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICByZXRzdWIKCnJla2V5QWRkcmVzc19hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyNgogICAgLy8gcmV0dXJuIHdhbGxldC5hZGRyZXNzCiAgICBmcmFtZV9kaWcgLTEKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5jcmVhdGVbcm91dGluZ10oKSAtPiB2b2lkOgpjcmVhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMDgKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyNwogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgcHVzaGJ5dGVzICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTEwCiAgICAvLyB0aGlzLnZlcnNpb24udmFsdWUgPSB2ZXJzaW9uCiAgICB1bmNvdmVyIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjIDYgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy5ha2l0YURBTy52YWx1ZSA9IGFraXRhREFPCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTA4CiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5vcHRJbltyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wdEluOgogICAgYnl0ZWNfMSAvLyAiIgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIG9wdEluKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgYXNzZXRzOiB1aW50NjRbXSwgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBzd2FwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGR1cAogICAgaW50Y18yIC8vIDgKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICB1bmNvdmVyIDIKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50NjRbXSkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTE5CiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZGlnIDIKICAgIGNhbGxzdWIgZ2V0RXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIGFzc2VydChlc2Nyb3cgIT09ICcnLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBieXRlY18xIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIEVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgZHVwCiAgICBjb3ZlciA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMjMtMTMyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBhc3NldHMubGVuZ3RoCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgID09CiAgICB1bmNvdmVyIDIKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBncmVhdGVyVGhhbkVxOiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBhc3NldHMubGVuZ3RoCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIHVuY292ZXIgNAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTIzLTEzMgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogc2VuZGVyLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPj0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzQKICAgIC8vIGFzc2VydCh0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9SRUNFSVZFX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGRpZyAxCiAgICBpdG9iCiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjXzIgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIGVzY3Jvd3MgPSBCb3hNYXA8V2FsbGV0RXNjcm93S2V5LCBSZWNlaXZlRXNjcm93Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhFc2Nyb3dzIH0pCiAgICBieXRlY18zIC8vICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gYXNzZXJ0KHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLmV4aXN0cywgRVJSX1JFQ0VJVkVfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZWNlaXZlIGVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyBjb25zdCB7IHNvdXJjZSwgb3B0aW5BbGxvd2VkIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgY292ZXIgMgogICAgcHVzaGludCAyNTcgLy8gMjU3CiAgICBnZXRiaXQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzAyCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNSZWtleUluZGV4KQogICAgcHVzaGJ5dGVzICJyZWtleV9pbmRleCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzAwLTMwMwogICAgLy8gY29uc3QgW3Jla2V5SW5kZXhCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVrZXlJbmRleCkKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEzNwogICAgLy8gY29uc3QgaW5pdGlhdG9yID0gZ3R4bi5UcmFuc2FjdGlvbihnZXRSZWtleUluZGV4KHdhbGxldCkpLnNlbmRlcgogICAgZ3R4bnMgU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzgKICAgIC8vIGNvbnN0IGlzQ2hpbGQgPSBHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCAhPT0gMCAmJiBBcHBsaWNhdGlvbihHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCkuY3JlYXRvciA9PT0gc291cmNlCiAgICBnbG9iYWwgQ2FsbGVyQXBwbGljYXRpb25JRAogICAgYnogb3B0SW5fYm9vbF9mYWxzZUA0CiAgICBnbG9iYWwgQ2FsbGVyQXBwbGljYXRpb25JRAogICAgYXBwX3BhcmFtc19nZXQgQXBwQ3JlYXRvcgogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZGlnIDMKICAgID09CiAgICBieiBvcHRJbl9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpvcHRJbl9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIGFzc2VydChzb3VyY2UgPT09IGluaXRpYXRvciB8fCBpc0NoaWxkLCBFUlJfRk9SQklEREVOKQogICAgZGlnIDMKICAgIGRpZyAyCiAgICA9PQogICAgfHwKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhc3NlcnQob3B0aW5BbGxvd2VkLCBFUlJfRVNDUk9XX05PVF9BTExPV0VEX1RPX09QVElOKQogICAgZGlnIDEKICAgIGFzc2VydCAvLyBFc2Nyb3cgaXMgbm90IGFsbG93ZWQgdG8gb3B0LWluCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhc3NldHMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDExCgpvcHRJbl93aGlsZV90b3BANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFzc2V0cy5sZW5ndGg7IGkrKykgewogICAgZGlnIDEwCiAgICBkaWcgNgogICAgPAogICAgYnogb3B0SW5fYWZ0ZXJfd2hpbGVAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gYXNzZXJ0KCFzZW5kZXIuaXNPcHRlZEluKEFzc2V0KGFzc2V0c1tpXSkpLCBFUlJfQUxSRUFEWV9PUFRFRF9JTikKICAgIGRpZyA2CiAgICBleHRyYWN0IDIgMAogICAgZGlnIDExCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAxMQogICAgZGlnIDUKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gYWxyZWFkeSBvcHRlZCBpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQ1LTE1MwogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHNlbmRlciwKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IEFzc2V0KGFzc2V0c1tpXSksCiAgICAvLyAgICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjayAmJiAoaSA9PT0gKGFzc2V0cy5sZW5ndGggLSAxKSksIHdhbGxldCkKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpOwogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTUxCiAgICAvLyByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrICYmIChpID09PSAoYXNzZXRzLmxlbmd0aCAtIDEpKSwgd2FsbGV0KQogICAgZGlnIDcKICAgIGJ6IG9wdEluX2Jvb2xfZmFsc2VAMTAKICAgIGRpZyA1CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZGlnIDExCiAgICA9PQogICAgYnogb3B0SW5fYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCm9wdEluX2Jvb2xfbWVyZ2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNTEKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2sgJiYgKGkgPT09IChhc3NldHMubGVuZ3RoIC0gMSkpLCB3YWxsZXQpCiAgICBkaWcgOQogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgZGlnIDkKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFzc2V0QW1vdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGRpZyA0CiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0NS0xNTIKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBBc3NldChhc3NldHNbaV0pLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2sgJiYgKGkgPT09IChhc3NldHMubGVuZ3RoIC0gMSkpLCB3YWxsZXQpCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDUtMTUzCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogc2VuZGVyLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogQXNzZXQoYXNzZXRzW2ldKSwKICAgIC8vICAgICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrICYmIChpID09PSAoYXNzZXRzLmxlbmd0aCAtIDEpKSwgd2FsbGV0KQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCk7CiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQyCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYXNzZXRzLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgMTAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDExCiAgICBiIG9wdEluX3doaWxlX3RvcEA2CgpvcHRJbl9ib29sX2ZhbHNlQDEwOgogICAgaW50Y18wIC8vIDAKICAgIGIgb3B0SW5fYm9vbF9tZXJnZUAxMQoKb3B0SW5fYWZ0ZXJfd2hpbGVAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNTYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLm9wdGluQ291bnQgKz0gYXNzZXRzLmxlbmd0aAogICAgZGlnIDMKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNwogICAgKwogICAgaXRvYgogICAgcHVzaGludCAzMyAvLyAzMwogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gb3B0SW4od2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBhc3NldHM6IHVpbnQ2NFtdLCBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm9wdEluX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIG9wdEluX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5uZXdSZWNlaXZlRXNjcm93W3JvdXRpbmddKCkgLT4gdm9pZDoKbmV3UmVjZWl2ZUVzY3JvdzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE2My0xNzEKICAgIC8vIG5ld1JlY2VpdmVFc2Nyb3coCiAgICAvLyAgIHdhbGxldDogQXBwbGljYXRpb24sCiAgICAvLyAgIHJla2V5QmFjazogYm9vbGVhbiwKICAgIC8vICAgZXNjcm93OiBzdHJpbmcsCiAgICAvLyAgIHNvdXJjZTogQWNjb3VudCwKICAgIC8vICAgYWxsb2NhdGFibGU6IGJvb2xlYW4sCiAgICAvLyAgIG9wdGluQWxsb3dlZDogYm9vbGVhbiwKICAgIC8vICAgc3BsaXRzOiBTcGxpdFtdCiAgICAvLyApOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDUKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDYKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3MwogICAgLy8gYXNzZXJ0KHRoaXMuY29udHJvbHMoc2VuZGVyKSwgRVJSX0ZPUkJJRERFTikKICAgIGNhbGxzdWIgY29udHJvbHMKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc0CiAgICAvLyBhc3NlcnQoc3BsaXRzLmxlbmd0aCA+IDAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX0VNUFRZKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgYXNzZXJ0IC8vIFNwbGl0cyBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3NQogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPD0gMTAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX01PUkVfVEhBTl8xMCkKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBhc3NlcnQgLy8gU3BsaXRzIGNhbm5vdCBiZSBtb3JlIHRoYW4gMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4NQogICAgLy8gY3JlYXRpb25EYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgNQogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciA0CiAgICBzZXRiaXQKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIG9wdGluQ291bnQ6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4MgogICAgLy8gcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3NwogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICBkaWcgNAogICAgaXRvYgogICAgZGlnIDMKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBlc2Nyb3dzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgUmVjZWl2ZUVzY3Jvdz4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4RXNjcm93cyB9KQogICAgYnl0ZWNfMyAvLyAiZSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3Ny0xODYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICB1bmNvdmVyIDIKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4OQogICAgLy8gdGhpcy52YWxpZGF0ZVNwbGl0cyhzcGxpdHMpCiAgICBzd2FwCiAgICBjYWxsc3ViIHZhbGlkYXRlU3BsaXRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNQogICAgLy8gc3BsaXRzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgU3BsaXRbXT4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4U3BsaXRzIH0pCiAgICBwdXNoYnl0ZXMgInMiCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTkxCiAgICAvLyB0aGlzLnNwbGl0cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gY2xvbmUoc3BsaXRzKQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE5MwogICAgLy8gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrLCB3YWxsZXQpCiAgICBzd2FwCiAgICBjYWxsc3ViIHJla2V5QmFja0lmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNjMtMTcxCiAgICAvLyBuZXdSZWNlaXZlRXNjcm93KAogICAgLy8gICB3YWxsZXQ6IEFwcGxpY2F0aW9uLAogICAgLy8gICByZWtleUJhY2s6IGJvb2xlYW4sCiAgICAvLyAgIGVzY3Jvdzogc3RyaW5nLAogICAgLy8gICBzb3VyY2U6IEFjY291bnQsCiAgICAvLyAgIGFsbG9jYXRhYmxlOiBib29sZWFuLAogICAgLy8gICBvcHRpbkFsbG93ZWQ6IGJvb2xlYW4sCiAgICAvLyAgIHNwbGl0czogU3BsaXRbXQogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLm5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmW3JvdXRpbmddKCkgLT4gdm9pZDoKbmV3UmVjZWl2ZUVzY3Jvd1dpdGhSZWY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMDAtMjA4CiAgICAvLyBuZXdSZWNlaXZlRXNjcm93V2l0aFJlZigKICAgIC8vICAgd2FsbGV0OiBBcHBsaWNhdGlvbiwKICAgIC8vICAgcmVrZXlCYWNrOiBib29sZWFuLAogICAgLy8gICBlc2Nyb3c6IHN0cmluZywKICAgIC8vICAgc291cmNlOiBBY2NvdW50LAogICAgLy8gICBhbGxvY2F0YWJsZTogYm9vbGVhbiwKICAgIC8vICAgb3B0aW5BbGxvd2VkOiBib29sZWFuLAogICAgLy8gICBzcGxpdFJlZjogU3BsaXRSZWYKICAgIC8vICk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBhc3NlcnQodGhpcy5jb250cm9scyhzZW5kZXIpLCBFUlJfRk9SQklEREVOKQogICAgY2FsbHN1YiBjb250cm9scwogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3Igd2FsbGV0IGNhbiBkZWxldGUgYSBzcGVuZGluZyBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjAKICAgIC8vIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBieXRlYyA0IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDUKICAgIHNldGJpdAogICAgaW50Y18xIC8vIDEKICAgIHVuY292ZXIgNAogICAgc2V0Yml0CiAgICB1bmNvdmVyIDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBvcHRpbkNvdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTcKICAgIC8vIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICBieXRlYyA0IC8vIDB4MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTIKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgZGlnIDQKICAgIGl0b2IKICAgIGRpZyAzCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWNfMiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMQogICAgLy8gZXNjcm93cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFJlY2VpdmVFc2Nyb3c+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeEVzY3Jvd3MgfSkKICAgIGJ5dGVjXzMgLy8gImUiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTItMjIxCiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZSA9IHsKICAgIC8vICAgc291cmNlLAogICAgLy8gICBhbGxvY2F0YWJsZSwKICAgIC8vICAgb3B0aW5BbGxvd2VkLAogICAgLy8gICBvcHRpbkNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRXNjcm93RGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBhbGxvY2F0aW9uQ291bnRlcjogMCwKICAgIC8vICAgbGFzdERpc2J1cnNlbWVudDogMCwKICAgIC8vICAgY3JlYXRpb25EYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gfQogICAgdW5jb3ZlciAyCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNwogICAgLy8gc3BsaXRSZWZzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgU3BsaXRSZWY+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeFNwbGl0UmVmcyB9KQogICAgcHVzaGJ5dGVzICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vIHRoaXMuc3BsaXRSZWZzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSBjbG9uZShzcGxpdFJlZikKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjYKICAgIC8vIHJla2V5QmFja0lmTmVjZXNzYXJ5KHJla2V5QmFjaywgd2FsbGV0KQogICAgc3dhcAogICAgY2FsbHN1YiByZWtleUJhY2tJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjAwLTIwOAogICAgLy8gbmV3UmVjZWl2ZUVzY3Jvd1dpdGhSZWYoCiAgICAvLyAgIHdhbGxldDogQXBwbGljYXRpb24sCiAgICAvLyAgIHJla2V5QmFjazogYm9vbGVhbiwKICAgIC8vICAgZXNjcm93OiBzdHJpbmcsCiAgICAvLyAgIHNvdXJjZTogQWNjb3VudCwKICAgIC8vICAgYWxsb2NhdGFibGU6IGJvb2xlYW4sCiAgICAvLyAgIG9wdGluQWxsb3dlZDogYm9vbGVhbiwKICAgIC8vICAgc3BsaXRSZWY6IFNwbGl0UmVmCiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgpzdGFydEVzY3Jvd0Rpc2J1cnNlbWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjMwCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZGlnIDEKICAgIGNhbGxzdWIgZ2V0RXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMzEKICAgIC8vIGFzc2VydChlc2Nyb3cgIT09ICcnLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBieXRlY18xIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIEVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBjb25zdCB7IGlkOiBlc2Nyb3dJRCB9ID0gbXVzdEdldEVzY3Jvd0luZm8od2FsbGV0KQogICAgZGlnIDIKICAgIGNhbGxzdWIgbXVzdEdldEVzY3Jvd0luZm8KICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjM0CiAgICAvLyBhc3NlcnQodGhpcy5jb250cm9scyhzZW5kZXIpLCBFUlJfRk9SQklEREVOKQogICAgZHVwCiAgICBjYWxsc3ViIGNvbnRyb2xzCiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciB3YWxsZXQgY2FuIGRlbGV0ZSBhIHNwZW5kaW5nIGFjY291bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gYXNzZXJ0KHNlbmRlciA9PT0gQXBwbGljYXRpb24oZXNjcm93SUQpLmFkZHJlc3MsIEVSUl9DT05UUk9MTEVEX0FERFJFU1NfTVVTVF9CRV9FU0NST1cpCiAgICBzd2FwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIENvbnRyb2xsZWQgYWRkcmVzcyBtdXN0IGJlIGVzY3JvdwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjM3CiAgICAvLyBhc3NlcnQodGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkuZXhpc3RzLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZGlnIDIKICAgIGl0b2IKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWNfMiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMQogICAgLy8gZXNjcm93cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFJlY2VpdmVFc2Nyb3c+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeEVzY3Jvd3MgfSkKICAgIGJ5dGVjXzMgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIzNwogICAgLy8gYXNzZXJ0KHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLmV4aXN0cywgRVJSX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRXNjcm93IGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMzkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRhYmxlLCBsYXN0RGlzYnVyc2VtZW50LCBjcmVhdGlvbkRhdGUgfSA9IHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBleHRyYWN0IDQxIDEKICAgIGRpZyAxCiAgICBwdXNoaW50IDI1NiAvLyAyNTYKICAgIGdldGJpdAogICAgZGlnIDIKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDMKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDAKICAgIC8vIGFzc2VydChwaGFzZSA9PT0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VJZGxlLCBFUlJfRVNDUk9XX05PVF9JRExFKQogICAgdW5jb3ZlciAzCiAgICBieXRlYyA0IC8vIDB4MDAKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBpZGxlIHBoYXNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDEKICAgIC8vIGFzc2VydChhbGxvY2F0YWJsZSwgRVJSX0VTQ1JPV19OT1RfQUxMT0NBVEFCTEUpCiAgICB1bmNvdmVyIDIKICAgIGFzc2VydCAvLyBFc2Nyb3cgaXMgbm90IGFsbG9jYXRhYmxlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIGNvbnN0IGxhdGVzdFdpbmRvdzogdWludDY0ID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtICgoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIGNyZWF0aW9uRGF0ZSkgJSBPTkVfREFZKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIC0KICAgIHB1c2hpbnQgODY0MDAgLy8gODY0MDAKICAgICUKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gYXNzZXJ0KGxhdGVzdFdpbmRvdyA+PSBsYXN0RGlzYnVyc2VtZW50LCBFUlJfRVNDUk9XX05PVF9SRUFEWV9GT1JfRElTQlVSU0VNRU5UKQogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgID49CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCByZWFkeSBmb3IgZGlzYnVyc2VtZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLnBoYXNlID0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uCiAgICBkaWcgMQogICAgcHVzaGludCA0MSAvLyA0MQogICAgYnl0ZWMgNyAvLyAweDE0CiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjQ3CiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5sYXN0RGlzYnVyc2VtZW50ID0gbGF0ZXN0V2luZG93CiAgICBpdG9iCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjQ5CiAgICAvLyByZWtleUJhY2tJZk5lY2Vzc2FyeShyZWtleUJhY2ssIHdhbGxldCkKICAgIHN3YXAKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb246CiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiA4CiAgICBieXRlY18xIC8vICIiCiAgICBkdXBuIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTIKICAgIC8vIHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgaWRzOiB1aW50NjRbXSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50NjRbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1MwogICAgLy8gY29uc3QgZXNjcm93ID0gZ2V0RXNjcm93KHdhbGxldCkKICAgIGR1cAogICAgY2FsbHN1YiBnZXRFc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1NAogICAgLy8gYXNzZXJ0KGVzY3JvdyAhPT0gJycsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJ5dGVjXzEgLy8gIiIKICAgICE9CiAgICBhc3NlcnQgLy8gRXNjcm93IGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTUKICAgIC8vIGNvbnN0IHsgaWQ6IGVzY3Jvd0lEIH0gPSBtdXN0R2V0RXNjcm93SW5mbyh3YWxsZXQpCiAgICBkaWcgMQogICAgY2FsbHN1YiBtdXN0R2V0RXNjcm93SW5mbwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgMgogICAgY292ZXIgNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjU3CiAgICAvLyBhc3NlcnQoc2VuZGVyID09PSBBcHBsaWNhdGlvbihlc2Nyb3dJRCkuYWRkcmVzcywgRVJSX0NPTlRST0xMRURfQUREUkVTU19NVVNUX0JFX0VTQ1JPVykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gQ29udHJvbGxlZCBhZGRyZXNzIG11c3QgYmUgZXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIG9wdGluQ291bnQsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIGVzY3Jvd3MgPSBCb3hNYXA8V2FsbGV0RXNjcm93S2V5LCBSZWNlaXZlRXNjcm93Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhFc2Nyb3dzIH0pCiAgICBieXRlY18zIC8vICJlIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBjb3ZlciAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIG9wdGluQ291bnQsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCA0MSAxCiAgICBkaWcgMQogICAgcHVzaGludCAzMyAvLyAzMwogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgcHVzaGludCA0MiAvLyA0MgogICAgZXh0cmFjdF91aW50NjQKICAgIGNvdmVyIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2MAogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sIEVSUl9FU0NST1dfTk9UX0lOX0FMTE9DQVRJT05fUEhBU0UpCiAgICBzd2FwCiAgICBieXRlYyA3IC8vIDB4MTQKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBhbGxvY2F0aW9uIHBoYXNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNjEKICAgIC8vIGNvbnN0IHRvdGFsQXNzZXRzVG9Qcm9jZXNzOiB1aW50NjQgPSBvcHRpbkNvdW50ICsgMSAvLyArIDEgdG8gaW5jbHVkZSBhbGdvCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUKICAgIC8vIHNwbGl0cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFNwbGl0W10+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeFNwbGl0cyB9KQogICAgcHVzaGJ5dGVzICJzIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjUxCiAgICAvLyBpZiAodGhpcy5zcGxpdHMoa2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NTIKICAgIC8vIHJldHVybiBjbG9uZSh0aGlzLnNwbGl0cyhrZXkpLnZhbHVlKQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgYnVyeSAyNAoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4ucmVzb2x2ZVNwbGl0c0A0MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2NwogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPiAwLCBFUlJfU1BMSVRTX0NBTk5PVF9CRV9FTVBUWSkKICAgIGRpZyAyMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgYXNzZXJ0IC8vIFNwbGl0cyBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2OAogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPD0gMTAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX01PUkVfVEhBTl8xMCkKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBhc3NlcnQgLy8gU3BsaXRzIGNhbm5vdCBiZSBtb3JlIHRoYW4gMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2OQogICAgLy8gdGhpcy52YWxpZGF0ZVNwbGl0cyhzcGxpdHMpCiAgICBjYWxsc3ViIHZhbGlkYXRlU3BsaXRzCiAgICBidXJ5IDI0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpZHMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDE4Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZGlnIDE3CiAgICBkaWcgOAogICAgPAogICAgYnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfd2hpbGVAMjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3MwogICAgLy8gY29uc3QgYXNzZXQgPSBpZHNbaV0KICAgIGRpZyA4CiAgICBleHRyYWN0IDIgMAogICAgZGlnIDE4CiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBhc3NlcnQoIXRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX0FMUkVBRFlfQUxMT0NBVEVEKQogICAgZGlnIDcKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpdG9iCiAgICBkdXAKICAgIGJ1cnkgMzIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzMKICAgIC8vIHJlY2VpdmVBc3NldHMgPSBCb3hNYXA8RXNjcm93QXNzZXRLZXksIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhSZWNlaXZlQXNzZXRzIH0pCiAgICBwdXNoYnl0ZXMgImEiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYnVyeSAyOQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBhc3NlcnQoIXRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX0FMUkVBRFlfQUxMT0NBVEVEKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gQXNzZXQgaXMgYWxyZWFkeSBhbGxvY2F0ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3Ni0yNzgKICAgIC8vIGNvbnN0IGJhbGFuY2U6IHVpbnQ2NCA9IGFzc2V0ID09PSAwCiAgICAvLyAgID8gb3AuYmFsYW5jZShHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcykgLSBHbG9iYWwubWluQmFsYW5jZQogICAgLy8gICA6IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2Uoc2VuZGVyLCBhc3NldClbMF0KICAgIGJueiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl90ZXJuYXJ5X2ZhbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3NwogICAgLy8gPyBvcC5iYWxhbmNlKEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzKSAtIEdsb2JhbC5taW5CYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYmFsYW5jZQogICAgZ2xvYmFsIE1pbkJhbGFuY2UKICAgIC0KCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3Rlcm5hcnlfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gZm9yIChsZXQgajogdWludDY0ID0gMDsgaiA8IHNwbGl0cy5sZW5ndGg7IGorKykgewogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTcKICAgIGJ1cnkgMTQKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBmb3IgKGxldCBqOiB1aW50NjQgPSAwOyBqIDwgc3BsaXRzLmxlbmd0aDsgaisrKSB7CiAgICBkaWcgMjMKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBidXJ5IDIzCiAgICBkaWcgMTYKICAgID4KICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODIKICAgIC8vIGNvbnN0IHsgdHlwZSwgcmVjZWl2ZXIsIHZhbHVlIH0gPSBjbG9uZShzcGxpdHNbal0pCiAgICBkaWcgMjMKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMTYKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBidXJ5IDE5CiAgICBkaWcgMjQKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMQogICAgYnVyeSAyNAogICAgZHVwCiAgICBwdXNoaW50IDMgLy8gMwogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI4NS0yODgKICAgIC8vIGNvbnN0IHJlY2VpdmVyRXNjcm93SW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiByZWNlaXZlci53YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFtbcmVjZWl2ZXIuZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODYKICAgIC8vIGFwcElkOiByZWNlaXZlci53YWxsZXQsCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDAgOAogICAgYnVyeSAzMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjg3CiAgICAvLyBhcmdzOiBbW3JlY2VpdmVyLmVzY3Jvd11dCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDMyCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDkgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODUtMjg4CiAgICAvLyBjb25zdCByZWNlaXZlckVzY3Jvd0luZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogcmVjZWl2ZXIud2FsbGV0LAogICAgLy8gICBhcmdzOiBbW3JlY2VpdmVyLmVzY3Jvd11dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgMTAgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjIDExIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgOSAvLyA5CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50NjQsYm9vbDEpW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTAKICAgIC8vIGNvbnN0IHJlY2VpdmVyQWRkcmVzcyA9IEFwcGxpY2F0aW9uKHJlY2VpdmVyRXNjcm93SW5mby5pZCkuYWRkcmVzcwogICAgZXh0cmFjdCA2IDkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgc3dhcAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDI3CiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTMKICAgIC8vIGlmICghcmVjZWl2ZXJBZGRyZXNzLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBkaWcgMTkKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjk0CiAgICAvLyBhcmM1OE9wdEluQW5kU2VuZCh0aGlzLmFraXRhREFPLnZhbHVlLCByZWNlaXZlci53YWxsZXQsIHJlY2VpdmVyLmVzY3JvdywgW2Fzc2V0XSwgWzBdKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNiAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjk0CiAgICAvLyBhcmM1OE9wdEluQW5kU2VuZCh0aGlzLmFraXRhREFPLnZhbHVlLCByZWNlaXZlci53YWxsZXQsIHJlY2VpdmVyLmVzY3JvdywgW2Fzc2V0XSwgWzBdKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDAwMDEKICAgIGRpZyAzMAogICAgY29uY2F0CiAgICBkdXAKICAgIGJ1cnkgMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzcyCiAgICAvLyBhc3NlcnQoYXNzZXRzLmxlbmd0aCA9PT0gYW1vdW50cy5sZW5ndGgsIEVSUl9BU1NFVFNfQU5EX0FNT1VOVFNfTUlTTUFUQ0gpCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBBc3NldHMgYW5kIGFtb3VudHMgbWlzbWF0Y2gKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICB1bmNvdmVyIDIKICAgIHB1c2hieXRlcyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozNzMKICAgIC8vIGNvbnN0IG9wdGluUGx1Z2luID0gZ2V0UGx1Z2luQXBwTGlzdChha2l0YURBTykub3B0aW4KICAgIGR1cAogICAgZXh0cmFjdCAwIDgKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDE4CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIHB1c2hieXRlcyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3Ni0zNzkKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogcmVjaXBpZW50V2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbb3B0aW5QbHVnaW4sIHRydWUsIGVzY3JvdywgW10sIFtdXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIHB1c2hieXRlcyAweDU4MmZmMzgyIC8vIG1ldGhvZCAiYXJjNThfcmVrZXlUb1BsdWdpbih1aW50NjQsYm9vbCxzdHJpbmcsdWludDY0W10sKHVpbnQ2NCx1aW50NjQpW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3OAogICAgLy8gYXJnczogW29wdGluUGx1Z2luLCB0cnVlLCBlc2Nyb3csIFtdLCBbXV0KICAgIHB1c2hieXRlcyAweDgwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDM0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWMgMTIgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWMgMTIgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3Ni0zNzkKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogcmVjaXBpZW50V2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbb3B0aW5QbHVnaW4sIHRydWUsIGVzY3JvdywgW10sIFtdXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzgxLTM5MgogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpblBsdWdpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHJlY2lwaWVudFdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIGFzc2V0cywKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mzg5CiAgICAvLyBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgdW5jb3ZlciAzCiAgICAqCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgc3dhcAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozODctMzkwCiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM4MS0zOTIKICAgIC8vIGl0eG5Db21wb3NlLm5leHQ8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogb3B0aW5QbHVnaW4sCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZWNpcGllbnRXYWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBhc3NldHMsCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICBieXRlYyA4IC8vIG1ldGhvZCAib3B0SW4odWludDY0LGJvb2wsdWludDY0W10scGF5KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozNzgKICAgIC8vIGFyZ3M6IFtvcHRpblBsdWdpbiwgdHJ1ZSwgZXNjcm93LCBbXSwgW11dCiAgICBwdXNoYnl0ZXMgMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzgxLTM5MgogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpblBsdWdpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHJlY2lwaWVudFdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIGFzc2V0cywKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDE3Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BAMzQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyAxNgogICAgaW50Y18xIC8vIDEKICAgIDwKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NQogICAgLy8gaWYgKGFtb3VudHNbaV0gPiAwKSB7CiAgICBkaWcgMTYKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBkdXAKICAgIGJ1cnkgMjIKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDAwMDAKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTMKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mzk2LTQwMgogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50c1tpXSwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldHNbaV0KICAgIC8vICAgfSkKICAgIC8vICkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MDAKICAgIC8vIHhmZXJBc3NldDogYXNzZXRzW2ldCiAgICBkaWcgMjcKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMjEKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZGlnIDI1CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGRpZyAxMQogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozOTctNDAxCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldEFtb3VudDogYW1vdW50c1tpXSwKICAgIC8vICAgYXNzZXRSZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICB4ZmVyQXNzZXQ6IGFzc2V0c1tpXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyAxNgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTcKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDM0Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl93aGlsZUAzODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDA2LTQwOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzPih7CiAgICAvLyAgIGFwcElkOiByZWNpcGllbnRXYWxsZXQKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIHB1c2hieXRlcyAweDZjYzNmNjA2IC8vIG1ldGhvZCAiYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3MoKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDE0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDEwCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTcKICAgIC8vIGxldCBhbW91bnQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDIwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTkKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlRmxhdDoKICAgIGRpZyAyMgogICAgcHVzaGJ5dGVzIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTktMzAxCiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZUZsYXQ6CiAgICAvLyAgIGFtb3VudCA9IHZhbHVlCiAgICAvLyAgIGJyZWFrCiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDE0CiAgICBkaWcgMTIKICAgIGJ1cnkgMjAKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Jsb2NrQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEyCiAgICAvLyBhc3NlcnQoYW1vdW50IDw9IHJlbWFpbmluZywgRVJSX09WRVJfQUxMT0NBVElPTikKICAgIGRpZyAxOQogICAgZHVwCiAgICBkaWcgMTUKICAgIGR1cAogICAgY292ZXIgMgogICAgPD0KICAgIGFzc2VydCAvLyBPdmVyIGFsbG9jYXRpb24gb2YgYXNzZXRzIGluIHRoZSBlc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxMwogICAgLy8gcmVtYWluaW5nIC09IGFtb3VudAogICAgc3dhcAogICAgLQogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE1CiAgICAvLyBpZiAoYXNzZXQgPT09IDApIHsKICAgIGRpZyAxOAogICAgYm56IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Vsc2VfYm9keUAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE2LTMyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDE5CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZGlnIDI0CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgNQogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxNi0zMjEKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICByZWNlaXZlcjogcmVjZWl2ZXJBZGRyZXNzLAogICAgLy8gICAgIGFtb3VudAogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE2LTMyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDcKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Vsc2VfYm9keUAyNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMyNC0zMzEKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGFtb3VudCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDE4CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZGlnIDE5CiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBkaWcgMjQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMjQtMzMwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogcmVjZWl2ZXJBZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBhbW91bnQsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldAogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzI0LTMzMQogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHJlY2VpdmVyQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50LAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgYiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BANwoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwMgogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOgogICAgZGlnIDIyCiAgICBieXRlYyA3IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDItMzA1CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6CiAgICAvLyAgIC8vIENhbGN1bGF0ZSBwZXJjZW50YWdlIG9uIHJlbWFpbmluZyBiYWxhbmNlIChhZnRlciBmbGF0IGFtb3VudHMpCiAgICAvLyAgIGFtb3VudCA9IGNhbGNQZXJjZW50KHJlbWFpbmluZywgdmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGRpZyAxMgogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMTQKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIGJ1cnkgMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwNQogICAgLy8gYnJlYWsKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYmxvY2tAMjEKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMTc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDYKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlUmVtYWluZGVyOgogICAgZGlnIDIyCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDYtMzA5CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVJlbWFpbmRlcjoKICAgIC8vICAgLy8gUmVtYWluZGVyIGdldHMgd2hhdGV2ZXIgaXMgbGVmdAogICAgLy8gICBhbW91bnQgPSByZW1haW5pbmcKICAgIC8vICAgYnJlYWsKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Jsb2NrQDIxCiAgICBkaWcgMTMKICAgIGJ1cnkgMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwOQogICAgLy8gYnJlYWsKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYmxvY2tAMjEKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM1CiAgICAvLyB0aGlzLnJlY2VpdmVBc3NldHMoeyBlc2Nyb3c6IGVzY3Jvd0lELCBhc3NldCB9KS5jcmVhdGUoKQogICAgZGlnIDI2CiAgICBpbnRjXzAgLy8gMAogICAgYm94X2NyZWF0ZQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpZHMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGRpZyAxNwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTgKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDIKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3Rlcm5hcnlfZmFsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3OAogICAgLy8gOiBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKHNlbmRlciwgYXNzZXQpWzBdCiAgICBkaWcgNQogICAgZGlnIDE5CiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl90ZXJuYXJ5X21lcmdlQDYKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM4CiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5hbGxvY2F0aW9uQ291bnRlciArPSBpZHMubGVuZ3RoCiAgICBkaWcgMwogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCA0MiAvLyA0MgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICBkdXAKICAgIGNvdmVyIDIKICAgICsKICAgIGl0b2IKICAgIHVuY292ZXIgMgogICAgcHVzaGludCA0MiAvLyA0MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM5CiAgICAvLyBpZiAoKGFsbG9jYXRpb25Db3VudGVyICsgaWRzLmxlbmd0aCkgPT09IHRvdGFsQXNzZXRzVG9Qcm9jZXNzKSB7CiAgICBkaWcgMwogICAgKwogICAgZGlnIDIKICAgID09CiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNDAKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLnBoYXNlID0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24KICAgIGRpZyAzCiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICBieXRlYyA1IC8vIDB4MWUKICAgIGJveF9yZXBsYWNlCgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDMwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzQzCiAgICAvLyByZWtleUJhY2tJZk5lY2Vzc2FyeShyZWtleUJhY2ssIHdhbGxldCkKICAgIGRpZyA5CiAgICBkaWcgMTEKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1MgogICAgLy8gcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb24od2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDQyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzcKICAgIC8vIHNwbGl0UmVmcyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFNwbGl0UmVmPih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhTcGxpdFJlZnMgfSkKICAgIHB1c2hieXRlcyAiciIKICAgIGRpZyA1CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjU2CiAgICAvLyBhc3NlcnQodGhpcy5zcGxpdFJlZnMoa2V5KS5leGlzdHMsIEVSUl9TUExJVFNfT1JfUkVGX1JFUVVJUkVEKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBFaXRoZXIgc3BsaXRzIG9yIGEgc3BsaXQgcmVmZXJlbmNlIG11c3QgYmUgcHJvdmlkZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB7IGFwcCwga2V5OiByZWZLZXkgfSA9IHRoaXMuc3BsaXRSZWZzKGtleSkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2MAogICAgLy8gY29uc3QgW3JlZlNwbGl0c0J5dGVzLCBleGlzdHNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoQXBwbGljYXRpb24oYXBwKSwgQnl0ZXMocmVmS2V5KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2MQogICAgLy8gYXNzZXJ0KGV4aXN0cywgRVJSX1NQTElUX1JFRl9OT1RfRk9VTkQpCiAgICBhc3NlcnQgLy8gUmVmZXJlbmNlZCBzcGxpdHMgbm90IGZvdW5kIGluIHRhcmdldCBjb250cmFjdAogICAgYnVyeSAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjY0CiAgICAvLyBjb25zdCBzcGxpdHMgPSB0aGlzLnJlc29sdmVTcGxpdHMod2FsbGV0LCBlc2Nyb3cpCiAgICBiIHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLnJlc29sdmVTcGxpdHNANDMKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1MQogICAgLy8gZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBzd2FwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZHVwCiAgICBjYWxsc3ViIGdldEVzY3JvdwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUzCiAgICAvLyBhc3NlcnQoZXNjcm93ICE9PSAnJywgRVJSX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYnl0ZWNfMSAvLyAiIgogICAgIT0KICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1NAogICAgLy8gY29uc3QgeyBpZDogZXNjcm93SUQgfSA9IG11c3RHZXRFc2Nyb3dJbmZvKHdhbGxldCkKICAgIGRpZyAxCiAgICBjYWxsc3ViIG11c3RHZXRFc2Nyb3dJbmZvCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzU2CiAgICAvLyBhc3NlcnQoc2VuZGVyID09PSBBcHBsaWNhdGlvbihlc2Nyb3dJRCkuYWRkcmVzcywgRVJSX0NPTlRST0xMRURfQUREUkVTU19NVVNUX0JFX0VTQ1JPVykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gQ29udHJvbGxlZCBhZGRyZXNzIG11c3QgYmUgZXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNTgKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBlc2Nyb3dzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgUmVjZWl2ZUVzY3Jvdz4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4RXNjcm93cyB9KQogICAgYnl0ZWNfMyAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNTgKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCA0MSAxCiAgICBzd2FwCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzU5CiAgICAvLyBhc3NlcnQocGhhc2UgPT09IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlRmluYWxpemF0aW9uLCBFUlJfRVNDUk9XX05PVF9JTl9GSU5BTElaQVRJT05fUEhBU0UpCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBmaW5hbGl6YXRpb24gcGhhc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkrKykgewogICAgaW50Y18wIC8vIDAKCmZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzYyCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaWRzLmxlbmd0aDsgaSsrKSB7CiAgICBkdXAKICAgIGRpZyA1CiAgICA8CiAgICBieiBmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudF9hZnRlcl93aGlsZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjMKICAgIC8vIGNvbnN0IGFzc2V0ID0gaWRzW2ldCiAgICBkaWcgNQogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAxCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjQKICAgIC8vIGFzc2VydCh0aGlzLnJlY2VpdmVBc3NldHMoeyBlc2Nyb3c6IGVzY3Jvd0lELCBhc3NldCB9KS5leGlzdHMsIEVSUl9BU1NFVF9OT1RfQUxMT0NBVEVEKQogICAgZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzMKICAgIC8vIHJlY2VpdmVBc3NldHMgPSBCb3hNYXA8RXNjcm93QXNzZXRLZXksIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhSZWNlaXZlQXNzZXRzIH0pCiAgICBwdXNoYnl0ZXMgImEiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2NAogICAgLy8gYXNzZXJ0KHRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX05PVF9BTExPQ0FURUQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIEFzc2V0IHdhcyBub3QgYWxsb2NhdGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjUKICAgIC8vIHRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmRlbGV0ZSgpCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkrKykgewogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMQogICAgYiBmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudF93aGlsZV90b3BAMgoKZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnRfYWZ0ZXJfd2hpbGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2OQogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUuYWxsb2NhdGlvbkNvdW50ZXIgLT0gaWRzLmxlbmd0aAogICAgZGlnIDIKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNgogICAgZHVwCiAgICBjb3ZlciAyCiAgICAtCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM3MgogICAgLy8gaWYgKChhbGxvY2F0aW9uQ291bnRlciAtIGlkcy5sZW5ndGgpID09PSAwKSB7CiAgICBkaWcgMgogICAgc3dhcAogICAgLQogICAgYm56IGZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzczCiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5waGFzZSA9IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZQogICAgZGlnIDIKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgYm94X3JlcGxhY2UKCmZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X2FmdGVyX2lmX2Vsc2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM3NgogICAgLy8gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrLCB3YWxsZXQpCiAgICBkaWcgNgogICAgZGlnIDgKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1MQogICAgLy8gZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA2IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNiAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uY29udHJvbHMoc2VuZGVyOiBieXRlcykgLT4gdWludDY0Ogpjb250cm9sczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM5CiAgICAvLyBwcml2YXRlIGNvbnRyb2xzKHNlbmRlcjogQWNjb3VudCk6IGJvb2xlYW4gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo0MAogICAgLy8gcmV0dXJuIHNlbmRlci5hdXRoQWRkcmVzcyA9PT0gR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGZyYW1lX2RpZyAtMQogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RBdXRoQWRkcgogICAgYXNzZXJ0IC8vIGFjY291bnQgZnVuZGVkCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgPT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi52YWxpZGF0ZVNwbGl0cyhzcGxpdHM6IGJ5dGVzKSAtPiBieXRlczoKdmFsaWRhdGVTcGxpdHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2OAogICAgLy8gcHJpdmF0ZSB2YWxpZGF0ZVNwbGl0cyhzcGxpdHM6IFNwbGl0W10pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gIiIKICAgIGR1cG4gMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NjkKICAgIC8vIGxldCB0b3RhbFBlcmNlbnRhZ2U6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo3MC03MQogICAgLy8gbGV0IGhhc1JlbWFpbmRlcjogYm9vbGVhbiA9IGZhbHNlCiAgICAvLyBsZXQgaGFzRmxhdDogYm9vbGVhbiA9IGZhbHNlCiAgICBkdXBuIDMKCnZhbGlkYXRlU3BsaXRzX3doaWxlX3RvcEAxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NzMKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBzcGxpdHMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgZnJhbWVfZGlnIDgKICAgID4KICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2FmdGVyX3doaWxlQDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo3NAogICAgLy8gY29uc3QgeyB0eXBlLCB2YWx1ZSB9ID0gY2xvbmUoc3BsaXRzW2ldKQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDIgMAogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgZnJhbWVfZGlnIDEKICAgIGR1cAogICAgY292ZXIgNAogICAgZGlnIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgNAogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGV4dHJhY3QgMiAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHB1c2hpbnQgMyAvLyAzCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjc1CiAgICAvLyBjb25zdCBpc0xhc3QgPSBpID09PSBzcGxpdHMubGVuZ3RoIC0gMQogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIHVuY292ZXIgMgogICAgPT0KICAgIGZyYW1lX2J1cnkgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NzkKICAgIC8vIHZhbHVlID4gMCB8fCB0eXBlID09PSBTcGxpdERpc3RyaWJ1dGlvblR5cGVSZW1haW5kZXIsCiAgICBibnogdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDQKICAgIGZyYW1lX2RpZyAwCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICBieiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDUKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUA0OgogICAgaW50Y18xIC8vIDEKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjc4LTgxCiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHZhbHVlID4gMCB8fCB0eXBlID09PSBTcGxpdERpc3RyaWJ1dGlvblR5cGVSZW1haW5kZXIsCiAgICAvLyAgIEVSUl9TUExJVF9WQUxVRV9NVVNUX0JFX1BPU0lUSVZFX09SX1JFTUFJTkRFUgogICAgLy8gKQogICAgYXNzZXJ0IC8vIFNwbGl0IHZhbHVlIG11c3QgYmUgcG9zaXRpdmUgb3IgcmVtYWluZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NAogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOgogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDcgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjg0LTg2CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6CiAgICAvLyAgIHRvdGFsUGVyY2VudGFnZSArPSB2YWx1ZQogICAgLy8gICBicmVhawogICAgYnogdmFsaWRhdGVTcGxpdHNfYWZ0ZXJfaWZfZWxzZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdG90YWxQZXJjZW50YWdlICs9IHZhbHVlCiAgICBmcmFtZV9kaWcgNQogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIGZyYW1lX2J1cnkgNQoKdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTY6CiAgICBmcmFtZV9kaWcgMgogICAgZnJhbWVfYnVyeSA4CiAgICBiIHZhbGlkYXRlU3BsaXRzX3doaWxlX3RvcEAxCgp2YWxpZGF0ZVNwbGl0c19hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NwogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVGbGF0OgogICAgZnJhbWVfZGlnIDAKICAgIHB1c2hieXRlcyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6ODctODkKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlRmxhdDoKICAgIC8vICAgaGFzRmxhdCA9IHRydWUKICAgIC8vICAgYnJlYWsKICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2FmdGVyX2lmX2Vsc2VAMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjg4CiAgICAvLyBoYXNGbGF0ID0gdHJ1ZQogICAgaW50Y18xIC8vIDEKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6ODkKICAgIC8vIGJyZWFrCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jsb2NrQDE2Cgp2YWxpZGF0ZVNwbGl0c19hZnRlcl9pZl9lbHNlQDEyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OTAKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlUmVtYWluZGVyOgogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDUgLy8gMHgxZQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkwLTk0CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVJlbWFpbmRlcjoKICAgIC8vICAgLy8gUmVtYWluZGVyIG11c3QgYmUgbGFzdAogICAgLy8gICBhc3NlcnQoaXNMYXN0LCBFUlJfUkVNQUlOREVSX01VU1RfQkVfTEFTVCkKICAgIC8vICAgaGFzUmVtYWluZGVyID0gdHJ1ZQogICAgLy8gICBicmVhawogICAgYnogdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkyCiAgICAvLyBhc3NlcnQoaXNMYXN0LCBFUlJfUkVNQUlOREVSX01VU1RfQkVfTEFTVCkKICAgIGZyYW1lX2RpZyAzCiAgICBhc3NlcnQgLy8gUmVtYWluZGVyIHNwbGl0IG11c3QgYmUgdGhlIGxhc3Qgc3BsaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBoYXNSZW1haW5kZXIgPSB0cnVlCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo5NAogICAgLy8gYnJlYWsKICAgIGIgdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTYKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfZmFsc2VANToKICAgIGludGNfMCAvLyAwCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VANgoKdmFsaWRhdGVTcGxpdHNfYWZ0ZXJfd2hpbGVAMTc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo5OQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSA8PSBESVZJU09SLCBFUlJfUEVSQ0VOVEFHRV9FWENFRURTXzEwMCkKICAgIGZyYW1lX2RpZyA1CiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gVG90YWwgcGVyY2VudGFnZSBzcGxpdHMgZXhjZWVkIDEwMCUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSAhPT0gRElWSVNPUiB8fCAhaGFzUmVtYWluZGVyLCBFUlJfUEVSQ0VOVEFHRV9NVVNUX0JFX05PVF9CRV8xMDBfV0lUSF9SRU1BSU5ERVIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICAhPQogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAxOQogICAgZnJhbWVfZGlnIDYKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDIwCgp2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMTk6CiAgICBpbnRjXzEgLy8gMQoKdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSAhPT0gRElWSVNPUiB8fCAhaGFzUmVtYWluZGVyLCBFUlJfUEVSQ0VOVEFHRV9NVVNUX0JFX05PVF9CRV8xMDBfV0lUSF9SRU1BSU5ERVIpCiAgICBhc3NlcnQgLy8gUGVyY2VudGFnZSBzcGxpdHMgY2Fubm90IHRvdGFsIDEwMCUgd2hlbiB1c2luZyBhIHJlbWFpbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTAzCiAgICAvLyBhc3NlcnQoIWhhc0ZsYXQgfHwgaGFzUmVtYWluZGVyIHx8IHRvdGFsUGVyY2VudGFnZSA9PT0gMCB8fCB0b3RhbFBlcmNlbnRhZ2UgPT09IERJVklTT1IsIEVSUl9GTEFUX1dJVEhfUEVSQ0VOVEFHRV9SRVFVSVJFU19SRU1BSU5ERVIpCiAgICBmcmFtZV9kaWcgNwogICAgYnogdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDI1CiAgICBmcmFtZV9kaWcgNgogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAyNQogICAgZnJhbWVfZGlnIDUKICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAyNQogICAgZnJhbWVfZGlnIDUKICAgIGludGMgNCAvLyAxMDAwMDAKICAgID09CiAgICBieiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDI2Cgp2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMjU6CiAgICBpbnRjXzEgLy8gMQoKdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMwogICAgLy8gYXNzZXJ0KCFoYXNGbGF0IHx8IGhhc1JlbWFpbmRlciB8fCB0b3RhbFBlcmNlbnRhZ2UgPT09IDAgfHwgdG90YWxQZXJjZW50YWdlID09PSBESVZJU09SLCBFUlJfRkxBVF9XSVRIX1BFUkNFTlRBR0VfUkVRVUlSRVNfUkVNQUlOREVSKQogICAgYXNzZXJ0IC8vIEZsYXQgYW1vdW50cyB3aXRoIHBlcmNlbnRhZ2VzIHJlcXVpcmUgYSByZW1haW5kZXIgb3IgMTAwJSB0b3RhbAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyBhc3NlcnQoaGFzUmVtYWluZGVyIHx8IHRvdGFsUGVyY2VudGFnZSA9PT0gRElWSVNPUiB8fCAodG90YWxQZXJjZW50YWdlID09PSAwICYmIGhhc0ZsYXQpLCBFUlJfU1BMSVRTX01VU1RfVE9UQUxfMTAwX09SX0hBVkVfUkVNQUlOREVSKQogICAgZnJhbWVfZGlnIDYKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMzEKICAgIGZyYW1lX2RpZyA1CiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA9PQogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAzMQogICAgZnJhbWVfZGlnIDUKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDMyCiAgICBmcmFtZV9kaWcgNwogICAgYnogdmFsaWRhdGVTcGxpdHNfYm9vbF9mYWxzZUAzMgoKdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDMxOgogICAgaW50Y18xIC8vIDEKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMDUKICAgIC8vIGFzc2VydChoYXNSZW1haW5kZXIgfHwgdG90YWxQZXJjZW50YWdlID09PSBESVZJU09SIHx8ICh0b3RhbFBlcmNlbnRhZ2UgPT09IDAgJiYgaGFzRmxhdCksIEVSUl9TUExJVFNfTVVTVF9UT1RBTF8xMDBfT1JfSEFWRV9SRU1BSU5ERVIpCiAgICBhc3NlcnQgLy8gUGVyY2VudGFnZSBzcGxpdHMgbXVzdCB0b3RhbCAxMDAlIG9yIGluY2x1ZGUgYSByZW1haW5kZXIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfZmFsc2VAMzI6CiAgICBpbnRjXzAgLy8gMAogICAgYiB2YWxpZGF0ZVNwbGl0c19ib29sX21lcmdlQDMzCgp2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDI2OgogICAgaW50Y18wIC8vIDAKICAgIGIgdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyNwoKdmFsaWRhdGVTcGxpdHNfYm9vbF9mYWxzZUAyMDoKICAgIGludGNfMCAvLyAwCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VAMjEK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAFAAEIAqCNBiYNEHNwZW5kaW5nX2FkZHJlc3MAAgAKAWUBAAEeCWFraXRhX2RhbwEUBGg147wEAAEAAgSiQD3fBBUffHUCAAAxGRREMRhBAD8nCIIHBOyNQ+UELYDm/ASE80wEBP5BD0QEfnwPcAQz6SyUBIVN7eA2GgCOCADhAgICpgM8A8wHRAgJAAEAI0OABM2a1n42GgCOAQCmAIoBAYv/gA5jdXJyZW50X3BsdWdpbmVISYEoWUsBFVJXAgCJigEBi/+I/9WxSRUWVwYCTFAnCUxQJwqyGrIai/+yGIEGshAisgGztD5JVwQASwFXAAQnCxJESSJZgQkLJQhMFRJEVwYJSSJbRImKAgCL/kEAHov/KGVIsYv+i/+IABKyICKyCEmyB7IAI7IQIrIBs4mKAgGL/kAAAzIDiYv/cghEiTYaAUkiWSUISwEVEkRXAgA2GgJJFSQSRBeAB3ZlcnNpb25PAmcnBkxnI0MpSTYaAUkVJBJEF0k2GgJJFSMSRCJTTDYaA0lOAkkiWUlOA0kkCyUITwIVEkQxFiMJSTgQIxJESwKI/vRJKRNESwMoZUhJTgVLAjgHEk8COAgyEE8ECw8QREsBFksBFRZXBgJPAlBMKlBMUCtMUElOAkm9RQFEvkhJVwAgTgKBgQJTTIALcmVrZXlfaW5kZXhlSDgAMg1BAIQyDXIHREsDEkEAeSNLA0sCEhFESwFEIkULSwpLBgxBAFFLBlcCAEsLJAtbSUULSwVMcABFARREsUsHQQAxSwUjCUsLEkEAJyNLCYj+3rIgSwmyESKyEksESbIUsgCBBLIQIrIBs0sKIwhFC0L/qyJC/9ZLA0m+RIEhW0sHCBaBIUy7I0MiQv+ENhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kiWSUISwEVEkRXAgA2GgRJFYEgEkQ2GgVJFSMSRCJTNhoGSRUjEkQiUzYaB0sGKGVIiAXhREkiWUlEgQoORDIHJwQiTwVUI08EVE8DTFAiFkxLAVAnBFBLAVBMUEwWUEsEFksDFRZXBgJPBFBMKlBMUCtLAVBPAr9MiAWlgAFzTwJQSbxITL9MiP3aI0M2GgFJFSQSRBc2GgJJFSMSRCJTNhoDSSJZJQhLARUSRFcCADYaBEkVgSASRDYaBUkVIxJEIlM2GgZJFSMSRCJTNhoHSwYoZUiIBT1EMgcnBCJPBVQjTwRUTwNMUCIWTEsBUCcEUEsBUExQTBZQSwQWSwMVFlcGAk8EUEwqUExQK0sBUE8Cv4ABckxQSbxITL9MiP1EI0M2GgFJFSQSRBc2GgJJFSMSRCJTSwGI/L1JKRNESwKI/NciW0sDKGVISYgExkRMcghEEkRLAhZLARUWVwYCTwJQTCpQTFArTFBJvUUBREm+SElXKQFLAYGAAlNLAoEyW08DgTpbTwMnBBJETwJEMgdJTwIJgYCjBRgJSU8CD0RLAYEpJwe7FoEyTLtMiPy0I0MiRwgpRwo2GgFJFSQSRBdJNhoCSRUjEkQiU0w2GgNJTgJJIllJTgMkCyUITBUSREmI/BJJKRNESwGI/CwiW0lOA0sCKGVISU4CTgRyCEQSREwWSwEVFlcGAk8CUEwqUExQSStLAVBJTgK+RElXKQFLAYEhW08CgSpbTgNMJwcSRCMITIABc0xQSb1FAUECwEm+REUYSxdJIllJRIEKDkSIA8xFGCJFEksRSwgMQQJxSwhXAgBLEiQLW0lFFEsHFksBFklFIFCAAWFMUElFHb1FARREQAJAMgpgMgEJIkURRQ5LFyJZSUUXSxANQQIaSxdXAgBLEElOAiULSwFMWU8CIwhJRRNLGEsBCUsDFU8CJQtLBExZTwJNUklXAgFFGEmBA1tFDrFJIllLARVSSVcACEUgSSJbSU4CRRFJJFlLARVSSUUgVwIASRUWVwYCTFAnCUxQJwqyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEJwsSREkiWYEJCyUITBUSRFcGCSJbcghMSU4CRRtESxNwAEUBQADzIicGZUSAAgABSx5QSUUeSSJZSSMSRE8CgANwYWxlSElXAAhMIltLEklOAoASY29udHJvbGxlZF9hZGRyZXNzZUhJTgNFILGABFgv84KyGk8DshqAAYCyGksishonDLIaJwyyGkyyGIEGshAisgG2MhBPAwuyCEyyByOyECKyAbYnCLIaSyCyGoABgLIashiyGoEGshAisgEiRRFLECMMQQA8SxAkC0lFFoAIAAAAAAAAAABMW0lFDUEAGrZLG1cCAEsVW7IRSxmyFEsLshKBBLIQIrIBSxAjCEURQv+9toAEbMP2BrIaSw6yGIEGshAisgGzIkUUSxaAAQoSQQBKSwxFFEsTSUsPSU4CDkRMCUUOSxJAABexSxOyCEsYsgdLBbIAI7IQIrIBs0L+HrFLErIRSxOyEksYshRLBbIAgQSyECKyAbNC/gJLFicHEkEAEksMSSEEDkRLDh0hBJdFFEL/oEsWJwUSQf+YSw1FFEL/kUsaIrlISxEjCEUSQv2RSwVLE3AASEL9vEsDSb5EgSpbSwlJTgIIFk8CgSpPArtLAwhLAhJBAAdLA4EpJwW7SwlLC4j5ZSNDgAFySwVQSb1FAURJvkhMIiS6F0sBJFlLAhVPA04CUlcCAGVERRhC/Rw2GgFJFSQSRBdJNhoCSRUjEkQiU0w2GgNJTgJJIllJTgMkCyUITBUSREmI+KBJKRNESwGI+LoiW0lOA0sCKGVITHIIRBJETBZLARUWVwYCTwJQTCpQTFArTFBJvkRJVykBTIEqW0wnBRJEIklLBQxBACZLBVcCAEsBSU4CJAskWEsFFkxQgAFhTFBJvUUBRLxIIwhFAUL/00sCSb5EgSpbSwZJTgIJFk8CgSpPArtLAkwJQAAHSwKBKScEu0sGSwiI+HcjQzYaAUkVJBJEFzEAIicGZUSABndhbGxldGVIcghEEkQnBkxnI0OKAQGL/3MCRDIKEomKAQEiKUcDIkcDi/8iWUmMAYsIDUEAiYv/VwIAiwhJTgIlC0sBTFlLAiMISYwCiwFJTgRLAQlLAxVPAiULSwRMWU8CTVJJVwIBjACBA1tJjARMIwlPAhKMA0AACIsAJwUSQQA4I0SLACcHEkEADosFiwQIjAWLAowIQv+OiwCAAQoSQQAGI4wHQv/qiwAnBRJB/+KLA0QjjAZC/9kiQv/FiwVJIQQORCEEE0AABYsGQABBI0SLB0EAEosGQAANiwVBAAiLBSEEEkEAJCNEiwZAABKLBSEEEkAACosFQAAMiwdBAAcjRIv/jACJIkL/9iJC/9kiQv+8\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[1325],\"errorMessage\":\"Asset is already allocated\"},{\"pc\":[2145],\"errorMessage\":\"Asset was not allocated\"},{\"pc\":[1542],\"errorMessage\":\"Assets and amounts mismatch\"},{\"pc\":[639,1221,1260,1919,2095,2159],\"errorMessage\":\"Box must have value\"},{\"pc\":[248,1485],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[1021,1194,2072],\"errorMessage\":\"Controlled address must be escrow\"},{\"pc\":[1972],\"errorMessage\":\"Either splits or a split reference must be provided\"},{\"pc\":[267,431,998,1047,1169,2051],\"errorMessage\":\"Escrow does not exist\"},{\"pc\":[1079],\"errorMessage\":\"Escrow is not allocatable\"},{\"pc\":[542],\"errorMessage\":\"Escrow is not allowed to opt-in\"},{\"pc\":[1242],\"errorMessage\":\"Escrow is not in allocation phase\"},{\"pc\":[2108],\"errorMessage\":\"Escrow is not in finalization phase\"},{\"pc\":[1076],\"errorMessage\":\"Escrow is not in idle phase\"},{\"pc\":[1096],\"errorMessage\":\"Escrow is not ready for disbursement\"},{\"pc\":[2453],\"errorMessage\":\"Flat amounts with percentages require a remainder or 100% total\"},{\"pc\":[456],\"errorMessage\":\"Invalid payment\"},{\"pc\":[1864],\"errorMessage\":\"Invalid percentage\"},{\"pc\":[77],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[2230],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[1789],\"errorMessage\":\"Over allocation of assets in the escrow\"},{\"pc\":[2428],\"errorMessage\":\"Percentage splits cannot total 100% when using a remainder\"},{\"pc\":[2478],\"errorMessage\":\"Percentage splits must total 100% or include a remainder\"},{\"pc\":[485],\"errorMessage\":\"Receive escrow does not exist\"},{\"pc\":[1997],\"errorMessage\":\"Referenced splits not found in target contract\"},{\"pc\":[2398],\"errorMessage\":\"Remainder split must be the last split\"},{\"pc\":[2350],\"errorMessage\":\"Split value must be positive or remainder\"},{\"pc\":[737,1269],\"errorMessage\":\"Splits cannot be empty\"},{\"pc\":[741,1273],\"errorMessage\":\"Splits cannot be more than 10\"},{\"pc\":[2415],\"errorMessage\":\"Total percentage splits exceed 100%\"},{\"pc\":[2244],\"errorMessage\":\"account funded\"},{\"pc\":[575],\"errorMessage\":\"already opted in\"},{\"pc\":[323,525,1019,1192,1511,2070,2228],\"errorMessage\":\"application exists\"},{\"pc\":[1525,2215],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[1380,2130,2302],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[260,1497],\"errorMessage\":\"invalid number of bytes for (len+(uint64,bool1)[])\"},{\"pc\":[412,1161,2043],\"errorMessage\":\"invalid number of bytes for (len+uint64[])\"},{\"pc\":[337,688,852],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[387,673,708,718,837,872,882,987,1138,2020],\"errorMessage\":\"invalid number of bytes for bool8\"},{\"pc\":[348,377,664,828,978,1128,2010,2207],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[700,864],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[539,732,896,1015],\"errorMessage\":\"only the creator wallet can delete a spending account\"},{\"pc\":[422],\"errorMessage\":\"transaction type is pay\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"RevenueManagerPlugin\",\"structs\":{\"EscrowAssetKey\":[{\"name\":\"escrow\",\"type\":\"uint64\"},{\"name\":\"asset\",\"type\":\"uint64\"}],\"ReceiveEscrow\":[{\"name\":\"source\",\"type\":\"address\"},{\"name\":\"allocatable\",\"type\":\"bool\"},{\"name\":\"optinAllowed\",\"type\":\"bool\"},{\"name\":\"optinCount\",\"type\":\"uint64\"},{\"name\":\"phase\",\"type\":\"uint8\"},{\"name\":\"allocationCounter\",\"type\":\"uint64\"},{\"name\":\"lastDisbursement\",\"type\":\"uint64\"},{\"name\":\"creationDate\",\"type\":\"uint64\"}],\"SplitRef\":[{\"name\":\"app\",\"type\":\"uint64\"},{\"name\":\"key\",\"type\":\"byte[]\"}],\"WalletEscrowKey\":[{\"name\":\"wallet\",\"type\":\"uint64\"},{\"name\":\"escrow\",\"type\":\"string\"}]},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"version\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"optIn\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"assets\"},{\"type\":\"pay\",\"name\":\"mbrPayment\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"optin exists because revenue manager uses additional metadata to track optin counts for processing payments\\nrevenue escrows must be locked to avoid mistracking optins to ensure all opted in assets get processed\"},{\"name\":\"newReceiveEscrow\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"escrow\"},{\"type\":\"address\",\"name\":\"source\"},{\"type\":\"bool\",\"name\":\"allocatable\"},{\"type\":\"bool\",\"name\":\"optinAllowed\"},{\"type\":\"((uint64,string),uint8,uint64)[]\",\"name\":\"splits\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Creates a new receive escrow with direct splits\\nUse this when you want to store splits directly in this contract\"},{\"name\":\"newReceiveEscrowWithRef\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"string\",\"name\":\"escrow\"},{\"type\":\"address\",\"name\":\"source\"},{\"type\":\"bool\",\"name\":\"allocatable\"},{\"type\":\"bool\",\"name\":\"optinAllowed\"},{\"type\":\"(uint64,byte[])\",\"name\":\"splitRef\",\"struct\":\"SplitRef\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Creates a new receive escrow with a reference to splits stored in another contract\\nUse this when you want splits to be dynamically read from another contract's global state\"},{\"name\":\"startEscrowDisbursement\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"processEscrowAllocation\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"ids\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"finalizeEscrowDisbursement\",\"args\":[{\"type\":\"uint64\",\"name\":\"wallet\"},{\"type\":\"bool\",\"name\":\"rekeyBack\"},{\"type\":\"uint64[]\",\"name\":\"ids\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"Cleans up processed asset boxes and resets escrow back to idle state\\nMust be called after all assets have been processed (escrow in Finalization phase)\\nCan be called in batches - pass the asset IDs that were processed to delete their tracking boxes\"},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false}],\"state\":{\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"escrows\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"ReceiveEscrow\",\"prefix\":\"ZQ==\",\"desc\":\"box map of all the escrows\"},\"receiveAssets\":{\"keyType\":\"EscrowAssetKey\",\"valueType\":\"AVMBytes\",\"prefix\":\"YQ==\",\"desc\":\"box map of escrow assets that have already been processed during this allocation\"},\"splitRefs\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"SplitRef\",\"prefix\":\"cg==\",\"desc\":\"references to splits stored in other contracts (alternative to direct splits)\"},\"splits\":{\"keyType\":\"WalletEscrowKey\",\"valueType\":\"((uint64,string),uint8,uint64)[]\",\"prefix\":\"cw==\",\"desc\":\"how to split revenue \\u0026 where to send it\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggMiAxMDAwMDAKICAgIGJ5dGVjYmxvY2sgInNwZW5kaW5nX2FkZHJlc3MiICIiIDB4MDAwYSAiZSIgMHgwMCAweDFlICJha2l0YV9kYW8iIDB4MTQgMHg2ODM1ZTNiYyAweDAwMDEwMDAyIDB4YTI0MDNkZGYgMHgxNTFmN2M3NSAweDAwMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI5CiAgICAvLyBleHBvcnQgY2xhc3MgUmV2ZW51ZU1hbmFnZXJQbHVnaW4gZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICB0eG4gT25Db21wbGV0aW9uCiAgICAhCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgTm9PcAogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgIGJ6IG1haW5fY3JlYXRlX05vT3BAMTIKICAgIGJ5dGVjIDggLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIHB1c2hieXRlc3MgMHhlYzhkNDNlNSAweDJkODBlNmZjIDB4ODRmMzRjMDQgMHhmZTQxMGY0NCAweDdlN2MwZjcwIDB4MzNlOTJjOTQgMHg4NTRkZWRlMCAvLyBtZXRob2QgIm5ld1JlY2VpdmVFc2Nyb3codWludDY0LGJvb2wsc3RyaW5nLGFkZHJlc3MsYm9vbCxib29sLCgodWludDY0LHN0cmluZyksdWludDgsdWludDY0KVtdKXZvaWQiLCBtZXRob2QgIm5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmKHVpbnQ2NCxib29sLHN0cmluZyxhZGRyZXNzLGJvb2wsYm9vbCwodWludDY0LGJ5dGVbXSkpdm9pZCIsIG1ldGhvZCAic3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQodWludDY0LGJvb2wpdm9pZCIsIG1ldGhvZCAicHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb24odWludDY0LGJvb2wsdWludDY0W10pdm9pZCIsIG1ldGhvZCAiZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQodWludDY0LGJvb2wsdWludDY0W10pdm9pZCIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU8odWludDY0KXZvaWQiLCBtZXRob2QgIm9wVXAoKXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBvcHRJbiBuZXdSZWNlaXZlRXNjcm93IG5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmIHN0YXJ0RXNjcm93RGlzYnVyc2VtZW50IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uIGZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAxMAogICAgZXJyCgptYWluX29wVXBfcm91dGVAMTA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo0MwogICAgLy8gb3BVcCgpOiB2b2lkIHsgfQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKbWFpbl9jcmVhdGVfTm9PcEAxMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI5CiAgICAvLyBleHBvcnQgY2xhc3MgUmV2ZW51ZU1hbmFnZXJQbHVnaW4gZXh0ZW5kcyBBa2l0YUJhc2VDb250cmFjdCB7CiAgICBwdXNoYnl0ZXMgMHhjZDlhZDY3ZSAvLyBtZXRob2QgImNyZWF0ZShzdHJpbmcsdWludDY0KXZvaWQiCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAwCiAgICBtYXRjaCBjcmVhdGUKICAgIGVycgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdldEVzY3Jvdyh3YWxsZXQ6IHVpbnQ2NCkgLT4gYnl0ZXM6CmdldEVzY3JvdzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjczCiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0RXNjcm93KHdhbGxldDogQXBwbGljYXRpb24pOiBzdHJpbmcgewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI3Ni0yNzkKICAgIC8vIGNvbnN0IFtjdXJyZW50UGx1Z2luQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ3VycmVudFBsdWdpbikKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNzgKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0N1cnJlbnRQbHVnaW4pCiAgICBwdXNoYnl0ZXMgImN1cnJlbnRfcGx1Z2luIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNzYtMjc5CiAgICAvLyBjb25zdCBbY3VycmVudFBsdWdpbkJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0N1cnJlbnRQbHVnaW4pCiAgICAvLyApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4MgogICAgLy8gcmV0dXJuIGN1cnJlbnRQbHVnaW4uZXNjcm93CiAgICBkdXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBleHRyYWN0IDIgMAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6bXVzdEdldEVzY3Jvd0luZm8od2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgptdXN0R2V0RXNjcm93SW5mbzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjkzCiAgICAvLyBleHBvcnQgZnVuY3Rpb24gbXVzdEdldEVzY3Jvd0luZm8od2FsbGV0OiBBcHBsaWNhdGlvbik6IEVzY3Jvd0luZm8gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4NgogICAgLy8gY29uc3QgZXNjcm93ID0gZ2V0RXNjcm93KHdhbGxldCkKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiBnZXRFc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mjg3LTI5MAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQuaWQsCiAgICAvLyAgIGFyZ3M6IFtbZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4OQogICAgLy8gYXJnczogW1tlc2Nyb3ddXQogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDkgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI4Ny0yOTAKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbW2VzY3Jvd11dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgMTAgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIC0xCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWMgMTEgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCA5IC8vIDkKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rKHVpbnQ2NCxib29sMSlbXSkKICAgIGV4dHJhY3QgNiA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI5NQogICAgLy8gYXNzZXJ0KGluZm8uaWQgPiAwLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mjk2CiAgICAvLyByZXR1cm4gaW5mbwogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6cmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrOiB1aW50NjQsIHdhbGxldDogdWludDY0KSAtPiB2b2lkOgpyZWtleUJhY2tJZk5lY2Vzc2FyeToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzA3CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrOiBib29sZWFuLCB3YWxsZXQ6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICBwcm90byAyIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzA4CiAgICAvLyBpZiAocmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJ6IHJla2V5QmFja0lmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZnJhbWVfZGlnIC0xCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzEwLTMxNwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiAwLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTUKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTQKICAgIC8vIGFtb3VudDogMCwKICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZHVwCiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBpdHhuX2ZpZWxkIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMTAtMzE2CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgcmVjZWl2ZXI6IHNlbmRlciwKICAgIC8vICAgICBhbW91bnQ6IDAsCiAgICAvLyAgICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjaywgd2FsbGV0KSwKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzEwLTMxNwogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiAwLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2ssIHdhbGxldCksCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCnJla2V5QmFja0lmTmVjZXNzYXJ5X2FmdGVyX2lmX2Vsc2VAMzoKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OnJla2V5QWRkcmVzcyhyZWtleUJhY2s6IHVpbnQ2NCwgd2FsbGV0OiB1aW50NjQpIC0+IGJ5dGVzOgpyZWtleUFkZHJlc3M6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMQogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIHJla2V5QWRkcmVzcyhyZWtleUJhY2s6IGJvb2xlYW4sIHdhbGxldDogQXBwbGljYXRpb24pOiBBY2NvdW50IHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozMjIKICAgIC8vIGlmICghcmVrZXlCYWNrKSB7CiAgICBmcmFtZV9kaWcgLTIKICAgIGJueiByZWtleUFkZHJlc3NfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyMwogICAgLy8gcmV0dXJuIEdsb2JhbC56ZXJvQWRkcmVzcwogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICByZXRzdWIKCnJla2V5QWRkcmVzc19hZnRlcl9pZl9lbHNlQDI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjMyNgogICAgLy8gcmV0dXJuIHdhbGxldC5hZGRyZXNzCiAgICBmcmFtZV9kaWcgLTEKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5jcmVhdGVbcm91dGluZ10oKSAtPiB2b2lkOgpjcmVhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMDgKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyNwogICAgLy8gdmVyc2lvbiA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5VmVyc2lvbiB9KQogICAgcHVzaGJ5dGVzICJ2ZXJzaW9uIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTEwCiAgICAvLyB0aGlzLnZlcnNpb24udmFsdWUgPSB2ZXJzaW9uCiAgICB1bmNvdmVyIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjIDYgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjExMQogICAgLy8gdGhpcy5ha2l0YURBTy52YWx1ZSA9IGFraXRhREFPCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTA4CiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5vcHRJbltyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wdEluOgogICAgYnl0ZWNfMSAvLyAiIgogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIG9wdEluKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgYXNzZXRzOiB1aW50NjRbXSwgbWJyUGF5bWVudDogZ3R4bi5QYXltZW50VHhuKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBzd2FwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGR1cAogICAgaW50Y18yIC8vIDgKICAgICoKICAgIGludGNfMyAvLyAyCiAgICArCiAgICB1bmNvdmVyIDIKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50NjRbXSkKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTE5CiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZGlnIDIKICAgIGNhbGxzdWIgZ2V0RXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIGFzc2VydChlc2Nyb3cgIT09ICcnLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBieXRlY18xIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIEVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgZHVwCiAgICBjb3ZlciA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMjMtMTMyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgbWJyUGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBhc3NldHMubGVuZ3RoCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgID09CiAgICB1bmNvdmVyIDIKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBncmVhdGVyVGhhbkVxOiBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiBhc3NldHMubGVuZ3RoCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIHVuY292ZXIgNAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTIzLTEzMgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIG1iclBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogc2VuZGVyLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPj0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzQKICAgIC8vIGFzc2VydCh0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS5leGlzdHMsIEVSUl9SRUNFSVZFX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGRpZyAxCiAgICBpdG9iCiAgICBkaWcgMQogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAyCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGJ5dGVjXzIgLy8gMHgwMDBhCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIGVzY3Jvd3MgPSBCb3hNYXA8V2FsbGV0RXNjcm93S2V5LCBSZWNlaXZlRXNjcm93Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhFc2Nyb3dzIH0pCiAgICBieXRlY18zIC8vICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gYXNzZXJ0KHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLmV4aXN0cywgRVJSX1JFQ0VJVkVfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBSZWNlaXZlIGVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTM2CiAgICAvLyBjb25zdCB7IHNvdXJjZSwgb3B0aW5BbGxvd2VkIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgcG9wCiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgY292ZXIgMgogICAgcHVzaGludCAyNTcgLy8gMjU3CiAgICBnZXRiaXQKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzAyCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNSZWtleUluZGV4KQogICAgcHVzaGJ5dGVzICJyZWtleV9pbmRleCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzAwLTMwMwogICAgLy8gY29uc3QgW3Jla2V5SW5kZXhCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzUmVrZXlJbmRleCkKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEzNwogICAgLy8gY29uc3QgaW5pdGlhdG9yID0gZ3R4bi5UcmFuc2FjdGlvbihnZXRSZWtleUluZGV4KHdhbGxldCkpLnNlbmRlcgogICAgZ3R4bnMgU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzgKICAgIC8vIGNvbnN0IGlzQ2hpbGQgPSBHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCAhPT0gMCAmJiBBcHBsaWNhdGlvbihHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCkuY3JlYXRvciA9PT0gc291cmNlCiAgICBnbG9iYWwgQ2FsbGVyQXBwbGljYXRpb25JRAogICAgYnogb3B0SW5fYm9vbF9mYWxzZUA0CiAgICBnbG9iYWwgQ2FsbGVyQXBwbGljYXRpb25JRAogICAgYXBwX3BhcmFtc19nZXQgQXBwQ3JlYXRvcgogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZGlnIDMKICAgID09CiAgICBieiBvcHRJbl9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpvcHRJbl9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIGFzc2VydChzb3VyY2UgPT09IGluaXRpYXRvciB8fCBpc0NoaWxkLCBFUlJfRk9SQklEREVOKQogICAgZGlnIDMKICAgIGRpZyAyCiAgICA9PQogICAgfHwKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQwCiAgICAvLyBhc3NlcnQob3B0aW5BbGxvd2VkLCBFUlJfRVNDUk9XX05PVF9BTExPV0VEX1RPX09QVElOKQogICAgZGlnIDEKICAgIGFzc2VydCAvLyBFc2Nyb3cgaXMgbm90IGFsbG93ZWQgdG8gb3B0LWluCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBhc3NldHMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDExCgpvcHRJbl93aGlsZV90b3BANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFzc2V0cy5sZW5ndGg7IGkrKykgewogICAgZGlnIDEwCiAgICBkaWcgNgogICAgPAogICAgYnogb3B0SW5fYWZ0ZXJfd2hpbGVAMTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gYXNzZXJ0KCFzZW5kZXIuaXNPcHRlZEluKEFzc2V0KGFzc2V0c1tpXSkpLCBFUlJfQUxSRUFEWV9PUFRFRF9JTikKICAgIGRpZyA2CiAgICBleHRyYWN0IDIgMAogICAgZGlnIDExCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAxMQogICAgZGlnIDUKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gYWxyZWFkeSBvcHRlZCBpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQ1LTE1MwogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHNlbmRlciwKICAgIC8vICAgICBhc3NldEFtb3VudDogMCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IEFzc2V0KGFzc2V0c1tpXSksCiAgICAvLyAgICAgcmVrZXlUbzogcmVrZXlBZGRyZXNzKHJla2V5QmFjayAmJiAoaSA9PT0gKGFzc2V0cy5sZW5ndGggLSAxKSksIHdhbGxldCkKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpOwogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTUxCiAgICAvLyByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrICYmIChpID09PSAoYXNzZXRzLmxlbmd0aCAtIDEpKSwgd2FsbGV0KQogICAgZGlnIDcKICAgIGJ6IG9wdEluX2Jvb2xfZmFsc2VAMTAKICAgIGRpZyA1CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZGlnIDExCiAgICA9PQogICAgYnogb3B0SW5fYm9vbF9mYWxzZUAxMAogICAgaW50Y18xIC8vIDEKCm9wdEluX2Jvb2xfbWVyZ2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNTEKICAgIC8vIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2sgJiYgKGkgPT09IChhc3NldHMubGVuZ3RoIC0gMSkpLCB3YWxsZXQpCiAgICBkaWcgOQogICAgY2FsbHN1YiByZWtleUFkZHJlc3MKICAgIGl0eG5fZmllbGQgUmVrZXlUbwogICAgZGlnIDkKICAgIGl0eG5fZmllbGQgWGZlckFzc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDkKICAgIC8vIGFzc2V0QW1vdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGRpZyA0CiAgICBkdXAKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE0NS0xNTIKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBBc3NldChhc3NldHNbaV0pLAogICAgLy8gICAgIHJla2V5VG86IHJla2V5QWRkcmVzcyhyZWtleUJhY2sgJiYgKGkgPT09IChhc3NldHMubGVuZ3RoIC0gMSkpLCB3YWxsZXQpCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNDUtMTUzCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogc2VuZGVyLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogQXNzZXQoYXNzZXRzW2ldKSwKICAgIC8vICAgICByZWtleVRvOiByZWtleUFkZHJlc3MocmVrZXlCYWNrICYmIChpID09PSAoYXNzZXRzLmxlbmd0aCAtIDEpKSwgd2FsbGV0KQogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCk7CiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTQyCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgYXNzZXRzLmxlbmd0aDsgaSsrKSB7CiAgICBkaWcgMTAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDExCiAgICBiIG9wdEluX3doaWxlX3RvcEA2CgpvcHRJbl9ib29sX2ZhbHNlQDEwOgogICAgaW50Y18wIC8vIDAKICAgIGIgb3B0SW5fYm9vbF9tZXJnZUAxMQoKb3B0SW5fYWZ0ZXJfd2hpbGVAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNTYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLm9wdGluQ291bnQgKz0gYXNzZXRzLmxlbmd0aAogICAgZGlnIDMKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNwogICAgKwogICAgaXRvYgogICAgcHVzaGludCAzMyAvLyAzMwogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gb3B0SW4od2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBhc3NldHM6IHVpbnQ2NFtdLCBtYnJQYXltZW50OiBndHhuLlBheW1lbnRUeG4pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm9wdEluX2Jvb2xfZmFsc2VANDoKICAgIGludGNfMCAvLyAwCiAgICBiIG9wdEluX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi5uZXdSZWNlaXZlRXNjcm93W3JvdXRpbmddKCkgLT4gdm9pZDoKbmV3UmVjZWl2ZUVzY3JvdzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE2My0xNzEKICAgIC8vIG5ld1JlY2VpdmVFc2Nyb3coCiAgICAvLyAgIHdhbGxldDogQXBwbGljYXRpb24sCiAgICAvLyAgIHJla2V5QmFjazogYm9vbGVhbiwKICAgIC8vICAgZXNjcm93OiBzdHJpbmcsCiAgICAvLyAgIHNvdXJjZTogQWNjb3VudCwKICAgIC8vICAgYWxsb2NhdGFibGU6IGJvb2xlYW4sCiAgICAvLyAgIG9wdGluQWxsb3dlZDogYm9vbGVhbiwKICAgIC8vICAgc3BsaXRzOiBTcGxpdFtdCiAgICAvLyApOiB2b2lkIHsKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDUKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDYKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3MwogICAgLy8gYXNzZXJ0KHRoaXMuY29udHJvbHMoc2VuZGVyKSwgRVJSX0ZPUkJJRERFTikKICAgIGNhbGxzdWIgY29udHJvbHMKICAgIGFzc2VydCAvLyBvbmx5IHRoZSBjcmVhdG9yIHdhbGxldCBjYW4gZGVsZXRlIGEgc3BlbmRpbmcgYWNjb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc0CiAgICAvLyBhc3NlcnQoc3BsaXRzLmxlbmd0aCA+IDAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX0VNUFRZKQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgYXNzZXJ0IC8vIFNwbGl0cyBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3NQogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPD0gMTAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX01PUkVfVEhBTl8xMCkKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBhc3NlcnQgLy8gU3BsaXRzIGNhbm5vdCBiZSBtb3JlIHRoYW4gMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4NQogICAgLy8gY3JlYXRpb25EYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgaW50Y18wIC8vIDAKICAgIHVuY292ZXIgNQogICAgc2V0Yml0CiAgICBpbnRjXzEgLy8gMQogICAgdW5jb3ZlciA0CiAgICBzZXRiaXQKICAgIHVuY292ZXIgMwogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxODEKICAgIC8vIG9wdGluQ291bnQ6IDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGl0b2IKICAgIHN3YXAKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4MgogICAgLy8gcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTc3LTE4NgogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICAvLyAgIHNvdXJjZSwKICAgIC8vICAgYWxsb2NhdGFibGUsCiAgICAvLyAgIG9wdGluQWxsb3dlZCwKICAgIC8vICAgb3B0aW5Db3VudDogMCwKICAgIC8vICAgcGhhc2U6IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIC8vICAgYWxsb2NhdGlvbkNvdW50ZXI6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnQ6IDAsCiAgICAvLyAgIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vIH0KICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3NwogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSB7CiAgICBkaWcgNAogICAgaXRvYgogICAgZGlnIDMKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgNAogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBlc2Nyb3dzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgUmVjZWl2ZUVzY3Jvdz4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4RXNjcm93cyB9KQogICAgYnl0ZWNfMyAvLyAiZSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE3Ny0xODYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICB1bmNvdmVyIDIKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE4OQogICAgLy8gdGhpcy52YWxpZGF0ZVNwbGl0cyhzcGxpdHMpCiAgICBzd2FwCiAgICBjYWxsc3ViIHZhbGlkYXRlU3BsaXRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNQogICAgLy8gc3BsaXRzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgU3BsaXRbXT4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4U3BsaXRzIH0pCiAgICBwdXNoYnl0ZXMgInMiCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTkxCiAgICAvLyB0aGlzLnNwbGl0cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gY2xvbmUoc3BsaXRzKQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHN3YXAKICAgIGJveF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjE5MwogICAgLy8gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrLCB3YWxsZXQpCiAgICBzd2FwCiAgICBjYWxsc3ViIHJla2V5QmFja0lmTmVjZXNzYXJ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxNjMtMTcxCiAgICAvLyBuZXdSZWNlaXZlRXNjcm93KAogICAgLy8gICB3YWxsZXQ6IEFwcGxpY2F0aW9uLAogICAgLy8gICByZWtleUJhY2s6IGJvb2xlYW4sCiAgICAvLyAgIGVzY3Jvdzogc3RyaW5nLAogICAgLy8gICBzb3VyY2U6IEFjY291bnQsCiAgICAvLyAgIGFsbG9jYXRhYmxlOiBib29sZWFuLAogICAgLy8gICBvcHRpbkFsbG93ZWQ6IGJvb2xlYW4sCiAgICAvLyAgIHNwbGl0czogU3BsaXRbXQogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLm5ld1JlY2VpdmVFc2Nyb3dXaXRoUmVmW3JvdXRpbmddKCkgLT4gdm9pZDoKbmV3UmVjZWl2ZUVzY3Jvd1dpdGhSZWY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMDAtMjA4CiAgICAvLyBuZXdSZWNlaXZlRXNjcm93V2l0aFJlZigKICAgIC8vICAgd2FsbGV0OiBBcHBsaWNhdGlvbiwKICAgIC8vICAgcmVrZXlCYWNrOiBib29sZWFuLAogICAgLy8gICBlc2Nyb3c6IHN0cmluZywKICAgIC8vICAgc291cmNlOiBBY2NvdW50LAogICAgLy8gICBhbGxvY2F0YWJsZTogYm9vbGVhbiwKICAgIC8vICAgb3B0aW5BbGxvd2VkOiBib29sZWFuLAogICAgLy8gICBzcGxpdFJlZjogU3BsaXRSZWYKICAgIC8vICk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgYm9vbDgKICAgIGludGNfMCAvLyAwCiAgICBnZXRiaXQKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNAogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjEwCiAgICAvLyBhc3NlcnQodGhpcy5jb250cm9scyhzZW5kZXIpLCBFUlJfRk9SQklEREVOKQogICAgY2FsbHN1YiBjb250cm9scwogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3Igd2FsbGV0IGNhbiBkZWxldGUgYSBzcGVuZGluZyBhY2NvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjAKICAgIC8vIGNyZWF0aW9uRGF0ZTogR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBieXRlYyA0IC8vIDB4MDAKICAgIGludGNfMCAvLyAwCiAgICB1bmNvdmVyIDUKICAgIHNldGJpdAogICAgaW50Y18xIC8vIDEKICAgIHVuY292ZXIgNAogICAgc2V0Yml0CiAgICB1bmNvdmVyIDMKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjE2CiAgICAvLyBvcHRpbkNvdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBpdG9iCiAgICBzd2FwCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTcKICAgIC8vIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICBieXRlYyA0IC8vIDB4MDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIxMi0yMjEKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgLy8gICBzb3VyY2UsCiAgICAvLyAgIGFsbG9jYXRhYmxlLAogICAgLy8gICBvcHRpbkFsbG93ZWQsCiAgICAvLyAgIG9wdGluQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGFsbG9jYXRpb25Db3VudGVyOiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50OiAwLAogICAgLy8gICBjcmVhdGlvbkRhdGU6IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICAvLyB9CiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTIKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlID0gewogICAgZGlnIDQKICAgIGl0b2IKICAgIGRpZyAzCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDQKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWNfMiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMQogICAgLy8gZXNjcm93cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFJlY2VpdmVFc2Nyb3c+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeEVzY3Jvd3MgfSkKICAgIGJ5dGVjXzMgLy8gImUiCiAgICBkaWcgMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMTItMjIxCiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZSA9IHsKICAgIC8vICAgc291cmNlLAogICAgLy8gICBhbGxvY2F0YWJsZSwKICAgIC8vICAgb3B0aW5BbGxvd2VkLAogICAgLy8gICBvcHRpbkNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRXNjcm93RGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBhbGxvY2F0aW9uQ291bnRlcjogMCwKICAgIC8vICAgbGFzdERpc2J1cnNlbWVudDogMCwKICAgIC8vICAgY3JlYXRpb25EYXRlOiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wLAogICAgLy8gfQogICAgdW5jb3ZlciAyCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNwogICAgLy8gc3BsaXRSZWZzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgU3BsaXRSZWY+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeFNwbGl0UmVmcyB9KQogICAgcHVzaGJ5dGVzICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjQKICAgIC8vIHRoaXMuc3BsaXRSZWZzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUgPSBjbG9uZShzcGxpdFJlZikKICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMjYKICAgIC8vIHJla2V5QmFja0lmTmVjZXNzYXJ5KHJla2V5QmFjaywgd2FsbGV0KQogICAgc3dhcAogICAgY2FsbHN1YiByZWtleUJhY2tJZk5lY2Vzc2FyeQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjAwLTIwOAogICAgLy8gbmV3UmVjZWl2ZUVzY3Jvd1dpdGhSZWYoCiAgICAvLyAgIHdhbGxldDogQXBwbGljYXRpb24sCiAgICAvLyAgIHJla2V5QmFjazogYm9vbGVhbiwKICAgIC8vICAgZXNjcm93OiBzdHJpbmcsCiAgICAvLyAgIHNvdXJjZTogQWNjb3VudCwKICAgIC8vICAgYWxsb2NhdGFibGU6IGJvb2xlYW4sCiAgICAvLyAgIG9wdGluQWxsb3dlZDogYm9vbGVhbiwKICAgIC8vICAgc3BsaXRSZWY6IFNwbGl0UmVmCiAgICAvLyApOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgpzdGFydEVzY3Jvd0Rpc2J1cnNlbWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjMwCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZGlnIDEKICAgIGNhbGxzdWIgZ2V0RXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMzEKICAgIC8vIGFzc2VydChlc2Nyb3cgIT09ICcnLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZHVwCiAgICBieXRlY18xIC8vICIiCiAgICAhPQogICAgYXNzZXJ0IC8vIEVzY3JvdyBkb2VzIG5vdCBleGlzdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBjb25zdCB7IGlkOiBlc2Nyb3dJRCB9ID0gbXVzdEdldEVzY3Jvd0luZm8od2FsbGV0KQogICAgZGlnIDIKICAgIGNhbGxzdWIgbXVzdEdldEVzY3Jvd0luZm8KICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjM0CiAgICAvLyBhc3NlcnQodGhpcy5jb250cm9scyhzZW5kZXIpLCBFUlJfRk9SQklEREVOKQogICAgZHVwCiAgICBjYWxsc3ViIGNvbnRyb2xzCiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciB3YWxsZXQgY2FuIGRlbGV0ZSBhIHNwZW5kaW5nIGFjY291bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIzNQogICAgLy8gYXNzZXJ0KHNlbmRlciA9PT0gQXBwbGljYXRpb24oZXNjcm93SUQpLmFkZHJlc3MsIEVSUl9DT05UUk9MTEVEX0FERFJFU1NfTVVTVF9CRV9FU0NST1cpCiAgICBzd2FwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIENvbnRyb2xsZWQgYWRkcmVzcyBtdXN0IGJlIGVzY3JvdwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjM3CiAgICAvLyBhc3NlcnQodGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkuZXhpc3RzLCBFUlJfRVNDUk9XX0RPRVNfTk9UX0VYSVNUKQogICAgZGlnIDIKICAgIGl0b2IKICAgIGRpZyAxCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgc3dhcAogICAgYnl0ZWNfMiAvLyAweDAwMGEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMQogICAgLy8gZXNjcm93cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFJlY2VpdmVFc2Nyb3c+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeEVzY3Jvd3MgfSkKICAgIGJ5dGVjXzMgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIzNwogICAgLy8gYXNzZXJ0KHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLmV4aXN0cywgRVJSX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gRXNjcm93IGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyMzkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRhYmxlLCBsYXN0RGlzYnVyc2VtZW50LCBjcmVhdGlvbkRhdGUgfSA9IHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBleHRyYWN0IDQxIDEKICAgIGRpZyAxCiAgICBwdXNoaW50IDI1NiAvLyAyNTYKICAgIGdldGJpdAogICAgZGlnIDIKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGV4dHJhY3RfdWludDY0CiAgICB1bmNvdmVyIDMKICAgIHB1c2hpbnQgNTggLy8gNTgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDAKICAgIC8vIGFzc2VydChwaGFzZSA9PT0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VJZGxlLCBFUlJfRVNDUk9XX05PVF9JRExFKQogICAgdW5jb3ZlciAzCiAgICBieXRlYyA0IC8vIDB4MDAKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBpZGxlIHBoYXNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDEKICAgIC8vIGFzc2VydChhbGxvY2F0YWJsZSwgRVJSX0VTQ1JPV19OT1RfQUxMT0NBVEFCTEUpCiAgICB1bmNvdmVyIDIKICAgIGFzc2VydCAvLyBFc2Nyb3cgaXMgbm90IGFsbG9jYXRhYmxlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDMKICAgIC8vIGNvbnN0IGxhdGVzdFdpbmRvdzogdWludDY0ID0gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtICgoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCAtIGNyZWF0aW9uRGF0ZSkgJSBPTkVfREFZKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIC0KICAgIHB1c2hpbnQgODY0MDAgLy8gODY0MDAKICAgICUKICAgIC0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gYXNzZXJ0KGxhdGVzdFdpbmRvdyA+PSBsYXN0RGlzYnVyc2VtZW50LCBFUlJfRVNDUk9XX05PVF9SRUFEWV9GT1JfRElTQlVSU0VNRU5UKQogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgID49CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCByZWFkeSBmb3IgZGlzYnVyc2VtZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNDYKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLnBoYXNlID0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uCiAgICBkaWcgMQogICAgcHVzaGludCA0MSAvLyA0MQogICAgYnl0ZWMgNyAvLyAweDE0CiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjQ3CiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5sYXN0RGlzYnVyc2VtZW50ID0gbGF0ZXN0V2luZG93CiAgICBpdG9iCiAgICBwdXNoaW50IDUwIC8vIDUwCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjQ5CiAgICAvLyByZWtleUJhY2tJZk5lY2Vzc2FyeShyZWtleUJhY2ssIHdhbGxldCkKICAgIHN3YXAKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gc3RhcnRFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uW3JvdXRpbmddKCkgLT4gdm9pZDoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb246CiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiA4CiAgICBieXRlY18xIC8vICIiCiAgICBkdXBuIDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTIKICAgIC8vIHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uKHdhbGxldDogQXBwbGljYXRpb24sIHJla2V5QmFjazogYm9vbGVhbiwgaWRzOiB1aW50NjRbXSk6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMSAvLyAxCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciBib29sOAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMwogICAgZHVwCiAgICBjb3ZlciAyCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBjb3ZlciAzCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgaW50Y18zIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1aW50NjRbXSkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1MwogICAgLy8gY29uc3QgZXNjcm93ID0gZ2V0RXNjcm93KHdhbGxldCkKICAgIGR1cAogICAgY2FsbHN1YiBnZXRFc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1NAogICAgLy8gYXNzZXJ0KGVzY3JvdyAhPT0gJycsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBkdXAKICAgIGJ5dGVjXzEgLy8gIiIKICAgICE9CiAgICBhc3NlcnQgLy8gRXNjcm93IGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTUKICAgIC8vIGNvbnN0IHsgaWQ6IGVzY3Jvd0lEIH0gPSBtdXN0R2V0RXNjcm93SW5mbyh3YWxsZXQpCiAgICBkaWcgMQogICAgY2FsbHN1YiBtdXN0R2V0RXNjcm93SW5mbwogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjI2OAogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgYnl0ZWNfMCAvLyAic3BlbmRpbmdfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY2LTI2OQogICAgLy8gY29uc3QgW3NwZW5kaW5nQWRkcmVzc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKAogICAgLy8gICB3YWxsZXQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c1NwZW5kaW5nQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIGR1cAogICAgY292ZXIgMgogICAgY292ZXIgNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjU3CiAgICAvLyBhc3NlcnQoc2VuZGVyID09PSBBcHBsaWNhdGlvbihlc2Nyb3dJRCkuYWRkcmVzcywgRVJSX0NPTlRST0xMRURfQUREUkVTU19NVVNUX0JFX0VTQ1JPVykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gQ29udHJvbGxlZCBhZGRyZXNzIG11c3QgYmUgZXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIG9wdGluQ291bnQsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEKICAgIC8vIGVzY3Jvd3MgPSBCb3hNYXA8V2FsbGV0RXNjcm93S2V5LCBSZWNlaXZlRXNjcm93Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhFc2Nyb3dzIH0pCiAgICBieXRlY18zIC8vICJlIgogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZHVwCiAgICBjb3ZlciAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNTkKICAgIC8vIGNvbnN0IHsgcGhhc2UsIG9wdGluQ291bnQsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCA0MSAxCiAgICBkaWcgMQogICAgcHVzaGludCAzMyAvLyAzMwogICAgZXh0cmFjdF91aW50NjQKICAgIHVuY292ZXIgMgogICAgcHVzaGludCA0MiAvLyA0MgogICAgZXh0cmFjdF91aW50NjQKICAgIGNvdmVyIDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2MAogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBFc2Nyb3dEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sIEVSUl9FU0NST1dfTk9UX0lOX0FMTE9DQVRJT05fUEhBU0UpCiAgICBzd2FwCiAgICBieXRlYyA3IC8vIDB4MTQKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBhbGxvY2F0aW9uIHBoYXNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNjEKICAgIC8vIGNvbnN0IHRvdGFsQXNzZXRzVG9Qcm9jZXNzOiB1aW50NjQgPSBvcHRpbkNvdW50ICsgMSAvLyArIDEgdG8gaW5jbHVkZSBhbGdvCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUKICAgIC8vIHNwbGl0cyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFNwbGl0W10+KHsga2V5UHJlZml4OiBSZXZlbnVlTWFuYWdlckJveFByZWZpeFNwbGl0cyB9KQogICAgcHVzaGJ5dGVzICJzIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjUxCiAgICAvLyBpZiAodGhpcy5zcGxpdHMoa2V5KS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUA0MgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NTIKICAgIC8vIHJldHVybiBjbG9uZSh0aGlzLnNwbGl0cyhrZXkpLnZhbHVlKQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgYnVyeSAyNAoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4ucmVzb2x2ZVNwbGl0c0A0MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2NwogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPiAwLCBFUlJfU1BMSVRTX0NBTk5PVF9CRV9FTVBUWSkKICAgIGRpZyAyMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGR1cAogICAgYXNzZXJ0IC8vIFNwbGl0cyBjYW5ub3QgYmUgZW1wdHkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2OAogICAgLy8gYXNzZXJ0KHNwbGl0cy5sZW5ndGggPD0gMTAsIEVSUl9TUExJVFNfQ0FOTk9UX0JFX01PUkVfVEhBTl8xMCkKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBhc3NlcnQgLy8gU3BsaXRzIGNhbm5vdCBiZSBtb3JlIHRoYW4gMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI2OQogICAgLy8gdGhpcy52YWxpZGF0ZVNwbGl0cyhzcGxpdHMpCiAgICBjYWxsc3ViIHZhbGlkYXRlU3BsaXRzCiAgICBidXJ5IDI0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpZHMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDE4Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkgKz0gMSkgewogICAgZGlnIDE3CiAgICBkaWcgOAogICAgPAogICAgYnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfd2hpbGVAMjgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3MwogICAgLy8gY29uc3QgYXNzZXQgPSBpZHNbaV0KICAgIGRpZyA4CiAgICBleHRyYWN0IDIgMAogICAgZGlnIDE4CiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYnVyeSAyMAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBhc3NlcnQoIXRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX0FMUkVBRFlfQUxMT0NBVEVEKQogICAgZGlnIDcKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpdG9iCiAgICBkdXAKICAgIGJ1cnkgMzIKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzMKICAgIC8vIHJlY2VpdmVBc3NldHMgPSBCb3hNYXA8RXNjcm93QXNzZXRLZXksIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhSZWNlaXZlQXNzZXRzIH0pCiAgICBwdXNoYnl0ZXMgImEiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGR1cAogICAgYnVyeSAyOQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjc0CiAgICAvLyBhc3NlcnQoIXRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX0FMUkVBRFlfQUxMT0NBVEVEKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICAhCiAgICBhc3NlcnQgLy8gQXNzZXQgaXMgYWxyZWFkeSBhbGxvY2F0ZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3Ni0yNzgKICAgIC8vIGNvbnN0IGJhbGFuY2U6IHVpbnQ2NCA9IGFzc2V0ID09PSAwCiAgICAvLyAgID8gb3AuYmFsYW5jZShHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcykgLSBHbG9iYWwubWluQmFsYW5jZQogICAgLy8gICA6IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2Uoc2VuZGVyLCBhc3NldClbMF0KICAgIGJueiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl90ZXJuYXJ5X2ZhbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3NwogICAgLy8gPyBvcC5iYWxhbmNlKEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzKSAtIEdsb2JhbC5taW5CYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYmFsYW5jZQogICAgZ2xvYmFsIE1pbkJhbGFuY2UKICAgIC0KCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3Rlcm5hcnlfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gZm9yIChsZXQgajogdWludDY0ID0gMDsgaiA8IHNwbGl0cy5sZW5ndGg7IGorKykgewogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTcKICAgIGJ1cnkgMTQKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjgxCiAgICAvLyBmb3IgKGxldCBqOiB1aW50NjQgPSAwOyBqIDwgc3BsaXRzLmxlbmd0aDsgaisrKSB7CiAgICBkaWcgMjMKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBidXJ5IDIzCiAgICBkaWcgMTYKICAgID4KICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODIKICAgIC8vIGNvbnN0IHsgdHlwZSwgcmVjZWl2ZXIsIHZhbHVlIH0gPSBjbG9uZShzcGxpdHNbal0pCiAgICBkaWcgMjMKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMTYKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZHVwCiAgICBidXJ5IDE5CiAgICBkaWcgMjQKICAgIGRpZyAxCiAgICAtIC8vIG9uIGVycm9yOiBpbmRleCBhY2Nlc3MgaXMgb3V0IG9mIGJvdW5kcwogICAgZGlnIDMKICAgIGxlbgogICAgdW5jb3ZlciAyCiAgICBpbnRjXzMgLy8gMgogICAgKgogICAgZGlnIDQKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDE2CiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDIgMQogICAgYnVyeSAyNAogICAgZHVwCiAgICBwdXNoaW50IDMgLy8gMwogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI4NS0yODgKICAgIC8vIGNvbnN0IHJlY2VpdmVyRXNjcm93SW5mbyA9IGFiaUNhbGw8dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9nZXRFc2Nyb3dzPih7CiAgICAvLyAgIGFwcElkOiByZWNlaXZlci53YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFtbcmVjZWl2ZXIuZXNjcm93XV0KICAgIC8vIH0pLnJldHVyblZhbHVlWzBdCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODYKICAgIC8vIGFwcElkOiByZWNlaXZlci53YWxsZXQsCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBleHRyYWN0IDAgOAogICAgYnVyeSAzMgogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjg3CiAgICAvLyBhcmdzOiBbW3JlY2VpdmVyLmVzY3Jvd11dCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDEKICAgIGxlbgogICAgc3Vic3RyaW5nMwogICAgZHVwCiAgICBidXJ5IDMyCiAgICBleHRyYWN0IDIgMAogICAgZHVwCiAgICBsZW4KICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICBzd2FwCiAgICBjb25jYXQKICAgIGJ5dGVjIDkgLy8gMHgwMDAxMDAwMgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyODUtMjg4CiAgICAvLyBjb25zdCByZWNlaXZlckVzY3Jvd0luZm8gPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZDogcmVjZWl2ZXIud2FsbGV0LAogICAgLy8gICBhcmdzOiBbW3JlY2VpdmVyLmVzY3Jvd11dCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgYnl0ZWMgMTAgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBkaWcgMQogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjIDExIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgOSAvLyA5CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuKyh1aW50NjQsYm9vbDEpW10pCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTAKICAgIC8vIGNvbnN0IHJlY2VpdmVyQWRkcmVzcyA9IEFwcGxpY2F0aW9uKHJlY2VpdmVyRXNjcm93SW5mby5pZCkuYWRkcmVzcwogICAgZXh0cmFjdCA2IDkKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgc3dhcAogICAgZHVwCiAgICBjb3ZlciAyCiAgICBidXJ5IDI3CiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTMKICAgIC8vIGlmICghcmVjZWl2ZXJBZGRyZXNzLmlzT3B0ZWRJbihBc3NldChhc3NldCkpKSB7CiAgICBkaWcgMTkKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjk0CiAgICAvLyBhcmM1OE9wdEluQW5kU2VuZCh0aGlzLmFraXRhREFPLnZhbHVlLCByZWNlaXZlci53YWxsZXQsIHJlY2VpdmVyLmVzY3JvdywgW2Fzc2V0XSwgWzBdKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNiAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6Mjk0CiAgICAvLyBhcmM1OE9wdEluQW5kU2VuZCh0aGlzLmFraXRhREFPLnZhbHVlLCByZWNlaXZlci53YWxsZXQsIHJlY2VpdmVyLmVzY3JvdywgW2Fzc2V0XSwgWzBdKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIHB1c2hieXRlcyAweDAwMDEKICAgIGRpZyAzMAogICAgY29uY2F0CiAgICBkdXAKICAgIGJ1cnkgMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzcyCiAgICAvLyBhc3NlcnQoYXNzZXRzLmxlbmd0aCA9PT0gYW1vdW50cy5sZW5ndGgsIEVSUl9BU1NFVFNfQU5EX0FNT1VOVFNfTUlTTUFUQ0gpCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBBc3NldHMgYW5kIGFtb3VudHMgbWlzbWF0Y2gKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICB1bmNvdmVyIDIKICAgIHB1c2hieXRlcyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozNzMKICAgIC8vIGNvbnN0IG9wdGluUGx1Z2luID0gZ2V0UGx1Z2luQXBwTGlzdChha2l0YURBTykub3B0aW4KICAgIGR1cAogICAgZXh0cmFjdCAwIDgKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDE4CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYzCiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIHB1c2hieXRlcyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDMyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3Ni0zNzkKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogcmVjaXBpZW50V2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbb3B0aW5QbHVnaW4sIHRydWUsIGVzY3JvdywgW10sIFtdXQogICAgLy8gfSkKICAgIGl0eG5fYmVnaW4KICAgIHB1c2hieXRlcyAweDU4MmZmMzgyIC8vIG1ldGhvZCAiYXJjNThfcmVrZXlUb1BsdWdpbih1aW50NjQsYm9vbCxzdHJpbmcsdWludDY0W10sKHVpbnQ2NCx1aW50NjQpW10pdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3OAogICAgLy8gYXJnczogW29wdGluUGx1Z2luLCB0cnVlLCBlc2Nyb3csIFtdLCBbXV0KICAgIHB1c2hieXRlcyAweDgwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDM0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWMgMTIgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgYnl0ZWMgMTIgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM3Ni0zNzkKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogcmVjaXBpZW50V2FsbGV0LmlkLAogICAgLy8gICBhcmdzOiBbb3B0aW5QbHVnaW4sIHRydWUsIGVzY3JvdywgW10sIFtdXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzgxLTM5MgogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpblBsdWdpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHJlY2lwaWVudFdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIGFzc2V0cywKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mzg5CiAgICAvLyBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgdW5jb3ZlciAzCiAgICAqCiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgc3dhcAogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozODctMzkwCiAgICAvLyBpdHhuLnBheW1lbnQoewogICAgLy8gICByZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIC8vIH0pCiAgICBpbnRjXzEgLy8gMQogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM4MS0zOTIKICAgIC8vIGl0eG5Db21wb3NlLm5leHQ8dHlwZW9mIE9wdEluUGx1Z2luLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogb3B0aW5QbHVnaW4sCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZWNpcGllbnRXYWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBhc3NldHMsCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgICBhbW91bnQ6IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIGFzc2V0cy5sZW5ndGgKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICBieXRlYyA4IC8vIG1ldGhvZCAib3B0SW4odWludDY0LGJvb2wsdWludDY0W10scGF5KXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMyCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozNzgKICAgIC8vIGFyZ3M6IFtvcHRpblBsdWdpbiwgdHJ1ZSwgZXNjcm93LCBbXSwgW11dCiAgICBwdXNoYnl0ZXMgMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MzgxLTM5MgogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgT3B0SW5QbHVnaW4ucHJvdG90eXBlLm9wdEluPih7CiAgICAvLyAgIGFwcElkOiBvcHRpblBsdWdpbiwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIHJlY2lwaWVudFdhbGxldCwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIGFzc2V0cywKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IG9yaWdpbiwKICAgIC8vICAgICAgIGFtb3VudDogR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogYXNzZXRzLmxlbmd0aAogICAgLy8gICAgIH0pCiAgICAvLyAgIF0KICAgIC8vIH0pCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDE3Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BAMzQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyAxNgogICAgaW50Y18xIC8vIDEKICAgIDwKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NQogICAgLy8gaWYgKGFtb3VudHNbaV0gPiAwKSB7CiAgICBkaWcgMTYKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBkdXAKICAgIGJ1cnkgMjIKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDAwMDAKICAgIHN3YXAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGJ1cnkgMTMKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Mzk2LTQwMgogICAgLy8gaXR4bkNvbXBvc2UubmV4dCgKICAgIC8vICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50c1tpXSwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBvcmlnaW4sCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldHNbaV0KICAgIC8vICAgfSkKICAgIC8vICkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MDAKICAgIC8vIHhmZXJBc3NldDogYXNzZXRzW2ldCiAgICBkaWcgMjcKICAgIGV4dHJhY3QgMiAwCiAgICBkaWcgMjEKICAgIGV4dHJhY3RfdWludDY0CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZGlnIDI1CiAgICBpdHhuX2ZpZWxkIEFzc2V0UmVjZWl2ZXIKICAgIGRpZyAxMQogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czozOTctNDAxCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldEFtb3VudDogYW1vdW50c1tpXSwKICAgIC8vICAgYXNzZXRSZWNlaXZlcjogb3JpZ2luLAogICAgLy8gICB4ZmVyQXNzZXQ6IGFzc2V0c1tpXQogICAgLy8gfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjM5NAogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGFtb3VudHMubGVuZ3RoOyBpKyspIHsKICAgIGRpZyAxNgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTcKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDM0Cgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl93aGlsZUAzODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDA2LTQwOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzPih7CiAgICAvLyAgIGFwcElkOiByZWNpcGllbnRXYWxsZXQKICAgIC8vIH0pCiAgICBpdHhuX25leHQKICAgIHB1c2hieXRlcyAweDZjYzNmNjA2IC8vIG1ldGhvZCAiYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3MoKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDE0CiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDEwCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTcKICAgIC8vIGxldCBhbW91bnQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDIwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTkKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlRmxhdDoKICAgIGRpZyAyMgogICAgcHVzaGJ5dGVzIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyOTktMzAxCiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZUZsYXQ6CiAgICAvLyAgIGFtb3VudCA9IHZhbHVlCiAgICAvLyAgIGJyZWFrCiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDE0CiAgICBkaWcgMTIKICAgIGJ1cnkgMjAKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Jsb2NrQDIxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzEyCiAgICAvLyBhc3NlcnQoYW1vdW50IDw9IHJlbWFpbmluZywgRVJSX09WRVJfQUxMT0NBVElPTikKICAgIGRpZyAxOQogICAgZHVwCiAgICBkaWcgMTUKICAgIGR1cAogICAgY292ZXIgMgogICAgPD0KICAgIGFzc2VydCAvLyBPdmVyIGFsbG9jYXRpb24gb2YgYXNzZXRzIGluIHRoZSBlc2Nyb3cKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxMwogICAgLy8gcmVtYWluaW5nIC09IGFtb3VudAogICAgc3dhcAogICAgLQogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE1CiAgICAvLyBpZiAoYXNzZXQgPT09IDApIHsKICAgIGRpZyAxOAogICAgYm56IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Vsc2VfYm9keUAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE2LTMyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDE5CiAgICBpdHhuX2ZpZWxkIEFtb3VudAogICAgZGlnIDI0CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICBkaWcgNQogICAgaXR4bl9maWVsZCBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxNi0zMjEKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICByZWNlaXZlcjogcmVjZWl2ZXJBZGRyZXNzLAogICAgLy8gICAgIGFtb3VudAogICAgLy8gICB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzE2LTMyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIHJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDcKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Vsc2VfYm9keUAyNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMyNC0zMzEKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIHNlbmRlciwKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiByZWNlaXZlckFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGFtb3VudCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0CiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgZGlnIDE4CiAgICBpdHhuX2ZpZWxkIFhmZXJBc3NldAogICAgZGlnIDE5CiAgICBpdHhuX2ZpZWxkIEFzc2V0QW1vdW50CiAgICBkaWcgMjQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgZGlnIDUKICAgIGl0eG5fZmllbGQgU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMjQtMzMwCiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBzZW5kZXIsCiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogcmVjZWl2ZXJBZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBhbW91bnQsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldAogICAgLy8gICB9KQogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzI0LTMzMQogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgc2VuZGVyLAogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHJlY2VpdmVyQWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50LAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgYiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl93aGlsZV90b3BANwoKcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYWZ0ZXJfaWZfZWxzZUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwMgogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOgogICAgZGlnIDIyCiAgICBieXRlYyA3IC8vIDB4MTQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDItMzA1CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6CiAgICAvLyAgIC8vIENhbGN1bGF0ZSBwZXJjZW50YWdlIG9uIHJlbWFpbmluZyBiYWxhbmNlIChhZnRlciBmbGF0IGFtb3VudHMpCiAgICAvLyAgIGFtb3VudCA9IGNhbGNQZXJjZW50KHJlbWFpbmluZywgdmFsdWUpCiAgICAvLyAgIGJyZWFrCiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGRpZyAxMgogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMTQKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIGJ1cnkgMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwNQogICAgLy8gYnJlYWsKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYmxvY2tAMjEKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lmX2Vsc2VAMTc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDYKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlUmVtYWluZGVyOgogICAgZGlnIDIyCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozMDYtMzA5CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVJlbWFpbmRlcjoKICAgIC8vICAgLy8gUmVtYWluZGVyIGdldHMgd2hhdGV2ZXIgaXMgbGVmdAogICAgLy8gICBhbW91bnQgPSByZW1haW5pbmcKICAgIC8vICAgYnJlYWsKICAgIGJ6IHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2Jsb2NrQDIxCiAgICBkaWcgMTMKICAgIGJ1cnkgMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMwOQogICAgLy8gYnJlYWsKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fYmxvY2tAMjEKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM1CiAgICAvLyB0aGlzLnJlY2VpdmVBc3NldHMoeyBlc2Nyb3c6IGVzY3Jvd0lELCBhc3NldCB9KS5jcmVhdGUoKQogICAgZGlnIDI2CiAgICBpbnRjXzAgLy8gMAogICAgYm94X2NyZWF0ZQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoyNzIKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBpZHMubGVuZ3RoOyBpICs9IDEpIHsKICAgIGRpZyAxNwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTgKICAgIGIgcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb25fd2hpbGVfdG9wQDIKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX3Rlcm5hcnlfZmFsc2VANToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI3OAogICAgLy8gOiBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKHNlbmRlciwgYXNzZXQpWzBdCiAgICBkaWcgNQogICAgZGlnIDE5CiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl90ZXJuYXJ5X21lcmdlQDYKCnByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX3doaWxlQDI4OgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM4CiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5hbGxvY2F0aW9uQ291bnRlciArPSBpZHMubGVuZ3RoCiAgICBkaWcgMwogICAgZHVwCiAgICBib3hfZ2V0CiAgICBhc3NlcnQgLy8gQm94IG11c3QgaGF2ZSB2YWx1ZQogICAgcHVzaGludCA0MiAvLyA0MgogICAgZXh0cmFjdF91aW50NjQKICAgIGRpZyA5CiAgICBkdXAKICAgIGNvdmVyIDIKICAgICsKICAgIGl0b2IKICAgIHVuY292ZXIgMgogICAgcHVzaGludCA0MiAvLyA0MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzM5CiAgICAvLyBpZiAoKGFsbG9jYXRpb25Db3VudGVyICsgaWRzLmxlbmd0aCkgPT09IHRvdGFsQXNzZXRzVG9Qcm9jZXNzKSB7CiAgICBkaWcgMwogICAgKwogICAgZGlnIDIKICAgID09CiAgICBieiBwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNDAKICAgIC8vIHRoaXMuZXNjcm93cyh7IHdhbGxldCwgZXNjcm93IH0pLnZhbHVlLnBoYXNlID0gRXNjcm93RGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24KICAgIGRpZyAzCiAgICBwdXNoaW50IDQxIC8vIDQxCiAgICBieXRlYyA1IC8vIDB4MWUKICAgIGJveF9yZXBsYWNlCgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDMwOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzQzCiAgICAvLyByZWtleUJhY2tJZk5lY2Vzc2FyeShyZWtleUJhY2ssIHdhbGxldCkKICAgIGRpZyA5CiAgICBkaWcgMTEKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjI1MgogICAgLy8gcHJvY2Vzc0VzY3Jvd0FsbG9jYXRpb24od2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpwcm9jZXNzRXNjcm93QWxsb2NhdGlvbl9hZnRlcl9pZl9lbHNlQDQyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzcKICAgIC8vIHNwbGl0UmVmcyA9IEJveE1hcDxXYWxsZXRFc2Nyb3dLZXksIFNwbGl0UmVmPih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhTcGxpdFJlZnMgfSkKICAgIHB1c2hieXRlcyAiciIKICAgIGRpZyA1CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjU2CiAgICAvLyBhc3NlcnQodGhpcy5zcGxpdFJlZnMoa2V5KS5leGlzdHMsIEVSUl9TUExJVFNfT1JfUkVGX1JFUVVJUkVEKQogICAgZHVwCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBFaXRoZXIgc3BsaXRzIG9yIGEgc3BsaXQgcmVmZXJlbmNlIG11c3QgYmUgcHJvdmlkZWQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCB7IGFwcCwga2V5OiByZWZLZXkgfSA9IHRoaXMuc3BsaXRSZWZzKGtleSkudmFsdWUKICAgIGR1cAogICAgYm94X2dldAogICAgcG9wCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIGV4dHJhY3QgMiAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2MAogICAgLy8gY29uc3QgW3JlZlNwbGl0c0J5dGVzLCBleGlzdHNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoQXBwbGljYXRpb24oYXBwKSwgQnl0ZXMocmVmS2V5KSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2MQogICAgLy8gYXNzZXJ0KGV4aXN0cywgRVJSX1NQTElUX1JFRl9OT1RfRk9VTkQpCiAgICBhc3NlcnQgLy8gUmVmZXJlbmNlZCBzcGxpdHMgbm90IGZvdW5kIGluIHRhcmdldCBjb250cmFjdAogICAgYnVyeSAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MjY0CiAgICAvLyBjb25zdCBzcGxpdHMgPSB0aGlzLnJlc29sdmVTcGxpdHMod2FsbGV0LCBlc2Nyb3cpCiAgICBiIHByb2Nlc3NFc2Nyb3dBbGxvY2F0aW9uX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OlJldmVudWVNYW5hZ2VyUGx1Z2luLnJlc29sdmVTcGxpdHNANDMKCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnRbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1MQogICAgLy8gZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBzd2FwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAzCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzMgLy8gMgogICAgKwogICAgc3dhcAogICAgbGVuCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAobGVuK3VpbnQ2NFtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUyCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBnZXRFc2Nyb3cod2FsbGV0KQogICAgZHVwCiAgICBjYWxsc3ViIGdldEVzY3JvdwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzUzCiAgICAvLyBhc3NlcnQoZXNjcm93ICE9PSAnJywgRVJSX0VTQ1JPV19ET0VTX05PVF9FWElTVCkKICAgIGR1cAogICAgYnl0ZWNfMSAvLyAiIgogICAgIT0KICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1NAogICAgLy8gY29uc3QgeyBpZDogZXNjcm93SUQgfSA9IG11c3RHZXRFc2Nyb3dJbmZvKHdhbGxldCkKICAgIGRpZyAxCiAgICBjYWxsc3ViIG11c3RHZXRFc2Nyb3dJbmZvCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgZGlnIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjY4CiAgICAvLyBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNTcGVuZGluZ0FkZHJlc3MpCiAgICBieXRlY18wIC8vICJzcGVuZGluZ19hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyNjYtMjY5CiAgICAvLyBjb25zdCBbc3BlbmRpbmdBZGRyZXNzQnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldCwKICAgIC8vICAgQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzU3BlbmRpbmdBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzU2CiAgICAvLyBhc3NlcnQoc2VuZGVyID09PSBBcHBsaWNhdGlvbihlc2Nyb3dJRCkuYWRkcmVzcywgRVJSX0NPTlRST0xMRURfQUREUkVTU19NVVNUX0JFX0VTQ1JPVykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gQ29udHJvbGxlZCBhZGRyZXNzIG11c3QgYmUgZXNjcm93CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNTgKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgc3dhcAogICAgaXRvYgogICAgZGlnIDEKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBieXRlY18yIC8vIDB4MDAwYQogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjMxCiAgICAvLyBlc2Nyb3dzID0gQm94TWFwPFdhbGxldEVzY3Jvd0tleSwgUmVjZWl2ZUVzY3Jvdz4oeyBrZXlQcmVmaXg6IFJldmVudWVNYW5hZ2VyQm94UHJlZml4RXNjcm93cyB9KQogICAgYnl0ZWNfMyAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNTgKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGFsbG9jYXRpb25Db3VudGVyIH0gPSB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZQogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgZXh0cmFjdCA0MSAxCiAgICBzd2FwCiAgICBwdXNoaW50IDQyIC8vIDQyCiAgICBleHRyYWN0X3VpbnQ2NAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzU5CiAgICAvLyBhc3NlcnQocGhhc2UgPT09IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlRmluYWxpemF0aW9uLCBFUlJfRVNDUk9XX05PVF9JTl9GSU5BTElaQVRJT05fUEhBU0UpCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICBhc3NlcnQgLy8gRXNjcm93IGlzIG5vdCBpbiBmaW5hbGl6YXRpb24gcGhhc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkrKykgewogICAgaW50Y18wIC8vIDAKCmZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X3doaWxlX3RvcEAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzYyCiAgICAvLyBmb3IgKGxldCBpOiB1aW50NjQgPSAwOyBpIDwgaWRzLmxlbmd0aDsgaSsrKSB7CiAgICBkdXAKICAgIGRpZyA1CiAgICA8CiAgICBieiBmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudF9hZnRlcl93aGlsZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjMKICAgIC8vIGNvbnN0IGFzc2V0ID0gaWRzW2ldCiAgICBkaWcgNQogICAgZXh0cmFjdCAyIDAKICAgIGRpZyAxCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdDMgLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjQKICAgIC8vIGFzc2VydCh0aGlzLnJlY2VpdmVBc3NldHMoeyBlc2Nyb3c6IGVzY3Jvd0lELCBhc3NldCB9KS5leGlzdHMsIEVSUl9BU1NFVF9OT1RfQUxMT0NBVEVEKQogICAgZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzMKICAgIC8vIHJlY2VpdmVBc3NldHMgPSBCb3hNYXA8RXNjcm93QXNzZXRLZXksIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUmV2ZW51ZU1hbmFnZXJCb3hQcmVmaXhSZWNlaXZlQXNzZXRzIH0pCiAgICBwdXNoYnl0ZXMgImEiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2NAogICAgLy8gYXNzZXJ0KHRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmV4aXN0cywgRVJSX0FTU0VUX05PVF9BTExPQ0FURUQpCiAgICBkdXAKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYXNzZXJ0IC8vIEFzc2V0IHdhcyBub3QgYWxsb2NhdGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czozNjUKICAgIC8vIHRoaXMucmVjZWl2ZUFzc2V0cyh7IGVzY3JvdzogZXNjcm93SUQsIGFzc2V0IH0pLmRlbGV0ZSgpCiAgICBib3hfZGVsCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGlkcy5sZW5ndGg7IGkrKykgewogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMQogICAgYiBmaW5hbGl6ZUVzY3Jvd0Rpc2J1cnNlbWVudF93aGlsZV90b3BAMgoKZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnRfYWZ0ZXJfd2hpbGVANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM2OQogICAgLy8gdGhpcy5lc2Nyb3dzKHsgd2FsbGV0LCBlc2Nyb3cgfSkudmFsdWUuYWxsb2NhdGlvbkNvdW50ZXIgLT0gaWRzLmxlbmd0aAogICAgZGlnIDIKICAgIGR1cAogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIGV4dHJhY3RfdWludDY0CiAgICBkaWcgNgogICAgZHVwCiAgICBjb3ZlciAyCiAgICAtCiAgICBpdG9iCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgNDIgLy8gNDIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM3MgogICAgLy8gaWYgKChhbGxvY2F0aW9uQ291bnRlciAtIGlkcy5sZW5ndGgpID09PSAwKSB7CiAgICBkaWcgMgogICAgc3dhcAogICAgLQogICAgYm56IGZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X2FmdGVyX2lmX2Vsc2VANgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MzczCiAgICAvLyB0aGlzLmVzY3Jvd3MoeyB3YWxsZXQsIGVzY3JvdyB9KS52YWx1ZS5waGFzZSA9IEVzY3Jvd0Rpc2J1cnNlbWVudFBoYXNlSWRsZQogICAgZGlnIDIKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIGJ5dGVjIDQgLy8gMHgwMAogICAgYm94X3JlcGxhY2UKCmZpbmFsaXplRXNjcm93RGlzYnVyc2VtZW50X2FmdGVyX2lmX2Vsc2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM3NgogICAgLy8gcmVrZXlCYWNrSWZOZWNlc3NhcnkocmVrZXlCYWNrLCB3YWxsZXQpCiAgICBkaWcgNgogICAgZGlnIDgKICAgIGNhbGxzdWIgcmVrZXlCYWNrSWZOZWNlc3NhcnkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM1MQogICAgLy8gZmluYWxpemVFc2Nyb3dEaXNidXJzZW1lbnQod2FsbGV0OiBBcHBsaWNhdGlvbiwgcmVrZXlCYWNrOiBib29sZWFuLCBpZHM6IHVpbnQ2NFtdKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OkFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZUFraXRhREFPW3JvdXRpbmddKCkgLT4gdm9pZDoKdXBkYXRlQWtpdGFEQU86CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOAogICAgLy8gdXBkYXRlQWtpdGFEQU8oYWtpdGFEQU86IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzkKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlYyA2IC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWMgNiAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo6UmV2ZW51ZU1hbmFnZXJQbHVnaW4uY29udHJvbHMoc2VuZGVyOiBieXRlcykgLT4gdWludDY0Ogpjb250cm9sczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjM5CiAgICAvLyBwcml2YXRlIGNvbnRyb2xzKHNlbmRlcjogQWNjb3VudCk6IGJvb2xlYW4gewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo0MAogICAgLy8gcmV0dXJuIHNlbmRlci5hdXRoQWRkcmVzcyA9PT0gR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGZyYW1lX2RpZyAtMQogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RBdXRoQWRkcgogICAgYXNzZXJ0IC8vIGFjY291bnQgZnVuZGVkCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgPT0KICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjpSZXZlbnVlTWFuYWdlclBsdWdpbi52YWxpZGF0ZVNwbGl0cyhzcGxpdHM6IGJ5dGVzKSAtPiBieXRlczoKdmFsaWRhdGVTcGxpdHM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo2OAogICAgLy8gcHJpdmF0ZSB2YWxpZGF0ZVNwbGl0cyhzcGxpdHM6IFNwbGl0W10pOiB2b2lkIHsKICAgIHByb3RvIDEgMQogICAgaW50Y18wIC8vIDAKICAgIGJ5dGVjXzEgLy8gIiIKICAgIGR1cG4gMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NjkKICAgIC8vIGxldCB0b3RhbFBlcmNlbnRhZ2U6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo3MC03MQogICAgLy8gbGV0IGhhc1JlbWFpbmRlcjogYm9vbGVhbiA9IGZhbHNlCiAgICAvLyBsZXQgaGFzRmxhdDogYm9vbGVhbiA9IGZhbHNlCiAgICBkdXBuIDMKCnZhbGlkYXRlU3BsaXRzX3doaWxlX3RvcEAxOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NzMKICAgIC8vIGZvciAobGV0IGk6IHVpbnQ2NCA9IDA7IGkgPCBzcGxpdHMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyAtMQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgZnJhbWVfZGlnIDgKICAgID4KICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2FmdGVyX3doaWxlQDE3CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo3NAogICAgLy8gY29uc3QgeyB0eXBlLCB2YWx1ZSB9ID0gY2xvbmUoc3BsaXRzW2ldKQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDIgMAogICAgZnJhbWVfZGlnIDgKICAgIGR1cAogICAgY292ZXIgMgogICAgaW50Y18zIC8vIDIKICAgICoKICAgIGRpZyAxCiAgICBzd2FwCiAgICBleHRyYWN0X3VpbnQxNgogICAgZGlnIDIKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgZnJhbWVfZGlnIDEKICAgIGR1cAogICAgY292ZXIgNAogICAgZGlnIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDIKICAgIGludGNfMyAvLyAyCiAgICAqCiAgICBkaWcgNAogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGV4dHJhY3QgMiAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHB1c2hpbnQgMyAvLyAzCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjc1CiAgICAvLyBjb25zdCBpc0xhc3QgPSBpID09PSBzcGxpdHMubGVuZ3RoIC0gMQogICAgc3dhcAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIHVuY292ZXIgMgogICAgPT0KICAgIGZyYW1lX2J1cnkgMwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6NzkKICAgIC8vIHZhbHVlID4gMCB8fCB0eXBlID09PSBTcGxpdERpc3RyaWJ1dGlvblR5cGVSZW1haW5kZXIsCiAgICBibnogdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDQKICAgIGZyYW1lX2RpZyAwCiAgICBieXRlYyA1IC8vIDB4MWUKICAgID09CiAgICBieiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDUKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUA0OgogICAgaW50Y18xIC8vIDEKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjc4LTgxCiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHZhbHVlID4gMCB8fCB0eXBlID09PSBTcGxpdERpc3RyaWJ1dGlvblR5cGVSZW1haW5kZXIsCiAgICAvLyAgIEVSUl9TUExJVF9WQUxVRV9NVVNUX0JFX1BPU0lUSVZFX09SX1JFTUFJTkRFUgogICAgLy8gKQogICAgYXNzZXJ0IC8vIFNwbGl0IHZhbHVlIG11c3QgYmUgcG9zaXRpdmUgb3IgcmVtYWluZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NAogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOgogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDcgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjg0LTg2CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6CiAgICAvLyAgIHRvdGFsUGVyY2VudGFnZSArPSB2YWx1ZQogICAgLy8gICBicmVhawogICAgYnogdmFsaWRhdGVTcGxpdHNfYWZ0ZXJfaWZfZWxzZUA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NQogICAgLy8gdG90YWxQZXJjZW50YWdlICs9IHZhbHVlCiAgICBmcmFtZV9kaWcgNQogICAgZnJhbWVfZGlnIDQKICAgICsKICAgIGZyYW1lX2J1cnkgNQoKdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTY6CiAgICBmcmFtZV9kaWcgMgogICAgZnJhbWVfYnVyeSA4CiAgICBiIHZhbGlkYXRlU3BsaXRzX3doaWxlX3RvcEAxCgp2YWxpZGF0ZVNwbGl0c19hZnRlcl9pZl9lbHNlQDk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo4NwogICAgLy8gY2FzZSBTcGxpdERpc3RyaWJ1dGlvblR5cGVGbGF0OgogICAgZnJhbWVfZGlnIDAKICAgIHB1c2hieXRlcyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6ODctODkKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlRmxhdDoKICAgIC8vICAgaGFzRmxhdCA9IHRydWUKICAgIC8vICAgYnJlYWsKICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2FmdGVyX2lmX2Vsc2VAMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjg4CiAgICAvLyBoYXNGbGF0ID0gdHJ1ZQogICAgaW50Y18xIC8vIDEKICAgIGZyYW1lX2J1cnkgNwogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6ODkKICAgIC8vIGJyZWFrCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jsb2NrQDE2Cgp2YWxpZGF0ZVNwbGl0c19hZnRlcl9pZl9lbHNlQDEyOgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6OTAKICAgIC8vIGNhc2UgU3BsaXREaXN0cmlidXRpb25UeXBlUmVtYWluZGVyOgogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDUgLy8gMHgxZQogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkwLTk0CiAgICAvLyBjYXNlIFNwbGl0RGlzdHJpYnV0aW9uVHlwZVJlbWFpbmRlcjoKICAgIC8vICAgLy8gUmVtYWluZGVyIG11c3QgYmUgbGFzdAogICAgLy8gICBhc3NlcnQoaXNMYXN0LCBFUlJfUkVNQUlOREVSX01VU1RfQkVfTEFTVCkKICAgIC8vICAgaGFzUmVtYWluZGVyID0gdHJ1ZQogICAgLy8gICBicmVhawogICAgYnogdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkyCiAgICAvLyBhc3NlcnQoaXNMYXN0LCBFUlJfUkVNQUlOREVSX01VU1RfQkVfTEFTVCkKICAgIGZyYW1lX2RpZyAzCiAgICBhc3NlcnQgLy8gUmVtYWluZGVyIHNwbGl0IG11c3QgYmUgdGhlIGxhc3Qgc3BsaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjkzCiAgICAvLyBoYXNSZW1haW5kZXIgPSB0cnVlCiAgICBpbnRjXzEgLy8gMQogICAgZnJhbWVfYnVyeSA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo5NAogICAgLy8gYnJlYWsKICAgIGIgdmFsaWRhdGVTcGxpdHNfYmxvY2tAMTYKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfZmFsc2VANToKICAgIGludGNfMCAvLyAwCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VANgoKdmFsaWRhdGVTcGxpdHNfYWZ0ZXJfd2hpbGVAMTc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czo5OQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSA8PSBESVZJU09SLCBFUlJfUEVSQ0VOVEFHRV9FWENFRURTXzEwMCkKICAgIGZyYW1lX2RpZyA1CiAgICBkdXAKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIDw9CiAgICBhc3NlcnQgLy8gVG90YWwgcGVyY2VudGFnZSBzcGxpdHMgZXhjZWVkIDEwMCUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSAhPT0gRElWSVNPUiB8fCAhaGFzUmVtYWluZGVyLCBFUlJfUEVSQ0VOVEFHRV9NVVNUX0JFX05PVF9CRV8xMDBfV0lUSF9SRU1BSU5ERVIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICAhPQogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAxOQogICAgZnJhbWVfZGlnIDYKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDIwCgp2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMTk6CiAgICBpbnRjXzEgLy8gMQoKdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMQogICAgLy8gYXNzZXJ0KHRvdGFsUGVyY2VudGFnZSAhPT0gRElWSVNPUiB8fCAhaGFzUmVtYWluZGVyLCBFUlJfUEVSQ0VOVEFHRV9NVVNUX0JFX05PVF9CRV8xMDBfV0lUSF9SRU1BSU5ERVIpCiAgICBhc3NlcnQgLy8gUGVyY2VudGFnZSBzcGxpdHMgY2Fubm90IHRvdGFsIDEwMCUgd2hlbiB1c2luZyBhIHJlbWFpbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTAzCiAgICAvLyBhc3NlcnQoIWhhc0ZsYXQgfHwgaGFzUmVtYWluZGVyIHx8IHRvdGFsUGVyY2VudGFnZSA9PT0gMCB8fCB0b3RhbFBlcmNlbnRhZ2UgPT09IERJVklTT1IsIEVSUl9GTEFUX1dJVEhfUEVSQ0VOVEFHRV9SRVFVSVJFU19SRU1BSU5ERVIpCiAgICBmcmFtZV9kaWcgNwogICAgYnogdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDI1CiAgICBmcmFtZV9kaWcgNgogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAyNQogICAgZnJhbWVfZGlnIDUKICAgIGJ6IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAyNQogICAgZnJhbWVfZGlnIDUKICAgIGludGMgNCAvLyAxMDAwMDAKICAgID09CiAgICBieiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDI2Cgp2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMjU6CiAgICBpbnRjXzEgLy8gMQoKdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9hcmM1OC9wbHVnaW5zL3JldmVudWUtbWFuYWdlci9jb250cmFjdC5hbGdvLnRzOjEwMwogICAgLy8gYXNzZXJ0KCFoYXNGbGF0IHx8IGhhc1JlbWFpbmRlciB8fCB0b3RhbFBlcmNlbnRhZ2UgPT09IDAgfHwgdG90YWxQZXJjZW50YWdlID09PSBESVZJU09SLCBFUlJfRkxBVF9XSVRIX1BFUkNFTlRBR0VfUkVRVUlSRVNfUkVNQUlOREVSKQogICAgYXNzZXJ0IC8vIEZsYXQgYW1vdW50cyB3aXRoIHBlcmNlbnRhZ2VzIHJlcXVpcmUgYSByZW1haW5kZXIgb3IgMTAwJSB0b3RhbAogICAgLy8gc21hcnRfY29udHJhY3RzL2FyYzU4L3BsdWdpbnMvcmV2ZW51ZS1tYW5hZ2VyL2NvbnRyYWN0LmFsZ28udHM6MTA1CiAgICAvLyBhc3NlcnQoaGFzUmVtYWluZGVyIHx8IHRvdGFsUGVyY2VudGFnZSA9PT0gRElWSVNPUiB8fCAodG90YWxQZXJjZW50YWdlID09PSAwICYmIGhhc0ZsYXQpLCBFUlJfU1BMSVRTX01VU1RfVE9UQUxfMTAwX09SX0hBVkVfUkVNQUlOREVSKQogICAgZnJhbWVfZGlnIDYKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX3RydWVAMzEKICAgIGZyYW1lX2RpZyA1CiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA9PQogICAgYm56IHZhbGlkYXRlU3BsaXRzX2Jvb2xfdHJ1ZUAzMQogICAgZnJhbWVfZGlnIDUKICAgIGJueiB2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDMyCiAgICBmcmFtZV9kaWcgNwogICAgYnogdmFsaWRhdGVTcGxpdHNfYm9vbF9mYWxzZUAzMgoKdmFsaWRhdGVTcGxpdHNfYm9vbF90cnVlQDMxOgogICAgaW50Y18xIC8vIDEKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VAMzM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvYXJjNTgvcGx1Z2lucy9yZXZlbnVlLW1hbmFnZXIvY29udHJhY3QuYWxnby50czoxMDUKICAgIC8vIGFzc2VydChoYXNSZW1haW5kZXIgfHwgdG90YWxQZXJjZW50YWdlID09PSBESVZJU09SIHx8ICh0b3RhbFBlcmNlbnRhZ2UgPT09IDAgJiYgaGFzRmxhdCksIEVSUl9TUExJVFNfTVVTVF9UT1RBTF8xMDBfT1JfSEFWRV9SRU1BSU5ERVIpCiAgICBhc3NlcnQgLy8gUGVyY2VudGFnZSBzcGxpdHMgbXVzdCB0b3RhbCAxMDAlIG9yIGluY2x1ZGUgYSByZW1haW5kZXIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAwCiAgICByZXRzdWIKCnZhbGlkYXRlU3BsaXRzX2Jvb2xfZmFsc2VAMzI6CiAgICBpbnRjXzAgLy8gMAogICAgYiB2YWxpZGF0ZVNwbGl0c19ib29sX21lcmdlQDMzCgp2YWxpZGF0ZVNwbGl0c19ib29sX2ZhbHNlQDI2OgogICAgaW50Y18wIC8vIDAKICAgIGIgdmFsaWRhdGVTcGxpdHNfYm9vbF9tZXJnZUAyNwoKdmFsaWRhdGVTcGxpdHNfYm9vbF9mYWxzZUAyMDoKICAgIGludGNfMCAvLyAwCiAgICBiIHZhbGlkYXRlU3BsaXRzX2Jvb2xfbWVyZ2VAMjEK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyAFAAEIAqCNBiYNEHNwZW5kaW5nX2FkZHJlc3MAAgAKAWUBAAEeCWFraXRhX2RhbwEUBGg147wEAAEAAgSiQD3fBBUffHUCAAAxGRREMRhBAD8nCIIHBOyNQ+UELYDm/ASE80wEBP5BD0QEfnwPcAQz6SyUBIVN7eA2GgCOCADhAgICpgM8A8wHRAgJAAEAI0OABM2a1n42GgCOAQCmAIoBAYv/gA5jdXJyZW50X3BsdWdpbmVISYEoWUsBFVJXAgCJigEBi/+I/9WxSRUWVwYCTFAnCUxQJwqyGrIai/+yGIEGshAisgGztD5JVwQASwFXAAQnCxJESSJZgQkLJQhMFRJEVwYJSSJbRImKAgCL/kEAHov/KGVIsYv+i/+IABKyICKyCEmyB7IAI7IQIrIBs4mKAgGL/kAAAzIDiYv/cghEiTYaAUkiWSUISwEVEkRXAgA2GgJJFSQSRBeAB3ZlcnNpb25PAmcnBkxnI0MpSTYaAUkVJBJEF0k2GgJJFSMSRCJTTDYaA0lOAkkiWUlOA0kkCyUITwIVEkQxFiMJSTgQIxJESwKI/vRJKRNESwMoZUhJTgVLAjgHEk8COAgyEE8ECw8QREsBFksBFRZXBgJPAlBMKlBMUCtMUElOAkm9RQFEvkhJVwAgTgKBgQJTTIALcmVrZXlfaW5kZXhlSDgAMg1BAIQyDXIHREsDEkEAeSNLA0sCEhFESwFEIkULSwpLBgxBAFFLBlcCAEsLJAtbSUULSwVMcABFARREsUsHQQAxSwUjCUsLEkEAJyNLCYj+3rIgSwmyESKyEksESbIUsgCBBLIQIrIBs0sKIwhFC0L/qyJC/9ZLA0m+RIEhW0sHCBaBIUy7I0MiQv+ENhoBSRUkEkQXNhoCSRUjEkQiUzYaA0kiWSUISwEVEkRXAgA2GgRJFYEgEkQ2GgVJFSMSRCJTNhoGSRUjEkQiUzYaB0sGKGVIiAXhREkiWUlEgQoORDIHJwQiTwVUI08EVE8DTFAiFkxLAVAnBFBLAVBMUEwWUEsEFksDFRZXBgJPBFBMKlBMUCtLAVBPAr9MiAWlgAFzTwJQSbxITL9MiP3aI0M2GgFJFSQSRBc2GgJJFSMSRCJTNhoDSSJZJQhLARUSRFcCADYaBEkVgSASRDYaBUkVIxJEIlM2GgZJFSMSRCJTNhoHSwYoZUiIBT1EMgcnBCJPBVQjTwRUTwNMUCIWTEsBUCcEUEsBUExQTBZQSwQWSwMVFlcGAk8EUEwqUExQK0sBUE8Cv4ABckxQSbxITL9MiP1EI0M2GgFJFSQSRBc2GgJJFSMSRCJTSwGI/L1JKRNESwKI/NciW0sDKGVISYgExkRMcghEEkRLAhZLARUWVwYCTwJQTCpQTFArTFBJvUUBREm+SElXKQFLAYGAAlNLAoEyW08DgTpbTwMnBBJETwJEMgdJTwIJgYCjBRgJSU8CD0RLAYEpJwe7FoEyTLtMiPy0I0MiRwgpRwo2GgFJFSQSRBdJNhoCSRUjEkQiU0w2GgNJTgJJIllJTgMkCyUITBUSREmI/BJJKRNESwGI/CwiW0lOA0sCKGVISU4CTgRyCEQSREwWSwEVFlcGAk8CUEwqUExQSStLAVBJTgK+RElXKQFLAYEhW08CgSpbTgNMJwcSRCMITIABc0xQSb1FAUECwEm+REUYSxdJIllJRIEKDkSIA8xFGCJFEksRSwgMQQJxSwhXAgBLEiQLW0lFFEsHFksBFklFIFCAAWFMUElFHb1FARREQAJAMgpgMgEJIkURRQ5LFyJZSUUXSxANQQIaSxdXAgBLEElOAiULSwFMWU8CIwhJRRNLGEsBCUsDFU8CJQtLBExZTwJNUklXAgFFGEmBA1tFDrFJIllLARVSSVcACEUgSSJbSU4CRRFJJFlLARVSSUUgVwIASRUWVwYCTFAnCUxQJwqyGrIashiBBrIQIrIBs7Q+SVcEAEsBVwAEJwsSREkiWYEJCyUITBUSRFcGCSJbcghMSU4CRRtESxNwAEUBQADzIicGZUSAAgABSx5QSUUeSSJZSSMSRE8CgANwYWxlSElXAAhMIltLEklOAoASY29udHJvbGxlZF9hZGRyZXNzZUhJTgNFILGABFgv84KyGk8DshqAAYCyGksishonDLIaJwyyGkyyGIEGshAisgG2MhBPAwuyCEyyByOyECKyAbYnCLIaSyCyGoABgLIashiyGoEGshAisgEiRRFLECMMQQA8SxAkC0lFFoAIAAAAAAAAAABMW0lFDUEAGrZLG1cCAEsVW7IRSxmyFEsLshKBBLIQIrIBSxAjCEURQv+9toAEbMP2BrIaSw6yGIEGshAisgGzIkUUSxaAAQoSQQBKSwxFFEsTSUsPSU4CDkRMCUUOSxJAABexSxOyCEsYsgdLBbIAI7IQIrIBs0L+HrFLErIRSxOyEksYshRLBbIAgQSyECKyAbNC/gJLFicHEkEAEksMSSEEDkRLDh0hBJdFFEL/oEsWJwUSQf+YSw1FFEL/kUsaIrlISxEjCEUSQv2RSwVLE3AASEL9vEsDSb5EgSpbSwlJTgIIFk8CgSpPArtLAwhLAhJBAAdLA4EpJwW7SwlLC4j5ZSNDgAFySwVQSb1FAURJvkhMIiS6F0sBJFlLAhVPA04CUlcCAGVERRhC/Rw2GgFJFSQSRBdJNhoCSRUjEkQiU0w2GgNJTgJJIllJTgMkCyUITBUSREmI+KBJKRNESwGI+LoiW0lOA0sCKGVITHIIRBJETBZLARUWVwYCTwJQTCpQTFArTFBJvkRJVykBTIEqW0wnBRJEIklLBQxBACZLBVcCAEsBSU4CJAskWEsFFkxQgAFhTFBJvUUBRLxIIwhFAUL/00sCSb5EgSpbSwZJTgIJFk8CgSpPArtLAkwJQAAHSwKBKScEu0sGSwiI+HcjQzYaAUkVJBJEFzEAIicGZUSABndhbGxldGVIcghEEkQnBkxnI0OKAQGL/3MCRDIKEomKAQEiKUcDIkcDi/8iWUmMAYsIDUEAiYv/VwIAiwhJTgIlC0sBTFlLAiMISYwCiwFJTgRLAQlLAxVPAiULSwRMWU8CTVJJVwIBjACBA1tJjARMIwlPAhKMA0AACIsAJwUSQQA4I0SLACcHEkEADosFiwQIjAWLAowIQv+OiwCAAQoSQQAGI4wHQv/qiwAnBRJB/+KLA0QjjAZC/9kiQv/FiwVJIQQORCEEE0AABYsGQABBI0SLB0EAEosGQAANiwVBAAiLBSEEEkEAJCNEiwZAABKLBSEEEkAACosFQAAMiwdBAAcjRIv/jACJIkL/9iJC/9kiQv+8\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "7c886062d5f49d21417520efe605df9a60eea31fe14acc267b127e3f94d087fc"
@@ -7,18 +7,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// SplitRef is a generated struct type.
-type SplitRef struct {
-	App uint64 `json:"app"`
-	Key []byte `json:"key"`
-}
-
-// WalletEscrowKey is a generated struct type.
-type WalletEscrowKey struct {
-	Wallet uint64 `json:"wallet"`
-	Escrow string `json:"escrow"`
-}
-
 // EscrowAssetKey is a generated struct type.
 type EscrowAssetKey struct {
 	Escrow uint64 `json:"escrow"`
@@ -37,65 +25,77 @@ type ReceiveEscrow struct {
 	CreationDate      uint64        `json:"creationDate"`
 }
 
+// SplitRef is a generated struct type.
+type SplitRef struct {
+	App uint64 `json:"app"`
+	Key []byte `json:"key"`
+}
+
+// WalletEscrowKey is a generated struct type.
+type WalletEscrowKey struct {
+	Wallet uint64 `json:"wallet"`
+	Escrow string `json:"escrow"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	Assets     []uint64
-	MBRPayment transaction.TransactionWithSigner
+	Wallet     uint64                            `json:"wallet"`
+	RekeyBack  bool                              `json:"rekeyBack"`
+	Assets     []uint64                          `json:"assets"`
+	MBRPayment transaction.TransactionWithSigner `json:"-"`
 }
 
 // NewReceiveEscrowArgs holds the arguments for the newReceiveEscrow method.
 type NewReceiveEscrowArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	Escrow       string
-	Source       types.Address
-	Allocatable  bool
-	OptinAllowed bool
-	Splits       [][]interface{}
+	Wallet       uint64                                          `json:"wallet"`
+	RekeyBack    bool                                            `json:"rekeyBack"`
+	Escrow       string                                          `json:"escrow"`
+	Source       types.Address                                   `json:"source"`
+	Allocatable  bool                                            `json:"allocatable"`
+	OptinAllowed bool                                            `json:"optinAllowed"`
+	Splits       []Tuple3[Tuple2[uint64, string], uint8, uint64] `json:"splits"`
 }
 
 // NewReceiveEscrowWithRefArgs holds the arguments for the newReceiveEscrowWithRef method.
 type NewReceiveEscrowWithRefArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	Escrow       string
-	Source       types.Address
-	Allocatable  bool
-	OptinAllowed bool
-	SplitRef     SplitRef
+	Wallet       uint64        `json:"wallet"`
+	RekeyBack    bool          `json:"rekeyBack"`
+	Escrow       string        `json:"escrow"`
+	Source       types.Address `json:"source"`
+	Allocatable  bool          `json:"allocatable"`
+	OptinAllowed bool          `json:"optinAllowed"`
+	SplitRef     SplitRef      `json:"splitRef"`
 }
 
 // StartEscrowDisbursementArgs holds the arguments for the startEscrowDisbursement method.
 type StartEscrowDisbursementArgs struct {
-	Wallet    uint64
-	RekeyBack bool
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
 }
 
 // ProcessEscrowAllocationArgs holds the arguments for the processEscrowAllocation method.
 type ProcessEscrowAllocationArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ids       []uint64
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ids       []uint64 `json:"ids"`
 }
 
 // FinalizeEscrowDisbursementArgs holds the arguments for the finalizeEscrowDisbursement method.
 type FinalizeEscrowDisbursementArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Ids       []uint64
+	Wallet    uint64   `json:"wallet"`
+	RekeyBack bool     `json:"rekeyBack"`
+	Ids       []uint64 `json:"ids"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -35,115 +35,115 @@ type UserAllocationsKey struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // CreateDisbursementArgs holds the arguments for the createDisbursement method.
 type CreateDisbursementArgs struct {
-	MBRPayment   transaction.TransactionWithSigner
-	Title        string
-	TimeToUnlock uint64
-	Expiration   uint64
-	Note         string
+	MBRPayment   transaction.TransactionWithSigner `json:"-"`
+	Title        string                            `json:"title"`
+	TimeToUnlock uint64                            `json:"timeToUnlock"`
+	Expiration   uint64                            `json:"expiration"`
+	Note         string                            `json:"note"`
 }
 
 // CreateDisbursementMethodResult holds the result of calling createDisbursement.
 type CreateDisbursementMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EditDisbursementArgs holds the arguments for the editDisbursement method.
 type EditDisbursementArgs struct {
-	ID           uint64
-	Title        string
-	TimeToUnlock uint64
-	Expiration   uint64
-	Note         string
+	ID           uint64 `json:"id"`
+	Title        string `json:"title"`
+	TimeToUnlock uint64 `json:"timeToUnlock"`
+	Expiration   uint64 `json:"expiration"`
+	Note         string `json:"note"`
 }
 
 // CreateUserAllocationsArgs holds the arguments for the createUserAllocations method.
 type CreateUserAllocationsArgs struct {
-	Payment     transaction.TransactionWithSigner
-	ID          uint64
-	Allocations [][]interface{}
+	Payment     transaction.TransactionWithSigner `json:"-"`
+	ID          uint64                            `json:"id"`
+	Allocations []Tuple2[types.Address, uint64]   `json:"allocations"`
 }
 
 // CreateASAUserAllocationsArgs holds the arguments for the createAsaUserAllocations method.
 type CreateASAUserAllocationsArgs struct {
-	MBRPayment  transaction.TransactionWithSigner
-	AssetXfer   transaction.TransactionWithSigner
-	ID          uint64
-	Allocations [][]interface{}
+	MBRPayment  transaction.TransactionWithSigner `json:"-"`
+	AssetXfer   transaction.TransactionWithSigner `json:"-"`
+	ID          uint64                            `json:"id"`
+	Allocations []Tuple2[types.Address, uint64]   `json:"allocations"`
 }
 
 // FinalizeDisbursementArgs holds the arguments for the finalizeDisbursement method.
 type FinalizeDisbursementArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // CreateInstantDisbursementArgs holds the arguments for the createInstantDisbursement method.
 type CreateInstantDisbursementArgs struct {
-	MBRPayment   transaction.TransactionWithSigner
-	TimeToUnlock uint64
-	Expiration   uint64
-	Allocations  [][]interface{}
+	MBRPayment   transaction.TransactionWithSigner `json:"-"`
+	TimeToUnlock uint64                            `json:"timeToUnlock"`
+	Expiration   uint64                            `json:"expiration"`
+	Allocations  []Tuple2[types.Address, uint64]   `json:"allocations"`
 }
 
 // CreateInstantDisbursementMethodResult holds the result of calling createInstantDisbursement.
 type CreateInstantDisbursementMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // CreateInstantASADisbursementArgs holds the arguments for the createInstantAsaDisbursement method.
 type CreateInstantASADisbursementArgs struct {
-	MBRPayment   transaction.TransactionWithSigner
-	AssetXfer    transaction.TransactionWithSigner
-	TimeToUnlock uint64
-	Expiration   uint64
-	Allocations  [][]interface{}
+	MBRPayment   transaction.TransactionWithSigner `json:"-"`
+	AssetXfer    transaction.TransactionWithSigner `json:"-"`
+	TimeToUnlock uint64                            `json:"timeToUnlock"`
+	Expiration   uint64                            `json:"expiration"`
+	Allocations  []Tuple2[types.Address, uint64]   `json:"allocations"`
 }
 
 // CreateInstantASADisbursementMethodResult holds the result of calling createInstantAsaDisbursement.
 type CreateInstantASADisbursementMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // ClaimRewardsArgs holds the arguments for the claimRewards method.
 type ClaimRewardsArgs struct {
-	Rewards [][]interface{}
+	Rewards []Tuple2[uint64, uint64] `json:"rewards"`
 }
 
 // ReclaimRewardsArgs holds the arguments for the reclaimRewards method.
 type ReclaimRewardsArgs struct {
-	ID       uint64
-	Reclaims [][]interface{}
+	ID       uint64                          `json:"id"`
+	Reclaims []Tuple2[types.Address, uint64] `json:"reclaims"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Title string
-	Note  string
+	Title string `json:"title"`
+	Note  string `json:"note"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RewardsMBRData
+	Return RewardsMBRData `json:"return"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,6 +2,7 @@
 package rewardsplugin
 
 import (
+	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -13,93 +14,93 @@ type RewardsMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // CreateDisbursementArgs holds the arguments for the createDisbursement method.
 type CreateDisbursementArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	Title        string
-	TimeToUnlock uint64
-	Expiration   uint64
-	Note         string
+	Wallet       uint64 `json:"wallet"`
+	RekeyBack    bool   `json:"rekeyBack"`
+	Title        string `json:"title"`
+	TimeToUnlock uint64 `json:"timeToUnlock"`
+	Expiration   uint64 `json:"expiration"`
+	Note         string `json:"note"`
 }
 
 // CreateDisbursementMethodResult holds the result of calling createDisbursement.
 type CreateDisbursementMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // EditDisbursementArgs holds the arguments for the editDisbursement method.
 type EditDisbursementArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	ID           uint64
-	Title        string
-	TimeToUnlock uint64
-	Expiration   uint64
-	Note         string
+	Wallet       uint64 `json:"wallet"`
+	RekeyBack    bool   `json:"rekeyBack"`
+	ID           uint64 `json:"id"`
+	Title        string `json:"title"`
+	TimeToUnlock uint64 `json:"timeToUnlock"`
+	Expiration   uint64 `json:"expiration"`
+	Note         string `json:"note"`
 }
 
 // CreateUserAllocationsArgs holds the arguments for the createUserAllocations method.
 type CreateUserAllocationsArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	ID          uint64
-	Allocations [][]interface{}
-	Sum         uint64
+	Wallet      uint64                          `json:"wallet"`
+	RekeyBack   bool                            `json:"rekeyBack"`
+	ID          uint64                          `json:"id"`
+	Allocations []Tuple2[types.Address, uint64] `json:"allocations"`
+	Sum         uint64                          `json:"sum"`
 }
 
 // CreateASAUserAllocationsArgs holds the arguments for the createAsaUserAllocations method.
 type CreateASAUserAllocationsArgs struct {
-	Wallet      uint64
-	RekeyBack   bool
-	ID          uint64
-	AssetID     uint64
-	Allocations [][]interface{}
-	Sum         uint64
+	Wallet      uint64                          `json:"wallet"`
+	RekeyBack   bool                            `json:"rekeyBack"`
+	ID          uint64                          `json:"id"`
+	AssetID     uint64                          `json:"assetID"`
+	Allocations []Tuple2[types.Address, uint64] `json:"allocations"`
+	Sum         uint64                          `json:"sum"`
 }
 
 // FinalizeDisbursementArgs holds the arguments for the finalizeDisbursement method.
 type FinalizeDisbursementArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	ID        uint64 `json:"id"`
 }
 
 // ClaimRewardsArgs holds the arguments for the claimRewards method.
 type ClaimRewardsArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Rewards   [][]interface{}
+	Wallet    uint64                   `json:"wallet"`
+	RekeyBack bool                     `json:"rekeyBack"`
+	Rewards   []Tuple2[uint64, uint64] `json:"rewards"`
 }
 
 // ReclaimRewardsArgs holds the arguments for the reclaimRewards method.
 type ReclaimRewardsArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Reclaims  [][]interface{}
+	Wallet    uint64                          `json:"wallet"`
+	RekeyBack bool                            `json:"rekeyBack"`
+	ID        uint64                          `json:"id"`
+	Reclaims  []Tuple2[types.Address, uint64] `json:"reclaims"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	Title string
-	Note  string
+	Title string `json:"title"`
+	Note  string `json:"note"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return RewardsMBRData
+	Return RewardsMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
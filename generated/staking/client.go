@@ -2,26 +2,338 @@
 package staking
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendInit calls the init ABI method.
+	SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendOptIn calls the optIn ABI method.
+	SendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) error
+	// SendStake calls the stake ABI method.
+	SendStake(ctx context.Context, params algokit.CallParams[StakeArgs]) error
+	// SendStakeASA calls the stakeAsa ABI method.
+	SendStakeASA(ctx context.Context, params algokit.CallParams[StakeASAArgs]) error
+	// SendWithdraw calls the withdraw ABI method.
+	SendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) error
+	// SendCreateHeartbeat calls the createHeartbeat ABI method.
+	SendCreateHeartbeat(ctx context.Context, params algokit.CallParams[CreateHeartbeatArgs]) error
+	// SendSoftCheck calls the softCheck ABI method.
+	SendSoftCheck(ctx context.Context, params algokit.CallParams[SoftCheckArgs]) (*SoftCheckMethodResult, error)
+	// SendUpdateSettings calls the updateSettings ABI method.
+	SendUpdateSettings(ctx context.Context, params algokit.CallParams[UpdateSettingsArgs]) error
+	// SendOptInCost calls the optInCost ABI method (readonly).
+	SendOptInCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*OptInCostMethodResult, error)
+	// SendStakeCost calls the stakeCost ABI method (readonly).
+	SendStakeCost(ctx context.Context, params algokit.CallParams[StakeCostArgs]) (*StakeCostMethodResult, error)
+	// SendGetTimeLeft calls the getTimeLeft ABI method (readonly).
+	SendGetTimeLeft(ctx context.Context, params algokit.CallParams[GetTimeLeftArgs]) (*GetTimeLeftMethodResult, error)
+	// SendMustGetTimeLeft calls the mustGetTimeLeft ABI method (readonly).
+	SendMustGetTimeLeft(ctx context.Context, params algokit.CallParams[MustGetTimeLeftArgs]) (*MustGetTimeLeftMethodResult, error)
+	// SendGetInfo calls the getInfo ABI method (readonly).
+	SendGetInfo(ctx context.Context, params algokit.CallParams[GetInfoArgs]) (*GetInfoMethodResult, error)
+	// SendMustGetInfo calls the mustGetInfo ABI method (readonly).
+	SendMustGetInfo(ctx context.Context, params algokit.CallParams[MustGetInfoArgs]) (*MustGetInfoMethodResult, error)
+	// SendGetEscrowInfo calls the getEscrowInfo ABI method (readonly).
+	SendGetEscrowInfo(ctx context.Context, params algokit.CallParams[GetEscrowInfoArgs]) (*GetEscrowInfoMethodResult, error)
+	// SendGetHeartbeat calls the getHeartbeat ABI method (readonly).
+	SendGetHeartbeat(ctx context.Context, params algokit.CallParams[GetHeartbeatArgs]) (*GetHeartbeatMethodResult, error)
+	// SendMustGetHeartbeat calls the mustGetHeartbeat ABI method (readonly).
+	SendMustGetHeartbeat(ctx context.Context, params algokit.CallParams[MustGetHeartbeatArgs]) (*MustGetHeartbeatMethodResult, error)
+	// SendGetHeartbeatAverage calls the getHeartbeatAverage ABI method (readonly).
+	SendGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[GetHeartbeatAverageArgs]) (*GetHeartbeatAverageMethodResult, error)
+	// SendMustGetHeartbeatAverage calls the mustGetHeartbeatAverage ABI method (readonly).
+	SendMustGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[MustGetHeartbeatAverageArgs]) (*MustGetHeartbeatAverageMethodResult, error)
+	// SendGetInfoList calls the getInfoList ABI method (readonly).
+	SendGetInfoList(ctx context.Context, params algokit.CallParams[GetInfoListArgs]) (*GetInfoListMethodResult, error)
+	// SendMustGetInfoList calls the mustGetInfoList ABI method (readonly).
+	SendMustGetInfoList(ctx context.Context, params algokit.CallParams[MustGetInfoListArgs]) (*MustGetInfoListMethodResult, error)
+	// SendStakeCheck calls the stakeCheck ABI method (readonly).
+	SendStakeCheck(ctx context.Context, params algokit.CallParams[StakeCheckArgs]) (*StakeCheckMethodResult, error)
+	// SendGetTotals calls the getTotals ABI method (readonly).
+	SendGetTotals(ctx context.Context, params algokit.CallParams[GetTotalsArgs]) (*GetTotalsMethodResult, error)
+	// SendUpdateAkitaDao calls the updateAkitaDAO ABI method.
+	SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapHeartbeats reads the value for key in the heartbeats box map.
+	GetBoxMapHeartbeats(ctx context.Context, key HeartbeatKey) ([4]Tuple4[uint64, uint64, uint64, uint64], error)
+	// GetBoxMapSettings reads the value for key in the settings box map.
+	GetBoxMapSettings(ctx context.Context, key uint64) (uint64, error)
+	// GetBoxMapStakes reads the value for key in the stakes box map.
+	GetBoxMapStakes(ctx context.Context, key StakeKey) (Stake, error)
+	// GetBoxMapTotals reads the value for key in the totals box map.
+	GetBoxMapTotals(ctx context.Context, key uint64) (TotalsInfo, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the Staking smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
+}
+
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
+}
+
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +345,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("Staking has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,191 +394,230 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
-// NewGroup creates a new Composer for building atomic transaction groups.
-func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
 	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
 }
 
-// SendInit calls the init ABI method and waits for confirmation.
-func (c *Client) SendInit(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match Staking")
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "init",
-		MethodArgs: methodArgs,
-	})
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
 	if err != nil {
 		return err
 	}
-
-	_ = result
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
 	return nil
 }
 
-// SendOptIn calls the optIn ABI method and waits for confirmation.
+// NewGroup creates a new Composer for building atomic transaction groups.
+func (c *Client) NewGroup() *Composer {
+	return &Composer{client: c}
+}
+
+// SendInit calls the init ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendInit(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return err
+	}
+	_, err = c.sendInit(ctx, params)
+	return err
+}
+
+func (c *Client) sendInit(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Init(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
+
+// SendOptIn calls the optIn ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // optin tells the contract to opt into an asa
 func (c *Client) SendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) error {
-	methodArgs := argsToInterfaceOptIn(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "optIn",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOptIn(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OptIn(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendStake calls the stake ABI method and waits for confirmation.
+// SendStake calls the stake ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendStake(ctx context.Context, params algokit.CallParams[StakeArgs]) error {
-	methodArgs := argsToInterfaceStake(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "stake",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendStake(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendStake(ctx context.Context, params algokit.CallParams[StakeArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Stake(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendStakeASA calls the stakeAsa ABI method and waits for confirmation.
+// SendStakeASA calls the stakeAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendStakeASA(ctx context.Context, params algokit.CallParams[StakeASAArgs]) error {
-	methodArgs := argsToInterfaceStakeASA(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "stakeAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendStakeASA(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendStakeASA(ctx context.Context, params algokit.CallParams[StakeASAArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().StakeASA(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendWithdraw calls the withdraw ABI method and waits for confirmation.
+// SendWithdraw calls the withdraw ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) error {
-	methodArgs := argsToInterfaceWithdraw(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "withdraw",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendWithdraw(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Withdraw(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendCreateHeartbeat calls the createHeartbeat ABI method and waits for confirmation.
+// SendCreateHeartbeat calls the createHeartbeat ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendCreateHeartbeat(ctx context.Context, params algokit.CallParams[CreateHeartbeatArgs]) error {
-	methodArgs := argsToInterfaceCreateHeartbeat(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "createHeartbeat",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendCreateHeartbeat(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendCreateHeartbeat(ctx context.Context, params algokit.CallParams[CreateHeartbeatArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().CreateHeartbeat(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSoftCheck calls the softCheck ABI method and waits for confirmation.
+// SendSoftCheck calls the softCheck ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSoftCheck(ctx context.Context, params algokit.CallParams[SoftCheckArgs]) (*SoftCheckMethodResult, error) {
-	methodArgs := argsToInterfaceSoftCheck(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSoftCheck(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "softCheck",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendSoftCheck(ctx context.Context, params algokit.CallParams[SoftCheckArgs]) (*SoftCheckMethodResult, error) {
+	comp, err := c.NewGroup().SoftCheck(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*SoftCheckMethodResult)
+	return typedResult, nil
+}
 
+// decodeSoftCheckResult decodes the return value of a softCheck call.
+func (c *Client) decodeSoftCheckResult(result *algokit.SendAppTransactionResult) (*SoftCheckMethodResult, error) {
 	typedResult := &SoftCheckMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -243,44 +633,56 @@ func (c *Client) SendSoftCheck(ctx context.Context, params algokit.CallParams[So
 	return typedResult, nil
 }
 
-// SendUpdateSettings calls the updateSettings ABI method and waits for confirmation.
+// SendUpdateSettings calls the updateSettings ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateSettings(ctx context.Context, params algokit.CallParams[UpdateSettingsArgs]) error {
-	methodArgs := argsToInterfaceUpdateSettings(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateSettings",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateSettings(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateSettings(ctx context.Context, params algokit.CallParams[UpdateSettingsArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateSettings(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendOptInCost calls the optInCost ABI method and waits for confirmation.
-func (c *Client) SendOptInCost(ctx context.Context) (*OptInCostMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendOptInCost calls the optInCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOptInCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*OptInCostMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendOptInCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "optInCost",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendOptInCost(ctx context.Context, params algokit.CallParams[struct{}]) (*OptInCostMethodResult, error) {
+	comp, err := c.NewGroup().OptInCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*OptInCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeOptInCostResult decodes the return value of a optInCost call.
+func (c *Client) decodeOptInCostResult(result *algokit.SendAppTransactionResult) (*OptInCostMethodResult, error) {
 	typedResult := &OptInCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -294,28 +696,31 @@ func (c *Client) SendOptInCost(ctx context.Context) (*OptInCostMethodResult, err
 	return typedResult, nil
 }
 
-// SendStakeCost calls the stakeCost ABI method and waits for confirmation.
+// SendStakeCost calls the stakeCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendStakeCost(ctx context.Context, params algokit.CallParams[StakeCostArgs]) (*StakeCostMethodResult, error) {
-	methodArgs := argsToInterfaceStakeCost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendStakeCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "stakeCost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendStakeCost(ctx context.Context, params algokit.CallParams[StakeCostArgs]) (*StakeCostMethodResult, error) {
+	comp, err := c.NewGroup().StakeCost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*StakeCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeStakeCostResult decodes the return value of a stakeCost call.
+func (c *Client) decodeStakeCostResult(result *algokit.SendAppTransactionResult) (*StakeCostMethodResult, error) {
 	typedResult := &StakeCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -329,28 +734,31 @@ func (c *Client) SendStakeCost(ctx context.Context, params algokit.CallParams[St
 	return typedResult, nil
 }
 
-// SendGetTimeLeft calls the getTimeLeft ABI method and waits for confirmation.
+// SendGetTimeLeft calls the getTimeLeft ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetTimeLeft(ctx context.Context, params algokit.CallParams[GetTimeLeftArgs]) (*GetTimeLeftMethodResult, error) {
-	methodArgs := argsToInterfaceGetTimeLeft(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetTimeLeft(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getTimeLeft",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetTimeLeft(ctx context.Context, params algokit.CallParams[GetTimeLeftArgs]) (*GetTimeLeftMethodResult, error) {
+	comp, err := c.NewGroup().GetTimeLeft(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetTimeLeftMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetTimeLeftResult decodes the return value of a getTimeLeft call.
+func (c *Client) decodeGetTimeLeftResult(result *algokit.SendAppTransactionResult) (*GetTimeLeftMethodResult, error) {
 	typedResult := &GetTimeLeftMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -364,28 +772,31 @@ func (c *Client) SendGetTimeLeft(ctx context.Context, params algokit.CallParams[
 	return typedResult, nil
 }
 
-// SendMustGetTimeLeft calls the mustGetTimeLeft ABI method and waits for confirmation.
+// SendMustGetTimeLeft calls the mustGetTimeLeft ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetTimeLeft(ctx context.Context, params algokit.CallParams[MustGetTimeLeftArgs]) (*MustGetTimeLeftMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetTimeLeft(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetTimeLeft(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetTimeLeft",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetTimeLeft(ctx context.Context, params algokit.CallParams[MustGetTimeLeftArgs]) (*MustGetTimeLeftMethodResult, error) {
+	comp, err := c.NewGroup().MustGetTimeLeft(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MustGetTimeLeftMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetTimeLeftResult decodes the return value of a mustGetTimeLeft call.
+func (c *Client) decodeMustGetTimeLeftResult(result *algokit.SendAppTransactionResult) (*MustGetTimeLeftMethodResult, error) {
 	typedResult := &MustGetTimeLeftMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -399,28 +810,31 @@ func (c *Client) SendMustGetTimeLeft(ctx context.Context, params algokit.CallPar
 	return typedResult, nil
 }
 
-// SendGetInfo calls the getInfo ABI method and waits for confirmation.
+// SendGetInfo calls the getInfo ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetInfo(ctx context.Context, params algokit.CallParams[GetInfoArgs]) (*GetInfoMethodResult, error) {
-	methodArgs := argsToInterfaceGetInfo(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetInfo(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getInfo",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetInfo(ctx context.Context, params algokit.CallParams[GetInfoArgs]) (*GetInfoMethodResult, error) {
+	comp, err := c.NewGroup().GetInfo(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetInfoMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetInfoResult decodes the return value of a getInfo call.
+func (c *Client) decodeGetInfoResult(result *algokit.SendAppTransactionResult) (*GetInfoMethodResult, error) {
 	typedResult := &GetInfoMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -436,28 +850,31 @@ func (c *Client) SendGetInfo(ctx context.Context, params algokit.CallParams[GetI
 	return typedResult, nil
 }
 
-// SendMustGetInfo calls the mustGetInfo ABI method and waits for confirmation.
+// SendMustGetInfo calls the mustGetInfo ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetInfo(ctx context.Context, params algokit.CallParams[MustGetInfoArgs]) (*MustGetInfoMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetInfo(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetInfo(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetInfo",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetInfo(ctx context.Context, params algokit.CallParams[MustGetInfoArgs]) (*MustGetInfoMethodResult, error) {
+	comp, err := c.NewGroup().MustGetInfo(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MustGetInfoMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetInfoResult decodes the return value of a mustGetInfo call.
+func (c *Client) decodeMustGetInfoResult(result *algokit.SendAppTransactionResult) (*MustGetInfoMethodResult, error) {
 	typedResult := &MustGetInfoMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -473,28 +890,31 @@ func (c *Client) SendMustGetInfo(ctx context.Context, params algokit.CallParams[
 	return typedResult, nil
 }
 
-// SendGetEscrowInfo calls the getEscrowInfo ABI method and waits for confirmation.
+// SendGetEscrowInfo calls the getEscrowInfo ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetEscrowInfo(ctx context.Context, params algokit.CallParams[GetEscrowInfoArgs]) (*GetEscrowInfoMethodResult, error) {
-	methodArgs := argsToInterfaceGetEscrowInfo(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetEscrowInfo(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getEscrowInfo",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetEscrowInfo(ctx context.Context, params algokit.CallParams[GetEscrowInfoArgs]) (*GetEscrowInfoMethodResult, error) {
+	comp, err := c.NewGroup().GetEscrowInfo(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetEscrowInfoMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetEscrowInfoResult decodes the return value of a getEscrowInfo call.
+func (c *Client) decodeGetEscrowInfoResult(result *algokit.SendAppTransactionResult) (*GetEscrowInfoMethodResult, error) {
 	typedResult := &GetEscrowInfoMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -510,98 +930,107 @@ func (c *Client) SendGetEscrowInfo(ctx context.Context, params algokit.CallParam
 	return typedResult, nil
 }
 
-// SendGetHeartbeat calls the getHeartbeat ABI method and waits for confirmation.
+// SendGetHeartbeat calls the getHeartbeat ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetHeartbeat(ctx context.Context, params algokit.CallParams[GetHeartbeatArgs]) (*GetHeartbeatMethodResult, error) {
-	methodArgs := argsToInterfaceGetHeartbeat(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetHeartbeat(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getHeartbeat",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetHeartbeat(ctx context.Context, params algokit.CallParams[GetHeartbeatArgs]) (*GetHeartbeatMethodResult, error) {
+	comp, err := c.NewGroup().GetHeartbeat(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetHeartbeatMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetHeartbeatResult decodes the return value of a getHeartbeat call.
+func (c *Client) decodeGetHeartbeatResult(result *algokit.SendAppTransactionResult) (*GetHeartbeatMethodResult, error) {
 	typedResult := &GetHeartbeatMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([4][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendMustGetHeartbeat calls the mustGetHeartbeat ABI method and waits for confirmation.
+// SendMustGetHeartbeat calls the mustGetHeartbeat ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetHeartbeat(ctx context.Context, params algokit.CallParams[MustGetHeartbeatArgs]) (*MustGetHeartbeatMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetHeartbeat(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetHeartbeat(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetHeartbeat",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetHeartbeat(ctx context.Context, params algokit.CallParams[MustGetHeartbeatArgs]) (*MustGetHeartbeatMethodResult, error) {
+	comp, err := c.NewGroup().MustGetHeartbeat(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MustGetHeartbeatMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetHeartbeatResult decodes the return value of a mustGetHeartbeat call.
+func (c *Client) decodeMustGetHeartbeatResult(result *algokit.SendAppTransactionResult) (*MustGetHeartbeatMethodResult, error) {
 	typedResult := &MustGetHeartbeatMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([4][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetHeartbeatAverage calls the getHeartbeatAverage ABI method and waits for confirmation.
+// SendGetHeartbeatAverage calls the getHeartbeatAverage ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[GetHeartbeatAverageArgs]) (*GetHeartbeatAverageMethodResult, error) {
-	methodArgs := argsToInterfaceGetHeartbeatAverage(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetHeartbeatAverage(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getHeartbeatAverage",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[GetHeartbeatAverageArgs]) (*GetHeartbeatAverageMethodResult, error) {
+	comp, err := c.NewGroup().GetHeartbeatAverage(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetHeartbeatAverageMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetHeartbeatAverageResult decodes the return value of a getHeartbeatAverage call.
+func (c *Client) decodeGetHeartbeatAverageResult(result *algokit.SendAppTransactionResult) (*GetHeartbeatAverageMethodResult, error) {
 	typedResult := &GetHeartbeatAverageMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -615,28 +1044,31 @@ func (c *Client) SendGetHeartbeatAverage(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendMustGetHeartbeatAverage calls the mustGetHeartbeatAverage ABI method and waits for confirmation.
+// SendMustGetHeartbeatAverage calls the mustGetHeartbeatAverage ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[MustGetHeartbeatAverageArgs]) (*MustGetHeartbeatAverageMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetHeartbeatAverage(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetHeartbeatAverage(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetHeartbeatAverage",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetHeartbeatAverage(ctx context.Context, params algokit.CallParams[MustGetHeartbeatAverageArgs]) (*MustGetHeartbeatAverageMethodResult, error) {
+	comp, err := c.NewGroup().MustGetHeartbeatAverage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*MustGetHeartbeatAverageMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetHeartbeatAverageResult decodes the return value of a mustGetHeartbeatAverage call.
+func (c *Client) decodeMustGetHeartbeatAverageResult(result *algokit.SendAppTransactionResult) (*MustGetHeartbeatAverageMethodResult, error) {
 	typedResult := &MustGetHeartbeatAverageMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -650,98 +1082,107 @@ func (c *Client) SendMustGetHeartbeatAverage(ctx context.Context, params algokit
 	return typedResult, nil
 }
 
-// SendGetInfoList calls the getInfoList ABI method and waits for confirmation.
+// SendGetInfoList calls the getInfoList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetInfoList(ctx context.Context, params algokit.CallParams[GetInfoListArgs]) (*GetInfoListMethodResult, error) {
-	methodArgs := argsToInterfaceGetInfoList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetInfoList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getInfoList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetInfoList(ctx context.Context, params algokit.CallParams[GetInfoListArgs]) (*GetInfoListMethodResult, error) {
+	comp, err := c.NewGroup().GetInfoList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetInfoListMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetInfoListResult decodes the return value of a getInfoList call.
+func (c *Client) decodeGetInfoListResult(result *algokit.SendAppTransactionResult) (*GetInfoListMethodResult, error) {
 	typedResult := &GetInfoListMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendMustGetInfoList calls the mustGetInfoList ABI method and waits for confirmation.
+// SendMustGetInfoList calls the mustGetInfoList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetInfoList(ctx context.Context, params algokit.CallParams[MustGetInfoListArgs]) (*MustGetInfoListMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetInfoList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetInfoList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetInfoList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetInfoList(ctx context.Context, params algokit.CallParams[MustGetInfoListArgs]) (*MustGetInfoListMethodResult, error) {
+	comp, err := c.NewGroup().MustGetInfoList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MustGetInfoListMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetInfoListResult decodes the return value of a mustGetInfoList call.
+func (c *Client) decodeMustGetInfoListResult(result *algokit.SendAppTransactionResult) (*MustGetInfoListMethodResult, error) {
 	typedResult := &MustGetInfoListMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendStakeCheck calls the stakeCheck ABI method and waits for confirmation.
+// SendStakeCheck calls the stakeCheck ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendStakeCheck(ctx context.Context, params algokit.CallParams[StakeCheckArgs]) (*StakeCheckMethodResult, error) {
-	methodArgs := argsToInterfaceStakeCheck(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendStakeCheck(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "stakeCheck",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendStakeCheck(ctx context.Context, params algokit.CallParams[StakeCheckArgs]) (*StakeCheckMethodResult, error) {
+	comp, err := c.NewGroup().StakeCheck(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*StakeCheckMethodResult)
+	return typedResult, nil
+}
 
+// decodeStakeCheckResult decodes the return value of a stakeCheck call.
+func (c *Client) decodeStakeCheckResult(result *algokit.SendAppTransactionResult) (*StakeCheckMethodResult, error) {
 	typedResult := &StakeCheckMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -755,81 +1196,91 @@ func (c *Client) SendStakeCheck(ctx context.Context, params algokit.CallParams[S
 	return typedResult, nil
 }
 
-// SendGetTotals calls the getTotals ABI method and waits for confirmation.
+// SendGetTotals calls the getTotals ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetTotals(ctx context.Context, params algokit.CallParams[GetTotalsArgs]) (*GetTotalsMethodResult, error) {
-	methodArgs := argsToInterfaceGetTotals(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetTotals(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getTotals",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetTotals(ctx context.Context, params algokit.CallParams[GetTotalsArgs]) (*GetTotalsMethodResult, error) {
+	comp, err := c.NewGroup().GetTotals(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetTotalsMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetTotalsResult decodes the return value of a getTotals call.
+func (c *Client) decodeGetTotalsResult(result *algokit.SendAppTransactionResult) (*GetTotalsMethodResult, error) {
 	typedResult := &GetTotalsMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation.
+// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDao(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAO",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendUpdateAkitaDao(ctx, params)
+	return err
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDao(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
 func argsToInterfaceCreate(args CreateArgs) []interface{} {
@@ -982,13 +1433,17 @@ func argsToInterfaceMustGetInfoList(args MustGetInfoListArgs) []interface{} {
 	}
 }
 
-func argsToInterfaceStakeCheck(args StakeCheckArgs) []interface{} {
-	return []interface{}{
-		args.Address,
-		args.Checks,
-		args.Type,
-		args.IncludeEscrowed,
-	}
+func argsToInterfaceStakeCheck(args StakeCheckArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 4)
+	methodArgs = append(methodArgs, args.Address)
+	if v, err := toABIValue(args.Checks); err != nil {
+		return nil, fmt.Errorf("invalid checks argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
+	}
+	methodArgs = append(methodArgs, args.Type)
+	methodArgs = append(methodArgs, args.IncludeEscrowed)
+	return methodArgs, nil
 }
 
 func argsToInterfaceGetTotals(args GetTotalsArgs) []interface{} {
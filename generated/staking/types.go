@@ -7,6 +7,18 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// Escrow is a generated struct type.
+type Escrow struct {
+	Hard uint64 `json:"hard"`
+	Lock uint64 `json:"lock"`
+}
+
+// HeartbeatKey is a generated struct type.
+type HeartbeatKey struct {
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
+}
+
 // Stake is a generated struct type.
 type Stake struct {
 	Amount     uint64 `json:"amount"`
@@ -39,260 +51,248 @@ type TotalsInfo struct {
 	Escrowed uint64 `json:"escrowed"`
 }
 
-// Escrow is a generated struct type.
-type Escrow struct {
-	Hard uint64 `json:"hard"`
-	Lock uint64 `json:"lock"`
-}
-
-// HeartbeatKey is a generated struct type.
-type HeartbeatKey struct {
-	Address types.Address `json:"address"`
-	Asset   uint64        `json:"asset"`
-}
-
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	AkitaDao uint64
+	Version  string `json:"version"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // StakeArgs holds the arguments for the stake method.
 type StakeArgs struct {
-	Payment    transaction.TransactionWithSigner
-	Type       uint8
-	Amount     uint64
-	Expiration uint64
+	Payment    transaction.TransactionWithSigner `json:"-"`
+	Type       uint8                             `json:"type"`
+	Amount     uint64                            `json:"amount"`
+	Expiration uint64                            `json:"expiration"`
 }
 
 // StakeASAArgs holds the arguments for the stakeAsa method.
 type StakeASAArgs struct {
-	Payment    transaction.TransactionWithSigner
-	AssetXfer  transaction.TransactionWithSigner
-	Type       uint8
-	Amount     uint64
-	Expiration uint64
+	Payment    transaction.TransactionWithSigner `json:"-"`
+	AssetXfer  transaction.TransactionWithSigner `json:"-"`
+	Type       uint8                             `json:"type"`
+	Amount     uint64                            `json:"amount"`
+	Expiration uint64                            `json:"expiration"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	Asset uint64
-	Type  uint8
+	Asset uint64 `json:"asset"`
+	Type  uint8  `json:"type"`
 }
 
 // CreateHeartbeatArgs holds the arguments for the createHeartbeat method.
 type CreateHeartbeatArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // SoftCheckArgs holds the arguments for the softCheck method.
 type SoftCheckArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // SoftCheckMethodResult holds the result of calling softCheck.
 type SoftCheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return StakeCheck
+	Return StakeCheck `json:"return"`
 }
 
 // UpdateSettingsArgs holds the arguments for the updateSettings method.
 type UpdateSettingsArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
-	Value   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
+	Value   uint64                            `json:"value"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // StakeCostArgs holds the arguments for the stakeCost method.
 type StakeCostArgs struct {
-	Asset uint64
-	Type  uint8
+	Asset uint64 `json:"asset"`
+	Type  uint8  `json:"type"`
 }
 
 // StakeCostMethodResult holds the result of calling stakeCost.
 type StakeCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetTimeLeftArgs holds the arguments for the getTimeLeft method.
 type GetTimeLeftArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // GetTimeLeftMethodResult holds the result of calling getTimeLeft.
 type GetTimeLeftMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // MustGetTimeLeftArgs holds the arguments for the mustGetTimeLeft method.
 type MustGetTimeLeftArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // MustGetTimeLeftMethodResult holds the result of calling mustGetTimeLeft.
 type MustGetTimeLeftMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetInfoArgs holds the arguments for the getInfo method.
 type GetInfoArgs struct {
-	Address types.Address
-	Stake   StakeInfo
+	Address types.Address `json:"address"`
+	Stake   StakeInfo     `json:"stake"`
 }
 
 // GetInfoMethodResult holds the result of calling getInfo.
 type GetInfoMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return Stake
+	Return Stake `json:"return"`
 }
 
 // MustGetInfoArgs holds the arguments for the mustGetInfo method.
 type MustGetInfoArgs struct {
-	Address types.Address
-	Stake   StakeInfo
+	Address types.Address `json:"address"`
+	Stake   StakeInfo     `json:"stake"`
 }
 
 // MustGetInfoMethodResult holds the result of calling mustGetInfo.
 type MustGetInfoMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return Stake
+	Return Stake `json:"return"`
 }
 
 // GetEscrowInfoArgs holds the arguments for the getEscrowInfo method.
 type GetEscrowInfoArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // GetEscrowInfoMethodResult holds the result of calling getEscrowInfo.
 type GetEscrowInfoMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return Escrow
+	Return Escrow `json:"return"`
 }
 
 // GetHeartbeatArgs holds the arguments for the getHeartbeat method.
 type GetHeartbeatArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // GetHeartbeatMethodResult holds the result of calling getHeartbeat.
 type GetHeartbeatMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [4][]interface{}
+	Return [4]Tuple4[uint64, uint64, uint64, uint64] `json:"return"`
 }
 
 // MustGetHeartbeatArgs holds the arguments for the mustGetHeartbeat method.
 type MustGetHeartbeatArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // MustGetHeartbeatMethodResult holds the result of calling mustGetHeartbeat.
 type MustGetHeartbeatMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [4][]interface{}
+	Return [4]Tuple4[uint64, uint64, uint64, uint64] `json:"return"`
 }
 
 // GetHeartbeatAverageArgs holds the arguments for the getHeartbeatAverage method.
 type GetHeartbeatAverageArgs struct {
-	Address         types.Address
-	Asset           uint64
-	IncludeEscrowed bool
+	Address         types.Address `json:"address"`
+	Asset           uint64        `json:"asset"`
+	IncludeEscrowed bool          `json:"includeEscrowed"`
 }
 
 // GetHeartbeatAverageMethodResult holds the result of calling getHeartbeatAverage.
 type GetHeartbeatAverageMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // MustGetHeartbeatAverageArgs holds the arguments for the mustGetHeartbeatAverage method.
 type MustGetHeartbeatAverageArgs struct {
-	Address         types.Address
-	Asset           uint64
-	IncludeEscrowed bool
+	Address         types.Address `json:"address"`
+	Asset           uint64        `json:"asset"`
+	IncludeEscrowed bool          `json:"includeEscrowed"`
 }
 
 // MustGetHeartbeatAverageMethodResult holds the result of calling mustGetHeartbeatAverage.
 type MustGetHeartbeatAverageMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetInfoListArgs holds the arguments for the getInfoList method.
 type GetInfoListArgs struct {
-	Address types.Address
-	Type    uint8
-	Assets  []uint64
+	Address types.Address `json:"address"`
+	Type    uint8         `json:"type"`
+	Assets  []uint64      `json:"assets"`
 }
 
 // GetInfoListMethodResult holds the result of calling getInfoList.
 type GetInfoListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple3[uint64, uint64, uint64] `json:"return"`
 }
 
 // MustGetInfoListArgs holds the arguments for the mustGetInfoList method.
 type MustGetInfoListArgs struct {
-	Address types.Address
-	Type    uint8
-	Assets  []uint64
+	Address types.Address `json:"address"`
+	Type    uint8         `json:"type"`
+	Assets  []uint64      `json:"assets"`
 }
 
 // MustGetInfoListMethodResult holds the result of calling mustGetInfoList.
 type MustGetInfoListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple3[uint64, uint64, uint64] `json:"return"`
 }
 
 // StakeCheckArgs holds the arguments for the stakeCheck method.
 type StakeCheckArgs struct {
-	Address         types.Address
-	Checks          [][]interface{}
-	Type            uint8
-	IncludeEscrowed bool
+	Address         types.Address            `json:"address"`
+	Checks          []Tuple2[uint64, uint64] `json:"checks"`
+	Type            uint8                    `json:"type"`
+	IncludeEscrowed bool                     `json:"includeEscrowed"`
 }
 
 // StakeCheckMethodResult holds the result of calling stakeCheck.
 type StakeCheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetTotalsArgs holds the arguments for the getTotals method.
 type GetTotalsArgs struct {
-	Assets []uint64
+	Assets []uint64 `json:"assets"`
 }
 
 // GetTotalsMethodResult holds the result of calling getTotals.
 type GetTotalsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple2[uint64, uint64] `json:"return"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -8,48 +8,48 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao uint64
-	Version  string
+	AkitaDao uint64 `json:"akitaDAO"`
+	Version  string `json:"version"`
 }
 
 // StakeArgs holds the arguments for the stake method.
 type StakeArgs struct {
-	Wallet     uint64
-	RekeyBack  bool
-	AssetID    uint64
-	Type       uint8
-	Amount     uint64
-	Expiration uint64
-	IsUpdate   bool
+	Wallet     uint64 `json:"wallet"`
+	RekeyBack  bool   `json:"rekeyBack"`
+	AssetID    uint64 `json:"assetID"`
+	Type       uint8  `json:"type"`
+	Amount     uint64 `json:"amount"`
+	Expiration uint64 `json:"expiration"`
+	IsUpdate   bool   `json:"isUpdate"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Asset     uint64
-	Type      uint8
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Asset     uint64 `json:"asset"`
+	Type      uint8  `json:"type"`
 }
 
 // CreateHeartbeatArgs holds the arguments for the createHeartbeat method.
 type CreateHeartbeatArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
-	Asset     uint64
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
+	Asset     uint64        `json:"asset"`
 }
 
 // SoftCheckArgs holds the arguments for the softCheck method.
 type SoftCheckArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
-	Asset     uint64
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
+	Asset     uint64        `json:"asset"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,4 +2,11 @@
 package stakingpool
 
 // AppSpecJSON contains the raw ARC-56 application specification.
-var AppSpecJSON = "{\"name\":\"StakingPool\",\"arcs\":[22,28],\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"title\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"address\",\"name\":\"creator\"},{\"type\":\"(address,uint64)\",\"name\":\"funder\",\"struct\":\"FunderInfo\"},{\"type\":\"address\",\"name\":\"marketplace\"},{\"type\":\"(address,string)\",\"name\":\"stakeKey\",\"struct\":\"RootKey\"},{\"type\":\"uint64\",\"name\":\"minimumStakeAmount\"},{\"type\":\"bool\",\"name\":\"allowLateSignups\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"uint64\",\"name\":\"maxEntries\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint64\",\"name\":\"akitaDAOEscrow\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"delete\",\"args\":[{\"type\":\"address\",\"name\":\"caller\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"DeleteApplication\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"optIn\",\"desc\":\"optin tells the contract to opt into an asa, it may also require the akita dao escrow to opt in\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction\"},{\"type\":\"uint64\",\"name\":\"asset\",\"desc\":\"The asset to be opted into\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"addReward\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"(uint64,uint8,uint64,uint64,uint64,uint64)\",\"name\":\"reward\",\"struct\":\"AddRewardParams\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"addRewardAsa\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"axfer\",\"name\":\"assetXfer\"},{\"type\":\"(uint64,uint8,uint64,uint64,uint64,uint64)\",\"name\":\"reward\",\"struct\":\"AddRewardParams\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalize\",\"args\":[{\"type\":\"uint64\",\"name\":\"signupTimestamp\"},{\"type\":\"uint64\",\"name\":\"startTimestamp\"},{\"type\":\"uint64\",\"name\":\"endTimestamp\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gatedEnter\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"(uint64,uint64,byte[32][])[]\",\"name\":\"entries\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"enter\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"(uint64,uint64,byte[32][])[]\",\"name\":\"entries\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"startDisbursement\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"raffle\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"disburseRewards\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"},{\"type\":\"uint64\",\"name\":\"iterationAmount\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"finalizeDistribution\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"check\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"(bool,uint64)\",\"struct\":\"ObjectC3416591\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"gateCheck\",\"args\":[{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"enterCost\",\"desc\":\"Calculates the total cost required to enter the pool\",\"args\":[{\"type\":\"address\",\"name\":\"address\",\"desc\":\"The address that will be entering\"},{\"type\":\"uint64\",\"name\":\"entryCount\",\"desc\":\"The number of entries being added\"}],\"returns\":{\"type\":\"uint64\",\"desc\":\"The total payment amount needed (includes box MBR + any shortfall to meet min balance)\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"optInCost\",\"args\":[{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"signUpsOpen\",\"args\":[],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean of whether sign ups are open\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"isLive\",\"args\":[],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean of whether the pool is live\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"isEntered\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean indicating if the address has entered the staking pool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"getState\",\"args\":[],\"returns\":{\"type\":\"(uint8,string,uint8,uint64,uint64,bool,uint64,uint64,uint64,uint64,uint64,(address,string),uint64,uint64,address)\",\"struct\":\"StakingPoolState\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}},{\"name\":\"updateAkitaDAOEscrow\",\"args\":[{\"type\":\"uint64\",\"name\":\"app\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"update\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"recommendations\":{}},{\"name\":\"mbr\",\"args\":[{\"type\":\"uint64\",\"name\":\"winningTickets\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64)\",\"desc\":\"the mbr created for each boxmap entry\",\"struct\":\"StakingPoolMBRData\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"recommendations\":{}}],\"structs\":{\"AddRewardParams\":[{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"distribution\",\"type\":\"uint8\"},{\"name\":\"rate\",\"type\":\"uint64\"},{\"name\":\"expiration\",\"type\":\"uint64\"},{\"name\":\"interval\",\"type\":\"uint64\"},{\"name\":\"winnerCount\",\"type\":\"uint64\"}],\"EntryData\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"quantity\",\"type\":\"uint64\"},{\"name\":\"gateArgs\",\"type\":\"byte[][]\"},{\"name\":\"disqualified\",\"type\":\"bool\"}],\"EntryKey\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"asset\",\"type\":\"uint64\"}],\"FunderInfo\":[{\"name\":\"account\",\"type\":\"address\"},{\"name\":\"amount\",\"type\":\"uint64\"}],\"ObjectC3416591\":[{\"name\":\"valid\",\"type\":\"bool\"},{\"name\":\"balance\",\"type\":\"uint64\"}],\"RaffleCursor\":[{\"name\":\"ticket\",\"type\":\"uint64\"},{\"name\":\"stake\",\"type\":\"uint64\"},{\"name\":\"disbursed\",\"type\":\"uint64\"}],\"Reward\":[{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"distribution\",\"type\":\"uint8\"},{\"name\":\"rate\",\"type\":\"uint64\"},{\"name\":\"expiration\",\"type\":\"uint64\"},{\"name\":\"interval\",\"type\":\"uint64\"},{\"name\":\"qualifiedStakers\",\"type\":\"uint64\"},{\"name\":\"qualifiedStake\",\"type\":\"uint64\"},{\"name\":\"winnerCount\",\"type\":\"uint64\"},{\"name\":\"winningTickets\",\"type\":\"uint64[]\"},{\"name\":\"raffleCursor\",\"type\":\"RaffleCursor\"},{\"name\":\"vrfFailureCount\",\"type\":\"uint64\"},{\"name\":\"phase\",\"type\":\"uint8\"},{\"name\":\"disbursementCursor\",\"type\":\"uint64\"},{\"name\":\"activeDisbursementID\",\"type\":\"uint64\"},{\"name\":\"activeDisbursementRoundStart\",\"type\":\"uint64\"},{\"name\":\"lastDisbursementTimestamp\",\"type\":\"uint64\"}],\"RootKey\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"name\",\"type\":\"string\"}],\"StakingPoolMBRData\":[{\"name\":\"entries\",\"type\":\"uint64\"},{\"name\":\"uniques\",\"type\":\"uint64\"},{\"name\":\"entriesByAddress\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"disbursements\",\"type\":\"uint64\"}],\"StakingPoolState\":[{\"name\":\"status\",\"type\":\"uint8\"},{\"name\":\"title\",\"type\":\"string\"},{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"signupTimestamp\",\"type\":\"uint64\"},{\"name\":\"startTimestamp\",\"type\":\"uint64\"},{\"name\":\"allowLateSignups\",\"type\":\"bool\"},{\"name\":\"endTimestamp\",\"type\":\"uint64\"},{\"name\":\"maxEntries\",\"type\":\"uint64\"},{\"name\":\"entryCount\",\"type\":\"uint64\"},{\"name\":\"rewardCount\",\"type\":\"uint64\"},{\"name\":\"totalStaked\",\"type\":\"uint64\"},{\"name\":\"stakeKey\",\"type\":\"RootKey\"},{\"name\":\"minimumStakeAmount\",\"type\":\"uint64\"},{\"name\":\"gateID\",\"type\":\"uint64\"},{\"name\":\"creator\",\"type\":\"address\"}]},\"state\":{\"schema\":{\"global\":{\"ints\":16,\"bytes\":9},\"local\":{\"ints\":0,\"bytes\":0}},\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"akitaDAOEscrow\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZXNjcm93\",\"desc\":\"the app ID for the akita DAO escrow to use\"},\"akitaRoyalty\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfcm95YWx0eQ==\",\"desc\":\"the akita royalty for the pool\"},\"akitaRoyaltyAmount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfcm95YWx0eV9hbW91bnQ=\",\"desc\":\"the amount of royalties that were paid in a disbursement\"},\"allowLateSignups\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWxsb3dfbGF0ZV9zaWdudXBz\",\"desc\":\"whether signups are allowed after the staking pool begins\"},\"creator\":{\"keyType\":\"AVMString\",\"valueType\":\"address\",\"key\":\"Y3JlYXRvcg==\",\"desc\":\"the address of the creator of the staking pool\"},\"endTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW5kX3RpbWVzdGFtcA==\",\"desc\":\"the timestamp when the pool ends\"},\"entryID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW50cnlfY291bnQ=\",\"desc\":\"the number of entries in a pool\"},\"funder\":{\"keyType\":\"AVMString\",\"valueType\":\"FunderInfo\",\"key\":\"ZnVuZGVy\"},\"gateID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9pZA==\",\"desc\":\"the gate id of the pool\"},\"gateSize\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9zaXpl\",\"desc\":\"the size of the gate were using\"},\"marketplace\":{\"keyType\":\"AVMString\",\"valueType\":\"address\",\"key\":\"bWFya2V0cGxhY2U=\",\"desc\":\"marketplace is pool creation side marketplace\"},\"marketplaceRoyalties\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWFya2V0cGxhY2Vfcm95YWx0aWVz\",\"desc\":\"the amount the marketplaces will get for the sale\"},\"maxEntries\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X2VudHJpZXM=\",\"desc\":\"the maximum entries allowed for the pool\"},\"minimumStakeAmount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWluaW11bV9zdGFrZV9hbW91bnQ=\",\"desc\":\"minimum stake amount\"},\"rewardID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cmV3YXJkX2NvdW50\",\"desc\":\"the number of rewards for the pool\"},\"salt\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMBytes\",\"key\":\"c2FsdA==\",\"desc\":\"salt for randomness\"},\"signupTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"c2lnbnVwX3RpbWVzdGFtcA==\",\"desc\":\"the timestamp when sign ups for the pool are allowed\"},\"stakeKey\":{\"keyType\":\"AVMString\",\"valueType\":\"RootKey\",\"key\":\"c3Rha2Vfa2V5\",\"desc\":\"the name for the meta merkle asset group to validate staking\\nstake key can be empty if distribution !== DistributionTypePercentage\"},\"startTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"c3RhcnRfdGltZXN0YW1w\",\"desc\":\"the timestamp when the pool starts\"},\"status\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"c3RhdHVz\",\"desc\":\"the status the pool is in\"},\"title\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dGl0bGU=\",\"desc\":\"title of the staking pool\"},\"totalStaked\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dG90YWxfc3Rha2Vk\",\"desc\":\"the total amount staked in the pool\"},\"type\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"dHlwZQ==\",\"desc\":\"the method of staking to be used for the pool\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"disbursements\":{\"keyType\":\"uint64\",\"valueType\":\"AVMBytes\",\"prefix\":\"ZA==\",\"desc\":\"the disbursements this pool as created \\u0026 finalized\"},\"entries\":{\"keyType\":\"uint64\",\"valueType\":\"EntryData\",\"prefix\":\"ZQ==\",\"desc\":\"indexed entries for efficient iteration\"},\"entriesByAddress\":{\"keyType\":\"EntryKey\",\"valueType\":\"uint64\",\"prefix\":\"YQ==\",\"desc\":\"the entries in the pool\"},\"rewards\":{\"keyType\":\"uint64\",\"valueType\":\"Reward\",\"prefix\":\"cg==\",\"desc\":\"the rewards for this staking pool\"},\"uniques\":{\"keyType\":\"address\",\"valueType\":\"uint64\",\"prefix\":\"dQ==\",\"desc\":\"the number of unique asset entries by address\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggOTIgMTAwMDAwIDYzNjQxMzYyMjM4NDY3OTMwMDUgNDAwIDE0NDI2OTUwNDA4ODg5NjM0MDcgMTQ0MjY5NTA0MDg4ODk2MzQwOSA0Mjk0OTY3Mjk1IDUwNjAwIDE4OTAwIDE4NDQ2NzQ0MDczNzA5NTUxNjE1CiAgICBieXRlY2Jsb2NrICJha2l0YV9kYW8iICJlbnRyeV9jb3VudCIgMHgxNTFmN2M3NSAweDAwICJ0eXBlIiAweDAwMDAgImFhbCIgImFraXRhX2VzY3JvdyIgMHgwYSAiZSIgInN0YXR1cyIgImNyZWF0b3IiIDB4MTQgMHgxZSAiYWxsb3dfbGF0ZV9zaWdudXBzIiAic3RhcnRfdGltZXN0YW1wIiAiZW5kX3RpbWVzdGFtcCIgImdhdGVfaWQiICJtYXhfZW50cmllcyIgMHg4MCAid2FsbGV0IiAiciIgImFraXRhX3JveWFsdHlfYW1vdW50IiAidSIgInNpZ251cF90aW1lc3RhbXAiICJyZXdhcmRfY291bnQiICJzdGFrZV9rZXkiICJha2l0YV9yb3lhbHR5IiAidGl0bGUiICJtaW5pbXVtX3N0YWtlX2Ftb3VudCIgImEiICJ0b3RhbF9zdGFrZWQiIDB4NzFiOTU3YWYgIm9hbCIgInNhbHQiICJzYWwiICJwYWwiICJjb250cm9sbGVkX2FkZHJlc3MiIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwIDB4MDAwMDAwMDAwMDAwMDAwMDAwIDB4YzkwNjg4MDkKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyBzdGFydFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3RhcnRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE1IC8vICJzdGFydF90aW1lc3RhbXAiCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIGVuZFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW5kVGltZXN0YW1wIH0pCiAgICBieXRlYyAxNiAvLyAiZW5kX3RpbWVzdGFtcCIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICBpbnRjXzEgLy8gMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIHJld2FyZElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlSZXdhcmRDb3VudCB9KQogICAgYnl0ZWMgMjUgLy8gInJld2FyZF9jb3VudCIKICAgIGludGNfMSAvLyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gdG90YWxTdGFrZWQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVRvdGFsU3Rha2VkIH0pCiAgICBieXRlYyAzMSAvLyAidG90YWxfc3Rha2VkIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CgptYWluX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDUtMTA5CiAgICAvLyBleHBvcnQgY2xhc3MgU3Rha2luZ1Bvb2wgZXh0ZW5kcyBjbGFzc2VzKAogICAgLy8gICBCYXNlU3Rha2luZ1Bvb2wsCiAgICAvLyAgIEFraXRhQmFzZUZlZUdlbmVyYXRvckNvbnRyYWN0LAogICAgLy8gICBDaGlsZENvbnRyYWN0CiAgICAvLyApIHsKICAgIHB1c2hieXRlc3MgMHhhYjhiMjEzYSAweGVhOTE4MGRkIC8vIG1ldGhvZCAiZGVsZXRlKGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAidXBkYXRlKHN0cmluZyl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggbWFpbl9kZWxldGVfcm91dGVANCBtYWluX3VwZGF0ZV9yb3V0ZUA1CgptYWluX3N3aXRjaF9jYXNlX25leHRANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDUtMTA5CiAgICAvLyBleHBvcnQgY2xhc3MgU3Rha2luZ1Bvb2wgZXh0ZW5kcyBjbGFzc2VzKAogICAgLy8gICBCYXNlU3Rha2luZ1Bvb2wsCiAgICAvLyAgIEFraXRhQmFzZUZlZUdlbmVyYXRvckNvbnRyYWN0LAogICAgLy8gICBDaGlsZENvbnRyYWN0CiAgICAvLyApIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYnogbWFpbl9jcmVhdGVfTm9PcEAzMgogICAgcHVzaGJ5dGVzcyAweDgzZjE0NzQ4IDB4Mzk0ZWFlYjIgLy8gbWV0aG9kICJpbml0KCl2b2lkIiwgbWV0aG9kICJvcHRJbihwYXksdWludDY0KXZvaWQiCiAgICBieXRlYyAzMiAvLyBtZXRob2QgImFkZFJld2FyZChwYXksKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiCiAgICBwdXNoYnl0ZXNzIDB4YTAwY2NlYzYgMHgxODFlODI5MSAweGZkY2ZkZmY2IDB4YjgyZWQxYjMgMHhhOTQxOGQzNyAweGZjNjk5Y2IzIDB4NmU3NmIyY2EgMHhjZGIwYWM2YSAweGIyMjNkMzVkIDB4YmM1OGFkMDkgMHg4ZTA4MjQ4NCAweDMzZjc4ODA4IDB4NTkyZWUzNDEgMHg4ZmE0YTE2MCAweGUzMzc3MzQyIDB4NzE3MDQ2NDkgMHgxZWFkMjBhOSAweDMzZTkyYzk0IDB4ODU0ZGVkZTAgMHhhNGIzZTc4OSAvLyBtZXRob2QgImFkZFJld2FyZEFzYShwYXksYXhmZXIsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiLCBtZXRob2QgImZpbmFsaXplKHVpbnQ2NCx1aW50NjQsdWludDY0KXZvaWQiLCBtZXRob2QgImdhdGVkRW50ZXIocGF5LGFwcGwsKHVpbnQ2NCx1aW50NjQsYnl0ZVszMl1bXSlbXSl2b2lkIiwgbWV0aG9kICJlbnRlcihwYXksKHVpbnQ2NCx1aW50NjQsYnl0ZVszMl1bXSlbXSl2b2lkIiwgbWV0aG9kICJzdGFydERpc2J1cnNlbWVudCh1aW50NjQpdm9pZCIsIG1ldGhvZCAicmFmZmxlKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJkaXNidXJzZVJld2FyZHModWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZURpc3RyaWJ1dGlvbih1aW50NjQpdm9pZCIsIG1ldGhvZCAiY2hlY2soYWRkcmVzcyx1aW50NjQpKGJvb2wsdWludDY0KSIsIG1ldGhvZCAiZ2F0ZUNoZWNrKGFwcGwsYWRkcmVzcyx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZW50ZXJDb3N0KGFkZHJlc3MsdWludDY0KXVpbnQ2NCIsIG1ldGhvZCAib3B0SW5Db3N0KHVpbnQ2NCl1aW50NjQiLCBtZXRob2QgInNpZ25VcHNPcGVuKClib29sIiwgbWV0aG9kICJpc0xpdmUoKWJvb2wiLCBtZXRob2QgImlzRW50ZXJlZChhZGRyZXNzKWJvb2wiLCBtZXRob2QgImdldFN0YXRlKCkodWludDgsc3RyaW5nLHVpbnQ4LHVpbnQ2NCx1aW50NjQsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LChhZGRyZXNzLHN0cmluZyksdWludDY0LHVpbnQ2NCxhZGRyZXNzKSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU9Fc2Nyb3codWludDY0KXZvaWQiLCBtZXRob2QgInVwZGF0ZUFraXRhREFPKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcFVwKCl2b2lkIiwgbWV0aG9kICJtYnIodWludDY0KSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGluaXQgb3B0SW4gYWRkUmV3YXJkIGFkZFJld2FyZEFzYSBmaW5hbGl6ZSBnYXRlZEVudGVyIGVudGVyIHN0YXJ0RGlzYnVyc2VtZW50IHJhZmZsZSBkaXNidXJzZVJld2FyZHMgZmluYWxpemVEaXN0cmlidXRpb24gY2hlY2sgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ10gZW50ZXJDb3N0IG9wdEluQ29zdCBzaWduVXBzT3BlbiBpc0xpdmUgaXNFbnRlcmVkIGdldFN0YXRlIHVwZGF0ZUFraXRhREFPRXNjcm93IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAyOSBtYnIKICAgIGVycgoKbWFpbl9vcFVwX3JvdXRlQDI5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDMKICAgIC8vIG9wVXAoKTogdm9pZCB7IH0KICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm1haW5fY3JlYXRlX05vT3BAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA1LTEwOQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YWtpbmdQb29sIGV4dGVuZHMgY2xhc3NlcygKICAgIC8vICAgQmFzZVN0YWtpbmdQb29sLAogICAgLy8gICBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCwKICAgIC8vICAgQ2hpbGRDb250cmFjdAogICAgLy8gKSB7CiAgICBwdXNoYnl0ZXMgMHhhMmYzYzU0NCAvLyBtZXRob2QgImNyZWF0ZShzdHJpbmcsdWludDgsYWRkcmVzcywoYWRkcmVzcyx1aW50NjQpLGFkZHJlc3MsKGFkZHJlc3Msc3RyaW5nKSx1aW50NjQsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgptYWluX3VwZGF0ZV9yb3V0ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCm1haW5fZGVsZXRlX3JvdXRlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODEyCiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiAnRGVsZXRlQXBwbGljYXRpb24nIH0pCiAgICB0eG4gT25Db21wbGV0aW9uCiAgICBwdXNoaW50IDUgLy8gRGVsZXRlQXBwbGljYXRpb24KICAgID09CiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgJiYKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBEZWxldGVBcHBsaWNhdGlvbiAmJiBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBiIGRlbGV0ZQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6Ol9fcGNnMzJPdXRwdXQoc3RhdGU6IHVpbnQ2NCkgLT4gdWludDY0OgpfX3BjZzMyT3V0cHV0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyMwogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIF9fcGNnMzJPdXRwdXQoc3RhdGU6IFBDRzMyU1RBVEUpOiB1aW50NjQgewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjI0CiAgICAvLyBjb25zdCB4b3JzaGlmdGVkID0gX19tYXNrVG9VaW50MzIob3Auc2hyKG9wLnNocihzdGF0ZSwgMTgpIF4gc3RhdGUsIDI3KSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAxOCAvLyAxOAogICAgc2hyCiAgICBmcmFtZV9kaWcgLTEKICAgIF4KICAgIHB1c2hpbnQgMjcgLy8gMjcKICAgIHNocgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxMwogICAgLy8gcmV0dXJuIHZhbHVlICYgKG9wLnNobCgxLCAzMikgLSAxKQogICAgaW50YyA5IC8vIDQyOTQ5NjcyOTUKICAgICYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MjUKICAgIC8vIGNvbnN0IHJvdCA9IG9wLnNocihzdGF0ZSwgNTkpCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIHNocgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyNgogICAgLy8gcmV0dXJuIG9wLnNocih4b3JzaGlmdGVkLCByb3QpIHwgX19tYXNrVG9VaW50MzIob3Auc2hsKHhvcnNoaWZ0ZWQsIF9fdWludDY0VHdvcyhyb3QpICYgMzEpKQogICAgZHVwMgogICAgc2hyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjgKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KH52YWx1ZSwgMSkKICAgIHN3YXAKICAgIH4KICAgIGludGNfMSAvLyAxCiAgICBhZGR3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MjYKICAgIC8vIHJldHVybiBvcC5zaHIoeG9yc2hpZnRlZCwgcm90KSB8IF9fbWFza1RvVWludDMyKG9wLnNobCh4b3JzaGlmdGVkLCBfX3VpbnQ2NFR3b3Mocm90KSAmIDMxKSkKICAgIHB1c2hpbnQgMzEgLy8gMzEKICAgICYKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgc2hsCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjEzCiAgICAvLyByZXR1cm4gdmFsdWUgJiAob3Auc2hsKDEsIDMyKSAtIDEpCiAgICBpbnRjIDkgLy8gNDI5NDk2NzI5NQogICAgJgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyNgogICAgLy8gcmV0dXJuIG9wLnNocih4b3JzaGlmdGVkLCByb3QpIHwgX19tYXNrVG9VaW50MzIob3Auc2hsKHhvcnNoaWZ0ZWQsIF9fdWludDY0VHdvcyhyb3QpICYgMzEpKQogICAgfAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFdhbGxldElEVXNpbmdBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc2CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbiwgYWRkcmVzczogQWNjb3VudCk6IEFwcGxpY2F0aW9uIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTIKICAgIGJ5dGVjIDMzIC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjYwCiAgICAvLyByZXR1cm4gZ2V0T3RoZXJBcHBMaXN0KGFraXRhREFPKS5lc2Nyb3cKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4Mi0xODUKICAgIC8vIGNvbnN0IGRhdGEgPSBhYmlDYWxsPHR5cGVvZiBFc2Nyb3dGYWN0b3J5LnByb3RvdHlwZS5nZXQ+KHsKICAgIC8vICAgYXBwSWQ6IGVzY3Jvd0ZhY3RvcnksCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIHB1c2hieXRlcyAweDNjMWE2ZjMzIC8vIG1ldGhvZCAiZ2V0KGFkZHJlc3MpYnl0ZVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTg3CiAgICAvLyBpZiAoQnl0ZXMoZGF0YSkubGVuZ3RoID09PSAwIHx8IEJ5dGVzKGRhdGEpLmxlbmd0aCAhPT0gOCkgewogICAgbGVuCiAgICBkdXAKICAgIGJ6IGdldFdhbGxldElEVXNpbmdBa2l0YURBT19pZl9ib2R5QDYKICAgIGZyYW1lX2RpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgIT0KICAgIGJ6IGdldFdhbGxldElEVXNpbmdBa2l0YURBT19hZnRlcl9pZl9lbHNlQDcKCmdldFdhbGxldElEVXNpbmdBa2l0YURBT19pZl9ib2R5QDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4OAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNzgKICAgIC8vIHJldHVybiBBcHBsaWNhdGlvbihnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKSkKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaWZfZWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTEKICAgIC8vIHJldHVybiBidG9pKGRhdGEpCiAgICBmcmFtZV9kaWcgMAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNzgKICAgIC8vIHJldHVybiBBcHBsaWNhdGlvbihnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKSkKICAgIGIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdhdGVDaGVjayhnYXRlVHhuOiB1aW50NjQsIGFraXRhREFPOiB1aW50NjQsIGNhbGxlcjogYnl0ZXMsIGlkOiB1aW50NjQpIC0+IHVpbnQ2NDoKZ2F0ZUNoZWNrOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjcKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnYXRlQ2hlY2soZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sIGFraXRhREFPOiBBcHBsaWNhdGlvbiwgY2FsbGVyOiBBY2NvdW50LCBpZDogdWludDY0KTogYm9vbGVhbiB7CiAgICBwcm90byA0IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5CiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGZyYW1lX2RpZyAtMwogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjkKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMAogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBPbkNvbXBsZXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgYm56IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMxCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgTnVtQXBwQXJncwogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzEKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzIKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgZnJhbWVfZGlnIC00CiAgICBpbnRjXzAgLy8gMAogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hieXRlcyAweDQzOTIyNjU1IC8vIG1ldGhvZCAibXVzdENoZWNrKGFkZHJlc3MsdWludDY0LGJ5dGVbXVtdKXZvaWQiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMyCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzMKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgZnJhbWVfZGlnIC00CiAgICBpbnRjXzEgLy8gMQogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMwogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzQKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGZyYW1lX2RpZyAtNAogICAgcHVzaGludCAyIC8vIDIKICAgIGd0eG5zYXMgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzQKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGJ6IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOC0yMzUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vICAgZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gICBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICAvLyApCiAgICByZXRzdWIKCmdhdGVDaGVja19ib29sX2ZhbHNlQDc6CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjgtMjM1CiAgICAvLyByZXR1cm4gKAogICAgLy8gICBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyAgIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vICAgZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgLy8gKQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6c3BsaXRPcHRJbkNvdW50KGFraXRhREFPOiB1aW50NjQsIGVzY3JvdzogYnl0ZXMsIGFzc2V0OiB1aW50NjQpIC0+IHVpbnQ2NDoKc3BsaXRPcHRJbkNvdW50OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1ODcKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBzcGxpdE9wdEluQ291bnQoYWtpdGFEQU86IEFwcGxpY2F0aW9uLCBlc2Nyb3c6IEFjY291bnQsIGFzc2V0OiBBc3NldCk6IHVpbnQ2NCB7CiAgICBwcm90byAzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTg4CiAgICAvLyBsZXQgY291bnQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5MAogICAgLy8gaWYgKCFlc2Nyb3cuaXNPcHRlZEluKGFzc2V0KSkgewogICAgZnJhbWVfZGlnIC0yCiAgICBmcmFtZV9kaWcgLTEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogc3BsaXRPcHRJbkNvdW50X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5OQogICAgLy8gY29uc3QgW3NwbGl0c0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHMpKQogICAgZnJhbWVfZGlnIC0zCiAgICBwdXNoYnl0ZXMgInJldmVudWVfc3BsaXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTQKICAgIC8vIGNvdW50ICs9IHNwbGl0cy5sZW5ndGgKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTEKICAgIC8vIGNvdW50ICs9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5NAogICAgLy8gY291bnQgKz0gc3BsaXRzLmxlbmd0aAogICAgKwogICAgZnJhbWVfYnVyeSAwCgpzcGxpdE9wdEluQ291bnRfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTcKICAgIC8vIHJldHVybiBjb3VudAogICAgZnJhbWVfZGlnIDAKICAgIHN3YXAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTgKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDhbMzJdLHVpbnQ2NCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDYKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA5CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMTAKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzczCiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSA9IFBvb2xTdGF0dXNEcmFmdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gdGl0bGUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUaXRsZSB9KQogICAgYnl0ZWMgMjggLy8gInRpdGxlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NAogICAgLy8gdGhpcy50aXRsZS52YWx1ZSA9IHRpdGxlCiAgICB1bmNvdmVyIDEyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NQogICAgLy8gdGhpcy50eXBlLnZhbHVlID0gdHlwZQogICAgdW5jb3ZlciAxMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzc2CiAgICAvLyB0aGlzLmNyZWF0b3IudmFsdWUgPSBjcmVhdG9yCiAgICB1bmNvdmVyIDEwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2NoaWxkLnRzOjExCiAgICAvLyBmdW5kZXIgPSBHbG9iYWxTdGF0ZTxGdW5kZXJJbmZvPih7IGtleTogR2xvYmFsU3RhdGVLZXlGdW5kZXIgfSkKICAgIHB1c2hieXRlcyAiZnVuZGVyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NwogICAgLy8gdGhpcy5mdW5kZXIudmFsdWUgPSBjbG9uZShmdW5kZXIpCiAgICB1bmNvdmVyIDkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ5CiAgICAvLyBtYXJrZXRwbGFjZSA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXJrZXRwbGFjZSB9KQogICAgcHVzaGJ5dGVzICJtYXJrZXRwbGFjZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NzgKICAgIC8vIHRoaXMubWFya2V0cGxhY2UudmFsdWUgPSBtYXJrZXRwbGFjZQogICAgdW5jb3ZlciA4CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gc3Rha2VLZXkgPSBHbG9iYWxTdGF0ZTxSb290S2V5Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3Rha2VLZXkgfSkKICAgIGJ5dGVjIDI2IC8vICJzdGFrZV9rZXkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzgwCiAgICAvLyB0aGlzLnN0YWtlS2V5LnZhbHVlID0gY2xvbmUoc3Rha2VLZXkpCiAgICB1bmNvdmVyIDcKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQxCiAgICAvLyBtaW5pbXVtU3Rha2VBbW91bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNaW5pbXVtU3Rha2VBbW91bnQgfSkKICAgIGJ5dGVjIDI5IC8vICJtaW5pbXVtX3N0YWtlX2Ftb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3ODEKICAgIC8vIHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlID0gbWluaW11bVN0YWtlQW1vdW50CiAgICB1bmNvdmVyIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyBhbGxvd0xhdGVTaWdudXBzID0gR2xvYmFsU3RhdGU8Ym9vbGVhbj4oeyBpbml0aWFsVmFsdWU6IGZhbHNlLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFsbG93TGF0ZVNpZ251cHMgfSkKICAgIGJ5dGVjIDE0IC8vICJhbGxvd19sYXRlX3NpZ251cHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzgyCiAgICAvLyB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUgPSBhbGxvd0xhdGVTaWdudXBzCiAgICB1bmNvdmVyIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDE3IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc4MwogICAgLy8gdGhpcy5nYXRlSUQudmFsdWUgPSBnYXRlSUQKICAgIHVuY292ZXIgNAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIG1heEVudHJpZXMgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXhFbnRyaWVzIH0pCiAgICBieXRlYyAxOCAvLyAibWF4X2VudHJpZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg0CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPSBtYXhFbnRyaWVzCiAgICB1bmNvdmVyIDMKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTU3CiAgICAvLyBzYWx0ID0gR2xvYmFsU3RhdGU8Ynl0ZXM8MzI+Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2FsdCB9KQogICAgYnl0ZWMgMzQgLy8gInNhbHQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg2CiAgICAvLyB0aGlzLnNhbHQudmFsdWUgPSBUeG4udHhJZAogICAgdHhuIFR4SUQKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3ODcKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgdW5jb3ZlciAyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg4CiAgICAvLyB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlID0gYWtpdGFEQU9Fc2Nyb3cKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzkwCiAgICAvLyBjb25zdCBmZWVzID0gZ2V0U3Rha2luZ0ZlZXModGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTAKICAgIC8vIGNvbnN0IGZlZXMgPSBnZXRTdGFraW5nRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NzQKICAgIC8vIGNvbnN0IFtzdGFraW5nRmVlc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzKSkKICAgIGR1cAogICAgcHVzaGJ5dGVzICJzdGFraW5nX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyAzNSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5MgogICAgLy8gY29uc3QgeyBpbXBhY3Q6IGltcGFjdEFwcCB9ID0gZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpOwogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTQKICAgIC8vIGxldCBpbXBhY3Q6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5NQogICAgLy8gaWYgKGltcGFjdEFwcCAhPT0gMCkgewogICAgYnogY3JlYXRlX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5NgogICAgLy8gaW1wYWN0ID0gZ2V0VXNlckltcGFjdCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmNyZWF0b3IudmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzk2CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIHRoaXMuY3JlYXRvci52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0NwogICAgLy8gY3JlYXRvciA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlDcmVhdG9yIH0pCiAgICBieXRlYyAxMSAvLyAiY3JlYXRvciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTYKICAgIC8vIGltcGFjdCA9IGdldFVzZXJJbXBhY3QodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5jcmVhdG9yLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMyLTEzNQogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5nZXRVc2VySW1wYWN0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QoYWtpdGFEQU8pLmltcGFjdCwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgc3dhcAogICAgYnl0ZWMgMzUgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KGFraXRhREFPKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkNTc0YmIxMCAvLyBtZXRob2QgImdldFVzZXJJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgYnVyeSAxCgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5OAogICAgLy8gdGhpcy5ha2l0YVJveWFsdHkudmFsdWUgPSBpbXBhY3RSYW5nZShpbXBhY3QsIGZlZXMuaW1wYWN0VGF4TWluLCBmZWVzLmltcGFjdFRheE1heCkKICAgIGRpZyAxCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA0CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEyNwogICAgLy8gY29uc3QgbWluSW1wYWN0OiB1aW50NjQgPSAoaW1wYWN0ID4gMSkgPyBpbXBhY3QgLSAxIDogMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPgogICAgYnogY3JlYXRlX3Rlcm5hcnlfZmFsc2VANgogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgLQoKY3JlYXRlX3Rlcm5hcnlfbWVyZ2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTI4CiAgICAvLyByZXR1cm4gbWF4IC0gKCgobWF4IC0gbWluKSAqIG1pbkltcGFjdCkgLyBJTVBBQ1RfRElWSVNPUikKICAgIGRpZyA0CiAgICBkdXAKICAgIGRpZyA1CiAgICAtCiAgICB1bmNvdmVyIDIKICAgICoKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTUzCiAgICAvLyBha2l0YVJveWFsdHkgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBa2l0YVJveWFsdHkgfSkKICAgIGJ5dGVjIDI3IC8vICJha2l0YV9yb3lhbHR5IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5OAogICAgLy8gdGhpcy5ha2l0YVJveWFsdHkudmFsdWUgPSBpbXBhY3RSYW5nZShpbXBhY3QsIGZlZXMuaW1wYWN0VGF4TWluLCBmZWVzLmltcGFjdFRheE1heCkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzU4CiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKY3JlYXRlX3Rlcm5hcnlfZmFsc2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTI3CiAgICAvLyBjb25zdCBtaW5JbXBhY3Q6IHVpbnQ2NCA9IChpbXBhY3QgPiAxKSA/IGltcGFjdCAtIDEgOiAxCiAgICBpbnRjXzEgLy8gMQogICAgYiBjcmVhdGVfdGVybmFyeV9tZXJnZUA3CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pbml0W3JvdXRpbmddKCkgLT4gdm9pZDoKaW5pdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25BZGRyZXNzID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsICdvbmx5IHRoZSBmYWN0b3J5IGNhbiBpbml0IHRoZSBwb29sJykKICAgIGdsb2JhbCBDYWxsZXJBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGdsb2JhbCBDcmVhdG9yQWRkcmVzcwogICAgPT0KICAgIGFzc2VydCAvLyBvbmx5IHRoZSBmYWN0b3J5IGNhbiBpbml0IHRoZSBwb29sCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA0CiAgICAvLyBpZiAodGhpcy5nYXRlSUQudmFsdWUgPiAwKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDQKICAgIC8vIGlmICh0aGlzLmdhdGVJRC52YWx1ZSA+IDApIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBpbml0X2FmdGVyX2lmX2Vsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNS04MDgKICAgIC8vIHRoaXMuZ2F0ZVNpemUudmFsdWUgPSBhYmlDYWxsPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5zaXplPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ2F0ZSwKICAgIC8vICAgYXJnczogW3RoaXMuZ2F0ZUlELnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA2CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA2CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNgogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5nYXRlLAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNS04MDgKICAgIC8vIHRoaXMuZ2F0ZVNpemUudmFsdWUgPSBhYmlDYWxsPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5zaXplPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ2F0ZSwKICAgIC8vICAgYXJnczogW3RoaXMuZ2F0ZUlELnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkZjU2YjAxZSAvLyBtZXRob2QgInNpemUodWludDY0KXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ1CiAgICAvLyBnYXRlU2l6ZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUdhdGVTaXplIH0pCiAgICBwdXNoYnl0ZXMgImdhdGVfc2l6ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDUtODA4CiAgICAvLyB0aGlzLmdhdGVTaXplLnZhbHVlID0gYWJpQ2FsbDx0eXBlb2YgR2F0ZS5wcm90b3R5cGUuc2l6ZT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICAvLyAgIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKCmluaXRfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwMQogICAgLy8gaW5pdCgpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmRlbGV0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlbGV0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6ICdEZWxldGVBcHBsaWNhdGlvbicgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTQKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsICdjYWxsIG11c3QgY29tZSBmcm9tIGZhY3RvcnknKQogICAgdHhuIFNlbmRlcgogICAgZ2xvYmFsIENyZWF0b3JBZGRyZXNzCiAgICA9PQogICAgYXNzZXJ0IC8vIGNhbGwgbXVzdCBjb21lIGZyb20gZmFjdG9yeQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gYXNzZXJ0KGNhbGxlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZGVsZXRlIHRoZSBwb29sJykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBjcmVhdG9yID0gR2xvYmFsU3RhdGU8QWNjb3VudD4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUNyZWF0b3IgfSkKICAgIGJ5dGVjIDExIC8vICJjcmVhdG9yIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gYXNzZXJ0KGNhbGxlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZGVsZXRlIHRoZSBwb29sJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3IgY2FuIGRlbGV0ZSB0aGUgcG9vbAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KHRoaXMuc3RhdHVzLnZhbHVlID09PSBQb29sU3RhdHVzRHJhZnQgfHwgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBvciBlbmRlZCcpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODE2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCB8fCBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUsICd0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBibnogZGVsZXRlX2Jvb2xfdHJ1ZUAzCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNgogICAgLy8gZW5kVGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbmRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE2IC8vICJlbmRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KHRoaXMuc3RhdHVzLnZhbHVlID09PSBQb29sU3RhdHVzRHJhZnQgfHwgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBvciBlbmRlZCcpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPgogICAgYnogZGVsZXRlX2Jvb2xfZmFsc2VANAoKZGVsZXRlX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCmRlbGV0ZV9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODE2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCB8fCBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUsICd0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkJykKICAgIGFzc2VydCAvLyB0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODIwLTgyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7IGNsb3NlUmVtYWluZGVyVG86IEdsb2JhbC5jcmVhdG9yQWRkcmVzcyB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MjEKICAgIC8vIC5wYXltZW50KHsgY2xvc2VSZW1haW5kZXJUbzogR2xvYmFsLmNyZWF0b3JBZGRyZXNzIH0pCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgIGl0eG5fZmllbGQgQ2xvc2VSZW1haW5kZXJUbwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgyMC04MjEKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoeyBjbG9zZVJlbWFpbmRlclRvOiBHbG9iYWwuY3JlYXRvckFkZHJlc3MgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MjAtODIyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsgY2xvc2VSZW1haW5kZXJUbzogR2xvYmFsLmNyZWF0b3JBZGRyZXNzIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6ICdEZWxldGVBcHBsaWNhdGlvbicgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRlbGV0ZV9ib29sX2ZhbHNlQDQ6CiAgICBpbnRjXzAgLy8gMAogICAgYiBkZWxldGVfYm9vbF9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5vcHRJbltyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wdEluOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMgogICAgLy8gb3B0SW4ocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBhc3NldDogQXNzZXQpOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuY3JlYXRvci52YWx1ZSwgRVJSX0ZPUkJJRERFTikKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBjcmVhdG9yID0gR2xvYmFsU3RhdGU8QWNjb3VudD4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUNyZWF0b3IgfSkKICAgIGJ5dGVjIDExIC8vICJjcmVhdG9yIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuY3JlYXRvci52YWx1ZSwgRVJSX0ZPUkJJRERFTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIEZvcmJpZGRlbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzNQogICAgLy8gbGV0IG9wdGluTUJSOiB1aW50NjQgPSBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFzc2V0KSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFzc2V0KSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogb3B0SW5fYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBjb25zdCBjb3VudCA9IHNwbGl0T3B0SW5Db3VudCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsIGFzc2V0KQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOQogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBjb25zdCBjb3VudCA9IHNwbGl0T3B0SW5Db3VudCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsIGFzc2V0KQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGRpZyAzCiAgICBjYWxsc3ViIHNwbGl0T3B0SW5Db3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0MAogICAgLy8gb3B0aW5NQlIgKz0gR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogY291bnQKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgKgogICAgKwoKb3B0SW5fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0NS04NTIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogb3B0aW5NQlIgKyByZXdhcmRzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NDUtODUyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG9wdGluTUJSICsgcmV3YXJkc01CUiwKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHN3YXAKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICB1bmNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NDMKICAgIC8vIGNvbnN0IHJld2FyZHNNQlI6IHVpbnQ2NCA9IHRoaXMucmV3YXJkc01icihXaW5uZXJDb3VudENhcCkgKiAyCiAgICBwdXNoaW50IDgwMDAgLy8gODAwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODQ1LTg1MgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NC04NjAKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NgogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NTcKICAgIC8vIGFzc2V0QW1vdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NC04NTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODU0LTg2MAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgYnl0ZWMgMjAgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBkdXAKICAgIGJ5dGVjIDM2IC8vICJwYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4MwogICAgLy8gY29uc3QgeyByZXZlbnVlTWFuYWdlciB9ID0gZ2V0UGx1Z2luQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBleHRyYWN0IDggOAogICAgc3dhcAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBkaWcgMgogICAgYnl0ZWMgMjAgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjcwLTczCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogW1tuYW1lXV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4YTI0MDNkZGYgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MgogICAgLy8gYXJnczogW1tuYW1lXV0sCiAgICBwdXNoYnl0ZXMgMHgwMDAxMDAwMjAwMDg3MjY1NzY1ZjcwNmY2ZjZjCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MC03MwogICAgLy8gY29uc3QgZXNjcm93ID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEVzY3Jvd3M+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dLAogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDkgLy8gOQogICAgKgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LGJvb2wxKVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzUKICAgIC8vIGFzc2VydChlc2Nyb3cuaWQgIT09IDAsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBleHRyYWN0IDYgOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBlc2Nyb3cgZm9yIHRoaXMgb3BlcmF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4NTgyZmYzODIgLy8gbWV0aG9kICJhcmM1OF9yZWtleVRvUGx1Z2luKHVpbnQ2NCxib29sLHN0cmluZyx1aW50NjRbXSwodWludDY0LHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjkyCiAgICAvLyB0cnVlLAogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjkzCiAgICAvLyBuYW1lLAogICAgcHVzaGJ5dGVzIDB4MDAwODcyNjU3NjVmNzA2ZjZmNmMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5NAogICAgLy8gWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgcHVzaGJ5dGVzIDB4MDAwMTAwMDAwMDAwMDAwMDAwMDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5NQogICAgLy8gW10KICAgIGJ5dGVjIDUgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6ODgtOTcKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIFswXSwgLy8gYWxsIHRoZSBha2l0YSBlc2Nyb3dzIGhhdmUgbWV0aG9kIHJlc3RyaWN0aW9ucyB3aXRoIG9wdGluIGJlaW5nIGluZGV4IDAKICAgIC8vICAgICBbXQogICAgLy8gICBdLAogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEwMQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OTktMTAzCiAgICAvLyBjb25zdCBvcHRJbkNvdW50ID0gc3BsaXRPcHRJbkNvdW50KAogICAgLy8gICB0aGlzLmFraXRhREFPLnZhbHVlLAogICAgLy8gICB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0CiAgICAvLyApCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGRpZyA0CiAgICBjYWxsc3ViIHNwbGl0T3B0SW5Db3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA1CiAgICAvLyBjb25zdCBtYnJBbW91bnQ6IHVpbnQ2NCA9IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIG9wdEluQ291bnQKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExMy0xMTYKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogbWJyQW1vdW50CiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTAKICAgIC8vIHdhbGxldCwKICAgIGRpZyAxCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTIKICAgIC8vIFthc3NldC5pZF0sCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDEKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NjgzNWUzYmMgLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OTIKICAgIC8vIHRydWUsCiAgICBieXRlYyAxOSAvLyAweDgwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTIwCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3M+KHsgYXBwSWQ6IHdhbGxldCB9KQogICAgaXR4bl9uZXh0CiAgICBwdXNoYnl0ZXMgMHg2Y2MzZjYwNiAvLyBtZXRob2QgImFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzKCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTMyCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MzIKICAgIC8vIG9wdEluKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgYXNzZXQ6IEFzc2V0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRbcm91dGluZ10oKSAtPiB2b2lkOgphZGRSZXdhcmQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODY5CiAgICAvLyBhZGRSZXdhcmQocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCA0MSAvLyA0MQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkCiAgICBwb3AKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZEFzYVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmFkZFJld2FyZEFzYToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGFkZFJld2FyZEFzYShwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGFzc2V0WGZlcjogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNCAvLyBheGZlcgogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGF4ZmVyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCA0MSAvLyA0MQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTA5LTkxOQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIGFzc2V0WGZlciwKICAgIC8vICAgewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIHhmZXJBc3NldDogQXNzZXQocmV3YXJkLmFzc2V0KSwKICAgIC8vICAgICBhc3NldEFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW46IDAKICAgIC8vICAgICB9CiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1RSQU5TRkVSCiAgICAvLyApCiAgICBkaWcgMQogICAgZ3R4bnMgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkxMgogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkwOS05MTkKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBhc3NldFhmZXIsCiAgICAvLyAgIHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICB4ZmVyQXNzZXQ6IEFzc2V0KHJld2FyZC5hc3NldCksCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuOiAwCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgIGRpZyAyCiAgICBndHhucyBYZmVyQXNzZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MTMKICAgIC8vIHhmZXJBc3NldDogQXNzZXQocmV3YXJkLmFzc2V0KSwKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDktOTE5CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgYXNzZXRYZmVyLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBBc3NldChyZXdhcmQuYXNzZXQpLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbjogMAogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfVFJBTlNGRVIKICAgIC8vICkKICAgID09CiAgICAmJgogICAgdW5jb3ZlciAyCiAgICBndHhucyBBc3NldEFtb3VudAogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHRyYW5zZmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTIxCiAgICAvLyB0aGlzLmFkZFJld2FyZChwYXltZW50LCByZXdhcmQpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGFkZFJld2FyZEFzYShwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGFzc2V0WGZlcjogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZmluYWxpemVbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjQKICAgIC8vIGZpbmFsaXplKHNpZ251cFRpbWVzdGFtcDogdWludDY0LCBzdGFydFRpbWVzdGFtcDogdWludDY0LCBlbmRUaW1lc3RhbXA6IHVpbnQ2NCkgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wnKQogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjYKICAgIC8vIGFzc2VydCh0aGlzLnN0YXR1cy52YWx1ZSA9PT0gUG9vbFN0YXR1c0RyYWZ0LCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBzdGF0ZSB0byBmaW5hbGl6ZScpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCwgJ3RoZSBwb29sIG11c3QgYmUgaW4gZHJhZnQgc3RhdGUgdG8gZmluYWxpemUnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgPT0KICAgIGFzc2VydCAvLyB0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IHN0YXRlIHRvIGZpbmFsaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI4CiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIHx8IChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKSwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgID4KICAgIGJueiBmaW5hbGl6ZV9ib29sX3RydWVANAogICAgZGlnIDIKICAgIGJueiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyBhbGxvd0xhdGVTaWdudXBzID0gR2xvYmFsU3RhdGU8Ym9vbGVhbj4oeyBpbml0aWFsVmFsdWU6IGZhbHNlLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFsbG93TGF0ZVNpZ251cHMgfSkKICAgIGJ5dGVjIDE0IC8vICJhbGxvd19sYXRlX3NpZ251cHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI4CiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIHx8IChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDUKCmZpbmFsaXplX2Jvb2xfdHJ1ZUA0OgogICAgaW50Y18xIC8vIDEKCmZpbmFsaXplX2Jvb2xfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjctOTMwCiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHNpZ251cFRpbWVzdGFtcCA+IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgfHwgKHNpZ251cFRpbWVzdGFtcCA9PT0gMCAmJiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUpLAogICAgLy8gICAndGhlIHNpZ251cCByb3VuZCBtdXN0IGJlIHplcm8gYW5kIGxhdGUgc2lnbiB1cHMgYWxsb3dlZCBvciBpbiB0aGUgZnV0dXJlJwogICAgLy8gKQogICAgYXNzZXJ0IC8vIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBsYXRlIHNpZ24gdXBzIGFsbG93ZWQgb3IgaW4gdGhlIGZ1dHVyZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkzMy05MzQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID09PSAwIHx8CiAgICAvLyBzdGFydFRpbWVzdGFtcCA+IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBkaWcgMQogICAgYnogZmluYWxpemVfYm9vbF90cnVlQDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGRpZyAxCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTMzLTkzNAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPT09IDAgfHwKICAgIC8vIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGJ6IGZpbmFsaXplX2Jvb2xfZmFsc2VAOQoKZmluYWxpemVfYm9vbF90cnVlQDg6CiAgICBpbnRjXzEgLy8gMQoKZmluYWxpemVfYm9vbF9tZXJnZUAxMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzItOTM2CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHN0YXJ0VGltZXN0YW1wID09PSAwIHx8CiAgICAvLyAgIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgJ3RoZSBzdGFydGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgaW4gdGhlIGZ1dHVyZScKICAgIC8vICkKICAgIGFzc2VydCAvLyB0aGUgc3RhcnRpbmcgcm91bmQgbXVzdCBiZSB6ZXJvIG9yIGluIHRoZSBmdXR1cmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzgKICAgIC8vIGlmIChzdGFydFRpbWVzdGFtcCA9PT0gMCkgewogICAgZGlnIDEKICAgIGJueiBmaW5hbGl6ZV9hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTM5CiAgICAvLyBhc3NlcnQoc2lnbnVwVGltZXN0YW1wID09PSAwICYmIHRoaXMuYWxsb3dMYXRlU2lnbnVwcy52YWx1ZSwgJ2lmIHRoZSBzdGFydGluZyByb3VuZCBpcyB6ZXJvLCB0aGUgc2lnbnVwIHJvdW5kIG11c3QgYmUgemVybyBhbmQgYWxsb3dMYXRlU2lnbnVwcyBtdXN0IGJlIHRydWUnKQogICAgZGlnIDIKICAgIGJueiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkzOQogICAgLy8gYXNzZXJ0KHNpZ251cFRpbWVzdGFtcCA9PT0gMCAmJiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUsICdpZiB0aGUgc3RhcnRpbmcgcm91bmQgaXMgemVybywgdGhlIHNpZ251cCByb3VuZCBtdXN0IGJlIHplcm8gYW5kIGFsbG93TGF0ZVNpZ251cHMgbXVzdCBiZSB0cnVlJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0CiAgICBpbnRjXzEgLy8gMQoKZmluYWxpemVfYm9vbF9tZXJnZUAxNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzkKICAgIC8vIGFzc2VydChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlLCAnaWYgdGhlIHN0YXJ0aW5nIHJvdW5kIGlzIHplcm8sIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBhbGxvd0xhdGVTaWdudXBzIG11c3QgYmUgdHJ1ZScpCiAgICBhc3NlcnQgLy8gaWYgdGhlIHN0YXJ0aW5nIHJvdW5kIGlzIHplcm8sIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBhbGxvd0xhdGVTaWdudXBzIG11c3QgYmUgdHJ1ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk0MAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBidXJ5IDIKCmZpbmFsaXplX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTQ0CiAgICAvLyBlbmRUaW1lc3RhbXAgPT09IDAgfHwgZW5kVGltZXN0YW1wID4gKHN0YXJ0VGltZXN0YW1wICsgMTApLAogICAgZHVwCiAgICBieiBmaW5hbGl6ZV9ib29sX3RydWVAMTgKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCiAgICBkaWcgMQogICAgPAogICAgYnogZmluYWxpemVfYm9vbF9mYWxzZUAxOQoKZmluYWxpemVfYm9vbF90cnVlQDE4OgogICAgaW50Y18xIC8vIDEKCmZpbmFsaXplX2Jvb2xfbWVyZ2VAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTQzLTk0NgogICAgLy8gYXNzZXJ0KAogICAgLy8gICBlbmRUaW1lc3RhbXAgPT09IDAgfHwgZW5kVGltZXN0YW1wID4gKHN0YXJ0VGltZXN0YW1wICsgMTApLAogICAgLy8gICAndGhlIGVuZGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgYWZ0ZXIgdGhlIHN0YXJ0aW5nIHJvdW5kICsgMTAnCiAgICAvLyApCiAgICBhc3NlcnQgLy8gdGhlIGVuZGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgYWZ0ZXIgdGhlIHN0YXJ0aW5nIHJvdW5kICsgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NDgKICAgIC8vIHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlID0gc2lnbnVwVGltZXN0YW1wCiAgICBkaWcgMwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NDkKICAgIC8vIHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgPSBzdGFydFRpbWVzdGFtcAogICAgZGlnIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyBlbmRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVuZFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTYgLy8gImVuZF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTUwCiAgICAvLyB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSA9IGVuZFRpbWVzdGFtcAogICAgZGlnIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTEKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlID0gUG9vbFN0YXR1c0ZpbmFsCiAgICBieXRlYyA4IC8vIDB4MGEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI0CiAgICAvLyBmaW5hbGl6ZShzaWdudXBUaW1lc3RhbXA6IHVpbnQ2NCwgc3RhcnRUaW1lc3RhbXA6IHVpbnQ2NCwgZW5kVGltZXN0YW1wOiB1aW50NjQpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmZpbmFsaXplX2Jvb2xfZmFsc2VAMTk6CiAgICBpbnRjXzAgLy8gMAogICAgYiBmaW5hbGl6ZV9ib29sX21lcmdlQDIwCgpmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUAxNQoKZmluYWxpemVfYm9vbF9mYWxzZUA5OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUAxMAoKZmluYWxpemVfYm9vbF9mYWxzZUA1OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUA2CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlZEVudGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2F0ZWRFbnRlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTQtOTU4CiAgICAvLyBnYXRlZEVudGVyKAogICAgLy8gICBwYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICBlbnRyaWVzOiBTdGFrZUVudHJ5W10sCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTkKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk1OQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZEVudGVyX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnYXRlZEVudGVyX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIGFzc2VydCh0aGlzLmdhdGVJRC52YWx1ZSAhPT0gMCwgJ2dhdGUgaWQgaXMgbm90IHNldCcpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIGFzc2VydCh0aGlzLmdhdGVJRC52YWx1ZSAhPT0gMCwgJ2dhdGUgaWQgaXMgbm90IHNldCcpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhc3NlcnQgLy8gZ2F0ZSBpZCBpcyBub3Qgc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDYKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDQKICAgIHVuY292ZXIgNAogICAgY2FsbHN1YiBnYXRlQ2hlY2sKICAgIGFzc2VydCAvLyBHYXRlIGNoZWNrIGZhaWxlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzkKICAgIC8vIHJldHVybiBkZWNvZGVBcmM0PEdhdGVBcmdzPihnYXRlVHhuLmFwcEFyZ3MoMykpCiAgICBwdXNoaW50IDMgLy8gMwogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjUKICAgIC8vIHRoaXMuY3JlYXRlUG9vbEVudHJpZXMocGF5bWVudCwgZW50cmllcywgZ2V0R2F0ZUFyZ3MoZ2F0ZVR4bikpCiAgICBkaWcgNQogICAgZGlnIDQKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBjcmVhdGVQb29sRW50cmllcwogICAgcG9wbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTU0LTk1OAogICAgLy8gZ2F0ZWRFbnRlcigKICAgIC8vICAgcGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBnYXRlVHhuOiBndHhuLkFwcGxpY2F0aW9uQ2FsbFR4biwKICAgIC8vICAgZW50cmllczogU3Rha2VFbnRyeVtdLAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnYXRlZEVudGVyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyAzNyAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgZ2F0ZWRFbnRlcl9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmVudGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKZW50ZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTY4CiAgICAvLyBlbnRlcihwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGVudHJpZXM6IFN0YWtlRW50cnlbXSk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTY5CiAgICAvLyB0aGlzLmNyZWF0ZVBvb2xFbnRyaWVzKHBheW1lbnQsIGVudHJpZXMsIFtdKQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGNhbGxzdWIgY3JlYXRlUG9vbEVudHJpZXMKICAgIHBvcG4gMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk2OAogICAgLy8gZW50ZXIocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBlbnRyaWVzOiBTdGFrZUVudHJ5W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnN0YXJ0RGlzYnVyc2VtZW50W3JvdXRpbmddKCkgLT4gdm9pZDoKc3RhcnREaXNidXJzZW1lbnQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTcyCiAgICAvLyBzdGFydERpc2J1cnNlbWVudChyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk3MwogICAgLy8gYXNzZXJ0KHRoaXMuaXNMaXZlKCksICd0aGUgcG9vbCBpcyBub3QgbGl2ZScpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlCiAgICBhc3NlcnQgLy8gdGhlIHBvb2wgaXMgbm90IGxpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyByZXdhcmQgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzYKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGludGVydmFsLCBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wLCBleHBpcmF0aW9uIH0gPSB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZGlnIDEKICAgIHB1c2hpbnQgMjUgLy8gMjUKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDIKICAgIHB1c2hpbnQgMTE2IC8vIDExNgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMTcgLy8gMTcKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgY292ZXIgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk3OAogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUlkbGUsICdyZXdhcmQgaXMgYWxyZWFkeSBpbiBhIGRpc2J1cnNlbWVudCBwaGFzZScpCiAgICBzd2FwCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBhc3NlcnQgLy8gcmV3YXJkIGlzIGFscmVhZHkgaW4gYSBkaXNidXJzZW1lbnQgcGhhc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzAKICAgIC8vIHJldHVybiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJSBpbnRlcnZhbCkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzAKICAgIC8vIHJldHVybiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJSBpbnRlcnZhbCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAtCiAgICB1bmNvdmVyIDIKICAgICUKICAgIC0KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUzNQogICAgLy8gcmV0dXJuIGxhdGVzdFdpbmRvd1N0YXJ0ICE9PSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wICYmIGxhc3QgPCBsYXRlc3RXaW5kb3dTdGFydAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgIT0KICAgIGJ6IHN0YXJ0RGlzYnVyc2VtZW50X2Jvb2xfZmFsc2VANAogICAgZGlnIDIKICAgIGRpZyAxCiAgICA8CiAgICBieiBzdGFydERpc2J1cnNlbWVudF9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpzdGFydERpc2J1cnNlbWVudF9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTc5CiAgICAvLyBhc3NlcnQodGhpcy52YWxpZFdpbmRvdyhpbnRlcnZhbCwgbGFzdERpc2J1cnNlbWVudFRpbWVzdGFtcCksICdkaXN0cmlidXRpb24gd2luZG93IGlzIG5vdCBvcGVuJykKICAgIGFzc2VydCAvLyBkaXN0cmlidXRpb24gd2luZG93IGlzIG5vdCBvcGVuCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTgyCiAgICAvLyBgJHt0aGlzLnRpdGxlLnZhbHVlfSAtIFJld2FyZHNgLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTYKICAgIC8vIHRpdGxlID0gR2xvYmFsU3RhdGU8c3RyaW5nPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VGl0bGUgfSkKICAgIGJ5dGVjIDI4IC8vICJ0aXRsZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODIKICAgIC8vIGAke3RoaXMudGl0bGUudmFsdWV9IC0gUmV3YXJkc2AsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICIgLSBSZXdhcmRzIgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTg0CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wICsgZXhwaXJhdGlvbgogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDMKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTcKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkucmV3YXJkcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTcKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkucmV3YXJkcykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTU3CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTgKICAgIC8vIGNvbnN0IHJld2FyZE1CUjogdWludDY0ID0gTWluRGlzYnVyc2VtZW50c01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIEJ5dGVzKHRpdGxlKS5sZW5ndGgpCiAgICBkaWcgMgogICAgbGVuCiAgICBpbnRjIDYgLy8gNDAwCiAgICBkaWcgMQogICAgKgogICAgcHVzaGludCAzNTMwMCAvLyAzNTMwMAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2MQogICAgLy8gcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIGRpZyAyCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTY1LTU3NAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZURpc2J1cnNlbWVudD4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG1iclBheW1lbnQsCiAgICAvLyAgICAgdGl0bGUsCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgJycsCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NjAtNTYzCiAgICAvLyBjb25zdCBtYnJQYXltZW50ID0gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiByZXdhcmRNQlIsCiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2NS01NzQKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHRpdGxlLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgICcnLAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2OQogICAgLy8gdGl0bGUsCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODMKICAgIC8vIDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU3MAogICAgLy8gdGltZVRvVW5sb2NrLAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU3MQogICAgLy8gZXhwaXJhdGlvbiwKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2NS01NzQKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHRpdGxlLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgICcnLAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDFjOWViYmRiIC8vIG1ldGhvZCAiY3JlYXRlRGlzYnVyc2VtZW50KHBheSxzdHJpbmcsdWludDY0LHVpbnQ2NCxzdHJpbmcpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NzIKICAgIC8vICcnLAogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NjUtNTc0CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgbWJyUGF5bWVudCwKICAgIC8vICAgICB0aXRsZSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICAnJywKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZ2l0eG4gMSBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTg3CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlcnMgPSAwCiAgICBkaWcgNQogICAgZHVwCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICBkaWcgNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODgKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucXVhbGlmaWVkU3Rha2UgPSAwCiAgICBkdXAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHVuY292ZXIgNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODkKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjIDggLy8gMHgwYQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50SUQgPSBkaXNidXJzZW1lbnRJRAogICAgZHVwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIHVuY292ZXIgMwogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCA9IEdsb2JhbC5yb3VuZAogICAgZ2xvYmFsIFJvdW5kCiAgICBpdG9iCiAgICBkaWcgMQogICAgcHVzaGludCAxMDggLy8gMTA4CiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTkzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpdG9iCiAgICBwdXNoaW50IDExNiAvLyAxMTYKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTcyCiAgICAvLyBzdGFydERpc2J1cnNlbWVudChyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpzdGFydERpc2J1cnNlbWVudF9ib29sX2ZhbHNlQDQ6CiAgICBpbnRjXzAgLy8gMAogICAgYiBzdGFydERpc2J1cnNlbWVudF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJhZmZsZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTYKICAgIC8vIHJhZmZsZShyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZGlzYnVyc2VSZXdhcmRzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGlzYnVyc2VSZXdhcmRzOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gMgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDI3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzNgogICAgLy8gZGlzYnVyc2VSZXdhcmRzKHJld2FyZElEOiB1aW50NjQsIGl0ZXJhdGlvbkFtb3VudDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzgKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDM4CiAgICAvLyBhc3NlcnQodGhpcy5yZXdhcmRzKHJld2FyZElEKS5leGlzdHMsICdyZXdhcmQgZG9lcyBub3QgZXhpc3QnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA0MAogICAgLy8gY29uc3QgeyBwaGFzZSwgZGlzdHJpYnV0aW9uLCB3aW5uaW5nVGlja2V0cyB9ID0gY2xvbmUodGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZSkKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNDMKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICBieXRlYyA4IC8vIDB4MGEKICAgID09CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQzLTEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyBwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uLAogICAgYm56IGRpc2J1cnNlUmV3YXJkc19ib29sX3RydWVAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sCiAgICBkaWcgMgogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQzLTEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyBwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uLAogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jvb2xfZmFsc2VANAoKZGlzYnVyc2VSZXdhcmRzX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCmRpc2J1cnNlUmV3YXJkc19ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA0Mi0xMDQ2CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyAgIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sCiAgICAvLyAgIEVSUl9OT1RfUkVBRFlfVE9fRElTQlVSU0UKICAgIC8vICkKICAgIGFzc2VydCAvLyBOb3QgcmVhZHkgdG8gZGlzYnVyc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQ4CiAgICAvLyBpZiAocGhhc2UgPT09IERpc2J1cnNlbWVudFBoYXNlUHJlcGFyYXRpb24pIHsKICAgIGR1cAogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI0CiAgICAvLyBjb25zdCB7IGRpc2J1cnNlbWVudEN1cnNvciwgZGlzdHJpYnV0aW9uLCByYXRlLCBhc3NldCB9ID0gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZQogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAyMwogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDQKICAgIGR1cAogICAgcHVzaGludCA5IC8vIDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAyOQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyNQogICAgLy8gbGV0IGNvdW50OiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSAyNwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gbGV0IHRvdGFsOiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDIxCiAgICAtCiAgICBidXJ5IDUKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDEwMDoKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEAxMDE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDIwCiAgICBkaWcgNQogICAgKwogICAgZGlnIDE4CiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfd2hpbGVAMTEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgcXVhbnRpdHkgfSA9IGNsb25lKHRoaXMuZW50cmllcyhpZCkudmFsdWUpCiAgICBkaWcgMTcKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBidXJ5IDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgcXVhbnRpdHkgfSA9IGNsb25lKHRoaXMuZW50cmllcyhpZCkudmFsdWUpCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjM0CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUzOQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfTk9ORSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzkKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX05PTkUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEwNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0MAogICAgLy8gcmV0dXJuIHsgdmFsaWQ6IHRydWUsIGJhbGFuY2U6IDAgfQogICAgcHVzaGJ5dGVzIDB4ODAwMDAwMDAwMDAwMDAwMDAwCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmdldFN0YWtlVmFsdWVAMTA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIzOQogICAgLy8gY29uc3QgeyB2YWxpZCB9ID0gdGhpcy5nZXRTdGFrZVZhbHVlKGlkKQogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0MAogICAgLy8gaWYgKCF2YWxpZCkgewogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gY291bnQgKz0gMQogICAgZGlnIDI1CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0NQogICAgLy8gdG90YWwgKz0gcXVhbnRpdHkKICAgIGRpZyA3CiAgICBkaWcgMTQKICAgICsKICAgIGJ1cnkgOAoKZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMzIKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMTcKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDE4CiAgICBiIGRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAMTAxCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEwNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDEKICAgIC8vIH0gZWxzZSBpZiAodGhpcy50eXBlLnZhbHVlID09PSBQT09MX1NUQUtJTkdfVFlQRV9IRUFSVEJFQVQpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQxCiAgICAvLyB9IGVsc2UgaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICA9PQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQyCiAgICAvLyBjb25zdCB7IGFkZHJlc3MsIGFzc2V0IH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkaWcgMzQKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDQtNTQ3CiAgICAvLyBjb25zdCBhdmcgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRIZWFydGJlYXRBdmVyYWdlPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogW2FkZHJlc3MsIGFzc2V0LCB0cnVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0NQogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ0LTU0NwogICAgLy8gY29uc3QgYXZnID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0SGVhcnRiZWF0QXZlcmFnZT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzLCBhc3NldCwgdHJ1ZV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YzZmZDBjYTUgLy8gbWV0aG9kICJnZXRIZWFydGJlYXRBdmVyYWdlKGFkZHJlc3MsdWludDY0LGJvb2wpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ2CiAgICAvLyBhcmdzOiBbYWRkcmVzcywgYXNzZXQsIHRydWVdLAogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0NC01NDcKICAgIC8vIGNvbnN0IGF2ZyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEhlYXJ0YmVhdEF2ZXJhZ2U+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbYWRkcmVzcywgYXNzZXQsIHRydWVdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDkKICAgIC8vIHJldHVybiB7IHZhbGlkOiB0cnVlLCBiYWxhbmNlOiBhdmcgfQogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjM5CiAgICAvLyBjb25zdCB7IHZhbGlkIH0gPSB0aGlzLmdldFN0YWtlVmFsdWUoaWQpCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2V0U3Rha2VWYWx1ZUAxMDkKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDEwODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTIKICAgIC8vIHJldHVybiB0aGlzLmNoZWNrQnlJRChpZCkKICAgIGRpZyAxNwogICAgY2FsbHN1YiBjaGVja0J5SUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMzkKICAgIC8vIGNvbnN0IHsgdmFsaWQgfSA9IHRoaXMuZ2V0U3Rha2VWYWx1ZShpZCkKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nZXRTdGFrZVZhbHVlQDEwOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDExMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNDgKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucXVhbGlmaWVkU3Rha2VycyArPSBjb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMjcKICAgICsKICAgIGl0b2IKICAgIGRpZyAxCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjQ5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlICs9IHRvdGFsCiAgICBkdXAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDkKICAgICsKICAgIGl0b2IKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjUxCiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBkaXNidXJzZW1lbnRDdXJzb3IpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNTEKICAgIC8vIGlmICh0aGlzLmVudHJ5SUQudmFsdWUgPT09IGRpc2J1cnNlbWVudEN1cnNvcikgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMTIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjUzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDkxIC8vIDkxCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI1NAogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSAxIC8vIEVudHJ5IElEcyBzdGFydCBhdCAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI1NgogICAgLy8gdGhpcy5wYXlBa2l0YVJveWFsdHkoZGlzdHJpYnV0aW9uLCByYXRlLCBhc3NldCwgdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5xdWFsaWZpZWRTdGFrZXJzKQogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTg5CiAgICAvLyBpZiAoZGlzdHJpYnV0aW9uID09PSBEaXN0cmlidXRpb25UeXBlRmxhdCkgewogICAgZGlnIDEKICAgIGJ5dGVjIDEyIC8vIDB4MTQKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDExNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE5MAogICAgLy8gYW1vdW50ID0gY2FsY1BlcmNlbnQoKHF1YWxpZmllZFN0YWtlcnMgKiByYXRlKSwgdGhpcy5ha2l0YVJveWFsdHkudmFsdWUpCiAgICBkaWcgMTQKICAgIGRpZyAxMwogICAgKgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBhbW91bnQgPSBjYWxjUGVyY2VudCgocXVhbGlmaWVkU3Rha2VycyAqIHJhdGUpLCB0aGlzLmFraXRhUm95YWx0eS52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGR1cAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgYnVyeSAyOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTk1CiAgICAvLyB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZSA9IGFtb3VudAogICAgZGlnIDI5CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTk4CiAgICAvLyBpZiAoYW1vdW50ID09PSAwKSB7CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBkaWcgMjcKICAgIGJueiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIwNC0yMDkKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50LAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDYKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjA2CiAgICAvLyByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGRpZyAyOQogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDQtMjA4CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudCwKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDQtMjA5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudCwKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzNgogICAgLy8gZGlzYnVyc2VSZXdhcmRzKHJld2FyZElEOiB1aW50NjQsIGl0ZXJhdGlvbkFtb3VudDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMTEKICAgIC8vIGFzc2VydCh0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKEFzc2V0KGFzc2V0KSksIEVSUl9EQU9fTk9UX09QVEVEX0lOKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxMQogICAgLy8gYXNzZXJ0KHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcy5pc09wdGVkSW4oQXNzZXQoYXNzZXQpKSwgRVJSX0RBT19OT1RfT1BURURfSU4pCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBkaWcgMjkKICAgIGR1cAogICAgY292ZXIgMgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBEQU8gbm90IG9wdGVkIGluIHRvIHRoZSBhc3NldAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxMy0yMTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50LAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxNQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgc3dhcAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgc3dhcAogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGRpZyAyOQogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgaXR4bl9maWVsZCBBc3NldFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjEzLTIxOAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBhbW91bnQsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMTMtMjE5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGFtb3VudCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0LAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDExNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIGFtb3VudCA9IGNhbGNQZXJjZW50KHJhdGUsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTkyCiAgICAvLyBhbW91bnQgPSBjYWxjUGVyY2VudChyYXRlLCB0aGlzLmFraXRhUm95YWx0eS52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGR1cAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgZGlnIDEzCiAgICBtdWx3CiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBkaXZ3CiAgICBidXJ5IDI5CiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDExNwoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUAxMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjU5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciArPSBpdGVyYXRpb25BbW91bnQKICAgIGRpZyAzCiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgNgogICAgKwogICAgaXRvYgogICAgaW50Y18zIC8vIDkyCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNTIKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6IHsKICAgIGRpZyAxCiAgICBieXRlYyA4IC8vIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA1Mi0xMDU1CiAgICAvLyBjYXNlIERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOiB7CiAgICAvLyAgIHRoaXMuY3JlYXRlUGVyY2VudGFnZURpc2J1cnNlbWVudChyZXdhcmRJRCwgaXRlcmF0aW9uQW1vdW50KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNzAKICAgIC8vIH0gPSB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlCiAgICBkaWcgMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDI5CiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGJ1cnkgMjMKICAgIGR1cAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMzYKICAgIGR1cAogICAgcHVzaGludCA0MSAvLyA0MQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE4CiAgICBwdXNoaW50IDkgLy8gOQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA4OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI3MwogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjczCiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMjEKICAgIC0KICAgIGJ1cnkgNQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAODg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc2CiAgICAvLyBjb25zdCBhY3R1YWxBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHRoaXMuYWtpdGFSb3lhbHR5QW1vdW50LnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc2CiAgICAvLyBjb25zdCBhY3R1YWxBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHRoaXMuYWtpdGFSb3lhbHR5QW1vdW50LnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDI5CiAgICBzd2FwCiAgICAtCiAgICBidXJ5IDMzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc3CiAgICAvLyBsZXQgYWxsb2NhdGlvbnM6IFVzZXJBbGxvY2F0aW9uW10gPSBbXQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNzgKICAgIC8vIGxldCBzdW06IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDExCiAgICBkaWcgMjAKICAgIGJ1cnkgMTgKCmRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAODk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjgwCiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDIwCiAgICBkaWcgNQogICAgKwogICAgZHVwCiAgICBidXJ5IDEwCiAgICBkaWcgMTgKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl93aGlsZUA5NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgeyBkaXNxdWFsaWZpZWQsIHF1YW50aXR5LCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkaWcgMTcKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgeyBkaXNxdWFsaWZpZWQsIHF1YW50aXR5LCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNgogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjgyCiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDkzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwOQogICAgLy8gYXNzZXJ0KGEgPCBiLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFX09GX0FSR1MpCiAgICBkaWcgMTMKICAgIGR1cAogICAgZGlnIDE3CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIDwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2Ugb2YgYXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgRElWSVNPUiksIGIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBtdWx3CiAgICB1bmNvdmVyIDIKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMzMKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODcKICAgIC8vIGFsbG9jYXRpb25zLnB1c2goeyBhZGRyZXNzLCBhbW91bnQ6IGluZGl2aWR1YWxBbW91bnQgfSkKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDM4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNwogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODgKICAgIC8vIHN1bSArPSBpbmRpdmlkdWFsQW1vdW50CiAgICBkaWcgMTEKICAgICsKICAgIGJ1cnkgMTEKCmRpc2J1cnNlUmV3YXJkc19ibG9ja0A5MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODAKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMTcKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDE4CiAgICBiIGRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAODkKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl93aGlsZUA5NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyOTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yICs9IGl0ZXJhdGlvbkFtb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA2CiAgICArCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjkzCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI4CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk1CiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gaWYgKHRoaXMuZW50cnlJRC52YWx1ZSA9PT0gKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgOQogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk2CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24KICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk3CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IDEgLy8gRW50cnkgSURzIHN0YXJ0IGF0IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDU2CiAgICAvLyBjYXNlIERpc3RyaWJ1dGlvblR5cGVGbGF0OiB7CiAgICBkaWcgMQogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDU2LTEwNTkKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZUZsYXQ6IHsKICAgIC8vICAgdGhpcy5jcmVhdGVGbGF0RGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxNQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMwOAogICAgLy8gfSA9IHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUKICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTAwIC8vIDEwMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDM1CiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDIyCiAgICBkdXAKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDEKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIHVuY292ZXIgMgogICAgaW50Y18wIC8vIDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDMxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gKHF1YWxpZmllZFN0YWtlcnMgKiBhbW91bnQpIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIHN3YXAKICAgIGRpZyAxCiAgICAqCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gKHF1YWxpZmllZFN0YWtlcnMgKiBhbW91bnQpIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAtCiAgICBidXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCBwZXJjZW50YWdlQWtpdGFGZWUgPSBjYWxjUGVyY2VudChhbW91bnQsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCBwZXJjZW50YWdlQWtpdGFGZWUgPSBjYWxjUGVyY2VudChhbW91bnQsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxMgogICAgLy8gY29uc3QgYWRqdXN0ZWRBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHBlcmNlbnRhZ2VBa2l0YUZlZQogICAgLQogICAgYnVyeSAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxNgogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3MwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxNwogICAgLy8gYmFsYW5jZSA9IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANzQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBhc3NlcnQoYmFsYW5jZSA+PSB0b3RhbCwgRVJSX05PVF9FTk9VR0hfRlVORFMpCiAgICBkaWcgOAogICAgPj0KICAgIGFzc2VydCAvLyBOb3QgZW5vdWdoIGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzI0CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjQKICAgIC8vIGlmICgoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSA+IHRoaXMuZW50cnlJRC52YWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDc2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzI1CiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjUKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgLQogICAgYnVyeSA1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA3NjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjgKICAgIC8vIGxldCBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSA9IFtdCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgYnVyeSAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMyOQogICAgLy8gbGV0IHN1bTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTEKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA3NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMzEKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTgKICAgIGRpZyAxOAogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDgyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzMyCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcyB9ID0gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZQogICAgZGlnIDE3CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMzIKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzMzCiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDgxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzM3CiAgICAvLyBhbGxvY2F0aW9ucy5wdXNoKHsgYWRkcmVzcywgYW1vdW50OiBhZGp1c3RlZEFtb3VudCB9KQogICAgZGlnIDMwCiAgICBkdXAKICAgIGl0b2IKICAgIGRpZyAzOAogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMzcKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQgLy8gb24gZXJyb3I6IG1heCBhcnJheSBsZW5ndGggZXhjZWVkZWQKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICByZXBsYWNlMiAwCiAgICBidXJ5IDM3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzM4CiAgICAvLyBzdW0gKz0gYWRqdXN0ZWRBbW91bnQKICAgIGRpZyAxMQogICAgKwogICAgYnVyeSAxMQoKZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDgxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMzMQogICAgLy8gZm9yIChsZXQgaWQgPSBkaXNidXJzZW1lbnRDdXJzb3I7IGlkIDwgKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCk7IGlkKyspIHsKICAgIGRpZyAxNwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTgKICAgIGIgZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA3NwoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDgyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0MgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSBuZXdDdXJzb3JWYWx1ZQogICAgZGlnIDE2CiAgICBkdXAKICAgIGl0b2IKICAgIGRpZyA1CiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNDMKICAgIC8vIHRoaXMuY3JlYXRlUmV3YXJkQWxsb2NhdGlvbnMoYWN0aXZlRGlzYnVyc2VtZW50SUQsIGFzc2V0LCBhbGxvY2F0aW9ucywgc3VtKQogICAgZGlnIDM0CiAgICBkaWcgMjkKICAgIGRpZyAzOAogICAgZGlnIDE0CiAgICBjYWxsc3ViIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNDUKICAgIC8vIGlmICh0aGlzLmVudHJ5SUQudmFsdWUgPT09IG5ld0N1cnNvclZhbHVlKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzQ1CiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBuZXdDdXJzb3JWYWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0NgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5waGFzZSA9IERpc2J1cnNlbWVudFBoYXNlRmluYWxpemF0aW9uCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDkxIC8vIDkxCiAgICBieXRlYyAxMyAvLyAweDFlCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0NwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSAxIC8vIEVudHJ5IElEcyBzdGFydCBhdCAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgaW50Y18zIC8vIDkyCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMTkKICAgIC8vIFtiYWxhbmNlXSA9IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2UoR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsIGFzc2V0KQogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGRpZyAyOAogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBwb3AKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANzQKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDE1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjAKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZUV2ZW46IHsKICAgIGRpZyAxCiAgICBieXRlYyAxMyAvLyAweDFlCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2MwogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlRXZlbjogewogICAgLy8gICB0aGlzLmNyZWF0ZUV2ZW5EaXNidXJzZW1lbnQocmV3YXJkSUQsIGl0ZXJhdGlvbkFtb3VudCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzU4CiAgICAvLyB9ID0gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZQogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMzUKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjIKICAgIGR1cAogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE2CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAzMAogICAgcHVzaGludCA5IC8vIDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA1OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2MwogICAgLy8gYmFsYW5jZSA9IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzY3CiAgICAvLyBjb25zdCBhY3R1YWxTdW06IHVpbnQ2NCA9IHJhdGUgLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGFraXRhUm95YWx0eUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eUFtb3VudCB9KQogICAgYnl0ZWMgMjIgLy8gImFraXRhX3JveWFsdHlfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2NwogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSByYXRlIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMTQKICAgIHN3YXAKICAgIC0KICAgIGR1cAogICAgYnVyeSAzNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2OAogICAgLy8gYXNzZXJ0KGJhbGFuY2UgPj0gYWN0dWFsU3VtLCBFUlJfTk9UX0VOT1VHSF9GVU5EUykKICAgID49CiAgICBhc3NlcnQgLy8gTm90IGVub3VnaCBmdW5kcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM3MAogICAgLy8gaWYgKChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpID4gdGhpcy5lbnRyeUlELnZhbHVlKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzcwCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA2MQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM3MQogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzcxCiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMjEKICAgIC0KICAgIGJ1cnkgNQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc0CiAgICAvLyBjb25zdCBhbW91bnQ6IHVpbnQ2NCA9IGFjdHVhbFN1bSAvIHF1YWxpZmllZFN0YWtlcnMKICAgIGRpZyAzMQogICAgZGlnIDE1CiAgICAvCiAgICBidXJ5IDI5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc1CiAgICAvLyBsZXQgYWxsb2NhdGlvbnM6IFVzZXJBbGxvY2F0aW9uW10gPSBbXQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzYKICAgIC8vIGxldCBhbGxvY1N1bTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMzAKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA2MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzcKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTgKICAgIGRpZyAxOAogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDY3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc4CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcyB9ID0gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZQogICAgZGlnIDE3CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzgKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc5CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDY2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzgzCiAgICAvLyBhbGxvY2F0aW9ucy5wdXNoKHsgYWRkcmVzcywgYW1vdW50IH0pCiAgICBkaWcgMjgKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDM4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNwogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozODQKICAgIC8vIGFsbG9jU3VtICs9IGFtb3VudAogICAgZGlnIDMwCiAgICArCiAgICBidXJ5IDMwCgpkaXNidXJzZVJld2FyZHNfYmxvY2tANjY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc3CiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDE3CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAxOAogICAgYiBkaXNidXJzZVJld2FyZHNfd2hpbGVfdG9wQDYyCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfd2hpbGVANjc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzg4CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IG5ld0N1cnNvclZhbHVlCiAgICBkaWcgMTYKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDUKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM4OQogICAgLy8gdGhpcy5jcmVhdGVSZXdhcmRBbGxvY2F0aW9ucyhhY3RpdmVEaXNidXJzZW1lbnRJRCwgYXNzZXQsIGFsbG9jYXRpb25zLCBhbGxvY1N1bSkKICAgIGRpZyAzNAogICAgZGlnIDI5CiAgICBkaWcgMzgKICAgIGRpZyAzMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzkxCiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBuZXdDdXJzb3JWYWx1ZSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM5MQogICAgLy8gaWYgKHRoaXMuZW50cnlJRC52YWx1ZSA9PT0gbmV3Q3Vyc29yVmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozOTIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZUZpbmFsaXphdGlvbgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgYnl0ZWMgMTMgLy8gMHgxZQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozOTMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGludGNfMyAvLyA5MgogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzAKCmRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlANTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzY1CiAgICAvLyBiYWxhbmNlID0gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywgYXNzZXQpWzBdCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDI4CiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA1OQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA2NAogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlU2h1ZmZsZTogewogICAgZGlnIDEKICAgIHB1c2hieXRlcyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjQtMTA3MQogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlU2h1ZmZsZTogewogICAgLy8gICBpZiAod2lubmluZ1RpY2tldHMubGVuZ3RoID09PSAwKSB7CiAgICAvLyAgICAgdGhpcy5yYWZmbGUocmV3YXJkSUQpCiAgICAvLyAgIH0gZWxzZSB7CiAgICAvLyAgICAgdGhpcy5jcmVhdGVTaHVmZmxlRGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICAvLyAgIH0KICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGFzc2VydCAvLyB1bmtub3duIHJld2FyZCByYXRlIHR5cGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDY1CiAgICAvLyBpZiAod2lubmluZ1RpY2tldHMubGVuZ3RoID09PSAwKSB7CiAgICBkaWcgMwogICAgcHVzaGludHMgMTI0IDIgLy8gMTI0LCAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYm56IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDY2CiAgICAvLyB0aGlzLnJhZmZsZShyZXdhcmRJRCkKICAgIGRpZyA1CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDI0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQwNgogICAgLy8gfSA9IGNsb25lKHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUpCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAzNQogICAgZHVwCiAgICBpbnRjXzMgLy8gOTIKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAyMgogICAgZHVwCiAgICBwdXNoaW50IDQ5IC8vIDQ5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGJ1cnkgMzAKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTAKICAgIC8vIGlmIChhc3NldCA9PT0gMCkgewogICAgYm56IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTEKICAgIC8vIGJhbGFuY2UgPSBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcy5iYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RCYWxhbmNlCiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQxNQogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSBzdW0gLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGFraXRhUm95YWx0eUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eUFtb3VudCB9KQogICAgYnl0ZWMgMjIgLy8gImFraXRhX3JveWFsdHlfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQxNQogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSBzdW0gLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAxMgogICAgc3dhcAogICAgLQogICAgZHVwCiAgICBidXJ5IDM0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE2CiAgICAvLyBhc3NlcnQoYmFsYW5jZSA+PSBhY3R1YWxTdW0sIEVSUl9OT1RfRU5PVUdIX0ZVTkRTKQogICAgPj0KICAgIGFzc2VydCAvLyBOb3QgZW5vdWdoIGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTgKICAgIC8vIGlmICgoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSA+IHRoaXMuZW50cnlJRC52YWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE5CiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTkKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgLQogICAgYnVyeSA1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MjMKICAgIC8vIGlmICh3aW5uZXJDb3VudCA+IDApIHsKICAgIGRpZyA2CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2lmX2JvZHlAMzcKICAgIGRpZyAzMQogICAgYnVyeSAyOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDI3CiAgICAvLyBsZXQgeyBzdGFrZSwgdGlja2V0LCBkaXNidXJzZWQgfSA9IHJhZmZsZUN1cnNvcgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludHMgNTkgMjQgLy8gNTksIDI0CiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMwogICAgYnVyeSAxNQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxNAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIGxldCBjdXJyZW50VGlja2V0ID0gdGlja2V0c1t0aWNrZXRdCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgcHVzaGludCAxMjYgLy8gMTI2CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MzIKICAgIC8vIGxldCBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSA9IFtdCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgYnVyeSAzNwogICAgYnVyeSAyNAogICAgZGlnIDIwCiAgICBidXJ5IDE5CgpkaXNidXJzZVJld2FyZHNfd2hpbGVfdG9wQDM5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzMwogICAgLy8gZm9yIChsZXQgaSA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaSA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpKyspIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGRpZyAxOQogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDUxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM0CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaSkudmFsdWUKICAgIGRpZyAxOAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2MgogICAgLy8gZW50cmllcyA9IEJveE1hcDx1aW50NjQsIEVudHJ5RGF0YT4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzIH0pCiAgICBieXRlYyA5IC8vICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM0CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaSkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBidXJ5IDIxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBib3hfZXh0cmFjdAogICAgYnVyeSAzOAogICAgZHVwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjgKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzNgogICAgLy8gY3VycmVudFJhbmdlRW5kID0gY3VycmVudFJhbmdlU3RhcnQgKyBxdWFudGl0eQogICAgZGlnIDI0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgKwogICAgYnVyeSAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gaWYgKGN1cnJlbnRUaWNrZXQgPj0gY3VycmVudFJhbmdlU3RhcnQgJiYgY3VycmVudFRpY2tldCA8PSBjdXJyZW50UmFuZ2VFbmQpIHsKICAgIGRpZyAyMwogICAgPD0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDQ5CiAgICBkaWcgMjIKICAgIGRpZyAyNQogICAgPD0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDQ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM4CiAgICAvLyBpZiAoIWRpc3F1YWxpZmllZCkgewogICAgZGlnIDE5CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MzkKICAgIC8vIGFsbG9jYXRpb25zLnB1c2goeyBhZGRyZXNzLCBhbW91bnQgfSkKICAgIGRpZyAyOAogICAgaXRvYgogICAgZGlnIDM3CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDAKICAgIC8vIGRpc2J1cnNlZCsrCiAgICBkaWcgMjEKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDIyCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDMKICAgIC8vIGlmICh0aWNrZXQgPT09IHRpY2tldHMubGVuZ3RoIC0gMSkgewogICAgZGlnIDMKICAgIHB1c2hpbnRzIDEyNCAyIC8vIDEyNCwgMgogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkaWcgMTAKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ0NQogICAgLy8gaWYgKHdpbm5lckNvdW50ICE9PSBkaXNidXJzZWQpIHsKICAgIGRpZyA2CiAgICBkaWcgMjIKICAgICE9CiAgICBieiBkaXNidXJzZVJld2FyZHNfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDYKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ0Ny00NTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucmFmZmxlQ3Vyc29yID0gewogICAgLy8gICB0aWNrZXQ6IDAsCiAgICAvLyAgIHN0YWtlOiAwLAogICAgLy8gICBkaXNidXJzZWQ6IDAsCiAgICAvLyB9CiAgICBkdXAKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGJ5dGVjIDM4IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ1MgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS53aW5uaW5nVGlja2V0cyA9IFtdCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBwdXNoaW50IDU3IC8vIDU3CiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18wIC8vIDAKICAgIHN3YXAKICAgIGV4dHJhY3QzCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgY29uY2F0CiAgICBkaWcgMQogICAgYm94X2RlbAogICAgcG9wCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDUzCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI3CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA2OAogICAgLy8gdGhpcy5jcmVhdGVTaHVmZmxlRGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfYmxvY2tANTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDcwCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI4CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDcyCiAgICAvLyBpZiAod2lubmVyQ291bnQgPT09IGRpc2J1cnNlZCkgewogICAgZGlnIDYKICAgIGRpZyAyMgogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlANTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZUZpbmFsaXphdGlvbgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgYnl0ZWMgMTMgLy8gMHgxZQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzQKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzUtNDc5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnJhZmZsZUN1cnNvciA9IHsKICAgIC8vICAgdGlja2V0OiAwLAogICAgLy8gICBzdGFrZTogMCwKICAgIC8vICAgZGlzYnVyc2VkOiAwLAogICAgLy8gfQogICAgZHVwCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBieXRlYyAzOCAvLyAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUud2lubmluZ1RpY2tldHMgPSBbXQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGR1cAogICAgcHVzaGludCA1NyAvLyA1NwogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICBleHRyYWN0MwogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGJveF9kZWwKICAgIHBvcAogICAgYm94X3B1dAogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA1MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yICs9IGl0ZXJhdGlvbkFtb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA2CiAgICArCiAgICBpdG9iCiAgICBkaWcgMQogICAgaW50Y18zIC8vIDkyCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDgzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnJhZmZsZUN1cnNvciA9IHsgdGlja2V0LCBzdGFrZSwgZGlzYnVyc2VkIH0KICAgIGRpZyAxMAogICAgaXRvYgogICAgZGlnIDEzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGRpZyAyMwogICAgaXRvYgogICAgY29uY2F0CiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDU5CiAgICAvLyBpdGVyYXRpb25BbW91bnQgLT0gaQogICAgZGlnIDQKICAgIGRpZyAxOQogICAgLQogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYwCiAgICAvLyB0aWNrZXQrKwogICAgZGlnIDkKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjEKICAgIC8vIGN1cnJlbnRUaWNrZXQgPSB0aWNrZXRzW3RpY2tldF0KICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBwdXNoaW50IDEyNiAvLyAxMjYKICAgICsKICAgIGRpZyA0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDI0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYyCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IDEgLy8gRW50cnkgSURzIHN0YXJ0IGF0IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYzCiAgICAvLyBpID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjQKICAgIC8vIHN0YWtlID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjUKICAgIC8vIGN1cnJlbnRSYW5nZUVuZCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDI1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0OToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjcKICAgIC8vIGN1cnJlbnRSYW5nZVN0YXJ0ID0gY3VycmVudFJhbmdlRW5kICsgMQogICAgZGlnIDI0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzMwogICAgLy8gZm9yIChsZXQgaSA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaSA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpKyspIHsKICAgIGRpZyAxOAogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTkKICAgIGIgZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEAzOQoKZGlzYnVyc2VSZXdhcmRzX2lmX2JvZHlAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDI0CiAgICAvLyBhbW91bnQgPSBhY3R1YWxTdW0gLyB3aW5uZXJDb3VudAogICAgZGlnIDMxCiAgICBkaWcgNwogICAgLwogICAgYnVyeSAyOQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzOAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUAzMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTMKICAgIC8vIGJhbGFuY2UgPSBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLCBhc3NldClbMF0KICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBkaWcgMjgKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM0CgpkaXNidXJzZVJld2FyZHNfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZmluYWxpemVEaXN0cmlidXRpb25bcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZURpc3RyaWJ1dGlvbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDc5CiAgICAvLyBmaW5hbGl6ZURpc3RyaWJ1dGlvbihyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODAKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4MAogICAgLy8gYXNzZXJ0KHRoaXMucmV3YXJkcyhyZXdhcmRJRCkuZXhpc3RzLCAncmV3YXJkIGRvZXMgbm90IGV4aXN0JykKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4MQogICAgLy8gY29uc3QgeyBwaGFzZSwgYWN0aXZlRGlzYnVyc2VtZW50SUQgfSA9IHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMQogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGR1cAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODIKICAgIC8vIGFzc2VydChwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24sIEVSUl9ESVNCVVJTRU1FTlRfTk9UX1JFQURZX0ZPUl9GSU5BTElaQVRJT04pCiAgICB1bmNvdmVyIDIKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgID09CiAgICBhc3NlcnQgLy8gRGlzYnVyc2VtZW50IG5vdCByZWFkeSBmb3IgZmluYWxpemF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjIyCiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjIyCiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYyMgogICAgLy8gY29uc3QgcmV3YXJkc0FwcCA9IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5yZXdhcmRzKQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjI0LTYyNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuZmluYWxpemVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFtkaXNidXJzZW1lbnRJRF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4NTQyNGE1OTEgLy8gbWV0aG9kICJmaW5hbGl6ZURpc2J1cnNlbWVudCh1aW50NjQpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4NgogICAgLy8gdGhpcy5kaXNidXJzZW1lbnRzKGFjdGl2ZURpc2J1cnNlbWVudElEKS5jcmVhdGUoKQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE3MAogICAgLy8gZGlzYnVyc2VtZW50cyA9IEJveE1hcDx1aW50NjQsIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeERpc2J1cnNlbWVudHMgfSkKICAgIHB1c2hieXRlcyAiZCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODYKICAgIC8vIHRoaXMuZGlzYnVyc2VtZW50cyhhY3RpdmVEaXNidXJzZW1lbnRJRCkuY3JlYXRlKCkKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDg4CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VJZGxlCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDg5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmFjdGl2ZURpc2J1cnNlbWVudElEID0gMAogICAgaW50Y18wIC8vIDAKICAgIGl0b2IKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGRpZyAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCA9IDAKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwOCAvLyAxMDgKICAgIGRpZyAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAyCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDkyCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlcnMgPSAwCiAgICBkaWcgMQogICAgcHVzaGludCAzMyAvLyAzMwogICAgZGlnIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA5MwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5xdWFsaWZpZWRTdGFrZSA9IDAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA3OQogICAgLy8gZmluYWxpemVEaXN0cmlidXRpb24ocmV3YXJkSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY2hlY2tbcm91dGluZ10oKSAtPiB2b2lkOgpjaGVjazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk2CiAgICAvLyBjaGVjayhhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk3CiAgICAvLyBjb25zdCBrZXk6IEVudHJ5S2V5ID0geyBhZGRyZXNzLCBhc3NldCB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTgKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGtleSkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA5OQogICAgLy8gcmV0dXJuIHRoaXMuY2hlY2tCeUlEKGlkKQogICAgY2FsbHN1YiBjaGVja0J5SUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk2CiAgICAvLyBjaGVjayhhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddKCkgLT4gdm9pZDoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ106CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEwMgogICAgLy8gZ2F0ZUNoZWNrKGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLCBhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KSB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXBwbAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAzCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgYWRkcmVzcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAzCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgYWRkcmVzcykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA3CiAgICBkaWcgMgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDE3IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyA2CiAgICB1bmNvdmVyIDIKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGdhdGVDaGVjawogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDcKICAgIC8vIGlmICghcGFzc2VzICYmIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDcKICAgIC8vIGlmICghcGFzc2VzICYmIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICAhPQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEwOAogICAgLy8gY29uc3Qga2V5OiBFbnRyeUtleSA9IHsgYWRkcmVzcywgYXNzZXQgfQogICAgZGlnIDEKICAgIGl0b2IKICAgIGRpZyAzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDkKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGtleSkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTExMAogICAgLy8gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZS5kaXNxdWFsaWZpZWQgPSB0cnVlCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTEwCiAgICAvLyB0aGlzLmVudHJpZXMoaWQpLnZhbHVlLmRpc3F1YWxpZmllZCA9IHRydWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIHNldGJpdAogICAgcHVzaGludCA1MCAvLyA1MAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lmX2Vsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAyCiAgICAvLyBnYXRlQ2hlY2soZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sIGFkZHJlc3M6IEFjY291bnQsIGFzc2V0OiB1aW50NjQpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgMzcgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTA0CiAgICAvLyBjb25zdCBvcmlnaW4gPSBvcmlnaW5Pcih3YWxsZXQsIGFkZHJlc3MpCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZW50ZXJDb3N0W3JvdXRpbmddKCkgLT4gdm9pZDoKZW50ZXJDb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMjIKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTI2CiAgICAvLyBsZXQgYm94TWJyOiB1aW50NjQgPSBlbnRyeU1CUiAqIGVudHJ5Q291bnQKICAgIGludGMgMTAgLy8gNTA2MDAKICAgICoKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTI5CiAgICAvLyBpZiAoIXRoaXMudW5pcXVlcyhhZGRyZXNzKS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGVudGVyQ29zdF9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTMwCiAgICAvLyBib3hNYnIgKz0gUG9vbFVuaXF1ZXNNQlIKICAgIGludGMgMTEgLy8gMTg5MDAKICAgICsKCmVudGVyQ29zdF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEyMgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5vcHRJbkNvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpvcHRJbkNvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMzgKICAgIC8vIGNvbnN0IGNvdW50ID0gc3BsaXRPcHRJbkNvdW50KHRoaXMuYWtpdGFEQU8udmFsdWUsIHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywgYXNzZXQpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOAogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOAogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgc3BsaXRPcHRJbkNvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOQogICAgLy8gcmV0dXJuIChHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiAoMSArIGNvdW50KSkgKyAodGhpcy5yZXdhcmRzTWJyKFdpbm5lckNvdW50Q2FwKSAqIDIpCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGludGNfMSAvLyAxCiAgICB1bmNvdmVyIDIKICAgICsKICAgICoKICAgIHB1c2hpbnQgODAwMCAvLyA4MDAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5zaWduVXBzT3Blbltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNpZ25VcHNPcGVuOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ2CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAweDAwCiAgICAhPQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYtMTE0NwogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBieiBzaWduVXBzT3Blbl9ib29sX2ZhbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ3CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5zaWdudXBUaW1lc3RhbXAudmFsdWUgJiYKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIwCiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVNpZ251cFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMjQgLy8gInNpZ251cF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0NwogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYtMTE0NwogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBieiBzaWduVXBzT3Blbl9ib29sX2ZhbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDwKICAgIGJueiBzaWduVXBzT3Blbl9ib29sX3RydWVANQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIGFsbG93TGF0ZVNpZ251cHMgPSBHbG9iYWxTdGF0ZTxib29sZWFuPih7IGluaXRpYWxWYWx1ZTogZmFsc2UsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWxsb3dMYXRlU2lnbnVwcyB9KQogICAgYnl0ZWMgMTQgLy8gImFsbG93X2xhdGVfc2lnbnVwcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ6IHNpZ25VcHNPcGVuX2Jvb2xfZmFsc2VANgoKc2lnblVwc09wZW5fYm9vbF90cnVlQDU6CiAgICBpbnRjXzEgLy8gMQoKc2lnblVwc09wZW5fYm9vbF9tZXJnZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnNpZ25VcHNPcGVuX2Jvb2xfZmFsc2VANjoKICAgIGludGNfMCAvLyAwCiAgICBiIHNpZ25VcHNPcGVuX2Jvb2xfbWVyZ2VANwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNMaXZlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzRW50ZXJlZFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmlzRW50ZXJlZDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTYzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTY1CiAgICAvLyByZXR1cm4gdGhpcy51bmlxdWVzKGFkZHJlc3MpLmV4aXN0czsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNjMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmdldFN0YXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0U3RhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MQogICAgLy8gc3RhdHVzOiB0aGlzLnN0YXR1cy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTcxCiAgICAvLyBzdGF0dXM6IHRoaXMuc3RhdHVzLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTcyCiAgICAvLyB0aXRsZTogdGhpcy50aXRsZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE2CiAgICAvLyB0aXRsZSA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVRpdGxlIH0pCiAgICBieXRlYyAyOCAvLyAidGl0bGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdGl0bGU6IHRoaXMudGl0bGUudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzMKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBzaWdudXBUaW1lc3RhbXA6IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBzaWdudXBUaW1lc3RhbXA6IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc1CiAgICAvLyBhbGxvd0xhdGVTaWdudXBzOiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzUKICAgIC8vIGFsbG93TGF0ZVNpZ251cHM6IHRoaXMuYWxsb3dMYXRlU2lnbnVwcy52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3NgogICAgLy8gc3RhcnRUaW1lc3RhbXA6IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXJ0VGltZXN0YW1wIH0pCiAgICBieXRlYyAxNSAvLyAic3RhcnRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzYKICAgIC8vIHN0YXJ0VGltZXN0YW1wOiB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc3CiAgICAvLyBlbmRUaW1lc3RhbXA6IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIGVuZFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW5kVGltZXN0YW1wIH0pCiAgICBieXRlYyAxNiAvLyAiZW5kX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc3CiAgICAvLyBlbmRUaW1lc3RhbXA6IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBtYXhFbnRyaWVzOiB0aGlzLm1heEVudHJpZXMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gbWF4RW50cmllcyA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1heEVudHJpZXMgfSkKICAgIGJ5dGVjIDE4IC8vICJtYXhfZW50cmllcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBtYXhFbnRyaWVzOiB0aGlzLm1heEVudHJpZXMudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzkKICAgIC8vIGVudHJ5Q291bnQ6ICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc5CiAgICAvLyBlbnRyeUNvdW50OiAodGhpcy5lbnRyeUlELnZhbHVlICsgMSksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgwCiAgICAvLyByZXdhcmRDb3VudDogKHRoaXMucmV3YXJkSUQudmFsdWUgKyAxKSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMyCiAgICAvLyByZXdhcmRJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5UmV3YXJkQ291bnQgfSkKICAgIGJ5dGVjIDI1IC8vICJyZXdhcmRfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4MAogICAgLy8gcmV3YXJkQ291bnQ6ICh0aGlzLnJld2FyZElELnZhbHVlICsgMSksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgxCiAgICAvLyB0b3RhbFN0YWtlZDogdGhpcy50b3RhbFN0YWtlZC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyB0b3RhbFN0YWtlZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VG90YWxTdGFrZWQgfSkKICAgIGJ5dGVjIDMxIC8vICJ0b3RhbF9zdGFrZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4MQogICAgLy8gdG90YWxTdGFrZWQ6IHRoaXMudG90YWxTdGFrZWQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODIKICAgIC8vIHN0YWtlS2V5OiB0aGlzLnN0YWtlS2V5LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHN0YWtlS2V5ID0gR2xvYmFsU3RhdGU8Um9vdEtleT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YWtlS2V5IH0pCiAgICBieXRlYyAyNiAvLyAic3Rha2Vfa2V5IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODIKICAgIC8vIHN0YWtlS2V5OiB0aGlzLnN0YWtlS2V5LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgzCiAgICAvLyBtaW5pbXVtU3Rha2VBbW91bnQ6IHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDEKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1pbmltdW1TdGFrZUFtb3VudCB9KQogICAgYnl0ZWMgMjkgLy8gIm1pbmltdW1fc3Rha2VfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudDogdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODQKICAgIC8vIGdhdGVJRDogdGhpcy5nYXRlSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg0CiAgICAvLyBnYXRlSUQ6IHRoaXMuZ2F0ZUlELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg1CiAgICAvLyBjcmVhdG9yOiB0aGlzLmNyZWF0b3IudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0NwogICAgLy8gY3JlYXRvciA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlDcmVhdG9yIH0pCiAgICBieXRlYyAxMSAvLyAiY3JlYXRvciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg1CiAgICAvLyBjcmVhdG9yOiB0aGlzLmNyZWF0b3IudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzAtMTE4NgogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgc3RhdHVzOiB0aGlzLnN0YXR1cy52YWx1ZSwKICAgIC8vICAgdGl0bGU6IHRoaXMudGl0bGUudmFsdWUsCiAgICAvLyAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgc2lnbnVwVGltZXN0YW1wOiB0aGlzLnNpZ251cFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgYWxsb3dMYXRlU2lnbnVwczogdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlLAogICAgLy8gICBzdGFydFRpbWVzdGFtcDogdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgZW5kVGltZXN0YW1wOiB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgbWF4RW50cmllczogdGhpcy5tYXhFbnRyaWVzLnZhbHVlLAogICAgLy8gICBlbnRyeUNvdW50OiAodGhpcy5lbnRyeUlELnZhbHVlICsgMSksCiAgICAvLyAgIHJld2FyZENvdW50OiAodGhpcy5yZXdhcmRJRC52YWx1ZSArIDEpLAogICAgLy8gICB0b3RhbFN0YWtlZDogdGhpcy50b3RhbFN0YWtlZC52YWx1ZSwKICAgIC8vICAgc3Rha2VLZXk6IHRoaXMuc3Rha2VLZXkudmFsdWUsCiAgICAvLyAgIG1pbmltdW1TdGFrZUFtb3VudDogdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsCiAgICAvLyAgIGdhdGVJRDogdGhpcy5nYXRlSUQudmFsdWUsCiAgICAvLyAgIGNyZWF0b3I6IHRoaXMuY3JlYXRvci52YWx1ZSwKICAgIC8vIH0KICAgIGRpZyAxMwogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAxNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDE0CiAgICBwdXNoYnl0ZXMgMHgwMDZmCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTExIC8vIDExMQogICAgKwogICAgc3dhcAogICAgdW5jb3ZlciAxNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAxNAogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNjgKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdC51cGRhdGVBa2l0YURBT0VzY3Jvd1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPRXNjcm93OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM3CiAgICAvLyB1cGRhdGVBa2l0YURBT0VzY3JvdyhhcHA6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM4CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDIwIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMzgKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIE9ubHkgdGhlIEFraXRhIERBTyBjYW4gY2FsbCB0aGlzIGZ1bmN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFwcAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzNwogICAgLy8gdXBkYXRlQWtpdGFEQU9Fc2Nyb3coYXBwOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpVcGdyYWRlYWJsZUFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjQ4CiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiBbJ1VwZGF0ZUFwcGxpY2F0aW9uJ10gfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBieXRlYyAyMCAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAzNiAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTEKICAgIC8vIGNvbnN0IHVwZGF0ZVBsdWdpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkudXBkYXRlCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBhcHAgdXBncmFkZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIHB1c2hieXRlcyAidmVyc2lvbiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjUzCiAgICAvLyB0aGlzLnZlcnNpb24udmFsdWUgPSBuZXdWZXJzaW9uCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VDb250cmFjdC51cGRhdGVBa2l0YURBT1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzgKICAgIC8vIHVwZGF0ZUFraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDIwIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6OkJhc2VTdGFraW5nUG9vbC5tYnJbcm91dGluZ10oKSAtPiB2b2lkOgptYnI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvYmFzZS50czo5CiAgICAvLyByZXR1cm4gTWluUG9vbFJld2FyZHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiB3aW5uaW5nVGlja2V0cykKICAgIGludGMgNiAvLyA0MDAKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvYmFzZS50czoxNS0yMQogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgZW50cmllczogUG9vbEVudHJpZXNNQlIsCiAgICAvLyAgIHVuaXF1ZXM6IFBvb2xVbmlxdWVzTUJSLAogICAgLy8gICBlbnRyaWVzQnlBZGRyZXNzOiBQb29sRW50cmllc0J5QWRkcmVzc01CUiwKICAgIC8vICAgcmV3YXJkczogdGhpcy5yZXdhcmRzTWJyKHdpbm5pbmdUaWNrZXRzKSwKICAgIC8vICAgZGlzYnVyc2VtZW50czogUG9vbERpc2J1cnNlbWVudFNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDYyZDQwMDAwMDAwMDAwMDA0OWQ0MDAwMDAwMDAwMDAwNjJkNAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MjAKICAgIC8vIGRpc2J1cnNlbWVudHM6IFBvb2xEaXNidXJzZW1lbnRTTUJSCiAgICBwdXNoaW50IDYxMDAgLy8gNjEwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9iYXNlLnRzOjE1LTIxCiAgICAvLyByZXR1cm4gewogICAgLy8gICBlbnRyaWVzOiBQb29sRW50cmllc01CUiwKICAgIC8vICAgdW5pcXVlczogUG9vbFVuaXF1ZXNNQlIsCiAgICAvLyAgIGVudHJpZXNCeUFkZHJlc3M6IFBvb2xFbnRyaWVzQnlBZGRyZXNzTUJSLAogICAgLy8gICByZXdhcmRzOiB0aGlzLnJld2FyZHNNYnIod2lubmluZ1RpY2tldHMpLAogICAgLy8gICBkaXNidXJzZW1lbnRzOiBQb29sRGlzYnVyc2VtZW50U01CUgogICAgLy8gfQogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY2hlY2tCeUlEKGlkOiB1aW50NjQpIC0+IGJ5dGVzOgpjaGVja0J5SUQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDg3CiAgICAvLyBwcml2YXRlIGNoZWNrQnlJRChpZDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgcHJvdG8gMSAxCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OQogICAgLy8gdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfTk9ORSB8fCB0aGlzLnR5cGUudmFsdWUgIT09IFBPT0xfU1RBS0lOR19UWVBFX0hFQVJUQkVBVCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgICE9CiAgICBibnogY2hlY2tCeUlEX2Jvb2xfdHJ1ZUAyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OQogICAgLy8gdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDggLy8gMHgwYQogICAgIT0KICAgIGJ6IGNoZWNrQnlJRF9ib29sX2ZhbHNlQDMKCmNoZWNrQnlJRF9ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCgpjaGVja0J5SURfYm9vbF9tZXJnZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OC00OTEKICAgIC8vIGFzc2VydCgKICAgIC8vICAgdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgLy8gICBFUlJfSU5WQUxJRF9QT09MX1RZUEVfRk9SX0NIRUNLCiAgICAvLyApCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwb29sIHR5cGUgZm9yIGNoZWNrCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDkzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0OTMKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzLCBhc3NldCwgcXVhbnRpdHkgfSA9IHRoaXMuZW50cmllcyhpZCkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBkaWcgMQogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBmcmFtZV9idXJ5IDAKICAgIGRpZyAxCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDMKICAgIHN3YXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBieiBjaGVja0J5SURfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDk2CiAgICAvLyByZXR1cm4geyB2YWxpZDogZmFsc2UsIGJhbGFuY2U6IDAgfQogICAgYnl0ZWMgMzkgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjaGVja0J5SURfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ5OQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfU09GVCkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0OTkKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICA9PQogICAgYnogY2hlY2tCeUlEX2Vsc2VfYm9keUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwMC01MDMKICAgIC8vIGNvbnN0IGNoZWNrID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuc29mdENoZWNrPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogW2FkZHJlc3MsIGFzc2V0XSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAxCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAxCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwMQogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAwLTUwMwogICAgLy8gY29uc3QgY2hlY2sgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5zb2Z0Q2hlY2s+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbYWRkcmVzcywgYXNzZXRdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDQ4NzE2ZjFhIC8vIG1ldGhvZCAic29mdENoZWNrKGFkZHJlc3MsdWludDY0KShib29sLHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgbGVuCiAgICBwdXNoaW50IDkgLy8gOQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGJvb2wxLHVpbnQ2NCkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA1CiAgICAvLyBpZiAoY2hlY2suYmFsYW5jZSA+PSBxdWFudGl0eSkgewogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgNAogICAgPj0KICAgIGJ6IGNoZWNrQnlJRF9hZnRlcl9pZl9lbHNlQDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA2CiAgICAvLyByZXR1cm4geyB2YWxpZDogdHJ1ZSwgYmFsYW5jZTogY2hlY2suYmFsYW5jZSB9CiAgICBmcmFtZV9kaWcgNQogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY2hlY2tCeUlEX2FmdGVyX2lmX2Vsc2VAMTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTI1CiAgICAvLyB0aGlzLmVudHJpZXMoaWQpLnZhbHVlLmRpc3F1YWxpZmllZCA9IHRydWUKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBzZXRiaXQKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTI2CiAgICAvLyByZXR1cm4geyB2YWxpZDogZmFsc2UsIGJhbGFuY2U6IDAgfQogICAgYnl0ZWMgMzkgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjaGVja0J5SURfZWxzZV9ib2R5QDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwOS01MTgKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFkZHJlc3MsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQ6IGFzc2V0LAogICAgLy8gICAgICAgdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgLy8gICAgIH0sCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxMAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxMAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDYgLy8gImFhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MTAKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxNQogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MTUKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTEzLTUxNgogICAgLy8gewogICAgLy8gICBhc3NldDogYXNzZXQsCiAgICAvLyAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vIH0sCiAgICBmcmFtZV9kaWcgMwogICAgaXRvYgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA5LTUxOAogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogYXNzZXQsCiAgICAvLyAgICAgICB0eXBlOiB0aGlzLnR5cGUudmFsdWUsCiAgICAvLyAgICAgfSwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBieXRlYyA0MCAvLyBtZXRob2QgImdldEluZm8oYWRkcmVzcywodWludDY0LHVpbnQ4KSkodWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgbGVuCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBwdXNoaW50IDQgLy8gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUyMAogICAgLy8gaWYgKGluZm8uYW1vdW50ID49IHF1YW50aXR5KSB7CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDYKICAgIGZyYW1lX2RpZyA0CiAgICA+PQogICAgYnogY2hlY2tCeUlEX2FmdGVyX2lmX2Vsc2VAMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MjEKICAgIC8vIHJldHVybiB7IHZhbGlkOiB0cnVlLCBiYWxhbmNlOiBpbmZvLmFtb3VudCB9CiAgICBmcmFtZV9kaWcgNgogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY2hlY2tCeUlEX2Jvb2xfZmFsc2VAMzoKICAgIGludGNfMCAvLyAwCiAgICBiIGNoZWNrQnlJRF9ib29sX21lcmdlQDQKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGRpc2J1cnNlbWVudElEOiB1aW50NjQsIGFzc2V0OiB1aW50NjQsIGFsbG9jYXRpb25zOiBieXRlcywgc3VtOiB1aW50NjQpIC0+IGJ5dGVzOgpjcmVhdGVSZXdhcmRBbGxvY2F0aW9uczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NzctNTgyCiAgICAvLyBwcml2YXRlIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKAogICAgLy8gICBkaXNidXJzZW1lbnRJRDogdWludDY0LAogICAgLy8gICBhc3NldDogdWludDY0LAogICAgLy8gICBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSwKICAgIC8vICAgc3VtOiB1aW50NjQKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg0CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg0CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU4NAogICAgLy8gY29uc3QgcmV3YXJkc0FwcCA9IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5yZXdhcmRzKQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjU1CiAgICAvLyByZXR1cm4gVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDI1MzAwIC8vIDI1MzAwCiAgICAqCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg3CiAgICAvLyBpZiAoYXNzZXQgPT09IDApIHsKICAgIGZyYW1lX2RpZyAtMwogICAgYm56IGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2Vsc2VfYm9keUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg5LTU5OQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlVXNlckFsbG9jYXRpb25zPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudCArIHN1bSwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBkaXNidXJzZW1lbnRJRCwKICAgIC8vICAgICBhbGxvY2F0aW9ucywKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTkzCiAgICAvLyByZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTk0CiAgICAvLyBhbW91bnQ6IG1ickFtb3VudCArIHN1bSwKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgLTEKICAgICsKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTkyLTU5NQogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQgKyBzdW0sCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1ODktNTk5CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50ICsgc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU5NgogICAgLy8gZGlzYnVyc2VtZW50SUQsCiAgICBmcmFtZV9kaWcgLTQKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1ODktNTk5CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50ICsgc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIHB1c2hieXRlcyAweDU2NTZhY2Y5IC8vIG1ldGhvZCAiY3JlYXRlVXNlckFsbG9jYXRpb25zKHBheSx1aW50NjQsKGFkZHJlc3MsdWludDY0KVtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2FmdGVyX2lmX2Vsc2VAMTA6CiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIHJldHN1YgoKY3JlYXRlUmV3YXJkQWxsb2NhdGlvbnNfZWxzZV9ib2R5QDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjAyLTYxNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlQXNhVXNlckFsbG9jYXRpb25zPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudCwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgICAgYXNzZXRSZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgLy8gICAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgICAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgZGlzYnVyc2VtZW50SUQsCiAgICAvLyAgICAgYWxsb2NhdGlvbnMsCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwNgogICAgLy8gcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIGR1cAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjA1LTYwOAogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQsCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MDktNjEzCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyB9KSwKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYxMAogICAgLy8gYXNzZXRSZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAtMwogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwOS02MTMKICAgIC8vIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vIH0pLAogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwMi02MTcKICAgIC8vIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUFzYVVzZXJBbGxvY2F0aW9ucz4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQsCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICAgIGFzc2V0UmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgICAgICBhc3NldEFtb3VudDogc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYxNAogICAgLy8gZGlzYnVyc2VtZW50SUQsCiAgICBmcmFtZV9kaWcgLTQKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MDItNjE3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVBc2FVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICB4ZmVyQXNzZXQ6IGFzc2V0LAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBkaXNidXJzZW1lbnRJRCwKICAgIC8vICAgICBhbGxvY2F0aW9ucywKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHgzYzYwZGZhMCAvLyBtZXRob2QgImNyZWF0ZUFzYVVzZXJBbGxvY2F0aW9ucyhwYXksYXhmZXIsdWludDY0LChhZGRyZXNzLHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBiIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2FmdGVyX2lmX2Vsc2VAMTAKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmNyZWF0ZVBvb2xFbnRyaWVzKHBheW1lbnQ6IHVpbnQ2NCwgZW50cmllczogYnl0ZXMsIGdhdGVBcmdzOiBieXRlcykgLT4gYnl0ZXMsIGJ5dGVzOgpjcmVhdGVQb29sRW50cmllczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NTgKICAgIC8vIHByaXZhdGUgY3JlYXRlUG9vbEVudHJpZXMocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBlbnRyaWVzOiBTdGFrZUVudHJ5W10sIGdhdGVBcmdzOiBHYXRlQXJncyk6IHZvaWQgewogICAgcHJvdG8gMyAyCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY1OQogICAgLy8gYXNzZXJ0KHRoaXMuaXNMaXZlKCksICd0aGUgcG9vbCBpcyBub3QgbGl2ZScpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlCiAgICBhc3NlcnQgLy8gdGhlIHBvb2wgaXMgbm90IGxpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjIKICAgIC8vICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSA8PSB0aGlzLm1heEVudHJpZXMudmFsdWUgfHwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjIKICAgIC8vICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSA8PSB0aGlzLm1heEVudHJpZXMudmFsdWUgfHwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIG1heEVudHJpZXMgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXhFbnRyaWVzIH0pCiAgICBieXRlYyAxOCAvLyAibWF4X2VudHJpZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjYyCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjItNjYzCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAsCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF90cnVlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjMKICAgIC8vIHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBtYXhFbnRyaWVzID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5TWF4RW50cmllcyB9KQogICAgYnl0ZWMgMTggLy8gIm1heF9lbnRyaWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY2MwogICAgLy8gdGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjItNjYzCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAsCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAzCgpjcmVhdGVQb29sRW50cmllc19ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCgpjcmVhdGVQb29sRW50cmllc19ib29sX21lcmdlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjYxLTY2NQogICAgLy8gYXNzZXJ0KAogICAgLy8gICAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyAgIHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCwKICAgIC8vICAgJ3Bvb2wgaGFzIHJlYWNoZWQgbWF4aW11bSBlbnRyaWVzJwogICAgLy8gKQogICAgYXNzZXJ0IC8vIHBvb2wgaGFzIHJlYWNoZWQgbWF4aW11bSBlbnRyaWVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjY5CiAgICAvLyBsZXQgdG90YWw6IHVpbnQ2NCA9IGVudHJ5TUJSICogZW50cmllcy5sZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMwogICAgaW50YyAxMCAvLyA1MDYwMAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBieXRlYyAyMyAvLyAidSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NzAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NzAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjcxCiAgICAvLyB0b3RhbCArPSBQb29sVW5pcXVlc01CUgogICAgaW50YyAxMSAvLyAxODkwMAogICAgKwoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY3NC02ODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogeyBncmVhdGVyVGhhbkVxOiB0b3RhbCB9LAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTMKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njc3CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY3NC02ODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogeyBncmVhdGVyVGhhbkVxOiB0b3RhbCB9LAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgZnJhbWVfZGlnIC0zCiAgICBndHhucyBBbW91bnQKICAgIHVuY292ZXIgMgogICAgPj0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjgzCiAgICAvLyBjb25zdCB7IGFkZHJlc3MsIG5hbWUgfSA9IHRoaXMuc3Rha2VLZXkudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTM5CiAgICAvLyBzdGFrZUtleSA9IEdsb2JhbFN0YXRlPFJvb3RLZXk+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFrZUtleSB9KQogICAgYnl0ZWMgMjYgLy8gInN0YWtlX2tleSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2ODMKICAgIC8vIGNvbnN0IHsgYWRkcmVzcywgbmFtZSB9ID0gdGhpcy5zdGFrZUtleS52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgZXh0cmFjdCAwIDMyCiAgICBmcmFtZV9idXJ5IDAKICAgIGR1cAogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGVudHJpZXMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDUKCmNyZWF0ZVBvb2xFbnRyaWVzX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGVudHJpZXMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMwogICAgPAogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfd2hpbGVAMjcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2ODYKICAgIC8vIGFzc2VydChlbnRyaWVzW2ldLnF1YW50aXR5ID49IHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlLCAncXVhbnRpdHkgaXMgbGVzcyB0aGFuIG1pbmltdW0gc3Rha2UgYW1vdW50JykKICAgIGZyYW1lX2RpZyAtMgogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAqCiAgICBkaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgMwogICAgZGlnIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAqCiAgICBkaWcgNAogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDEKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1pbmltdW1TdGFrZUFtb3VudCB9KQogICAgYnl0ZWMgMjkgLy8gIm1pbmltdW1fc3Rha2VfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NgogICAgLy8gYXNzZXJ0KGVudHJpZXNbaV0ucXVhbnRpdHkgPj0gdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsICdxdWFudGl0eSBpcyBsZXNzIHRoYW4gbWluaW11bSBzdGFrZSBhbW91bnQnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID49CiAgICBhc3NlcnQgLy8gcXVhbnRpdHkgaXMgbGVzcyB0aGFuIG1pbmltdW0gc3Rha2UgYW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njg4CiAgICAvLyBpZiAoYWRkcmVzcyAhPT0gR2xvYmFsLnplcm9BZGRyZXNzKSB7CiAgICBmcmFtZV9kaWcgMAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAhPQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4OS02OTgKICAgIC8vIGNvbnN0IHZlcmlmaWVkID0gYWJpQ2FsbDx0eXBlb2YgTWV0YU1lcmtsZXMucHJvdG90eXBlLnZlcmlmeT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgLy8gICAgIGVudHJpZXNbaV0ucHJvb2YsCiAgICAvLyAgICAgTUVSS0xFX1RSRUVfVFlQRV9BU1NFVCwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjkwCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY5MAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5tZXRhTWVya2xlcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjkwCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgcHVzaGludCA3MiAvLyA3MgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2OTQKICAgIC8vIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgZnJhbWVfZGlnIDIKICAgIGR1cAogICAgZXh0cmFjdCAwIDgKICAgIHNoYTI1NgogICAgc2hhMjU2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njk1CiAgICAvLyBlbnRyaWVzW2ldLnByb29mLAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2OTYKICAgIC8vIE1FUktMRV9UUkVFX1RZUEVfQVNTRVQsCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4OS02OTgKICAgIC8vIGNvbnN0IHZlcmlmaWVkID0gYWJpQ2FsbDx0eXBlb2YgTWV0YU1lcmtsZXMucHJvdG90eXBlLnZlcmlmeT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgLy8gICAgIGVudHJpZXNbaV0ucHJvb2YsCiAgICAvLyAgICAgTUVSS0xFX1RSRUVfVFlQRV9BU1NFVCwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgyYmYzY2M1YSAvLyBtZXRob2QgInZlcmlmeShhZGRyZXNzLHN0cmluZyxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NClib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzAwCiAgICAvLyBhc3NlcnQodmVyaWZpZWQsICdmYWlsZWQgdG8gdmVyaWZ5IHN0YWtlIHJlcXVpcmVtZW50cycpCiAgICBhc3NlcnQgLy8gZmFpbGVkIHRvIHZlcmlmeSBzdGFrZSByZXF1aXJlbWVudHMKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA1CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX0hFQVJUQkVBVCB8fAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MDUKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcwNS03MDYKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGJueiBjcmVhdGVQb29sRW50cmllc19pZl9ib2R5QDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA2CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA2CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcwNS03MDYKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGJ6IGNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMjEKCmNyZWF0ZVBvb2xFbnRyaWVzX2lmX2JvZHlAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzEwCiAgICAvLyBpZiAoZW50cmllc1tpXS5hc3NldCAhPT0gMCkgewogICAgZnJhbWVfZGlnIDIKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDcKICAgIGJ6IGNyZWF0ZVBvb2xFbnRyaWVzX2Vsc2VfYm9keUAxNQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcxMQogICAgLy8gKFtiYWxhbmNlLCBvcHRlZEluXSA9IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2UoVHhuLnNlbmRlciwgZW50cmllc1tpXS5hc3NldCkpCiAgICB0eG4gU2VuZGVyCiAgICBmcmFtZV9kaWcgNwogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBzd2FwCiAgICBmcmFtZV9idXJ5IDQKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzE2CiAgICAvLyBhc3NlcnQob3B0ZWRJbiAmJiBiYWxhbmNlID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgbWluIGJhbGFuY2UnKQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAxOQogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyA2CiAgICA+PQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAxOQogICAgaW50Y18xIC8vIDEKCmNyZWF0ZVBvb2xFbnRyaWVzX2Jvb2xfbWVyZ2VAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzE2CiAgICAvLyBhc3NlcnQob3B0ZWRJbiAmJiBiYWxhbmNlID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgbWluIGJhbGFuY2UnKQogICAgYXNzZXJ0IC8vIHVzZXIgZG9lcyBub3QgaGF2ZSBtaW4gYmFsYW5jZQoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjAKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgIT09IFBPT0xfU1RBS0lOR19UWVBFX05PTkUpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzIwCiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAweDAwCiAgICAhPQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyMS03MzAKICAgIC8vIGNvbnN0IHN0YWtlSW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgICAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjIKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjIKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzIyCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjQKICAgIC8vIFR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzI2CiAgICAvLyBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIGZyYW1lX2RpZyAyCiAgICBleHRyYWN0IDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyNwogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjcKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzI1LTcyOAogICAgLy8gewogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgLy8gfSwKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyMS03MzAKICAgIC8vIGNvbnN0IHN0YWtlSW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgICAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDQwIC8vIG1ldGhvZCAiZ2V0SW5mbyhhZGRyZXNzLCh1aW50NjQsdWludDgpKSh1aW50NjQsdWludDY0LHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgcHVzaGludCA0IC8vIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzIKICAgIC8vIGFzc2VydChzdGFrZUluZm8uYW1vdW50ID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgZW5vdWdoIHN0YWtlZCcpCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfZGlnIDYKICAgID49CiAgICBhc3NlcnQgLy8gdXNlciBkb2VzIG5vdCBoYXZlIGVub3VnaCBzdGFrZWQKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMjQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc2CiAgICAvLyBjb25zdCBpZCA9IHRoaXMuZW50cnlJRC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE3NgogICAgLy8gY29uc3QgaWQgPSB0aGlzLmVudHJ5SUQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc3CiAgICAvLyB0aGlzLmVudHJ5SUQudmFsdWUgKz0gMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc3CiAgICAvLyB0aGlzLmVudHJ5SUQudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzcKICAgIC8vIGFkZHJlc3M6IFR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzM2LTc0MgogICAgLy8gdGhpcy5lbnRyaWVzKGVudHJ5SUQpLnZhbHVlID0gewogICAgLy8gICBhZGRyZXNzOiBUeG4uc2VuZGVyLAogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgcXVhbnRpdHk6IGVudHJpZXNbaV0ucXVhbnRpdHksCiAgICAvLyAgIGdhdGVBcmdzOiBjbG9uZShnYXRlQXJncyksCiAgICAvLyAgIGRpc3F1YWxpZmllZDogZmFsc2UKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAyCiAgICBleHRyYWN0IDAgOAogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDYKICAgIGl0b2IKICAgIGNvbmNhdAogICAgcHVzaGJ5dGVzIDB4MDAzMzAwCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzM2CiAgICAvLyB0aGlzLmVudHJpZXMoZW50cnlJRCkudmFsdWUgPSB7CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzYtNzQyCiAgICAvLyB0aGlzLmVudHJpZXMoZW50cnlJRCkudmFsdWUgPSB7CiAgICAvLyAgIGFkZHJlc3M6IFR4bi5zZW5kZXIsCiAgICAvLyAgIGFzc2V0OiBlbnRyaWVzW2ldLmFzc2V0LAogICAgLy8gICBxdWFudGl0eTogZW50cmllc1tpXS5xdWFudGl0eSwKICAgIC8vICAgZ2F0ZUFyZ3M6IGNsb25lKGdhdGVBcmdzKSwKICAgIC8vICAgZGlzcXVhbGlmaWVkOiBmYWxzZQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgMgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc0NQogICAgLy8gYWRkcmVzczogVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NDQtNzQ3CiAgICAvLyBjb25zdCBhS2V5ID0gewogICAgLy8gICBhZGRyZXNzOiBUeG4uc2VuZGVyLAogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vIH0KICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzQ5CiAgICAvLyB0aGlzLmVudHJpZXNCeUFkZHJlc3MoYUtleSkudmFsdWUgPSBlbnRyeUlECiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiA4CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc0OQogICAgLy8gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGFLZXkpLnZhbHVlID0gZW50cnlJRAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBieXRlYyAyMyAvLyAidSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzUxCiAgICAvLyB0aGlzLnVuaXF1ZXMoVHhuLnNlbmRlcikudmFsdWUgPSBlbnRyaWVzLmxlbmd0aCAgLy8gb3IgYW55IG5vbi16ZXJvIHZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyB1bmlxdWVzID0gQm94TWFwPEFjY291bnQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xHbG9iYWxTdGF0ZUtleVVuaXF1ZXMgfSkKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc1MQogICAgLy8gdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLnZhbHVlID0gZW50cmllcy5sZW5ndGggIC8vIG9yIGFueSBub24temVybyB2YWx1ZQogICAgZnJhbWVfZGlnIDMKICAgIGl0b2IKICAgIGJveF9wdXQKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfd2hpbGVfdG9wQDcKCmNyZWF0ZVBvb2xFbnRyaWVzX2Jvb2xfZmFsc2VAMTk6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVQb29sRW50cmllc19ib29sX21lcmdlQDIwCgpjcmVhdGVQb29sRW50cmllc19lbHNlX2JvZHlAMTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzEzCiAgICAvLyBvcHRlZEluID0gdHJ1ZQogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MTQKICAgIC8vIGJhbGFuY2UgPSBUeG4uc2VuZGVyLmJhbGFuY2UKICAgIHR4biBTZW5kZXIKICAgIGFjY3RfcGFyYW1zX2dldCBBY2N0QmFsYW5jZQogICAgc3dhcAogICAgZnJhbWVfYnVyeSA0CiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAxNgoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfd2hpbGVAMjc6CiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmQocGF5bWVudDogdWludDY0LCByZXdhcmQ6IGJ5dGVzKSAtPiBieXRlczoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODY5CiAgICAvLyBhZGRSZXdhcmQocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgcHJvdG8gMiAxCiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcwCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCBFUlJfRk9SQklEREVOKQogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcwCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCBFUlJfRk9SQklEREVOKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gRm9yYmlkZGVuCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcxCiAgICAvLyBpZiAoVHhuLmFwcGxpY2F0aW9uQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3IodGhpcy5hZGRSZXdhcmQpKSB7CiAgICBpbnRjXzAgLy8gMAogICAgdHhuYXMgQXBwbGljYXRpb25BcmdzCiAgICBieXRlYyAzMiAvLyBtZXRob2QgImFkZFJld2FyZChwYXksKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiCiAgICA9PQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcyCiAgICAvLyBhc3NlcnQocmV3YXJkLmFzc2V0ID09PSAwLCBFUlJfTk9UX0FMR08pCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgIQogICAgYXNzZXJ0IC8vIE11c3QgYmUgYW4gQWxnbyBhc3NldAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYzNwogICAgLy8gdGhpcy5zdGFrZUtleS52YWx1ZS5hZGRyZXNzICE9PSBHbG9iYWwuemVyb0FkZHJlc3MgfHwgcmV3YXJkLmRpc3RyaWJ1dGlvbiAhPT0gRGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2UsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gc3Rha2VLZXkgPSBHbG9iYWxTdGF0ZTxSb290S2V5Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3Rha2VLZXkgfSkKICAgIGJ5dGVjIDI2IC8vICJzdGFrZV9rZXkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnN0YWtlS2V5LnZhbHVlLmFkZHJlc3MgIT09IEdsb2JhbC56ZXJvQWRkcmVzcyB8fCByZXdhcmQuZGlzdHJpYnV0aW9uICE9PSBEaXN0cmlidXRpb25UeXBlUGVyY2VudGFnZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBleHRyYWN0IDAgMzIKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgIT0KICAgIGJueiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX3RydWVAOQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDggMQogICAgYnl0ZWMgOCAvLyAweDBhCiAgICAhPQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxMAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDk6CiAgICBpbnRjXzEgLy8gMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MzYtNjM5CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHRoaXMuc3Rha2VLZXkudmFsdWUuYWRkcmVzcyAhPT0gR2xvYmFsLnplcm9BZGRyZXNzIHx8IHJld2FyZC5kaXN0cmlidXRpb24gIT09IERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlLAogICAgLy8gICBFUlJfU1RBS0VfS0VZX1JFUVVJUkVECiAgICAvLyApCiAgICBhc3NlcnQgLy8gU3Rha2Uga2V5IHJlcXVpcmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQyCiAgICAvLyBpZiAocmV3YXJkLmRpc3RyaWJ1dGlvbiA9PT0gRGlzdHJpYnV0aW9uVHlwZVNodWZmbGUpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA4IDEKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICBwdXNoYnl0ZXMgMHgyOAogICAgPT0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2FmdGVyX2lmX2Vsc2VAMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDMKICAgIC8vIGFzc2VydChyZXdhcmQucmF0ZSA+IHJld2FyZC53aW5uZXJDb3VudCAmJiByZXdhcmQud2lubmVyQ291bnQgPD0gV2lubmVyQ291bnRDYXAsIEVSUl9SQVRFX01VU1RfQkVfR1JFQVRFUl9USEFOX1dJTk5FUl9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgPgogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxNQogICAgZnJhbWVfZGlnIDEKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX2ZhbHNlQDE1CiAgICBpbnRjXzEgLy8gMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDMKICAgIC8vIGFzc2VydChyZXdhcmQucmF0ZSA+IHJld2FyZC53aW5uZXJDb3VudCAmJiByZXdhcmQud2lubmVyQ291bnQgPD0gV2lubmVyQ291bnRDYXAsIEVSUl9SQVRFX01VU1RfQkVfR1JFQVRFUl9USEFOX1dJTk5FUl9DT1VOVCkKICAgIGFzc2VydCAvLyBSYXRlIG11c3QgYmUgZ3JlYXRlciB0aGFuIHdpbm5lciBjb3VudAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAxNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDcKICAgIC8vIGlmIChyZXdhcmQuZGlzdHJpYnV0aW9uID09PSBEaXN0cmlidXRpb25UeXBlRXZlbikgewogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9hZnRlcl9pZl9lbHNlQDIzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQ4CiAgICAvLyBhc3NlcnQodGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwIHx8IHRoaXMubWF4RW50cmllcy52YWx1ZSA8PSByZXdhcmQucmF0ZSwgRVJSX01BWF9FTlRSSUVTX0NBTk5PVF9CRV9HUkVBVEVSX1RIQU5fUkFURSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBtYXhFbnRyaWVzID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5TWF4RW50cmllcyB9KQogICAgYnl0ZWMgMTggLy8gIm1heF9lbnRyaWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY0OAogICAgLy8gYXNzZXJ0KHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCB8fCB0aGlzLm1heEVudHJpZXMudmFsdWUgPD0gcmV3YXJkLnJhdGUsIEVSUl9NQVhfRU5UUklFU19DQU5OT1RfQkVfR1JFQVRFUl9USEFOX1JBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDIwCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gbWF4RW50cmllcyA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1heEVudHJpZXMgfSkKICAgIGJ5dGVjIDE4IC8vICJtYXhfZW50cmllcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDgKICAgIC8vIGFzc2VydCh0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAgfHwgdGhpcy5tYXhFbnRyaWVzLnZhbHVlIDw9IHJld2FyZC5yYXRlLCBFUlJfTUFYX0VOVFJJRVNfQ0FOTk9UX0JFX0dSRUFURVJfVEhBTl9SQVRFKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICA8PQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAyMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDIwOgogICAgaW50Y18xIC8vIDEKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfbWVyZ2VAMjI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQ4CiAgICAvLyBhc3NlcnQodGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwIHx8IHRoaXMubWF4RW50cmllcy52YWx1ZSA8PSByZXdhcmQucmF0ZSwgRVJSX01BWF9FTlRSSUVTX0NBTk5PVF9CRV9HUkVBVEVSX1RIQU5fUkFURSkKICAgIGFzc2VydCAvLyBNYXggZW50cmllcyBjYW5ub3QgYmUgZ3JlYXRlciB0aGFuIHJhdGUKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2FmdGVyX2lmX2Vsc2VAMjM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjUxCiAgICAvLyBhc3NlcnQocmV3YXJkLnJhdGUgPiAwLCBFUlJfUkFURV9NVVNUX0JFX0dSRUFURVJfVEhBTl9aRVJPKQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDkgLy8gOQogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYXNzZXJ0IC8vIFJhdGUgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg3Ny04ODYKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogdGhpcy5yZXdhcmRzTWJyKHJld2FyZC53aW5uZXJDb3VudCkKICAgIC8vICAgICB9CiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODc3LTg4NgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiB0aGlzLnJld2FyZHNNYnIocmV3YXJkLndpbm5lckNvdW50KQogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyBncmVhdGVyVGhhbkVxOiB0aGlzLnJld2FyZHNNYnIocmV3YXJkLndpbm5lckNvdW50KQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9iYXNlLnRzOjkKICAgIC8vIHJldHVybiBNaW5Qb29sUmV3YXJkc01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIHdpbm5pbmdUaWNrZXRzKQogICAgaW50YyA2IC8vIDQwMAogICAgZGlnIDEKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NzctODg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuRXE6IHRoaXMucmV3YXJkc01icihyZXdhcmQud2lubmVyQ291bnQpCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICB1bmNvdmVyIDIKICAgIDw9CiAgICB1bmNvdmVyIDIKICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBjb25zdCBpZCA9IHRoaXMucmV3YXJkSUQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMyCiAgICAvLyByZXdhcmRJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5UmV3YXJkQ291bnQgfSkKICAgIGJ5dGVjIDI1IC8vICJyZXdhcmRfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBjb25zdCBpZCA9IHRoaXMucmV3YXJkSUQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgzCiAgICAvLyB0aGlzLnJld2FyZElELnZhbHVlICs9IDEKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIHJld2FyZElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlSZXdhcmRDb3VudCB9KQogICAgYnl0ZWMgMjUgLy8gInJld2FyZF9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxODMKICAgIC8vIHRoaXMucmV3YXJkSUQudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4OTAKICAgIC8vIC4uLmNsb25lKHJld2FyZCksCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMCA4CiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMTcgOAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDI1IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4OTMKICAgIC8vIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIHVuY292ZXIgMwogICAgZnJhbWVfZGlnIDAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHB1c2hieXRlcyAweDAwN2MwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkwMAogICAgLy8gcGhhc2U6IERpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg4OS05MDUKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIC4uLmNsb25lKHJld2FyZCksCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlcnM6IDAsCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlOiAwLAogICAgLy8gICB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICAvLyAgIHJhZmZsZUN1cnNvcjogewogICAgLy8gICAgIHRpY2tldDogMCwKICAgIC8vICAgICBzdGFrZTogMCwKICAgIC8vICAgICBkaXNidXJzZWQ6IDAKICAgIC8vICAgfSwKICAgIC8vICAgdnJmRmFpbHVyZUNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBkaXNidXJzZW1lbnRDdXJzb3I6IDEsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudElEOiAwLAogICAgLy8gICBhY3RpdmVEaXNidXJzZW1lbnRSb3VuZFN0YXJ0OiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wOiAwCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDEKICAgIC8vIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODkzCiAgICAvLyB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg4OS05MDUKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIC4uLmNsb25lKHJld2FyZCksCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlcnM6IDAsCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlOiAwLAogICAgLy8gICB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICAvLyAgIHJhZmZsZUN1cnNvcjogewogICAgLy8gICAgIHRpY2tldDogMCwKICAgIC8vICAgICBzdGFrZTogMCwKICAgIC8vICAgICBkaXNidXJzZWQ6IDAKICAgIC8vICAgfSwKICAgIC8vICAgdnJmRmFpbHVyZUNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBkaXNidXJzZW1lbnRDdXJzb3I6IDEsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudElEOiAwLAogICAgLy8gICBhY3RpdmVEaXNidXJzZW1lbnRSb3VuZFN0YXJ0OiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wOiAwCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4ODkKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICBzd2FwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX2ZhbHNlQDIxOgogICAgaW50Y18wIC8vIDAKICAgIGIgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAyMgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxNToKICAgIGludGNfMCAvLyAwCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfbWVyZ2VAMTYKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfZmFsc2VAMTA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX21lcmdlQDExCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGUocmV3YXJkSUQ6IHVpbnQ2NCkgLT4gdm9pZDoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTk2CiAgICAvLyByYWZmbGUocmV3YXJkSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgcHJvdG8gMSAwCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAzCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk5NwogICAgLy8gYXNzZXJ0KHRoaXMucmV3YXJkcyhyZXdhcmRJRCkuZXhpc3RzLCAncmV3YXJkIGRvZXMgbm90IGV4aXN0JykKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2OAogICAgLy8gcmV3YXJkcyA9IEJveE1hcDx1aW50NjQsIFJld2FyZD4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhSZXdhcmRzIH0pCiAgICBieXRlYyAyMSAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBhc3NlcnQodGhpcy5yZXdhcmRzKHJld2FyZElEKS5leGlzdHMsICdyZXdhcmQgZG9lcyBub3QgZXhpc3QnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwNAogICAgLy8gfSA9IGNsb25lKHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUpCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTA4IC8vIDEwOAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMgogICAgcHVzaGludCA4MyAvLyA4MwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMwogICAgcHVzaGludCA0MSAvLyA0MQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBjb3ZlciA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwNgogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sIEVSUl9JTlZBTElEX0RJU0JVUlNFTUVOVF9QSEFTRSkKICAgIHVuY292ZXIgMgogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIGRpc2J1cnNlbWVudCBwaGFzZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMDcKICAgIC8vIGFzc2VydCh3aW5uaW5nVGlja2V0cy5sZW5ndGggPT09IDAsIEVSUl9XSU5OSU5HX1RJQ0tFVFNfQUxSRUFEWV9FWElTVCkKICAgIHVuY292ZXIgMgogICAgcHVzaGludHMgMTI0IDIgLy8gMTI0LCAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgIQogICAgYXNzZXJ0IC8vIFdpbm5pbmcgdGlja2V0cyBhbHJlYWR5IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgcm91bmRUb1VzZTogdWludDY0ID0gYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCArIDEgKyAoNCAqIHZyZkZhaWx1cmVDb3VudCkKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBwdXNoaW50IDQgLy8gNAogICAgdW5jb3ZlciAyCiAgICAqCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxMS0xMDE0CiAgICAvLyBjb25zdCBzZWVkID0gYWJpQ2FsbDx0eXBlb2YgUmFuZG9tbmVzc0JlYWNvbi5wcm90b3R5cGUuZ2V0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRPdGhlckFwcExpc3QoR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbklkKS52cmZCZWFjb24sCiAgICAvLyAgIGFyZ3M6IFtyb3VuZFRvVXNlLCB0aGlzLnNhbHQudmFsdWVdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDEyCiAgICAvLyBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25JZCkudnJmQmVhY29uLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU1CiAgICAvLyBjb25zdCBbb3RoZXJBcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0KSkKICAgIGJ5dGVjIDMzIC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxMgogICAgLy8gYXBwSWQ6IGdldE90aGVyQXBwTGlzdChHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uSWQpLnZyZkJlYWNvbiwKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMTMKICAgIC8vIGFyZ3M6IFtyb3VuZFRvVXNlLCB0aGlzLnNhbHQudmFsdWVdLAogICAgc3dhcAogICAgaXRvYgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTcKICAgIC8vIHNhbHQgPSBHbG9iYWxTdGF0ZTxieXRlczwzMj4+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTYWx0IH0pCiAgICBieXRlYyAzNCAvLyAic2FsdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDEzCiAgICAvLyBhcmdzOiBbcm91bmRUb1VzZSwgdGhpcy5zYWx0LnZhbHVlXSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMTEtMTAxNAogICAgLy8gY29uc3Qgc2VlZCA9IGFiaUNhbGw8dHlwZW9mIFJhbmRvbW5lc3NCZWFjb24ucHJvdG90eXBlLmdldD4oewogICAgLy8gICBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25JZCkudnJmQmVhY29uLAogICAgLy8gICBhcmdzOiBbcm91bmRUb1VzZSwgdGhpcy5zYWx0LnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgxODkzOTJjNSAvLyBtZXRob2QgImdldCh1aW50NjQsYnl0ZVtdKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDE2CiAgICAvLyBpZiAoc2VlZC5sZW5ndGggPT09IDApIHsKICAgIGxlbgogICAgZHVwCiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxNwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS52cmZGYWlsdXJlQ291bnQgKz0gMQogICAgZnJhbWVfZGlnIDEyCiAgICBkdXAKICAgIHB1c2hpbnQgODMgLy8gODMKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGl0b2IKICAgIHB1c2hpbnQgODMgLy8gODMKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxOAogICAgLy8gcmV0dXJuCiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDIyCiAgICAvLyBjb25zdCBybmdTdGF0ZSA9IHBjZzY0SW5pdChzZWVkLnNsaWNlKDAsIDE2KSkKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTUKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGRpZyAyCiAgICA+PQogICAgcHVzaGludCAxNiAvLyAxNgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZnJhbWVfZGlnIDE0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE2CiAgICAvLyBhc3NlcnQoc2VlZC5sZW5ndGggPT09IDE2KQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTkKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjkyCiAgICAvLyBjb25zdCBzdGF0ZSA9IF9fcGNnMzJTdGVwKDAsIGluY3IpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTkKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIGludGMgNyAvLyAxNDQyNjk1MDQwODg4OTYzNDA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBhZGR3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6OTMKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KHN0YXRlLCBpbml0aWFsU3RhdGUpCiAgICB1bmNvdmVyIDIKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE5CiAgICAvLyBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDApLCBwY2dGaXJzdEluY3JlbWVudCksCiAgICBpbnRjIDcgLy8gMTQ0MjY5NTA0MDg4ODk2MzQwNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgY292ZXIgMgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjIwCiAgICAvLyBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDgpLCBwY2dTZWNvbmRJbmNyZW1lbnQpLAogICAgdW5jb3ZlciAyCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MTgKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KG11bExvdywgaW5jcikKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MjAKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgOCksIHBjZ1NlY29uZEluY3JlbWVudCksCiAgICBpbnRjIDggLy8gMTQ0MjY5NTA0MDg4ODk2MzQwOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjkzCiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhzdGF0ZSwgaW5pdGlhbFN0YXRlKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE3CiAgICAvLyBjb25zdCBbLCBtdWxMb3ddID0gb3AubXVsdyhzdGF0ZSwgcGNnTXVsdGlwbGllcikKICAgIGludGMgNSAvLyA2MzY0MTM2MjIzODQ2NzkzMDA1CiAgICBtdWx3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MjAKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgOCksIHBjZ1NlY29uZEluY3JlbWVudCksCiAgICBpbnRjIDggLy8gMTQ0MjY5NTA0MDg4ODk2MzQwOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE4LTIxCiAgICAvLyByZXR1cm4gWwogICAgLy8gICAgIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIC8vICAgICBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDgpLCBwY2dTZWNvbmRJbmNyZW1lbnQpLAogICAgLy8gXQogICAgc3dhcAogICAgaXRvYgogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDI2CiAgICAvLyBpZiAodXBwZXJCb3VuZCA8IE1BWF9VSU5UNjQpIHsKICAgIGZyYW1lX2RpZyAxMwogICAgaW50YyAxMiAvLyAxODQ0Njc0NDA3MzcwOTU1MTYxNQogICAgPAogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2lmX2JvZHlANAogICAgZnJhbWVfZGlnIDEzCiAgICBmcmFtZV9idXJ5IDExCgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjMwCiAgICAvLyBjb25zdCByZXN1bHQgPSBuZXcgRHluYW1pY0FycmF5PGFyYzQuVWludDY0PigpCiAgICBpbnRjXzAgLy8gMAogICAgaXRvYgogICAgZnJhbWVfYnVyeSAwCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjQyCiAgICAvLyBpZiAodXBwZXJCb3VuZCAhPT0gMCkgewogICAgZnJhbWVfZGlnIDExCiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9lbHNlX2JvZHlAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NDMKICAgIC8vIGFzc2VydCh1cHBlckJvdW5kID4gMSkKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPgogICAgYXNzZXJ0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjQ0CiAgICAvLyBhc3NlcnQobG93ZXJCb3VuZCA8IHVwcGVyQm91bmQgLSAxKQogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzMAogICAgLy8gY29uc3Qgcm5nUmVzdWx0ID0gcGNnNjRSYW5kb20ocm5nU3RhdGUsIDEsIHVwcGVyQm91bmQsIE1heEdsb2JhbFN0YXRlVWludDY0QXJyYXkpCiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo0NAogICAgLy8gYXNzZXJ0KGxvd2VyQm91bmQgPCB1cHBlckJvdW5kIC0gMSkKICAgID4KICAgIGFzc2VydAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfaWZfZWxzZUAxNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6OAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcofnZhbHVlLCAxKQogICAgZnJhbWVfZGlnIDQKICAgIGR1cAogICAgfgogICAgaW50Y18xIC8vIDEKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo1MwogICAgLy8gY29uc3QgdGhyZXNob2xkOiB1aW50NjQgPSBfX3VpbnQ2NFR3b3MoYWJzb2x1dGVCb3VuZCkgJSBhYnNvbHV0ZUJvdW5kCiAgICBzd2FwCiAgICAlCiAgICBmcmFtZV9idXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU1CiAgICAvLyBmb3IgKGxldCBpID0gVWludDY0KDApOyBpIDwgbGVuZ3RoOyBpID0gaSArIDEpIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDcKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9idXJ5IDMKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTUKICAgIC8vIGZvciAobGV0IGkgPSBVaW50NjQoMCk7IGkgPCBsZW5ndGg7IGkgPSBpICsgMSkgewogICAgZnJhbWVfZGlnIDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDMwCiAgICAvLyBjb25zdCBybmdSZXN1bHQgPSBwY2c2NFJhbmRvbShybmdTdGF0ZSwgMSwgdXBwZXJCb3VuZCwgTWF4R2xvYmFsU3RhdGVVaW50NjRBcnJheSkKICAgIHB1c2hpbnQgMTUgLy8gMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTUKICAgIC8vIGZvciAobGV0IGkgPSBVaW50NjQoMCk7IGkgPCBsZW5ndGg7IGkgPSBpICsgMSkgewogICAgPAogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfd2hpbGVAMjIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6OQogICAgLy8gY29uc3QgbmV3U3RhdGUxID0gX19wY2czMlN0ZXAoc3RhdGVbMF0sIHBjZ0ZpcnN0SW5jcmVtZW50KQogICAgZnJhbWVfZGlnIDMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjkKICAgIC8vIGNvbnN0IG5ld1N0YXRlMSA9IF9fcGNnMzJTdGVwKHN0YXRlWzBdLCBwY2dGaXJzdEluY3JlbWVudCkKICAgIGludGMgNyAvLyAxNDQyNjk1MDQwODg4OTYzNDA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBhZGR3CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGZyYW1lX2J1cnkgNQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjEwCiAgICAvLyBjb25zdCBuZXdTdGF0ZTIgPSBfX3BjZzMyU3RlcChzdGF0ZVsxXSwgbmV3U3RhdGUxID09PSAwID8gb3Auc2hsKHBjZ1NlY29uZEluY3JlbWVudCwgMSkgOiBwY2dTZWNvbmRJbmNyZW1lbnQpCiAgICBzd2FwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgOAogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3Rlcm5hcnlfZmFsc2VAMjYKICAgIHB1c2hpbnQgMjg4NTM5MDA4MTc3NzkyNjgxOCAvLyAyODg1MzkwMDgxNzc3OTI2ODE4CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV90ZXJuYXJ5X21lcmdlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICB1bmNvdmVyIDIKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czoxMgogICAgLy8gcmV0dXJuIFtbbmV3U3RhdGUxLCBuZXdTdGF0ZTJdLCBvcC5zaGwoX19wY2czMk91dHB1dChzdGF0ZVswXSksIDMyKSB8IF9fcGNnMzJPdXRwdXQoc3RhdGVbMV0pXQogICAgZnJhbWVfZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDkKICAgIGNhbGxzdWIgX19wY2czMk91dHB1dAogICAgcHVzaGludCAzMiAvLyAzMgogICAgc2hsCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgX19wY2czMk91dHB1dAogICAgfAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCBbbmV3U3RhdGUsIGNhbmRpZGF0ZV0gPSBfX3BjZzY0VW5ib3VuZGVkUmFuZG9tKHN0YXRlKQogICAgZHVwCiAgICBleHRyYWN0IDAgMTYKICAgIHN3YXAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo1OQogICAgLy8gaWYgKGNhbmRpZGF0ZSA+PSB0aHJlc2hvbGQpIHsKICAgIGZyYW1lX2RpZyAxMAogICAgPj0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VAMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NjAKICAgIC8vIHJlc3VsdC5wdXNoKG5ldyBhcmM0LlVpbnQ2NCgoY2FuZGlkYXRlICUgYWJzb2x1dGVCb3VuZCkgKyBsb3dlckJvdW5kKSkKICAgIGZyYW1lX2RpZyA2CiAgICBmcmFtZV9kaWcgNAogICAgJQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzAKICAgIC8vIGNvbnN0IHJuZ1Jlc3VsdCA9IHBjZzY0UmFuZG9tKHJuZ1N0YXRlLCAxLCB1cHBlckJvdW5kLCBNYXhHbG9iYWxTdGF0ZVVpbnQ2NEFycmF5KQogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NjAKICAgIC8vIHJlc3VsdC5wdXNoKG5ldyBhcmM0LlVpbnQ2NCgoY2FuZGlkYXRlICUgYWJzb2x1dGVCb3VuZCkgKyBsb3dlckJvdW5kKSkKICAgICsKICAgIGl0b2IKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgY29uY2F0IC8vIG9uIGVycm9yOiBtYXggYXJyYXkgbGVuZ3RoIGV4Y2VlZGVkCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgcmVwbGFjZTIgMAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU1CiAgICAvLyBmb3IgKGxldCBpID0gVWludDY0KDApOyBpIDwgbGVuZ3RoOyBpID0gaSArIDEpIHsKICAgIGZyYW1lX2RpZyA3CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSA3CiAgICBmcmFtZV9idXJ5IDMKICAgIGIgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfd2hpbGVfdG9wQDE2CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDIwOgogICAgZnJhbWVfYnVyeSAzCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxOAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfdGVybmFyeV9mYWxzZUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTAKICAgIC8vIGNvbnN0IG5ld1N0YXRlMiA9IF9fcGNnMzJTdGVwKHN0YXRlWzFdLCBuZXdTdGF0ZTEgPT09IDAgPyBvcC5zaGwocGNnU2Vjb25kSW5jcmVtZW50LCAxKSA6IHBjZ1NlY29uZEluY3JlbWVudCkKICAgIGludGMgOCAvLyAxNDQyNjk1MDQwODg4OTYzNDA5CiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3Rlcm5hcnlfbWVyZ2VAMjcKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX3doaWxlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo2NwogICAgLy8gcmV0dXJuIFtzdGF0ZSwgcmVzdWx0XQogICAgZnJhbWVfZGlnIDMKICAgIHB1c2hieXRlcyAweDAwMTIKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUud2lubmluZ1RpY2tldHMgPSBkZWNvZGVBcmM0PHVpbnQ2NFtdPihybmdSZXN1bHRbMV0uYnl0ZXMpCiAgICBkdXAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBmcmFtZV9kaWcgMTIKICAgIGR1cAogICAgY292ZXIgMgogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1NyAvLyA1NwogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICBleHRyYWN0MwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgYm94X2RlbAogICAgcG9wCiAgICBkaWcgMQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUudnJmRmFpbHVyZUNvdW50ID0gMAogICAgcHVzaGludCA4MyAvLyA4MwogICAgZnJhbWVfZGlnIDAKICAgIGJveF9yZXBsYWNlCiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2Vsc2VfYm9keUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTAKICAgIC8vIGFic29sdXRlQm91bmQgPSBvcC5idG9pKEJ5dGVzKEJpZ1VpbnQoMiAqKiA2NCkgLSBCaWdVaW50KGxvd2VyQm91bmQpKSkKICAgIGludGMgMTIgLy8gMTg0NDY3NDQwNzM3MDk1NTE2MTUKICAgIGZyYW1lX2J1cnkgNAogICAgYiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDE1CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9pZl9ib2R5QDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAyNwogICAgLy8gdXBwZXJCb3VuZCArPSAxCiAgICBmcmFtZV9kaWcgMTMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDExCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlKCkgLT4gdWludDY0OgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU2CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1NgogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgIT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU2LTExNTcKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIC8vIChHbG9iYWwubGF0ZXN0VGltZXN0YW1wID49IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUpICYmCiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZV9ib29sX2ZhbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1Ni0xMTU3CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF9mYWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1OAogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNgogICAgLy8gZW5kVGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbmRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE2IC8vICJlbmRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTgKICAgIC8vIChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlIHx8IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlID09PSAwKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDw9CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF90cnVlQDQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyBlbmRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVuZFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTYgLy8gImVuZF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1OAogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlX2Jvb2xfZmFsc2VANQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF90cnVlQDQ6CiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTUtMTE1OQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gICAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgLy8gICAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8PSB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSB8fCB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSA9PT0gMCkKICAgIC8vICkKICAgIHJldHN1YgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF9mYWxzZUA1OgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU1LTExNTkKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPj0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJiYKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICAvLyApCiAgICByZXRzdWIK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyANAAEIXKCNBq3+1eTUhf2oWJADz4Keu+/v3oIU0YKeu+/v3oIU/////w+oiwPUkwH///////////8BJikJYWtpdGFfZGFvC2VudHJ5X2NvdW50BBUffHUBAAR0eXBlAgAAA2FhbAxha2l0YV9lc2Nyb3cBCgFlBnN0YXR1cwdjcmVhdG9yARQBHhJhbGxvd19sYXRlX3NpZ251cHMPc3RhcnRfdGltZXN0YW1wDWVuZF90aW1lc3RhbXAHZ2F0ZV9pZAttYXhfZW50cmllcwGABndhbGxldAFyFGFraXRhX3JveWFsdHlfYW1vdW50AXUQc2lnbnVwX3RpbWVzdGFtcAxyZXdhcmRfY291bnQJc3Rha2Vfa2V5DWFraXRhX3JveWFsdHkFdGl0bGUUbWluaW11bV9zdGFrZV9hbW91bnQBYQx0b3RhbF9zdGFrZWQEcblXrwNvYWwEc2FsdANzYWwDcGFsEmNvbnRyb2xsZWRfYWRkcmVzcxgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJAAAAAAAAAAAABMkGiAkxGEAAGycYImcnDiJnJw8iZycQImcpI2cnGSNnJx8iZ4ICBKuLIToE6pGA3TYaAI4CAM0AwTEZFEQxGEEAqoICBIPxR0gEOU6usicgghQEoAzOxgQYHoKRBP3P3/YEuC7RswSpQY03BPxpnLMEbnayygTNsKxqBLIj010EvFitCQSOCCSEBDP3iAgEWS7jQQSPpKFgBOM3c0IEcXBGSQQerSCpBDPpLJQEhU3t4ASks+eJNhoAjhcCdAMJBH8EmATUBXYF0gXoBvgHBg4rDqUOyQ9BD2sPlw/PD90P+BCeEPcAAREUACNDgASi88VENhoAjgEBFQAxGYEEEjEYEERCEJ8xGYEFEjEYEERCAqGKAQGL/4ESkYv/G4EbkSEJGov/gTuRSpFMHCMeRQGBHxpPAkyQIQkaGYmKAgGL/ichZUiBGFuxgAQ8Gm8zshqL/7IashiBBrIQIrIBs7Q+SVcEAEsBVwAEKhJESSJZgQIITBUSRFcGAEkVSUEAB4sBJBNBAAQijACJiwAXQv/3igQBi/w4GIv9JwZlSIEoWxJBADqL/DgZQAAzi/w4G4EEEkEAKYv8IsIagARDkiZVEkEAGov8I8Iai/4SQQAPi/yBAsIai/8WEkEAAiOJIomKAwEii/6L/3AARQFAABqL/YAOcmV2ZW51ZV9zcGxpdHNlSCJZIwiMAIsATImAAEk2GgFJIlmBAghLARUSRFcCADYaAkkVIxJENhoDSRWBIBJENhoESRWBKBJENhoFSRWBIBJENhoGNhoHSRUkEkQXNhoISRUjEkQiUzYaCUkVJBJEFzYaCkkVJBJEFzYaC0kVJBJEFzYaDEkVJBJEFycKK2cnHE8MZycETwtnJwtPCmeABmZ1bmRlck8JZ4ALbWFya2V0cGxhY2VPCGcnGk8HZycdTwZnJw5PBWcnEU8EZycSTwNnJyIxF2coTwJnJwdMZyIoZURJgAxzdGFraW5nX2ZlZXNlSEwnI2VIgRBbIkxBADsiKGVEIicLZUSxTCcjZUiBEFuABNV0uxCyGrIYshqBBrIQIrIBs7Q+SVcEAExXAAQqEkRJFSQSRBdFAUsBSSRbRQSBEFtFBEkjDUEAF0kjCUsESUsFCU8CC4HoBwoJJxtMZyNDI0L/6DIOMgkSRCInEWVEQQBGsSIoZUQnBmVIgShbIicRZUQWgATfVrAeshqyGrIYgQayECKyAbO0PklXBABMVwAEKhJESRUkEkQXgAlnYXRlX3NpemVMZyNDNhoBSRWBIBJEMQAyCRJEIicLZUQSRCInCmVEKxJAAAsyByInEGVEDUEAECNEsTIJsgkjshAisgGzI0MiQv/tMRYjCUk4ECMSRDYaAUkVJBJEF0kxACInC2VEEkQyEEwiJwdlRHIIRExwAEUBQAAVIihlRCInB2VEcghESwOI/a4yEAsISwJJOAcyChJMOAhPAoHAPggSEESxMgpLAUlOArIRIrISshSBBLIQIrIBsyIoZURJJxRlSExJJyRlSElXCAhMJFtLAicUZUixgASiQD3fshqADgABAAIACHJldl9wb29sshqyGIEGshAisgGztD5JVwQASwFXAAQqEkRJIlmBCQuBAghMFRJEVwYJIltJRCInB2VETEsBEkSxgARYL/OCshpPArIaJxOyGoAKAAhyZXZfcG9vbLIagAoAAQAAAAAAAAAAshonBbIaSwOyGIEGshAisgFyCERPAkxLBIj8yzIQC7YiJwdlRHIIRLIHsggjshAisgG2SwEWTwMWgAIAAUxQgARoNeO8shpMshonE7IashqyGIEGshAisgG2gARsw/YGshqyGIEGshAisgGzI0MxFiMJSTgQIxJENhoBSRWBKRJEiBBsSCNDMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVgSkSREsBOBQyChJLAjgRSwIiWxIQTwI4EhBEiBAwSCNDNhoBSRUkEkQXSTYaAkkVJBJEF0w2GgNJFSQSRBdMMQAiJwtlRBJEIicKZUQrEkQyBw1AAA1LAkAAZCInDmVEQQBcI0RLAUEACEsBMgcNQQBJI0RLAUAAE0sCQAA5IicOZURBADEjRDIHRQJJQQALSwGBCghLAQxBABgjRCcYSwNnJw9LAmcnEEsBZycKJwhnI0MiQv/lIkL/zCJC/7QiQv+hMRaBAglJOBAjEkQxFiMJSTgQgQYSRDYaASIoZUQxAIj6u0kxAExAACpJIicRZURJRCIoZURLBklPAk8ETwSI+vFEgQPCGksFSwRPAogNMUYCI0NLASclZUhC/84xFiMJSTgQIxJENhoBJwWIDRJGAiNDNhoBSRUkEkQXiBJQRBYnFUxQRwK9RQFESYFbI7pLAYEZJLoXSwKBdCS6F04DTwKBESS6F04CTCsSRDIHSSInD2VECU8CGAlJMgcTQQC+SwJLAQxBALYjRCInHGVEgAogLSBSZXdhcmRzUDIHSwMIIihlRCcGZUgkW0sCFSEGSwELgeSTAghLAnIIRLGyB7III7IQIrIBthZXBgJPA1AiFk8DFoAEHJ6727IaTwKyGksBshqyGicFshpMshiBBrIQIrIBs7cBPklXBABMVwAEKhJESRUkEkRLBUmBIUsEu0mBKU8Eu0mBWycIuyMWSwElTwK7SYFkTwO7MgYWSwGBbE8CuzIHFoF0TLsjQyJC/0c2GgFJFSQSRBeIDyUjQyJHAoAARxs2GgFJFSQSRBdJNhoCSRUkEkQXTBYnFUxQRwK9RQFESYFbI7pJTwIkI7pMJwgSSUAACEsCJwwSQQbgI0RJQQHQSwNJJSS6F0lOAkUXSSQjukUESYEJJLoXRQ8iJLoXRR0iRRsiRQlLBQgiKWVEDUEACSIpZURLFQlFBUsURRJLFEsFCEsSDUEArksRFicJTFBJRSRJgTIjuiJTTIEoJLoXRQ9AACciJwRlRCsSQQAmgAmAAAAAAAAAAAAiU0EADUsZIwhFGksHSw4IRQhLESMIRRJC/6kiJwRlRCcIEkEAT0siSSKBILpMgSAkurEiKGVEJwZlSCJbgATG/QylshpPArIaTLIaJxOyGrIYgQayECKyAbO0PklXBABMVwAEKhJESRUkEkQXFicTTFBC/4tLEYgJB0L/g0sDSYEhJLoXSxsIFksBgSFPArtJgSkkuhdLCQgWgSlMuyIpZURLFRJBAJ1LA0mBWycMuyMWSwElTwK7gSEkuhdFD0sBJwwSQQBpSw5LDQsiJxtlREkhBA5EHSEEl0UdJxZLHUlOAmdBABtLG0AAGLEiJwdlRHIIREsdsgiyByOyECKyAbMjQyInB2VESXIIREsdSU4CcABFAUSxTHIIREyyEUsdshKyFIEEshAisgGzQv/SIicbZURJIQQOREsNHSEEl0UdQv+XSwNJJSS6F0sGCBYlTLtC/6xLAScIEkEA+UsDSSIkuhdFHUklJLoXSU4CRRdJgWQkuhdFJEmBKSS6F0USgQkkuhdFHksFCCIpZUQNQQAJIillREsVCUUFIicWZURLHUwJRSEnBUUkIkULSxRFEksUSwUISUUKSxINQQBjSxEWJwlMUEmBMiO6IlNLAYEoJLoXRRBMIoEgukUmQAA5Sw1JSxFJTgMMRCEEHU8Cl0khBA5ESyEdIQSXSRZLJkxQSyVJTwJQTCJZIwgWVwYCXABFJUsLCEULSxEjCEUSQv+PSwNJJSS6F0sGCBYlTLtLIUscSyVLDYgIXUgiKWVESwkSQf67SwNJgVsnDbsjFiVMu0L+q0sBJwwSQQD8SwNJgWQkuhdFI0klJLoXRRZJgSEkuhdLAYEJJLoXTwIiJLoXSU4DRR9MSwELIicWZUQJRQoiJxtlREkhBA5ESwEdIQSXCUUgQACnMgpzAERLCA9ESxRLBQgiKWVEDUEACSIpZURLFQlFBScFRSQiRQtLFEUSSxRLBQhJRRJLEg1BAEFLERYnCUxQSYEyI7oiU0wigSC6RSZAACBLHkkWSyZMUEslSU8CUEwiWSMIFlcGAlwARSVLCwhFC0sRIwhFEkL/sUsQSRZLBSVPArtLIksdSyZLDogHYUgiKWVEEkH9wUsDSYFbJw27IxYlTLtC/bEyCksccABIQv9USwEnDRJBAO9LA0mBZCS6F0UjSSUkuhdFFkmBISS6F0UQSSIkuhdJTgJFHoEJJLoXRQ5AALgyCnMARCInFmVESw5MCUlFIg9ESxRLBQgiKWVEDUEACSIpZURLFQlFBUsfSw8KRR0nBUUkIkUeSxRFEksUSwUISUUSSxINQQBBSxEWJwlMUEmBMiO6IlNMIoEgukUmQAAgSxxJFksmTFBLJUlPAlBMIlkjCBZXBgJcAEUlSx4IRR5LESMIRRJC/7FLEEkWSwUlTwK7SyJLHUsmSyGIBmpIIillRBJB/MpLA0mBWycNuyMWJUy7Qvy6MgpLHHAASEL/Q0sBgAEoEkRLA4MCfAK6F0AACEsFiAn5QvyWSwNJgWQkuhdFI0klJLoXRRZJgTEkuhdFCEkiJLoXSU4CRR6BCSS6F0UMQAG9MgpzAEQiJxZlREsMTAlJRSIPREsUSwUIIillRA1BAAkiKWVESxUJRQVLBkABhUsfRR1LA0mDAjsYukkkW0lOA0UPSSJbSU4CRQ6BEFtFGSQLgX4IJLoXRRgnBUUlRRhLFEUTSxRLBQhLEw1BAKlLEhYnCUxQSYEyI7oiU0UVSSKBILpFJkmBICS6F0UcgSgkuhdLGElPAghFGksXDkEBBEsWSxkOQQD8SxNAACBLHBZLJUxQSyRJTwJQTCJZIwgWVwYCXABFJEsVIwhFFksDgwJ8AroXIwlLChJBAJxLBksWE0EAMiMWSwRJTgIlTwK7SYE7Jya7Sb5ISYE5WSJMWCcFUEsBvEi/SyFLG0slSw2IBO1IQvtSSyFLHEslSw2IBN5ISwZLFhJBACtLA0mBWycNuyMWSwElTwK7SYE7Jya7Sb5ISYE5WSJMWCcFUEsBvEi/QvsTSwNJJSS6F0sGCBZLASVPArtLChZLDRZQSxcWUIE7TLtC+vBLBEsTCUUFSwkjCElFCyQLgX4ISwRJTwIkuhdFGCMWJUy7IkUTIkUMIkUZSxgjCEUYSxIjCEUTQv6wSx9LBwpFHUL+dTIKSxxwAEhC/j4iQvkdNhoBSRUkEkQXFicVTFBJvUUBREmBWyO6SwGBZCS6SRdPAicNEkQiKGVEJwZlSCRbsYAEVCSlkbIaTwKyGrIYgQayECKyAbMWgAFkTFAiuUhJgVsruyIWSwGBZEsCu0sBgWxLArsjFksCJU8Cu0sBgSFLAruBKUy7I0M2GgFJFYEgEkQ2GgJJFSQSRBcWUCceTFC+RBeIAoUqTFCwI0MxFiMJSTgQgQYSRDYaAUcCFYEgEkQ2GgJJFSQSRBdMIihlREyI8WNJQABFSwIiKGVEIicRZURLBk8CTwNPA4jxnkAAKiInBGVEJwgTQQAfSwEWSwNMUCceTFC+RBcWJwlMUEmBMiO6IiNUgTJMuyNDSSclZUhC/7U2GgFJFYEgEkQ2GgJJFSQSRBchCgtMJxdMUL1FAUAAAyELCBYqTFCwI0M2GgFJFSQSRBciKGVEIicHZURyCERPAojxczIQI08CCAuBwD4IFipMULAjQyInCmVEKxNBACoyByInGGVEDUEAHzIHIicPZUQMQAAIIicOZURBAAwjKyJPAlQqTFCwI0MiQv/xiAhyKyJPAlQqTFCwI0M2GgFJFYEgEkQnF0xQvUUBKyJPAlQqTFCwI0MiJwplRCInHGVEIicEZUQiJxhlRCInDmVEIicPZUQiJxBlRCInEmVEIillRCMIIicZZUQjCCInH2VEIicaZUQiJx1lRCInEWVEIicLZURLDRUWVwYCTw5QTw6AAgBvUEsBFYFvCExPD1BPDhZQTwwWUCsiTw5UUE8LFlBPChZQTwkWUE8IFlBPBxZQTBZXBgJQTwQWUE8DFlBPAlBMUExQKkxQsCNDNhoBSRUkEkQXMQAiKGVEJxRlSHIIRBJEJwdMZyNDNhoBSSJZgQIISwEVEkRXAgAxACIoZURJJxRlSHIIRE8CEkQnJGVIgRBbMg0SRIAHdmVyc2lvbkxnI0M2GgFJFSQSRBcxACIoZUQnFGVIcghEEkQoTGcjQzYaAUkVJBJEFyEGCxaAGAAAAAAAAGLUAAAAAAAASdQAAAAAAABi1ExQgdQvFlAqTFCwI0OKAQEiRwKAAEcDIicEZUQrE0AACyInBGVEJwgTQQD1I0SL/xYnCUxQSYwCSYEyI7oiU0sBIoEguowASwGBICS6SYwBF4wDTIEoJLoXjARBAAUnJ4wAiSInBGVEJwwSQQBhsSIoZUQnBmVIIluABEhxbxqyGosAshqLAbIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKhJEFYEJEkSBBVtJjAWLBA9BAAqLBRYnE0xQjACJiwJJgTIjuiIjVIEyTLsnJ4wAibEiKGVEJwZlSCJbIicEZUSLAxZMUCcoshqLALIashqyGIEGshAisgGztD5JVwQASwFXAAQqEkQVgRgSRIEEW0mMBosED0H/pYsGFicTTFCMAIkiQv8IigQBIihlRCcGZUgkW4v+IlmB1MUBC0yL/UAANLFJcghEiwCL/wiyCLIHI7IQIrIBtov8FoAEVlas+bIashqL/rIashiBBrIQIrIBs4v+TImxSXIIRIsAsgiyByOyECKyAbZJcghEi/+yEov9shGyFIEEshAisgG2i/wWgAQ8YN+gshqyGov+shqyGIEGshAisgGzQv+2igMCIkcCgABHBIgFQUQiKWVEIwgiJxJlRA5AAAgiJxJlREAB4iNEi/4iWUmMAyEKCycXMQBQvUUBQAADIQsIi/04BzIKEov9OAhPAg8QRCInGmVESVcAIIwASYEgWUsBFVKMASKMBYsFiwMMQQGPi/5XAgCLBUlOAoECC0sBTFlPAiMISYwFiwNLAQlLAxVPAoECC0sETFlPAk1SSYwCJFtJjAYiJx1lRA9EiwAyAxNBAFuxIihlRCcGZUiBSFuLAklXAAgBAUsBgRBZSwIVTwNOAlIjFoAEK/PMWrIaiwCyGosBshpPArIaTLIashqyGIEGshAisgGztD5JVwQATFcABCoSREkVIxJEIlNEIicEZUQnCBJAAAsiJwRlRCcMEkEAIIsCIltJjAdBAMQxAIsHcABMjARBALSLBIsGD0EArCNEIicEZUQrE0EARbEiKGVEJwZlSCJbMQCLAlcACCInBGVEUCcoshpMshqyGrIYgQayECKyAbO0PklXBABLAVcABCoSRBWBGBJEgQRbiwYPRCIpZURJIwgpTGcxAIsCVwAITEsBUIsGFlCAAwAzAFCL/1BPAhYnCUsBUEm8SE8CvzEATwJQSVcAIExXIAhQJx5MUEy/JxcxAFC9RQFA/oUnFzEAUIsDFr9C/nkiQv9RIzEAcwBMjAREQv85i/6L/4wBjACJIkL+G4oCASKAADEAIicLZUQSRCLAGicgEkEABov/IlsURCInGmVEVwAgMgMTQAALi/9XCAEnCBNBAO0jRIv/VwgBSYwAgAEoEkEAG4v/gQlbi/+BIVtJjAENQQDHiwGBCg5BAL8jRIsAJw0SQQAYIicSZURBAA4iJxJlRIv/gQlbDkEAmyNEi/+BCVtJRIv+OAcyChKL/jgIi/+BIVshBksBC08CDk8CEEQiJxllREkjCCcZTGeL/1cACIv/VxEIi/9XGQgiFk8DiwBQTwYWUE8DUE8CUEsBUEsBUE8DFlCAGgB8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAUEsBUCtQIxZQSwFQSwFQTFAnBVBMFicVTFBJvEhMv4v/jACJIkL/YiJC/z4iQv8QigEAIkcDgABHB4v/FicVTFBHAr1FAURJgVsjuksBgWwkuhdLAoFTJLoXSwOBKSS6F04ETwInDBJETwKDAnwCuhcUREwjCIEETwILCLEyCCchZUgiW0wWIiciZURJFRZXBgJMUIAEGJOSxbIaTLIashqyGIEGshAisgGztD5JVwQASwFXAAQqEkRJIlmBAghMFRJEVwYASRVJQAAQiwxJgVMkuhcjCBaBU0y7iSKLD0lOAg8iSwJPAk2BEEsCD4EQTwNPAk2LDk4CUkkVgRASREkiWyIhBR1FAUkhBx5FAU8CHkUBIQUdRQEhBx5OAkhPAiRbTCEIHkUBHkUBIQUdRQEhCB5FAUwWTBZQjAKLDSEMDEAA/YsNjAsiFowAJwWMAYsLQQDliwtJIw1EIwlJjAQjDUSLBEkcIx5FAUwYjAoijAeLAowDiweBDwxBAIyLA0kiW0mMCSEFHUUBIQceSU4CjAVITCRbjAhAAGmBooW89t7fvYUoiwhJIQUdRQFPAh5FAYsFFkwWUIsJiOhxgSCQTwKI6GkZFlBJVwAQTIEQW0mMBosKD0EAJosGiwQYIwgWiwFJTwJQTCJZIwgWVwYCXACMAYsHIwiMB4wDQv92jANC/3khCEL/nIsDgAIAElCLAVBJgRBZSwEVUosMSU4CvkRJgTlZIkxYTFBLAbxISwFMv4FTiwC7iSEMjARC/yKLDSMIjAtC/v4iJwplRCsTQQAgMgciJw9lRA9BABUyByInEGVEDkAACCInEGVEQAACI4kiiQ==\",\"clear\":\"C4EBQw==\"},\"sourceInfo\":{\"approval\":{\"sourceInfo\":[{\"pc\":[4422,4524,6820],\"errorMessage\":\"Box must have value\"},{\"pc\":[779,1222,1340,1615,2369,2741,5219,5320,5763,5892,6457],\"errorMessage\":\"Bytes has valid prefix\"},{\"pc\":[2917],\"errorMessage\":\"DAO not opted in to the asset\"},{\"pc\":[4312],\"errorMessage\":\"Disbursement not ready for finalization\"},{\"pc\":[1635],\"errorMessage\":\"Escrow does not exist\"},{\"pc\":[1453,6039],\"errorMessage\":\"Forbidden\"},{\"pc\":[2111],\"errorMessage\":\"Gate check failed\"},{\"pc\":[4977],\"errorMessage\":\"Invalid app upgrade\"},{\"pc\":[6377],\"errorMessage\":\"Invalid disbursement phase\"},{\"pc\":[1513,5580,6190],\"errorMessage\":\"Invalid payment\"},{\"pc\":[2852,2952,3140,3308],\"errorMessage\":\"Invalid percentage\"},{\"pc\":[3129],\"errorMessage\":\"Invalid percentage of args\"},{\"pc\":[5104],\"errorMessage\":\"Invalid pool type for check\"},{\"pc\":[1877],\"errorMessage\":\"Invalid transfer\"},{\"pc\":[6155],\"errorMessage\":\"Max entries cannot be greater than rate\"},{\"pc\":[6054],\"errorMessage\":\"Must be an Algo asset\"},{\"pc\":[3329,3569,3834],\"errorMessage\":\"Not enough funds\"},{\"pc\":[2512],\"errorMessage\":\"Not ready to disburse\"},{\"pc\":[685],\"errorMessage\":\"OnCompletion must be DeleteApplication \\u0026\\u0026 can only call when not creating\"},{\"pc\":[475],\"errorMessage\":\"OnCompletion must be NoOp\"},{\"pc\":[673],\"errorMessage\":\"OnCompletion must be UpdateApplication \\u0026\\u0026 can only call when not creating\"},{\"pc\":[4925,4966,5014],\"errorMessage\":\"Only the Akita DAO can call this function\"},{\"pc\":[6123],\"errorMessage\":\"Rate must be greater than winner count\"},{\"pc\":[6162],\"errorMessage\":\"Rate must be greater than zero\"},{\"pc\":[6081],\"errorMessage\":\"Stake key required\"},{\"pc\":[6387],\"errorMessage\":\"Winning tickets already exist\"},{\"pc\":[1645],\"errorMessage\":\"Wrong escrow for this operation\"},{\"pc\":[3325,3555,3820,6008],\"errorMessage\":\"account funded\"},{\"pc\":[1464,1484,1708,1728,2298,2883,2907,2922,4615,4923,4962,5012,5384,5436,5453],\"errorMessage\":\"application exists\"},{\"pc\":[1376],\"errorMessage\":\"call must come from factory\"},{\"pc\":[1141,1175,1180,1286,1294,1306,1381,1388,1400,1451,1461,1476,1481,1540,1640,1725,1920,1927,1946,1981,2074,2092,2098,2225,2252,2274,2561,2569,2622,2670,2693,2800,2847,2880,2903,2947,3037,3045,3055,3215,3295,3303,3338,3346,3467,3560,3578,3586,3714,3825,3843,3851,4316,4467,4481,4486,4505,4607,4612,4643,4655,4666,4675,4740,4745,4750,4755,4760,4765,4770,4775,4779,4786,4793,4798,4803,4808,4813,4916,4954,5005,5086,5096,5162,5173,5270,5281,5358,5519,5526,5535,5585,5670,5685,5776,5787,5830,5840,5858,5908,6037,6059,6136,6144,6195,6412,6864,6876,6887,6896],\"errorMessage\":\"check GlobalState exists\"},{\"pc\":[2247],\"errorMessage\":\"distribution window is not open\"},{\"pc\":[5771],\"errorMessage\":\"failed to verify stake requirements\"},{\"pc\":[2094],\"errorMessage\":\"gate id is not set\"},{\"pc\":[1986],\"errorMessage\":\"if the starting round is zero, the signup round must be zero and allowLateSignups must be true\"},{\"pc\":[5641],\"errorMessage\":\"index access is out of bounds\"},{\"pc\":[5224],\"errorMessage\":\"invalid number of bytes for (bool1,uint64)\"},{\"pc\":[1628],\"errorMessage\":\"invalid number of bytes for (len+(uint64,bool1)[])\"},{\"pc\":[789,6467],\"errorMessage\":\"invalid number of bytes for (len+uint8[])\"},{\"pc\":[957,4945],\"errorMessage\":\"invalid number of bytes for (len+utf8[])\"},{\"pc\":[5325,5897],\"errorMessage\":\"invalid number of bytes for (uint64,uint64,uint64)\"},{\"pc\":[1817,1854],\"errorMessage\":\"invalid number of bytes for (uint64,uint8,uint64,uint64,uint64,uint64)\"},{\"pc\":[986],\"errorMessage\":\"invalid number of bytes for (uint8[32],uint64)\"},{\"pc\":[1015,5768],\"errorMessage\":\"invalid number of bytes for bool8\"},{\"pc\":[1006,1025,1034,1043,1052,1227,1345,1442,1891,1901,1911,2167,2374,2439,2460,2470,2746,4282,4413,4461,4569,4602,4909,4998,5027],\"errorMessage\":\"invalid number of bytes for uint64\"},{\"pc\":[968],\"errorMessage\":\"invalid number of bytes for uint8\"},{\"pc\":[977,995,1370,4405,4453,4561,4717],\"errorMessage\":\"invalid number of bytes for uint8[32]\"},{\"pc\":[3158,3414,3661,4001,6761],\"errorMessage\":\"max array length exceeded\"},{\"pc\":[1383],\"errorMessage\":\"only the creator can delete the pool\"},{\"pc\":[1922],\"errorMessage\":\"only the creator can finalize the pool\"},{\"pc\":[1281],\"errorMessage\":\"only the factory can init the pool\"},{\"pc\":[5540],\"errorMessage\":\"pool has reached maximum entries\"},{\"pc\":[5672],\"errorMessage\":\"quantity is less than minimum stake amount\"},{\"pc\":[2183,2483,4293,6343],\"errorMessage\":\"reward does not exist\"},{\"pc\":[2217],\"errorMessage\":\"reward is already in a disbursement phase\"},{\"pc\":[2007],\"errorMessage\":\"the ending round must be zero or after the starting round + 10\"},{\"pc\":[2172,5515],\"errorMessage\":\"the pool is not live\"},{\"pc\":[1406],\"errorMessage\":\"the pool must be in draft or ended\"},{\"pc\":[1930],\"errorMessage\":\"the pool must be in draft state to finalize\"},{\"pc\":[1951],\"errorMessage\":\"the signup round must be zero and late sign ups allowed or in the future\"},{\"pc\":[1966],\"errorMessage\":\"the starting round must be zero or in the future\"},{\"pc\":[2067,4443],\"errorMessage\":\"transaction type is appl\"},{\"pc\":[1845],\"errorMessage\":\"transaction type is axfer\"},{\"pc\":[1434,1808,1834,2056,2147],\"errorMessage\":\"transaction type is pay\"},{\"pc\":[3751],\"errorMessage\":\"unknown reward rate type\"},{\"pc\":[5904],\"errorMessage\":\"user does not have enough staked\"},{\"pc\":[5825],\"errorMessage\":\"user does not have min balance\"}],\"pcOffsetMethod\":\"none\"},\"clear\":{\"sourceInfo\":[],\"pcOffsetMethod\":\"none\"}},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}}}"
+var AppSpecJSON = "{\"name\":\"StakingPool\",\"structs\":{\"AddRewardParams\":[{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"distribution\",\"type\":\"uint8\"},{\"name\":\"rate\",\"type\":\"uint64\"},{\"name\":\"expiration\",\"type\":\"uint64\"},{\"name\":\"interval\",\"type\":\"uint64\"},{\"name\":\"winnerCount\",\"type\":\"uint64\"}],\"EntryData\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"quantity\",\"type\":\"uint64\"},{\"name\":\"gateArgs\",\"type\":\"byte[][]\"},{\"name\":\"disqualified\",\"type\":\"bool\"}],\"EntryKey\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"asset\",\"type\":\"uint64\"}],\"FunderInfo\":[{\"name\":\"account\",\"type\":\"address\"},{\"name\":\"amount\",\"type\":\"uint64\"}],\"ObjectC3416591\":[{\"name\":\"valid\",\"type\":\"bool\"},{\"name\":\"balance\",\"type\":\"uint64\"}],\"RaffleCursor\":[{\"name\":\"ticket\",\"type\":\"uint64\"},{\"name\":\"stake\",\"type\":\"uint64\"},{\"name\":\"disbursed\",\"type\":\"uint64\"}],\"Reward\":[{\"name\":\"asset\",\"type\":\"uint64\"},{\"name\":\"distribution\",\"type\":\"uint8\"},{\"name\":\"rate\",\"type\":\"uint64\"},{\"name\":\"expiration\",\"type\":\"uint64\"},{\"name\":\"interval\",\"type\":\"uint64\"},{\"name\":\"qualifiedStakers\",\"type\":\"uint64\"},{\"name\":\"qualifiedStake\",\"type\":\"uint64\"},{\"name\":\"winnerCount\",\"type\":\"uint64\"},{\"name\":\"winningTickets\",\"type\":\"uint64[]\"},{\"name\":\"raffleCursor\",\"type\":\"RaffleCursor\"},{\"name\":\"vrfFailureCount\",\"type\":\"uint64\"},{\"name\":\"phase\",\"type\":\"uint8\"},{\"name\":\"disbursementCursor\",\"type\":\"uint64\"},{\"name\":\"activeDisbursementID\",\"type\":\"uint64\"},{\"name\":\"activeDisbursementRoundStart\",\"type\":\"uint64\"},{\"name\":\"lastDisbursementTimestamp\",\"type\":\"uint64\"}],\"RootKey\":[{\"name\":\"address\",\"type\":\"address\"},{\"name\":\"name\",\"type\":\"string\"}],\"StakingPoolMBRData\":[{\"name\":\"entries\",\"type\":\"uint64\"},{\"name\":\"uniques\",\"type\":\"uint64\"},{\"name\":\"entriesByAddress\",\"type\":\"uint64\"},{\"name\":\"rewards\",\"type\":\"uint64\"},{\"name\":\"disbursements\",\"type\":\"uint64\"}],\"StakingPoolState\":[{\"name\":\"status\",\"type\":\"uint8\"},{\"name\":\"title\",\"type\":\"string\"},{\"name\":\"type\",\"type\":\"uint8\"},{\"name\":\"signupTimestamp\",\"type\":\"uint64\"},{\"name\":\"startTimestamp\",\"type\":\"uint64\"},{\"name\":\"allowLateSignups\",\"type\":\"bool\"},{\"name\":\"endTimestamp\",\"type\":\"uint64\"},{\"name\":\"maxEntries\",\"type\":\"uint64\"},{\"name\":\"entryCount\",\"type\":\"uint64\"},{\"name\":\"rewardCount\",\"type\":\"uint64\"},{\"name\":\"totalStaked\",\"type\":\"uint64\"},{\"name\":\"stakeKey\",\"type\":\"RootKey\"},{\"name\":\"minimumStakeAmount\",\"type\":\"uint64\"},{\"name\":\"gateID\",\"type\":\"uint64\"},{\"name\":\"creator\",\"type\":\"address\"}]},\"methods\":[{\"name\":\"create\",\"args\":[{\"type\":\"string\",\"name\":\"title\"},{\"type\":\"uint8\",\"name\":\"type\"},{\"type\":\"address\",\"name\":\"creator\"},{\"type\":\"(address,uint64)\",\"name\":\"funder\",\"struct\":\"FunderInfo\"},{\"type\":\"address\",\"name\":\"marketplace\"},{\"type\":\"(address,string)\",\"name\":\"stakeKey\",\"struct\":\"RootKey\"},{\"type\":\"uint64\",\"name\":\"minimumStakeAmount\"},{\"type\":\"bool\",\"name\":\"allowLateSignups\"},{\"type\":\"uint64\",\"name\":\"gateID\"},{\"type\":\"uint64\",\"name\":\"maxEntries\"},{\"type\":\"uint64\",\"name\":\"akitaDAO\"},{\"type\":\"uint64\",\"name\":\"akitaDAOEscrow\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[\"NoOp\"],\"call\":[]},\"readonly\":false},{\"name\":\"init\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"delete\",\"args\":[{\"type\":\"address\",\"name\":\"caller\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"DeleteApplication\"]},\"readonly\":false},{\"name\":\"optIn\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\",\"desc\":\"The payment transaction\"},{\"type\":\"uint64\",\"name\":\"asset\",\"desc\":\"The asset to be opted into\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false,\"desc\":\"optin tells the contract to opt into an asa, it may also require the akita dao escrow to opt in\"},{\"name\":\"addReward\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"(uint64,uint8,uint64,uint64,uint64,uint64)\",\"name\":\"reward\",\"struct\":\"AddRewardParams\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"addRewardAsa\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"axfer\",\"name\":\"assetXfer\"},{\"type\":\"(uint64,uint8,uint64,uint64,uint64,uint64)\",\"name\":\"reward\",\"struct\":\"AddRewardParams\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"finalize\",\"args\":[{\"type\":\"uint64\",\"name\":\"signupTimestamp\"},{\"type\":\"uint64\",\"name\":\"startTimestamp\"},{\"type\":\"uint64\",\"name\":\"endTimestamp\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gatedEnter\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"(uint64,uint64,byte[32][])[]\",\"name\":\"entries\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"enter\",\"args\":[{\"type\":\"pay\",\"name\":\"payment\"},{\"type\":\"(uint64,uint64,byte[32][])[]\",\"name\":\"entries\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"startDisbursement\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"raffle\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"disburseRewards\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"},{\"type\":\"uint64\",\"name\":\"iterationAmount\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"finalizeDistribution\",\"args\":[{\"type\":\"uint64\",\"name\":\"rewardID\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"check\",\"args\":[{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"(bool,uint64)\",\"struct\":\"ObjectC3416591\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"gateCheck\",\"args\":[{\"type\":\"appl\",\"name\":\"gateTxn\"},{\"type\":\"address\",\"name\":\"address\"},{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"enterCost\",\"args\":[{\"type\":\"address\",\"name\":\"address\",\"desc\":\"The address that will be entering\"},{\"type\":\"uint64\",\"name\":\"entryCount\",\"desc\":\"The number of entries being added\"}],\"returns\":{\"type\":\"uint64\",\"desc\":\"The total payment amount needed (includes box MBR + any shortfall to meet min balance)\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true,\"desc\":\"Calculates the total cost required to enter the pool\"},{\"name\":\"optInCost\",\"args\":[{\"type\":\"uint64\",\"name\":\"asset\"}],\"returns\":{\"type\":\"uint64\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"signUpsOpen\",\"args\":[],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean of whether sign ups are open\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"isLive\",\"args\":[],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean of whether the pool is live\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"isEntered\",\"args\":[{\"type\":\"address\",\"name\":\"address\"}],\"returns\":{\"type\":\"bool\",\"desc\":\"a boolean indicating if the address has entered the staking pool\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"getState\",\"args\":[],\"returns\":{\"type\":\"(uint8,string,uint8,uint64,uint64,bool,uint64,uint64,uint64,uint64,uint64,(address,string),uint64,uint64,address)\",\"struct\":\"StakingPoolState\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true},{\"name\":\"updateAkitaDAOEscrow\",\"args\":[{\"type\":\"uint64\",\"name\":\"app\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"update\",\"args\":[{\"type\":\"string\",\"name\":\"newVersion\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"UpdateApplication\"]},\"readonly\":false},{\"name\":\"updateAkitaDAO\",\"args\":[{\"type\":\"uint64\",\"name\":\"akitaDAO\"}],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"opUp\",\"args\":[],\"returns\":{\"type\":\"void\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":false},{\"name\":\"mbr\",\"args\":[{\"type\":\"uint64\",\"name\":\"winningTickets\"}],\"returns\":{\"type\":\"(uint64,uint64,uint64,uint64,uint64)\",\"struct\":\"StakingPoolMBRData\",\"desc\":\"the mbr created for each boxmap entry\"},\"actions\":{\"create\":[],\"call\":[\"NoOp\"]},\"readonly\":true}],\"state\":{\"keys\":{\"global\":{\"akitaDAO\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZGFv\",\"desc\":\"the app ID of the Akita DAO\"},\"akitaDAOEscrow\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfZXNjcm93\",\"desc\":\"the app ID for the akita DAO escrow to use\"},\"akitaRoyalty\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfcm95YWx0eQ==\",\"desc\":\"the akita royalty for the pool\"},\"akitaRoyaltyAmount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWtpdGFfcm95YWx0eV9hbW91bnQ=\",\"desc\":\"the amount of royalties that were paid in a disbursement\"},\"allowLateSignups\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"YWxsb3dfbGF0ZV9zaWdudXBz\",\"desc\":\"whether signups are allowed after the staking pool begins\"},\"creator\":{\"keyType\":\"AVMString\",\"valueType\":\"address\",\"key\":\"Y3JlYXRvcg==\",\"desc\":\"the address of the creator of the staking pool\"},\"endTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW5kX3RpbWVzdGFtcA==\",\"desc\":\"the timestamp when the pool ends\"},\"entryID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"ZW50cnlfY291bnQ=\",\"desc\":\"the number of entries in a pool\"},\"funder\":{\"keyType\":\"AVMString\",\"valueType\":\"FunderInfo\",\"key\":\"ZnVuZGVy\"},\"gateID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9pZA==\",\"desc\":\"the gate id of the pool\"},\"gateSize\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"Z2F0ZV9zaXpl\",\"desc\":\"the size of the gate were using\"},\"marketplace\":{\"keyType\":\"AVMString\",\"valueType\":\"address\",\"key\":\"bWFya2V0cGxhY2U=\",\"desc\":\"marketplace is pool creation side marketplace\"},\"marketplaceRoyalties\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWFya2V0cGxhY2Vfcm95YWx0aWVz\",\"desc\":\"the amount the marketplaces will get for the sale\"},\"maxEntries\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWF4X2VudHJpZXM=\",\"desc\":\"the maximum entries allowed for the pool\"},\"minimumStakeAmount\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"bWluaW11bV9zdGFrZV9hbW91bnQ=\",\"desc\":\"minimum stake amount\"},\"rewardID\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"cmV3YXJkX2NvdW50\",\"desc\":\"the number of rewards for the pool\"},\"salt\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMBytes\",\"key\":\"c2FsdA==\",\"desc\":\"salt for randomness\"},\"signupTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"c2lnbnVwX3RpbWVzdGFtcA==\",\"desc\":\"the timestamp when sign ups for the pool are allowed\"},\"stakeKey\":{\"keyType\":\"AVMString\",\"valueType\":\"RootKey\",\"key\":\"c3Rha2Vfa2V5\",\"desc\":\"the name for the meta merkle asset group to validate staking\\nstake key can be empty if distribution !== DistributionTypePercentage\"},\"startTimestamp\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"c3RhcnRfdGltZXN0YW1w\",\"desc\":\"the timestamp when the pool starts\"},\"status\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"c3RhdHVz\",\"desc\":\"the status the pool is in\"},\"title\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dGl0bGU=\",\"desc\":\"title of the staking pool\"},\"totalStaked\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMUint64\",\"key\":\"dG90YWxfc3Rha2Vk\",\"desc\":\"the total amount staked in the pool\"},\"type\":{\"keyType\":\"AVMString\",\"valueType\":\"uint8\",\"key\":\"dHlwZQ==\",\"desc\":\"the method of staking to be used for the pool\"},\"version\":{\"keyType\":\"AVMString\",\"valueType\":\"AVMString\",\"key\":\"dmVyc2lvbg==\",\"desc\":\"the current version of the contract\"}},\"local\":{},\"box\":{}},\"maps\":{\"global\":{},\"local\":{},\"box\":{\"disbursements\":{\"keyType\":\"uint64\",\"valueType\":\"AVMBytes\",\"prefix\":\"ZA==\",\"desc\":\"the disbursements this pool as created \\u0026 finalized\"},\"entries\":{\"keyType\":\"uint64\",\"valueType\":\"EntryData\",\"prefix\":\"ZQ==\",\"desc\":\"indexed entries for efficient iteration\"},\"entriesByAddress\":{\"keyType\":\"EntryKey\",\"valueType\":\"uint64\",\"prefix\":\"YQ==\",\"desc\":\"the entries in the pool\"},\"rewards\":{\"keyType\":\"uint64\",\"valueType\":\"Reward\",\"prefix\":\"cg==\",\"desc\":\"the rewards for this staking pool\"},\"uniques\":{\"keyType\":\"address\",\"valueType\":\"uint64\",\"prefix\":\"dQ==\",\"desc\":\"the number of unique asset entries by address\"}}}},\"bareActions\":{\"create\":[],\"call\":[]},\"networks\":{},\"source\":{\"approval\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYXJjNC9pbmRleC5kLnRzOjpDb250cmFjdC5hcHByb3ZhbFByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBpbnRjYmxvY2sgMCAxIDggOTIgMTAwMDAwIDYzNjQxMzYyMjM4NDY3OTMwMDUgNDAwIDE0NDI2OTUwNDA4ODg5NjM0MDcgMTQ0MjY5NTA0MDg4ODk2MzQwOSA0Mjk0OTY3Mjk1IDUwNjAwIDE4OTAwIDE4NDQ2NzQ0MDczNzA5NTUxNjE1CiAgICBieXRlY2Jsb2NrICJha2l0YV9kYW8iICJlbnRyeV9jb3VudCIgMHgxNTFmN2M3NSAweDAwICJ0eXBlIiAweDAwMDAgImFhbCIgImFraXRhX2VzY3JvdyIgMHgwYSAiZSIgInN0YXR1cyIgImNyZWF0b3IiIDB4MTQgMHgxZSAiYWxsb3dfbGF0ZV9zaWdudXBzIiAic3RhcnRfdGltZXN0YW1wIiAiZW5kX3RpbWVzdGFtcCIgImdhdGVfaWQiICJtYXhfZW50cmllcyIgMHg4MCAid2FsbGV0IiAiciIgImFraXRhX3JveWFsdHlfYW1vdW50IiAidSIgInNpZ251cF90aW1lc3RhbXAiICJyZXdhcmRfY291bnQiICJzdGFrZV9rZXkiICJha2l0YV9yb3lhbHR5IiAidGl0bGUiICJtaW5pbXVtX3N0YWtlX2Ftb3VudCIgImEiICJ0b3RhbF9zdGFrZWQiIDB4NzFiOTU3YWYgIm9hbCIgInNhbHQiICJzYWwiICJwYWwiICJjb250cm9sbGVkX2FkZHJlc3MiIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwIDB4MDAwMDAwMDAwMDAwMDAwMDAwIDB4YzkwNjg4MDkKICAgIHR4biBBcHBsaWNhdGlvbklECiAgICBibnogbWFpbl9hZnRlcl9pZl9lbHNlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI0CiAgICAvLyBzdGFydFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3RhcnRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE1IC8vICJzdGFydF90aW1lc3RhbXAiCiAgICBpbnRjXzAgLy8gMAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIGVuZFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW5kVGltZXN0YW1wIH0pCiAgICBieXRlYyAxNiAvLyAiZW5kX3RpbWVzdGFtcCIKICAgIGludGNfMCAvLyAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICBpbnRjXzEgLy8gMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIHJld2FyZElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlSZXdhcmRDb3VudCB9KQogICAgYnl0ZWMgMjUgLy8gInJld2FyZF9jb3VudCIKICAgIGludGNfMSAvLyAxCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzNAogICAgLy8gdG90YWxTdGFrZWQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVRvdGFsU3Rha2VkIH0pCiAgICBieXRlYyAzMSAvLyAidG90YWxfc3Rha2VkIgogICAgaW50Y18wIC8vIDAKICAgIGFwcF9nbG9iYWxfcHV0CgptYWluX2FmdGVyX2lmX2Vsc2VAMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDUtMTA5CiAgICAvLyBleHBvcnQgY2xhc3MgU3Rha2luZ1Bvb2wgZXh0ZW5kcyBjbGFzc2VzKAogICAgLy8gICBCYXNlU3Rha2luZ1Bvb2wsCiAgICAvLyAgIEFraXRhQmFzZUZlZUdlbmVyYXRvckNvbnRyYWN0LAogICAgLy8gICBDaGlsZENvbnRyYWN0CiAgICAvLyApIHsKICAgIHB1c2hieXRlc3MgMHhhYjhiMjEzYSAweGVhOTE4MGRkIC8vIG1ldGhvZCAiZGVsZXRlKGFkZHJlc3Mpdm9pZCIsIG1ldGhvZCAidXBkYXRlKHN0cmluZyl2b2lkIgogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMAogICAgbWF0Y2ggbWFpbl9kZWxldGVfcm91dGVANCBtYWluX3VwZGF0ZV9yb3V0ZUA1CgptYWluX3N3aXRjaF9jYXNlX25leHRANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDUtMTA5CiAgICAvLyBleHBvcnQgY2xhc3MgU3Rha2luZ1Bvb2wgZXh0ZW5kcyBjbGFzc2VzKAogICAgLy8gICBCYXNlU3Rha2luZ1Bvb2wsCiAgICAvLyAgIEFraXRhQmFzZUZlZUdlbmVyYXRvckNvbnRyYWN0LAogICAgLy8gICBDaGlsZENvbnRyYWN0CiAgICAvLyApIHsKICAgIHR4biBPbkNvbXBsZXRpb24KICAgICEKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBOb09wCiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgYnogbWFpbl9jcmVhdGVfTm9PcEAzMgogICAgcHVzaGJ5dGVzcyAweDgzZjE0NzQ4IDB4Mzk0ZWFlYjIgLy8gbWV0aG9kICJpbml0KCl2b2lkIiwgbWV0aG9kICJvcHRJbihwYXksdWludDY0KXZvaWQiCiAgICBieXRlYyAzMiAvLyBtZXRob2QgImFkZFJld2FyZChwYXksKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiCiAgICBwdXNoYnl0ZXNzIDB4YTAwY2NlYzYgMHgxODFlODI5MSAweGZkY2ZkZmY2IDB4YjgyZWQxYjMgMHhhOTQxOGQzNyAweGZjNjk5Y2IzIDB4NmU3NmIyY2EgMHhjZGIwYWM2YSAweGIyMjNkMzVkIDB4YmM1OGFkMDkgMHg4ZTA4MjQ4NCAweDMzZjc4ODA4IDB4NTkyZWUzNDEgMHg4ZmE0YTE2MCAweGUzMzc3MzQyIDB4NzE3MDQ2NDkgMHgxZWFkMjBhOSAweDMzZTkyYzk0IDB4ODU0ZGVkZTAgMHhhNGIzZTc4OSAvLyBtZXRob2QgImFkZFJld2FyZEFzYShwYXksYXhmZXIsKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiLCBtZXRob2QgImZpbmFsaXplKHVpbnQ2NCx1aW50NjQsdWludDY0KXZvaWQiLCBtZXRob2QgImdhdGVkRW50ZXIocGF5LGFwcGwsKHVpbnQ2NCx1aW50NjQsYnl0ZVszMl1bXSlbXSl2b2lkIiwgbWV0aG9kICJlbnRlcihwYXksKHVpbnQ2NCx1aW50NjQsYnl0ZVszMl1bXSlbXSl2b2lkIiwgbWV0aG9kICJzdGFydERpc2J1cnNlbWVudCh1aW50NjQpdm9pZCIsIG1ldGhvZCAicmFmZmxlKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJkaXNidXJzZVJld2FyZHModWludDY0LHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJmaW5hbGl6ZURpc3RyaWJ1dGlvbih1aW50NjQpdm9pZCIsIG1ldGhvZCAiY2hlY2soYWRkcmVzcyx1aW50NjQpKGJvb2wsdWludDY0KSIsIG1ldGhvZCAiZ2F0ZUNoZWNrKGFwcGwsYWRkcmVzcyx1aW50NjQpdm9pZCIsIG1ldGhvZCAiZW50ZXJDb3N0KGFkZHJlc3MsdWludDY0KXVpbnQ2NCIsIG1ldGhvZCAib3B0SW5Db3N0KHVpbnQ2NCl1aW50NjQiLCBtZXRob2QgInNpZ25VcHNPcGVuKClib29sIiwgbWV0aG9kICJpc0xpdmUoKWJvb2wiLCBtZXRob2QgImlzRW50ZXJlZChhZGRyZXNzKWJvb2wiLCBtZXRob2QgImdldFN0YXRlKCkodWludDgsc3RyaW5nLHVpbnQ4LHVpbnQ2NCx1aW50NjQsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0LChhZGRyZXNzLHN0cmluZyksdWludDY0LHVpbnQ2NCxhZGRyZXNzKSIsIG1ldGhvZCAidXBkYXRlQWtpdGFEQU9Fc2Nyb3codWludDY0KXZvaWQiLCBtZXRob2QgInVwZGF0ZUFraXRhREFPKHVpbnQ2NCl2b2lkIiwgbWV0aG9kICJvcFVwKCl2b2lkIiwgbWV0aG9kICJtYnIodWludDY0KSh1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQsdWludDY0KSIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGluaXQgb3B0SW4gYWRkUmV3YXJkIGFkZFJld2FyZEFzYSBmaW5hbGl6ZSBnYXRlZEVudGVyIGVudGVyIHN0YXJ0RGlzYnVyc2VtZW50IHJhZmZsZSBkaXNidXJzZVJld2FyZHMgZmluYWxpemVEaXN0cmlidXRpb24gY2hlY2sgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ10gZW50ZXJDb3N0IG9wdEluQ29zdCBzaWduVXBzT3BlbiBpc0xpdmUgaXNFbnRlcmVkIGdldFN0YXRlIHVwZGF0ZUFraXRhREFPRXNjcm93IHVwZGF0ZUFraXRhREFPIG1haW5fb3BVcF9yb3V0ZUAyOSBtYnIKICAgIGVycgoKbWFpbl9vcFVwX3JvdXRlQDI5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDMKICAgIC8vIG9wVXAoKTogdm9pZCB7IH0KICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCm1haW5fY3JlYXRlX05vT3BAMzI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA1LTEwOQogICAgLy8gZXhwb3J0IGNsYXNzIFN0YWtpbmdQb29sIGV4dGVuZHMgY2xhc3NlcygKICAgIC8vICAgQmFzZVN0YWtpbmdQb29sLAogICAgLy8gICBBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdCwKICAgIC8vICAgQ2hpbGRDb250cmFjdAogICAgLy8gKSB7CiAgICBwdXNoYnl0ZXMgMHhhMmYzYzU0NCAvLyBtZXRob2QgImNyZWF0ZShzdHJpbmcsdWludDgsYWRkcmVzcywoYWRkcmVzcyx1aW50NjQpLGFkZHJlc3MsKGFkZHJlc3Msc3RyaW5nKSx1aW50NjQsYm9vbCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpdm9pZCIKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDAKICAgIG1hdGNoIGNyZWF0ZQogICAgZXJyCgptYWluX3VwZGF0ZV9yb3V0ZUA1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgdHhuIE9uQ29tcGxldGlvbgogICAgcHVzaGludCA0IC8vIFVwZGF0ZUFwcGxpY2F0aW9uCiAgICA9PQogICAgdHhuIEFwcGxpY2F0aW9uSUQKICAgICYmCiAgICBhc3NlcnQgLy8gT25Db21wbGV0aW9uIG11c3QgYmUgVXBkYXRlQXBwbGljYXRpb24gJiYgY2FuIG9ubHkgY2FsbCB3aGVuIG5vdCBjcmVhdGluZwogICAgYiB1cGRhdGUKCm1haW5fZGVsZXRlX3JvdXRlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODEyCiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiAnRGVsZXRlQXBwbGljYXRpb24nIH0pCiAgICB0eG4gT25Db21wbGV0aW9uCiAgICBwdXNoaW50IDUgLy8gRGVsZXRlQXBwbGljYXRpb24KICAgID09CiAgICB0eG4gQXBwbGljYXRpb25JRAogICAgJiYKICAgIGFzc2VydCAvLyBPbkNvbXBsZXRpb24gbXVzdCBiZSBEZWxldGVBcHBsaWNhdGlvbiAmJiBjYW4gb25seSBjYWxsIHdoZW4gbm90IGNyZWF0aW5nCiAgICBiIGRlbGV0ZQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6Ol9fcGNnMzJPdXRwdXQoc3RhdGU6IHVpbnQ2NCkgLT4gdWludDY0OgpfX3BjZzMyT3V0cHV0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyMwogICAgLy8gZXhwb3J0IGZ1bmN0aW9uIF9fcGNnMzJPdXRwdXQoc3RhdGU6IFBDRzMyU1RBVEUpOiB1aW50NjQgewogICAgcHJvdG8gMSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjI0CiAgICAvLyBjb25zdCB4b3JzaGlmdGVkID0gX19tYXNrVG9VaW50MzIob3Auc2hyKG9wLnNocihzdGF0ZSwgMTgpIF4gc3RhdGUsIDI3KSkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCAxOCAvLyAxOAogICAgc2hyCiAgICBmcmFtZV9kaWcgLTEKICAgIF4KICAgIHB1c2hpbnQgMjcgLy8gMjcKICAgIHNocgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxMwogICAgLy8gcmV0dXJuIHZhbHVlICYgKG9wLnNobCgxLCAzMikgLSAxKQogICAgaW50YyA5IC8vIDQyOTQ5NjcyOTUKICAgICYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MjUKICAgIC8vIGNvbnN0IHJvdCA9IG9wLnNocihzdGF0ZSwgNTkpCiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIHNocgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyNgogICAgLy8gcmV0dXJuIG9wLnNocih4b3JzaGlmdGVkLCByb3QpIHwgX19tYXNrVG9VaW50MzIob3Auc2hsKHhvcnNoaWZ0ZWQsIF9fdWludDY0VHdvcyhyb3QpICYgMzEpKQogICAgZHVwMgogICAgc2hyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjgKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KH52YWx1ZSwgMSkKICAgIHN3YXAKICAgIH4KICAgIGludGNfMSAvLyAxCiAgICBhZGR3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MjYKICAgIC8vIHJldHVybiBvcC5zaHIoeG9yc2hpZnRlZCwgcm90KSB8IF9fbWFza1RvVWludDMyKG9wLnNobCh4b3JzaGlmdGVkLCBfX3VpbnQ2NFR3b3Mocm90KSAmIDMxKSkKICAgIHB1c2hpbnQgMzEgLy8gMzEKICAgICYKICAgIHVuY292ZXIgMgogICAgc3dhcAogICAgc2hsCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjEzCiAgICAvLyByZXR1cm4gdmFsdWUgJiAob3Auc2hsKDEsIDMyKSAtIDEpCiAgICBpbnRjIDkgLy8gNDI5NDk2NzI5NQogICAgJgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoyNgogICAgLy8gcmV0dXJuIG9wLnNocih4b3JzaGlmdGVkLCByb3QpIHwgX19tYXNrVG9VaW50MzIob3Auc2hsKHhvcnNoaWZ0ZWQsIF9fdWludDY0VHdvcyhyb3QpICYgMzEpKQogICAgfAogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiB1aW50NjQsIGFkZHJlc3M6IGJ5dGVzKSAtPiB1aW50NjQ6CmdldFdhbGxldElEVXNpbmdBa2l0YURBTzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTc2CiAgICAvLyBleHBvcnQgZnVuY3Rpb24gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbiwgYWRkcmVzczogQWNjb3VudCk6IEFwcGxpY2F0aW9uIHsKICAgIHByb3RvIDIgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1NQogICAgLy8gY29uc3QgW290aGVyQXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c090aGVyQXBwTGlzdCkpCiAgICBmcmFtZV9kaWcgLTIKICAgIGJ5dGVjIDMzIC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjYwCiAgICAvLyByZXR1cm4gZ2V0T3RoZXJBcHBMaXN0KGFraXRhREFPKS5lc2Nyb3cKICAgIHB1c2hpbnQgMjQgLy8gMjQKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4Mi0xODUKICAgIC8vIGNvbnN0IGRhdGEgPSBhYmlDYWxsPHR5cGVvZiBFc2Nyb3dGYWN0b3J5LnByb3RvdHlwZS5nZXQ+KHsKICAgIC8vICAgYXBwSWQ6IGVzY3Jvd0ZhY3RvcnksCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzXQogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIHB1c2hieXRlcyAweDNjMWE2ZjMzIC8vIG1ldGhvZCAiZ2V0KGFkZHJlc3MpYnl0ZVtdIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTg3CiAgICAvLyBpZiAoQnl0ZXMoZGF0YSkubGVuZ3RoID09PSAwIHx8IEJ5dGVzKGRhdGEpLmxlbmd0aCAhPT0gOCkgewogICAgbGVuCiAgICBkdXAKICAgIGJ6IGdldFdhbGxldElEVXNpbmdBa2l0YURBT19pZl9ib2R5QDYKICAgIGZyYW1lX2RpZyAxCiAgICBpbnRjXzIgLy8gOAogICAgIT0KICAgIGJ6IGdldFdhbGxldElEVXNpbmdBa2l0YURBT19hZnRlcl9pZl9lbHNlQDcKCmdldFdhbGxldElEVXNpbmdBa2l0YURBT19pZl9ib2R5QDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE4OAogICAgLy8gcmV0dXJuIDAKICAgIGludGNfMCAvLyAwCgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpnZXRXYWxsZXRJREA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNzgKICAgIC8vIHJldHVybiBBcHBsaWNhdGlvbihnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKSkKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU9fYWZ0ZXJfaWZfZWxzZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxOTEKICAgIC8vIHJldHVybiBidG9pKGRhdGEpCiAgICBmcmFtZV9kaWcgMAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNzgKICAgIC8vIHJldHVybiBBcHBsaWNhdGlvbihnZXRXYWxsZXRJRChlc2Nyb3dGYWN0b3J5LCBhZGRyZXNzKSkKICAgIGIgZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6Z2V0V2FsbGV0SURAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6OmdhdGVDaGVjayhnYXRlVHhuOiB1aW50NjQsIGFraXRhREFPOiB1aW50NjQsIGNhbGxlcjogYnl0ZXMsIGlkOiB1aW50NjQpIC0+IHVpbnQ2NDoKZ2F0ZUNoZWNrOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjcKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBnYXRlQ2hlY2soZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sIGFraXRhREFPOiBBcHBsaWNhdGlvbiwgY2FsbGVyOiBBY2NvdW50LCBpZDogdWludDY0KTogYm9vbGVhbiB7CiAgICBwcm90byA0IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5CiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICBmcmFtZV9kaWcgLTQKICAgIGd0eG5zIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGZyYW1lX2RpZyAtMwogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjkKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGV4dHJhY3RfdWludDY0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMwCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICBieiBnYXRlQ2hlY2tfYm9vbF9mYWxzZUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIzMAogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgZnJhbWVfZGlnIC00CiAgICBndHhucyBPbkNvbXBsZXRpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMAogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgYm56IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjMxCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIGZyYW1lX2RpZyAtNAogICAgZ3R4bnMgTnVtQXBwQXJncwogICAgcHVzaGludCA0IC8vIDQKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzEKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzIKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgZnJhbWVfZGlnIC00CiAgICBpbnRjXzAgLy8gMAogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIHB1c2hieXRlcyAweDQzOTIyNjU1IC8vIG1ldGhvZCAibXVzdENoZWNrKGFkZHJlc3MsdWludDY0LGJ5dGVbXVtdKXZvaWQiCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjktMjMyCiAgICAvLyBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyBnYXRlVHhuLm9uQ29tcGxldGlvbiA9PT0gT25Db21wbGV0ZUFjdGlvbi5Ob09wICYmCiAgICAvLyBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzMKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgZnJhbWVfZGlnIC00CiAgICBpbnRjXzEgLy8gMQogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MjI5LTIzMwogICAgLy8gZ2F0ZVR4bi5hcHBJZCA9PT0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KGFraXRhREFPKS5nYXRlKSAmJgogICAgLy8gZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMCkgPT09IG1ldGhvZFNlbGVjdG9yPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5tdXN0Q2hlY2s+KCkgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgYnogZ2F0ZUNoZWNrX2Jvb2xfZmFsc2VANwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzQKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGZyYW1lX2RpZyAtNAogICAgcHVzaGludCAyIC8vIDIKICAgIGd0eG5zYXMgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOS0yMzQKICAgIC8vIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vIGdhdGVUeG4ubnVtQXBwQXJncyA9PT0gNCAmJgogICAgLy8gZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIC8vIGdhdGVUeG4uYXBwQXJncygyKSA9PT0gaXRvYihpZCkKICAgIGJ6IGdhdGVDaGVja19ib29sX2ZhbHNlQDcKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjIyOC0yMzUKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIGdhdGVUeG4uYXBwSWQgPT09IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdChha2l0YURBTykuZ2F0ZSkgJiYKICAgIC8vICAgZ2F0ZVR4bi5vbkNvbXBsZXRpb24gPT09IE9uQ29tcGxldGVBY3Rpb24uTm9PcCAmJgogICAgLy8gICBnYXRlVHhuLm51bUFwcEFyZ3MgPT09IDQgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDApID09PSBtZXRob2RTZWxlY3Rvcjx0eXBlb2YgR2F0ZS5wcm90b3R5cGUubXVzdENoZWNrPigpICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygxKSA9PT0gbmV3IEFkZHJlc3MoY2FsbGVyKS5ieXRlcyAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMikgPT09IGl0b2IoaWQpCiAgICAvLyApCiAgICByZXRzdWIKCmdhdGVDaGVja19ib29sX2ZhbHNlQDc6CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMjgtMjM1CiAgICAvLyByZXR1cm4gKAogICAgLy8gICBnYXRlVHhuLmFwcElkID09PSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QoYWtpdGFEQU8pLmdhdGUpICYmCiAgICAvLyAgIGdhdGVUeG4ub25Db21wbGV0aW9uID09PSBPbkNvbXBsZXRlQWN0aW9uLk5vT3AgJiYKICAgIC8vICAgZ2F0ZVR4bi5udW1BcHBBcmdzID09PSA0ICYmCiAgICAvLyAgIGdhdGVUeG4uYXBwQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3I8dHlwZW9mIEdhdGUucHJvdG90eXBlLm11c3RDaGVjaz4oKSAmJgogICAgLy8gICBnYXRlVHhuLmFwcEFyZ3MoMSkgPT09IG5ldyBBZGRyZXNzKGNhbGxlcikuYnl0ZXMgJiYKICAgIC8vICAgZ2F0ZVR4bi5hcHBBcmdzKDIpID09PSBpdG9iKGlkKQogICAgLy8gKQogICAgcmV0c3ViCgoKLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6c3BsaXRPcHRJbkNvdW50KGFraXRhREFPOiB1aW50NjQsIGVzY3JvdzogYnl0ZXMsIGFzc2V0OiB1aW50NjQpIC0+IHVpbnQ2NDoKc3BsaXRPcHRJbkNvdW50OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1ODcKICAgIC8vIGV4cG9ydCBmdW5jdGlvbiBzcGxpdE9wdEluQ291bnQoYWtpdGFEQU86IEFwcGxpY2F0aW9uLCBlc2Nyb3c6IEFjY291bnQsIGFzc2V0OiBBc3NldCk6IHVpbnQ2NCB7CiAgICBwcm90byAzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTg4CiAgICAvLyBsZXQgY291bnQ6IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5MAogICAgLy8gaWYgKCFlc2Nyb3cuaXNPcHRlZEluKGFzc2V0KSkgewogICAgZnJhbWVfZGlnIC0yCiAgICBmcmFtZV9kaWcgLTEKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogc3BsaXRPcHRJbkNvdW50X2FmdGVyX2lmX2Vsc2VAMgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo5OQogICAgLy8gY29uc3QgW3NwbGl0c0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1JldmVudWVTcGxpdHMpKQogICAgZnJhbWVfZGlnIC0zCiAgICBwdXNoYnl0ZXMgInJldmVudWVfc3BsaXRzIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTQKICAgIC8vIGNvdW50ICs9IHNwbGl0cy5sZW5ndGgKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTEKICAgIC8vIGNvdW50ICs9IDEKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU5NAogICAgLy8gY291bnQgKz0gc3BsaXRzLmxlbmd0aAogICAgKwogICAgZnJhbWVfYnVyeSAwCgpzcGxpdE9wdEluQ291bnRfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo1OTcKICAgIC8vIHJldHVybiBjb3VudAogICAgZnJhbWVfZGlnIDAKICAgIHN3YXAKICAgIHJldHN1YgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY3JlYXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKY3JlYXRlOgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTgKICAgIC8vIEBhYmltZXRob2QoeyBvbkNyZWF0ZTogJ3JlcXVpcmUnIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIGRpZyAxCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdXRmOFtdKQogICAgZXh0cmFjdCAyIDAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDIKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzEgLy8gMQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDgKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDQKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDQwIC8vIDQwCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDhbMzJdLHVpbnQ2NCkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDUKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDYKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDcKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyA5CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMTAKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzczCiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSA9IFBvb2xTdGF0dXNEcmFmdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNgogICAgLy8gdGl0bGUgPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUaXRsZSB9KQogICAgYnl0ZWMgMjggLy8gInRpdGxlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NAogICAgLy8gdGhpcy50aXRsZS52YWx1ZSA9IHRpdGxlCiAgICB1bmNvdmVyIDEyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NQogICAgLy8gdGhpcy50eXBlLnZhbHVlID0gdHlwZQogICAgdW5jb3ZlciAxMQogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzc2CiAgICAvLyB0aGlzLmNyZWF0b3IudmFsdWUgPSBjcmVhdG9yCiAgICB1bmNvdmVyIDEwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2NoaWxkLnRzOjExCiAgICAvLyBmdW5kZXIgPSBHbG9iYWxTdGF0ZTxGdW5kZXJJbmZvPih7IGtleTogR2xvYmFsU3RhdGVLZXlGdW5kZXIgfSkKICAgIHB1c2hieXRlcyAiZnVuZGVyIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc3NwogICAgLy8gdGhpcy5mdW5kZXIudmFsdWUgPSBjbG9uZShmdW5kZXIpCiAgICB1bmNvdmVyIDkKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ5CiAgICAvLyBtYXJrZXRwbGFjZSA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXJrZXRwbGFjZSB9KQogICAgcHVzaGJ5dGVzICJtYXJrZXRwbGFjZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NzgKICAgIC8vIHRoaXMubWFya2V0cGxhY2UudmFsdWUgPSBtYXJrZXRwbGFjZQogICAgdW5jb3ZlciA4CiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gc3Rha2VLZXkgPSBHbG9iYWxTdGF0ZTxSb290S2V5Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3Rha2VLZXkgfSkKICAgIGJ5dGVjIDI2IC8vICJzdGFrZV9rZXkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzgwCiAgICAvLyB0aGlzLnN0YWtlS2V5LnZhbHVlID0gY2xvbmUoc3Rha2VLZXkpCiAgICB1bmNvdmVyIDcKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQxCiAgICAvLyBtaW5pbXVtU3Rha2VBbW91bnQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNaW5pbXVtU3Rha2VBbW91bnQgfSkKICAgIGJ5dGVjIDI5IC8vICJtaW5pbXVtX3N0YWtlX2Ftb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3ODEKICAgIC8vIHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlID0gbWluaW11bVN0YWtlQW1vdW50CiAgICB1bmNvdmVyIDYKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyBhbGxvd0xhdGVTaWdudXBzID0gR2xvYmFsU3RhdGU8Ym9vbGVhbj4oeyBpbml0aWFsVmFsdWU6IGZhbHNlLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFsbG93TGF0ZVNpZ251cHMgfSkKICAgIGJ5dGVjIDE0IC8vICJhbGxvd19sYXRlX3NpZ251cHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzgyCiAgICAvLyB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUgPSBhbGxvd0xhdGVTaWdudXBzCiAgICB1bmNvdmVyIDUKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDE3IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc4MwogICAgLy8gdGhpcy5nYXRlSUQudmFsdWUgPSBnYXRlSUQKICAgIHVuY292ZXIgNAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIG1heEVudHJpZXMgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXhFbnRyaWVzIH0pCiAgICBieXRlYyAxOCAvLyAibWF4X2VudHJpZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg0CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPSBtYXhFbnRyaWVzCiAgICB1bmNvdmVyIDMKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTU3CiAgICAvLyBzYWx0ID0gR2xvYmFsU3RhdGU8Ynl0ZXM8MzI+Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2FsdCB9KQogICAgYnl0ZWMgMzQgLy8gInNhbHQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg2CiAgICAvLyB0aGlzLnNhbHQudmFsdWUgPSBUeG4udHhJZAogICAgdHhuIFR4SUQKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3ODcKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgdW5jb3ZlciAyCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzg4CiAgICAvLyB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlID0gYWtpdGFEQU9Fc2Nyb3cKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzkwCiAgICAvLyBjb25zdCBmZWVzID0gZ2V0U3Rha2luZ0ZlZXModGhpcy5ha2l0YURBTy52YWx1ZSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTAKICAgIC8vIGNvbnN0IGZlZXMgPSBnZXRTdGFraW5nRmVlcyh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NzQKICAgIC8vIGNvbnN0IFtzdGFraW5nRmVlc0J5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1N0YWtpbmdGZWVzKSkKICAgIGR1cAogICAgcHVzaGJ5dGVzICJzdGFraW5nX2ZlZXMiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQ1CiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhU29jaWFsQXBwTGlzdCkpCiAgICBieXRlYyAzNSAvLyAic2FsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5MgogICAgLy8gY29uc3QgeyBpbXBhY3Q6IGltcGFjdEFwcCB9ID0gZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpOwogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTQKICAgIC8vIGxldCBpbXBhY3Q6IHVpbnQ2NCA9IDA7CiAgICBpbnRjXzAgLy8gMAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5NQogICAgLy8gaWYgKGltcGFjdEFwcCAhPT0gMCkgewogICAgYnogY3JlYXRlX2FmdGVyX2lmX2Vsc2VAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5NgogICAgLy8gaW1wYWN0ID0gZ2V0VXNlckltcGFjdCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmNyZWF0b3IudmFsdWUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Nzk2CiAgICAvLyBpbXBhY3QgPSBnZXRVc2VySW1wYWN0KHRoaXMuYWtpdGFEQU8udmFsdWUsIHRoaXMuY3JlYXRvci52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0NwogICAgLy8gY3JlYXRvciA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlDcmVhdG9yIH0pCiAgICBieXRlYyAxMSAvLyAiY3JlYXRvciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3OTYKICAgIC8vIGltcGFjdCA9IGdldFVzZXJJbXBhY3QodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5jcmVhdG9yLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMyLTEzNQogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIEFraXRhU29jaWFsSW1wYWN0LnByb3RvdHlwZS5nZXRVc2VySW1wYWN0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YVNvY2lhbEFwcExpc3QoYWtpdGFEQU8pLmltcGFjdCwKICAgIC8vICAgYXJnczogW2FjY291bnRdCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0NQogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YVNvY2lhbEFwcExpc3QpKQogICAgc3dhcAogICAgYnl0ZWMgMzUgLy8gInNhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTMzCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFTb2NpYWxBcHBMaXN0KGFraXRhREFPKS5pbXBhY3QsCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMzItMTM1CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgQWtpdGFTb2NpYWxJbXBhY3QucHJvdG90eXBlLmdldFVzZXJJbXBhY3Q+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhU29jaWFsQXBwTGlzdChha2l0YURBTykuaW1wYWN0LAogICAgLy8gICBhcmdzOiBbYWNjb3VudF0KICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkNTc0YmIxMCAvLyBtZXRob2QgImdldFVzZXJJbXBhY3QoYWRkcmVzcyl1aW50NjQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgYnVyeSAxCgpjcmVhdGVfYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5OAogICAgLy8gdGhpcy5ha2l0YVJveWFsdHkudmFsdWUgPSBpbXBhY3RSYW5nZShpbXBhY3QsIGZlZXMuaW1wYWN0VGF4TWluLCBmZWVzLmltcGFjdFRheE1heCkKICAgIGRpZyAxCiAgICBkdXAKICAgIGludGNfMiAvLyA4CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA0CiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEyNwogICAgLy8gY29uc3QgbWluSW1wYWN0OiB1aW50NjQgPSAoaW1wYWN0ID4gMSkgPyBpbXBhY3QgLSAxIDogMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPgogICAgYnogY3JlYXRlX3Rlcm5hcnlfZmFsc2VANgogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgLQoKY3JlYXRlX3Rlcm5hcnlfbWVyZ2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTI4CiAgICAvLyByZXR1cm4gbWF4IC0gKCgobWF4IC0gbWluKSAqIG1pbkltcGFjdCkgLyBJTVBBQ1RfRElWSVNPUikKICAgIGRpZyA0CiAgICBkdXAKICAgIGRpZyA1CiAgICAtCiAgICB1bmNvdmVyIDIKICAgICoKICAgIHB1c2hpbnQgMTAwMCAvLyAxMDAwCiAgICAvCiAgICAtCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTUzCiAgICAvLyBha2l0YVJveWFsdHkgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBa2l0YVJveWFsdHkgfSkKICAgIGJ5dGVjIDI3IC8vICJha2l0YV9yb3lhbHR5IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc5OAogICAgLy8gdGhpcy5ha2l0YVJveWFsdHkudmFsdWUgPSBpbXBhY3RSYW5nZShpbXBhY3QsIGZlZXMuaW1wYWN0VGF4TWluLCBmZWVzLmltcGFjdFRheE1heCkKICAgIHN3YXAKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzU4CiAgICAvLyBAYWJpbWV0aG9kKHsgb25DcmVhdGU6ICdyZXF1aXJlJyB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKY3JlYXRlX3Rlcm5hcnlfZmFsc2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTI3CiAgICAvLyBjb25zdCBtaW5JbXBhY3Q6IHVpbnQ2NCA9IChpbXBhY3QgPiAxKSA/IGltcGFjdCAtIDEgOiAxCiAgICBpbnRjXzEgLy8gMQogICAgYiBjcmVhdGVfdGVybmFyeV9tZXJnZUA3CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pbml0W3JvdXRpbmddKCkgLT4gdm9pZDoKaW5pdDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25BZGRyZXNzID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsICdvbmx5IHRoZSBmYWN0b3J5IGNhbiBpbml0IHRoZSBwb29sJykKICAgIGdsb2JhbCBDYWxsZXJBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGdsb2JhbCBDcmVhdG9yQWRkcmVzcwogICAgPT0KICAgIGFzc2VydCAvLyBvbmx5IHRoZSBmYWN0b3J5IGNhbiBpbml0IHRoZSBwb29sCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA0CiAgICAvLyBpZiAodGhpcy5nYXRlSUQudmFsdWUgPiAwKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDQKICAgIC8vIGlmICh0aGlzLmdhdGVJRC52YWx1ZSA+IDApIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBpbml0X2FmdGVyX2lmX2Vsc2VANAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNS04MDgKICAgIC8vIHRoaXMuZ2F0ZVNpemUudmFsdWUgPSBhYmlDYWxsPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5zaXplPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ2F0ZSwKICAgIC8vICAgYXJnczogW3RoaXMuZ2F0ZUlELnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA2CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODA2CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNgogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5nYXRlLAogICAgcHVzaGludCA0MCAvLyA0MAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDcKICAgIC8vIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwNS04MDgKICAgIC8vIHRoaXMuZ2F0ZVNpemUudmFsdWUgPSBhYmlDYWxsPHR5cGVvZiBHYXRlLnByb3RvdHlwZS5zaXplPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuZ2F0ZSwKICAgIC8vICAgYXJnczogW3RoaXMuZ2F0ZUlELnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHhkZjU2YjAxZSAvLyBtZXRob2QgInNpemUodWludDY0KXVpbnQ2NCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgaXR4biBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ1CiAgICAvLyBnYXRlU2l6ZSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUdhdGVTaXplIH0pCiAgICBwdXNoYnl0ZXMgImdhdGVfc2l6ZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MDUtODA4CiAgICAvLyB0aGlzLmdhdGVTaXplLnZhbHVlID0gYWJpQ2FsbDx0eXBlb2YgR2F0ZS5wcm90b3R5cGUuc2l6ZT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLmdhdGUsCiAgICAvLyAgIGFyZ3M6IFt0aGlzLmdhdGVJRC52YWx1ZV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKCmluaXRfYWZ0ZXJfaWZfZWxzZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgwMQogICAgLy8gaW5pdCgpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmRlbGV0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmRlbGV0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6ICdEZWxldGVBcHBsaWNhdGlvbicgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTQKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSBHbG9iYWwuY3JlYXRvckFkZHJlc3MsICdjYWxsIG11c3QgY29tZSBmcm9tIGZhY3RvcnknKQogICAgdHhuIFNlbmRlcgogICAgZ2xvYmFsIENyZWF0b3JBZGRyZXNzCiAgICA9PQogICAgYXNzZXJ0IC8vIGNhbGwgbXVzdCBjb21lIGZyb20gZmFjdG9yeQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gYXNzZXJ0KGNhbGxlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZGVsZXRlIHRoZSBwb29sJykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBjcmVhdG9yID0gR2xvYmFsU3RhdGU8QWNjb3VudD4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUNyZWF0b3IgfSkKICAgIGJ5dGVjIDExIC8vICJjcmVhdG9yIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNQogICAgLy8gYXNzZXJ0KGNhbGxlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZGVsZXRlIHRoZSBwb29sJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIG9ubHkgdGhlIGNyZWF0b3IgY2FuIGRlbGV0ZSB0aGUgcG9vbAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KHRoaXMuc3RhdHVzLnZhbHVlID09PSBQb29sU3RhdHVzRHJhZnQgfHwgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBvciBlbmRlZCcpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODE2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCB8fCBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUsICd0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBibnogZGVsZXRlX2Jvb2xfdHJ1ZUAzCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNgogICAgLy8gZW5kVGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbmRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE2IC8vICJlbmRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgxNgogICAgLy8gYXNzZXJ0KHRoaXMuc3RhdHVzLnZhbHVlID09PSBQb29sU3RhdHVzRHJhZnQgfHwgR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBvciBlbmRlZCcpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPgogICAgYnogZGVsZXRlX2Jvb2xfZmFsc2VANAoKZGVsZXRlX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCmRlbGV0ZV9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODE2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCB8fCBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUsICd0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkJykKICAgIGFzc2VydCAvLyB0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IG9yIGVuZGVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODIwLTgyMgogICAgLy8gaXR4bgogICAgLy8gICAucGF5bWVudCh7IGNsb3NlUmVtYWluZGVyVG86IEdsb2JhbC5jcmVhdG9yQWRkcmVzcyB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MjEKICAgIC8vIC5wYXltZW50KHsgY2xvc2VSZW1haW5kZXJUbzogR2xvYmFsLmNyZWF0b3JBZGRyZXNzIH0pCiAgICBnbG9iYWwgQ3JlYXRvckFkZHJlc3MKICAgIGl0eG5fZmllbGQgQ2xvc2VSZW1haW5kZXJUbwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgyMC04MjEKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoeyBjbG9zZVJlbWFpbmRlclRvOiBHbG9iYWwuY3JlYXRvckFkZHJlc3MgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MjAtODIyCiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsgY2xvc2VSZW1haW5kZXJUbzogR2xvYmFsLmNyZWF0b3JBZGRyZXNzIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MTIKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6ICdEZWxldGVBcHBsaWNhdGlvbicgfSkKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmRlbGV0ZV9ib29sX2ZhbHNlQDQ6CiAgICBpbnRjXzAgLy8gMAogICAgYiBkZWxldGVfYm9vbF9tZXJnZUA1CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5vcHRJbltyb3V0aW5nXSgpIC0+IHZvaWQ6Cm9wdEluOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMgogICAgLy8gb3B0SW4ocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBhc3NldDogQXNzZXQpOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBpbnRjXzEgLy8gMQogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuY3JlYXRvci52YWx1ZSwgRVJSX0ZPUkJJRERFTikKICAgIHR4biBTZW5kZXIKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQ3CiAgICAvLyBjcmVhdG9yID0gR2xvYmFsU3RhdGU8QWNjb3VudD4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUNyZWF0b3IgfSkKICAgIGJ5dGVjIDExIC8vICJjcmVhdG9yIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzMwogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuY3JlYXRvci52YWx1ZSwgRVJSX0ZPUkJJRERFTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIEZvcmJpZGRlbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzNQogICAgLy8gbGV0IG9wdGluTUJSOiB1aW50NjQgPSBHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFzc2V0KSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOAogICAgLy8gaWYgKCF0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKGFzc2V0KSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIHN3YXAKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgYnVyeSAxCiAgICBibnogb3B0SW5fYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBjb25zdCBjb3VudCA9IHNwbGl0T3B0SW5Db3VudCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsIGFzc2V0KQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjgzOQogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODM5CiAgICAvLyBjb25zdCBjb3VudCA9IHNwbGl0T3B0SW5Db3VudCh0aGlzLmFraXRhREFPLnZhbHVlLCB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsIGFzc2V0KQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGRpZyAzCiAgICBjYWxsc3ViIHNwbGl0T3B0SW5Db3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0MAogICAgLy8gb3B0aW5NQlIgKz0gR2xvYmFsLmFzc2V0T3B0SW5NaW5CYWxhbmNlICogY291bnQKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgKgogICAgKwoKb3B0SW5fYWZ0ZXJfaWZfZWxzZUAzOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0NS04NTIKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogb3B0aW5NQlIgKyByZXdhcmRzTUJSLAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBkaWcgMgogICAgZHVwCiAgICBndHhucyBSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OAogICAgLy8gcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NDUtODUyCiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IG9wdGluTUJSICsgcmV3YXJkc01CUiwKICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIHN3YXAKICAgIGd0eG5zIEFtb3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICB1bmNvdmVyIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NDMKICAgIC8vIGNvbnN0IHJld2FyZHNNQlI6IHVpbnQ2NCA9IHRoaXMucmV3YXJkc01icihXaW5uZXJDb3VudENhcCkgKiAyCiAgICBwdXNoaW50IDgwMDAgLy8gODAwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg0OQogICAgLy8gYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODQ1LTg1MgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiBvcHRpbk1CUiArIHJld2FyZHNNQlIsCiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgID09CiAgICAmJgogICAgYXNzZXJ0IC8vIEludmFsaWQgcGF5bWVudAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NC04NjAKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NgogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDEKICAgIGR1cAogICAgY292ZXIgMgogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NTcKICAgIC8vIGFzc2V0QW1vdW50OiAwLAogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg1NC04NTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiAwLAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICBwdXNoaW50IDQgLy8gNAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODU0LTg2MAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IDAsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgYnl0ZWMgMjAgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBkdXAKICAgIGJ5dGVjIDM2IC8vICJwYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4MwogICAgLy8gY29uc3QgeyByZXZlbnVlTWFuYWdlciB9ID0gZ2V0UGx1Z2luQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKQogICAgZHVwCiAgICBleHRyYWN0IDggOAogICAgc3dhcAogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBkaWcgMgogICAgYnl0ZWMgMjAgLy8gIndhbGxldCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjcwLTczCiAgICAvLyBjb25zdCBlc2Nyb3cgPSBhYmlDYWxsPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfZ2V0RXNjcm93cz4oewogICAgLy8gICBhcHBJZCwKICAgIC8vICAgYXJnczogW1tuYW1lXV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZVswXQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4YTI0MDNkZGYgLy8gbWV0aG9kICJhcmM1OF9nZXRFc2Nyb3dzKHN0cmluZ1tdKSh1aW50NjQsYm9vbClbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MgogICAgLy8gYXJnczogW1tuYW1lXV0sCiAgICBwdXNoYnl0ZXMgMHgwMDAxMDAwMjAwMDg3MjY1NzY1ZjcwNmY2ZjZjCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo3MC03MwogICAgLy8gY29uc3QgZXNjcm93ID0gYWJpQ2FsbDx0eXBlb2YgQWJzdHJhY3RlZEFjY291bnQucHJvdG90eXBlLmFyYzU4X2dldEVzY3Jvd3M+KHsKICAgIC8vICAgYXBwSWQsCiAgICAvLyAgIGFyZ3M6IFtbbmFtZV1dLAogICAgLy8gfSkucmV0dXJuVmFsdWVbMF0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDkgLy8gOQogICAgKgogICAgcHVzaGludCAyIC8vIDIKICAgICsKICAgIHN3YXAKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbisodWludDY0LGJvb2wxKVtdKQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NzUKICAgIC8vIGFzc2VydChlc2Nyb3cuaWQgIT09IDAsIEVSUl9FU0NST1dfRE9FU19OT1RfRVhJU1QpCiAgICBleHRyYWN0IDYgOQogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGFzc2VydCAvLyBFc2Nyb3cgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjg2CiAgICAvLyBhc3NlcnQoaWQgPT09IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuaWQsIEVSUl9XUk9OR19FU0NST1dfRk9SX09QRVJBVElPTikKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBkaWcgMQogICAgPT0KICAgIGFzc2VydCAvLyBXcm9uZyBlc2Nyb3cgZm9yIHRoaXMgb3BlcmF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo4OC05NwogICAgLy8gaXR4bkNvbXBvc2UuYmVnaW48dHlwZW9mIEFic3RyYWN0ZWRBY2NvdW50LnByb3RvdHlwZS5hcmM1OF9yZWtleVRvUGx1Z2luPih7CiAgICAvLyAgIGFwcElkOiB3YWxsZXQsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICByZXZlbnVlTWFuYWdlciwKICAgIC8vICAgICB0cnVlLAogICAgLy8gICAgIG5hbWUsCiAgICAvLyAgICAgWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgLy8gICAgIFtdCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4NTgyZmYzODIgLy8gbWV0aG9kICJhcmM1OF9yZWtleVRvUGx1Z2luKHVpbnQ2NCxib29sLHN0cmluZyx1aW50NjRbXSwodWludDY0LHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjkyCiAgICAvLyB0cnVlLAogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjkzCiAgICAvLyBuYW1lLAogICAgcHVzaGJ5dGVzIDB4MDAwODcyNjU3NjVmNzA2ZjZmNmMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5NAogICAgLy8gWzBdLCAvLyBhbGwgdGhlIGFraXRhIGVzY3Jvd3MgaGF2ZSBtZXRob2QgcmVzdHJpY3Rpb25zIHdpdGggb3B0aW4gYmVpbmcgaW5kZXggMAogICAgcHVzaGJ5dGVzIDB4MDAwMTAwMDAwMDAwMDAwMDAwMDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo5NQogICAgLy8gW10KICAgIGJ5dGVjIDUgLy8gMHgwMDAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZGlnIDMKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6ODgtOTcKICAgIC8vIGl0eG5Db21wb3NlLmJlZ2luPHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfcmVrZXlUb1BsdWdpbj4oewogICAgLy8gICBhcHBJZDogd2FsbGV0LAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIFswXSwgLy8gYWxsIHRoZSBha2l0YSBlc2Nyb3dzIGhhdmUgbWV0aG9kIHJlc3RyaWN0aW9ucyB3aXRoIG9wdGluIGJlaW5nIGluZGV4IDAKICAgIC8vICAgICBbXQogICAgLy8gICBdLAogICAgLy8gfSkKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEwMQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OTktMTAzCiAgICAvLyBjb25zdCBvcHRJbkNvdW50ID0gc3BsaXRPcHRJbkNvdW50KAogICAgLy8gICB0aGlzLmFraXRhREFPLnZhbHVlLAogICAgLy8gICB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFzc2V0CiAgICAvLyApCiAgICB1bmNvdmVyIDIKICAgIHN3YXAKICAgIGRpZyA0CiAgICBjYWxsc3ViIHNwbGl0T3B0SW5Db3VudAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA1CiAgICAvLyBjb25zdCBtYnJBbW91bnQ6IHVpbnQ2NCA9IEdsb2JhbC5hc3NldE9wdEluTWluQmFsYW5jZSAqIG9wdEluQ291bnQKICAgIGdsb2JhbCBBc3NldE9wdEluTWluQmFsYW5jZQogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTQKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjExMy0xMTYKICAgIC8vIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgIGFtb3VudDogbWJyQW1vdW50CiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgaXR4bl9uZXh0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTAKICAgIC8vIHdhbGxldCwKICAgIGRpZyAxCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMTIKICAgIC8vIFthc3NldC5pZF0sCiAgICB1bmNvdmVyIDMKICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDEKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGJ5dGVzIDB4NjgzNWUzYmMgLy8gbWV0aG9kICJvcHRJbih1aW50NjQsYm9vbCx1aW50NjRbXSxwYXkpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6OTIKICAgIC8vIHRydWUsCiAgICBieXRlYyAxOSAvLyAweDgwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTA3LTExOAogICAgLy8gaXR4bkNvbXBvc2UubmV4dDx0eXBlb2YgUmV2ZW51ZU1hbmFnZXJQbHVnaW5TdHViLnByb3RvdHlwZS5vcHRJbj4oewogICAgLy8gICBhcHBJZDogcmV2ZW51ZU1hbmFnZXIsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICB3YWxsZXQsCiAgICAvLyAgICAgdHJ1ZSwKICAgIC8vICAgICBbYXNzZXQuaWRdLAogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQKICAgIC8vICAgICB9KQogICAgLy8gICBdCiAgICAvLyB9KQogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTIwCiAgICAvLyBpdHhuQ29tcG9zZS5uZXh0PHR5cGVvZiBBYnN0cmFjdGVkQWNjb3VudC5wcm90b3R5cGUuYXJjNThfdmVyaWZ5QXV0aEFkZHJlc3M+KHsgYXBwSWQ6IHdhbGxldCB9KQogICAgaXR4bl9uZXh0CiAgICBwdXNoYnl0ZXMgMHg2Y2MzZjYwNiAvLyBtZXRob2QgImFyYzU4X3ZlcmlmeUF1dGhBZGRyZXNzKCl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTMyCiAgICAvLyBpdHhuQ29tcG9zZS5zdWJtaXQoKQogICAgaXR4bl9zdWJtaXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4MzIKICAgIC8vIG9wdEluKHBheW1lbnQ6IGd0eG4uUGF5bWVudFR4biwgYXNzZXQ6IEFzc2V0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRbcm91dGluZ10oKSAtPiB2b2lkOgphZGRSZXdhcmQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODY5CiAgICAvLyBhZGRSZXdhcmQocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCA0MSAvLyA0MQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkCiAgICBwb3AKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZEFzYVtyb3V0aW5nXSgpIC0+IHZvaWQ6CmFkZFJld2FyZEFzYToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGFkZFJld2FyZEFzYShwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGFzc2V0WGZlcjogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNCAvLyBheGZlcgogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGF4ZmVyCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgcHVzaGludCA0MSAvLyA0MQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTA5LTkxOQogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIGFzc2V0WGZlciwKICAgIC8vICAgewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIHhmZXJBc3NldDogQXNzZXQocmV3YXJkLmFzc2V0KSwKICAgIC8vICAgICBhc3NldEFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW46IDAKICAgIC8vICAgICB9CiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1RSQU5TRkVSCiAgICAvLyApCiAgICBkaWcgMQogICAgZ3R4bnMgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkxMgogICAgLy8gYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkwOS05MTkKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBhc3NldFhmZXIsCiAgICAvLyAgIHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICB4ZmVyQXNzZXQ6IEFzc2V0KHJld2FyZC5hc3NldCksCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuOiAwCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9UUkFOU0ZFUgogICAgLy8gKQogICAgPT0KICAgIGRpZyAyCiAgICBndHhucyBYZmVyQXNzZXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MTMKICAgIC8vIHhmZXJBc3NldDogQXNzZXQocmV3YXJkLmFzc2V0KSwKICAgIGRpZyAyCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDktOTE5CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgYXNzZXRYZmVyLAogICAgLy8gICB7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgeGZlckFzc2V0OiBBc3NldChyZXdhcmQuYXNzZXQpLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbjogMAogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfVFJBTlNGRVIKICAgIC8vICkKICAgID09CiAgICAmJgogICAgdW5jb3ZlciAyCiAgICBndHhucyBBc3NldEFtb3VudAogICAgJiYKICAgIGFzc2VydCAvLyBJbnZhbGlkIHRyYW5zZmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTIxCiAgICAvLyB0aGlzLmFkZFJld2FyZChwYXltZW50LCByZXdhcmQpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDgKICAgIC8vIGFkZFJld2FyZEFzYShwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGFzc2V0WGZlcjogZ3R4bi5Bc3NldFRyYW5zZmVyVHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZmluYWxpemVbcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjQKICAgIC8vIGZpbmFsaXplKHNpZ251cFRpbWVzdGFtcDogdWludDY0LCBzdGFydFRpbWVzdGFtcDogdWludDY0LCBlbmRUaW1lc3RhbXA6IHVpbnQ2NCkgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIGR1cAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDMKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICBidG9pCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wnKQogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI1CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCAnb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gb25seSB0aGUgY3JlYXRvciBjYW4gZmluYWxpemUgdGhlIHBvb2wKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjYKICAgIC8vIGFzc2VydCh0aGlzLnN0YXR1cy52YWx1ZSA9PT0gUG9vbFN0YXR1c0RyYWZ0LCAndGhlIHBvb2wgbXVzdCBiZSBpbiBkcmFmdCBzdGF0ZSB0byBmaW5hbGl6ZScpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI2CiAgICAvLyBhc3NlcnQodGhpcy5zdGF0dXMudmFsdWUgPT09IFBvb2xTdGF0dXNEcmFmdCwgJ3RoZSBwb29sIG11c3QgYmUgaW4gZHJhZnQgc3RhdGUgdG8gZmluYWxpemUnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgPT0KICAgIGFzc2VydCAvLyB0aGUgcG9vbCBtdXN0IGJlIGluIGRyYWZ0IHN0YXRlIHRvIGZpbmFsaXplCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI4CiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIHx8IChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKSwKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgID4KICAgIGJueiBmaW5hbGl6ZV9ib29sX3RydWVANAogICAgZGlnIDIKICAgIGJueiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIyCiAgICAvLyBhbGxvd0xhdGVTaWdudXBzID0gR2xvYmFsU3RhdGU8Ym9vbGVhbj4oeyBpbml0aWFsVmFsdWU6IGZhbHNlLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFsbG93TGF0ZVNpZ251cHMgfSkKICAgIGJ5dGVjIDE0IC8vICJhbGxvd19sYXRlX3NpZ251cHMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI4CiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIHx8IChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDUKCmZpbmFsaXplX2Jvb2xfdHJ1ZUA0OgogICAgaW50Y18xIC8vIDEKCmZpbmFsaXplX2Jvb2xfbWVyZ2VANjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MjctOTMwCiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHNpZ251cFRpbWVzdGFtcCA+IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgfHwgKHNpZ251cFRpbWVzdGFtcCA9PT0gMCAmJiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUpLAogICAgLy8gICAndGhlIHNpZ251cCByb3VuZCBtdXN0IGJlIHplcm8gYW5kIGxhdGUgc2lnbiB1cHMgYWxsb3dlZCBvciBpbiB0aGUgZnV0dXJlJwogICAgLy8gKQogICAgYXNzZXJ0IC8vIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBsYXRlIHNpZ24gdXBzIGFsbG93ZWQgb3IgaW4gdGhlIGZ1dHVyZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkzMy05MzQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID09PSAwIHx8CiAgICAvLyBzdGFydFRpbWVzdGFtcCA+IEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAsCiAgICBkaWcgMQogICAgYnogZmluYWxpemVfYm9vbF90cnVlQDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGRpZyAxCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICA+CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTMzLTkzNAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPT09IDAgfHwKICAgIC8vIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIGJ6IGZpbmFsaXplX2Jvb2xfZmFsc2VAOQoKZmluYWxpemVfYm9vbF90cnVlQDg6CiAgICBpbnRjXzEgLy8gMQoKZmluYWxpemVfYm9vbF9tZXJnZUAxMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzItOTM2CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHN0YXJ0VGltZXN0YW1wID09PSAwIHx8CiAgICAvLyAgIHN0YXJ0VGltZXN0YW1wID4gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCwKICAgIC8vICAgJ3RoZSBzdGFydGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgaW4gdGhlIGZ1dHVyZScKICAgIC8vICkKICAgIGFzc2VydCAvLyB0aGUgc3RhcnRpbmcgcm91bmQgbXVzdCBiZSB6ZXJvIG9yIGluIHRoZSBmdXR1cmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzgKICAgIC8vIGlmIChzdGFydFRpbWVzdGFtcCA9PT0gMCkgewogICAgZGlnIDEKICAgIGJueiBmaW5hbGl6ZV9hZnRlcl9pZl9lbHNlQDE2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTM5CiAgICAvLyBhc3NlcnQoc2lnbnVwVGltZXN0YW1wID09PSAwICYmIHRoaXMuYWxsb3dMYXRlU2lnbnVwcy52YWx1ZSwgJ2lmIHRoZSBzdGFydGluZyByb3VuZCBpcyB6ZXJvLCB0aGUgc2lnbnVwIHJvdW5kIG11c3QgYmUgemVybyBhbmQgYWxsb3dMYXRlU2lnbnVwcyBtdXN0IGJlIHRydWUnKQogICAgZGlnIDIKICAgIGJueiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkzOQogICAgLy8gYXNzZXJ0KHNpZ251cFRpbWVzdGFtcCA9PT0gMCAmJiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUsICdpZiB0aGUgc3RhcnRpbmcgcm91bmQgaXMgemVybywgdGhlIHNpZ251cCByb3VuZCBtdXN0IGJlIHplcm8gYW5kIGFsbG93TGF0ZVNpZ251cHMgbXVzdCBiZSB0cnVlJykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieiBmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0CiAgICBpbnRjXzEgLy8gMQoKZmluYWxpemVfYm9vbF9tZXJnZUAxNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MzkKICAgIC8vIGFzc2VydChzaWdudXBUaW1lc3RhbXAgPT09IDAgJiYgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlLCAnaWYgdGhlIHN0YXJ0aW5nIHJvdW5kIGlzIHplcm8sIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBhbGxvd0xhdGVTaWdudXBzIG11c3QgYmUgdHJ1ZScpCiAgICBhc3NlcnQgLy8gaWYgdGhlIHN0YXJ0aW5nIHJvdW5kIGlzIHplcm8sIHRoZSBzaWdudXAgcm91bmQgbXVzdCBiZSB6ZXJvIGFuZCBhbGxvd0xhdGVTaWdudXBzIG11c3QgYmUgdHJ1ZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk0MAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBidXJ5IDIKCmZpbmFsaXplX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTQ0CiAgICAvLyBlbmRUaW1lc3RhbXAgPT09IDAgfHwgZW5kVGltZXN0YW1wID4gKHN0YXJ0VGltZXN0YW1wICsgMTApLAogICAgZHVwCiAgICBieiBmaW5hbGl6ZV9ib29sX3RydWVAMTgKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwIC8vIDEwCiAgICArCiAgICBkaWcgMQogICAgPAogICAgYnogZmluYWxpemVfYm9vbF9mYWxzZUAxOQoKZmluYWxpemVfYm9vbF90cnVlQDE4OgogICAgaW50Y18xIC8vIDEKCmZpbmFsaXplX2Jvb2xfbWVyZ2VAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTQzLTk0NgogICAgLy8gYXNzZXJ0KAogICAgLy8gICBlbmRUaW1lc3RhbXAgPT09IDAgfHwgZW5kVGltZXN0YW1wID4gKHN0YXJ0VGltZXN0YW1wICsgMTApLAogICAgLy8gICAndGhlIGVuZGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgYWZ0ZXIgdGhlIHN0YXJ0aW5nIHJvdW5kICsgMTAnCiAgICAvLyApCiAgICBhc3NlcnQgLy8gdGhlIGVuZGluZyByb3VuZCBtdXN0IGJlIHplcm8gb3IgYWZ0ZXIgdGhlIHN0YXJ0aW5nIHJvdW5kICsgMTAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NDgKICAgIC8vIHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlID0gc2lnbnVwVGltZXN0YW1wCiAgICBkaWcgMwogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NDkKICAgIC8vIHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgPSBzdGFydFRpbWVzdGFtcAogICAgZGlnIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyBlbmRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVuZFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTYgLy8gImVuZF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTUwCiAgICAvLyB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSA9IGVuZFRpbWVzdGFtcAogICAgZGlnIDEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTEKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlID0gUG9vbFN0YXR1c0ZpbmFsCiAgICBieXRlYyA4IC8vIDB4MGEKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTI0CiAgICAvLyBmaW5hbGl6ZShzaWdudXBUaW1lc3RhbXA6IHVpbnQ2NCwgc3RhcnRUaW1lc3RhbXA6IHVpbnQ2NCwgZW5kVGltZXN0YW1wOiB1aW50NjQpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCmZpbmFsaXplX2Jvb2xfZmFsc2VAMTk6CiAgICBpbnRjXzAgLy8gMAogICAgYiBmaW5hbGl6ZV9ib29sX21lcmdlQDIwCgpmaW5hbGl6ZV9ib29sX2ZhbHNlQDE0OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUAxNQoKZmluYWxpemVfYm9vbF9mYWxzZUA5OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUAxMAoKZmluYWxpemVfYm9vbF9mYWxzZUA1OgogICAgaW50Y18wIC8vIDAKICAgIGIgZmluYWxpemVfYm9vbF9tZXJnZUA2CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlZEVudGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2F0ZWRFbnRlcjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTQtOTU4CiAgICAvLyBnYXRlZEVudGVyKAogICAgLy8gICBwYXltZW50OiBndHhuLlBheW1lbnRUeG4sCiAgICAvLyAgIGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLAogICAgLy8gICBlbnRyaWVzOiBTdGFrZUVudHJ5W10sCiAgICAvLyApOiB2b2lkIHsKICAgIHR4biBHcm91cEluZGV4CiAgICBwdXNoaW50IDIgLy8gMgogICAgLQogICAgZHVwCiAgICBndHhucyBUeXBlRW51bQogICAgaW50Y18xIC8vIHBheQogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIHBheQogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgPT0KICAgIGFzc2VydCAvLyB0cmFuc2FjdGlvbiB0eXBlIGlzIGFwcGwKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NTkKICAgIC8vIGNvbnN0IHdhbGxldCA9IGdldFdhbGxldElEVXNpbmdBa2l0YURBTyh0aGlzLmFraXRhREFPLnZhbHVlLCBUeG4uc2VuZGVyKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk1OQogICAgLy8gY29uc3Qgd2FsbGV0ID0gZ2V0V2FsbGV0SURVc2luZ0FraXRhREFPKHRoaXMuYWtpdGFEQU8udmFsdWUsIFR4bi5zZW5kZXIpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgdHhuIFNlbmRlcgogICAgY2FsbHN1YiBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIHR4biBTZW5kZXIKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTM5CiAgICAvLyBpZiAod2FsbGV0SUQuaWQgPT09IDApIHsKICAgIGJueiBnYXRlZEVudGVyX2FmdGVyX2lmX2Vsc2VAMwogICAgZHVwCgpnYXRlZEVudGVyX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIGFzc2VydCh0aGlzLmdhdGVJRC52YWx1ZSAhPT0gMCwgJ2dhdGUgaWQgaXMgbm90IHNldCcpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjIKICAgIC8vIGFzc2VydCh0aGlzLmdhdGVJRC52YWx1ZSAhPT0gMCwgJ2dhdGUgaWQgaXMgbm90IHNldCcpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhc3NlcnQgLy8gZ2F0ZSBpZCBpcyBub3Qgc2V0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTYzCiAgICAvLyBhc3NlcnQoZ2F0ZUNoZWNrKGdhdGVUeG4sIHRoaXMuYWtpdGFEQU8udmFsdWUsIG9yaWdpbiwgdGhpcy5nYXRlSUQudmFsdWUpLCBFUlJfRkFJTEVEX0dBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDYKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICB1bmNvdmVyIDQKICAgIHVuY292ZXIgNAogICAgY2FsbHN1YiBnYXRlQ2hlY2sKICAgIGFzc2VydCAvLyBHYXRlIGNoZWNrIGZhaWxlZAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoyMzkKICAgIC8vIHJldHVybiBkZWNvZGVBcmM0PEdhdGVBcmdzPihnYXRlVHhuLmFwcEFyZ3MoMykpCiAgICBwdXNoaW50IDMgLy8gMwogICAgZ3R4bnNhcyBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NjUKICAgIC8vIHRoaXMuY3JlYXRlUG9vbEVudHJpZXMocGF5bWVudCwgZW50cmllcywgZ2V0R2F0ZUFyZ3MoZ2F0ZVR4bikpCiAgICBkaWcgNQogICAgZGlnIDQKICAgIHVuY292ZXIgMgogICAgY2FsbHN1YiBjcmVhdGVQb29sRW50cmllcwogICAgcG9wbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTU0LTk1OAogICAgLy8gZ2F0ZWRFbnRlcigKICAgIC8vICAgcGF5bWVudDogZ3R4bi5QYXltZW50VHhuLAogICAgLy8gICBnYXRlVHhuOiBndHhuLkFwcGxpY2F0aW9uQ2FsbFR4biwKICAgIC8vICAgZW50cmllczogU3Rha2VFbnRyeVtdLAogICAgLy8gKTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpnYXRlZEVudGVyX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGRpZyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjE2MwogICAgLy8gQnl0ZXMoQWJzdHJhY3RBY2NvdW50R2xvYmFsU3RhdGVLZXlzQ29udHJvbGxlZEFkZHJlc3MpCiAgICBieXRlYyAzNyAvLyAiY29udHJvbGxlZF9hZGRyZXNzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjEtMTY0CiAgICAvLyBjb25zdCBbY29udHJvbGxlZEFjY291bnRCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcygKICAgIC8vICAgd2FsbGV0SUQsCiAgICAvLyAgIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgLy8gKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNDYKICAgIC8vIHJldHVybiBvcmlnaW5Pcih3YWxsZXRJRCwgVHhuLnNlbmRlcikKICAgIGIgZ2F0ZWRFbnRlcl9hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6Om9yaWdpbk9yQDQKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmVudGVyW3JvdXRpbmddKCkgLT4gdm9pZDoKZW50ZXI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTY4CiAgICAvLyBlbnRlcihwYXltZW50OiBndHhuLlBheW1lbnRUeG4sIGVudHJpZXM6IFN0YWtlRW50cnlbXSk6IHZvaWQgewogICAgdHhuIEdyb3VwSW5kZXgKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkdXAKICAgIGd0eG5zIFR5cGVFbnVtCiAgICBpbnRjXzEgLy8gcGF5CiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgcGF5CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTY5CiAgICAvLyB0aGlzLmNyZWF0ZVBvb2xFbnRyaWVzKHBheW1lbnQsIGVudHJpZXMsIFtdKQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGNhbGxzdWIgY3JlYXRlUG9vbEVudHJpZXMKICAgIHBvcG4gMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk2OAogICAgLy8gZW50ZXIocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBlbnRyaWVzOiBTdGFrZUVudHJ5W10pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnN0YXJ0RGlzYnVyc2VtZW50W3JvdXRpbmddKCkgLT4gdm9pZDoKc3RhcnREaXNidXJzZW1lbnQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTcyCiAgICAvLyBzdGFydERpc2J1cnNlbWVudChyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk3MwogICAgLy8gYXNzZXJ0KHRoaXMuaXNMaXZlKCksICd0aGUgcG9vbCBpcyBub3QgbGl2ZScpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlCiAgICBhc3NlcnQgLy8gdGhlIHBvb2wgaXMgbm90IGxpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzQKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBib3hfbGVuCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyByZXdhcmQgZG9lcyBub3QgZXhpc3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5NzYKICAgIC8vIGNvbnN0IHsgcGhhc2UsIGludGVydmFsLCBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wLCBleHBpcmF0aW9uIH0gPSB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZGlnIDEKICAgIHB1c2hpbnQgMjUgLy8gMjUKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDIKICAgIHB1c2hpbnQgMTE2IC8vIDExNgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBjb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMTcgLy8gMTcKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgY292ZXIgMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk3OAogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUlkbGUsICdyZXdhcmQgaXMgYWxyZWFkeSBpbiBhIGRpc2J1cnNlbWVudCBwaGFzZScpCiAgICBzd2FwCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBhc3NlcnQgLy8gcmV3YXJkIGlzIGFscmVhZHkgaW4gYSBkaXNidXJzZW1lbnQgcGhhc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzAKICAgIC8vIHJldHVybiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJSBpbnRlcnZhbCkKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzAKICAgIC8vIHJldHVybiBHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gKChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIC0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJSBpbnRlcnZhbCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAtCiAgICB1bmNvdmVyIDIKICAgICUKICAgIC0KICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUzNQogICAgLy8gcmV0dXJuIGxhdGVzdFdpbmRvd1N0YXJ0ICE9PSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wICYmIGxhc3QgPCBsYXRlc3RXaW5kb3dTdGFydAogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgIT0KICAgIGJ6IHN0YXJ0RGlzYnVyc2VtZW50X2Jvb2xfZmFsc2VANAogICAgZGlnIDIKICAgIGRpZyAxCiAgICA8CiAgICBieiBzdGFydERpc2J1cnNlbWVudF9ib29sX2ZhbHNlQDQKICAgIGludGNfMSAvLyAxCgpzdGFydERpc2J1cnNlbWVudF9ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTc5CiAgICAvLyBhc3NlcnQodGhpcy52YWxpZFdpbmRvdyhpbnRlcnZhbCwgbGFzdERpc2J1cnNlbWVudFRpbWVzdGFtcCksICdkaXN0cmlidXRpb24gd2luZG93IGlzIG5vdCBvcGVuJykKICAgIGFzc2VydCAvLyBkaXN0cmlidXRpb24gd2luZG93IGlzIG5vdCBvcGVuCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTgyCiAgICAvLyBgJHt0aGlzLnRpdGxlLnZhbHVlfSAtIFJld2FyZHNgLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTYKICAgIC8vIHRpdGxlID0gR2xvYmFsU3RhdGU8c3RyaW5nPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VGl0bGUgfSkKICAgIGJ5dGVjIDI4IC8vICJ0aXRsZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODIKICAgIC8vIGAke3RoaXMudGl0bGUudmFsdWV9IC0gUmV3YXJkc2AsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgcHVzaGJ5dGVzICIgLSBSZXdhcmRzIgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTg0CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wICsgZXhwaXJhdGlvbgogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgZGlnIDMKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTcKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkucmV3YXJkcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTcKICAgIC8vIGNvbnN0IHJld2FyZHNBcHAgPSBBcHBsaWNhdGlvbihnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkucmV3YXJkcykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTU3CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTgKICAgIC8vIGNvbnN0IHJld2FyZE1CUjogdWludDY0ID0gTWluRGlzYnVyc2VtZW50c01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIEJ5dGVzKHRpdGxlKS5sZW5ndGgpCiAgICBkaWcgMgogICAgbGVuCiAgICBpbnRjIDYgLy8gNDAwCiAgICBkaWcgMQogICAgKgogICAgcHVzaGludCAzNTMwMCAvLyAzNTMwMAogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2MQogICAgLy8gcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIGRpZyAyCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTY1LTU3NAogICAgLy8gcmV0dXJuIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZURpc2J1cnNlbWVudD4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIG1iclBheW1lbnQsCiAgICAvLyAgICAgdGl0bGUsCiAgICAvLyAgICAgdGltZVRvVW5sb2NrLAogICAgLy8gICAgIGV4cGlyYXRpb24sCiAgICAvLyAgICAgJycsCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgaXR4bl9maWVsZCBSZWNlaXZlcgogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NjAtNTYzCiAgICAvLyBjb25zdCBtYnJQYXltZW50ID0gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiByZXdhcmRNQlIsCiAgICAvLyB9KQogICAgaW50Y18xIC8vIDEKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2NS01NzQKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHRpdGxlLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgICcnLAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2OQogICAgLy8gdGl0bGUsCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAzCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODMKICAgIC8vIDAsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU3MAogICAgLy8gdGltZVRvVW5sb2NrLAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU3MQogICAgLy8gZXhwaXJhdGlvbiwKICAgIHVuY292ZXIgMwogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU2NS01NzQKICAgIC8vIHJldHVybiBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBtYnJQYXltZW50LAogICAgLy8gICAgIHRpdGxlLAogICAgLy8gICAgIHRpbWVUb1VubG9jaywKICAgIC8vICAgICBleHBpcmF0aW9uLAogICAgLy8gICAgICcnLAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDFjOWViYmRiIC8vIG1ldGhvZCAiY3JlYXRlRGlzYnVyc2VtZW50KHBheSxzdHJpbmcsdWludDY0LHVpbnQ2NCxzdHJpbmcpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGRpZyAxCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NzIKICAgIC8vICcnLAogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NjUtNTc0CiAgICAvLyByZXR1cm4gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlRGlzYnVyc2VtZW50Pih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgbWJyUGF5bWVudCwKICAgIC8vICAgICB0aXRsZSwKICAgIC8vICAgICB0aW1lVG9VbmxvY2ssCiAgICAvLyAgICAgZXhwaXJhdGlvbiwKICAgIC8vICAgICAnJywKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoaW50IDYgLy8gYXBwbAogICAgaXR4bl9maWVsZCBUeXBlRW51bQogICAgaW50Y18wIC8vIDAKICAgIGl0eG5fZmllbGQgRmVlCiAgICBpdHhuX3N1Ym1pdAogICAgZ2l0eG4gMSBMYXN0TG9nCiAgICBkdXAKICAgIGV4dHJhY3QgNCAwCiAgICBzd2FwCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGR1cAogICAgbGVuCiAgICBpbnRjXzIgLy8gOAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTg3CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlcnMgPSAwCiAgICBkaWcgNQogICAgZHVwCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICBkaWcgNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODgKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucXVhbGlmaWVkU3Rha2UgPSAwCiAgICBkdXAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHVuY292ZXIgNAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5ODkKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjIDggLy8gMHgwYQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50SUQgPSBkaXNidXJzZW1lbnRJRAogICAgZHVwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIHVuY292ZXIgMwogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCA9IEdsb2JhbC5yb3VuZAogICAgZ2xvYmFsIFJvdW5kCiAgICBpdG9iCiAgICBkaWcgMQogICAgcHVzaGludCAxMDggLy8gMTA4CiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTkzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXAgPSBHbG9iYWwubGF0ZXN0VGltZXN0YW1wCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpdG9iCiAgICBwdXNoaW50IDExNiAvLyAxMTYKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTcyCiAgICAvLyBzdGFydERpc2J1cnNlbWVudChyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpzdGFydERpc2J1cnNlbWVudF9ib29sX2ZhbHNlQDQ6CiAgICBpbnRjXzAgLy8gMAogICAgYiBzdGFydERpc2J1cnNlbWVudF9ib29sX21lcmdlQDUKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnJhZmZsZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5OTYKICAgIC8vIHJhZmZsZShyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZGlzYnVyc2VSZXdhcmRzW3JvdXRpbmddKCkgLT4gdm9pZDoKZGlzYnVyc2VSZXdhcmRzOgogICAgaW50Y18wIC8vIDAKICAgIGR1cG4gMgogICAgcHVzaGJ5dGVzICIiCiAgICBkdXBuIDI3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzNgogICAgLy8gZGlzYnVyc2VSZXdhcmRzKHJld2FyZElEOiB1aW50NjQsIGl0ZXJhdGlvbkFtb3VudDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgZHVwCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAyCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzgKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICBkdXBuIDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDM4CiAgICAvLyBhc3NlcnQodGhpcy5yZXdhcmRzKHJld2FyZElEKS5leGlzdHMsICdyZXdhcmQgZG9lcyBub3QgZXhpc3QnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA0MAogICAgLy8gY29uc3QgeyBwaGFzZSwgZGlzdHJpYnV0aW9uLCB3aW5uaW5nVGlja2V0cyB9ID0gY2xvbmUodGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZSkKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgc3dhcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNDMKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICBieXRlYyA4IC8vIDB4MGEKICAgID09CiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQzLTEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyBwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uLAogICAgYm56IGRpc2J1cnNlUmV3YXJkc19ib29sX3RydWVAMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sCiAgICBkaWcgMgogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQzLTEwNDQKICAgIC8vIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyBwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uLAogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jvb2xfZmFsc2VANAoKZGlzYnVyc2VSZXdhcmRzX2Jvb2xfdHJ1ZUAzOgogICAgaW50Y18xIC8vIDEKCmRpc2J1cnNlUmV3YXJkc19ib29sX21lcmdlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA0Mi0xMDQ2CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZVByZXBhcmF0aW9uIHx8CiAgICAvLyAgIHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sCiAgICAvLyAgIEVSUl9OT1RfUkVBRFlfVE9fRElTQlVSU0UKICAgIC8vICkKICAgIGFzc2VydCAvLyBOb3QgcmVhZHkgdG8gZGlzYnVyc2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDQ4CiAgICAvLyBpZiAocGhhc2UgPT09IERpc2J1cnNlbWVudFBoYXNlUHJlcGFyYXRpb24pIHsKICAgIGR1cAogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI0CiAgICAvLyBjb25zdCB7IGRpc2J1cnNlbWVudEN1cnNvciwgZGlzdHJpYnV0aW9uLCByYXRlLCBhc3NldCB9ID0gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZQogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAyMwogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDQKICAgIGR1cAogICAgcHVzaGludCA5IC8vIDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNQogICAgaW50Y18wIC8vIDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAyOQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyNQogICAgLy8gbGV0IGNvdW50OiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSAyNwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyNgogICAgLy8gbGV0IHRvdGFsOiB1aW50NjQgPSAwCiAgICBpbnRjXzAgLy8gMAogICAgYnVyeSA5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjI4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxMDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMjkKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIyOQogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDIxCiAgICAtCiAgICBidXJ5IDUKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDEwMDoKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEAxMDE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMyCiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDIwCiAgICBkaWcgNQogICAgKwogICAgZGlnIDE4CiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfd2hpbGVAMTEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgcXVhbnRpdHkgfSA9IGNsb25lKHRoaXMuZW50cmllcyhpZCkudmFsdWUpCiAgICBkaWcgMTcKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBidXJ5IDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjMzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgcXVhbnRpdHkgfSA9IGNsb25lKHRoaXMuZW50cmllcyhpZCkudmFsdWUpCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgcHVzaGludCA0MCAvLyA0MAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjM0CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUzOQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfTk9ORSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MzkKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX05PTkUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEwNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0MAogICAgLy8gcmV0dXJuIHsgdmFsaWQ6IHRydWUsIGJhbGFuY2U6IDAgfQogICAgcHVzaGJ5dGVzIDB4ODAwMDAwMDAwMDAwMDAwMDAwCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmdldFN0YWtlVmFsdWVAMTA5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIzOQogICAgLy8gY29uc3QgeyB2YWxpZCB9ID0gdGhpcy5nZXRTdGFrZVZhbHVlKGlkKQogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0MAogICAgLy8gaWYgKCF2YWxpZCkgewogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0NAogICAgLy8gY291bnQgKz0gMQogICAgZGlnIDI1CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI0NQogICAgLy8gdG90YWwgKz0gcXVhbnRpdHkKICAgIGRpZyA3CiAgICBkaWcgMTQKICAgICsKICAgIGJ1cnkgOAoKZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDExMjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMzIKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMTcKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDE4CiAgICBiIGRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAMTAxCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEwNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDEKICAgIC8vIH0gZWxzZSBpZiAodGhpcy50eXBlLnZhbHVlID09PSBQT09MX1NUQUtJTkdfVFlQRV9IRUFSVEJFQVQpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQxCiAgICAvLyB9IGVsc2UgaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICA9PQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTA4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQyCiAgICAvLyBjb25zdCB7IGFkZHJlc3MsIGFzc2V0IH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkaWcgMzQKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBzd2FwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDQtNTQ3CiAgICAvLyBjb25zdCBhdmcgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRIZWFydGJlYXRBdmVyYWdlPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogW2FkZHJlc3MsIGFzc2V0LCB0cnVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ1CiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0NQogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ0LTU0NwogICAgLy8gY29uc3QgYXZnID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuZ2V0SGVhcnRiZWF0QXZlcmFnZT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICAvLyAgIGFyZ3M6IFthZGRyZXNzLCBhc3NldCwgdHJ1ZV0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgcHVzaGJ5dGVzIDB4YzZmZDBjYTUgLy8gbWV0aG9kICJnZXRIZWFydGJlYXRBdmVyYWdlKGFkZHJlc3MsdWludDY0LGJvb2wpdWludDY0IgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHVuY292ZXIgMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIHN3YXAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTQ2CiAgICAvLyBhcmdzOiBbYWRkcmVzcywgYXNzZXQsIHRydWVdLAogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU0NC01NDcKICAgIC8vIGNvbnN0IGF2ZyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEhlYXJ0YmVhdEF2ZXJhZ2U+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbYWRkcmVzcywgYXNzZXQsIHRydWVdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIHN3YXAKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NDkKICAgIC8vIHJldHVybiB7IHZhbGlkOiB0cnVlLCBiYWxhbmNlOiBhdmcgfQogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjM5CiAgICAvLyBjb25zdCB7IHZhbGlkIH0gPSB0aGlzLmdldFN0YWtlVmFsdWUoaWQpCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pbmxpbmVkX3NtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2V0U3Rha2VWYWx1ZUAxMDkKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDEwODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NTIKICAgIC8vIHJldHVybiB0aGlzLmNoZWNrQnlJRChpZCkKICAgIGRpZyAxNwogICAgY2FsbHN1YiBjaGVja0J5SUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMzkKICAgIC8vIGNvbnN0IHsgdmFsaWQgfSA9IHRoaXMuZ2V0U3Rha2VWYWx1ZShpZCkKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nZXRTdGFrZVZhbHVlQDEwOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDExMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNDgKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucXVhbGlmaWVkU3Rha2VycyArPSBjb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMjcKICAgICsKICAgIGl0b2IKICAgIGRpZyAxCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjQ5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlICs9IHRvdGFsCiAgICBkdXAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDkKICAgICsKICAgIGl0b2IKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjUxCiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBkaXNidXJzZW1lbnRDdXJzb3IpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNTEKICAgIC8vIGlmICh0aGlzLmVudHJ5SUQudmFsdWUgPT09IGRpc2J1cnNlbWVudEN1cnNvcikgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMTIxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjUzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VBbGxvY2F0aW9uCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDkxIC8vIDkxCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI1NAogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSAxIC8vIEVudHJ5IElEcyBzdGFydCBhdCAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgZGlnIDEKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI1NgogICAgLy8gdGhpcy5wYXlBa2l0YVJveWFsdHkoZGlzdHJpYnV0aW9uLCByYXRlLCBhc3NldCwgdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5xdWFsaWZpZWRTdGFrZXJzKQogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTg5CiAgICAvLyBpZiAoZGlzdHJpYnV0aW9uID09PSBEaXN0cmlidXRpb25UeXBlRmxhdCkgewogICAgZGlnIDEKICAgIGJ5dGVjIDEyIC8vIDB4MTQKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDExNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE5MAogICAgLy8gYW1vdW50ID0gY2FsY1BlcmNlbnQoKHF1YWxpZmllZFN0YWtlcnMgKiByYXRlKSwgdGhpcy5ha2l0YVJveWFsdHkudmFsdWUpCiAgICBkaWcgMTQKICAgIGRpZyAxMwogICAgKgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTkwCiAgICAvLyBhbW91bnQgPSBjYWxjUGVyY2VudCgocXVhbGlmaWVkU3Rha2VycyAqIHJhdGUpLCB0aGlzLmFraXRhUm95YWx0eS52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGR1cAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgYnVyeSAyOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTE3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTk1CiAgICAvLyB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZSA9IGFtb3VudAogICAgZGlnIDI5CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGFwcF9nbG9iYWxfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTk4CiAgICAvLyBpZiAoYW1vdW50ID09PSAwKSB7CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIwMwogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBkaWcgMjcKICAgIGJueiBkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEyMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIwNC0yMDkKICAgIC8vIGl0eG4KICAgIC8vICAgLnBheW1lbnQoewogICAgLy8gICAgIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50LAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDYKICAgIC8vIHJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjA2CiAgICAvLyByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgIGRpZyAyOQogICAgaXR4bl9maWVsZCBBbW91bnQKICAgIGl0eG5fZmllbGQgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDQtMjA4CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudCwKICAgIC8vICAgfSkKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMDQtMjA5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5wYXltZW50KHsKICAgIC8vICAgICByZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFtb3VudCwKICAgIC8vICAgfSkKICAgIC8vICAgLnN1Ym1pdCgpCiAgICBpdHhuX3N1Ym1pdAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzNgogICAgLy8gZGlzYnVyc2VSZXdhcmRzKHJld2FyZElEOiB1aW50NjQsIGl0ZXJhdGlvbkFtb3VudDogdWludDY0KTogdm9pZCB7CiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDEyMDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMTEKICAgIC8vIGFzc2VydCh0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MuaXNPcHRlZEluKEFzc2V0KGFzc2V0KSksIEVSUl9EQU9fTk9UX09QVEVEX0lOKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjY1CiAgICAvLyBha2l0YURBT0VzY3JvdyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YUVzY3JvdyB9KQogICAgYnl0ZWMgNyAvLyAiYWtpdGFfZXNjcm93IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxMQogICAgLy8gYXNzZXJ0KHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcy5pc09wdGVkSW4oQXNzZXQoYXNzZXQpKSwgRVJSX0RBT19OT1RfT1BURURfSU4pCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBkaWcgMjkKICAgIGR1cAogICAgY292ZXIgMgogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBidXJ5IDEKICAgIGFzc2VydCAvLyBEQU8gbm90IG9wdGVkIGluIHRvIHRoZSBhc3NldAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxMy0yMTkKICAgIC8vIGl0eG4KICAgIC8vICAgLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgIGFzc2V0UmVjZWl2ZXI6IHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywKICAgIC8vICAgICBhc3NldEFtb3VudDogYW1vdW50LAogICAgLy8gICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIH0pCiAgICAvLyAgIC5zdWJtaXQoKQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjIxNQogICAgLy8gYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgc3dhcAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgc3dhcAogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGRpZyAyOQogICAgaXR4bl9maWVsZCBBc3NldEFtb3VudAogICAgaXR4bl9maWVsZCBBc3NldFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjEzLTIxOAogICAgLy8gaXR4bgogICAgLy8gICAuYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgYXNzZXRSZWNlaXZlcjogdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLAogICAgLy8gICAgIGFzc2V0QW1vdW50OiBhbW91bnQsCiAgICAvLyAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgfSkKICAgIHB1c2hpbnQgNCAvLyA0CiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyMTMtMjE5CiAgICAvLyBpdHhuCiAgICAvLyAgIC5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICBhc3NldFJlY2VpdmVyOiB0aGlzLmFraXRhREFPRXNjcm93LnZhbHVlLmFkZHJlc3MsCiAgICAvLyAgICAgYXNzZXRBbW91bnQ6IGFtb3VudCwKICAgIC8vICAgICB4ZmVyQXNzZXQ6IGFzc2V0LAogICAgLy8gICB9KQogICAgLy8gICAuc3VibWl0KCkKICAgIGl0eG5fc3VibWl0CiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDExNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxOTIKICAgIC8vIGFtb3VudCA9IGNhbGNQZXJjZW50KHJhdGUsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTkyCiAgICAvLyBhbW91bnQgPSBjYWxjUGVyY2VudChyYXRlLCB0aGlzLmFraXRhUm95YWx0eS52YWx1ZSkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwNAogICAgLy8gYXNzZXJ0KHAgPD0gRElWSVNPUiwgRVJSX0lOVkFMSURfUEVSQ0VOVEFHRSkKICAgIGR1cAogICAgaW50YyA0IC8vIDEwMDAwMAogICAgPD0KICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA1CiAgICAvLyByZXR1cm4gb3AuZGl2dyguLi5vcC5tdWx3KGEsIHApLCBESVZJU09SKQogICAgZGlnIDEzCiAgICBtdWx3CiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBkaXZ3CiAgICBidXJ5IDI5CiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDExNwoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUAxMjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjU5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciArPSBpdGVyYXRpb25BbW91bnQKICAgIGRpZyAzCiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgNgogICAgKwogICAgaXRvYgogICAgaW50Y18zIC8vIDkyCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNTIKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2U6IHsKICAgIGRpZyAxCiAgICBieXRlYyA4IC8vIDB4MGEKICAgID09CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA1Mi0xMDU1CiAgICAvLyBjYXNlIERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlOiB7CiAgICAvLyAgIHRoaXMuY3JlYXRlUGVyY2VudGFnZURpc2J1cnNlbWVudChyZXdhcmRJRCwgaXRlcmF0aW9uQW1vdW50KQogICAgLy8gICBicmVhawogICAgLy8gfQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNzAKICAgIC8vIH0gPSB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlCiAgICBkaWcgMwogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDI5CiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGJ1cnkgMjMKICAgIGR1cAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMzYKICAgIGR1cAogICAgcHVzaGludCA0MSAvLyA0MQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE4CiAgICBwdXNoaW50IDkgLy8gOQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjcyCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA4OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI3MwogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjczCiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMjEKICAgIC0KICAgIGJ1cnkgNQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAODg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc2CiAgICAvLyBjb25zdCBhY3R1YWxBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHRoaXMuYWtpdGFSb3lhbHR5QW1vdW50LnZhbHVlCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc2CiAgICAvLyBjb25zdCBhY3R1YWxBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHRoaXMuYWtpdGFSb3lhbHR5QW1vdW50LnZhbHVlCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZGlnIDI5CiAgICBzd2FwCiAgICAtCiAgICBidXJ5IDMzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjc3CiAgICAvLyBsZXQgYWxsb2NhdGlvbnM6IFVzZXJBbGxvY2F0aW9uW10gPSBbXQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyNzgKICAgIC8vIGxldCBzdW06IHVpbnQ2NCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDExCiAgICBkaWcgMjAKICAgIGJ1cnkgMTgKCmRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAODk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjgwCiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDIwCiAgICBkaWcgNQogICAgKwogICAgZHVwCiAgICBidXJ5IDEwCiAgICBkaWcgMTgKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl93aGlsZUA5NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgeyBkaXNxdWFsaWZpZWQsIHF1YW50aXR5LCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkaWcgMTcKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI4MQogICAgLy8gY29uc3QgeyBkaXNxdWFsaWZpZWQsIHF1YW50aXR5LCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgZGlnIDEKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNgogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjgyCiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDkzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEwOQogICAgLy8gYXNzZXJ0KGEgPCBiLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFX09GX0FSR1MpCiAgICBkaWcgMTMKICAgIGR1cAogICAgZGlnIDE3CiAgICBkdXAKICAgIGNvdmVyIDMKICAgIDwKICAgIGFzc2VydCAvLyBJbnZhbGlkIHBlcmNlbnRhZ2Ugb2YgYXJncwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMTAKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgRElWSVNPUiksIGIpCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICBtdWx3CiAgICB1bmNvdmVyIDIKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMzMKICAgIG11bHcKICAgIGludGMgNCAvLyAxMDAwMDAKICAgIGRpdncKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODcKICAgIC8vIGFsbG9jYXRpb25zLnB1c2goeyBhZGRyZXNzLCBhbW91bnQ6IGluZGl2aWR1YWxBbW91bnQgfSkKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDM4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNwogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODgKICAgIC8vIHN1bSArPSBpbmRpdmlkdWFsQW1vdW50CiAgICBkaWcgMTEKICAgICsKICAgIGJ1cnkgMTEKCmRpc2J1cnNlUmV3YXJkc19ibG9ja0A5MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyODAKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMTcKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDE4CiAgICBiIGRpc2J1cnNlUmV3YXJkc193aGlsZV90b3BAODkKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl93aGlsZUA5NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoyOTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yICs9IGl0ZXJhdGlvbkFtb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA2CiAgICArCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MjkzCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI4CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk1CiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjI5NQogICAgLy8gaWYgKHRoaXMuZW50cnlJRC52YWx1ZSA9PT0gKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgOQogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk2CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24KICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mjk3CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IDEgLy8gRW50cnkgSURzIHN0YXJ0IGF0IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDU2CiAgICAvLyBjYXNlIERpc3RyaWJ1dGlvblR5cGVGbGF0OiB7CiAgICBkaWcgMQogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDU2LTEwNTkKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZUZsYXQ6IHsKICAgIC8vICAgdGhpcy5jcmVhdGVGbGF0RGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICAvLyAgIGJyZWFrCiAgICAvLyB9CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAxNQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMwOAogICAgLy8gfSA9IHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUKICAgIGRpZyAzCiAgICBkdXAKICAgIHB1c2hpbnQgMTAwIC8vIDEwMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDM1CiAgICBkdXAKICAgIGludGNfMyAvLyA5MgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDIyCiAgICBkdXAKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZGlnIDEKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIHVuY292ZXIgMgogICAgaW50Y18wIC8vIDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZHVwCiAgICBjb3ZlciAzCiAgICBidXJ5IDMxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gKHF1YWxpZmllZFN0YWtlcnMgKiBhbW91bnQpIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIHN3YXAKICAgIGRpZyAxCiAgICAqCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE1NQogICAgLy8gYWtpdGFSb3lhbHR5QW1vdW50ID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWtpdGFSb3lhbHR5QW1vdW50IH0pCiAgICBieXRlYyAyMiAvLyAiYWtpdGFfcm95YWx0eV9hbW91bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzEwCiAgICAvLyBjb25zdCB0b3RhbDogdWludDY0ID0gKHF1YWxpZmllZFN0YWtlcnMgKiBhbW91bnQpIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAtCiAgICBidXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCBwZXJjZW50YWdlQWtpdGFGZWUgPSBjYWxjUGVyY2VudChhbW91bnQsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTMKICAgIC8vIGFraXRhUm95YWx0eSA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eSB9KQogICAgYnl0ZWMgMjcgLy8gImFraXRhX3JveWFsdHkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzExCiAgICAvLyBjb25zdCBwZXJjZW50YWdlQWtpdGFGZWUgPSBjYWxjUGVyY2VudChhbW91bnQsIHRoaXMuYWtpdGFSb3lhbHR5LnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTA0CiAgICAvLyBhc3NlcnQocCA8PSBESVZJU09SLCBFUlJfSU5WQUxJRF9QRVJDRU5UQUdFKQogICAgZHVwCiAgICBpbnRjIDQgLy8gMTAwMDAwCiAgICA8PQogICAgYXNzZXJ0IC8vIEludmFsaWQgcGVyY2VudGFnZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxMDUKICAgIC8vIHJldHVybiBvcC5kaXZ3KC4uLm9wLm11bHcoYSwgcCksIERJVklTT1IpCiAgICBkaWcgMQogICAgbXVsdwogICAgaW50YyA0IC8vIDEwMDAwMAogICAgZGl2dwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxMgogICAgLy8gY29uc3QgYWRqdXN0ZWRBbW91bnQ6IHVpbnQ2NCA9IGFtb3VudCAtIHBlcmNlbnRhZ2VBa2l0YUZlZQogICAgLQogICAgYnVyeSAzMgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxNgogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3MwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMxNwogICAgLy8gYmFsYW5jZSA9IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANzQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzIyCiAgICAvLyBhc3NlcnQoYmFsYW5jZSA+PSB0b3RhbCwgRVJSX05PVF9FTk9VR0hfRlVORFMpCiAgICBkaWcgOAogICAgPj0KICAgIGFzc2VydCAvLyBOb3QgZW5vdWdoIGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzI0CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjQKICAgIC8vIGlmICgoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSA+IHRoaXMuZW50cnlJRC52YWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDc2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzI1CiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjUKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgLQogICAgYnVyeSA1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA3NjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMjgKICAgIC8vIGxldCBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSA9IFtdCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgYnVyeSAzNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMyOQogICAgLy8gbGV0IHN1bTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTEKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA3NzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMzEKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTgKICAgIGRpZyAxOAogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDgyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzMyCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcyB9ID0gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZQogICAgZGlnIDE3CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMzIKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzMzCiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDgxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzM3CiAgICAvLyBhbGxvY2F0aW9ucy5wdXNoKHsgYWRkcmVzcywgYW1vdW50OiBhZGp1c3RlZEFtb3VudCB9KQogICAgZGlnIDMwCiAgICBkdXAKICAgIGl0b2IKICAgIGRpZyAzOAogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMzcKICAgIGR1cAogICAgdW5jb3ZlciAyCiAgICBjb25jYXQgLy8gb24gZXJyb3I6IG1heCBhcnJheSBsZW5ndGggZXhjZWVkZWQKICAgIHN3YXAKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGl0b2IKICAgIGV4dHJhY3QgNiAyCiAgICByZXBsYWNlMiAwCiAgICBidXJ5IDM3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzM4CiAgICAvLyBzdW0gKz0gYWRqdXN0ZWRBbW91bnQKICAgIGRpZyAxMQogICAgKwogICAgYnVyeSAxMQoKZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDgxOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjMzMQogICAgLy8gZm9yIChsZXQgaWQgPSBkaXNidXJzZW1lbnRDdXJzb3I7IGlkIDwgKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCk7IGlkKyspIHsKICAgIGRpZyAxNwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTgKICAgIGIgZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA3NwoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDgyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0MgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSBuZXdDdXJzb3JWYWx1ZQogICAgZGlnIDE2CiAgICBkdXAKICAgIGl0b2IKICAgIGRpZyA1CiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNDMKICAgIC8vIHRoaXMuY3JlYXRlUmV3YXJkQWxsb2NhdGlvbnMoYWN0aXZlRGlzYnVyc2VtZW50SUQsIGFzc2V0LCBhbGxvY2F0aW9ucywgc3VtKQogICAgZGlnIDM0CiAgICBkaWcgMjkKICAgIGRpZyAzOAogICAgZGlnIDE0CiAgICBjYWxsc3ViIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNDUKICAgIC8vIGlmICh0aGlzLmVudHJ5SUQudmFsdWUgPT09IG5ld0N1cnNvclZhbHVlKSB7CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzQ1CiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBuZXdDdXJzb3JWYWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0NgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5waGFzZSA9IERpc2J1cnNlbWVudFBoYXNlRmluYWxpemF0aW9uCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDkxIC8vIDkxCiAgICBieXRlYyAxMyAvLyAweDFlCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM0NwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5kaXNidXJzZW1lbnRDdXJzb3IgPSAxIC8vIEVudHJ5IElEcyBzdGFydCBhdCAxCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgaW50Y18zIC8vIDkyCiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA3MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozMTkKICAgIC8vIFtiYWxhbmNlXSA9IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2UoR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsIGFzc2V0KQogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MKICAgIGRpZyAyOAogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBwb3AKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANzQKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDE1OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjAKICAgIC8vIGNhc2UgRGlzdHJpYnV0aW9uVHlwZUV2ZW46IHsKICAgIGRpZyAxCiAgICBieXRlYyAxMyAvLyAweDFlCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjAtMTA2MwogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlRXZlbjogewogICAgLy8gICB0aGlzLmNyZWF0ZUV2ZW5EaXNidXJzZW1lbnQocmV3YXJkSUQsIGl0ZXJhdGlvbkFtb3VudCkKICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDE5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzU4CiAgICAvLyB9ID0gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZQogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMzUKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjIKICAgIGR1cAogICAgcHVzaGludCAzMyAvLyAzMwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDE2CiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAzMAogICAgcHVzaGludCA5IC8vIDkKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAxNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2MgogICAgLy8gaWYgKGFzc2V0ID09PSAwKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA1OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2MwogICAgLy8gYmFsYW5jZSA9IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLmJhbGFuY2UKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBhY2N0X3BhcmFtc19nZXQgQWNjdEJhbGFuY2UKICAgIGFzc2VydCAvLyBhY2NvdW50IGZ1bmRlZAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzY3CiAgICAvLyBjb25zdCBhY3R1YWxTdW06IHVpbnQ2NCA9IHJhdGUgLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGFraXRhUm95YWx0eUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eUFtb3VudCB9KQogICAgYnl0ZWMgMjIgLy8gImFraXRhX3JveWFsdHlfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2NwogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSByYXRlIC0gdGhpcy5ha2l0YVJveWFsdHlBbW91bnQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMTQKICAgIHN3YXAKICAgIC0KICAgIGR1cAogICAgYnVyeSAzNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM2OAogICAgLy8gYXNzZXJ0KGJhbGFuY2UgPj0gYWN0dWFsU3VtLCBFUlJfTk9UX0VOT1VHSF9GVU5EUykKICAgID49CiAgICBhc3NlcnQgLy8gTm90IGVub3VnaCBmdW5kcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM3MAogICAgLy8gaWYgKChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpID4gdGhpcy5lbnRyeUlELnZhbHVlKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzcwCiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA+CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA2MQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM3MQogICAgLy8gaXRlcmF0aW9uQW1vdW50ID0gdGhpcy5lbnRyeUlELnZhbHVlIC0gZGlzYnVyc2VtZW50Q3Vyc29yCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzcxCiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkaWcgMjEKICAgIC0KICAgIGJ1cnkgNQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANjE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc0CiAgICAvLyBjb25zdCBhbW91bnQ6IHVpbnQ2NCA9IGFjdHVhbFN1bSAvIHF1YWxpZmllZFN0YWtlcnMKICAgIGRpZyAzMQogICAgZGlnIDE1CiAgICAvCiAgICBidXJ5IDI5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc1CiAgICAvLyBsZXQgYWxsb2NhdGlvbnM6IFVzZXJBbGxvY2F0aW9uW10gPSBbXQogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzYKICAgIC8vIGxldCBhbGxvY1N1bTogdWludDY0ID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMzAKICAgIGRpZyAyMAogICAgYnVyeSAxOAoKZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEA2MjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzcKICAgIC8vIGZvciAobGV0IGlkID0gZGlzYnVyc2VtZW50Q3Vyc29yOyBpZCA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpZCsrKSB7CiAgICBkaWcgMjAKICAgIGRpZyA1CiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTgKICAgIGRpZyAxOAogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX3doaWxlQDY3CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc4CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcyB9ID0gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZQogICAgZGlnIDE3CiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozNzgKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzIH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGdldGJpdAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBidXJ5IDM4CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc5CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDY2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzgzCiAgICAvLyBhbGxvY2F0aW9ucy5wdXNoKHsgYWRkcmVzcywgYW1vdW50IH0pCiAgICBkaWcgMjgKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDM4CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNwogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozODQKICAgIC8vIGFsbG9jU3VtICs9IGFtb3VudAogICAgZGlnIDMwCiAgICArCiAgICBidXJ5IDMwCgpkaXNidXJzZVJld2FyZHNfYmxvY2tANjY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzc3CiAgICAvLyBmb3IgKGxldCBpZCA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaWQgPCAoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KTsgaWQrKykgewogICAgZGlnIDE3CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAxOAogICAgYiBkaXNidXJzZVJld2FyZHNfd2hpbGVfdG9wQDYyCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfd2hpbGVANjc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Mzg4CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IG5ld0N1cnNvclZhbHVlCiAgICBkaWcgMTYKICAgIGR1cAogICAgaXRvYgogICAgZGlnIDUKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM4OQogICAgLy8gdGhpcy5jcmVhdGVSZXdhcmRBbGxvY2F0aW9ucyhhY3RpdmVEaXNidXJzZW1lbnRJRCwgYXNzZXQsIGFsbG9jYXRpb25zLCBhbGxvY1N1bSkKICAgIGRpZyAzNAogICAgZGlnIDI5CiAgICBkaWcgMzgKICAgIGRpZyAzMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzkxCiAgICAvLyBpZiAodGhpcy5lbnRyeUlELnZhbHVlID09PSBuZXdDdXJzb3JWYWx1ZSkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjM5MQogICAgLy8gaWYgKHRoaXMuZW50cnlJRC52YWx1ZSA9PT0gbmV3Q3Vyc29yVmFsdWUpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICA9PQogICAgYnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozOTIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZUZpbmFsaXphdGlvbgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgYnl0ZWMgMTMgLy8gMHgxZQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czozOTMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGludGNfMyAvLyA5MgogICAgc3dhcAogICAgYm94X3JlcGxhY2UKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzAKCmRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlANTg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MzY1CiAgICAvLyBiYWxhbmNlID0gQXNzZXRIb2xkaW5nLmFzc2V0QmFsYW5jZShHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywgYXNzZXQpWzBdCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgZGlnIDI4CiAgICBhc3NldF9ob2xkaW5nX2dldCBBc3NldEJhbGFuY2UKICAgIHBvcAogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA1OQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMTk6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA2NAogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlU2h1ZmZsZTogewogICAgZGlnIDEKICAgIHB1c2hieXRlcyAweDI4CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwNjQtMTA3MQogICAgLy8gY2FzZSBEaXN0cmlidXRpb25UeXBlU2h1ZmZsZTogewogICAgLy8gICBpZiAod2lubmluZ1RpY2tldHMubGVuZ3RoID09PSAwKSB7CiAgICAvLyAgICAgdGhpcy5yYWZmbGUocmV3YXJkSUQpCiAgICAvLyAgIH0gZWxzZSB7CiAgICAvLyAgICAgdGhpcy5jcmVhdGVTaHVmZmxlRGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICAvLyAgIH0KICAgIC8vICAgYnJlYWsKICAgIC8vIH0KICAgIGFzc2VydCAvLyB1bmtub3duIHJld2FyZCByYXRlIHR5cGUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDY1CiAgICAvLyBpZiAod2lubmluZ1RpY2tldHMubGVuZ3RoID09PSAwKSB7CiAgICBkaWcgMwogICAgcHVzaGludHMgMTI0IDIgLy8gMTI0LCAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYm56IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDY2CiAgICAvLyB0aGlzLnJhZmZsZShyZXdhcmRJRCkKICAgIGRpZyA1CiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfZWxzZV9ib2R5QDI0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQwNgogICAgLy8gfSA9IGNsb25lKHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUpCiAgICBkaWcgMwogICAgZHVwCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAzNQogICAgZHVwCiAgICBpbnRjXzMgLy8gOTIKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgYnVyeSAyMgogICAgZHVwCiAgICBwdXNoaW50IDQ5IC8vIDQ5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgOAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGJ1cnkgMzAKICAgIHB1c2hpbnQgOSAvLyA5CiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTAKICAgIC8vIGlmIChhc3NldCA9PT0gMCkgewogICAgYm56IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlAMzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTEKICAgIC8vIGJhbGFuY2UgPSBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcy5iYWxhbmNlCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgYWNjdF9wYXJhbXNfZ2V0IEFjY3RCYWxhbmNlCiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKCmRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQxNQogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSBzdW0gLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTUKICAgIC8vIGFraXRhUm95YWx0eUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUFraXRhUm95YWx0eUFtb3VudCB9KQogICAgYnl0ZWMgMjIgLy8gImFraXRhX3JveWFsdHlfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQxNQogICAgLy8gY29uc3QgYWN0dWFsU3VtOiB1aW50NjQgPSBzdW0gLSB0aGlzLmFraXRhUm95YWx0eUFtb3VudC52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAxMgogICAgc3dhcAogICAgLQogICAgZHVwCiAgICBidXJ5IDM0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE2CiAgICAvLyBhc3NlcnQoYmFsYW5jZSA+PSBhY3R1YWxTdW0sIEVSUl9OT1RfRU5PVUdIX0ZVTkRTKQogICAgPj0KICAgIGFzc2VydCAvLyBOb3QgZW5vdWdoIGZ1bmRzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE4CiAgICAvLyBpZiAoKGRpc2J1cnNlbWVudEN1cnNvciArIGl0ZXJhdGlvbkFtb3VudCkgPiB0aGlzLmVudHJ5SUQudmFsdWUpIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTgKICAgIC8vIGlmICgoZGlzYnVyc2VtZW50Q3Vyc29yICsgaXRlcmF0aW9uQW1vdW50KSA+IHRoaXMuZW50cnlJRC52YWx1ZSkgewogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID4KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDE5CiAgICAvLyBpdGVyYXRpb25BbW91bnQgPSB0aGlzLmVudHJ5SUQudmFsdWUgLSBkaXNidXJzZW1lbnRDdXJzb3IKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTkKICAgIC8vIGl0ZXJhdGlvbkFtb3VudCA9IHRoaXMuZW50cnlJRC52YWx1ZSAtIGRpc2J1cnNlbWVudEN1cnNvcgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyAyMQogICAgLQogICAgYnVyeSA1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MjMKICAgIC8vIGlmICh3aW5uZXJDb3VudCA+IDApIHsKICAgIGRpZyA2CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2lmX2JvZHlAMzcKICAgIGRpZyAzMQogICAgYnVyeSAyOQoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VAMzg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDI3CiAgICAvLyBsZXQgeyBzdGFrZSwgdGlja2V0LCBkaXNidXJzZWQgfSA9IHJhZmZsZUN1cnNvcgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludHMgNTkgMjQgLy8gNTksIDI0CiAgICBib3hfZXh0cmFjdAogICAgZHVwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMwogICAgYnVyeSAxNQogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgY292ZXIgMgogICAgYnVyeSAxNAogICAgcHVzaGludCAxNiAvLyAxNgogICAgZXh0cmFjdF91aW50NjQKICAgIGJ1cnkgMjUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MjgKICAgIC8vIGxldCBjdXJyZW50VGlja2V0ID0gdGlja2V0c1t0aWNrZXRdCiAgICBpbnRjXzIgLy8gOAogICAgKgogICAgcHVzaGludCAxMjYgLy8gMTI2CiAgICArCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MzIKICAgIC8vIGxldCBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSA9IFtdCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgYnVyeSAzNwogICAgYnVyeSAyNAogICAgZGlnIDIwCiAgICBidXJ5IDE5CgpkaXNidXJzZVJld2FyZHNfd2hpbGVfdG9wQDM5OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzMwogICAgLy8gZm9yIChsZXQgaSA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaSA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpKyspIHsKICAgIGRpZyAyMAogICAgZGlnIDUKICAgICsKICAgIGRpZyAxOQogICAgPgogICAgYnogZGlzYnVyc2VSZXdhcmRzX2Jsb2NrQDUxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM0CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaSkudmFsdWUKICAgIGRpZyAxOAogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2MgogICAgLy8gZW50cmllcyA9IEJveE1hcDx1aW50NjQsIEVudHJ5RGF0YT4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzIH0pCiAgICBieXRlYyA5IC8vICJlIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM0CiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaSkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBidXJ5IDIxCiAgICBkdXAKICAgIGludGNfMCAvLyAwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBib3hfZXh0cmFjdAogICAgYnVyeSAzOAogICAgZHVwCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGJ1cnkgMjgKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzNgogICAgLy8gY3VycmVudFJhbmdlRW5kID0gY3VycmVudFJhbmdlU3RhcnQgKyBxdWFudGl0eQogICAgZGlnIDI0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgKwogICAgYnVyeSAyNgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzNwogICAgLy8gaWYgKGN1cnJlbnRUaWNrZXQgPj0gY3VycmVudFJhbmdlU3RhcnQgJiYgY3VycmVudFRpY2tldCA8PSBjdXJyZW50UmFuZ2VFbmQpIHsKICAgIGRpZyAyMwogICAgPD0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDQ5CiAgICBkaWcgMjIKICAgIGRpZyAyNQogICAgPD0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDQ5CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDM4CiAgICAvLyBpZiAoIWRpc3F1YWxpZmllZCkgewogICAgZGlnIDE5CiAgICBibnogZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MzkKICAgIC8vIGFsbG9jYXRpb25zLnB1c2goeyBhZGRyZXNzLCBhbW91bnQgfSkKICAgIGRpZyAyOAogICAgaXRvYgogICAgZGlnIDM3CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGRpZyAzNgogICAgZHVwCiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdCAvLyBvbiBlcnJvcjogbWF4IGFycmF5IGxlbmd0aCBleGNlZWRlZAogICAgc3dhcAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHJlcGxhY2UyIDAKICAgIGJ1cnkgMzYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDAKICAgIC8vIGRpc2J1cnNlZCsrCiAgICBkaWcgMjEKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBidXJ5IDIyCgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0NDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDMKICAgIC8vIGlmICh0aWNrZXQgPT09IHRpY2tldHMubGVuZ3RoIC0gMSkgewogICAgZGlnIDMKICAgIHB1c2hpbnRzIDEyNCAyIC8vIDEyNCwgMgogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGludGNfMSAvLyAxCiAgICAtCiAgICBkaWcgMTAKICAgID09CiAgICBieiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0OAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ0NQogICAgLy8gaWYgKHdpbm5lckNvdW50ICE9PSBkaXNidXJzZWQpIHsKICAgIGRpZyA2CiAgICBkaWcgMjIKICAgICE9CiAgICBieiBkaXNidXJzZVJld2FyZHNfYmxvY2tANTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NDYKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyA0CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGludGNfMyAvLyA5MgogICAgdW5jb3ZlciAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ0Ny00NTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucmFmZmxlQ3Vyc29yID0gewogICAgLy8gICB0aWNrZXQ6IDAsCiAgICAvLyAgIHN0YWtlOiAwLAogICAgLy8gICBkaXNidXJzZWQ6IDAsCiAgICAvLyB9CiAgICBkdXAKICAgIHB1c2hpbnQgNTkgLy8gNTkKICAgIGJ5dGVjIDM4IC8vIDB4MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ1MgogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS53aW5uaW5nVGlja2V0cyA9IFtdCiAgICBkdXAKICAgIGJveF9nZXQKICAgIHBvcAogICAgZHVwCiAgICBwdXNoaW50IDU3IC8vIDU3CiAgICBleHRyYWN0X3VpbnQxNgogICAgaW50Y18wIC8vIDAKICAgIHN3YXAKICAgIGV4dHJhY3QzCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgY29uY2F0CiAgICBkaWcgMQogICAgYm94X2RlbAogICAgcG9wCiAgICBib3hfcHV0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDUzCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI3CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA2OAogICAgLy8gdGhpcy5jcmVhdGVTaHVmZmxlRGlzYnVyc2VtZW50KHJld2FyZElELCBpdGVyYXRpb25BbW91bnQpCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDMwCgpkaXNidXJzZVJld2FyZHNfYmxvY2tANTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDcwCiAgICAvLyB0aGlzLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGFjdGl2ZURpc2J1cnNlbWVudElELCBhc3NldCwgYWxsb2NhdGlvbnMsIHN1bSkKICAgIGRpZyAzMwogICAgZGlnIDI4CiAgICBkaWcgMzcKICAgIGRpZyAxMwogICAgY2FsbHN1YiBjcmVhdGVSZXdhcmRBbGxvY2F0aW9ucwogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDcyCiAgICAvLyBpZiAod2lubmVyQ291bnQgPT09IGRpc2J1cnNlZCkgewogICAgZGlnIDYKICAgIGRpZyAyMgogICAgPT0KICAgIGJ6IGRpc2J1cnNlUmV3YXJkc19lbHNlX2JvZHlANTMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUucGhhc2UgPSBEaXNidXJzZW1lbnRQaGFzZUZpbmFsaXphdGlvbgogICAgZGlnIDMKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgYnl0ZWMgMTMgLy8gMHgxZQogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzQKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAxCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NzUtNDc5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnJhZmZsZUN1cnNvciA9IHsKICAgIC8vICAgdGlja2V0OiAwLAogICAgLy8gICBzdGFrZTogMCwKICAgIC8vICAgZGlzYnVyc2VkOiAwLAogICAgLy8gfQogICAgZHVwCiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBieXRlYyAzOCAvLyAweDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUud2lubmluZ1RpY2tldHMgPSBbXQogICAgZHVwCiAgICBib3hfZ2V0CiAgICBwb3AKICAgIGR1cAogICAgcHVzaGludCA1NyAvLyA1NwogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICBleHRyYWN0MwogICAgYnl0ZWMgNSAvLyAweDAwMDAKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGJveF9kZWwKICAgIHBvcAogICAgYm94X3B1dAogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUA1MzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yICs9IGl0ZXJhdGlvbkFtb3VudAogICAgZGlnIDMKICAgIGR1cAogICAgaW50Y18zIC8vIDkyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGJ0b2kKICAgIGRpZyA2CiAgICArCiAgICBpdG9iCiAgICBkaWcgMQogICAgaW50Y18zIC8vIDkyCiAgICB1bmNvdmVyIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDgzCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnJhZmZsZUN1cnNvciA9IHsgdGlja2V0LCBzdGFrZSwgZGlzYnVyc2VkIH0KICAgIGRpZyAxMAogICAgaXRvYgogICAgZGlnIDEzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIGRpZyAyMwogICAgaXRvYgogICAgY29uY2F0CiAgICBwdXNoaW50IDU5IC8vIDU5CiAgICBzd2FwCiAgICBib3hfcmVwbGFjZQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzMAoKZGlzYnVyc2VSZXdhcmRzX2FmdGVyX2lmX2Vsc2VANDg6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDU5CiAgICAvLyBpdGVyYXRpb25BbW91bnQgLT0gaQogICAgZGlnIDQKICAgIGRpZyAxOQogICAgLQogICAgYnVyeSA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYwCiAgICAvLyB0aWNrZXQrKwogICAgZGlnIDkKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBkdXAKICAgIGJ1cnkgMTEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjEKICAgIC8vIGN1cnJlbnRUaWNrZXQgPSB0aWNrZXRzW3RpY2tldF0KICAgIGludGNfMiAvLyA4CiAgICAqCiAgICBwdXNoaW50IDEyNiAvLyAxMjYKICAgICsKICAgIGRpZyA0CiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBidXJ5IDI0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYyCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmRpc2J1cnNlbWVudEN1cnNvciA9IDEgLy8gRW50cnkgSURzIHN0YXJ0IGF0IDEKICAgIGludGNfMSAvLyAxCiAgICBpdG9iCiAgICBpbnRjXzMgLy8gOTIKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDYzCiAgICAvLyBpID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTkKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjQKICAgIC8vIHN0YWtlID0gMAogICAgaW50Y18wIC8vIDAKICAgIGJ1cnkgMTIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjUKICAgIC8vIGN1cnJlbnRSYW5nZUVuZCA9IDAKICAgIGludGNfMCAvLyAwCiAgICBidXJ5IDI1CgpkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUA0OToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0NjcKICAgIC8vIGN1cnJlbnRSYW5nZVN0YXJ0ID0gY3VycmVudFJhbmdlRW5kICsgMQogICAgZGlnIDI0CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgYnVyeSAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQzMwogICAgLy8gZm9yIChsZXQgaSA9IGRpc2J1cnNlbWVudEN1cnNvcjsgaSA8IChkaXNidXJzZW1lbnRDdXJzb3IgKyBpdGVyYXRpb25BbW91bnQpOyBpKyspIHsKICAgIGRpZyAxOAogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGJ1cnkgMTkKICAgIGIgZGlzYnVyc2VSZXdhcmRzX3doaWxlX3RvcEAzOQoKZGlzYnVyc2VSZXdhcmRzX2lmX2JvZHlAMzc6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDI0CiAgICAvLyBhbW91bnQgPSBhY3R1YWxTdW0gLyB3aW5uZXJDb3VudAogICAgZGlnIDMxCiAgICBkaWcgNwogICAgLwogICAgYnVyeSAyOQogICAgYiBkaXNidXJzZVJld2FyZHNfYWZ0ZXJfaWZfZWxzZUAzOAoKZGlzYnVyc2VSZXdhcmRzX2Vsc2VfYm9keUAzMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0MTMKICAgIC8vIGJhbGFuY2UgPSBBc3NldEhvbGRpbmcuYXNzZXRCYWxhbmNlKEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLCBhc3NldClbMF0KICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICBkaWcgMjgKICAgIGFzc2V0X2hvbGRpbmdfZ2V0IEFzc2V0QmFsYW5jZQogICAgcG9wCiAgICBiIGRpc2J1cnNlUmV3YXJkc19hZnRlcl9pZl9lbHNlQDM0CgpkaXNidXJzZVJld2FyZHNfYm9vbF9mYWxzZUA0OgogICAgaW50Y18wIC8vIDAKICAgIGIgZGlzYnVyc2VSZXdhcmRzX2Jvb2xfbWVyZ2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZmluYWxpemVEaXN0cmlidXRpb25bcm91dGluZ10oKSAtPiB2b2lkOgpmaW5hbGl6ZURpc3RyaWJ1dGlvbjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDc5CiAgICAvLyBmaW5hbGl6ZURpc3RyaWJ1dGlvbihyZXdhcmRJRDogdWludDY0KTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODAKICAgIC8vIGFzc2VydCh0aGlzLnJld2FyZHMocmV3YXJkSUQpLmV4aXN0cywgJ3Jld2FyZCBkb2VzIG5vdCBleGlzdCcpCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4MAogICAgLy8gYXNzZXJ0KHRoaXMucmV3YXJkcyhyZXdhcmRJRCkuZXhpc3RzLCAncmV3YXJkIGRvZXMgbm90IGV4aXN0JykKICAgIGR1cAogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4MQogICAgLy8gY29uc3QgeyBwaGFzZSwgYWN0aXZlRGlzYnVyc2VtZW50SUQgfSA9IHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA5MSAvLyA5MQogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBkaWcgMQogICAgcHVzaGludCAxMDAgLy8gMTAwCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGR1cAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODIKICAgIC8vIGFzc2VydChwaGFzZSA9PT0gRGlzYnVyc2VtZW50UGhhc2VGaW5hbGl6YXRpb24sIEVSUl9ESVNCVVJTRU1FTlRfTk9UX1JFQURZX0ZPUl9GSU5BTElaQVRJT04pCiAgICB1bmNvdmVyIDIKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgID09CiAgICBhc3NlcnQgLy8gRGlzYnVyc2VtZW50IG5vdCByZWFkeSBmb3IgZmluYWxpemF0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjIyCiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjIyCiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYyMgogICAgLy8gY29uc3QgcmV3YXJkc0FwcCA9IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5yZXdhcmRzKQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjI0LTYyNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuZmluYWxpemVEaXNidXJzZW1lbnQ+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFtkaXNidXJzZW1lbnRJRF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgcHVzaGJ5dGVzIDB4NTQyNGE1OTEgLy8gbWV0aG9kICJmaW5hbGl6ZURpc2J1cnNlbWVudCh1aW50NjQpdm9pZCIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA4NgogICAgLy8gdGhpcy5kaXNidXJzZW1lbnRzKGFjdGl2ZURpc2J1cnNlbWVudElEKS5jcmVhdGUoKQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE3MAogICAgLy8gZGlzYnVyc2VtZW50cyA9IEJveE1hcDx1aW50NjQsIGJ5dGVzPDA+Pih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeERpc2J1cnNlbWVudHMgfSkKICAgIHB1c2hieXRlcyAiZCIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwODYKICAgIC8vIHRoaXMuZGlzYnVyc2VtZW50cyhhY3RpdmVEaXNidXJzZW1lbnRJRCkuY3JlYXRlKCkKICAgIGludGNfMCAvLyAwCiAgICBib3hfY3JlYXRlCiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDg4CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnBoYXNlID0gRGlzYnVyc2VtZW50UGhhc2VJZGxlCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDg5CiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLmFjdGl2ZURpc2J1cnNlbWVudElEID0gMAogICAgaW50Y18wIC8vIDAKICAgIGl0b2IKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwMCAvLyAxMDAKICAgIGRpZyAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTAKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCA9IDAKICAgIGRpZyAxCiAgICBwdXNoaW50IDEwOCAvLyAxMDgKICAgIGRpZyAyCiAgICBib3hfcmVwbGFjZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTEKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUuZGlzYnVyc2VtZW50Q3Vyc29yID0gMSAvLyBFbnRyeSBJRHMgc3RhcnQgYXQgMQogICAgaW50Y18xIC8vIDEKICAgIGl0b2IKICAgIGRpZyAyCiAgICBpbnRjXzMgLy8gOTIKICAgIHVuY292ZXIgMgogICAgYm94X3JlcGxhY2UKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDkyCiAgICAvLyB0aGlzLnJld2FyZHMocmV3YXJkSUQpLnZhbHVlLnF1YWxpZmllZFN0YWtlcnMgPSAwCiAgICBkaWcgMQogICAgcHVzaGludCAzMyAvLyAzMwogICAgZGlnIDIKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA5MwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS5xdWFsaWZpZWRTdGFrZSA9IDAKICAgIHB1c2hpbnQgNDEgLy8gNDEKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA3OQogICAgLy8gZmluYWxpemVEaXN0cmlidXRpb24ocmV3YXJkSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY2hlY2tbcm91dGluZ10oKSAtPiB2b2lkOgpjaGVjazoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk2CiAgICAvLyBjaGVjayhhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk3CiAgICAvLyBjb25zdCBrZXk6IEVudHJ5S2V5ID0geyBhZGRyZXNzLCBhc3NldCB9CiAgICBpdG9iCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwOTgKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGtleSkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTA5OQogICAgLy8gcmV0dXJuIHRoaXMuY2hlY2tCeUlEKGlkKQogICAgY2FsbHN1YiBjaGVja0J5SUQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDk2CiAgICAvLyBjaGVjayhhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddKCkgLT4gdm9pZDoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ106CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEwMgogICAgLy8gZ2F0ZUNoZWNrKGdhdGVUeG46IGd0eG4uQXBwbGljYXRpb25DYWxsVHhuLCBhZGRyZXNzOiBBY2NvdW50LCBhc3NldDogdWludDY0KSB7CiAgICB0eG4gR3JvdXBJbmRleAogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZ3R4bnMgVHlwZUVudW0KICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICA9PQogICAgYXNzZXJ0IC8vIHRyYW5zYWN0aW9uIHR5cGUgaXMgYXBwbAogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwbiAyCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAzCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgYWRkcmVzcykKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAzCiAgICAvLyBjb25zdCB3YWxsZXQgPSBnZXRXYWxsZXRJRFVzaW5nQWtpdGFEQU8odGhpcy5ha2l0YURBTy52YWx1ZSwgYWRkcmVzcykKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBzd2FwCiAgICBjYWxsc3ViIGdldFdhbGxldElEVXNpbmdBa2l0YURBTwogICAgZHVwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjEzOQogICAgLy8gaWYgKHdhbGxldElELmlkID09PSAwKSB7CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA3CiAgICBkaWcgMgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaW5saW5lZF9zbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjpvcmlnaW5PckA4OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTQzCiAgICAvLyBnYXRlSUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlHYXRlSUQgfSkKICAgIGJ5dGVjIDE3IC8vICJnYXRlX2lkIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDYKICAgIC8vIGNvbnN0IHBhc3NlcyA9IGdhdGVDaGVjayhnYXRlVHhuLCB0aGlzLmFraXRhREFPLnZhbHVlLCBvcmlnaW4sIHRoaXMuZ2F0ZUlELnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGRpZyA2CiAgICB1bmNvdmVyIDIKICAgIHVuY292ZXIgMwogICAgdW5jb3ZlciAzCiAgICBjYWxsc3ViIGdhdGVDaGVjawogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDcKICAgIC8vIGlmICghcGFzc2VzICYmIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA0CiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDcKICAgIC8vIGlmICghcGFzc2VzICYmIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICAhPQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5nYXRlQ2hlY2tbcm91dGluZ11fYWZ0ZXJfaWZfZWxzZUA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEwOAogICAgLy8gY29uc3Qga2V5OiBFbnRyeUtleSA9IHsgYWRkcmVzcywgYXNzZXQgfQogICAgZGlnIDEKICAgIGl0b2IKICAgIGRpZyAzCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMDkKICAgIC8vIGNvbnN0IGlkID0gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGtleSkudmFsdWUKICAgIGJveF9nZXQKICAgIGFzc2VydCAvLyBCb3ggbXVzdCBoYXZlIHZhbHVlCiAgICBidG9pCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTExMAogICAgLy8gdGhpcy5lbnRyaWVzKGlkKS52YWx1ZS5kaXNxdWFsaWZpZWQgPSB0cnVlCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTYyCiAgICAvLyBlbnRyaWVzID0gQm94TWFwPHVpbnQ2NCwgRW50cnlEYXRhPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeEVudHJpZXMgfSkKICAgIGJ5dGVjIDkgLy8gImUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTEwCiAgICAvLyB0aGlzLmVudHJpZXMoaWQpLnZhbHVlLmRpc3F1YWxpZmllZCA9IHRydWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgaW50Y18xIC8vIDEKICAgIHNldGJpdAogICAgcHVzaGludCA1MCAvLyA1MAogICAgc3dhcAogICAgYm94X3JlcGxhY2UKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lmX2Vsc2VANDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTAyCiAgICAvLyBnYXRlQ2hlY2soZ2F0ZVR4bjogZ3R4bi5BcHBsaWNhdGlvbkNhbGxUeG4sIGFkZHJlc3M6IEFjY291bnQsIGFzc2V0OiB1aW50NjQpIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lmX2Vsc2VANzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGR1cAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czoxNjMKICAgIC8vIEJ5dGVzKEFic3RyYWN0QWNjb3VudEdsb2JhbFN0YXRlS2V5c0NvbnRyb2xsZWRBZGRyZXNzKQogICAgYnl0ZWMgMzcgLy8gImNvbnRyb2xsZWRfYWRkcmVzcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6MTYxLTE2NAogICAgLy8gY29uc3QgW2NvbnRyb2xsZWRBY2NvdW50Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoCiAgICAvLyAgIHdhbGxldElELAogICAgLy8gICBCeXRlcyhBYnN0cmFjdEFjY291bnRHbG9iYWxTdGF0ZUtleXNDb250cm9sbGVkQWRkcmVzcykKICAgIC8vICkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTA0CiAgICAvLyBjb25zdCBvcmlnaW4gPSBvcmlnaW5Pcih3YWxsZXQsIGFkZHJlc3MpCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZ2F0ZUNoZWNrW3JvdXRpbmddX2FmdGVyX2lubGluZWRfc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo6b3JpZ2luT3JAOAoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuZW50ZXJDb3N0W3JvdXRpbmddKCkgLT4gdm9pZDoKZW50ZXJDb3N0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMjIKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ4WzMyXQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMgogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTI2CiAgICAvLyBsZXQgYm94TWJyOiB1aW50NjQgPSBlbnRyeU1CUiAqIGVudHJ5Q291bnQKICAgIGludGMgMTAgLy8gNTA2MDAKICAgICoKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTI5CiAgICAvLyBpZiAoIXRoaXMudW5pcXVlcyhhZGRyZXNzKS5leGlzdHMpIHsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgYm56IGVudGVyQ29zdF9hZnRlcl9pZl9lbHNlQDMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTMwCiAgICAvLyBib3hNYnIgKz0gUG9vbFVuaXF1ZXNNQlIKICAgIGludGMgMTEgLy8gMTg5MDAKICAgICsKCmVudGVyQ29zdF9hZnRlcl9pZl9lbHNlQDM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEyMgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5vcHRJbkNvc3Rbcm91dGluZ10oKSAtPiB2b2lkOgpvcHRJbkNvc3Q6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExMzgKICAgIC8vIGNvbnN0IGNvdW50ID0gc3BsaXRPcHRJbkNvdW50KHRoaXMuYWtpdGFEQU8udmFsdWUsIHRoaXMuYWtpdGFEQU9Fc2Nyb3cudmFsdWUuYWRkcmVzcywgYXNzZXQpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOAogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NjUKICAgIC8vIGFraXRhREFPRXNjcm93ID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhRXNjcm93IH0pCiAgICBieXRlYyA3IC8vICJha2l0YV9lc2Nyb3ciCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOAogICAgLy8gY29uc3QgY291bnQgPSBzcGxpdE9wdEluQ291bnQodGhpcy5ha2l0YURBTy52YWx1ZSwgdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZS5hZGRyZXNzLCBhc3NldCkKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgc3BsaXRPcHRJbkNvdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzOQogICAgLy8gcmV0dXJuIChHbG9iYWwuYXNzZXRPcHRJbk1pbkJhbGFuY2UgKiAoMSArIGNvdW50KSkgKyAodGhpcy5yZXdhcmRzTWJyKFdpbm5lckNvdW50Q2FwKSAqIDIpCiAgICBnbG9iYWwgQXNzZXRPcHRJbk1pbkJhbGFuY2UKICAgIGludGNfMSAvLyAxCiAgICB1bmNvdmVyIDIKICAgICsKICAgICoKICAgIHB1c2hpbnQgODAwMCAvLyA4MDAwCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTEzNgogICAgLy8gQGFiaW1ldGhvZCh7IHJlYWRvbmx5OiB0cnVlIH0pCiAgICBpdG9iCiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgIHN3YXAKICAgIGNvbmNhdAogICAgbG9nCiAgICBpbnRjXzEgLy8gMQogICAgcmV0dXJuCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5zaWduVXBzT3Blbltyb3V0aW5nXSgpIC0+IHZvaWQ6CnNpZ25VcHNPcGVuOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ2CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAweDAwCiAgICAhPQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYtMTE0NwogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBieiBzaWduVXBzT3Blbl9ib29sX2ZhbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ3CiAgICAvLyBHbG9iYWwubGF0ZXN0VGltZXN0YW1wID4gdGhpcy5zaWdudXBUaW1lc3RhbXAudmFsdWUgJiYKICAgIGdsb2JhbCBMYXRlc3RUaW1lc3RhbXAKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTIwCiAgICAvLyBzaWdudXBUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVNpZ251cFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMjQgLy8gInNpZ251cF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0NwogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDYtMTE0NwogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlICYmCiAgICBieiBzaWduVXBzT3Blbl9ib29sX2ZhbHNlQDYKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDwKICAgIGJueiBzaWduVXBzT3Blbl9ib29sX3RydWVANQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjIKICAgIC8vIGFsbG93TGF0ZVNpZ251cHMgPSBHbG9iYWxTdGF0ZTxib29sZWFuPih7IGluaXRpYWxWYWx1ZTogZmFsc2UsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5QWxsb3dMYXRlU2lnbnVwcyB9KQogICAgYnl0ZWMgMTQgLy8gImFsbG93X2xhdGVfc2lnbnVwcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTQ4CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ6IHNpZ25VcHNPcGVuX2Jvb2xfZmFsc2VANgoKc2lnblVwc09wZW5fYm9vbF90cnVlQDU6CiAgICBpbnRjXzEgLy8gMQoKc2lnblVwc09wZW5fYm9vbF9tZXJnZUA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNDMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCnNpZ25VcHNPcGVuX2Jvb2xfZmFsc2VANjoKICAgIGludGNfMCAvLyAwCiAgICBiIHNpZ25VcHNPcGVuX2Jvb2xfbWVyZ2VANwoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlW3JvdXRpbmddKCkgLT4gdm9pZDoKaXNMaXZlOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgY2FsbHN1YiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzRW50ZXJlZFtyb3V0aW5nXSgpIC0+IHZvaWQ6CmlzRW50ZXJlZDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTYzCiAgICAvLyBAYWJpbWV0aG9kKHsgcmVhZG9ubHk6IHRydWUgfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgbGVuCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50OFszMl0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICBzd2FwCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTY1CiAgICAvLyByZXR1cm4gdGhpcy51bmlxdWVzKGFkZHJlc3MpLmV4aXN0czsKICAgIGJveF9sZW4KICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNjMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAyCiAgICBzZXRiaXQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgc3dhcAogICAgY29uY2F0CiAgICBsb2cKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmdldFN0YXRlW3JvdXRpbmddKCkgLT4gdm9pZDoKZ2V0U3RhdGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MQogICAgLy8gc3RhdHVzOiB0aGlzLnN0YXR1cy52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE0CiAgICAvLyBzdGF0dXMgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YXR1cz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXR1cyB9KQogICAgYnl0ZWMgMTAgLy8gInN0YXR1cyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTcxCiAgICAvLyBzdGF0dXM6IHRoaXMuc3RhdHVzLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTcyCiAgICAvLyB0aXRsZTogdGhpcy50aXRsZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE2CiAgICAvLyB0aXRsZSA9IEdsb2JhbFN0YXRlPHN0cmluZz4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVRpdGxlIH0pCiAgICBieXRlYyAyOCAvLyAidGl0bGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MgogICAgLy8gdGl0bGU6IHRoaXMudGl0bGUudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzMKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3MwogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBzaWdudXBUaW1lc3RhbXA6IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjAKICAgIC8vIHNpZ251cFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U2lnbnVwVGltZXN0YW1wIH0pCiAgICBieXRlYyAyNCAvLyAic2lnbnVwX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc0CiAgICAvLyBzaWdudXBUaW1lc3RhbXA6IHRoaXMuc2lnbnVwVGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc1CiAgICAvLyBhbGxvd0xhdGVTaWdudXBzOiB0aGlzLmFsbG93TGF0ZVNpZ251cHMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyMgogICAgLy8gYWxsb3dMYXRlU2lnbnVwcyA9IEdsb2JhbFN0YXRlPGJvb2xlYW4+KHsgaW5pdGlhbFZhbHVlOiBmYWxzZSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlBbGxvd0xhdGVTaWdudXBzIH0pCiAgICBieXRlYyAxNCAvLyAiYWxsb3dfbGF0ZV9zaWdudXBzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzUKICAgIC8vIGFsbG93TGF0ZVNpZ251cHM6IHRoaXMuYWxsb3dMYXRlU2lnbnVwcy52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE3NgogICAgLy8gc3RhcnRUaW1lc3RhbXA6IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNAogICAgLy8gc3RhcnRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YXJ0VGltZXN0YW1wIH0pCiAgICBieXRlYyAxNSAvLyAic3RhcnRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzYKICAgIC8vIHN0YXJ0VGltZXN0YW1wOiB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc3CiAgICAvLyBlbmRUaW1lc3RhbXA6IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjYKICAgIC8vIGVuZFRpbWVzdGFtcCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW5kVGltZXN0YW1wIH0pCiAgICBieXRlYyAxNiAvLyAiZW5kX3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc3CiAgICAvLyBlbmRUaW1lc3RhbXA6IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBtYXhFbnRyaWVzOiB0aGlzLm1heEVudHJpZXMudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gbWF4RW50cmllcyA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1heEVudHJpZXMgfSkKICAgIGJ5dGVjIDE4IC8vICJtYXhfZW50cmllcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc4CiAgICAvLyBtYXhFbnRyaWVzOiB0aGlzLm1heEVudHJpZXMudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzkKICAgIC8vIGVudHJ5Q291bnQ6ICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTc5CiAgICAvLyBlbnRyeUNvdW50OiAodGhpcy5lbnRyeUlELnZhbHVlICsgMSksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgwCiAgICAvLyByZXdhcmRDb3VudDogKHRoaXMucmV3YXJkSUQudmFsdWUgKyAxKSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMyCiAgICAvLyByZXdhcmRJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5UmV3YXJkQ291bnQgfSkKICAgIGJ5dGVjIDI1IC8vICJyZXdhcmRfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4MAogICAgLy8gcmV3YXJkQ291bnQ6ICh0aGlzLnJld2FyZElELnZhbHVlICsgMSksCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgxCiAgICAvLyB0b3RhbFN0YWtlZDogdGhpcy50b3RhbFN0YWtlZC52YWx1ZSwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTM0CiAgICAvLyB0b3RhbFN0YWtlZCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDAsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VG90YWxTdGFrZWQgfSkKICAgIGJ5dGVjIDMxIC8vICJ0b3RhbF9zdGFrZWQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4MQogICAgLy8gdG90YWxTdGFrZWQ6IHRoaXMudG90YWxTdGFrZWQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODIKICAgIC8vIHN0YWtlS2V5OiB0aGlzLnN0YWtlS2V5LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzkKICAgIC8vIHN0YWtlS2V5ID0gR2xvYmFsU3RhdGU8Um9vdEtleT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVN0YWtlS2V5IH0pCiAgICBieXRlYyAyNiAvLyAic3Rha2Vfa2V5IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODIKICAgIC8vIHN0YWtlS2V5OiB0aGlzLnN0YWtlS2V5LnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgzCiAgICAvLyBtaW5pbXVtU3Rha2VBbW91bnQ6IHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDEKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1pbmltdW1TdGFrZUFtb3VudCB9KQogICAgYnl0ZWMgMjkgLy8gIm1pbmltdW1fc3Rha2VfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODMKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudDogdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExODQKICAgIC8vIGdhdGVJRDogdGhpcy5nYXRlSUQudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0MwogICAgLy8gZ2F0ZUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5R2F0ZUlEIH0pCiAgICBieXRlYyAxNyAvLyAiZ2F0ZV9pZCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg0CiAgICAvLyBnYXRlSUQ6IHRoaXMuZ2F0ZUlELnZhbHVlLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg1CiAgICAvLyBjcmVhdG9yOiB0aGlzLmNyZWF0b3IudmFsdWUsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE0NwogICAgLy8gY3JlYXRvciA9IEdsb2JhbFN0YXRlPEFjY291bnQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlDcmVhdG9yIH0pCiAgICBieXRlYyAxMSAvLyAiY3JlYXRvciIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTg1CiAgICAvLyBjcmVhdG9yOiB0aGlzLmNyZWF0b3IudmFsdWUsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNzAtMTE4NgogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgc3RhdHVzOiB0aGlzLnN0YXR1cy52YWx1ZSwKICAgIC8vICAgdGl0bGU6IHRoaXMudGl0bGUudmFsdWUsCiAgICAvLyAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgc2lnbnVwVGltZXN0YW1wOiB0aGlzLnNpZ251cFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgYWxsb3dMYXRlU2lnbnVwczogdGhpcy5hbGxvd0xhdGVTaWdudXBzLnZhbHVlLAogICAgLy8gICBzdGFydFRpbWVzdGFtcDogdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgZW5kVGltZXN0YW1wOiB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSwKICAgIC8vICAgbWF4RW50cmllczogdGhpcy5tYXhFbnRyaWVzLnZhbHVlLAogICAgLy8gICBlbnRyeUNvdW50OiAodGhpcy5lbnRyeUlELnZhbHVlICsgMSksCiAgICAvLyAgIHJld2FyZENvdW50OiAodGhpcy5yZXdhcmRJRC52YWx1ZSArIDEpLAogICAgLy8gICB0b3RhbFN0YWtlZDogdGhpcy50b3RhbFN0YWtlZC52YWx1ZSwKICAgIC8vICAgc3Rha2VLZXk6IHRoaXMuc3Rha2VLZXkudmFsdWUsCiAgICAvLyAgIG1pbmltdW1TdGFrZUFtb3VudDogdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsCiAgICAvLyAgIGdhdGVJRDogdGhpcy5nYXRlSUQudmFsdWUsCiAgICAvLyAgIGNyZWF0b3I6IHRoaXMuY3JlYXRvci52YWx1ZSwKICAgIC8vIH0KICAgIGRpZyAxMwogICAgbGVuCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgdW5jb3ZlciAxNAogICAgY29uY2F0CiAgICB1bmNvdmVyIDE0CiAgICBwdXNoYnl0ZXMgMHgwMDZmCiAgICBjb25jYXQKICAgIGRpZyAxCiAgICBsZW4KICAgIHB1c2hpbnQgMTExIC8vIDExMQogICAgKwogICAgc3dhcAogICAgdW5jb3ZlciAxNQogICAgY29uY2F0CiAgICB1bmNvdmVyIDE0CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMTIKICAgIGl0b2IKICAgIGNvbmNhdAogICAgYnl0ZWNfMyAvLyAweDAwCiAgICBpbnRjXzAgLy8gMAogICAgdW5jb3ZlciAxNAogICAgc2V0Yml0CiAgICBjb25jYXQKICAgIHVuY292ZXIgMTEKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAxMAogICAgaXRvYgogICAgY29uY2F0CiAgICB1bmNvdmVyIDkKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciA4CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgNwogICAgaXRvYgogICAgY29uY2F0CiAgICBzd2FwCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgY29uY2F0CiAgICB1bmNvdmVyIDQKICAgIGl0b2IKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICBzd2FwCiAgICBjb25jYXQKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNjgKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VGZWVHZW5lcmF0b3JDb250cmFjdC51cGRhdGVBa2l0YURBT0VzY3Jvd1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPRXNjcm93OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM3CiAgICAvLyB1cGRhdGVBa2l0YURBT0VzY3JvdyhhcHA6IEFwcGxpY2F0aW9uKTogdm9pZCB7CiAgICB0eG5hIEFwcGxpY2F0aW9uQXJncyAxCiAgICBkdXAKICAgIGxlbgogICAgaW50Y18yIC8vIDgKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIHVpbnQ2NAogICAgYnRvaQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MTM4CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDIwIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoxMzgKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICA9PQogICAgYXNzZXJ0IC8vIE9ubHkgdGhlIEFraXRhIERBTyBjYW4gY2FsbCB0aGlzIGZ1bmN0aW9uCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czo2NQogICAgLy8gYWtpdGFEQU9Fc2Nyb3cgPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFFc2Nyb3cgfSkKICAgIGJ5dGVjIDcgLy8gImFraXRhX2VzY3JvdyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzOQogICAgLy8gdGhpcy5ha2l0YURBT0VzY3Jvdy52YWx1ZSA9IGFwcAogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjEzNwogICAgLy8gdXBkYXRlQWtpdGFEQU9Fc2Nyb3coYXBwOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpVcGdyYWRlYWJsZUFraXRhQmFzZUNvbnRyYWN0LnVwZGF0ZVtyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjQ4CiAgICAvLyBAYWJpbWV0aG9kKHsgYWxsb3dBY3Rpb25zOiBbJ1VwZGF0ZUFwcGxpY2F0aW9uJ10gfSkKICAgIHR4bmEgQXBwbGljYXRpb25BcmdzIDEKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDIgLy8gMgogICAgKwogICAgZGlnIDEKICAgIGxlbgogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGxlbit1dGY4W10pCiAgICBleHRyYWN0IDIgMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozMgogICAgLy8gY29uc3QgW3dhbGxldElEXSA9IG9wLkFwcEdsb2JhbC5nZXRFeFVpbnQ2NCh0aGlzLmFraXRhREFPLnZhbHVlLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c1dhbGxldCkpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgZHVwCiAgICBieXRlYyAyMCAvLyAid2FsbGV0IgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTAKICAgIC8vIGFzc2VydChUeG4uc2VuZGVyID09PSB0aGlzLmdldEFraXRhREFPV2FsbGV0KCkuYWRkcmVzcywgRVJSX05PVF9BS0lUQV9EQU8pCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICB1bmNvdmVyIDIKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NTAKICAgIC8vIGNvbnN0IFtwbHVnaW5BcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzUGx1Z2luQXBwTGlzdCkpCiAgICBieXRlYyAzNiAvLyAicGFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTEKICAgIC8vIGNvbnN0IHVwZGF0ZVBsdWdpbiA9IGdldFBsdWdpbkFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkudXBkYXRlCiAgICBwdXNoaW50IDE2IC8vIDE2CiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NTIKICAgIC8vIGFzc2VydChHbG9iYWwuY2FsbGVyQXBwbGljYXRpb25JZCA9PT0gdXBkYXRlUGx1Z2luLCBFUlJfSU5WQUxJRF9VUEdSQURFKQogICAgZ2xvYmFsIENhbGxlckFwcGxpY2F0aW9uSUQKICAgID09CiAgICBhc3NlcnQgLy8gSW52YWxpZCBhcHAgdXBncmFkZQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjcKICAgIC8vIHZlcnNpb24gPSBHbG9iYWxTdGF0ZTxzdHJpbmc+KHsga2V5OiBHbG9iYWxTdGF0ZUtleVZlcnNpb24gfSkKICAgIHB1c2hieXRlcyAidmVyc2lvbiIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjUzCiAgICAvLyB0aGlzLnZlcnNpb24udmFsdWUgPSBuZXdWZXJzaW9uCiAgICBzd2FwCiAgICBhcHBfZ2xvYmFsX3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDgKICAgIC8vIEBhYmltZXRob2QoeyBhbGxvd0FjdGlvbnM6IFsnVXBkYXRlQXBwbGljYXRpb24nXSB9KQogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjpBa2l0YUJhc2VDb250cmFjdC51cGRhdGVBa2l0YURBT1tyb3V0aW5nXSgpIC0+IHZvaWQ6CnVwZGF0ZUFraXRhREFPOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzgKICAgIC8vIHVwZGF0ZUFraXRhREFPKGFraXRhREFPOiBBcHBsaWNhdGlvbik6IHZvaWQgewogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM5CiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5nZXRBa2l0YURBT1dhbGxldCgpLmFkZHJlc3MsIEVSUl9OT1RfQUtJVEFfREFPKQogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MzIKICAgIC8vIGNvbnN0IFt3YWxsZXRJRF0gPSBvcC5BcHBHbG9iYWwuZ2V0RXhVaW50NjQodGhpcy5ha2l0YURBTy52YWx1ZSwgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNXYWxsZXQpKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDIwIC8vICJ3YWxsZXQiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czozOQogICAgLy8gYXNzZXJ0KFR4bi5zZW5kZXIgPT09IHRoaXMuZ2V0QWtpdGFEQU9XYWxsZXQoKS5hZGRyZXNzLCBFUlJfTk9UX0FLSVRBX0RBTykKICAgIGFwcF9wYXJhbXNfZ2V0IEFwcEFkZHJlc3MKICAgIGFzc2VydCAvLyBhcHBsaWNhdGlvbiBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gT25seSB0aGUgQWtpdGEgREFPIGNhbiBjYWxsIHRoaXMgZnVuY3Rpb24KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6NDAKICAgIC8vIHRoaXMuYWtpdGFEQU8udmFsdWUgPSBha2l0YURBTwogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjM4CiAgICAvLyB1cGRhdGVBa2l0YURBTyhha2l0YURBTzogQXBwbGljYXRpb24pOiB2b2lkIHsKICAgIGludGNfMSAvLyAxCiAgICByZXR1cm4KCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6OkJhc2VTdGFraW5nUG9vbC5tYnJbcm91dGluZ10oKSAtPiB2b2lkOgptYnI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgdHhuYSBBcHBsaWNhdGlvbkFyZ3MgMQogICAgZHVwCiAgICBsZW4KICAgIGludGNfMiAvLyA4CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciB1aW50NjQKICAgIGJ0b2kKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvYmFzZS50czo5CiAgICAvLyByZXR1cm4gTWluUG9vbFJld2FyZHNNQlIgKyAoQm94Q29zdFBlckJ5dGUgKiB3aW5uaW5nVGlja2V0cykKICAgIGludGMgNiAvLyA0MDAKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvYmFzZS50czoxNS0yMQogICAgLy8gcmV0dXJuIHsKICAgIC8vICAgZW50cmllczogUG9vbEVudHJpZXNNQlIsCiAgICAvLyAgIHVuaXF1ZXM6IFBvb2xVbmlxdWVzTUJSLAogICAgLy8gICBlbnRyaWVzQnlBZGRyZXNzOiBQb29sRW50cmllc0J5QWRkcmVzc01CUiwKICAgIC8vICAgcmV3YXJkczogdGhpcy5yZXdhcmRzTWJyKHdpbm5pbmdUaWNrZXRzKSwKICAgIC8vICAgZGlzYnVyc2VtZW50czogUG9vbERpc2J1cnNlbWVudFNNQlIKICAgIC8vIH0KICAgIGl0b2IKICAgIHB1c2hieXRlcyAweDAwMDAwMDAwMDAwMDYyZDQwMDAwMDAwMDAwMDA0OWQ0MDAwMDAwMDAwMDAwNjJkNAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MjAKICAgIC8vIGRpc2J1cnNlbWVudHM6IFBvb2xEaXNidXJzZW1lbnRTTUJSCiAgICBwdXNoaW50IDYxMDAgLy8gNjEwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9iYXNlLnRzOjE1LTIxCiAgICAvLyByZXR1cm4gewogICAgLy8gICBlbnRyaWVzOiBQb29sRW50cmllc01CUiwKICAgIC8vICAgdW5pcXVlczogUG9vbFVuaXF1ZXNNQlIsCiAgICAvLyAgIGVudHJpZXNCeUFkZHJlc3M6IFBvb2xFbnRyaWVzQnlBZGRyZXNzTUJSLAogICAgLy8gICByZXdhcmRzOiB0aGlzLnJld2FyZHNNYnIod2lubmluZ1RpY2tldHMpLAogICAgLy8gICBkaXNidXJzZW1lbnRzOiBQb29sRGlzYnVyc2VtZW50U01CUgogICAgLy8gfQogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2Jhc2UudHM6MTMKICAgIC8vIEBhYmltZXRob2QoeyByZWFkb25seTogdHJ1ZSB9KQogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICBzd2FwCiAgICBjb25jYXQKICAgIGxvZwogICAgaW50Y18xIC8vIDEKICAgIHJldHVybgoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuY2hlY2tCeUlEKGlkOiB1aW50NjQpIC0+IGJ5dGVzOgpjaGVja0J5SUQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDg3CiAgICAvLyBwcml2YXRlIGNoZWNrQnlJRChpZDogdWludDY0KTogeyB2YWxpZDogYm9vbGVhbiwgYmFsYW5jZTogdWludDY0IH0gewogICAgcHJvdG8gMSAxCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gMwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OQogICAgLy8gdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0ODkKICAgIC8vIHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfTk9ORSB8fCB0aGlzLnR5cGUudmFsdWUgIT09IFBPT0xfU1RBS0lOR19UWVBFX0hFQVJUQkVBVCwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlY18zIC8vIDB4MDAKICAgICE9CiAgICBibnogY2hlY2tCeUlEX2Jvb2xfdHJ1ZUAyCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExOAogICAgLy8gdHlwZSA9IEdsb2JhbFN0YXRlPFN0YWtpbmdQb29sU3Rha2luZ1R5cGU+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlUeXBlIH0pCiAgICBieXRlYyA0IC8vICJ0eXBlIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OQogICAgLy8gdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjIDggLy8gMHgwYQogICAgIT0KICAgIGJ6IGNoZWNrQnlJRF9ib29sX2ZhbHNlQDMKCmNoZWNrQnlJRF9ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCgpjaGVja0J5SURfYm9vbF9tZXJnZUA0OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ4OC00OTEKICAgIC8vIGFzc2VydCgKICAgIC8vICAgdGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FIHx8IHRoaXMudHlwZS52YWx1ZSAhPT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFULAogICAgLy8gICBFUlJfSU5WQUxJRF9QT09MX1RZUEVfRk9SX0NIRUNLCiAgICAvLyApCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwb29sIHR5cGUgZm9yIGNoZWNrCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDkzCiAgICAvLyBjb25zdCB7IGRpc3F1YWxpZmllZCwgYWRkcmVzcywgYXNzZXQsIHF1YW50aXR5IH0gPSB0aGlzLmVudHJpZXMoaWQpLnZhbHVlCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0OTMKICAgIC8vIGNvbnN0IHsgZGlzcXVhbGlmaWVkLCBhZGRyZXNzLCBhc3NldCwgcXVhbnRpdHkgfSA9IHRoaXMuZW50cmllcyhpZCkudmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1MCAvLyA1MAogICAgaW50Y18xIC8vIDEKICAgIGJveF9leHRyYWN0CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICBkaWcgMQogICAgaW50Y18wIC8vIDAKICAgIHB1c2hpbnQgMzIgLy8gMzIKICAgIGJveF9leHRyYWN0CiAgICBmcmFtZV9idXJ5IDAKICAgIGRpZyAxCiAgICBwdXNoaW50IDMyIC8vIDMyCiAgICBpbnRjXzIgLy8gOAogICAgYm94X2V4dHJhY3QKICAgIGR1cAogICAgZnJhbWVfYnVyeSAxCiAgICBidG9pCiAgICBmcmFtZV9idXJ5IDMKICAgIHN3YXAKICAgIHB1c2hpbnQgNDAgLy8gNDAKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDk1CiAgICAvLyBpZiAoZGlzcXVhbGlmaWVkKSB7CiAgICBieiBjaGVja0J5SURfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NDk2CiAgICAvLyByZXR1cm4geyB2YWxpZDogZmFsc2UsIGJhbGFuY2U6IDAgfQogICAgYnl0ZWMgMzkgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjaGVja0J5SURfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjQ5OQogICAgLy8gaWYgKHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfU09GVCkgewogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo0OTkKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQpIHsKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICA9PQogICAgYnogY2hlY2tCeUlEX2Vsc2VfYm9keUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwMC01MDMKICAgIC8vIGNvbnN0IGNoZWNrID0gYWJpQ2FsbDx0eXBlb2YgU3Rha2luZy5wcm90b3R5cGUuc29mdENoZWNrPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogW2FkZHJlc3MsIGFzc2V0XSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAxCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAxCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwMQogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTAwLTUwMwogICAgLy8gY29uc3QgY2hlY2sgPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5zb2Z0Q2hlY2s+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbYWRkcmVzcywgYXNzZXRdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIHB1c2hieXRlcyAweDQ4NzE2ZjFhIC8vIG1ldGhvZCAic29mdENoZWNrKGFkZHJlc3MsdWludDY0KShib29sLHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgMQogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgbGVuCiAgICBwdXNoaW50IDkgLy8gOQogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKGJvb2wxLHVpbnQ2NCkKICAgIHB1c2hpbnQgNSAvLyA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA1CiAgICAvLyBpZiAoY2hlY2suYmFsYW5jZSA+PSBxdWFudGl0eSkgewogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgNAogICAgPj0KICAgIGJ6IGNoZWNrQnlJRF9hZnRlcl9pZl9lbHNlQDE1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA2CiAgICAvLyByZXR1cm4geyB2YWxpZDogdHJ1ZSwgYmFsYW5jZTogY2hlY2suYmFsYW5jZSB9CiAgICBmcmFtZV9kaWcgNQogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY2hlY2tCeUlEX2FmdGVyX2lmX2Vsc2VAMTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTI1CiAgICAvLyB0aGlzLmVudHJpZXMoaWQpLnZhbHVlLmRpc3F1YWxpZmllZCA9IHRydWUKICAgIGZyYW1lX2RpZyAyCiAgICBkdXAKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgaW50Y18wIC8vIDAKICAgIGludGNfMSAvLyAxCiAgICBzZXRiaXQKICAgIHB1c2hpbnQgNTAgLy8gNTAKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTI2CiAgICAvLyByZXR1cm4geyB2YWxpZDogZmFsc2UsIGJhbGFuY2U6IDAgfQogICAgYnl0ZWMgMzkgLy8gMHgwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpjaGVja0J5SURfZWxzZV9ib2R5QDExOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUwOS01MTgKICAgIC8vIGNvbnN0IGluZm8gPSBhYmlDYWxsPHR5cGVvZiBTdGFraW5nLnByb3RvdHlwZS5nZXRJbmZvPih7CiAgICAvLyAgIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGFkZHJlc3MsCiAgICAvLyAgICAgewogICAgLy8gICAgICAgYXNzZXQ6IGFzc2V0LAogICAgLy8gICAgICAgdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgLy8gICAgIH0sCiAgICAvLyAgIF0sCiAgICAvLyB9KS5yZXR1cm5WYWx1ZQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxMAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxMAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9mdW5jdGlvbnMudHM6NDAKICAgIC8vIGNvbnN0IFthcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzQWtpdGFBcHBMaXN0KSkKICAgIGJ5dGVjIDYgLy8gImFhbCIKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBwb3AKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MTAKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUxNQogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MTUKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTEzLTUxNgogICAgLy8gewogICAgLy8gICBhc3NldDogYXNzZXQsCiAgICAvLyAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vIH0sCiAgICBmcmFtZV9kaWcgMwogICAgaXRvYgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTA5LTUxOAogICAgLy8gY29uc3QgaW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogYXNzZXQsCiAgICAvLyAgICAgICB0eXBlOiB0aGlzLnR5cGUudmFsdWUsCiAgICAvLyAgICAgfSwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBieXRlYyA0MCAvLyBtZXRob2QgImdldEluZm8oYWRkcmVzcywodWludDY0LHVpbnQ4KSkodWludDY0LHVpbnQ2NCx1aW50NjQpIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgbGVuCiAgICBwdXNoaW50IDI0IC8vIDI0CiAgICA9PQogICAgYXNzZXJ0IC8vIGludmFsaWQgbnVtYmVyIG9mIGJ5dGVzIGZvciAodWludDY0LHVpbnQ2NCx1aW50NjQpCiAgICBwdXNoaW50IDQgLy8gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjUyMAogICAgLy8gaWYgKGluZm8uYW1vdW50ID49IHF1YW50aXR5KSB7CiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDYKICAgIGZyYW1lX2RpZyA0CiAgICA+PQogICAgYnogY2hlY2tCeUlEX2FmdGVyX2lmX2Vsc2VAMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1MjEKICAgIC8vIHJldHVybiB7IHZhbGlkOiB0cnVlLCBiYWxhbmNlOiBpbmZvLmFtb3VudCB9CiAgICBmcmFtZV9kaWcgNgogICAgaXRvYgogICAgYnl0ZWMgMTkgLy8gMHg4MAogICAgc3dhcAogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY2hlY2tCeUlEX2Jvb2xfZmFsc2VAMzoKICAgIGludGNfMCAvLyAwCiAgICBiIGNoZWNrQnlJRF9ib29sX21lcmdlQDQKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKGRpc2J1cnNlbWVudElEOiB1aW50NjQsIGFzc2V0OiB1aW50NjQsIGFsbG9jYXRpb25zOiBieXRlcywgc3VtOiB1aW50NjQpIC0+IGJ5dGVzOgpjcmVhdGVSZXdhcmRBbGxvY2F0aW9uczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1NzctNTgyCiAgICAvLyBwcml2YXRlIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zKAogICAgLy8gICBkaXNidXJzZW1lbnRJRDogdWludDY0LAogICAgLy8gICBhc3NldDogdWludDY0LAogICAgLy8gICBhbGxvY2F0aW9uczogVXNlckFsbG9jYXRpb25bXSwKICAgIC8vICAgc3VtOiB1aW50NjQKICAgIC8vICk6IHZvaWQgewogICAgcHJvdG8gNCAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg0CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Jhc2UtY29udHJhY3RzL2Jhc2UudHM6MjkKICAgIC8vIGFraXRhREFPID0gR2xvYmFsU3RhdGU8QXBwbGljYXRpb24+KHsga2V5OiBHbG9iYWxTdGF0ZUtleUFraXRhREFPIH0pCiAgICBieXRlY18wIC8vICJha2l0YV9kYW8iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg0CiAgICAvLyBjb25zdCByZXdhcmRzQXBwID0gQXBwbGljYXRpb24oZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnJld2FyZHMpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL2Z1bmN0aW9ucy50czo0MAogICAgLy8gY29uc3QgW2FwcExpc3RCeXRlc10gPSBvcC5BcHBHbG9iYWwuZ2V0RXhCeXRlcyhha2l0YURBTywgQnl0ZXMoQWtpdGFEQU9HbG9iYWxTdGF0ZUtleXNBa2l0YUFwcExpc3QpKQogICAgYnl0ZWMgNiAvLyAiYWFsIgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIHBvcAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU4NAogICAgLy8gY29uc3QgcmV3YXJkc0FwcCA9IEFwcGxpY2F0aW9uKGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5yZXdhcmRzKQogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjU1CiAgICAvLyByZXR1cm4gVXNlckFsbG9jYXRpb25NQlIgKiBhbGxvY2F0aW9ucy5sZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBwdXNoaW50IDI1MzAwIC8vIDI1MzAwCiAgICAqCiAgICBzd2FwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg3CiAgICAvLyBpZiAoYXNzZXQgPT09IDApIHsKICAgIGZyYW1lX2RpZyAtMwogICAgYm56IGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2Vsc2VfYm9keUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTg5LTU5OQogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlVXNlckFsbG9jYXRpb25zPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudCArIHN1bSwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBkaXNidXJzZW1lbnRJRCwKICAgIC8vICAgICBhbGxvY2F0aW9ucywKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTkzCiAgICAvLyByZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTk0CiAgICAvLyBhbW91bnQ6IG1ickFtb3VudCArIHN1bSwKICAgIGZyYW1lX2RpZyAwCiAgICBmcmFtZV9kaWcgLTEKICAgICsKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NTkyLTU5NQogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQgKyBzdW0sCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1ODktNTk5CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50ICsgc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjU5NgogICAgLy8gZGlzYnVyc2VtZW50SUQsCiAgICBmcmFtZV9kaWcgLTQKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo1ODktNTk5CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50ICsgc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIHB1c2hieXRlcyAweDU2NTZhY2Y5IC8vIG1ldGhvZCAiY3JlYXRlVXNlckFsbG9jYXRpb25zKHBheSx1aW50NjQsKGFkZHJlc3MsdWludDY0KVtdKXZvaWQiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAtMgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKCmNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2FmdGVyX2lmX2Vsc2VAMTA6CiAgICBmcmFtZV9kaWcgLTIKICAgIHN3YXAKICAgIHJldHN1YgoKY3JlYXRlUmV3YXJkQWxsb2NhdGlvbnNfZWxzZV9ib2R5QDY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjAyLTYxNwogICAgLy8gYWJpQ2FsbDx0eXBlb2YgUmV3YXJkcy5wcm90b3R5cGUuY3JlYXRlQXNhVXNlckFsbG9jYXRpb25zPih7CiAgICAvLyAgIGFwcElkOiByZXdhcmRzQXBwLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgaXR4bi5wYXltZW50KHsKICAgIC8vICAgICAgIHJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICBhbW91bnQ6IG1ickFtb3VudCwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICAgICAgYXNzZXRSZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgLy8gICAgICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgICAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgZGlzYnVyc2VtZW50SUQsCiAgICAvLyAgICAgYWxsb2NhdGlvbnMsCiAgICAvLyAgIF0sCiAgICAvLyB9KQogICAgaXR4bl9iZWdpbgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwNgogICAgLy8gcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIGR1cAogICAgYXBwX3BhcmFtc19nZXQgQXBwQWRkcmVzcwogICAgYXNzZXJ0IC8vIGFwcGxpY2F0aW9uIGV4aXN0cwogICAgZnJhbWVfZGlnIDAKICAgIGl0eG5fZmllbGQgQW1vdW50CiAgICBpdHhuX2ZpZWxkIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjA1LTYwOAogICAgLy8gaXR4bi5wYXltZW50KHsKICAgIC8vICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgYW1vdW50OiBtYnJBbW91bnQsCiAgICAvLyB9KSwKICAgIGludGNfMSAvLyAxCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MDktNjEzCiAgICAvLyBpdHhuLmFzc2V0VHJhbnNmZXIoewogICAgLy8gICBhc3NldFJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgIGFzc2V0QW1vdW50OiBzdW0sCiAgICAvLyB9KSwKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYxMAogICAgLy8gYXNzZXRSZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgZHVwCiAgICBhcHBfcGFyYW1zX2dldCBBcHBBZGRyZXNzCiAgICBhc3NlcnQgLy8gYXBwbGljYXRpb24gZXhpc3RzCiAgICBmcmFtZV9kaWcgLTEKICAgIGl0eG5fZmllbGQgQXNzZXRBbW91bnQKICAgIGZyYW1lX2RpZyAtMwogICAgaXR4bl9maWVsZCBYZmVyQXNzZXQKICAgIGl0eG5fZmllbGQgQXNzZXRSZWNlaXZlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwOS02MTMKICAgIC8vIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgIGFzc2V0UmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgeGZlckFzc2V0OiBhc3NldCwKICAgIC8vICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vIH0pLAogICAgcHVzaGludCA0IC8vIDQKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYwMi02MTcKICAgIC8vIGFiaUNhbGw8dHlwZW9mIFJld2FyZHMucHJvdG90eXBlLmNyZWF0ZUFzYVVzZXJBbGxvY2F0aW9ucz4oewogICAgLy8gICBhcHBJZDogcmV3YXJkc0FwcCwKICAgIC8vICAgYXJnczogWwogICAgLy8gICAgIGl0eG4ucGF5bWVudCh7CiAgICAvLyAgICAgICByZWNlaXZlcjogcmV3YXJkc0FwcC5hZGRyZXNzLAogICAgLy8gICAgICAgYW1vdW50OiBtYnJBbW91bnQsCiAgICAvLyAgICAgfSksCiAgICAvLyAgICAgaXR4bi5hc3NldFRyYW5zZmVyKHsKICAgIC8vICAgICAgIGFzc2V0UmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIHhmZXJBc3NldDogYXNzZXQsCiAgICAvLyAgICAgICBhc3NldEFtb3VudDogc3VtLAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGRpc2J1cnNlbWVudElELAogICAgLy8gICAgIGFsbG9jYXRpb25zLAogICAgLy8gICBdLAogICAgLy8gfSkKICAgIGl0eG5fbmV4dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYxNAogICAgLy8gZGlzYnVyc2VtZW50SUQsCiAgICBmcmFtZV9kaWcgLTQKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MDItNjE3CiAgICAvLyBhYmlDYWxsPHR5cGVvZiBSZXdhcmRzLnByb3RvdHlwZS5jcmVhdGVBc2FVc2VyQWxsb2NhdGlvbnM+KHsKICAgIC8vICAgYXBwSWQ6IHJld2FyZHNBcHAsCiAgICAvLyAgIGFyZ3M6IFsKICAgIC8vICAgICBpdHhuLnBheW1lbnQoewogICAgLy8gICAgICAgcmVjZWl2ZXI6IHJld2FyZHNBcHAuYWRkcmVzcywKICAgIC8vICAgICAgIGFtb3VudDogbWJyQW1vdW50LAogICAgLy8gICAgIH0pLAogICAgLy8gICAgIGl0eG4uYXNzZXRUcmFuc2Zlcih7CiAgICAvLyAgICAgICBhc3NldFJlY2VpdmVyOiByZXdhcmRzQXBwLmFkZHJlc3MsCiAgICAvLyAgICAgICB4ZmVyQXNzZXQ6IGFzc2V0LAogICAgLy8gICAgICAgYXNzZXRBbW91bnQ6IHN1bSwKICAgIC8vICAgICB9KSwKICAgIC8vICAgICBkaXNidXJzZW1lbnRJRCwKICAgIC8vICAgICBhbGxvY2F0aW9ucywKICAgIC8vICAgXSwKICAgIC8vIH0pCiAgICBwdXNoYnl0ZXMgMHgzYzYwZGZhMCAvLyBtZXRob2QgImNyZWF0ZUFzYVVzZXJBbGxvY2F0aW9ucyhwYXksYXhmZXIsdWludDY0LChhZGRyZXNzLHVpbnQ2NClbXSl2b2lkIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBmcmFtZV9kaWcgLTIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBiIGNyZWF0ZVJld2FyZEFsbG9jYXRpb25zX2FmdGVyX2lmX2Vsc2VAMTAKCgovLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmNyZWF0ZVBvb2xFbnRyaWVzKHBheW1lbnQ6IHVpbnQ2NCwgZW50cmllczogYnl0ZXMsIGdhdGVBcmdzOiBieXRlcykgLT4gYnl0ZXMsIGJ5dGVzOgpjcmVhdGVQb29sRW50cmllczoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NTgKICAgIC8vIHByaXZhdGUgY3JlYXRlUG9vbEVudHJpZXMocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCBlbnRyaWVzOiBTdGFrZUVudHJ5W10sIGdhdGVBcmdzOiBHYXRlQXJncyk6IHZvaWQgewogICAgcHJvdG8gMyAyCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAyCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY1OQogICAgLy8gYXNzZXJ0KHRoaXMuaXNMaXZlKCksICd0aGUgcG9vbCBpcyBub3QgbGl2ZScpCiAgICBjYWxsc3ViIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlCiAgICBhc3NlcnQgLy8gdGhlIHBvb2wgaXMgbm90IGxpdmUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjIKICAgIC8vICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSA8PSB0aGlzLm1heEVudHJpZXMudmFsdWUgfHwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMwCiAgICAvLyBlbnRyeUlEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbnRyeUNvdW50IH0pCiAgICBieXRlY18xIC8vICJlbnRyeV9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjIKICAgIC8vICh0aGlzLmVudHJ5SUQudmFsdWUgKyAxKSA8PSB0aGlzLm1heEVudHJpZXMudmFsdWUgfHwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjgKICAgIC8vIG1heEVudHJpZXMgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlNYXhFbnRyaWVzIH0pCiAgICBieXRlYyAxOCAvLyAibWF4X2VudHJpZXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjYyCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgPD0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjItNjYzCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAsCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF90cnVlQDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjMKICAgIC8vIHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCwKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBtYXhFbnRyaWVzID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5TWF4RW50cmllcyB9KQogICAgYnl0ZWMgMTggLy8gIm1heF9lbnRyaWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY2MwogICAgLy8gdGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwLAogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NjItNjYzCiAgICAvLyAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyB0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAsCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAzCgpjcmVhdGVQb29sRW50cmllc19ib29sX3RydWVAMjoKICAgIGludGNfMSAvLyAxCgpjcmVhdGVQb29sRW50cmllc19ib29sX21lcmdlQDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjYxLTY2NQogICAgLy8gYXNzZXJ0KAogICAgLy8gICAodGhpcy5lbnRyeUlELnZhbHVlICsgMSkgPD0gdGhpcy5tYXhFbnRyaWVzLnZhbHVlIHx8CiAgICAvLyAgIHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCwKICAgIC8vICAgJ3Bvb2wgaGFzIHJlYWNoZWQgbWF4aW11bSBlbnRyaWVzJwogICAgLy8gKQogICAgYXNzZXJ0IC8vIHBvb2wgaGFzIHJlYWNoZWQgbWF4aW11bSBlbnRyaWVzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjY5CiAgICAvLyBsZXQgdG90YWw6IHVpbnQ2NCA9IGVudHJ5TUJSICogZW50cmllcy5sZW5ndGgKICAgIGZyYW1lX2RpZyAtMgogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDE2CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMwogICAgaW50YyAxMCAvLyA1MDYwMAogICAgKgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBieXRlYyAyMyAvLyAidSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NzAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NzAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUA2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjcxCiAgICAvLyB0b3RhbCArPSBQb29sVW5pcXVlc01CUgogICAgaW50YyAxMSAvLyAxODkwMAogICAgKwoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUA2OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY3NC02ODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogeyBncmVhdGVyVGhhbkVxOiB0b3RhbCB9LAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICBmcmFtZV9kaWcgLTMKICAgIGd0eG5zIFJlY2VpdmVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njc3CiAgICAvLyByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICBnbG9iYWwgQ3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY3NC02ODEKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogeyBncmVhdGVyVGhhbkVxOiB0b3RhbCB9LAogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICA9PQogICAgZnJhbWVfZGlnIC0zCiAgICBndHhucyBBbW91bnQKICAgIHVuY292ZXIgMgogICAgPj0KICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjgzCiAgICAvLyBjb25zdCB7IGFkZHJlc3MsIG5hbWUgfSA9IHRoaXMuc3Rha2VLZXkudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTM5CiAgICAvLyBzdGFrZUtleSA9IEdsb2JhbFN0YXRlPFJvb3RLZXk+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFrZUtleSB9KQogICAgYnl0ZWMgMjYgLy8gInN0YWtlX2tleSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2ODMKICAgIC8vIGNvbnN0IHsgYWRkcmVzcywgbmFtZSB9ID0gdGhpcy5zdGFrZUtleS52YWx1ZQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGR1cAogICAgZXh0cmFjdCAwIDMyCiAgICBmcmFtZV9idXJ5IDAKICAgIGR1cAogICAgcHVzaGludCAzMiAvLyAzMgogICAgZXh0cmFjdF91aW50MTYKICAgIGRpZyAxCiAgICBsZW4KICAgIHN1YnN0cmluZzMKICAgIGZyYW1lX2J1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGVudHJpZXMubGVuZ3RoOyBpKyspIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDUKCmNyZWF0ZVBvb2xFbnRyaWVzX3doaWxlX3RvcEA3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NQogICAgLy8gZm9yIChsZXQgaTogdWludDY0ID0gMDsgaSA8IGVudHJpZXMubGVuZ3RoOyBpKyspIHsKICAgIGZyYW1lX2RpZyA1CiAgICBmcmFtZV9kaWcgMwogICAgPAogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfd2hpbGVAMjcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2ODYKICAgIC8vIGFzc2VydChlbnRyaWVzW2ldLnF1YW50aXR5ID49IHRoaXMubWluaW11bVN0YWtlQW1vdW50LnZhbHVlLCAncXVhbnRpdHkgaXMgbGVzcyB0aGFuIG1pbmltdW0gc3Rha2UgYW1vdW50JykKICAgIGZyYW1lX2RpZyAtMgogICAgZXh0cmFjdCAyIDAKICAgIGZyYW1lX2RpZyA1CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAqCiAgICBkaWcgMQogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGR1cAogICAgZnJhbWVfYnVyeSA1CiAgICBmcmFtZV9kaWcgMwogICAgZGlnIDEKICAgIC0gLy8gb24gZXJyb3I6IGluZGV4IGFjY2VzcyBpcyBvdXQgb2YgYm91bmRzCiAgICBkaWcgMwogICAgbGVuCiAgICB1bmNvdmVyIDIKICAgIHB1c2hpbnQgMiAvLyAyCiAgICAqCiAgICBkaWcgNAogICAgc3dhcAogICAgZXh0cmFjdF91aW50MTYKICAgIHVuY292ZXIgMgogICAgc2VsZWN0CiAgICBzdWJzdHJpbmczCiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMgogICAgaW50Y18yIC8vIDgKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDEKICAgIC8vIG1pbmltdW1TdGFrZUFtb3VudCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1pbmltdW1TdGFrZUFtb3VudCB9KQogICAgYnl0ZWMgMjkgLy8gIm1pbmltdW1fc3Rha2VfYW1vdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4NgogICAgLy8gYXNzZXJ0KGVudHJpZXNbaV0ucXVhbnRpdHkgPj0gdGhpcy5taW5pbXVtU3Rha2VBbW91bnQudmFsdWUsICdxdWFudGl0eSBpcyBsZXNzIHRoYW4gbWluaW11bSBzdGFrZSBhbW91bnQnKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID49CiAgICBhc3NlcnQgLy8gcXVhbnRpdHkgaXMgbGVzcyB0aGFuIG1pbmltdW0gc3Rha2UgYW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njg4CiAgICAvLyBpZiAoYWRkcmVzcyAhPT0gR2xvYmFsLnplcm9BZGRyZXNzKSB7CiAgICBmcmFtZV9kaWcgMAogICAgZ2xvYmFsIFplcm9BZGRyZXNzCiAgICAhPQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAxMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4OS02OTgKICAgIC8vIGNvbnN0IHZlcmlmaWVkID0gYWJpQ2FsbDx0eXBlb2YgTWV0YU1lcmtsZXMucHJvdG90eXBlLnZlcmlmeT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgLy8gICAgIGVudHJpZXNbaV0ucHJvb2YsCiAgICAvLyAgICAgTUVSS0xFX1RSRUVfVFlQRV9BU1NFVCwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBpdHhuX2JlZ2luCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjkwCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy9iYXNlLWNvbnRyYWN0cy9iYXNlLnRzOjI5CiAgICAvLyBha2l0YURBTyA9IEdsb2JhbFN0YXRlPEFwcGxpY2F0aW9uPih7IGtleTogR2xvYmFsU3RhdGVLZXlBa2l0YURBTyB9KQogICAgYnl0ZWNfMCAvLyAiYWtpdGFfZGFvIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY5MAogICAgLy8gYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5tZXRhTWVya2xlcywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjkwCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgcHVzaGludCA3MiAvLyA3MgogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2OTQKICAgIC8vIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgZnJhbWVfZGlnIDIKICAgIGR1cAogICAgZXh0cmFjdCAwIDgKICAgIHNoYTI1NgogICAgc2hhMjU2CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6Njk1CiAgICAvLyBlbnRyaWVzW2ldLnByb29mLAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMgogICAgbGVuCiAgICB1bmNvdmVyIDMKICAgIGNvdmVyIDIKICAgIHN1YnN0cmluZzMKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2OTYKICAgIC8vIE1FUktMRV9UUkVFX1RZUEVfQVNTRVQsCiAgICBpbnRjXzEgLy8gMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY4OS02OTgKICAgIC8vIGNvbnN0IHZlcmlmaWVkID0gYWJpQ2FsbDx0eXBlb2YgTWV0YU1lcmtsZXMucHJvdG90eXBlLnZlcmlmeT4oewogICAgLy8gICBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLm1ldGFNZXJrbGVzLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgYWRkcmVzcywKICAgIC8vICAgICBuYW1lLAogICAgLy8gICAgIHNoYTI1NihzaGEyNTYoaXRvYihlbnRyaWVzW2ldLmFzc2V0KSkpLAogICAgLy8gICAgIGVudHJpZXNbaV0ucHJvb2YsCiAgICAvLyAgICAgTUVSS0xFX1RSRUVfVFlQRV9BU1NFVCwKICAgIC8vICAgXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgyYmYzY2M1YSAvLyBtZXRob2QgInZlcmlmeShhZGRyZXNzLHN0cmluZyxieXRlWzMyXSxieXRlWzMyXVtdLHVpbnQ2NClib29sIgogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGZyYW1lX2RpZyAwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgZnJhbWVfZGlnIDEKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICB1bmNvdmVyIDIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgc3dhcAogICAgZXh0cmFjdCAwIDQKICAgIGJ5dGVjXzIgLy8gMHgxNTFmN2M3NQogICAgPT0KICAgIGFzc2VydCAvLyBCeXRlcyBoYXMgdmFsaWQgcHJlZml4CiAgICBkdXAKICAgIGxlbgogICAgaW50Y18xIC8vIDEKICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIGJvb2w4CiAgICBpbnRjXzAgLy8gMAogICAgZ2V0Yml0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzAwCiAgICAvLyBhc3NlcnQodmVyaWZpZWQsICdmYWlsZWQgdG8gdmVyaWZ5IHN0YWtlIHJlcXVpcmVtZW50cycpCiAgICBhc3NlcnQgLy8gZmFpbGVkIHRvIHZlcmlmeSBzdGFrZSByZXF1aXJlbWVudHMKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMTE6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA1CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX0hFQVJUQkVBVCB8fAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MDUKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWMgOCAvLyAweDBhCiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcwNS03MDYKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGJueiBjcmVhdGVQb29sRW50cmllc19pZl9ib2R5QDEzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA2CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzA2CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBieXRlYyAxMiAvLyAweDE0CiAgICA9PQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcwNS03MDYKICAgIC8vIHRoaXMudHlwZS52YWx1ZSA9PT0gUE9PTF9TVEFLSU5HX1RZUEVfSEVBUlRCRUFUIHx8CiAgICAvLyB0aGlzLnR5cGUudmFsdWUgPT09IFBPT0xfU1RBS0lOR19UWVBFX1NPRlQKICAgIGJ6IGNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMjEKCmNyZWF0ZVBvb2xFbnRyaWVzX2lmX2JvZHlAMTM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzEwCiAgICAvLyBpZiAoZW50cmllc1tpXS5hc3NldCAhPT0gMCkgewogICAgZnJhbWVfZGlnIDIKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZHVwCiAgICBmcmFtZV9idXJ5IDcKICAgIGJ6IGNyZWF0ZVBvb2xFbnRyaWVzX2Vsc2VfYm9keUAxNQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcxMQogICAgLy8gKFtiYWxhbmNlLCBvcHRlZEluXSA9IEFzc2V0SG9sZGluZy5hc3NldEJhbGFuY2UoVHhuLnNlbmRlciwgZW50cmllc1tpXS5hc3NldCkpCiAgICB0eG4gU2VuZGVyCiAgICBmcmFtZV9kaWcgNwogICAgYXNzZXRfaG9sZGluZ19nZXQgQXNzZXRCYWxhbmNlCiAgICBzd2FwCiAgICBmcmFtZV9idXJ5IDQKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMTY6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzE2CiAgICAvLyBhc3NlcnQob3B0ZWRJbiAmJiBiYWxhbmNlID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgbWluIGJhbGFuY2UnKQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAxOQogICAgZnJhbWVfZGlnIDQKICAgIGZyYW1lX2RpZyA2CiAgICA+PQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAxOQogICAgaW50Y18xIC8vIDEKCmNyZWF0ZVBvb2xFbnRyaWVzX2Jvb2xfbWVyZ2VAMjA6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzE2CiAgICAvLyBhc3NlcnQob3B0ZWRJbiAmJiBiYWxhbmNlID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgbWluIGJhbGFuY2UnKQogICAgYXNzZXJ0IC8vIHVzZXIgZG9lcyBub3QgaGF2ZSBtaW4gYmFsYW5jZQoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAyMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjAKICAgIC8vIGlmICh0aGlzLnR5cGUudmFsdWUgIT09IFBPT0xfU1RBS0lOR19UWVBFX05PTkUpIHsKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE4CiAgICAvLyB0eXBlID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGFraW5nVHlwZT4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleVR5cGUgfSkKICAgIGJ5dGVjIDQgLy8gInR5cGUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzIwCiAgICAvLyBpZiAodGhpcy50eXBlLnZhbHVlICE9PSBQT09MX1NUQUtJTkdfVFlQRV9OT05FKSB7CiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnl0ZWNfMyAvLyAweDAwCiAgICAhPQogICAgYnogY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAyNAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyMS03MzAKICAgIC8vIGNvbnN0IHN0YWtlSW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgICAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjIKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvYmFzZS1jb250cmFjdHMvYmFzZS50czoyOQogICAgLy8gYWtpdGFEQU8gPSBHbG9iYWxTdGF0ZTxBcHBsaWNhdGlvbj4oeyBrZXk6IEdsb2JhbFN0YXRlS2V5QWtpdGFEQU8gfSkKICAgIGJ5dGVjXzAgLy8gImFraXRhX2RhbyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjIKICAgIC8vIGFwcElkOiBnZXRBa2l0YUFwcExpc3QodGhpcy5ha2l0YURBTy52YWx1ZSkuc3Rha2luZywKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjQwCiAgICAvLyBjb25zdCBbYXBwTGlzdEJ5dGVzXSA9IG9wLkFwcEdsb2JhbC5nZXRFeEJ5dGVzKGFraXRhREFPLCBCeXRlcyhBa2l0YURBT0dsb2JhbFN0YXRlS2V5c0FraXRhQXBwTGlzdCkpCiAgICBieXRlYyA2IC8vICJhYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzIyCiAgICAvLyBhcHBJZDogZ2V0QWtpdGFBcHBMaXN0KHRoaXMuYWtpdGFEQU8udmFsdWUpLnN0YWtpbmcsCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjQKICAgIC8vIFR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzI2CiAgICAvLyBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIGZyYW1lX2RpZyAyCiAgICBleHRyYWN0IDAgOAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyNwogICAgLy8gdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTgKICAgIC8vIHR5cGUgPSBHbG9iYWxTdGF0ZTxTdGFraW5nUG9vbFN0YWtpbmdUeXBlPih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5VHlwZSB9KQogICAgYnl0ZWMgNCAvLyAidHlwZSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MjcKICAgIC8vIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzI1LTcyOAogICAgLy8gewogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgdHlwZTogdGhpcy50eXBlLnZhbHVlLAogICAgLy8gfSwKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjcyMS03MzAKICAgIC8vIGNvbnN0IHN0YWtlSW5mbyA9IGFiaUNhbGw8dHlwZW9mIFN0YWtpbmcucHJvdG90eXBlLmdldEluZm8+KHsKICAgIC8vICAgYXBwSWQ6IGdldEFraXRhQXBwTGlzdCh0aGlzLmFraXRhREFPLnZhbHVlKS5zdGFraW5nLAogICAgLy8gICBhcmdzOiBbCiAgICAvLyAgICAgVHhuLnNlbmRlciwKICAgIC8vICAgICB7CiAgICAvLyAgICAgICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgICAgIHR5cGU6IHRoaXMudHlwZS52YWx1ZSwKICAgIC8vICAgICB9LAogICAgLy8gICBdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGJ5dGVjIDQwIC8vIG1ldGhvZCAiZ2V0SW5mbyhhZGRyZXNzLCh1aW50NjQsdWludDgpKSh1aW50NjQsdWludDY0LHVpbnQ2NCkiCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgc3dhcAogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uSUQKICAgIHB1c2hpbnQgNiAvLyBhcHBsCiAgICBpdHhuX2ZpZWxkIFR5cGVFbnVtCiAgICBpbnRjXzAgLy8gMAogICAgaXR4bl9maWVsZCBGZWUKICAgIGl0eG5fc3VibWl0CiAgICBpdHhuIExhc3RMb2cKICAgIGR1cAogICAgZXh0cmFjdCA0IDAKICAgIGRpZyAxCiAgICBleHRyYWN0IDAgNAogICAgYnl0ZWNfMiAvLyAweDE1MWY3Yzc1CiAgICA9PQogICAgYXNzZXJ0IC8vIEJ5dGVzIGhhcyB2YWxpZCBwcmVmaXgKICAgIGxlbgogICAgcHVzaGludCAyNCAvLyAyNAogICAgPT0KICAgIGFzc2VydCAvLyBpbnZhbGlkIG51bWJlciBvZiBieXRlcyBmb3IgKHVpbnQ2NCx1aW50NjQsdWludDY0KQogICAgcHVzaGludCA0IC8vIDQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzIKICAgIC8vIGFzc2VydChzdGFrZUluZm8uYW1vdW50ID49IGVudHJpZXNbaV0ucXVhbnRpdHksICd1c2VyIGRvZXMgbm90IGhhdmUgZW5vdWdoIHN0YWtlZCcpCiAgICBleHRyYWN0X3VpbnQ2NAogICAgZnJhbWVfZGlnIDYKICAgID49CiAgICBhc3NlcnQgLy8gdXNlciBkb2VzIG5vdCBoYXZlIGVub3VnaCBzdGFrZWQKCmNyZWF0ZVBvb2xFbnRyaWVzX2FmdGVyX2lmX2Vsc2VAMjQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc2CiAgICAvLyBjb25zdCBpZCA9IHRoaXMuZW50cnlJRC52YWx1ZQogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzAKICAgIC8vIGVudHJ5SUQgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAxLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVudHJ5Q291bnQgfSkKICAgIGJ5dGVjXzEgLy8gImVudHJ5X2NvdW50IgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE3NgogICAgLy8gY29uc3QgaWQgPSB0aGlzLmVudHJ5SUQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc3CiAgICAvLyB0aGlzLmVudHJ5SUQudmFsdWUgKz0gMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzMAogICAgLy8gZW50cnlJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5RW50cnlDb3VudCB9KQogICAgYnl0ZWNfMSAvLyAiZW50cnlfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTc3CiAgICAvLyB0aGlzLmVudHJ5SUQudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzcKICAgIC8vIGFkZHJlc3M6IFR4bi5zZW5kZXIsCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzM2LTc0MgogICAgLy8gdGhpcy5lbnRyaWVzKGVudHJ5SUQpLnZhbHVlID0gewogICAgLy8gICBhZGRyZXNzOiBUeG4uc2VuZGVyLAogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vICAgcXVhbnRpdHk6IGVudHJpZXNbaV0ucXVhbnRpdHksCiAgICAvLyAgIGdhdGVBcmdzOiBjbG9uZShnYXRlQXJncyksCiAgICAvLyAgIGRpc3F1YWxpZmllZDogZmFsc2UKICAgIC8vIH0KICAgIGZyYW1lX2RpZyAyCiAgICBleHRyYWN0IDAgOAogICAgc3dhcAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDYKICAgIGl0b2IKICAgIGNvbmNhdAogICAgcHVzaGJ5dGVzIDB4MDAzMzAwCiAgICBjb25jYXQKICAgIGZyYW1lX2RpZyAtMQogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzM2CiAgICAvLyB0aGlzLmVudHJpZXMoZW50cnlJRCkudmFsdWUgPSB7CiAgICB1bmNvdmVyIDIKICAgIGl0b2IKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjIKICAgIC8vIGVudHJpZXMgPSBCb3hNYXA8dWludDY0LCBFbnRyeURhdGE+KHsga2V5UHJlZml4OiBQb29sQm94UHJlZml4RW50cmllcyB9KQogICAgYnl0ZWMgOSAvLyAiZSIKICAgIGRpZyAxCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MzYtNzQyCiAgICAvLyB0aGlzLmVudHJpZXMoZW50cnlJRCkudmFsdWUgPSB7CiAgICAvLyAgIGFkZHJlc3M6IFR4bi5zZW5kZXIsCiAgICAvLyAgIGFzc2V0OiBlbnRyaWVzW2ldLmFzc2V0LAogICAgLy8gICBxdWFudGl0eTogZW50cmllc1tpXS5xdWFudGl0eSwKICAgIC8vICAgZ2F0ZUFyZ3M6IGNsb25lKGdhdGVBcmdzKSwKICAgIC8vICAgZGlzcXVhbGlmaWVkOiBmYWxzZQogICAgLy8gfQogICAgZHVwCiAgICBib3hfZGVsCiAgICBwb3AKICAgIHVuY292ZXIgMgogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc0NQogICAgLy8gYWRkcmVzczogVHhuLnNlbmRlciwKICAgIHR4biBTZW5kZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NDQtNzQ3CiAgICAvLyBjb25zdCBhS2V5ID0gewogICAgLy8gICBhZGRyZXNzOiBUeG4uc2VuZGVyLAogICAgLy8gICBhc3NldDogZW50cmllc1tpXS5hc3NldCwKICAgIC8vIH0KICAgIHVuY292ZXIgMgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzQ5CiAgICAvLyB0aGlzLmVudHJpZXNCeUFkZHJlc3MoYUtleSkudmFsdWUgPSBlbnRyeUlECiAgICBkdXAKICAgIGV4dHJhY3QgMCAzMgogICAgc3dhcAogICAgZXh0cmFjdCAzMiA4CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjYKICAgIC8vIGVudHJpZXNCeUFkZHJlc3MgPSBCb3hNYXA8RW50cnlLZXksIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhFbnRyaWVzQnlBZGRyZXNzIH0pCiAgICBieXRlYyAzMCAvLyAiYSIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc0OQogICAgLy8gdGhpcy5lbnRyaWVzQnlBZGRyZXNzKGFLZXkpLnZhbHVlID0gZW50cnlJRAogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBieXRlYyAyMyAvLyAidSIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgdHhuIFNlbmRlcgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2NAogICAgLy8gdW5pcXVlcyA9IEJveE1hcDxBY2NvdW50LCB1aW50NjQ+KHsga2V5UHJlZml4OiBQb29sR2xvYmFsU3RhdGVLZXlVbmlxdWVzIH0pCiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3NTAKICAgIC8vIGlmICghdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLmV4aXN0cykgewogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBibnogY3JlYXRlUG9vbEVudHJpZXNfd2hpbGVfdG9wQDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNjQKICAgIC8vIHVuaXF1ZXMgPSBCb3hNYXA8QWNjb3VudCwgdWludDY0Pih7IGtleVByZWZpeDogUG9vbEdsb2JhbFN0YXRlS2V5VW5pcXVlcyB9KQogICAgYnl0ZWMgMjMgLy8gInUiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzUxCiAgICAvLyB0aGlzLnVuaXF1ZXMoVHhuLnNlbmRlcikudmFsdWUgPSBlbnRyaWVzLmxlbmd0aCAgLy8gb3IgYW55IG5vbi16ZXJvIHZhbHVlCiAgICB0eG4gU2VuZGVyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY0CiAgICAvLyB1bmlxdWVzID0gQm94TWFwPEFjY291bnQsIHVpbnQ2ND4oeyBrZXlQcmVmaXg6IFBvb2xHbG9iYWxTdGF0ZUtleVVuaXF1ZXMgfSkKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjc1MQogICAgLy8gdGhpcy51bmlxdWVzKFR4bi5zZW5kZXIpLnZhbHVlID0gZW50cmllcy5sZW5ndGggIC8vIG9yIGFueSBub24temVybyB2YWx1ZQogICAgZnJhbWVfZGlnIDMKICAgIGl0b2IKICAgIGJveF9wdXQKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfd2hpbGVfdG9wQDcKCmNyZWF0ZVBvb2xFbnRyaWVzX2Jvb2xfZmFsc2VAMTk6CiAgICBpbnRjXzAgLy8gMAogICAgYiBjcmVhdGVQb29sRW50cmllc19ib29sX21lcmdlQDIwCgpjcmVhdGVQb29sRW50cmllc19lbHNlX2JvZHlAMTU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NzEzCiAgICAvLyBvcHRlZEluID0gdHJ1ZQogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo3MTQKICAgIC8vIGJhbGFuY2UgPSBUeG4uc2VuZGVyLmJhbGFuY2UKICAgIHR4biBTZW5kZXIKICAgIGFjY3RfcGFyYW1zX2dldCBBY2N0QmFsYW5jZQogICAgc3dhcAogICAgZnJhbWVfYnVyeSA0CiAgICBhc3NlcnQgLy8gYWNjb3VudCBmdW5kZWQKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfaWZfZWxzZUAxNgoKY3JlYXRlUG9vbEVudHJpZXNfYWZ0ZXJfd2hpbGVAMjc6CiAgICBmcmFtZV9kaWcgLTIKICAgIGZyYW1lX2RpZyAtMQogICAgZnJhbWVfYnVyeSAxCiAgICBmcmFtZV9idXJ5IDAKICAgIHJldHN1YgoKY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9mYWxzZUAzOgogICAgaW50Y18wIC8vIDAKICAgIGIgY3JlYXRlUG9vbEVudHJpZXNfYm9vbF9tZXJnZUA0CgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmQocGF5bWVudDogdWludDY0LCByZXdhcmQ6IGJ5dGVzKSAtPiBieXRlczoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODY5CiAgICAvLyBhZGRSZXdhcmQocGF5bWVudDogZ3R4bi5QYXltZW50VHhuLCByZXdhcmQ6IEFkZFJld2FyZFBhcmFtcyk6IHZvaWQgewogICAgcHJvdG8gMiAxCiAgICBpbnRjXzAgLy8gMAogICAgcHVzaGJ5dGVzICIiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcwCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCBFUlJfRk9SQklEREVOKQogICAgdHhuIFNlbmRlcgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNDcKICAgIC8vIGNyZWF0b3IgPSBHbG9iYWxTdGF0ZTxBY2NvdW50Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5Q3JlYXRvciB9KQogICAgYnl0ZWMgMTEgLy8gImNyZWF0b3IiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcwCiAgICAvLyBhc3NlcnQoVHhuLnNlbmRlciA9PT0gdGhpcy5jcmVhdG9yLnZhbHVlLCBFUlJfRk9SQklEREVOKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID09CiAgICBhc3NlcnQgLy8gRm9yYmlkZGVuCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcxCiAgICAvLyBpZiAoVHhuLmFwcGxpY2F0aW9uQXJncygwKSA9PT0gbWV0aG9kU2VsZWN0b3IodGhpcy5hZGRSZXdhcmQpKSB7CiAgICBpbnRjXzAgLy8gMAogICAgdHhuYXMgQXBwbGljYXRpb25BcmdzCiAgICBieXRlYyAzMiAvLyBtZXRob2QgImFkZFJld2FyZChwYXksKHVpbnQ2NCx1aW50OCx1aW50NjQsdWludDY0LHVpbnQ2NCx1aW50NjQpKXZvaWQiCiAgICA9PQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODcyCiAgICAvLyBhc3NlcnQocmV3YXJkLmFzc2V0ID09PSAwLCBFUlJfTk9UX0FMR08pCiAgICBmcmFtZV9kaWcgLTEKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgIQogICAgYXNzZXJ0IC8vIE11c3QgYmUgYW4gQWxnbyBhc3NldAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjYzNwogICAgLy8gdGhpcy5zdGFrZUtleS52YWx1ZS5hZGRyZXNzICE9PSBHbG9iYWwuemVyb0FkZHJlc3MgfHwgcmV3YXJkLmRpc3RyaWJ1dGlvbiAhPT0gRGlzdHJpYnV0aW9uVHlwZVBlcmNlbnRhZ2UsCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEzOQogICAgLy8gc3Rha2VLZXkgPSBHbG9iYWxTdGF0ZTxSb290S2V5Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5U3Rha2VLZXkgfSkKICAgIGJ5dGVjIDI2IC8vICJzdGFrZV9rZXkiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjM3CiAgICAvLyB0aGlzLnN0YWtlS2V5LnZhbHVlLmFkZHJlc3MgIT09IEdsb2JhbC56ZXJvQWRkcmVzcyB8fCByZXdhcmQuZGlzdHJpYnV0aW9uICE9PSBEaXN0cmlidXRpb25UeXBlUGVyY2VudGFnZSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBleHRyYWN0IDAgMzIKICAgIGdsb2JhbCBaZXJvQWRkcmVzcwogICAgIT0KICAgIGJueiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX3RydWVAOQogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDggMQogICAgYnl0ZWMgOCAvLyAweDBhCiAgICAhPQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxMAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDk6CiAgICBpbnRjXzEgLy8gMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAxMToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2MzYtNjM5CiAgICAvLyBhc3NlcnQoCiAgICAvLyAgIHRoaXMuc3Rha2VLZXkudmFsdWUuYWRkcmVzcyAhPT0gR2xvYmFsLnplcm9BZGRyZXNzIHx8IHJld2FyZC5kaXN0cmlidXRpb24gIT09IERpc3RyaWJ1dGlvblR5cGVQZXJjZW50YWdlLAogICAgLy8gICBFUlJfU1RBS0VfS0VZX1JFUVVJUkVECiAgICAvLyApCiAgICBhc3NlcnQgLy8gU3Rha2Uga2V5IHJlcXVpcmVkCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQyCiAgICAvLyBpZiAocmV3YXJkLmRpc3RyaWJ1dGlvbiA9PT0gRGlzdHJpYnV0aW9uVHlwZVNodWZmbGUpIHsKICAgIGZyYW1lX2RpZyAtMQogICAgZXh0cmFjdCA4IDEKICAgIGR1cAogICAgZnJhbWVfYnVyeSAwCiAgICBwdXNoYnl0ZXMgMHgyOAogICAgPT0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2FmdGVyX2lmX2Vsc2VAMTcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDMKICAgIC8vIGFzc2VydChyZXdhcmQucmF0ZSA+IHJld2FyZC53aW5uZXJDb3VudCAmJiByZXdhcmQud2lubmVyQ291bnQgPD0gV2lubmVyQ291bnRDYXAsIEVSUl9SQVRFX01VU1RfQkVfR1JFQVRFUl9USEFOX1dJTk5FUl9DT1VOVCkKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICBmcmFtZV9kaWcgLTEKICAgIHB1c2hpbnQgMzMgLy8gMzMKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgMQogICAgPgogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxNQogICAgZnJhbWVfZGlnIDEKICAgIHB1c2hpbnQgMTAgLy8gMTAKICAgIDw9CiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX2ZhbHNlQDE1CiAgICBpbnRjXzEgLy8gMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDMKICAgIC8vIGFzc2VydChyZXdhcmQucmF0ZSA+IHJld2FyZC53aW5uZXJDb3VudCAmJiByZXdhcmQud2lubmVyQ291bnQgPD0gV2lubmVyQ291bnRDYXAsIEVSUl9SQVRFX01VU1RfQkVfR1JFQVRFUl9USEFOX1dJTk5FUl9DT1VOVCkKICAgIGFzc2VydCAvLyBSYXRlIG11c3QgYmUgZ3JlYXRlciB0aGFuIHdpbm5lciBjb3VudAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYWZ0ZXJfaWZfZWxzZUAxNzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDcKICAgIC8vIGlmIChyZXdhcmQuZGlzdHJpYnV0aW9uID09PSBEaXN0cmlidXRpb25UeXBlRXZlbikgewogICAgZnJhbWVfZGlnIDAKICAgIGJ5dGVjIDEzIC8vIDB4MWUKICAgID09CiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9hZnRlcl9pZl9lbHNlQDIzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQ4CiAgICAvLyBhc3NlcnQodGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwIHx8IHRoaXMubWF4RW50cmllcy52YWx1ZSA8PSByZXdhcmQucmF0ZSwgRVJSX01BWF9FTlRSSUVTX0NBTk5PVF9CRV9HUkVBVEVSX1RIQU5fUkFURSkKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI4CiAgICAvLyBtYXhFbnRyaWVzID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGtleTogUG9vbEdsb2JhbFN0YXRlS2V5TWF4RW50cmllcyB9KQogICAgYnl0ZWMgMTggLy8gIm1heF9lbnRyaWVzIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjY0OAogICAgLy8gYXNzZXJ0KHRoaXMubWF4RW50cmllcy52YWx1ZSA9PT0gMCB8fCB0aGlzLm1heEVudHJpZXMudmFsdWUgPD0gcmV3YXJkLnJhdGUsIEVSUl9NQVhfRU5UUklFU19DQU5OT1RfQkVfR1JFQVRFUl9USEFOX1JBVEUpCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDIwCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyOAogICAgLy8gbWF4RW50cmllcyA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleU1heEVudHJpZXMgfSkKICAgIGJ5dGVjIDE4IC8vICJtYXhfZW50cmllcyIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo2NDgKICAgIC8vIGFzc2VydCh0aGlzLm1heEVudHJpZXMudmFsdWUgPT09IDAgfHwgdGhpcy5tYXhFbnRyaWVzLnZhbHVlIDw9IHJld2FyZC5yYXRlLCBFUlJfTUFYX0VOVFJJRVNfQ0FOTk9UX0JFX0dSRUFURVJfVEhBTl9SQVRFKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGZyYW1lX2RpZyAtMQogICAgcHVzaGludCA5IC8vIDkKICAgIGV4dHJhY3RfdWludDY0CiAgICA8PQogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAyMQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF90cnVlQDIwOgogICAgaW50Y18xIC8vIDEKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfbWVyZ2VAMjI6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjQ4CiAgICAvLyBhc3NlcnQodGhpcy5tYXhFbnRyaWVzLnZhbHVlID09PSAwIHx8IHRoaXMubWF4RW50cmllcy52YWx1ZSA8PSByZXdhcmQucmF0ZSwgRVJSX01BWF9FTlRSSUVTX0NBTk5PVF9CRV9HUkVBVEVSX1RIQU5fUkFURSkKICAgIGFzc2VydCAvLyBNYXggZW50cmllcyBjYW5ub3QgYmUgZ3JlYXRlciB0aGFuIHJhdGUKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2FmdGVyX2lmX2Vsc2VAMjM6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6NjUxCiAgICAvLyBhc3NlcnQocmV3YXJkLnJhdGUgPiAwLCBFUlJfUkFURV9NVVNUX0JFX0dSRUFURVJfVEhBTl9aRVJPKQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDkgLy8gOQogICAgZXh0cmFjdF91aW50NjQKICAgIGR1cAogICAgYXNzZXJ0IC8vIFJhdGUgbXVzdCBiZSBncmVhdGVyIHRoYW4gemVybwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg3Ny04ODYKICAgIC8vIGFzc2VydE1hdGNoKAogICAgLy8gICBwYXltZW50LAogICAgLy8gICB7CiAgICAvLyAgICAgcmVjZWl2ZXI6IEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25BZGRyZXNzLAogICAgLy8gICAgIGFtb3VudDogewogICAgLy8gICAgICAgZ3JlYXRlclRoYW5FcTogdGhpcy5yZXdhcmRzTWJyKHJld2FyZC53aW5uZXJDb3VudCkKICAgIC8vICAgICB9CiAgICAvLyAgIH0sCiAgICAvLyAgIEVSUl9JTlZBTElEX1BBWU1FTlQKICAgIC8vICkKICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgUmVjZWl2ZXIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4ODAKICAgIC8vIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIGdsb2JhbCBDdXJyZW50QXBwbGljYXRpb25BZGRyZXNzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODc3LTg4NgogICAgLy8gYXNzZXJ0TWF0Y2goCiAgICAvLyAgIHBheW1lbnQsCiAgICAvLyAgIHsKICAgIC8vICAgICByZWNlaXZlcjogR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbkFkZHJlc3MsCiAgICAvLyAgICAgYW1vdW50OiB7CiAgICAvLyAgICAgICBncmVhdGVyVGhhbkVxOiB0aGlzLnJld2FyZHNNYnIocmV3YXJkLndpbm5lckNvdW50KQogICAgLy8gICAgIH0KICAgIC8vICAgfSwKICAgIC8vICAgRVJSX0lOVkFMSURfUEFZTUVOVAogICAgLy8gKQogICAgPT0KICAgIGZyYW1lX2RpZyAtMgogICAgZ3R4bnMgQW1vdW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODgyCiAgICAvLyBncmVhdGVyVGhhbkVxOiB0aGlzLnJld2FyZHNNYnIocmV3YXJkLndpbm5lckNvdW50KQogICAgZnJhbWVfZGlnIC0xCiAgICBwdXNoaW50IDMzIC8vIDMzCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9iYXNlLnRzOjkKICAgIC8vIHJldHVybiBNaW5Qb29sUmV3YXJkc01CUiArIChCb3hDb3N0UGVyQnl0ZSAqIHdpbm5pbmdUaWNrZXRzKQogICAgaW50YyA2IC8vIDQwMAogICAgZGlnIDEKICAgICoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4NzctODg2CiAgICAvLyBhc3NlcnRNYXRjaCgKICAgIC8vICAgcGF5bWVudCwKICAgIC8vICAgewogICAgLy8gICAgIHJlY2VpdmVyOiBHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uQWRkcmVzcywKICAgIC8vICAgICBhbW91bnQ6IHsKICAgIC8vICAgICAgIGdyZWF0ZXJUaGFuRXE6IHRoaXMucmV3YXJkc01icihyZXdhcmQud2lubmVyQ291bnQpCiAgICAvLyAgICAgfQogICAgLy8gICB9LAogICAgLy8gICBFUlJfSU5WQUxJRF9QQVlNRU5UCiAgICAvLyApCiAgICB1bmNvdmVyIDIKICAgIDw9CiAgICB1bmNvdmVyIDIKICAgICYmCiAgICBhc3NlcnQgLy8gSW52YWxpZCBwYXltZW50CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBjb25zdCBpZCA9IHRoaXMucmV3YXJkSUQudmFsdWUKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTMyCiAgICAvLyByZXdhcmRJRCA9IEdsb2JhbFN0YXRlPHVpbnQ2ND4oeyBpbml0aWFsVmFsdWU6IDEsIGtleTogUG9vbEdsb2JhbFN0YXRlS2V5UmV3YXJkQ291bnQgfSkKICAgIGJ5dGVjIDI1IC8vICJyZXdhcmRfY291bnQiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgyCiAgICAvLyBjb25zdCBpZCA9IHRoaXMucmV3YXJkSUQudmFsdWUKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTgzCiAgICAvLyB0aGlzLnJld2FyZElELnZhbHVlICs9IDEKICAgIGR1cAogICAgaW50Y18xIC8vIDEKICAgICsKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMzIKICAgIC8vIHJld2FyZElEID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMSwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlSZXdhcmRDb3VudCB9KQogICAgYnl0ZWMgMjUgLy8gInJld2FyZF9jb3VudCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxODMKICAgIC8vIHRoaXMucmV3YXJkSUQudmFsdWUgKz0gMQogICAgc3dhcAogICAgYXBwX2dsb2JhbF9wdXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4OTAKICAgIC8vIC4uLmNsb25lKHJld2FyZCksCiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMCA4CiAgICBmcmFtZV9kaWcgLTEKICAgIGV4dHJhY3QgMTcgOAogICAgZnJhbWVfZGlnIC0xCiAgICBleHRyYWN0IDI1IDgKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4OTMKICAgIC8vIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIGludGNfMCAvLyAwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIHVuY292ZXIgMwogICAgZnJhbWVfZGlnIDAKICAgIGNvbmNhdAogICAgdW5jb3ZlciA2CiAgICBpdG9iCiAgICBjb25jYXQKICAgIHVuY292ZXIgMwogICAgY29uY2F0CiAgICB1bmNvdmVyIDIKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgdW5jb3ZlciAzCiAgICBpdG9iCiAgICBjb25jYXQKICAgIHB1c2hieXRlcyAweDAwN2MwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjkwMAogICAgLy8gcGhhc2U6IERpc2J1cnNlbWVudFBoYXNlSWRsZSwKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg4OS05MDUKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIC4uLmNsb25lKHJld2FyZCksCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlcnM6IDAsCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlOiAwLAogICAgLy8gICB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICAvLyAgIHJhZmZsZUN1cnNvcjogewogICAgLy8gICAgIHRpY2tldDogMCwKICAgIC8vICAgICBzdGFrZTogMCwKICAgIC8vICAgICBkaXNidXJzZWQ6IDAKICAgIC8vICAgfSwKICAgIC8vICAgdnJmRmFpbHVyZUNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBkaXNidXJzZW1lbnRDdXJzb3I6IDEsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudElEOiAwLAogICAgLy8gICBhY3RpdmVEaXNidXJzZW1lbnRSb3VuZFN0YXJ0OiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wOiAwCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo5MDEKICAgIC8vIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIGludGNfMSAvLyAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIGl0b2IKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgZGlnIDEKICAgIGNvbmNhdAogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODkzCiAgICAvLyB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjg4OS05MDUKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICAvLyAgIC4uLmNsb25lKHJld2FyZCksCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlcnM6IDAsCiAgICAvLyAgIHF1YWxpZmllZFN0YWtlOiAwLAogICAgLy8gICB3aW5uaW5nVGlja2V0czogW10gYXMgdWludDY0W10sCiAgICAvLyAgIHJhZmZsZUN1cnNvcjogewogICAgLy8gICAgIHRpY2tldDogMCwKICAgIC8vICAgICBzdGFrZTogMCwKICAgIC8vICAgICBkaXNidXJzZWQ6IDAKICAgIC8vICAgfSwKICAgIC8vICAgdnJmRmFpbHVyZUNvdW50OiAwLAogICAgLy8gICBwaGFzZTogRGlzYnVyc2VtZW50UGhhc2VJZGxlLAogICAgLy8gICBkaXNidXJzZW1lbnRDdXJzb3I6IDEsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudElEOiAwLAogICAgLy8gICBhY3RpdmVEaXNidXJzZW1lbnRSb3VuZFN0YXJ0OiAwLAogICAgLy8gICBsYXN0RGlzYnVyc2VtZW50VGltZXN0YW1wOiAwCiAgICAvLyB9CiAgICBjb25jYXQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo4ODkKICAgIC8vIHRoaXMucmV3YXJkcyhpZCkudmFsdWUgPSB7CiAgICBzd2FwCiAgICBpdG9iCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTY4CiAgICAvLyByZXdhcmRzID0gQm94TWFwPHVpbnQ2NCwgUmV3YXJkPih7IGtleVByZWZpeDogUG9vbEJveFByZWZpeFJld2FyZHMgfSkKICAgIGJ5dGVjIDIxIC8vICJyIgogICAgc3dhcAogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6ODg5LTkwNQogICAgLy8gdGhpcy5yZXdhcmRzKGlkKS52YWx1ZSA9IHsKICAgIC8vICAgLi4uY2xvbmUocmV3YXJkKSwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2VyczogMCwKICAgIC8vICAgcXVhbGlmaWVkU3Rha2U6IDAsCiAgICAvLyAgIHdpbm5pbmdUaWNrZXRzOiBbXSBhcyB1aW50NjRbXSwKICAgIC8vICAgcmFmZmxlQ3Vyc29yOiB7CiAgICAvLyAgICAgdGlja2V0OiAwLAogICAgLy8gICAgIHN0YWtlOiAwLAogICAgLy8gICAgIGRpc2J1cnNlZDogMAogICAgLy8gICB9LAogICAgLy8gICB2cmZGYWlsdXJlQ291bnQ6IDAsCiAgICAvLyAgIHBoYXNlOiBEaXNidXJzZW1lbnRQaGFzZUlkbGUsCiAgICAvLyAgIGRpc2J1cnNlbWVudEN1cnNvcjogMSwKICAgIC8vICAgYWN0aXZlRGlzYnVyc2VtZW50SUQ6IDAsCiAgICAvLyAgIGFjdGl2ZURpc2J1cnNlbWVudFJvdW5kU3RhcnQ6IDAsCiAgICAvLyAgIGxhc3REaXNidXJzZW1lbnRUaW1lc3RhbXA6IDAKICAgIC8vIH0KICAgIGR1cAogICAgYm94X2RlbAogICAgcG9wCiAgICBzd2FwCiAgICBib3hfcHV0CiAgICBmcmFtZV9kaWcgLTEKICAgIGZyYW1lX2J1cnkgMAogICAgcmV0c3ViCgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX2ZhbHNlQDIxOgogICAgaW50Y18wIC8vIDAKICAgIGIgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9tZXJnZUAyMgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5hZGRSZXdhcmRfYm9vbF9mYWxzZUAxNToKICAgIGludGNfMCAvLyAwCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfbWVyZ2VAMTYKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuYWRkUmV3YXJkX2Jvb2xfZmFsc2VAMTA6CiAgICBpbnRjXzAgLy8gMAogICAgYiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmFkZFJld2FyZF9ib29sX21lcmdlQDExCgoKLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGUocmV3YXJkSUQ6IHVpbnQ2NCkgLT4gdm9pZDoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTk2CiAgICAvLyByYWZmbGUocmV3YXJkSUQ6IHVpbnQ2NCk6IHZvaWQgewogICAgcHJvdG8gMSAwCiAgICBpbnRjXzAgLy8gMAogICAgZHVwbiAzCiAgICBwdXNoYnl0ZXMgIiIKICAgIGR1cG4gNwogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjk5NwogICAgLy8gYXNzZXJ0KHRoaXMucmV3YXJkcyhyZXdhcmRJRCkuZXhpc3RzLCAncmV3YXJkIGRvZXMgbm90IGV4aXN0JykKICAgIGZyYW1lX2RpZyAtMQogICAgaXRvYgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjE2OAogICAgLy8gcmV3YXJkcyA9IEJveE1hcDx1aW50NjQsIFJld2FyZD4oeyBrZXlQcmVmaXg6IFBvb2xCb3hQcmVmaXhSZXdhcmRzIH0pCiAgICBieXRlYyAyMSAvLyAiciIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgZHVwbiAyCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OTk3CiAgICAvLyBhc3NlcnQodGhpcy5yZXdhcmRzKHJld2FyZElEKS5leGlzdHMsICdyZXdhcmQgZG9lcyBub3QgZXhpc3QnKQogICAgYm94X2xlbgogICAgYnVyeSAxCiAgICBhc3NlcnQgLy8gcmV3YXJkIGRvZXMgbm90IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwNAogICAgLy8gfSA9IGNsb25lKHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUpCiAgICBkdXAKICAgIHB1c2hpbnQgOTEgLy8gOTEKICAgIGludGNfMSAvLyAxCiAgICBib3hfZXh0cmFjdAogICAgZGlnIDEKICAgIHB1c2hpbnQgMTA4IC8vIDEwOAogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMgogICAgcHVzaGludCA4MyAvLyA4MwogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBkaWcgMwogICAgcHVzaGludCA0MSAvLyA0MQogICAgaW50Y18yIC8vIDgKICAgIGJveF9leHRyYWN0CiAgICBidG9pCiAgICBjb3ZlciA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwNgogICAgLy8gYXNzZXJ0KHBoYXNlID09PSBEaXNidXJzZW1lbnRQaGFzZUFsbG9jYXRpb24sIEVSUl9JTlZBTElEX0RJU0JVUlNFTUVOVF9QSEFTRSkKICAgIHVuY292ZXIgMgogICAgYnl0ZWMgMTIgLy8gMHgxNAogICAgPT0KICAgIGFzc2VydCAvLyBJbnZhbGlkIGRpc2J1cnNlbWVudCBwaGFzZQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMDcKICAgIC8vIGFzc2VydCh3aW5uaW5nVGlja2V0cy5sZW5ndGggPT09IDAsIEVSUl9XSU5OSU5HX1RJQ0tFVFNfQUxSRUFEWV9FWElTVCkKICAgIHVuY292ZXIgMgogICAgcHVzaGludHMgMTI0IDIgLy8gMTI0LCAyCiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgIQogICAgYXNzZXJ0IC8vIFdpbm5pbmcgdGlja2V0cyBhbHJlYWR5IGV4aXN0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAwOQogICAgLy8gY29uc3Qgcm91bmRUb1VzZTogdWludDY0ID0gYWN0aXZlRGlzYnVyc2VtZW50Um91bmRTdGFydCArIDEgKyAoNCAqIHZyZkZhaWx1cmVDb3VudCkKICAgIHN3YXAKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBwdXNoaW50IDQgLy8gNAogICAgdW5jb3ZlciAyCiAgICAqCiAgICArCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxMS0xMDE0CiAgICAvLyBjb25zdCBzZWVkID0gYWJpQ2FsbDx0eXBlb2YgUmFuZG9tbmVzc0JlYWNvbi5wcm90b3R5cGUuZ2V0Pih7CiAgICAvLyAgIGFwcElkOiBnZXRPdGhlckFwcExpc3QoR2xvYmFsLmN1cnJlbnRBcHBsaWNhdGlvbklkKS52cmZCZWFjb24sCiAgICAvLyAgIGFyZ3M6IFtyb3VuZFRvVXNlLCB0aGlzLnNhbHQudmFsdWVdLAogICAgLy8gfSkucmV0dXJuVmFsdWUKICAgIGl0eG5fYmVnaW4KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDEyCiAgICAvLyBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25JZCkudnJmQmVhY29uLAogICAgZ2xvYmFsIEN1cnJlbnRBcHBsaWNhdGlvbklECiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvZnVuY3Rpb25zLnRzOjU1CiAgICAvLyBjb25zdCBbb3RoZXJBcHBMaXN0Qnl0ZXNdID0gb3AuQXBwR2xvYmFsLmdldEV4Qnl0ZXMoYWtpdGFEQU8sIEJ5dGVzKEFraXRhREFPR2xvYmFsU3RhdGVLZXlzT3RoZXJBcHBMaXN0KSkKICAgIGJ5dGVjIDMzIC8vICJvYWwiCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxMgogICAgLy8gYXBwSWQ6IGdldE90aGVyQXBwTGlzdChHbG9iYWwuY3VycmVudEFwcGxpY2F0aW9uSWQpLnZyZkJlYWNvbiwKICAgIGludGNfMCAvLyAwCiAgICBleHRyYWN0X3VpbnQ2NAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMTMKICAgIC8vIGFyZ3M6IFtyb3VuZFRvVXNlLCB0aGlzLnNhbHQudmFsdWVdLAogICAgc3dhcAogICAgaXRvYgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxNTcKICAgIC8vIHNhbHQgPSBHbG9iYWxTdGF0ZTxieXRlczwzMj4+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTYWx0IH0pCiAgICBieXRlYyAzNCAvLyAic2FsdCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDEzCiAgICAvLyBhcmdzOiBbcm91bmRUb1VzZSwgdGhpcy5zYWx0LnZhbHVlXSwKICAgIGFwcF9nbG9iYWxfZ2V0X2V4CiAgICBhc3NlcnQgLy8gY2hlY2sgR2xvYmFsU3RhdGUgZXhpc3RzCiAgICBkdXAKICAgIGxlbgogICAgaXRvYgogICAgZXh0cmFjdCA2IDIKICAgIHN3YXAKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMTEtMTAxNAogICAgLy8gY29uc3Qgc2VlZCA9IGFiaUNhbGw8dHlwZW9mIFJhbmRvbW5lc3NCZWFjb24ucHJvdG90eXBlLmdldD4oewogICAgLy8gICBhcHBJZDogZ2V0T3RoZXJBcHBMaXN0KEdsb2JhbC5jdXJyZW50QXBwbGljYXRpb25JZCkudnJmQmVhY29uLAogICAgLy8gICBhcmdzOiBbcm91bmRUb1VzZSwgdGhpcy5zYWx0LnZhbHVlXSwKICAgIC8vIH0pLnJldHVyblZhbHVlCiAgICBwdXNoYnl0ZXMgMHgxODkzOTJjNSAvLyBtZXRob2QgImdldCh1aW50NjQsYnl0ZVtdKWJ5dGVbXSIKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25BcmdzCiAgICBzd2FwCiAgICBpdHhuX2ZpZWxkIEFwcGxpY2F0aW9uQXJncwogICAgaXR4bl9maWVsZCBBcHBsaWNhdGlvbkFyZ3MKICAgIGl0eG5fZmllbGQgQXBwbGljYXRpb25JRAogICAgcHVzaGludCA2IC8vIGFwcGwKICAgIGl0eG5fZmllbGQgVHlwZUVudW0KICAgIGludGNfMCAvLyAwCiAgICBpdHhuX2ZpZWxkIEZlZQogICAgaXR4bl9zdWJtaXQKICAgIGl0eG4gTGFzdExvZwogICAgZHVwCiAgICBleHRyYWN0IDQgMAogICAgZGlnIDEKICAgIGV4dHJhY3QgMCA0CiAgICBieXRlY18yIC8vIDB4MTUxZjdjNzUKICAgID09CiAgICBhc3NlcnQgLy8gQnl0ZXMgaGFzIHZhbGlkIHByZWZpeAogICAgZHVwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIHB1c2hpbnQgMiAvLyAyCiAgICArCiAgICBzd2FwCiAgICBsZW4KICAgID09CiAgICBhc3NlcnQgLy8gaW52YWxpZCBudW1iZXIgb2YgYnl0ZXMgZm9yIChsZW4rdWludDhbXSkKICAgIGV4dHJhY3QgNiAwCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDE2CiAgICAvLyBpZiAoc2VlZC5sZW5ndGggPT09IDApIHsKICAgIGxlbgogICAgZHVwCiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfaWZfZWxzZUAzCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxNwogICAgLy8gdGhpcy5yZXdhcmRzKHJld2FyZElEKS52YWx1ZS52cmZGYWlsdXJlQ291bnQgKz0gMQogICAgZnJhbWVfZGlnIDEyCiAgICBkdXAKICAgIHB1c2hpbnQgODMgLy8gODMKICAgIGludGNfMiAvLyA4CiAgICBib3hfZXh0cmFjdAogICAgYnRvaQogICAgaW50Y18xIC8vIDEKICAgICsKICAgIGl0b2IKICAgIHB1c2hpbnQgODMgLy8gODMKICAgIHN3YXAKICAgIGJveF9yZXBsYWNlCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAxOAogICAgLy8gcmV0dXJuCiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VAMzoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDIyCiAgICAvLyBjb25zdCBybmdTdGF0ZSA9IHBjZzY0SW5pdChzZWVkLnNsaWNlKDAsIDE2KSkKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9kaWcgMTUKICAgIGR1cAogICAgY292ZXIgMgogICAgPj0KICAgIGludGNfMCAvLyAwCiAgICBkaWcgMgogICAgdW5jb3ZlciAyCiAgICBzZWxlY3QKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGRpZyAyCiAgICA+PQogICAgcHVzaGludCAxNiAvLyAxNgogICAgdW5jb3ZlciAzCiAgICB1bmNvdmVyIDIKICAgIHNlbGVjdAogICAgZnJhbWVfZGlnIDE0CiAgICBjb3ZlciAyCiAgICBzdWJzdHJpbmczCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE2CiAgICAvLyBhc3NlcnQoc2VlZC5sZW5ndGggPT09IDE2KQogICAgZHVwCiAgICBsZW4KICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgID09CiAgICBhc3NlcnQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTkKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjkyCiAgICAvLyBjb25zdCBzdGF0ZSA9IF9fcGNnMzJTdGVwKDAsIGluY3IpCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBkdXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTkKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIGludGMgNyAvLyAxNDQyNjk1MDQwODg4OTYzNDA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBhZGR3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6OTMKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KHN0YXRlLCBpbml0aWFsU3RhdGUpCiAgICB1bmNvdmVyIDIKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE5CiAgICAvLyBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDApLCBwY2dGaXJzdEluY3JlbWVudCksCiAgICBpbnRjIDcgLy8gMTQ0MjY5NTA0MDg4ODk2MzQwNwogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgY292ZXIgMgogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjIwCiAgICAvLyBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDgpLCBwY2dTZWNvbmRJbmNyZW1lbnQpLAogICAgdW5jb3ZlciAyCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6MTgKICAgIC8vIGNvbnN0IFssIGFkZExvd10gPSBvcC5hZGR3KG11bExvdywgaW5jcikKICAgIHN3YXAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MjAKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgOCksIHBjZ1NlY29uZEluY3JlbWVudCksCiAgICBpbnRjIDggLy8gMTQ0MjY5NTA0MDg4ODk2MzQwOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjkzCiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhzdGF0ZSwgaW5pdGlhbFN0YXRlKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE3CiAgICAvLyBjb25zdCBbLCBtdWxMb3ddID0gb3AubXVsdyhzdGF0ZSwgcGNnTXVsdGlwbGllcikKICAgIGludGMgNSAvLyA2MzY0MTM2MjIzODQ2NzkzMDA1CiAgICBtdWx3CiAgICBidXJ5IDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MjAKICAgIC8vIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgOCksIHBjZ1NlY29uZEluY3JlbWVudCksCiAgICBpbnRjIDggLy8gMTQ0MjY5NTA0MDg4ODk2MzQwOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxOAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcobXVsTG93LCBpbmNyKQogICAgYWRkdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjE4LTIxCiAgICAvLyByZXR1cm4gWwogICAgLy8gICAgIF9fcGNnMzJJbml0KG9wLmV4dHJhY3RVaW50NjQoc2VlZCwgMCksIHBjZ0ZpcnN0SW5jcmVtZW50KSwKICAgIC8vICAgICBfX3BjZzMySW5pdChvcC5leHRyYWN0VWludDY0KHNlZWQsIDgpLCBwY2dTZWNvbmRJbmNyZW1lbnQpLAogICAgLy8gXQogICAgc3dhcAogICAgaXRvYgogICAgc3dhcAogICAgaXRvYgogICAgY29uY2F0CiAgICBmcmFtZV9idXJ5IDIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDI2CiAgICAvLyBpZiAodXBwZXJCb3VuZCA8IE1BWF9VSU5UNjQpIHsKICAgIGZyYW1lX2RpZyAxMwogICAgaW50YyAxMiAvLyAxODQ0Njc0NDA3MzcwOTU1MTYxNQogICAgPAogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2lmX2JvZHlANAogICAgZnJhbWVfZGlnIDEzCiAgICBmcmFtZV9idXJ5IDExCgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDU6CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjMwCiAgICAvLyBjb25zdCByZXN1bHQgPSBuZXcgRHluYW1pY0FycmF5PGFyYzQuVWludDY0PigpCiAgICBpbnRjXzAgLy8gMAogICAgaXRvYgogICAgZnJhbWVfYnVyeSAwCiAgICBieXRlYyA1IC8vIDB4MDAwMAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjQyCiAgICAvLyBpZiAodXBwZXJCb3VuZCAhPT0gMCkgewogICAgZnJhbWVfZGlnIDExCiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9lbHNlX2JvZHlAMTQKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NDMKICAgIC8vIGFzc2VydCh1cHBlckJvdW5kID4gMSkKICAgIGZyYW1lX2RpZyAxMQogICAgZHVwCiAgICBpbnRjXzEgLy8gMQogICAgPgogICAgYXNzZXJ0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjQ0CiAgICAvLyBhc3NlcnQobG93ZXJCb3VuZCA8IHVwcGVyQm91bmQgLSAxKQogICAgaW50Y18xIC8vIDEKICAgIC0KICAgIGR1cAogICAgZnJhbWVfYnVyeSA0CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAzMAogICAgLy8gY29uc3Qgcm5nUmVzdWx0ID0gcGNnNjRSYW5kb20ocm5nU3RhdGUsIDEsIHVwcGVyQm91bmQsIE1heEdsb2JhbFN0YXRlVWludDY0QXJyYXkpCiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo0NAogICAgLy8gYXNzZXJ0KGxvd2VyQm91bmQgPCB1cHBlckJvdW5kIC0gMSkKICAgID4KICAgIGFzc2VydAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfaWZfZWxzZUAxNToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzMyLmFsZ28udHM6OAogICAgLy8gY29uc3QgWywgYWRkTG93XSA9IG9wLmFkZHcofnZhbHVlLCAxKQogICAgZnJhbWVfZGlnIDQKICAgIGR1cAogICAgfgogICAgaW50Y18xIC8vIDEKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo1MwogICAgLy8gY29uc3QgdGhyZXNob2xkOiB1aW50NjQgPSBfX3VpbnQ2NFR3b3MoYWJzb2x1dGVCb3VuZCkgJSBhYnNvbHV0ZUJvdW5kCiAgICBzd2FwCiAgICAlCiAgICBmcmFtZV9idXJ5IDEwCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU1CiAgICAvLyBmb3IgKGxldCBpID0gVWludDY0KDApOyBpIDwgbGVuZ3RoOyBpID0gaSArIDEpIHsKICAgIGludGNfMCAvLyAwCiAgICBmcmFtZV9idXJ5IDcKICAgIGZyYW1lX2RpZyAyCiAgICBmcmFtZV9idXJ5IDMKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTUKICAgIC8vIGZvciAobGV0IGkgPSBVaW50NjQoMCk7IGkgPCBsZW5ndGg7IGkgPSBpICsgMSkgewogICAgZnJhbWVfZGlnIDcKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMDMwCiAgICAvLyBjb25zdCBybmdSZXN1bHQgPSBwY2c2NFJhbmRvbShybmdTdGF0ZSwgMSwgdXBwZXJCb3VuZCwgTWF4R2xvYmFsU3RhdGVVaW50NjRBcnJheSkKICAgIHB1c2hpbnQgMTUgLy8gMTUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTUKICAgIC8vIGZvciAobGV0IGkgPSBVaW50NjQoMCk7IGkgPCBsZW5ndGg7IGkgPSBpICsgMSkgewogICAgPAogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfYWZ0ZXJfd2hpbGVAMjIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxODoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6OQogICAgLy8gY29uc3QgbmV3U3RhdGUxID0gX19wY2czMlN0ZXAoc3RhdGVbMF0sIHBjZ0ZpcnN0SW5jcmVtZW50KQogICAgZnJhbWVfZGlnIDMKICAgIGR1cAogICAgaW50Y18wIC8vIDAKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgOQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjkKICAgIC8vIGNvbnN0IG5ld1N0YXRlMSA9IF9fcGNnMzJTdGVwKHN0YXRlWzBdLCBwY2dGaXJzdEluY3JlbWVudCkKICAgIGludGMgNyAvLyAxNDQyNjk1MDQwODg4OTYzNDA3CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICBhZGR3CiAgICBkdXAKICAgIGNvdmVyIDIKICAgIGZyYW1lX2J1cnkgNQogICAgcG9wCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjEwCiAgICAvLyBjb25zdCBuZXdTdGF0ZTIgPSBfX3BjZzMyU3RlcChzdGF0ZVsxXSwgbmV3U3RhdGUxID09PSAwID8gb3Auc2hsKHBjZ1NlY29uZEluY3JlbWVudCwgMSkgOiBwY2dTZWNvbmRJbmNyZW1lbnQpCiAgICBzd2FwCiAgICBpbnRjXzIgLy8gOAogICAgZXh0cmFjdF91aW50NjQKICAgIGZyYW1lX2J1cnkgOAogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3Rlcm5hcnlfZmFsc2VAMjYKICAgIHB1c2hpbnQgMjg4NTM5MDA4MTc3NzkyNjgxOCAvLyAyODg1MzkwMDgxNzc3OTI2ODE4CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV90ZXJuYXJ5X21lcmdlQDI3OgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnMzIuYWxnby50czoxNwogICAgLy8gY29uc3QgWywgbXVsTG93XSA9IG9wLm11bHcoc3RhdGUsIHBjZ011bHRpcGxpZXIpCiAgICBmcmFtZV9kaWcgOAogICAgZHVwCiAgICBpbnRjIDUgLy8gNjM2NDEzNjIyMzg0Njc5MzAwNQogICAgbXVsdwogICAgYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2czMi5hbGdvLnRzOjE4CiAgICAvLyBjb25zdCBbLCBhZGRMb3ddID0gb3AuYWRkdyhtdWxMb3csIGluY3IpCiAgICB1bmNvdmVyIDIKICAgIGFkZHcKICAgIGJ1cnkgMQogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czoxMgogICAgLy8gcmV0dXJuIFtbbmV3U3RhdGUxLCBuZXdTdGF0ZTJdLCBvcC5zaGwoX19wY2czMk91dHB1dChzdGF0ZVswXSksIDMyKSB8IF9fcGNnMzJPdXRwdXQoc3RhdGVbMV0pXQogICAgZnJhbWVfZGlnIDUKICAgIGl0b2IKICAgIHN3YXAKICAgIGl0b2IKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDkKICAgIGNhbGxzdWIgX19wY2czMk91dHB1dAogICAgcHVzaGludCAzMiAvLyAzMgogICAgc2hsCiAgICB1bmNvdmVyIDIKICAgIGNhbGxzdWIgX19wY2czMk91dHB1dAogICAgfAogICAgaXRvYgogICAgY29uY2F0CiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU3CiAgICAvLyBjb25zdCBbbmV3U3RhdGUsIGNhbmRpZGF0ZV0gPSBfX3BjZzY0VW5ib3VuZGVkUmFuZG9tKHN0YXRlKQogICAgZHVwCiAgICBleHRyYWN0IDAgMTYKICAgIHN3YXAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDY0CiAgICBkdXAKICAgIGZyYW1lX2J1cnkgNgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo1OQogICAgLy8gaWYgKGNhbmRpZGF0ZSA+PSB0aHJlc2hvbGQpIHsKICAgIGZyYW1lX2RpZyAxMAogICAgPj0KICAgIGJ6IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VAMjAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NjAKICAgIC8vIHJlc3VsdC5wdXNoKG5ldyBhcmM0LlVpbnQ2NCgoY2FuZGlkYXRlICUgYWJzb2x1dGVCb3VuZCkgKyBsb3dlckJvdW5kKSkKICAgIGZyYW1lX2RpZyA2CiAgICBmcmFtZV9kaWcgNAogICAgJQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzAKICAgIC8vIGNvbnN0IHJuZ1Jlc3VsdCA9IHBjZzY0UmFuZG9tKHJuZ1N0YXRlLCAxLCB1cHBlckJvdW5kLCBNYXhHbG9iYWxTdGF0ZVVpbnQ2NEFycmF5KQogICAgaW50Y18xIC8vIDEKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NjAKICAgIC8vIHJlc3VsdC5wdXNoKG5ldyBhcmM0LlVpbnQ2NCgoY2FuZGlkYXRlICUgYWJzb2x1dGVCb3VuZCkgKyBsb3dlckJvdW5kKSkKICAgICsKICAgIGl0b2IKICAgIGZyYW1lX2RpZyAxCiAgICBkdXAKICAgIHVuY292ZXIgMgogICAgY29uY2F0IC8vIG9uIGVycm9yOiBtYXggYXJyYXkgbGVuZ3RoIGV4Y2VlZGVkCiAgICBzd2FwCiAgICBpbnRjXzAgLy8gMAogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBpdG9iCiAgICBleHRyYWN0IDYgMgogICAgcmVwbGFjZTIgMAogICAgZnJhbWVfYnVyeSAxCiAgICAvLyBzbWFydF9jb250cmFjdHMvdXRpbHMvdHlwZXMvbGliX3BjZy9wY2c2NC5hbGdvLnRzOjU1CiAgICAvLyBmb3IgKGxldCBpID0gVWludDY0KDApOyBpIDwgbGVuZ3RoOyBpID0gaSArIDEpIHsKICAgIGZyYW1lX2RpZyA3CiAgICBpbnRjXzEgLy8gMQogICAgKwogICAgZnJhbWVfYnVyeSA3CiAgICBmcmFtZV9idXJ5IDMKICAgIGIgc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfd2hpbGVfdG9wQDE2CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDIwOgogICAgZnJhbWVfYnVyeSAzCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3doaWxlX3RvcEAxOAoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5yYWZmbGVfdGVybmFyeV9mYWxzZUAyNjoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6MTAKICAgIC8vIGNvbnN0IG5ld1N0YXRlMiA9IF9fcGNnMzJTdGVwKHN0YXRlWzFdLCBuZXdTdGF0ZTEgPT09IDAgPyBvcC5zaGwocGNnU2Vjb25kSW5jcmVtZW50LCAxKSA6IHBjZ1NlY29uZEluY3JlbWVudCkKICAgIGludGMgOCAvLyAxNDQyNjk1MDQwODg4OTYzNDA5CiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX3Rlcm5hcnlfbWVyZ2VAMjcKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX3doaWxlQDIyOgogICAgLy8gc21hcnRfY29udHJhY3RzL3V0aWxzL3R5cGVzL2xpYl9wY2cvcGNnNjQuYWxnby50czo2NwogICAgLy8gcmV0dXJuIFtzdGF0ZSwgcmVzdWx0XQogICAgZnJhbWVfZGlnIDMKICAgIHB1c2hieXRlcyAweDAwMTIKICAgIGNvbmNhdAogICAgZnJhbWVfZGlnIDEKICAgIGNvbmNhdAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzIKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUud2lubmluZ1RpY2tldHMgPSBkZWNvZGVBcmM0PHVpbnQ2NFtdPihybmdSZXN1bHRbMV0uYnl0ZXMpCiAgICBkdXAKICAgIHB1c2hpbnQgMTYgLy8gMTYKICAgIGV4dHJhY3RfdWludDE2CiAgICBkaWcgMQogICAgbGVuCiAgICBzdWJzdHJpbmczCiAgICBmcmFtZV9kaWcgMTIKICAgIGR1cAogICAgY292ZXIgMgogICAgYm94X2dldAogICAgYXNzZXJ0IC8vIEJveCBtdXN0IGhhdmUgdmFsdWUKICAgIGR1cAogICAgcHVzaGludCA1NyAvLyA1NwogICAgZXh0cmFjdF91aW50MTYKICAgIGludGNfMCAvLyAwCiAgICBzd2FwCiAgICBleHRyYWN0MwogICAgc3dhcAogICAgY29uY2F0CiAgICBkaWcgMQogICAgYm94X2RlbAogICAgcG9wCiAgICBkaWcgMQogICAgc3dhcAogICAgYm94X3B1dAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEwMzMKICAgIC8vIHRoaXMucmV3YXJkcyhyZXdhcmRJRCkudmFsdWUudnJmRmFpbHVyZUNvdW50ID0gMAogICAgcHVzaGludCA4MyAvLyA4MwogICAgZnJhbWVfZGlnIDAKICAgIGJveF9yZXBsYWNlCiAgICByZXRzdWIKCnNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2Vsc2VfYm9keUAxNDoKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy91dGlscy90eXBlcy9saWJfcGNnL3BjZzY0LmFsZ28udHM6NTAKICAgIC8vIGFic29sdXRlQm91bmQgPSBvcC5idG9pKEJ5dGVzKEJpZ1VpbnQoMiAqKiA2NCkgLSBCaWdVaW50KGxvd2VyQm91bmQpKSkKICAgIGludGMgMTIgLy8gMTg0NDY3NDQwNzM3MDk1NTE2MTUKICAgIGZyYW1lX2J1cnkgNAogICAgYiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9hZnRlcl9pZl9lbHNlQDE1CgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLnJhZmZsZV9pZl9ib2R5QDQ6CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTAyNwogICAgLy8gdXBwZXJCb3VuZCArPSAxCiAgICBmcmFtZV9kaWcgMTMKICAgIGludGNfMSAvLyAxCiAgICArCiAgICBmcmFtZV9idXJ5IDExCiAgICBiIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wucmFmZmxlX2FmdGVyX2lmX2Vsc2VANQoKCi8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlKCkgLT4gdWludDY0OgpzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZToKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU2CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNAogICAgLy8gc3RhdHVzID0gR2xvYmFsU3RhdGU8U3Rha2luZ1Bvb2xTdGF0dXM+KHsga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGF0dXMgfSkKICAgIGJ5dGVjIDEwIC8vICJzdGF0dXMiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1NgogICAgLy8gdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIGJ5dGVjXzMgLy8gMHgwMAogICAgIT0KICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU2LTExNTcKICAgIC8vIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIC8vIChHbG9iYWwubGF0ZXN0VGltZXN0YW1wID49IHRoaXMuc3RhcnRUaW1lc3RhbXAudmFsdWUpICYmCiAgICBieiBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6OlN0YWtpbmdQb29sLmlzTGl2ZV9ib29sX2ZhbHNlQDUKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgZ2xvYmFsIExhdGVzdFRpbWVzdGFtcAogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMjQKICAgIC8vIHN0YXJ0VGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlTdGFydFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTUgLy8gInN0YXJ0X3RpbWVzdGFtcCIKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU3CiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgID49CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1Ni0xMTU3CiAgICAvLyB0aGlzLnN0YXR1cy52YWx1ZSAhPT0gUG9vbFN0YXR1c0RyYWZ0ICYmCiAgICAvLyAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgYnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF9mYWxzZUA1CiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1OAogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICBnbG9iYWwgTGF0ZXN0VGltZXN0YW1wCiAgICBpbnRjXzAgLy8gMAogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjEyNgogICAgLy8gZW5kVGltZXN0YW1wID0gR2xvYmFsU3RhdGU8dWludDY0Pih7IGluaXRpYWxWYWx1ZTogMCwga2V5OiBQb29sR2xvYmFsU3RhdGVLZXlFbmRUaW1lc3RhbXAgfSkKICAgIGJ5dGVjIDE2IC8vICJlbmRfdGltZXN0YW1wIgogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTgKICAgIC8vIChHbG9iYWwubGF0ZXN0VGltZXN0YW1wIDw9IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlIHx8IHRoaXMuZW5kVGltZXN0YW1wLnZhbHVlID09PSAwKQogICAgYXBwX2dsb2JhbF9nZXRfZXgKICAgIGFzc2VydCAvLyBjaGVjayBHbG9iYWxTdGF0ZSBleGlzdHMKICAgIDw9CiAgICBibnogc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF90cnVlQDQKICAgIGludGNfMCAvLyAwCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTI2CiAgICAvLyBlbmRUaW1lc3RhbXAgPSBHbG9iYWxTdGF0ZTx1aW50NjQ+KHsgaW5pdGlhbFZhbHVlOiAwLCBrZXk6IFBvb2xHbG9iYWxTdGF0ZUtleUVuZFRpbWVzdGFtcCB9KQogICAgYnl0ZWMgMTYgLy8gImVuZF90aW1lc3RhbXAiCiAgICAvLyBzbWFydF9jb250cmFjdHMvc3Rha2luZy1wb29sL2NvbnRyYWN0LmFsZ28udHM6MTE1OAogICAgLy8gKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICBhcHBfZ2xvYmFsX2dldF9leAogICAgYXNzZXJ0IC8vIGNoZWNrIEdsb2JhbFN0YXRlIGV4aXN0cwogICAgYm56IHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czo6U3Rha2luZ1Bvb2wuaXNMaXZlX2Jvb2xfZmFsc2VANQoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF90cnVlQDQ6CiAgICBpbnRjXzEgLy8gMQogICAgLy8gc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjExNTUtMTE1OQogICAgLy8gcmV0dXJuICgKICAgIC8vICAgdGhpcy5zdGF0dXMudmFsdWUgIT09IFBvb2xTdGF0dXNEcmFmdCAmJgogICAgLy8gICAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA+PSB0aGlzLnN0YXJ0VGltZXN0YW1wLnZhbHVlKSAmJgogICAgLy8gICAoR2xvYmFsLmxhdGVzdFRpbWVzdGFtcCA8PSB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSB8fCB0aGlzLmVuZFRpbWVzdGFtcC52YWx1ZSA9PT0gMCkKICAgIC8vICkKICAgIHJldHN1YgoKc21hcnRfY29udHJhY3RzL3N0YWtpbmctcG9vbC9jb250cmFjdC5hbGdvLnRzOjpTdGFraW5nUG9vbC5pc0xpdmVfYm9vbF9mYWxzZUA1OgogICAgaW50Y18wIC8vIDAKICAgIC8vIHNtYXJ0X2NvbnRyYWN0cy9zdGFraW5nLXBvb2wvY29udHJhY3QuYWxnby50czoxMTU1LTExNTkKICAgIC8vIHJldHVybiAoCiAgICAvLyAgIHRoaXMuc3RhdHVzLnZhbHVlICE9PSBQb29sU3RhdHVzRHJhZnQgJiYKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPj0gdGhpcy5zdGFydFRpbWVzdGFtcC52YWx1ZSkgJiYKICAgIC8vICAgKEdsb2JhbC5sYXRlc3RUaW1lc3RhbXAgPD0gdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgfHwgdGhpcy5lbmRUaW1lc3RhbXAudmFsdWUgPT09IDApCiAgICAvLyApCiAgICByZXRzdWIK\",\"clear\":\"I3ByYWdtYSB2ZXJzaW9uIDExCiNwcmFnbWEgdHlwZXRyYWNrIGZhbHNlCgovLyBAYWxnb3JhbmRmb3VuZGF0aW9uL2FsZ29yYW5kLXR5cGVzY3JpcHQvYmFzZS1jb250cmFjdC5kLnRzOjpCYXNlQ29udHJhY3QuY2xlYXJTdGF0ZVByb2dyYW0oKSAtPiB1aW50NjQ6Cm1haW46CiAgICBwdXNoaW50IDEgLy8gMQogICAgcmV0dXJuCg==\"},\"byteCode\":{\"approval\":\"CyANAAEIXKCNBq3+1eTUhf2oWJADz4Keu+/v3oIU0YKeu+/v3oIU/////w+oiwPUkwH///////////8BJikJYWtpdGFfZGFvC2VudHJ5X2NvdW50BBUffHUBAAR0eXBlAgAAA2FhbAxha2l0YV9lc2Nyb3cBCgFlBnN0YXR1cwdjcmVhdG9yARQBHhJhbGxvd19sYXRlX3NpZ251cHMPc3RhcnRfdGltZXN0YW1wDWVuZF90aW1lc3RhbXAHZ2F0ZV9pZAttYXhfZW50cmllcwGABndhbGxldAFyFGFraXRhX3JveWFsdHlfYW1vdW50AXUQc2lnbnVwX3RpbWVzdGFtcAxyZXdhcmRfY291bnQJc3Rha2Vfa2V5DWFraXRhX3JveWFsdHkFdGl0bGUUbWluaW11bV9zdGFrZV9hbW91bnQBYQx0b3RhbF9zdGFrZWQEcblXrwNvYWwEc2FsdANzYWwDcGFsEmNvbnRyb2xsZWRfYWRkcmVzcxgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJAAAAAAAAAAAABMkGiAkxGEAAGycYImcnDiJnJw8iZycQImcpI2cnGSNnJx8iZ4ICBKuLIToE6pGA3TYaAI4CAM0AwTEZFEQxGEEAqoICBIPxR0gEOU6usicgghQEoAzOxgQYHoKRBP3P3/YEuC7RswSpQY03BPxpnLMEbnayygTNsKxqBLIj010EvFitCQSOCCSEBDP3iAgEWS7jQQSPpKFgBOM3c0IEcXBGSQQerSCpBDPpLJQEhU3t4ASks+eJNhoAjhcCdAMJBH8EmATUBXYF0gXoBvgHBg4rDqUOyQ9BD2sPlw/PD90P+BCeEPcAAREUACNDgASi88VENhoAjgEBFQAxGYEEEjEYEERCEJ8xGYEFEjEYEERCAqGKAQGL/4ESkYv/G4EbkSEJGov/gTuRSpFMHCMeRQGBHxpPAkyQIQkaGYmKAgGL/ichZUiBGFuxgAQ8Gm8zshqL/7IashiBBrIQIrIBs7Q+SVcEAEsBVwAEKhJESSJZgQIITBUSRFcGAEkVSUEAB4sBJBNBAAQijACJiwAXQv/3igQBi/w4GIv9JwZlSIEoWxJBADqL/DgZQAAzi/w4G4EEEkEAKYv8IsIagARDkiZVEkEAGov8I8Iai/4SQQAPi/yBAsIai/8WEkEAAiOJIomKAwEii/6L/3AARQFAABqL/YAOcmV2ZW51ZV9zcGxpdHNlSCJZIwiMAIsATImAAEk2GgFJIlmBAghLARUSRFcCADYaAkkVIxJENhoDSRWBIBJENhoESRWBKBJENhoFSRWBIBJENhoGNhoHSRUkEkQXNhoISRUjEkQiUzYaCUkVJBJEFzYaCkkVJBJEFzYaC0kVJBJEFzYaDEkVJBJEFycKK2cnHE8MZycETwtnJwtPCmeABmZ1bmRlck8JZ4ALbWFya2V0cGxhY2VPCGcnGk8HZycdTwZnJw5PBWcnEU8EZycSTwNnJyIxF2coTwJnJwdMZyIoZURJgAxzdGFraW5nX2ZlZXNlSEwnI2VIgRBbIkxBADsiKGVEIicLZUSxTCcjZUiBEFuABNV0uxCyGrIYshqBBrIQIrIBs7Q+SVcEAExXAAQqEkRJFSQSRBdFAUsBSSRbRQSBEFtFBEkjDUEAF0kjCUsESUsFCU8CC4HoBwoJJxtMZyNDI0L/6DIOMgkSRCInEWVEQQBGsSIoZUQnBmVIgShbIicRZUQWgATfVrAeshqyGrIYgQayECKyAbO0PklXBABMVwAEKhJESRUkEkQXgAlnYXRlX3NpemVMZyNDNhoBSRWBIBJEMQAyCRJEIicLZUQSRCInCmVEKxJAAAsyByInEGVEDUEAECNEsTIJsgkjshAisgGzI0MiQv/tMRYjCUk4ECMSRDYaAUkVJBJEF0kxACInC2VEEkQyEEwiJwdlRHIIRExwAEUBQAAVIihlRCInB2VEcghESwOI/a4yEAsISwJJOAcyChJMOAhPAoHAPggSEESxMgpLAUlOArIRIrISshSBBLIQIrIBsyIoZURJJxRlSExJJyRlSElXCAhMJFtLAicUZUixgASiQD3fshqADgABAAIACHJldl9wb29sshqyGIEGshAisgGztD5JVwQASwFXAAQqEkRJIlmBCQuBAghMFRJEVwYJIltJRCInB2VETEsBEkSxgARYL/OCshpPArIaJxOyGoAKAAhyZXZfcG9vbLIagAoAAQAAAAAAAAAAshonBbIaSwOyGIEGshAisgFyCERPAkxLBIj8yzIQC7YiJwdlRHIIRLIHsggjshAisgG2SwEWTwMWgAIAAUxQgARoNeO8shpMshonE7IashqyGIEGshAisgG2gARsw/YGshqyGIEGshAisgGzI0MxFiMJSTgQIxJENhoBSRWBKRJEiBBsSCNDMRaBAglJOBAjEkQxFiMJSTgQgQQSRDYaAUkVgSkSREsBOBQyChJLAjgRSwIiWxIQTwI4EhBEiBAwSCNDNhoBSRUkEkQXSTYaAkkVJBJEF0w2GgNJFSQSRBdMMQAiJwtlRBJEIicKZUQrEkQyBw1AAA1LAkAAZCInDmVEQQBcI0RLAUEACEsBMgcNQQBJI0RLAUAAE0sCQAA5IicOZURBADEjRDIHRQJJQQALSwGBCghLAQxBABgjRCcYSwNnJw9LAmcnEEsBZycKJwhnI0MiQv/lIkL/zCJC/7QiQv+hMRaBAglJOBAjEkQxFiMJSTgQgQYSRDYaASIoZUQxAIj6u0kxAExAACpJIicRZURJRCIoZURLBklPAk8ETwSI+vFEgQPCGksFSwRPAogNMUYCI0NLASclZUhC/84xFiMJSTgQIxJENhoBJwWIDRJGAiNDNhoBSRUkEkQXiBJQRBYnFUxQRwK9RQFESYFbI7pLAYEZJLoXSwKBdCS6F04DTwKBESS6F04CTCsSRDIHSSInD2VECU8CGAlJMgcTQQC+SwJLAQxBALYjRCInHGVEgAogLSBSZXdhcmRzUDIHSwMIIihlRCcGZUgkW0sCFSEGSwELgeSTAghLAnIIRLGyB7III7IQIrIBthZXBgJPA1AiFk8DFoAEHJ6727IaTwKyGksBshqyGicFshpMshiBBrIQIrIBs7cBPklXBABMVwAEKhJESRUkEkRLBUmBIUsEu0mBKU8Eu0mBWycIuyMWSwElTwK7SYFkTwO7MgYWSwGBbE8CuzIHFoF0TLsjQyJC/0c2GgFJFSQSRBeIDyUjQyJHAoAARxs2GgFJFSQSRBdJNhoCSRUkEkQXTBYnFUxQRwK9RQFESYFbI7pJTwIkI7pMJwgSSUAACEsCJwwSQQbgI0RJQQHQSwNJJSS6F0lOAkUXSSQjukUESYEJJLoXRQ8iJLoXRR0iRRsiRQlLBQgiKWVEDUEACSIpZURLFQlFBUsURRJLFEsFCEsSDUEArksRFicJTFBJRSRJgTIjuiJTTIEoJLoXRQ9AACciJwRlRCsSQQAmgAmAAAAAAAAAAAAiU0EADUsZIwhFGksHSw4IRQhLESMIRRJC/6kiJwRlRCcIEkEAT0siSSKBILpMgSAkurEiKGVEJwZlSCJbgATG/QylshpPArIaTLIaJxOyGrIYgQayECKyAbO0PklXBABMVwAEKhJESRUkEkQXFicTTFBC/4tLEYgJB0L/g0sDSYEhJLoXSxsIFksBgSFPArtJgSkkuhdLCQgWgSlMuyIpZURLFRJBAJ1LA0mBWycMuyMWSwElTwK7gSEkuhdFD0sBJwwSQQBpSw5LDQsiJxtlREkhBA5EHSEEl0UdJxZLHUlOAmdBABtLG0AAGLEiJwdlRHIIREsdsgiyByOyECKyAbMjQyInB2VESXIIREsdSU4CcABFAUSxTHIIREyyEUsdshKyFIEEshAisgGzQv/SIicbZURJIQQOREsNHSEEl0UdQv+XSwNJJSS6F0sGCBYlTLtC/6xLAScIEkEA+UsDSSIkuhdFHUklJLoXSU4CRRdJgWQkuhdFJEmBKSS6F0USgQkkuhdFHksFCCIpZUQNQQAJIillREsVCUUFIicWZURLHUwJRSEnBUUkIkULSxRFEksUSwUISUUKSxINQQBjSxEWJwlMUEmBMiO6IlNLAYEoJLoXRRBMIoEgukUmQAA5Sw1JSxFJTgMMRCEEHU8Cl0khBA5ESyEdIQSXSRZLJkxQSyVJTwJQTCJZIwgWVwYCXABFJUsLCEULSxEjCEUSQv+PSwNJJSS6F0sGCBYlTLtLIUscSyVLDYgIXUgiKWVESwkSQf67SwNJgVsnDbsjFiVMu0L+q0sBJwwSQQD8SwNJgWQkuhdFI0klJLoXRRZJgSEkuhdLAYEJJLoXTwIiJLoXSU4DRR9MSwELIicWZUQJRQoiJxtlREkhBA5ESwEdIQSXCUUgQACnMgpzAERLCA9ESxRLBQgiKWVEDUEACSIpZURLFQlFBScFRSQiRQtLFEUSSxRLBQhJRRJLEg1BAEFLERYnCUxQSYEyI7oiU0wigSC6RSZAACBLHkkWSyZMUEslSU8CUEwiWSMIFlcGAlwARSVLCwhFC0sRIwhFEkL/sUsQSRZLBSVPArtLIksdSyZLDogHYUgiKWVEEkH9wUsDSYFbJw27IxYlTLtC/bEyCksccABIQv9USwEnDRJBAO9LA0mBZCS6F0UjSSUkuhdFFkmBISS6F0UQSSIkuhdJTgJFHoEJJLoXRQ5AALgyCnMARCInFmVESw5MCUlFIg9ESxRLBQgiKWVEDUEACSIpZURLFQlFBUsfSw8KRR0nBUUkIkUeSxRFEksUSwUISUUSSxINQQBBSxEWJwlMUEmBMiO6IlNMIoEgukUmQAAgSxxJFksmTFBLJUlPAlBMIlkjCBZXBgJcAEUlSx4IRR5LESMIRRJC/7FLEEkWSwUlTwK7SyJLHUsmSyGIBmpIIillRBJB/MpLA0mBWycNuyMWJUy7Qvy6MgpLHHAASEL/Q0sBgAEoEkRLA4MCfAK6F0AACEsFiAn5QvyWSwNJgWQkuhdFI0klJLoXRRZJgTEkuhdFCEkiJLoXSU4CRR6BCSS6F0UMQAG9MgpzAEQiJxZlREsMTAlJRSIPREsUSwUIIillRA1BAAkiKWVESxUJRQVLBkABhUsfRR1LA0mDAjsYukkkW0lOA0UPSSJbSU4CRQ6BEFtFGSQLgX4IJLoXRRgnBUUlRRhLFEUTSxRLBQhLEw1BAKlLEhYnCUxQSYEyI7oiU0UVSSKBILpFJkmBICS6F0UcgSgkuhdLGElPAghFGksXDkEBBEsWSxkOQQD8SxNAACBLHBZLJUxQSyRJTwJQTCJZIwgWVwYCXABFJEsVIwhFFksDgwJ8AroXIwlLChJBAJxLBksWE0EAMiMWSwRJTgIlTwK7SYE7Jya7Sb5ISYE5WSJMWCcFUEsBvEi/SyFLG0slSw2IBO1IQvtSSyFLHEslSw2IBN5ISwZLFhJBACtLA0mBWycNuyMWSwElTwK7SYE7Jya7Sb5ISYE5WSJMWCcFUEsBvEi/QvsTSwNJJSS6F0sGCBZLASVPArtLChZLDRZQSxcWUIE7TLtC+vBLBEsTCUUFSwkjCElFCyQLgX4ISwRJTwIkuhdFGCMWJUy7IkUTIkUMIkUZSxgjCEUYSxIjCEUTQv6wSx9LBwpFHUL+dTIKSxxwAEhC/j4iQvkdNhoBSRUkEkQXFicVTFBJvUUBREmBWyO6SwGBZCS6SRdPAicNEkQiKGVEJwZlSCRbsYAEVCSlkbIaTwKyGrIYgQayECKyAbMWgAFkTFAiuUhJgVsruyIWSwGBZEsCu0sBgWxLArsjFksCJU8Cu0sBgSFLAruBKUy7I0M2GgFJFYEgEkQ2GgJJFSQSRBcWUCceTFC+RBeIAoUqTFCwI0MxFiMJSTgQgQYSRDYaAUcCFYEgEkQ2GgJJFSQSRBdMIihlREyI8WNJQABFSwIiKGVEIicRZURLBk8CTwNPA4jxnkAAKiInBGVEJwgTQQAfSwEWSwNMUCceTFC+RBcWJwlMUEmBMiO6IiNUgTJMuyNDSSclZUhC/7U2GgFJFYEgEkQ2GgJJFSQSRBchCgtMJxdMUL1FAUAAAyELCBYqTFCwI0M2GgFJFSQSRBciKGVEIicHZURyCERPAojxczIQI08CCAuBwD4IFipMULAjQyInCmVEKxNBACoyByInGGVEDUEAHzIHIicPZUQMQAAIIicOZURBAAwjKyJPAlQqTFCwI0MiQv/xiAhyKyJPAlQqTFCwI0M2GgFJFYEgEkQnF0xQvUUBKyJPAlQqTFCwI0MiJwplRCInHGVEIicEZUQiJxhlRCInDmVEIicPZUQiJxBlRCInEmVEIillRCMIIicZZUQjCCInH2VEIicaZUQiJx1lRCInEWVEIicLZURLDRUWVwYCTw5QTw6AAgBvUEsBFYFvCExPD1BPDhZQTwwWUCsiTw5UUE8LFlBPChZQTwkWUE8IFlBPBxZQTBZXBgJQTwQWUE8DFlBPAlBMUExQKkxQsCNDNhoBSRUkEkQXMQAiKGVEJxRlSHIIRBJEJwdMZyNDNhoBSSJZgQIISwEVEkRXAgAxACIoZURJJxRlSHIIRE8CEkQnJGVIgRBbMg0SRIAHdmVyc2lvbkxnI0M2GgFJFSQSRBcxACIoZUQnFGVIcghEEkQoTGcjQzYaAUkVJBJEFyEGCxaAGAAAAAAAAGLUAAAAAAAASdQAAAAAAABi1ExQgdQvFlAqTFCwI0OKAQEiRwKAAEcDIicEZUQrE0AACyInBGVEJwgTQQD1I0SL/xYnCUxQSYwCSYEyI7oiU0sBIoEguowASwGBICS6SYwBF4wDTIEoJLoXjARBAAUnJ4wAiSInBGVEJwwSQQBhsSIoZUQnBmVIIluABEhxbxqyGosAshqLAbIashiBBrIQIrIBs7Q+SVcEAEsBVwAEKhJEFYEJEkSBBVtJjAWLBA9BAAqLBRYnE0xQjACJiwJJgTIjuiIjVIEyTLsnJ4wAibEiKGVEJwZlSCJbIicEZUSLAxZMUCcoshqLALIashqyGIEGshAisgGztD5JVwQASwFXAAQqEkQVgRgSRIEEW0mMBosED0H/pYsGFicTTFCMAIkiQv8IigQBIihlRCcGZUgkW4v+IlmB1MUBC0yL/UAANLFJcghEiwCL/wiyCLIHI7IQIrIBtov8FoAEVlas+bIashqL/rIashiBBrIQIrIBs4v+TImxSXIIRIsAsgiyByOyECKyAbZJcghEi/+yEov9shGyFIEEshAisgG2i/wWgAQ8YN+gshqyGov+shqyGIEGshAisgGzQv+2igMCIkcCgABHBIgFQUQiKWVEIwgiJxJlRA5AAAgiJxJlREAB4iNEi/4iWUmMAyEKCycXMQBQvUUBQAADIQsIi/04BzIKEov9OAhPAg8QRCInGmVESVcAIIwASYEgWUsBFVKMASKMBYsFiwMMQQGPi/5XAgCLBUlOAoECC0sBTFlPAiMISYwFiwNLAQlLAxVPAoECC0sETFlPAk1SSYwCJFtJjAYiJx1lRA9EiwAyAxNBAFuxIihlRCcGZUiBSFuLAklXAAgBAUsBgRBZSwIVTwNOAlIjFoAEK/PMWrIaiwCyGosBshpPArIaTLIashqyGIEGshAisgGztD5JVwQATFcABCoSREkVIxJEIlNEIicEZUQnCBJAAAsiJwRlRCcMEkEAIIsCIltJjAdBAMQxAIsHcABMjARBALSLBIsGD0EArCNEIicEZUQrE0EARbEiKGVEJwZlSCJbMQCLAlcACCInBGVEUCcoshpMshqyGrIYgQayECKyAbO0PklXBABLAVcABCoSRBWBGBJEgQRbiwYPRCIpZURJIwgpTGcxAIsCVwAITEsBUIsGFlCAAwAzAFCL/1BPAhYnCUsBUEm8SE8CvzEATwJQSVcAIExXIAhQJx5MUEy/JxcxAFC9RQFA/oUnFzEAUIsDFr9C/nkiQv9RIzEAcwBMjAREQv85i/6L/4wBjACJIkL+G4oCASKAADEAIicLZUQSRCLAGicgEkEABov/IlsURCInGmVEVwAgMgMTQAALi/9XCAEnCBNBAO0jRIv/VwgBSYwAgAEoEkEAG4v/gQlbi/+BIVtJjAENQQDHiwGBCg5BAL8jRIsAJw0SQQAYIicSZURBAA4iJxJlRIv/gQlbDkEAmyNEi/+BCVtJRIv+OAcyChKL/jgIi/+BIVshBksBC08CDk8CEEQiJxllREkjCCcZTGeL/1cACIv/VxEIi/9XGQgiFk8DiwBQTwYWUE8DUE8CUEsBUEsBUE8DFlCAGgB8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAUEsBUCtQIxZQSwFQSwFQTFAnBVBMFicVTFBJvEhMv4v/jACJIkL/YiJC/z4iQv8QigEAIkcDgABHB4v/FicVTFBHAr1FAURJgVsjuksBgWwkuhdLAoFTJLoXSwOBKSS6F04ETwInDBJETwKDAnwCuhcUREwjCIEETwILCLEyCCchZUgiW0wWIiciZURJFRZXBgJMUIAEGJOSxbIaTLIashqyGIEGshAisgGztD5JVwQASwFXAAQqEkRJIlmBAghMFRJEVwYASRVJQAAQiwxJgVMkuhcjCBaBU0y7iSKLD0lOAg8iSwJPAk2BEEsCD4EQTwNPAk2LDk4CUkkVgRASREkiWyIhBR1FAUkhBx5FAU8CHkUBIQUdRQEhBx5OAkhPAiRbTCEIHkUBHkUBIQUdRQEhCB5FAUwWTBZQjAKLDSEMDEAA/YsNjAsiFowAJwWMAYsLQQDliwtJIw1EIwlJjAQjDUSLBEkcIx5FAUwYjAoijAeLAowDiweBDwxBAIyLA0kiW0mMCSEFHUUBIQceSU4CjAVITCRbjAhAAGmBooW89t7fvYUoiwhJIQUdRQFPAh5FAYsFFkwWUIsJiOhxgSCQTwKI6GkZFlBJVwAQTIEQW0mMBosKD0EAJosGiwQYIwgWiwFJTwJQTCJZIwgWVwYCXACMAYsHIwiMB4wDQv92jANC/3khCEL/nIsDgAIAElCLAVBJgRBZSwEVUosMSU4CvkRJgTlZIkxYTFBLAbxISwFMv4FTiwC7iSEMjARC/yKLDSMIjAtC/v4iJwplRCsTQQAgMgciJw9lRA9BABUyByInEGVEDkAACCInEGVEQAACI4kiiQ==\",\"clear\":\"C4EBQw==\"},\"compilerInfo\":{\"compiler\":\"puya\",\"compilerVersion\":{\"major\":5,\"minor\":2,\"patch\":0}},\"templateVariables\":{}}"
+
+// NetworkAppIDs maps base64 genesis hashes to the app ID of the contract on
+// that network, from the spec's networks section.
+var NetworkAppIDs = map[string]uint64{}
+
+// ApprovalProgramHash is the hex SHA-512/256 hash of the embedded approval program.
+const ApprovalProgramHash = "51cdb6444ab791077fb03806f75f21d92fa8d1c5b5a3e73b09c48bf402295dbe"
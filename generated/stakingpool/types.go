@@ -7,32 +7,14 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// StakingPoolMBRData is a generated struct type.
-type StakingPoolMBRData struct {
-	Entries          uint64 `json:"entries"`
-	Uniques          uint64 `json:"uniques"`
-	EntriesByAddress uint64 `json:"entriesByAddress"`
-	Rewards          uint64 `json:"rewards"`
-	Disbursements    uint64 `json:"disbursements"`
-}
-
-// StakingPoolState is a generated struct type.
-type StakingPoolState struct {
-	Status             uint8         `json:"status"`
-	Title              string        `json:"title"`
-	Type               uint8         `json:"type"`
-	SignupTimestamp    uint64        `json:"signupTimestamp"`
-	StartTimestamp     uint64        `json:"startTimestamp"`
-	AllowLateSignups   bool          `json:"allowLateSignups"`
-	EndTimestamp       uint64        `json:"endTimestamp"`
-	MaxEntries         uint64        `json:"maxEntries"`
-	EntryCount         uint64        `json:"entryCount"`
-	RewardCount        uint64        `json:"rewardCount"`
-	TotalStaked        uint64        `json:"totalStaked"`
-	StakeKey           RootKey       `json:"stakeKey"`
-	MinimumStakeAmount uint64        `json:"minimumStakeAmount"`
-	GateID             uint64        `json:"gateID"`
-	Creator            types.Address `json:"creator"`
+// AddRewardParams is a generated struct type.
+type AddRewardParams struct {
+	Asset        uint64 `json:"asset"`
+	Distribution uint8  `json:"distribution"`
+	Rate         uint64 `json:"rate"`
+	Expiration   uint64 `json:"expiration"`
+	Interval     uint64 `json:"interval"`
+	WinnerCount  uint64 `json:"winnerCount"`
 }
 
 // EntryData is a generated struct type.
@@ -50,6 +32,18 @@ type EntryKey struct {
 	Asset   uint64        `json:"asset"`
 }
 
+// FunderInfo is a generated struct type.
+type FunderInfo struct {
+	Account types.Address `json:"account"`
+	Amount  uint64        `json:"amount"`
+}
+
+// ObjectC3416591 is a generated struct type.
+type ObjectC3416591 struct {
+	Valid   bool   `json:"valid"`
+	Balance uint64 `json:"balance"`
+}
+
 // RaffleCursor is a generated struct type.
 type RaffleCursor struct {
 	Ticket    uint64 `json:"ticket"`
@@ -77,210 +71,216 @@ type Reward struct {
 	LastDisbursementTimestamp    uint64       `json:"lastDisbursementTimestamp"`
 }
 
-// FunderInfo is a generated struct type.
-type FunderInfo struct {
-	Account types.Address `json:"account"`
-	Amount  uint64        `json:"amount"`
-}
-
-// ObjectC3416591 is a generated struct type.
-type ObjectC3416591 struct {
-	Valid   bool   `json:"valid"`
-	Balance uint64 `json:"balance"`
-}
-
 // RootKey is a generated struct type.
 type RootKey struct {
 	Address types.Address `json:"address"`
 	Name    string        `json:"name"`
 }
 
-// AddRewardParams is a generated struct type.
-type AddRewardParams struct {
-	Asset        uint64 `json:"asset"`
-	Distribution uint8  `json:"distribution"`
-	Rate         uint64 `json:"rate"`
-	Expiration   uint64 `json:"expiration"`
-	Interval     uint64 `json:"interval"`
-	WinnerCount  uint64 `json:"winnerCount"`
+// StakingPoolMBRData is a generated struct type.
+type StakingPoolMBRData struct {
+	Entries          uint64 `json:"entries"`
+	Uniques          uint64 `json:"uniques"`
+	EntriesByAddress uint64 `json:"entriesByAddress"`
+	Rewards          uint64 `json:"rewards"`
+	Disbursements    uint64 `json:"disbursements"`
+}
+
+// StakingPoolState is a generated struct type.
+type StakingPoolState struct {
+	Status             uint8         `json:"status"`
+	Title              string        `json:"title"`
+	Type               uint8         `json:"type"`
+	SignupTimestamp    uint64        `json:"signupTimestamp"`
+	StartTimestamp     uint64        `json:"startTimestamp"`
+	AllowLateSignups   bool          `json:"allowLateSignups"`
+	EndTimestamp       uint64        `json:"endTimestamp"`
+	MaxEntries         uint64        `json:"maxEntries"`
+	EntryCount         uint64        `json:"entryCount"`
+	RewardCount        uint64        `json:"rewardCount"`
+	TotalStaked        uint64        `json:"totalStaked"`
+	StakeKey           RootKey       `json:"stakeKey"`
+	MinimumStakeAmount uint64        `json:"minimumStakeAmount"`
+	GateID             uint64        `json:"gateID"`
+	Creator            types.Address `json:"creator"`
 }
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Title              string
-	Type               uint8
-	Creator            types.Address
-	Funder             FunderInfo
-	Marketplace        types.Address
-	StakeKey           RootKey
-	MinimumStakeAmount uint64
-	AllowLateSignups   bool
-	GateID             uint64
-	MaxEntries         uint64
-	AkitaDao           uint64
-	AkitaDaoEscrow     uint64
+	Title              string        `json:"title"`
+	Type               uint8         `json:"type"`
+	Creator            types.Address `json:"creator"`
+	Funder             FunderInfo    `json:"funder"`
+	Marketplace        types.Address `json:"marketplace"`
+	StakeKey           RootKey       `json:"stakeKey"`
+	MinimumStakeAmount uint64        `json:"minimumStakeAmount"`
+	AllowLateSignups   bool          `json:"allowLateSignups"`
+	GateID             uint64        `json:"gateID"`
+	MaxEntries         uint64        `json:"maxEntries"`
+	AkitaDao           uint64        `json:"akitaDAO"`
+	AkitaDaoEscrow     uint64        `json:"akitaDAOEscrow"`
 }
 
 // DeleteArgs holds the arguments for the delete method.
 type DeleteArgs struct {
-	Caller types.Address
+	Caller types.Address `json:"caller"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // AddRewardArgs holds the arguments for the addReward method.
 type AddRewardArgs struct {
-	Payment transaction.TransactionWithSigner
-	Reward  AddRewardParams
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Reward  AddRewardParams                   `json:"reward"`
 }
 
 // AddRewardASAArgs holds the arguments for the addRewardAsa method.
 type AddRewardASAArgs struct {
-	Payment   transaction.TransactionWithSigner
-	AssetXfer transaction.TransactionWithSigner
-	Reward    AddRewardParams
+	Payment   transaction.TransactionWithSigner `json:"-"`
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
+	Reward    AddRewardParams                   `json:"reward"`
 }
 
 // FinalizeArgs holds the arguments for the finalize method.
 type FinalizeArgs struct {
-	SignupTimestamp uint64
-	StartTimestamp  uint64
-	EndTimestamp    uint64
+	SignupTimestamp uint64 `json:"signupTimestamp"`
+	StartTimestamp  uint64 `json:"startTimestamp"`
+	EndTimestamp    uint64 `json:"endTimestamp"`
 }
 
 // GatedEnterArgs holds the arguments for the gatedEnter method.
 type GatedEnterArgs struct {
-	Payment transaction.TransactionWithSigner
-	GateTXN transaction.TransactionWithSigner
-	Entries [][]interface{}
+	Payment transaction.TransactionWithSigner    `json:"-"`
+	GateTXN transaction.TransactionWithSigner    `json:"-"`
+	Entries []Tuple3[uint64, uint64, [][32]byte] `json:"entries"`
 }
 
 // EnterArgs holds the arguments for the enter method.
 type EnterArgs struct {
-	Payment transaction.TransactionWithSigner
-	Entries [][]interface{}
+	Payment transaction.TransactionWithSigner    `json:"-"`
+	Entries []Tuple3[uint64, uint64, [][32]byte] `json:"entries"`
 }
 
 // StartDisbursementArgs holds the arguments for the startDisbursement method.
 type StartDisbursementArgs struct {
-	RewardID uint64
+	RewardID uint64 `json:"rewardID"`
 }
 
 // RaffleArgs holds the arguments for the raffle method.
 type RaffleArgs struct {
-	RewardID uint64
+	RewardID uint64 `json:"rewardID"`
 }
 
 // DisburseRewardsArgs holds the arguments for the disburseRewards method.
 type DisburseRewardsArgs struct {
-	RewardID        uint64
-	IterationAmount uint64
+	RewardID        uint64 `json:"rewardID"`
+	IterationAmount uint64 `json:"iterationAmount"`
 }
 
 // FinalizeDistributionArgs holds the arguments for the finalizeDistribution method.
 type FinalizeDistributionArgs struct {
-	RewardID uint64
+	RewardID uint64 `json:"rewardID"`
 }
 
 // CheckArgs holds the arguments for the check method.
 type CheckArgs struct {
-	Address types.Address
-	Asset   uint64
+	Address types.Address `json:"address"`
+	Asset   uint64        `json:"asset"`
 }
 
 // CheckMethodResult holds the result of calling check.
 type CheckMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return ObjectC3416591
+	Return ObjectC3416591 `json:"return"`
 }
 
 // GateCheckArgs holds the arguments for the gateCheck method.
 type GateCheckArgs struct {
-	GateTXN transaction.TransactionWithSigner
-	Address types.Address
-	Asset   uint64
+	GateTXN transaction.TransactionWithSigner `json:"-"`
+	Address types.Address                     `json:"address"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // EnterCostArgs holds the arguments for the enterCost method.
 type EnterCostArgs struct {
-	Address    types.Address
-	EntryCount uint64
+	Address    types.Address `json:"address"`
+	EntryCount uint64        `json:"entryCount"`
 }
 
 // EnterCostMethodResult holds the result of calling enterCost.
 type EnterCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // SignUpsOpenMethodResult holds the result of calling signUpsOpen.
 type SignUpsOpenMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // IsLiveMethodResult holds the result of calling isLive.
 type IsLiveMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // IsEnteredArgs holds the arguments for the isEntered method.
 type IsEnteredArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // IsEnteredMethodResult holds the result of calling isEntered.
 type IsEnteredMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetStateMethodResult holds the result of calling getState.
 type GetStateMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return StakingPoolState
+	Return StakingPoolState `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	WinningTickets uint64
+	WinningTickets uint64 `json:"winningTickets"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return StakingPoolMBRData
+	Return StakingPoolMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
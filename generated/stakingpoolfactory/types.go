@@ -4,6 +4,7 @@ package stakingpoolfactory
 import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
+	stakingpool "github.com/kylebeee/algokit-client-generator-go/generated/stakingpool"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -24,100 +25,112 @@ type StakingPoolMBRData struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	ChildVersion   string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	ChildVersion   string `json:"childVersion"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // SetEscrowArgs holds the arguments for the setEscrow method.
 type SetEscrowArgs struct {
-	Escrow uint64
+	Escrow uint64 `json:"escrow"`
 }
 
 // NewPoolArgs holds the arguments for the newPool method.
 type NewPoolArgs struct {
-	Payment            transaction.TransactionWithSigner
-	Title              string
-	Type               uint8
-	Marketplace        types.Address
-	StakeKey           RootKey
-	MinimumStakeAmount uint64
-	AllowLateSignups   bool
-	GateID             uint64
-	MaxEntries         uint64
+	Payment            transaction.TransactionWithSigner `json:"-"`
+	Title              string                            `json:"title"`
+	Type               uint8                             `json:"type"`
+	Marketplace        types.Address                     `json:"marketplace"`
+	StakeKey           RootKey                           `json:"stakeKey"`
+	MinimumStakeAmount uint64                            `json:"minimumStakeAmount"`
+	AllowLateSignups   bool                              `json:"allowLateSignups"`
+	GateID             uint64                            `json:"gateID"`
+	MaxEntries         uint64                            `json:"maxEntries"`
 }
 
 // NewPoolMethodResult holds the result of calling newPool.
 type NewPoolMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
+
+	childParams algokit.AppClientParams
+}
+
+// Child returns a typed client for the stakingpool app created by newPool,
+// sharing the Algorand client, default sender and signer of the calling client.
+func (r *NewPoolMethodResult) Child() (*stakingpool.Client, error) {
+	params := r.childParams
+	params.AppID = r.Return
+	params.AppSpec = nil
+	params.AppName = ""
+	return stakingpool.NewClientFromSpec(params)
 }
 
 // DeletePoolArgs holds the arguments for the deletePool method.
 type DeletePoolArgs struct {
-	AppID uint64
+	AppID uint64 `json:"appId"`
 }
 
 // NewPoolCostMethodResult holds the result of calling newPoolCost.
 type NewPoolCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	WinningTickets uint64
+	WinningTickets uint64 `json:"winningTickets"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return StakingPoolMBRData
+	Return StakingPoolMBRData `json:"return"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Version string
-	Size    uint64
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -6,21 +6,6 @@ import (
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
-// StakingPoolMBRData is a generated struct type.
-type StakingPoolMBRData struct {
-	Entries          uint64 `json:"entries"`
-	Uniques          uint64 `json:"uniques"`
-	EntriesByAddress uint64 `json:"entriesByAddress"`
-	Rewards          uint64 `json:"rewards"`
-	Disbursements    uint64 `json:"disbursements"`
-}
-
-// RootKey is a generated struct type.
-type RootKey struct {
-	Address types.Address `json:"address"`
-	Name    string        `json:"name"`
-}
-
 // RaffleCursor is a generated struct type.
 type RaffleCursor struct {
 	Ticket    uint64 `json:"ticket"`
@@ -48,78 +33,93 @@ type Reward struct {
 	LastDisbursementTimestamp    uint64       `json:"lastDisbursementTimestamp"`
 }
 
+// RootKey is a generated struct type.
+type RootKey struct {
+	Address types.Address `json:"address"`
+	Name    string        `json:"name"`
+}
+
+// StakingPoolMBRData is a generated struct type.
+type StakingPoolMBRData struct {
+	Entries          uint64 `json:"entries"`
+	Uniques          uint64 `json:"uniques"`
+	EntriesByAddress uint64 `json:"entriesByAddress"`
+	Rewards          uint64 `json:"rewards"`
+	Disbursements    uint64 `json:"disbursements"`
+}
+
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version  string
-	Factory  uint64
-	AkitaDao uint64
+	Version  string `json:"version"`
+	Factory  uint64 `json:"factory"`
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // NewPoolArgs holds the arguments for the newPool method.
 type NewPoolArgs struct {
-	Wallet             uint64
-	RekeyBack          bool
-	Title              string
-	Type               uint8
-	Marketplace        types.Address
-	StakeKey           RootKey
-	MinimumStakeAmount uint64
-	AllowLateSignups   bool
-	GateID             uint64
-	MaxEntries         uint64
+	Wallet             uint64        `json:"wallet"`
+	RekeyBack          bool          `json:"rekeyBack"`
+	Title              string        `json:"title"`
+	Type               uint8         `json:"type"`
+	Marketplace        types.Address `json:"marketplace"`
+	StakeKey           RootKey       `json:"stakeKey"`
+	MinimumStakeAmount uint64        `json:"minimumStakeAmount"`
+	AllowLateSignups   bool          `json:"allowLateSignups"`
+	GateID             uint64        `json:"gateID"`
+	MaxEntries         uint64        `json:"maxEntries"`
 }
 
 // InitPoolArgs holds the arguments for the initPool method.
 type InitPoolArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	PoolID    uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	PoolID    uint64 `json:"poolID"`
 }
 
 // DeletePoolArgs holds the arguments for the deletePool method.
 type DeletePoolArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // AddRewardArgs holds the arguments for the addReward method.
 type AddRewardArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Reward    Reward
-	Amount    uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	Reward    Reward `json:"reward"`
+	Amount    uint64 `json:"amount"`
 }
 
 // FinalizePoolArgs holds the arguments for the finalizePool method.
 type FinalizePoolArgs struct {
-	Wallet          uint64
-	RekeyBack       bool
-	PoolID          uint64
-	SignupTimestamp uint64
-	StartTimestamp  uint64
-	EndTimestamp    uint64
+	Wallet          uint64 `json:"wallet"`
+	RekeyBack       bool   `json:"rekeyBack"`
+	PoolID          uint64 `json:"poolID"`
+	SignupTimestamp uint64 `json:"signupTimestamp"`
+	StartTimestamp  uint64 `json:"startTimestamp"`
+	EndTimestamp    uint64 `json:"endTimestamp"`
 }
 
 // EnterArgs holds the arguments for the enter method.
 type EnterArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	Entries   [][]interface{}
-	Args      [][]byte
+	Wallet    uint64                               `json:"wallet"`
+	RekeyBack bool                                 `json:"rekeyBack"`
+	AppID     uint64                               `json:"appId"`
+	Entries   []Tuple3[uint64, uint64, [][32]byte] `json:"entries"`
+	Args      [][]byte                             `json:"args"`
 }
 
 // MBRArgs holds the arguments for the mbr method.
 type MBRArgs struct {
-	WinningTickets uint64
+	WinningTickets uint64 `json:"winningTickets"`
 }
 
 // MBRMethodResult holds the result of calling mbr.
 type MBRMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return StakingPoolMBRData
+	Return StakingPoolMBRData `json:"return"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -2,26 +2,370 @@
 package subscriptions
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
+// ClientAPI is the set of contract calls provided by Client. Depend on it
+// instead of *Client to substitute a fake in unit tests.
+type ClientAPI interface {
+	// AppID returns the application ID.
+	AppID() uint64
+	// AppAddress returns the application's escrow address.
+	AppAddress() types.Address
+	// SendNewService calls the newService ABI method.
+	SendNewService(ctx context.Context, params algokit.CallParams[NewServiceArgs]) (*NewServiceMethodResult, error)
+	// SendSetServiceDescription calls the setServiceDescription ABI method.
+	SendSetServiceDescription(ctx context.Context, params algokit.CallParams[SetServiceDescriptionArgs]) error
+	// SendActivateService calls the activateService ABI method.
+	SendActivateService(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// SendPauseService calls the pauseService ABI method.
+	SendPauseService(ctx context.Context, params algokit.CallParams[PauseServiceArgs]) error
+	// SendUnpauseService calls the unpauseService ABI method.
+	SendUnpauseService(ctx context.Context, params algokit.CallParams[UnpauseServiceArgs]) error
+	// SendShutdownService calls the shutdownService ABI method.
+	SendShutdownService(ctx context.Context, params algokit.CallParams[ShutdownServiceArgs]) error
+	// SendBlock calls the block ABI method.
+	SendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) error
+	// SendUnblock calls the unblock ABI method.
+	SendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) error
+	// SendGatedSubscribe calls the gatedSubscribe ABI method.
+	SendGatedSubscribe(ctx context.Context, params algokit.CallParams[GatedSubscribeArgs]) (*GatedSubscribeMethodResult, error)
+	// SendSubscribe calls the subscribe ABI method.
+	SendSubscribe(ctx context.Context, params algokit.CallParams[SubscribeArgs]) (*SubscribeMethodResult, error)
+	// SendGatedSubscribeASA calls the gatedSubscribeAsa ABI method.
+	SendGatedSubscribeASA(ctx context.Context, params algokit.CallParams[GatedSubscribeASAArgs]) (*GatedSubscribeASAMethodResult, error)
+	// SendSubscribeASA calls the subscribeAsa ABI method.
+	SendSubscribeASA(ctx context.Context, params algokit.CallParams[SubscribeASAArgs]) (*SubscribeASAMethodResult, error)
+	// SendDeposit calls the deposit ABI method.
+	SendDeposit(ctx context.Context, params algokit.CallParams[DepositArgs]) error
+	// SendDepositASA calls the depositAsa ABI method.
+	SendDepositASA(ctx context.Context, params algokit.CallParams[DepositASAArgs]) error
+	// SendWithdraw calls the withdraw ABI method.
+	SendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) error
+	// SendUnsubscribe calls the unsubscribe ABI method.
+	SendUnsubscribe(ctx context.Context, params algokit.CallParams[UnsubscribeArgs]) error
+	// SendGatedTriggerPayment calls the gatedTriggerPayment ABI method.
+	SendGatedTriggerPayment(ctx context.Context, params algokit.CallParams[GatedTriggerPaymentArgs]) error
+	// SendTriggerPayment calls the triggerPayment ABI method.
+	SendTriggerPayment(ctx context.Context, params algokit.CallParams[TriggerPaymentArgs]) error
+	// SendStreakCheck calls the streakCheck ABI method.
+	SendStreakCheck(ctx context.Context, params algokit.CallParams[StreakCheckArgs]) error
+	// SendSetPasses calls the setPasses ABI method.
+	SendSetPasses(ctx context.Context, params algokit.CallParams[SetPassesArgs]) error
+	// SendTriggerList calls the triggerList ABI method (readonly).
+	SendTriggerList(ctx context.Context, params algokit.CallParams[TriggerListArgs]) (*TriggerListMethodResult, error)
+	// SendIsBlocked calls the isBlocked ABI method (readonly).
+	SendIsBlocked(ctx context.Context, params algokit.CallParams[IsBlockedArgs]) (*IsBlockedMethodResult, error)
+	// SendIsShutdown calls the isShutdown ABI method (readonly).
+	SendIsShutdown(ctx context.Context, params algokit.CallParams[IsShutdownArgs]) (*IsShutdownMethodResult, error)
+	// SendNewServiceCost calls the newServiceCost ABI method (readonly).
+	SendNewServiceCost(ctx context.Context, params algokit.CallParams[NewServiceCostArgs]) (*NewServiceCostMethodResult, error)
+	// SendNewSubscriptionCost calls the newSubscriptionCost ABI method (readonly).
+	SendNewSubscriptionCost(ctx context.Context, params algokit.CallParams[NewSubscriptionCostArgs]) (*NewSubscriptionCostMethodResult, error)
+	// SendBlockCost calls the blockCost ABI method (readonly).
+	SendBlockCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BlockCostMethodResult, error)
+	// SendGetService calls the getService ABI method (readonly).
+	SendGetService(ctx context.Context, params algokit.CallParams[GetServiceArgs]) (*GetServiceMethodResult, error)
+	// SendGetServicesByAddress calls the getServicesByAddress ABI method (readonly).
+	SendGetServicesByAddress(ctx context.Context, params algokit.CallParams[GetServicesByAddressArgs]) (*GetServicesByAddressMethodResult, error)
+	// SendGetSubscription calls the getSubscription ABI method (readonly).
+	SendGetSubscription(ctx context.Context, params algokit.CallParams[GetSubscriptionArgs]) (*GetSubscriptionMethodResult, error)
+	// SendMustGetSubscription calls the mustGetSubscription ABI method (readonly).
+	SendMustGetSubscription(ctx context.Context, params algokit.CallParams[MustGetSubscriptionArgs]) (*MustGetSubscriptionMethodResult, error)
+	// SendGetSubscriptionWithDetails calls the getSubscriptionWithDetails ABI method (readonly).
+	SendGetSubscriptionWithDetails(ctx context.Context, params algokit.CallParams[GetSubscriptionWithDetailsArgs]) (*GetSubscriptionWithDetailsMethodResult, error)
+	// SendIsFirstSubscription calls the isFirstSubscription ABI method (readonly).
+	SendIsFirstSubscription(ctx context.Context, params algokit.CallParams[IsFirstSubscriptionArgs]) (*IsFirstSubscriptionMethodResult, error)
+	// SendGetServiceList calls the getServiceList ABI method (readonly).
+	SendGetServiceList(ctx context.Context, params algokit.CallParams[GetServiceListArgs]) (*GetServiceListMethodResult, error)
+	// SendGetSubscriptionList calls the getSubscriptionList ABI method (readonly).
+	SendGetSubscriptionList(ctx context.Context, params algokit.CallParams[GetSubscriptionListArgs]) (*GetSubscriptionListMethodResult, error)
+	// SendOptIn calls the optIn ABI method.
+	SendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) error
+	// SendOptInCost calls the optInCost ABI method (readonly).
+	SendOptInCost(ctx context.Context, params algokit.CallParams[OptInCostArgs]) (*OptInCostMethodResult, error)
+	// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method.
+	SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error
+	// SendUpdateAkitaDao calls the updateAkitaDAO ABI method.
+	SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error
+	// SendOpUp calls the opUp ABI method.
+	SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error
+	// GetGlobalState reads the app's global state.
+	GetGlobalState(ctx context.Context) (*GlobalState, error)
+	// GetBoxMapBlocks reads the value for key in the blocks box map.
+	GetBoxMapBlocks(ctx context.Context, key BlockListKey) ([]byte, error)
+	// GetBoxMapPasses reads the value for key in the passes box map.
+	GetBoxMapPasses(ctx context.Context, key SubscriptionKey) ([]types.Address, error)
+	// GetBoxMapServices reads the value for key in the services box map.
+	GetBoxMapServices(ctx context.Context, key ServicesKey) (Service, error)
+	// GetBoxMapServiceslist reads the value for key in the serviceslist box map.
+	GetBoxMapServiceslist(ctx context.Context, key types.Address) (uint64, error)
+	// GetBoxMapSubscriptions reads the value for key in the subscriptions box map.
+	GetBoxMapSubscriptions(ctx context.Context, key SubscriptionKey) (SubscriptionInfo, error)
+	// GetBoxMapSubscriptionslist reads the value for key in the subscriptionslist box map.
+	GetBoxMapSubscriptionslist(ctx context.Context, key types.Address) (uint64, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
+
 // Client is a typed client for the Subscriptions smart contract.
+//
+// A Client may be shared by many goroutines. It keeps no per-call state:
+// each Send{Method} call builds its own group with a Composer.
+// Composers are not safe for concurrent use; create one per group with
+// NewGroup.
 type Client struct {
 	AppClient *algokit.AppClient
+
+	opts   ClientOptions
+	params algokit.AppClientParams // shared with child app clients
+	appID  uint64                  // for a client without an AppClient
 }
 
-// NewClient creates a new typed client wrapping an existing AppClient.
-func NewClient(appClient *algokit.AppClient) *Client {
-	return &Client{AppClient: appClient}
+// ClientOptions are defaults for every call sent through a Client and its
+// Composers. Fields set in a call's params take precedence.
+type ClientOptions struct {
+	// Sender and Signer are used when a call sets no sender. Signer is also
+	// used when a call's sender is Sender but it sets no signer.
+	Sender types.Address
+	Signer transaction.TransactionSigner
+
+	// ExtraFee and StaticFee are used when a call sets neither.
+	ExtraFee  uint64
+	StaticFee uint64
+
+	// NotePrefix is prepended to every call's note, unless already there.
+	NotePrefix []byte
+
+	// ValidityWindow is the number of rounds transactions stay valid for,
+	// at most maxValidityWindow. Zero uses the suggested params default.
+	ValidityWindow uint64
+
+	// Algod sends the calls. It is needed by clients created with NewClient
+	// and set by NewClientWithAlgod; others use the algod client of their
+	// AppClientParams.Algorand.
+	Algod *algod.Client
 }
 
-// NewClientFromSpec creates a new typed client from AppClientParams.
-func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
+// maxValidityWindow is the most rounds a transaction can stay valid for.
+const maxValidityWindow = 1000
+
+// withDefaults fills the fields params leaves unset from opts. Applying it
+// twice gives the same params.
+func withDefaults[T any](opts ClientOptions, params algokit.CallParams[T]) (algokit.CallParams[T], error) {
+	if opts.ValidityWindow > maxValidityWindow {
+		return params, fmt.Errorf("ValidityWindow %d is more than %d rounds", opts.ValidityWindow, maxValidityWindow)
+	}
+	if params.Signer == nil && (params.Sender.IsZero() || params.Sender == opts.Sender) {
+		params.Signer = opts.Signer
+	}
+	if params.Sender.IsZero() {
+		params.Sender = opts.Sender
+	}
+	if params.ExtraFee == 0 && params.StaticFee == 0 {
+		params.ExtraFee, params.StaticFee = opts.ExtraFee, opts.StaticFee
+	}
+	if len(opts.NotePrefix) > 0 && !bytes.HasPrefix(params.Note, opts.NotePrefix) {
+		params.Note = append(append([]byte(nil), opts.NotePrefix...), params.Note...)
+	}
+	return params, nil
+}
+
+// optionalParams returns the params passed to a method without args, if any.
+func optionalParams(opt []algokit.CallParams[struct{}]) algokit.CallParams[struct{}] {
+	if len(opt) > 0 {
+		return opt[0]
+	}
+	return algokit.CallParams[struct{}]{}
+}
+
+// defaultWaitRounds is the number of rounds Send{Method} and Composer.Send
+// wait for confirmation when ctx has no deadline.
+const defaultWaitRounds = 5
+
+// roundTime is the approximate time between rounds, used to turn a ctx
+// deadline into a number of rounds to wait.
+const roundTime = 3 * time.Second
+
+var (
+	// ErrNotConfirmed matches a ConfirmationError for a transaction that was
+	// submitted, or may have been, but was not seen confirmed before ctx
+	// ended. It can still be confirmed until its last valid round, so
+	// building and sending the call again may execute it twice.
+	ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+	// ErrRejected matches a ConfirmationError for a transaction that will
+	// never be confirmed: the network rejected it or its last valid round
+	// passed. Sending the call again is safe. algod reports rejections as
+	// text, so an error it words differently is left unclassified rather
+	// than matching ErrRejected.
+	ErrRejected = errors.New("transaction rejected")
+)
+
+// ConfirmationError is returned when a call is not known to be confirmed.
+// Use errors.Is with ErrNotConfirmed or ErrRejected to tell the cases apart.
+type ConfirmationError struct {
+	TxID      string // "" if the transaction ID is not known
+	Rejected  bool
+	Reason    string // the pool error, or why waiting stopped
+	LastValid uint64 // 0 if not known
+	LastRound uint64 // the last round seen while waiting, 0 if not known
+	Err       error  // the underlying error, if any
+}
+
+func (e *ConfirmationError) Error() string {
+	txn := "transaction"
+	if e.TxID != "" {
+		txn += " " + e.TxID
+	}
+	if e.Rejected {
+		return txn + " rejected: " + e.Reason
+	}
+	return txn + " not yet confirmed: " + e.Reason
+}
+
+// Is matches ErrRejected or ErrNotConfirmed.
+func (e *ConfirmationError) Is(target error) bool {
+	if e.Rejected {
+		return target == ErrRejected
+	}
+	return target == ErrNotConfirmed
+}
+
+func (e *ConfirmationError) Unwrap() error {
+	return e.Err
+}
+
+// Expired reports whether the transaction was rejected because its last
+// valid round passed before it was confirmed.
+func (e *ConfirmationError) Expired() bool {
+	return e.Rejected && e.LastValid > 0 && e.LastRound > e.LastValid
+}
+
+// validityPassed reports whether err shows that a transaction was not
+// accepted because its validity window passed, before or after submission.
+func validityPassed(err error) bool {
+	var confErr *ConfirmationError
+	if !errors.As(err, &confErr) {
+		return false
+	}
+	return confErr.Expired() || confErr.Rejected && strings.Contains(confErr.Reason, "txn dead")
+}
+
+// evalFailures are parts of the algod errors for a transaction that failed
+// evaluation and so was not added to the pool. Other errors algod answers
+// with HTTP 400, such as "transaction already in ledger", may be for a
+// transaction that was accepted. algod reports these as text only, so
+// matching them is best-effort; errors that match none are returned as is.
+var evalFailures = []string{"logic eval error", "rejected by logic", "overspend", "fee too small", "txn dead"}
+
+// poolRejection starts the error the SDK returns when waiting for a
+// transaction that left the pool with an error.
+const poolRejection = "Transaction rejected: "
+
+// sendError classifies an error from sending atc. After the SDK composer
+// marked the group submitted, any error is from waiting for it, so the group
+// was not seen confirmed unless the SDK reported a pool error. Before that,
+// the error is classified by confirmationError.
+func sendError(ctx context.Context, atc *transaction.AtomicTransactionComposer, lastValid uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+	var txID string
+	if txIDs := groupTxIDs(atc); len(txIDs) > 0 {
+		txID = txIDs[0]
+	}
+	if atc.GetStatus() < transaction.SUBMITTED {
+		err = confirmationError(ctx, txID, err)
+		if confErr, ok := err.(*ConfirmationError); ok {
+			confErr.LastValid = lastValid
+		}
+		return err
+	}
+	msg := err.Error()
+	if reason, ok := strings.CutPrefix(msg, poolRejection); ok {
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: reason, LastValid: lastValid, Err: err}
+	}
+	return &ConfirmationError{TxID: txID, Reason: msg, LastValid: lastValid, Err: err}
+}
+
+// confirmationError classifies an error from submitting a transaction, which
+// algod may or may not have accepted, by matching evalFailures.
+func confirmationError(ctx context.Context, txID string, err error) error {
+	var confErr *ConfirmationError
+	if err == nil || errors.As(err, &confErr) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case isEvalFailure(msg):
+		return &ConfirmationError{TxID: txID, Rejected: true, Reason: msg, Err: err}
+	case ctx.Err() != nil:
+		return &ConfirmationError{TxID: txID, Reason: msg, Err: err}
+	}
+	return err
+}
+
+// isEvalFailure reports whether msg is an algod error for a transaction that
+// failed evaluation.
+func isEvalFailure(msg string) bool {
+	if strings.Contains(msg, "already in ledger") {
+		return false
+	}
+	for _, failure := range evalFailures {
+		if strings.Contains(msg, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitRounds returns the number of rounds to wait for confirmation before
+// the ctx deadline, or defaultWaitRounds if ctx has none.
+func waitRounds(ctx context.Context) uint64 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultWaitRounds
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 1
+	}
+	return uint64(remaining/roundTime) + 1
+}
+
+// clientOptions returns the options passed to a constructor, if any.
+func clientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return ClientOptions{}
+}
+
+// NewClient creates a new typed client wrapping an existing AppClient, with
+// optional call defaults. Calls need ClientOptions.Algod to be set.
+func NewClient(appClient *algokit.AppClient, opts ...ClientOptions) *Client {
+	return &Client{AppClient: appClient, opts: clientOptions(opts)}
+}
+
+// NewClientFromSpec creates a new typed client from AppClientParams, with
+// optional call defaults.
+func NewClientFromSpec(params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
 	if params.AppSpec == nil {
 		spec, err := GetAppSpec()
 		if err != nil {
@@ -33,7 +377,46 @@ func NewClientFromSpec(params algokit.AppClientParams) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{AppClient: appClient}, nil
+	return &Client{AppClient: appClient, opts: clientOptions(opts), params: params}, nil
+}
+
+// NewClientWithAlgod creates a client for app appID that sends its calls
+// and reads state through algodClient, without an AppClient. It sets
+// ClientOptions.Algod.
+func NewClientWithAlgod(algodClient *algod.Client, appID uint64, opts ...ClientOptions) *Client {
+	o := clientOptions(opts)
+	o.Algod = algodClient
+	return &Client{opts: o, appID: appID}
+}
+
+// NewClientForNetwork creates a client for the app deployed on the network
+// params.Algorand is connected to, using NetworkAppID. params.AppID is
+// ignored.
+func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {
+	if params.Algorand == nil {
+		return nil, fmt.Errorf("NewClientForNetwork needs params.Algorand")
+	}
+	appID, err := NetworkAppID(ctx, params.Algorand.Algod())
+	if err != nil {
+		return nil, err
+	}
+	params.AppID = appID
+	return NewClientFromSpec(params, opts...)
+}
+
+// NetworkAppID returns the app ID in NetworkAppIDs for the network
+// algodClient is connected to, found by its genesis hash.
+func NetworkAppID(ctx context.Context, algodClient *algod.Client) (uint64, error) {
+	version, err := algodClient.Versions().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get genesis hash: %w", err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(version.GenesisHash)
+	appID, ok := NetworkAppIDs[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("Subscriptions has no app ID for network %s (genesis hash %s)", version.GenesisID, genesisHash)
+	}
+	return appID, nil
 }
 
 // GetAppSpec returns the parsed ARC-56 app specification for this contract.
@@ -43,45 +426,85 @@ func GetAppSpec() (*algokit.Arc56Contract, error) {
 
 // AppID returns the application ID.
 func (c *Client) AppID() uint64 {
+	if c.AppClient == nil {
+		return c.appID
+	}
 	return c.AppClient.AppID()
 }
 
 // AppAddress returns the application's escrow address.
 func (c *Client) AppAddress() types.Address {
+	if c.AppClient == nil {
+		return crypto.GetApplicationAddress(c.appID)
+	}
 	return c.AppClient.AppAddress()
 }
 
+// algodClient returns ClientOptions.Algod, or the algod client of a client
+// created by NewClientFromSpec or a Factory.
+func (c *Client) algodClient(what string) (*algod.Client, error) {
+	if c.opts.Algod != nil {
+		return c.opts.Algod, nil
+	}
+	if c.params.Algorand == nil {
+		return nil, fmt.Errorf("%s needs ClientOptions.Algod or a client created by NewClientFromSpec or a Factory", what)
+	}
+	return c.params.Algorand.Algod(), nil
+}
+
+// ErrProgramMismatch is returned by VerifyProgram when the app runs a different approval program.
+var ErrProgramMismatch = errors.New("approval program does not match Subscriptions")
+
+// VerifyProgram checks that the app's on-chain approval program hashes to
+// ApprovalProgramHash, so calls are not sent to a different contract. The
+// client must have been created by NewClientFromSpec or a Factory.
+func (c *Client) VerifyProgram(ctx context.Context) error {
+	client, err := c.algodClient("VerifyProgram")
+	if err != nil {
+		return err
+	}
+	app, err := client.GetApplicationByID(c.AppID()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up app %d: %w", c.AppID(), err)
+	}
+	hash := sha512.Sum512_256(app.Params.ApprovalProgram)
+	if got := hex.EncodeToString(hash[:]); got != ApprovalProgramHash {
+		return fmt.Errorf("%w: app %d has approval program hash %s, want %s", ErrProgramMismatch, c.AppID(), got, ApprovalProgramHash)
+	}
+	return nil
+}
+
 // NewGroup creates a new Composer for building atomic transaction groups.
 func (c *Client) NewGroup() *Composer {
-	return &Composer{
-		client:   c,
-		composer: c.AppClient.NewComposer(),
-	}
+	return &Composer{client: c}
 }
 
-// SendNewService calls the newService ABI method and waits for confirmation.
+// SendNewService calls the newService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // newService creates a new service for a merchant
 func (c *Client) SendNewService(ctx context.Context, params algokit.CallParams[NewServiceArgs]) (*NewServiceMethodResult, error) {
-	methodArgs := argsToInterfaceNewService(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendNewService(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "newService",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendNewService(ctx context.Context, params algokit.CallParams[NewServiceArgs]) (*NewServiceMethodResult, error) {
+	comp, err := c.NewGroup().NewService(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*NewServiceMethodResult)
+	return typedResult, nil
+}
 
+// decodeNewServiceResult decodes the return value of a newService call.
+func (c *Client) decodeNewServiceResult(result *algokit.SendAppTransactionResult) (*NewServiceMethodResult, error) {
 	typedResult := &NewServiceMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -95,210 +518,210 @@ func (c *Client) SendNewService(ctx context.Context, params algokit.CallParams[N
 	return typedResult, nil
 }
 
-// SendSetServiceDescription calls the setServiceDescription ABI method and waits for confirmation.
+// SendSetServiceDescription calls the setServiceDescription ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSetServiceDescription(ctx context.Context, params algokit.CallParams[SetServiceDescriptionArgs]) error {
-	methodArgs := argsToInterfaceSetServiceDescription(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setServiceDescription",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetServiceDescription(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetServiceDescription(ctx context.Context, params algokit.CallParams[SetServiceDescriptionArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetServiceDescription(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendActivateService calls the activateService ABI method and waits for confirmation.
+// SendActivateService calls the activateService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // activateService activates an service for a merchant
-func (c *Client) SendActivateService(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "activateService",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) SendActivateService(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendActivateService(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendActivateService(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ActivateService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendPauseService calls the pauseService ABI method and waits for confirmation.
+// SendPauseService calls the pauseService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // pauseService pauses a service for a merchant
 // it does not shutdown pre-existing subscriptions
 // it simply prevents new subscriptions from being created
 // for a specific service
 func (c *Client) SendPauseService(ctx context.Context, params algokit.CallParams[PauseServiceArgs]) error {
-	methodArgs := argsToInterfacePauseService(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "pauseService",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendPauseService(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendPauseService(ctx context.Context, params algokit.CallParams[PauseServiceArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().PauseService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnpauseService calls the unpauseService ABI method and waits for confirmation.
+// SendUnpauseService calls the unpauseService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // unpauseService activates an service for a merchant
 func (c *Client) SendUnpauseService(ctx context.Context, params algokit.CallParams[UnpauseServiceArgs]) error {
-	methodArgs := argsToInterfaceUnpauseService(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unpauseService",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnpauseService(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnpauseService(ctx context.Context, params algokit.CallParams[UnpauseServiceArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UnpauseService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendShutdownService calls the shutdownService ABI method and waits for confirmation.
+// SendShutdownService calls the shutdownService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // shutdownService permanently shuts down an service for a merchant
 // it also shutsdown pre-existing subscriptions
 func (c *Client) SendShutdownService(ctx context.Context, params algokit.CallParams[ShutdownServiceArgs]) error {
-	methodArgs := argsToInterfaceShutdownService(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "shutdownService",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendShutdownService(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendShutdownService(ctx context.Context, params algokit.CallParams[ShutdownServiceArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().ShutdownService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendBlock calls the block ABI method and waits for confirmation.
+// SendBlock calls the block ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // block blacklists an address for a merchant
 func (c *Client) SendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) error {
-	methodArgs := argsToInterfaceBlock(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "block",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendBlock(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendBlock(ctx context.Context, params algokit.CallParams[BlockArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Block(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnblock calls the unblock ABI method and waits for confirmation.
+// SendUnblock calls the unblock ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // unblock removes an address from a merchants blocks
 func (c *Client) SendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) error {
-	methodArgs := argsToInterfaceUnblock(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unblock",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnblock(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnblock(ctx context.Context, params algokit.CallParams[UnblockArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Unblock(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedSubscribe calls the gatedSubscribe ABI method and waits for confirmation.
+// SendGatedSubscribe calls the gatedSubscribe ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedSubscribe(ctx context.Context, params algokit.CallParams[GatedSubscribeArgs]) (*GatedSubscribeMethodResult, error) {
-	methodArgs := argsToInterfaceGatedSubscribe(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGatedSubscribe(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedSubscribe",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGatedSubscribe(ctx context.Context, params algokit.CallParams[GatedSubscribeArgs]) (*GatedSubscribeMethodResult, error) {
+	comp, err := c.NewGroup().GatedSubscribe(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GatedSubscribeMethodResult)
+	return typedResult, nil
+}
 
+// decodeGatedSubscribeResult decodes the return value of a gatedSubscribe call.
+func (c *Client) decodeGatedSubscribeResult(result *algokit.SendAppTransactionResult) (*GatedSubscribeMethodResult, error) {
 	typedResult := &GatedSubscribeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -312,28 +735,31 @@ func (c *Client) SendGatedSubscribe(ctx context.Context, params algokit.CallPara
 	return typedResult, nil
 }
 
-// SendSubscribe calls the subscribe ABI method and waits for confirmation.
+// SendSubscribe calls the subscribe ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSubscribe(ctx context.Context, params algokit.CallParams[SubscribeArgs]) (*SubscribeMethodResult, error) {
-	methodArgs := argsToInterfaceSubscribe(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSubscribe(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "subscribe",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendSubscribe(ctx context.Context, params algokit.CallParams[SubscribeArgs]) (*SubscribeMethodResult, error) {
+	comp, err := c.NewGroup().Subscribe(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*SubscribeMethodResult)
+	return typedResult, nil
+}
 
+// decodeSubscribeResult decodes the return value of a subscribe call.
+func (c *Client) decodeSubscribeResult(result *algokit.SendAppTransactionResult) (*SubscribeMethodResult, error) {
 	typedResult := &SubscribeMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -347,28 +773,31 @@ func (c *Client) SendSubscribe(ctx context.Context, params algokit.CallParams[Su
 	return typedResult, nil
 }
 
-// SendGatedSubscribeASA calls the gatedSubscribeAsa ABI method and waits for confirmation.
+// SendGatedSubscribeASA calls the gatedSubscribeAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedSubscribeASA(ctx context.Context, params algokit.CallParams[GatedSubscribeASAArgs]) (*GatedSubscribeASAMethodResult, error) {
-	methodArgs := argsToInterfaceGatedSubscribeASA(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGatedSubscribeASA(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedSubscribeAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGatedSubscribeASA(ctx context.Context, params algokit.CallParams[GatedSubscribeASAArgs]) (*GatedSubscribeASAMethodResult, error) {
+	comp, err := c.NewGroup().GatedSubscribeASA(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GatedSubscribeASAMethodResult)
+	return typedResult, nil
+}
 
+// decodeGatedSubscribeASAResult decodes the return value of a gatedSubscribeAsa call.
+func (c *Client) decodeGatedSubscribeASAResult(result *algokit.SendAppTransactionResult) (*GatedSubscribeASAMethodResult, error) {
 	typedResult := &GatedSubscribeASAMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -382,28 +811,31 @@ func (c *Client) SendGatedSubscribeASA(ctx context.Context, params algokit.CallP
 	return typedResult, nil
 }
 
-// SendSubscribeASA calls the subscribeAsa ABI method and waits for confirmation.
+// SendSubscribeASA calls the subscribeAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSubscribeASA(ctx context.Context, params algokit.CallParams[SubscribeASAArgs]) (*SubscribeASAMethodResult, error) {
-	methodArgs := argsToInterfaceSubscribeASA(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendSubscribeASA(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "subscribeAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendSubscribeASA(ctx context.Context, params algokit.CallParams[SubscribeASAArgs]) (*SubscribeASAMethodResult, error) {
+	comp, err := c.NewGroup().SubscribeASA(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*SubscribeASAMethodResult)
+	return typedResult, nil
+}
 
+// decodeSubscribeASAResult decodes the return value of a subscribeAsa call.
+func (c *Client) decodeSubscribeASAResult(result *algokit.SendAppTransactionResult) (*SubscribeASAMethodResult, error) {
 	typedResult := &SubscribeASAMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -417,236 +849,223 @@ func (c *Client) SendSubscribeASA(ctx context.Context, params algokit.CallParams
 	return typedResult, nil
 }
 
-// SendDeposit calls the deposit ABI method and waits for confirmation.
+// SendDeposit calls the deposit ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDeposit(ctx context.Context, params algokit.CallParams[DepositArgs]) error {
-	methodArgs := argsToInterfaceDeposit(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "deposit",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDeposit(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDeposit(ctx context.Context, params algokit.CallParams[DepositArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Deposit(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendDepositASA calls the depositAsa ABI method and waits for confirmation.
+// SendDepositASA calls the depositAsa ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendDepositASA(ctx context.Context, params algokit.CallParams[DepositASAArgs]) error {
-	methodArgs := argsToInterfaceDepositASA(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "depositAsa",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendDepositASA(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendDepositASA(ctx context.Context, params algokit.CallParams[DepositASAArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().DepositASA(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendWithdraw calls the withdraw ABI method and waits for confirmation.
+// SendWithdraw calls the withdraw ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) error {
-	methodArgs := argsToInterfaceWithdraw(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "withdraw",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendWithdraw(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendWithdraw(ctx context.Context, params algokit.CallParams[WithdrawArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Withdraw(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUnsubscribe calls the unsubscribe ABI method and waits for confirmation.
+// SendUnsubscribe calls the unsubscribe ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUnsubscribe(ctx context.Context, params algokit.CallParams[UnsubscribeArgs]) error {
-	methodArgs := argsToInterfaceUnsubscribe(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "unsubscribe",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUnsubscribe(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUnsubscribe(ctx context.Context, params algokit.CallParams[UnsubscribeArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().Unsubscribe(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendGatedTriggerPayment calls the gatedTriggerPayment ABI method and waits for confirmation.
+// SendGatedTriggerPayment calls the gatedTriggerPayment ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGatedTriggerPayment(ctx context.Context, params algokit.CallParams[GatedTriggerPaymentArgs]) error {
-	methodArgs := argsToInterfaceGatedTriggerPayment(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "gatedTriggerPayment",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendGatedTriggerPayment(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendGatedTriggerPayment(ctx context.Context, params algokit.CallParams[GatedTriggerPaymentArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().GatedTriggerPayment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendTriggerPayment calls the triggerPayment ABI method and waits for confirmation.
+// SendTriggerPayment calls the triggerPayment ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendTriggerPayment(ctx context.Context, params algokit.CallParams[TriggerPaymentArgs]) error {
-	methodArgs := argsToInterfaceTriggerPayment(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "triggerPayment",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendTriggerPayment(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendTriggerPayment(ctx context.Context, params algokit.CallParams[TriggerPaymentArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().TriggerPayment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendStreakCheck calls the streakCheck ABI method and waits for confirmation.
+// SendStreakCheck calls the streakCheck ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendStreakCheck(ctx context.Context, params algokit.CallParams[StreakCheckArgs]) error {
-	methodArgs := argsToInterfaceStreakCheck(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "streakCheck",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendStreakCheck(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendStreakCheck(ctx context.Context, params algokit.CallParams[StreakCheckArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().StreakCheck(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendSetPasses calls the setPasses ABI method and waits for confirmation.
+// SendSetPasses calls the setPasses ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendSetPasses(ctx context.Context, params algokit.CallParams[SetPassesArgs]) error {
-	methodArgs := argsToInterfaceSetPasses(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "setPasses",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendSetPasses(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendSetPasses(ctx context.Context, params algokit.CallParams[SetPassesArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().SetPasses(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendTriggerList calls the triggerList ABI method and waits for confirmation.
+// SendTriggerList calls the triggerList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendTriggerList(ctx context.Context, params algokit.CallParams[TriggerListArgs]) (*TriggerListMethodResult, error) {
-	methodArgs := argsToInterfaceTriggerList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendTriggerList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "triggerList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendTriggerList(ctx context.Context, params algokit.CallParams[TriggerListArgs]) (*TriggerListMethodResult, error) {
+	comp, err := c.NewGroup().TriggerList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*TriggerListMethodResult)
+	return typedResult, nil
+}
 
+// decodeTriggerListResult decodes the return value of a triggerList call.
+func (c *Client) decodeTriggerListResult(result *algokit.SendAppTransactionResult) (*TriggerListMethodResult, error) {
 	typedResult := &TriggerListMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -660,29 +1079,32 @@ func (c *Client) SendTriggerList(ctx context.Context, params algokit.CallParams[
 	return typedResult, nil
 }
 
-// SendIsBlocked calls the isBlocked ABI method and waits for confirmation.
+// SendIsBlocked calls the isBlocked ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // isBlocked checks if an address is blocked for a merchant
 func (c *Client) SendIsBlocked(ctx context.Context, params algokit.CallParams[IsBlockedArgs]) (*IsBlockedMethodResult, error) {
-	methodArgs := argsToInterfaceIsBlocked(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendIsBlocked(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "isBlocked",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendIsBlocked(ctx context.Context, params algokit.CallParams[IsBlockedArgs]) (*IsBlockedMethodResult, error) {
+	comp, err := c.NewGroup().IsBlocked(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*IsBlockedMethodResult)
+	return typedResult, nil
+}
 
+// decodeIsBlockedResult decodes the return value of a isBlocked call.
+func (c *Client) decodeIsBlockedResult(result *algokit.SendAppTransactionResult) (*IsBlockedMethodResult, error) {
 	typedResult := &IsBlockedMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -696,29 +1118,32 @@ func (c *Client) SendIsBlocked(ctx context.Context, params algokit.CallParams[Is
 	return typedResult, nil
 }
 
-// SendIsShutdown calls the isShutdown ABI method and waits for confirmation.
+// SendIsShutdown calls the isShutdown ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // serviceIsActive checks if an service is shutdown
 func (c *Client) SendIsShutdown(ctx context.Context, params algokit.CallParams[IsShutdownArgs]) (*IsShutdownMethodResult, error) {
-	methodArgs := argsToInterfaceIsShutdown(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendIsShutdown(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "isShutdown",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendIsShutdown(ctx context.Context, params algokit.CallParams[IsShutdownArgs]) (*IsShutdownMethodResult, error) {
+	comp, err := c.NewGroup().IsShutdown(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*IsShutdownMethodResult)
+	return typedResult, nil
+}
 
+// decodeIsShutdownResult decodes the return value of a isShutdown call.
+func (c *Client) decodeIsShutdownResult(result *algokit.SendAppTransactionResult) (*IsShutdownMethodResult, error) {
 	typedResult := &IsShutdownMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -732,28 +1157,31 @@ func (c *Client) SendIsShutdown(ctx context.Context, params algokit.CallParams[I
 	return typedResult, nil
 }
 
-// SendNewServiceCost calls the newServiceCost ABI method and waits for confirmation.
+// SendNewServiceCost calls the newServiceCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendNewServiceCost(ctx context.Context, params algokit.CallParams[NewServiceCostArgs]) (*NewServiceCostMethodResult, error) {
-	methodArgs := argsToInterfaceNewServiceCost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendNewServiceCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "newServiceCost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendNewServiceCost(ctx context.Context, params algokit.CallParams[NewServiceCostArgs]) (*NewServiceCostMethodResult, error) {
+	comp, err := c.NewGroup().NewServiceCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*NewServiceCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeNewServiceCostResult decodes the return value of a newServiceCost call.
+func (c *Client) decodeNewServiceCostResult(result *algokit.SendAppTransactionResult) (*NewServiceCostMethodResult, error) {
 	typedResult := &NewServiceCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -767,28 +1195,31 @@ func (c *Client) SendNewServiceCost(ctx context.Context, params algokit.CallPara
 	return typedResult, nil
 }
 
-// SendNewSubscriptionCost calls the newSubscriptionCost ABI method and waits for confirmation.
+// SendNewSubscriptionCost calls the newSubscriptionCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendNewSubscriptionCost(ctx context.Context, params algokit.CallParams[NewSubscriptionCostArgs]) (*NewSubscriptionCostMethodResult, error) {
-	methodArgs := argsToInterfaceNewSubscriptionCost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendNewSubscriptionCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "newSubscriptionCost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendNewSubscriptionCost(ctx context.Context, params algokit.CallParams[NewSubscriptionCostArgs]) (*NewSubscriptionCostMethodResult, error) {
+	comp, err := c.NewGroup().NewSubscriptionCost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*NewSubscriptionCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeNewSubscriptionCostResult decodes the return value of a newSubscriptionCost call.
+func (c *Client) decodeNewSubscriptionCostResult(result *algokit.SendAppTransactionResult) (*NewSubscriptionCostMethodResult, error) {
 	typedResult := &NewSubscriptionCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -802,18 +1233,32 @@ func (c *Client) SendNewSubscriptionCost(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendBlockCost calls the blockCost ABI method and waits for confirmation.
-func (c *Client) SendBlockCost(ctx context.Context) (*BlockCostMethodResult, error) {
-	methodArgs := []interface{}(nil)
+// SendBlockCost calls the blockCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendBlockCost(ctx context.Context, opt ...algokit.CallParams[struct{}]) (*BlockCostMethodResult, error) {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendBlockCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "blockCost",
-		MethodArgs: methodArgs,
-	})
+func (c *Client) sendBlockCost(ctx context.Context, params algokit.CallParams[struct{}]) (*BlockCostMethodResult, error) {
+	comp, err := c.NewGroup().BlockCost(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*BlockCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeBlockCostResult decodes the return value of a blockCost call.
+func (c *Client) decodeBlockCostResult(result *algokit.SendAppTransactionResult) (*BlockCostMethodResult, error) {
 	typedResult := &BlockCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -827,28 +1272,31 @@ func (c *Client) SendBlockCost(ctx context.Context) (*BlockCostMethodResult, err
 	return typedResult, nil
 }
 
-// SendGetService calls the getService ABI method and waits for confirmation.
+// SendGetService calls the getService ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetService(ctx context.Context, params algokit.CallParams[GetServiceArgs]) (*GetServiceMethodResult, error) {
-	methodArgs := argsToInterfaceGetService(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetService(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getService",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetService(ctx context.Context, params algokit.CallParams[GetServiceArgs]) (*GetServiceMethodResult, error) {
+	comp, err := c.NewGroup().GetService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetServiceMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetServiceResult decodes the return value of a getService call.
+func (c *Client) decodeGetServiceResult(result *algokit.SendAppTransactionResult) (*GetServiceMethodResult, error) {
 	typedResult := &GetServiceMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -864,63 +1312,69 @@ func (c *Client) SendGetService(ctx context.Context, params algokit.CallParams[G
 	return typedResult, nil
 }
 
-// SendGetServicesByAddress calls the getServicesByAddress ABI method and waits for confirmation.
+// SendGetServicesByAddress calls the getServicesByAddress ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetServicesByAddress(ctx context.Context, params algokit.CallParams[GetServicesByAddressArgs]) (*GetServicesByAddressMethodResult, error) {
-	methodArgs := argsToInterfaceGetServicesByAddress(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetServicesByAddress(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getServicesByAddress",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetServicesByAddress(ctx context.Context, params algokit.CallParams[GetServicesByAddressArgs]) (*GetServicesByAddressMethodResult, error) {
+	comp, err := c.NewGroup().GetServicesByAddress(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetServicesByAddressMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetServicesByAddressResult decodes the return value of a getServicesByAddress call.
+func (c *Client) decodeGetServicesByAddressResult(result *algokit.SendAppTransactionResult) (*GetServicesByAddressMethodResult, error) {
 	typedResult := &GetServicesByAddressMethodResult{
 		SendAppTransactionResult: *result,
 	}
 
 	if result.ABIReturn != nil {
-		if val, ok := result.ABIReturn.([][]interface{}); ok {
-			typedResult.Return = val
+		if err := fromABIValue(result.ABIReturn, &typedResult.Return); err != nil {
+			return nil, fmt.Errorf("failed to decode return: %w", err)
 		}
 	}
 
 	return typedResult, nil
 }
 
-// SendGetSubscription calls the getSubscription ABI method and waits for confirmation.
+// SendGetSubscription calls the getSubscription ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetSubscription(ctx context.Context, params algokit.CallParams[GetSubscriptionArgs]) (*GetSubscriptionMethodResult, error) {
-	methodArgs := argsToInterfaceGetSubscription(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetSubscription(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getSubscription",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetSubscription(ctx context.Context, params algokit.CallParams[GetSubscriptionArgs]) (*GetSubscriptionMethodResult, error) {
+	comp, err := c.NewGroup().GetSubscription(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetSubscriptionMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetSubscriptionResult decodes the return value of a getSubscription call.
+func (c *Client) decodeGetSubscriptionResult(result *algokit.SendAppTransactionResult) (*GetSubscriptionMethodResult, error) {
 	typedResult := &GetSubscriptionMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -936,28 +1390,31 @@ func (c *Client) SendGetSubscription(ctx context.Context, params algokit.CallPar
 	return typedResult, nil
 }
 
-// SendMustGetSubscription calls the mustGetSubscription ABI method and waits for confirmation.
+// SendMustGetSubscription calls the mustGetSubscription ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendMustGetSubscription(ctx context.Context, params algokit.CallParams[MustGetSubscriptionArgs]) (*MustGetSubscriptionMethodResult, error) {
-	methodArgs := argsToInterfaceMustGetSubscription(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendMustGetSubscription(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "mustGetSubscription",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendMustGetSubscription(ctx context.Context, params algokit.CallParams[MustGetSubscriptionArgs]) (*MustGetSubscriptionMethodResult, error) {
+	comp, err := c.NewGroup().MustGetSubscription(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*MustGetSubscriptionMethodResult)
+	return typedResult, nil
+}
 
+// decodeMustGetSubscriptionResult decodes the return value of a mustGetSubscription call.
+func (c *Client) decodeMustGetSubscriptionResult(result *algokit.SendAppTransactionResult) (*MustGetSubscriptionMethodResult, error) {
 	typedResult := &MustGetSubscriptionMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -973,28 +1430,31 @@ func (c *Client) SendMustGetSubscription(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendGetSubscriptionWithDetails calls the getSubscriptionWithDetails ABI method and waits for confirmation.
+// SendGetSubscriptionWithDetails calls the getSubscriptionWithDetails ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetSubscriptionWithDetails(ctx context.Context, params algokit.CallParams[GetSubscriptionWithDetailsArgs]) (*GetSubscriptionWithDetailsMethodResult, error) {
-	methodArgs := argsToInterfaceGetSubscriptionWithDetails(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetSubscriptionWithDetails(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getSubscriptionWithDetails",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetSubscriptionWithDetails(ctx context.Context, params algokit.CallParams[GetSubscriptionWithDetailsArgs]) (*GetSubscriptionWithDetailsMethodResult, error) {
+	comp, err := c.NewGroup().GetSubscriptionWithDetails(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetSubscriptionWithDetailsMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetSubscriptionWithDetailsResult decodes the return value of a getSubscriptionWithDetails call.
+func (c *Client) decodeGetSubscriptionWithDetailsResult(result *algokit.SendAppTransactionResult) (*GetSubscriptionWithDetailsMethodResult, error) {
 	typedResult := &GetSubscriptionWithDetailsMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1010,28 +1470,31 @@ func (c *Client) SendGetSubscriptionWithDetails(ctx context.Context, params algo
 	return typedResult, nil
 }
 
-// SendIsFirstSubscription calls the isFirstSubscription ABI method and waits for confirmation.
+// SendIsFirstSubscription calls the isFirstSubscription ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendIsFirstSubscription(ctx context.Context, params algokit.CallParams[IsFirstSubscriptionArgs]) (*IsFirstSubscriptionMethodResult, error) {
-	methodArgs := argsToInterfaceIsFirstSubscription(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendIsFirstSubscription(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "isFirstSubscription",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendIsFirstSubscription(ctx context.Context, params algokit.CallParams[IsFirstSubscriptionArgs]) (*IsFirstSubscriptionMethodResult, error) {
+	comp, err := c.NewGroup().IsFirstSubscription(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*IsFirstSubscriptionMethodResult)
+	return typedResult, nil
+}
 
+// decodeIsFirstSubscriptionResult decodes the return value of a isFirstSubscription call.
+func (c *Client) decodeIsFirstSubscriptionResult(result *algokit.SendAppTransactionResult) (*IsFirstSubscriptionMethodResult, error) {
 	typedResult := &IsFirstSubscriptionMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1045,28 +1508,31 @@ func (c *Client) SendIsFirstSubscription(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendGetServiceList calls the getServiceList ABI method and waits for confirmation.
+// SendGetServiceList calls the getServiceList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetServiceList(ctx context.Context, params algokit.CallParams[GetServiceListArgs]) (*GetServiceListMethodResult, error) {
-	methodArgs := argsToInterfaceGetServiceList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetServiceList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getServiceList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetServiceList(ctx context.Context, params algokit.CallParams[GetServiceListArgs]) (*GetServiceListMethodResult, error) {
+	comp, err := c.NewGroup().GetServiceList(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*GetServiceListMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetServiceListResult decodes the return value of a getServiceList call.
+func (c *Client) decodeGetServiceListResult(result *algokit.SendAppTransactionResult) (*GetServiceListMethodResult, error) {
 	typedResult := &GetServiceListMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1080,28 +1546,31 @@ func (c *Client) SendGetServiceList(ctx context.Context, params algokit.CallPara
 	return typedResult, nil
 }
 
-// SendGetSubscriptionList calls the getSubscriptionList ABI method and waits for confirmation.
+// SendGetSubscriptionList calls the getSubscriptionList ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendGetSubscriptionList(ctx context.Context, params algokit.CallParams[GetSubscriptionListArgs]) (*GetSubscriptionListMethodResult, error) {
-	methodArgs := argsToInterfaceGetSubscriptionList(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendGetSubscriptionList(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "getSubscriptionList",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendGetSubscriptionList(ctx context.Context, params algokit.CallParams[GetSubscriptionListArgs]) (*GetSubscriptionListMethodResult, error) {
+	comp, err := c.NewGroup().GetSubscriptionList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*GetSubscriptionListMethodResult)
+	return typedResult, nil
+}
 
+// decodeGetSubscriptionListResult decodes the return value of a getSubscriptionList call.
+func (c *Client) decodeGetSubscriptionListResult(result *algokit.SendAppTransactionResult) (*GetSubscriptionListMethodResult, error) {
 	typedResult := &GetSubscriptionListMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1115,55 +1584,56 @@ func (c *Client) SendGetSubscriptionList(ctx context.Context, params algokit.Cal
 	return typedResult, nil
 }
 
-// SendOptIn calls the optIn ABI method and waits for confirmation.
+// SendOptIn calls the optIn ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 // optin tells the contract to opt into an asa
 func (c *Client) SendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) error {
-	methodArgs := argsToInterfaceOptIn(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "optIn",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOptIn(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOptIn(ctx context.Context, params algokit.CallParams[OptInArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OptIn(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendOptInCost calls the optInCost ABI method and waits for confirmation.
+// SendOptInCost calls the optInCost ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendOptInCost(ctx context.Context, params algokit.CallParams[OptInCostArgs]) (*OptInCostMethodResult, error) {
-	methodArgs := argsToInterfaceOptInCost(params.Args)
+	params, err := withDefaults(c.opts, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendOptInCost(ctx, params)
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "optInCost",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+func (c *Client) sendOptInCost(ctx context.Context, params algokit.CallParams[OptInCostArgs]) (*OptInCostMethodResult, error) {
+	comp, err := c.NewGroup().OptInCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
 	if err != nil {
 		return nil, err
 	}
+	typedResult, _ := result.Returns[0].(*OptInCostMethodResult)
+	return typedResult, nil
+}
 
+// decodeOptInCostResult decodes the return value of a optInCost call.
+func (c *Client) decodeOptInCostResult(result *algokit.SendAppTransactionResult) (*OptInCostMethodResult, error) {
 	typedResult := &OptInCostMethodResult{
 		SendAppTransactionResult: *result,
 	}
@@ -1177,72 +1647,77 @@ func (c *Client) SendOptInCost(ctx context.Context, params algokit.CallParams[Op
 	return typedResult, nil
 }
 
-// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation.
+// SendUpdateAkitaDaoEscrow calls the updateAkitaDAOEscrow ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDaoEscrow(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAOEscrow",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendUpdateAkitaDaoEscrow(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendUpdateAkitaDaoEscrow(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoEscrowArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDaoEscrow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
-// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation.
+// SendUpdateAkitaDao calls the updateAkitaDAO ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
 func (c *Client) SendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) error {
-	methodArgs := argsToInterfaceUpdateAkitaDao(params.Args)
-
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName:        "updateAkitaDAO",
-		MethodArgs:        methodArgs,
-		Sender:            params.Sender,
-		Signer:            params.Signer,
-		Note:              params.Note,
-		BoxReferences:     params.BoxReferences,
-		AccountReferences: params.AccountReferences,
-		AppReferences:     params.AppReferences,
-		AssetReferences:   params.AssetReferences,
-		ExtraFee:          params.ExtraFee,
-		StaticFee:         params.StaticFee,
-		SendParams:        params.SendParams,
-	})
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
-
-	_ = result
-	return nil
+	_, err = c.sendUpdateAkitaDao(ctx, params)
+	return err
 }
 
-// SendOpUp calls the opUp ABI method and waits for confirmation.
-func (c *Client) SendOpUp(ctx context.Context) error {
-	methodArgs := []interface{}(nil)
+func (c *Client) sendUpdateAkitaDao(ctx context.Context, params algokit.CallParams[UpdateAkitaDaoArgs]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().UpdateAkitaDao(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
+}
 
-	result, err := c.AppClient.Send(ctx, algokit.AppCallSendParams{
-		MethodName: "opUp",
-		MethodArgs: methodArgs,
-	})
+// SendOpUp calls the opUp ABI method and waits for confirmation,
+// as a group of one call. See Composer.Send.
+func (c *Client) SendOpUp(ctx context.Context, opt ...algokit.CallParams[struct{}]) error {
+	params := optionalParams(opt)
+	params, err := withDefaults(c.opts, params)
 	if err != nil {
 		return err
 	}
+	_, err = c.sendOpUp(ctx, params)
+	return err
+}
 
-	_ = result
-	return nil
+func (c *Client) sendOpUp(ctx context.Context, params algokit.CallParams[struct{}]) (*algokit.SendAppTransactionResult, error) {
+	comp, err := c.NewGroup().OpUp(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := comp.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResult, _ := result.Returns[0].(*algokit.SendAppTransactionResult)
+	return typedResult, nil
 }
 
 func argsToInterfaceCreate(args CreateArgs) []interface{} {
@@ -1403,10 +1878,14 @@ func argsToInterfaceSetPasses(args SetPassesArgs) []interface{} {
 	}
 }
 
-func argsToInterfaceTriggerList(args TriggerListArgs) []interface{} {
-	return []interface{}{
-		args.Req,
+func argsToInterfaceTriggerList(args TriggerListArgs) ([]interface{}, error) {
+	methodArgs := make([]interface{}, 0, 1)
+	if v, err := toABIValue(args.Req); err != nil {
+		return nil, fmt.Errorf("invalid req argument: %w", err)
+	} else {
+		methodArgs = append(methodArgs, v)
 	}
+	return methodArgs, nil
 }
 
 func argsToInterfaceIsBlocked(args IsBlockedArgs) []interface{} {
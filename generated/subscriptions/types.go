@@ -92,358 +92,358 @@ type SubscriptionKey struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	Version        string
-	AkitaDao       uint64
-	AkitaDaoEscrow uint64
+	Version        string `json:"version"`
+	AkitaDao       uint64 `json:"akitaDAO"`
+	AkitaDaoEscrow uint64 `json:"akitaDAOEscrow"`
 }
 
 // NewServiceArgs holds the arguments for the newService method.
 type NewServiceArgs struct {
-	Payment          transaction.TransactionWithSigner
-	Interval         uint64
-	Asset            uint64
-	Amount           uint64
-	Passes           uint64
-	GateID           uint64
-	Title            string
-	BannerImage      [36]byte
-	HighlightMessage uint8
-	HighlightColor   [3]byte
+	Payment          transaction.TransactionWithSigner `json:"-"`
+	Interval         uint64                            `json:"interval"`
+	Asset            uint64                            `json:"asset"`
+	Amount           uint64                            `json:"amount"`
+	Passes           uint64                            `json:"passes"`
+	GateID           uint64                            `json:"gateID"`
+	Title            string                            `json:"title"`
+	BannerImage      [36]byte                          `json:"bannerImage"`
+	HighlightMessage uint8                             `json:"highlightMessage"`
+	HighlightColor   [3]byte                           `json:"highlightColor"`
 }
 
 // NewServiceMethodResult holds the result of calling newService.
 type NewServiceMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // SetServiceDescriptionArgs holds the arguments for the setServiceDescription method.
 type SetServiceDescriptionArgs struct {
-	Offset uint64
-	Data   []byte
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // PauseServiceArgs holds the arguments for the pauseService method.
 type PauseServiceArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // UnpauseServiceArgs holds the arguments for the unpauseService method.
 type UnpauseServiceArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // ShutdownServiceArgs holds the arguments for the shutdownService method.
 type ShutdownServiceArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // BlockArgs holds the arguments for the block method.
 type BlockArgs struct {
-	Payment transaction.TransactionWithSigner
-	Blocked types.Address
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Blocked types.Address                     `json:"blocked"`
 }
 
 // UnblockArgs holds the arguments for the unblock method.
 type UnblockArgs struct {
-	Blocked types.Address
+	Blocked types.Address `json:"blocked"`
 }
 
 // GatedSubscribeArgs holds the arguments for the gatedSubscribe method.
 type GatedSubscribeArgs struct {
-	Payment   transaction.TransactionWithSigner
-	GateTXN   transaction.TransactionWithSigner
-	Recipient types.Address
-	Amount    uint64
-	Interval  uint64
-	ServiceID uint64
+	Payment   transaction.TransactionWithSigner `json:"-"`
+	GateTXN   transaction.TransactionWithSigner `json:"-"`
+	Recipient types.Address                     `json:"recipient"`
+	Amount    uint64                            `json:"amount"`
+	Interval  uint64                            `json:"interval"`
+	ServiceID uint64                            `json:"serviceID"`
 }
 
 // GatedSubscribeMethodResult holds the result of calling gatedSubscribe.
 type GatedSubscribeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // SubscribeArgs holds the arguments for the subscribe method.
 type SubscribeArgs struct {
-	Payment   transaction.TransactionWithSigner
-	Recipient types.Address
-	Amount    uint64
-	Interval  uint64
-	ServiceID uint64
+	Payment   transaction.TransactionWithSigner `json:"-"`
+	Recipient types.Address                     `json:"recipient"`
+	Amount    uint64                            `json:"amount"`
+	Interval  uint64                            `json:"interval"`
+	ServiceID uint64                            `json:"serviceID"`
 }
 
 // SubscribeMethodResult holds the result of calling subscribe.
 type SubscribeMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GatedSubscribeASAArgs holds the arguments for the gatedSubscribeAsa method.
 type GatedSubscribeASAArgs struct {
-	Payment   transaction.TransactionWithSigner
-	AssetXfer transaction.TransactionWithSigner
-	GateTXN   transaction.TransactionWithSigner
-	Recipient types.Address
-	Amount    uint64
-	Interval  uint64
-	ServiceID uint64
+	Payment   transaction.TransactionWithSigner `json:"-"`
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
+	GateTXN   transaction.TransactionWithSigner `json:"-"`
+	Recipient types.Address                     `json:"recipient"`
+	Amount    uint64                            `json:"amount"`
+	Interval  uint64                            `json:"interval"`
+	ServiceID uint64                            `json:"serviceID"`
 }
 
 // GatedSubscribeASAMethodResult holds the result of calling gatedSubscribeAsa.
 type GatedSubscribeASAMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // SubscribeASAArgs holds the arguments for the subscribeAsa method.
 type SubscribeASAArgs struct {
-	Payment   transaction.TransactionWithSigner
-	AssetXfer transaction.TransactionWithSigner
-	Recipient types.Address
-	Amount    uint64
-	Interval  uint64
-	ServiceID uint64
+	Payment   transaction.TransactionWithSigner `json:"-"`
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
+	Recipient types.Address                     `json:"recipient"`
+	Amount    uint64                            `json:"amount"`
+	Interval  uint64                            `json:"interval"`
+	ServiceID uint64                            `json:"serviceID"`
 }
 
 // SubscribeASAMethodResult holds the result of calling subscribeAsa.
 type SubscribeASAMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // DepositArgs holds the arguments for the deposit method.
 type DepositArgs struct {
-	Payment transaction.TransactionWithSigner
-	ID      uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	ID      uint64                            `json:"id"`
 }
 
 // DepositASAArgs holds the arguments for the depositAsa method.
 type DepositASAArgs struct {
-	AssetXfer transaction.TransactionWithSigner
-	ID        uint64
+	AssetXfer transaction.TransactionWithSigner `json:"-"`
+	ID        uint64                            `json:"id"`
 }
 
 // WithdrawArgs holds the arguments for the withdraw method.
 type WithdrawArgs struct {
-	ID     uint64
-	Amount uint64
+	ID     uint64 `json:"id"`
+	Amount uint64 `json:"amount"`
 }
 
 // UnsubscribeArgs holds the arguments for the unsubscribe method.
 type UnsubscribeArgs struct {
-	ID uint64
+	ID uint64 `json:"id"`
 }
 
 // GatedTriggerPaymentArgs holds the arguments for the gatedTriggerPayment method.
 type GatedTriggerPaymentArgs struct {
-	GateTXN transaction.TransactionWithSigner
-	Key     SubscriptionKey
+	GateTXN transaction.TransactionWithSigner `json:"-"`
+	Key     SubscriptionKey                   `json:"key"`
 }
 
 // TriggerPaymentArgs holds the arguments for the triggerPayment method.
 type TriggerPaymentArgs struct {
-	Key SubscriptionKey
+	Key SubscriptionKey `json:"key"`
 }
 
 // StreakCheckArgs holds the arguments for the streakCheck method.
 type StreakCheckArgs struct {
-	Key SubscriptionKey
+	Key SubscriptionKey `json:"key"`
 }
 
 // SetPassesArgs holds the arguments for the setPasses method.
 type SetPassesArgs struct {
-	ID        uint64
-	Addresses []types.Address
+	ID        uint64          `json:"id"`
+	Addresses []types.Address `json:"addresses"`
 }
 
 // TriggerListArgs holds the arguments for the triggerList method.
 type TriggerListArgs struct {
-	Req [][]interface{}
+	Req []Tuple2[types.Address, []uint64] `json:"req"`
 }
 
 // TriggerListMethodResult holds the result of calling triggerList.
 type TriggerListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return []bool
+	Return []bool `json:"return"`
 }
 
 // IsBlockedArgs holds the arguments for the isBlocked method.
 type IsBlockedArgs struct {
-	Address types.Address
-	Blocked types.Address
+	Address types.Address `json:"address"`
+	Blocked types.Address `json:"blocked"`
 }
 
 // IsBlockedMethodResult holds the result of calling isBlocked.
 type IsBlockedMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // IsShutdownArgs holds the arguments for the isShutdown method.
 type IsShutdownArgs struct {
-	Address types.Address
-	ID      uint64
+	Address types.Address `json:"address"`
+	ID      uint64        `json:"id"`
 }
 
 // IsShutdownMethodResult holds the result of calling isShutdown.
 type IsShutdownMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // NewServiceCostArgs holds the arguments for the newServiceCost method.
 type NewServiceCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // NewServiceCostMethodResult holds the result of calling newServiceCost.
 type NewServiceCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // NewSubscriptionCostArgs holds the arguments for the newSubscriptionCost method.
 type NewSubscriptionCostArgs struct {
-	Recipient types.Address
-	Asset     uint64
-	ServiceID uint64
+	Recipient types.Address `json:"recipient"`
+	Asset     uint64        `json:"asset"`
+	ServiceID uint64        `json:"serviceID"`
 }
 
 // NewSubscriptionCostMethodResult holds the result of calling newSubscriptionCost.
 type NewSubscriptionCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // BlockCostMethodResult holds the result of calling blockCost.
 type BlockCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetServiceArgs holds the arguments for the getService method.
 type GetServiceArgs struct {
-	Address types.Address
-	ID      uint64
+	Address types.Address `json:"address"`
+	ID      uint64        `json:"id"`
 }
 
 // GetServiceMethodResult holds the result of calling getService.
 type GetServiceMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return Service
+	Return Service `json:"return"`
 }
 
 // GetServicesByAddressArgs holds the arguments for the getServicesByAddress method.
 type GetServicesByAddressArgs struct {
-	Address    types.Address
-	Start      uint64
-	WindowSize uint64
+	Address    types.Address `json:"address"`
+	Start      uint64        `json:"start"`
+	WindowSize uint64        `json:"windowSize"`
 }
 
 // GetServicesByAddressMethodResult holds the result of calling getServicesByAddress.
 type GetServicesByAddressMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return [][]interface{}
+	Return []Tuple11[uint8, uint64, uint64, uint64, uint64, uint64, string, string, [36]byte, uint8, [3]byte] `json:"return"`
 }
 
 // GetSubscriptionArgs holds the arguments for the getSubscription method.
 type GetSubscriptionArgs struct {
-	Key SubscriptionKey
+	Key SubscriptionKey `json:"key"`
 }
 
 // GetSubscriptionMethodResult holds the result of calling getSubscription.
 type GetSubscriptionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return SubscriptionInfoWithExistence
+	Return SubscriptionInfoWithExistence `json:"return"`
 }
 
 // MustGetSubscriptionArgs holds the arguments for the mustGetSubscription method.
 type MustGetSubscriptionArgs struct {
-	Key SubscriptionKey
+	Key SubscriptionKey `json:"key"`
 }
 
 // MustGetSubscriptionMethodResult holds the result of calling mustGetSubscription.
 type MustGetSubscriptionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return SubscriptionInfo
+	Return SubscriptionInfo `json:"return"`
 }
 
 // GetSubscriptionWithDetailsArgs holds the arguments for the getSubscriptionWithDetails method.
 type GetSubscriptionWithDetailsArgs struct {
-	Key SubscriptionKey
+	Key SubscriptionKey `json:"key"`
 }
 
 // GetSubscriptionWithDetailsMethodResult holds the result of calling getSubscriptionWithDetails.
 type GetSubscriptionWithDetailsMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return SubscriptionInfoWithDetails
+	Return SubscriptionInfoWithDetails `json:"return"`
 }
 
 // IsFirstSubscriptionArgs holds the arguments for the isFirstSubscription method.
 type IsFirstSubscriptionArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // IsFirstSubscriptionMethodResult holds the result of calling isFirstSubscription.
 type IsFirstSubscriptionMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return bool
+	Return bool `json:"return"`
 }
 
 // GetServiceListArgs holds the arguments for the getServiceList method.
 type GetServiceListArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GetServiceListMethodResult holds the result of calling getServiceList.
 type GetServiceListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // GetSubscriptionListArgs holds the arguments for the getSubscriptionList method.
 type GetSubscriptionListArgs struct {
-	Address types.Address
+	Address types.Address `json:"address"`
 }
 
 // GetSubscriptionListMethodResult holds the result of calling getSubscriptionList.
 type GetSubscriptionListMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Payment transaction.TransactionWithSigner
-	Asset   uint64
+	Payment transaction.TransactionWithSigner `json:"-"`
+	Asset   uint64                            `json:"asset"`
 }
 
 // OptInCostArgs holds the arguments for the optInCost method.
 type OptInCostArgs struct {
-	Asset uint64
+	Asset uint64 `json:"asset"`
 }
 
 // OptInCostMethodResult holds the result of calling optInCost.
 type OptInCostMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // UpdateAkitaDaoEscrowArgs holds the arguments for the updateAkitaDAOEscrow method.
 type UpdateAkitaDaoEscrowArgs struct {
-	App uint64
+	App uint64 `json:"app"`
 }
 
 // UpdateArgs holds the arguments for the update method.
 type UpdateArgs struct {
-	NewVersion string
+	NewVersion string `json:"newVersion"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
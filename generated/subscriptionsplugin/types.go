@@ -14,118 +14,118 @@ type SubscriptionKey struct {
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao uint64
-	Version  string
+	AkitaDao uint64 `json:"akitaDAO"`
+	Version  string `json:"version"`
 }
 
 // OptInArgs holds the arguments for the optIn method.
 type OptInArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Asset     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Asset     uint64 `json:"asset"`
 }
 
 // InitDescriptionArgs holds the arguments for the initDescription method.
 type InitDescriptionArgs struct {
-	Wallet uint64
-	Size   uint64
+	Wallet uint64 `json:"wallet"`
+	Size   uint64 `json:"size"`
 }
 
 // LoadDescriptionArgs holds the arguments for the loadDescription method.
 type LoadDescriptionArgs struct {
-	Wallet uint64
-	Offset uint64
-	Data   []byte
+	Wallet uint64 `json:"wallet"`
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // NewServiceArgs holds the arguments for the newService method.
 type NewServiceArgs struct {
-	Wallet           uint64
-	RekeyBack        bool
-	Interval         uint64
-	Asset            uint64
-	Amount           uint64
-	Passes           uint64
-	Gate             uint64
-	Title            string
-	BannerImage      [36]byte
-	HighlightMessage uint8
-	HighlightColor   [3]byte
+	Wallet           uint64   `json:"wallet"`
+	RekeyBack        bool     `json:"rekeyBack"`
+	Interval         uint64   `json:"interval"`
+	Asset            uint64   `json:"asset"`
+	Amount           uint64   `json:"amount"`
+	Passes           uint64   `json:"passes"`
+	Gate             uint64   `json:"gate"`
+	Title            string   `json:"title"`
+	BannerImage      [36]byte `json:"bannerImage"`
+	HighlightMessage uint8    `json:"highlightMessage"`
+	HighlightColor   [3]byte  `json:"highlightColor"`
 }
 
 // NewServiceMethodResult holds the result of calling newService.
 type NewServiceMethodResult struct {
 	algokit.SendAppTransactionResult
-	Return uint64
+	Return uint64 `json:"return"`
 }
 
 // PauseServiceArgs holds the arguments for the pauseService method.
 type PauseServiceArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	ID        uint64 `json:"id"`
 }
 
 // ShutdownServiceArgs holds the arguments for the shutdownService method.
 type ShutdownServiceArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Index     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	Index     uint64 `json:"index"`
 }
 
 // BlockArgs holds the arguments for the block method.
 type BlockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // UnblockArgs holds the arguments for the unblock method.
 type UnblockArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
 }
 
 // SubscribeArgs holds the arguments for the subscribe method.
 type SubscribeArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Asset     uint64
-	Recipient types.Address
-	Amount    uint64
-	Interval  uint64
-	Index     uint64
-	Args      [][]byte
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Asset     uint64        `json:"asset"`
+	Recipient types.Address `json:"recipient"`
+	Amount    uint64        `json:"amount"`
+	Interval  uint64        `json:"interval"`
+	Index     uint64        `json:"index"`
+	Args      [][]byte      `json:"args"`
 }
 
 // TriggerPaymentArgs holds the arguments for the triggerPayment method.
 type TriggerPaymentArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Address   types.Address
-	ID        uint64
-	Args      [][]byte
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Address   types.Address `json:"address"`
+	ID        uint64        `json:"id"`
+	Args      [][]byte      `json:"args"`
 }
 
 // StreakCheckArgs holds the arguments for the streakCheck method.
 type StreakCheckArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Key       SubscriptionKey
+	Wallet    uint64          `json:"wallet"`
+	RekeyBack bool            `json:"rekeyBack"`
+	Key       SubscriptionKey `json:"key"`
 }
 
 // SetPassesArgs holds the arguments for the setPasses method.
 type SetPassesArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	ID        uint64
-	Addresses []types.Address
+	Wallet    uint64          `json:"wallet"`
+	RekeyBack bool            `json:"rekeyBack"`
+	ID        uint64          `json:"id"`
+	Addresses []types.Address `json:"addresses"`
 }
 
 // UpdateAkitaDaoArgs holds the arguments for the updateAkitaDAO method.
 type UpdateAkitaDaoArgs struct {
-	AkitaDao uint64
+	AkitaDao uint64 `json:"akitaDAO"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
@@ -7,18 +7,18 @@ import (
 
 // CloseOutAlgoArgs holds the arguments for the closeOutAlgo method.
 type CloseOutAlgoArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	Receiver  types.Address
-	Amount    uint64
-	CloseTo   types.Address
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	Receiver  types.Address `json:"receiver"`
+	Amount    uint64        `json:"amount"`
+	CloseTo   types.Address `json:"closeTo"`
 }
 
 // CloseOutAssetArgs holds the arguments for the closeOutAsset method.
 type CloseOutAssetArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	Asset        uint64
-	Receiver     types.Address
-	AssetCloseTo types.Address
+	Wallet       uint64        `json:"wallet"`
+	RekeyBack    bool          `json:"rekeyBack"`
+	Asset        uint64        `json:"asset"`
+	Receiver     types.Address `json:"receiver"`
+	AssetCloseTo types.Address `json:"assetCloseTo"`
 }
@@ -7,10 +7,10 @@ import (
 
 // ProxyPayArgs holds the arguments for the proxyPay method.
 type ProxyPayArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	PayPlugin uint64
-	Receiver  types.Address
-	Asset     uint64
-	Amount    uint64
+	Wallet    uint64        `json:"wallet"`
+	RekeyBack bool          `json:"rekeyBack"`
+	PayPlugin uint64        `json:"payPlugin"`
+	Receiver  types.Address `json:"receiver"`
+	Asset     uint64        `json:"asset"`
+	Amount    uint64        `json:"amount"`
 }
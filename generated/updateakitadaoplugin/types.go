@@ -7,72 +7,72 @@ import (
 
 // CreateArgs holds the arguments for the create method.
 type CreateArgs struct {
-	AkitaDao     uint64
-	ClearProgram []byte
+	AkitaDao     uint64 `json:"akitaDAO"`
+	ClearProgram []byte `json:"clearProgram"`
 }
 
 // SetClearProgramArgs holds the arguments for the setClearProgram method.
 type SetClearProgramArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	ClearProgram []byte
+	Wallet       uint64 `json:"wallet"`
+	RekeyBack    bool   `json:"rekeyBack"`
+	ClearProgram []byte `json:"clearProgram"`
 }
 
 // InitBoxedContractArgs holds the arguments for the initBoxedContract method.
 type InitBoxedContractArgs struct {
-	Wallet  uint64
-	Version string
-	Size    uint64
+	Wallet  uint64 `json:"wallet"`
+	Version string `json:"version"`
+	Size    uint64 `json:"size"`
 }
 
 // LoadBoxedContractArgs holds the arguments for the loadBoxedContract method.
 type LoadBoxedContractArgs struct {
-	Wallet uint64
-	Offset uint64
-	Data   []byte
+	Wallet uint64 `json:"wallet"`
+	Offset uint64 `json:"offset"`
+	Data   []byte `json:"data"`
 }
 
 // DeleteBoxedContractArgs holds the arguments for the deleteBoxedContract method.
 type DeleteBoxedContractArgs struct {
-	Wallet    uint64
-	RekeyBack bool
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
 }
 
 // UpdateAppArgs holds the arguments for the updateApp method.
 type UpdateAppArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
 }
 
 // UpdateAkitaDaoAppIDForAppArgs holds the arguments for the updateAkitaDaoAppIDForApp method.
 type UpdateAkitaDaoAppIDForAppArgs struct {
-	Wallet           uint64
-	RekeyBack        bool
-	AppID            uint64
-	NewAkitaDaoAppID uint64
+	Wallet           uint64 `json:"wallet"`
+	RekeyBack        bool   `json:"rekeyBack"`
+	AppID            uint64 `json:"appId"`
+	NewAkitaDaoAppID uint64 `json:"newAkitaDAOAppID"`
 }
 
 // UpdateAkitaDaoEscrowForAppArgs holds the arguments for the updateAkitaDaoEscrowForApp method.
 type UpdateAkitaDaoEscrowForAppArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	AppID     uint64
-	NewEscrow uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	AppID     uint64 `json:"appId"`
+	NewEscrow uint64 `json:"newEscrow"`
 }
 
 // UpdateRevocationArgs holds the arguments for the updateRevocation method.
 type UpdateRevocationArgs struct {
-	Wallet    uint64
-	RekeyBack bool
-	App       uint64
+	Wallet    uint64 `json:"wallet"`
+	RekeyBack bool   `json:"rekeyBack"`
+	App       uint64 `json:"app"`
 }
 
 // UpdateFactoryChildContractArgs holds the arguments for the updateFactoryChildContract method.
 type UpdateFactoryChildContractArgs struct {
-	Wallet       uint64
-	RekeyBack    bool
-	FactoryAppID uint64
+	Wallet       uint64 `json:"wallet"`
+	RekeyBack    bool   `json:"rekeyBack"`
+	FactoryAppID uint64 `json:"factoryAppId"`
 }
 
 // FactoryCreateParams is a convenience alias for typed factory create parameters.
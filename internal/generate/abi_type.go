@@ -0,0 +1,183 @@
+package generate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ABIKind identifies the kind of a parsed ARC-4 ABI type.
+type ABIKind int
+
+const (
+	ABIUint ABIKind = iota
+	ABIUfixed
+	ABIByte
+	ABIBool
+	ABIAddress
+	ABIString
+	ABIStaticArray
+	ABIDynamicArray
+	ABITuple
+)
+
+// ABIType is a parsed ARC-4 ABI type.
+type ABIType struct {
+	Kind      ABIKind
+	Bits      int        // uint<N> and ufixed<N>x<M> width
+	Precision int        // ufixed<N>x<M> precision
+	Length    int        // static array length
+	Elem      *ABIType   // array element type
+	Fields    []*ABIType // tuple element types
+}
+
+// ParseABIType parses an ARC-4 ABI type string, validating widths and
+// precisions (N a multiple of 8 between 8 and 512, M between 1 and 160).
+func ParseABIType(s string) (*ABIType, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty ABI type")
+	}
+
+	switch s {
+	case "byte":
+		return &ABIType{Kind: ABIByte}, nil
+	case "bool":
+		return &ABIType{Kind: ABIBool}, nil
+	case "address":
+		return &ABIType{Kind: ABIAddress}, nil
+	case "string":
+		return &ABIType{Kind: ABIString}, nil
+	}
+
+	// Arrays: the outermost array suffix is always the last bracket pair.
+	if strings.HasSuffix(s, "]") {
+		open := strings.LastIndex(s, "[")
+		if open <= 0 {
+			return nil, fmt.Errorf("malformed array type %q", s)
+		}
+		elem, err := ParseABIType(s[:open])
+		if err != nil {
+			return nil, err
+		}
+		lenStr := s[open+1 : len(s)-1]
+		if lenStr == "" {
+			return &ABIType{Kind: ABIDynamicArray, Elem: elem}, nil
+		}
+		n, err := strconv.Atoi(lenStr)
+		if err != nil || n < 0 || strconv.Itoa(n) != lenStr {
+			return nil, fmt.Errorf("invalid static array length %q in %q", lenStr, s)
+		}
+		return &ABIType{Kind: ABIStaticArray, Length: n, Elem: elem}, nil
+	}
+
+	if strings.HasPrefix(s, "(") {
+		if !strings.HasSuffix(s, ")") || !balancedParens(s) {
+			return nil, fmt.Errorf("unbalanced parentheses in %q", s)
+		}
+		t := &ABIType{Kind: ABITuple}
+		if s == "()" {
+			return t, nil
+		}
+		for _, part := range SplitTupleTypes(s) {
+			field, err := ParseABIType(part)
+			if err != nil {
+				return nil, err
+			}
+			t.Fields = append(t.Fields, field)
+		}
+		return t, nil
+	}
+
+	if m := ufixedRegex.FindStringSubmatch(s); m != nil {
+		bits, err := parseBits(m[1], s)
+		if err != nil {
+			return nil, err
+		}
+		precision, _ := strconv.Atoi(m[2])
+		if precision < 1 || precision > 160 {
+			return nil, fmt.Errorf("invalid ufixed precision %d in %q (must be 1..160)", precision, s)
+		}
+		return &ABIType{Kind: ABIUfixed, Bits: bits, Precision: precision}, nil
+	}
+
+	if strings.HasPrefix(s, "uint") {
+		bits, err := parseBits(strings.TrimPrefix(s, "uint"), s)
+		if err != nil {
+			return nil, err
+		}
+		return &ABIType{Kind: ABIUint, Bits: bits}, nil
+	}
+
+	return nil, fmt.Errorf("unknown ABI type %q", s)
+}
+
+// String returns the canonical ABI type string.
+func (t *ABIType) String() string {
+	switch t.Kind {
+	case ABIUint:
+		return fmt.Sprintf("uint%d", t.Bits)
+	case ABIUfixed:
+		return fmt.Sprintf("ufixed%dx%d", t.Bits, t.Precision)
+	case ABIByte:
+		return "byte"
+	case ABIBool:
+		return "bool"
+	case ABIAddress:
+		return "address"
+	case ABIString:
+		return "string"
+	case ABIStaticArray:
+		return fmt.Sprintf("%s[%d]", t.Elem, t.Length)
+	case ABIDynamicArray:
+		return t.Elem.String() + "[]"
+	case ABITuple:
+		parts := make([]string, len(t.Fields))
+		for i, f := range t.Fields {
+			parts[i] = f.String()
+		}
+		return "(" + strings.Join(parts, ",") + ")"
+	}
+	return ""
+}
+
+// IsKnownTypeName reports whether t is one of the non-ARC-4 type names the
+// generator accepts in specs: void, AVM native types, transaction and
+// reference argument types, and the "bytes" shorthand.
+func IsKnownTypeName(t string) bool {
+	switch t {
+	case "void", "bytes", "AVMBytes", "AVMString", "AVMUint64":
+		return true
+	}
+	return isTransactionType(t) || isReferenceType(t)
+}
+
+func parseBits(digits, s string) (int, error) {
+	bits, err := strconv.Atoi(digits)
+	if err != nil || strconv.Itoa(bits) != digits {
+		return 0, fmt.Errorf("invalid bit width in %q", s)
+	}
+	if bits < 8 || bits > 512 || bits%8 != 0 {
+		return 0, fmt.Errorf("invalid bit width %d in %q (must be a multiple of 8 between 8 and 512)", bits, s)
+	}
+	return bits, nil
+}
+
+func balancedParens(s string) bool {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			// The outer tuple must only close at the very end.
+			if depth == 0 && i != len(s)-1 {
+				return false
+			}
+		}
+		if depth < 0 {
+			return false
+		}
+	}
+	return depth == 0
+}
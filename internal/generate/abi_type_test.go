@@ -0,0 +1,66 @@
+package generate
+
+import (
+	"testing"
+)
+
+func TestParseABIType(t *testing.T) {
+	valid := []string{
+		"uint8", "uint24", "uint64", "uint512",
+		"ufixed64x2", "ufixed8x1", "ufixed512x160",
+		"byte", "bool", "address", "string",
+		"byte[32]", "uint64[]", "uint64[2][]", "byte[][]",
+		"()", "(uint64,address)", "(uint64,(string,byte[]))[]",
+		"((uint64,string),uint8,uint64)[]",
+	}
+	for _, s := range valid {
+		t.Run(s, func(t *testing.T) {
+			parsed, err := ParseABIType(s)
+			if err != nil {
+				t.Fatalf("ParseABIType(%q) failed: %v", s, err)
+			}
+			if parsed.String() != s {
+				t.Errorf("round trip: got %q, want %q", parsed.String(), s)
+			}
+		})
+	}
+
+	invalid := []string{
+		"", "uint", "uint0", "uint7", "uint520", "uint064",
+		"ufixed64x0", "ufixed64x161", "ufixed63x2",
+		"uint64[-1]", "uint64[x]", "[]", "(uint64", "uint64)",
+		"(uint64)(bool)", "foo", "AVMUint64", "pay",
+	}
+	for _, s := range invalid {
+		t.Run("invalid_"+s, func(t *testing.T) {
+			if _, err := ParseABIType(s); err == nil {
+				t.Errorf("ParseABIType(%q) expected error", s)
+			}
+		})
+	}
+}
+
+func TestParseABITypeStructure(t *testing.T) {
+	parsed, err := ParseABIType("(uint64,byte[32])[3]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Kind != ABIStaticArray || parsed.Length != 3 {
+		t.Fatalf("expected static array of length 3, got %+v", parsed)
+	}
+	tuple := parsed.Elem
+	if tuple.Kind != ABITuple || len(tuple.Fields) != 2 {
+		t.Fatalf("expected tuple with 2 fields, got %+v", tuple)
+	}
+	if tuple.Fields[1].Kind != ABIStaticArray || tuple.Fields[1].Elem.Kind != ABIByte {
+		t.Errorf("expected byte[32] second field, got %+v", tuple.Fields[1])
+	}
+
+	ufixed, err := ParseABIType("ufixed128x10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ufixed.Bits != 128 || ufixed.Precision != 10 {
+		t.Errorf("unexpected ufixed parse: %+v", ufixed)
+	}
+}
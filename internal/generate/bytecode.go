@@ -0,0 +1,33 @@
+package generate
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+// approvalProgramHash returns the hex SHA-512/256 hash of the approval
+// byteCode embedded in an ARC-56 spec. It returns false if the spec has no
+// byteCode for both programs, or declares template variables whose values
+// the byteCode cannot contain.
+func approvalProgramHash(specJSON []byte) (string, bool) {
+	extras, err := schema.ParseExtras(specJSON)
+	if err != nil || extras.ByteCode == nil {
+		return "", false
+	}
+	code := extras.ByteCode
+	if code.Approval == "" || code.Clear == "" || len(extras.TemplateVariables) > 0 {
+		return "", false
+	}
+	approval, err := base64.StdEncoding.DecodeString(code.Approval)
+	if err != nil {
+		return "", false
+	}
+	if _, err := base64.StdEncoding.DecodeString(code.Clear); err != nil {
+		return "", false
+	}
+	hash := sha512.Sum512_256(approval)
+	return hex.EncodeToString(hash[:]), true
+}
@@ -13,6 +13,9 @@ type MethodCallConfig struct {
 	CanUpdate     bool
 	CanDelete     bool
 	IsReadonly    bool
+
+	CanCreateNoOp  bool // create with the NoOp on-complete
+	CanCreateOptIn bool // create with the OptIn on-complete
 }
 
 // AnalyzeCallConfig determines the call configuration for a method.
@@ -25,8 +28,10 @@ func AnalyzeCallConfig(method algokit.Arc56Method) MethodCallConfig {
 		switch action {
 		case "NoOp":
 			config.CanCreate = true
+			config.CanCreateNoOp = true
 		case "OptIn":
 			config.CanCreate = true
+			config.CanCreateOptIn = true
 			config.CanOptIn = true
 		}
 	}
@@ -57,6 +62,9 @@ type BareCallConfig struct {
 	CanCloseOut bool
 	CanUpdate   bool
 	CanDelete   bool
+
+	CanCreateNoOp  bool
+	CanCreateOptIn bool
 }
 
 // AnalyzeBareConfig determines the bare call configuration.
@@ -67,8 +75,10 @@ func AnalyzeBareConfig(bareActions algokit.BareActions) BareCallConfig {
 		switch action {
 		case "NoOp":
 			config.CanCreate = true
+			config.CanCreateNoOp = true
 		case "OptIn":
 			config.CanCreate = true
+			config.CanCreateOptIn = true
 			config.CanOptIn = true
 		}
 	}
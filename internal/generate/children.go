@@ -0,0 +1,84 @@
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"strings"
+
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// ChildApp declares that a method returns the app ID of a contract with its
+// own generated package, such as an app created by a factory contract.
+type ChildApp struct {
+	Import  string `json:"import"`            // import path of the child's generated package
+	Package string `json:"package,omitempty"` // package name, if not the last element of Import
+}
+
+// PackageName returns the name the child package is imported as.
+func (c ChildApp) PackageName() string {
+	if c.Package != "" {
+		return c.Package
+	}
+	return path.Base(c.Import)
+}
+
+// ChildApps maps ARC-56 method names to the child app their return identifies.
+type ChildApps map[string]ChildApp
+
+// LoadChildApps loads child app declarations from a JSON config file.
+func LoadChildApps(filePath string) (ChildApps, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read child config: %w", err)
+	}
+
+	var children ChildApps
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&children); err != nil {
+		return nil, fmt.Errorf("failed to parse child config %s: %w", filePath, err)
+	}
+	return children, nil
+}
+
+// Check reports declarations that are incomplete or don't apply to the contract.
+func (c ChildApps) Check(contract *algokit.Arc56Contract) error {
+	var problems []string
+	imports := make(map[string]string)
+	for _, name := range sortedKeys(c) {
+		child := c[name]
+		if child.Import == "" {
+			problems = append(problems, fmt.Sprintf("%q: import is required", name))
+		} else if pkg := child.PackageName(); !token.IsIdentifier(pkg) || token.IsKeyword(pkg) {
+			problems = append(problems, fmt.Sprintf("%q: %q is not a valid package name", name, pkg))
+		} else if prev, ok := imports[pkg]; ok && prev != child.Import {
+			problems = append(problems, fmt.Sprintf("package %s is imported from both %s and %s", pkg, prev, child.Import))
+		} else {
+			imports[pkg] = child.Import
+		}
+
+		found := false
+		for _, m := range contract.Methods {
+			if m.Name != name {
+				continue
+			}
+			found = true
+			if m.Returns.Type != "uint64" {
+				problems = append(problems, fmt.Sprintf("%q: returns %s, expected a uint64 app ID", name, m.Returns.Type))
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("%q: no such method in %s", name, contract.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid child config:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
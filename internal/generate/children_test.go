@@ -0,0 +1,99 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+func TestRenderChildApps(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/akita/AuctionFactory.arc56.json")
+	if err != nil {
+		t.Skipf("akita testdata not available: %v", err)
+	}
+
+	children := ChildApps{
+		"newAuction":         {Import: "example.com/clients/auction"},
+		"newPrizeBoxAuction": {Import: "example.com/clients/auction-v2", Package: "auctionv2"},
+	}
+	files, err := Render(context.Background(), contract, Options{PackageName: "auctionfactory", Mode: "full", ChildApps: children})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	types := string(files["types.go"])
+	for _, want := range []string{
+		`auction "example.com/clients/auction"`,
+		`auctionv2 "example.com/clients/auction-v2"`,
+		"func (r *NewAuctionMethodResult) Child() (*auction.Client, error) {",
+		"func (r *NewPrizeBoxAuctionMethodResult) Child() (*auctionv2.Client, error) {",
+	} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.go does not contain %q", want)
+		}
+	}
+	if strings.Contains(types, "func (r *NewAuctionCostMethodResult) Child()") {
+		t.Error("only declared methods should have a Child accessor")
+	}
+	if !strings.Contains(string(files["client.go"]), "childParams:              c.params,") {
+		t.Error("client.go should pass its params to child results")
+	}
+}
+
+func TestChildAppsCheck(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	void := ChildApps{"pause_registry": {Import: "example.com/x"}}
+	err = void.Check(contract)
+	if err == nil || !strings.Contains(err.Error(), "expected a uint64 app ID") {
+		t.Errorf("expected return type error, got %v", err)
+	}
+
+	invalid := ChildApps{
+		"missing":         {Import: "example.com/x"},
+		"create":          {Import: "example.com/x", Package: "type"},
+		"pause_proposals": {Import: "example.com/other/x"},
+	}
+	err = invalid.Check(contract)
+	if err == nil {
+		t.Fatal("expected errors")
+	}
+	for _, want := range []string{
+		`"missing": no such method`,
+		`"type" is not a valid package name`,
+		`package x is imported from both example.com/x and example.com/other/x`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not mention %q: %v", want, err)
+		}
+	}
+}
+
+func TestLoadChildApps(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "children.json")
+	if err := os.WriteFile(path, []byte(`{"newPool": {"import": "example.com/stakingpool"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	children, err := LoadChildApps(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := children["newPool"].PackageName(); got != "stakingpool" {
+		t.Errorf("PackageName() = %q, want stakingpool", got)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"newPool": {"importPath": "example.com/stakingpool"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadChildApps(path); err == nil {
+		t.Error("expected unknown field error")
+	}
+}
@@ -1,8 +1,12 @@
 package generate
 
 import (
+	"crypto/sha512"
+	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -19,6 +23,25 @@ type GeneratorContext struct {
 	Imports       map[string]bool
 	PreserveNames bool
 	usedNames     map[string]bool // tracks all type names to avoid collisions
+
+	Untyped      []UntypedLocation        // ABI types with no Go mapping, sorted by location
+	Wrappers     map[string]ABIWrapper    // generated wrapper types keyed by Go name
+	Converters   map[string]TypeConverter // override converters keyed by Go type
+	CodecImports map[string]bool          // imports needed by the converters
+	HasCodec     bool                     // true if any value goes through the generated ABI codec
+	Events       []EventData              // ARC-28 events, sorted by name
+	hooks        Hooks
+	types        *typeMapper
+}
+
+// UntypedLocation records where an ABI type without a Go mapping was used.
+type UntypedLocation struct {
+	Location string // e.g. "method hello arg name"
+	ABIType  string
+}
+
+func (u UntypedLocation) String() string {
+	return fmt.Sprintf("%s: ABI type %q has no Go mapping", u.Location, u.ABIType)
 }
 
 // MethodData holds processed data for a single method.
@@ -31,6 +54,8 @@ type MethodData struct {
 	CallConfig    MethodCallConfig
 	Desc          string
 	ABIArgTypes   []string // ABI types for non-transaction args
+
+	Child *ChildApp // set if the uint64 return is the app ID of a child contract
 }
 
 // ArgData holds processed data for a single method argument.
@@ -42,6 +67,10 @@ type ArgData struct {
 	IsTransaction bool
 	IsReference   bool
 	StructName   string // If the arg is a struct
+
+	Untyped bool // True if the ABI type has no Go mapping
+	Codec   bool // True if the value must be converted for the ABI encoder
+	Imports []string
 }
 
 // StructData holds processed data for a generated struct type.
@@ -56,6 +85,9 @@ type StructFieldData struct {
 	GoType   string // Go type string
 	ABIType  string // Original ABI type string
 	JSONTag  string // JSON tag for serialization
+
+	Untyped bool // True if the ABI type has no Go mapping
+	Imports []string
 }
 
 // StateData holds processed data for the contract's state.
@@ -76,6 +108,11 @@ type StateKeyData struct {
 	ValueType string // Go type for the value
 	ABIType   string // ABI type string
 	Desc      string
+
+	OriginalName string // name in the spec
+	DecodeType   string // ABI type of the stored bytes, with structs as tuples
+	Untyped      bool   // True if the value type has no Go mapping
+	Imports      []string
 }
 
 // StateMapData holds processed data for a state map.
@@ -85,16 +122,48 @@ type StateMapData struct {
 	ValueType string // Go type for the map value
 	Prefix    string // Base64 encoded prefix
 	Desc      string
+
+	OriginalName    string // name in the spec
+	KeyDecodeType   string // ABI type of the encoded key, with structs as tuples
+	ValueDecodeType string // ABI type of the stored bytes, with structs as tuples
+	Untyped         bool   // True if the key or value type has no Go mapping
+	Imports         []string
+}
+
+// EventData holds processed data for an ARC-28 event.
+type EventData struct {
+	Name         string // Go type name, the PascalCase event name plus "Event"
+	OriginalName string // Original event name
+	Signature    string // e.g. "Transfer(address,uint64)", with structs as tuples
+	ArgsType     string // ABI tuple type of the args, e.g. "(address,uint64)"
+	Selector     string // first 4 bytes of the signature's SHA-512/256 hash as a Go byte list
+	Desc         string
+	Fields       []StructFieldData
 }
 
 // BuildContext creates a GeneratorContext from an ARC-56 contract.
 func BuildContext(contract *algokit.Arc56Contract, packageName string, mode string, preserveNames bool) *GeneratorContext {
+	return BuildContextWithHooks(contract, packageName, mode, preserveNames, Hooks{})
+}
+
+// BuildContextWithHooks is like BuildContext but applies the given naming
+// and type mapping hooks.
+func BuildContextWithHooks(contract *algokit.Arc56Contract, packageName string, mode string, preserveNames bool, hooks Hooks) *GeneratorContext {
+	return buildContext(contract, nil, packageName, mode, preserveNames, hooks, nil)
+}
+
+func buildContext(contract *algokit.Arc56Contract, events []schema.Event, packageName string, mode string, preserveNames bool, hooks Hooks, overrides *TypeOverrides) *GeneratorContext {
 	ctx := &GeneratorContext{
 		PackageName:   packageName,
-		ContractName:  ToPascalCase(contract.Name),
+		ContractName:  hooks.name(NameContract, contract.Name),
 		Imports:       make(map[string]bool),
+		Wrappers:      make(map[string]ABIWrapper),
+		Converters:    make(map[string]TypeConverter),
+		CodecImports:  make(map[string]bool),
 		PreserveNames: preserveNames,
 		usedNames:     make(map[string]bool),
+		hooks:         hooks,
+		types:         &typeMapper{structs: contract.Structs, hooks: hooks, overrides: overrides},
 	}
 
 	ctx.BareConfig = AnalyzeBareConfig(contract.BareActions)
@@ -102,21 +171,28 @@ func BuildContext(contract *algokit.Arc56Contract, packageName string, mode stri
 
 	// Process structs - register names first
 	for name, fields := range contract.Structs {
-		goName := ToPascalCase(name)
+		// Structs overridden with hand-written types are not generated
+		if _, ok := overrides.lookupStruct(name); ok {
+			continue
+		}
+		goName := hooks.name(NameStruct, name)
 		ctx.usedNames[goName] = true
 		sd := StructData{
 			Name: goName,
 		}
 		for _, f := range fields {
-			tm := mapType(f.Type, contract.Structs)
+			tm := ctx.types.mapField(name, f.Name, f.Type, "")
 			for _, imp := range tm.Imports {
 				ctx.Imports[imp] = true
 			}
+			ctx.trackType(fmt.Sprintf("struct %s field %s", name, f.Name), f.Type, tm)
 			sd.Fields = append(sd.Fields, StructFieldData{
-				Name:    ToPascalCase(f.Name),
+				Name:    hooks.name(NameStructField, f.Name),
 				GoType:  tm.GoType,
 				ABIType: f.Type,
 				JSONTag: f.Name,
+				Untyped: tm.Unmapped,
+				Imports: tm.Imports,
 			})
 		}
 		ctx.Structs = append(ctx.Structs, sd)
@@ -125,7 +201,7 @@ func BuildContext(contract *algokit.Arc56Contract, packageName string, mode stri
 	// Process methods
 	for _, m := range contract.Methods {
 		md := MethodData{
-			Name:         ToPascalCase(m.Name),
+			Name:         hooks.name(NameMethod, m.Name),
 			OriginalName: m.Name,
 			Signature:    m.GetSignature(),
 			CallConfig:   AnalyzeCallConfig(m),
@@ -133,27 +209,32 @@ func BuildContext(contract *algokit.Arc56Contract, packageName string, mode stri
 		}
 
 		if preserveNames {
-			md.Name = ToPascalCase(m.Name)
+			md.Name = hooks.name(NameMethod, m.Name)
 		}
 
 		// Process args
 		for _, arg := range m.Args {
-			tm := MapABITypeToGo(arg.Type, contract.Structs, arg.Struct)
+			tm := ctx.types.mapField(m.Name, arg.Name, arg.Type, arg.Struct)
 			for _, imp := range tm.Imports {
 				ctx.Imports[imp] = true
 			}
 
+			ctx.trackType(fmt.Sprintf("method %s arg %s", m.Name, arg.Name), arg.Type, tm)
+
 			isTransaction := isTransactionType(arg.Type)
 			isReference := isReferenceType(arg.Type)
 
 			ad := ArgData{
-				Name:          ToPascalCase(arg.Name),
+				Name:          hooks.name(NameArg, arg.Name),
 				OriginalName:  arg.Name,
 				GoType:        tm.GoType,
 				ABIType:       arg.Type,
 				IsTransaction: isTransaction,
 				IsReference:   isReference,
 				StructName:    tm.StructName,
+				Untyped:       tm.Unmapped,
+				Codec:         tm.Codec,
+				Imports:       tm.Imports,
 			}
 
 			md.Args = append(md.Args, ad)
@@ -164,10 +245,11 @@ func BuildContext(contract *algokit.Arc56Contract, packageName string, mode stri
 		}
 
 		// Process return type
-		md.ReturnType = MapABITypeToGo(m.Returns.Type, contract.Structs, m.Returns.Struct)
+		md.ReturnType = ctx.types.mapArg(m.Returns.Type, m.Returns.Struct)
 		for _, imp := range md.ReturnType.Imports {
 			ctx.Imports[imp] = true
 		}
+		ctx.trackType(fmt.Sprintf("method %s return", m.Name), m.Returns.Type, md.ReturnType)
 
 		ctx.Methods = append(ctx.Methods, md)
 	}
@@ -175,6 +257,18 @@ func BuildContext(contract *algokit.Arc56Contract, packageName string, mode stri
 	// Process state
 	ctx.State = buildStateData(contract, ctx)
 
+	// Process events
+	ctx.Events = buildEventData(events, ctx)
+
+	// Spec maps are iterated in random order; sort so output is reproducible
+	sort.Slice(ctx.Structs, func(i, j int) bool {
+		return ctx.Structs[i].Name < ctx.Structs[j].Name
+	})
+
+	sort.SliceStable(ctx.Untyped, func(i, j int) bool {
+		return ctx.Untyped[i].Location < ctx.Untyped[j].Location
+	})
+
 	return ctx
 }
 
@@ -182,72 +276,169 @@ func buildStateData(contract *algokit.Arc56Contract, ctx *GeneratorContext) Stat
 	sd := StateData{}
 
 	for name, key := range contract.State.Keys.Global {
-		tm := mapType(key.ValueType, contract.Structs)
+		tm := ctx.types.mapField("global", name, key.ValueType, "")
 		for _, imp := range tm.Imports {
 			ctx.Imports[imp] = true
 		}
+		ctx.trackType(fmt.Sprintf("global state key %s", name), key.ValueType, tm)
 		sd.Global = append(sd.Global, StateKeyData{
-			Name:      ToPascalCase(name),
+			Name:      ctx.hooks.name(NameStateKey, name),
 			Key:       key.Key,
 			ValueType: tm.GoType,
 			ABIType:   key.ValueType,
 			Desc:      key.Desc,
+
+			OriginalName: name,
+			DecodeType:   ctx.types.decodeType(key.ValueType),
+			Untyped:      tm.Unmapped,
+			Imports:      tm.Imports,
 		})
 		sd.HasGlobal = true
 	}
 
 	for name, key := range contract.State.Keys.Local {
-		tm := mapType(key.ValueType, contract.Structs)
+		tm := ctx.types.mapField("local", name, key.ValueType, "")
 		for _, imp := range tm.Imports {
 			ctx.Imports[imp] = true
 		}
+		ctx.trackType(fmt.Sprintf("local state key %s", name), key.ValueType, tm)
 		sd.Local = append(sd.Local, StateKeyData{
-			Name:      ToPascalCase(name),
+			Name:      ctx.hooks.name(NameStateKey, name),
 			Key:       key.Key,
 			ValueType: tm.GoType,
 			ABIType:   key.ValueType,
 			Desc:      key.Desc,
+
+			OriginalName: name,
+			DecodeType:   ctx.types.decodeType(key.ValueType),
+			Untyped:      tm.Unmapped,
+			Imports:      tm.Imports,
 		})
 		sd.HasLocal = true
 	}
 
 	for name, key := range contract.State.Keys.Box {
-		tm := mapType(key.ValueType, contract.Structs)
+		tm := ctx.types.mapField("box", name, key.ValueType, "")
 		for _, imp := range tm.Imports {
 			ctx.Imports[imp] = true
 		}
+		ctx.trackType(fmt.Sprintf("box state key %s", name), key.ValueType, tm)
 		sd.Box = append(sd.Box, StateKeyData{
-			Name:      ToPascalCase(name),
+			Name:      ctx.hooks.name(NameStateKey, name),
 			Key:       key.Key,
 			ValueType: tm.GoType,
 			ABIType:   key.ValueType,
 			Desc:      key.Desc,
+
+			OriginalName: name,
+			DecodeType:   ctx.types.decodeType(key.ValueType),
+			Untyped:      tm.Unmapped,
+			Imports:      tm.Imports,
 		})
 		sd.HasBox = true
 	}
 
 	for name, mapDef := range contract.State.Maps.Box {
-		keyTm := mapType(mapDef.KeyType, contract.Structs)
-		valTm := mapType(mapDef.ValueType, contract.Structs)
+		keyTm := ctx.types.mapType(mapDef.KeyType)
+		valTm := ctx.types.mapField("box", name, mapDef.ValueType, "")
 		for _, imp := range keyTm.Imports {
 			ctx.Imports[imp] = true
 		}
 		for _, imp := range valTm.Imports {
 			ctx.Imports[imp] = true
 		}
+		ctx.trackType(fmt.Sprintf("box map %s key", name), mapDef.KeyType, keyTm)
+		ctx.trackType(fmt.Sprintf("box map %s value", name), mapDef.ValueType, valTm)
 		sd.BoxMaps = append(sd.BoxMaps, StateMapData{
-			Name:      ToPascalCase(name),
+			Name:      ctx.hooks.name(NameStateMap, name),
 			KeyType:   keyTm.GoType,
 			ValueType: valTm.GoType,
 			Prefix:    mapDef.Prefix,
 			Desc:      mapDef.Desc,
+
+			OriginalName:    name,
+			KeyDecodeType:   ctx.types.decodeType(mapDef.KeyType),
+			ValueDecodeType: ctx.types.decodeType(mapDef.ValueType),
+			Untyped:         keyTm.Unmapped || valTm.Unmapped,
+			Imports:         append(append([]string(nil), keyTm.Imports...), valTm.Imports...),
 		})
 		sd.HasBox = true
 	}
 
+	for _, keys := range [][]StateKeyData{sd.Global, sd.Local, sd.Box} {
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].Name < keys[j].Name
+		})
+	}
+	sort.Slice(sd.BoxMaps, func(i, j int) bool {
+		return sd.BoxMaps[i].Name < sd.BoxMaps[j].Name
+	})
+
 	return sd
 }
 
+func buildEventData(events []schema.Event, ctx *GeneratorContext) []EventData {
+	var result []EventData
+	for _, e := range events {
+		ed := EventData{
+			Name:         ctx.hooks.name(NameEvent, e.Name) + "Event",
+			OriginalName: e.Name,
+			Desc:         e.Desc,
+		}
+		argTypes := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			tm := ctx.types.mapField(e.Name, arg.Name, arg.Type, arg.Struct)
+			for _, imp := range tm.Imports {
+				ctx.Imports[imp] = true
+			}
+			ctx.trackType(fmt.Sprintf("event %s arg %s", e.Name, arg.Name), arg.Type, tm)
+			ed.Fields = append(ed.Fields, StructFieldData{
+				Name:    ctx.hooks.name(NameStructField, arg.Name),
+				GoType:  tm.GoType,
+				ABIType: arg.Type,
+				JSONTag: arg.Name,
+				Untyped: tm.Unmapped,
+				Imports: tm.Imports,
+			})
+			argTypes[i] = ctx.types.decodeType(arg.Type)
+		}
+		ed.ArgsType = "(" + strings.Join(argTypes, ",") + ")"
+		ed.Signature = e.Name + ed.ArgsType
+		hash := sha512.Sum512_256([]byte(ed.Signature))
+		selector := make([]string, 4)
+		for i, b := range hash[:4] {
+			selector[i] = fmt.Sprintf("0x%02x", b)
+		}
+		ed.Selector = strings.Join(selector, ", ")
+		result = append(result, ed)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// trackType records the wrapper types a mapping needs and any location
+// whose ABI type has no Go mapping.
+func (ctx *GeneratorContext) trackType(location, abiType string, tm TypeMapping) {
+	for _, w := range tm.Wrappers {
+		ctx.Wrappers[w.Name] = w
+	}
+	if tm.Codec {
+		ctx.HasCodec = true
+	}
+	for _, c := range tm.Converters {
+		ctx.Converters[c.GoType] = c
+		for _, imp := range tm.Imports {
+			ctx.CodecImports[imp] = true
+		}
+	}
+	if tm.Unmapped {
+		ctx.Untyped = append(ctx.Untyped, UntypedLocation{Location: location, ABIType: abiType})
+	}
+}
+
 func isTransactionType(t string) bool {
 	txnTypes := map[string]bool{
 		"pay": true, "txn": true, "appl": true,
@@ -276,6 +467,26 @@ func (m *MethodData) HasNonVoidReturn() bool {
 	return !m.ReturnType.IsVoid
 }
 
+// HasCodecArgs returns true if any arg must be converted for the ABI encoder.
+func (m *MethodData) HasCodecArgs() bool {
+	for _, a := range m.Args {
+		if a.Codec {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTransactionArgs returns true if any arg is a transaction.
+func (m *MethodData) HasTransactionArgs() bool {
+	for _, a := range m.Args {
+		if a.IsTransaction {
+			return true
+		}
+	}
+	return false
+}
+
 // HasArgs returns true if the method has any args.
 func (m *MethodData) HasArgs() bool {
 	return len(m.Args) > 0
@@ -286,6 +497,15 @@ func (m *MethodData) GetArgsStructName() string {
 	return m.Name + "Args"
 }
 
+// GetParamsArgsType returns the type argument of the method's
+// algokit.CallParams: the args struct, or struct{} for methods without args.
+func (m *MethodData) GetParamsArgsType() string {
+	if !m.HasArgs() {
+		return "struct{}"
+	}
+	return m.GetArgsStructName()
+}
+
 // GetResultStructName returns the name for the result struct.
 func (m *MethodData) GetResultStructName() string {
 	return m.Name + "MethodResult"
@@ -0,0 +1,151 @@
+package generate
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// CLICommand is a method subcommand of the program generated with --emit-cli.
+type CLICommand struct {
+	Method *MethodData
+	Use    string // kebab-case subcommand name
+	Flags  []CLIFlag
+}
+
+// CLIFlag is a flag parsed into one field of a method's args struct.
+type CLIFlag struct {
+	Name string // kebab-case flag name
+	Arg  ArgData
+}
+
+// CLISkip is a method the generated CLI cannot call.
+type CLISkip struct {
+	Name   string // original method name
+	Reason string
+}
+
+// cliReservedCommands are subcommand names used by the generated CLI itself.
+var cliReservedCommands = map[string]bool{"state": true, "replay": true, "help": true, "completion": true}
+
+// cliReservedFlags are the persistent flags of the generated CLI.
+var cliReservedFlags = map[string]bool{
+	"help": true, "app-id": true, "sender": true, "mnemonic-env": true,
+	"kmd-url": true, "kmd-token": true, "kmd-wallet": true, "kmd-password-env": true,
+	"record": true,
+}
+
+// buildCLICommands returns a subcommand for each method callable on an
+// existing app, and the methods skipped because their args cannot be given
+// as flags.
+func buildCLICommands(methods []MethodData) ([]CLICommand, []CLISkip) {
+	var commands []CLICommand
+	var skipped []CLISkip
+	for i := range methods {
+		m := &methods[i]
+		if !m.CallConfig.CanCall {
+			continue
+		}
+		reason := ""
+		for _, a := range m.Args {
+			switch {
+			case a.IsTransaction:
+				reason = fmt.Sprintf("transaction arg %s", a.OriginalName)
+			case a.Untyped:
+				reason = fmt.Sprintf("arg %s has no Go type", a.OriginalName)
+			}
+			if reason != "" {
+				break
+			}
+		}
+		if reason != "" {
+			skipped = append(skipped, CLISkip{Name: m.OriginalName, Reason: reason})
+			continue
+		}
+
+		cmd := CLICommand{Method: m, Use: ToKebabCase(m.Name)}
+		if cliReservedCommands[cmd.Use] {
+			cmd.Use += "-method"
+		}
+		for _, a := range m.Args {
+			name := ToKebabCase(a.Name)
+			if cliReservedFlags[name] {
+				name = "arg-" + name
+			}
+			cmd.Flags = append(cmd.Flags, CLIFlag{Name: name, Arg: a})
+		}
+		commands = append(commands, cmd)
+	}
+	return commands, skipped
+}
+
+// ToKebabCase converts a PascalCase or camelCase Go name to kebab-case,
+// e.g. "GetBoxValue" to "get-box-value".
+func ToKebabCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('-')
+			}
+		}
+		if r == '_' {
+			b.WriteByte('-')
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// ModuleImportPath returns the Go import path of dir from the module path in
+// the nearest go.mod at or above it.
+func ModuleImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := abs; ; root = filepath.Dir(root) {
+		module, err := readModulePath(filepath.Join(root, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(module, filepath.ToSlash(rel)), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("no go.mod found above %s; set the import path of the generated package", dir)
+		}
+	}
+}
+
+func readModulePath(gomod string) (string, error) {
+	f, err := os.Open(gomod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if module, ok := strings.CutPrefix(line, "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s has no module directive", gomod)
+}
@@ -0,0 +1,116 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+func TestToKebabCase(t *testing.T) {
+	tests := map[string]string{
+		"GetBox":                "get-box",
+		"DecodeUint64":          "decode-uint64",
+		"SetXgovManager":        "set-xgov-manager",
+		"HTTPServer":            "http-server",
+		"Init":                  "init",
+		"Config_XgovRegistry":   "config-xgov-registry",
+		"DynamicArrayOfArrays2": "dynamic-array-of-arrays2",
+	}
+	for in, want := range tests {
+		if got := ToKebabCase(in); got != want {
+			t.Errorf("ToKebabCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRenderEmitCLI(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	opts := Options{PackageName: "xgov", Mode: "full", EmitCLI: true, CLIImportPath: "example.com/clients/xgov"}
+	files, err := Render(context.Background(), contract, opts)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	cli, ok := files["cmd/xgov/main.go"]
+	if !ok {
+		t.Fatal("cmd/xgov/main.go was not generated")
+	}
+	src := string(cli)
+	for _, want := range []string{
+		"package main",
+		`app "example.com/clients/xgov"`,
+		`Use:   "set-xgov-manager",`,
+		`parseFlag("manager", flags.Manager, &args.Manager)`,
+		`cmd.Flags().StringVar(&flags.ProposalID, "proposal-id", "", "proposal_id (uint64)")`,
+		"client.SendGetState(cmd.Context())",
+		"// subscribe_xgov has no subcommand: transaction arg payment.",
+		"func newStateCmd(opts *options) *cobra.Command {",
+		`flags.StringVar(&opts.algodURL, "algod-url", "http://localhost:4001", "Algod URL")`,
+		"appID, err = app.NetworkAppID(ctx, algodClient)",
+		"client := app.NewClientWithAlgod(algodClient, appID, clientOpts)",
+		"client.Recorder = app.NewJSONLRecorder(f)",
+		"results, err := client.Replay(cmd.Context(), f, app.ReplayOptions{})",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("main.go does not contain %q", want)
+		}
+	}
+	if strings.Contains(src, "LocalNet") {
+		t.Error("main.go should connect to --algod-url, not LocalNet")
+	}
+	if strings.Contains(src, `Use:   "create"`) {
+		t.Error("create-only methods should have no subcommand")
+	}
+
+	opts.CLIImportPath = ""
+	if _, err := Render(context.Background(), contract, opts); err == nil {
+		t.Error("expected an error without an import path")
+	}
+}
+
+func TestBuildCLICommandsReserved(t *testing.T) {
+	methods := []MethodData{{
+		Name:       "State",
+		CallConfig: MethodCallConfig{CanCall: true},
+		Args:       []ArgData{{Name: "Sender", OriginalName: "sender"}, {Name: "Amount", OriginalName: "amount"}},
+	}}
+	commands, skipped := buildCLICommands(methods)
+	if len(commands) != 1 || len(skipped) != 0 {
+		t.Fatalf("got %d commands and %d skipped", len(commands), len(skipped))
+	}
+	if commands[0].Use != "state-method" {
+		t.Errorf("Use = %q, want state-method", commands[0].Use)
+	}
+	if commands[0].Flags[0].Name != "arg-sender" || commands[0].Flags[1].Name != "amount" {
+		t.Errorf("unexpected flags %+v", commands[0].Flags)
+	}
+}
+
+func TestModuleImportPath(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/ops\n\ngo 1.22\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "clients", "xgov")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ModuleImportPath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "example.com/ops/clients/xgov" {
+		t.Errorf("ModuleImportPath = %q", got)
+	}
+	if got, err := ModuleImportPath(root); err != nil || got != "example.com/ops" {
+		t.Errorf("ModuleImportPath(root) = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,109 @@
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// FuzzStruct describes a generated struct covered by the emitted round-trip tests.
+type FuzzStruct struct {
+	Name    string // Go type name
+	ABIType string // tuple type with nested structs expanded
+	Shape   string // Go literal of the fuzzType describing ABIType
+	Codec   bool   // true if the client decodes it with fromABIValue rather than TupleToStruct
+}
+
+// StructTupleType returns the ABI tuple type of an ARC-56 struct, expanding
+// nested struct references. It returns false for recursive structs.
+func StructTupleType(name string, structs map[string][]algokit.StructField) (string, bool) {
+	return structTupleType(name, structs, map[string]bool{})
+}
+
+func structTupleType(name string, structs map[string][]algokit.StructField, visiting map[string]bool) (string, bool) {
+	if visiting[name] {
+		return "", false
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	parts := make([]string, 0, len(structs[name]))
+	for _, f := range structs[name] {
+		if _, ok := structs[f.Type]; ok {
+			nested, ok := structTupleType(f.Type, structs, visiting)
+			if !ok {
+				return "", false
+			}
+			parts = append(parts, nested)
+			continue
+		}
+		parts = append(parts, f.Type)
+	}
+	return "(" + strings.Join(parts, ",") + ")", true
+}
+
+// buildFuzzStructs returns the generated structs whose ABI type can be
+// round-tripped: every field must be a valid ARC-4 type with a Go mapping.
+func buildFuzzStructs(ctx *GeneratorContext, contract *algokit.Arc56Contract) []FuzzStruct {
+	goNames := make(map[string]string, len(contract.Structs))
+	for name := range contract.Structs {
+		goNames[ctx.hooks.name(NameStruct, name)] = name
+	}
+
+	var result []FuzzStruct
+	for _, sd := range ctx.Structs {
+		untyped := false
+		for _, f := range sd.Fields {
+			untyped = untyped || f.Untyped
+		}
+		name, ok := goNames[sd.Name]
+		if untyped || !ok {
+			continue
+		}
+		tupleType, ok := StructTupleType(name, contract.Structs)
+		if !ok {
+			continue
+		}
+		parsed, err := ParseABIType(tupleType)
+		if err != nil {
+			continue
+		}
+		result = append(result, FuzzStruct{
+			Name:    sd.Name,
+			ABIType: tupleType,
+			Shape:   fuzzShape(parsed),
+			Codec:   ctx.types.structMapping(name).Codec,
+		})
+	}
+	return result
+}
+
+// fuzzShape renders a parsed ABI type as a fuzzType literal for the emitted tests.
+func fuzzShape(t *ABIType) string {
+	switch t.Kind {
+	case ABIUint:
+		return fmt.Sprintf(`&fuzzType{kind: "uint", bits: %d}`, t.Bits)
+	case ABIUfixed:
+		return fmt.Sprintf(`&fuzzType{kind: "ufixed", bits: %d}`, t.Bits)
+	case ABIByte:
+		return `&fuzzType{kind: "byte"}`
+	case ABIBool:
+		return `&fuzzType{kind: "bool"}`
+	case ABIAddress:
+		return `&fuzzType{kind: "address"}`
+	case ABIString:
+		return `&fuzzType{kind: "string"}`
+	case ABIStaticArray:
+		return fmt.Sprintf(`&fuzzType{kind: "static", length: %d, elem: %s}`, t.Length, fuzzShape(t.Elem))
+	case ABIDynamicArray:
+		return fmt.Sprintf(`&fuzzType{kind: "dynamic", elem: %s}`, fuzzShape(t.Elem))
+	case ABITuple:
+		fields := make([]string, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = fuzzShape(f)
+		}
+		return fmt.Sprintf(`&fuzzType{kind: "tuple", fields: []*fuzzType{%s}}`, strings.Join(fields, ", "))
+	}
+	return "nil"
+}
@@ -2,17 +2,22 @@ package generate
 
 import (
 	"bytes"
+	"context"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"go/format"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
@@ -21,15 +26,88 @@ var templateFS embed.FS
 
 // Options configures the code generator.
 type Options struct {
-	AppSpecPath   string
-	OutputDir     string
-	PackageName   string
-	Mode          string // "full" or "minimal"
-	PreserveNames bool
+	AppSpecPath      string
+	OutputDir        string
+	PackageName      string
+	Mode             string // "full" or "minimal"
+	PreserveNames    bool
+	AllowUntyped     bool // emit ABI types without a Go mapping as any instead of failing
+	Hooks            Hooks
+	Templates        fs.FS             // custom templates overriding or extending the embedded ones
+	TypeOverrides    *TypeOverrides    // Go types replacing the default mapping
+	EmitTests        bool              // also emit round-trip fuzz tests for the generated structs
+	ChildApps        ChildApps         // methods returning the app IDs of child contracts
+	Networks         map[string]uint64 // app IDs by base64 genesis hash, added to or replacing the spec's networks
+	EmitCLI          bool              // also emit a cobra program in cmd/<package>
+	CLIImportPath    string            // import path of the generated package; Generate derives it from go.mod if empty
+	EmitFake         bool              // also emit fake.go with FakeClient
+	EmitRecorder     bool              // also emit recorder.go with Recorder and Replay; implied by EmitCLI
+	EmitJSON         bool              // also emit json.go with MarshalJSON and UnmarshalJSON; implied by EmitRecorder
+	EmitInterceptors bool              // also emit interceptor.go with Client.Interceptors and Composer.Use
+	EmitParamsCache  bool              // also emit paramscache.go with SuggestedParamsCache
+	EmitAsync        bool              // also emit async.go with SendAsync and Send{Method}Async
+	EmitOffline      bool              // also emit offline.go with Build and SubmitSigned; implies EmitAsync
+	Events           []schema.Event    // ARC-28 events of the spec, which algokit.Arc56Contract does not hold
 }
 
-// Generate generates typed Go client code from an ARC-56 contract specification.
+// Generate generates typed Go client code from an ARC-56 contract specification
+// and writes it to opts.OutputDir.
 func Generate(contract *algokit.Arc56Contract, opts Options) error {
+	if opts.EmitCLI && opts.CLIImportPath == "" {
+		importPath, err := ModuleImportPath(opts.OutputDir)
+		if err != nil {
+			return err
+		}
+		opts.CLIImportPath = importPath
+	}
+
+	files, err := Render(context.Background(), contract, opts)
+	if err != nil {
+		var fe *FormatError
+		if !errors.As(err, &fe) {
+			return err
+		}
+		// Write the unformatted code for debugging
+		files = map[string][]byte{fe.Filename: fe.Source}
+	}
+
+	// Create output directory
+	if mkErr := os.MkdirAll(opts.OutputDir, 0o755); mkErr != nil {
+		return fmt.Errorf("failed to create output directory: %w", mkErr)
+	}
+
+	for filename, src := range files {
+		dst := filepath.Join(opts.OutputDir, filepath.FromSlash(filename))
+		if mkErr := os.MkdirAll(filepath.Dir(dst), 0o755); mkErr != nil {
+			return fmt.Errorf("failed to create output directory: %w", mkErr)
+		}
+		if writeErr := os.WriteFile(dst, src, 0o644); writeErr != nil {
+			return fmt.Errorf("failed to write %s: %w", filename, writeErr)
+		}
+	}
+
+	return err
+}
+
+// FormatError is returned when generated code cannot be formatted, which
+// means it has syntax errors. Source holds the unformatted code.
+type FormatError struct {
+	Filename string
+	Source   []byte
+	Err      error
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("failed to generate %s: generated code has syntax errors: %v", e.Filename, e.Err)
+}
+
+func (e *FormatError) Unwrap() error {
+	return e.Err
+}
+
+// Render generates typed Go client code from an ARC-56 contract specification
+// and returns the formatted files keyed by file name.
+func Render(ctx context.Context, contract *algokit.Arc56Contract, opts Options) (map[string][]byte, error) {
 	// Determine package name
 	packageName := opts.PackageName
 	if packageName == "" {
@@ -37,41 +115,64 @@ func Generate(contract *algokit.Arc56Contract, opts Options) error {
 	}
 
 	// Build generator context
-	ctx := BuildContext(contract, packageName, opts.Mode, opts.PreserveNames)
+	if err := opts.TypeOverrides.Check(contract); err != nil {
+		return nil, err
+	}
+	if err := opts.ChildApps.Check(contract); err != nil {
+		return nil, err
+	}
+	gctx := buildContext(contract, opts.Events, packageName, opts.Mode, opts.PreserveNames, opts.Hooks, opts.TypeOverrides)
+	for i := range gctx.Methods {
+		if child, ok := opts.ChildApps[gctx.Methods[i].OriginalName]; ok {
+			gctx.Methods[i].Child = &child
+		}
+	}
+	if len(gctx.Untyped) > 0 && !opts.AllowUntyped {
+		lines := make([]string, len(gctx.Untyped))
+		for i, u := range gctx.Untyped {
+			lines[i] = "  " + u.String()
+		}
+		return nil, fmt.Errorf("unmapped ABI types (pass --allow-untyped to generate them as any):\n%s", strings.Join(lines, "\n"))
+	}
 
 	// Serialize app spec JSON and quote it as a Go string literal
 	specJSON, err := json.Marshal(contract)
 	if err != nil {
-		return fmt.Errorf("failed to marshal app spec: %w", err)
+		return nil, fmt.Errorf("failed to marshal app spec: %w", err)
 	}
-	ctx.AppSpecJSON = strconv.Quote(string(specJSON))
-
-	// Create output directory
-	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
-		return fmt.Errorf("failed to create output directory: %w", err)
-	}
-
-	// Parse all templates
-	funcMap := template.FuncMap{
-		"join":    strings.Join,
-		"toLower": strings.ToLower,
-		"toUpper": strings.ToUpper,
-		"comment": func(s string) string {
-			lines := strings.Split(s, "\n")
-			for i, line := range lines {
-				lines[i] = "// " + line
-			}
-			return strings.Join(lines, "\n")
-		},
+	specJSON, networks, err := applyNetworks(specJSON, opts.Networks)
+	if err != nil {
+		return nil, err
 	}
+	gctx.AppSpecJSON = strconv.Quote(string(specJSON))
 
-	tmpl, err := template.New("").Funcs(funcMap).ParseFS(templateFS, "*.go.tmpl")
+	// Parse the embedded templates and any custom overrides
+	tmpl, extras, err := parseTemplates(opts.Templates)
 	if err != nil {
-		return fmt.Errorf("failed to parse templates: %w", err)
+		return nil, err
 	}
 
 	// Build template data
-	data := buildTemplateData(ctx, contract)
+	data := buildTemplateData(gctx, contract, opts.Mode)
+	data.ApprovalProgramHash, data.HasByteCode = approvalProgramHash(specJSON)
+	data.Networks = networks
+	data.EmitFake = opts.EmitFake
+	// The CLI records calls with --record
+	data.EmitRecorder = opts.EmitRecorder || opts.EmitCLI
+	// Records and CLI output hold args and returns in their JSON form
+	data.EmitJSON = opts.EmitJSON || data.EmitRecorder
+	data.EmitInterceptors = opts.EmitInterceptors
+	data.EmitParamsCache = opts.EmitParamsCache
+	data.EmitOffline = opts.EmitOffline
+	// Build and SubmitSigned use the SDK composer and PendingGroup of async.go
+	data.EmitAsync = opts.EmitAsync || opts.EmitOffline
+	if opts.EmitTests {
+		data.FuzzStructs = buildFuzzStructs(gctx, contract)
+		// The tests exercise the codec helpers in abitypes.go
+		if len(data.FuzzStructs) > 0 {
+			data.HasCodec = true
+		}
+	}
 
 	// Generate each file
 	files := map[string]string{
@@ -81,39 +182,100 @@ func Generate(contract *algokit.Arc56Contract, opts Options) error {
 		"composer.go": "composer.go.tmpl",
 	}
 
-	if ctx.HasFactory {
+	if opts.EmitFake {
+		files["fake.go"] = "fake.go.tmpl"
+	}
+
+	if data.EmitJSON {
+		files["json.go"] = "json.go.tmpl"
+	}
+	if data.EmitRecorder {
+		files["recorder.go"] = "recorder.go.tmpl"
+	}
+	if data.EmitInterceptors {
+		files["interceptor.go"] = "interceptor.go.tmpl"
+	}
+	if data.EmitParamsCache {
+		files["paramscache.go"] = "paramscache.go.tmpl"
+	}
+	if data.EmitAsync {
+		files["async.go"] = "async.go.tmpl"
+	}
+	if data.EmitOffline {
+		files["offline.go"] = "offline.go.tmpl"
+	}
+
+	if data.State.HasGlobal || data.State.HasLocal || data.State.HasBox {
+		files["state.go"] = "state.go.tmpl"
+	}
+	if len(data.Events) > 0 {
+		files["events.go"] = "events.go.tmpl"
+	}
+
+	if gctx.HasFactory {
 		files["factory.go"] = "factory.go.tmpl"
+		files["deploy.go"] = "deploy.go.tmpl"
+		files["lookup.go"] = "lookup.go.tmpl"
 	}
 
-	for filename, tmplName := range files {
-		if err := renderTemplate(tmpl, tmplName, data, filepath.Join(opts.OutputDir, filename)); err != nil {
-			return fmt.Errorf("failed to generate %s: %w", filename, err)
+	if data.HasCodec {
+		files["abitypes.go"] = "abitypes.go.tmpl"
+	}
+
+	if len(data.FuzzStructs) > 0 {
+		files["roundtrip_test.go"] = "roundtrip_test.go.tmpl"
+	}
+
+	if opts.EmitTests && data.HasCallMethods {
+		files["concurrency_test.go"] = "concurrency_test.go.tmpl"
+	}
+
+	if opts.EmitTests {
+		files["client_test.go"] = "client_test.go.tmpl"
+	}
+
+	if opts.EmitTests && data.EmitAsync {
+		files["async_test.go"] = "async_test.go.tmpl"
+	}
+
+	if opts.EmitCLI {
+		if opts.CLIImportPath == "" {
+			return nil, fmt.Errorf("--emit-cli needs the import path of the generated package")
 		}
+		data.CLIImportPath = opts.CLIImportPath
+		data.CLICommands, data.CLISkipped = buildCLICommands(data.Methods)
+		files["cmd/"+data.PackageName+"/main.go"] = "cli.go.tmpl"
 	}
 
-	return nil
-}
+	// Extra custom templates each produce a file named after the template
+	for _, name := range extras {
+		files[strings.TrimSuffix(path.Base(name), ".tmpl")] = name
+	}
+
+	out := make(map[string][]byte, len(files))
+	for filename, tmplName := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		src, err := renderTemplate(tmpl, tmplName, data)
+		if err != nil {
+			if fe, ok := err.(*FormatError); ok {
+				fe.Filename = filename
+				return nil, fe
+			}
+			return nil, fmt.Errorf("failed to generate %s: %w", filename, err)
+		}
+		out[filename] = src
+	}
 
-// templateData is the data passed to templates.
-type templateData struct {
-	PackageName              string
-	ContractName             string
-	AppSpecJSON              string
-	Methods                  []MethodData
-	Structs                  []StructData
-	State                    StateData
-	BareConfig               BareCallConfig
-	HasFactory               bool
-	HasMethodCreateWithArgs  bool
-	HasMethodCreateNoArgs    bool
-	CreateMethodOriginalName string
-	CreateMethodGoName       string
-	TypesImports             []string
-	ClientImports            []string
+	return out, nil
 }
 
-func buildTemplateData(ctx *GeneratorContext, contract *algokit.Arc56Contract) *templateData {
-	data := &templateData{
+func buildTemplateData(ctx *GeneratorContext, contract *algokit.Arc56Contract, mode string) *TemplateData {
+	data := &TemplateData{
+		Version:      TemplateDataVersion,
+		Mode:         mode,
+		Contract:     contract,
 		PackageName:  ctx.PackageName,
 		ContractName: ctx.ContractName,
 		AppSpecJSON:  ctx.AppSpecJSON,
@@ -122,19 +284,80 @@ func buildTemplateData(ctx *GeneratorContext, contract *algokit.Arc56Contract) *
 		State:        ctx.State,
 		BareConfig:   ctx.BareConfig,
 		HasFactory:   ctx.HasFactory,
+		Untyped:      ctx.Untyped,
+		Events:       ctx.Events,
 	}
 
-	// Compute create method metadata for factory template
+	for _, w := range ctx.Wrappers {
+		data.Wrappers = append(data.Wrappers, w)
+		if w.IsUFixed() {
+			data.HasUFixed = true
+		}
+		if w.IsBig() {
+			data.HasBigUint = true
+		}
+		if w.IsTuple() {
+			data.HasTuples = true
+		}
+	}
+	sort.Slice(data.Wrappers, func(i, j int) bool {
+		return data.Wrappers[i].Name < data.Wrappers[j].Name
+	})
+
+	// State and events are decoded with the codec helpers
+	hasState := ctx.State.HasGlobal || ctx.State.HasLocal || ctx.State.HasBox
+	data.HasCodec = ctx.HasCodec || hasState || len(ctx.Events) > 0
+	for _, c := range ctx.Converters {
+		data.Converters = append(data.Converters, c)
+	}
+	sort.Slice(data.Converters, func(i, j int) bool {
+		return data.Converters[i].GoType < data.Converters[j].GoType
+	})
+	// The codec helpers always import these
+	codecImports := make(map[string]bool)
+	for imp := range ctx.CodecImports {
+		if imp != "fmt" && imp != "math/big" && imp != "reflect" && imp != "strings" {
+			codecImports[imp] = true
+		}
+	}
+	data.CodecImports = sortImports(codecImports)
+
 	for _, m := range ctx.Methods {
-		if m.CallConfig.CanCreate {
-			data.CreateMethodOriginalName = m.OriginalName
-			data.CreateMethodGoName = m.Name
+		if m.CallConfig.CanCall {
+			data.HasCallMethods = true
 			if m.HasArgs() {
-				data.HasMethodCreateWithArgs = true
-			} else {
-				data.HasMethodCreateNoArgs = true
+				data.HasCallArgs = true
+				break
 			}
-			break
+		}
+	}
+
+	// Every create method gets its own Factory.Create<Method>. ARC-56 has no
+	// notion of a preferred create method, so the first in spec order backs
+	// Factory.Create, FactoryCreateParams and Deploy.
+	for i := range ctx.Methods {
+		if m := &ctx.Methods[i]; m.CallConfig.CanCreate {
+			data.CreateMethods = append(data.CreateMethods, m)
+		}
+	}
+	if len(data.CreateMethods) > 0 {
+		m := data.CreateMethods[0]
+		data.CreateMethodOriginalName = m.OriginalName
+		data.CreateMethodGoName = m.Name
+		data.CreateMethodHasCodecArgs = m.HasCodecArgs()
+		data.HasMethodCreateWithArgs = m.HasArgs()
+		data.HasMethodCreateNoArgs = !m.HasArgs()
+	}
+
+	// Deploy sends the first update and delete methods; validation warns
+	// when there is more than one.
+	for i := range ctx.Methods {
+		m := &ctx.Methods[i]
+		if m.CallConfig.CanUpdate && data.UpdateMethod == nil {
+			data.UpdateMethod = m
+		}
+		if m.CallConfig.CanDelete && data.DeleteMethod == nil {
+			data.DeleteMethod = m
 		}
 	}
 
@@ -153,16 +376,14 @@ func buildTemplateData(ctx *GeneratorContext, contract *algokit.Arc56Contract) *
 	}
 	for _, s := range ctx.Structs {
 		for _, f := range s.Fields {
-			tm := mapType(f.ABIType, contract.Structs)
-			for _, imp := range tm.Imports {
+			for _, imp := range f.Imports {
 				typesImports[imp] = true
 			}
 		}
 	}
 	for _, m := range ctx.Methods {
 		for _, a := range m.Args {
-			tm := mapType(a.ABIType, contract.Structs)
-			for _, imp := range tm.Imports {
+			for _, imp := range a.Imports {
 				typesImports[imp] = true
 			}
 		}
@@ -175,6 +396,71 @@ func buildTemplateData(ctx *GeneratorContext, contract *algokit.Arc56Contract) *
 
 	data.TypesImports = sortImports(typesImports)
 
+	// Compute imports for state.go and events.go beyond the ones they always need
+	stateTypeImports := make(map[string]bool)
+	for _, keys := range [][]StateKeyData{ctx.State.Global, ctx.State.Local, ctx.State.Box} {
+		for _, k := range keys {
+			for _, imp := range k.Imports {
+				stateTypeImports[imp] = true
+			}
+		}
+	}
+	for _, m := range ctx.State.BoxMaps {
+		for _, imp := range m.Imports {
+			stateTypeImports[imp] = true
+		}
+	}
+	stateImports := make(map[string]bool)
+	for imp := range stateTypeImports {
+		stateImports[imp] = true
+	}
+	if ctx.State.HasGlobal || ctx.State.HasLocal {
+		stateImports["github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"] = true
+	}
+	if ctx.State.HasLocal {
+		stateImports["github.com/algorand/go-algorand-sdk/v2/types"] = true
+	}
+	data.StateImports = sortImports(stateImports)
+
+	// ClientAPI and FakeClient declare the box readers, whose value types may
+	// need imports of their own
+	clientImports := make(map[string]bool)
+	for _, k := range ctx.State.Box {
+		for _, imp := range k.Imports {
+			clientImports[imp] = true
+		}
+	}
+	for _, m := range ctx.State.BoxMaps {
+		for _, imp := range m.Imports {
+			clientImports[imp] = true
+		}
+	}
+	delete(clientImports, "github.com/algorand/go-algorand-sdk/v2/types")
+	data.ClientImports = sortImports(clientImports)
+
+	eventImports := make(map[string]bool)
+	for _, e := range ctx.Events {
+		if len(e.Fields) > 0 {
+			data.HasEventArgs = true
+		}
+		for _, f := range e.Fields {
+			for _, imp := range f.Imports {
+				eventImports[imp] = true
+			}
+		}
+	}
+	data.EventImports = sortImports(eventImports)
+
+	childImports := make(map[string]ChildApp)
+	for _, m := range ctx.Methods {
+		if m.Child != nil {
+			childImports[m.Child.Import] = *m.Child
+		}
+	}
+	for _, imp := range sortedKeys(childImports) {
+		data.ChildImports = append(data.ChildImports, childImports[imp])
+	}
+
 	return data
 }
 
@@ -196,21 +482,17 @@ func sortImports(imports map[string]bool) []string {
 	return result
 }
 
-func renderTemplate(tmpl *template.Template, name string, data interface{}, outputPath string) error {
+func renderTemplate(tmpl *template.Template, name string, data interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
-		return fmt.Errorf("failed to execute template %s: %w", name, err)
+		return nil, fmt.Errorf("failed to execute template %s: %w", name, err)
 	}
 
-	// Try to format the Go code
+	// Format the Go code, keeping the unformatted source for debugging
 	formatted, err := format.Source(buf.Bytes())
 	if err != nil {
-		// If formatting fails, write the unformatted code for debugging
-		if writeErr := os.WriteFile(outputPath, buf.Bytes(), 0o644); writeErr != nil {
-			return fmt.Errorf("format error: %w, write error: %v", err, writeErr)
-		}
-		return fmt.Errorf("generated code for %s has syntax errors: %w", name, err)
+		return nil, &FormatError{Source: buf.Bytes(), Err: err}
 	}
 
-	return os.WriteFile(outputPath, formatted, 0o644)
+	return formatted, nil
 }
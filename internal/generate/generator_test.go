@@ -1,12 +1,16 @@
 package generate
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	algokit "github.com/kylebeee/algokit-utils-go"
 )
 
 func TestGenerateApplicationEquality(t *testing.T) {
@@ -73,7 +77,7 @@ func TestGenerateAllAkitaSpecs(t *testing.T) {
 			}
 
 			// Verify all expected files exist and have valid Go content
-			expectedFiles := []string{"appspec.go", "types.go", "client.go", "composer.go", "factory.go"}
+			expectedFiles := []string{"appspec.go", "types.go", "client.go", "composer.go", "factory.go", "deploy.go", "lookup.go"}
 			for _, f := range expectedFiles {
 				path := filepath.Join(outputDir, f)
 				data, err := os.ReadFile(path)
@@ -117,7 +121,7 @@ func testGenerate(t *testing.T, specPath string, pkgName string, mode string) {
 	// Verify expected files exist
 	expectedFiles := []string{"appspec.go", "types.go", "client.go", "composer.go"}
 	if mode == "full" {
-		expectedFiles = append(expectedFiles, "factory.go")
+		expectedFiles = append(expectedFiles, "factory.go", "deploy.go", "lookup.go")
 	}
 
 	for _, f := range expectedFiles {
@@ -155,3 +159,555 @@ func testGenerate(t *testing.T, specPath string, pkgName string, mode string) {
 	// Log the method count for visibility
 	t.Logf("Generated %d methods for %s (%s mode)", len(contract.Methods), contract.Name, mode)
 }
+
+func TestGenerateUnmappedTypes(t *testing.T) {
+	contract, err := algokit.ParseArc56Contract([]byte(`{
+		"name": "Untyped",
+		"structs": {"Thing": [{"name": "value", "type": "uint7"}]},
+		"methods": [{
+			"name": "store",
+			"args": [{"name": "payload", "type": "mystery[]"}],
+			"returns": {"type": "void"},
+			"actions": {"create": [], "call": ["NoOp"]},
+			"readonly": false
+		}],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": [], "call": []}
+	}`))
+	if err != nil {
+		t.Fatalf("failed to parse spec: %v", err)
+	}
+
+	outputDir := t.TempDir()
+	opts := Options{OutputDir: outputDir, PackageName: "untyped", Mode: "minimal"}
+
+	err = Generate(contract, opts)
+	if err == nil {
+		t.Fatal("expected generation to fail for unmapped types")
+	}
+	for _, want := range []string{"method store arg payload", `"mystery[]"`, "struct Thing field value", "--allow-untyped"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+
+	opts.AllowUntyped = true
+	if err := Generate(contract, opts); err != nil {
+		t.Fatalf("generation with AllowUntyped failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(outputDir, "types.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := string(data)
+	for _, want := range []string{"Payload []any", "Value any", "// WARNING: generated with --allow-untyped", `untyped ABI type "mystery[]"`} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.go does not contain %q", want)
+		}
+	}
+}
+
+func TestGenerateABITypes(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ABITypes.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	outputDir := t.TempDir()
+	if err := Generate(contract, Options{OutputDir: outputDir, PackageName: "abitypes", Mode: "full"}); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "abitypes.go"))
+	if err != nil {
+		t.Fatalf("abitypes.go not generated: %v", err)
+	}
+	abitypes := string(data)
+	for _, want := range []string{"type Uint24 uint64", "type Uint40 uint64", "type Uint48 uint64", "type Uint56 uint64", "type UFixed struct", "type UFixed64x2 struct", "type UFixed128x10 struct"} {
+		if !strings.Contains(abitypes, want) {
+			t.Errorf("abitypes.go does not contain %q", want)
+		}
+	}
+
+	data, err = os.ReadFile(filepath.Join(outputDir, "types.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "Ticks  [3][2]Uint48") {
+		t.Errorf("types.go does not map nested static arrays")
+	}
+
+	// Specs without non-native widths do not get the helper file.
+	plainDir := t.TempDir()
+	plain, err := schema.LoadAppSpec("../../testdata/ApplicationEquality.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Generate(plain, Options{OutputDir: plainDir, PackageName: "plain", Mode: "full"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(plainDir, "abitypes.go")); !os.IsNotExist(err) {
+		t.Errorf("abitypes.go should not be generated for specs without wrapper types")
+	}
+}
+
+func TestRenderBigUint(t *testing.T) {
+	contract, err := algokit.ParseArc56Contract([]byte(`{
+		"name": "Wide",
+		"structs": {"Supply": [{"name": "total", "type": "uint256"}, {"name": "cap", "type": "uint128"}]},
+		"methods": [
+			{"name": "mint", "args": [{"name": "amount", "type": "uint512"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Render(context.Background(), contract, Options{PackageName: "wide", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	checkGolden(t, "biguint", files, "abitypes.go", "types.go")
+}
+
+func TestRenderCustomTemplates(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ApplicationEquality.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	overrides := fstest.MapFS{
+		"composer.go.tmpl": {Data: []byte("// Custom composer.\npackage {{.PackageName}}\n\ntype Composer struct{}\n")},
+		"tracing.go.tmpl": {Data: []byte(`package {{.PackageName}}
+
+// Version {{.Version}} ({{.Mode}})
+var tracedMethods = []string{
+{{- range .Methods}}
+	{{quote .OriginalName}}, // {{toCamelCase .Name}}
+{{- end}}
+}
+`)},
+		"README.md": {Data: []byte("ignored")},
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "appeq", Mode: "full", Templates: overrides})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	if got := string(files["composer.go"]); !strings.HasPrefix(got, "// Custom composer.") {
+		t.Errorf("composer.go was not overridden:\n%s", got)
+	}
+	if !strings.Contains(string(files["client.go"]), "type Client struct") {
+		t.Error("client.go should still use the built-in template")
+	}
+	checkGolden(t, "customtemplates", files, "tracing.go")
+	if len(files) != 8 {
+		t.Errorf("expected 8 files, got %d", len(files))
+	}
+
+	broken := fstest.MapFS{"extra.go.tmpl": {Data: []byte("package {{.PackageName}\n")}}
+	if _, err := Render(context.Background(), contract, Options{Mode: "full", Templates: broken}); err == nil || !strings.Contains(err.Error(), "extra.go.tmpl") {
+		t.Errorf("expected parse error naming the template, got %v", err)
+	}
+
+	invalid := fstest.MapFS{"extra.go.tmpl": {Data: []byte("package {{.PackageName}}\nfunc {\n")}}
+	_, err = Render(context.Background(), contract, Options{Mode: "full", Templates: invalid})
+	var fe *FormatError
+	if !errors.As(err, &fe) || fe.Filename != "extra.go" {
+		t.Errorf("expected FormatError for extra.go, got %v", err)
+	}
+}
+
+func TestRenderClientAPIAndFake(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ApplicationEquality.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "appeq", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["fake.go"]; ok {
+		t.Error("fake.go generated without EmitFake")
+	}
+	files, err = Render(context.Background(), contract, Options{PackageName: "appeq", Mode: "full", EmitFake: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	checkGolden(t, "fake", files, "client.go", "fake.go")
+
+	// State readers are part of ClientAPI and stubbed by the fake
+	state, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = Render(context.Background(), state, Options{PackageName: "statedecoding", Mode: "full", EmitFake: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "fake_state", files, "fake.go")
+}
+
+func TestRenderCreateVariants(t *testing.T) {
+	contract, err := algokit.ParseArc56Contract([]byte(`{
+		"name": "MultiCreate",
+		"structs": {},
+		"methods": [
+			{"name": "create", "args": [{"name": "version", "type": "string"}], "returns": {"type": "void"}, "actions": {"create": ["NoOp"], "call": []}},
+			{"name": "createAndJoin", "args": [], "returns": {"type": "void"}, "actions": {"create": ["OptIn"], "call": []}},
+			{"name": "hello", "args": [], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}}
+		],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp", "OptIn"], "call": []}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "multicreate", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	// hello cannot create and has no Create variant
+	checkGolden(t, "createvariants", files, "factory.go")
+}
+
+func TestRenderDeploy(t *testing.T) {
+	contract, err := algokit.ParseArc56Contract([]byte(`{
+		"name": "Upgradable",
+		"structs": {},
+		"methods": [
+			{"name": "create", "args": [{"name": "version", "type": "string"}], "returns": {"type": "void"}, "actions": {"create": ["NoOp"], "call": []}},
+			{"name": "update", "args": [{"name": "version", "type": "string"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["UpdateApplication"]}}
+		],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": [], "call": ["DeleteApplication"]}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "upgradable", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "deploy", files, "deploy.go")
+
+	// Without lifecycle methods the strategies that need them fail
+	contract.Methods = contract.Methods[:1]
+	contract.BareActions.Call = nil
+	files, err = Render(context.Background(), contract, Options{PackageName: "upgradable", Mode: "full"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "deploy_nolifecycle", files, "deploy.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "upgradable", Mode: "minimal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["deploy.go"]; ok {
+		t.Error("deploy.go should not be generated in minimal mode")
+	}
+}
+
+func TestRenderLookup(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "lookup", files, "lookup.go", "factory.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "minimal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["lookup.go"]; ok {
+		t.Error("lookup.go should not be generated in minimal mode")
+	}
+}
+
+func TestRenderByteCode(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "bytecode", files, "appspec.go", "client.go", "factory.go", "deploy.go")
+
+	// Specs with template variables are compiled by the AppFactory as before
+	xgov, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = Render(context.Background(), xgov, Options{PackageName: "xgov", Mode: "full"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "bytecode_templatevars", files, "client.go", "factory.go")
+}
+
+func TestApprovalProgramHash(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		ok   bool
+	}{
+		{"bytecode", `{"byteCode": {"approval": "CoEBQw==", "clear": "CoEBQw=="}}`, true},
+		{"no bytecode", `{"name": "x"}`, false},
+		{"clear missing", `{"byteCode": {"approval": "CoEBQw=="}}`, false},
+		{"template variables", `{"byteCode": {"approval": "CoEBQw==", "clear": "CoEBQw=="}, "templateVariables": {"fee": {"type": "uint64"}}}`, false},
+		{"invalid base64", `{"byteCode": {"approval": "%%", "clear": "CoEBQw=="}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, ok := approvalProgramHash([]byte(tt.spec))
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && len(hash) != 64 {
+				t.Errorf("hash %q is not a hex SHA-512/256", hash)
+			}
+		})
+	}
+}
+
+func TestRenderEmitTests(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ABITypes.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "abitypes", Mode: "full"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["roundtrip_test.go"]; ok {
+		t.Error("roundtrip_test.go should only be generated with EmitTests")
+	}
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "abitypes", Mode: "full", EmitTests: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "emittests", files, "roundtrip_test.go")
+
+	// Specs without wrapper types still get the codec helpers the tests use
+	plain, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = Render(context.Background(), plain, Options{PackageName: "xgov", Mode: "full", EmitTests: true, EmitParamsCache: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["abitypes.go"]; !ok {
+		t.Error("abitypes.go should be generated with EmitTests")
+	}
+	// Structs without wrapper types are cross-checked with TupleToStruct
+	checkGolden(t, "emittests_paramscache", files, "roundtrip_test.go", "concurrency_test.go", "client_test.go")
+	if _, ok := files["async_test.go"]; ok {
+		t.Error("async_test.go should only be generated with EmitTests and EmitAsync")
+	}
+}
+
+func TestStructTupleType(t *testing.T) {
+	contract, err := algokit.ParseArc56Contract([]byte(`{
+		"name": "Nested",
+		"structs": {
+			"Inner": [{"name": "a", "type": "byte[32]"}, {"name": "b", "type": "bool"}],
+			"Outer": [{"name": "inner", "type": "Inner"}, {"name": "items", "type": "Inner[]"}, {"name": "n", "type": "uint64"}],
+			"Loop": [{"name": "self", "type": "Loop"}]
+		},
+		"methods": [],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := StructTupleType("Outer", contract.Structs)
+	if want := "((byte[32],bool),Inner[],uint64)"; !ok || got != want {
+		t.Errorf("StructTupleType(Outer) = %q, want %q", got, want)
+	}
+	if _, ok := StructTupleType("Loop", contract.Structs); ok {
+		t.Error("expected recursive struct to be rejected")
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["json.go"]; ok {
+		t.Error("json.go generated without EmitJSON")
+	}
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitJSON: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	// Methods without args have no args JSON methods
+	checkGolden(t, "json", files, "types.go", "json.go")
+
+	// Transaction args are left out of the JSON form and event types encode
+	// like structs
+	xgov, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extras, err := schema.LoadExtras("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = Render(context.Background(), xgov, Options{PackageName: "xgov", Mode: "full", EmitJSON: true, Events: extras.Events})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "json_events", files, "types.go", "json.go")
+}
+
+func TestRenderRecorder(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/XGovRegistry.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "xgov", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["recorder.go"]; ok {
+		t.Error("recorder.go generated without EmitRecorder")
+	}
+	checkGolden(t, "recorder_off", files, "client.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "xgov", Mode: "full", EmitRecorder: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	// Recording needs the JSON helpers
+	checkGolden(t, "recorder", files, "client.go", "json.go", "composer.go", "recorder.go")
+}
+
+func TestRenderInterceptors(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["interceptor.go"]; ok {
+		t.Error("interceptor.go generated without EmitInterceptors")
+	}
+	checkGolden(t, "interceptors_off", files, "client.go", "composer.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitInterceptors: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "interceptors", files, "client.go", "composer.go", "interceptor.go")
+}
+
+func TestRenderClientOptions(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitRecorder: true, EmitAsync: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "clientoptions", files, "client.go", "composer.go", "recorder.go")
+}
+
+func TestRenderParamsCache(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitTests: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["paramscache.go"]; ok {
+		t.Error("paramscache.go generated without EmitParamsCache")
+	}
+	checkGolden(t, "paramscache_off", files, "concurrency_test.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitParamsCache: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "paramscache", files, "paramscache.go")
+}
+
+func TestRenderAsync(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full"})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	for _, filename := range []string{"async.go", "offline.go", "async_test.go"} {
+		if _, ok := files[filename]; ok {
+			t.Errorf("%s generated without EmitAsync", filename)
+		}
+	}
+	checkGolden(t, "async_off", files, "client.go", "composer.go")
+
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitInterceptors: true, EmitAsync: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "async", files, "async.go", "client.go", "composer.go", "interceptor.go")
+}
+
+func TestRenderOffline(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitAsync: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if _, ok := files["offline.go"]; ok {
+		t.Error("offline.go generated without EmitOffline")
+	}
+
+	// EmitOffline implies EmitAsync
+	files, err = Render(context.Background(), contract, Options{PackageName: "statedecoding", Mode: "full", EmitOffline: true})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "offline", files, "offline.go", "async.go")
+}
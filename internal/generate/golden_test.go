@@ -0,0 +1,246 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current generator output")
+
+const goldenDir = "../../testdata/golden"
+
+// goldenSpecs returns the top-level specs in testdata. The akita specs are
+// covered by TestGenerateAllAkitaSpecs instead, to keep the golden files small.
+func goldenSpecs(t *testing.T) []string {
+	t.Helper()
+	var specs []string
+	for _, pattern := range []string{"*.arc56.json", "*.arc32.json"} {
+		matches, err := filepath.Glob(filepath.Join("../../testdata", pattern))
+		if err != nil {
+			t.Fatal(err)
+		}
+		specs = append(specs, matches...)
+	}
+	sort.Strings(specs)
+	if len(specs) == 0 {
+		t.Fatal("no specs found in testdata")
+	}
+	return specs
+}
+
+// goldenOptions enables every optional file, so the golden files cover
+// every template.
+func goldenOptions(pkgName string) Options {
+	return Options{PackageName: pkgName, Mode: "full", EmitFake: true, EmitRecorder: true, EmitJSON: true, EmitInterceptors: true, EmitParamsCache: true, EmitAsync: true, EmitOffline: true}
+}
+
+// loadGolden loads a spec and its events.
+func loadGolden(t *testing.T, specPath string) (*algokit.Arc56Contract, []schema.Event) {
+	t.Helper()
+	contract, err := schema.LoadAppSpec(specPath)
+	if err != nil {
+		t.Fatalf("failed to load %s: %v", specPath, err)
+	}
+	extras, err := schema.LoadExtras(specPath)
+	if err != nil {
+		t.Fatalf("failed to load %s: %v", specPath, err)
+	}
+	return contract, extras.Events
+}
+
+func renderGolden(t *testing.T, specPath string) (string, map[string][]byte) {
+	t.Helper()
+	contract, events := loadGolden(t, specPath)
+	pkgName := ToPackageName(contract.Name)
+	opts := goldenOptions(pkgName)
+	opts.Events = events
+	files, err := Render(context.Background(), contract, opts)
+	if err != nil {
+		t.Fatalf("failed to render %s: %v", specPath, err)
+	}
+	return pkgName, files
+}
+
+// TestGolden compares every generated file byte-for-byte with the files in
+// testdata/golden. Run `go test ./internal/generate -update`
+// after an intended template change and review the diff.
+func TestGolden(t *testing.T) {
+	for _, specPath := range goldenSpecs(t) {
+		name := strings.TrimSuffix(filepath.Base(specPath), filepath.Ext(specPath))
+		t.Run(name, func(t *testing.T) {
+			pkgName, files := renderGolden(t, specPath)
+			dir := filepath.Join(goldenDir, pkgName)
+
+			if *update {
+				if err := os.RemoveAll(dir); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				for filename, src := range files {
+					if err := os.WriteFile(filepath.Join(dir, filename+".golden"), src, 0o644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				return
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("missing golden files (run with -update): %v", err)
+			}
+			for _, e := range entries {
+				filename := strings.TrimSuffix(e.Name(), ".golden")
+				if _, ok := files[filename]; !ok {
+					t.Errorf("%s is no longer generated", filename)
+				}
+			}
+			for filename, got := range files {
+				want, err := os.ReadFile(filepath.Join(dir, filename+".golden"))
+				if err != nil {
+					t.Errorf("%s: no golden file (run with -update)", filename)
+					continue
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s differs from golden file:\n%s", filename, firstDiff(string(want), string(got)))
+				}
+			}
+		})
+	}
+}
+
+// TestGoldenTypecheck compiles the generated packages against the
+// algokit-utils-go version in go.mod with go vet: once as generated by
+// default and once with every optional file, the tests and the CLI. The
+// generator itself builds against that module, so the check runs wherever
+// these tests do; it is only skipped with -short.
+func TestGoldenTypecheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping typecheck in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Fatalf("go toolchain not available: %v", err)
+	}
+	if out, err := exec.Command(goBin, "list", "github.com/kylebeee/algokit-utils-go").CombinedOutput(); err != nil {
+		t.Fatalf("algokit-utils-go is not available: %s", bytes.TrimSpace(out))
+	}
+
+	// The packages must live inside the module so their imports resolve;
+	// the leading underscore keeps them out of ./... patterns.
+	root, err := os.MkdirTemp("../..", "_typecheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	var pkgs []string
+	for _, specPath := range goldenSpecs(t) {
+		contract, events := loadGolden(t, specPath)
+		pkgName := ToPackageName(contract.Name)
+		for variant, opts := range map[string]Options{
+			"default": {PackageName: pkgName, Mode: "full"},
+			"all":     goldenOptions(pkgName),
+		} {
+			opts.Events = events
+			dir := filepath.Join(root, variant, pkgName)
+			if variant == "all" {
+				opts.EmitTests = true
+				opts.EmitCLI = true
+				if opts.CLIImportPath, err = ModuleImportPath(dir); err != nil {
+					t.Fatal(err)
+				}
+			}
+			files, err := Render(context.Background(), contract, opts)
+			if err != nil {
+				t.Fatalf("failed to render %s: %v", specPath, err)
+			}
+			for filename, src := range files {
+				target := filepath.Join(dir, filepath.FromSlash(filename))
+				if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(target, src, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			pkgs = append(pkgs, "./"+filepath.ToSlash(filepath.Join(filepath.Base(root), variant, pkgName))+"/...")
+		}
+	}
+
+	cmd := exec.Command(goBin, append([]string{"vet"}, pkgs...)...)
+	cmd.Dir = "../.."
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated code does not typecheck: %v\n%s", err, out)
+	}
+}
+
+// checkGolden compares the named generated files byte-for-byte with the files
+// in testdata/golden/render/<name>, or rewrites them with -update. The render
+// tests use it for the option combinations and inline specs TestGolden does
+// not cover.
+func checkGolden(t *testing.T, name string, files map[string][]byte, filenames ...string) {
+	t.Helper()
+	dir := filepath.Join(goldenDir, "render", name)
+	if *update {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, filename := range filenames {
+		got, ok := files[filename]
+		if !ok {
+			t.Errorf("%s was not generated", filename)
+			continue
+		}
+		path := filepath.Join(dir, filename+".golden")
+		if *update {
+			if err := os.WriteFile(path, got, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("%s: no golden file in %s (run with -update)", filename, dir)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs from %s:\n%s", filename, path, firstDiff(string(want), string(got)))
+		}
+	}
+}
+
+// firstDiff describes the first line that differs between want and got.
+func firstDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n  want: %s\n  got:  %s", i+1, w, g)
+		}
+	}
+	return "(no line difference)"
+}
@@ -0,0 +1,57 @@
+package generate
+
+// NameKind identifies what a generated Go identifier is for.
+type NameKind int
+
+const (
+	NameContract NameKind = iota
+	NameStruct
+	NameStructField
+	NameMethod
+	NameArg
+	NameStateKey // global, local and box state keys
+	NameStateMap // box maps
+	NameEvent    // ARC-28 events
+)
+
+func (k NameKind) String() string {
+	switch k {
+	case NameContract:
+		return "contract"
+	case NameStruct:
+		return "struct"
+	case NameStructField:
+		return "struct field"
+	case NameMethod:
+		return "method"
+	case NameArg:
+		return "arg"
+	case NameStateKey:
+		return "state key"
+	case NameStateMap:
+		return "state map"
+	case NameEvent:
+		return "event"
+	}
+	return "unknown"
+}
+
+// Hooks customises naming and type mapping. Nil hooks keep the default behaviour.
+type Hooks struct {
+	// Name returns the Go identifier for a name from the spec. def is the
+	// identifier the generator would otherwise use.
+	Name func(kind NameKind, original, def string) string
+
+	// Type returns the Go mapping for an ABI type. def is the mapping the
+	// generator would otherwise use. It is called for every ABI type,
+	// including array elements and struct references.
+	Type func(abiType string, def TypeMapping) TypeMapping
+}
+
+func (h Hooks) name(kind NameKind, original string) string {
+	def := ToPascalCase(original)
+	if h.Name == nil {
+		return def
+	}
+	return h.Name(kind, original, def)
+}
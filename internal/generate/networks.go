@@ -0,0 +1,107 @@
+package generate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+// KnownNetworks maps network names accepted by ParseNetwork to their genesis hashes.
+var KnownNetworks = map[string]string{
+	"mainnet": "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=",
+	"testnet": "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=",
+}
+
+// NetworkAppID is the app ID of the contract on the network with GenesisHash.
+type NetworkAppID struct {
+	GenesisHash string // base64, as in the ARC-56 networks section
+	AppID       uint64
+	Name        string // KnownNetworks name, if any
+}
+
+// ParseNetwork parses a "<network>=<appID>" entry, where network is a
+// KnownNetworks name or a base64 genesis hash.
+func ParseNetwork(entry string) (string, uint64, error) {
+	// Genesis hashes end in "=" padding, so split on the last "="
+	i := strings.LastIndex(entry, "=")
+	if i < 0 {
+		return "", 0, fmt.Errorf("invalid network %q: expected <network>=<appID>", entry)
+	}
+	network, id := entry[:i], entry[i+1:]
+	appID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || appID == 0 {
+		return "", 0, fmt.Errorf("invalid network %q: app ID must be a positive integer", entry)
+	}
+	if hash, ok := KnownNetworks[strings.ToLower(network)]; ok {
+		return hash, appID, nil
+	}
+	if err := checkGenesisHash(network); err != nil {
+		return "", 0, fmt.Errorf("invalid network %q: %w", entry, err)
+	}
+	return network, appID, nil
+}
+
+func checkGenesisHash(hash string) error {
+	raw, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil || len(raw) != 32 {
+		names := sortedKeys(KnownNetworks)
+		return fmt.Errorf("expected %s or a base64 genesis hash", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// applyNetworks returns the networks section of specJSON with overrides
+// applied, and specJSON rewritten to embed them. Overrides are keyed by
+// genesis hash.
+func applyNetworks(specJSON []byte, overrides map[string]uint64) ([]byte, []NetworkAppID, error) {
+	extras, err := schema.ParseExtras(specJSON)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read networks: %w", err)
+	}
+
+	appIDs := make(map[string]uint64)
+	for hash, n := range extras.Networks {
+		appIDs[hash] = n.AppID
+	}
+	for hash, appID := range overrides {
+		if err := checkGenesisHash(hash); err != nil {
+			return nil, nil, fmt.Errorf("invalid network %q: %w", hash, err)
+		}
+		appIDs[hash] = appID
+	}
+
+	if len(overrides) > 0 {
+		var doc map[string]json.RawMessage
+		if err := json.Unmarshal(specJSON, &doc); err != nil {
+			return nil, nil, err
+		}
+		networks := make(map[string]map[string]uint64, len(appIDs))
+		for hash, appID := range appIDs {
+			networks[hash] = map[string]uint64{"appID": appID}
+		}
+		raw, err := json.Marshal(networks)
+		if err != nil {
+			return nil, nil, err
+		}
+		doc["networks"] = raw
+		if specJSON, err = json.Marshal(doc); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	names := make(map[string]string, len(KnownNetworks))
+	for name, hash := range KnownNetworks {
+		names[hash] = name
+	}
+	result := make([]NetworkAppID, 0, len(appIDs))
+	for hash, appID := range appIDs {
+		result = append(result, NetworkAppID{GenesisHash: hash, AppID: appID, Name: names[hash]})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GenesisHash < result[j].GenesisHash })
+	return specJSON, result, nil
+}
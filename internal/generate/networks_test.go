@@ -0,0 +1,120 @@
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+func TestParseNetwork(t *testing.T) {
+	tests := []struct {
+		entry string
+		hash  string
+		appID uint64
+		err   string
+	}{
+		{entry: "mainnet=1234", hash: KnownNetworks["mainnet"], appID: 1234},
+		{entry: "TestNet=5", hash: KnownNetworks["testnet"], appID: 5},
+		{entry: "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI==7", hash: KnownNetworks["testnet"], appID: 7},
+		{entry: "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=7", err: "expected mainnet, testnet or a base64 genesis hash"},
+		{entry: "mainnet", err: "expected <network>=<appID>"},
+		{entry: "mainnet=0", err: "app ID must be a positive integer"},
+		{entry: "betanet=1", err: "expected mainnet, testnet or a base64 genesis hash"},
+	}
+	for _, tt := range tests {
+		hash, appID, err := ParseNetwork(tt.entry)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ParseNetwork(%q) error = %v, want %q", tt.entry, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseNetwork(%q) failed: %v", tt.entry, err)
+			continue
+		}
+		if hash != tt.hash || appID != tt.appID {
+			t.Errorf("ParseNetwork(%q) = %s, %d, want %s, %d", tt.entry, hash, appID, tt.hash, tt.appID)
+		}
+	}
+}
+
+func TestApplyNetworks(t *testing.T) {
+	custom := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	spec := []byte(`{"name":"X","networks":{"` + KnownNetworks["mainnet"] + `":{"appID":1},"` + custom + `":{"appID":2}}}`)
+
+	specJSON, networks, err := applyNetworks(spec, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(specJSON) != string(spec) {
+		t.Error("spec should be unchanged without overrides")
+	}
+	if len(networks) != 2 || networks[0].GenesisHash != custom || networks[1].Name != "mainnet" {
+		t.Errorf("unexpected networks %+v", networks)
+	}
+
+	specJSON, networks, err = applyNetworks(spec, map[string]uint64{
+		KnownNetworks["mainnet"]: 10,
+		KnownNetworks["testnet"]: 20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []NetworkAppID{
+		{GenesisHash: custom, AppID: 2},
+		{GenesisHash: KnownNetworks["testnet"], AppID: 20, Name: "testnet"},
+		{GenesisHash: KnownNetworks["mainnet"], AppID: 10, Name: "mainnet"},
+	}
+	if len(networks) != len(want) {
+		t.Fatalf("got %d networks, want %d", len(networks), len(want))
+	}
+	for i := range want {
+		if networks[i] != want[i] {
+			t.Errorf("networks[%d] = %+v, want %+v", i, networks[i], want[i])
+		}
+	}
+
+	// The embedded spec carries the overrides too
+	var doc struct {
+		Name     string
+		Networks map[string]struct {
+			AppID uint64 `json:"appID"`
+		}
+	}
+	if err := json.Unmarshal(specJSON, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Name != "X" || doc.Networks[KnownNetworks["mainnet"]].AppID != 10 || doc.Networks[KnownNetworks["testnet"]].AppID != 20 {
+		t.Errorf("unexpected embedded spec %s", specJSON)
+	}
+
+	if _, _, err := applyNetworks(spec, map[string]uint64{"mainnet": 1}); err == nil {
+		t.Error("expected an error for an override that is not a genesis hash")
+	}
+}
+
+func TestRenderNetworks(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/StateDecoding.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{
+		PackageName: "statedecoding",
+		Mode:        "full",
+		Networks:    map[string]uint64{KnownNetworks["testnet"]: 5678},
+	})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if want := `"SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=": 5678, // testnet`; !strings.Contains(string(files["appspec.go"]), want) {
+		t.Errorf("appspec.go does not contain %q", want)
+	}
+	if want := "func NewClientForNetwork(ctx context.Context, params algokit.AppClientParams, opts ...ClientOptions) (*Client, error) {"; !strings.Contains(string(files["client.go"]), want) {
+		t.Errorf("client.go does not contain %q", want)
+	}
+}
@@ -0,0 +1,230 @@
+package generate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// TypeOverride replaces the Go type generated for an ABI type, struct or field.
+type TypeOverride struct {
+	GoType  string `json:"goType"`            // e.g. "domain.Address"
+	Import  string `json:"import,omitempty"`  // import path providing GoType and the converters
+	ToABI   string `json:"toABI,omitempty"`   // func(GoType) (interface{}, error)
+	FromABI string `json:"fromABI,omitempty"` // func(interface{}) (GoType, error)
+	ABIType string `json:"abiType,omitempty"` // field overrides only: restrict to this ABI type
+}
+
+// HasConverters returns true if the override converts values with its own functions.
+func (o TypeOverride) HasConverters() bool {
+	return o.ToABI != ""
+}
+
+// TypeOverrides holds the type overrides declared in a config file.
+//
+// ABITypes is keyed by ABI type ("address", "uint64"), Structs by ARC-56
+// struct name, and Fields by "<struct>.<field>" path, which also matches
+// method arguments as "<method>.<arg>". Field keys may use path.Match
+// patterns such as "*.*Round". Field overrides take precedence over struct
+// and ABI type overrides.
+type TypeOverrides struct {
+	ABITypes map[string]TypeOverride `json:"abiTypes,omitempty"`
+	Structs  map[string]TypeOverride `json:"structs,omitempty"`
+	Fields   map[string]TypeOverride `json:"fields,omitempty"`
+}
+
+// TypeConverter is a Go type with user-supplied ABI converter functions.
+type TypeConverter struct {
+	GoType  string
+	ToABI   string
+	FromABI string
+}
+
+// LoadTypeOverrides loads type overrides from a JSON config file.
+func LoadTypeOverrides(filePath string) (*TypeOverrides, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read type config: %w", err)
+	}
+
+	var overrides TypeOverrides
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse type config %s: %w", filePath, err)
+	}
+	return &overrides, nil
+}
+
+// Check reports overrides that are incomplete or don't apply to the contract.
+func (o *TypeOverrides) Check(contract *algokit.Arc56Contract) error {
+	if o == nil {
+		return nil
+	}
+
+	var problems []string
+	check := func(kind, key string, ov TypeOverride) {
+		if ov.GoType == "" {
+			problems = append(problems, fmt.Sprintf("%s %q: goType is required", kind, key))
+		}
+		if (ov.ToABI == "") != (ov.FromABI == "") {
+			problems = append(problems, fmt.Sprintf("%s %q: toABI and fromABI must be set together", kind, key))
+		}
+	}
+
+	used := contractTypes(contract)
+	for _, key := range sortedKeys(o.ABITypes) {
+		check("abiTypes", key, o.ABITypes[key])
+		// A type the generator doesn't know is fine if the contract uses it
+		if _, err := ParseABIType(key); err != nil && !IsKnownTypeName(key) && !used[key] {
+			problems = append(problems, fmt.Sprintf("abiTypes %q: not a valid ABI type", key))
+		}
+	}
+	for _, key := range sortedKeys(o.Structs) {
+		check("structs", key, o.Structs[key])
+		if _, ok := contract.Structs[key]; !ok {
+			problems = append(problems, fmt.Sprintf("structs %q: no such struct in %s", key, contract.Name))
+		}
+	}
+	for _, key := range sortedKeys(o.Fields) {
+		check("fields", key, o.Fields[key])
+		if _, _, ok := strings.Cut(key, "."); !ok {
+			problems = append(problems, fmt.Sprintf("fields %q: expected <struct>.<field>", key))
+		} else if _, err := path.Match(key, ""); err != nil {
+			problems = append(problems, fmt.Sprintf("fields %q: %v", key, err))
+		}
+	}
+
+	// A Go type can only be registered with one pair of converters
+	converters := make(map[string]TypeOverride)
+	for _, ov := range o.all() {
+		if !ov.HasConverters() {
+			continue
+		}
+		if prev, ok := converters[ov.GoType]; ok && (prev.ToABI != ov.ToABI || prev.FromABI != ov.FromABI) {
+			problems = append(problems, fmt.Sprintf("%s has conflicting converters", ov.GoType))
+			continue
+		}
+		converters[ov.GoType] = ov
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid type config:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// Covers reports whether an override replaces the Go type of abiType, either
+// everywhere or, if name is set, at the "<owner>.<name>" path. Validation uses it to accept
+// types that only an override gives a Go mapping.
+func (o *TypeOverrides) Covers(owner, name, abiType string) bool {
+	if _, ok := o.lookupABIType(abiType); ok {
+		return true
+	}
+	if name == "" {
+		return false
+	}
+	_, ok := o.lookupField(owner, name, abiType)
+	return ok
+}
+
+// contractTypes returns the type names used by the contract's methods,
+// structs and state.
+func contractTypes(contract *algokit.Arc56Contract) map[string]bool {
+	used := make(map[string]bool)
+	for _, m := range contract.Methods {
+		for _, a := range m.Args {
+			used[a.Type] = true
+		}
+		used[m.Returns.Type] = true
+	}
+	for _, fields := range contract.Structs {
+		for _, f := range fields {
+			used[f.Type] = true
+		}
+	}
+	st := contract.State
+	for _, keys := range []map[string]algokit.StorageKey{st.Keys.Global, st.Keys.Local, st.Keys.Box} {
+		for _, k := range keys {
+			used[k.KeyType], used[k.ValueType] = true, true
+		}
+	}
+	for _, maps := range []map[string]algokit.StorageMap{st.Maps.Global, st.Maps.Local, st.Maps.Box} {
+		for _, m := range maps {
+			used[m.KeyType], used[m.ValueType] = true, true
+		}
+	}
+	return used
+}
+
+// lookupField returns the override for a struct field or method arg path.
+// Exact keys win over patterns; patterns are tried in sorted order.
+func (o *TypeOverrides) lookupField(owner, name, abiType string) (TypeOverride, bool) {
+	if o == nil || len(o.Fields) == 0 {
+		return TypeOverride{}, false
+	}
+	key := owner + "." + name
+	if ov, ok := o.Fields[key]; ok && (ov.ABIType == "" || ov.ABIType == abiType) {
+		return ov, true
+	}
+	for _, pattern := range sortedKeys(o.Fields) {
+		ov := o.Fields[pattern]
+		if ok, _ := path.Match(pattern, key); ok && (ov.ABIType == "" || ov.ABIType == abiType) {
+			return ov, true
+		}
+	}
+	return TypeOverride{}, false
+}
+
+func (o *TypeOverrides) lookupABIType(abiType string) (TypeOverride, bool) {
+	if o == nil {
+		return TypeOverride{}, false
+	}
+	ov, ok := o.ABITypes[abiType]
+	return ov, ok
+}
+
+func (o *TypeOverrides) lookupStruct(name string) (TypeOverride, bool) {
+	if o == nil {
+		return TypeOverride{}, false
+	}
+	ov, ok := o.Structs[name]
+	return ov, ok
+}
+
+func (o *TypeOverrides) all() []TypeOverride {
+	var result []TypeOverride
+	for _, m := range []map[string]TypeOverride{o.ABITypes, o.Structs, o.Fields} {
+		for _, key := range sortedKeys(m) {
+			result = append(result, m[key])
+		}
+	}
+	return result
+}
+
+// mapping returns the TypeMapping for an override. Overridden values always
+// go through the codec so named types and converters are applied.
+func (ov TypeOverride) mapping() TypeMapping {
+	tm := TypeMapping{GoType: ov.GoType, Codec: true}
+	if ov.Import != "" {
+		tm.Imports = []string{ov.Import}
+	}
+	if ov.HasConverters() {
+		tm.Converters = []TypeConverter{{GoType: ov.GoType, ToABI: ov.ToABI, FromABI: ov.FromABI}}
+	}
+	return tm
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,233 @@
+package generate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+const domainImport = "example.com/domain"
+
+func testOverrides() *TypeOverrides {
+	return &TypeOverrides{
+		ABITypes: map[string]TypeOverride{
+			"ufixed128x10": {GoType: "domain.Rate", Import: domainImport, ToABI: "domain.RateToABI", FromABI: "domain.RateFromABI"},
+		},
+		Structs: map[string]TypeOverride{
+			"Price": {GoType: "domain.Price", Import: domainImport},
+		},
+		Fields: map[string]TypeOverride{
+			"*.round":  {GoType: "domain.Round", Import: domainImport, ABIType: "uint64"},
+			"*.limits": {GoType: "[]domain.Limit", Import: domainImport, ABIType: "uint32[]"},
+		},
+	}
+}
+
+func TestRenderTypeOverrides(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ABITypes.arc56.json")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "abitypes", Mode: "full", TypeOverrides: testOverrides()})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	types := string(files["types.go"])
+	if strings.Contains(types, "type Price struct") {
+		t.Error("overridden struct Price should not be generated")
+	}
+	for _, want := range []string{
+		`"example.com/domain"`,
+		"Price  domain.Price",
+		"Rate   domain.Rate",
+		"Round  domain.Round",
+		"Limits []Uint56", // abiType filter doesn't match uint56[]
+		"Return domain.Price",
+	} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.go does not contain %q", want)
+		}
+	}
+
+	abitypes := string(files["abitypes.go"])
+	for _, want := range []string{
+		`"example.com/domain"`,
+		"reflect.TypeOf((*domain.Rate)(nil)).Elem()",
+		"return domain.RateToABI(v.(domain.Rate))",
+		"return domain.RateFromABI(raw)",
+	} {
+		if !strings.Contains(abitypes, want) {
+			t.Errorf("abitypes.go does not contain %q", want)
+		}
+	}
+
+	// Overridden returns are decoded through the codec
+	if !strings.Contains(string(files["client.go"]), "fromABIValue(result.ABIReturn, &typedResult.Return)") {
+		t.Error("client.go does not decode overridden returns with fromABIValue")
+	}
+}
+
+func TestTypeOverridesCheck(t *testing.T) {
+	contract, err := schema.LoadAppSpec("../../testdata/ABITypes.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testOverrides().Check(contract); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bad := &TypeOverrides{
+		ABITypes: map[string]TypeOverride{
+			"uint7":   {GoType: "X"},
+			"address": {GoType: "domain.Address", ToABI: "domain.ToABI"},
+		},
+		Structs: map[string]TypeOverride{"Missing": {GoType: "X"}},
+		Fields: map[string]TypeOverride{
+			"noDot":   {GoType: "X"},
+			"a.b":     {},
+			"c.[":     {GoType: "X"},
+			"Price.x": {GoType: "domain.Address", ToABI: "other.ToABI", FromABI: "other.FromABI"},
+		},
+	}
+	err = bad.Check(contract)
+	if err == nil {
+		t.Fatal("expected errors")
+	}
+	for _, want := range []string{
+		`abiTypes "uint7": not a valid ABI type`,
+		`abiTypes "address": toABI and fromABI must be set together`,
+		`structs "Missing": no such struct`,
+		`fields "noDot": expected <struct>.<field>`,
+		`fields "a.b": goType is required`,
+		`fields "c.[": syntax error in pattern`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not contain %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestTypeOverridesUnknownType(t *testing.T) {
+	contract, err := schema.ParseAppSpec([]byte(`{
+		"name": "X", "structs": {},
+		"methods": [{"name": "set", "args": [{"name": "id", "type": "UserID"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}}],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides := &TypeOverrides{ABITypes: map[string]TypeOverride{"UserID": {GoType: "domain.UserID", Import: domainImport}}}
+	if err := overrides.Check(contract); err != nil {
+		t.Fatalf("an unknown type used by the contract should be overridable: %v", err)
+	}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "x", Mode: "full", TypeOverrides: overrides})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !strings.Contains(string(files["types.go"]), "ID domain.UserID") {
+		t.Errorf("types.go does not use the override:\n%s", files["types.go"])
+	}
+}
+
+func TestTypeOverridesStateAndEvents(t *testing.T) {
+	spec := []byte(`{
+		"name": "X", "structs": {},
+		"methods": [],
+		"state": {
+			"keys": {
+				"global": {"round": {"keyType": "AVMString", "valueType": "uint64", "key": "cg=="}},
+				"local": {"lastRound": {"keyType": "AVMString", "valueType": "uint64", "key": "bA=="}},
+				"box": {}
+			},
+			"maps": {"global": {}, "local": {}, "box": {"round": {"keyType": "address", "valueType": "uint64", "prefix": "cg=="}}}
+		},
+		"bareActions": {"create": ["NoOp"], "call": []},
+		"events": [{"name": "Closed", "args": [{"name": "round", "type": "uint64"}, {"name": "count", "type": "uint64"}]}]
+	}`)
+	contract, err := schema.ParseAppSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extras, err := schema.ParseExtras(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides := &TypeOverrides{Fields: map[string]TypeOverride{
+		"*.round":     {GoType: "domain.Round", Import: domainImport, ABIType: "uint64"},
+		"*.lastRound": {GoType: "domain.Round", Import: domainImport},
+	}}
+
+	files, err := Render(context.Background(), contract, Options{PackageName: "x", Mode: "full", TypeOverrides: overrides, Events: extras.Events})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	state := string(files["state.go"])
+	for _, want := range []string{
+		`"example.com/domain"`,
+		"\tRound domain.Round",     // global.round
+		"\tLastRound domain.Round", // local.lastRound
+		"GetBoxMapRound(ctx context.Context, key types.Address) (domain.Round, error)", // box.round
+	} {
+		if !strings.Contains(state, want) {
+			t.Errorf("state.go does not contain %q:\n%s", want, state)
+		}
+	}
+	events := string(files["events.go"])
+	for _, want := range []string{`"example.com/domain"`, "\tRound domain.Round", "\tCount uint64"} {
+		if !strings.Contains(events, want) {
+			t.Errorf("events.go does not contain %q:\n%s", want, events)
+		}
+	}
+}
+
+func TestTypeOverridesLookupField(t *testing.T) {
+	o := &TypeOverrides{Fields: map[string]TypeOverride{
+		"*.*Round":       {GoType: "Round", ABIType: "uint64"},
+		"Order.maxRound": {GoType: "MaxRound"},
+	}}
+
+	tests := []struct {
+		owner, name, abiType, want string
+	}{
+		{"Order", "maxRound", "uint64", "MaxRound"},
+		{"Order", "lastRound", "uint64", "Round"},
+		{"bid", "firstRound", "uint64", "Round"},
+		{"Order", "lastRound", "uint32", ""},
+		{"Order", "round", "uint64", ""},
+	}
+	for _, tt := range tests {
+		ov, ok := o.lookupField(tt.owner, tt.name, tt.abiType)
+		if got := ov.GoType; ok != (tt.want != "") || got != tt.want {
+			t.Errorf("lookupField(%q, %q, %q) = %q, want %q", tt.owner, tt.name, tt.abiType, got, tt.want)
+		}
+	}
+}
+
+func TestLoadTypeOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "types.json")
+	if err := os.WriteFile(path, []byte(`{"abiTypes": {"address": {"goType": "domain.Address", "import": "example.com/domain"}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	o, err := LoadTypeOverrides(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.ABITypes["address"].GoType != "domain.Address" {
+		t.Errorf("unexpected overrides: %+v", o)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"abiType": {}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTypeOverrides(path); err == nil {
+		t.Error("expected unknown field error")
+	}
+}
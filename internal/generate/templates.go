@@ -0,0 +1,181 @@
+package generate
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// TemplateDataVersion is the version of the TemplateData contract. It is
+// incremented whenever a field is removed or changes meaning; adding fields
+// does not change it. Custom templates can guard against incompatible
+// generators with {{if ne .Version 1}}...{{end}}.
+const TemplateDataVersion = 1
+
+// TemplateData is the data passed to every template, built-in or custom.
+// Fields are part of the template data contract described by TemplateDataVersion.
+type TemplateData struct {
+	Version      int    // TemplateDataVersion
+	PackageName  string // Go package name of the generated code
+	ContractName string // PascalCase contract name
+	Mode         string // "full" or "minimal"
+	AppSpecJSON  string // ARC-56 spec as a quoted Go string literal
+
+	Methods    []MethodData
+	Structs    []StructData
+	State      StateData
+	Events     []EventData // ARC-28 events, sorted by name
+	BareConfig BareCallConfig
+	HasFactory bool
+
+	// HasCallArgs is true if any method callable on an existing app takes args
+	HasCallArgs bool
+	// HasCallMethods is true if any method is callable on an existing app
+	HasCallMethods bool
+
+	// HasByteCode is true if the spec embeds byteCode usable without
+	// recompiling; ApprovalProgramHash is the hex SHA-512/256 of its approval program
+	HasByteCode         bool
+	ApprovalProgramHash string
+
+	Networks []NetworkAppID // app IDs from the spec's networks section, sorted by genesis hash
+
+	// CreateMethods are the methods allowing a create call, in spec order.
+	// The first is the default create method described by the fields below.
+	CreateMethods []*MethodData
+
+	// Default create method metadata, used by the factory template
+	HasMethodCreateWithArgs  bool
+	HasMethodCreateNoArgs    bool
+	CreateMethodOriginalName string
+	CreateMethodGoName       string
+	CreateMethodHasCodecArgs bool
+
+	// Lifecycle methods used by Deploy; nil if none allows the action
+	UpdateMethod *MethodData
+	DeleteMethod *MethodData
+
+	TypesImports  []string          // imports needed by types.go
+	StateImports  []string          // imports needed by state.go beyond the ones it always has
+	EventImports  []string          // imports needed by events.go for its field types
+	HasEventArgs  bool              // true if any event has args to decode
+	ChildImports  []ChildApp        // child app packages imported by types.go, sorted by import path
+	ClientImports []string          // imports needed by client.go and fake.go for state value types
+	Untyped       []UntypedLocation // ABI types emitted as any with --allow-untyped
+	Wrappers      []ABIWrapper      // wrapper types emitted in abitypes.go, sorted by name
+	HasUFixed     bool              // true if any wrapper is a ufixed type
+	HasBigUint    bool              // true if any wrapper is a uint<N> wider than 64 bits
+	HasTuples     bool              // true if any wrapper is a TupleN type
+	HasCodec      bool              // true if abitypes.go and its codec helpers are generated
+	Converters    []TypeConverter   // type override converters registered in abitypes.go
+	CodecImports  []string          // extra imports needed by abitypes.go
+	FuzzStructs   []FuzzStruct      // structs covered by roundtrip_test.go with --emit-tests
+
+	// Optional files, each emitted with its --emit-* flag
+	EmitFake         bool // fake.go
+	EmitRecorder     bool // recorder.go, also with --emit-cli
+	EmitJSON         bool // json.go, also with the recorder
+	EmitInterceptors bool // interceptor.go
+	EmitParamsCache  bool // paramscache.go
+	EmitAsync        bool // async.go, also with offline.go
+	EmitOffline      bool // offline.go
+
+	// Program emitted in cmd/<package> with --emit-cli
+	CLIImportPath string       // import path of the generated package
+	CLICommands   []CLICommand // a subcommand per method callable on an existing app
+	CLISkipped    []CLISkip    // callable methods without a subcommand
+
+	// Contract is the parsed spec, for templates needing data not exposed above.
+	Contract *algokit.Arc56Contract
+}
+
+// TemplateFuncs returns the functions available to templates.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"join":    strings.Join,
+		"toLower": strings.ToLower,
+		"toUpper": strings.ToUpper,
+		"comment": func(s string) string {
+			lines := strings.Split(s, "\n")
+			for i, line := range lines {
+				lines[i] = "// " + line
+			}
+			return strings.Join(lines, "\n")
+		},
+
+		// Naming
+		"toPascalCase":  ToPascalCase,
+		"toCamelCase":   ToCamelCase,
+		"toPackageName": ToPackageName,
+		"safeGoName":    SafeGoName,
+
+		// Strings
+		"quote":      strconv.Quote,
+		"contains":   strings.Contains,
+		"hasPrefix":  strings.HasPrefix,
+		"hasSuffix":  strings.HasSuffix,
+		"trimPrefix": strings.TrimPrefix,
+		"trimSuffix": strings.TrimSuffix,
+		"replace":    strings.ReplaceAll,
+		"split":      strings.Split,
+		"indent": func(n int, s string) string {
+			pad := strings.Repeat("\t", n)
+			lines := strings.Split(s, "\n")
+			for i, line := range lines {
+				if line != "" {
+					lines[i] = pad + line
+				}
+			}
+			return strings.Join(lines, "\n")
+		},
+
+		// Arithmetic, e.g. for comma placement in ranges
+		"add": func(a, b int) int { return a + b },
+		"sub": func(a, b int) int { return a - b },
+
+		// ABI types
+		"parseABIType": ParseABIType,
+	}
+}
+
+// parseTemplates parses the embedded templates, then any *.go.tmpl files in
+// overrides. Override files named like an embedded template replace it; the
+// names of the remaining override files are returned as extra templates,
+// each rendered to its own output file.
+func parseTemplates(overrides fs.FS) (*template.Template, []string, error) {
+	tmpl, err := template.New("").Funcs(TemplateFuncs()).ParseFS(templateFS, "*.go.tmpl")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+	if overrides == nil {
+		return tmpl, nil, nil
+	}
+
+	matches, err := fs.Glob(overrides, "*.go.tmpl")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list custom templates: %w", err)
+	}
+	sort.Strings(matches)
+
+	var extras []string
+	for _, name := range matches {
+		if tmpl.Lookup(path.Base(name)) == nil {
+			extras = append(extras, name)
+		}
+		src, err := fs.ReadFile(overrides, name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read custom template %s: %w", name, err)
+		}
+		if _, err := tmpl.New(name).Parse(string(src)); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse custom template %s: %w", name, err)
+		}
+	}
+
+	return tmpl, extras, nil
+}
@@ -3,7 +3,6 @@ package generate
 import (
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	algokit "github.com/kylebeee/algokit-utils-go"
@@ -23,34 +22,116 @@ type TypeMapping struct {
 	IsVoid     bool     // True if the type is void
 	IsStruct   bool     // True if this maps to a generated struct
 	StructName string   // Name of the struct if IsStruct
+	Unmapped   bool     // True if the type (or an element of it) has no Go mapping
+	Codec      bool     // True if values need converting to/from the ABI encoder's representation
+	Wrappers   []ABIWrapper
+	Converters []TypeConverter // user-supplied converters from type overrides
+}
+
+// ABIWrapper describes a generated wrapper type for an ABI type without a
+// native Go equivalent, such as uint24, uint256, ufixed64x2 or an unnamed
+// tuple.
+type ABIWrapper struct {
+	Name      string // Go type name, e.g. "Uint24", "UFixed64x2" or "Tuple2"
+	Bits      int
+	Precision int // ufixed precision; 0 for uint wrappers
+	Arity     int // number of tuple elements; 0 for uint and ufixed wrappers
+}
+
+// IsTuple returns true if the wrapper is a generic TupleN type.
+func (w ABIWrapper) IsTuple() bool {
+	return w.Arity > 0
+}
+
+// Items returns the element indexes of a tuple wrapper.
+func (w ABIWrapper) Items() []int {
+	items := make([]int, w.Arity)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+// IsUFixed returns true if the wrapper represents a ufixed<N>x<M> type.
+func (w ABIWrapper) IsUFixed() bool {
+	return w.Precision > 0
+}
+
+// IsBig returns true if the wrapper is a uint<N> type wider than 64 bits,
+// backed by a big.Int.
+func (w ABIWrapper) IsBig() bool {
+	return !w.IsUFixed() && !w.IsTuple() && w.Bits > 64
 }
 
 // MapABITypeToGo converts an ABI type string to a Go type string.
 func MapABITypeToGo(abiType string, structs map[string][]algokit.StructField, structName string) TypeMapping {
+	return (&typeMapper{structs: structs}).mapArg(abiType, structName)
+}
+
+func mapType(abiType string, structs map[string][]algokit.StructField) TypeMapping {
+	return (&typeMapper{structs: structs}).mapType(abiType)
+}
+
+// typeMapper maps ABI types to Go types, applying the naming and type
+// mapping hooks when set.
+type typeMapper struct {
+	structs   map[string][]algokit.StructField
+	hooks     Hooks
+	overrides *TypeOverrides
+}
+
+// mapField maps a struct field or method arg, applying any field override
+// for its "<owner>.<name>" path before the usual mapping.
+func (tm *typeMapper) mapField(owner, name, abiType, structName string) TypeMapping {
+	if ov, ok := tm.overrides.lookupField(owner, name, abiType); ok {
+		return tm.override(abiType, ov.mapping())
+	}
+	return tm.mapArg(abiType, structName)
+}
+
+// mapArg maps an argument or return type, preferring its struct reference.
+func (tm *typeMapper) mapArg(abiType, structName string) TypeMapping {
 	// If there's a struct reference, use the struct name
 	if structName != "" {
-		if _, ok := structs[structName]; ok {
-			goName := ToPascalCase(structName)
-			return TypeMapping{
-				GoType:     goName,
-				IsStruct:   true,
-				StructName: goName,
-			}
+		if _, ok := tm.structs[structName]; ok {
+			return tm.override(abiType, tm.structMapping(structName))
 		}
 	}
 
-	return mapType(abiType, structs)
+	return tm.mapType(abiType)
 }
 
-func mapType(abiType string, structs map[string][]algokit.StructField) TypeMapping {
+func (tm *typeMapper) mapType(abiType string) TypeMapping {
+	if ov, ok := tm.overrides.lookupABIType(abiType); ok {
+		return tm.override(abiType, ov.mapping())
+	}
+	return tm.override(abiType, tm.defaultMapping(abiType))
+}
+
+func (tm *typeMapper) override(abiType string, def TypeMapping) TypeMapping {
+	if tm.hooks.Type == nil {
+		return def
+	}
+	return tm.hooks.Type(abiType, def)
+}
+
+func (tm *typeMapper) structMapping(name string) TypeMapping {
+	if ov, ok := tm.overrides.lookupStruct(name); ok {
+		return ov.mapping()
+	}
+	goName := tm.hooks.name(NameStruct, name)
+	return TypeMapping{
+		GoType:     goName,
+		IsStruct:   true,
+		StructName: goName,
+		Codec:      tm.structNeedsCodec(name, nil),
+	}
+}
+
+func (tm *typeMapper) defaultMapping(abiType string) TypeMapping {
 	// Check for struct reference first
-	if _, ok := structs[abiType]; ok {
-		goName := ToPascalCase(abiType)
-		return TypeMapping{
-			GoType:     goName,
-			IsStruct:   true,
-			StructName: goName,
-		}
+	if _, ok := tm.structs[abiType]; ok {
+		return tm.structMapping(abiType)
 	}
 
 	switch abiType {
@@ -96,46 +177,134 @@ func mapType(abiType string, structs map[string][]algokit.StructField) TypeMappi
 		return TypeMapping{GoType: "uint64"}
 	}
 
-	// Check for uint128+ (big integers)
-	if strings.HasPrefix(abiType, "uint") {
-		bits, err := strconv.Atoi(strings.TrimPrefix(abiType, "uint"))
-		if err == nil && bits > 64 {
-			return TypeMapping{GoType: "*big.Int", Imports: []string{"math/big"}}
+	// uint<N>: native widths map directly and the remaining widths get a
+	// range-checked wrapper type, backed by a big.Int above 64 bits.
+	if strings.HasPrefix(abiType, "uint") && !strings.HasSuffix(abiType, "]") {
+		parsed, err := ParseABIType(abiType)
+		if err != nil {
+			return TypeMapping{GoType: "any", Unmapped: true}
 		}
+		w := ABIWrapper{Name: fmt.Sprintf("Uint%d", parsed.Bits), Bits: parsed.Bits}
+		return TypeMapping{GoType: w.Name, Codec: true, Wrappers: []ABIWrapper{w}}
 	}
 
-	// ufixed types
+	// ufixed<N>x<M> maps to a wrapper around the exact decimal UFixed type
 	if ufixedRegex.MatchString(abiType) {
-		return TypeMapping{GoType: "*big.Rat", Imports: []string{"math/big"}}
+		parsed, err := ParseABIType(abiType)
+		if err != nil {
+			return TypeMapping{GoType: "any", Unmapped: true}
+		}
+		w := ABIWrapper{
+			Name:      fmt.Sprintf("UFixed%dx%d", parsed.Bits, parsed.Precision),
+			Bits:      parsed.Bits,
+			Precision: parsed.Precision,
+		}
+		return TypeMapping{GoType: w.Name, Codec: true, Wrappers: []ABIWrapper{w}}
 	}
 
 	// Static array: T[N]
 	if m := staticArrayRegex.FindStringSubmatch(abiType); m != nil {
-		elemType := mapType(m[1], structs)
+		elemType := tm.mapType(m[1])
 		return TypeMapping{
-			GoType:  fmt.Sprintf("[%s]%s", m[2], elemType.GoType),
-			Imports: elemType.Imports,
+			GoType:     fmt.Sprintf("[%s]%s", m[2], elemType.GoType),
+			Imports:    elemType.Imports,
+			Unmapped:   elemType.Unmapped,
+			Codec:      elemType.Codec,
+			Wrappers:   elemType.Wrappers,
+			Converters: elemType.Converters,
 		}
 	}
 
 	// Dynamic array: T[]
 	if m := dynamicArrayRegex.FindStringSubmatch(abiType); m != nil {
-		elemType := mapType(m[1], structs)
+		elemType := tm.mapType(m[1])
 		return TypeMapping{
-			GoType:  "[]" + elemType.GoType,
-			Imports: elemType.Imports,
+			GoType:     "[]" + elemType.GoType,
+			Imports:    elemType.Imports,
+			Unmapped:   elemType.Unmapped,
+			Codec:      elemType.Codec,
+			Wrappers:   elemType.Wrappers,
+			Converters: elemType.Converters,
 		}
 	}
 
-	// Tuple: (T1,T2,...)
+	// Tuple: (T1,T2,...) maps to a generic TupleN of the element types
 	if m := tupleRegex.FindStringSubmatch(abiType); m != nil {
-		// For unnamed tuples, we generate a struct
-		// For now, just use the raw tuple notation
-		return TypeMapping{GoType: "[]interface{}"}
+		if _, err := ParseABIType(abiType); err != nil {
+			return TypeMapping{GoType: "any", Unmapped: true}
+		}
+		parts := SplitTupleTypes(abiType)
+		w := ABIWrapper{Name: fmt.Sprintf("Tuple%d", len(parts)), Arity: len(parts)}
+		mapping := TypeMapping{Codec: true, Wrappers: []ABIWrapper{w}}
+		elems := make([]string, len(parts))
+		for i, part := range parts {
+			elem := tm.mapType(part)
+			elems[i] = elem.GoType
+			mapping.Imports = append(mapping.Imports, elem.Imports...)
+			mapping.Unmapped = mapping.Unmapped || elem.Unmapped
+			mapping.Wrappers = append(mapping.Wrappers, elem.Wrappers...)
+			mapping.Converters = append(mapping.Converters, elem.Converters...)
+		}
+		mapping.GoType = w.Name + "[" + strings.Join(elems, ", ") + "]"
+		return mapping
 	}
 
-	// Fallback for unknown types
-	return TypeMapping{GoType: "interface{}"}
+	// Unknown types have no Go mapping. Generate refuses them unless
+	// Options.AllowUntyped is set, in which case they are emitted as any.
+	return TypeMapping{GoType: "any", Unmapped: true}
+}
+
+// decodeType returns abiType with every struct name replaced by its tuple
+// type, as the ABI encoder and decoder expect.
+func (tm *typeMapper) decodeType(abiType string) string {
+	if fields, ok := tm.structs[abiType]; ok {
+		types := make([]string, len(fields))
+		for i, f := range fields {
+			types[i] = tm.decodeType(f.Type)
+		}
+		return "(" + strings.Join(types, ",") + ")"
+	}
+	if m := staticArrayRegex.FindStringSubmatch(abiType); m != nil {
+		return tm.decodeType(m[1]) + "[" + m[2] + "]"
+	}
+	if m := dynamicArrayRegex.FindStringSubmatch(abiType); m != nil {
+		return tm.decodeType(m[1]) + "[]"
+	}
+	return abiType
+}
+
+// structNeedsCodec reports whether any field of the struct, recursively,
+// needs converting to or from the ABI encoder's representation.
+func (tm *typeMapper) structNeedsCodec(name string, visiting map[string]bool) bool {
+	if visiting[name] {
+		return false
+	}
+	if visiting == nil {
+		visiting = make(map[string]bool)
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	for _, f := range tm.structs[name] {
+		if _, ok := tm.structs[f.Type]; ok {
+			if _, overridden := tm.overrides.lookupStruct(f.Type); !overridden {
+				if tm.structNeedsCodec(f.Type, visiting) {
+					return true
+				}
+				continue
+			}
+		}
+		if ov, ok := tm.overrides.lookupField(name, f.Name, f.Type); ok {
+			if ov.mapping().Codec {
+				return true
+			}
+			continue
+		}
+		if m := tm.mapType(f.Type); m.Codec {
+			return true
+		}
+	}
+	return false
 }
 
 // SplitTupleTypes splits a tuple type string into its component types.
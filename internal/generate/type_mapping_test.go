@@ -27,8 +27,16 @@ func TestMapABITypeToGo(t *testing.T) {
 		{"uint16", "", "uint16"},
 		{"uint32", "", "uint32"},
 		{"uint64", "", "uint64"},
-		{"uint128", "", "*big.Int"},
-		{"uint256", "", "*big.Int"},
+		{"uint128", "", "Uint128"},
+		{"uint256", "", "Uint256"},
+		{"uint24", "", "Uint24"},
+		{"uint48", "", "Uint48"},
+		{"ufixed64x2", "", "UFixed64x2"},
+		{"ufixed128x10", "", "UFixed128x10"},
+		{"uint64[2][3]", "", "[3][2]uint64"},
+		{"uint24[]", "", "[]Uint24"},
+		{"(uint64,address)", "", "Tuple2[uint64, types.Address]"},
+		{"(uint64,(bool,uint256))[]", "", "[]Tuple2[uint64, Tuple2[bool, Uint256]]"},
 		{"address", "", "types.Address"},
 		{"bytes", "", "[]byte"},
 		{"byte[]", "", "[]byte"},
@@ -58,6 +66,16 @@ func TestMapABITypeToGo(t *testing.T) {
 	}
 }
 
+func TestMapABITypeToGoInvalidWidths(t *testing.T) {
+	for _, abiType := range []string{"uint7", "uint520", "ufixed64x0", "ufixed63x2", "(uint64,uint7)"} {
+		t.Run(abiType, func(t *testing.T) {
+			if result := MapABITypeToGo(abiType, nil, ""); !result.Unmapped {
+				t.Errorf("MapABITypeToGo(%q) = %q, want unmapped", abiType, result.GoType)
+			}
+		})
+	}
+}
+
 func TestMapABITypeToGoStruct(t *testing.T) {
 	structs := map[string][]algokit.StructField{
 		"MyStruct": {
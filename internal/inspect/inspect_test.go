@@ -0,0 +1,97 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+func loadSummary(t *testing.T, path string) *Summary {
+	t.Helper()
+
+	contract, err := schema.LoadAppSpec(path)
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+	extras, err := schema.LoadExtras(path)
+	if err != nil {
+		t.Fatalf("failed to load extras: %v", err)
+	}
+	return Build(contract, extras)
+}
+
+func TestBuildXGovRegistry(t *testing.T) {
+	s := loadSummary(t, "../../testdata/XGovRegistry.arc56.json")
+
+	if s.Name != "XGovRegistry" {
+		t.Errorf("expected name XGovRegistry, got %s", s.Name)
+	}
+	if len(s.Methods) == 0 {
+		t.Fatal("expected methods")
+	}
+
+	create := s.Methods[0]
+	if create.Name != "create" || create.GoName != "Create" {
+		t.Errorf("unexpected first method: %+v", create)
+	}
+	if len(create.Actions) != 1 || create.Actions[0] != "create:NoOp" {
+		t.Errorf("expected create:NoOp action, got %v", create.Actions)
+	}
+
+	if s.Schema.Global.Ints != 36 || s.Schema.Global.Bytes != 28 {
+		t.Errorf("unexpected global schema: %+v", s.Schema.Global)
+	}
+	if len(s.Events) == 0 {
+		t.Error("expected events")
+	}
+	if len(s.TemplateVariables) != 1 || s.TemplateVariables[0].Name != "entropy" {
+		t.Errorf("expected entropy template variable, got %+v", s.TemplateVariables)
+	}
+	if len(s.Errors) == 0 {
+		t.Error("expected error messages from sourceInfo")
+	}
+
+	for _, k := range s.State {
+		if k.Name == "xgov_manager" {
+			if k.Key != "xgov_manager" {
+				t.Errorf("expected decoded key xgov_manager, got %s", k.Key)
+			}
+			if k.GoType != "types.Address" {
+				t.Errorf("expected types.Address, got %s", k.GoType)
+			}
+		}
+	}
+}
+
+func TestRenderFormats(t *testing.T) {
+	s := loadSummary(t, "../../testdata/akita/Gate.arc56.json")
+
+	for _, format := range Formats {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Render(&buf, s, format); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			out := buf.String()
+			if !strings.Contains(out, "GateFilterEntryWithArgsShape") {
+				t.Errorf("expected method Go name in %s output", format)
+			}
+			if format == "json" {
+				var decoded Summary
+				if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+					t.Fatalf("invalid json output: %v", err)
+				}
+				if len(decoded.Methods) != len(s.Methods) {
+					t.Errorf("expected %d methods, got %d", len(s.Methods), len(decoded.Methods))
+				}
+			}
+		})
+	}
+
+	if err := Render(&bytes.Buffer{}, s, "yaml"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
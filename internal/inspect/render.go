@@ -0,0 +1,156 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Formats lists the output formats supported by Render.
+var Formats = []string{"table", "json", "markdown"}
+
+// Render writes the summary to w in the requested format.
+func Render(w io.Writer, s *Summary, format string) error {
+	switch format {
+	case "table", "":
+		return renderTable(w, s)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	case "markdown", "md":
+		return renderMarkdown(w, s)
+	}
+	return fmt.Errorf("unknown format %q (expected one of: %s)", format, strings.Join(Formats, ", "))
+}
+
+// section is a titled table shared by the table and markdown renderers.
+type section struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+func sections(s *Summary) []section {
+	methods := section{title: "Methods", header: []string{"Method", "Go Name", "Args", "Returns", "Actions", "Readonly"}}
+	for _, m := range s.Methods {
+		var args []string
+		for _, a := range m.Args {
+			args = append(args, fmt.Sprintf("%s %s", a.GoName, a.GoType))
+		}
+		returns := m.Returns.GoType
+		if returns == "" {
+			returns = "-"
+		}
+		methods.rows = append(methods.rows, []string{
+			m.Signature,
+			m.GoName,
+			strings.Join(args, ", "),
+			returns,
+			strings.Join(m.Actions, ", "),
+			fmt.Sprintf("%t", m.Readonly),
+		})
+	}
+
+	structs := section{title: "Structs", header: []string{"Struct", "Field", "ABI Type", "Go Type"}}
+	for _, st := range s.Structs {
+		for _, f := range st.Fields {
+			structs.rows = append(structs.rows, []string{st.GoName, f.GoName, f.ABIType, f.GoType})
+		}
+	}
+
+	schemaSection := section{title: "State Schema", header: []string{"Storage", "Ints", "Bytes"}}
+	schemaSection.rows = [][]string{
+		{"global", fmt.Sprint(s.Schema.Global.Ints), fmt.Sprint(s.Schema.Global.Bytes)},
+		{"local", fmt.Sprint(s.Schema.Local.Ints), fmt.Sprint(s.Schema.Local.Bytes)},
+	}
+
+	state := section{title: "State Keys", header: []string{"Storage", "Name", "Key", "ABI Type", "Go Type"}}
+	for _, k := range s.State {
+		state.rows = append(state.rows, []string{k.Storage, k.GoName, k.Key, k.ABIType, k.GoType})
+	}
+
+	boxMaps := section{title: "Box Maps", header: []string{"Name", "Prefix", "Key Type", "Value Type"}}
+	for _, m := range s.BoxMaps {
+		boxMaps.rows = append(boxMaps.rows, []string{m.GoName, m.Prefix, m.Key.GoType, m.Value.GoType})
+	}
+
+	events := section{title: "Events", header: []string{"Event", "Args"}}
+	for _, e := range s.Events {
+		var args []string
+		for _, a := range e.Args {
+			args = append(args, fmt.Sprintf("%s %s", a.GoName, a.GoType))
+		}
+		events.rows = append(events.rows, []string{e.Name, strings.Join(args, ", ")})
+	}
+
+	tmplVars := section{title: "Template Variables", header: []string{"Name", "Type"}}
+	for _, v := range s.TemplateVariables {
+		tmplVars.rows = append(tmplVars.rows, []string{v.Name, v.Type})
+	}
+
+	errs := section{title: "Error Messages", header: []string{"Program", "PCs", "Message"}}
+	for _, e := range s.Errors {
+		pcs := make([]string, len(e.PCs))
+		for i, pc := range e.PCs {
+			pcs[i] = fmt.Sprint(pc)
+		}
+		errs.rows = append(errs.rows, []string{e.Program, strings.Join(pcs, ","), e.Message})
+	}
+
+	return []section{methods, structs, schemaSection, state, boxMaps, events, tmplVars, errs}
+}
+
+func renderTable(w io.Writer, s *Summary) error {
+	fmt.Fprintf(w, "Contract: %s\n", s.Name)
+	if s.Desc != "" {
+		fmt.Fprintf(w, "%s\n", s.Desc)
+	}
+
+	for _, sec := range sections(s) {
+		fmt.Fprintf(w, "\n%s\n", sec.title)
+		if len(sec.rows) == 0 {
+			fmt.Fprintln(w, "  (none)")
+			continue
+		}
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(sec.header, "\t"))
+		for _, row := range sec.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func renderMarkdown(w io.Writer, s *Summary) error {
+	fmt.Fprintf(w, "# %s\n", s.Name)
+	if s.Desc != "" {
+		fmt.Fprintf(w, "\n%s\n", s.Desc)
+	}
+
+	for _, sec := range sections(s) {
+		fmt.Fprintf(w, "\n## %s\n\n", sec.title)
+		if len(sec.rows) == 0 {
+			fmt.Fprintln(w, "_None_")
+			continue
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(sec.header, " | "))
+		fmt.Fprintf(w, "|%s\n", strings.Repeat("---|", len(sec.header)))
+		for _, row := range sec.rows {
+			cells := make([]string, len(row))
+			for i, c := range row {
+				cells[i] = "`" + strings.ReplaceAll(c, "|", "\\|") + "`"
+				if c == "" {
+					cells[i] = ""
+				}
+			}
+			fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package inspect
+
+import (
+	"encoding/base64"
+	"sort"
+	"unicode"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// Summary is a human-oriented view of a contract as the generator sees it.
+type Summary struct {
+	Name              string             `json:"name"`
+	Desc              string             `json:"desc,omitempty"`
+	Methods           []Method           `json:"methods"`
+	Structs           []Struct           `json:"structs"`
+	Schema            schema.StateSchema `json:"schema"`
+	State             []StateKey         `json:"state"`
+	BoxMaps           []BoxMap           `json:"boxMaps"`
+	Events            []Event            `json:"events"`
+	TemplateVariables []TemplateVariable `json:"templateVariables"`
+	Errors            []ErrorMessage     `json:"errors"`
+}
+
+// Method describes an ABI method and its generated Go names.
+type Method struct {
+	Name      string   `json:"name"`
+	GoName    string   `json:"goName"`
+	Signature string   `json:"signature"`
+	Args      []Value  `json:"args"`
+	Returns   Value    `json:"returns"`
+	Actions   []string `json:"actions"`
+	Readonly  bool     `json:"readonly"`
+	Desc      string   `json:"desc,omitempty"`
+}
+
+// Value describes a named, typed value such as an argument or struct field.
+type Value struct {
+	Name    string `json:"name,omitempty"`
+	GoName  string `json:"goName,omitempty"`
+	ABIType string `json:"abiType"`
+	GoType  string `json:"goType"`
+}
+
+// Struct describes an ARC-56 struct and its generated Go type.
+type Struct struct {
+	Name   string  `json:"name"`
+	GoName string  `json:"goName"`
+	Fields []Value `json:"fields"`
+}
+
+// StateKey describes a single global, local or box key.
+type StateKey struct {
+	Storage string `json:"storage"`
+	Name    string `json:"name"`
+	GoName  string `json:"goName"`
+	Key     string `json:"key"`
+	ABIType string `json:"abiType"`
+	GoType  string `json:"goType"`
+	Desc    string `json:"desc,omitempty"`
+}
+
+// BoxMap describes a box map with its key prefix.
+type BoxMap struct {
+	Name   string `json:"name"`
+	GoName string `json:"goName"`
+	Prefix string `json:"prefix"`
+	Key    Value  `json:"key"`
+	Value  Value  `json:"value"`
+	Desc   string `json:"desc,omitempty"`
+}
+
+// Event describes an ARC-28 event.
+type Event struct {
+	Name string  `json:"name"`
+	Args []Value `json:"args"`
+	Desc string  `json:"desc,omitempty"`
+}
+
+// TemplateVariable describes a TMPL_ variable.
+type TemplateVariable struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ErrorMessage describes an error message emitted by the program at the given pcs.
+type ErrorMessage struct {
+	Program string `json:"program"`
+	Message string `json:"message"`
+	PCs     []int  `json:"pcs"`
+}
+
+// Build creates a Summary from a loaded contract and its ARC-56 extras.
+func Build(contract *algokit.Arc56Contract, extras *schema.SpecExtras) *Summary {
+	if extras == nil {
+		extras = &schema.SpecExtras{}
+	}
+
+	ctx := generate.BuildContext(contract, generate.ToPackageName(contract.Name), "full", false)
+
+	s := &Summary{
+		Name:   contract.Name,
+		Desc:   extras.Desc,
+		Schema: extras.State.Schema,
+	}
+
+	for i, md := range ctx.Methods {
+		m := Method{
+			Name:      md.OriginalName,
+			GoName:    md.Name,
+			Signature: md.Signature,
+			Returns: Value{
+				ABIType: contract.Methods[i].Returns.Type,
+				GoType:  md.ReturnType.GoType,
+			},
+			Actions:  methodActions(contract.Methods[i]),
+			Readonly: md.CallConfig.IsReadonly,
+			Desc:     md.Desc,
+		}
+		for _, a := range md.Args {
+			m.Args = append(m.Args, Value{
+				Name:    a.OriginalName,
+				GoName:  a.Name,
+				ABIType: a.ABIType,
+				GoType:  a.GoType,
+			})
+		}
+		s.Methods = append(s.Methods, m)
+	}
+
+	for _, name := range sortedKeys(contract.Structs) {
+		st := Struct{Name: name, GoName: generate.ToPascalCase(name)}
+		for _, f := range contract.Structs[name] {
+			st.Fields = append(st.Fields, value(contract, f.Name, f.Type, ""))
+		}
+		s.Structs = append(s.Structs, st)
+	}
+
+	for _, storage := range []string{"global", "local", "box"} {
+		keys := contract.State.Keys.Global
+		switch storage {
+		case "local":
+			keys = contract.State.Keys.Local
+		case "box":
+			keys = contract.State.Keys.Box
+		}
+		for _, name := range sortedKeys(keys) {
+			k := keys[name]
+			s.State = append(s.State, StateKey{
+				Storage: storage,
+				Name:    name,
+				GoName:  generate.ToPascalCase(name),
+				Key:     displayKey(k.Key),
+				ABIType: k.ValueType,
+				GoType:  generate.MapABITypeToGo(k.ValueType, contract.Structs, "").GoType,
+				Desc:    k.Desc,
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(contract.State.Maps.Box) {
+		m := contract.State.Maps.Box[name]
+		s.BoxMaps = append(s.BoxMaps, BoxMap{
+			Name:   name,
+			GoName: generate.ToPascalCase(name),
+			Prefix: displayKey(m.Prefix),
+			Key:    value(contract, "", m.KeyType, ""),
+			Value:  value(contract, "", m.ValueType, ""),
+			Desc:   m.Desc,
+		})
+	}
+
+	for _, e := range extras.Events {
+		ev := Event{Name: e.Name, Desc: e.Desc}
+		for _, a := range e.Args {
+			ev.Args = append(ev.Args, value(contract, a.Name, a.Type, a.Struct))
+		}
+		s.Events = append(s.Events, ev)
+	}
+
+	for _, name := range sortedKeys(extras.TemplateVariables) {
+		s.TemplateVariables = append(s.TemplateVariables, TemplateVariable{
+			Name: name,
+			Type: extras.TemplateVariables[name].Type,
+		})
+	}
+
+	if extras.SourceInfo != nil {
+		s.Errors = append(s.Errors, errorMessages("approval", extras.SourceInfo.Approval)...)
+		s.Errors = append(s.Errors, errorMessages("clear", extras.SourceInfo.Clear)...)
+	}
+
+	return s
+}
+
+func value(contract *algokit.Arc56Contract, name, abiType, structName string) Value {
+	v := Value{
+		Name:    name,
+		ABIType: abiType,
+		GoType:  generate.MapABITypeToGo(abiType, contract.Structs, structName).GoType,
+	}
+	if name != "" {
+		v.GoName = generate.ToPascalCase(name)
+	}
+	return v
+}
+
+func methodActions(m algokit.Arc56Method) []string {
+	var actions []string
+	for _, a := range m.Actions.Create {
+		actions = append(actions, "create:"+a)
+	}
+	for _, a := range m.Actions.Call {
+		actions = append(actions, "call:"+a)
+	}
+	return actions
+}
+
+func errorMessages(program string, info schema.ProgramSourceInfo) []ErrorMessage {
+	var result []ErrorMessage
+	for _, e := range info.SourceInfo {
+		if e.ErrorMessage == "" {
+			continue
+		}
+		result = append(result, ErrorMessage{
+			Program: program,
+			Message: e.ErrorMessage,
+			PCs:     e.PC,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Message < result[j].Message
+	})
+	return result
+}
+
+// displayKey decodes a base64 state key and returns it as text when printable,
+// falling back to the original base64 form otherwise.
+func displayKey(key string) string {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) == 0 {
+		return key
+	}
+	for _, r := range string(raw) {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return key
+		}
+	}
+	return string(raw)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
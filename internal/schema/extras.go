@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SpecExtras holds the parts of an ARC-56 specification that the generator
+// does not consume through algokit.Arc56Contract, read directly from the JSON.
+type SpecExtras struct {
+	Desc              string                      `json:"desc,omitempty"`
+	Events            []Event                     `json:"events,omitempty"`
+	TemplateVariables map[string]TemplateVariable `json:"templateVariables,omitempty"`
+	SourceInfo        *SourceInfo                 `json:"sourceInfo,omitempty"`
+	ByteCode          *ByteCode                   `json:"byteCode,omitempty"`
+	Networks          map[string]Network          `json:"networks,omitempty"`
+	State             struct {
+		Schema StateSchema `json:"schema"`
+	} `json:"state"`
+}
+
+// Event describes an ARC-28 event emitted by the contract.
+type Event struct {
+	Name string     `json:"name"`
+	Desc string     `json:"desc,omitempty"`
+	Args []EventArg `json:"args"`
+}
+
+// EventArg describes a single event argument.
+type EventArg struct {
+	Name   string `json:"name,omitempty"`
+	Type   string `json:"type"`
+	Desc   string `json:"desc,omitempty"`
+	Struct string `json:"struct,omitempty"`
+}
+
+// TemplateVariable describes a TMPL_ variable substituted at compile time.
+type TemplateVariable struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+// SourceInfo holds the program counter to source mappings for both programs.
+type SourceInfo struct {
+	Approval ProgramSourceInfo `json:"approval"`
+	Clear    ProgramSourceInfo `json:"clear"`
+}
+
+// ProgramSourceInfo holds the source mappings for a single program.
+type ProgramSourceInfo struct {
+	SourceInfo     []SourceInfoEntry `json:"sourceInfo"`
+	PCOffsetMethod string            `json:"pcOffsetMethod"`
+}
+
+// SourceInfoEntry maps one or more program counters to source details.
+type SourceInfoEntry struct {
+	PC           []int  `json:"pc"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	Teal         int    `json:"teal,omitempty"`
+	Source       string `json:"source,omitempty"`
+}
+
+// ByteCode holds the base64 encoded compiled programs.
+type ByteCode struct {
+	Approval string `json:"approval"`
+	Clear    string `json:"clear"`
+}
+
+// Network holds the deployment details for a single network.
+type Network struct {
+	AppID uint64 `json:"appID"`
+}
+
+// StateSchema holds the declared global and local state allocation.
+type StateSchema struct {
+	Global SchemaCounts `json:"global"`
+	Local  SchemaCounts `json:"local"`
+}
+
+// SchemaCounts holds the number of uint64 and byte slice slots.
+type SchemaCounts struct {
+	Ints  int `json:"ints"`
+	Bytes int `json:"bytes"`
+}
+
+// LoadExtras loads the ARC-56 extras from a JSON file.
+func LoadExtras(path string) (*SpecExtras, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read app spec file: %w", err)
+	}
+
+	return ParseExtras(data)
+}
+
+// ParseExtras parses the ARC-56 extras from JSON bytes.
+// ARC-32 specs yield an empty result since they carry none of these sections.
+func ParseExtras(data []byte) (*SpecExtras, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse app spec JSON: %w", err)
+	}
+
+	extras := &SpecExtras{}
+	if _, hasContract := raw["contract"]; hasContract {
+		return extras, nil
+	}
+	if err := json.Unmarshal(data, extras); err != nil {
+		return nil, fmt.Errorf("failed to parse app spec extras: %w", err)
+	}
+	return extras, nil
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 
 	algokit "github.com/kylebeee/algokit-utils-go"
 )
@@ -17,62 +16,66 @@ func LoadAppSpec(path string) (*algokit.Arc56Contract, error) {
 		return nil, fmt.Errorf("failed to read app spec file: %w", err)
 	}
 
-	// Try to detect format
-	if isArc56(data) {
-		return algokit.ParseArc56Contract(data)
+	return ParseAppSpec(data)
+}
+
+// Spec formats returned by DetectFormat and DetectFormatData.
+const (
+	FormatARC56   = "arc56"
+	FormatARC32   = "arc32"
+	FormatUnknown = "unknown"
+)
+
+// ParseAppSpec parses an ARC-56 or ARC-32 application specification from JSON bytes.
+// It auto-detects the format and converts ARC-32 to ARC-56 if needed.
+func ParseAppSpec(data []byte) (*algokit.Arc56Contract, error) {
+	format, err := DetectFormatData(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse app spec JSON: %w", err)
 	}
 
-	// Try ARC-32 conversion
-	return algokit.Arc32ToArc56(data)
+	switch format {
+	case FormatARC32:
+		return algokit.Arc32ToArc56(data)
+	case FormatARC56:
+		contract, err := algokit.ParseArc56Contract(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ARC-56 app spec: %w", err)
+		}
+		return contract, nil
+	}
+
+	return nil, fmt.Errorf("unrecognized app spec format: expected top-level \"methods\" (ARC-56) or \"contract\" (ARC-32)")
 }
 
-// isArc56 detects whether the JSON data is an ARC-56 spec.
-// ARC-56 specs have top-level "methods" with "actions" fields and "bareActions".
-// ARC-32 specs have "contract", "hints", "source", "schema" at top level.
-func isArc56(data []byte) bool {
+// DetectFormatData returns the format of a spec from its top-level keys.
+// ARC-32 has "contract"; anything else with "methods" is treated as ARC-56,
+// so that a malformed spec is reported as such instead of as a failed ARC-32
+// conversion.
+func DetectFormatData(data []byte) (string, error) {
 	var raw map[string]json.RawMessage
 	if err := json.Unmarshal(data, &raw); err != nil {
-		return false
+		return "", err
 	}
-
-	// ARC-56 has "methods" and "bareActions" at top level
-	_, hasMethods := raw["methods"]
-	_, hasBareActions := raw["bareActions"]
-	_, hasContract := raw["contract"]
-
-	// ARC-32 has "contract" at top level; ARC-56 does not
-	if hasContract {
-		return false
+	if _, hasContract := raw["contract"]; hasContract {
+		return FormatARC32, nil
 	}
-
-	return hasMethods && hasBareActions
+	if _, hasMethods := raw["methods"]; hasMethods {
+		return FormatARC56, nil
+	}
+	return FormatUnknown, nil
 }
 
-// DetectFormat returns "arc56" or "arc32" based on the file content.
+// DetectFormat returns the format of a spec file. See DetectFormatData.
 func DetectFormat(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return "", fmt.Errorf("failed to read file: %w", err)
 	}
 
-	if isArc56(data) {
-		return "arc56", nil
-	}
-
-	// Check if it could be ARC-32
-	var raw map[string]json.RawMessage
-	if err := json.Unmarshal(data, &raw); err != nil {
+	format, err := DetectFormatData(data)
+	if err != nil {
 		return "", fmt.Errorf("failed to parse JSON: %w", err)
 	}
-
-	if _, hasContract := raw["contract"]; hasContract {
-		return "arc32", nil
-	}
-
-	// If the filename contains arc56, trust it
-	if strings.Contains(strings.ToLower(path), "arc56") {
-		return "arc56", nil
-	}
-
-	return "unknown", nil
+	return format, nil
 }
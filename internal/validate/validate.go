@@ -0,0 +1,603 @@
+// Package validate performs strict validation of ARC-56 application specs,
+// reporting every problem found together with its JSON path.
+package validate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// Kind classifies a diagnostic.
+type Kind string
+
+const (
+	KindDocument   Kind = "document"   // malformed JSON or missing/mistyped fields
+	KindType       Kind = "type"       // unknown or malformed ABI type
+	KindUsage      Kind = "usage"      // a known type used where it isn't allowed
+	KindStructRef  Kind = "struct"     // unresolved or mismatched struct reference
+	KindSignature  Kind = "signature"  // duplicate method signature
+	KindAction     Kind = "action"     // invalid OnComplete action
+	KindEncoding   Kind = "encoding"   // invalid base64 key or prefix
+	KindSchema     Kind = "schema"     // schema counts can't hold the declared keys (a warning)
+	KindIdentifier Kind = "identifier" // invalid or colliding Go identifier
+	KindLifecycle  Kind = "lifecycle"  // several methods allow an update or delete that Deploy sends (a warning)
+)
+
+// Diagnostic is a single validation problem at a JSON path within the spec.
+type Diagnostic struct {
+	Path    string `json:"path"`
+	Kind    Kind   `json:"kind"`
+	Message string `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return d.Path + ": " + d.Message
+}
+
+// IsWarning reports whether d describes a valid spec that may not generate
+// what the author expects. The schema counts are a heuristic, since they
+// can't tell how a contract stores an ABI-typed value, and Deploy can only
+// send one of several update or delete methods. Warnings don't block
+// generation.
+func (d Diagnostic) IsWarning() bool {
+	return d.Kind == KindSchema || d.Kind == KindLifecycle
+}
+
+// Error wraps a non-empty list of diagnostics as an error.
+type Error struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *Error) Error() string {
+	lines := make([]string, len(e.Diagnostics))
+	for i, d := range e.Diagnostics {
+		lines[i] = "  " + d.String()
+	}
+	return fmt.Sprintf("app spec has %d problem(s):\n%s", len(e.Diagnostics), strings.Join(lines, "\n"))
+}
+
+// reservedNames are identifiers emitted by the generator that spec-derived
+// type names must not collide with. TestReservedNames checks it against the
+// rendered output.
+var reservedNames = map[string]bool{
+	"Client": true, "Composer": true, "Factory": true, "DeployResult": true,
+	"FactoryCreateParams": true, "AppSpecJSON": true, "GetAppSpec": true,
+	"ClientOptions": true, "NewClient": true, "NewClientFromSpec": true, "NewFactory": true,
+	"ClientAPI": true, "FakeClient": true, "FakeCall": true, "NewFakeClient": true,
+	"FactoryDeployParams": true, "OnChange": true, "DeployAction": true,
+	"OnChangeFail": true, "OnChangeUpdate": true, "OnChangeReplace": true, "OnChangeAppend": true,
+	"DeployActionNone": true, "DeployActionCreate": true, "DeployActionUpdate": true,
+	"DeployActionReplace": true, "DeployActionAppend": true,
+	"ErrProgramMismatch": true, "ApprovalProgramHash": true,
+	"NetworkAppIDs": true, "NewClientForNetwork": true, "NetworkAppID": true, "NewClientWithAlgod": true,
+	"DeployNotePrefix": true, "ErrAppNotFound": true, "AppMetadata": true,
+	"AppLookupIndexer": true, "IndexerLookup": true, "FakeIndexer": true,
+	"Recorder": true, "RecordedCall": true, "CallRecord": true, "JSONLRecorder": true,
+	"NewJSONLRecorder": true, "ReplayOptions": true, "ReplayResult": true,
+	"CallInfo": true, "Invoker": true, "Interceptor": true, "ResultTxIDs": true,
+	"LoggingInterceptor": true, "RetryInterceptor": true, "IsTransientError": true,
+	"StartSpanFunc": true, "TracingInterceptor": true,
+	"SuggestedParamsCache": true, "NewSuggestedParamsCache": true,
+	"ErrNotConfirmed": true, "ErrRejected": true, "ConfirmationError": true,
+	"PendingGroup": true, "PendingCall": true, "GroupResult": true,
+	"WriteTransactions": true, "ReadSignedTransactions": true,
+	"GlobalState": true, "LocalState": true, "ParseEvents": true,
+}
+
+// Options configures ValidateWith.
+type Options struct {
+	// TypeOverrides declares Go types for ABI types and fields. Types they
+	// cover are not reported as unknown.
+	TypeOverrides *generate.TypeOverrides
+}
+
+// Validate checks the raw JSON of an ARC-56 or ARC-32 spec and returns every
+// problem found. ARC-32 specs are converted first, so their paths refer to the
+// converted ARC-56 document.
+func Validate(data []byte) []Diagnostic {
+	return ValidateWith(data, Options{})
+}
+
+// ValidateWith is Validate with options.
+func ValidateWith(data []byte, opts Options) []Diagnostic {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(data, &top); err != nil {
+		return []Diagnostic{{Path: "$", Kind: KindDocument, Message: fmt.Sprintf("invalid JSON: %v", err)}}
+	}
+
+	if format, _ := schema.DetectFormatData(data); format == schema.FormatARC32 {
+		contract, err := algokit.Arc32ToArc56(data)
+		if err != nil {
+			return []Diagnostic{{Path: "$", Kind: KindDocument, Message: fmt.Sprintf("ARC-32 conversion failed: %v", err)}}
+		}
+		converted, err := json.Marshal(contract)
+		if err != nil {
+			return []Diagnostic{{Path: "$", Kind: KindDocument, Message: fmt.Sprintf("ARC-32 conversion failed: %v", err)}}
+		}
+		data = converted
+		top = nil
+		if err := json.Unmarshal(data, &top); err != nil {
+			return []Diagnostic{{Path: "$", Kind: KindDocument, Message: fmt.Sprintf("invalid JSON: %v", err)}}
+		}
+	}
+
+	v := &validator{overrides: opts.TypeOverrides}
+	for _, key := range []string{"name", "methods", "structs", "state", "bareActions"} {
+		if _, ok := top[key]; !ok {
+			v.addf("$."+key, KindDocument, "required field is missing")
+		}
+	}
+
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			v.addf("$."+typeErr.Field, KindDocument, "expected %s, got JSON %s", typeErr.Type, typeErr.Value)
+		} else {
+			v.addf("$", KindDocument, "invalid ARC-56 document: %v", err)
+		}
+		return v.diags
+	}
+
+	v.spec = &s
+	v.run()
+	return v.diags
+}
+
+// spec mirrors the ARC-56 JSON closely enough to report precise paths.
+type spec struct {
+	Name        string                  `json:"name"`
+	Structs     map[string][]field      `json:"structs"`
+	Methods     []method                `json:"methods"`
+	State       state                   `json:"state"`
+	Events      []schema.Event          `json:"events"`
+	BareActions map[string][]string     `json:"bareActions"`
+	Templates   map[string]templateType `json:"templateVariables"`
+}
+
+type field struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+type method struct {
+	Name    string `json:"name"`
+	Args    []arg  `json:"args"`
+	Returns arg    `json:"returns"`
+	Actions struct {
+		Create []string `json:"create"`
+		Call   []string `json:"call"`
+	} `json:"actions"`
+}
+
+type arg struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Struct string `json:"struct"`
+}
+
+type state struct {
+	Schema *schema.StateSchema `json:"schema"`
+	Keys   struct {
+		Global map[string]storageKey `json:"global"`
+		Local  map[string]storageKey `json:"local"`
+		Box    map[string]storageKey `json:"box"`
+	} `json:"keys"`
+	Maps struct {
+		Global map[string]storageMap `json:"global"`
+		Local  map[string]storageMap `json:"local"`
+		Box    map[string]storageMap `json:"box"`
+	} `json:"maps"`
+}
+
+type storageKey struct {
+	KeyType   string `json:"keyType"`
+	ValueType string `json:"valueType"`
+	Key       string `json:"key"`
+}
+
+type storageMap struct {
+	KeyType   string `json:"keyType"`
+	ValueType string `json:"valueType"`
+	Prefix    string `json:"prefix"`
+}
+
+type templateType struct {
+	Type string `json:"type"`
+}
+
+// typeContext controls which non-ARC-4 type names are accepted.
+type typeContext int
+
+const (
+	ctxABI typeContext = iota
+	ctxArg
+	ctxReturn
+	ctxStorage
+)
+
+var validActions = map[string]bool{
+	"NoOp": true, "OptIn": true, "CloseOut": true, "ClearState": true,
+	"UpdateApplication": true, "DeleteApplication": true,
+}
+
+type validator struct {
+	spec      *spec
+	overrides *generate.TypeOverrides
+	diags     []Diagnostic
+}
+
+func (v *validator) addf(path string, kind Kind, format string, args ...interface{}) {
+	v.diags = append(v.diags, Diagnostic{Path: path, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) run() {
+	if v.spec.Name == "" {
+		v.addf("$.name", KindDocument, "contract name is empty")
+	}
+
+	v.checkStructs()
+	v.checkMethods()
+	v.checkLifecycle()
+	v.checkState()
+	v.checkEvents()
+	v.checkTemplateVariables()
+}
+
+func (v *validator) checkStructs() {
+	seen := make(map[string]string)
+	for _, name := range sortedKeys(v.spec.Structs) {
+		path := fmt.Sprintf("$.structs[%q]", name)
+		v.checkIdentifier(path, name, seen)
+		if len(v.spec.Structs[name]) == 0 {
+			v.addf(path, KindStructRef, "struct has no fields")
+		}
+		v.checkFields(path, name, v.spec.Structs[name])
+		if _, err := v.structTuple(name, nil); err != nil {
+			v.addf(path, KindStructRef, "%v", err)
+		}
+	}
+}
+
+// checkFields checks the fields of a struct, whose name is owner for
+// top-level structs and empty for nested field lists.
+func (v *validator) checkFields(path, owner string, fields []field) {
+	seen := make(map[string]string)
+	for i, f := range fields {
+		fpath := fmt.Sprintf("%s[%d]", path, i)
+		v.checkIdentifier(fpath+".name", f.Name, seen)
+
+		var typeName string
+		if err := json.Unmarshal(f.Type, &typeName); err == nil {
+			v.checkValueType(fpath+".type", owner, f.Name, typeName, ctxABI)
+			continue
+		}
+		var nested []field
+		if err := json.Unmarshal(f.Type, &nested); err != nil {
+			v.addf(fpath+".type", KindType, "expected an ABI type string or an array of struct fields")
+			continue
+		}
+		v.checkFields(fpath+".type", "", nested)
+	}
+}
+
+func (v *validator) checkMethods() {
+	signatures := make(map[string]int)
+	goNames := make(map[string]int)
+
+	for i, m := range v.spec.Methods {
+		path := fmt.Sprintf("$.methods[%d]", i)
+		if m.Name == "" {
+			v.addf(path+".name", KindDocument, "method name is empty")
+		}
+
+		var argTypes []string
+		argNames := make(map[string]string)
+		for j, a := range m.Args {
+			apath := fmt.Sprintf("%s.args[%d]", path, j)
+			v.checkValueType(apath+".type", m.Name, a.Name, a.Type, ctxArg)
+			v.checkStructRef(apath, a)
+			v.checkIdentifier(apath+".name", a.Name, argNames)
+			argTypes = append(argTypes, a.Type)
+		}
+
+		v.checkType(path+".returns.type", m.Returns.Type, ctxReturn)
+		v.checkStructRef(path+".returns", m.Returns)
+
+		for j, a := range m.Actions.Create {
+			if a != "NoOp" && a != "OptIn" && a != "DeleteApplication" {
+				v.addf(fmt.Sprintf("%s.actions.create[%d]", path, j), KindAction, "invalid create action %q", a)
+			}
+		}
+		for j, a := range m.Actions.Call {
+			if !validActions[a] {
+				v.addf(fmt.Sprintf("%s.actions.call[%d]", path, j), KindAction, "invalid call action %q", a)
+			}
+		}
+
+		sig := fmt.Sprintf("%s(%s)%s", m.Name, strings.Join(argTypes, ","), m.Returns.Type)
+		if prev, ok := signatures[sig]; ok {
+			v.addf(path, KindSignature, "duplicate method signature %s (first declared at $.methods[%d])", sig, prev)
+			continue
+		}
+		signatures[sig] = i
+
+		if m.Name != "" {
+			goName := generate.ToPascalCase(m.Name)
+			v.checkIdentifier(path+".name", m.Name, map[string]string{})
+			if prev, ok := goNames[goName]; ok {
+				v.addf(path+".name", KindIdentifier, "method %q collides with $.methods[%d] (%q): both generate Go name %s",
+					m.Name, prev, v.spec.Methods[prev].Name, goName)
+			} else {
+				goNames[goName] = i
+			}
+			if goName == "Bare" && len(m.Actions.Create) > 0 && len(v.spec.BareActions["create"]) > 0 {
+				v.addf(path+".name", KindIdentifier, "create method %q generates Factory.CreateBare, which collides with the bare create", m.Name)
+			}
+			for _, suffix := range []string{"Args", "MethodResult"} {
+				if _, ok := v.spec.Structs[goName+suffix]; ok {
+					v.addf(path+".name", KindIdentifier, "generated type %s%s collides with struct %q", goName, suffix, goName+suffix)
+				}
+			}
+		}
+	}
+}
+
+// checkLifecycle warns when several methods allow the update or delete
+// that Deploy sends, since it always uses the first.
+func (v *validator) checkLifecycle() {
+	for _, action := range []string{"UpdateApplication", "DeleteApplication"} {
+		first := -1
+		for i, m := range v.spec.Methods {
+			allowed := false
+			for _, a := range m.Actions.Call {
+				allowed = allowed || a == action
+			}
+			if !allowed {
+				continue
+			}
+			if first < 0 {
+				first = i
+				continue
+			}
+			v.addf(fmt.Sprintf("$.methods[%d]", i), KindLifecycle, "method %q also allows %s; Factory.Deploy sends the first, %q",
+				m.Name, action, v.spec.Methods[first].Name)
+		}
+	}
+}
+
+func (v *validator) checkState() {
+	st := v.spec.State
+
+	scopes := []struct {
+		name string
+		keys map[string]storageKey
+		maps map[string]storageMap
+	}{
+		{"global", st.Keys.Global, st.Maps.Global},
+		{"local", st.Keys.Local, st.Maps.Local},
+		{"box", st.Keys.Box, st.Maps.Box},
+	}
+
+	for _, scope := range scopes {
+		names := make(map[string]string)
+		ints, bytes := 0, 0
+		for _, name := range sortedKeys(scope.keys) {
+			k := scope.keys[name]
+			path := fmt.Sprintf("$.state.keys.%s[%q]", scope.name, name)
+			v.checkIdentifier(path, name, names)
+			v.checkType(path+".keyType", k.KeyType, ctxStorage)
+			v.checkValueType(path+".valueType", scope.name, name, k.ValueType, ctxStorage)
+			if k.Key == "" {
+				v.addf(path+".key", KindEncoding, "key is empty")
+			} else if _, err := base64.StdEncoding.DecodeString(k.Key); err != nil {
+				v.addf(path+".key", KindEncoding, "invalid base64 key %q: %v", k.Key, err)
+			}
+			if k.ValueType == "AVMUint64" {
+				ints++
+			} else {
+				bytes++
+			}
+		}
+
+		for _, name := range sortedKeys(scope.maps) {
+			m := scope.maps[name]
+			path := fmt.Sprintf("$.state.maps.%s[%q]", scope.name, name)
+			v.checkIdentifier(path, name, names)
+			v.checkType(path+".keyType", m.KeyType, ctxStorage)
+			v.checkValueType(path+".valueType", scope.name, name, m.ValueType, ctxStorage)
+			if _, err := base64.StdEncoding.DecodeString(m.Prefix); err != nil {
+				v.addf(path+".prefix", KindEncoding, "invalid base64 prefix %q: %v", m.Prefix, err)
+			}
+		}
+
+		if st.Schema == nil || scope.name == "box" {
+			continue
+		}
+		counts := st.Schema.Global
+		if scope.name == "local" {
+			counts = st.Schema.Local
+		}
+		if ints > counts.Ints {
+			v.addf(fmt.Sprintf("$.state.schema.%s.ints", scope.name), KindSchema,
+				"schema declares %d uint slots but %d %s keys hold AVMUint64 values", counts.Ints, ints, scope.name)
+		}
+		if bytes > counts.Bytes {
+			v.addf(fmt.Sprintf("$.state.schema.%s.bytes", scope.name), KindSchema,
+				"schema declares %d byte slots but %d %s keys hold byte values", counts.Bytes, bytes, scope.name)
+		}
+	}
+}
+
+func (v *validator) checkEvents() {
+	// Events become <Name>Event types next to the structs
+	structs := make(map[string]bool)
+	for name := range v.spec.Structs {
+		structs[generate.ToPascalCase(name)] = true
+	}
+	seen := make(map[string]string)
+	for i, e := range v.spec.Events {
+		path := fmt.Sprintf("$.events[%d]", i)
+		if e.Name == "" {
+			v.addf(path+".name", KindDocument, "event name is empty")
+		} else {
+			v.checkIdentifier(path+".name", e.Name, seen)
+			if goName := generate.ToPascalCase(e.Name) + "Event"; structs[goName] {
+				v.addf(path+".name", KindIdentifier, "event %q collides with struct %s", e.Name, goName)
+			}
+		}
+		argNames := make(map[string]string)
+		for j, a := range e.Args {
+			apath := fmt.Sprintf("%s.args[%d]", path, j)
+			v.checkIdentifier(apath+".name", a.Name, argNames)
+			v.checkValueType(apath+".type", e.Name, a.Name, a.Type, ctxABI)
+			v.checkStructRef(apath, arg{Name: a.Name, Type: a.Type, Struct: a.Struct})
+		}
+	}
+}
+
+func (v *validator) checkTemplateVariables() {
+	for _, name := range sortedKeys(v.spec.Templates) {
+		path := fmt.Sprintf("$.templateVariables[%q]", name)
+		v.checkType(path+".type", v.spec.Templates[name].Type, ctxStorage)
+	}
+}
+
+// checkValueType validates the type of a field, arg, state value or event
+// arg at the "<owner>.<name>" override path. Types a type override maps are
+// accepted as they are.
+func (v *validator) checkValueType(path, owner, name, t string, ctx typeContext) {
+	if t != "" && v.overrides.Covers(owner, name, t) {
+		return
+	}
+	v.checkType(path, t, ctx)
+}
+
+// checkType validates a type string in the given context.
+func (v *validator) checkType(path, t string, ctx typeContext) {
+	if t == "" {
+		v.addf(path, KindType, "type is empty")
+		return
+	}
+	if v.overrides.Covers("", "", t) {
+		return
+	}
+	if _, isStruct := v.spec.Structs[t]; isStruct && (ctx == ctxABI || ctx == ctxStorage) {
+		return
+	}
+	switch {
+	case t == "void":
+		if ctx != ctxReturn {
+			v.addf(path, KindUsage, "void is only valid as a return type")
+		}
+		return
+	case strings.HasPrefix(t, "AVM") && generate.IsKnownTypeName(t):
+		if ctx != ctxStorage {
+			v.addf(path, KindUsage, "%s is only valid for storage and template variables", t)
+		}
+		return
+	case t == "bytes":
+		// Shorthand for byte[], valid wherever an ABI type is
+		return
+	case generate.IsKnownTypeName(t):
+		if ctx != ctxArg {
+			v.addf(path, KindUsage, "%s is only valid as a method argument type", t)
+		}
+		return
+	}
+	if _, err := generate.ParseABIType(t); err != nil {
+		v.addf(path, KindType, "%v", err)
+	}
+}
+
+// checkStructRef verifies that a struct reference resolves and matches the type.
+func (v *validator) checkStructRef(path string, a arg) {
+	if a.Struct == "" {
+		return
+	}
+	if _, ok := v.spec.Structs[a.Struct]; !ok {
+		v.addf(path+".struct", KindStructRef, "struct %q is not defined in $.structs", a.Struct)
+		return
+	}
+	tuple, err := v.structTuple(a.Struct, nil)
+	if err != nil {
+		return // reported by checkStructs
+	}
+	if tuple != a.Type {
+		v.addf(path+".struct", KindStructRef, "struct %q has ABI type %s but the declared type is %s", a.Struct, tuple, a.Type)
+	}
+}
+
+// structTuple expands a struct into its ABI tuple type string.
+func (v *validator) structTuple(name string, visiting []string) (string, error) {
+	for _, n := range visiting {
+		if n == name {
+			return "", fmt.Errorf("struct %q is recursive (%s)", name, strings.Join(append(visiting, name), " -> "))
+		}
+	}
+	return v.fieldsTuple(v.spec.Structs[name], append(visiting, name))
+}
+
+func (v *validator) fieldsTuple(fields []field, visiting []string) (string, error) {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		var typeName string
+		if err := json.Unmarshal(f.Type, &typeName); err != nil {
+			var nested []field
+			if err := json.Unmarshal(f.Type, &nested); err != nil {
+				return "", fmt.Errorf("field %q has an invalid type", f.Name)
+			}
+			t, err := v.fieldsTuple(nested, visiting)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, t)
+			continue
+		}
+		if _, isStruct := v.spec.Structs[typeName]; isStruct {
+			t, err := v.structTuple(typeName, visiting)
+			if err != nil {
+				return "", err
+			}
+			typeName = t
+		}
+		parts = append(parts, typeName)
+	}
+	return "(" + strings.Join(parts, ",") + ")", nil
+}
+
+// checkIdentifier reports names that don't sanitize to a valid Go identifier or
+// that collide with an earlier name in the same scope.
+func (v *validator) checkIdentifier(path, name string, seen map[string]string) {
+	goName := generate.ToPascalCase(name)
+	if !token.IsIdentifier(goName) || !token.IsExported(goName) {
+		v.addf(path, KindIdentifier, "name %q does not produce a valid exported Go identifier (got %q)", name, goName)
+		return
+	}
+	if reservedNames[goName] && strings.HasPrefix(path, "$.structs") {
+		v.addf(path, KindIdentifier, "struct %q collides with generated identifier %s", name, goName)
+	}
+	if prev, ok := seen[goName]; ok && prev != name {
+		v.addf(path, KindIdentifier, "name %q collides with %q after conversion to Go identifier %s", name, prev, goName)
+		return
+	}
+	seen[goName] = name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,278 @@
+package validate
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+)
+
+func TestValidateTestdataSpecs(t *testing.T) {
+	paths, _ := filepath.Glob("../../testdata/*.arc56.json")
+	akita, _ := filepath.Glob("../../testdata/akita/*.arc56.json")
+	paths = append(paths, akita...)
+	if len(paths) == 0 {
+		t.Skip("no specs found in testdata")
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range Validate(data) {
+				if d.IsWarning() {
+					t.Logf("warning: %s", d)
+					continue
+				}
+				t.Errorf("unexpected diagnostic: %s", d)
+			}
+		})
+	}
+}
+
+func TestValidateDiagnostics(t *testing.T) {
+	spec := `{
+		"name": "Broken",
+		"structs": {
+			"Pair": [{"name": "a", "type": "uint64"}, {"name": "A", "type": "uint7"}],
+			"Ok": [{"name": "x", "type": "uint64"}],
+			"HelloArgs": [{"name": "x", "type": "uint64"}]
+		},
+		"methods": [
+			{"name": "hello", "args": [{"name": "p", "type": "(uint64,bool)", "struct": "Ok"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}},
+			{"name": "hello", "args": [{"name": "p", "type": "(uint64,bool)", "struct": "Ok"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}},
+			{"name": "get", "args": [{"name": "x", "type": "uint64"}, {"name": "X", "type": "strnig"}], "returns": {"type": "Missing", "struct": "Missing"}, "actions": {"create": [], "call": ["Bogus"]}},
+			{"name": "get_", "args": [], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}},
+			{"name": "bare", "args": [], "returns": {"type": "void"}, "actions": {"create": ["OptIn"], "call": []}}
+		],
+		"state": {
+			"schema": {"global": {"ints": 0, "bytes": 1}, "local": {"ints": 0, "bytes": 0}},
+			"keys": {
+				"global": {"counter": {"keyType": "AVMString", "valueType": "AVMUint64", "key": "not base64!"}},
+				"local": {},
+				"box": {}
+			},
+			"maps": {"global": {}, "local": {}, "box": {"m": {"keyType": "uint64", "valueType": "Ok", "prefix": "%%"}}}
+		},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`
+
+	diags := Validate([]byte(spec))
+	expect := map[string]string{
+		`$.structs["Pair"][1].name`:          "collides",
+		`$.structs["Pair"][1].type`:          "invalid bit width",
+		`$.methods[0].args[0].struct`:        "has ABI type (uint64)",
+		`$.methods[1]`:                       "duplicate method signature",
+		`$.methods[2].args[1].name`:          "collides",
+		`$.methods[2].args[1].type`:          "unknown ABI type",
+		`$.methods[2].returns.type`:          "unknown ABI type",
+		`$.methods[2].returns.struct`:        "not defined",
+		`$.methods[2].actions.call[0]`:       "invalid call action",
+		`$.methods[3].name`:                  "collides",
+		`$.methods[0].name`:                  "HelloArgs",
+		`$.methods[4].name`:                  "CreateBare",
+		`$.state.keys.global["counter"].key`: "invalid base64",
+		`$.state.maps.box["m"].prefix`:       "invalid base64",
+		`$.state.schema.global.ints`:         "schema declares 0 uint slots",
+	}
+
+	for path, substr := range expect {
+		found := false
+		for _, d := range diags {
+			if d.Path == path && strings.Contains(d.Message, substr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected diagnostic at %s containing %q", path, substr)
+		}
+	}
+	if t.Failed() {
+		for _, d := range diags {
+			t.Log(d)
+		}
+	}
+}
+
+func TestValidateStructuralErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		path string
+	}{
+		{"invalid json", `{"name":`, "$"},
+		{"missing fields", `{"name": "X", "methods": []}`, "$.bareActions"},
+		{"wrong type", `{"name": "X", "methods": {}, "structs": {}, "state": {}, "bareActions": {}}`, "$.methods"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := Validate([]byte(tt.spec))
+			for _, d := range diags {
+				if d.Path == tt.path {
+					return
+				}
+			}
+			t.Errorf("expected diagnostic at %s, got %v", tt.path, diags)
+		})
+	}
+}
+
+func TestValidateBytesShorthand(t *testing.T) {
+	spec := `{
+		"name": "X",
+		"structs": {"S": [{"name": "b", "type": "bytes"}]},
+		"methods": [{"name": "echo", "args": [{"name": "b", "type": "bytes"}], "returns": {"type": "bytes"}, "actions": {"create": [], "call": ["NoOp"]}}],
+		"state": {"keys": {"global": {}, "local": {}, "box": {"b": {"keyType": "AVMString", "valueType": "bytes", "key": "Yg=="}}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`
+	for _, d := range Validate([]byte(spec)) {
+		t.Errorf("unexpected diagnostic: %s", d)
+	}
+}
+
+func TestValidateSchemaWarnings(t *testing.T) {
+	spec := `{
+		"name": "X", "structs": {}, "methods": [],
+		"state": {
+			"schema": {"global": {"ints": 0, "bytes": 0}, "local": {"ints": 0, "bytes": 0}},
+			"keys": {"global": {"n": {"keyType": "AVMString", "valueType": "AVMUint64", "key": "bg=="}}, "local": {}, "box": {}},
+			"maps": {"global": {}, "local": {}, "box": {}}
+		},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`
+	diags := Validate([]byte(spec))
+	if len(diags) != 1 || !diags[0].IsWarning() {
+		t.Fatalf("expected a single schema warning, got %v", diags)
+	}
+	if (Diagnostic{Kind: KindType}).IsWarning() {
+		t.Error("type diagnostics must not be warnings")
+	}
+}
+
+func TestValidateTypeOverrides(t *testing.T) {
+	spec := `{
+		"name": "X",
+		"structs": {"S": [{"name": "when", "type": "Timestamp"}]},
+		"methods": [{"name": "set", "args": [{"name": "id", "type": "UserID"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}}],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": []}
+	}`
+	if diags := Validate([]byte(spec)); len(diags) != 2 {
+		t.Fatalf("expected 2 unknown type diagnostics without overrides, got %v", diags)
+	}
+
+	overrides := &generate.TypeOverrides{
+		ABITypes: map[string]generate.TypeOverride{"Timestamp": {GoType: "time.Time", Import: "time"}},
+		Fields:   map[string]generate.TypeOverride{"set.id": {GoType: "[]byte"}},
+	}
+	for _, d := range ValidateWith([]byte(spec), Options{TypeOverrides: overrides}) {
+		t.Errorf("unexpected diagnostic: %s", d)
+	}
+}
+
+func TestValidateLifecycleWarnings(t *testing.T) {
+	spec := `{
+		"name": "X", "structs": {},
+		"methods": [
+			{"name": "update", "args": [], "returns": {"type": "void"}, "actions": {"create": [], "call": ["UpdateApplication"]}},
+			{"name": "upgrade", "args": [{"name": "v", "type": "uint64"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["UpdateApplication"]}},
+			{"name": "remove", "args": [], "returns": {"type": "void"}, "actions": {"create": [], "call": ["DeleteApplication"]}}
+		],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": ["DeleteApplication"]}
+	}`
+	diags := Validate([]byte(spec))
+	if len(diags) != 1 || !diags[0].IsWarning() || diags[0].Path != "$.methods[1]" {
+		t.Fatalf("expected a single lifecycle warning for upgrade, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Message, `sends the first, "update"`) {
+		t.Errorf("unexpected message: %s", diags[0].Message)
+	}
+}
+
+func TestValidateEventCollision(t *testing.T) {
+	spec := `{
+		"name": "X", "structs": {"TransferEvent": [{"name": "a", "type": "uint64"}]},
+		"methods": [],
+		"state": {"keys": {"global": {}, "local": {}, "box": {}}, "maps": {"global": {}, "local": {}, "box": {}}},
+		"bareActions": {"create": ["NoOp"], "call": []},
+		"events": [{"name": "Transfer", "args": [{"name": "amount", "type": "uint64"}, {"name": "Amount", "type": "uint64"}]}]
+	}`
+	diags := Validate([]byte(spec))
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	if diags[0].Path != "$.events[0].name" || !strings.Contains(diags[0].Message, "collides with struct TransferEvent") {
+		t.Errorf("unexpected diagnostic: %s", diags[0])
+	}
+	if diags[1].Path != "$.events[0].args[1].name" || diags[1].Kind != KindIdentifier {
+		t.Errorf("unexpected diagnostic: %s", diags[1])
+	}
+}
+
+// TestReservedNames renders a spec with every optional file and checks that
+// each exported package-level identifier not derived from the spec is in
+// reservedNames, so a new template identifier can't be forgotten there.
+func TestReservedNames(t *testing.T) {
+	spec := `{
+		"name": "X", "structs": {},
+		"methods": [{"name": "specMethod", "args": [], "returns": {"type": "void"}, "actions": {"create": ["NoOp"], "call": ["NoOp"]}}],
+		"state": {
+			"schema": {"global": {"ints": 1, "bytes": 0}, "local": {"ints": 0, "bytes": 0}},
+			"keys": {
+				"global": {"specKey": {"keyType": "AVMString", "valueType": "AVMUint64", "key": "aw=="}},
+				"local": {"specLocal": {"keyType": "AVMString", "valueType": "AVMUint64", "key": "bA=="}},
+				"box": {"specBox": {"keyType": "AVMString", "valueType": "uint64", "key": "Yg=="}}
+			},
+			"maps": {"global": {}, "local": {}, "box": {"specMap": {"keyType": "address", "valueType": "uint64", "prefix": "bQ=="}}}
+		},
+		"events": [{"name": "specEvent", "args": [{"name": "amount", "type": "uint64"}]}],
+		"bareActions": {"create": ["NoOp"], "call": []},
+		"byteCode": {"approval": "CoEBQw==", "clear": "CoEBQw=="},
+		"networks": {"wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=": {"appID": 1}}
+	}`
+	contract, err := schema.ParseAppSpec([]byte(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	extras, err := schema.ParseExtras([]byte(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate.Render(context.Background(), contract, generate.Options{
+		PackageName: "x", Mode: "full", EmitFake: true, EmitRecorder: true, EmitJSON: true,
+		EmitInterceptors: true, EmitParamsCache: true, EmitAsync: true, EmitOffline: true,
+		Events: extras.Events,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for filename, src := range files {
+		f, err := parser.ParseFile(fset, filename, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name := range f.Scope.Objects {
+			if !ast.IsExported(name) || strings.HasPrefix(name, "SpecMethod") || strings.HasPrefix(name, "SpecKey") ||
+				strings.HasPrefix(name, "SpecEvent") || strings.HasPrefix(name, "ParseSpecEvent") {
+				continue
+			}
+			if !reservedNames[name] {
+				t.Errorf("%s declares %s, which is missing from reservedNames", filename, name)
+			}
+		}
+	}
+}
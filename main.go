@@ -21,6 +21,8 @@ for deploying new contract instances.`,
 
 func init() {
 	rootCmd.AddCommand(cmd.GetGenerateCmd())
+	rootCmd.AddCommand(cmd.GetInspectCmd())
+	rootCmd.AddCommand(cmd.GetValidateCmd())
 }
 
 func main() {
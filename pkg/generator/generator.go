@@ -0,0 +1,242 @@
+// Package generator exposes the client generator as a Go library so that
+// build tooling and tests can generate typed clients without the CLI.
+//
+//	contract, err := generator.Load(spec)
+//	if err != nil {
+//		return err
+//	}
+//	files, err := generator.Generate(ctx, contract, generator.Options{PackageName: "myapp"})
+//
+// Generate returns the formatted files in memory, keyed by file name; nothing
+// is written to disk.
+package generator
+
+import (
+	"context"
+	"io/fs"
+
+	"github.com/kylebeee/algokit-client-generator-go/internal/generate"
+	"github.com/kylebeee/algokit-client-generator-go/internal/schema"
+	"github.com/kylebeee/algokit-client-generator-go/internal/validate"
+	algokit "github.com/kylebeee/algokit-utils-go"
+)
+
+// Contract is a parsed ARC-56 application specification.
+type Contract = algokit.Arc56Contract
+
+// TypeMapping describes the Go type an ABI type maps to.
+type TypeMapping = generate.TypeMapping
+
+// NameKind identifies what a generated Go identifier is for.
+type NameKind = generate.NameKind
+
+const (
+	NameContract    = generate.NameContract
+	NameStruct      = generate.NameStruct
+	NameStructField = generate.NameStructField
+	NameMethod      = generate.NameMethod
+	NameArg         = generate.NameArg
+	NameStateKey    = generate.NameStateKey
+	NameStateMap    = generate.NameStateMap
+	NameEvent       = generate.NameEvent
+)
+
+// NameFunc returns the Go identifier for a name from the spec. def is the
+// identifier the generator would otherwise use.
+type NameFunc func(kind NameKind, original, def string) string
+
+// TypeMapFunc returns the Go mapping for an ABI type. def is the mapping the
+// generator would otherwise use; return it unchanged to keep the default.
+// It is called for every ABI type, including array elements and struct references.
+type TypeMapFunc func(abiType string, def TypeMapping) TypeMapping
+
+// TemplateData is the data passed to every template. See TemplateDataVersion.
+type TemplateData = generate.TemplateData
+
+// TemplateDataVersion is the version of the TemplateData contract. It changes
+// only when a field is removed or changes meaning.
+const TemplateDataVersion = generate.TemplateDataVersion
+
+// TypeOverrides declares Go types replacing the default mapping for ABI
+// types, structs and "<struct>.<field>" paths. See LoadTypeOverrides.
+type TypeOverrides = generate.TypeOverrides
+
+// TypeOverride is a single Go type override with optional converter functions.
+type TypeOverride = generate.TypeOverride
+
+// LoadTypeOverrides reads type overrides from a JSON config file.
+func LoadTypeOverrides(path string) (*TypeOverrides, error) {
+	return generate.LoadTypeOverrides(path)
+}
+
+// ChildApps maps method names to the child contract whose app ID they
+// return. See LoadChildApps.
+type ChildApps = generate.ChildApps
+
+// ChildApp names the generated package of a child contract.
+type ChildApp = generate.ChildApp
+
+// LoadChildApps reads child app declarations from a JSON config file.
+func LoadChildApps(path string) (ChildApps, error) {
+	return generate.LoadChildApps(path)
+}
+
+// ParseNetwork parses a "<network>=<appID>" entry into a base64 genesis hash
+// and app ID. The network is "mainnet", "testnet" or a base64 genesis hash.
+func ParseNetwork(entry string) (string, uint64, error) {
+	return generate.ParseNetwork(entry)
+}
+
+// ModuleImportPath returns the Go import path of dir, using the module path
+// in the nearest go.mod at or above it.
+func ModuleImportPath(dir string) (string, error) {
+	return generate.ModuleImportPath(dir)
+}
+
+// Diagnostic is a single validation problem at a JSON path within the spec.
+type Diagnostic = validate.Diagnostic
+
+// ValidationError lists every problem found in a spec. Callers can wrap
+// the result of Validate in it to report the problems as an error.
+type ValidationError = validate.Error
+
+// FormatError is returned when generated code has syntax errors, typically
+// because a hook produced an invalid Go type or identifier.
+type FormatError = generate.FormatError
+
+// Options configures Generate.
+type Options struct {
+	PackageName   string // default: derived from the contract name
+	Mode          string // "full" (default) or "minimal"
+	PreserveNames bool
+	AllowUntyped  bool // emit ABI types without a Go mapping as any instead of failing
+	EmitTests     bool // also emit roundtrip_test.go with FuzzRoundTrip<Struct> tests
+
+	// EmitCLI also emits "cmd/<package>/main.go", a cobra program calling the
+	// deployed app. CLIImportPath is the import path of the generated package
+	// and is required with EmitCLI; see ModuleImportPath.
+	EmitCLI       bool
+	CLIImportPath string
+
+	// EmitFake also emits "fake.go" with FakeClient, an in-memory ClientAPI.
+	EmitFake bool
+
+	// EmitRecorder also emits "recorder.go" with Client.Recorder and
+	// Client.Replay. EmitCLI implies it.
+	EmitRecorder bool
+
+	// EmitJSON also emits "json.go" with MarshalJSON and UnmarshalJSON for
+	// the generated types. EmitRecorder implies it.
+	EmitJSON bool
+
+	// EmitInterceptors also emits "interceptor.go" with Client.Interceptors,
+	// Composer.Use and the logging, retry and tracing interceptors.
+	EmitInterceptors bool
+
+	// EmitParamsCache also emits "paramscache.go" with SuggestedParamsCache,
+	// which caches suggested params for ClientOptions.ParamsCache.
+	EmitParamsCache bool
+
+	// EmitAsync also emits "async.go" with SendAsync and the
+	// Send{Method}Async methods. EmitOffline implies it.
+	EmitAsync bool
+
+	// EmitOffline also emits "offline.go" with Build{Method}, Composer.Build
+	// and SubmitSigned for external signing.
+	EmitOffline bool
+
+	// Naming overrides generated identifiers. Nil keeps the default PascalCase names.
+	Naming NameFunc
+
+	// TypeMapping overrides the Go types generated for ABI types. Nil keeps the
+	// default mapping. Imports needed by a custom type go in TypeMapping.Imports.
+	TypeMapping TypeMapFunc
+
+	// TypeOverrides replaces the Go types generated for ABI types, structs and
+	// fields. It is applied before the TypeMapping hook.
+	TypeOverrides *TypeOverrides
+
+	// ChildApps gives the results of these methods a Child() accessor
+	// returning a typed client for the app ID they return.
+	ChildApps ChildApps
+
+	// Networks adds or replaces app IDs in the spec's networks section, keyed
+	// by base64 genesis hash. See ParseNetwork.
+	Networks map[string]uint64
+
+	// Templates holds *.go.tmpl files that replace the built-in templates of
+	// the same name. Other templates are rendered to extra files named after
+	// the template without its .tmpl suffix.
+	Templates fs.FS
+
+	// Events are the ARC-28 events to generate types for. Contract does not
+	// hold them; read them from the spec with LoadEvents.
+	Events []Event
+}
+
+// Event is an ARC-28 event declared in an ARC-56 specification.
+type Event = schema.Event
+
+// LoadEvents returns the ARC-28 events declared in an ARC-56 specification.
+// ARC-32 specs have none.
+func LoadEvents(spec []byte) ([]Event, error) {
+	extras, err := schema.ParseExtras(spec)
+	if err != nil {
+		return nil, err
+	}
+	return extras.Events, nil
+}
+
+// Load parses an ARC-56 or ARC-32 application specification from JSON.
+// ARC-32 specs are converted to ARC-56.
+func Load(spec []byte) (*Contract, error) {
+	return schema.ParseAppSpec(spec)
+}
+
+// Validate strictly checks an ARC-56 or ARC-32 specification and returns
+// every problem found. It returns nil if the spec is valid. Diagnostics
+// whose IsWarning method returns true need not block generation.
+func Validate(spec []byte) []Diagnostic {
+	return validate.Validate(spec)
+}
+
+// ValidateOptions configures ValidateWith.
+type ValidateOptions = validate.Options
+
+// ValidateWith is Validate with options. Set TypeOverrides to the overrides
+// passed to Generate so that the types they map are not reported as unknown.
+func ValidateWith(spec []byte, opts ValidateOptions) []Diagnostic {
+	return validate.ValidateWith(spec, opts)
+}
+
+// Generate renders typed Go client code for the contract and returns the
+// files keyed by file name (e.g. "client.go").
+func Generate(ctx context.Context, contract *Contract, opts Options) (map[string][]byte, error) {
+	mode := opts.Mode
+	if mode == "" {
+		mode = "full"
+	}
+
+	return generate.Render(ctx, contract, generate.Options{
+		PackageName:      opts.PackageName,
+		Mode:             mode,
+		PreserveNames:    opts.PreserveNames,
+		AllowUntyped:     opts.AllowUntyped,
+		EmitTests:        opts.EmitTests,
+		Hooks:            generate.Hooks{Name: opts.Naming, Type: opts.TypeMapping},
+		Templates:        opts.Templates,
+		TypeOverrides:    opts.TypeOverrides,
+		ChildApps:        opts.ChildApps,
+		Networks:         opts.Networks,
+		EmitCLI:          opts.EmitCLI,
+		CLIImportPath:    opts.CLIImportPath,
+		EmitFake:         opts.EmitFake,
+		EmitRecorder:     opts.EmitRecorder,
+		EmitJSON:         opts.EmitJSON,
+		EmitInterceptors: opts.EmitInterceptors,
+		EmitParamsCache:  opts.EmitParamsCache,
+		EmitAsync:        opts.EmitAsync,
+		EmitOffline:      opts.EmitOffline,
+		Events:           opts.Events,
+	})
+}
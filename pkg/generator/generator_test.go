@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+func loadTestSpec(t *testing.T, path string) *Contract {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := Load(data)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	return contract
+}
+
+func TestGenerateInMemory(t *testing.T) {
+	contract := loadTestSpec(t, "../../testdata/ApplicationEquality.arc56.json")
+
+	files, err := Generate(context.Background(), contract, Options{PackageName: "appeq"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	for _, name := range []string{"appspec.go", "types.go", "client.go", "composer.go", "factory.go"} {
+		src, ok := files[name]
+		if !ok {
+			t.Errorf("missing %s", name)
+			continue
+		}
+		if !strings.HasPrefix(string(src), "// Code generated") || !strings.Contains(string(src), "package appeq") {
+			t.Errorf("%s has unexpected content", name)
+		}
+	}
+
+	files, err = Generate(context.Background(), contract, Options{PackageName: "appeq", Mode: "minimal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["factory.go"]; ok {
+		t.Error("minimal mode should not generate factory.go")
+	}
+}
+
+func TestGenerateHooks(t *testing.T) {
+	contract := loadTestSpec(t, "../../testdata/ABITypes.arc56.json")
+
+	opts := Options{
+		PackageName: "hooked",
+		Naming: func(kind NameKind, original, def string) string {
+			switch kind {
+			case NameStruct:
+				return def + "Data"
+			case NameMethod:
+				return "Do" + def
+			}
+			return def
+		},
+		TypeMapping: func(abiType string, def TypeMapping) TypeMapping {
+			if abiType == "uint64" {
+				return TypeMapping{GoType: "Amount"}
+			}
+			return def
+		},
+	}
+	files, err := Generate(context.Background(), contract, opts)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	types := string(files["types.go"])
+	for _, want := range []string{"type PriceData struct", "Price  PriceData", "Round  Amount", "Values [3][2]Amount", "type DoSetPriceArgs struct"} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.go does not contain %q", want)
+		}
+	}
+	if !strings.Contains(string(files["client.go"]), "func (c *Client) SendDoSetPrice(") {
+		t.Error("client.go does not use the renamed method")
+	}
+}
+
+func TestGenerateCancelled(t *testing.T) {
+	contract := loadTestSpec(t, "../../testdata/ApplicationEquality.arc56.json")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Generate(ctx, contract, Options{}); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/ABITypes.arc56.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := Validate(data); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+	if diags := Validate([]byte(`{"methods": "nope"}`)); len(diags) == 0 {
+		t.Error("expected diagnostics for malformed spec")
+	}
+}
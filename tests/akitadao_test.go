@@ -9,10 +9,8 @@ import (
 	"github.com/kylebeee/algokit-client-generator-go/tests/testutil"
 )
 
-// AkitaDAO has a complex CreateArgs with nested structs and [][]interface{} fields
-// (RevenueSplits). The go-algorand-sdk ABI encoder cannot infer tuple types from
-// empty [][]interface{} slices, so deployment with zero-value args fails.
-// These tests verify factory creation and type compilation instead.
+// AkitaDAO has a complex CreateArgs with nested structs and unnamed tuple fields
+// (RevenueSplits). These tests verify factory creation and type compilation.
 
 func TestAkitaDaoFactoryCreation(t *testing.T) {
 	fixture := testutil.NewTestFixture(t)
@@ -38,7 +36,7 @@ func TestAkitaDaoTypedCreateArgs(t *testing.T) {
 		Apps:             akitadao.AkitaDaoApps{},
 		Fees:             akitadao.AkitaDaoFees{},
 		ProposalSettings: akitadao.Object752a5b25{},
-		RevenueSplits:    [][]interface{}{},
+		RevenueSplits:    []akitadao.Tuple3[akitadao.Tuple2[uint64, string], uint8, uint64]{},
 	}
 	t.Log("AkitaDAO typed CreateArgs compile correctly")
 }
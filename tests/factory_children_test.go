@@ -2,6 +2,7 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	algokit "github.com/kylebeee/algokit-utils-go"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/kylebeee/algokit-client-generator-go/generated/auction"
 	"github.com/kylebeee/algokit-client-generator-go/generated/listing"
 	"github.com/kylebeee/algokit-client-generator-go/generated/poll"
+	"github.com/kylebeee/algokit-client-generator-go/generated/pollfactory"
 	"github.com/kylebeee/algokit-client-generator-go/generated/raffle"
 	"github.com/kylebeee/algokit-client-generator-go/generated/stakingpool"
 	"github.com/kylebeee/algokit-client-generator-go/tests/testutil"
@@ -19,6 +21,40 @@ import (
 // Direct deployment will fail. These tests verify that:
 // 1. The typed client code compiles correctly
 // 2. Direct deployment correctly fails (proving they need CallerApplicationID)
+// 3. A child created by its factory is reachable through the generated Child()
+
+func TestPollFactoryNewChild(t *testing.T) {
+	env := setupPollFactoryTest(t)
+
+	// new requires 813,500 microAlgos plus the min balance it sends the poll,
+	// and creates and funds the poll with two inner transactions
+	payment := env.fixture.MakePaymentTxn(env.owner, env.client.AppAddress(), 813_500+100_000)
+	result, err := env.client.SendNew(env.ctx, algokit.CallParams[pollfactory.NewArgs]{
+		Args: pollfactory.NewArgs{
+			Payment:     payment,
+			EndTime:     uint64(time.Now().Add(time.Hour).Unix()),
+			MaxSelected: 1,
+			Question:    "Ship it?",
+			Options:     []string{"yes", "no"},
+		},
+		ExtraFee: 2000,
+	})
+	if err != nil {
+		t.Fatalf("failed to create poll: %v", err)
+	}
+
+	child, err := result.Child()
+	if err != nil {
+		t.Fatalf("failed to create poll client: %v", err)
+	}
+	if child.AppID() != result.Return {
+		t.Fatalf("child app ID = %d, want %d", child.AppID(), result.Return)
+	}
+	if err := child.SendOpUp(env.ctx); err != nil {
+		t.Fatalf("failed to call the child poll: %v", err)
+	}
+	t.Logf("PollFactory created poll %d", child.AppID())
+}
 
 func TestAuctionDirectDeployFails(t *testing.T) {
 	fixture := testutil.NewTestFixture(t)
@@ -189,8 +189,8 @@ func TestPrizeBoxOptInAndWithdrawASA(t *testing.T) {
 	// Withdraw partial amount (not closing out)
 	err = env.client.SendWithdraw(env.ctx, algokit.CallParams[prizebox.WithdrawArgs]{
 		Args: prizebox.WithdrawArgs{
-			Assets: [][]interface{}{
-				{assetID, uint64(200)}, // (asset, amount) tuple
+			Assets: []prizebox.Tuple2[uint64, uint64]{
+				{Item0: assetID, Item1: 200}, // (asset, amount) tuple
 			},
 		},
 		Sender:          env.owner.Address,
@@ -232,8 +232,8 @@ func TestPrizeBoxOptInAndCloseOutASA(t *testing.T) {
 	// Withdraw full amount (close out - amount == balance)
 	err = env.client.SendWithdraw(env.ctx, algokit.CallParams[prizebox.WithdrawArgs]{
 		Args: prizebox.WithdrawArgs{
-			Assets: [][]interface{}{
-				{assetID, uint64(500)}, // close out: amount == balance
+			Assets: []prizebox.Tuple2[uint64, uint64]{
+				{Item0: assetID, Item1: 500}, // close out: amount == balance
 			},
 		},
 		Sender:          env.owner.Address,
@@ -280,9 +280,9 @@ func TestRewardsCreateUserAllocations(t *testing.T) {
 		Args: rewards.CreateUserAllocationsArgs{
 			Payment: allocPayment,
 			ID:      createResult.Return,
-			Allocations: [][]interface{}{
-				{env.user1.Address, uint64(1_000_000)},
-				{env.user2.Address, uint64(500_000)},
+			Allocations: []rewards.Tuple2[types.Address, uint64]{
+				{Item0: env.user1.Address, Item1: 1_000_000},
+				{Item0: env.user2.Address, Item1: 500_000},
 			},
 		},
 		Sender: env.deployer.Address,
@@ -3,8 +3,14 @@ package testutil
 import (
 	"context"
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/algorand/go-algorand-sdk/v2/client/kmd"
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
@@ -12,6 +18,8 @@ import (
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	algokit "github.com/kylebeee/algokit-utils-go"
+
+	"github.com/kylebeee/algokit-client-generator-go/tests/testutil/memnet"
 )
 
 // LocalNetAlgodURL is the default algod URL for localnet.
@@ -26,6 +34,23 @@ const LocalNetKMDURL = "http://localhost:4002"
 // LocalNetKMDToken is the default KMD token for localnet.
 const LocalNetKMDToken = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 
+// SkipLocalNetEnv is the environment variable that makes tests skip, rather
+// than fail, when LocalNet is not reachable.
+const SkipLocalNetEnv = "ALGOKIT_SKIP_LOCALNET"
+
+// MemNetEnv is the environment variable that makes the tests run against
+// memnet, an in-process stand-in for LocalNet, instead of a LocalNet started
+// with Docker.
+const MemNetEnv = "ALGOKIT_MEMNET"
+
+// memNetSpecs are the app specs whose TEAL memnet compiles, relative to tests/.
+var memNetSpecs = []string{"../testdata/*.arc56.json", "../testdata/akita/*.json"}
+
+var (
+	memNetOnce sync.Once
+	memNetErr  error
+)
+
 // TestFixture provides test utilities for localnet integration tests.
 type TestFixture struct {
 	T        *testing.T
@@ -35,14 +60,31 @@ type TestFixture struct {
 }
 
 // NewTestFixture creates a new test fixture connected to localnet.
+// The test fails if algod or KMD is not reachable, unless ALGOKIT_SKIP_LOCALNET
+// is set. With ALGOKIT_MEMNET set, memnet answers requests to LocalNet's
+// addresses in-process for the rest of the test binary's run.
 func NewTestFixture(t *testing.T) *TestFixture {
 	t.Helper()
 
+	if os.Getenv(MemNetEnv) != "" {
+		memNetOnce.Do(func() { memNetErr = startMemNet() })
+		if memNetErr != nil {
+			t.Fatalf("failed to start memnet: %v", memNetErr)
+		}
+	}
+
 	algorand, err := algokit.LocalNet()
 	if err != nil {
 		t.Fatalf("failed to create localnet client: %v", err)
 	}
 
+	if err := checkLocalNet(algorand.Algod()); err != nil {
+		if os.Getenv(SkipLocalNetEnv) != "" {
+			t.Skipf("localnet not reachable: %v", err)
+		}
+		t.Fatalf("localnet not reachable (%v); run `algokit localnet start`, set %s=1 to use memnet or %s=1 to skip", err, MemNetEnv, SkipLocalNetEnv)
+	}
+
 	return &TestFixture{
 		T:        t,
 		Algod:    algorand.Algod(),
@@ -51,6 +93,76 @@ func NewTestFixture(t *testing.T) *TestFixture {
 	}
 }
 
+// checkLocalNet verifies that algod and KMD respond.
+func checkLocalNet(algodClient *algod.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := algodClient.HealthCheck().Do(ctx); err != nil {
+		return fmt.Errorf("algod at %s: %w", LocalNetAlgodURL, err)
+	}
+
+	// The KMD client has no context, so check its version endpoint directly.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, LocalNetKMDURL+"/versions", nil)
+	if err != nil {
+		return fmt.Errorf("kmd at %s: %w", LocalNetKMDURL, err)
+	}
+	req.Header.Set("X-KMD-API-Token", LocalNetKMDToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("kmd at %s: %w", LocalNetKMDURL, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kmd at %s: %s", LocalNetKMDURL, resp.Status)
+	}
+	return nil
+}
+
+// startMemNet installs memnet as http.DefaultTransport for LocalNet's algod
+// and KMD addresses, with the TEAL of the test app specs registered so that
+// it can be compiled. No ports are bound, so it does not clash with a running
+// LocalNet or with other test binaries.
+func startMemNet() error {
+	srv := memnet.New()
+	for _, pattern := range memNetSpecs {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if err := registerSpecPrograms(srv, path); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	http.DefaultTransport = srv.Transport("localhost:4001", "localhost:4002", http.DefaultTransport)
+	return nil
+}
+
+// registerSpecPrograms registers the byteCode of an ARC-56 app spec as the
+// compiled form of its source.
+func registerSpecPrograms(srv *memnet.Server, path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var spec struct {
+		Source   struct{ Approval, Clear []byte } `json:"source"`
+		ByteCode struct{ Approval, Clear []byte } `json:"byteCode"`
+	}
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return err
+	}
+	if len(spec.Source.Approval) > 0 && len(spec.ByteCode.Approval) > 0 {
+		srv.RegisterProgram(string(spec.Source.Approval), spec.ByteCode.Approval)
+	}
+	if len(spec.Source.Clear) > 0 && len(spec.ByteCode.Clear) > 0 {
+		srv.RegisterProgram(string(spec.Source.Clear), spec.ByteCode.Clear)
+	}
+	return nil
+}
+
 // TestAccount wraps a crypto.Account with a signer for convenience.
 type TestAccount struct {
 	Account crypto.Account
@@ -0,0 +1,582 @@
+package memnet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// maxWaitForBlock is how long /v2/status/wait-for-block-after waits for a
+// new block, as in algod.
+const maxWaitForBlock = time.Minute
+
+// httpError is an error with the status algod would answer it with.
+type httpError struct {
+	status int
+	msg    string
+}
+
+func (e *httpError) Error() string { return e.msg }
+
+func badRequest(format string, args ...interface{}) error {
+	return &httpError{http.StatusBadRequest, fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &httpError{http.StatusNotFound, fmt.Sprintf(format, args...)}
+}
+
+// algodHandler returns the algod REST API.
+func (s *Server) algodHandler() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, h func(*http.Request) (interface{}, error)) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			resp, err := h(r)
+			if err != nil {
+				status := http.StatusInternalServerError
+				var he *httpError
+				if errors.As(err, &he) {
+					status = he.status
+				}
+				writeJSON(w, status, map[string]string{"message": err.Error()})
+				return
+			}
+			if r.URL.Query().Get("format") == "msgpack" {
+				w.Header().Set("Content-Type", "application/msgpack")
+				w.Write(msgpack.Encode(resp))
+				return
+			}
+			writeJSON(w, http.StatusOK, resp)
+		})
+	}
+
+	handle("GET /health", func(*http.Request) (interface{}, error) { return nil, nil })
+	handle("GET /ready", func(*http.Request) (interface{}, error) { return nil, nil })
+	handle("GET /versions", func(*http.Request) (interface{}, error) {
+		return models.Version{
+			Build:       models.BuildVersion{Branch: "memnet", Channel: "dev", Major: 4},
+			GenesisHash: genesisHash[:],
+			GenesisID:   genesisID,
+			Versions:    []string{"v2"},
+		}, nil
+	})
+	handle("GET /v2/status", func(*http.Request) (interface{}, error) { return s.status(), nil })
+	handle("GET /v2/status/wait-for-block-after/{round}", func(r *http.Request) (interface{}, error) {
+		round, err := pathUint(r, "round")
+		if err != nil {
+			return nil, err
+		}
+		s.waitForBlockAfter(round, maxWaitForBlock)
+		return s.status(), nil
+	})
+	handle("GET /v2/transactions/params", func(*http.Request) (interface{}, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return models.TransactionParametersResponse{
+			ConsensusVersion: consensusVersion,
+			Fee:              0,
+			GenesisHash:      genesisHash[:],
+			GenesisId:        genesisID,
+			LastRound:        s.l.round,
+			MinFee:           minTxnFee,
+		}, nil
+	})
+	handle("POST /v2/transactions", s.handleSend)
+	handle("GET /v2/transactions/pending", func(*http.Request) (interface{}, error) {
+		return models.PendingTransactionsResponse{TopTransactions: []types.SignedTxn{}}, nil
+	})
+	handle("GET /v2/transactions/pending/{txid}", s.handlePendingInfo)
+	handle("POST /v2/transactions/simulate", s.handleSimulate)
+	handle("POST /v2/teal/compile", s.handleCompile)
+	handle("GET /v2/accounts/{address}", s.handleAccount)
+	handle("GET /v2/accounts/{address}/applications/{id}", s.handleAccountApp)
+	handle("GET /v2/accounts/{address}/assets/{id}", s.handleAccountAsset)
+	handle("GET /v2/applications/{id}", s.handleApp)
+	handle("GET /v2/applications/{id}/box", s.handleBox)
+	handle("GET /v2/applications/{id}/boxes", s.handleBoxes)
+	handle("GET /v2/assets/{id}", s.handleAsset)
+	handle("GET /v2/devmode/blocks/offset", func(*http.Request) (interface{}, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return models.GetBlockTimeStampOffsetResponse{Offset: uint64(s.l.offset)}, nil
+	})
+	handle("POST /v2/devmode/blocks/offset/{offset}", func(r *http.Request) (interface{}, error) {
+		offset, err := pathUint(r, "offset")
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.l.offset = int64(offset)
+		return nil, nil
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(json.Encode(v))
+}
+
+func pathUint(r *http.Request, name string) (uint64, error) {
+	u, err := strconv.ParseUint(r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, badRequest("invalid %s %q", name, r.PathValue(name))
+	}
+	return u, nil
+}
+
+func pathAddress(r *http.Request) (types.Address, error) {
+	addr, err := types.DecodeAddress(r.PathValue("address"))
+	if err != nil {
+		return addr, badRequest("failed to parse the address: %v", err)
+	}
+	return addr, nil
+}
+
+func (s *Server) status() models.NodeStatusResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return models.NodeStatusResponse{
+		LastRound:            s.l.round,
+		LastVersion:          consensusVersion,
+		NextVersion:          consensusVersion,
+		NextVersionRound:     s.l.round + 1,
+		NextVersionSupported: true,
+	}
+}
+
+// handleSend accepts a group of concatenated msgpack signed transactions.
+func (s *Server) handleSend(r *http.Request) (interface{}, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var stxns []types.SignedTxn
+	dec := msgpack.NewDecoder(bytes.NewReader(body))
+	for {
+		var stxn types.SignedTxn
+		err := dec.Decode(&stxn)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, badRequest("received a malformed tx group: %v", err)
+		}
+		stxns = append(stxns, stxn)
+	}
+	if len(stxns) == 0 {
+		return nil, badRequest("empty txgroup")
+	}
+	if _, _, err := s.submit(stxns, groupOptions{}); err != nil {
+		return nil, badRequest("TransactionPool.Remember: %v", err)
+	}
+	return models.PostTransactionsResponse{Txid: crypto.GetTxID(stxns[0].Txn)}, nil
+}
+
+func (s *Server) handlePendingInfo(r *http.Request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	txID := r.PathValue("txid")
+	ct, ok := s.txns[txID]
+	if !ok {
+		return nil, notFound("txn does not exist")
+	}
+	resp := pendingResponse(ct.result)
+	resp.Transaction = ct.stxn
+	resp.ConfirmedRound = ct.round
+	return resp, nil
+}
+
+// pendingResponse describes a transaction result as algod does.
+func pendingResponse(r *txnResult) models.PendingTransactionResponse {
+	resp := models.PendingTransactionResponse{
+		Transaction:      types.SignedTxn{Txn: r.txn},
+		ApplicationIndex: r.createdApp,
+		AssetIndex:       r.createdAsset,
+		Logs:             r.logs,
+	}
+	for _, inner := range r.inner {
+		resp.InnerTxns = append(resp.InnerTxns, pendingResponse(inner))
+	}
+	return resp
+}
+
+func (s *Server) handleSimulate(r *http.Request) (interface{}, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var req models.SimulateRequest
+	if err := msgpack.NewLenientDecoder(bytes.NewReader(body)).Decode(&req); err != nil {
+		return nil, badRequest("failed to decode the simulate request: %v", err)
+	}
+	resp := models.SimulateResponse{
+		Version: 2,
+		EvalOverrides: models.SimulationEvalOverrides{
+			AllowEmptySignatures:  req.AllowEmptySignatures,
+			AllowUnnamedResources: req.AllowUnnamedResources,
+			ExtraOpcodeBudget:     req.ExtraOpcodeBudget,
+		},
+	}
+	for _, group := range req.TxnGroups {
+		results, consumed, err := s.submit(group.Txns, groupOptions{
+			allowEmptySignatures: req.AllowEmptySignatures,
+			extraBudget:          int(req.ExtraOpcodeBudget),
+			dryRun:               true,
+		})
+		gr := models.SimulateTransactionGroupResult{AppBudgetConsumed: uint64(consumed)}
+		var ge *groupError
+		switch {
+		case errors.As(err, &ge):
+			gr.FailureMessage = err.Error()
+			gr.FailedAt = []uint64{uint64(ge.index)}
+		case err != nil:
+			return nil, badRequest("%v", err)
+		}
+		for i, stxn := range group.Txns {
+			tr := models.SimulateTransactionResult{}
+			if i < len(results) && results[i] != nil {
+				tr.TxnResult = pendingResponse(results[i])
+			}
+			tr.TxnResult.Transaction = stxn
+			gr.TxnResults = append(gr.TxnResults, tr)
+		}
+		resp.TxnGroups = append(resp.TxnGroups, gr)
+	}
+	s.mu.Lock()
+	resp.LastRound = s.l.round
+	s.mu.Unlock()
+	return resp, nil
+}
+
+// handleCompile answers with the program registered for the source, since
+// memnet has no TEAL assembler.
+func (s *Server) handleCompile(r *http.Request) (interface{}, error) {
+	src, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	program, ok := s.programs[string(src)]
+	s.mu.Unlock()
+	if !ok {
+		return nil, badRequest("memnet cannot compile TEAL; register the program's byteCode with RegisterProgram")
+	}
+	return models.CompileResponse{
+		Hash:   crypto.AddressFromProgram(program).String(),
+		Result: base64.StdEncoding.EncodeToString(program),
+	}, nil
+}
+
+func (s *Server) handleAccount(r *http.Request) (interface{}, error) {
+	addr, err := pathAddress(r)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := models.Account{
+		Address: addr.String(),
+		Round:   s.l.round,
+		Status:  "Offline",
+	}
+	a, ok := s.l.accounts[addr]
+	if !ok {
+		return resp, nil
+	}
+	schema, extraPages := s.l.totalSchema(addr)
+	resp.Amount = a.balance
+	resp.AmountWithoutPendingRewards = a.balance
+	resp.MinBalance = s.l.minBalance(addr)
+	resp.AppsTotalSchema = models.ApplicationStateSchema{NumUint: schema.NumUint, NumByteSlice: schema.NumByteSlice}
+	resp.AppsTotalExtraPages = extraPages
+	resp.TotalAppsOptedIn = uint64(len(a.locals))
+	resp.TotalAssetsOptedIn = uint64(len(a.holdings))
+	resp.TotalCreatedApps = uint64(len(a.createdApps))
+	resp.TotalCreatedAssets = uint64(len(a.createdAssets))
+	resp.TotalBoxes = a.totalBoxes
+	resp.TotalBoxBytes = a.totalBoxBytes
+	if !a.authAddr.IsZero() {
+		resp.AuthAddr = a.authAddr.String()
+	}
+	if r.URL.Query().Get("exclude") == "all" {
+		return resp, nil
+	}
+	for _, id := range sortedIDs(a.holdings) {
+		resp.Assets = append(resp.Assets, assetHolding(id, a.holdings[id]))
+	}
+	for _, id := range sortedIDs(a.locals) {
+		resp.AppsLocalState = append(resp.AppsLocalState, s.localState(id, a.locals[id]))
+	}
+	for _, id := range sortedIDs(a.createdApps) {
+		resp.CreatedApps = append(resp.CreatedApps, appModel(s.l.apps[id]))
+	}
+	for _, id := range sortedIDs(a.createdAssets) {
+		resp.CreatedAssets = append(resp.CreatedAssets, assetModel(s.l.assets[id]))
+	}
+	return resp, nil
+}
+
+func (s *Server) handleAccountApp(r *http.Request) (interface{}, error) {
+	addr, err := pathAddress(r)
+	if err != nil {
+		return nil, err
+	}
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := models.AccountApplicationResponse{Round: s.l.round}
+	a, ok := s.l.accounts[addr]
+	if !ok {
+		return nil, notFound("account application info not found")
+	}
+	kv, optedIn := a.locals[id]
+	created := a.createdApps[id]
+	if !optedIn && !created {
+		return nil, notFound("account application info not found")
+	}
+	if optedIn {
+		resp.AppLocalState = s.localState(id, kv)
+	}
+	if created {
+		resp.CreatedApp = appModel(s.l.apps[id]).Params
+	}
+	return resp, nil
+}
+
+func (s *Server) handleAccountAsset(r *http.Request) (interface{}, error) {
+	addr, err := pathAddress(r)
+	if err != nil {
+		return nil, err
+	}
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := models.AccountAssetResponse{Round: s.l.round}
+	a, ok := s.l.accounts[addr]
+	if !ok {
+		return nil, notFound("account asset info not found")
+	}
+	h, holds := a.holdings[id]
+	created := a.createdAssets[id]
+	if !holds && !created {
+		return nil, notFound("account asset info not found")
+	}
+	if holds {
+		resp.AssetHolding = assetHolding(id, h)
+	}
+	if created {
+		resp.CreatedAsset = assetModel(s.l.assets[id]).Params
+	}
+	return resp, nil
+}
+
+func (s *Server) handleApp(r *http.Request) (interface{}, error) {
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ap, ok := s.l.apps[id]
+	if !ok {
+		return nil, notFound("application does not exist")
+	}
+	return appModel(ap), nil
+}
+
+func (s *Server) handleAsset(r *http.Request) (interface{}, error) {
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	as, ok := s.l.assets[id]
+	if !ok {
+		return nil, notFound("asset does not exist")
+	}
+	return assetModel(as), nil
+}
+
+func (s *Server) handleBox(r *http.Request) (interface{}, error) {
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := parseBoxName(r.URL.Query().Get("name"))
+	if err != nil {
+		return nil, badRequest("%v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ap, ok := s.l.apps[id]
+	if !ok {
+		return nil, notFound("application does not exist")
+	}
+	v, ok := ap.boxes[string(name)]
+	if !ok {
+		return nil, notFound("box not found")
+	}
+	return models.Box{Name: name, Round: s.l.round, Value: v}, nil
+}
+
+func (s *Server) handleBoxes(r *http.Request) (interface{}, error) {
+	id, err := pathUint(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ap, ok := s.l.apps[id]
+	if !ok {
+		return nil, notFound("application does not exist")
+	}
+	resp := models.BoxesResponse{ApplicationId: id, Boxes: []models.BoxDescriptor{}}
+	names := make([]string, 0, len(ap.boxes))
+	for name := range ap.boxes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Boxes = append(resp.Boxes, models.BoxDescriptor{Name: []byte(name)})
+	}
+	return resp, nil
+}
+
+// parseBoxName decodes a box name in algod's encoding:name form.
+func parseBoxName(s string) ([]byte, error) {
+	enc, v, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("box name %q has no encoding prefix", s)
+	}
+	switch enc {
+	case "str":
+		return []byte(v), nil
+	case "b64":
+		return base64.StdEncoding.DecodeString(v)
+	case "int":
+		u, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, u), nil
+	case "addr":
+		addr, err := types.DecodeAddress(v)
+		if err != nil {
+			return nil, err
+		}
+		return addr[:], nil
+	}
+	return nil, fmt.Errorf("unsupported box name encoding %q", enc)
+}
+
+func sortedIDs[V any](m map[uint64]V) []uint64 {
+	ids := make([]uint64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func tealKeyValues(kv map[string]value) []models.TealKeyValue {
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]models.TealKeyValue, 0, len(keys))
+	for _, k := range keys {
+		v := kv[k]
+		tv := models.TealValue{Type: 2, Uint: v.u}
+		if v.isBytes {
+			tv = models.TealValue{Type: 1, Bytes: base64.StdEncoding.EncodeToString(v.b)}
+		}
+		out = append(out, models.TealKeyValue{Key: base64.StdEncoding.EncodeToString([]byte(k)), Value: tv})
+	}
+	return out
+}
+
+func (s *Server) localState(id uint64, kv map[string]value) models.ApplicationLocalState {
+	ls := models.ApplicationLocalState{Id: id, KeyValue: tealKeyValues(kv)}
+	if ap, ok := s.l.apps[id]; ok {
+		ls.Schema = models.ApplicationStateSchema{NumUint: ap.localSchema.NumUint, NumByteSlice: ap.localSchema.NumByteSlice}
+	}
+	return ls
+}
+
+func appModel(ap *app) models.Application {
+	return models.Application{
+		Id: ap.id,
+		Params: models.ApplicationParams{
+			ApprovalProgram:   ap.approval,
+			ClearStateProgram: ap.clear,
+			Creator:           ap.creator.String(),
+			ExtraProgramPages: uint64(ap.extraPages),
+			GlobalState:       tealKeyValues(ap.global),
+			GlobalStateSchema: models.ApplicationStateSchema{NumUint: ap.globalSchema.NumUint, NumByteSlice: ap.globalSchema.NumByteSlice},
+			LocalStateSchema:  models.ApplicationStateSchema{NumUint: ap.localSchema.NumUint, NumByteSlice: ap.localSchema.NumByteSlice},
+		},
+	}
+}
+
+func assetHolding(id uint64, h *holding) models.AssetHolding {
+	return models.AssetHolding{AssetId: id, Amount: h.amount, IsFrozen: h.frozen}
+}
+
+func assetModel(as *asset) models.Asset {
+	p := as.params
+	addr := func(a types.Address) string {
+		if a.IsZero() {
+			return ""
+		}
+		return a.String()
+	}
+	var hash []byte
+	if p.MetadataHash != ([32]byte{}) {
+		hash = p.MetadataHash[:]
+	}
+	return models.Asset{
+		Index: as.id,
+		Params: models.AssetParams{
+			Creator:       as.creator.String(),
+			Total:         p.Total,
+			Decimals:      uint64(p.Decimals),
+			DefaultFrozen: p.DefaultFrozen,
+			UnitName:      p.UnitName,
+			UnitNameB64:   []byte(p.UnitName),
+			Name:          p.AssetName,
+			NameB64:       []byte(p.AssetName),
+			Url:           p.URL,
+			UrlB64:        []byte(p.URL),
+			MetadataHash:  hash,
+			Manager:       addr(p.Manager),
+			Reserve:       addr(p.Reserve),
+			Freeze:        addr(p.Freeze),
+			Clawback:      addr(p.Clawback),
+		},
+	}
+}
@@ -0,0 +1,486 @@
+package memnet
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Limits on the programs and schema of a new app.
+const (
+	maxGlobalSchemaEntries = 64
+	maxLocalSchemaEntries  = 16
+)
+
+// txnResult is the outcome of applying one transaction.
+type txnResult struct {
+	txn          types.Transaction
+	createdApp   uint64
+	createdAsset uint64
+	logs         [][]byte
+	inner        []*txnResult
+	scratch      [256]value
+}
+
+// groupContext is the state shared by the transactions of a top-level group
+// and the inner transactions they issue.
+type groupContext struct {
+	l          *ledger
+	budget     int    // pooled opcode budget
+	feeCredit  uint64 // fees paid above the minimum, available to inner transactions
+	innerCount int
+}
+
+// verifySignature checks that stxn is signed by the account authorized to
+// send from its sender. An empty signature passes if allowEmpty is set.
+func (l *ledger) verifySignature(stxn types.SignedTxn, allowEmpty bool) error {
+	auth := l.authAddr(stxn.Txn.Sender)
+	if !stxn.AuthAddr.IsZero() && stxn.AuthAddr != auth {
+		return fmt.Errorf("should have been authorized by %s but was actually authorized by %s", auth, stxn.AuthAddr)
+	}
+	if stxn.AuthAddr.IsZero() && auth != stxn.Txn.Sender {
+		return fmt.Errorf("should have been authorized by %s but was actually authorized by %s", auth, stxn.Txn.Sender)
+	}
+	if len(stxn.Lsig.Logic) > 0 {
+		return errors.New("logic signatures are not supported by memnet")
+	}
+	msg := append([]byte("TX"), msgpack.Encode(stxn.Txn)...)
+	if len(stxn.Msig.Subsigs) > 0 {
+		if !crypto.VerifyMultisig(auth, msg, stxn.Msig) {
+			return errors.New("multisig validation failed")
+		}
+		return nil
+	}
+	if stxn.Sig == (types.Signature{}) {
+		if allowEmpty {
+			return nil
+		}
+		return errors.New("signedtxn has no sig")
+	}
+	if !ed25519.Verify(auth[:], msg, stxn.Sig[:]) {
+		return errors.New("signature validation failed")
+	}
+	return nil
+}
+
+// checkGroup checks the group ID, validity window and fees of a top-level
+// group, and returns the fee credit it leaves for inner transactions.
+func (l *ledger) checkGroup(txns []types.Transaction) (uint64, error) {
+	if len(txns) == 0 || len(txns) > maxTxGroupSize {
+		return 0, fmt.Errorf("group size %d is not between 1 and %d", len(txns), maxTxGroupSize)
+	}
+	if len(txns) > 1 || txns[0].Group != (types.Digest{}) {
+		bare := make([]types.Transaction, len(txns))
+		for i, t := range txns {
+			bare[i] = t
+			bare[i].Group = types.Digest{}
+		}
+		gid, err := crypto.ComputeGroupID(bare)
+		if err != nil {
+			return 0, err
+		}
+		for _, t := range txns {
+			if t.Group != gid {
+				return 0, errors.New("transactionGroup: incomplete group")
+			}
+		}
+	}
+	var fees uint64
+	for _, t := range txns {
+		round := l.round + 1
+		if uint64(t.FirstValid) > round || uint64(t.LastValid) < round {
+			return 0, fmt.Errorf("txn dead: round %d outside of %d--%d", round, t.FirstValid, t.LastValid)
+		}
+		if t.LastValid-t.FirstValid > maxTxnLife {
+			return 0, fmt.Errorf("transaction window size excessive (%d)", t.LastValid-t.FirstValid)
+		}
+		if t.GenesisHash != (types.Digest{}) && t.GenesisHash != genesisHash {
+			return 0, fmt.Errorf("transaction %s: genesis hash mismatch", crypto.GetTxID(t))
+		}
+		if t.GenesisID != "" && t.GenesisID != genesisID {
+			return 0, fmt.Errorf("transaction %s: genesis ID mismatch %q", crypto.GetTxID(t), t.GenesisID)
+		}
+		fees += uint64(t.Fee)
+	}
+	required := minTxnFee * uint64(len(txns))
+	if fees < required {
+		return 0, fmt.Errorf("fee too small: group pays %d, requires %d", fees, required)
+	}
+	return fees - required, nil
+}
+
+// apply applies the gi'th transaction of group and stores its result in
+// results. caller is the app issuing it, or 0 for a top-level transaction.
+func (c *groupContext) apply(group []types.Transaction, results []*txnResult, gi int, caller uint64, depth int) error {
+	t := &group[gi]
+	r := &txnResult{txn: *t}
+	results[gi] = r
+
+	sender := c.l.account(t.Sender)
+	if sender.balance < uint64(t.Fee) {
+		return overspend(t.Sender, sender.balance, uint64(t.Fee))
+	}
+	sender.balance -= uint64(t.Fee)
+
+	var err error
+	switch t.Type {
+	case types.PaymentTx:
+		err = c.applyPayment(t)
+	case types.AssetTransferTx:
+		err = c.applyAssetTransfer(t)
+	case types.AssetConfigTx:
+		err = c.applyAssetConfig(t, r)
+	case types.AssetFreezeTx:
+		err = c.applyAssetFreeze(t)
+	case types.ApplicationCallTx:
+		err = c.applyAppCall(group, results, gi, caller, depth)
+	case types.KeyRegistrationTx:
+		// Participation keys have no effect on memnet.
+	default:
+		err = fmt.Errorf("transaction type %q is not supported by memnet", t.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !t.RekeyTo.IsZero() {
+		a := c.l.account(t.Sender)
+		a.authAddr = t.RekeyTo
+		if t.RekeyTo == t.Sender {
+			a.authAddr = types.Address{}
+		}
+	}
+	return nil
+}
+
+func overspend(addr types.Address, balance, amount uint64) error {
+	return fmt.Errorf("overspend (account %s, data {balance %d}, tried to spend {%d})", addr, balance, amount)
+}
+
+func (c *groupContext) applyPayment(t *types.Transaction) error {
+	sender := c.l.account(t.Sender)
+	if sender.balance < uint64(t.Amount) {
+		return overspend(t.Sender, sender.balance, uint64(t.Amount))
+	}
+	sender.balance -= uint64(t.Amount)
+	c.l.account(t.Receiver).balance += uint64(t.Amount)
+	if t.CloseRemainderTo.IsZero() {
+		return nil
+	}
+	switch {
+	case len(sender.holdings) > 0:
+		return fmt.Errorf("cannot close account %s: it still holds assets", t.Sender)
+	case len(sender.locals) > 0 || len(sender.createdApps) > 0:
+		return fmt.Errorf("cannot close account %s: it has apps", t.Sender)
+	case sender.totalBoxes > 0:
+		return fmt.Errorf("cannot close account %s: it has boxes", t.Sender)
+	}
+	c.l.account(t.CloseRemainderTo).balance += sender.balance
+	delete(c.l.accounts, t.Sender)
+	return nil
+}
+
+func (c *groupContext) applyAssetTransfer(t *types.Transaction) error {
+	id := uint64(t.XferAsset)
+	as, ok := c.l.assets[id]
+	if !ok {
+		return fmt.Errorf("asset %d does not exist or has been deleted", id)
+	}
+
+	// An opt-in is a zero transfer to oneself.
+	if t.AssetAmount == 0 && t.Sender == t.AssetReceiver && t.AssetSender.IsZero() && t.AssetCloseTo.IsZero() {
+		sender := c.l.account(t.Sender)
+		if _, ok := sender.holdings[id]; !ok {
+			sender.holdings[id] = &holding{frozen: as.params.DefaultFrozen}
+		}
+		return nil
+	}
+
+	source := t.Sender
+	clawback := !t.AssetSender.IsZero()
+	if clawback {
+		if t.Sender != as.params.Clawback {
+			return fmt.Errorf("clawback not allowed: sender %s, clawback %s", t.Sender, as.params.Clawback)
+		}
+		if !t.AssetCloseTo.IsZero() {
+			return errors.New("cannot close asset by clawback")
+		}
+		source = t.AssetSender
+	}
+	from, ok := c.l.account(source).holdings[id]
+	if !ok {
+		return fmt.Errorf("asset %d missing from %s", id, source)
+	}
+	to, ok := c.l.account(t.AssetReceiver).holdings[id]
+	if !ok {
+		return fmt.Errorf("receiver error: must optin, asset %d missing from %s", id, t.AssetReceiver)
+	}
+	if !clawback && (from.frozen || to.frozen) {
+		return fmt.Errorf("asset %d frozen in %s", id, source)
+	}
+	if from.amount < t.AssetAmount {
+		return fmt.Errorf("underflow on subtracting %d from sender amount %d", t.AssetAmount, from.amount)
+	}
+	from.amount -= t.AssetAmount
+	to.amount += t.AssetAmount
+
+	if t.AssetCloseTo.IsZero() {
+		return nil
+	}
+	if source == as.creator {
+		return fmt.Errorf("cannot close asset ID in allocating account")
+	}
+	closeTo, ok := c.l.account(t.AssetCloseTo).holdings[id]
+	if !ok {
+		return fmt.Errorf("receiver error: must optin, asset %d missing from %s", id, t.AssetCloseTo)
+	}
+	if from.frozen || closeTo.frozen {
+		return fmt.Errorf("asset %d frozen in %s", id, source)
+	}
+	closeTo.amount += from.amount
+	delete(c.l.account(source).holdings, id)
+	return nil
+}
+
+func (c *groupContext) applyAssetConfig(t *types.Transaction, r *txnResult) error {
+	p := t.AssetParams
+	if t.ConfigAsset == 0 {
+		switch {
+		case p.Decimals > 19:
+			return fmt.Errorf("transaction asset decimals is too high (max is 19)")
+		case len(p.UnitName) > 8:
+			return fmt.Errorf("transaction asset unit name too big: %d > 8", len(p.UnitName))
+		case len(p.AssetName) > 32:
+			return fmt.Errorf("transaction asset name too big: %d > 32", len(p.AssetName))
+		case len(p.URL) > 96:
+			return fmt.Errorf("transaction asset url too big: %d > 96", len(p.URL))
+		}
+		id := c.l.newID()
+		c.l.assets[id] = &asset{id: id, creator: t.Sender, params: p}
+		creator := c.l.account(t.Sender)
+		creator.createdAssets[id] = true
+		creator.holdings[id] = &holding{amount: p.Total}
+		r.createdAsset = id
+		return nil
+	}
+
+	id := uint64(t.ConfigAsset)
+	as, ok := c.l.assets[id]
+	if !ok {
+		return fmt.Errorf("asset %d does not exist or has been deleted", id)
+	}
+	if as.params.Manager.IsZero() || t.Sender != as.params.Manager {
+		return fmt.Errorf("this transaction should be issued by the manager. It is issued by %s, manager key %s", t.Sender, as.params.Manager)
+	}
+	if p.IsZero() {
+		creator := c.l.account(as.creator)
+		if h := creator.holdings[id]; h == nil || h.amount != as.params.Total {
+			return fmt.Errorf("cannot destroy asset: creator is holding only part of the total %d", as.params.Total)
+		}
+		delete(creator.holdings, id)
+		delete(creator.createdAssets, id)
+		delete(c.l.assets, id)
+		return nil
+	}
+	update := func(dst *types.Address, v types.Address) error {
+		if dst.IsZero() && !v.IsZero() {
+			return errors.New("this transaction tries to set an address that was cleared")
+		}
+		*dst = v
+		return nil
+	}
+	for _, f := range []struct {
+		dst *types.Address
+		v   types.Address
+	}{
+		{&as.params.Manager, p.Manager},
+		{&as.params.Reserve, p.Reserve},
+		{&as.params.Freeze, p.Freeze},
+		{&as.params.Clawback, p.Clawback},
+	} {
+		if err := update(f.dst, f.v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *groupContext) applyAssetFreeze(t *types.Transaction) error {
+	id := uint64(t.FreezeAsset)
+	as, ok := c.l.assets[id]
+	if !ok {
+		return fmt.Errorf("asset %d does not exist or has been deleted", id)
+	}
+	if as.params.Freeze.IsZero() || t.Sender != as.params.Freeze {
+		return fmt.Errorf("freeze not allowed: sender %s, freeze %s", t.Sender, as.params.Freeze)
+	}
+	h, ok := c.l.account(t.FreezeAccount).holdings[id]
+	if !ok {
+		return fmt.Errorf("asset %d missing from %s", id, t.FreezeAccount)
+	}
+	h.frozen = t.AssetFrozen
+	return nil
+}
+
+func (c *groupContext) applyAppCall(group []types.Transaction, results []*txnResult, gi int, caller uint64, depth int) error {
+	t := &group[gi]
+	r := results[gi]
+	if depth > 0 {
+		c.budget += appCallBudget
+	}
+
+	appID := uint64(t.ApplicationID)
+	if appID == 0 {
+		if err := checkNewApp(t); err != nil {
+			return err
+		}
+		appID = c.l.newID()
+		c.l.apps[appID] = &app{
+			id:           appID,
+			creator:      t.Sender,
+			approval:     t.ApprovalProgram,
+			clear:        t.ClearStateProgram,
+			globalSchema: t.GlobalStateSchema,
+			localSchema:  t.LocalStateSchema,
+			extraPages:   t.ExtraProgramPages,
+			global:       make(map[string]value),
+			boxes:        make(map[string][]byte),
+		}
+		c.l.account(t.Sender).createdApps[appID] = true
+		r.createdApp = appID
+	}
+	ap, ok := c.l.apps[appID]
+	if !ok {
+		return fmt.Errorf("application %d does not exist", appID)
+	}
+	sender := c.l.account(t.Sender)
+	_, optedIn := sender.locals[appID]
+
+	switch t.OnCompletion {
+	case types.ClearStateOC:
+		if !optedIn {
+			return fmt.Errorf("cannot clear state: %s is not currently opted in to app %d", t.Sender, appID)
+		}
+		// A failing clear program has its effects discarded, but the
+		// account is opted out regardless.
+		snapshot := c.l.clone()
+		if err := c.runProgram(ap.clear, appID, group, results, gi, caller, depth); err != nil {
+			c.l = snapshot
+			r.logs, r.inner = nil, nil
+		}
+		delete(c.l.account(t.Sender).locals, appID)
+		return nil
+	case types.OptInOC:
+		if optedIn {
+			return fmt.Errorf("account %s has already opted in to app %d", t.Sender, appID)
+		}
+		sender.locals[appID] = make(map[string]value)
+	case types.CloseOutOC:
+		if !optedIn {
+			return fmt.Errorf("account %s is not opted in to app %d", t.Sender, appID)
+		}
+	}
+
+	if err := c.runProgram(ap.approval, appID, group, results, gi, caller, depth); err != nil {
+		var ee *evalError
+		if errors.As(err, &ee) {
+			return fmt.Errorf("logic eval error: %v. Details: app=%d, pc=%d", ee.err, appID, ee.pc)
+		}
+		if errors.Is(err, errApprovalFailed) {
+			return errors.New("transaction rejected by ApprovalProgram")
+		}
+		return err
+	}
+
+	switch t.OnCompletion {
+	case types.CloseOutOC:
+		delete(c.l.account(t.Sender).locals, appID)
+	case types.UpdateApplicationOC:
+		if err := checkPrograms(t.ApprovalProgram, t.ClearStateProgram, ap.extraPages); err != nil {
+			return err
+		}
+		ap = c.l.apps[appID]
+		ap.approval, ap.clear = t.ApprovalProgram, t.ClearStateProgram
+	case types.DeleteApplicationOC:
+		delete(c.l.account(c.l.apps[appID].creator).createdApps, appID)
+		delete(c.l.apps, appID)
+	}
+	return nil
+}
+
+// runProgram runs program for the gi'th transaction of group.
+func (c *groupContext) runProgram(program []byte, appID uint64, group []types.Transaction, results []*txnResult, gi int, caller uint64, depth int) error {
+	e := &evaluator{
+		c:       c,
+		appID:   appID,
+		txn:     &group[gi],
+		gi:      gi,
+		group:   group,
+		results: results,
+		result:  results[gi],
+		caller:  caller,
+		depth:   depth,
+		program: program,
+	}
+	err := e.run()
+	results[gi].scratch = e.scratch
+	return err
+}
+
+func checkNewApp(t *types.Transaction) error {
+	if t.ExtraProgramPages > maxExtraAppPages {
+		return fmt.Errorf("tx.ExtraProgramPages exceeds MaxExtraAppProgramPages = %d", maxExtraAppPages)
+	}
+	if t.GlobalStateSchema.NumUint+t.GlobalStateSchema.NumByteSlice > maxGlobalSchemaEntries {
+		return fmt.Errorf("tx.GlobalStateSchema too large, max number of keys is %d", maxGlobalSchemaEntries)
+	}
+	if t.LocalStateSchema.NumUint+t.LocalStateSchema.NumByteSlice > maxLocalSchemaEntries {
+		return fmt.Errorf("tx.LocalStateSchema too large, max number of keys is %d", maxLocalSchemaEntries)
+	}
+	return checkPrograms(t.ApprovalProgram, t.ClearStateProgram, t.ExtraProgramPages)
+}
+
+func checkPrograms(approval, clear []byte, extraPages uint32) error {
+	if len(approval) == 0 || len(clear) == 0 {
+		return errors.New("approval and clear state programs must not be empty")
+	}
+	max := maxAppProgramLen * (1 + int(extraPages))
+	if len(approval)+len(clear) > max {
+		return fmt.Errorf("app programs too long. max total len %d bytes", max)
+	}
+	return nil
+}
+
+// checkMinBalances fails if an account is below its minimum balance. Empty
+// accounts are dropped.
+func (l *ledger) checkMinBalances() error {
+	for addr, a := range l.accounts {
+		if a.balance == 0 && a.authAddr.IsZero() && len(a.holdings) == 0 && len(a.locals) == 0 &&
+			len(a.createdApps) == 0 && len(a.createdAssets) == 0 && a.totalBoxes == 0 {
+			delete(l.accounts, addr)
+			continue
+		}
+		if min := l.minBalance(addr); a.balance < min {
+			return fmt.Errorf("account %s balance %d below min %d (%d assets)", addr, a.balance, min, len(a.holdings))
+		}
+	}
+	return nil
+}
+
+// programAddress is the address of a program, used by ed25519verify.
+func programAddress(program []byte) types.Address {
+	return crypto.AddressFromProgram(program)
+}
+
+// leaseConflict reports whether t's lease is held by an earlier transaction.
+func (l *ledger) leaseConflict(t types.Transaction) bool {
+	if t.Lease == ([32]byte{}) {
+		return false
+	}
+	last, ok := l.leases[leaseKey{t.Sender, t.Lease}]
+	return ok && last >= l.round+1
+}
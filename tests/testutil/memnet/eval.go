@@ -0,0 +1,257 @@
+package memnet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// minProgramVersion is the oldest program version memnet runs. Older
+// programs lack the resource sharing and opcodes the generator targets.
+const minProgramVersion = 4
+
+// maxProgramVersion is the newest program version memnet knows.
+const maxProgramVersion = 11
+
+// maxStackDepth is the AVM stack limit.
+const maxStackDepth = 1000
+
+// errApprovalFailed is returned by run when the program completes with a
+// zero result rather than failing.
+var errApprovalFailed = errors.New("rejected")
+
+// evaluator runs one application program for one transaction.
+type evaluator struct {
+	c       *groupContext
+	appID   uint64
+	txn     *types.Transaction
+	gi      int // index of txn in its group
+	group   []types.Transaction
+	results []*txnResult // of group, filled in as transactions are applied
+	result  *txnResult   // of txn; logs are appended to it
+	caller  uint64       // the app that issued txn, if it is an inner transaction
+	depth   int          // inner transaction nesting level
+
+	program []byte
+	version uint64
+	pc      int // the opcode being executed
+	next    int // the opcode after it, set by immediate readers
+	stack   []value
+	frames  []frame
+	intc    []uint64
+	bytec   [][]byte
+	scratch [256]value
+	done    bool
+
+	building []types.Transaction // the inner group under construction
+	inner    []*txnResult        // the last inner group submitted
+}
+
+// frame is an entry of the callsub stack.
+type frame struct {
+	retpc  int
+	height int // stack height at callsub
+	args   int
+	rets   int
+	proto  bool
+}
+
+// evalError is a program failure at a pc. Its message follows algod's.
+type evalError struct {
+	pc  int
+	err error
+}
+
+func (e *evalError) Error() string {
+	return fmt.Sprintf("%v pc=%d", e.err, e.pc)
+}
+
+func (e *evalError) Unwrap() error { return e.err }
+
+// run executes the program and reports whether it approved.
+func (e *evaluator) run() error {
+	version, n := binary.Uvarint(e.program)
+	if n <= 0 {
+		return &evalError{pc: 0, err: errors.New("invalid version")}
+	}
+	if version < minProgramVersion || version > maxProgramVersion {
+		return &evalError{pc: 0, err: fmt.Errorf("program version %d is not supported by memnet", version)}
+	}
+	e.version = version
+	e.pc = n
+	for e.pc < len(e.program) && !e.done {
+		op := &opTable[e.program[e.pc]]
+		if op.fn == nil {
+			return &evalError{pc: e.pc, err: fmt.Errorf("illegal opcode 0x%02x", e.program[e.pc])}
+		}
+		if op.version > e.version {
+			return &evalError{pc: e.pc, err: fmt.Errorf("%s opcode was introduced in v%d", op.name, op.version)}
+		}
+		e.c.budget -= op.cost
+		if e.c.budget < 0 {
+			return &evalError{pc: e.pc, err: fmt.Errorf("dynamic cost budget exceeded, executing %s", op.name)}
+		}
+		e.next = e.pc + 1
+		if err := op.fn(e); err != nil {
+			var ee *evalError
+			if errors.As(err, &ee) {
+				return err
+			}
+			return &evalError{pc: e.pc, err: err}
+		}
+		if len(e.stack) > maxStackDepth {
+			return &evalError{pc: e.pc, err: fmt.Errorf("stack overflow in %s", op.name)}
+		}
+		if !e.done {
+			e.pc = e.next
+		}
+	}
+	if !e.done && len(e.stack) != 1 {
+		return &evalError{pc: e.pc, err: fmt.Errorf("stack len is %d instead of 1", len(e.stack))}
+	}
+	if len(e.stack) == 0 {
+		return &evalError{pc: e.pc, err: errors.New("stack is empty at return")}
+	}
+	top := e.stack[len(e.stack)-1]
+	if top.isBytes {
+		return &evalError{pc: e.pc, err: errors.New("stack finished with bytes not int")}
+	}
+	if top.u == 0 {
+		return errApprovalFailed
+	}
+	return nil
+}
+
+func (e *evaluator) push(v value) { e.stack = append(e.stack, v) }
+
+func (e *evaluator) pushUint(u uint64) { e.push(uintValue(u)) }
+
+func (e *evaluator) pushBytes(b []byte) { e.push(bytesValue(b)) }
+
+func (e *evaluator) pushBool(ok bool) { e.push(boolValue(ok)) }
+
+func (e *evaluator) pop() (value, error) {
+	if len(e.stack) == 0 {
+		return value{}, errors.New("stack underflow")
+	}
+	v := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+	return v, nil
+}
+
+func (e *evaluator) popUint() (uint64, error) {
+	v, err := e.pop()
+	if err != nil {
+		return 0, err
+	}
+	if v.isBytes {
+		return 0, fmt.Errorf("%s arg is []byte not uint64", opTable[e.program[e.pc]].name)
+	}
+	return v.u, nil
+}
+
+func (e *evaluator) popBytes() ([]byte, error) {
+	v, err := e.pop()
+	if err != nil {
+		return nil, err
+	}
+	if !v.isBytes {
+		return nil, fmt.Errorf("%s arg is uint64 not []byte", opTable[e.program[e.pc]].name)
+	}
+	return v.b, nil
+}
+
+// popUints pops n uint64s, returning them in push order.
+func (e *evaluator) popUints(n int) ([]uint64, error) {
+	out := make([]uint64, n)
+	for i := n - 1; i >= 0; i-- {
+		u, err := e.popUint()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = u
+	}
+	return out, nil
+}
+
+// popBytesN pops n byte slices, returning them in push order.
+func (e *evaluator) popBytesN(n int) ([][]byte, error) {
+	out := make([][]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b, err := e.popBytes()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = b
+	}
+	return out, nil
+}
+
+// Immediate readers. Each advances e.next past the immediate it reads.
+
+func (e *evaluator) imm8() (byte, error) {
+	if e.next >= len(e.program) {
+		return 0, errors.New("program ends in the middle of an immediate")
+	}
+	b := e.program[e.next]
+	e.next++
+	return b, nil
+}
+
+func (e *evaluator) immInt16() (int, error) {
+	if e.next+2 > len(e.program) {
+		return 0, errors.New("program ends in the middle of a branch offset")
+	}
+	off := int(int16(binary.BigEndian.Uint16(e.program[e.next:])))
+	e.next += 2
+	return off, nil
+}
+
+func (e *evaluator) immVaruint() (uint64, error) {
+	u, n := binary.Uvarint(e.program[e.next:])
+	if n <= 0 {
+		return 0, errors.New("invalid varuint immediate")
+	}
+	e.next += n
+	return u, nil
+}
+
+func (e *evaluator) immBytes() ([]byte, error) {
+	n, err := e.immVaruint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(e.program)-e.next) < n {
+		return nil, errors.New("byte immediate runs past the end of the program")
+	}
+	b := e.program[e.next : e.next+int(n)]
+	e.next += int(n)
+	return b, nil
+}
+
+// branch moves execution to offset bytes after the current instruction.
+func (e *evaluator) branch(offset int) error {
+	target := e.next + offset
+	if target < 0 || target > len(e.program) {
+		return fmt.Errorf("branch target %d outside of program", target)
+	}
+	e.next = target
+	return nil
+}
+
+// immLabels reads the offsets of a switch or match.
+func (e *evaluator) immLabels() ([]int, error) {
+	n, err := e.imm8()
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]int, n)
+	for i := range labels {
+		if labels[i], err = e.immInt16(); err != nil {
+			return nil, err
+		}
+	}
+	return labels, nil
+}
@@ -0,0 +1,526 @@
+package memnet
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Transaction field numbers, as in the AVM spec.
+const (
+	fieldSender = iota
+	fieldFee
+	fieldFirstValid
+	fieldFirstValidTime
+	fieldLastValid
+	fieldNote
+	fieldLease
+	fieldReceiver
+	fieldAmount
+	fieldCloseRemainderTo
+	fieldVotePK
+	fieldSelectionPK
+	fieldVoteFirst
+	fieldVoteLast
+	fieldVoteKeyDilution
+	fieldType
+	fieldTypeEnum
+	fieldXferAsset
+	fieldAssetAmount
+	fieldAssetSender
+	fieldAssetReceiver
+	fieldAssetCloseTo
+	fieldGroupIndex
+	fieldTxID
+	fieldApplicationID
+	fieldOnCompletion
+	fieldApplicationArgs
+	fieldNumAppArgs
+	fieldAccounts
+	fieldNumAccounts
+	fieldApprovalProgram
+	fieldClearStateProgram
+	fieldRekeyTo
+	fieldConfigAsset
+	fieldConfigAssetTotal
+	fieldConfigAssetDecimals
+	fieldConfigAssetDefaultFrozen
+	fieldConfigAssetUnitName
+	fieldConfigAssetName
+	fieldConfigAssetURL
+	fieldConfigAssetMetadataHash
+	fieldConfigAssetManager
+	fieldConfigAssetReserve
+	fieldConfigAssetFreeze
+	fieldConfigAssetClawback
+	fieldFreezeAsset
+	fieldFreezeAssetAccount
+	fieldFreezeAssetFrozen
+	fieldAssets
+	fieldNumAssets
+	fieldApplications
+	fieldNumApplications
+	fieldGlobalNumUint
+	fieldGlobalNumByteSlice
+	fieldLocalNumUint
+	fieldLocalNumByteSlice
+	fieldExtraProgramPages
+	fieldNonparticipation
+	fieldLogs
+	fieldNumLogs
+	fieldCreatedAssetID
+	fieldCreatedApplicationID
+	fieldLastLog
+	fieldStateProofPK
+	fieldApprovalProgramPages
+	fieldNumApprovalProgramPages
+	fieldClearStateProgramPages
+	fieldNumClearStateProgramPages
+)
+
+// programPageSize is the length of each ApprovalProgramPages and
+// ClearStateProgramPages element.
+const programPageSize = 4096
+
+var typeEnums = map[types.TxType]uint64{
+	types.PaymentTx:         1,
+	types.KeyRegistrationTx: 2,
+	types.AssetConfigTx:     3,
+	types.AssetTransferTx:   4,
+	types.AssetFreezeTx:     5,
+	types.ApplicationCallTx: 6,
+}
+
+// isArrayField reports whether field is indexed by txna and friends.
+func isArrayField(field int) bool {
+	switch field {
+	case fieldApplicationArgs, fieldAccounts, fieldAssets, fieldApplications, fieldLogs,
+		fieldApprovalProgramPages, fieldClearStateProgramPages:
+		return true
+	}
+	return false
+}
+
+// txnField returns field of t, the gi'th transaction of its group. r is the
+// result of t if it has been applied, for the Logs and Created fields.
+func (e *evaluator) txnField(t *types.Transaction, r *txnResult, gi int, field int, idx uint64, indexed bool) (value, error) {
+	if isArrayField(field) != indexed {
+		return value{}, fmt.Errorf("invalid txn field %d", field)
+	}
+	addr := func(a types.Address) (value, error) { return bytesValue(append([]byte{}, a[:]...)), nil }
+	u := func(u uint64) (value, error) { return uintValue(u), nil }
+	b := func(b []byte) (value, error) { return bytesValue(b), nil }
+	index := func(n int) error {
+		if idx >= uint64(n) {
+			return fmt.Errorf("invalid array index %d for field %d", idx, field)
+		}
+		return nil
+	}
+
+	switch field {
+	case fieldSender:
+		return addr(t.Sender)
+	case fieldFee:
+		return u(uint64(t.Fee))
+	case fieldFirstValid:
+		return u(uint64(t.FirstValid))
+	case fieldLastValid:
+		return u(uint64(t.LastValid))
+	case fieldNote:
+		return b(t.Note)
+	case fieldLease:
+		return b(append([]byte{}, t.Lease[:]...))
+	case fieldReceiver:
+		return addr(t.Receiver)
+	case fieldAmount:
+		return u(uint64(t.Amount))
+	case fieldCloseRemainderTo:
+		return addr(t.CloseRemainderTo)
+	case fieldVotePK:
+		return b(append([]byte{}, t.VotePK[:]...))
+	case fieldSelectionPK:
+		return b(append([]byte{}, t.SelectionPK[:]...))
+	case fieldVoteFirst:
+		return u(uint64(t.VoteFirst))
+	case fieldVoteLast:
+		return u(uint64(t.VoteLast))
+	case fieldVoteKeyDilution:
+		return u(t.VoteKeyDilution)
+	case fieldType:
+		return b([]byte(t.Type))
+	case fieldTypeEnum:
+		return u(typeEnums[t.Type])
+	case fieldXferAsset:
+		return u(uint64(t.XferAsset))
+	case fieldAssetAmount:
+		return u(t.AssetAmount)
+	case fieldAssetSender:
+		return addr(t.AssetSender)
+	case fieldAssetReceiver:
+		return addr(t.AssetReceiver)
+	case fieldAssetCloseTo:
+		return addr(t.AssetCloseTo)
+	case fieldGroupIndex:
+		return u(uint64(gi))
+	case fieldTxID:
+		return b(crypto.TransactionID(*t))
+	case fieldApplicationID:
+		return u(uint64(t.ApplicationID))
+	case fieldOnCompletion:
+		return u(uint64(t.OnCompletion))
+	case fieldApplicationArgs:
+		if err := index(len(t.ApplicationArgs)); err != nil {
+			return value{}, err
+		}
+		return b(t.ApplicationArgs[idx])
+	case fieldNumAppArgs:
+		return u(uint64(len(t.ApplicationArgs)))
+	case fieldAccounts:
+		if idx == 0 {
+			return addr(t.Sender)
+		}
+		if err := index(len(t.Accounts) + 1); err != nil {
+			return value{}, err
+		}
+		return addr(t.Accounts[idx-1])
+	case fieldNumAccounts:
+		return u(uint64(len(t.Accounts)))
+	case fieldApprovalProgram:
+		return b(t.ApprovalProgram)
+	case fieldClearStateProgram:
+		return b(t.ClearStateProgram)
+	case fieldRekeyTo:
+		return addr(t.RekeyTo)
+	case fieldConfigAsset:
+		return u(uint64(t.ConfigAsset))
+	case fieldConfigAssetTotal:
+		return u(t.AssetParams.Total)
+	case fieldConfigAssetDecimals:
+		return u(uint64(t.AssetParams.Decimals))
+	case fieldConfigAssetDefaultFrozen:
+		return boolValue(t.AssetParams.DefaultFrozen), nil
+	case fieldConfigAssetUnitName:
+		return b([]byte(t.AssetParams.UnitName))
+	case fieldConfigAssetName:
+		return b([]byte(t.AssetParams.AssetName))
+	case fieldConfigAssetURL:
+		return b([]byte(t.AssetParams.URL))
+	case fieldConfigAssetMetadataHash:
+		return b(append([]byte{}, t.AssetParams.MetadataHash[:]...))
+	case fieldConfigAssetManager:
+		return addr(t.AssetParams.Manager)
+	case fieldConfigAssetReserve:
+		return addr(t.AssetParams.Reserve)
+	case fieldConfigAssetFreeze:
+		return addr(t.AssetParams.Freeze)
+	case fieldConfigAssetClawback:
+		return addr(t.AssetParams.Clawback)
+	case fieldFreezeAsset:
+		return u(uint64(t.FreezeAsset))
+	case fieldFreezeAssetAccount:
+		return addr(t.FreezeAccount)
+	case fieldFreezeAssetFrozen:
+		return boolValue(t.AssetFrozen), nil
+	case fieldAssets:
+		if err := index(len(t.ForeignAssets)); err != nil {
+			return value{}, err
+		}
+		return u(uint64(t.ForeignAssets[idx]))
+	case fieldNumAssets:
+		return u(uint64(len(t.ForeignAssets)))
+	case fieldApplications:
+		if idx == 0 {
+			return u(uint64(t.ApplicationID))
+		}
+		if err := index(len(t.ForeignApps) + 1); err != nil {
+			return value{}, err
+		}
+		return u(uint64(t.ForeignApps[idx-1]))
+	case fieldNumApplications:
+		return u(uint64(len(t.ForeignApps)))
+	case fieldGlobalNumUint:
+		return u(t.GlobalStateSchema.NumUint)
+	case fieldGlobalNumByteSlice:
+		return u(t.GlobalStateSchema.NumByteSlice)
+	case fieldLocalNumUint:
+		return u(t.LocalStateSchema.NumUint)
+	case fieldLocalNumByteSlice:
+		return u(t.LocalStateSchema.NumByteSlice)
+	case fieldExtraProgramPages:
+		return u(uint64(t.ExtraProgramPages))
+	case fieldNonparticipation:
+		return boolValue(t.Nonparticipation), nil
+	case fieldStateProofPK:
+		return b(append([]byte{}, t.StateProofPK[:]...))
+	case fieldApprovalProgramPages:
+		return programPage(t.ApprovalProgram, idx)
+	case fieldNumApprovalProgramPages:
+		return u(uint64((len(t.ApprovalProgram) + programPageSize - 1) / programPageSize))
+	case fieldClearStateProgramPages:
+		return programPage(t.ClearStateProgram, idx)
+	case fieldNumClearStateProgramPages:
+		return u(uint64((len(t.ClearStateProgram) + programPageSize - 1) / programPageSize))
+	case fieldLogs, fieldNumLogs, fieldCreatedAssetID, fieldCreatedApplicationID, fieldLastLog:
+		if r == nil {
+			return value{}, fmt.Errorf("txn field %d is only available on applied transactions", field)
+		}
+		switch field {
+		case fieldLogs:
+			if err := index(len(r.logs)); err != nil {
+				return value{}, err
+			}
+			return b(r.logs[idx])
+		case fieldNumLogs:
+			return u(uint64(len(r.logs)))
+		case fieldCreatedAssetID:
+			return u(r.createdAsset)
+		case fieldCreatedApplicationID:
+			return u(r.createdApp)
+		default:
+			if len(r.logs) == 0 {
+				return b(nil)
+			}
+			return b(r.logs[len(r.logs)-1])
+		}
+	}
+	return value{}, fmt.Errorf("txn field %d is not supported by memnet", field)
+}
+
+func programPage(program []byte, idx uint64) (value, error) {
+	start := idx * programPageSize
+	if start >= uint64(len(program)) {
+		return value{}, fmt.Errorf("invalid program page index %d", idx)
+	}
+	end := start + programPageSize
+	if end > uint64(len(program)) {
+		end = uint64(len(program))
+	}
+	return bytesValue(program[start:end]), nil
+}
+
+// groupTxn returns the gi'th transaction of the current group.
+func (e *evaluator) groupTxn(gi uint64) (*types.Transaction, *txnResult, error) {
+	if gi >= uint64(len(e.group)) {
+		return nil, nil, fmt.Errorf("txn index %d, len(group) is %d", gi, len(e.group))
+	}
+	var r *txnResult
+	if gi < uint64(e.gi) {
+		r = e.results[gi]
+	}
+	return &e.group[gi], r, nil
+}
+
+// pushTxnField pushes a field of the gi'th transaction of the group.
+func (e *evaluator) pushTxnField(gi uint64, field byte, idx uint64, indexed bool) error {
+	t, r, err := e.groupTxn(gi)
+	if err != nil {
+		return err
+	}
+	v, err := e.txnField(t, r, int(gi), int(field), idx, indexed)
+	if err != nil {
+		return err
+	}
+	e.push(v)
+	return nil
+}
+
+func opTxn(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(e.gi), f, 0, false)
+}
+
+func opTxna(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(e.gi), f, uint64(i), true)
+}
+
+func opTxnas(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(e.gi), f, i, true)
+}
+
+func opGtxn(e *evaluator) error {
+	gi, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(gi), f, 0, false)
+}
+
+func opGtxna(e *evaluator) error {
+	gi, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(gi), f, uint64(i), true)
+}
+
+func opGtxnas(e *evaluator) error {
+	gi, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(uint64(gi), f, i, true)
+}
+
+func opGtxns(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	gi, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(gi, f, 0, false)
+}
+
+func opGtxnsa(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	gi, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(gi, f, uint64(i), true)
+}
+
+func opGtxnsas(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	gi, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.pushTxnField(gi, f, i, true)
+}
+
+// Global field numbers, as in the AVM spec.
+const (
+	globalMinTxnFee = iota
+	globalMinBalance
+	globalMaxTxnLife
+	globalZeroAddress
+	globalGroupSize
+	globalLogicSigVersion
+	globalRound
+	globalLatestTimestamp
+	globalCurrentApplicationID
+	globalCreatorAddress
+	globalCurrentApplicationAddress
+	globalGroupID
+	globalOpcodeBudget
+	globalCallerApplicationID
+	globalCallerApplicationAddress
+	globalAssetCreateMinBalance
+	globalAssetOptInMinBalance
+	globalGenesisHash
+)
+
+func opGlobal(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	switch f {
+	case globalMinTxnFee:
+		e.pushUint(minTxnFee)
+	case globalMinBalance:
+		e.pushUint(minBalance)
+	case globalMaxTxnLife:
+		e.pushUint(maxTxnLife)
+	case globalZeroAddress:
+		e.pushBytes(make([]byte, 32))
+	case globalGroupSize:
+		e.pushUint(uint64(len(e.group)))
+	case globalLogicSigVersion:
+		e.pushUint(maxProgramVersion)
+	case globalRound:
+		e.pushUint(e.c.l.round)
+	case globalLatestTimestamp:
+		e.pushUint(uint64(e.c.l.timestamp))
+	case globalCurrentApplicationID:
+		e.pushUint(e.appID)
+	case globalCreatorAddress:
+		ap, ok := e.c.l.apps[e.appID]
+		if !ok {
+			return fmt.Errorf("no such app %d", e.appID)
+		}
+		e.pushBytes(append([]byte{}, ap.creator[:]...))
+	case globalCurrentApplicationAddress:
+		a := appAddress(e.appID)
+		e.pushBytes(a[:])
+	case globalGroupID:
+		e.pushBytes(append([]byte{}, e.txn.Group[:]...))
+	case globalOpcodeBudget:
+		e.pushUint(uint64(e.c.budget))
+	case globalCallerApplicationID:
+		e.pushUint(e.caller)
+	case globalCallerApplicationAddress:
+		if e.caller == 0 {
+			e.pushBytes(make([]byte, 32))
+			break
+		}
+		a := appAddress(e.caller)
+		e.pushBytes(a[:])
+	case globalAssetCreateMinBalance:
+		e.pushUint(assetMinBalance)
+	case globalAssetOptInMinBalance:
+		e.pushUint(assetMinBalance)
+	case globalGenesisHash:
+		e.pushBytes(append([]byte{}, genesisHash[:]...))
+	default:
+		return fmt.Errorf("global field %d is not supported by memnet", f)
+	}
+	return nil
+}
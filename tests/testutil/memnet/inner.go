@@ -0,0 +1,381 @@
+package memnet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+var txTypes = map[uint64]types.TxType{
+	1: types.PaymentTx,
+	2: types.KeyRegistrationTx,
+	3: types.AssetConfigTx,
+	4: types.AssetTransferTx,
+	5: types.AssetFreezeTx,
+	6: types.ApplicationCallTx,
+}
+
+// newInner returns an inner transaction with the defaults algod fills in.
+func (e *evaluator) newInner() types.Transaction {
+	var t types.Transaction
+	t.Sender = appAddress(e.appID)
+	t.FirstValid = e.txn.FirstValid
+	t.LastValid = e.txn.LastValid
+	t.GenesisHash = e.txn.GenesisHash
+	if e.c.feeCredit < minTxnFee {
+		t.Fee = types.MicroAlgos(minTxnFee - e.c.feeCredit)
+	}
+	return t
+}
+
+func opItxnBegin(e *evaluator) error {
+	if e.building != nil {
+		return errors.New("itxn_begin without itxn_submit")
+	}
+	e.building = []types.Transaction{e.newInner()}
+	return nil
+}
+
+func opItxnNext(e *evaluator) error {
+	if e.building == nil {
+		return errors.New("itxn_next without itxn_begin")
+	}
+	if len(e.building) >= maxTxGroupSize {
+		return fmt.Errorf("too many inner transactions %d", len(e.building)+1)
+	}
+	e.building = append(e.building, e.newInner())
+	return nil
+}
+
+func opItxnField(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if e.building == nil {
+		return errors.New("itxn_field without itxn_begin")
+	}
+	t := &e.building[len(e.building)-1]
+	if err := e.setInnerField(t, int(f), v); err != nil {
+		return fmt.Errorf("itxn_field %d: %w", f, err)
+	}
+	return nil
+}
+
+// setInnerField sets an itxn_field of t.
+func (e *evaluator) setInnerField(t *types.Transaction, field int, v value) error {
+	var err error
+	addr := func(dst *types.Address) {
+		var a types.Address
+		if a, err = e.resolveAccount(v); err == nil {
+			*dst = a
+		}
+	}
+	u := func() uint64 {
+		if v.isBytes {
+			err = errors.New("expected uint64 but got []byte")
+		}
+		return v.u
+	}
+	b := func(max int) []byte {
+		if !v.isBytes {
+			err = errors.New("expected []byte but got uint64")
+			return nil
+		}
+		if len(v.b) > max {
+			err = fmt.Errorf("length %d exceeds %d", len(v.b), max)
+		}
+		return append([]byte{}, v.b...)
+	}
+	str := func(max int) string { return string(b(max)) }
+	bit := func() bool {
+		n := u()
+		if n > 1 {
+			err = fmt.Errorf("%d is not 0 or 1", n)
+		}
+		return n == 1
+	}
+	schema := func(dst *uint64) {
+		if n := u(); err == nil {
+			*dst = n
+		}
+	}
+
+	switch field {
+	case fieldSender:
+		addr(&t.Sender)
+	case fieldFee:
+		t.Fee = types.MicroAlgos(u())
+	case fieldNote:
+		t.Note = b(1024)
+	case fieldReceiver:
+		addr(&t.Receiver)
+	case fieldAmount:
+		t.Amount = types.MicroAlgos(u())
+	case fieldCloseRemainderTo:
+		addr(&t.CloseRemainderTo)
+	case fieldVotePK:
+		copy(t.VotePK[:], b(len(t.VotePK)))
+	case fieldSelectionPK:
+		copy(t.SelectionPK[:], b(len(t.SelectionPK)))
+	case fieldVoteFirst:
+		t.VoteFirst = types.Round(u())
+	case fieldVoteLast:
+		t.VoteLast = types.Round(u())
+	case fieldVoteKeyDilution:
+		t.VoteKeyDilution = u()
+	case fieldType:
+		typ := types.TxType(str(10))
+		if _, ok := typeEnums[typ]; !ok && err == nil {
+			err = fmt.Errorf("%s is not a valid type for itxn_field", typ)
+		}
+		t.Type = typ
+	case fieldTypeEnum:
+		typ, ok := txTypes[u()]
+		if !ok && err == nil {
+			err = fmt.Errorf("%d is not a valid type enum for itxn_field", v.u)
+		}
+		t.Type = typ
+	case fieldXferAsset:
+		t.XferAsset = types.AssetIndex(e.resolveAsset(u()))
+	case fieldAssetAmount:
+		t.AssetAmount = u()
+	case fieldAssetSender:
+		addr(&t.AssetSender)
+	case fieldAssetReceiver:
+		addr(&t.AssetReceiver)
+	case fieldAssetCloseTo:
+		addr(&t.AssetCloseTo)
+	case fieldApplicationID:
+		t.ApplicationID = types.AppIndex(e.resolveApp(u()))
+	case fieldOnCompletion:
+		n := u()
+		if n > uint64(types.DeleteApplicationOC) && err == nil {
+			err = fmt.Errorf("%d is not a valid OnCompletion", n)
+		}
+		t.OnCompletion = types.OnCompletion(n)
+	case fieldApplicationArgs:
+		t.ApplicationArgs = append(t.ApplicationArgs, b(maxStringSize))
+	case fieldAccounts:
+		var a types.Address
+		addr(&a)
+		t.Accounts = append(t.Accounts, a)
+	case fieldApprovalProgram:
+		t.ApprovalProgram = b(maxAppProgramLen * (1 + maxExtraAppPages))
+	case fieldClearStateProgram:
+		t.ClearStateProgram = b(maxAppProgramLen * (1 + maxExtraAppPages))
+	case fieldRekeyTo:
+		addr(&t.RekeyTo)
+	case fieldConfigAsset:
+		t.ConfigAsset = types.AssetIndex(e.resolveAsset(u()))
+	case fieldConfigAssetTotal:
+		t.AssetParams.Total = u()
+	case fieldConfigAssetDecimals:
+		t.AssetParams.Decimals = uint32(u())
+	case fieldConfigAssetDefaultFrozen:
+		t.AssetParams.DefaultFrozen = bit()
+	case fieldConfigAssetUnitName:
+		t.AssetParams.UnitName = str(8)
+	case fieldConfigAssetName:
+		t.AssetParams.AssetName = str(32)
+	case fieldConfigAssetURL:
+		t.AssetParams.URL = str(96)
+	case fieldConfigAssetMetadataHash:
+		hash := b(32)
+		if len(hash) != 32 && err == nil {
+			err = fmt.Errorf("metadata hash must be 32 bytes, got %d", len(hash))
+		}
+		copy(t.AssetParams.MetadataHash[:], hash)
+	case fieldConfigAssetManager:
+		addr(&t.AssetParams.Manager)
+	case fieldConfigAssetReserve:
+		addr(&t.AssetParams.Reserve)
+	case fieldConfigAssetFreeze:
+		addr(&t.AssetParams.Freeze)
+	case fieldConfigAssetClawback:
+		addr(&t.AssetParams.Clawback)
+	case fieldFreezeAsset:
+		t.FreezeAsset = types.AssetIndex(e.resolveAsset(u()))
+	case fieldFreezeAssetAccount:
+		addr(&t.FreezeAccount)
+	case fieldFreezeAssetFrozen:
+		t.AssetFrozen = bit()
+	case fieldAssets:
+		t.ForeignAssets = append(t.ForeignAssets, types.AssetIndex(u()))
+	case fieldApplications:
+		t.ForeignApps = append(t.ForeignApps, types.AppIndex(u()))
+	case fieldGlobalNumUint:
+		schema(&t.GlobalStateSchema.NumUint)
+	case fieldGlobalNumByteSlice:
+		schema(&t.GlobalStateSchema.NumByteSlice)
+	case fieldLocalNumUint:
+		schema(&t.LocalStateSchema.NumUint)
+	case fieldLocalNumByteSlice:
+		schema(&t.LocalStateSchema.NumByteSlice)
+	case fieldExtraProgramPages:
+		n := u()
+		if n > maxExtraAppPages && err == nil {
+			err = fmt.Errorf("%d extra pages exceeds %d", n, maxExtraAppPages)
+		}
+		t.ExtraProgramPages = uint32(n)
+	case fieldNonparticipation:
+		t.Nonparticipation = bit()
+	case fieldApprovalProgramPages:
+		t.ApprovalProgram = append(t.ApprovalProgram, b(programPageSize)...)
+	case fieldClearStateProgramPages:
+		t.ClearStateProgram = append(t.ClearStateProgram, b(programPageSize)...)
+	default:
+		return fmt.Errorf("field %d cannot be set on inner transactions", field)
+	}
+	return err
+}
+
+func opItxnSubmit(e *evaluator) error {
+	if len(e.building) == 0 {
+		return errors.New("itxn_submit without itxn_begin")
+	}
+	group := e.building
+	e.building = nil
+	if e.depth+1 >= maxAppCallDepth {
+		return errors.New("appl depth exceeded")
+	}
+	e.c.innerCount += len(group)
+	if e.c.innerCount > maxInnerTxns {
+		return fmt.Errorf("too many inner transactions %d with 0 left", e.c.innerCount)
+	}
+
+	// Inner fees are pooled with the outer group: overpayment anywhere
+	// covers inner transactions that pay less than the minimum.
+	var paid uint64
+	for _, t := range group {
+		paid += uint64(t.Fee)
+	}
+	required := minTxnFee * uint64(len(group))
+	if paid+e.c.feeCredit < required {
+		return fmt.Errorf("fee too small %d < %d", paid+e.c.feeCredit, required)
+	}
+	e.c.feeCredit = e.c.feeCredit + paid - required
+
+	if len(group) > 1 {
+		gid, err := crypto.ComputeGroupID(group)
+		if err != nil {
+			return err
+		}
+		for i := range group {
+			group[i].Group = gid
+		}
+	}
+	results := make([]*txnResult, len(group))
+	for i := range group {
+		sender := group[i].Sender
+		if auth := e.c.l.authAddr(sender); auth != appAddress(e.appID) {
+			return fmt.Errorf("unauthorized inner transaction: sender %s is authorized by %s", sender, auth)
+		}
+		if err := e.c.apply(group, results, i, e.appID, e.depth+1); err != nil {
+			return fmt.Errorf("inner tx %d failed: %w", i, err)
+		}
+	}
+	e.inner = results
+	e.result.inner = append(e.result.inner, results...)
+	return nil
+}
+
+// innerField pushes a field of the ti'th transaction of the last inner
+// group.
+func (e *evaluator) innerField(ti uint64, field byte, idx uint64, indexed bool) error {
+	if len(e.inner) == 0 {
+		return errors.New("no inner transaction available")
+	}
+	if ti >= uint64(len(e.inner)) {
+		return fmt.Errorf("invalid inner transaction index %d: the last group has %d", ti, len(e.inner))
+	}
+	r := e.inner[ti]
+	v, err := e.txnField(&r.txn, r, int(ti), int(field), idx, indexed)
+	if err != nil {
+		return err
+	}
+	e.push(v)
+	return nil
+}
+
+func opItxn(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(len(e.inner)-1), f, 0, false)
+}
+
+func opItxna(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(len(e.inner)-1), f, uint64(i), true)
+}
+
+func opItxnas(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(len(e.inner)-1), f, i, true)
+}
+
+func opGitxn(e *evaluator) error {
+	ti, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(ti), f, 0, false)
+}
+
+func opGitxna(e *evaluator) error {
+	ti, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(ti), f, uint64(i), true)
+}
+
+func opGitxnas(e *evaluator) error {
+	ti, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.innerField(uint64(ti), f, i, true)
+}
@@ -0,0 +1,205 @@
+package memnet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/kmd"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// walletID is the ID of the default wallet, the only one memnet has.
+const walletID = "memnet-default-wallet"
+
+// handleExpirySeconds is how long KMD says a wallet handle lasts. memnet's
+// handles never expire.
+const handleExpirySeconds = 60
+
+var defaultWallet = kmd.APIV1Wallet{
+	ID:            walletID,
+	Name:          DefaultWallet,
+	DriverName:    "sqlite",
+	DriverVersion: 1,
+	SupportedTransactions: []types.TxType{
+		types.PaymentTx,
+		types.KeyRegistrationTx,
+		types.AssetConfigTx,
+		types.AssetTransferTx,
+		types.AssetFreezeTx,
+		types.ApplicationCallTx,
+	},
+}
+
+// kmdRoute serves pattern with h, which gets the decoded JSON request body.
+// Errors are answered in KMD's error envelope.
+func kmdRoute[Req any](mux *http.ServeMux, pattern string, h func(*Req) (interface{}, error)) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		body, err := io.ReadAll(r.Body)
+		if err == nil && len(body) > 0 {
+			err = json.Decode(body, &req)
+		}
+		var resp interface{}
+		if err == nil {
+			resp, err = h(&req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(json.Encode(kmd.APIV1ResponseEnvelope{Error: true, Message: err.Error()}))
+			return
+		}
+		w.Write(json.Encode(resp))
+	})
+}
+
+// kmdHandler returns the KMD REST API.
+func (s *Server) kmdHandler() http.Handler {
+	mux := http.NewServeMux()
+	kmdRoute(mux, "GET /versions", func(*kmd.VersionsRequest) (interface{}, error) {
+		return kmd.VersionsResponse{Versions: []string{"v1"}}, nil
+	})
+	kmdRoute(mux, "GET /v1/wallets", func(*kmd.ListWalletsRequest) (interface{}, error) {
+		return kmd.ListWalletsResponse{Wallets: []kmd.APIV1Wallet{defaultWallet}}, nil
+	})
+	kmdRoute(mux, "POST /v1/wallet/init", func(req *kmd.InitWalletHandleRequest) (interface{}, error) {
+		if req.WalletID != walletID {
+			return nil, fmt.Errorf("wallet %q not found", req.WalletID)
+		}
+		token := make([]byte, 32)
+		if _, err := rand.Read(token); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.handles[hex.EncodeToString(token)] = true
+		return kmd.InitWalletHandleResponse{WalletHandleToken: hex.EncodeToString(token)}, nil
+	})
+	kmdRoute(mux, "POST /v1/wallet/release", func(req *kmd.ReleaseWalletHandleRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.handles, req.WalletHandleToken)
+		return kmd.ReleaseWalletHandleResponse{}, nil
+	})
+	kmdRoute(mux, "POST /v1/wallet/renew", func(req *kmd.RenewWalletHandleRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		return kmd.RenewWalletHandleResponse{WalletHandle: walletHandle()}, nil
+	})
+	kmdRoute(mux, "POST /v1/wallet/info", func(req *kmd.GetWalletRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		return kmd.GetWalletResponse{WalletHandle: walletHandle()}, nil
+	})
+	kmdRoute(mux, "POST /v1/key/list", func(req *kmd.ListKeysRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		resp := kmd.ListKeysResponse{Addresses: []string{}}
+		for _, account := range s.wallet {
+			resp.Addresses = append(resp.Addresses, account.Address.String())
+		}
+		return resp, nil
+	})
+	kmdRoute(mux, "POST /v1/key/export", func(req *kmd.ExportKeyRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		addr, err := types.DecodeAddress(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		account, err := s.walletAccount(addr)
+		if err != nil {
+			return nil, err
+		}
+		return kmd.ExportKeyResponse{PrivateKey: account.PrivateKey}, nil
+	})
+	kmdRoute(mux, "POST /v1/key", func(req *kmd.GenerateKeyRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		account := crypto.GenerateAccount()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.wallet = append(s.wallet, account)
+		return kmd.GenerateKeyResponse{Address: account.Address.String()}, nil
+	})
+	kmdRoute(mux, "POST /v1/key/import", func(req *kmd.ImportKeyRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		account, err := crypto.AccountFromPrivateKey(req.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.walletAccount(account.Address); err == nil {
+			return nil, errors.New("key already exists in wallet")
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.wallet = append(s.wallet, account)
+		return kmd.ImportKeyResponse{Address: account.Address.String()}, nil
+	})
+	kmdRoute(mux, "POST /v1/transaction/sign", func(req *kmd.SignTransactionRequest) (interface{}, error) {
+		if err := s.checkHandle(req.WalletHandleToken); err != nil {
+			return nil, err
+		}
+		var txn types.Transaction
+		if err := msgpack.Decode(req.Transaction, &txn); err != nil {
+			return nil, fmt.Errorf("failed to decode the transaction: %w", err)
+		}
+		signer := txn.Sender
+		if len(req.PublicKey) > 0 {
+			copy(signer[:], req.PublicKey)
+		}
+		account, err := s.walletAccount(signer)
+		if err != nil {
+			return nil, err
+		}
+		_, stxn, err := crypto.SignTransaction(account.PrivateKey, txn)
+		if err != nil {
+			return nil, err
+		}
+		return kmd.SignTransactionResponse{SignedTransaction: stxn}, nil
+	})
+	return mux
+}
+
+func (s *Server) checkHandle(token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.handles[token] {
+		return errors.New("handle does not exist")
+	}
+	return nil
+}
+
+func (s *Server) walletAccount(addr types.Address) (crypto.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, account := range s.wallet {
+		if account.Address == addr {
+			return account, nil
+		}
+	}
+	return crypto.Account{}, fmt.Errorf("key %s does not exist in this wallet", addr)
+}
+
+func walletHandle() kmd.APIV1WalletHandle {
+	return kmd.APIV1WalletHandle{Wallet: defaultWallet, ExpiresSeconds: handleExpirySeconds}
+}
@@ -0,0 +1,249 @@
+package memnet
+
+import (
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Consensus parameters, as on LocalNet.
+const (
+	minTxnFee            = 1000
+	minBalance           = 100_000
+	assetMinBalance      = 100_000
+	appFlatMinBalance    = 100_000
+	appPageMinBalance    = 100_000
+	schemaEntryMinBal    = 25_000
+	schemaUintMinBal     = 3_500
+	schemaBytesMinBal    = 25_000
+	boxFlatMinBalance    = 2_500
+	boxByteMinBalance    = 400
+	maxTxnLife           = 1000
+	maxTxGroupSize       = 16
+	maxInnerTxns         = 256
+	maxAppCallDepth      = 8
+	maxAppProgramLen     = 2048
+	maxExtraAppPages     = 3
+	maxAppKeyLen         = 64
+	maxAppBytesValueLen  = 128
+	maxAppSumKeyValueLen = 128
+	maxBoxSize           = 32768
+	maxLogCalls          = 32
+	maxLogSize           = 1024
+	maxStringSize        = 4096
+	appCallBudget        = 700
+	firstAppOrAssetID    = 1001
+)
+
+// ledger is the in-memory state of the network.
+type ledger struct {
+	round     uint64
+	timestamp int64 // of the last block
+	offset    int64 // added to the wall clock for the next block
+	nextID    uint64
+	accounts  map[types.Address]*account
+	apps      map[uint64]*app
+	assets    map[uint64]*asset
+	leases    map[leaseKey]uint64 // last valid round of each lease
+}
+
+type leaseKey struct {
+	sender types.Address
+	lease  [32]byte
+}
+
+type account struct {
+	balance       uint64
+	authAddr      types.Address
+	holdings      map[uint64]*holding
+	locals        map[uint64]map[string]value // local state of opted-in apps
+	createdApps   map[uint64]bool
+	createdAssets map[uint64]bool
+	totalBoxes    uint64
+	totalBoxBytes uint64
+}
+
+type holding struct {
+	amount uint64
+	frozen bool
+}
+
+type app struct {
+	id           uint64
+	creator      types.Address
+	approval     []byte
+	clear        []byte
+	globalSchema types.StateSchema
+	localSchema  types.StateSchema
+	extraPages   uint32
+	global       map[string]value
+	boxes        map[string][]byte
+}
+
+type asset struct {
+	id      uint64
+	creator types.Address
+	params  types.AssetParams
+}
+
+func newLedger() *ledger {
+	return &ledger{
+		nextID:   firstAppOrAssetID,
+		accounts: make(map[types.Address]*account),
+		apps:     make(map[uint64]*app),
+		assets:   make(map[uint64]*asset),
+		leases:   make(map[leaseKey]uint64),
+	}
+}
+
+// clone returns a deep copy, so a failed group can be discarded.
+func (l *ledger) clone() *ledger {
+	c := &ledger{
+		round:     l.round,
+		timestamp: l.timestamp,
+		offset:    l.offset,
+		nextID:    l.nextID,
+		accounts:  make(map[types.Address]*account, len(l.accounts)),
+		apps:      make(map[uint64]*app, len(l.apps)),
+		assets:    make(map[uint64]*asset, len(l.assets)),
+		leases:    make(map[leaseKey]uint64, len(l.leases)),
+	}
+	for addr, a := range l.accounts {
+		c.accounts[addr] = a.clone()
+	}
+	for id, a := range l.apps {
+		c.apps[id] = a.clone()
+	}
+	for id, a := range l.assets {
+		cp := *a
+		c.assets[id] = &cp
+	}
+	for k, v := range l.leases {
+		c.leases[k] = v
+	}
+	return c
+}
+
+func (a *account) clone() *account {
+	c := *a
+	c.holdings = make(map[uint64]*holding, len(a.holdings))
+	for id, h := range a.holdings {
+		cp := *h
+		c.holdings[id] = &cp
+	}
+	c.locals = make(map[uint64]map[string]value, len(a.locals))
+	for id, kv := range a.locals {
+		c.locals[id] = cloneState(kv)
+	}
+	c.createdApps = cloneSet(a.createdApps)
+	c.createdAssets = cloneSet(a.createdAssets)
+	return &c
+}
+
+func (a *app) clone() *app {
+	c := *a
+	c.global = cloneState(a.global)
+	c.boxes = make(map[string][]byte, len(a.boxes))
+	for name, v := range a.boxes {
+		c.boxes[name] = append([]byte(nil), v...)
+	}
+	return &c
+}
+
+func cloneState(kv map[string]value) map[string]value {
+	c := make(map[string]value, len(kv))
+	for k, v := range kv {
+		c[k] = v.clone()
+	}
+	return c
+}
+
+func cloneSet(s map[uint64]bool) map[uint64]bool {
+	c := make(map[uint64]bool, len(s))
+	for k := range s {
+		c[k] = true
+	}
+	return c
+}
+
+// account returns the account at addr, creating an empty one if needed.
+func (l *ledger) account(addr types.Address) *account {
+	a, ok := l.accounts[addr]
+	if !ok {
+		a = &account{
+			holdings:      make(map[uint64]*holding),
+			locals:        make(map[uint64]map[string]value),
+			createdApps:   make(map[uint64]bool),
+			createdAssets: make(map[uint64]bool),
+		}
+		l.accounts[addr] = a
+	}
+	return a
+}
+
+// authAddr returns the address whose key authorizes transactions from addr.
+func (l *ledger) authAddr(addr types.Address) types.Address {
+	if a, ok := l.accounts[addr]; ok && !a.authAddr.IsZero() {
+		return a.authAddr
+	}
+	return addr
+}
+
+// minBalance returns the minimum balance addr must hold.
+func (l *ledger) minBalance(addr types.Address) uint64 {
+	a, ok := l.accounts[addr]
+	if !ok {
+		return minBalance
+	}
+	total := uint64(minBalance)
+	total += assetMinBalance * uint64(len(a.holdings))
+	for id := range a.locals {
+		if ap, ok := l.apps[id]; ok {
+			total += appFlatMinBalance + schemaMinBalance(ap.localSchema)
+		}
+	}
+	for id := range a.createdApps {
+		if ap, ok := l.apps[id]; ok {
+			total += appPageMinBalance*uint64(1+ap.extraPages) + schemaMinBalance(ap.globalSchema)
+		}
+	}
+	total += boxFlatMinBalance*a.totalBoxes + boxByteMinBalance*a.totalBoxBytes
+	return total
+}
+
+func schemaMinBalance(s types.StateSchema) uint64 {
+	return (schemaEntryMinBal+schemaUintMinBal)*s.NumUint + (schemaEntryMinBal+schemaBytesMinBal)*s.NumByteSlice
+}
+
+// totalSchema returns the state schema addr has allocated, from its created
+// apps and the apps it opted in to.
+func (l *ledger) totalSchema(addr types.Address) (schema types.StateSchema, extraPages uint64) {
+	a, ok := l.accounts[addr]
+	if !ok {
+		return
+	}
+	for id := range a.locals {
+		if ap, ok := l.apps[id]; ok {
+			schema.NumUint += ap.localSchema.NumUint
+			schema.NumByteSlice += ap.localSchema.NumByteSlice
+		}
+	}
+	for id := range a.createdApps {
+		if ap, ok := l.apps[id]; ok {
+			schema.NumUint += ap.globalSchema.NumUint
+			schema.NumByteSlice += ap.globalSchema.NumByteSlice
+			extraPages += uint64(ap.extraPages)
+		}
+	}
+	return
+}
+
+// newID returns the next app or asset ID.
+func (l *ledger) newID() uint64 {
+	id := l.nextID
+	l.nextID++
+	return id
+}
+
+func appAddress(id uint64) types.Address {
+	return crypto.GetApplicationAddress(id)
+}
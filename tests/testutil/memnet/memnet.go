@@ -0,0 +1,274 @@
+// Package memnet is an in-process stand-in for a LocalNet algod and KMD, for
+// running the integration tests without Docker.
+//
+// It serves the subset of the algod and KMD REST APIs that the SDK clients
+// and the generated clients use, and runs application programs with an AVM
+// interpreter over in-memory state. Every submitted group is confirmed
+// immediately in a block of its own, as on LocalNet in dev mode.
+//
+// memnet is not a full node. It does not check that the resources a program
+// touches are available to its transaction, does not accept logic
+// signatures, and cannot compile TEAL: /v2/teal/compile only answers for
+// sources registered with RegisterProgram. Opcodes it does not implement,
+// such as the elliptic curve ones, fail the program with an error that says
+// so.
+package memnet
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// The network memnet pretends to be.
+const (
+	genesisID        = "dockernet-v1"
+	consensusVersion = "future"
+)
+
+var genesisHash = types.Digest(sha512.Sum512_256([]byte("memnet")))
+
+// DefaultWallet is the name of the KMD wallet holding the funded accounts,
+// as on LocalNet.
+const DefaultWallet = "unencrypted-default-wallet"
+
+// defaultAccounts is the number of funded accounts in the default wallet.
+const defaultAccounts = 3
+
+// defaultBalance is the balance of each funded account.
+const defaultBalance = 1_000_000_000_000_000
+
+// Server is an in-memory algod and KMD.
+type Server struct {
+	mu       sync.Mutex
+	l        *ledger
+	txns     map[string]*confirmedTxn // by transaction ID
+	programs map[string][]byte        // compiled programs, by TEAL source
+	wallet   []crypto.Account
+	handles  map[string]bool
+	newBlock chan struct{} // closed and replaced when a block is added
+
+	algod, kmd *http.Server
+}
+
+// confirmedTxn is a transaction in a block.
+type confirmedTxn struct {
+	stxn   types.SignedTxn
+	result *txnResult
+	round  uint64
+}
+
+// New returns a Server whose default wallet holds funded accounts. The
+// accounts are derived from fixed seeds, so they are the same in every run.
+func New() *Server {
+	s := &Server{
+		l:        newLedger(),
+		txns:     make(map[string]*confirmedTxn),
+		programs: make(map[string][]byte),
+		handles:  make(map[string]bool),
+		newBlock: make(chan struct{}),
+	}
+	for i := 0; i < defaultAccounts; i++ {
+		seed := sha512.Sum512_256([]byte(fmt.Sprintf("memnet account %d", i)))
+		account, err := crypto.AccountFromPrivateKey(ed25519.NewKeyFromSeed(seed[:]))
+		if err != nil {
+			panic(err)
+		}
+		s.wallet = append(s.wallet, account)
+		s.l.account(account.Address).balance = defaultBalance
+	}
+	s.l.timestamp = time.Now().Unix()
+	return s
+}
+
+// RegisterProgram makes /v2/teal/compile answer source with program.
+func (s *Server) RegisterProgram(source string, program []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.programs[source] = program
+}
+
+// Start serves algod on algodAddr and KMD on kmdAddr, such as
+// "localhost:4001" and "localhost:4002".
+func (s *Server) Start(algodAddr, kmdAddr string) error {
+	algodLn, err := net.Listen("tcp", algodAddr)
+	if err != nil {
+		return fmt.Errorf("memnet algod: %w", err)
+	}
+	kmdLn, err := net.Listen("tcp", kmdAddr)
+	if err != nil {
+		algodLn.Close()
+		return fmt.Errorf("memnet kmd: %w", err)
+	}
+	s.algod = &http.Server{Handler: s.algodHandler()}
+	s.kmd = &http.Server{Handler: s.kmdHandler()}
+	go s.algod.Serve(algodLn)
+	go s.kmd.Serve(kmdLn)
+	return nil
+}
+
+// Transport returns a RoundTripper that serves requests to algodHost and
+// kmdHost, such as "localhost:4001" and "localhost:4002", in-process and
+// passes any other request to base. Installed as http.DefaultTransport, it
+// lets clients configured for LocalNet reach memnet without memnet listening
+// on LocalNet's ports.
+func (s *Server) Transport(algodHost, kmdHost string, base http.RoundTripper) http.RoundTripper {
+	return &transport{
+		hosts: map[string]http.Handler{algodHost: s.algodHandler(), kmdHost: s.kmdHandler()},
+		base:  base,
+	}
+}
+
+// transport serves requests for its hosts with their handlers.
+type transport struct {
+	hosts map[string]http.Handler
+	base  http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	handler, ok := t.hosts[req.URL.Host]
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	if req.Body == nil {
+		req = req.Clone(req.Context())
+		req.Body = http.NoBody
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	resp := rec.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// Close stops the servers started by Start.
+func (s *Server) Close() error {
+	var errs []error
+	for _, srv := range []*http.Server{s.algod, s.kmd} {
+		if srv != nil {
+			errs = append(errs, srv.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// groupOptions adjust how a group is evaluated, for simulate.
+type groupOptions struct {
+	allowEmptySignatures bool
+	extraBudget          int
+	dryRun               bool
+}
+
+// groupError is the failure of the transaction at index in a group.
+type groupError struct {
+	index int
+	txID  string
+	err   error
+}
+
+func (e *groupError) Error() string {
+	return fmt.Sprintf("transaction %s: %v", e.txID, e.err)
+}
+
+func (e *groupError) Unwrap() error { return e.err }
+
+// submit evaluates a group and, unless opts.dryRun is set, adds it to the
+// ledger in a new block. It returns the result of each transaction and the
+// opcode budget consumed.
+func (s *Server) submit(stxns []types.SignedTxn, opts groupOptions) ([]*txnResult, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txns := make([]types.Transaction, len(stxns))
+	appCalls := 0
+	for i, stxn := range stxns {
+		txns[i] = stxn.Txn
+		if stxn.Txn.Type == types.ApplicationCallTx {
+			appCalls++
+		}
+	}
+	credit, err := s.l.checkGroup(txns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c := &groupContext{
+		l:         s.l.clone(),
+		budget:    appCallBudget*appCalls + opts.extraBudget,
+		feeCredit: credit,
+	}
+	initialBudget := c.budget
+	c.l.round++
+	results := make([]*txnResult, len(txns))
+	for i, stxn := range stxns {
+		txID := crypto.GetTxID(stxn.Txn)
+		fail := func(err error) ([]*txnResult, int, error) {
+			return results, initialBudget - c.budget, &groupError{index: i, txID: txID, err: err}
+		}
+		if _, ok := s.txns[txID]; ok {
+			return fail(fmt.Errorf("transaction already in ledger: %s", txID))
+		}
+		if c.l.leaseConflict(stxn.Txn) {
+			return fail(errors.New("using an overlapping lease"))
+		}
+		if err := c.l.verifySignature(stxn, opts.allowEmptySignatures); err != nil {
+			return fail(err)
+		}
+		if err := c.apply(txns, results, i, 0, 0); err != nil {
+			return fail(err)
+		}
+		if err := c.l.checkMinBalances(); err != nil {
+			return fail(err)
+		}
+		if t := stxn.Txn; t.Lease != ([32]byte{}) {
+			c.l.leases[leaseKey{t.Sender, t.Lease}] = uint64(t.LastValid)
+		}
+	}
+	consumed := initialBudget - c.budget
+	if opts.dryRun {
+		return results, consumed, nil
+	}
+
+	ts := time.Now().Unix() + c.l.offset
+	if ts > c.l.timestamp {
+		c.l.timestamp = ts
+	}
+	s.l = c.l
+	for i, stxn := range stxns {
+		s.txns[crypto.GetTxID(stxn.Txn)] = &confirmedTxn{stxn: stxn, result: results[i], round: s.l.round}
+	}
+	close(s.newBlock)
+	s.newBlock = make(chan struct{})
+	return results, consumed, nil
+}
+
+// waitForBlockAfter returns once the ledger is past round or the timeout
+// passes.
+func (s *Server) waitForBlockAfter(round uint64, timeout time.Duration) {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		current, next := s.l.round, s.newBlock
+		s.mu.Unlock()
+		if current > round {
+			return
+		}
+		select {
+		case <-next:
+		case <-deadline:
+			return
+		}
+	}
+}
@@ -0,0 +1,498 @@
+package memnet
+
+import (
+	"bytes"
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/abi"
+	"github.com/algorand/go-algorand-sdk/v2/client/kmd"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const testToken = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+type testNet struct {
+	t         *testing.T
+	ctx       context.Context
+	algod     *algod.Client
+	kmd       kmd.Client
+	dispenser crypto.Account
+}
+
+// newTestNet serves a fresh Server over httptest and returns clients for it
+// and the first account of the default wallet, found through KMD as the
+// fixture does.
+func newTestNet(t *testing.T) *testNet {
+	t.Helper()
+	s := New()
+	algodSrv := httptest.NewServer(s.algodHandler())
+	kmdSrv := httptest.NewServer(s.kmdHandler())
+	t.Cleanup(algodSrv.Close)
+	t.Cleanup(kmdSrv.Close)
+
+	algodClient, err := algod.MakeClient(algodSrv.URL, testToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kmdClient, err := kmd.MakeClient(kmdSrv.URL, testToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallets, err := kmdClient.ListWallets()
+	if err != nil {
+		t.Fatalf("ListWallets: %v", err)
+	}
+	if len(wallets.Wallets) != 1 || wallets.Wallets[0].Name != DefaultWallet {
+		t.Fatalf("wallets = %+v, want only %s", wallets.Wallets, DefaultWallet)
+	}
+	handle, err := kmdClient.InitWalletHandle(wallets.Wallets[0].ID, "")
+	if err != nil {
+		t.Fatalf("InitWalletHandle: %v", err)
+	}
+	keys, err := kmdClient.ListKeys(handle.WalletHandleToken)
+	if err != nil {
+		t.Fatalf("ListKeys: %v", err)
+	}
+	if len(keys.Addresses) != defaultAccounts {
+		t.Fatalf("got %d keys, want %d", len(keys.Addresses), defaultAccounts)
+	}
+	key, err := kmdClient.ExportKey(handle.WalletHandleToken, "", keys.Addresses[0])
+	if err != nil {
+		t.Fatalf("ExportKey: %v", err)
+	}
+	dispenser, err := crypto.AccountFromPrivateKey(key.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testNet{t: t, ctx: context.Background(), algod: algodClient, kmd: kmdClient, dispenser: dispenser}
+}
+
+func (n *testNet) params() types.SuggestedParams {
+	n.t.Helper()
+	sp, err := n.algod.SuggestedParams().Do(n.ctx)
+	if err != nil {
+		n.t.Fatalf("SuggestedParams: %v", err)
+	}
+	return sp
+}
+
+// send signs txn with the dispenser and waits for it to be confirmed.
+func (n *testNet) send(txn types.Transaction) (string, error) {
+	n.t.Helper()
+	txID, stxn, err := crypto.SignTransaction(n.dispenser.PrivateKey, txn)
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	if _, err := n.algod.SendRawTransaction(stxn).Do(n.ctx); err != nil {
+		return "", err
+	}
+	if _, err := transaction.WaitForConfirmation(n.algod, txID, 1, n.ctx); err != nil {
+		n.t.Fatalf("WaitForConfirmation: %v", err)
+	}
+	return txID, nil
+}
+
+func (n *testNet) pay(receiver types.Address, amount uint64) {
+	n.t.Helper()
+	txn, err := transaction.MakePaymentTxn(n.dispenser.Address.String(), receiver.String(), amount, nil, "", n.params())
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	if _, err := n.send(txn); err != nil {
+		n.t.Fatalf("payment: %v", err)
+	}
+}
+
+// payment returns a payment from the dispenser for use as a method argument.
+func (n *testNet) payment(receiver types.Address, amount uint64) transaction.TransactionWithSigner {
+	n.t.Helper()
+	txn, err := transaction.MakePaymentTxn(n.dispenser.Address.String(), receiver.String(), amount, nil, "", n.params())
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	return transaction.TransactionWithSigner{Txn: txn, Signer: transaction.BasicAccountTransactionSigner{Account: n.dispenser}}
+}
+
+// appSpec is the part of an ARC-56 app spec needed to create the app.
+type appSpec struct {
+	approval, clear []byte
+	global, local   types.StateSchema
+	extraPages      uint32
+}
+
+// readSpec reads testdata/<spec>.
+func (n *testNet) readSpec(spec string) appSpec {
+	n.t.Helper()
+	raw, err := os.ReadFile(filepath.Join("..", "..", "..", "testdata", spec))
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	var s struct {
+		ByteCode struct {
+			Approval, Clear []byte
+		} `json:"byteCode"`
+		State struct {
+			Schema struct {
+				Global, Local struct{ Ints, Bytes uint64 }
+			} `json:"schema"`
+		} `json:"state"`
+	}
+	if err := stdjson.Unmarshal(raw, &s); err != nil {
+		n.t.Fatal(err)
+	}
+	schema := s.State.Schema
+	var extraPages uint32
+	if size := len(s.ByteCode.Approval) + len(s.ByteCode.Clear); size > 2048 {
+		extraPages = uint32((size - 1) / 2048)
+	}
+	return appSpec{
+		extraPages: extraPages,
+		approval:   s.ByteCode.Approval,
+		clear:      s.ByteCode.Clear,
+		global:     types.StateSchema{NumUint: schema.Global.Ints, NumByteSlice: schema.Global.Bytes},
+		local:      types.StateSchema{NumUint: schema.Local.Ints, NumByteSlice: schema.Local.Bytes},
+	}
+}
+
+// deploy creates the app in testdata/<spec> from its byteCode with a bare
+// call.
+func (n *testNet) deploy(spec string) uint64 {
+	n.t.Helper()
+	s := n.readSpec(spec)
+	txn, err := transaction.MakeApplicationCreateTxWithExtraPages(false, s.approval, s.clear, s.global, s.local,
+		nil, nil, nil, nil, n.params(), n.dispenser.Address, nil, types.Digest{}, [32]byte{}, types.ZeroAddress, s.extraPages)
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	txID, err := n.send(txn)
+	if err != nil {
+		n.t.Fatalf("create %s: %v", spec, err)
+	}
+	info, _, err := n.algod.PendingTransactionInformation(txID).Do(n.ctx)
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	if info.ApplicationIndex == 0 {
+		n.t.Fatalf("create %s: no application index", spec)
+	}
+	return info.ApplicationIndex
+}
+
+// methodCall returns the parameters for calling an ABI method of appID.
+func (n *testNet) methodCall(appID uint64, signature string, fee uint64, args ...interface{}) transaction.AddMethodCallParams {
+	n.t.Helper()
+	method, err := abi.MethodFromSignature(signature)
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	sp := n.params()
+	if fee > 0 {
+		sp.FlatFee = true
+		sp.Fee = types.MicroAlgos(fee)
+	}
+	return transaction.AddMethodCallParams{
+		AppID:           appID,
+		Method:          method,
+		MethodArgs:      args,
+		Sender:          n.dispenser.Address,
+		SuggestedParams: sp,
+		Signer:          transaction.BasicAccountTransactionSigner{Account: n.dispenser},
+	}
+}
+
+// execute sends the calls as one group and returns their return values.
+func (n *testNet) execute(calls ...transaction.AddMethodCallParams) ([]interface{}, error) {
+	n.t.Helper()
+	var atc transaction.AtomicTransactionComposer
+	for _, c := range calls {
+		if err := atc.AddMethodCall(c); err != nil {
+			n.t.Fatal(err)
+		}
+	}
+	result, err := atc.Execute(n.algod, n.ctx, 2)
+	if err != nil {
+		return nil, err
+	}
+	var values []interface{}
+	for _, r := range result.MethodResults {
+		values = append(values, r.ReturnValue)
+	}
+	return values, nil
+}
+
+// call calls an ABI method of appID and returns its return value.
+func (n *testNet) call(appID uint64, signature string, fee uint64, args ...interface{}) (interface{}, error) {
+	n.t.Helper()
+	values, err := n.execute(n.methodCall(appID, signature, fee, args...))
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// roundTripFunc is an http.RoundTripper made from a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport(t *testing.T) {
+	errOther := errors.New("not memnet")
+	base := roundTripFunc(func(*http.Request) (*http.Response, error) { return nil, errOther })
+	tr := New().Transport("localhost:4001", "localhost:4002", base)
+
+	algodClient, err := algod.MakeClientWithTransport("http://localhost:4001", testToken, nil, tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp, err := algodClient.SuggestedParams().Do(context.Background())
+	if err != nil {
+		t.Fatalf("SuggestedParams: %v", err)
+	}
+	if sp.GenesisID != genesisID {
+		t.Errorf("genesis ID = %q, want %q", sp.GenesisID, genesisID)
+	}
+
+	client := &http.Client{Transport: tr}
+	resp, err := client.Get("http://localhost:4002/versions")
+	if err != nil {
+		t.Fatalf("kmd versions: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("kmd versions: %s", resp.Status)
+	}
+
+	// Other hosts go to the base transport
+	if _, err := client.Get("http://localhost:4003/health"); !errors.Is(err, errOther) {
+		t.Errorf("request to another host: err = %v, want the base transport's error", err)
+	}
+}
+
+func TestKMDSignedPayment(t *testing.T) {
+	n := newTestNet(t)
+	handle, err := n.kmd.InitWalletHandle(walletID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver := crypto.GenerateAccount().Address
+	txn, err := transaction.MakePaymentTxn(n.dispenser.Address.String(), receiver.String(), 1_000_000, nil, "", n.params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := n.kmd.SignTransaction(handle.WalletHandleToken, "", txn)
+	if err != nil {
+		t.Fatalf("SignTransaction: %v", err)
+	}
+	txID, err := n.algod.SendRawTransaction(signed.SignedTransaction).Do(n.ctx)
+	if err != nil {
+		t.Fatalf("SendRawTransaction: %v", err)
+	}
+	if _, err := transaction.WaitForConfirmation(n.algod, txID, 1, n.ctx); err != nil {
+		t.Fatalf("WaitForConfirmation: %v", err)
+	}
+	info, err := n.algod.AccountInformation(receiver.String()).Do(n.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Amount != 1_000_000 {
+		t.Errorf("receiver balance = %d, want 1000000", info.Amount)
+	}
+
+	// Resending is rejected, as by algod.
+	if _, err := n.algod.SendRawTransaction(signed.SignedTransaction).Do(n.ctx); err == nil {
+		t.Error("resending the payment succeeded")
+	}
+	// A payment that leaves a new account below the minimum balance is
+	// rejected.
+	txn, err = transaction.MakePaymentTxn(n.dispenser.Address.String(), crypto.GenerateAccount().Address.String(), 1, nil, "", n.params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.send(txn); err == nil || !strings.Contains(err.Error(), "below min") {
+		t.Errorf("payment below the minimum balance: err = %v", err)
+	}
+}
+
+func TestStateDecodingApp(t *testing.T) {
+	n := newTestNet(t)
+	appID := n.deploy("StateDecoding.arc56.json")
+	n.pay(crypto.GetApplicationAddress(appID), 20_000_000)
+
+	got, err := n.call(appID, "retObject()(uint64,uint64)", 0)
+	if err != nil {
+		t.Fatalf("retObject: %v", err)
+	}
+	if want := []interface{}{uint64(1), uint64(2)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("retObject() = %v, want %v", got, want)
+	}
+
+	got, err = n.call(appID, "checkObjectAssignment(uint64,uint64)(uint64,uint64)", 0, uint64(3), uint64(4))
+	if err != nil {
+		t.Fatalf("checkObjectAssignment: %v", err)
+	}
+	if want := []interface{}{uint64(3), uint64(4)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("checkObjectAssignment(3, 4) = %v, want %v", got, want)
+	}
+	app, err := n.algod.GetApplicationByID(appID).Do(n.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var check []byte
+	for _, kv := range app.Params.GlobalState {
+		if kv.Key == "Y2hlY2s=" { // "check"
+			check = []byte(kv.Value.Bytes)
+		}
+	}
+	if string(check) != "AAAAAAAAAAMAAAAAAAAABA==" {
+		t.Errorf("global check = %q, want the encoded (3, 4)", check)
+	}
+
+	if _, err := n.call(appID, "init()void", 0); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	box, err := n.algod.GetApplicationBoxByName(appID, []byte("c\x00")).Do(n.ctx)
+	if err != nil {
+		t.Fatalf("GetApplicationBoxByName: %v", err)
+	}
+	if !bytes.Equal(box.Value, make([]byte, 4096)) {
+		t.Errorf("box c0 has %d bytes, want 4096 zero bytes", len(box.Value))
+	}
+	boxes, err := n.algod.GetApplicationBoxes(appID).Do(n.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boxes.Boxes) != 2 {
+		t.Errorf("got %d boxes, want 2", len(boxes.Boxes))
+	}
+	got, err = n.call(appID, "getBox(uint64)byte[]", 0, uint64(4000))
+	if err != nil {
+		t.Fatalf("getBox: %v", err)
+	}
+	if b, ok := got.([]interface{}); !ok || len(b) != 96 {
+		t.Errorf("getBox(4000) = %v, want the last 96 bytes of the box", got)
+	}
+	// The whole box and its ABI return prefix exceed the AVM's 4096 byte
+	// limit.
+	if _, err := n.call(appID, "getBox(uint64)byte[]", 0, uint64(0)); err == nil || !strings.Contains(err.Error(), "too big") {
+		t.Errorf("getBox(0): err = %v, want a too big byte-array", err)
+	}
+
+	// bigLoop raises its budget with inner app calls paid from the outer fee.
+	got, err = n.call(appID, "bigLoop()uint64", 200_000)
+	if err != nil {
+		t.Fatalf("bigLoop: %v", err)
+	}
+	// The compiled loop returns its counter.
+	if got != uint64(4096) {
+		t.Errorf("bigLoop() = %v, want 4096", got)
+	}
+	if _, err := n.call(appID, "bigLoop()uint64", 0); err == nil || !strings.Contains(err.Error(), "fee too small") {
+		t.Errorf("bigLoop() without fees for its inner calls: err = %v, want fee too small", err)
+	}
+}
+
+func TestApplicationEqualityGroup(t *testing.T) {
+	n := newTestNet(t)
+	appID := n.deploy("ApplicationEquality.arc56.json")
+
+	// appEquals checks the app called by the previous transaction in its
+	// group.
+	_, err := n.execute(
+		n.methodCall(appID, "doNothing()void", 0),
+		n.methodCall(appID, "appEquals(uint64)void", 0, appID),
+	)
+	if err != nil {
+		t.Fatalf("doNothing, appEquals(own id): %v", err)
+	}
+	_, err = n.execute(
+		n.methodCall(appID, "doNothing()void", 0),
+		n.methodCall(appID, "appEquals(uint64)void", 0, appID+1),
+	)
+	if err == nil || !strings.Contains(err.Error(), "logic eval error") {
+		t.Errorf("doNothing, appEquals(other id): err = %v, want a logic eval error", err)
+	}
+	if _, err := n.call(appID, "appEquals(uint64)void", 0, appID); err == nil {
+		t.Error("appEquals outside a group succeeded")
+	}
+}
+
+// The Akita contracts are the largest programs the integration tests run
+// against memnet.
+func TestAkitaContracts(t *testing.T) {
+	n := newTestNet(t)
+	for _, spec := range []string{
+		"akita/AkitaDAOTypes.arc56.json",
+		"akita/AsaMintPlugin.arc56.json",
+		"akita/EscrowFactory.arc56.json",
+		"akita/OptInPlugin.arc56.json",
+		"akita/PayPlugin.arc56.json",
+	} {
+		if appID := n.deploy(spec); appID == 0 {
+			t.Errorf("%s: no app ID", spec)
+		}
+	}
+
+	s := n.readSpec("akita/MetaMerkles.arc56.json")
+	create := n.methodCall(0, "create()void", 0)
+	create.ApprovalProgram, create.ClearProgram = s.approval, s.clear
+	create.GlobalSchema, create.LocalSchema = s.global, s.local
+	create.ExtraPages = s.extraPages
+	var atc transaction.AtomicTransactionComposer
+	if err := atc.AddMethodCall(create); err != nil {
+		t.Fatal(err)
+	}
+	result, err := atc.Execute(n.algod, n.ctx, 2)
+	if err != nil {
+		t.Fatalf("MetaMerkles create: %v", err)
+	}
+	info, _, err := n.algod.PendingTransactionInformation(result.TxIDs[0]).Do(n.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appID := info.ApplicationIndex
+	n.pay(crypto.GetApplicationAddress(appID), 1_000_000)
+
+	cost, err := n.call(appID, "rootCosts(string)uint64", 0, "root")
+	if err != nil {
+		t.Fatalf("rootCosts: %v", err)
+	}
+	mbr, ok := cost.(uint64)
+	if !ok || mbr == 0 {
+		t.Fatalf("rootCosts(root) = %v, want a box cost", cost)
+	}
+	// addRoot checks that the root's type exists, and addType costs 100 ALGO.
+	addType := n.methodCall(appID, "addType(pay,string,uint8[])void", 0,
+		n.payment(crypto.GetApplicationAddress(appID), 100_000_000), "type", []uint8{13})
+	if _, err := n.execute(addType); err != nil {
+		t.Fatalf("addType: %v", err)
+	}
+	var root [32]byte
+	root[0] = 1
+	addRoot := n.methodCall(appID, "addRoot(pay,string,byte[32],uint64)void", 0,
+		n.payment(crypto.GetApplicationAddress(appID), mbr), "root", root, uint64(0))
+	if _, err := n.execute(addRoot); err != nil {
+		t.Fatalf("addRoot: %v", err)
+	}
+	boxes, err := n.algod.GetApplicationBoxes(appID).Do(n.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boxes.Boxes) == 0 {
+		t.Error("addRoot stored no box")
+	}
+}
@@ -0,0 +1,1586 @@
+package memnet
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// opSpec describes one opcode.
+type opSpec struct {
+	name    string
+	version uint64 // the version that introduced it
+	cost    int
+	fn      func(*evaluator) error
+}
+
+// opTable is indexed by opcode. It is filled in by init, since its
+// functions refer back to it for error messages.
+var opTable [256]opSpec
+
+func init() {
+	ops := []struct {
+		code byte
+		spec opSpec
+	}{
+		{0x00, opSpec{"err", 1, 1, opErr}},
+		{0x01, opSpec{"sha256", 1, 35, opHash(func(b []byte) []byte { h := sha256.Sum256(b); return h[:] })}},
+		{0x02, unsupported("keccak256", 1)},
+		{0x03, opSpec{"sha512_256", 1, 45, opHash(func(b []byte) []byte { h := sha512.Sum512_256(b); return h[:] })}},
+		{0x04, opSpec{"ed25519verify", 1, 1900, opEd25519Verify}},
+		{0x05, unsupported("ecdsa_verify", 5)},
+		{0x06, unsupported("ecdsa_pk_decompress", 5)},
+		{0x07, unsupported("ecdsa_pk_recover", 5)},
+		{0x08, opSpec{"+", 1, 1, opArith(func(a, b uint64) (uint64, error) {
+			sum, carry := bits.Add64(a, b, 0)
+			if carry != 0 {
+				return 0, errors.New("+ overflowed")
+			}
+			return sum, nil
+		})}},
+		{0x09, opSpec{"-", 1, 1, opArith(func(a, b uint64) (uint64, error) {
+			if b > a {
+				return 0, errors.New("- would result negative")
+			}
+			return a - b, nil
+		})}},
+		{0x0a, opSpec{"/", 1, 1, opArith(func(a, b uint64) (uint64, error) {
+			if b == 0 {
+				return 0, errors.New("/ 0")
+			}
+			return a / b, nil
+		})}},
+		{0x0b, opSpec{"*", 1, 1, opArith(func(a, b uint64) (uint64, error) {
+			hi, lo := bits.Mul64(a, b)
+			if hi != 0 {
+				return 0, errors.New("* overflowed")
+			}
+			return lo, nil
+		})}},
+		{0x0c, opSpec{"<", 1, 1, opCompare(func(a, b uint64) bool { return a < b })}},
+		{0x0d, opSpec{">", 1, 1, opCompare(func(a, b uint64) bool { return a > b })}},
+		{0x0e, opSpec{"<=", 1, 1, opCompare(func(a, b uint64) bool { return a <= b })}},
+		{0x0f, opSpec{">=", 1, 1, opCompare(func(a, b uint64) bool { return a >= b })}},
+		{0x10, opSpec{"&&", 1, 1, opCompare(func(a, b uint64) bool { return a != 0 && b != 0 })}},
+		{0x11, opSpec{"||", 1, 1, opCompare(func(a, b uint64) bool { return a != 0 || b != 0 })}},
+		{0x12, opSpec{"==", 1, 1, opEquals(false)}},
+		{0x13, opSpec{"!=", 1, 1, opEquals(true)}},
+		{0x14, opSpec{"!", 1, 1, opNot}},
+		{0x15, opSpec{"len", 1, 1, opLen}},
+		{0x16, opSpec{"itob", 1, 1, opItob}},
+		{0x17, opSpec{"btoi", 1, 1, opBtoi}},
+		{0x18, opSpec{"%", 1, 1, opArith(func(a, b uint64) (uint64, error) {
+			if b == 0 {
+				return 0, errors.New("% 0")
+			}
+			return a % b, nil
+		})}},
+		{0x19, opSpec{"|", 1, 1, opArith(func(a, b uint64) (uint64, error) { return a | b, nil })}},
+		{0x1a, opSpec{"&", 1, 1, opArith(func(a, b uint64) (uint64, error) { return a & b, nil })}},
+		{0x1b, opSpec{"^", 1, 1, opArith(func(a, b uint64) (uint64, error) { return a ^ b, nil })}},
+		{0x1c, opSpec{"~", 1, 1, opBitNot}},
+		{0x1d, opSpec{"mulw", 1, 1, opMulw}},
+		{0x1e, opSpec{"addw", 2, 1, opAddw}},
+		{0x1f, opSpec{"divmodw", 4, 20, opDivmodw}},
+		{0x20, opSpec{"intcblock", 1, 1, opIntcblock}},
+		{0x21, opSpec{"intc", 1, 1, opIntc}},
+		{0x22, opSpec{"intc_0", 1, 1, opIntcN(0)}},
+		{0x23, opSpec{"intc_1", 1, 1, opIntcN(1)}},
+		{0x24, opSpec{"intc_2", 1, 1, opIntcN(2)}},
+		{0x25, opSpec{"intc_3", 1, 1, opIntcN(3)}},
+		{0x26, opSpec{"bytecblock", 1, 1, opBytecblock}},
+		{0x27, opSpec{"bytec", 1, 1, opBytec}},
+		{0x28, opSpec{"bytec_0", 1, 1, opBytecN(0)}},
+		{0x29, opSpec{"bytec_1", 1, 1, opBytecN(1)}},
+		{0x2a, opSpec{"bytec_2", 1, 1, opBytecN(2)}},
+		{0x2b, opSpec{"bytec_3", 1, 1, opBytecN(3)}},
+		{0x2c, unsupported("arg", 1)},
+		{0x2d, unsupported("arg_0", 1)},
+		{0x2e, unsupported("arg_1", 1)},
+		{0x2f, unsupported("arg_2", 1)},
+		{0x30, unsupported("arg_3", 1)},
+		{0x31, opSpec{"txn", 1, 1, opTxn}},
+		{0x32, opSpec{"global", 1, 1, opGlobal}},
+		{0x33, opSpec{"gtxn", 1, 1, opGtxn}},
+		{0x34, opSpec{"load", 1, 1, opLoad}},
+		{0x35, opSpec{"store", 1, 1, opStore}},
+		{0x36, opSpec{"txna", 2, 1, opTxna}},
+		{0x37, opSpec{"gtxna", 2, 1, opGtxna}},
+		{0x38, opSpec{"gtxns", 3, 1, opGtxns}},
+		{0x39, opSpec{"gtxnsa", 3, 1, opGtxnsa}},
+		{0x3a, opSpec{"gload", 4, 1, opGload}},
+		{0x3b, opSpec{"gloads", 4, 1, opGloads}},
+		{0x3c, opSpec{"gaid", 4, 1, opGaid}},
+		{0x3d, opSpec{"gaids", 4, 1, opGaids}},
+		{0x3e, opSpec{"loads", 5, 1, opLoads}},
+		{0x3f, opSpec{"stores", 5, 1, opStores}},
+		{0x40, opSpec{"bnz", 1, 1, opBranchIf(true)}},
+		{0x41, opSpec{"bz", 2, 1, opBranchIf(false)}},
+		{0x42, opSpec{"b", 2, 1, opB}},
+		{0x43, opSpec{"return", 2, 1, opReturn}},
+		{0x44, opSpec{"assert", 3, 1, opAssert}},
+		{0x45, opSpec{"bury", 8, 1, opBury}},
+		{0x46, opSpec{"popn", 8, 1, opPopn}},
+		{0x47, opSpec{"dupn", 8, 1, opDupn}},
+		{0x48, opSpec{"pop", 1, 1, opPop}},
+		{0x49, opSpec{"dup", 1, 1, opDup}},
+		{0x4a, opSpec{"dup2", 2, 1, opDup2}},
+		{0x4b, opSpec{"dig", 3, 1, opDig}},
+		{0x4c, opSpec{"swap", 3, 1, opSwap}},
+		{0x4d, opSpec{"select", 3, 1, opSelect}},
+		{0x4e, opSpec{"cover", 5, 1, opCover}},
+		{0x4f, opSpec{"uncover", 5, 1, opUncover}},
+		{0x50, opSpec{"concat", 2, 1, opConcat}},
+		{0x51, opSpec{"substring", 2, 1, opSubstring}},
+		{0x52, opSpec{"substring3", 2, 1, opSubstring3}},
+		{0x53, opSpec{"getbit", 3, 1, opGetbit}},
+		{0x54, opSpec{"setbit", 3, 1, opSetbit}},
+		{0x55, opSpec{"getbyte", 3, 1, opGetbyte}},
+		{0x56, opSpec{"setbyte", 3, 1, opSetbyte}},
+		{0x57, opSpec{"extract", 5, 1, opExtract}},
+		{0x58, opSpec{"extract3", 5, 1, opExtract3}},
+		{0x59, opSpec{"extract_uint16", 5, 1, opExtractUint(2)}},
+		{0x5a, opSpec{"extract_uint32", 5, 1, opExtractUint(4)}},
+		{0x5b, opSpec{"extract_uint64", 5, 1, opExtractUint(8)}},
+		{0x5c, opSpec{"replace2", 7, 1, opReplace2}},
+		{0x5d, opSpec{"replace3", 7, 1, opReplace3}},
+		{0x5e, opSpec{"base64_decode", 7, 1, opBase64Decode}},
+		{0x5f, unsupported("json_ref", 7)},
+		{0x60, opSpec{"balance", 2, 1, opBalance}},
+		{0x61, opSpec{"app_opted_in", 2, 1, opAppOptedIn}},
+		{0x62, opSpec{"app_local_get", 2, 1, opAppLocalGet}},
+		{0x63, opSpec{"app_local_get_ex", 2, 1, opAppLocalGetEx}},
+		{0x64, opSpec{"app_global_get", 2, 1, opAppGlobalGet}},
+		{0x65, opSpec{"app_global_get_ex", 2, 1, opAppGlobalGetEx}},
+		{0x66, opSpec{"app_local_put", 2, 1, opAppLocalPut}},
+		{0x67, opSpec{"app_global_put", 2, 1, opAppGlobalPut}},
+		{0x68, opSpec{"app_local_del", 2, 1, opAppLocalDel}},
+		{0x69, opSpec{"app_global_del", 2, 1, opAppGlobalDel}},
+		{0x70, opSpec{"asset_holding_get", 2, 1, opAssetHoldingGet}},
+		{0x71, opSpec{"asset_params_get", 2, 1, opAssetParamsGet}},
+		{0x72, opSpec{"app_params_get", 5, 1, opAppParamsGet}},
+		{0x73, opSpec{"acct_params_get", 6, 1, opAcctParamsGet}},
+		{0x74, unsupported("voter_params_get", 11)},
+		{0x75, unsupported("online_stake", 11)},
+		{0x78, opSpec{"min_balance", 3, 1, opMinBalance}},
+		{0x80, opSpec{"pushbytes", 3, 1, opPushbytes}},
+		{0x81, opSpec{"pushint", 3, 1, opPushint}},
+		{0x82, opSpec{"pushbytess", 8, 1, opPushbytess}},
+		{0x83, opSpec{"pushints", 8, 1, opPushints}},
+		{0x84, opSpec{"ed25519verify_bare", 7, 1900, opEd25519VerifyBare}},
+		{0x88, opSpec{"callsub", 4, 1, opCallsub}},
+		{0x89, opSpec{"retsub", 4, 1, opRetsub}},
+		{0x8a, opSpec{"proto", 8, 1, opProto}},
+		{0x8b, opSpec{"frame_dig", 8, 1, opFrameDig}},
+		{0x8c, opSpec{"frame_bury", 8, 1, opFrameBury}},
+		{0x8d, opSpec{"switch", 8, 1, opSwitch}},
+		{0x8e, opSpec{"match", 8, 1, opMatch}},
+		{0x90, opSpec{"shl", 4, 1, opArith(func(a, b uint64) (uint64, error) {
+			if b > 63 {
+				return 0, fmt.Errorf("shl arg too big, (%d)", b)
+			}
+			return a << b, nil
+		})}},
+		{0x91, opSpec{"shr", 4, 1, opArith(func(a, b uint64) (uint64, error) {
+			if b > 63 {
+				return 0, fmt.Errorf("shr arg too big, (%d)", b)
+			}
+			return a >> b, nil
+		})}},
+		{0x92, opSpec{"sqrt", 4, 4, opSqrt}},
+		{0x93, opSpec{"bitlen", 4, 1, opBitlen}},
+		{0x94, opSpec{"exp", 4, 1, opExp}},
+		{0x95, opSpec{"expw", 4, 10, opExpw}},
+		{0x96, opSpec{"bsqrt", 6, 40, opBsqrt}},
+		{0x97, opSpec{"divw", 6, 1, opDivw}},
+		{0x98, unsupported("sha3_256", 7)},
+		{0xa0, opSpec{"b+", 4, 10, opBigArith(func(z, a, b *big.Int) error { z.Add(a, b); return nil })}},
+		{0xa1, opSpec{"b-", 4, 10, opBigArith(func(z, a, b *big.Int) error {
+			if a.Cmp(b) < 0 {
+				return errors.New("byte math would have negative result")
+			}
+			z.Sub(a, b)
+			return nil
+		})}},
+		{0xa2, opSpec{"b/", 4, 20, opBigArith(func(z, a, b *big.Int) error {
+			if b.Sign() == 0 {
+				return errors.New("division by zero")
+			}
+			z.Div(a, b)
+			return nil
+		})}},
+		{0xa3, opSpec{"b*", 4, 20, opBigArith(func(z, a, b *big.Int) error { z.Mul(a, b); return nil })}},
+		{0xa4, opSpec{"b<", 4, 1, opBigCompare(func(c int) bool { return c < 0 })}},
+		{0xa5, opSpec{"b>", 4, 1, opBigCompare(func(c int) bool { return c > 0 })}},
+		{0xa6, opSpec{"b<=", 4, 1, opBigCompare(func(c int) bool { return c <= 0 })}},
+		{0xa7, opSpec{"b>=", 4, 1, opBigCompare(func(c int) bool { return c >= 0 })}},
+		{0xa8, opSpec{"b==", 4, 1, opBigCompare(func(c int) bool { return c == 0 })}},
+		{0xa9, opSpec{"b!=", 4, 1, opBigCompare(func(c int) bool { return c != 0 })}},
+		{0xaa, opSpec{"b%", 4, 20, opBigArith(func(z, a, b *big.Int) error {
+			if b.Sign() == 0 {
+				return errors.New("modulo by zero")
+			}
+			z.Mod(a, b)
+			return nil
+		})}},
+		{0xab, opSpec{"b|", 4, 6, opByteLogic(func(a, b byte) byte { return a | b })}},
+		{0xac, opSpec{"b&", 4, 6, opByteLogic(func(a, b byte) byte { return a & b })}},
+		{0xad, opSpec{"b^", 4, 6, opByteLogic(func(a, b byte) byte { return a ^ b })}},
+		{0xae, opSpec{"b~", 4, 4, opByteNot}},
+		{0xaf, opSpec{"bzero", 4, 1, opBzero}},
+		{0xb0, opSpec{"log", 5, 1, opLog}},
+		{0xb1, opSpec{"itxn_begin", 5, 1, opItxnBegin}},
+		{0xb2, opSpec{"itxn_field", 5, 1, opItxnField}},
+		{0xb3, opSpec{"itxn_submit", 5, 1, opItxnSubmit}},
+		{0xb4, opSpec{"itxn", 5, 1, opItxn}},
+		{0xb5, opSpec{"itxna", 5, 1, opItxna}},
+		{0xb6, opSpec{"itxn_next", 6, 1, opItxnNext}},
+		{0xb7, opSpec{"gitxn", 6, 1, opGitxn}},
+		{0xb8, opSpec{"gitxna", 6, 1, opGitxna}},
+		{0xb9, opSpec{"box_create", 8, 1, opBoxCreate}},
+		{0xba, opSpec{"box_extract", 8, 1, opBoxExtract}},
+		{0xbb, opSpec{"box_replace", 8, 1, opBoxReplace}},
+		{0xbc, opSpec{"box_del", 8, 1, opBoxDel}},
+		{0xbd, opSpec{"box_len", 8, 1, opBoxLen}},
+		{0xbe, opSpec{"box_get", 8, 1, opBoxGet}},
+		{0xbf, opSpec{"box_put", 8, 1, opBoxPut}},
+		{0xc0, opSpec{"txnas", 5, 1, opTxnas}},
+		{0xc1, opSpec{"gtxnas", 5, 1, opGtxnas}},
+		{0xc2, opSpec{"gtxnsas", 5, 1, opGtxnsas}},
+		{0xc3, unsupported("args", 5)},
+		{0xc4, opSpec{"gloadss", 6, 1, opGloadss}},
+		{0xc5, opSpec{"itxnas", 6, 1, opItxnas}},
+		{0xc6, opSpec{"gitxnas", 6, 1, opGitxnas}},
+		{0xd0, unsupported("vrf_verify", 7)},
+		{0xd1, unsupported("block", 7)},
+		{0xd2, opSpec{"box_splice", 10, 1, opBoxSplice}},
+		{0xd3, opSpec{"box_resize", 10, 1, opBoxResize}},
+		{0xe0, unsupported("ec_add", 10)},
+		{0xe1, unsupported("ec_scalar_mul", 10)},
+		{0xe2, unsupported("ec_pairing_check", 10)},
+		{0xe3, unsupported("ec_multi_scalar_mul", 10)},
+		{0xe4, unsupported("ec_subgroup_check", 10)},
+		{0xe5, unsupported("ec_map_to", 10)},
+	}
+	for _, op := range ops {
+		opTable[op.code] = op.spec
+	}
+}
+
+// unsupported is an opcode memnet knows but does not implement.
+func unsupported(name string, version uint64) opSpec {
+	return opSpec{name, version, 1, func(*evaluator) error {
+		return fmt.Errorf("%s is not supported by memnet", name)
+	}}
+}
+
+func opErr(*evaluator) error { return errors.New("err opcode executed") }
+
+func opHash(sum func([]byte) []byte) func(*evaluator) error {
+	return func(e *evaluator) error {
+		b, err := e.popBytes()
+		if err != nil {
+			return err
+		}
+		e.pushBytes(sum(b))
+		return nil
+	}
+}
+
+// verifyArgs pops the data, signature and public key of an ed25519 check.
+func (e *evaluator) verifyArgs() (data, sig, pk []byte, err error) {
+	args, err := e.popBytesN(3)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(args[1]) != ed25519.SignatureSize || len(args[2]) != ed25519.PublicKeySize {
+		return nil, nil, nil, errors.New("invalid signature or public key length")
+	}
+	return args[0], args[1], args[2], nil
+}
+
+func opEd25519Verify(e *evaluator) error {
+	data, sig, pk, err := e.verifyArgs()
+	if err != nil {
+		return err
+	}
+	addr := programAddress(e.program)
+	msg := append([]byte("ProgData"), addr[:]...)
+	e.pushBool(ed25519.Verify(pk, append(msg, data...), sig))
+	return nil
+}
+
+func opEd25519VerifyBare(e *evaluator) error {
+	data, sig, pk, err := e.verifyArgs()
+	if err != nil {
+		return err
+	}
+	e.pushBool(ed25519.Verify(pk, data, sig))
+	return nil
+}
+
+func opArith(f func(a, b uint64) (uint64, error)) func(*evaluator) error {
+	return func(e *evaluator) error {
+		args, err := e.popUints(2)
+		if err != nil {
+			return err
+		}
+		r, err := f(args[0], args[1])
+		if err != nil {
+			return err
+		}
+		e.pushUint(r)
+		return nil
+	}
+}
+
+func opCompare(f func(a, b uint64) bool) func(*evaluator) error {
+	return func(e *evaluator) error {
+		args, err := e.popUints(2)
+		if err != nil {
+			return err
+		}
+		e.pushBool(f(args[0], args[1]))
+		return nil
+	}
+}
+
+func opEquals(negate bool) func(*evaluator) error {
+	return func(e *evaluator) error {
+		b, err := e.pop()
+		if err != nil {
+			return err
+		}
+		a, err := e.pop()
+		if err != nil {
+			return err
+		}
+		if a.isBytes != b.isBytes {
+			return fmt.Errorf("cannot compare (%s to %s)", a.typeName(), b.typeName())
+		}
+		eq := a.u == b.u
+		if a.isBytes {
+			eq = bytes.Equal(a.b, b.b)
+		}
+		e.pushBool(eq != negate)
+		return nil
+	}
+}
+
+func opNot(e *evaluator) error {
+	a, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	e.pushBool(a == 0)
+	return nil
+}
+
+func opLen(e *evaluator) error {
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	e.pushUint(uint64(len(b)))
+	return nil
+}
+
+func opItob(e *evaluator) error {
+	a, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	e.pushBytes(itob(a))
+	return nil
+}
+
+func opBtoi(e *evaluator) error {
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	if len(b) > 8 {
+		return fmt.Errorf("btoi arg too long, got [%d]bytes", len(b))
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	e.pushUint(u)
+	return nil
+}
+
+func opBitNot(e *evaluator) error {
+	a, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	e.pushUint(^a)
+	return nil
+}
+
+func opMulw(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	hi, lo := bits.Mul64(args[0], args[1])
+	e.pushUint(hi)
+	e.pushUint(lo)
+	return nil
+}
+
+func opAddw(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	sum, carry := bits.Add64(args[0], args[1], 0)
+	e.pushUint(carry)
+	e.pushUint(sum)
+	return nil
+}
+
+func opDivmodw(e *evaluator) error {
+	args, err := e.popUints(4)
+	if err != nil {
+		return err
+	}
+	a := new(big.Int).Lsh(new(big.Int).SetUint64(args[0]), 64)
+	a.Or(a, new(big.Int).SetUint64(args[1]))
+	b := new(big.Int).Lsh(new(big.Int).SetUint64(args[2]), 64)
+	b.Or(b, new(big.Int).SetUint64(args[3]))
+	if b.Sign() == 0 {
+		return errors.New("/ 0")
+	}
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	mask := new(big.Int).SetUint64(math.MaxUint64)
+	e.pushUint(new(big.Int).Rsh(q, 64).Uint64())
+	e.pushUint(new(big.Int).And(q, mask).Uint64())
+	e.pushUint(new(big.Int).Rsh(r, 64).Uint64())
+	e.pushUint(new(big.Int).And(r, mask).Uint64())
+	return nil
+}
+
+func opDivw(e *evaluator) error {
+	args, err := e.popUints(3)
+	if err != nil {
+		return err
+	}
+	if args[2] == 0 {
+		return errors.New("/ 0")
+	}
+	if args[0] >= args[2] {
+		return errors.New("divw overflow")
+	}
+	q, _ := bits.Div64(args[0], args[1], args[2])
+	e.pushUint(q)
+	return nil
+}
+
+func opSqrt(e *evaluator) error {
+	a, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	e.pushUint(new(big.Int).Sqrt(new(big.Int).SetUint64(a)).Uint64())
+	return nil
+}
+
+func opBitlen(e *evaluator) error {
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if !v.isBytes {
+		e.pushUint(uint64(bits.Len64(v.u)))
+		return nil
+	}
+	e.pushUint(uint64(new(big.Int).SetBytes(v.b).BitLen()))
+	return nil
+}
+
+func opExp(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	if args[0] == 0 && args[1] == 0 {
+		return errors.New("0^0 is undefined")
+	}
+	r := new(big.Int).Exp(new(big.Int).SetUint64(args[0]), new(big.Int).SetUint64(args[1]), nil)
+	if !r.IsUint64() {
+		return errors.New("exp overflowed")
+	}
+	e.pushUint(r.Uint64())
+	return nil
+}
+
+func opExpw(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	if args[0] == 0 && args[1] == 0 {
+		return errors.New("0^0 is undefined")
+	}
+	r := new(big.Int).Exp(new(big.Int).SetUint64(args[0]), new(big.Int).SetUint64(args[1]), nil)
+	if r.BitLen() > 128 {
+		return errors.New("expw overflowed")
+	}
+	e.pushUint(new(big.Int).Rsh(r, 64).Uint64())
+	e.pushUint(new(big.Int).And(r, new(big.Int).SetUint64(math.MaxUint64)).Uint64())
+	return nil
+}
+
+// maxByteMathSize is the longest byte string the b-prefixed math opcodes
+// accept.
+const maxByteMathSize = 64
+
+func (e *evaluator) popBigInts(n int) ([]*big.Int, error) {
+	args, err := e.popBytesN(n)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*big.Int, n)
+	for i, b := range args {
+		if len(b) > maxByteMathSize {
+			return nil, errors.New("math attempted on large byte-array")
+		}
+		out[i] = new(big.Int).SetBytes(b)
+	}
+	return out, nil
+}
+
+func opBsqrt(e *evaluator) error {
+	args, err := e.popBigInts(1)
+	if err != nil {
+		return err
+	}
+	e.pushBytes(new(big.Int).Sqrt(args[0]).Bytes())
+	return nil
+}
+
+func opBigArith(f func(z, a, b *big.Int) error) func(*evaluator) error {
+	return func(e *evaluator) error {
+		args, err := e.popBigInts(2)
+		if err != nil {
+			return err
+		}
+		z := new(big.Int)
+		if err := f(z, args[0], args[1]); err != nil {
+			return err
+		}
+		out := z.Bytes()
+		if len(out) > maxByteMathSize*2 {
+			return errors.New("byte math result too large")
+		}
+		e.pushBytes(out)
+		return nil
+	}
+}
+
+func opBigCompare(f func(c int) bool) func(*evaluator) error {
+	return func(e *evaluator) error {
+		args, err := e.popBigInts(2)
+		if err != nil {
+			return err
+		}
+		e.pushBool(f(args[0].Cmp(args[1])))
+		return nil
+	}
+}
+
+func opByteLogic(f func(a, b byte) byte) func(*evaluator) error {
+	return func(e *evaluator) error {
+		args, err := e.popBytesN(2)
+		if err != nil {
+			return err
+		}
+		a, b := args[0], args[1]
+		if len(a) < len(b) {
+			a, b = b, a
+		}
+		// Left-pad the shorter operand with zeros.
+		out := make([]byte, len(a))
+		pad := len(a) - len(b)
+		for i := range out {
+			var bb byte
+			if i >= pad {
+				bb = b[i-pad]
+			}
+			out[i] = f(a[i], bb)
+		}
+		e.pushBytes(out)
+		return nil
+	}
+}
+
+func opByteNot(e *evaluator) error {
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	out := make([]byte, len(b))
+	for i, c := range b {
+		out[i] = ^c
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opBzero(e *evaluator) error {
+	n, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if n > maxStringSize {
+		return fmt.Errorf("bzero attempted to create a too large string")
+	}
+	e.pushBytes(make([]byte, n))
+	return nil
+}
+
+// Constants.
+
+func opIntcblock(e *evaluator) error {
+	n, err := e.immVaruint()
+	if err != nil {
+		return err
+	}
+	e.intc = make([]uint64, 0, n)
+	for i := uint64(0); i < n; i++ {
+		u, err := e.immVaruint()
+		if err != nil {
+			return err
+		}
+		e.intc = append(e.intc, u)
+	}
+	return nil
+}
+
+func (e *evaluator) pushIntc(i int) error {
+	if i >= len(e.intc) {
+		return fmt.Errorf("intc %d beyond %d constants", i, len(e.intc))
+	}
+	e.pushUint(e.intc[i])
+	return nil
+}
+
+func opIntc(e *evaluator) error {
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushIntc(int(i))
+}
+
+func opIntcN(i int) func(*evaluator) error {
+	return func(e *evaluator) error { return e.pushIntc(i) }
+}
+
+func opBytecblock(e *evaluator) error {
+	n, err := e.immVaruint()
+	if err != nil {
+		return err
+	}
+	e.bytec = make([][]byte, 0, n)
+	for i := uint64(0); i < n; i++ {
+		b, err := e.immBytes()
+		if err != nil {
+			return err
+		}
+		e.bytec = append(e.bytec, b)
+	}
+	return nil
+}
+
+func (e *evaluator) pushBytec(i int) error {
+	if i >= len(e.bytec) {
+		return fmt.Errorf("bytec %d beyond %d constants", i, len(e.bytec))
+	}
+	e.pushBytes(e.bytec[i])
+	return nil
+}
+
+func opBytec(e *evaluator) error {
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.pushBytec(int(i))
+}
+
+func opBytecN(i int) func(*evaluator) error {
+	return func(e *evaluator) error { return e.pushBytec(i) }
+}
+
+func opPushbytes(e *evaluator) error {
+	b, err := e.immBytes()
+	if err != nil {
+		return err
+	}
+	e.pushBytes(b)
+	return nil
+}
+
+func opPushint(e *evaluator) error {
+	u, err := e.immVaruint()
+	if err != nil {
+		return err
+	}
+	e.pushUint(u)
+	return nil
+}
+
+func opPushbytess(e *evaluator) error {
+	n, err := e.immVaruint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < n; i++ {
+		b, err := e.immBytes()
+		if err != nil {
+			return err
+		}
+		e.pushBytes(b)
+	}
+	return nil
+}
+
+func opPushints(e *evaluator) error {
+	n, err := e.immVaruint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < n; i++ {
+		u, err := e.immVaruint()
+		if err != nil {
+			return err
+		}
+		e.pushUint(u)
+	}
+	return nil
+}
+
+// Flow control.
+
+func opBranchIf(nonzero bool) func(*evaluator) error {
+	return func(e *evaluator) error {
+		off, err := e.immInt16()
+		if err != nil {
+			return err
+		}
+		cond, err := e.popUint()
+		if err != nil {
+			return err
+		}
+		if (cond != 0) == nonzero {
+			return e.branch(off)
+		}
+		return nil
+	}
+}
+
+func opB(e *evaluator) error {
+	off, err := e.immInt16()
+	if err != nil {
+		return err
+	}
+	return e.branch(off)
+}
+
+func opReturn(e *evaluator) error {
+	v, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	e.stack = append(e.stack[:0], uintValue(v))
+	e.done = true
+	return nil
+}
+
+func opAssert(e *evaluator) error {
+	v, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if v == 0 {
+		return errors.New("assert failed")
+	}
+	return nil
+}
+
+func opCallsub(e *evaluator) error {
+	off, err := e.immInt16()
+	if err != nil {
+		return err
+	}
+	if len(e.frames) >= maxStackDepth/4 {
+		return errors.New("callsub nested too deeply")
+	}
+	e.frames = append(e.frames, frame{retpc: e.next, height: len(e.stack)})
+	return e.branch(off)
+}
+
+func opRetsub(e *evaluator) error {
+	if len(e.frames) == 0 {
+		return errors.New("retsub with empty callstack")
+	}
+	f := e.frames[len(e.frames)-1]
+	e.frames = e.frames[:len(e.frames)-1]
+	if f.proto {
+		if len(e.stack) < f.height+f.rets {
+			return fmt.Errorf("retsub executed with stack below frame. Did you pop args?")
+		}
+		base := f.height - f.args
+		copy(e.stack[base:], e.stack[len(e.stack)-f.rets:])
+		e.stack = e.stack[:base+f.rets]
+	}
+	e.next = f.retpc
+	return nil
+}
+
+func opProto(e *evaluator) error {
+	args, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	rets, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	if len(e.frames) == 0 {
+		return errors.New("proto was executed without a callsub")
+	}
+	f := &e.frames[len(e.frames)-1]
+	if f.proto {
+		return errors.New("proto was executed twice in one frame")
+	}
+	if int(args) > f.height {
+		return fmt.Errorf("callsub to proto that requires %d args with stack height %d", args, f.height)
+	}
+	f.proto, f.args, f.rets = true, int(args), int(rets)
+	return nil
+}
+
+// frameIndex returns the stack index of a frame_dig or frame_bury operand.
+func (e *evaluator) frameIndex() (int, error) {
+	b, err := e.imm8()
+	if err != nil {
+		return 0, err
+	}
+	if len(e.frames) == 0 || !e.frames[len(e.frames)-1].proto {
+		return 0, errors.New("frame access without a proto")
+	}
+	f := e.frames[len(e.frames)-1]
+	off := int(int8(b))
+	if off < 0 && -off > f.args {
+		return 0, fmt.Errorf("frame access %d below %d args", off, f.args)
+	}
+	i := f.height + off
+	if i >= len(e.stack) {
+		return 0, fmt.Errorf("frame access %d above stack", off)
+	}
+	return i, nil
+}
+
+func opFrameDig(e *evaluator) error {
+	i, err := e.frameIndex()
+	if err != nil {
+		return err
+	}
+	e.push(e.stack[i])
+	return nil
+}
+
+func opFrameBury(e *evaluator) error {
+	i, err := e.frameIndex()
+	if err != nil {
+		return err
+	}
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if i >= len(e.stack) {
+		return errors.New("frame_bury above stack")
+	}
+	e.stack[i] = v
+	return nil
+}
+
+func opSwitch(e *evaluator) error {
+	labels, err := e.immLabels()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if i < uint64(len(labels)) {
+		return e.branch(labels[i])
+	}
+	return nil
+}
+
+func opMatch(e *evaluator) error {
+	labels, err := e.immLabels()
+	if err != nil {
+		return err
+	}
+	if len(e.stack) < len(labels)+1 {
+		return errors.New("match stack underflow")
+	}
+	target, err := e.pop()
+	if err != nil {
+		return err
+	}
+	cands := e.stack[len(e.stack)-len(labels):]
+	e.stack = e.stack[:len(e.stack)-len(labels)]
+	for i, c := range cands {
+		if c.isBytes != target.isBytes {
+			return fmt.Errorf("match expected %s but got %s", target.typeName(), c.typeName())
+		}
+		if (c.isBytes && bytes.Equal(c.b, target.b)) || (!c.isBytes && c.u == target.u) {
+			return e.branch(labels[i])
+		}
+	}
+	return nil
+}
+
+// Stack manipulation.
+
+// stackIndex returns the index of the nth value from the top.
+func (e *evaluator) stackIndex(n int) (int, error) {
+	i := len(e.stack) - 1 - n
+	if i < 0 {
+		return 0, errors.New("stack underflow")
+	}
+	return i, nil
+}
+
+func opBury(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("bury 0 is invalid")
+	}
+	i, err := e.stackIndex(int(n))
+	if err != nil {
+		return err
+	}
+	v, _ := e.pop()
+	e.stack[i] = v
+	return nil
+}
+
+func opPopn(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	if int(n) > len(e.stack) {
+		return errors.New("popn stack underflow")
+	}
+	e.stack = e.stack[:len(e.stack)-int(n)]
+	return nil
+}
+
+func opDupn(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	if len(e.stack) == 0 {
+		return errors.New("dupn stack underflow")
+	}
+	top := e.stack[len(e.stack)-1]
+	for i := 0; i < int(n); i++ {
+		e.push(top)
+	}
+	return nil
+}
+
+func opPop(e *evaluator) error {
+	_, err := e.pop()
+	return err
+}
+
+func opDup(e *evaluator) error {
+	if len(e.stack) == 0 {
+		return errors.New("dup stack underflow")
+	}
+	e.push(e.stack[len(e.stack)-1])
+	return nil
+}
+
+func opDup2(e *evaluator) error {
+	if len(e.stack) < 2 {
+		return errors.New("dup2 stack underflow")
+	}
+	e.stack = append(e.stack, e.stack[len(e.stack)-2:]...)
+	return nil
+}
+
+func opDig(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.stackIndex(int(n))
+	if err != nil {
+		return err
+	}
+	e.push(e.stack[i])
+	return nil
+}
+
+func opSwap(e *evaluator) error {
+	if len(e.stack) < 2 {
+		return errors.New("swap stack underflow")
+	}
+	n := len(e.stack)
+	e.stack[n-1], e.stack[n-2] = e.stack[n-2], e.stack[n-1]
+	return nil
+}
+
+func opSelect(e *evaluator) error {
+	c, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	b, err := e.pop()
+	if err != nil {
+		return err
+	}
+	a, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if c != 0 {
+		e.push(b)
+	} else {
+		e.push(a)
+	}
+	return nil
+}
+
+func opCover(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.stackIndex(int(n))
+	if err != nil {
+		return err
+	}
+	top := e.stack[len(e.stack)-1]
+	copy(e.stack[i+1:], e.stack[i:len(e.stack)-1])
+	e.stack[i] = top
+	return nil
+}
+
+func opUncover(e *evaluator) error {
+	n, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	i, err := e.stackIndex(int(n))
+	if err != nil {
+		return err
+	}
+	v := e.stack[i]
+	copy(e.stack[i:], e.stack[i+1:])
+	e.stack[len(e.stack)-1] = v
+	return nil
+}
+
+// Byte strings.
+
+func opConcat(e *evaluator) error {
+	args, err := e.popBytesN(2)
+	if err != nil {
+		return err
+	}
+	if len(args[0])+len(args[1]) > maxStringSize {
+		return errors.New("concat produced a too big (>4096) byte-array")
+	}
+	out := make([]byte, 0, len(args[0])+len(args[1]))
+	e.pushBytes(append(append(out, args[0]...), args[1]...))
+	return nil
+}
+
+func substring(b []byte, start, end uint64) ([]byte, error) {
+	if end < start {
+		return nil, errors.New("substring end before start")
+	}
+	if end > uint64(len(b)) {
+		return nil, errors.New("substring range beyond length of string")
+	}
+	return b[start:end], nil
+}
+
+func opSubstring(e *evaluator) error {
+	start, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	end, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	out, err := substring(b, uint64(start), uint64(end))
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opSubstring3(e *evaluator) error {
+	idx, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	out, err := substring(b, idx[0], idx[1])
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func extract(b []byte, start, length uint64) ([]byte, error) {
+	end := start + length
+	if end < start || end > uint64(len(b)) {
+		return nil, fmt.Errorf("extraction end %d is beyond length: %d", end, len(b))
+	}
+	return b[start:end], nil
+}
+
+func opExtract(e *evaluator) error {
+	start, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	length, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	if uint64(start) > uint64(len(b)) {
+		return fmt.Errorf("extraction start %d is beyond length: %d", start, len(b))
+	}
+	n := uint64(length)
+	if n == 0 {
+		n = uint64(len(b)) - uint64(start)
+	}
+	out, err := extract(b, uint64(start), n)
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opExtract3(e *evaluator) error {
+	idx, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	out, err := extract(b, idx[0], idx[1])
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opExtractUint(size uint64) func(*evaluator) error {
+	return func(e *evaluator) error {
+		start, err := e.popUint()
+		if err != nil {
+			return err
+		}
+		b, err := e.popBytes()
+		if err != nil {
+			return err
+		}
+		out, err := extract(b, start, size)
+		if err != nil {
+			return err
+		}
+		var u uint64
+		for _, c := range out {
+			u = u<<8 | uint64(c)
+		}
+		e.pushUint(u)
+		return nil
+	}
+}
+
+func replace(b []byte, start uint64, r []byte) ([]byte, error) {
+	end := start + uint64(len(r))
+	if end < start || end > uint64(len(b)) {
+		return nil, fmt.Errorf("replacement end %d beyond original length: %d", end, len(b))
+	}
+	out := append([]byte{}, b...)
+	copy(out[start:], r)
+	return out, nil
+}
+
+func opReplace2(e *evaluator) error {
+	start, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	args, err := e.popBytesN(2)
+	if err != nil {
+		return err
+	}
+	out, err := replace(args[0], uint64(start), args[1])
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opReplace3(e *evaluator) error {
+	r, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	start, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	out, err := replace(b, start, r)
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opGetbit(e *evaluator) error {
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if !v.isBytes {
+		if i > 63 {
+			return fmt.Errorf("getbit index %d beyond 64 bits", i)
+		}
+		e.pushUint(v.u >> i & 1)
+		return nil
+	}
+	if i/8 >= uint64(len(v.b)) {
+		return fmt.Errorf("getbit index %d beyond byteslice", i)
+	}
+	e.pushUint(uint64(v.b[i/8]>>(7-i%8)) & 1)
+	return nil
+}
+
+func opSetbit(e *evaluator) error {
+	bit, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if bit > 1 {
+		return errors.New("setbit value > 1")
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	if !v.isBytes {
+		if i > 63 {
+			return fmt.Errorf("setbit index %d beyond 64 bits", i)
+		}
+		v.u = v.u&^(1<<i) | bit<<i
+		e.push(v)
+		return nil
+	}
+	if i/8 >= uint64(len(v.b)) {
+		return fmt.Errorf("setbit index %d beyond byteslice", i)
+	}
+	out := append([]byte{}, v.b...)
+	mask := byte(1) << (7 - i%8)
+	if bit == 1 {
+		out[i/8] |= mask
+	} else {
+		out[i/8] &^= mask
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+func opGetbyte(e *evaluator) error {
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	if i >= uint64(len(b)) {
+		return fmt.Errorf("getbyte index %d beyond length", i)
+	}
+	e.pushUint(uint64(b[i]))
+	return nil
+}
+
+func opSetbyte(e *evaluator) error {
+	c, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if c > 255 {
+		return errors.New("setbyte value > 255")
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	if i >= uint64(len(b)) {
+		return fmt.Errorf("setbyte index %d beyond length", i)
+	}
+	out := append([]byte{}, b...)
+	out[i] = byte(c)
+	e.pushBytes(out)
+	return nil
+}
+
+func opBase64Decode(e *evaluator) error {
+	enc, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	var encoding *base64.Encoding
+	switch enc {
+	case 0:
+		encoding = base64.URLEncoding
+	case 1:
+		encoding = base64.StdEncoding
+	default:
+		return fmt.Errorf("invalid base64_decode encoding %d", enc)
+	}
+	e.c.budget -= len(b) / 16
+	out, err := encoding.Strict().DecodeString(string(b))
+	if err != nil {
+		// Padding is optional.
+		out, err = encoding.WithPadding(base64.NoPadding).Strict().DecodeString(string(b))
+	}
+	if err != nil {
+		return err
+	}
+	e.pushBytes(out)
+	return nil
+}
+
+// Scratch space.
+
+func opLoad(e *evaluator) error {
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	e.push(e.scratch[i])
+	return nil
+}
+
+func opStore(e *evaluator) error {
+	i, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	e.scratch[i] = v
+	return nil
+}
+
+func opLoads(e *evaluator) error {
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if i > 255 {
+		return fmt.Errorf("invalid scratch space index %d", i)
+	}
+	e.push(e.scratch[i])
+	return nil
+}
+
+func opStores(e *evaluator) error {
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	i, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	if i > 255 {
+		return fmt.Errorf("invalid scratch space index %d", i)
+	}
+	e.scratch[i] = v
+	return nil
+}
+
+// earlier returns the result of an earlier transaction of the group, for
+// gload and gaid.
+func (e *evaluator) earlier(gi uint64) (*txnResult, error) {
+	if gi >= uint64(e.gi) {
+		return nil, fmt.Errorf("can't get results of transaction %d from transaction %d", gi, e.gi)
+	}
+	return e.results[gi], nil
+}
+
+func (e *evaluator) gload(gi uint64, slot uint64) error {
+	if slot > 255 {
+		return fmt.Errorf("invalid scratch space index %d", slot)
+	}
+	r, err := e.earlier(gi)
+	if err != nil {
+		return err
+	}
+	if e.group[gi].Type != "appl" {
+		return fmt.Errorf("can't use gload on non-app call txn with index %d", gi)
+	}
+	e.push(r.scratch[slot])
+	return nil
+}
+
+func opGload(e *evaluator) error {
+	gi, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	slot, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.gload(uint64(gi), uint64(slot))
+}
+
+func opGloads(e *evaluator) error {
+	slot, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	gi, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.gload(gi, uint64(slot))
+}
+
+func opGloadss(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	return e.gload(args[0], args[1])
+}
+
+func (e *evaluator) gaid(gi uint64) error {
+	r, err := e.earlier(gi)
+	if err != nil {
+		return err
+	}
+	switch {
+	case r.createdApp != 0:
+		e.pushUint(r.createdApp)
+	case r.createdAsset != 0:
+		e.pushUint(r.createdAsset)
+	default:
+		return fmt.Errorf("can't use gaid on txn that is not an app call nor an asset config txn with index %d", gi)
+	}
+	return nil
+}
+
+func opGaid(e *evaluator) error {
+	gi, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	return e.gaid(uint64(gi))
+}
+
+func opGaids(e *evaluator) error {
+	gi, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	return e.gaid(gi)
+}
+
+func opLog(e *evaluator) error {
+	b, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	if len(e.result.logs) >= maxLogCalls {
+		return fmt.Errorf("too many log calls in program. up to %d is allowed", maxLogCalls)
+	}
+	size := len(b)
+	for _, l := range e.result.logs {
+		size += len(l)
+	}
+	if size > maxLogSize {
+		return fmt.Errorf("program logs too large. %d bytes >  %d bytes limit", size, maxLogSize)
+	}
+	e.result.logs = append(e.result.logs, b)
+	return nil
+}
@@ -0,0 +1,682 @@
+package memnet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// Resource references. memnet does not check that a resource is available
+// to the transaction; an operand that matches a foreign array entry, or is a
+// small enough index into it, resolves as algod would, and anything else is
+// taken as an ID or address.
+
+// resolveAccount returns the address an account operand refers to.
+func (e *evaluator) resolveAccount(v value) (types.Address, error) {
+	var addr types.Address
+	if v.isBytes {
+		if len(v.b) != len(addr) {
+			return addr, fmt.Errorf("invalid Account reference %s", v)
+		}
+		copy(addr[:], v.b)
+		return addr, nil
+	}
+	if v.u == 0 {
+		return e.txn.Sender, nil
+	}
+	if v.u > uint64(len(e.txn.Accounts)) {
+		return addr, fmt.Errorf("invalid Account reference %d", v.u)
+	}
+	return e.txn.Accounts[v.u-1], nil
+}
+
+func (e *evaluator) popAccount() (types.Address, error) {
+	v, err := e.pop()
+	if err != nil {
+		return types.Address{}, err
+	}
+	return e.resolveAccount(v)
+}
+
+// resolveApp returns the app ID an app operand refers to.
+func (e *evaluator) resolveApp(ref uint64) uint64 {
+	if ref == 0 {
+		return e.appID
+	}
+	for _, id := range e.txn.ForeignApps {
+		if uint64(id) == ref {
+			return ref
+		}
+	}
+	if ref <= uint64(len(e.txn.ForeignApps)) {
+		return uint64(e.txn.ForeignApps[ref-1])
+	}
+	return ref
+}
+
+// resolveAsset returns the asset ID an asset operand refers to.
+func (e *evaluator) resolveAsset(ref uint64) uint64 {
+	for _, id := range e.txn.ForeignAssets {
+		if uint64(id) == ref {
+			return ref
+		}
+	}
+	if ref < uint64(len(e.txn.ForeignAssets)) {
+		return uint64(e.txn.ForeignAssets[ref])
+	}
+	return ref
+}
+
+func opBalance(e *evaluator) error {
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	var bal uint64
+	if a, ok := e.c.l.accounts[addr]; ok {
+		bal = a.balance
+	}
+	e.pushUint(bal)
+	return nil
+}
+
+func opMinBalance(e *evaluator) error {
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	e.pushUint(e.c.l.minBalance(addr))
+	return nil
+}
+
+func opAppOptedIn(e *evaluator) error {
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	_, ok := e.c.l.account(addr).locals[e.resolveApp(ref)]
+	e.pushBool(ok)
+	return nil
+}
+
+// localState returns the local state of addr in appID.
+func (e *evaluator) localState(addr types.Address, appID uint64) (map[string]value, error) {
+	kv, ok := e.c.l.account(addr).locals[appID]
+	if !ok {
+		return nil, fmt.Errorf("account %s is not opted into %d", addr, appID)
+	}
+	return kv, nil
+}
+
+func (e *evaluator) pushStateValue(v value, ok bool, withFlag bool) {
+	if ok {
+		e.push(v)
+	} else {
+		e.pushUint(0)
+	}
+	if withFlag {
+		e.pushBool(ok)
+	}
+}
+
+func opAppLocalGet(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	kv, err := e.localState(addr, e.appID)
+	if err != nil {
+		return err
+	}
+	v, ok := kv[string(key)]
+	e.pushStateValue(v, ok, false)
+	return nil
+}
+
+func opAppLocalGetEx(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	v, ok := e.c.l.account(addr).locals[e.resolveApp(ref)][string(key)]
+	e.pushStateValue(v, ok, true)
+	return nil
+}
+
+func opAppGlobalGet(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	v, ok := e.c.l.apps[e.appID].global[string(key)]
+	e.pushStateValue(v, ok, false)
+	return nil
+}
+
+func opAppGlobalGetEx(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	var v value
+	var ok bool
+	if ap, exists := e.c.l.apps[e.resolveApp(ref)]; exists {
+		v, ok = ap.global[string(key)]
+	}
+	e.pushStateValue(v, ok, true)
+	return nil
+}
+
+// putState sets key in kv, enforcing the key and value limits and schema.
+func putState(kv map[string]value, schema types.StateSchema, key []byte, v value) error {
+	if len(key) > maxAppKeyLen {
+		return fmt.Errorf("key too long: length was %d, maximum is %d", len(key), maxAppKeyLen)
+	}
+	if v.isBytes {
+		if len(v.b) > maxAppBytesValueLen {
+			return fmt.Errorf("value too long for key 0x%x: length was %d", key, len(v.b))
+		}
+		if len(key)+len(v.b) > maxAppSumKeyValueLen {
+			return fmt.Errorf("key/value total too long for key 0x%x: sum was %d", key, len(key)+len(v.b))
+		}
+	}
+	var uints, byteSlices uint64
+	for k, old := range kv {
+		if k == string(key) {
+			continue
+		}
+		if old.isBytes {
+			byteSlices++
+		} else {
+			uints++
+		}
+	}
+	if v.isBytes {
+		byteSlices++
+	} else {
+		uints++
+	}
+	if uints > schema.NumUint {
+		return fmt.Errorf("store integer count %d exceeds schema integer count %d", uints, schema.NumUint)
+	}
+	if byteSlices > schema.NumByteSlice {
+		return fmt.Errorf("store bytes count %d exceeds schema bytes count %d", byteSlices, schema.NumByteSlice)
+	}
+	kv[string(key)] = v.clone()
+	return nil
+}
+
+func opAppLocalPut(e *evaluator) error {
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	kv, err := e.localState(addr, e.appID)
+	if err != nil {
+		return err
+	}
+	return putState(kv, e.c.l.apps[e.appID].localSchema, key, v)
+}
+
+func opAppGlobalPut(e *evaluator) error {
+	v, err := e.pop()
+	if err != nil {
+		return err
+	}
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	ap := e.c.l.apps[e.appID]
+	return putState(ap.global, ap.globalSchema, key, v)
+}
+
+func opAppLocalDel(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	kv, err := e.localState(addr, e.appID)
+	if err != nil {
+		return err
+	}
+	delete(kv, string(key))
+	return nil
+}
+
+func opAppGlobalDel(e *evaluator) error {
+	key, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	delete(e.c.l.apps[e.appID].global, string(key))
+	return nil
+}
+
+func opAssetHoldingGet(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	h, ok := e.c.l.account(addr).holdings[e.resolveAsset(ref)]
+	if !ok {
+		h = &holding{}
+	}
+	switch f {
+	case 0:
+		e.pushUint(h.amount)
+	case 1:
+		e.pushBool(h.frozen)
+	default:
+		return fmt.Errorf("invalid asset_holding_get field %d", f)
+	}
+	e.pushBool(ok)
+	return nil
+}
+
+func opAssetParamsGet(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	as, ok := e.c.l.assets[e.resolveAsset(ref)]
+	if !ok {
+		as = &asset{}
+	}
+	p := as.params
+	addr := func(a types.Address) { e.pushBytes(append([]byte{}, a[:]...)) }
+	switch f {
+	case 0:
+		e.pushUint(p.Total)
+	case 1:
+		e.pushUint(uint64(p.Decimals))
+	case 2:
+		e.pushBool(p.DefaultFrozen)
+	case 3:
+		e.pushBytes([]byte(p.UnitName))
+	case 4:
+		e.pushBytes([]byte(p.AssetName))
+	case 5:
+		e.pushBytes([]byte(p.URL))
+	case 6:
+		e.pushBytes(append([]byte{}, p.MetadataHash[:]...))
+	case 7:
+		addr(p.Manager)
+	case 8:
+		addr(p.Reserve)
+	case 9:
+		addr(p.Freeze)
+	case 10:
+		addr(p.Clawback)
+	case 11:
+		addr(as.creator)
+	default:
+		return fmt.Errorf("invalid asset_params_get field %d", f)
+	}
+	e.pushBool(ok)
+	return nil
+}
+
+func opAppParamsGet(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	ref, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	ap, ok := e.c.l.apps[e.resolveApp(ref)]
+	if !ok {
+		ap = &app{}
+	}
+	switch f {
+	case 0:
+		e.pushBytes(ap.approval)
+	case 1:
+		e.pushBytes(ap.clear)
+	case 2:
+		e.pushUint(ap.globalSchema.NumUint)
+	case 3:
+		e.pushUint(ap.globalSchema.NumByteSlice)
+	case 4:
+		e.pushUint(ap.localSchema.NumUint)
+	case 5:
+		e.pushUint(ap.localSchema.NumByteSlice)
+	case 6:
+		e.pushUint(uint64(ap.extraPages))
+	case 7:
+		e.pushBytes(append([]byte{}, ap.creator[:]...))
+	case 8:
+		if !ok {
+			e.pushBytes(make([]byte, 32))
+			break
+		}
+		a := appAddress(ap.id)
+		e.pushBytes(a[:])
+	default:
+		return fmt.Errorf("invalid app_params_get field %d", f)
+	}
+	e.pushBool(ok)
+	return nil
+}
+
+func opAcctParamsGet(e *evaluator) error {
+	f, err := e.imm8()
+	if err != nil {
+		return err
+	}
+	addr, err := e.popAccount()
+	if err != nil {
+		return err
+	}
+	a := e.c.l.account(addr)
+	schema, extraPages := e.c.l.totalSchema(addr)
+	switch f {
+	case 0:
+		e.pushUint(a.balance)
+	case 1:
+		e.pushUint(e.c.l.minBalance(addr))
+	case 2:
+		e.pushBytes(append([]byte{}, a.authAddr[:]...))
+	case 3:
+		e.pushUint(schema.NumUint)
+	case 4:
+		e.pushUint(schema.NumByteSlice)
+	case 5:
+		e.pushUint(extraPages)
+	case 6:
+		e.pushUint(uint64(len(a.createdApps)))
+	case 7:
+		e.pushUint(uint64(len(a.locals)))
+	case 8:
+		e.pushUint(uint64(len(a.createdAssets)))
+	case 9:
+		e.pushUint(uint64(len(a.holdings)))
+	case 10:
+		e.pushUint(a.totalBoxes)
+	case 11:
+		e.pushUint(a.totalBoxBytes)
+	case 12:
+		e.pushBool(false)
+	case 13, 14:
+		e.pushUint(0)
+	default:
+		return fmt.Errorf("invalid acct_params_get field %d", f)
+	}
+	e.pushBool(a.balance > 0)
+	return nil
+}
+
+// Boxes of the current app.
+
+func (e *evaluator) popBoxName() (string, error) {
+	name, err := e.popBytes()
+	if err != nil {
+		return "", err
+	}
+	if len(name) == 0 || len(name) > maxAppKeyLen {
+		return "", fmt.Errorf("box names must be 1..%d bytes, got %d", maxAppKeyLen, len(name))
+	}
+	return string(name), nil
+}
+
+// setBox stores a box of the current app, keeping the app account's box
+// totals, which count towards its minimum balance, up to date.
+func (e *evaluator) setBox(name string, v []byte) error {
+	if len(v) > maxBoxSize {
+		return fmt.Errorf("box size too large: %d, max is %d", len(v), maxBoxSize)
+	}
+	ap := e.c.l.apps[e.appID]
+	owner := e.c.l.account(appAddress(e.appID))
+	if old, ok := ap.boxes[name]; ok {
+		owner.totalBoxBytes -= uint64(len(name) + len(old))
+	} else {
+		owner.totalBoxes++
+	}
+	owner.totalBoxBytes += uint64(len(name) + len(v))
+	ap.boxes[name] = v
+	return nil
+}
+
+func (e *evaluator) getBox(name string) ([]byte, bool) {
+	v, ok := e.c.l.apps[e.appID].boxes[name]
+	return v, ok
+}
+
+func (e *evaluator) mustGetBox(name string) ([]byte, error) {
+	v, ok := e.getBox(name)
+	if !ok {
+		return nil, fmt.Errorf("no such box %q", name)
+	}
+	return v, nil
+}
+
+func opBoxCreate(e *evaluator) error {
+	size, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	if old, ok := e.getBox(name); ok {
+		if uint64(len(old)) != size {
+			return fmt.Errorf("box size mismatch %d %d", len(old), size)
+		}
+		e.pushBool(false)
+		return nil
+	}
+	if size > maxBoxSize {
+		return fmt.Errorf("box size too large: %d, max is %d", size, maxBoxSize)
+	}
+	if err := e.setBox(name, make([]byte, size)); err != nil {
+		return err
+	}
+	e.pushBool(true)
+	return nil
+}
+
+func opBoxExtract(e *evaluator) error {
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, err := e.mustGetBox(name)
+	if err != nil {
+		return err
+	}
+	if args[1] > maxStringSize {
+		return errors.New("box_extract produced a too big byte-array")
+	}
+	out, err := extract(v, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	e.pushBytes(append([]byte{}, out...))
+	return nil
+}
+
+func opBoxReplace(e *evaluator) error {
+	r, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	start, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, err := e.mustGetBox(name)
+	if err != nil {
+		return err
+	}
+	out, err := replace(v, start, r)
+	if err != nil {
+		return err
+	}
+	return e.setBox(name, out)
+}
+
+func opBoxSplice(e *evaluator) error {
+	r, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	args, err := e.popUints(2)
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, err := e.mustGetBox(name)
+	if err != nil {
+		return err
+	}
+	start, length := args[0], args[1]
+	if start > uint64(len(v)) {
+		return fmt.Errorf("replacement start %d beyond length: %d", start, len(v))
+	}
+	end := start + length
+	if end < start || end > uint64(len(v)) {
+		end = uint64(len(v))
+	}
+	out := append(append(append([]byte{}, v[:start]...), r...), v[end:]...)
+	// The box keeps its size: pad with zeros or truncate.
+	if len(out) < len(v) {
+		out = append(out, make([]byte, len(v)-len(out))...)
+	}
+	return e.setBox(name, out[:len(v)])
+}
+
+func opBoxDel(e *evaluator) error {
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, ok := e.getBox(name)
+	if ok {
+		owner := e.c.l.account(appAddress(e.appID))
+		owner.totalBoxes--
+		owner.totalBoxBytes -= uint64(len(name) + len(v))
+		delete(e.c.l.apps[e.appID].boxes, name)
+	}
+	e.pushBool(ok)
+	return nil
+}
+
+func opBoxLen(e *evaluator) error {
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, ok := e.getBox(name)
+	e.pushUint(uint64(len(v)))
+	e.pushBool(ok)
+	return nil
+}
+
+func opBoxGet(e *evaluator) error {
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, ok := e.getBox(name)
+	if len(v) > maxStringSize {
+		return fmt.Errorf("box_get produced a too big (%d) byte-array", len(v))
+	}
+	e.pushBytes(append([]byte{}, v...))
+	e.pushBool(ok)
+	return nil
+}
+
+func opBoxPut(e *evaluator) error {
+	v, err := e.popBytes()
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	if old, ok := e.getBox(name); ok && len(old) != len(v) {
+		return fmt.Errorf("attempt to box_put wrong size %d != %d", len(old), len(v))
+	}
+	return e.setBox(name, append([]byte{}, v...))
+}
+
+func opBoxResize(e *evaluator) error {
+	size, err := e.popUint()
+	if err != nil {
+		return err
+	}
+	name, err := e.popBoxName()
+	if err != nil {
+		return err
+	}
+	v, err := e.mustGetBox(name)
+	if err != nil {
+		return err
+	}
+	if size > maxBoxSize {
+		return fmt.Errorf("box size too large: %d, max is %d", size, maxBoxSize)
+	}
+	out := make([]byte, size)
+	copy(out, v)
+	return e.setBox(name, out)
+}
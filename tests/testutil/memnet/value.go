@@ -0,0 +1,52 @@
+package memnet
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// value is an AVM stack value: a uint64 or a byte slice.
+type value struct {
+	isBytes bool
+	u       uint64
+	b       []byte
+}
+
+func uintValue(u uint64) value { return value{u: u} }
+
+func bytesValue(b []byte) value { return value{isBytes: true, b: b} }
+
+func boolValue(ok bool) value {
+	if ok {
+		return uintValue(1)
+	}
+	return uintValue(0)
+}
+
+func (v value) clone() value {
+	if v.isBytes {
+		v.b = append([]byte{}, v.b...)
+	}
+	return v
+}
+
+func (v value) String() string {
+	if v.isBytes {
+		return fmt.Sprintf("0x%x", v.b)
+	}
+	return fmt.Sprint(v.u)
+}
+
+func (v value) typeName() string {
+	if v.isBytes {
+		return "[]byte"
+	}
+	return "uint64"
+}
+
+// itob encodes u as 8 big-endian bytes.
+func itob(u uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, u)
+	return b
+}